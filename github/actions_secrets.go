@@ -7,9 +7,14 @@ package github
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+
+	"golang.org/x/crypto/nacl/box"
 )
 
 // PublicKey represents the public key that should be used to encrypt secrets.
@@ -47,6 +52,28 @@ func (p *PublicKey) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Encrypt encrypts value with the public key in a libsodium sealed box, and
+// returns it base64 encoded. The result is the EncryptedValue of an Actions
+// or Dependabot secret, whose KeyID is the ID of the public key.
+func (p *PublicKey) Encrypt(value []byte) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(p.GetKey())
+	if err != nil {
+		return "", fmt.Errorf("decoding public key: %v", err)
+	}
+	if len(key) != 32 {
+		return "", errors.New("public key must be 32 bytes long")
+	}
+
+	var recipient [32]byte
+	copy(recipient[:], key)
+	sealed, err := box.SealAnonymous(nil, value, &recipient, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
 func (s *ActionsService) getPublicKey(ctx context.Context, url string) (*PublicKey, *Response, error) {
 	req, err := s.client.NewRequest("GET", url, nil)
 	if err != nil {
@@ -193,7 +220,7 @@ type SelectedRepoIDs []int64
 //
 // The value of EncryptedValue must be your secret, encrypted with
 // LibSodium (see documentation here: https://libsodium.gitbook.io/doc/bindings_for_other_languages)
-// using the public key retrieved using the GetPublicKey method.
+// using the public key retrieved using the GetPublicKey method. See PublicKey.Encrypt.
 type EncryptedSecret struct {
 	Name                  string          `json:"-"`
 	KeyID                 string          `json:"key_id"`
@@ -7,6 +7,8 @@ package github
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -14,6 +16,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"golang.org/x/crypto/nacl/box"
 )
 
 func TestPublicKey_UnmarshalJSON(t *testing.T) {
@@ -92,6 +95,37 @@ func TestPublicKey_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestPublicKey_Encrypt(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &PublicKey{KeyID: String("1234"), Key: String(base64.StdEncoding.EncodeToString(publicKey[:]))}
+
+	encrypted, err := key.Encrypt([]byte("s3cr3t"))
+	if err != nil {
+		t.Fatalf("PublicKey.Encrypt returned error: %v", err)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatalf("PublicKey.Encrypt returned invalid base64: %v", err)
+	}
+	value, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+	if !ok {
+		t.Fatal("PublicKey.Encrypt returned a value which does not open with the private key")
+	}
+	if got, want := string(value), "s3cr3t"; got != want {
+		t.Errorf("PublicKey.Encrypt encrypted %q, want %q", got, want)
+	}
+
+	for _, k := range []string{"not base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		if _, err := (&PublicKey{Key: String(k)}).Encrypt([]byte("s3cr3t")); err == nil {
+			t.Errorf("PublicKey.Encrypt with key %q returned no error", k)
+		}
+	}
+}
+
 func TestActionsService_GetRepoPublicKey(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -166,6 +166,21 @@ func (s *ActivityService) MarkThreadRead(ctx context.Context, id string) (*Respo
 	return s.client.Do(ctx, req, nil)
 }
 
+// MarkThreadDone marks the specified thread as done, removing it from the
+// authenticated user's inbox.
+//
+// GitHub API docs: https://docs.github.com/en/rest/activity/notifications#mark-a-thread-as-done
+func (s *ActivityService) MarkThreadDone(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("notifications/threads/%v", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // GetThreadSubscription checks to see if the authenticated user is subscribed
 // to a thread.
 //
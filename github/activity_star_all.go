@@ -0,0 +1,252 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// StarredRepositoryIterator iterates over the repositories starred by a
+// user, fetching them page by page. It is created by
+// ActivityService.ListStarredAll.
+//
+// Pages are fetched only as needed, so accounts with tens of thousands of
+// stars can be processed without holding them in memory. Requests rejected
+// by the secondary rate limit are retried.
+//
+//	it := client.Activity.ListStarredAll("octocat", nil)
+//	for it.Next(ctx) {
+//		process(it.StarredRepository())
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type StarredRepositoryIterator struct {
+	// MinRateRemaining pauses until the rate limit resets whenever fewer
+	// than this many requests remain after fetching a page. Default is 100.
+	MinRateRemaining int
+
+	fetch func(ctx context.Context, opts *ActivityListStarredOptions) ([]*StarredRepository, *Response, error)
+	opts  ActivityListStarredOptions
+	pacer requestPacer
+
+	fetched bool
+	page    []*StarredRepository
+	idx     int
+
+	repo *StarredRepository
+	resp *Response
+	err  error
+}
+
+// ListStarredAll returns an iterator over the repositories starred by a
+// user, along with when they were starred. Passing the empty string
+// iterates over the repositories starred by the authenticated user. If
+// opts.Page is set, iteration starts at that page.
+func (s *ActivityService) ListStarredAll(user string, opts *ActivityListStarredOptions) *StarredRepositoryIterator {
+	it := &StarredRepositoryIterator{
+		fetch: func(ctx context.Context, opts *ActivityListStarredOptions) ([]*StarredRepository, *Response, error) {
+			return s.ListStarred(ctx, user, opts)
+		},
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.PerPage == 0 {
+		it.opts.PerPage = 100
+	}
+	return it
+}
+
+// Next advances the iterator to the next starred repository, fetching
+// pages as needed. It returns false when there are no more repositories or
+// an error occurred.
+func (it *StarredRepositoryIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.idx >= len(it.page) {
+		if it.fetched {
+			if it.resp == nil || it.resp.NextPage == 0 {
+				it.repo = nil
+				return false
+			}
+			it.opts.Page = it.resp.NextPage
+		}
+
+		minRemaining := it.MinRateRemaining
+		if minRemaining <= 0 {
+			minRemaining = defaultMinRateRemaining
+		}
+		var page []*StarredRepository
+		err := pacedDo(ctx, &it.pacer, minRemaining, func() (*Response, error) {
+			var resp *Response
+			var err error
+			page, resp, err = it.fetch(ctx, &it.opts)
+			it.resp = resp
+			return resp, err
+		})
+		if err != nil {
+			it.err = err
+			it.repo = nil
+			return false
+		}
+		it.fetched = true
+		it.page, it.idx = page, 0
+	}
+
+	it.repo = it.page[it.idx]
+	it.idx++
+	return true
+}
+
+// StarredRepository returns the starred repository the iterator is
+// positioned at.
+func (it *StarredRepositoryIterator) StarredRepository() *StarredRepository {
+	return it.repo
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *StarredRepositoryIterator) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last API call made.
+func (it *StarredRepositoryIterator) Response() *Response {
+	return it.resp
+}
+
+// Actions of a StarChange.
+const (
+	StarChangeStar   = "star"
+	StarChangeUnstar = "unstar"
+)
+
+// SyncStarsOptions specifies the optional parameters to the
+// ActivityService.SyncStars method.
+type SyncStarsOptions struct {
+	// Unstar unstars the repositories starred by the authenticated user
+	// that are not in the desired set. By default they are kept.
+	Unstar bool
+
+	// MinInterval is the minimum delay between two requests starring or
+	// unstarring a repository. Default is one second.
+	MinInterval time.Duration
+
+	// MinRateRemaining pauses until the rate limit resets whenever fewer
+	// than this many requests remain. Default is 100.
+	MinRateRemaining int
+
+	// DryRun reports the changes that would be made without making them.
+	DryRun bool
+}
+
+// StarChange describes a change made, or that would be made in a dry run,
+// to the stars of the authenticated user by ActivityService.SyncStars.
+type StarChange struct {
+	// Action is StarChangeStar or StarChangeUnstar.
+	Action string
+	// Repo is the full name of the repository, such as "google/go-github".
+	Repo string
+}
+
+// SyncStars makes the repositories starred by the authenticated user match
+// desired, a list of repository full names such as "google/go-github".
+// Names are compared ignoring case. Repositories in desired that are not
+// starred are starred. Starred repositories not in desired are unstarred
+// only if opts.Unstar is set.
+//
+// All desired names are validated before any change is made. The changes
+// are returned in the order they are applied: stars sorted by name, then
+// unstars sorted by name. On error, the changes before the failing one were
+// applied.
+func (s *ActivityService) SyncStars(ctx context.Context, desired []string, opts *SyncStarsOptions) ([]*StarChange, *Response, error) {
+	if opts == nil {
+		opts = &SyncStarsOptions{}
+	}
+	interval := opts.MinInterval
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+	minRemaining := opts.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	wanted := make(map[string]bool)
+	var names []string
+	for _, name := range desired {
+		if parts := strings.Split(name, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, nil, fmt.Errorf("invalid repository name %q, want \"owner/repo\"", name)
+		}
+		key := strings.ToLower(name)
+		if wanted[key] {
+			return nil, nil, fmt.Errorf("duplicate desired repository %q", name)
+		}
+		wanted[key] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	current := make(map[string]bool)
+	var starred []string
+	it := s.ListStarredAll("", nil)
+	it.MinRateRemaining = minRemaining
+	for it.Next(ctx) {
+		name := it.StarredRepository().GetRepository().GetFullName()
+		current[strings.ToLower(name)] = true
+		starred = append(starred, name)
+	}
+	resp := it.Response()
+	if err := it.Err(); err != nil {
+		return nil, resp, err
+	}
+	sort.Strings(starred)
+
+	var changes []*StarChange
+	for _, name := range names {
+		if !current[strings.ToLower(name)] {
+			changes = append(changes, &StarChange{Action: StarChangeStar, Repo: name})
+		}
+	}
+	if opts.Unstar {
+		for _, name := range starred {
+			if !wanted[strings.ToLower(name)] {
+				changes = append(changes, &StarChange{Action: StarChangeUnstar, Repo: name})
+			}
+		}
+	}
+	if opts.DryRun {
+		return changes, resp, nil
+	}
+
+	pacer := &requestPacer{interval: interval}
+	for i, change := range changes {
+		change := change
+		parts := strings.Split(change.Repo, "/")
+		owner, repo := parts[0], parts[1]
+		err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+			var err error
+			switch change.Action {
+			case StarChangeUnstar:
+				resp, err = s.Unstar(ctx, owner, repo)
+			default:
+				resp, err = s.Star(ctx, owner, repo)
+			}
+			return resp, err
+		})
+		if err != nil {
+			return changes[:i], resp, err
+		}
+	}
+
+	return changes, resp, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestActivityService_ListStarredAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeStarringPreview+", "+mediaTypeTopicsPreview)
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"sort": "created", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/users/u/starred?page=2&per_page=100>; rel="next"`)
+			fmt.Fprint(w, `[{"starred_at":"2002-02-10T15:30:00Z","repo":{"id":1}},{"repo":{"id":2}}]`)
+		case "2":
+			testFormValues(t, r, values{"sort": "created", "page": "2", "per_page": "100"})
+			fmt.Fprint(w, `[{"repo":{"id":3}}]`)
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Activity.ListStarredAll("u", &ActivityListStarredOptions{Sort: "created"})
+	var ids []int64
+	for it.Next(ctx) {
+		ids = append(ids, it.StarredRepository().GetRepository().GetID())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("StarredRepositoryIterator returned error: %v", err)
+	}
+
+	if want := []int64{1, 2, 3}; !cmp.Equal(ids, want) {
+		t.Errorf("StarredRepositoryIterator returned %v, want %v", ids, want)
+	}
+	if it.Next(ctx) {
+		t.Error("StarredRepositoryIterator.Next returned true after the end of the list")
+	}
+	if it.StarredRepository() != nil {
+		t.Errorf("StarredRepositoryIterator.StarredRepository = %+v after the end of the list, want nil", it.StarredRepository())
+	}
+}
+
+func TestActivityService_ListStarredAll_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	it := client.Activity.ListStarredAll("", nil)
+	if it.Next(ctx) {
+		t.Fatal("StarredRepositoryIterator.Next returned true, want false")
+	}
+	if it.Err() == nil {
+		t.Error("StarredRepositoryIterator.Err returned nil, want error")
+	}
+	if it.Response() == nil || it.Response().StatusCode != http.StatusInternalServerError {
+		t.Errorf("StarredRepositoryIterator.Response = %+v, want a 500 response", it.Response())
+	}
+
+	const methodName = "ListStarredAll"
+	testBadOptions(t, methodName, func() (err error) {
+		it := client.Activity.ListStarredAll("\n", nil)
+		it.Next(ctx)
+		return it.Err()
+	})
+}
+
+func TestActivityService_SyncStars(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"repo":{"full_name":"o/Keep"}},{"repo":{"full_name":"o/old"}}]`)
+	})
+	var requests []string
+	for _, repo := range []string{"keep", "new", "old"} {
+		mux.HandleFunc("/user/starred/o/"+repo, func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	ctx := context.Background()
+	opts := &SyncStarsOptions{Unstar: true, MinInterval: time.Nanosecond, DryRun: true}
+	changes, _, err := client.Activity.SyncStars(ctx, []string{"o/new", "o/keep"}, opts)
+	if err != nil {
+		t.Fatalf("Activity.SyncStars returned error: %v", err)
+	}
+
+	want := []*StarChange{
+		{Action: StarChangeStar, Repo: "o/new"},
+		{Action: StarChangeUnstar, Repo: "o/old"},
+	}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("Activity.SyncStars returned %+v, want %+v", changes, want)
+	}
+	if len(requests) != 0 {
+		t.Errorf("Activity.SyncStars made %v in a dry run", requests)
+	}
+
+	opts.DryRun = false
+	changes, _, err = client.Activity.SyncStars(ctx, []string{"o/new", "o/keep"}, opts)
+	if err != nil {
+		t.Fatalf("Activity.SyncStars returned error: %v", err)
+	}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("Activity.SyncStars returned %+v, want %+v", changes, want)
+	}
+	if wantRequests := []string{"PUT /user/starred/o/new", "DELETE /user/starred/o/old"}; !cmp.Equal(requests, wantRequests) {
+		t.Errorf("Activity.SyncStars made %v, want %v", requests, wantRequests)
+	}
+}
+
+func TestActivityService_SyncStars_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, desired := range [][]string{{"repo"}, {"o/"}, {"o/r/x"}, {"o/r", "O/R"}} {
+		if _, _, err := client.Activity.SyncStars(ctx, desired, nil); err == nil {
+			t.Errorf("Activity.SyncStars(%v) returned nil error", desired)
+		}
+	}
+}
@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 )
 
 // AppsService provides access to the installation related functions
@@ -52,6 +54,8 @@ type InstallationTokenOptions struct {
 
 	// The permissions granted to the access token.
 	// The permissions object includes the permission names and their access type.
+	// They cannot exceed the permissions of the installation; see
+	// InstallationPermissions.ExceededPermissions.
 	Permissions *InstallationPermissions `json:"permissions,omitempty"`
 }
 
@@ -62,43 +66,102 @@ type InstallationTokenOptions struct {
 //	https://docs.github.com/en/enterprise-server@3.0/rest/apps#create-an-installation-access-token-for-an-app
 //	https://docs.github.com/en/rest/apps#create-an-installation-access-token-for-an-app
 type InstallationPermissions struct {
-	Actions                       *string `json:"actions,omitempty"`
-	Administration                *string `json:"administration,omitempty"`
-	Blocking                      *string `json:"blocking,omitempty"`
-	Checks                        *string `json:"checks,omitempty"`
-	Contents                      *string `json:"contents,omitempty"`
-	ContentReferences             *string `json:"content_references,omitempty"`
-	Deployments                   *string `json:"deployments,omitempty"`
-	Emails                        *string `json:"emails,omitempty"`
-	Environments                  *string `json:"environments,omitempty"`
-	Followers                     *string `json:"followers,omitempty"`
-	Issues                        *string `json:"issues,omitempty"`
-	Metadata                      *string `json:"metadata,omitempty"`
-	Members                       *string `json:"members,omitempty"`
-	OrganizationAdministration    *string `json:"organization_administration,omitempty"`
-	OrganizationCustomRoles       *string `json:"organization_custom_roles,omitempty"`
-	OrganizationHooks             *string `json:"organization_hooks,omitempty"`
-	OrganizationPackages          *string `json:"organization_packages,omitempty"`
-	OrganizationPlan              *string `json:"organization_plan,omitempty"`
-	OrganizationPreReceiveHooks   *string `json:"organization_pre_receive_hooks,omitempty"`
-	OrganizationProjects          *string `json:"organization_projects,omitempty"`
-	OrganizationSecrets           *string `json:"organization_secrets,omitempty"`
-	OrganizationSelfHostedRunners *string `json:"organization_self_hosted_runners,omitempty"`
-	OrganizationUserBlocking      *string `json:"organization_user_blocking,omitempty"`
-	Packages                      *string `json:"packages,omitempty"`
-	Pages                         *string `json:"pages,omitempty"`
-	PullRequests                  *string `json:"pull_requests,omitempty"`
-	RepositoryHooks               *string `json:"repository_hooks,omitempty"`
-	RepositoryProjects            *string `json:"repository_projects,omitempty"`
-	RepositoryPreReceiveHooks     *string `json:"repository_pre_receive_hooks,omitempty"`
-	Secrets                       *string `json:"secrets,omitempty"`
-	SecretScanningAlerts          *string `json:"secret_scanning_alerts,omitempty"`
-	SecurityEvents                *string `json:"security_events,omitempty"`
-	SingleFile                    *string `json:"single_file,omitempty"`
-	Statuses                      *string `json:"statuses,omitempty"`
-	TeamDiscussions               *string `json:"team_discussions,omitempty"`
-	VulnerabilityAlerts           *string `json:"vulnerability_alerts,omitempty"`
-	Workflows                     *string `json:"workflows,omitempty"`
+	Actions                                 *string `json:"actions,omitempty"`
+	Administration                          *string `json:"administration,omitempty"`
+	Blocking                                *string `json:"blocking,omitempty"`
+	Checks                                  *string `json:"checks,omitempty"`
+	Codespaces                              *string `json:"codespaces,omitempty"`
+	Contents                                *string `json:"contents,omitempty"`
+	ContentReferences                       *string `json:"content_references,omitempty"`
+	DependabotSecrets                       *string `json:"dependabot_secrets,omitempty"`
+	Deployments                             *string `json:"deployments,omitempty"`
+	EmailAddresses                          *string `json:"email_addresses,omitempty"`
+	Emails                                  *string `json:"emails,omitempty"`
+	Environments                            *string `json:"environments,omitempty"`
+	Followers                               *string `json:"followers,omitempty"`
+	GitSSHKeys                              *string `json:"git_ssh_keys,omitempty"`
+	GPGKeys                                 *string `json:"gpg_keys,omitempty"`
+	InteractionLimits                       *string `json:"interaction_limits,omitempty"`
+	Issues                                  *string `json:"issues,omitempty"`
+	MergeQueues                             *string `json:"merge_queues,omitempty"`
+	Metadata                                *string `json:"metadata,omitempty"`
+	Members                                 *string `json:"members,omitempty"`
+	OrganizationAdministration              *string `json:"organization_administration,omitempty"`
+	OrganizationAnnouncementBanners         *string `json:"organization_announcement_banners,omitempty"`
+	OrganizationCopilotSeatManagement       *string `json:"organization_copilot_seat_management,omitempty"`
+	OrganizationCustomOrgRoles              *string `json:"organization_custom_org_roles,omitempty"`
+	OrganizationCustomProperties            *string `json:"organization_custom_properties,omitempty"`
+	OrganizationCustomRoles                 *string `json:"organization_custom_roles,omitempty"`
+	OrganizationEvents                      *string `json:"organization_events,omitempty"`
+	OrganizationHooks                       *string `json:"organization_hooks,omitempty"`
+	OrganizationPackages                    *string `json:"organization_packages,omitempty"`
+	OrganizationPersonalAccessTokens        *string `json:"organization_personal_access_tokens,omitempty"`
+	OrganizationPersonalAccessTokenRequests *string `json:"organization_personal_access_token_requests,omitempty"`
+	OrganizationPlan                        *string `json:"organization_plan,omitempty"`
+	OrganizationPreReceiveHooks             *string `json:"organization_pre_receive_hooks,omitempty"`
+	OrganizationProjects                    *string `json:"organization_projects,omitempty"`
+	OrganizationSecrets                     *string `json:"organization_secrets,omitempty"`
+	OrganizationSelfHostedRunners           *string `json:"organization_self_hosted_runners,omitempty"`
+	OrganizationUserBlocking                *string `json:"organization_user_blocking,omitempty"`
+	Packages                                *string `json:"packages,omitempty"`
+	Pages                                   *string `json:"pages,omitempty"`
+	Profile                                 *string `json:"profile,omitempty"`
+	PullRequests                            *string `json:"pull_requests,omitempty"`
+	RepositoryCustomProperties              *string `json:"repository_custom_properties,omitempty"`
+	RepositoryHooks                         *string `json:"repository_hooks,omitempty"`
+	RepositoryProjects                      *string `json:"repository_projects,omitempty"`
+	RepositoryPreReceiveHooks               *string `json:"repository_pre_receive_hooks,omitempty"`
+	Secrets                                 *string `json:"secrets,omitempty"`
+	SecretScanningAlerts                    *string `json:"secret_scanning_alerts,omitempty"`
+	SecurityEvents                          *string `json:"security_events,omitempty"`
+	SingleFile                              *string `json:"single_file,omitempty"`
+	Starring                                *string `json:"starring,omitempty"`
+	Statuses                                *string `json:"statuses,omitempty"`
+	TeamDiscussions                         *string `json:"team_discussions,omitempty"`
+	VulnerabilityAlerts                     *string `json:"vulnerability_alerts,omitempty"`
+	Workflows                               *string `json:"workflows,omitempty"`
+}
+
+// permissionLevels ranks the access levels of an installation permission.
+var permissionLevels = map[string]int{
+	"read":  1,
+	"write": 2,
+	"admin": 3,
+}
+
+// ExceededPermissions returns the names of the permissions in p that ask for
+// more access than granted holds, such as write access to contents when only
+// read access was granted. It can be used to check InstallationTokenOptions
+// against the permissions of an Installation before calling
+// AppsService.CreateInstallationToken, which GitHub rejects with a 422 error
+// otherwise.
+func (p *InstallationPermissions) ExceededPermissions(granted *InstallationPermissions) []string {
+	if p == nil {
+		return nil
+	}
+	if granted == nil {
+		granted = &InstallationPermissions{}
+	}
+
+	pv := reflect.ValueOf(p).Elem()
+	gv := reflect.ValueOf(granted).Elem()
+	var exceeded []string
+	for i := 0; i < pv.NumField(); i++ {
+		want, _ := pv.Field(i).Interface().(*string)
+		if want == nil {
+			continue
+		}
+		var have string
+		if g, _ := gv.Field(i).Interface().(*string); g != nil {
+			have = *g
+		}
+		if permissionLevels[*want] > permissionLevels[have] {
+			name := strings.Split(pv.Type().Field(i).Tag.Get("json"), ",")[0]
+			exceeded = append(exceeded, name)
+		}
+	}
+
+	return exceeded
 }
 
 // Installation represents a GitHub Apps installation.
@@ -125,6 +188,18 @@ type Installation struct {
 	SuspendedAt            *Timestamp               `json:"suspended_at,omitempty"`
 }
 
+// InstallationRequest represents a pending GitHub App installation request.
+type InstallationRequest struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	// Account is the user or organization the App was requested to be
+	// installed on. Its Type field tells them apart.
+	Account *User `json:"account,omitempty"`
+	// Requester is the user who requested the installation.
+	Requester *User      `json:"requester,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+}
+
 // Attachment represents a GitHub Apps attachment.
 type Attachment struct {
 	ID    *int64  `json:"id,omitempty"`
@@ -197,6 +272,30 @@ func (s *AppsService) ListInstallations(ctx context.Context, opts *ListOptions)
 	return i, resp, nil
 }
 
+// ListInstallationRequests lists the pending installation requests that the
+// current GitHub App has.
+//
+// GitHub API docs: https://docs.github.com/en/rest/apps/apps#list-installation-requests-for-the-authenticated-app
+func (s *AppsService) ListInstallationRequests(ctx context.Context, opts *ListOptions) ([]*InstallationRequest, *Response, error) {
+	u, err := addOptions("app/installation-requests", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var i []*InstallationRequest
+	resp, err := s.client.Do(ctx, req, &i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
+
 // GetInstallation returns the specified installation.
 //
 // GitHub API docs: https://docs.github.com/en/rest/apps/apps#get-an-installation-for-the-authenticated-app
@@ -273,6 +372,10 @@ func (s *AppsService) DeleteInstallation(ctx context.Context, id int64) (*Respon
 
 // CreateInstallationToken creates a new installation token.
 //
+// If opts asks for repositories or permissions the installation does not
+// have, GitHub responds with 422 Unprocessable Entity and the returned error
+// is an *ErrorResponse describing the rejected fields.
+//
 // GitHub API docs: https://docs.github.com/en/rest/apps/apps#create-an-installation-access-token-for-an-app
 func (s *AppsService) CreateInstallationToken(ctx context.Context, id int64, opts *InstallationTokenOptions) (*InstallationToken, *Response, error) {
 	u := fmt.Sprintf("app/installations/%v/access_tokens", id)
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // ListHookDeliveries lists deliveries of an App webhook.
@@ -70,3 +71,19 @@ func (s *AppsService) RedeliverHookDelivery(ctx context.Context, deliveryID int6
 
 	return h, resp, nil
 }
+
+// RedeliverFailedHookDeliveries redelivers the deliveries of the App webhook
+// that failed since the given time, such as the start of an outage of the
+// receiving service. It follows the same rules as
+// OrganizationsService.RedeliverFailedHookDeliveries.
+//
+// It returns the deliveries that were redelivered.
+func (s *AppsService) RedeliverFailedHookDeliveries(ctx context.Context, since time.Time) ([]*HookDelivery, *Response, error) {
+	list := func(opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
+		return s.ListHookDeliveries(ctx, opts)
+	}
+	redeliver := func(deliveryID int64) (*HookDelivery, *Response, error) {
+		return s.RedeliverHookDelivery(ctx, deliveryID)
+	}
+	return redeliverFailedHookDeliveries(ctx, since, list, redeliver)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -117,3 +118,58 @@ func TestAppsService_RedeliverHookDelivery(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestAppsService_RedeliverFailedHookDeliveries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	since := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mux.HandleFunc("/app/hook/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("cursor") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/app/hook/deliveries?per_page=100&cursor=v1_2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"id":4,"guid":"a","status_code":200,"delivered_at":"2023-01-03T00:00:00Z","redelivery":true},
+				{"id":3,"guid":"b","status_code":503,"delivered_at":"2023-01-02T00:00:00Z"}
+			]`)
+		case "v1_2":
+			fmt.Fprint(w, `[
+				{"id":2,"guid":"a","status_code":500,"delivered_at":"2023-01-01T12:00:00Z"},
+				{"id":1,"guid":"c","status_code":500,"delivered_at":"2022-12-31T00:00:00Z"}
+			]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("cursor"))
+		}
+	})
+	var redelivered []string
+	mux.HandleFunc("/app/hook/deliveries/3/attempts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		redelivered = append(redelivered, "3")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	deliveries, _, err := client.Apps.RedeliverFailedHookDeliveries(ctx, since)
+	if err != nil {
+		t.Fatalf("Apps.RedeliverFailedHookDeliveries returned error: %v", err)
+	}
+
+	want := []*HookDelivery{{ID: Int64(3), GUID: String("b"), StatusCode: Int(503), DeliveredAt: &Timestamp{time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)}}}
+	if !cmp.Equal(deliveries, want) {
+		t.Errorf("Apps.RedeliverFailedHookDeliveries returned %+v, want %+v", deliveries, want)
+	}
+	if want := []string{"3"}; !cmp.Equal(redelivered, want) {
+		t.Errorf("Apps.RedeliverFailedHookDeliveries redelivered %v, want %v", redelivered, want)
+	}
+
+	const methodName = "RedeliverFailedHookDeliveries"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Apps.RedeliverFailedHookDeliveries(ctx, since)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -12,24 +12,28 @@ import (
 
 // AppConfig describes the configuration of a GitHub App.
 type AppConfig struct {
-	ID            *int64     `json:"id,omitempty"`
-	Slug          *string    `json:"slug,omitempty"`
-	NodeID        *string    `json:"node_id,omitempty"`
-	Owner         *User      `json:"owner,omitempty"`
-	Name          *string    `json:"name,omitempty"`
-	Description   *string    `json:"description,omitempty"`
-	ExternalURL   *string    `json:"external_url,omitempty"`
-	HTMLURL       *string    `json:"html_url,omitempty"`
-	CreatedAt     *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
-	ClientID      *string    `json:"client_id,omitempty"`
-	ClientSecret  *string    `json:"client_secret,omitempty"`
-	WebhookSecret *string    `json:"webhook_secret,omitempty"`
-	PEM           *string    `json:"pem,omitempty"`
+	ID            *int64                   `json:"id,omitempty"`
+	Slug          *string                  `json:"slug,omitempty"`
+	NodeID        *string                  `json:"node_id,omitempty"`
+	Owner         *User                    `json:"owner,omitempty"`
+	Name          *string                  `json:"name,omitempty"`
+	Description   *string                  `json:"description,omitempty"`
+	ExternalURL   *string                  `json:"external_url,omitempty"`
+	HTMLURL       *string                  `json:"html_url,omitempty"`
+	CreatedAt     *Timestamp               `json:"created_at,omitempty"`
+	UpdatedAt     *Timestamp               `json:"updated_at,omitempty"`
+	Permissions   *InstallationPermissions `json:"permissions,omitempty"`
+	Events        []string                 `json:"events,omitempty"`
+	ClientID      *string                  `json:"client_id,omitempty"`
+	ClientSecret  *string                  `json:"client_secret,omitempty"`
+	WebhookSecret *string                  `json:"webhook_secret,omitempty"`
+	PEM           *string                  `json:"pem,omitempty"`
 }
 
 // CompleteAppManifest completes the App manifest handshake flow for the given
-// code.
+// code. The returned configuration holds the credentials of the new App,
+// including its private key, webhook secret and OAuth client secret, which
+// GitHub does not return again.
 //
 // GitHub API docs: https://docs.github.com/en/rest/apps/apps#create-a-github-app-from-a-manifest
 func (s *AppsService) CompleteAppManifest(ctx context.Context, code string) (*AppConfig, *Response, error) {
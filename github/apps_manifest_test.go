@@ -17,6 +17,8 @@ import (
 const (
 	manifestJSON = `{
 	"id": 1,
+  "permissions": {"contents": "read", "issues": "write"},
+  "events": ["push"],
   "client_id": "a" ,
   "client_secret": "b",
   "webhook_secret": "c",
@@ -42,6 +44,8 @@ func TestGetConfig(t *testing.T) {
 
 	want := &AppConfig{
 		ID:            Int64(1),
+		Permissions:   &InstallationPermissions{Contents: String("read"), Issues: String("write")},
+		Events:        []string{"push"},
 		ClientID:      String("a"),
 		ClientSecret:  String("b"),
 		WebhookSecret: String("c"),
@@ -100,6 +104,8 @@ func TestAppConfig_Marshal(t *testing.T) {
 		HTMLURL:       String("hu"),
 		CreatedAt:     &Timestamp{referenceTime},
 		UpdatedAt:     &Timestamp{referenceTime},
+		Permissions:   &InstallationPermissions{Contents: String("read")},
+		Events:        []string{"push"},
 		ClientID:      String("ci"),
 		ClientSecret:  String("cs"),
 		WebhookSecret: String("ws"),
@@ -136,6 +142,10 @@ func TestAppConfig_Marshal(t *testing.T) {
 		"html_url": "hu",
 		"created_at": ` + referenceTimeStr + `,
 		"updated_at": ` + referenceTimeStr + `,
+		"permissions": {
+			"contents": "read"
+		},
+		"events": ["push"],
 		"client_id": "ci",
 		"client_secret": "cs",
 		"webhook_secret": "ws",
@@ -21,7 +21,7 @@ type MarketplaceService struct {
 	// for testing your GitHub Apps. Stubbed data is hard-coded and will not
 	// change based on actual subscriptions.
 	//
-	// GitHub API docs: https://docs.github.com/en/rest/apps#testing-with-stubbed-endpoints
+	// GitHub API docs: https://docs.github.com/en/rest/apps/marketplace#testing-with-stubbed-endpoints
 	Stubbed bool
 }
 
@@ -89,7 +89,8 @@ type MarketplacePurchaseAccount struct {
 
 // ListPlans lists all plans for your Marketplace listing.
 //
-// GitHub API docs: https://docs.github.com/en/rest/apps#list-plans
+// GitHub API docs: https://docs.github.com/en/rest/apps/marketplace#list-plans
+// GitHub API docs: https://docs.github.com/en/rest/apps/marketplace#list-plans-stubbed
 func (s *MarketplaceService) ListPlans(ctx context.Context, opts *ListOptions) ([]*MarketplacePlan, *Response, error) {
 	uri := s.marketplaceURI("plans")
 	u, err := addOptions(uri, opts)
@@ -113,7 +114,8 @@ func (s *MarketplaceService) ListPlans(ctx context.Context, opts *ListOptions) (
 
 // ListPlanAccountsForPlan lists all GitHub accounts (user or organization) on a specific plan.
 //
-// GitHub API docs: https://docs.github.com/en/rest/apps#list-accounts-for-a-plan
+// GitHub API docs: https://docs.github.com/en/rest/apps/marketplace#list-accounts-for-a-plan
+// GitHub API docs: https://docs.github.com/en/rest/apps/marketplace#list-accounts-for-a-plan-stubbed
 func (s *MarketplaceService) ListPlanAccountsForPlan(ctx context.Context, planID int64, opts *ListOptions) ([]*MarketplacePlanAccount, *Response, error) {
 	uri := s.marketplaceURI(fmt.Sprintf("plans/%v/accounts", planID))
 	u, err := addOptions(uri, opts)
@@ -137,7 +139,8 @@ func (s *MarketplaceService) ListPlanAccountsForPlan(ctx context.Context, planID
 
 // GetPlanAccountForAccount get GitHub account (user or organization) associated with an account.
 //
-// GitHub API docs: https://docs.github.com/en/rest/apps#get-a-subscription-plan-for-an-account
+// GitHub API docs: https://docs.github.com/en/rest/apps/marketplace#get-a-subscription-plan-for-an-account
+// GitHub API docs: https://docs.github.com/en/rest/apps/marketplace#get-a-subscription-plan-for-an-account-stubbed
 func (s *MarketplaceService) GetPlanAccountForAccount(ctx context.Context, accountID int64) (*MarketplacePlanAccount, *Response, error) {
 	uri := s.marketplaceURI(fmt.Sprintf("accounts/%v", accountID))
 
@@ -79,6 +79,7 @@ func TestMarketplaceService_ListPlanAccountsForPlan(t *testing.T) {
 
 	mux.HandleFunc("/marketplace_listing/plans/1/accounts", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1", "per_page": "2"})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
@@ -111,13 +112,15 @@ func TestMarketplaceService_Stubbed_ListPlanAccountsForPlan(t *testing.T) {
 
 	mux.HandleFunc("/marketplace_listing/stubbed/plans/1/accounts", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1", "per_page": "2"})
+		w.Header().Set("Link", `<https://api.github.com/marketplace_listing/stubbed/plans/1/accounts?page=2&per_page=2>; rel="next"`)
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
 	opt := &ListOptions{Page: 1, PerPage: 2}
 	client.Marketplace.Stubbed = true
 	ctx := context.Background()
-	accounts, _, err := client.Marketplace.ListPlanAccountsForPlan(ctx, 1, opt)
+	accounts, resp, err := client.Marketplace.ListPlanAccountsForPlan(ctx, 1, opt)
 	if err != nil {
 		t.Errorf("Marketplace.ListPlanAccountsForPlan (Stubbed) returned error: %v", err)
 	}
@@ -126,6 +129,9 @@ func TestMarketplaceService_Stubbed_ListPlanAccountsForPlan(t *testing.T) {
 	if !cmp.Equal(accounts, want) {
 		t.Errorf("Marketplace.ListPlanAccountsForPlan (Stubbed) returned %+v, want %+v", accounts, want)
 	}
+	if resp.NextPage != 2 {
+		t.Errorf("Marketplace.ListPlanAccountsForPlan (Stubbed) NextPage = %v, want 2", resp.NextPage)
+	}
 }
 
 func TestMarketplaceService_GetPlanAccountForAccount(t *testing.T) {
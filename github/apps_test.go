@@ -202,6 +202,48 @@ func TestAppsService_ListInstallations(t *testing.T) {
 	})
 }
 
+func TestAppsService_ListInstallationRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installation-requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1", "per_page": "2"})
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"account": {"login": "o", "type": "Organization"},
+			"requester": {"login": "u", "type": "User"},
+			"created_at": `+referenceTimeStr+`
+		}]`)
+	})
+
+	opt := &ListOptions{Page: 1, PerPage: 2}
+	ctx := context.Background()
+	requests, _, err := client.Apps.ListInstallationRequests(ctx, opt)
+	if err != nil {
+		t.Errorf("Apps.ListInstallationRequests returned error: %v", err)
+	}
+
+	want := []*InstallationRequest{{
+		ID:        Int64(1),
+		Account:   &User{Login: String("o"), Type: String("Organization")},
+		Requester: &User{Login: String("u"), Type: String("User")},
+		CreatedAt: &Timestamp{referenceTime},
+	}}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("Apps.ListInstallationRequests returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListInstallationRequests"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Apps.ListInstallationRequests(ctx, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestAppsService_GetInstallation(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -422,6 +464,71 @@ func TestAppsService_CreateInstallationTokenWithOptions(t *testing.T) {
 	}
 }
 
+func TestAppsService_CreateInstallationToken_unprocessable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"The permissions requested are not granted to this installation.","errors":[{"resource":"Installation","field":"permissions","code":"invalid"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &InstallationTokenOptions{Permissions: &InstallationPermissions{Administration: String("write")}}
+	_, _, err := client.Apps.CreateInstallationToken(ctx, 1, opts)
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Apps.CreateInstallationToken returned error %v, want *ErrorResponse", err)
+	}
+
+	want := []Error{{Resource: "Installation", Field: "permissions", Code: "invalid"}}
+	if !cmp.Equal(errResp.Errors, want) {
+		t.Errorf("Apps.CreateInstallationToken returned errors %+v, want %+v", errResp.Errors, want)
+	}
+}
+
+func TestInstallationPermissions_ExceededPermissions(t *testing.T) {
+	granted := &InstallationPermissions{
+		Contents: String("write"),
+		Issues:   String("read"),
+		Metadata: String("read"),
+	}
+
+	tests := []struct {
+		name      string
+		requested *InstallationPermissions
+		want      []string
+	}{
+		{name: "nil", requested: nil},
+		{name: "empty", requested: &InstallationPermissions{}},
+		{name: "within grants", requested: &InstallationPermissions{Contents: String("read"), Issues: String("read")}},
+		{
+			name:      "exceeds level",
+			requested: &InstallationPermissions{Contents: String("admin"), Issues: String("write"), Metadata: String("read")},
+			want:      []string{"contents", "issues"},
+		},
+		{
+			name:      "not granted",
+			requested: &InstallationPermissions{Workflows: String("read")},
+			want:      []string{"workflows"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.requested.ExceededPermissions(granted)
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("ExceededPermissions returned %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (&InstallationPermissions{Checks: String("read")}).ExceededPermissions(nil); !cmp.Equal(got, []string{"checks"}) {
+		t.Errorf("ExceededPermissions(nil) returned %v, want [checks]", got)
+	}
+}
+
 func TestAppsService_CreateAttachement(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -636,6 +743,34 @@ func TestAttachment_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestInstallationRequest_Marshal(t *testing.T) {
+	testJSONMarshal(t, &InstallationRequest{}, "{}")
+
+	u := &InstallationRequest{
+		ID:        Int64(1),
+		NodeID:    String("nid"),
+		Account:   &User{Login: String("o"), Type: String("Organization")},
+		Requester: &User{Login: String("u"), Type: String("User")},
+		CreatedAt: &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"id": 1,
+		"node_id": "nid",
+		"account": {
+			"login": "o",
+			"type": "Organization"
+		},
+		"requester": {
+			"login": "u",
+			"type": "User"
+		},
+		"created_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
 func TestInstallationPermissions_Marshal(t *testing.T) {
 	testJSONMarshal(t, &InstallationPermissions{}, "{}")
 
@@ -0,0 +1,344 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Attestation represents an artifact attestation: a Sigstore bundle
+// signing an in-toto statement about the artifact.
+type Attestation struct {
+	// Bundle is the Sigstore bundle, as returned by the API.
+	Bundle       json.RawMessage `json:"bundle"`
+	RepositoryID *int64          `json:"repository_id,omitempty"`
+}
+
+// AttestationsResponse represents the attestations of an artifact.
+type AttestationsResponse struct {
+	Attestations []*Attestation `json:"attestations"`
+}
+
+// ListAttestationsOptions specifies optional parameters to the
+// RepositoriesService.ListAttestations, OrganizationsService.ListAttestations
+// and UsersService.ListAttestations methods.
+type ListAttestationsOptions struct {
+	// PredicateType filters attestations by their predicate type, for
+	// example "https://slsa.dev/provenance/v1".
+	PredicateType string `url:"predicate_type,omitempty"`
+
+	ListCursorOptions
+}
+
+// listAttestations lists the attestations of the artifact with the given
+// digest, in the scope given by u.
+func (c *Client) listAttestations(ctx context.Context, u, subjectDigest string, opts *ListAttestationsOptions) (*AttestationsResponse, *Response, error) {
+	u = fmt.Sprintf("%v/attestations/%v", u, subjectDigest)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attestations := new(AttestationsResponse)
+	resp, err := c.Do(ctx, req, attestations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return attestations, resp, nil
+}
+
+// InTotoStatement represents the in-toto statement signed by an attestation.
+type InTotoStatement struct {
+	Type          string           `json:"_type"`
+	Subject       []*InTotoSubject `json:"subject"`
+	PredicateType string           `json:"predicateType"`
+	// Predicate is left undecoded, as its format depends on PredicateType.
+	Predicate json.RawMessage `json:"predicate,omitempty"`
+}
+
+// InTotoSubject represents an artifact an in-toto statement is about.
+type InTotoSubject struct {
+	Name string `json:"name"`
+	// Digest maps an algorithm, such as sha256, to the hex-encoded digest
+	// of the artifact.
+	Digest map[string]string `json:"digest"`
+}
+
+// AttestationVerifyOptions specifies the parameters to the
+// Attestation.Verify method.
+type AttestationVerifyOptions struct {
+	// Roots are the trusted certificates of the certificate authority which
+	// issued the signing certificate, for example the Fulcio root and
+	// intermediate certificates of Sigstore or of GitHub. It is required.
+	Roots *x509.CertPool
+
+	// CertificateIdentity is the identity the signing certificate must be
+	// issued to: one of its URI or email subject alternative names. For
+	// GitHub Actions, it is the URI of the workflow, such as
+	// "https://github.com/owner/repo/.github/workflows/release.yml@refs/heads/main".
+	// It is required.
+	CertificateIdentity string
+
+	// CertificateOIDCIssuer is the OIDC issuer the identity of the signing
+	// certificate must come from, such as
+	// "https://token.actions.githubusercontent.com" for GitHub Actions. It
+	// is required.
+	CertificateOIDCIssuer string
+
+	// SignedAt is the time at which the signing certificate must be valid.
+	// Signing certificates are short-lived, so it should be a time the
+	// signature is independently known to have existed at, for example
+	// from a transparency log entry whose inclusion proof was verified.
+	// Defaults to the integrated time of the transparency log entry of the
+	// bundle.
+	SignedAt time.Time
+}
+
+// sigstoreBundle represents the parts of a Sigstore bundle used to verify it.
+type sigstoreBundle struct {
+	MediaType            string `json:"mediaType"`
+	VerificationMaterial struct {
+		Certificate *struct {
+			RawBytes []byte `json:"rawBytes"`
+		} `json:"certificate"`
+		X509CertificateChain *struct {
+			Certificates []struct {
+				RawBytes []byte `json:"rawBytes"`
+			} `json:"certificates"`
+		} `json:"x509CertificateChain"`
+		TlogEntries []struct {
+			// IntegratedTime is a Unix time, encoded as a string.
+			IntegratedTime string `json:"integratedTime"`
+		} `json:"tlogEntries"`
+	} `json:"verificationMaterial"`
+	DSSEEnvelope *struct {
+		Payload     []byte `json:"payload"`
+		PayloadType string `json:"payloadType"`
+		Signatures  []struct {
+			Sig []byte `json:"sig"`
+		} `json:"signatures"`
+	} `json:"dsseEnvelope"`
+}
+
+// Object identifiers of the Fulcio certificate extensions holding the OIDC
+// issuer of the certificate identity, the first being deprecated.
+var (
+	oidFulcioIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidFulcioIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// Verify checks the Sigstore bundle of the attestation offline, and returns
+// the in-toto statement it signs. It checks that the DSSE envelope is signed
+// by the certificate of the bundle, that the certificate chains to
+// opts.Roots, is valid at the signing time and is issued to
+// opts.CertificateIdentity by opts.CertificateOIDCIssuer, and that the
+// statement is about the artifact with the given digest, formatted as
+// "algorithm:hex", for example "sha256:…".
+//
+// Unless opts.SignedAt is set, the signing time is the integrated time of
+// the transparency log entry of the bundle, and an error is returned if the
+// bundle has none. Verify does not check the inclusion proof or signed
+// entry timestamp of that entry, nor any signed timestamp of the bundle.
+// Use a Sigstore client for a complete verification.
+func (a *Attestation) Verify(subjectDigest string, opts *AttestationVerifyOptions) (*InTotoStatement, error) {
+	if opts == nil || opts.Roots == nil {
+		return nil, errors.New("opts.Roots must be set to verify the signing certificate")
+	}
+	if opts.CertificateIdentity == "" || opts.CertificateOIDCIssuer == "" {
+		return nil, errors.New("opts.CertificateIdentity and opts.CertificateOIDCIssuer must be set to verify the signer")
+	}
+
+	var bundle sigstoreBundle
+	if err := json.Unmarshal(a.Bundle, &bundle); err != nil {
+		return nil, fmt.Errorf("decoding bundle: %v", err)
+	}
+	if bundle.DSSEEnvelope == nil {
+		return nil, errors.New("bundle has no DSSE envelope")
+	}
+	envelope := bundle.DSSEEnvelope
+	if envelope.PayloadType != "application/vnd.in-toto+json" {
+		return nil, fmt.Errorf("unexpected payload type %q", envelope.PayloadType)
+	}
+
+	signedAt := opts.SignedAt
+	if signedAt.IsZero() {
+		entries := bundle.VerificationMaterial.TlogEntries
+		if len(entries) == 0 {
+			return nil, errors.New("bundle has no transparency log entry to take the signing time from")
+		}
+		sec, err := strconv.ParseInt(entries[0].IntegratedTime, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integrated time %q", entries[0].IntegratedTime)
+		}
+		signedAt = time.Unix(sec, 0)
+	}
+
+	var chain [][]byte
+	material := bundle.VerificationMaterial
+	switch {
+	case material.Certificate != nil:
+		chain = append(chain, material.Certificate.RawBytes)
+	case material.X509CertificateChain != nil:
+		for _, c := range material.X509CertificateChain.Certificates {
+			chain = append(chain, c.RawBytes)
+		}
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("bundle has no certificate")
+	}
+	cert, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %v", err)
+	}
+
+	pae := dssePAE(envelope.PayloadType, envelope.Payload)
+	var verified bool
+	for _, s := range envelope.Signatures {
+		if verifySignature(cert.PublicKey, pae, s.Sig) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("no valid signature in DSSE envelope")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, der := range chain[1:] {
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate: %v", err)
+		}
+		intermediates.AddCert(c)
+	}
+	verifyOpts := x509.VerifyOptions{
+		Roots:         opts.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   signedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	if _, err := cert.Verify(verifyOpts); err != nil {
+		return nil, fmt.Errorf("verifying certificate: %v", err)
+	}
+	if err := checkCertificateIdentity(cert, opts.CertificateIdentity, opts.CertificateOIDCIssuer); err != nil {
+		return nil, err
+	}
+
+	statement := new(InTotoStatement)
+	if err := json.Unmarshal(envelope.Payload, statement); err != nil {
+		return nil, fmt.Errorf("decoding statement: %v", err)
+	}
+
+	algorithm, digest, ok := splitDigest(subjectDigest)
+	if !ok {
+		return nil, fmt.Errorf("invalid subject digest %q", subjectDigest)
+	}
+	for _, s := range statement.Subject {
+		if strings.EqualFold(s.Digest[algorithm], digest) {
+			return statement, nil
+		}
+	}
+	return nil, fmt.Errorf("statement is not about %v", subjectDigest)
+}
+
+// checkCertificateIdentity checks that a Fulcio certificate is issued to
+// identity, one of its URI or email subject alternative names, by the OIDC
+// issuer recorded in its extensions.
+func checkCertificateIdentity(cert *x509.Certificate, identity, issuer string) error {
+	var certIssuer string
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidFulcioIssuerV2):
+			if _, err := asn1.UnmarshalWithParams(ext.Value, &certIssuer, "utf8"); err != nil {
+				return fmt.Errorf("parsing certificate issuer: %v", err)
+			}
+		case ext.Id.Equal(oidFulcioIssuerV1) && certIssuer == "":
+			certIssuer = string(ext.Value)
+		}
+	}
+	if certIssuer != issuer {
+		return fmt.Errorf("certificate is issued by %q, want %q", certIssuer, issuer)
+	}
+
+	for _, u := range cert.URIs {
+		if u.String() == identity {
+			return nil
+		}
+	}
+	for _, email := range cert.EmailAddresses {
+		if email == identity {
+			return nil
+		}
+	}
+	return fmt.Errorf("certificate is not issued to %q", identity)
+}
+
+// dssePAE returns the pre-authentication encoding of a DSSE payload, which
+// is what its signatures sign.
+func dssePAE(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// verifySignature checks that sig is a signature of data by the given key.
+func verifySignature(key crypto.PublicKey, data, sig []byte) error {
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		var digest []byte
+		switch key.Curve.Params().BitSize {
+		case 256:
+			d := sha256.Sum256(data)
+			digest = d[:]
+		case 384:
+			d := sha512.Sum384(data)
+			digest = d[:]
+		default:
+			d := sha512.Sum512(data)
+			digest = d[:]
+		}
+		if !ecdsa.VerifyASN1(key, digest, sig) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, sig) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported public key type %T", key)
+}
+
+// splitDigest splits a digest formatted as "algorithm:hex".
+func splitDigest(s string) (algorithm, digest string, ok bool) {
+	i := strings.Index(s, ":")
+	if i <= 0 || i == len(s)-1 {
+		return "", "", false
+	}
+	return strings.ToLower(s[:i]), s[i+1:], true
+}
@@ -0,0 +1,229 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testAttestationIdentity = "https://github.com/o/r/.github/workflows/release.yml@refs/heads/main"
+	testAttestationIssuer   = "https://token.actions.githubusercontent.com"
+)
+
+// testAttestationSigner issues a code signing certificate from a test
+// certificate authority and signs attestation bundles with it.
+type testAttestationSigner struct {
+	roots     *x509.CertPool
+	key       *ecdsa.PrivateKey
+	cert      []byte
+	notBefore time.Time
+}
+
+func newTestAttestationSigner(t *testing.T) *testAttestationSigner {
+	t.Helper()
+
+	notBefore := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test root"},
+		NotBefore:             notBefore.Add(-time.Hour),
+		NotAfter:              notBefore.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := asn1.MarshalWithParams(testAttestationIssuer, "utf8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := url.Parse(testAttestationIdentity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       notBefore,
+		NotAfter:        notBefore.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:            []*url.URL{identity},
+		ExtraExtensions: []pkix.Extension{{Id: oidFulcioIssuerV2, Value: issuer}},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, leafTemplate, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	return &testAttestationSigner{roots: roots, key: key, cert: cert, notBefore: notBefore}
+}
+
+// bundle returns an attestation of the statement.
+func (s *testAttestationSigner) bundle(t *testing.T, statement string) *Attestation {
+	t.Helper()
+
+	payloadType := "application/vnd.in-toto+json"
+	digest := sha256.Sum256(dssePAE(payloadType, []byte(statement)))
+	sig, err := ecdsa.SignASN1(rand.Reader, s.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bundle := map[string]interface{}{
+		"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+		"verificationMaterial": map[string]interface{}{
+			"certificate": map[string]interface{}{"rawBytes": s.cert},
+			"tlogEntries": []interface{}{
+				map[string]interface{}{"integratedTime": strconv.FormatInt(s.notBefore.Add(time.Minute).Unix(), 10)},
+			},
+		},
+		"dsseEnvelope": map[string]interface{}{
+			"payload":     []byte(statement),
+			"payloadType": payloadType,
+			"signatures":  []interface{}{map[string]interface{}{"sig": sig}},
+		},
+	}
+	raw, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Attestation{Bundle: raw, RepositoryID: Int64(1)}
+}
+
+const testInTotoStatement = `{
+	"_type": "https://in-toto.io/Statement/v1",
+	"subject": [{"name": "app", "digest": {"sha256": "ABCDEF0123"}}],
+	"predicateType": "https://slsa.dev/provenance/v1",
+	"predicate": {"buildDefinition": {}}
+}`
+
+func TestAttestation_Verify(t *testing.T) {
+	signer := newTestAttestationSigner(t)
+	attestation := signer.bundle(t, testInTotoStatement)
+
+	opts := &AttestationVerifyOptions{
+		Roots:                 signer.roots,
+		CertificateIdentity:   testAttestationIdentity,
+		CertificateOIDCIssuer: testAttestationIssuer,
+	}
+	statement, err := attestation.Verify("sha256:abcdef0123", opts)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if statement.PredicateType != "https://slsa.dev/provenance/v1" {
+		t.Errorf("Verify returned predicate type %q", statement.PredicateType)
+	}
+	if got := statement.Subject[0].Name; got != "app" {
+		t.Errorf("Verify returned subject %q, want app", got)
+	}
+}
+
+func TestAttestation_Verify_invalid(t *testing.T) {
+	signer := newTestAttestationSigner(t)
+	other := newTestAttestationSigner(t)
+	valid := signer.bundle(t, testInTotoStatement)
+	opts := &AttestationVerifyOptions{
+		Roots:                 signer.roots,
+		CertificateIdentity:   testAttestationIdentity,
+		CertificateOIDCIssuer: testAttestationIssuer,
+	}
+	withOpts := func(f func(o *AttestationVerifyOptions)) *AttestationVerifyOptions {
+		o := *opts
+		f(&o)
+		return &o
+	}
+
+	var untimedBundle map[string]interface{}
+	if err := json.Unmarshal(valid.Bundle, &untimedBundle); err != nil {
+		t.Fatal(err)
+	}
+	delete(untimedBundle["verificationMaterial"].(map[string]interface{}), "tlogEntries")
+	untimedRaw, err := json.Marshal(untimedBundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	untimed := &Attestation{Bundle: untimedRaw}
+
+	// Pair the payload of another statement with the signature of valid.
+	var validBundle, forgedBundle map[string]interface{}
+	if err := json.Unmarshal(valid.Bundle, &validBundle); err != nil {
+		t.Fatal(err)
+	}
+	signedOther := signer.bundle(t, strings.Replace(testInTotoStatement, `"app"`, `"evil"`, 1))
+	if err := json.Unmarshal(signedOther.Bundle, &forgedBundle); err != nil {
+		t.Fatal(err)
+	}
+	validEnvelope := validBundle["dsseEnvelope"].(map[string]interface{})
+	forgedBundle["dsseEnvelope"].(map[string]interface{})["signatures"] = validEnvelope["signatures"]
+	raw, err := json.Marshal(forgedBundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := &Attestation{Bundle: raw}
+
+	tests := []struct {
+		name          string
+		attestation   *Attestation
+		subjectDigest string
+		opts          *AttestationVerifyOptions
+		wantErr       string
+	}{
+		{"other subject", valid, "sha256:0000", opts, "statement is not about"},
+		{"other algorithm", valid, "sha512:abcdef0123", opts, "statement is not about"},
+		{"bad digest", valid, "abcdef0123", opts, "invalid subject digest"},
+		{"no options", valid, "sha256:abcdef0123", nil, "opts.Roots must be set"},
+		{"no roots", valid, "sha256:abcdef0123", withOpts(func(o *AttestationVerifyOptions) { o.Roots = nil }), "opts.Roots must be set"},
+		{"no identity", valid, "sha256:abcdef0123", withOpts(func(o *AttestationVerifyOptions) { o.CertificateIdentity = "" }), "must be set to verify the signer"},
+		{"no issuer", valid, "sha256:abcdef0123", withOpts(func(o *AttestationVerifyOptions) { o.CertificateOIDCIssuer = "" }), "must be set to verify the signer"},
+		{"other identity", valid, "sha256:abcdef0123", withOpts(func(o *AttestationVerifyOptions) { o.CertificateIdentity = "https://github.com/evil/r" }), "certificate is not issued to"},
+		{"other issuer", valid, "sha256:abcdef0123", withOpts(func(o *AttestationVerifyOptions) { o.CertificateOIDCIssuer = "https://accounts.google.com" }), "certificate is issued by"},
+		{"untrusted certificate", valid, "sha256:abcdef0123", withOpts(func(o *AttestationVerifyOptions) { o.Roots = other.roots }), "verifying certificate"},
+		{"expired certificate", valid, "sha256:abcdef0123", withOpts(func(o *AttestationVerifyOptions) { o.SignedAt = signer.notBefore.Add(time.Hour) }), "verifying certificate"},
+		{"no signing time", untimed, "sha256:abcdef0123", opts, "no transparency log entry"},
+		{"signature of another payload", forged, "sha256:abcdef0123", opts, "no valid signature"},
+		{"not a bundle", &Attestation{Bundle: json.RawMessage(`{}`)}, "sha256:abcdef0123", opts, "no DSSE envelope"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.attestation.Verify(tt.subjectDigest, tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Verify returned error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
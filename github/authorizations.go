@@ -68,8 +68,12 @@ type Authorization struct {
 	CreatedAt      *Timestamp        `json:"created_at,omitempty"`
 	Fingerprint    *string           `json:"fingerprint,omitempty"`
 
-	// User is only populated by the Check and Reset methods.
+	// User is only populated by the Check, Reset and CreateScopedToken methods.
 	User *User `json:"user,omitempty"`
+	// ExpiresAt and Installation are only populated for GitHub App
+	// user-to-server tokens.
+	ExpiresAt    *Timestamp    `json:"expires_at,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
 }
 
 func (a Authorization) String() string {
@@ -222,6 +226,51 @@ func (s *AuthorizationsService) Revoke(ctx context.Context, clientID, accessToke
 	return s.client.Do(ctx, req, nil)
 }
 
+// ScopedTokenOptions specifies the target and restrictions of a token
+// created by AuthorizationsService.CreateScopedToken. One of Target and
+// TargetID must be set.
+type ScopedTokenOptions struct {
+	// AccessToken is the user-to-server access token to scope.
+	AccessToken string `json:"access_token"`
+	// Target is the login of the user or organization to scope the token to.
+	Target *string `json:"target,omitempty"`
+	// TargetID is the ID of the user or organization to scope the token to.
+	TargetID *int64 `json:"target_id,omitempty"`
+	// Repositories and RepositoryIDs restrict the token to the named
+	// repositories. At most 500 repositories can be listed.
+	Repositories  []string `json:"repositories,omitempty"`
+	RepositoryIDs []int64  `json:"repository_ids,omitempty"`
+	// Permissions restricts the permissions of the token. They cannot exceed
+	// the permissions of the GitHub App installation.
+	Permissions *InstallationPermissions `json:"permissions,omitempty"`
+}
+
+// CreateScopedToken exchanges a user-to-server token of a GitHub App for one
+// that is restricted to a single user or organization, and optionally to
+// specific repositories and permissions.
+//
+// Note that this operation requires the use of BasicAuth, but where the
+// username is the GitHub App clientID, and the password is its clientSecret.
+// Invalid tokens will return a 404 Not Found.
+//
+// GitHub API docs: https://docs.github.com/en/rest/apps/apps#create-a-scoped-access-token
+func (s *AuthorizationsService) CreateScopedToken(ctx context.Context, clientID string, opts *ScopedTokenOptions) (*Authorization, *Response, error) {
+	u := fmt.Sprintf("applications/%v/token/scoped", clientID)
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(Authorization)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
 // DeleteGrant deletes an OAuth application grant. Deleting an application's
 // grant will also delete all OAuth tokens associated with the application for
 // the user.
@@ -116,6 +116,53 @@ func TestAuthorizationsService_Revoke(t *testing.T) {
 	})
 }
 
+func TestAuthorizationsService_CreateScopedToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/applications/id/token/scoped", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"access_token":"a","target":"o","repositories":["r"],"permissions":{"contents":"read"}}`+"\n")
+		fmt.Fprint(w, `{"id":1,"token":"t","expires_at":`+referenceTimeStr+`,"installation":{"id":2}}`)
+	})
+
+	opts := &ScopedTokenOptions{
+		AccessToken:  "a",
+		Target:       String("o"),
+		Repositories: []string{"r"},
+		Permissions:  &InstallationPermissions{Contents: String("read")},
+	}
+	ctx := context.Background()
+	got, _, err := client.Authorizations.CreateScopedToken(ctx, "id", opts)
+	if err != nil {
+		t.Errorf("Authorizations.CreateScopedToken returned error: %v", err)
+	}
+
+	want := &Authorization{
+		ID:           Int64(1),
+		Token:        String("t"),
+		ExpiresAt:    &Timestamp{referenceTime},
+		Installation: &Installation{ID: Int64(2)},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Authorizations.CreateScopedToken returned auth %+v, want %+v", got, want)
+	}
+
+	const methodName = "CreateScopedToken"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Authorizations.CreateScopedToken(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Authorizations.CreateScopedToken(ctx, "id", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestDeleteGrant(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
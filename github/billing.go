@@ -41,6 +41,42 @@ type StorageBilling struct {
 	EstimatedStorageForMonth     float64 `json:"estimated_storage_for_month"`
 }
 
+// EstimatedCost estimates the cost of the paid GitHub Actions minutes, given
+// the price per minute of each machine type in MinutesUsedBreakdown. Paid
+// minutes are assumed to be spread across machine types in proportion to
+// their share of the total minutes used; machine types without a rate are
+// free.
+func (b *ActionBilling) EstimatedCost(ratesPerMinute map[string]float64) float64 {
+	if b == nil || b.TotalMinutesUsed == 0 {
+		return 0
+	}
+
+	var cost float64
+	for machine, minutes := range b.MinutesUsedBreakdown {
+		cost += float64(minutes) * ratesPerMinute[machine]
+	}
+
+	return cost * b.TotalPaidMinutesUsed / b.TotalMinutesUsed
+}
+
+// EstimatedCost estimates the cost of the paid GitHub Packages bandwidth,
+// given the price per gigabyte.
+func (b *PackageBilling) EstimatedCost(ratePerGigabyte float64) float64 {
+	if b == nil {
+		return 0
+	}
+	return float64(b.TotalPaidGigabytesBandwidthUsed) * ratePerGigabyte
+}
+
+// EstimatedCost estimates the cost of the paid shared storage for the month,
+// given the price per gigabyte per month.
+func (b *StorageBilling) EstimatedCost(ratePerGigabyte float64) float64 {
+	if b == nil {
+		return 0
+	}
+	return b.EstimatedPaidStorageForMonth * ratePerGigabyte
+}
+
 // ActiveCommitters represents the total active committers across all repositories in an Organization.
 type ActiveCommitters struct {
 	TotalAdvancedSecurityCommitters int                           `json:"total_advanced_security_committers"`
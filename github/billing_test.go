@@ -428,6 +428,46 @@ func TestStorageBilling_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestActionBilling_EstimatedCost(t *testing.T) {
+	b := &ActionBilling{
+		TotalMinutesUsed:     300,
+		TotalPaidMinutesUsed: 150,
+		MinutesUsedBreakdown: MinutesUsedBreakdown{"UBUNTU": 200, "MACOS": 100, "WINDOWS": 0},
+	}
+	rates := map[string]float64{"UBUNTU": 0.01, "MACOS": 0.1}
+
+	// Half of the minutes are paid: (200*0.01 + 100*0.1) / 2.
+	if got, want := b.EstimatedCost(rates), 6.0; got != want {
+		t.Errorf("ActionBilling.EstimatedCost returned %v, want %v", got, want)
+	}
+	if got := (&ActionBilling{}).EstimatedCost(rates); got != 0 {
+		t.Errorf("ActionBilling.EstimatedCost without usage returned %v, want 0", got)
+	}
+	if got := (*ActionBilling)(nil).EstimatedCost(rates); got != 0 {
+		t.Errorf("ActionBilling.EstimatedCost on nil returned %v, want 0", got)
+	}
+}
+
+func TestPackageBilling_EstimatedCost(t *testing.T) {
+	b := &PackageBilling{TotalGigabytesBandwidthUsed: 50, TotalPaidGigabytesBandwidthUsed: 40}
+	if got, want := b.EstimatedCost(0.5), 20.0; got != want {
+		t.Errorf("PackageBilling.EstimatedCost returned %v, want %v", got, want)
+	}
+	if got := (*PackageBilling)(nil).EstimatedCost(0.5); got != 0 {
+		t.Errorf("PackageBilling.EstimatedCost on nil returned %v, want 0", got)
+	}
+}
+
+func TestStorageBilling_EstimatedCost(t *testing.T) {
+	b := &StorageBilling{EstimatedStorageForMonth: 40, EstimatedPaidStorageForMonth: 15}
+	if got, want := b.EstimatedCost(0.25), 3.75; got != want {
+		t.Errorf("StorageBilling.EstimatedCost returned %v, want %v", got, want)
+	}
+	if got := (*StorageBilling)(nil).EstimatedCost(0.25); got != 0 {
+		t.Errorf("StorageBilling.EstimatedCost on nil returned %v, want 0", got)
+	}
+}
+
 func TestBillingService_GetAdvancedSecurityActiveCommittersOrg(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,86 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// UsageReportOptions specifies the optional parameters to the
+// BillingService.GetUsageReportOrg and BillingService.GetUsageReportEnterprise
+// methods. Without a filter, the report covers the current year.
+type UsageReportOptions struct {
+	// Year filters the report to a year, such as 2024.
+	Year *int `url:"year,omitempty"`
+	// Month filters the report to a month of the year, from 1 to 12.
+	Month *int `url:"month,omitempty"`
+	// Day filters the report to a day of the month, from 1 to 31.
+	Day *int `url:"day,omitempty"`
+	// Hour filters the report to an hour of the day, from 0 to 23.
+	Hour *int `url:"hour,omitempty"`
+	// CostCenterID filters the report to a cost center. It only applies to
+	// enterprises.
+	CostCenterID *string `url:"cost_center_id,omitempty"`
+}
+
+// UsageReport represents a usage report of the enhanced billing platform.
+type UsageReport struct {
+	UsageItems []*UsageItem `json:"usageItems,omitempty"`
+}
+
+// UsageItem represents the usage of a product SKU in a usage report.
+type UsageItem struct {
+	Date             *string  `json:"date,omitempty"`
+	Product          *string  `json:"product,omitempty"`
+	SKU              *string  `json:"sku,omitempty"`
+	Quantity         *float64 `json:"quantity,omitempty"`
+	UnitType         *string  `json:"unitType,omitempty"`
+	PricePerUnit     *float64 `json:"pricePerUnit,omitempty"`
+	GrossAmount      *float64 `json:"grossAmount,omitempty"`
+	DiscountAmount   *float64 `json:"discountAmount,omitempty"`
+	NetAmount        *float64 `json:"netAmount,omitempty"`
+	OrganizationName *string  `json:"organizationName,omitempty"`
+	RepositoryName   *string  `json:"repositoryName,omitempty"`
+}
+
+// GetUsageReportOrg gets the usage report of an organization on the enhanced
+// billing platform.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/enhanced-billing#get-billing-usage-report-for-an-organization
+func (s *BillingService) GetUsageReportOrg(ctx context.Context, org string, opts *UsageReportOptions) (*UsageReport, *Response, error) {
+	u := fmt.Sprintf("organizations/%v/settings/billing/usage", org)
+	return s.getUsageReport(ctx, u, opts)
+}
+
+// GetUsageReportEnterprise gets the usage report of an enterprise on the
+// enhanced billing platform.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/billing#get-billing-usage-report-for-an-enterprise
+func (s *BillingService) GetUsageReportEnterprise(ctx context.Context, enterprise string, opts *UsageReportOptions) (*UsageReport, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/settings/billing/usage", enterprise)
+	return s.getUsageReport(ctx, u, opts)
+}
+
+func (s *BillingService) getUsageReport(ctx context.Context, u string, opts *UsageReportOptions) (*UsageReport, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(UsageReport)
+	resp, err := s.client.Do(ctx, req, report)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return report, resp, nil
+}
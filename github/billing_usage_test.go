@@ -0,0 +1,113 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBillingService_GetUsageReportOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/organizations/o/settings/billing/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"year": "2024", "month": "1", "hour": "0"})
+		fmt.Fprint(w, `{"usageItems":[{
+			"date": "2024-01-01T00:00:00Z",
+			"product": "Actions",
+			"sku": "Actions Linux",
+			"quantity": 100,
+			"unitType": "minutes",
+			"pricePerUnit": 0.008,
+			"grossAmount": 0.8,
+			"discountAmount": 0,
+			"netAmount": 0.8,
+			"organizationName": "o",
+			"repositoryName": "o/r"
+		}]}`)
+	})
+
+	opts := &UsageReportOptions{Year: Int(2024), Month: Int(1), Hour: Int(0)}
+	ctx := context.Background()
+	report, _, err := client.Billing.GetUsageReportOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Billing.GetUsageReportOrg returned error: %v", err)
+	}
+
+	want := &UsageReport{UsageItems: []*UsageItem{{
+		Date:             String("2024-01-01T00:00:00Z"),
+		Product:          String("Actions"),
+		SKU:              String("Actions Linux"),
+		Quantity:         Float64(100),
+		UnitType:         String("minutes"),
+		PricePerUnit:     Float64(0.008),
+		GrossAmount:      Float64(0.8),
+		DiscountAmount:   Float64(0),
+		NetAmount:        Float64(0.8),
+		OrganizationName: String("o"),
+		RepositoryName:   String("o/r"),
+	}}}
+	if !cmp.Equal(report, want) {
+		t.Errorf("Billing.GetUsageReportOrg returned %+v, want %+v", report, want)
+	}
+
+	const methodName = "GetUsageReportOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Billing.GetUsageReportOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Billing.GetUsageReportOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestBillingService_GetUsageReportEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/settings/billing/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"day": "2", "cost_center_id": "cc"})
+		fmt.Fprint(w, `{"usageItems":[{"product":"Packages","netAmount":1.5}]}`)
+	})
+
+	opts := &UsageReportOptions{Day: Int(2), CostCenterID: String("cc")}
+	ctx := context.Background()
+	report, _, err := client.Billing.GetUsageReportEnterprise(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Billing.GetUsageReportEnterprise returned error: %v", err)
+	}
+
+	want := &UsageReport{UsageItems: []*UsageItem{{Product: String("Packages"), NetAmount: Float64(1.5)}}}
+	if !cmp.Equal(report, want) {
+		t.Errorf("Billing.GetUsageReportEnterprise returned %+v, want %+v", report, want)
+	}
+
+	const methodName = "GetUsageReportEnterprise"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Billing.GetUsageReportEnterprise(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Billing.GetUsageReportEnterprise(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
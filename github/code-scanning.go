@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -122,6 +123,24 @@ type AlertListOptions struct {
 	// Return code scanning alerts for a specific branch reference. The ref must be formatted as heads/<branch name>.
 	Ref string `url:"ref,omitempty"`
 
+	// The name of a code scanning tool. Only alerts reported by this tool are listed.
+	// Do not use together with ToolGUID.
+	ToolName string `url:"tool_name,omitempty"`
+
+	// The GUID of a code scanning tool. Only alerts reported by this tool are listed.
+	// Do not use together with ToolName.
+	ToolGUID string `url:"tool_guid,omitempty"`
+
+	// Severity of the code scanning alerts to list. Can be one of critical, high, medium, low,
+	// warning, note or error.
+	Severity string `url:"severity,omitempty"`
+
+	// The property by which to sort the results. Can be either created or updated. Default: created
+	Sort string `url:"sort,omitempty"`
+
+	// The direction to sort the results by. Can be either asc or desc. Default: desc
+	Direction string `url:"direction,omitempty"`
+
 	ListCursorOptions
 
 	// Add ListOptions so offset pagination with integer type "page" query parameter is accepted
@@ -197,6 +216,34 @@ type SarifID struct {
 	URL *string `json:"url,omitempty"`
 }
 
+// ListAlertsForEnterprise lists code scanning alerts for the repositories of an enterprise.
+//
+// Alerts are only returned for the organizations of the enterprise for which the
+// authenticated user is an organization owner or a security manager.
+// Use ListCursorOptions.After to page through the results.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/code-scanning/code-scanning#list-code-scanning-alerts-for-an-enterprise
+func (s *CodeScanningService) ListAlertsForEnterprise(ctx context.Context, enterprise string, opts *AlertListOptions) ([]*Alert, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/code-scanning/alerts", enterprise)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*Alert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
 // ListAlertsForOrg lists code scanning alerts for an org.
 //
 // You must use an access token with the security_events scope to use this endpoint. GitHub Apps must have the security_events
@@ -308,6 +355,11 @@ func (s *CodeScanningService) UpdateAlert(ctx context.Context, owner, repo strin
 // You must use an access token with the security_events scope to use this endpoint. GitHub Apps must have the security_events
 // write permission to use this endpoint.
 //
+// GitHub processes uploads asynchronously, so this method usually returns
+// an *AcceptedError and a status code of 202. In this event, the SarifID
+// value will be returned, which can be passed to GetSARIF or
+// WaitForSARIFProcessing to follow the processing of the upload.
+//
 // GitHub API docs: https://docs.github.com/en/rest/code-scanning#upload-an-analysis-as-sarif-data
 func (s *CodeScanningService) UploadSarif(ctx context.Context, owner, repo string, sarif *SarifAnalysis) (*SarifID, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs", owner, repo)
@@ -320,6 +372,14 @@ func (s *CodeScanningService) UploadSarif(ctx context.Context, owner, repo strin
 	sarifID := new(SarifID)
 	resp, err := s.client.Do(ctx, req, sarifID)
 	if err != nil {
+		// Persist AcceptedError's metadata to the SarifID object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, sarifID); err != nil {
+				return sarifID, resp, err
+			}
+
+			return sarifID, resp, err
+		}
 		return nil, resp, err
 	}
 
@@ -378,3 +438,177 @@ func (s *CodeScanningService) GetAnalysis(ctx context.Context, owner, repo strin
 
 	return analysis, resp, nil
 }
+
+// DeleteAnalysis represents the response of deleting a code scanning analysis.
+// The URLs point to the next deletable analysis of the same set, if any.
+type DeleteAnalysis struct {
+	// NextAnalysisURL deletes the next analysis, without confirming the
+	// deletion of the last analysis of the set.
+	NextAnalysisURL *string `json:"next_analysis_url,omitempty"`
+	// ConfirmDeleteURL deletes the next analysis, confirming the deletion
+	// of the last analysis of the set.
+	ConfirmDeleteURL *string `json:"confirm_delete_url,omitempty"`
+}
+
+// DeleteAnalysisOptions specifies the optional parameters to the
+// CodeScanningService.DeleteAnalysis and CodeScanningService.DeleteAnalysisSeries methods.
+type DeleteAnalysisOptions struct {
+	// ConfirmDelete allows deleting the last analysis of a set of analyses
+	// sharing the same tool, category and ref, which deletes the historical
+	// alert data of the set. Without it, GitHub rejects such deletions.
+	ConfirmDelete bool `url:"confirm_delete,omitempty"`
+}
+
+// DeleteAnalysis deletes a single code scanning analysis from a repository.
+// Only the most recent analysis of a set can be deleted; the returned
+// DeleteAnalysis points to the next one.
+//
+// You must use an access token with the repo scope to use this endpoint.
+// GitHub Apps must have the security_events write permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/rest/code-scanning#delete-a-code-scanning-analysis-from-a-repository
+func (s *CodeScanningService) DeleteAnalysis(ctx context.Context, owner, repo string, id int64, opts *DeleteAnalysisOptions) (*DeleteAnalysis, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses/%v", owner, repo, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.deleteAnalysis(ctx, u)
+}
+
+func (s *CodeScanningService) deleteAnalysis(ctx context.Context, u string) (*DeleteAnalysis, *Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(DeleteAnalysis)
+	resp, err := s.client.Do(ctx, req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// DeleteAnalysisSeries deletes the analysis id and then the older analyses
+// of its set, following the URLs returned by each deletion, such as to
+// clean up the analyses of a misconfigured SARIF category. The last
+// analysis of the set is deleted only if opts.ConfirmDelete is set.
+//
+// It returns the number of analyses deleted, including on error.
+func (s *CodeScanningService) DeleteAnalysisSeries(ctx context.Context, owner, repo string, id int64, opts *DeleteAnalysisOptions) (int, *Response, error) {
+	confirm := opts != nil && opts.ConfirmDelete
+	d, resp, err := s.DeleteAnalysis(ctx, owner, repo, id, opts)
+	deleted := 0
+	for {
+		if err != nil {
+			return deleted, resp, err
+		}
+		deleted++
+
+		next := d.GetNextAnalysisURL()
+		if confirm && d.GetConfirmDeleteURL() != "" {
+			next = d.GetConfirmDeleteURL()
+		}
+		if next == "" {
+			return deleted, resp, nil
+		}
+		d, resp, err = s.deleteAnalysis(ctx, next)
+	}
+}
+
+// DefaultSetupConfiguration represents a code scanning default setup configuration.
+type DefaultSetupConfiguration struct {
+	// State can be one of "configured" or "not-configured".
+	State     *string  `json:"state,omitempty"`
+	Languages []string `json:"languages,omitempty"`
+	// QuerySuite can be one of "default" or "extended".
+	QuerySuite *string `json:"query_suite,omitempty"`
+	// Schedule can be "weekly", or null if not configured.
+	Schedule  *string    `json:"schedule,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
+}
+
+// GetDefaultSetupConfiguration gets a code scanning default setup configuration.
+//
+// You must use an access token with the repo scope to use this
+// endpoint with private repos or the public_repo scope for public repos. GitHub Apps must have the repo write
+// permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/rest/code-scanning#get-a-code-scanning-default-setup-configuration
+func (s *CodeScanningService) GetDefaultSetupConfiguration(ctx context.Context, owner, repo string) (*DefaultSetupConfiguration, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/default-setup", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := new(DefaultSetupConfiguration)
+	resp, err := s.client.Do(ctx, req, cfg)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cfg, resp, nil
+}
+
+// UpdateDefaultSetupConfigurationOptions specifies parameters to the CodeScanningService.UpdateDefaultSetupConfiguration
+// method.
+type UpdateDefaultSetupConfigurationOptions struct {
+	// State can be one of "configured" or "not-configured". Required.
+	State string `json:"state"`
+	// QuerySuite can be one of "default" or "extended".
+	QuerySuite *string `json:"query_suite,omitempty"`
+	// Languages lists the languages to analyze. Default is all the
+	// languages detected in the repository.
+	Languages []string `json:"languages,omitempty"`
+}
+
+// UpdateDefaultSetupConfigurationResponse represents a response from updating a code scanning default setup configuration.
+type UpdateDefaultSetupConfigurationResponse struct {
+	// RunID and RunURL identify the workflow run that applies the
+	// configuration, if one was started.
+	RunID  *int64  `json:"run_id,omitempty"`
+	RunURL *string `json:"run_url,omitempty"`
+}
+
+// UpdateDefaultSetupConfiguration updates a code scanning default setup configuration.
+//
+// You must use an access token with the repo scope to use this
+// endpoint with private repos or the public_repo scope for public repos. GitHub Apps must have the repo write
+// permission to use this endpoint.
+//
+// This method might return an *AcceptedError and a status code of 202. This
+// is because this is the status that GitHub returns to signify that it has
+// started a workflow run to apply the configuration. In this event, the
+// UpdateDefaultSetupConfigurationResponse value will be returned, which
+// includes the ID of that run.
+//
+// GitHub API docs: https://docs.github.com/en/rest/code-scanning#update-a-code-scanning-default-setup-configuration
+func (s *CodeScanningService) UpdateDefaultSetupConfiguration(ctx context.Context, owner, repo string, options *UpdateDefaultSetupConfigurationOptions) (*UpdateDefaultSetupConfigurationResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/default-setup", owner, repo)
+
+	req, err := s.client.NewRequest("PATCH", u, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(UpdateDefaultSetupConfigurationResponse)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		// Persist AcceptedError's metadata to the response object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, a); err != nil {
+				return a, resp, err
+			}
+
+			return a, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Processing states of a SARIF upload.
+const (
+	SARIFProcessingPending  = "pending"
+	SARIFProcessingComplete = "complete"
+	SARIFProcessingFailed   = "failed"
+)
+
+// Intervals between the polls made by CodeScanningService.WaitForSARIFProcessing.
+// The interval doubles after each poll, up to sarifPollMaxInterval.
+var (
+	sarifPollInitialInterval = 1 * time.Second
+	sarifPollMaxInterval     = 30 * time.Second
+)
+
+// SARIFUpload represents the processing state of a SARIF upload.
+type SARIFUpload struct {
+	// ProcessingStatus is one of SARIFProcessingPending,
+	// SARIFProcessingComplete or SARIFProcessingFailed.
+	ProcessingStatus *string `json:"processing_status,omitempty"`
+	// AnalysesURL is the URL of the analyses created from the upload, once
+	// processing is complete.
+	AnalysesURL *string `json:"analyses_url,omitempty"`
+	// Errors lists the processing errors, if processing failed.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// SARIFProcessingError is returned by CodeScanningService.WaitForSARIFProcessing
+// when GitHub failed to process a SARIF upload.
+type SARIFProcessingError struct {
+	SarifID string
+	Errors  []string
+}
+
+func (e *SARIFProcessingError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("processing of SARIF upload %v failed", e.SarifID)
+	}
+	return fmt.Sprintf("processing of SARIF upload %v failed: %v", e.SarifID, strings.Join(e.Errors, "; "))
+}
+
+// GetSARIF gets information about a SARIF upload, including its processing
+// status and errors. sarifID is the ID returned by UploadSarif.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events read permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/rest/code-scanning#get-information-about-a-sarif-upload
+func (s *CodeScanningService) GetSARIF(ctx context.Context, owner, repo, sarifID string) (*SARIFUpload, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs/%v", owner, repo, sarifID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upload := new(SARIFUpload)
+	resp, err := s.client.Do(ctx, req, upload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return upload, resp, nil
+}
+
+// WaitForSARIFProcessing polls a SARIF upload until GitHub has processed
+// it, and returns the analyses created from it. If processing failed, a
+// *SARIFProcessingError listing the processing errors is returned, so CI
+// integrations can fail the build. The delay between polls starts at one
+// second and doubles up to 30 seconds; use a context with a deadline to
+// bound the total wait.
+func (s *CodeScanningService) WaitForSARIFProcessing(ctx context.Context, owner, repo, sarifID string) ([]*ScanningAnalysis, *Response, error) {
+	interval := sarifPollInitialInterval
+	for {
+		upload, resp, err := s.GetSARIF(ctx, owner, repo, sarifID)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		switch upload.GetProcessingStatus() {
+		case SARIFProcessingComplete:
+			return s.listSARIFAnalyses(ctx, owner, repo, sarifID)
+		case SARIFProcessingFailed:
+			return nil, resp, &SARIFProcessingError{SarifID: sarifID, Errors: upload.Errors}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, resp, err
+		}
+		interval *= 2
+		if interval > sarifPollMaxInterval {
+			interval = sarifPollMaxInterval
+		}
+	}
+}
+
+// listSARIFAnalyses lists all analyses created from the SARIF upload sarifID.
+func (s *CodeScanningService) listSARIFAnalyses(ctx context.Context, owner, repo, sarifID string) ([]*ScanningAnalysis, *Response, error) {
+	opts := &AnalysesListOptions{SarifID: String(sarifID), ListOptions: ListOptions{PerPage: 100}}
+	var analyses []*ScanningAnalysis
+	for {
+		page, resp, err := s.ListAnalysesForRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		analyses = append(analyses, page...)
+		if resp.NextPage == 0 {
+			return analyses, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
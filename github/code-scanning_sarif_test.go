@@ -0,0 +1,172 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodeScanningService_UploadSarif_accepted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":"47177e22","url":"https://api.github.com/repos/o/r/code-scanning/sarifs/47177e22"}`)
+	})
+
+	ctx := context.Background()
+	sarifID, _, err := client.CodeScanning.UploadSarif(ctx, "o", "r", &SarifAnalysis{CommitSHA: String("abc")})
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("CodeScanning.UploadSarif returned error %v, want *AcceptedError", err)
+	}
+
+	want := &SarifID{ID: String("47177e22"), URL: String("https://api.github.com/repos/o/r/code-scanning/sarifs/47177e22")}
+	if !cmp.Equal(sarifID, want) {
+		t.Errorf("CodeScanning.UploadSarif returned %+v, want %+v", sarifID, want)
+	}
+}
+
+func TestCodeScanningService_GetSARIF(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"processing_status": "complete",
+			"analyses_url": "https://api.github.com/repos/o/r/code-scanning/analyses?sarif_id=abc"
+		}`)
+	})
+
+	ctx := context.Background()
+	upload, _, err := client.CodeScanning.GetSARIF(ctx, "o", "r", "abc")
+	if err != nil {
+		t.Errorf("CodeScanning.GetSARIF returned error: %v", err)
+	}
+
+	want := &SARIFUpload{
+		ProcessingStatus: String("complete"),
+		AnalysesURL:      String("https://api.github.com/repos/o/r/code-scanning/analyses?sarif_id=abc"),
+	}
+	if !cmp.Equal(upload, want) {
+		t.Errorf("CodeScanning.GetSARIF returned %+v, want %+v", upload, want)
+	}
+
+	const methodName = "GetSARIF"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.GetSARIF(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.GetSARIF(ctx, "o", "r", "abc")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_WaitForSARIFProcessing(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(initial, max time.Duration) {
+		sarifPollInitialInterval, sarifPollMaxInterval = initial, max
+	}(sarifPollInitialInterval, sarifPollMaxInterval)
+	sarifPollInitialInterval, sarifPollMaxInterval = time.Millisecond, 2*time.Millisecond
+
+	var polls int
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		polls++
+		if polls < 3 {
+			fmt.Fprint(w, `{"processing_status":"pending"}`)
+			return
+		}
+		fmt.Fprint(w, `{"processing_status":"complete"}`)
+	})
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"sarif_id": "abc", "per_page": "100"})
+		fmt.Fprint(w, `[{"id":1,"sarif_id":"abc"},{"id":2,"sarif_id":"abc"}]`)
+	})
+
+	ctx := context.Background()
+	analyses, _, err := client.CodeScanning.WaitForSARIFProcessing(ctx, "o", "r", "abc")
+	if err != nil {
+		t.Fatalf("CodeScanning.WaitForSARIFProcessing returned error: %v", err)
+	}
+
+	want := []*ScanningAnalysis{
+		{ID: Int64(1), SarifID: String("abc")},
+		{ID: Int64(2), SarifID: String("abc")},
+	}
+	if !cmp.Equal(analyses, want) {
+		t.Errorf("CodeScanning.WaitForSARIFProcessing returned %+v, want %+v", analyses, want)
+	}
+	if polls != 3 {
+		t.Errorf("CodeScanning.WaitForSARIFProcessing polled %v times, want 3", polls)
+	}
+
+	const methodName = "WaitForSARIFProcessing"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.WaitForSARIFProcessing(ctx, "\n", "\n", "\n")
+		return err
+	})
+}
+
+func TestCodeScanningService_WaitForSARIFProcessing_failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"processing_status":"failed","errors":["invalid SARIF: missing runs"]}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.CodeScanning.WaitForSARIFProcessing(ctx, "o", "r", "abc")
+	var perr *SARIFProcessingError
+	if !errors.As(err, &perr) {
+		t.Fatalf("CodeScanning.WaitForSARIFProcessing returned error %v, want *SARIFProcessingError", err)
+	}
+
+	want := &SARIFProcessingError{SarifID: "abc", Errors: []string{"invalid SARIF: missing runs"}}
+	if !cmp.Equal(perr, want) {
+		t.Errorf("CodeScanning.WaitForSARIFProcessing returned %+v, want %+v", perr, want)
+	}
+	if got, want := perr.Error(), "processing of SARIF upload abc failed: invalid SARIF: missing runs"; got != want {
+		t.Errorf("SARIFProcessingError.Error = %q, want %q", got, want)
+	}
+}
+
+func TestCodeScanningService_WaitForSARIFProcessing_contextDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(initial time.Duration) { sarifPollInitialInterval = initial }(sarifPollInitialInterval)
+	sarifPollInitialInterval = time.Millisecond
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/abc", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"processing_status":"pending"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.CodeScanning.WaitForSARIFProcessing(ctx, "o", "r", "abc"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("CodeScanning.WaitForSARIFProcessing returned error %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -89,6 +90,72 @@ func TestCodeScanningService_UploadSarif(t *testing.T) {
 	})
 }
 
+func TestCodeScanningService_ListAlertsForEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"state":     "open",
+			"tool_name": "CodeQL",
+			"severity":  "high",
+			"sort":      "updated",
+			"direction": "asc",
+			"after":     "Y3Vyc29y",
+			"per_page":  "50",
+		})
+		fmt.Fprint(w, `[{
+			"number": 25,
+			"repository": {"id": 1, "full_name": "o/r"},
+			"rule": {"id": "js/trivial-conditional", "security_severity_level": "high"},
+			"tool": {"name": "CodeQL", "version": "1.4.0"},
+			"state": "open",
+			"html_url": "https://github.com/o/r/security/code-scanning/25"
+		}]`)
+	})
+
+	opts := &AlertListOptions{
+		State:             "open",
+		ToolName:          "CodeQL",
+		Severity:          "high",
+		Sort:              "updated",
+		Direction:         "asc",
+		ListCursorOptions: ListCursorOptions{After: "Y3Vyc29y", PerPage: 50},
+	}
+	ctx := context.Background()
+	alerts, _, err := client.CodeScanning.ListAlertsForEnterprise(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("CodeScanning.ListAlertsForEnterprise returned error: %v", err)
+	}
+
+	want := []*Alert{{
+		Number:     Int(25),
+		Repository: &Repository{ID: Int64(1), FullName: String("o/r")},
+		Rule:       &Rule{ID: String("js/trivial-conditional"), SecuritySeverityLevel: String("high")},
+		Tool:       &Tool{Name: String("CodeQL"), Version: String("1.4.0")},
+		State:      String("open"),
+		HTMLURL:    String("https://github.com/o/r/security/code-scanning/25"),
+	}}
+	if !cmp.Equal(alerts, want) {
+		t.Errorf("CodeScanning.ListAlertsForEnterprise returned %+v, want %+v", alerts, want)
+	}
+
+	const methodName = "ListAlertsForEnterprise"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.ListAlertsForEnterprise(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.ListAlertsForEnterprise(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestCodeScanningService_ListAlertsForOrg(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1177,3 +1244,185 @@ func TestCodeScanningService_GetAnalysis(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestCodeScanningService_GetDefaultSetupConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/default-setup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"state": "configured",
+			"languages": ["javascript", "python"],
+			"query_suite": "default",
+			"schedule": "weekly",
+			"updated_at": `+referenceTimeStr+`
+		}`)
+	})
+
+	ctx := context.Background()
+	cfg, _, err := client.CodeScanning.GetDefaultSetupConfiguration(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("CodeScanning.GetDefaultSetupConfiguration returned error: %v", err)
+	}
+
+	want := &DefaultSetupConfiguration{
+		State:      String("configured"),
+		Languages:  []string{"javascript", "python"},
+		QuerySuite: String("default"),
+		Schedule:   String("weekly"),
+		UpdatedAt:  &Timestamp{referenceTime},
+	}
+	if !cmp.Equal(cfg, want) {
+		t.Errorf("CodeScanning.GetDefaultSetupConfiguration returned %+v, want %+v", cfg, want)
+	}
+
+	const methodName = "GetDefaultSetupConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.GetDefaultSetupConfiguration(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.GetDefaultSetupConfiguration(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_UpdateDefaultSetupConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/default-setup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"configured","query_suite":"extended","languages":["go"]}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"run_id": 5301214200, "run_url": "https://api.github.com/repos/o/r/actions/runs/5301214200"}`)
+	})
+
+	ctx := context.Background()
+	options := &UpdateDefaultSetupConfigurationOptions{
+		State:      "configured",
+		QuerySuite: String("extended"),
+		Languages:  []string{"go"},
+	}
+	got, _, err := client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, "o", "r", options)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned error %v, want *AcceptedError", err)
+	}
+
+	want := &UpdateDefaultSetupConfigurationResponse{
+		RunID:  Int64(5301214200),
+		RunURL: String("https://api.github.com/repos/o/r/actions/runs/5301214200"),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "UpdateDefaultSetupConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, "o", "r", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_DeleteAnalysis(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses/40", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testFormValues(t, r, values{"confirm_delete": "true"})
+		fmt.Fprint(w, `{
+			"next_analysis_url": "https://api.github.com/repos/o/r/code-scanning/analyses/41",
+			"confirm_delete_url": "https://api.github.com/repos/o/r/code-scanning/analyses/41?confirm_delete"
+		}`)
+	})
+
+	ctx := context.Background()
+	opts := &DeleteAnalysisOptions{ConfirmDelete: true}
+	analysis, _, err := client.CodeScanning.DeleteAnalysis(ctx, "o", "r", 40, opts)
+	if err != nil {
+		t.Errorf("CodeScanning.DeleteAnalysis returned error: %v", err)
+	}
+
+	want := &DeleteAnalysis{
+		NextAnalysisURL:  String("https://api.github.com/repos/o/r/code-scanning/analyses/41"),
+		ConfirmDeleteURL: String("https://api.github.com/repos/o/r/code-scanning/analyses/41?confirm_delete"),
+	}
+	if !cmp.Equal(analysis, want) {
+		t.Errorf("CodeScanning.DeleteAnalysis returned %+v, want %+v", analysis, want)
+	}
+
+	const methodName = "DeleteAnalysis"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.DeleteAnalysis(ctx, "\n", "\n", -123, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.DeleteAnalysis(ctx, "o", "r", 40, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_DeleteAnalysisSeries(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *DeleteAnalysisOptions
+		deleted []string
+	}{
+		{name: "without confirmation", deleted: []string{"1", "2"}},
+		{name: "with confirmation", opts: &DeleteAnalysisOptions{ConfirmDelete: true}, deleted: []string{"1", "2", "3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, serverURL, teardown := setup()
+			defer teardown()
+
+			base := serverURL + baseURLPath + "/repos/o/r/code-scanning/analyses/"
+			var deleted []string
+			mux.HandleFunc("/repos/o/r/code-scanning/analyses/", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				id := strings.TrimPrefix(r.URL.Path, "/repos/o/r/code-scanning/analyses/")
+				deleted = append(deleted, id)
+				switch id {
+				case "1":
+					fmt.Fprintf(w, `{"next_analysis_url":%q,"confirm_delete_url":%q}`, base+"2", base+"2?confirm_delete=true")
+				case "2":
+					// Analysis 3 is the last of the set.
+					fmt.Fprintf(w, `{"next_analysis_url":null,"confirm_delete_url":%q}`, base+"3?confirm_delete=true")
+				default:
+					fmt.Fprint(w, `{"next_analysis_url":null,"confirm_delete_url":null}`)
+				}
+			})
+
+			ctx := context.Background()
+			n, _, err := client.CodeScanning.DeleteAnalysisSeries(ctx, "o", "r", 1, tt.opts)
+			if err != nil {
+				t.Fatalf("CodeScanning.DeleteAnalysisSeries returned error: %v", err)
+			}
+			if n != len(tt.deleted) {
+				t.Errorf("CodeScanning.DeleteAnalysisSeries returned %v, want %v", n, len(tt.deleted))
+			}
+			if !cmp.Equal(deleted, tt.deleted) {
+				t.Errorf("CodeScanning.DeleteAnalysisSeries deleted %v, want %v", deleted, tt.deleted)
+			}
+		})
+	}
+}
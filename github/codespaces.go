@@ -0,0 +1,352 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CodespacesService handles communication with the Codespaces related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/
+type CodespacesService service
+
+// Codespace represents a codespace.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces
+type Codespace struct {
+	ID          *int64  `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	// EnvironmentID is the ID of the codespace environment, if any.
+	EnvironmentID    *string            `json:"environment_id,omitempty"`
+	Owner            *User              `json:"owner,omitempty"`
+	BillableOwner    *User              `json:"billable_owner,omitempty"`
+	Repository       *Repository        `json:"repository,omitempty"`
+	Machine          *CodespacesMachine `json:"machine,omitempty"`
+	DevcontainerPath *string            `json:"devcontainer_path,omitempty"`
+	// Prebuild reports whether the codespace was created from a prebuild.
+	Prebuild   *bool      `json:"prebuild,omitempty"`
+	CreatedAt  *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt  *Timestamp `json:"updated_at,omitempty"`
+	LastUsedAt *Timestamp `json:"last_used_at,omitempty"`
+	// State is the state of the codespace, for example Available,
+	// Shutdown or Starting.
+	State     *string              `json:"state,omitempty"`
+	URL       *string              `json:"url,omitempty"`
+	GitStatus *CodespacesGitStatus `json:"git_status,omitempty"`
+	// Location is the geographic area the codespace is hosted in, one of
+	// EastUs, SouthEastAsia, WestEurope or WestUs2.
+	Location                       *string                       `json:"location,omitempty"`
+	IdleTimeoutMinutes             *int                          `json:"idle_timeout_minutes,omitempty"`
+	WebURL                         *string                       `json:"web_url,omitempty"`
+	MachinesURL                    *string                       `json:"machines_url,omitempty"`
+	StartURL                       *string                       `json:"start_url,omitempty"`
+	StopURL                        *string                       `json:"stop_url,omitempty"`
+	PublishURL                     *string                       `json:"publish_url,omitempty"`
+	PullsURL                       *string                       `json:"pulls_url,omitempty"`
+	RecentFolders                  []string                      `json:"recent_folders,omitempty"`
+	RuntimeConstraints             *CodespacesRuntimeConstraints `json:"runtime_constraints,omitempty"`
+	PendingOperation               *bool                         `json:"pending_operation,omitempty"`
+	PendingOperationDisabledReason *string                       `json:"pending_operation_disabled_reason,omitempty"`
+	IdleTimeoutNotice              *string                       `json:"idle_timeout_notice,omitempty"`
+	RetentionPeriodMinutes         *int                          `json:"retention_period_minutes,omitempty"`
+	RetentionExpiresAt             *Timestamp                    `json:"retention_expires_at,omitempty"`
+	LastKnownStopNotice            *string                       `json:"last_known_stop_notice,omitempty"`
+}
+
+// CodespacesGitStatus represents the git status of a codespace.
+type CodespacesGitStatus struct {
+	Ahead                 *int    `json:"ahead,omitempty"`
+	Behind                *int    `json:"behind,omitempty"`
+	HasUnpushedChanges    *bool   `json:"has_unpushed_changes,omitempty"`
+	HasUncommittedChanges *bool   `json:"has_uncommitted_changes,omitempty"`
+	Ref                   *string `json:"ref,omitempty"`
+}
+
+// CodespacesMachine represents the machine type of a codespace.
+type CodespacesMachine struct {
+	Name            *string `json:"name,omitempty"`
+	DisplayName     *string `json:"display_name,omitempty"`
+	OperatingSystem *string `json:"operating_system,omitempty"`
+	StorageInBytes  *int64  `json:"storage_in_bytes,omitempty"`
+	MemoryInBytes   *int64  `json:"memory_in_bytes,omitempty"`
+	CPUs            *int    `json:"cpus,omitempty"`
+	// PrebuildAvailability is one of none, ready or in_progress.
+	PrebuildAvailability *string `json:"prebuild_availability,omitempty"`
+}
+
+// CodespacesRuntimeConstraints represents the runtime constraints of a codespace.
+type CodespacesRuntimeConstraints struct {
+	AllowedPortPrivacySettings []string `json:"allowed_port_privacy_settings,omitempty"`
+}
+
+// ListCodespaces represents the response from the list codespaces endpoints.
+type ListCodespaces struct {
+	TotalCount *int         `json:"total_count,omitempty"`
+	Codespaces []*Codespace `json:"codespaces"`
+}
+
+// ListCodespacesOptions represents the options for listing codespaces for a user.
+type ListCodespacesOptions struct {
+	ListOptions
+	// RepositoryID only lists the codespaces of this repository.
+	RepositoryID int64 `url:"repository_id,omitempty"`
+}
+
+// List lists the codespaces of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#list-codespaces-for-the-authenticated-user
+func (s *CodespacesService) List(ctx context.Context, opts *ListCodespacesOptions) (*ListCodespaces, *Response, error) {
+	u, err := addOptions("user/codespaces", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var codespaces *ListCodespaces
+	resp, err := s.client.Do(ctx, req, &codespaces)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return codespaces, resp, nil
+}
+
+// CreateCodespaceOptions represents the options for creating a codespace.
+type CreateCodespaceOptions struct {
+	// Ref is the git ref to create the codespace from. It is ignored when
+	// creating a codespace for a pull request.
+	Ref *string `json:"ref,omitempty"`
+	// Geo is the geographic area to create the codespace in, one of
+	// EuropeWest, SoutheastAsia, UsEast or UsWest. If not set, it is
+	// chosen from the IP address of the request, or of ClientIP.
+	Geo      *string `json:"geo,omitempty"`
+	ClientIP *string `json:"client_ip,omitempty"`
+	// Machine is the name of the machine type, as listed for the repository.
+	Machine *string `json:"machine,omitempty"`
+	// DevcontainerPath is the path to the devcontainer.json configuration
+	// to use, for example ".devcontainer/example/devcontainer.json".
+	DevcontainerPath           *string `json:"devcontainer_path,omitempty"`
+	MultiRepoPermissionsOptOut *bool   `json:"multi_repo_permissions_opt_out,omitempty"`
+	WorkingDirectory           *string `json:"working_directory,omitempty"`
+	IdleTimeoutMinutes         *int    `json:"idle_timeout_minutes,omitempty"`
+	DisplayName                *string `json:"display_name,omitempty"`
+	// RetentionPeriodMinutes is how long the codespace is kept after it is
+	// stopped, up to 43200 (30 days).
+	RetentionPeriodMinutes *int `json:"retention_period_minutes,omitempty"`
+}
+
+// CreateInRepo creates a codespace in a repository for the authenticated user.
+//
+// This method might return an *AcceptedError and a status code of 202.
+// This is because this is the status that GitHub returns to signify that
+// it is still creating the codespace in a background task. In this event,
+// the Codespace value will be returned, which includes the details about
+// the codespace being created.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#create-a-codespace-in-a-repository
+func (s *CodespacesService) CreateInRepo(ctx context.Context, owner, repo string, opts *CreateCodespaceOptions) (*Codespace, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/codespaces", owner, repo)
+	return s.createCodespace(ctx, u, opts)
+}
+
+// CreateFromPullRequest creates a codespace for the authenticated user from
+// the head branch of a pull request.
+//
+// This method might return an *AcceptedError and a status code of 202,
+// as CreateInRepo does.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#create-a-codespace-from-a-pull-request
+func (s *CodespacesService) CreateFromPullRequest(ctx context.Context, owner, repo string, number int, opts *CreateCodespaceOptions) (*Codespace, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pulls/%v/codespaces", owner, repo, number)
+	return s.createCodespace(ctx, u, opts)
+}
+
+func (s *CodespacesService) createCodespace(ctx context.Context, u string, opts *CreateCodespaceOptions) (*Codespace, *Response, error) {
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codespace := new(Codespace)
+	resp, err := s.client.Do(ctx, req, codespace)
+	if err != nil {
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, codespace); err != nil {
+				return codespace, resp, err
+			}
+
+			return codespace, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return codespace, resp, nil
+}
+
+// Get gets a codespace of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#get-a-codespace-for-the-authenticated-user
+func (s *CodespacesService) Get(ctx context.Context, codespaceName string) (*Codespace, *Response, error) {
+	u := fmt.Sprintf("user/codespaces/%v", codespaceName)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codespace := new(Codespace)
+	resp, err := s.client.Do(ctx, req, codespace)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return codespace, resp, nil
+}
+
+// Start starts a codespace of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#start-a-codespace-for-the-authenticated-user
+func (s *CodespacesService) Start(ctx context.Context, codespaceName string) (*Codespace, *Response, error) {
+	u := fmt.Sprintf("user/codespaces/%v/start", codespaceName)
+	return s.postCodespace(ctx, u, nil)
+}
+
+// Stop stops a codespace of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#stop-a-codespace-for-the-authenticated-user
+func (s *CodespacesService) Stop(ctx context.Context, codespaceName string) (*Codespace, *Response, error) {
+	u := fmt.Sprintf("user/codespaces/%v/stop", codespaceName)
+	return s.postCodespace(ctx, u, nil)
+}
+
+// PublishCodespaceOptions represents the options for publishing a codespace.
+type PublishCodespaceOptions struct {
+	// Name is the name of the new repository.
+	Name *string `json:"name,omitempty"`
+	// Private reports whether the new repository is private. Defaults to false.
+	Private *bool `json:"private,omitempty"`
+}
+
+// Publish publishes an unpublished codespace of the authenticated user,
+// created from a template, to a new repository. The codespace is then
+// connected to the new repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#create-a-repository-from-an-unpublished-codespace
+func (s *CodespacesService) Publish(ctx context.Context, codespaceName string, opts *PublishCodespaceOptions) (*Codespace, *Response, error) {
+	u := fmt.Sprintf("user/codespaces/%v/publish", codespaceName)
+	return s.postCodespace(ctx, u, opts)
+}
+
+func (s *CodespacesService) postCodespace(ctx context.Context, u string, body interface{}) (*Codespace, *Response, error) {
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codespace := new(Codespace)
+	resp, err := s.client.Do(ctx, req, codespace)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return codespace, resp, nil
+}
+
+// Delete deletes a codespace of the authenticated user. GitHub deletes the
+// codespace asynchronously.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#delete-a-codespace-for-the-authenticated-user
+func (s *CodespacesService) Delete(ctx context.Context, codespaceName string) (*Response, error) {
+	u := fmt.Sprintf("user/codespaces/%v", codespaceName)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		if _, ok := err.(*AcceptedError); ok {
+			return resp, nil
+		}
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// CodespaceExport represents the export of the unpushed changes of a
+// codespace to a branch.
+type CodespaceExport struct {
+	ID *string `json:"id,omitempty"`
+	// State is one of succeeded, failed, in_progress or null.
+	State       *string    `json:"state,omitempty"`
+	CompletedAt *Timestamp `json:"completed_at,omitempty"`
+	Branch      *string    `json:"branch,omitempty"`
+	SHA         *string    `json:"sha,omitempty"`
+	ExportURL   *string    `json:"export_url,omitempty"`
+	HTMLURL     *string    `json:"html_url,omitempty"`
+}
+
+// Export exports the unpushed changes of a codespace of the authenticated
+// user to a branch. The export runs in the background; use GetExport to
+// follow its state. The codespace is stopped if needed.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#export-a-codespace-for-the-authenticated-user
+func (s *CodespacesService) Export(ctx context.Context, codespaceName string) (*CodespaceExport, *Response, error) {
+	u := fmt.Sprintf("user/codespaces/%v/exports", codespaceName)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	export := new(CodespaceExport)
+	resp, err := s.client.Do(ctx, req, export)
+	if err != nil {
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, export); err != nil {
+				return nil, resp, err
+			}
+
+			return export, resp, nil
+		}
+		return nil, resp, err
+	}
+
+	return export, resp, nil
+}
+
+// GetExport gets the details of an export of a codespace of the
+// authenticated user. Use "latest" as exportID to get the latest export.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#get-details-about-a-codespace-export
+func (s *CodespacesService) GetExport(ctx context.Context, codespaceName, exportID string) (*CodespaceExport, *Response, error) {
+	u := fmt.Sprintf("user/codespaces/%v/exports/%v", codespaceName, exportID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	export := new(CodespaceExport)
+	resp, err := s.client.Do(ctx, req, export)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return export, resp, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// CodespacesMachines represents the machine types available for a codespace.
+type CodespacesMachines struct {
+	TotalCount int                  `json:"total_count"`
+	Machines   []*CodespacesMachine `json:"machines"`
+}
+
+// ListMachineTypesOptions specifies optional parameters to the
+// CodespacesService.ListMachineTypesForRepo method.
+type ListMachineTypesOptions struct {
+	// Location is the location to check for available machines, for
+	// example WestUs2. If not set, it is chosen from the IP address of the
+	// request, or of ClientIP.
+	Location string `url:"location,omitempty"`
+	ClientIP string `url:"client_ip,omitempty"`
+	// Ref is the branch or commit to check for prebuild availability and
+	// devcontainer restrictions.
+	Ref string `url:"ref,omitempty"`
+}
+
+// ListMachineTypesForRepo lists the machine types a codespace of a
+// repository can use, and whether a prebuild is available for each.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/machines#list-available-machine-types-for-a-repository
+func (s *CodespacesService) ListMachineTypesForRepo(ctx context.Context, owner, repo string, opts *ListMachineTypesOptions) (*CodespacesMachines, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/codespaces/machines", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.listMachineTypes(ctx, u)
+}
+
+// ListMachineTypesForCodespace lists the machine types a codespace of the
+// authenticated user can transition to.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/machines#list-machine-types-for-a-codespace
+func (s *CodespacesService) ListMachineTypesForCodespace(ctx context.Context, codespaceName string) (*CodespacesMachines, *Response, error) {
+	u := fmt.Sprintf("user/codespaces/%v/machines", codespaceName)
+	return s.listMachineTypes(ctx, u)
+}
+
+func (s *CodespacesService) listMachineTypes(ctx context.Context, u string) (*CodespacesMachines, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	machines := new(CodespacesMachines)
+	resp, err := s.client.Do(ctx, req, machines)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return machines, resp, nil
+}
+
+// CodespacesDevcontainer represents a devcontainer.json configuration of a repository.
+type CodespacesDevcontainer struct {
+	Path        *string `json:"path,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+}
+
+// CodespacesDevcontainers represents the devcontainer.json configurations of a repository.
+type CodespacesDevcontainers struct {
+	TotalCount    int                       `json:"total_count"`
+	Devcontainers []*CodespacesDevcontainer `json:"devcontainers"`
+}
+
+// ListDevcontainersForRepo lists the devcontainer.json configurations of
+// the default branch of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#list-devcontainer-configurations-in-a-repository-for-the-authenticated-user
+func (s *CodespacesService) ListDevcontainersForRepo(ctx context.Context, owner, repo string, opts *ListOptions) (*CodespacesDevcontainers, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/codespaces/devcontainers", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	devcontainers := new(CodespacesDevcontainers)
+	resp, err := s.client.Do(ctx, req, devcontainers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return devcontainers, resp, nil
+}
+
+// CodespaceDefaultAttributes represents the attributes a new codespace of
+// a repository gets by default.
+type CodespaceDefaultAttributes struct {
+	BillableOwner *User              `json:"billable_owner,omitempty"`
+	Defaults      *CodespaceDefaults `json:"defaults,omitempty"`
+}
+
+// CodespaceDefaults represents the default location and devcontainer of a
+// new codespace.
+type CodespaceDefaults struct {
+	Location         *string `json:"location,omitempty"`
+	DevcontainerPath *string `json:"devcontainer_path,omitempty"`
+}
+
+// GetDefaultAttributesOptions specifies optional parameters to the
+// CodespacesService.GetDefaultAttributes method.
+type GetDefaultAttributesOptions struct {
+	// Ref is the branch or commit the codespace would be created from.
+	Ref      string `url:"ref,omitempty"`
+	ClientIP string `url:"client_ip,omitempty"`
+}
+
+// GetDefaultAttributes gets the default attributes a new codespace of a
+// repository would get for the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/codespaces#get-default-attributes-for-a-codespace
+func (s *CodespacesService) GetDefaultAttributes(ctx context.Context, owner, repo string, opts *GetDefaultAttributesOptions) (*CodespaceDefaultAttributes, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/codespaces/new", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attributes := new(CodespaceDefaultAttributes)
+	resp, err := s.client.Do(ctx, req, attributes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return attributes, resp, nil
+}
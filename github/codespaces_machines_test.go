@@ -0,0 +1,197 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodespacesService_ListMachineTypesForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces/machines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"location": "WestUs2", "ref": "main"})
+		fmt.Fprint(w, `{"total_count":1,"machines":[{
+			"name": "standardLinux",
+			"display_name": "4 cores, 8 GB RAM, 64 GB storage",
+			"operating_system": "linux",
+			"storage_in_bytes": 68719476736,
+			"memory_in_bytes": 8589934592,
+			"cpus": 4,
+			"prebuild_availability": "ready"
+		}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListMachineTypesOptions{Location: "WestUs2", Ref: "main"}
+	machines, _, err := client.Codespaces.ListMachineTypesForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListMachineTypesForRepo returned error: %v", err)
+	}
+
+	want := &CodespacesMachines{
+		TotalCount: 1,
+		Machines: []*CodespacesMachine{
+			{
+				Name:                 String("standardLinux"),
+				DisplayName:          String("4 cores, 8 GB RAM, 64 GB storage"),
+				OperatingSystem:      String("linux"),
+				StorageInBytes:       Int64(68719476736),
+				MemoryInBytes:        Int64(8589934592),
+				CPUs:                 Int(4),
+				PrebuildAvailability: String("ready"),
+			},
+		},
+	}
+	if !cmp.Equal(machines, want) {
+		t.Errorf("Codespaces.ListMachineTypesForRepo returned %+v, want %+v", machines, want)
+	}
+
+	const methodName = "ListMachineTypesForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListMachineTypesForRepo(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListMachineTypesForRepo(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_ListMachineTypesForCodespace(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/c/machines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"machines":[{"name":"premiumLinux","prebuild_availability":"none"}]}`)
+	})
+
+	ctx := context.Background()
+	machines, _, err := client.Codespaces.ListMachineTypesForCodespace(ctx, "c")
+	if err != nil {
+		t.Errorf("Codespaces.ListMachineTypesForCodespace returned error: %v", err)
+	}
+
+	want := &CodespacesMachines{
+		TotalCount: 1,
+		Machines:   []*CodespacesMachine{{Name: String("premiumLinux"), PrebuildAvailability: String("none")}},
+	}
+	if !cmp.Equal(machines, want) {
+		t.Errorf("Codespaces.ListMachineTypesForCodespace returned %+v, want %+v", machines, want)
+	}
+
+	const methodName = "ListMachineTypesForCodespace"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListMachineTypesForCodespace(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListMachineTypesForCodespace(ctx, "c")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_ListDevcontainersForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces/devcontainers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"devcontainers":[{"path":".devcontainer/foobar/devcontainer.json","name":"foobar","display_name":"foobar"},{"path":".devcontainer/devcontainer.json"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2}
+	devcontainers, _, err := client.Codespaces.ListDevcontainersForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListDevcontainersForRepo returned error: %v", err)
+	}
+
+	want := &CodespacesDevcontainers{
+		TotalCount: 2,
+		Devcontainers: []*CodespacesDevcontainer{
+			{Path: String(".devcontainer/foobar/devcontainer.json"), Name: String("foobar"), DisplayName: String("foobar")},
+			{Path: String(".devcontainer/devcontainer.json")},
+		},
+	}
+	if !cmp.Equal(devcontainers, want) {
+		t.Errorf("Codespaces.ListDevcontainersForRepo returned %+v, want %+v", devcontainers, want)
+	}
+
+	const methodName = "ListDevcontainersForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListDevcontainersForRepo(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListDevcontainersForRepo(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_GetDefaultAttributes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces/new", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "main", "client_ip": "1.2.3.4"})
+		fmt.Fprint(w, `{"billable_owner":{"login":"octocat"},"defaults":{"location":"EastUs","devcontainer_path":".devcontainer/devcontainer.json"}}`)
+	})
+
+	ctx := context.Background()
+	opts := &GetDefaultAttributesOptions{Ref: "main", ClientIP: "1.2.3.4"}
+	attributes, _, err := client.Codespaces.GetDefaultAttributes(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Codespaces.GetDefaultAttributes returned error: %v", err)
+	}
+
+	want := &CodespaceDefaultAttributes{
+		BillableOwner: &User{Login: String("octocat")},
+		Defaults: &CodespaceDefaults{
+			Location:         String("EastUs"),
+			DevcontainerPath: String(".devcontainer/devcontainer.json"),
+		},
+	}
+	if !cmp.Equal(attributes, want) {
+		t.Errorf("Codespaces.GetDefaultAttributes returned %+v, want %+v", attributes, want)
+	}
+
+	const methodName = "GetDefaultAttributes"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.GetDefaultAttributes(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.GetDefaultAttributes(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
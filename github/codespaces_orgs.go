@@ -0,0 +1,137 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListInOrg lists the codespaces of all members of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organizations#list-codespaces-for-the-organization
+func (s *CodespacesService) ListInOrg(ctx context.Context, org string, opts *ListOptions) (*ListCodespaces, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/codespaces", org)
+	return s.listCodespaces(ctx, u, opts)
+}
+
+// ListUserCodespacesInOrg lists the codespaces of a member of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organizations#list-codespaces-for-a-user-in-organization
+func (s *CodespacesService) ListUserCodespacesInOrg(ctx context.Context, org, username string, opts *ListOptions) (*ListCodespaces, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/members/%v/codespaces", org, username)
+	return s.listCodespaces(ctx, u, opts)
+}
+
+func (s *CodespacesService) listCodespaces(ctx context.Context, u string, opts *ListOptions) (*ListCodespaces, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var codespaces *ListCodespaces
+	resp, err := s.client.Do(ctx, req, &codespaces)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return codespaces, resp, nil
+}
+
+// StopUserCodespaceInOrg stops a codespace of a member of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organizations#stop-a-codespace-for-an-organization-user
+func (s *CodespacesService) StopUserCodespaceInOrg(ctx context.Context, org, username, codespaceName string) (*Codespace, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/members/%v/codespaces/%v/stop", org, username, codespaceName)
+	return s.postCodespace(ctx, u, nil)
+}
+
+// DeleteUserCodespaceInOrg deletes a codespace of a member of an
+// organization. GitHub deletes the codespace asynchronously.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organizations#delete-a-codespace-from-the-organization
+func (s *CodespacesService) DeleteUserCodespaceInOrg(ctx context.Context, org, username, codespaceName string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/members/%v/codespaces/%v", org, username, codespaceName)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		if _, ok := err.(*AcceptedError); ok {
+			return resp, nil
+		}
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// CodespacesOrgAccessControlRequest represents the request to set which
+// members of an organization can use codespaces billed to it.
+type CodespacesOrgAccessControlRequest struct {
+	// Visibility is one of disabled, selected_members, all_members or
+	// all_members_and_outside_collaborators.
+	Visibility string `json:"visibility"`
+	// SelectedUsernames are the members allowed to use codespaces billed to
+	// the organization. Required when Visibility is selected_members.
+	SelectedUsernames []string `json:"selected_usernames,omitempty"`
+}
+
+// SetOrgAccessControl sets which users can use codespaces billed to an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organizations#manage-access-control-for-organization-codespaces
+func (s *CodespacesService) SetOrgAccessControl(ctx context.Context, org string, request CodespacesOrgAccessControlRequest) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/codespaces/access", org)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AddUsersToOrgAccess adds users to the members allowed to use codespaces
+// billed to an organization. The organization must allow selected members.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organizations#add-users-to-codespaces-access-for-an-organization
+func (s *CodespacesService) AddUsersToOrgAccess(ctx context.Context, org string, usernames []string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/codespaces/access/selected_users", org)
+	return s.setOrgAccessUsers(ctx, "POST", u, usernames)
+}
+
+// RemoveUsersFromOrgAccess removes users from the members allowed to use
+// codespaces billed to an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organizations#remove-users-from-codespaces-access-for-an-organization
+func (s *CodespacesService) RemoveUsersFromOrgAccess(ctx context.Context, org string, usernames []string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/codespaces/access/selected_users", org)
+	return s.setOrgAccessUsers(ctx, "DELETE", u, usernames)
+}
+
+func (s *CodespacesService) setOrgAccessUsers(ctx context.Context, method, u string, usernames []string) (*Response, error) {
+	body := struct {
+		SelectedUsernames []string `json:"selected_usernames"`
+	}{
+		SelectedUsernames: usernames,
+	}
+
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,242 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodespacesService_ListInOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"codespaces":[{"id":1,"owner":{"login":"u1"}},{"id":2,"owner":{"login":"u2"}}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2}
+	codespaces, _, err := client.Codespaces.ListInOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListInOrg returned error: %v", err)
+	}
+
+	want := &ListCodespaces{
+		TotalCount: Int(2),
+		Codespaces: []*Codespace{
+			{ID: Int64(1), Owner: &User{Login: String("u1")}},
+			{ID: Int64(2), Owner: &User{Login: String("u2")}},
+		},
+	}
+	if !cmp.Equal(codespaces, want) {
+		t.Errorf("Codespaces.ListInOrg returned %+v, want %+v", codespaces, want)
+	}
+
+	const methodName = "ListInOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListInOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListInOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_ListUserCodespacesInOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members/u/codespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `{"total_count":1,"codespaces":[{"id":1,"owner":{"login":"u"}}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{PerPage: 1}
+	codespaces, _, err := client.Codespaces.ListUserCodespacesInOrg(ctx, "o", "u", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListUserCodespacesInOrg returned error: %v", err)
+	}
+
+	want := &ListCodespaces{
+		TotalCount: Int(1),
+		Codespaces: []*Codespace{{ID: Int64(1), Owner: &User{Login: String("u")}}},
+	}
+	if !cmp.Equal(codespaces, want) {
+		t.Errorf("Codespaces.ListUserCodespacesInOrg returned %+v, want %+v", codespaces, want)
+	}
+
+	const methodName = "ListUserCodespacesInOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListUserCodespacesInOrg(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListUserCodespacesInOrg(ctx, "o", "u", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_StopUserCodespaceInOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members/u/codespaces/c/stop", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":1,"state":"ShuttingDown"}`)
+	})
+
+	ctx := context.Background()
+	codespace, _, err := client.Codespaces.StopUserCodespaceInOrg(ctx, "o", "u", "c")
+	if err != nil {
+		t.Errorf("Codespaces.StopUserCodespaceInOrg returned error: %v", err)
+	}
+
+	want := &Codespace{ID: Int64(1), State: String("ShuttingDown")}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.StopUserCodespaceInOrg returned %+v, want %+v", codespace, want)
+	}
+
+	const methodName = "StopUserCodespaceInOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.StopUserCodespaceInOrg(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.StopUserCodespaceInOrg(ctx, "o", "u", "c")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_DeleteUserCodespaceInOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members/u/codespaces/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.DeleteUserCodespaceInOrg(ctx, "o", "u", "c")
+	if err != nil {
+		t.Errorf("Codespaces.DeleteUserCodespaceInOrg returned error: %v", err)
+	}
+
+	const methodName = "DeleteUserCodespaceInOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.DeleteUserCodespaceInOrg(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.DeleteUserCodespaceInOrg(ctx, "o", "u", "c")
+	})
+}
+
+func TestCodespacesService_SetOrgAccessControl(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/access", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"visibility":"selected_members","selected_usernames":["u1","u2"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	request := CodespacesOrgAccessControlRequest{Visibility: "selected_members", SelectedUsernames: []string{"u1", "u2"}}
+	_, err := client.Codespaces.SetOrgAccessControl(ctx, "o", request)
+	if err != nil {
+		t.Errorf("Codespaces.SetOrgAccessControl returned error: %v", err)
+	}
+
+	const methodName = "SetOrgAccessControl"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.SetOrgAccessControl(ctx, "\n", request)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.SetOrgAccessControl(ctx, "o", request)
+	})
+}
+
+func TestCodespacesService_AddUsersToOrgAccess(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/access/selected_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"selected_usernames":["u1","u2"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.AddUsersToOrgAccess(ctx, "o", []string{"u1", "u2"})
+	if err != nil {
+		t.Errorf("Codespaces.AddUsersToOrgAccess returned error: %v", err)
+	}
+
+	const methodName = "AddUsersToOrgAccess"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.AddUsersToOrgAccess(ctx, "\n", []string{"u1", "u2"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.AddUsersToOrgAccess(ctx, "o", []string{"u1", "u2"})
+	})
+}
+
+func TestCodespacesService_RemoveUsersFromOrgAccess(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/access/selected_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"selected_usernames":["u1","u2"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.RemoveUsersFromOrgAccess(ctx, "o", []string{"u1", "u2"})
+	if err != nil {
+		t.Errorf("Codespaces.RemoveUsersFromOrgAccess returned error: %v", err)
+	}
+
+	const methodName = "RemoveUsersFromOrgAccess"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.RemoveUsersFromOrgAccess(ctx, "\n", []string{"u1", "u2"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.RemoveUsersFromOrgAccess(ctx, "o", []string{"u1", "u2"})
+	})
+}
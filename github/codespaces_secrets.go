@@ -0,0 +1,325 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *CodespacesService) getPublicKey(ctx context.Context, url string) (*PublicKey, *Response, error) {
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey := new(PublicKey)
+	resp, err := s.client.Do(ctx, req, pubKey)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pubKey, resp, nil
+}
+
+// GetUserPublicKey gets the public key of the authenticated user to encrypt Codespaces secrets with.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#get-public-key-for-the-authenticated-user
+func (s *CodespacesService) GetUserPublicKey(ctx context.Context) (*PublicKey, *Response, error) {
+	url := "user/codespaces/secrets/public-key"
+	return s.getPublicKey(ctx, url)
+}
+
+// GetOrgPublicKey gets the public key of an organization to encrypt Codespaces secrets with.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#get-an-organization-public-key
+func (s *CodespacesService) GetOrgPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets/public-key", org)
+	return s.getPublicKey(ctx, url)
+}
+
+// GetRepoPublicKey gets the public key of a repository to encrypt Codespaces secrets with.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/repository-secrets#get-a-repository-public-key
+func (s *CodespacesService) GetRepoPublicKey(ctx context.Context, owner, repo string) (*PublicKey, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/codespaces/secrets/public-key", owner, repo)
+	return s.getPublicKey(ctx, url)
+}
+
+func (s *CodespacesService) listSecrets(ctx context.Context, url string, opts *ListOptions) (*Secrets, *Response, error) {
+	u, err := addOptions(url, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secrets := new(Secrets)
+	resp, err := s.client.Do(ctx, req, secrets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return secrets, resp, nil
+}
+
+// ListUserSecrets lists the Codespaces secrets of the authenticated user without their values.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#list-secrets-for-the-authenticated-user
+func (s *CodespacesService) ListUserSecrets(ctx context.Context, opts *ListOptions) (*Secrets, *Response, error) {
+	url := "user/codespaces/secrets"
+	return s.listSecrets(ctx, url, opts)
+}
+
+// ListOrgSecrets lists the Codespaces secrets of an organization without their values.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#list-organization-secrets
+func (s *CodespacesService) ListOrgSecrets(ctx context.Context, org string, opts *ListOptions) (*Secrets, *Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets", org)
+	return s.listSecrets(ctx, url, opts)
+}
+
+// ListRepoSecrets lists the Codespaces secrets of a repository without their values.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/repository-secrets#list-repository-secrets
+func (s *CodespacesService) ListRepoSecrets(ctx context.Context, owner, repo string, opts *ListOptions) (*Secrets, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/codespaces/secrets", owner, repo)
+	return s.listSecrets(ctx, url, opts)
+}
+
+func (s *CodespacesService) getSecret(ctx context.Context, url string) (*Secret, *Response, error) {
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret := new(Secret)
+	resp, err := s.client.Do(ctx, req, secret)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return secret, resp, nil
+}
+
+// GetUserSecret gets a Codespaces secret of the authenticated user without its value.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#get-a-secret-for-the-authenticated-user
+func (s *CodespacesService) GetUserSecret(ctx context.Context, name string) (*Secret, *Response, error) {
+	url := fmt.Sprintf("user/codespaces/secrets/%v", name)
+	return s.getSecret(ctx, url)
+}
+
+// GetOrgSecret gets a Codespaces secret of an organization without its value.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#get-an-organization-secret
+func (s *CodespacesService) GetOrgSecret(ctx context.Context, org string, name string) (*Secret, *Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", org, name)
+	return s.getSecret(ctx, url)
+}
+
+// GetRepoSecret gets a Codespaces secret of a repository without its value.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/repository-secrets#get-a-repository-secret
+func (s *CodespacesService) GetRepoSecret(ctx context.Context, owner, repo string, name string) (*Secret, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", owner, repo, name)
+	return s.getSecret(ctx, url)
+}
+
+func (s *CodespacesService) putSecret(ctx context.Context, url string, eSecret *EncryptedSecret) (*Response, error) {
+	req, err := s.client.NewRequest("PUT", url, eSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// CreateOrUpdateUserSecret creates or updates a Codespaces secret of the authenticated user with
+// a value encrypted with GetUserPublicKey. See PublicKey.Encrypt.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#create-or-update-a-secret-for-the-authenticated-user
+func (s *CodespacesService) CreateOrUpdateUserSecret(ctx context.Context, eSecret *EncryptedSecret) (*Response, error) {
+	url := fmt.Sprintf("user/codespaces/secrets/%v", eSecret.Name)
+	return s.putSecret(ctx, url, eSecret)
+}
+
+// CreateOrUpdateOrgSecret creates or updates a Codespaces secret of an organization with
+// a value encrypted with GetOrgPublicKey. See PublicKey.Encrypt.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#create-or-update-an-organization-secret
+func (s *CodespacesService) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *EncryptedSecret) (*Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", org, eSecret.Name)
+	return s.putSecret(ctx, url, eSecret)
+}
+
+// CreateOrUpdateRepoSecret creates or updates a Codespaces secret of a repository with
+// a value encrypted with GetRepoPublicKey. See PublicKey.Encrypt.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/repository-secrets#create-or-update-a-repository-secret
+func (s *CodespacesService) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *EncryptedSecret) (*Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", owner, repo, eSecret.Name)
+	return s.putSecret(ctx, url, eSecret)
+}
+
+func (s *CodespacesService) deleteSecret(ctx context.Context, url string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteUserSecret deletes a Codespaces secret of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#delete-a-secret-for-the-authenticated-user
+func (s *CodespacesService) DeleteUserSecret(ctx context.Context, name string) (*Response, error) {
+	url := fmt.Sprintf("user/codespaces/secrets/%v", name)
+	return s.deleteSecret(ctx, url)
+}
+
+// DeleteOrgSecret deletes a Codespaces secret of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#delete-an-organization-secret
+func (s *CodespacesService) DeleteOrgSecret(ctx context.Context, org string, name string) (*Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", org, name)
+	return s.deleteSecret(ctx, url)
+}
+
+// DeleteRepoSecret deletes a Codespaces secret of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/repository-secrets#delete-a-repository-secret
+func (s *CodespacesService) DeleteRepoSecret(ctx context.Context, owner, repo string, name string) (*Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", owner, repo, name)
+	return s.deleteSecret(ctx, url)
+}
+
+func (s *CodespacesService) listSelectedReposForSecret(ctx context.Context, url string, opts *ListOptions) (*SelectedReposList, *Response, error) {
+	u, err := addOptions(url, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(SelectedReposList)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// ListSelectedReposForUserSecret lists the repositories which can access a
+// Codespaces secret of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#list-selected-repositories-for-a-user-secret
+func (s *CodespacesService) ListSelectedReposForUserSecret(ctx context.Context, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
+	url := fmt.Sprintf("user/codespaces/secrets/%v/repositories", name)
+	return s.listSelectedReposForSecret(ctx, url, opts)
+}
+
+// ListSelectedReposForOrgSecret lists the repositories which can access a
+// Codespaces secret of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#list-selected-repositories-for-an-organization-secret
+func (s *CodespacesService) ListSelectedReposForOrgSecret(ctx context.Context, org string, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories", org, name)
+	return s.listSelectedReposForSecret(ctx, url, opts)
+}
+
+func (s *CodespacesService) setSelectedReposForSecret(ctx context.Context, url string, ids SelectedRepoIDs) (*Response, error) {
+	type repoIDs struct {
+		SelectedIDs SelectedRepoIDs `json:"selected_repository_ids"`
+	}
+
+	req, err := s.client.NewRequest("PUT", url, repoIDs{SelectedIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// SetSelectedReposForUserSecret sets the repositories which can access a
+// Codespaces secret of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#set-selected-repositories-for-a-user-secret
+func (s *CodespacesService) SetSelectedReposForUserSecret(ctx context.Context, name string, ids SelectedRepoIDs) (*Response, error) {
+	url := fmt.Sprintf("user/codespaces/secrets/%v/repositories", name)
+	return s.setSelectedReposForSecret(ctx, url, ids)
+}
+
+// SetSelectedReposForOrgSecret sets the repositories which can access a
+// Codespaces secret of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#set-selected-repositories-for-an-organization-secret
+func (s *CodespacesService) SetSelectedReposForOrgSecret(ctx context.Context, org string, name string, ids SelectedRepoIDs) (*Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories", org, name)
+	return s.setSelectedReposForSecret(ctx, url, ids)
+}
+
+func (s *CodespacesService) addSelectedRepoToSecret(ctx context.Context, url string) (*Response, error) {
+	req, err := s.client.NewRequest("PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AddSelectedRepoToUserSecret adds a repository to those which can access a
+// Codespaces secret of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#add-a-selected-repository-to-a-user-secret
+func (s *CodespacesService) AddSelectedRepoToUserSecret(ctx context.Context, name string, repo *Repository) (*Response, error) {
+	url := fmt.Sprintf("user/codespaces/secrets/%v/repositories/%v", name, *repo.ID)
+	return s.addSelectedRepoToSecret(ctx, url)
+}
+
+// AddSelectedRepoToOrgSecret adds a repository to those which can access a
+// Codespaces secret of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#add-selected-repository-to-an-organization-secret
+func (s *CodespacesService) AddSelectedRepoToOrgSecret(ctx context.Context, org string, name string, repo *Repository) (*Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories/%v", org, name, *repo.ID)
+	return s.addSelectedRepoToSecret(ctx, url)
+}
+
+func (s *CodespacesService) removeSelectedRepoFromSecret(ctx context.Context, url string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveSelectedRepoFromUserSecret removes a repository from those which can
+// access a Codespaces secret of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/secrets#remove-a-selected-repository-from-a-user-secret
+func (s *CodespacesService) RemoveSelectedRepoFromUserSecret(ctx context.Context, name string, repo *Repository) (*Response, error) {
+	url := fmt.Sprintf("user/codespaces/secrets/%v/repositories/%v", name, *repo.ID)
+	return s.removeSelectedRepoFromSecret(ctx, url)
+}
+
+// RemoveSelectedRepoFromOrgSecret removes a repository from those which can
+// access a Codespaces secret of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/codespaces/organization-secrets#remove-selected-repository-from-an-organization-secret
+func (s *CodespacesService) RemoveSelectedRepoFromOrgSecret(ctx context.Context, org string, name string, repo *Repository) (*Response, error) {
+	url := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories/%v", org, name, *repo.ID)
+	return s.removeSelectedRepoFromSecret(ctx, url)
+}
@@ -0,0 +1,764 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodespacesService_GetUserPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	ctx := context.Background()
+	pubKey, _, err := client.Codespaces.GetUserPublicKey(ctx)
+	if err != nil {
+		t.Errorf("Codespaces.GetUserPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !cmp.Equal(pubKey, want) {
+		t.Errorf("Codespaces.GetUserPublicKey returned %+v, want %+v", pubKey, want)
+	}
+
+	const methodName = "GetUserPublicKey"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.GetUserPublicKey(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_GetOrgPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	ctx := context.Background()
+	pubKey, _, err := client.Codespaces.GetOrgPublicKey(ctx, "o")
+	if err != nil {
+		t.Errorf("Codespaces.GetOrgPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !cmp.Equal(pubKey, want) {
+		t.Errorf("Codespaces.GetOrgPublicKey returned %+v, want %+v", pubKey, want)
+	}
+
+	const methodName = "GetOrgPublicKey"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.GetOrgPublicKey(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.GetOrgPublicKey(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_GetRepoPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	ctx := context.Background()
+	pubKey, _, err := client.Codespaces.GetRepoPublicKey(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Codespaces.GetRepoPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !cmp.Equal(pubKey, want) {
+		t.Errorf("Codespaces.GetRepoPublicKey returned %+v, want %+v", pubKey, want)
+	}
+
+	const methodName = "GetRepoPublicKey"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.GetRepoPublicKey(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.GetRepoPublicKey(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_ListUserSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"secrets":[{"name":"A","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"},{"name":"B","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	secrets, _, err := client.Codespaces.ListUserSecrets(ctx, opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListUserSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 2,
+		Secrets: []*Secret{
+			{Name: "A", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+			{Name: "B", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+		},
+	}
+	if !cmp.Equal(secrets, want) {
+		t.Errorf("Codespaces.ListUserSecrets returned %+v, want %+v", secrets, want)
+	}
+
+	const methodName = "ListUserSecrets"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListUserSecrets(ctx, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_ListOrgSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"secrets":[{"name":"A","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"},{"name":"B","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	secrets, _, err := client.Codespaces.ListOrgSecrets(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListOrgSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 2,
+		Secrets: []*Secret{
+			{Name: "A", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+			{Name: "B", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+		},
+	}
+	if !cmp.Equal(secrets, want) {
+		t.Errorf("Codespaces.ListOrgSecrets returned %+v, want %+v", secrets, want)
+	}
+
+	const methodName = "ListOrgSecrets"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListOrgSecrets(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListOrgSecrets(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_ListRepoSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"secrets":[{"name":"A","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"},{"name":"B","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	secrets, _, err := client.Codespaces.ListRepoSecrets(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListRepoSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 2,
+		Secrets: []*Secret{
+			{Name: "A", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+			{Name: "B", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+		},
+	}
+	if !cmp.Equal(secrets, want) {
+		t.Errorf("Codespaces.ListRepoSecrets returned %+v, want %+v", secrets, want)
+	}
+
+	const methodName = "ListRepoSecrets"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListRepoSecrets(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListRepoSecrets(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_GetUserSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}`)
+	})
+
+	ctx := context.Background()
+	secret, _, err := client.Codespaces.GetUserSecret(ctx, "NAME")
+	if err != nil {
+		t.Errorf("Codespaces.GetUserSecret returned error: %v", err)
+	}
+
+	want := &Secret{
+		Name:      "NAME",
+		CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
+		UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
+	}
+	if !cmp.Equal(secret, want) {
+		t.Errorf("Codespaces.GetUserSecret returned %+v, want %+v", secret, want)
+	}
+
+	const methodName = "GetUserSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.GetUserSecret(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.GetUserSecret(ctx, "NAME")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_GetOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}`)
+	})
+
+	ctx := context.Background()
+	secret, _, err := client.Codespaces.GetOrgSecret(ctx, "o", "NAME")
+	if err != nil {
+		t.Errorf("Codespaces.GetOrgSecret returned error: %v", err)
+	}
+
+	want := &Secret{
+		Name:      "NAME",
+		CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
+		UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
+	}
+	if !cmp.Equal(secret, want) {
+		t.Errorf("Codespaces.GetOrgSecret returned %+v, want %+v", secret, want)
+	}
+
+	const methodName = "GetOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.GetOrgSecret(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.GetOrgSecret(ctx, "o", "NAME")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_GetRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}`)
+	})
+
+	ctx := context.Background()
+	secret, _, err := client.Codespaces.GetRepoSecret(ctx, "o", "r", "NAME")
+	if err != nil {
+		t.Errorf("Codespaces.GetRepoSecret returned error: %v", err)
+	}
+
+	want := &Secret{
+		Name:      "NAME",
+		CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
+		UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
+	}
+	if !cmp.Equal(secret, want) {
+		t.Errorf("Codespaces.GetRepoSecret returned %+v, want %+v", secret, want)
+	}
+
+	const methodName = "GetRepoSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.GetRepoSecret(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.GetRepoSecret(ctx, "o", "r", "NAME")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_CreateOrUpdateUserSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv="}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{
+		Name:           "NAME",
+		EncryptedValue: "QIv=",
+		KeyID:          "1234",
+	}
+	ctx := context.Background()
+	_, err := client.Codespaces.CreateOrUpdateUserSecret(ctx, input)
+	if err != nil {
+		t.Errorf("Codespaces.CreateOrUpdateUserSecret returned error: %v", err)
+	}
+
+	const methodName = "CreateOrUpdateUserSecret"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.CreateOrUpdateUserSecret(ctx, input)
+	})
+}
+
+func TestCodespacesService_CreateOrUpdateOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv="}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{
+		Name:           "NAME",
+		EncryptedValue: "QIv=",
+		KeyID:          "1234",
+	}
+	ctx := context.Background()
+	_, err := client.Codespaces.CreateOrUpdateOrgSecret(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Codespaces.CreateOrUpdateOrgSecret returned error: %v", err)
+	}
+
+	const methodName = "CreateOrUpdateOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.CreateOrUpdateOrgSecret(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.CreateOrUpdateOrgSecret(ctx, "o", input)
+	})
+}
+
+func TestCodespacesService_CreateOrUpdateRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv="}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{
+		Name:           "NAME",
+		EncryptedValue: "QIv=",
+		KeyID:          "1234",
+	}
+	ctx := context.Background()
+	_, err := client.Codespaces.CreateOrUpdateRepoSecret(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Codespaces.CreateOrUpdateRepoSecret returned error: %v", err)
+	}
+
+	const methodName = "CreateOrUpdateRepoSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.CreateOrUpdateRepoSecret(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.CreateOrUpdateRepoSecret(ctx, "o", "r", input)
+	})
+}
+
+func TestCodespacesService_DeleteUserSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.DeleteUserSecret(ctx, "NAME")
+	if err != nil {
+		t.Errorf("Codespaces.DeleteUserSecret returned error: %v", err)
+	}
+
+	const methodName = "DeleteUserSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.DeleteUserSecret(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.DeleteUserSecret(ctx, "NAME")
+	})
+}
+
+func TestCodespacesService_DeleteOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.DeleteOrgSecret(ctx, "o", "NAME")
+	if err != nil {
+		t.Errorf("Codespaces.DeleteOrgSecret returned error: %v", err)
+	}
+
+	const methodName = "DeleteOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.DeleteOrgSecret(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.DeleteOrgSecret(ctx, "o", "NAME")
+	})
+}
+
+func TestCodespacesService_DeleteRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.DeleteRepoSecret(ctx, "o", "r", "NAME")
+	if err != nil {
+		t.Errorf("Codespaces.DeleteRepoSecret returned error: %v", err)
+	}
+
+	const methodName = "DeleteRepoSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.DeleteRepoSecret(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.DeleteRepoSecret(ctx, "o", "r", "NAME")
+	})
+}
+
+func TestCodespacesService_ListSelectedReposForUserSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1"})
+		fmt.Fprintf(w, `{"total_count":1,"repositories":[{"id":1}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 1}
+	repos, _, err := client.Codespaces.ListSelectedReposForUserSecret(ctx, "NAME", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListSelectedReposForUserSecret returned error: %v", err)
+	}
+
+	want := &SelectedReposList{
+		TotalCount:   Int(1),
+		Repositories: []*Repository{{ID: Int64(1)}},
+	}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Codespaces.ListSelectedReposForUserSecret returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListSelectedReposForUserSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListSelectedReposForUserSecret(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListSelectedReposForUserSecret(ctx, "NAME", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_ListSelectedReposForOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1"})
+		fmt.Fprintf(w, `{"total_count":1,"repositories":[{"id":1}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 1}
+	repos, _, err := client.Codespaces.ListSelectedReposForOrgSecret(ctx, "o", "NAME", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListSelectedReposForOrgSecret returned error: %v", err)
+	}
+
+	want := &SelectedReposList{
+		TotalCount:   Int(1),
+		Repositories: []*Repository{{ID: Int64(1)}},
+	}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Codespaces.ListSelectedReposForOrgSecret returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListSelectedReposForOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListSelectedReposForOrgSecret(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListSelectedReposForOrgSecret(ctx, "o", "NAME", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_SetSelectedReposForUserSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"selected_repository_ids":[64780797]}`+"\n")
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.SetSelectedReposForUserSecret(ctx, "NAME", SelectedRepoIDs{64780797})
+	if err != nil {
+		t.Errorf("Codespaces.SetSelectedReposForUserSecret returned error: %v", err)
+	}
+
+	const methodName = "SetSelectedReposForUserSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.SetSelectedReposForUserSecret(ctx, "\n", SelectedRepoIDs{64780797})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.SetSelectedReposForUserSecret(ctx, "NAME", SelectedRepoIDs{64780797})
+	})
+}
+
+func TestCodespacesService_SetSelectedReposForOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"selected_repository_ids":[64780797]}`+"\n")
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.SetSelectedReposForOrgSecret(ctx, "o", "NAME", SelectedRepoIDs{64780797})
+	if err != nil {
+		t.Errorf("Codespaces.SetSelectedReposForOrgSecret returned error: %v", err)
+	}
+
+	const methodName = "SetSelectedReposForOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.SetSelectedReposForOrgSecret(ctx, "\n", "\n", SelectedRepoIDs{64780797})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.SetSelectedReposForOrgSecret(ctx, "o", "NAME", SelectedRepoIDs{64780797})
+	})
+}
+
+func TestCodespacesService_AddSelectedRepoToUserSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets/NAME/repositories/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	repo := &Repository{ID: Int64(1234)}
+	ctx := context.Background()
+	_, err := client.Codespaces.AddSelectedRepoToUserSecret(ctx, "NAME", repo)
+	if err != nil {
+		t.Errorf("Codespaces.AddSelectedRepoToUserSecret returned error: %v", err)
+	}
+
+	const methodName = "AddSelectedRepoToUserSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.AddSelectedRepoToUserSecret(ctx, "\n", repo)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.AddSelectedRepoToUserSecret(ctx, "NAME", repo)
+	})
+}
+
+func TestCodespacesService_AddSelectedRepoToOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets/NAME/repositories/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	repo := &Repository{ID: Int64(1234)}
+	ctx := context.Background()
+	_, err := client.Codespaces.AddSelectedRepoToOrgSecret(ctx, "o", "NAME", repo)
+	if err != nil {
+		t.Errorf("Codespaces.AddSelectedRepoToOrgSecret returned error: %v", err)
+	}
+
+	const methodName = "AddSelectedRepoToOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.AddSelectedRepoToOrgSecret(ctx, "\n", "\n", repo)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.AddSelectedRepoToOrgSecret(ctx, "o", "NAME", repo)
+	})
+}
+
+func TestCodespacesService_RemoveSelectedRepoFromUserSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/secrets/NAME/repositories/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	repo := &Repository{ID: Int64(1234)}
+	ctx := context.Background()
+	_, err := client.Codespaces.RemoveSelectedRepoFromUserSecret(ctx, "NAME", repo)
+	if err != nil {
+		t.Errorf("Codespaces.RemoveSelectedRepoFromUserSecret returned error: %v", err)
+	}
+
+	const methodName = "RemoveSelectedRepoFromUserSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.RemoveSelectedRepoFromUserSecret(ctx, "\n", repo)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.RemoveSelectedRepoFromUserSecret(ctx, "NAME", repo)
+	})
+}
+
+func TestCodespacesService_RemoveSelectedRepoFromOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces/secrets/NAME/repositories/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	repo := &Repository{ID: Int64(1234)}
+	ctx := context.Background()
+	_, err := client.Codespaces.RemoveSelectedRepoFromOrgSecret(ctx, "o", "NAME", repo)
+	if err != nil {
+		t.Errorf("Codespaces.RemoveSelectedRepoFromOrgSecret returned error: %v", err)
+	}
+
+	const methodName = "RemoveSelectedRepoFromOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.RemoveSelectedRepoFromOrgSecret(ctx, "\n", "\n", repo)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.RemoveSelectedRepoFromOrgSecret(ctx, "o", "NAME", repo)
+	})
+}
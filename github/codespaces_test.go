@@ -0,0 +1,467 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodespacesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1", "per_page": "2", "repository_id": "1296269"})
+		fmt.Fprint(w, `{"total_count":1,"codespaces":[{
+			"id": 1,
+			"name": "monalisa-octocat-hello-world-g4wpq6h95q",
+			"environment_id": "26a7c758-7299-4a73-b978-5a92a7ae98a0",
+			"owner": {"login": "octocat"},
+			"billable_owner": {"login": "octocat"},
+			"repository": {"id": 1296269},
+			"machine": {
+				"name": "standardLinux",
+				"display_name": "4 cores, 8 GB RAM, 64 GB storage",
+				"operating_system": "linux",
+				"storage_in_bytes": 68719476736,
+				"memory_in_bytes": 8589934592,
+				"cpus": 4
+			},
+			"prebuild": false,
+			"devcontainer_path": ".devcontainer/devcontainer.json",
+			"created_at": "2021-10-14T00:53:30-06:00",
+			"state": "Available",
+			"git_status": {"ahead": 0, "behind": 0, "has_unpushed_changes": false, "has_uncommitted_changes": false, "ref": "main"},
+			"location": "WestUs2",
+			"idle_timeout_minutes": 60,
+			"recent_folders": [],
+			"runtime_constraints": {"allowed_port_privacy_settings": ["public"]}
+		}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListCodespacesOptions{ListOptions: ListOptions{Page: 1, PerPage: 2}, RepositoryID: 1296269}
+	codespaces, _, err := client.Codespaces.List(ctx, opts)
+	if err != nil {
+		t.Errorf("Codespaces.List returned error: %v", err)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, "2021-10-14T00:53:30-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ListCodespaces{
+		TotalCount: Int(1),
+		Codespaces: []*Codespace{
+			{
+				ID:            Int64(1),
+				Name:          String("monalisa-octocat-hello-world-g4wpq6h95q"),
+				EnvironmentID: String("26a7c758-7299-4a73-b978-5a92a7ae98a0"),
+				Owner:         &User{Login: String("octocat")},
+				BillableOwner: &User{Login: String("octocat")},
+				Repository:    &Repository{ID: Int64(1296269)},
+				Machine: &CodespacesMachine{
+					Name:            String("standardLinux"),
+					DisplayName:     String("4 cores, 8 GB RAM, 64 GB storage"),
+					OperatingSystem: String("linux"),
+					StorageInBytes:  Int64(68719476736),
+					MemoryInBytes:   Int64(8589934592),
+					CPUs:            Int(4),
+				},
+				Prebuild:         Bool(false),
+				DevcontainerPath: String(".devcontainer/devcontainer.json"),
+				CreatedAt:        &Timestamp{createdAt},
+				State:            String("Available"),
+				GitStatus: &CodespacesGitStatus{
+					Ahead:                 Int(0),
+					Behind:                Int(0),
+					HasUnpushedChanges:    Bool(false),
+					HasUncommittedChanges: Bool(false),
+					Ref:                   String("main"),
+				},
+				Location:           String("WestUs2"),
+				IdleTimeoutMinutes: Int(60),
+				RecentFolders:      []string{},
+				RuntimeConstraints: &CodespacesRuntimeConstraints{AllowedPortPrivacySettings: []string{"public"}},
+			},
+		},
+	}
+	if !cmp.Equal(codespaces, want) {
+		t.Errorf("Codespaces.List returned %+v, want %+v", codespaces, want)
+	}
+
+	const methodName = "List"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.List(ctx, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_CreateInRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"main","geo":"WestUs2","machine":"standardLinux","devcontainer_path":".devcontainer/devcontainer.json","idle_timeout_minutes":60}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1, "repository": {"id": 1296269}}`)
+	})
+
+	ctx := context.Background()
+	opts := &CreateCodespaceOptions{
+		Ref:                String("main"),
+		Geo:                String("WestUs2"),
+		Machine:            String("standardLinux"),
+		DevcontainerPath:   String(".devcontainer/devcontainer.json"),
+		IdleTimeoutMinutes: Int(60),
+	}
+	codespace, _, err := client.Codespaces.CreateInRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Codespaces.CreateInRepo returned error: %v", err)
+	}
+
+	want := &Codespace{ID: Int64(1), Repository: &Repository{ID: Int64(1296269)}}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.CreateInRepo returned %+v, want %+v", codespace, want)
+	}
+
+	const methodName = "CreateInRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.CreateInRepo(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.CreateInRepo(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_CreateInRepo_accepted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":1, "state": "Queued"}`)
+	})
+
+	ctx := context.Background()
+	codespace, _, err := client.Codespaces.CreateInRepo(ctx, "o", "r", nil)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Codespaces.CreateInRepo returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &Codespace{ID: Int64(1), State: String("Queued")}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.CreateInRepo returned %+v, want %+v", codespace, want)
+	}
+}
+
+func TestCodespacesService_CreateFromPullRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/42/codespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"machine":"standardLinux","retention_period_minutes":1440}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1, "pulls_url": "https://api.github.com/repos/o/r/pulls/42"}`)
+	})
+
+	ctx := context.Background()
+	opts := &CreateCodespaceOptions{Machine: String("standardLinux"), RetentionPeriodMinutes: Int(1440)}
+	codespace, _, err := client.Codespaces.CreateFromPullRequest(ctx, "o", "r", 42, opts)
+	if err != nil {
+		t.Errorf("Codespaces.CreateFromPullRequest returned error: %v", err)
+	}
+
+	want := &Codespace{ID: Int64(1), PullsURL: String("https://api.github.com/repos/o/r/pulls/42")}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.CreateFromPullRequest returned %+v, want %+v", codespace, want)
+	}
+
+	const methodName = "CreateFromPullRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.CreateFromPullRequest(ctx, "\n", "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.CreateFromPullRequest(ctx, "o", "r", 42, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1, "name": "c", "state": "Shutdown"}`)
+	})
+
+	ctx := context.Background()
+	codespace, _, err := client.Codespaces.Get(ctx, "c")
+	if err != nil {
+		t.Errorf("Codespaces.Get returned error: %v", err)
+	}
+
+	want := &Codespace{ID: Int64(1), Name: String("c"), State: String("Shutdown")}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.Get returned %+v, want %+v", codespace, want)
+	}
+
+	const methodName = "Get"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.Get(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.Get(ctx, "c")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_Start(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/c/start", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":1, "state": "Starting"}`)
+	})
+
+	ctx := context.Background()
+	codespace, _, err := client.Codespaces.Start(ctx, "c")
+	if err != nil {
+		t.Errorf("Codespaces.Start returned error: %v", err)
+	}
+
+	want := &Codespace{ID: Int64(1), State: String("Starting")}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.Start returned %+v, want %+v", codespace, want)
+	}
+
+	const methodName = "Start"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.Start(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.Start(ctx, "c")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_Stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/c/stop", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":1, "state": "ShuttingDown"}`)
+	})
+
+	ctx := context.Background()
+	codespace, _, err := client.Codespaces.Stop(ctx, "c")
+	if err != nil {
+		t.Errorf("Codespaces.Stop returned error: %v", err)
+	}
+
+	want := &Codespace{ID: Int64(1), State: String("ShuttingDown")}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.Stop returned %+v, want %+v", codespace, want)
+	}
+
+	const methodName = "Stop"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.Stop(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.Stop(ctx, "c")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_Publish(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/c/publish", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"new-repo","private":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1, "repository": {"name": "new-repo", "private": true}}`)
+	})
+
+	ctx := context.Background()
+	opts := &PublishCodespaceOptions{Name: String("new-repo"), Private: Bool(true)}
+	codespace, _, err := client.Codespaces.Publish(ctx, "c", opts)
+	if err != nil {
+		t.Errorf("Codespaces.Publish returned error: %v", err)
+	}
+
+	want := &Codespace{ID: Int64(1), Repository: &Repository{Name: String("new-repo"), Private: Bool(true)}}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.Publish returned %+v, want %+v", codespace, want)
+	}
+
+	const methodName = "Publish"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.Publish(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.Publish(ctx, "c", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.Delete(ctx, "c")
+	if err != nil {
+		t.Errorf("Codespaces.Delete returned error: %v", err)
+	}
+
+	const methodName = "Delete"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.Delete(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.Delete(ctx, "c")
+	})
+}
+
+func TestCodespacesService_Export(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/c/exports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":"latest","state":"in_progress","branch":"codespace-c","export_url":"https://api.github.com/user/codespaces/c/exports/latest"}`)
+	})
+
+	ctx := context.Background()
+	export, _, err := client.Codespaces.Export(ctx, "c")
+	if err != nil {
+		t.Errorf("Codespaces.Export returned error: %v", err)
+	}
+
+	want := &CodespaceExport{
+		ID:        String("latest"),
+		State:     String("in_progress"),
+		Branch:    String("codespace-c"),
+		ExportURL: String("https://api.github.com/user/codespaces/c/exports/latest"),
+	}
+	if !cmp.Equal(export, want) {
+		t.Errorf("Codespaces.Export returned %+v, want %+v", export, want)
+	}
+
+	const methodName = "Export"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.Export(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.Export(ctx, "c")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_GetExport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/codespaces/c/exports/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"latest","state":"succeeded","completed_at":"2021-01-01T19:01:12Z","branch":"codespace-c","sha":"fd95a81ca01e48ede9f39c799ecbcef817b8a3b2"}`)
+	})
+
+	ctx := context.Background()
+	export, _, err := client.Codespaces.GetExport(ctx, "c", "latest")
+	if err != nil {
+		t.Errorf("Codespaces.GetExport returned error: %v", err)
+	}
+
+	want := &CodespaceExport{
+		ID:          String("latest"),
+		State:       String("succeeded"),
+		CompletedAt: &Timestamp{time.Date(2021, time.January, 1, 19, 1, 12, 0, time.UTC)},
+		Branch:      String("codespace-c"),
+		SHA:         String("fd95a81ca01e48ede9f39c799ecbcef817b8a3b2"),
+	}
+	if !cmp.Equal(export, want) {
+		t.Errorf("Codespaces.GetExport returned %+v, want %+v", export, want)
+	}
+
+	const methodName = "GetExport"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.GetExport(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.GetExport(ctx, "c", "latest")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
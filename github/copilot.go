@@ -0,0 +1,480 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CopilotService provides access to the Copilot-related functions
+// in the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot
+type CopilotService service
+
+// CopilotOrganizationDetails represents the details of an organization's Copilot for Business subscription.
+type CopilotOrganizationDetails struct {
+	SeatBreakdown *CopilotSeatBreakdown `json:"seat_breakdown"`
+	// PublicCodeSuggestions is one of allow, block or unconfigured.
+	PublicCodeSuggestions string `json:"public_code_suggestions"`
+	// CopilotChat is one of enabled, disabled or unconfigured.
+	CopilotChat string `json:"copilot_chat"`
+	// SeatManagementSetting is one of assign_all, assign_selected, disabled or unconfigured.
+	SeatManagementSetting string `json:"seat_management_setting"`
+}
+
+// CopilotSeatBreakdown represents the breakdown of Copilot for Business seats for the organization.
+type CopilotSeatBreakdown struct {
+	Total               int `json:"total"`
+	AddedThisCycle      int `json:"added_this_cycle"`
+	PendingCancellation int `json:"pending_cancellation"`
+	PendingInvitation   int `json:"pending_invitation"`
+	ActiveThisCycle     int `json:"active_this_cycle"`
+	InactiveThisCycle   int `json:"inactive_this_cycle"`
+}
+
+// ListCopilotSeatsResponse represents the Copilot for Business seat assignments for an organization.
+type ListCopilotSeatsResponse struct {
+	TotalSeats int64                 `json:"total_seats"`
+	Seats      []*CopilotSeatDetails `json:"seats"`
+}
+
+// CopilotSeatDetails represents the details of a Copilot for Business seat.
+type CopilotSeatDetails struct {
+	// Assignee is a *User, *Team or *Organization, depending on its type.
+	// See the GetUser, GetTeam and GetOrganization methods.
+	Assignee interface{} `json:"assignee"`
+	// AssigningTeam is the team through which the assignee was granted the seat, if any.
+	AssigningTeam           *Team      `json:"assigning_team,omitempty"`
+	PendingCancellationDate *string    `json:"pending_cancellation_date,omitempty"`
+	LastActivityAt          *Timestamp `json:"last_activity_at,omitempty"`
+	LastActivityEditor      *string    `json:"last_activity_editor,omitempty"`
+	CreatedAt               *Timestamp `json:"created_at"`
+	UpdatedAt               *Timestamp `json:"updated_at,omitempty"`
+}
+
+// SeatAssignments represents the number of seats assigned.
+type SeatAssignments struct {
+	SeatsCreated int `json:"seats_created"`
+}
+
+// SeatCancellations represents the number of seats cancelled.
+type SeatCancellations struct {
+	SeatsCancelled int `json:"seats_cancelled"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It decodes the
+// assignee according to its type.
+func (cp *CopilotSeatDetails) UnmarshalJSON(data []byte) error {
+	// Using an alias to avoid infinite recursion when calling json.Unmarshal
+	type alias CopilotSeatDetails
+	var seatDetail alias
+
+	if err := json.Unmarshal(data, &seatDetail); err != nil {
+		return err
+	}
+
+	*cp = CopilotSeatDetails(seatDetail)
+
+	v, ok := seatDetail.Assignee.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	switch v["type"] {
+	case "User", nil:
+		user := new(User)
+		if err := json.Unmarshal(raw, user); err != nil {
+			return err
+		}
+		cp.Assignee = user
+	case "Team":
+		team := new(Team)
+		if err := json.Unmarshal(raw, team); err != nil {
+			return err
+		}
+		cp.Assignee = team
+	case "Organization":
+		org := new(Organization)
+		if err := json.Unmarshal(raw, org); err != nil {
+			return err
+		}
+		cp.Assignee = org
+	default:
+		return fmt.Errorf("unsupported assignee type %v", v["type"])
+	}
+
+	return nil
+}
+
+// GetUser gets the User from the CopilotSeatDetails if the assignee is a user.
+func (cp *CopilotSeatDetails) GetUser() (*User, bool) { u, ok := cp.Assignee.(*User); return u, ok }
+
+// GetTeam gets the Team from the CopilotSeatDetails if the assignee is a team.
+func (cp *CopilotSeatDetails) GetTeam() (*Team, bool) { t, ok := cp.Assignee.(*Team); return t, ok }
+
+// GetOrganization gets the Organization from the CopilotSeatDetails if the assignee is an organization.
+func (cp *CopilotSeatDetails) GetOrganization() (*Organization, bool) {
+	o, ok := cp.Assignee.(*Organization)
+	return o, ok
+}
+
+// GetCopilotBilling gets Copilot for Business billing information and settings for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-business#get-copilot-business-seat-information-and-settings-for-an-organization
+func (s *CopilotService) GetCopilotBilling(ctx context.Context, org string) (*CopilotOrganizationDetails, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var copilotDetails *CopilotOrganizationDetails
+	resp, err := s.client.Do(ctx, req, &copilotDetails)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return copilotDetails, resp, nil
+}
+
+// ListCopilotSeats lists Copilot for Business seat assignments for an organization.
+//
+// To paginate through all seats, populate 'Page' with the number of the last page.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-business#list-all-copilot-business-seat-assignments-for-an-organization
+func (s *CopilotService) ListCopilotSeats(ctx context.Context, org string, opts *ListOptions) (*ListCopilotSeatsResponse, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/seats", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var copilotSeats *ListCopilotSeatsResponse
+	resp, err := s.client.Do(ctx, req, &copilotSeats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return copilotSeats, resp, nil
+}
+
+// AddCopilotTeams adds teams to the Copilot for Business subscription for an organization.
+// All members of the teams are granted a seat.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-business#add-teams-to-the-copilot-business-subscription-for-an-organization
+func (s *CopilotService) AddCopilotTeams(ctx context.Context, org string, teamNames []string) (*SeatAssignments, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_teams", org)
+
+	body := struct {
+		SelectedTeams []string `json:"selected_teams"`
+	}{
+		SelectedTeams: teamNames,
+	}
+
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seatAssignments *SeatAssignments
+	resp, err := s.client.Do(ctx, req, &seatAssignments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatAssignments, resp, nil
+}
+
+// RemoveCopilotTeams removes teams from the Copilot for Business subscription for an organization.
+// The seats of their members are cancelled at the end of the billing cycle.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-business#remove-teams-from-the-copilot-business-subscription-for-an-organization
+func (s *CopilotService) RemoveCopilotTeams(ctx context.Context, org string, teamNames []string) (*SeatCancellations, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_teams", org)
+
+	body := struct {
+		SelectedTeams []string `json:"selected_teams"`
+	}{
+		SelectedTeams: teamNames,
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seatCancellations *SeatCancellations
+	resp, err := s.client.Do(ctx, req, &seatCancellations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatCancellations, resp, nil
+}
+
+// AddCopilotUsers adds users to the Copilot for Business subscription for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-business#add-users-to-the-copilot-business-subscription-for-an-organization
+func (s *CopilotService) AddCopilotUsers(ctx context.Context, org string, users []string) (*SeatAssignments, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_users", org)
+
+	body := struct {
+		SelectedUsernames []string `json:"selected_usernames"`
+	}{
+		SelectedUsernames: users,
+	}
+
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seatAssignments *SeatAssignments
+	resp, err := s.client.Do(ctx, req, &seatAssignments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatAssignments, resp, nil
+}
+
+// RemoveCopilotUsers removes users from the Copilot for Business subscription for an organization.
+// Their seats are cancelled at the end of the billing cycle.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-business#remove-users-from-the-copilot-business-subscription-for-an-organization
+func (s *CopilotService) RemoveCopilotUsers(ctx context.Context, org string, users []string) (*SeatCancellations, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_users", org)
+
+	body := struct {
+		SelectedUsernames []string `json:"selected_usernames"`
+	}{
+		SelectedUsernames: users,
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seatCancellations *SeatCancellations
+	resp, err := s.client.Do(ctx, req, &seatCancellations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatCancellations, resp, nil
+}
+
+// GetSeatDetails gets Copilot for Business seat assignment details for a user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-business#get-copilot-business-seat-assignment-details-for-a-user
+func (s *CopilotService) GetSeatDetails(ctx context.Context, org, user string) (*CopilotSeatDetails, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/members/%v/copilot", org, user)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seatDetails *CopilotSeatDetails
+	resp, err := s.client.Do(ctx, req, &seatDetails)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatDetails, resp, nil
+}
+
+// CopilotMetricsListOptions specifies optional parameters to the
+// CopilotService metrics methods.
+type CopilotMetricsListOptions struct {
+	// Since only returns metrics from this day on. At most 28 days ago.
+	Since time.Time `url:"since,omitempty"`
+	// Until only returns metrics up to this day.
+	Until time.Time `url:"until,omitempty"`
+
+	ListOptions
+}
+
+// CopilotMetrics represents the Copilot usage metrics for a day.
+type CopilotMetrics struct {
+	// Date is the day the metrics are for, in YYYY-MM-DD format.
+	Date                      string                     `json:"date"`
+	TotalActiveUsers          *int                       `json:"total_active_users,omitempty"`
+	TotalEngagedUsers         *int                       `json:"total_engaged_users,omitempty"`
+	CopilotIDECodeCompletions *CopilotIDECodeCompletions `json:"copilot_ide_code_completions,omitempty"`
+	CopilotIDEChat            *CopilotIDEChat            `json:"copilot_ide_chat,omitempty"`
+	CopilotDotcomChat         *CopilotDotcomChat         `json:"copilot_dotcom_chat,omitempty"`
+	CopilotDotcomPullRequests *CopilotDotcomPullRequests `json:"copilot_dotcom_pull_requests,omitempty"`
+}
+
+// CopilotIDECodeCompletions represents the usage of Copilot code completions in IDEs.
+type CopilotIDECodeCompletions struct {
+	TotalEngagedUsers int                                  `json:"total_engaged_users"`
+	Languages         []*CopilotIDECodeCompletionsLanguage `json:"languages"`
+	Editors           []*CopilotIDECodeCompletionsEditor   `json:"editors"`
+}
+
+// CopilotIDECodeCompletionsLanguage represents the usage of Copilot code completions for a language.
+type CopilotIDECodeCompletionsLanguage struct {
+	Name              string `json:"name"`
+	TotalEngagedUsers int    `json:"total_engaged_users"`
+}
+
+// CopilotIDECodeCompletionsEditor represents the usage of Copilot code completions in an editor.
+type CopilotIDECodeCompletionsEditor struct {
+	Name              string                            `json:"name"`
+	TotalEngagedUsers int                               `json:"total_engaged_users"`
+	Models            []*CopilotIDECodeCompletionsModel `json:"models"`
+}
+
+// CopilotIDECodeCompletionsModel represents the usage of a model for Copilot code completions in an editor.
+type CopilotIDECodeCompletionsModel struct {
+	Name                    string                                    `json:"name"`
+	IsCustomModel           bool                                      `json:"is_custom_model"`
+	CustomModelTrainingDate *string                                   `json:"custom_model_training_date,omitempty"`
+	TotalEngagedUsers       int                                       `json:"total_engaged_users"`
+	Languages               []*CopilotIDECodeCompletionsModelLanguage `json:"languages"`
+}
+
+// CopilotIDECodeCompletionsModelLanguage represents the suggestions and
+// acceptances of Copilot code completions for a language, model and editor.
+type CopilotIDECodeCompletionsModelLanguage struct {
+	Name                    string `json:"name"`
+	TotalEngagedUsers       int    `json:"total_engaged_users"`
+	TotalCodeSuggestions    int    `json:"total_code_suggestions"`
+	TotalCodeAcceptances    int    `json:"total_code_acceptances"`
+	TotalCodeLinesSuggested int    `json:"total_code_lines_suggested"`
+	TotalCodeLinesAccepted  int    `json:"total_code_lines_accepted"`
+}
+
+// CopilotIDEChat represents the usage of Copilot Chat in IDEs.
+type CopilotIDEChat struct {
+	TotalEngagedUsers int                     `json:"total_engaged_users"`
+	Editors           []*CopilotIDEChatEditor `json:"editors"`
+}
+
+// CopilotIDEChatEditor represents the usage of Copilot Chat in an editor.
+type CopilotIDEChatEditor struct {
+	Name              string                 `json:"name"`
+	TotalEngagedUsers int                    `json:"total_engaged_users"`
+	Models            []*CopilotIDEChatModel `json:"models"`
+}
+
+// CopilotIDEChatModel represents the usage of a model for Copilot Chat in an editor.
+type CopilotIDEChatModel struct {
+	Name                     string  `json:"name"`
+	IsCustomModel            bool    `json:"is_custom_model"`
+	CustomModelTrainingDate  *string `json:"custom_model_training_date,omitempty"`
+	TotalEngagedUsers        int     `json:"total_engaged_users"`
+	TotalChats               int     `json:"total_chats"`
+	TotalChatInsertionEvents int     `json:"total_chat_insertion_events"`
+	TotalChatCopyEvents      int     `json:"total_chat_copy_events"`
+}
+
+// CopilotDotcomChat represents the usage of Copilot Chat on github.com.
+type CopilotDotcomChat struct {
+	TotalEngagedUsers int                       `json:"total_engaged_users"`
+	Models            []*CopilotDotcomChatModel `json:"models"`
+}
+
+// CopilotDotcomChatModel represents the usage of a model for Copilot Chat on github.com.
+type CopilotDotcomChatModel struct {
+	Name                    string  `json:"name"`
+	IsCustomModel           bool    `json:"is_custom_model"`
+	CustomModelTrainingDate *string `json:"custom_model_training_date,omitempty"`
+	TotalEngagedUsers       int     `json:"total_engaged_users"`
+	TotalChats              int     `json:"total_chats"`
+}
+
+// CopilotDotcomPullRequests represents the usage of Copilot pull request summaries on github.com.
+type CopilotDotcomPullRequests struct {
+	TotalEngagedUsers int                                    `json:"total_engaged_users"`
+	Repositories      []*CopilotDotcomPullRequestsRepository `json:"repositories"`
+}
+
+// CopilotDotcomPullRequestsRepository represents the usage of Copilot pull request summaries in a repository.
+type CopilotDotcomPullRequestsRepository struct {
+	// Name is the full name of the repository, for example "octo-org/octo-repo".
+	Name              string                            `json:"name"`
+	TotalEngagedUsers int                               `json:"total_engaged_users"`
+	Models            []*CopilotDotcomPullRequestsModel `json:"models"`
+}
+
+// CopilotDotcomPullRequestsModel represents the usage of a model for Copilot pull request summaries in a repository.
+type CopilotDotcomPullRequestsModel struct {
+	Name                    string  `json:"name"`
+	IsCustomModel           bool    `json:"is_custom_model"`
+	CustomModelTrainingDate *string `json:"custom_model_training_date,omitempty"`
+	TotalPRSummariesCreated int     `json:"total_pr_summaries_created"`
+	TotalEngagedUsers       int     `json:"total_engaged_users"`
+}
+
+// GetOrganizationMetrics gets the daily Copilot usage metrics for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-metrics#get-copilot-metrics-for-an-organization
+func (s *CopilotService) GetOrganizationMetrics(ctx context.Context, org string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/metrics", org)
+	return s.listCopilotMetrics(ctx, u, opts)
+}
+
+// GetOrganizationTeamMetrics gets the daily Copilot usage metrics for a team of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-metrics#get-copilot-metrics-for-a-team
+func (s *CopilotService) GetOrganizationTeamMetrics(ctx context.Context, org, team string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/team/%v/copilot/metrics", org, team)
+	return s.listCopilotMetrics(ctx, u, opts)
+}
+
+// GetEnterpriseMetrics gets the daily Copilot usage metrics for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-metrics#get-copilot-metrics-for-an-enterprise
+func (s *CopilotService) GetEnterpriseMetrics(ctx context.Context, enterprise string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/copilot/metrics", enterprise)
+	return s.listCopilotMetrics(ctx, u, opts)
+}
+
+// GetEnterpriseTeamMetrics gets the daily Copilot usage metrics for a team of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/rest/copilot/copilot-metrics#get-copilot-metrics-for-an-enterprise-team
+func (s *CopilotService) GetEnterpriseTeamMetrics(ctx context.Context, enterprise, team string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/team/%v/copilot/metrics", enterprise, team)
+	return s.listCopilotMetrics(ctx, u, opts)
+}
+
+func (s *CopilotService) listCopilotMetrics(ctx context.Context, u string, opts *CopilotMetricsListOptions) ([]*CopilotMetrics, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metrics []*CopilotMetrics
+	resp, err := s.client.Do(ctx, req, &metrics)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return metrics, resp, nil
+}
@@ -0,0 +1,702 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCopilotSeatDetails_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *CopilotSeatDetails
+		wantErr bool
+	}{
+		{
+			name: "user assignee",
+			data: `{"assignee":{"type":"User","login":"octocat","id":1},"created_at":"2021-08-03T18:00:00Z"}`,
+			want: &CopilotSeatDetails{
+				Assignee:  &User{Type: String("User"), Login: String("octocat"), ID: Int64(1)},
+				CreatedAt: &Timestamp{time.Date(2021, time.August, 3, 18, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "team assignee",
+			data: `{"assignee":{"type":"Team","name":"justice-league","id":1}}`,
+			want: &CopilotSeatDetails{Assignee: &Team{Name: String("justice-league"), ID: Int64(1)}},
+		},
+		{
+			name: "organization assignee",
+			data: `{"assignee":{"type":"Organization","login":"github","id":1}}`,
+			want: &CopilotSeatDetails{Assignee: &Organization{Type: String("Organization"), Login: String("github"), ID: Int64(1)}},
+		},
+		{
+			name: "no assignee",
+			data: `{"last_activity_editor":"vscode"}`,
+			want: &CopilotSeatDetails{LastActivityEditor: String("vscode")},
+		},
+		{
+			name:    "unsupported assignee type",
+			data:    `{"assignee":{"type":"Bot","login":"bot"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid assignee",
+			data:    `{"assignee":{"type":"User","login":1}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(CopilotSeatDetails)
+			err := json.Unmarshal([]byte(tt.data), got)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("json.Unmarshal returned nil error, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("json.Unmarshal returned %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopilotSeatDetails_GetAssignee(t *testing.T) {
+	seat := &CopilotSeatDetails{Assignee: &User{Login: String("octocat")}}
+	if u, ok := seat.GetUser(); !ok || u.GetLogin() != "octocat" {
+		t.Errorf("GetUser returned %v, %v", u, ok)
+	}
+	if _, ok := seat.GetTeam(); ok {
+		t.Error("GetTeam returned ok for a user assignee")
+	}
+	if _, ok := seat.GetOrganization(); ok {
+		t.Error("GetOrganization returned ok for a user assignee")
+	}
+
+	seat = &CopilotSeatDetails{Assignee: &Team{Name: String("t")}}
+	if team, ok := seat.GetTeam(); !ok || team.GetName() != "t" {
+		t.Errorf("GetTeam returned %v, %v", team, ok)
+	}
+
+	seat = &CopilotSeatDetails{Assignee: &Organization{Login: String("o")}}
+	if org, ok := seat.GetOrganization(); !ok || org.GetLogin() != "o" {
+		t.Errorf("GetOrganization returned %v, %v", org, ok)
+	}
+}
+
+func TestCopilotService_GetCopilotBilling(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"seat_breakdown": {
+				"total": 12,
+				"added_this_cycle": 9,
+				"pending_invitation": 0,
+				"pending_cancellation": 0,
+				"active_this_cycle": 12,
+				"inactive_this_cycle": 11
+			},
+			"seat_management_setting": "assign_selected",
+			"public_code_suggestions": "block",
+			"copilot_chat": "enabled"
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.GetCopilotBilling(ctx, "o")
+	if err != nil {
+		t.Errorf("Copilot.GetCopilotBilling returned error: %v", err)
+	}
+
+	want := &CopilotOrganizationDetails{
+		SeatBreakdown: &CopilotSeatBreakdown{
+			Total:               12,
+			AddedThisCycle:      9,
+			PendingInvitation:   0,
+			PendingCancellation: 0,
+			ActiveThisCycle:     12,
+			InactiveThisCycle:   11,
+		},
+		PublicCodeSuggestions: "block",
+		CopilotChat:           "enabled",
+		SeatManagementSetting: "assign_selected",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.GetCopilotBilling returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetCopilotBilling"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.GetCopilotBilling(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.GetCopilotBilling(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_ListCopilotSeats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/seats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		fmt.Fprint(w, `{
+			"total_seats": 2,
+			"seats": [
+				{
+					"created_at": "2021-08-03T18:00:00-06:00",
+					"updated_at": "2021-09-23T15:00:00-06:00",
+					"pending_cancellation_date": null,
+					"last_activity_at": "2021-10-14T00:53:32-06:00",
+					"last_activity_editor": "vscode/1.77.3/copilot/1.86.82",
+					"assignee": {"login": "octocat", "id": 1, "type": "User"},
+					"assigning_team": {"id": 1, "name": "Justice League", "slug": "justice-league"}
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2, PerPage: 1}
+	got, _, err := client.Copilot.ListCopilotSeats(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Copilot.ListCopilotSeats returned error: %v", err)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, "2021-08-03T18:00:00-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updatedAt, err := time.Parse(time.RFC3339, "2021-09-23T15:00:00-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastActivityAt, err := time.Parse(time.RFC3339, "2021-10-14T00:53:32-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListCopilotSeatsResponse{
+		TotalSeats: 2,
+		Seats: []*CopilotSeatDetails{
+			{
+				Assignee: &User{Login: String("octocat"), ID: Int64(1), Type: String("User")},
+				AssigningTeam: &Team{
+					ID:   Int64(1),
+					Name: String("Justice League"),
+					Slug: String("justice-league"),
+				},
+				CreatedAt:          &Timestamp{createdAt},
+				UpdatedAt:          &Timestamp{updatedAt},
+				LastActivityAt:     &Timestamp{lastActivityAt},
+				LastActivityEditor: String("vscode/1.77.3/copilot/1.86.82"),
+			},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.ListCopilotSeats returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListCopilotSeats"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.ListCopilotSeats(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.ListCopilotSeats(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_AddCopilotTeams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/selected_teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"selected_teams":["team1","team2"]}`+"\n")
+		fmt.Fprint(w, `{"seats_created": 2}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.AddCopilotTeams(ctx, "o", []string{"team1", "team2"})
+	if err != nil {
+		t.Errorf("Copilot.AddCopilotTeams returned error: %v", err)
+	}
+
+	want := &SeatAssignments{SeatsCreated: 2}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.AddCopilotTeams returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "AddCopilotTeams"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.AddCopilotTeams(ctx, "\n", []string{"team1", "team2"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.AddCopilotTeams(ctx, "o", []string{"team1", "team2"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_RemoveCopilotTeams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/selected_teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"selected_teams":["team1","team2"]}`+"\n")
+		fmt.Fprint(w, `{"seats_cancelled": 2}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.RemoveCopilotTeams(ctx, "o", []string{"team1", "team2"})
+	if err != nil {
+		t.Errorf("Copilot.RemoveCopilotTeams returned error: %v", err)
+	}
+
+	want := &SeatCancellations{SeatsCancelled: 2}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.RemoveCopilotTeams returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "RemoveCopilotTeams"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.RemoveCopilotTeams(ctx, "\n", []string{"team1", "team2"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.RemoveCopilotTeams(ctx, "o", []string{"team1", "team2"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_AddCopilotUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/selected_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"selected_usernames":["user1","user2"]}`+"\n")
+		fmt.Fprint(w, `{"seats_created": 2}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.AddCopilotUsers(ctx, "o", []string{"user1", "user2"})
+	if err != nil {
+		t.Errorf("Copilot.AddCopilotUsers returned error: %v", err)
+	}
+
+	want := &SeatAssignments{SeatsCreated: 2}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.AddCopilotUsers returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "AddCopilotUsers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.AddCopilotUsers(ctx, "\n", []string{"user1", "user2"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.AddCopilotUsers(ctx, "o", []string{"user1", "user2"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_RemoveCopilotUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/selected_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"selected_usernames":["user1","user2"]}`+"\n")
+		fmt.Fprint(w, `{"seats_cancelled": 2}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.RemoveCopilotUsers(ctx, "o", []string{"user1", "user2"})
+	if err != nil {
+		t.Errorf("Copilot.RemoveCopilotUsers returned error: %v", err)
+	}
+
+	want := &SeatCancellations{SeatsCancelled: 2}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.RemoveCopilotUsers returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "RemoveCopilotUsers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.RemoveCopilotUsers(ctx, "\n", []string{"user1", "user2"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.RemoveCopilotUsers(ctx, "o", []string{"user1", "user2"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_GetSeatDetails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members/u/copilot", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"created_at": "2021-08-03T18:00:00Z",
+			"pending_cancellation_date": "2021-11-01",
+			"last_activity_editor": "vscode",
+			"assignee": {"login": "u", "id": 1, "type": "User"}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.GetSeatDetails(ctx, "o", "u")
+	if err != nil {
+		t.Errorf("Copilot.GetSeatDetails returned error: %v", err)
+	}
+
+	want := &CopilotSeatDetails{
+		Assignee:                &User{Login: String("u"), ID: Int64(1), Type: String("User")},
+		PendingCancellationDate: String("2021-11-01"),
+		LastActivityEditor:      String("vscode"),
+		CreatedAt:               &Timestamp{time.Date(2021, time.August, 3, 18, 0, 0, 0, time.UTC)},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.GetSeatDetails returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetSeatDetails"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.GetSeatDetails(ctx, "\n", "u")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.GetSeatDetails(ctx, "o", "u")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_GetOrganizationMetrics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"since":    "2024-06-01T00:00:00Z",
+			"until":    "2024-06-24T00:00:00Z",
+			"page":     "2",
+			"per_page": "1",
+		})
+		fmt.Fprint(w, `[{
+			"date": "2024-06-24",
+			"total_active_users": 24,
+			"total_engaged_users": 20,
+			"copilot_ide_code_completions": {
+				"total_engaged_users": 20,
+				"languages": [{"name": "python", "total_engaged_users": 10}],
+				"editors": [{
+					"name": "vscode",
+					"total_engaged_users": 13,
+					"models": [{
+						"name": "default",
+						"is_custom_model": false,
+						"custom_model_training_date": null,
+						"total_engaged_users": 13,
+						"languages": [{
+							"name": "python",
+							"total_engaged_users": 6,
+							"total_code_suggestions": 249,
+							"total_code_acceptances": 123,
+							"total_code_lines_suggested": 225,
+							"total_code_lines_accepted": 135
+						}]
+					}]
+				}]
+			},
+			"copilot_ide_chat": {
+				"total_engaged_users": 13,
+				"editors": [{
+					"name": "vscode",
+					"total_engaged_users": 13,
+					"models": [{
+						"name": "a-custom-model",
+						"is_custom_model": true,
+						"custom_model_training_date": "2024-02-01",
+						"total_engaged_users": 12,
+						"total_chats": 45,
+						"total_chat_insertion_events": 12,
+						"total_chat_copy_events": 16
+					}]
+				}]
+			},
+			"copilot_dotcom_chat": {
+				"total_engaged_users": 14,
+				"models": [{"name": "default", "is_custom_model": false, "total_engaged_users": 14, "total_chats": 38}]
+			},
+			"copilot_dotcom_pull_requests": {
+				"total_engaged_users": 12,
+				"repositories": [{
+					"name": "demo/repo1",
+					"total_engaged_users": 8,
+					"models": [{"name": "default", "is_custom_model": false, "total_pr_summaries_created": 6, "total_engaged_users": 8}]
+				}]
+			}
+		}]`)
+	})
+
+	ctx := context.Background()
+	opts := &CopilotMetricsListOptions{
+		Since:       time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		Until:       time.Date(2024, time.June, 24, 0, 0, 0, 0, time.UTC),
+		ListOptions: ListOptions{Page: 2, PerPage: 1},
+	}
+	got, _, err := client.Copilot.GetOrganizationMetrics(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Copilot.GetOrganizationMetrics returned error: %v", err)
+	}
+
+	want := []*CopilotMetrics{
+		{
+			Date:              "2024-06-24",
+			TotalActiveUsers:  Int(24),
+			TotalEngagedUsers: Int(20),
+			CopilotIDECodeCompletions: &CopilotIDECodeCompletions{
+				TotalEngagedUsers: 20,
+				Languages:         []*CopilotIDECodeCompletionsLanguage{{Name: "python", TotalEngagedUsers: 10}},
+				Editors: []*CopilotIDECodeCompletionsEditor{
+					{
+						Name:              "vscode",
+						TotalEngagedUsers: 13,
+						Models: []*CopilotIDECodeCompletionsModel{
+							{
+								Name:              "default",
+								TotalEngagedUsers: 13,
+								Languages: []*CopilotIDECodeCompletionsModelLanguage{
+									{
+										Name:                    "python",
+										TotalEngagedUsers:       6,
+										TotalCodeSuggestions:    249,
+										TotalCodeAcceptances:    123,
+										TotalCodeLinesSuggested: 225,
+										TotalCodeLinesAccepted:  135,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			CopilotIDEChat: &CopilotIDEChat{
+				TotalEngagedUsers: 13,
+				Editors: []*CopilotIDEChatEditor{
+					{
+						Name:              "vscode",
+						TotalEngagedUsers: 13,
+						Models: []*CopilotIDEChatModel{
+							{
+								Name:                     "a-custom-model",
+								IsCustomModel:            true,
+								CustomModelTrainingDate:  String("2024-02-01"),
+								TotalEngagedUsers:        12,
+								TotalChats:               45,
+								TotalChatInsertionEvents: 12,
+								TotalChatCopyEvents:      16,
+							},
+						},
+					},
+				},
+			},
+			CopilotDotcomChat: &CopilotDotcomChat{
+				TotalEngagedUsers: 14,
+				Models:            []*CopilotDotcomChatModel{{Name: "default", TotalEngagedUsers: 14, TotalChats: 38}},
+			},
+			CopilotDotcomPullRequests: &CopilotDotcomPullRequests{
+				TotalEngagedUsers: 12,
+				Repositories: []*CopilotDotcomPullRequestsRepository{
+					{
+						Name:              "demo/repo1",
+						TotalEngagedUsers: 8,
+						Models:            []*CopilotDotcomPullRequestsModel{{Name: "default", TotalPRSummariesCreated: 6, TotalEngagedUsers: 8}},
+					},
+				},
+			},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.GetOrganizationMetrics returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetOrganizationMetrics"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.GetOrganizationMetrics(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.GetOrganizationMetrics(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_GetOrganizationTeamMetrics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/team/t/copilot/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"date": "2024-06-24", "total_active_users": 4}]`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.GetOrganizationTeamMetrics(ctx, "o", "t", nil)
+	if err != nil {
+		t.Errorf("Copilot.GetOrganizationTeamMetrics returned error: %v", err)
+	}
+
+	want := []*CopilotMetrics{{Date: "2024-06-24", TotalActiveUsers: Int(4)}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.GetOrganizationTeamMetrics returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetOrganizationTeamMetrics"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.GetOrganizationTeamMetrics(ctx, "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.GetOrganizationTeamMetrics(ctx, "o", "t", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_GetEnterpriseMetrics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/copilot/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"since": "2024-06-01T00:00:00Z"})
+		fmt.Fprint(w, `[{"date": "2024-06-24", "total_active_users": 240}]`)
+	})
+
+	ctx := context.Background()
+	opts := &CopilotMetricsListOptions{Since: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)}
+	got, _, err := client.Copilot.GetEnterpriseMetrics(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Copilot.GetEnterpriseMetrics returned error: %v", err)
+	}
+
+	want := []*CopilotMetrics{{Date: "2024-06-24", TotalActiveUsers: Int(240)}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.GetEnterpriseMetrics returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetEnterpriseMetrics"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.GetEnterpriseMetrics(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.GetEnterpriseMetrics(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_GetEnterpriseTeamMetrics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/team/t/copilot/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"date": "2024-06-24", "total_active_users": 3}]`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.GetEnterpriseTeamMetrics(ctx, "e", "t", nil)
+	if err != nil {
+		t.Errorf("Copilot.GetEnterpriseTeamMetrics returned error: %v", err)
+	}
+
+	want := []*CopilotMetrics{{Date: "2024-06-24", TotalActiveUsers: Int(3)}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Copilot.GetEnterpriseTeamMetrics returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetEnterpriseTeamMetrics"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.GetEnterpriseTeamMetrics(ctx, "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.GetEnterpriseTeamMetrics(ctx, "e", "t", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -23,12 +23,27 @@ type AdvisoryCVSs struct {
 	VectorString *string  `json:"vector_string,omitempty"`
 }
 
+// AdvisoryCVSSSeverities represents the scores of an advisory in the
+// versions 3 and 4 of the Common Vulnerability Scoring System.
+type AdvisoryCVSSSeverities struct {
+	CVSSV3 *AdvisoryCVSs `json:"cvss_v3,omitempty"`
+	CVSSV4 *AdvisoryCVSs `json:"cvss_v4,omitempty"`
+}
+
 // AdvisoryCWEs reprensent the advisory pertaining to Common Weakness Enumeration.
 type AdvisoryCWEs struct {
 	CWEID *string `json:"cwe_id,omitempty"`
 	Name  *string `json:"name,omitempty"`
 }
 
+// AdvisoryEPSS represents the Exploit Prediction Scoring System score of an
+// advisory: the probability of the vulnerability being exploited in the
+// next 30 days, and its percentile among all scored vulnerabilities.
+type AdvisoryEPSS struct {
+	Percentage float64 `json:"percentage"`
+	Percentile float64 `json:"percentile"`
+}
+
 // DependabotSecurityAdvisory represents the GitHub Security Advisory.
 type DependabotSecurityAdvisory struct {
 	GHSAID          *string                  `json:"ghsa_id,omitempty"`
@@ -38,7 +53,9 @@ type DependabotSecurityAdvisory struct {
 	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
 	Severity        *string                  `json:"severity,omitempty"`
 	CVSs            *AdvisoryCVSs            `json:"cvss,omitempty"`
+	CVSSSeverities  *AdvisoryCVSSSeverities  `json:"cvss_severities,omitempty"`
 	CWEs            []*AdvisoryCWEs          `json:"cwes,omitempty"`
+	EPSS            *AdvisoryEPSS            `json:"epss,omitempty"`
 	Identifiers     []*AdvisoryIdentifier    `json:"identifiers,omitempty"`
 	References      []*AdvisoryReference     `json:"references,omitempty"`
 	PublishedAt     *Timestamp               `json:"published_at,omitempty"`
@@ -62,16 +79,43 @@ type DependabotAlert struct {
 	DismissedReason       *string                     `json:"dismissed_reason,omitempty"`
 	DismissedComment      *string                     `json:"dismissed_comment,omitempty"`
 	FixedAt               *Timestamp                  `json:"fixed_at,omitempty"`
+	AutoDismissedAt       *Timestamp                  `json:"auto_dismissed_at,omitempty"`
+	// Repository is only populated for alerts listed for an organization or
+	// an enterprise.
+	Repository *Repository `json:"repository,omitempty"`
 }
 
-// ListAlertsOptions specifies the optional parameters to the DependabotService.ListRepoAlerts
-// and DependabotService.ListOrgAlerts methods.
+// DependabotAlertState represents the state of a Dependabot alert to update.
+type DependabotAlertState struct {
+	// State can be one of "dismissed" or "open". Required.
+	State string `json:"state"`
+	// DismissedReason is required when State is "dismissed". It can be one
+	// of "fix_started", "inaccurate", "no_bandwidth", "not_used" or
+	// "tolerable_risk".
+	DismissedReason *string `json:"dismissed_reason,omitempty"`
+	// DismissedComment is an optional comment explaining the dismissal.
+	DismissedComment *string `json:"dismissed_comment,omitempty"`
+}
+
+// ListAlertsOptions specifies the optional parameters to the DependabotService.ListRepoAlerts,
+// DependabotService.ListOrgAlerts and DependabotService.ListEnterpriseAlerts methods.
+//
+// State, Severity, Ecosystem and Package accept comma-separated lists of values.
 type ListAlertsOptions struct {
-	State     *string `url:"state,omitempty"`
-	Severity  *string `url:"severity,omitempty"`
+	// State can be one of "auto_dismissed", "dismissed", "fixed" or "open".
+	State *string `url:"state,omitempty"`
+	// Severity can be one of "low", "medium", "high" or "critical".
+	Severity *string `url:"severity,omitempty"`
+	// Ecosystem can be one of "composer", "go", "maven", "npm", "nuget",
+	// "pip", "pub", "rubygems" or "rust".
 	Ecosystem *string `url:"ecosystem,omitempty"`
 	Package   *string `url:"package,omitempty"`
-	Scope     *string `url:"scope,omitempty"`
+	// Scope can be one of "development" or "runtime".
+	Scope *string `url:"scope,omitempty"`
+	// EPSSPercentage filters alerts by the EPSS percentage of their
+	// advisory, such as "0.1", ">=0.1" or "0.1..0.5".
+	EPSSPercentage *string `url:"epss_percentage,omitempty"`
+	// Sort can be one of "created", "updated" or "epss_percentage".
 	Sort      *string `url:"sort,omitempty"`
 	Direction *string `url:"direction,omitempty"`
 
@@ -114,6 +158,16 @@ func (s *DependabotService) ListOrgAlerts(ctx context.Context, org string, opts
 	return s.listAlerts(ctx, url, opts)
 }
 
+// ListEnterpriseAlerts lists all Dependabot alerts of the repositories owned
+// by the organizations of an enterprise. The authenticated user must be an
+// enterprise owner or security manager.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/dependabot/alerts#list-dependabot-alerts-for-an-enterprise
+func (s *DependabotService) ListEnterpriseAlerts(ctx context.Context, enterprise string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	url := fmt.Sprintf("enterprises/%v/dependabot/alerts", enterprise)
+	return s.listAlerts(ctx, url, opts)
+}
+
 // GetRepoAlert gets a single repository Dependabot alert.
 //
 // GitHub API docs: https://docs.github.com/en/rest/dependabot/alerts#get-a-dependabot-alert
@@ -132,3 +186,23 @@ func (s *DependabotService) GetRepoAlert(ctx context.Context, owner, repo string
 
 	return alert, resp, nil
 }
+
+// UpdateAlert updates the state of a repository Dependabot alert, to
+// dismiss or reopen it.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependabot/alerts#update-a-dependabot-alert
+func (s *DependabotService) UpdateAlert(ctx context.Context, owner, repo string, number int, stateInfo *DependabotAlertState) (*DependabotAlert, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+	req, err := s.client.NewRequest("PATCH", url, stateInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(DependabotAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
@@ -131,3 +131,116 @@ func TestDependabotService_ListOrgAlerts(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestDependabotService_ListEnterpriseAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"state":           "open",
+			"severity":        "high,critical",
+			"epss_percentage": ">=0.1",
+			"sort":            "epss_percentage",
+			"after":           "c1",
+		})
+		fmt.Fprint(w, `[{
+			"number":1,
+			"state":"open",
+			"security_advisory":{"epss":{"percentage":0.2,"percentile":0.9},"cvss_severities":{"cvss_v4":{"score":8.7,"vector_string":"CVSS:4.0/AV:N"}}},
+			"repository":{"id":1,"full_name":"o/r"}
+		}]`)
+	})
+
+	opts := &ListAlertsOptions{
+		State:             String("open"),
+		Severity:          String("high,critical"),
+		EPSSPercentage:    String(">=0.1"),
+		Sort:              String("epss_percentage"),
+		ListCursorOptions: ListCursorOptions{After: "c1"},
+	}
+	ctx := context.Background()
+	alerts, _, err := client.Dependabot.ListEnterpriseAlerts(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListEnterpriseAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{
+		{
+			Number: Int(1),
+			State:  String("open"),
+			SecurityAdvisory: &DependabotSecurityAdvisory{
+				EPSS: &AdvisoryEPSS{Percentage: 0.2, Percentile: 0.9},
+				CVSSSeverities: &AdvisoryCVSSSeverities{
+					CVSSV4: &AdvisoryCVSs{Score: Float64(8.7), VectorString: String("CVSS:4.0/AV:N")},
+				},
+			},
+			Repository: &Repository{ID: Int64(1), FullName: String("o/r")},
+		},
+	}
+	if !cmp.Equal(alerts, want) {
+		t.Errorf("Dependabot.ListEnterpriseAlerts returned %+v, want %+v", alerts, want)
+	}
+
+	const methodName = "ListEnterpriseAlerts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.ListEnterpriseAlerts(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.ListEnterpriseAlerts(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_UpdateAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	state := &DependabotAlertState{
+		State:            "dismissed",
+		DismissedReason:  String("no_bandwidth"),
+		DismissedComment: String("no time to fix this"),
+	}
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"dismissed","dismissed_reason":"no_bandwidth","dismissed_comment":"no time to fix this"}`+"\n")
+		fmt.Fprint(w, `{"number":42,"state":"dismissed","dismissed_reason":"no_bandwidth","dismissed_comment":"no time to fix this","auto_dismissed_at":null}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 42, state)
+	if err != nil {
+		t.Errorf("Dependabot.UpdateAlert returned error: %v", err)
+	}
+
+	want := &DependabotAlert{
+		Number:           Int(42),
+		State:            String("dismissed"),
+		DismissedReason:  String("no_bandwidth"),
+		DismissedComment: String("no time to fix this"),
+	}
+	if !cmp.Equal(alert, want) {
+		t.Errorf("Dependabot.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+
+	const methodName = "UpdateAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.UpdateAlert(ctx, "\n", "\n", 0, state)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 42, state)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
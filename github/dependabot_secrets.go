@@ -114,7 +114,7 @@ func (s *DependabotService) GetOrgSecret(ctx context.Context, org, name string)
 //
 // The value of EncryptedValue must be your secret, encrypted with
 // LibSodium (see documentation here: https://libsodium.gitbook.io/doc/bindings_for_other_languages)
-// using the public key retrieved using the GetPublicKey method.
+// using the public key retrieved using the GetPublicKey method. See PublicKey.Encrypt.
 type DependabotEncryptedSecret struct {
 	Name                  string                           `json:"-"`
 	KeyID                 string                           `json:"key_id"`
@@ -0,0 +1,161 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DependencyGraphService handles communication with the dependency graph
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph
+type DependencyGraphService service
+
+// SBOM represents a software bill of materials, which describes the
+// packages a repository depends on.
+type SBOM struct {
+	SBOM *SBOMInfo `json:"sbom,omitempty"`
+}
+
+// SBOMInfo represents a software bill of materials in the SPDX format.
+type SBOMInfo struct {
+	SPDXID       *string       `json:"SPDXID,omitempty"`
+	SPDXVersion  *string       `json:"spdxVersion,omitempty"`
+	CreationInfo *CreationInfo `json:"creationInfo,omitempty"`
+
+	// Name is the name of the repository.
+	Name              *string  `json:"name,omitempty"`
+	DataLicense       *string  `json:"dataLicense,omitempty"`
+	DocumentDescribes []string `json:"documentDescribes,omitempty"`
+	DocumentNamespace *string  `json:"documentNamespace,omitempty"`
+
+	// Packages lists the repository and the packages it depends on.
+	Packages      []*RepoDependencies `json:"packages,omitempty"`
+	Relationships []*SBOMRelationship `json:"relationships,omitempty"`
+}
+
+// CreationInfo represents when an SBOM was created and who created it.
+type CreationInfo struct {
+	Created  *Timestamp `json:"created,omitempty"`
+	Creators []string   `json:"creators,omitempty"`
+}
+
+// RepoDependencies represents a package of an SBOM.
+type RepoDependencies struct {
+	SPDXID *string `json:"SPDXID,omitempty"`
+	// Name is the name of the package.
+	Name             *string            `json:"name,omitempty"`
+	VersionInfo      *string            `json:"versionInfo,omitempty"`
+	DownloadLocation *string            `json:"downloadLocation,omitempty"`
+	FilesAnalyzed    *bool              `json:"filesAnalyzed,omitempty"`
+	LicenseConcluded *string            `json:"licenseConcluded,omitempty"`
+	LicenseDeclared  *string            `json:"licenseDeclared,omitempty"`
+	Supplier         *string            `json:"supplier,omitempty"`
+	CopyrightText    *string            `json:"copyrightText,omitempty"`
+	ExternalRefs     []*SBOMExternalRef `json:"externalRefs,omitempty"`
+}
+
+// SBOMExternalRef represents an external reference of an SBOM package,
+// such as its package URL.
+type SBOMExternalRef struct {
+	ReferenceCategory *string `json:"referenceCategory,omitempty"`
+	// ReferenceType is for example "purl".
+	ReferenceType    *string `json:"referenceType,omitempty"`
+	ReferenceLocator *string `json:"referenceLocator,omitempty"`
+}
+
+// SBOMRelationship represents a relationship between two elements of an SBOM,
+// for example that the repository depends on a package.
+type SBOMRelationship struct {
+	RelationshipType   *string `json:"relationshipType,omitempty"`
+	SPDXElementID      *string `json:"spdxElementId,omitempty"`
+	RelatedSPDXElement *string `json:"relatedSpdxElement,omitempty"`
+}
+
+// GetSBOM fetches the software bill of materials of a repository, as
+// computed from its dependency graph.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph/sboms#export-a-software-bill-of-materials-sbom-for-a-repository
+func (s *DependencyGraphService) GetSBOM(ctx context.Context, owner, repo string) (*SBOM, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/sbom", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sbom := new(SBOM)
+	resp, err := s.client.Do(ctx, req, sbom)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sbom, resp, nil
+}
+
+// DependencyGraphCompareOptions specifies optional parameters to the
+// DependencyGraphService.Compare method.
+type DependencyGraphCompareOptions struct {
+	// Name is the full path, relative to the repository root, of a
+	// dependency manifest file. Only changes to this manifest are returned.
+	Name string `url:"name,omitempty"`
+}
+
+// DependencyChange represents a dependency added or removed between two
+// revisions of a repository.
+type DependencyChange struct {
+	// ChangeType is either added or removed.
+	ChangeType          *string                    `json:"change_type,omitempty"`
+	Manifest            *string                    `json:"manifest,omitempty"`
+	Ecosystem           *string                    `json:"ecosystem,omitempty"`
+	Name                *string                    `json:"name,omitempty"`
+	Version             *string                    `json:"version,omitempty"`
+	PackageURL          *string                    `json:"package_url,omitempty"`
+	License             *string                    `json:"license,omitempty"`
+	SourceRepositoryURL *string                    `json:"source_repository_url,omitempty"`
+	Vulnerabilities     []*DependencyVulnerability `json:"vulnerabilities,omitempty"`
+	// Scope is one of unknown, runtime or development.
+	Scope *string `json:"scope,omitempty"`
+}
+
+// DependencyVulnerability represents a known vulnerability of a changed dependency.
+type DependencyVulnerability struct {
+	Severity        *string `json:"severity,omitempty"`
+	AdvisoryGHSAID  *string `json:"advisory_ghsa_id,omitempty"`
+	AdvisorySummary *string `json:"advisory_summary,omitempty"`
+	AdvisoryURL     *string `json:"advisory_url,omitempty"`
+}
+
+// Compare lists the dependencies added or removed between the base and head
+// revisions of a repository, with their licenses and known vulnerabilities.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph/dependency-review#get-a-diff-of-the-dependencies-between-commits
+func (s *DependencyGraphService) Compare(ctx context.Context, owner, repo, base, head string, opts *DependencyGraphCompareOptions) ([]*DependencyChange, *Response, error) {
+	escapedBase := url.QueryEscape(base)
+	escapedHead := url.QueryEscape(head)
+
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/compare/%v...%v", owner, repo, escapedBase, escapedHead)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []*DependencyChange
+	resp, err := s.client.Do(ctx, req, &changes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return changes, resp, nil
+}
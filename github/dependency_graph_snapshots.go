@@ -0,0 +1,112 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependencyGraphSnapshot represents the dependencies of a repository at a
+// given commit, as found by an external detector.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph/dependency-submission#create-a-snapshot-of-dependencies-for-a-repository
+type DependencyGraphSnapshot struct {
+	// Version is the version of the snapshot format. (Required.)
+	Version int `json:"version"`
+	// SHA is the commit the dependencies were detected at. (Required.)
+	SHA *string `json:"sha,omitempty"`
+	// Ref is the fully qualified ref of the commit, for example
+	// "refs/heads/main". (Required.)
+	Ref      *string                          `json:"ref,omitempty"`
+	Job      *DependencyGraphSnapshotJob      `json:"job,omitempty"`
+	Detector *DependencyGraphSnapshotDetector `json:"detector,omitempty"`
+	Metadata map[string]interface{}           `json:"metadata,omitempty"`
+	// Manifests maps a manifest key, unique in the snapshot, to the manifest.
+	Manifests map[string]*DependencyGraphSnapshotManifest `json:"manifests,omitempty"`
+	// Scanned is when the dependencies were detected. (Required.)
+	Scanned *Timestamp `json:"scanned,omitempty"`
+}
+
+// DependencyGraphSnapshotJob represents the job which submitted a snapshot.
+type DependencyGraphSnapshotJob struct {
+	// Correlator groups the snapshots of a job: a new snapshot replaces
+	// the previous one with the same correlator and detector. (Required.)
+	Correlator *string `json:"correlator,omitempty"`
+	// ID is the external ID of the job. (Required.)
+	ID      *string `json:"id,omitempty"`
+	HTMLURL *string `json:"html_url,omitempty"`
+}
+
+// DependencyGraphSnapshotDetector represents the tool which detected the
+// dependencies of a snapshot. All its fields are required.
+type DependencyGraphSnapshotDetector struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+	URL     *string `json:"url,omitempty"`
+}
+
+// DependencyGraphSnapshotManifest represents a manifest of a snapshot and
+// the dependencies resolved from it.
+type DependencyGraphSnapshotManifest struct {
+	// Name is the name of the manifest. (Required.)
+	Name     *string                              `json:"name,omitempty"`
+	File     *DependencyGraphSnapshotManifestFile `json:"file,omitempty"`
+	Metadata map[string]interface{}               `json:"metadata,omitempty"`
+	// Resolved maps a package name to the dependency resolved for it.
+	Resolved map[string]*DependencyGraphSnapshotResolvedDependency `json:"resolved,omitempty"`
+}
+
+// DependencyGraphSnapshotManifestFile represents the file of a snapshot manifest.
+type DependencyGraphSnapshotManifestFile struct {
+	// SourceLocation is the path of the manifest, relative to the repository root.
+	SourceLocation *string `json:"source_location,omitempty"`
+}
+
+// DependencyGraphSnapshotResolvedDependency represents a dependency of a
+// snapshot manifest.
+type DependencyGraphSnapshotResolvedDependency struct {
+	// PackageURL is the package URL (purl) of the dependency.
+	PackageURL *string                `json:"package_url,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+	// Relationship is either direct or indirect.
+	Relationship *string `json:"relationship,omitempty"`
+	// Scope is either runtime or development.
+	Scope *string `json:"scope,omitempty"`
+	// Dependencies lists the package URLs of the dependencies of this dependency.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// DependencyGraphSnapshotCreationData represents the result of submitting
+// a snapshot.
+type DependencyGraphSnapshotCreationData struct {
+	ID        *int64     `json:"id,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	// Result is one of SUCCESS, ACCEPTED or INVALID.
+	Result  *string `json:"result,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// CreateSnapshot submits a snapshot of the dependencies of a repository to
+// its dependency graph.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph/dependency-submission#create-a-snapshot-of-dependencies-for-a-repository
+func (s *DependencyGraphService) CreateSnapshot(ctx context.Context, owner, repo string, snapshot *DependencyGraphSnapshot) (*DependencyGraphSnapshotCreationData, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/snapshots", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(DependencyGraphSnapshotCreationData)
+	resp, err := s.client.Do(ctx, req, data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data, resp, nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDependencyGraphService_GetSBOM(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/owner/repo/dependency-graph/sbom", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"sbom": {
+				"creationInfo": {
+					"created": "2021-09-01T00:00:00Z",
+					"creators": ["Tool: GitHub.com-Dependency-Graph"]
+				},
+				"name": "owner/repo",
+				"SPDXID": "SPDXRef-DOCUMENT",
+				"spdxVersion": "SPDX-2.3",
+				"dataLicense": "CC0-1.0",
+				"documentNamespace": "https://github.com/owner/repo/dependency_graph/sbom-1",
+				"packages": [{
+					"SPDXID": "SPDXRef-npm-rubocop-1.0.0",
+					"name": "rubocop",
+					"versionInfo": "1.0.0",
+					"downloadLocation": "NOASSERTION",
+					"filesAnalyzed": false,
+					"licenseConcluded": "MIT",
+					"licenseDeclared": "MIT",
+					"copyrightText": "NOASSERTION",
+					"externalRefs": [{
+						"referenceCategory": "PACKAGE-MANAGER",
+						"referenceType": "purl",
+						"referenceLocator": "pkg:gem/rubocop@1.0.0"
+					}]
+				}],
+				"relationships": [{
+					"relationshipType": "DEPENDS_ON",
+					"spdxElementId": "SPDXRef-Repository",
+					"relatedSpdxElement": "SPDXRef-npm-rubocop-1.0.0"
+				}]
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	sbom, _, err := client.DependencyGraph.GetSBOM(ctx, "owner", "repo")
+	if err != nil {
+		t.Errorf("DependencyGraph.GetSBOM returned error: %v", err)
+	}
+
+	want := &SBOM{
+		&SBOMInfo{
+			SPDXID:      String("SPDXRef-DOCUMENT"),
+			SPDXVersion: String("SPDX-2.3"),
+			CreationInfo: &CreationInfo{
+				Created:  &Timestamp{time.Date(2021, time.September, 1, 0, 0, 0, 0, time.UTC)},
+				Creators: []string{"Tool: GitHub.com-Dependency-Graph"},
+			},
+			Name:              String("owner/repo"),
+			DataLicense:       String("CC0-1.0"),
+			DocumentNamespace: String("https://github.com/owner/repo/dependency_graph/sbom-1"),
+			Packages: []*RepoDependencies{{
+				SPDXID:           String("SPDXRef-npm-rubocop-1.0.0"),
+				Name:             String("rubocop"),
+				VersionInfo:      String("1.0.0"),
+				DownloadLocation: String("NOASSERTION"),
+				FilesAnalyzed:    Bool(false),
+				LicenseConcluded: String("MIT"),
+				LicenseDeclared:  String("MIT"),
+				CopyrightText:    String("NOASSERTION"),
+				ExternalRefs: []*SBOMExternalRef{{
+					ReferenceCategory: String("PACKAGE-MANAGER"),
+					ReferenceType:     String("purl"),
+					ReferenceLocator:  String("pkg:gem/rubocop@1.0.0"),
+				}},
+			}},
+			Relationships: []*SBOMRelationship{{
+				RelationshipType:   String("DEPENDS_ON"),
+				SPDXElementID:      String("SPDXRef-Repository"),
+				RelatedSPDXElement: String("SPDXRef-npm-rubocop-1.0.0"),
+			}},
+		},
+	}
+	if !cmp.Equal(sbom, want) {
+		t.Errorf("DependencyGraph.GetSBOM returned %+v, want %+v", sbom, want)
+	}
+
+	const methodName = "GetSBOM"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DependencyGraph.GetSBOM(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DependencyGraph.GetSBOM(ctx, "owner", "repo")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependencyGraphService_Compare(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/compare/main...feature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"name": "package-lock.json"})
+		fmt.Fprint(w, `[
+			{
+				"change_type": "removed",
+				"manifest": "package-lock.json",
+				"ecosystem": "npm",
+				"name": "helmet",
+				"version": "4.6.0",
+				"package_url": "pkg:npm/helmet@4.6.0",
+				"license": "MIT",
+				"source_repository_url": "https://github.com/helmetjs/helmet",
+				"vulnerabilities": [],
+				"scope": "runtime"
+			},
+			{
+				"change_type": "added",
+				"manifest": "package-lock.json",
+				"ecosystem": "npm",
+				"name": "lodash",
+				"version": "4.17.20",
+				"package_url": "pkg:npm/lodash@4.17.20",
+				"license": "MIT",
+				"vulnerabilities": [{
+					"severity": "high",
+					"advisory_ghsa_id": "GHSA-35jh-r3h4-6jhm",
+					"advisory_summary": "Command Injection in lodash",
+					"advisory_url": "https://github.com/advisories/GHSA-35jh-r3h4-6jhm"
+				}],
+				"scope": "development"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	opts := &DependencyGraphCompareOptions{Name: "package-lock.json"}
+	changes, _, err := client.DependencyGraph.Compare(ctx, "o", "r", "main", "feature", opts)
+	if err != nil {
+		t.Errorf("DependencyGraph.Compare returned error: %v", err)
+	}
+
+	want := []*DependencyChange{
+		{
+			ChangeType:          String("removed"),
+			Manifest:            String("package-lock.json"),
+			Ecosystem:           String("npm"),
+			Name:                String("helmet"),
+			Version:             String("4.6.0"),
+			PackageURL:          String("pkg:npm/helmet@4.6.0"),
+			License:             String("MIT"),
+			SourceRepositoryURL: String("https://github.com/helmetjs/helmet"),
+			Vulnerabilities:     []*DependencyVulnerability{},
+			Scope:               String("runtime"),
+		},
+		{
+			ChangeType: String("added"),
+			Manifest:   String("package-lock.json"),
+			Ecosystem:  String("npm"),
+			Name:       String("lodash"),
+			Version:    String("4.17.20"),
+			PackageURL: String("pkg:npm/lodash@4.17.20"),
+			License:    String("MIT"),
+			Vulnerabilities: []*DependencyVulnerability{{
+				Severity:        String("high"),
+				AdvisoryGHSAID:  String("GHSA-35jh-r3h4-6jhm"),
+				AdvisorySummary: String("Command Injection in lodash"),
+				AdvisoryURL:     String("https://github.com/advisories/GHSA-35jh-r3h4-6jhm"),
+			}},
+			Scope: String("development"),
+		},
+	}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("DependencyGraph.Compare returned %+v, want %+v", changes, want)
+	}
+
+	const methodName = "Compare"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DependencyGraph.Compare(ctx, "\n", "\n", "main", "feature", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DependencyGraph.Compare(ctx, "o", "r", "main", "feature", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
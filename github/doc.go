@@ -208,5 +208,9 @@ github.Response struct.
 		}
 		opt.Page = resp.NextPage
 	}
+
+Methods that make several API calls, such as those listing all pages of a
+collection, waiting for an operation to complete, or applying a batch of
+changes, return the github.Response of the last API call made.
 */
 package github
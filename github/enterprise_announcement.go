@@ -0,0 +1,40 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetAnnouncementBanner gets the announcement banner currently set for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/announcement-banners/enterprises#get-announcement-banner-for-enterprise
+func (s *EnterpriseService) GetAnnouncementBanner(ctx context.Context, enterprise string) (*AnnouncementBanner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/announcement", enterprise)
+	return getAnnouncementBanner(ctx, s.client, u)
+}
+
+// SetAnnouncementBanner sets the announcement banner of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/announcement-banners/enterprises#set-announcement-banner-for-enterprise
+func (s *EnterpriseService) SetAnnouncementBanner(ctx context.Context, enterprise string, banner *AnnouncementBanner) (*AnnouncementBanner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/announcement", enterprise)
+	return setAnnouncementBanner(ctx, s.client, u, banner)
+}
+
+// RemoveAnnouncementBanner removes the announcement banner of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/announcement-banners/enterprises#remove-announcement-banner-from-enterprise
+func (s *EnterpriseService) RemoveAnnouncementBanner(ctx context.Context, enterprise string) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/announcement", enterprise)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
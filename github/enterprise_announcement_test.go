@@ -0,0 +1,115 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnterpriseService_GetAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"announcement":"Maintenance tonight","expires_at":`+referenceTimeStr+`,"user_dismissible":true}`)
+	})
+
+	ctx := context.Background()
+	banner, _, err := client.Enterprise.GetAnnouncementBanner(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetAnnouncementBanner returned error: %v", err)
+	}
+
+	want := &AnnouncementBanner{
+		Announcement:    String("Maintenance tonight"),
+		ExpiresAt:       &Timestamp{referenceTime},
+		UserDismissible: Bool(true),
+	}
+	if !cmp.Equal(banner, want) {
+		t.Errorf("Enterprise.GetAnnouncementBanner returned %+v, want %+v", banner, want)
+	}
+
+	const methodName = "GetAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetAnnouncementBanner(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetAnnouncementBanner(ctx, "e")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_SetAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"announcement":"Maintenance tonight","user_dismissible":false}`+"\n")
+		fmt.Fprint(w, `{"announcement":"Maintenance tonight","expires_at":null,"user_dismissible":false}`)
+	})
+
+	ctx := context.Background()
+	input := &AnnouncementBanner{Announcement: String("Maintenance tonight"), UserDismissible: Bool(false)}
+	banner, _, err := client.Enterprise.SetAnnouncementBanner(ctx, "e", input)
+	if err != nil {
+		t.Errorf("Enterprise.SetAnnouncementBanner returned error: %v", err)
+	}
+
+	if !cmp.Equal(banner, input) {
+		t.Errorf("Enterprise.SetAnnouncementBanner returned %+v, want %+v", banner, input)
+	}
+
+	const methodName = "SetAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.SetAnnouncementBanner(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.SetAnnouncementBanner(ctx, "e", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_RemoveAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Enterprise.RemoveAnnouncementBanner(ctx, "e"); err != nil {
+		t.Errorf("Enterprise.RemoveAnnouncementBanner returned error: %v", err)
+	}
+
+	const methodName = "RemoveAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Enterprise.RemoveAnnouncementBanner(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.RemoveAnnouncementBanner(ctx, "e")
+	})
+}
@@ -0,0 +1,240 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// Audit log stream types accepted in AuditLogStreamConfig.StreamType.
+const (
+	AuditLogStreamTypeAzureBlobStorage    = "Azure Blob Storage"
+	AuditLogStreamTypeAzureEventHubs      = "Azure Event Hubs"
+	AuditLogStreamTypeAmazonS3            = "Amazon S3"
+	AuditLogStreamTypeSplunk              = "Splunk"
+	AuditLogStreamTypeHTTPSEventCollector = "HTTPS Event Collector"
+	AuditLogStreamTypeGoogleCloudStorage  = "Google Cloud Storage"
+	AuditLogStreamTypeDatadog             = "Datadog"
+)
+
+// AuditLogStream represents an audit log stream configured for an enterprise.
+type AuditLogStream struct {
+	ID         *int64  `json:"id,omitempty"`
+	StreamType *string `json:"stream_type,omitempty"`
+	// StreamDetails describes the stream destination, such as the name of a
+	// bucket or the domain of a Splunk instance.
+	StreamDetails *string    `json:"stream_details,omitempty"`
+	Enabled       *bool      `json:"enabled,omitempty"`
+	CreatedAt     *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
+	// PausedAt is set when the stream is paused, either manually or because
+	// GitHub failed to deliver events to the destination.
+	PausedAt *Timestamp `json:"paused_at,omitempty"`
+}
+
+// IsPaused reports whether events are currently not being delivered to the stream.
+func (s *AuditLogStream) IsPaused() bool {
+	return !s.GetEnabled() || s.PausedAt != nil
+}
+
+// AuditLogStreamConfig represents the configuration of an audit log stream.
+//
+// Secrets in the vendor specific configuration must be encrypted with the
+// key returned by EnterpriseService.GetAuditLogStreamKey, the same way
+// Actions secrets are encrypted, and the key ID must be set.
+type AuditLogStreamConfig struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// StreamType is the type of the destination. Possible values are the
+	// AuditLogStreamType constants.
+	StreamType *string `json:"stream_type,omitempty"`
+	// VendorSpecific holds the destination settings matching StreamType:
+	// *AzureBlobStreamConfig, *AzureHubStreamConfig, *AmazonS3OIDCStreamConfig,
+	// *AmazonS3AccessKeysStreamConfig, *SplunkStreamConfig, *HECStreamConfig,
+	// *GoogleCloudStreamConfig or *DatadogStreamConfig.
+	VendorSpecific interface{} `json:"vendor_specific,omitempty"`
+}
+
+// AzureBlobStreamConfig represents the settings of an Azure Blob Storage stream.
+type AzureBlobStreamConfig struct {
+	KeyID           *string `json:"key_id,omitempty"`
+	EncryptedSASURL *string `json:"encrypted_sas_url,omitempty"`
+	Container       *string `json:"container,omitempty"`
+}
+
+// AzureHubStreamConfig represents the settings of an Azure Event Hubs stream.
+type AzureHubStreamConfig struct {
+	Name                *string `json:"name,omitempty"`
+	EncryptedConnstring *string `json:"encrypted_connstring,omitempty"`
+	KeyID               *string `json:"key_id,omitempty"`
+}
+
+// AmazonS3OIDCStreamConfig represents the settings of an Amazon S3 stream
+// authenticating with OpenID Connect.
+type AmazonS3OIDCStreamConfig struct {
+	Bucket *string `json:"bucket,omitempty"`
+	Region *string `json:"region,omitempty"`
+	KeyID  *string `json:"key_id,omitempty"`
+	// AuthenticationType must be "oidc".
+	AuthenticationType *string `json:"authentication_type,omitempty"`
+	ARNRole            *string `json:"arn_role,omitempty"`
+}
+
+// AmazonS3AccessKeysStreamConfig represents the settings of an Amazon S3
+// stream authenticating with access keys.
+type AmazonS3AccessKeysStreamConfig struct {
+	Bucket *string `json:"bucket,omitempty"`
+	Region *string `json:"region,omitempty"`
+	KeyID  *string `json:"key_id,omitempty"`
+	// AuthenticationType must be "access_keys".
+	AuthenticationType   *string `json:"authentication_type,omitempty"`
+	EncryptedSecretKey   *string `json:"encrypted_secret_key,omitempty"`
+	EncryptedAccessKeyID *string `json:"encrypted_access_key_id,omitempty"`
+}
+
+// SplunkStreamConfig represents the settings of a Splunk stream.
+type SplunkStreamConfig struct {
+	Domain         *string `json:"domain,omitempty"`
+	Port           *int    `json:"port,omitempty"`
+	KeyID          *string `json:"key_id,omitempty"`
+	EncryptedToken *string `json:"encrypted_token,omitempty"`
+	SSLVerify      *bool   `json:"ssl_verify,omitempty"`
+}
+
+// HECStreamConfig represents the settings of an HTTPS Event Collector stream.
+type HECStreamConfig struct {
+	Domain         *string `json:"domain,omitempty"`
+	Port           *int    `json:"port,omitempty"`
+	KeyID          *string `json:"key_id,omitempty"`
+	EncryptedToken *string `json:"encrypted_token,omitempty"`
+	Path           *string `json:"path,omitempty"`
+	SSLVerify      *bool   `json:"ssl_verify,omitempty"`
+}
+
+// GoogleCloudStreamConfig represents the settings of a Google Cloud Storage stream.
+type GoogleCloudStreamConfig struct {
+	Bucket                   *string `json:"bucket,omitempty"`
+	KeyID                    *string `json:"key_id,omitempty"`
+	EncryptedJSONCredentials *string `json:"encrypted_json_credentials,omitempty"`
+}
+
+// DatadogStreamConfig represents the settings of a Datadog stream.
+type DatadogStreamConfig struct {
+	EncryptedToken *string `json:"encrypted_token,omitempty"`
+	// Site is the Datadog site, such as "US" or "EU".
+	Site  *string `json:"site,omitempty"`
+	KeyID *string `json:"key_id,omitempty"`
+}
+
+// GetAuditLogStreamKey gets the public key used to encrypt the secrets of
+// audit log stream configurations.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#get-the-audit-log-stream-key-for-encrypting-secrets
+func (s *EnterpriseService) GetAuditLogStreamKey(ctx context.Context, enterprise string) (*PublicKey, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/stream-key", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(PublicKey)
+	resp, err := s.client.Do(ctx, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, nil
+}
+
+// ListAuditLogStreams lists the audit log streams configured for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#list-audit-log-stream-configurations-for-an-enterprise
+func (s *EnterpriseService) ListAuditLogStreams(ctx context.Context, enterprise string) ([]*AuditLogStream, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var streams []*AuditLogStream
+	resp, err := s.client.Do(ctx, req, &streams)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return streams, resp, nil
+}
+
+// GetAuditLogStream gets an audit log stream configured for an enterprise.
+// Use AuditLogStream.IsPaused to check whether events are being delivered.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#list-one-audit-log-streaming-configuration-via-a-stream-id
+func (s *EnterpriseService) GetAuditLogStream(ctx context.Context, enterprise string, streamID int64) (*AuditLogStream, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams/%v", enterprise, streamID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(AuditLogStream)
+	resp, err := s.client.Do(ctx, req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, nil
+}
+
+// CreateAuditLogStream creates an audit log stream for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#create-an-audit-log-streaming-configuration-for-an-enterprise
+func (s *EnterpriseService) CreateAuditLogStream(ctx context.Context, enterprise string, config *AuditLogStreamConfig) (*AuditLogStream, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams", enterprise)
+	req, err := s.client.NewRequest("POST", u, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(AuditLogStream)
+	resp, err := s.client.Do(ctx, req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, nil
+}
+
+// UpdateAuditLogStream updates an audit log stream configured for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#update-an-existing-audit-log-stream-configuration
+func (s *EnterpriseService) UpdateAuditLogStream(ctx context.Context, enterprise string, streamID int64, config *AuditLogStreamConfig) (*AuditLogStream, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams/%v", enterprise, streamID)
+	req, err := s.client.NewRequest("PUT", u, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(AuditLogStream)
+	resp, err := s.client.Do(ctx, req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, nil
+}
+
+// DeleteAuditLogStream deletes an audit log stream configured for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#delete-an-audit-log-streaming-configuration-for-an-enterprise
+func (s *EnterpriseService) DeleteAuditLogStream(ctx context.Context, enterprise string, streamID int64) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams/%v", enterprise, streamID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
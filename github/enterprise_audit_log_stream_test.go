@@ -0,0 +1,251 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnterpriseService_GetAuditLogStreamKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log/stream-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Enterprise.GetAuditLogStreamKey(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetAuditLogStreamKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !cmp.Equal(key, want) {
+		t.Errorf("Enterprise.GetAuditLogStreamKey returned %+v, want %+v", key, want)
+	}
+
+	const methodName = "GetAuditLogStreamKey"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetAuditLogStreamKey(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetAuditLogStreamKey(ctx, "e")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_ListAuditLogStreams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log/streams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"stream_type":"Splunk","stream_details":"US","enabled":true,"created_at":`+referenceTimeStr+`},
+			{"id":2,"stream_type":"Amazon S3","stream_details":"bucket","enabled":true,"paused_at":`+referenceTimeStr+`}
+		]`)
+	})
+
+	ctx := context.Background()
+	streams, _, err := client.Enterprise.ListAuditLogStreams(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.ListAuditLogStreams returned error: %v", err)
+	}
+
+	want := []*AuditLogStream{
+		{ID: Int64(1), StreamType: String("Splunk"), StreamDetails: String("US"), Enabled: Bool(true), CreatedAt: &Timestamp{referenceTime}},
+		{ID: Int64(2), StreamType: String("Amazon S3"), StreamDetails: String("bucket"), Enabled: Bool(true), PausedAt: &Timestamp{referenceTime}},
+	}
+	if !cmp.Equal(streams, want) {
+		t.Errorf("Enterprise.ListAuditLogStreams returned %+v, want %+v", streams, want)
+	}
+	if streams[0].IsPaused() || !streams[1].IsPaused() {
+		t.Errorf("IsPaused returned %v and %v, want false and true", streams[0].IsPaused(), streams[1].IsPaused())
+	}
+
+	const methodName = "ListAuditLogStreams"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.ListAuditLogStreams(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.ListAuditLogStreams(ctx, "e")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_GetAuditLogStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log/streams/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"stream_type":"Datadog","enabled":false}`)
+	})
+
+	ctx := context.Background()
+	stream, _, err := client.Enterprise.GetAuditLogStream(ctx, "e", 1)
+	if err != nil {
+		t.Errorf("Enterprise.GetAuditLogStream returned error: %v", err)
+	}
+
+	want := &AuditLogStream{ID: Int64(1), StreamType: String("Datadog"), Enabled: Bool(false)}
+	if !cmp.Equal(stream, want) {
+		t.Errorf("Enterprise.GetAuditLogStream returned %+v, want %+v", stream, want)
+	}
+	if !stream.IsPaused() {
+		t.Error("IsPaused returned false for a disabled stream, want true")
+	}
+
+	const methodName = "GetAuditLogStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetAuditLogStream(ctx, "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetAuditLogStream(ctx, "e", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_CreateAuditLogStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log/streams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"enabled":true,"stream_type":"Splunk","vendor_specific":{"domain":"splunk.example.com","port":8088,"key_id":"1234","encrypted_token":"secret","ssl_verify":true}}`+"\n")
+		fmt.Fprint(w, `{"id":1,"stream_type":"Splunk","enabled":true}`)
+	})
+
+	ctx := context.Background()
+	config := &AuditLogStreamConfig{
+		Enabled:    Bool(true),
+		StreamType: String(AuditLogStreamTypeSplunk),
+		VendorSpecific: &SplunkStreamConfig{
+			Domain:         String("splunk.example.com"),
+			Port:           Int(8088),
+			KeyID:          String("1234"),
+			EncryptedToken: String("secret"),
+			SSLVerify:      Bool(true),
+		},
+	}
+	stream, _, err := client.Enterprise.CreateAuditLogStream(ctx, "e", config)
+	if err != nil {
+		t.Errorf("Enterprise.CreateAuditLogStream returned error: %v", err)
+	}
+
+	want := &AuditLogStream{ID: Int64(1), StreamType: String("Splunk"), Enabled: Bool(true)}
+	if !cmp.Equal(stream, want) {
+		t.Errorf("Enterprise.CreateAuditLogStream returned %+v, want %+v", stream, want)
+	}
+
+	const methodName = "CreateAuditLogStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.CreateAuditLogStream(ctx, "\n", config)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.CreateAuditLogStream(ctx, "e", config)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_UpdateAuditLogStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log/streams/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled":false,"stream_type":"Amazon S3","vendor_specific":{"bucket":"b","region":"us-east-1","key_id":"1234","authentication_type":"oidc","arn_role":"arn:aws:iam::1:role/r"}}`+"\n")
+		fmt.Fprint(w, `{"id":1,"stream_type":"Amazon S3","enabled":false}`)
+	})
+
+	ctx := context.Background()
+	config := &AuditLogStreamConfig{
+		Enabled:    Bool(false),
+		StreamType: String(AuditLogStreamTypeAmazonS3),
+		VendorSpecific: &AmazonS3OIDCStreamConfig{
+			Bucket:             String("b"),
+			Region:             String("us-east-1"),
+			KeyID:              String("1234"),
+			AuthenticationType: String("oidc"),
+			ARNRole:            String("arn:aws:iam::1:role/r"),
+		},
+	}
+	stream, _, err := client.Enterprise.UpdateAuditLogStream(ctx, "e", 1, config)
+	if err != nil {
+		t.Errorf("Enterprise.UpdateAuditLogStream returned error: %v", err)
+	}
+
+	want := &AuditLogStream{ID: Int64(1), StreamType: String("Amazon S3"), Enabled: Bool(false)}
+	if !cmp.Equal(stream, want) {
+		t.Errorf("Enterprise.UpdateAuditLogStream returned %+v, want %+v", stream, want)
+	}
+
+	const methodName = "UpdateAuditLogStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.UpdateAuditLogStream(ctx, "\n", 1, config)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.UpdateAuditLogStream(ctx, "e", 1, config)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_DeleteAuditLogStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log/streams/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Enterprise.DeleteAuditLogStream(ctx, "e", 1); err != nil {
+		t.Errorf("Enterprise.DeleteAuditLogStream returned error: %v", err)
+	}
+
+	const methodName = "DeleteAuditLogStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Enterprise.DeleteAuditLogStream(ctx, "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.DeleteAuditLogStream(ctx, "e", 1)
+	})
+}
@@ -25,6 +25,7 @@ func TestEnterpriseService_GetCodeSecurityAndAnalysis(t *testing.T) {
 		fmt.Fprint(w, `
 		{
 		  "advanced_security_enabled_for_new_repositories": true,
+		  "dependabot_alerts_enabled_for_new_repositories": true,
 		  "secret_scanning_enabled_for_new_repositories": true,
 		  "secret_scanning_push_protection_enabled_for_new_repositories": true,
 		  "secret_scanning_push_protection_custom_link": "https://github.com/test-org/test-repo/blob/main/README.md"
@@ -41,6 +42,7 @@ func TestEnterpriseService_GetCodeSecurityAndAnalysis(t *testing.T) {
 	}
 	want := &EnterpriseSecurityAnalysisSettings{
 		AdvancedSecurityEnabledForNewRepositories:             Bool(true),
+		DependabotAlertsEnabledForNewRepositories:             Bool(true),
 		SecretScanningEnabledForNewRepositories:               Bool(true),
 		SecretScanningPushProtectionEnabledForNewRepositories: Bool(true),
 		SecretScanningPushProtectionCustomLink:                String("https://github.com/test-org/test-repo/blob/main/README.md"),
@@ -70,6 +72,7 @@ func TestEnterpriseService_UpdateCodeSecurityAndAnalysis(t *testing.T) {
 
 	input := &EnterpriseSecurityAnalysisSettings{
 		AdvancedSecurityEnabledForNewRepositories:             Bool(true),
+		DependabotAlertsEnabledForNewRepositories:             Bool(false),
 		SecretScanningEnabledForNewRepositories:               Bool(true),
 		SecretScanningPushProtectionEnabledForNewRepositories: Bool(true),
 		SecretScanningPushProtectionCustomLink:                String("https://github.com/test-org/test-repo/blob/main/README.md"),
@@ -130,3 +133,19 @@ func TestEnterpriseService_EnableAdvancedSecurity(t *testing.T) {
 		return client.Enterprise.EnableDisableSecurityFeature(ctx, "e", "advanced_security", "enable_all")
 	})
 }
+
+func TestEnterpriseService_EnableDependabotAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/dependabot_alerts/disable_all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.EnableDisableSecurityFeature(ctx, "e", "dependabot_alerts", "disable_all")
+	if err != nil {
+		t.Errorf("Enterprise.EnableDisableSecurityFeature returned error: %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// EnterpriseConsumedLicenses represents the license consumption of an
+// enterprise. Users holds one page of the users consuming a license.
+type EnterpriseConsumedLicenses struct {
+	TotalSeatsConsumed  *int                      `json:"total_seats_consumed,omitempty"`
+	TotalSeatsPurchased *int                      `json:"total_seats_purchased,omitempty"`
+	Users               []*EnterpriseLicensedUser `json:"users,omitempty"`
+}
+
+// EnterpriseLicensedUser represents a user consuming a license of an
+// enterprise, across GitHub.com, GitHub Enterprise Server and Visual Studio
+// subscriptions.
+type EnterpriseLicensedUser struct {
+	GitHubComLogin                  *string  `json:"github_com_login,omitempty"`
+	GitHubComName                   *string  `json:"github_com_name,omitempty"`
+	EnterpriseServerUserIDs         []string `json:"enterprise_server_user_ids,omitempty"`
+	GitHubComUser                   *bool    `json:"github_com_user,omitempty"`
+	EnterpriseServerUser            *bool    `json:"enterprise_server_user,omitempty"`
+	VisualStudioSubscriptionUser    *bool    `json:"visual_studio_subscription_user,omitempty"`
+	LicenseType                     *string  `json:"license_type,omitempty"`
+	GitHubComProfile                *string  `json:"github_com_profile,omitempty"`
+	GitHubComMemberRoles            []string `json:"github_com_member_roles,omitempty"`
+	GitHubComEnterpriseRoles        []string `json:"github_com_enterprise_roles,omitempty"`
+	GitHubComVerifiedDomainEmails   []string `json:"github_com_verified_domain_emails,omitempty"`
+	GitHubComSAMLNameID             *string  `json:"github_com_saml_name_id,omitempty"`
+	GitHubComOrgsWithPendingInvites []string `json:"github_com_orgs_with_pending_invites,omitempty"`
+	GitHubComTwoFactorAuth          *bool    `json:"github_com_two_factor_auth,omitempty"`
+	EnterpriseServerPrimaryEmails   []string `json:"enterprise_server_primary_emails,omitempty"`
+	VisualStudioLicenseStatus       *string  `json:"visual_studio_license_status,omitempty"`
+	VisualStudioSubscriptionEmail   *string  `json:"visual_studio_subscription_email,omitempty"`
+	TotalUserAccounts               *int     `json:"total_user_accounts,omitempty"`
+}
+
+// EnterpriseLicenseSyncStatus represents the license sync status of the
+// GitHub Enterprise Server instances connected to an enterprise.
+type EnterpriseLicenseSyncStatus struct {
+	ServerInstances []*EnterpriseServerInstance `json:"server_instances,omitempty"`
+}
+
+// EnterpriseServerInstance represents a GitHub Enterprise Server instance
+// syncing license usage with an enterprise.
+type EnterpriseServerInstance struct {
+	ServerID *string                `json:"server_id,omitempty"`
+	Hostname *string                `json:"hostname,omitempty"`
+	LastSync *EnterpriseLicenseSync `json:"last_sync,omitempty"`
+}
+
+// EnterpriseLicenseSync represents the last license sync of a GitHub
+// Enterprise Server instance.
+type EnterpriseLicenseSync struct {
+	Date *Timestamp `json:"date,omitempty"`
+	// Status is one of success or failed.
+	Status *string `json:"status,omitempty"`
+	Error  *string `json:"error,omitempty"`
+}
+
+// GetConsumedLicenses gets the license consumption of an enterprise. The
+// users consuming a license are paginated with opts.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/license#list-enterprise-consumed-licenses
+func (s *EnterpriseService) GetConsumedLicenses(ctx context.Context, enterprise string, opts *ListOptions) (*EnterpriseConsumedLicenses, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/consumed-licenses", enterprise)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	licenses := new(EnterpriseConsumedLicenses)
+	resp, err := s.client.Do(ctx, req, licenses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return licenses, resp, nil
+}
+
+// GetLicenseSyncStatus gets the license sync status of the GitHub Enterprise
+// Server instances connected to an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/license#get-a-license-sync-status
+func (s *EnterpriseService) GetLicenseSyncStatus(ctx context.Context, enterprise string) (*EnterpriseLicenseSyncStatus, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/license-sync-status", enterprise)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(EnterpriseLicenseSyncStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnterpriseService_GetConsumedLicenses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/consumed-licenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		w.Header().Set("Link", `<https://api.github.com/enterprises/e/consumed-licenses?page=3&per_page=1>; rel="next"`)
+		fmt.Fprint(w, `{
+			"total_seats_consumed": 5,
+			"total_seats_purchased": 10,
+			"users": [{
+				"github_com_login": "octocat",
+				"github_com_user": true,
+				"enterprise_server_user": true,
+				"enterprise_server_user_ids": ["ghes-1:42"],
+				"license_type": "enterprise",
+				"github_com_member_roles": ["o:Owner"],
+				"github_com_two_factor_auth": true,
+				"total_user_accounts": 2
+			}]
+		}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 1}
+	ctx := context.Background()
+	licenses, resp, err := client.Enterprise.GetConsumedLicenses(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.GetConsumedLicenses returned error: %v", err)
+	}
+
+	want := &EnterpriseConsumedLicenses{
+		TotalSeatsConsumed:  Int(5),
+		TotalSeatsPurchased: Int(10),
+		Users: []*EnterpriseLicensedUser{{
+			GitHubComLogin:          String("octocat"),
+			GitHubComUser:           Bool(true),
+			EnterpriseServerUser:    Bool(true),
+			EnterpriseServerUserIDs: []string{"ghes-1:42"},
+			LicenseType:             String("enterprise"),
+			GitHubComMemberRoles:    []string{"o:Owner"},
+			GitHubComTwoFactorAuth:  Bool(true),
+			TotalUserAccounts:       Int(2),
+		}},
+	}
+	if !cmp.Equal(licenses, want) {
+		t.Errorf("Enterprise.GetConsumedLicenses returned %+v, want %+v", licenses, want)
+	}
+	if resp.NextPage != 3 {
+		t.Errorf("Enterprise.GetConsumedLicenses NextPage = %v, want 3", resp.NextPage)
+	}
+
+	const methodName = "GetConsumedLicenses"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetConsumedLicenses(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetConsumedLicenses(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_GetLicenseSyncStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/license-sync-status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"server_instances":[{"server_id":"s1","hostname":"ghes.example.com","last_sync":{"date":`+referenceTimeStr+`,"status":"success","error":""}}]}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.Enterprise.GetLicenseSyncStatus(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetLicenseSyncStatus returned error: %v", err)
+	}
+
+	want := &EnterpriseLicenseSyncStatus{
+		ServerInstances: []*EnterpriseServerInstance{{
+			ServerID: String("s1"),
+			Hostname: String("ghes.example.com"),
+			LastSync: &EnterpriseLicenseSync{
+				Date:   &Timestamp{referenceTime},
+				Status: String("success"),
+				Error:  String(""),
+			},
+		}},
+	}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Enterprise.GetLicenseSyncStatus returned %+v, want %+v", status, want)
+	}
+
+	const methodName = "GetLicenseSyncStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetLicenseSyncStatus(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetLicenseSyncStatus(ctx, "e")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// The organizations and owners of an enterprise on GitHub Enterprise Cloud
+// are only managed through the GitHub GraphQL API. On GitHub Enterprise
+// Server, use AdminService.CreateOrg instead.
+
+// CreateEnterpriseOrgOptions specifies the parameters to the
+// EnterpriseService.CreateOrganization method.
+type CreateEnterpriseOrgOptions struct {
+	// Login is the login of the new organization. Required.
+	Login string
+	// ProfileName is the display name of the new organization. Default is Login.
+	ProfileName string
+	// BillingEmail is the email address receiving the billing notifications
+	// of the organization. Required.
+	BillingEmail string
+	// AdminLogins lists the logins of the users that become the owners of
+	// the organization. At least one is required.
+	AdminLogins []string
+}
+
+// graphQLEnterpriseOrg is the GraphQL representation of an Organization.
+type graphQLEnterpriseOrg struct {
+	ID         string `json:"id"`
+	DatabaseID int64  `json:"databaseId"`
+	Login      string `json:"login"`
+	Name       string `json:"name"`
+	URL        string `json:"url"`
+}
+
+const enterpriseOrgFields = `id databaseId login name url`
+
+func (o *graphQLEnterpriseOrg) toOrganization() *Organization {
+	org := &Organization{
+		NodeID:  String(o.ID),
+		Login:   String(o.Login),
+		HTMLURL: String(o.URL),
+	}
+	if o.DatabaseID != 0 {
+		org.ID = Int64(o.DatabaseID)
+	}
+	if o.Name != "" {
+		org.Name = String(o.Name)
+	}
+	return org
+}
+
+// enterpriseNodeID returns the GraphQL node ID of an enterprise.
+func (s *EnterpriseService) enterpriseNodeID(ctx context.Context, enterprise string) (string, *Response, error) {
+	var data struct {
+		Enterprise *struct {
+			ID string `json:"id"`
+		} `json:"enterprise"`
+	}
+	query := `query($slug: String!) { enterprise(slug: $slug) { id } }`
+	resp, err := s.client.graphQL(ctx, query, map[string]interface{}{"slug": enterprise}, &data)
+	if err != nil {
+		return "", resp, err
+	}
+	if data.Enterprise == nil {
+		return "", resp, fmt.Errorf("enterprise %v not found", enterprise)
+	}
+	return data.Enterprise.ID, resp, nil
+}
+
+// ListOrganizations lists all organizations of an enterprise. The returned
+// organizations have their ID, NodeID, Login, Name and HTMLURL set.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/graphql/reference/objects#enterprise
+func (s *EnterpriseService) ListOrganizations(ctx context.Context, enterprise string) ([]*Organization, *Response, error) {
+	query := `query($slug: String!, $cursor: String) {
+  enterprise(slug: $slug) {
+    organizations(first: 100, after: $cursor) {
+      pageInfo { hasNextPage endCursor }
+      nodes { ` + enterpriseOrgFields + ` }
+    }
+  }
+}`
+	variables := map[string]interface{}{"slug": enterprise}
+
+	var orgs []*Organization
+	var resp *Response
+	for {
+		var data struct {
+			Enterprise *struct {
+				Organizations struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []*graphQLEnterpriseOrg `json:"nodes"`
+				} `json:"organizations"`
+			} `json:"enterprise"`
+		}
+		var err error
+		resp, err = s.client.graphQL(ctx, query, variables, &data)
+		if err != nil {
+			return nil, resp, err
+		}
+		if data.Enterprise == nil {
+			return nil, resp, fmt.Errorf("enterprise %v not found", enterprise)
+		}
+
+		page := data.Enterprise.Organizations
+		for _, o := range page.Nodes {
+			orgs = append(orgs, o.toOrganization())
+		}
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = page.PageInfo.EndCursor
+	}
+
+	return orgs, resp, nil
+}
+
+// CreateOrganization creates an organization in an enterprise. The
+// authenticated user must be an owner of the enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/graphql/reference/mutations#createenterpriseorganization
+func (s *EnterpriseService) CreateOrganization(ctx context.Context, enterprise string, opts *CreateEnterpriseOrgOptions) (*Organization, *Response, error) {
+	if opts == nil || opts.Login == "" || opts.BillingEmail == "" || len(opts.AdminLogins) == 0 {
+		return nil, nil, errors.New("opts.Login, opts.BillingEmail and opts.AdminLogins are required")
+	}
+	profileName := opts.ProfileName
+	if profileName == "" {
+		profileName = opts.Login
+	}
+
+	id, resp, err := s.enterpriseNodeID(ctx, enterprise)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	query := `mutation($input: CreateEnterpriseOrganizationInput!) {
+  createEnterpriseOrganization(input: $input) {
+    organization { ` + enterpriseOrgFields + ` }
+  }
+}`
+	input := map[string]interface{}{
+		"enterpriseId": id,
+		"login":        opts.Login,
+		"profileName":  profileName,
+		"billingEmail": opts.BillingEmail,
+		"adminLogins":  opts.AdminLogins,
+	}
+	var data struct {
+		CreateEnterpriseOrganization struct {
+			Organization *graphQLEnterpriseOrg `json:"organization"`
+		} `json:"createEnterpriseOrganization"`
+	}
+	resp, err = s.client.graphQL(ctx, query, map[string]interface{}{"input": input}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	org := data.CreateEnterpriseOrganization.Organization
+	if org == nil {
+		return nil, resp, nil
+	}
+	return org.toOrganization(), resp, nil
+}
+
+// InviteOwner invites a user to become an owner of an enterprise. The user
+// becomes an owner once they accept the invitation.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/graphql/reference/mutations#inviteenterpriseadmin
+func (s *EnterpriseService) InviteOwner(ctx context.Context, enterprise, user string) (*Response, error) {
+	id, resp, err := s.enterpriseNodeID(ctx, enterprise)
+	if err != nil {
+		return resp, err
+	}
+
+	query := `mutation($enterpriseId: ID!, $invitee: String!) {
+  inviteEnterpriseAdmin(input: {enterpriseId: $enterpriseId, invitee: $invitee, role: OWNER}) {
+    invitation { id }
+  }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"enterpriseId": id, "invitee": user}, nil)
+}
+
+// RemoveOwner removes a user from the administrators of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/graphql/reference/mutations#removeenterpriseadmin
+func (s *EnterpriseService) RemoveOwner(ctx context.Context, enterprise, user string) (*Response, error) {
+	id, resp, err := s.enterpriseNodeID(ctx, enterprise)
+	if err != nil {
+		return resp, err
+	}
+
+	query := `mutation($enterpriseId: ID!, $login: String!) {
+  removeEnterpriseAdmin(input: {enterpriseId: $enterpriseId, login: $login}) {
+    admin { login }
+  }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"enterpriseId": id, "login": user}, nil)
+}
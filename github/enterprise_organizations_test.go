@@ -0,0 +1,188 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleEnterpriseGraphQL resolves the node ID of enterprise "e" and passes
+// other requests to handle.
+func handleEnterpriseGraphQL(t *testing.T, mux *http.ServeMux, handle func(req *graphQLRequest) string) {
+	t.Helper()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		req := new(graphQLRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+
+		if strings.Contains(req.Query, "enterprise(slug: $slug) { id }") {
+			if req.Variables["slug"] != "e" {
+				fmt.Fprint(w, `{"data":{"enterprise":null}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":{"enterprise":{"id":"E_1"}}}`)
+			return
+		}
+		fmt.Fprint(w, handle(req))
+	})
+}
+
+func TestEnterpriseService_ListOrganizations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleEnterpriseGraphQL(t, mux, func(req *graphQLRequest) string {
+		if req.Variables["cursor"] == nil {
+			return `{"data":{"enterprise":{"organizations":{
+				"pageInfo":{"hasNextPage":true,"endCursor":"c1"},
+				"nodes":[{"id":"O_1","databaseId":1,"login":"a","name":"A","url":"https://github.com/a"}]
+			}}}}`
+		}
+		if got := req.Variables["cursor"]; got != "c1" {
+			t.Errorf("Request cursor = %v, want c1", got)
+		}
+		return `{"data":{"enterprise":{"organizations":{
+			"pageInfo":{"hasNextPage":false},
+			"nodes":[{"id":"O_2","databaseId":2,"login":"b","url":"https://github.com/b"}]
+		}}}}`
+	})
+
+	ctx := context.Background()
+	orgs, _, err := client.Enterprise.ListOrganizations(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.ListOrganizations returned error: %v", err)
+	}
+
+	want := []*Organization{
+		{ID: Int64(1), NodeID: String("O_1"), Login: String("a"), Name: String("A"), HTMLURL: String("https://github.com/a")},
+		{ID: Int64(2), NodeID: String("O_2"), Login: String("b"), HTMLURL: String("https://github.com/b")},
+	}
+	if !cmp.Equal(orgs, want) {
+		t.Errorf("Enterprise.ListOrganizations returned %+v, want %+v", orgs, want)
+	}
+
+	const methodName = "ListOrganizations"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.ListOrganizations(ctx, "e")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_CreateOrganization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleEnterpriseGraphQL(t, mux, func(req *graphQLRequest) string {
+		want := map[string]interface{}{
+			"enterpriseId": "E_1",
+			"login":        "new-org",
+			"profileName":  "new-org",
+			"billingEmail": "billing@example.com",
+			"adminLogins":  []interface{}{"octocat"},
+		}
+		if !cmp.Equal(req.Variables["input"], want) {
+			t.Errorf("Request input = %+v, want %+v", req.Variables["input"], want)
+		}
+		return `{"data":{"createEnterpriseOrganization":{"organization":{"id":"O_3","databaseId":3,"login":"new-org","url":"https://github.com/new-org"}}}}`
+	})
+
+	ctx := context.Background()
+	opts := &CreateEnterpriseOrgOptions{
+		Login:        "new-org",
+		BillingEmail: "billing@example.com",
+		AdminLogins:  []string{"octocat"},
+	}
+	org, _, err := client.Enterprise.CreateOrganization(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.CreateOrganization returned error: %v", err)
+	}
+
+	want := &Organization{ID: Int64(3), NodeID: String("O_3"), Login: String("new-org"), HTMLURL: String("https://github.com/new-org")}
+	if !cmp.Equal(org, want) {
+		t.Errorf("Enterprise.CreateOrganization returned %+v, want %+v", org, want)
+	}
+
+	if _, _, err := client.Enterprise.CreateOrganization(ctx, "unknown", opts); err == nil {
+		t.Error("Enterprise.CreateOrganization returned nil error for an unknown enterprise, want error")
+	}
+	if _, _, err := client.Enterprise.CreateOrganization(ctx, "e", &CreateEnterpriseOrgOptions{Login: "x"}); err == nil {
+		t.Error("Enterprise.CreateOrganization returned nil error for incomplete options, want error")
+	}
+
+	const methodName = "CreateOrganization"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.CreateOrganization(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_InviteOwner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleEnterpriseGraphQL(t, mux, func(req *graphQLRequest) string {
+		if !strings.Contains(req.Query, "inviteEnterpriseAdmin") || !strings.Contains(req.Query, "role: OWNER") {
+			t.Errorf("Request query = %q, want an inviteEnterpriseAdmin mutation with role OWNER", req.Query)
+		}
+		want := map[string]interface{}{"enterpriseId": "E_1", "invitee": "octocat"}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", req.Variables, want)
+		}
+		return `{"data":{"inviteEnterpriseAdmin":{"invitation":{"id":"I_1"}}}}`
+	})
+
+	ctx := context.Background()
+	if _, err := client.Enterprise.InviteOwner(ctx, "e", "octocat"); err != nil {
+		t.Errorf("Enterprise.InviteOwner returned error: %v", err)
+	}
+
+	const methodName = "InviteOwner"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.InviteOwner(ctx, "e", "octocat")
+	})
+}
+
+func TestEnterpriseService_RemoveOwner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleEnterpriseGraphQL(t, mux, func(req *graphQLRequest) string {
+		if !strings.Contains(req.Query, "removeEnterpriseAdmin") {
+			t.Errorf("Request query = %q, want a removeEnterpriseAdmin mutation", req.Query)
+		}
+		want := map[string]interface{}{"enterpriseId": "E_1", "login": "octocat"}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", req.Variables, want)
+		}
+		return `{"data":{"removeEnterpriseAdmin":{"admin":{"login":"octocat"}}}}`
+	})
+
+	ctx := context.Background()
+	if _, err := client.Enterprise.RemoveOwner(ctx, "e", "octocat"); err != nil {
+		t.Errorf("Enterprise.RemoveOwner returned error: %v", err)
+	}
+
+	const methodName = "RemoveOwner"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.RemoveOwner(ctx, "e", "octocat")
+	})
+}
@@ -43,12 +43,18 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 		payload = &ContentReferenceEvent{}
 	case "CreateEvent":
 		payload = &CreateEvent{}
+	case "CustomPropertyEvent":
+		payload = &CustomPropertyEvent{}
+	case "CustomPropertyValuesEvent":
+		payload = &CustomPropertyValuesEvent{}
 	case "DeleteEvent":
 		payload = &DeleteEvent{}
 	case "DeployKeyEvent":
 		payload = &DeployKeyEvent{}
 	case "DeploymentEvent":
 		payload = &DeploymentEvent{}
+	case "DeploymentProtectionRuleEvent":
+		payload = &DeploymentProtectionRuleEvent{}
 	case "DeploymentStatusEvent":
 		payload = &DeploymentStatusEvent{}
 	case "DiscussionEvent":
@@ -99,6 +105,10 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 		payload = &ProjectCardEvent{}
 	case "ProjectColumnEvent":
 		payload = &ProjectColumnEvent{}
+	case "ProjectV2Event":
+		payload = &ProjectV2Event{}
+	case "ProjectV2ItemEvent":
+		payload = &ProjectV2ItemEvent{}
 	case "PublicEvent":
 		payload = &PublicEvent{}
 	case "PullRequestEvent":
@@ -113,6 +123,8 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 		payload = &PullRequestTargetEvent{}
 	case "PushEvent":
 		payload = &PushEvent{}
+	case "RegistryPackageEvent":
+		payload = &RegistryPackageEvent{}
 	case "ReleaseEvent":
 		payload = &ReleaseEvent{}
 	case "RepositoryEvent":
@@ -121,14 +133,20 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 		payload = &RepositoryDispatchEvent{}
 	case "RepositoryImportEvent":
 		payload = &RepositoryImportEvent{}
+	case "RepositoryRulesetEvent":
+		payload = &RepositoryRulesetEvent{}
 	case "RepositoryVulnerabilityAlertEvent":
 		payload = &RepositoryVulnerabilityAlertEvent{}
 	case "SecretScanningAlertEvent":
 		payload = &SecretScanningAlertEvent{}
+	case "SecretScanningAlertLocationEvent":
+		payload = &SecretScanningAlertLocationEvent{}
 	case "StarEvent":
 		payload = &StarEvent{}
 	case "StatusEvent":
 		payload = &StatusEvent{}
+	case "SubIssuesEvent":
+		payload = &SubIssuesEvent{}
 	case "TeamEvent":
 		payload = &TeamEvent{}
 	case "TeamAddEvent":
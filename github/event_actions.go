@@ -0,0 +1,519 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// The constants below are the values of the Action field of webhook events,
+// named after the event type, such as PullRequestActionOpened for
+// PullRequestEvent.
+
+// Actions of BranchProtectionRuleEvent.
+const (
+	BranchProtectionRuleActionCreated = "created"
+	BranchProtectionRuleActionEdited  = "edited"
+	BranchProtectionRuleActionDeleted = "deleted"
+)
+
+// Actions of CheckRunEvent.
+const (
+	CheckRunActionCreated         = "created"
+	CheckRunActionCompleted       = "completed"
+	CheckRunActionRerequested     = "rerequested"
+	CheckRunActionRequestedAction = "requested_action"
+)
+
+// Actions of CheckSuiteEvent.
+const (
+	CheckSuiteActionCompleted   = "completed"
+	CheckSuiteActionRequested   = "requested"
+	CheckSuiteActionRerequested = "rerequested"
+)
+
+// Actions of CodeScanningAlertEvent.
+const (
+	CodeScanningAlertActionAppearedInBranch = "appeared_in_branch"
+	CodeScanningAlertActionClosedByUser     = "closed_by_user"
+	CodeScanningAlertActionCreated          = "created"
+	CodeScanningAlertActionFixed            = "fixed"
+	CodeScanningAlertActionReopened         = "reopened"
+	CodeScanningAlertActionReopenedByUser   = "reopened_by_user"
+)
+
+// Actions of CommitCommentEvent.
+const (
+	CommitCommentActionCreated = "created"
+)
+
+// Actions of ContentReferenceEvent.
+const (
+	ContentReferenceActionCreated = "created"
+)
+
+// Actions of CustomPropertyEvent.
+const (
+	CustomPropertyActionCreated = "created"
+	CustomPropertyActionDeleted = "deleted"
+	CustomPropertyActionUpdated = "updated"
+)
+
+// Actions of CustomPropertyValuesEvent.
+const (
+	CustomPropertyValuesActionUpdated = "updated"
+)
+
+// Actions of DeployKeyEvent.
+const (
+	DeployKeyActionCreated = "created"
+	DeployKeyActionDeleted = "deleted"
+)
+
+// Actions of DeploymentProtectionRuleEvent.
+const (
+	DeploymentProtectionRuleActionRequested = "requested"
+)
+
+// Actions of DiscussionCommentEvent.
+const (
+	DiscussionCommentActionCreated = "created"
+	DiscussionCommentActionDeleted = "deleted"
+	DiscussionCommentActionEdited  = "edited"
+)
+
+// Actions of DiscussionEvent.
+const (
+	DiscussionActionAnswered        = "answered"
+	DiscussionActionCategoryChanged = "category_changed"
+	DiscussionActionCreated         = "created"
+	DiscussionActionDeleted         = "deleted"
+	DiscussionActionEdited          = "edited"
+	DiscussionActionLabeled         = "labeled"
+	DiscussionActionLocked          = "locked"
+	DiscussionActionPinned          = "pinned"
+	DiscussionActionTransferred     = "transferred"
+	DiscussionActionUnanswered      = "unanswered"
+	DiscussionActionUnlabeled       = "unlabeled"
+	DiscussionActionUnlocked        = "unlocked"
+	DiscussionActionUnpinned        = "unpinned"
+)
+
+// Actions of GitHubAppAuthorizationEvent.
+const (
+	GitHubAppAuthorizationActionRevoked = "revoked"
+)
+
+// Actions of InstallationEvent.
+const (
+	InstallationActionCreated                = "created"
+	InstallationActionDeleted                = "deleted"
+	InstallationActionNewPermissionsAccepted = "new_permissions_accepted"
+	InstallationActionSuspend                = "suspend"
+	InstallationActionUnsuspend              = "unsuspend"
+)
+
+// Actions of InstallationRepositoriesEvent.
+const (
+	InstallationRepositoriesActionAdded   = "added"
+	InstallationRepositoriesActionRemoved = "removed"
+)
+
+// Actions of IssueCommentEvent.
+const (
+	IssueCommentActionCreated = "created"
+	IssueCommentActionDeleted = "deleted"
+	IssueCommentActionEdited  = "edited"
+)
+
+// Actions of IssuesEvent.
+const (
+	IssuesActionAssigned     = "assigned"
+	IssuesActionClosed       = "closed"
+	IssuesActionDeleted      = "deleted"
+	IssuesActionDemilestoned = "demilestoned"
+	IssuesActionEdited       = "edited"
+	IssuesActionLabeled      = "labeled"
+	IssuesActionLocked       = "locked"
+	IssuesActionMilestoned   = "milestoned"
+	IssuesActionOpened       = "opened"
+	IssuesActionPinned       = "pinned"
+	IssuesActionReopened     = "reopened"
+	IssuesActionTransferred  = "transferred"
+	IssuesActionUnassigned   = "unassigned"
+	IssuesActionUnlabeled    = "unlabeled"
+	IssuesActionUnlocked     = "unlocked"
+	IssuesActionUnpinned     = "unpinned"
+)
+
+// Actions of LabelEvent.
+const (
+	LabelActionCreated = "created"
+	LabelActionDeleted = "deleted"
+	LabelActionEdited  = "edited"
+)
+
+// Actions of MarketplacePurchaseEvent.
+const (
+	MarketplacePurchaseActionCancelled              = "cancelled"
+	MarketplacePurchaseActionChanged                = "changed"
+	MarketplacePurchaseActionPendingChange          = "pending_change"
+	MarketplacePurchaseActionPendingChangeCancelled = "pending_change_cancelled"
+	MarketplacePurchaseActionPurchased              = "purchased"
+)
+
+// Actions of MemberEvent.
+const (
+	MemberActionAdded   = "added"
+	MemberActionEdited  = "edited"
+	MemberActionRemoved = "removed"
+)
+
+// Actions of MembershipEvent.
+const (
+	MembershipActionAdded   = "added"
+	MembershipActionRemoved = "removed"
+)
+
+// Actions of MergeGroupEvent.
+const (
+	MergeGroupActionChecksRequested = "checks_requested"
+	MergeGroupActionDestroyed       = "destroyed"
+)
+
+// Actions of MetaEvent.
+const (
+	MetaActionDeleted = "deleted"
+)
+
+// Actions of MilestoneEvent.
+const (
+	MilestoneActionClosed  = "closed"
+	MilestoneActionCreated = "created"
+	MilestoneActionDeleted = "deleted"
+	MilestoneActionEdited  = "edited"
+	MilestoneActionOpened  = "opened"
+)
+
+// Actions of OrgBlockEvent.
+const (
+	OrgBlockActionBlocked   = "blocked"
+	OrgBlockActionUnblocked = "unblocked"
+)
+
+// Actions of OrganizationEvent.
+const (
+	OrganizationActionDeleted       = "deleted"
+	OrganizationActionMemberAdded   = "member_added"
+	OrganizationActionMemberInvited = "member_invited"
+	OrganizationActionMemberRemoved = "member_removed"
+	OrganizationActionRenamed       = "renamed"
+)
+
+// Actions of PackageEvent.
+const (
+	PackageActionPublished = "published"
+	PackageActionUpdated   = "updated"
+)
+
+// Actions of ProjectCardEvent.
+const (
+	ProjectCardActionConverted = "converted"
+	ProjectCardActionCreated   = "created"
+	ProjectCardActionDeleted   = "deleted"
+	ProjectCardActionEdited    = "edited"
+	ProjectCardActionMoved     = "moved"
+)
+
+// Actions of ProjectColumnEvent.
+const (
+	ProjectColumnActionCreated = "created"
+	ProjectColumnActionDeleted = "deleted"
+	ProjectColumnActionEdited  = "edited"
+	ProjectColumnActionMoved   = "moved"
+)
+
+// Actions of ProjectEvent.
+const (
+	ProjectActionClosed   = "closed"
+	ProjectActionCreated  = "created"
+	ProjectActionDeleted  = "deleted"
+	ProjectActionEdited   = "edited"
+	ProjectActionReopened = "reopened"
+)
+
+// Actions of ProjectV2Event.
+const (
+	ProjectV2ActionClosed   = "closed"
+	ProjectV2ActionCreated  = "created"
+	ProjectV2ActionDeleted  = "deleted"
+	ProjectV2ActionEdited   = "edited"
+	ProjectV2ActionReopened = "reopened"
+)
+
+// Actions of ProjectV2ItemEvent.
+const (
+	ProjectV2ItemActionArchived  = "archived"
+	ProjectV2ItemActionConverted = "converted"
+	ProjectV2ItemActionCreated   = "created"
+	ProjectV2ItemActionDeleted   = "deleted"
+	ProjectV2ItemActionEdited    = "edited"
+	ProjectV2ItemActionReordered = "reordered"
+	ProjectV2ItemActionRestored  = "restored"
+)
+
+// Actions of PullRequestEvent.
+const (
+	PullRequestActionAssigned             = "assigned"
+	PullRequestActionAutoMergeDisabled    = "auto_merge_disabled"
+	PullRequestActionAutoMergeEnabled     = "auto_merge_enabled"
+	PullRequestActionClosed               = "closed"
+	PullRequestActionConvertedToDraft     = "converted_to_draft"
+	PullRequestActionDemilestoned         = "demilestoned"
+	PullRequestActionDequeued             = "dequeued"
+	PullRequestActionEdited               = "edited"
+	PullRequestActionEnqueued             = "enqueued"
+	PullRequestActionLabeled              = "labeled"
+	PullRequestActionLocked               = "locked"
+	PullRequestActionMilestoned           = "milestoned"
+	PullRequestActionOpened               = "opened"
+	PullRequestActionReadyForReview       = "ready_for_review"
+	PullRequestActionReopened             = "reopened"
+	PullRequestActionReviewRequestRemoved = "review_request_removed"
+	PullRequestActionReviewRequested      = "review_requested"
+	PullRequestActionSynchronize          = "synchronize"
+	PullRequestActionUnassigned           = "unassigned"
+	PullRequestActionUnlabeled            = "unlabeled"
+	PullRequestActionUnlocked             = "unlocked"
+)
+
+// Actions of PullRequestReviewCommentEvent.
+const (
+	PullRequestReviewCommentActionCreated = "created"
+	PullRequestReviewCommentActionDeleted = "deleted"
+	PullRequestReviewCommentActionEdited  = "edited"
+)
+
+// Actions of PullRequestReviewEvent.
+const (
+	PullRequestReviewActionDismissed = "dismissed"
+	PullRequestReviewActionEdited    = "edited"
+	PullRequestReviewActionSubmitted = "submitted"
+)
+
+// Actions of PullRequestReviewThreadEvent.
+const (
+	PullRequestReviewThreadActionResolved   = "resolved"
+	PullRequestReviewThreadActionUnresolved = "unresolved"
+)
+
+// Actions of PullRequestTargetEvent.
+const (
+	PullRequestTargetActionAssigned             = "assigned"
+	PullRequestTargetActionAutoMergeDisabled    = "auto_merge_disabled"
+	PullRequestTargetActionAutoMergeEnabled     = "auto_merge_enabled"
+	PullRequestTargetActionClosed               = "closed"
+	PullRequestTargetActionConvertedToDraft     = "converted_to_draft"
+	PullRequestTargetActionDemilestoned         = "demilestoned"
+	PullRequestTargetActionDequeued             = "dequeued"
+	PullRequestTargetActionEdited               = "edited"
+	PullRequestTargetActionEnqueued             = "enqueued"
+	PullRequestTargetActionLabeled              = "labeled"
+	PullRequestTargetActionLocked               = "locked"
+	PullRequestTargetActionMilestoned           = "milestoned"
+	PullRequestTargetActionOpened               = "opened"
+	PullRequestTargetActionReadyForReview       = "ready_for_review"
+	PullRequestTargetActionReopened             = "reopened"
+	PullRequestTargetActionReviewRequestRemoved = "review_request_removed"
+	PullRequestTargetActionReviewRequested      = "review_requested"
+	PullRequestTargetActionSynchronize          = "synchronize"
+	PullRequestTargetActionUnassigned           = "unassigned"
+	PullRequestTargetActionUnlabeled            = "unlabeled"
+	PullRequestTargetActionUnlocked             = "unlocked"
+)
+
+// Actions of RegistryPackageEvent.
+const (
+	RegistryPackageActionPublished = "published"
+	RegistryPackageActionUpdated   = "updated"
+)
+
+// Actions of ReleaseEvent.
+const (
+	ReleaseActionCreated     = "created"
+	ReleaseActionDeleted     = "deleted"
+	ReleaseActionEdited      = "edited"
+	ReleaseActionPrereleased = "prereleased"
+	ReleaseActionPublished   = "published"
+	ReleaseActionReleased    = "released"
+	ReleaseActionUnpublished = "unpublished"
+)
+
+// Actions of RepositoryEvent.
+const (
+	RepositoryActionArchived    = "archived"
+	RepositoryActionCreated     = "created"
+	RepositoryActionDeleted     = "deleted"
+	RepositoryActionEdited      = "edited"
+	RepositoryActionPrivatized  = "privatized"
+	RepositoryActionPublicized  = "publicized"
+	RepositoryActionRenamed     = "renamed"
+	RepositoryActionTransferred = "transferred"
+	RepositoryActionUnarchived  = "unarchived"
+)
+
+// Actions of RepositoryRulesetEvent.
+const (
+	RepositoryRulesetActionCreated = "created"
+	RepositoryRulesetActionDeleted = "deleted"
+	RepositoryRulesetActionEdited  = "edited"
+)
+
+// Actions of RepositoryVulnerabilityAlertEvent.
+const (
+	RepositoryVulnerabilityAlertActionCreate  = "create"
+	RepositoryVulnerabilityAlertActionDismiss = "dismiss"
+	RepositoryVulnerabilityAlertActionReopen  = "reopen"
+	RepositoryVulnerabilityAlertActionResolve = "resolve"
+)
+
+// Actions of SecretScanningAlertEvent.
+const (
+	SecretScanningAlertActionCreated   = "created"
+	SecretScanningAlertActionReopened  = "reopened"
+	SecretScanningAlertActionResolved  = "resolved"
+	SecretScanningAlertActionRevoked   = "revoked"
+	SecretScanningAlertActionValidated = "validated"
+)
+
+// Actions of SecretScanningAlertLocationEvent.
+const (
+	SecretScanningAlertLocationActionCreated = "created"
+)
+
+// Actions of StarEvent.
+const (
+	StarActionCreated = "created"
+	StarActionDeleted = "deleted"
+)
+
+// Actions of SubIssuesEvent.
+const (
+	SubIssuesActionParentIssueAdded   = "parent_issue_added"
+	SubIssuesActionParentIssueRemoved = "parent_issue_removed"
+	SubIssuesActionSubIssueAdded      = "sub_issue_added"
+	SubIssuesActionSubIssueRemoved    = "sub_issue_removed"
+)
+
+// Actions of TeamEvent.
+const (
+	TeamActionAddedToRepository     = "added_to_repository"
+	TeamActionCreated               = "created"
+	TeamActionDeleted               = "deleted"
+	TeamActionEdited                = "edited"
+	TeamActionRemovedFromRepository = "removed_from_repository"
+)
+
+// Actions of UserEvent.
+const (
+	UserActionCreated = "created"
+	UserActionDeleted = "deleted"
+)
+
+// Actions of WatchEvent.
+const (
+	WatchActionStarted = "started"
+)
+
+// Actions of WorkflowJobEvent.
+const (
+	WorkflowJobActionCompleted  = "completed"
+	WorkflowJobActionInProgress = "in_progress"
+	WorkflowJobActionQueued     = "queued"
+	WorkflowJobActionWaiting    = "waiting"
+)
+
+// Actions of WorkflowRunEvent.
+const (
+	WorkflowRunActionCompleted  = "completed"
+	WorkflowRunActionInProgress = "in_progress"
+	WorkflowRunActionRequested  = "requested"
+)
+
+// eventActions maps webhook event types to the actions GitHub documents for
+// them.
+var eventActions = map[string][]string{
+	"branch_protection_rule":         {BranchProtectionRuleActionCreated, BranchProtectionRuleActionEdited, BranchProtectionRuleActionDeleted},
+	"check_run":                      {CheckRunActionCreated, CheckRunActionCompleted, CheckRunActionRerequested, CheckRunActionRequestedAction},
+	"check_suite":                    {CheckSuiteActionCompleted, CheckSuiteActionRequested, CheckSuiteActionRerequested},
+	"code_scanning_alert":            {CodeScanningAlertActionAppearedInBranch, CodeScanningAlertActionClosedByUser, CodeScanningAlertActionCreated, CodeScanningAlertActionFixed, CodeScanningAlertActionReopened, CodeScanningAlertActionReopenedByUser},
+	"commit_comment":                 {CommitCommentActionCreated},
+	"content_reference":              {ContentReferenceActionCreated},
+	"custom_property":                {CustomPropertyActionCreated, CustomPropertyActionDeleted, CustomPropertyActionUpdated},
+	"custom_property_values":         {CustomPropertyValuesActionUpdated},
+	"deploy_key":                     {DeployKeyActionCreated, DeployKeyActionDeleted},
+	"deployment_protection_rule":     {DeploymentProtectionRuleActionRequested},
+	"discussion":                     {DiscussionActionAnswered, DiscussionActionCategoryChanged, DiscussionActionCreated, DiscussionActionDeleted, DiscussionActionEdited, DiscussionActionLabeled, DiscussionActionLocked, DiscussionActionPinned, DiscussionActionTransferred, DiscussionActionUnanswered, DiscussionActionUnlabeled, DiscussionActionUnlocked, DiscussionActionUnpinned},
+	"discussion_comment":             {DiscussionCommentActionCreated, DiscussionCommentActionDeleted, DiscussionCommentActionEdited},
+	"github_app_authorization":       {GitHubAppAuthorizationActionRevoked},
+	"installation":                   {InstallationActionCreated, InstallationActionDeleted, InstallationActionNewPermissionsAccepted, InstallationActionSuspend, InstallationActionUnsuspend},
+	"installation_repositories":      {InstallationRepositoriesActionAdded, InstallationRepositoriesActionRemoved},
+	"issue_comment":                  {IssueCommentActionCreated, IssueCommentActionDeleted, IssueCommentActionEdited},
+	"issues":                         {IssuesActionAssigned, IssuesActionClosed, IssuesActionDeleted, IssuesActionDemilestoned, IssuesActionEdited, IssuesActionLabeled, IssuesActionLocked, IssuesActionMilestoned, IssuesActionOpened, IssuesActionPinned, IssuesActionReopened, IssuesActionTransferred, IssuesActionUnassigned, IssuesActionUnlabeled, IssuesActionUnlocked, IssuesActionUnpinned},
+	"label":                          {LabelActionCreated, LabelActionDeleted, LabelActionEdited},
+	"marketplace_purchase":           {MarketplacePurchaseActionCancelled, MarketplacePurchaseActionChanged, MarketplacePurchaseActionPendingChange, MarketplacePurchaseActionPendingChangeCancelled, MarketplacePurchaseActionPurchased},
+	"member":                         {MemberActionAdded, MemberActionEdited, MemberActionRemoved},
+	"membership":                     {MembershipActionAdded, MembershipActionRemoved},
+	"merge_group":                    {MergeGroupActionChecksRequested, MergeGroupActionDestroyed},
+	"meta":                           {MetaActionDeleted},
+	"milestone":                      {MilestoneActionClosed, MilestoneActionCreated, MilestoneActionDeleted, MilestoneActionEdited, MilestoneActionOpened},
+	"org_block":                      {OrgBlockActionBlocked, OrgBlockActionUnblocked},
+	"organization":                   {OrganizationActionDeleted, OrganizationActionMemberAdded, OrganizationActionMemberInvited, OrganizationActionMemberRemoved, OrganizationActionRenamed},
+	"package":                        {PackageActionPublished, PackageActionUpdated},
+	"project":                        {ProjectActionClosed, ProjectActionCreated, ProjectActionDeleted, ProjectActionEdited, ProjectActionReopened},
+	"project_card":                   {ProjectCardActionConverted, ProjectCardActionCreated, ProjectCardActionDeleted, ProjectCardActionEdited, ProjectCardActionMoved},
+	"project_column":                 {ProjectColumnActionCreated, ProjectColumnActionDeleted, ProjectColumnActionEdited, ProjectColumnActionMoved},
+	"projects_v2":                    {ProjectV2ActionClosed, ProjectV2ActionCreated, ProjectV2ActionDeleted, ProjectV2ActionEdited, ProjectV2ActionReopened},
+	"projects_v2_item":               {ProjectV2ItemActionArchived, ProjectV2ItemActionConverted, ProjectV2ItemActionCreated, ProjectV2ItemActionDeleted, ProjectV2ItemActionEdited, ProjectV2ItemActionReordered, ProjectV2ItemActionRestored},
+	"pull_request":                   {PullRequestActionAssigned, PullRequestActionAutoMergeDisabled, PullRequestActionAutoMergeEnabled, PullRequestActionClosed, PullRequestActionConvertedToDraft, PullRequestActionDemilestoned, PullRequestActionDequeued, PullRequestActionEdited, PullRequestActionEnqueued, PullRequestActionLabeled, PullRequestActionLocked, PullRequestActionMilestoned, PullRequestActionOpened, PullRequestActionReadyForReview, PullRequestActionReopened, PullRequestActionReviewRequestRemoved, PullRequestActionReviewRequested, PullRequestActionSynchronize, PullRequestActionUnassigned, PullRequestActionUnlabeled, PullRequestActionUnlocked},
+	"pull_request_review":            {PullRequestReviewActionDismissed, PullRequestReviewActionEdited, PullRequestReviewActionSubmitted},
+	"pull_request_review_comment":    {PullRequestReviewCommentActionCreated, PullRequestReviewCommentActionDeleted, PullRequestReviewCommentActionEdited},
+	"pull_request_review_thread":     {PullRequestReviewThreadActionResolved, PullRequestReviewThreadActionUnresolved},
+	"pull_request_target":            {PullRequestTargetActionAssigned, PullRequestTargetActionAutoMergeDisabled, PullRequestTargetActionAutoMergeEnabled, PullRequestTargetActionClosed, PullRequestTargetActionConvertedToDraft, PullRequestTargetActionDemilestoned, PullRequestTargetActionDequeued, PullRequestTargetActionEdited, PullRequestTargetActionEnqueued, PullRequestTargetActionLabeled, PullRequestTargetActionLocked, PullRequestTargetActionMilestoned, PullRequestTargetActionOpened, PullRequestTargetActionReadyForReview, PullRequestTargetActionReopened, PullRequestTargetActionReviewRequestRemoved, PullRequestTargetActionReviewRequested, PullRequestTargetActionSynchronize, PullRequestTargetActionUnassigned, PullRequestTargetActionUnlabeled, PullRequestTargetActionUnlocked},
+	"registry_package":               {RegistryPackageActionPublished, RegistryPackageActionUpdated},
+	"release":                        {ReleaseActionCreated, ReleaseActionDeleted, ReleaseActionEdited, ReleaseActionPrereleased, ReleaseActionPublished, ReleaseActionReleased, ReleaseActionUnpublished},
+	"repository":                     {RepositoryActionArchived, RepositoryActionCreated, RepositoryActionDeleted, RepositoryActionEdited, RepositoryActionPrivatized, RepositoryActionPublicized, RepositoryActionRenamed, RepositoryActionTransferred, RepositoryActionUnarchived},
+	"repository_ruleset":             {RepositoryRulesetActionCreated, RepositoryRulesetActionDeleted, RepositoryRulesetActionEdited},
+	"repository_vulnerability_alert": {RepositoryVulnerabilityAlertActionCreate, RepositoryVulnerabilityAlertActionDismiss, RepositoryVulnerabilityAlertActionReopen, RepositoryVulnerabilityAlertActionResolve},
+	"secret_scanning_alert":          {SecretScanningAlertActionCreated, SecretScanningAlertActionReopened, SecretScanningAlertActionResolved, SecretScanningAlertActionRevoked, SecretScanningAlertActionValidated},
+	"secret_scanning_alert_location": {SecretScanningAlertLocationActionCreated},
+	"star":                           {StarActionCreated, StarActionDeleted},
+	"sub_issues":                     {SubIssuesActionParentIssueAdded, SubIssuesActionParentIssueRemoved, SubIssuesActionSubIssueAdded, SubIssuesActionSubIssueRemoved},
+	"team":                           {TeamActionAddedToRepository, TeamActionCreated, TeamActionDeleted, TeamActionEdited, TeamActionRemovedFromRepository},
+	"user":                           {UserActionCreated, UserActionDeleted},
+	"watch":                          {WatchActionStarted},
+	"workflow_job":                   {WorkflowJobActionCompleted, WorkflowJobActionInProgress, WorkflowJobActionQueued, WorkflowJobActionWaiting},
+	"workflow_run":                   {WorkflowRunActionCompleted, WorkflowRunActionInProgress, WorkflowRunActionRequested},
+}
+
+// EventActions returns the actions GitHub documents for webhook events of
+// eventType, such as "pull_request", or nil for events without actions or
+// unknown event types.
+func EventActions(eventType string) []string {
+	actions := eventActions[eventType]
+	if actions == nil {
+		return nil
+	}
+	return append([]string(nil), actions...)
+}
+
+// IsKnownEventAction reports whether action is one of the actions GitHub
+// documents for webhook events of eventType. Consumers can use it to detect
+// actions added by GitHub that their handlers do not cover yet.
+func IsKnownEventAction(eventType, action string) bool {
+	for _, a := range eventActions[eventType] {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
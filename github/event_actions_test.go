@@ -0,0 +1,60 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEventActions_eventsHaveActionField(t *testing.T) {
+	for eventType := range eventActions {
+		payload, err := ParseWebHook(eventType, []byte("{}"))
+		if err != nil {
+			t.Errorf("ParseWebHook(%q) returned error: %v", eventType, err)
+			continue
+		}
+
+		if _, ok := reflect.TypeOf(payload).Elem().FieldByName("Action"); !ok {
+			t.Errorf("%T has no Action field", payload)
+		}
+	}
+}
+
+func TestEventActions(t *testing.T) {
+	got := EventActions("watch")
+	if want := []string{WatchActionStarted}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EventActions(watch) = %v, want %v", got, want)
+	}
+
+	got[0] = "modified"
+	if eventActions["watch"][0] != WatchActionStarted {
+		t.Error("EventActions returned a slice sharing storage with eventActions")
+	}
+
+	if got := EventActions("unknown"); got != nil {
+		t.Errorf("EventActions(unknown) = %v, want nil", got)
+	}
+}
+
+func TestIsKnownEventAction(t *testing.T) {
+	tests := []struct {
+		eventType, action string
+		want              bool
+	}{
+		{"pull_request", PullRequestActionOpened, true},
+		{"pull_request", "opend", false},
+		{"issues", IssuesActionLabeled, true},
+		{"issues", PullRequestActionSynchronize, false},
+		{"unknown", "created", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsKnownEventAction(tt.eventType, tt.action); got != tt.want {
+			t.Errorf("IsKnownEventAction(%q, %q) = %v, want %v", tt.eventType, tt.action, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,637 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// EventDispatcher routes webhook deliveries to the handlers registered for
+// their event type. It implements http.Handler, validating the signature of
+// each delivery before dispatching it.
+//
+// Example usage:
+//
+//	d := github.NewEventDispatcher(webhookSecretKey)
+//	d.OnPullRequest(func(ctx context.Context, event *github.PullRequestEvent) error {
+//	  // Process event...
+//	  return nil
+//	})
+//	http.Handle("/webhook", d)
+type EventDispatcher struct {
+	// Deduplicate, if set, is called with the delivery ID of each delivery
+	// received by ServeHTTP before it is dispatched. Deliveries it reports as
+	// duplicates are acknowledged without being dispatched. It must only
+	// check the delivery ID, not record it: a delivery whose handlers fail
+	// is redelivered by GitHub, and must not be reported as a duplicate
+	// then. Record delivery IDs in Delivered instead.
+	Deduplicate func(ctx context.Context, deliveryID string) (duplicate bool, err error)
+
+	// Delivered, if set, is called with the delivery ID of each delivery
+	// received by ServeHTTP once it has been dispatched successfully, so
+	// that Deduplicate reports it as a duplicate from then on. If it returns
+	// an error, ServeHTTP responds with 500 Internal Server Error, and the
+	// delivery may be dispatched again when it is redelivered.
+	Delivered func(ctx context.Context, deliveryID string) error
+
+	// Unhandled, if set, is called with the raw payload of events that have
+	// no registered handler, including event types unknown to this package.
+	Unhandled func(ctx context.Context, eventType string, payload []byte) error
+
+	// ErrorHandler, if set, is called with the errors ServeHTTP responds
+	// with, so that they can be logged.
+	ErrorHandler func(r *http.Request, err error)
+
+	// UnknownFields, if set, is called with the payload fields that the
+	// event struct they were parsed into does not model, as with
+	// ParseWebHookStrict.
+	UnknownFields UnknownFieldsFunc
+
+	secretToken []byte
+
+	mu       sync.RWMutex
+	handlers map[string][]func(context.Context, interface{}) error
+}
+
+// NewEventDispatcher returns an EventDispatcher validating deliveries with
+// the webhook secretToken. An empty secretToken disables the validation,
+// which should only be done for local development.
+func NewEventDispatcher(secretToken []byte) *EventDispatcher {
+	return &EventDispatcher{
+		secretToken: secretToken,
+		handlers:    make(map[string][]func(context.Context, interface{}) error),
+	}
+}
+
+// On registers handler for events of eventType, such as "pull_request".
+// handler receives the event as returned by ParseWebHook, or as a
+// json.RawMessage if eventType is not known to this package. Handlers of
+// the same event type are called in the order they were registered.
+func (d *EventDispatcher) On(eventType string, handler func(ctx context.Context, event interface{}) error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers[eventType] = append(d.handlers[eventType], handler)
+}
+
+// Dispatch parses payload as an event of eventType and calls its handlers,
+// stopping at the first error. Events without handlers are passed to
+// Unhandled, if set, and ignored otherwise. Pings without handlers, sent
+// when a webhook is created, are always acknowledged.
+func (d *EventDispatcher) Dispatch(ctx context.Context, eventType string, payload []byte) error {
+	d.mu.RLock()
+	handlers := d.handlers[eventType]
+	d.mu.RUnlock()
+
+	if len(handlers) == 0 {
+		if d.Unhandled != nil && eventType != "ping" {
+			return d.Unhandled(ctx, eventType, payload)
+		}
+		return nil
+	}
+
+	var event interface{}
+	if _, ok := eventTypeMapping[eventType]; ok {
+		var err error
+		if event, err = ParseWebHookStrict(eventType, payload, d.UnknownFields); err != nil {
+			return err
+		}
+	} else {
+		event = json.RawMessage(payload)
+	}
+
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServeHTTP validates the webhook delivery r, skips it if Deduplicate
+// reports it as a duplicate, dispatches it and reports it to Delivered. The handlers can get the
+// delivery headers with WebhookHeadersFromContext. It responds with
+// 400 Bad Request when the delivery is invalid, 500 Internal Server Error
+// when it could not be handled, and 204 No Content otherwise.
+func (d *EventDispatcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	headers, err := ParseWebhookHeaders(r)
+	if err != nil {
+		d.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	ctx := context.WithValue(r.Context(), webhookHeadersKey{}, headers)
+
+	payload, err := ValidatePayload(r, d.secretToken)
+	if err != nil {
+		d.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if d.Deduplicate != nil {
+		duplicate, err := d.Deduplicate(ctx, headers.DeliveryID)
+		if err != nil {
+			d.fail(w, r, http.StatusInternalServerError, err)
+			return
+		}
+		if duplicate {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	if err := d.Dispatch(ctx, headers.Event, payload); err != nil {
+		d.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	if d.Delivered != nil {
+		if err := d.Delivered(ctx, headers.DeliveryID); err != nil {
+			d.fail(w, r, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type webhookHeadersKey struct{}
+
+// WebhookHeadersFromContext returns the headers of the webhook delivery
+// being handled by EventDispatcher.ServeHTTP, or nil if ctx does not come
+// from it.
+func WebhookHeadersFromContext(ctx context.Context) *WebhookHeaders {
+	headers, _ := ctx.Value(webhookHeadersKey{}).(*WebhookHeaders)
+	return headers
+}
+
+func (d *EventDispatcher) fail(w http.ResponseWriter, r *http.Request, code int, err error) {
+	if d.ErrorHandler != nil {
+		d.ErrorHandler(r, err)
+	}
+	http.Error(w, http.StatusText(code), code)
+}
+
+// OnBranchProtectionRule registers handler for "branch_protection_rule" events.
+func (d *EventDispatcher) OnBranchProtectionRule(handler func(context.Context, *BranchProtectionRuleEvent) error) {
+	d.On("branch_protection_rule", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*BranchProtectionRuleEvent))
+	})
+}
+
+// OnCheckRun registers handler for "check_run" events.
+func (d *EventDispatcher) OnCheckRun(handler func(context.Context, *CheckRunEvent) error) {
+	d.On("check_run", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*CheckRunEvent))
+	})
+}
+
+// OnCheckSuite registers handler for "check_suite" events.
+func (d *EventDispatcher) OnCheckSuite(handler func(context.Context, *CheckSuiteEvent) error) {
+	d.On("check_suite", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*CheckSuiteEvent))
+	})
+}
+
+// OnCodeScanningAlert registers handler for "code_scanning_alert" events.
+func (d *EventDispatcher) OnCodeScanningAlert(handler func(context.Context, *CodeScanningAlertEvent) error) {
+	d.On("code_scanning_alert", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*CodeScanningAlertEvent))
+	})
+}
+
+// OnCommitComment registers handler for "commit_comment" events.
+func (d *EventDispatcher) OnCommitComment(handler func(context.Context, *CommitCommentEvent) error) {
+	d.On("commit_comment", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*CommitCommentEvent))
+	})
+}
+
+// OnContentReference registers handler for "content_reference" events.
+func (d *EventDispatcher) OnContentReference(handler func(context.Context, *ContentReferenceEvent) error) {
+	d.On("content_reference", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*ContentReferenceEvent))
+	})
+}
+
+// OnCreate registers handler for "create" events.
+func (d *EventDispatcher) OnCreate(handler func(context.Context, *CreateEvent) error) {
+	d.On("create", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*CreateEvent))
+	})
+}
+
+// OnCustomProperty registers handler for "custom_property" events.
+func (d *EventDispatcher) OnCustomProperty(handler func(context.Context, *CustomPropertyEvent) error) {
+	d.On("custom_property", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*CustomPropertyEvent))
+	})
+}
+
+// OnCustomPropertyValues registers handler for "custom_property_values" events.
+func (d *EventDispatcher) OnCustomPropertyValues(handler func(context.Context, *CustomPropertyValuesEvent) error) {
+	d.On("custom_property_values", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*CustomPropertyValuesEvent))
+	})
+}
+
+// OnDelete registers handler for "delete" events.
+func (d *EventDispatcher) OnDelete(handler func(context.Context, *DeleteEvent) error) {
+	d.On("delete", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*DeleteEvent))
+	})
+}
+
+// OnDeployKey registers handler for "deploy_key" events.
+func (d *EventDispatcher) OnDeployKey(handler func(context.Context, *DeployKeyEvent) error) {
+	d.On("deploy_key", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*DeployKeyEvent))
+	})
+}
+
+// OnDeployment registers handler for "deployment" events.
+func (d *EventDispatcher) OnDeployment(handler func(context.Context, *DeploymentEvent) error) {
+	d.On("deployment", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*DeploymentEvent))
+	})
+}
+
+// OnDeploymentProtectionRule registers handler for "deployment_protection_rule" events.
+func (d *EventDispatcher) OnDeploymentProtectionRule(handler func(context.Context, *DeploymentProtectionRuleEvent) error) {
+	d.On("deployment_protection_rule", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*DeploymentProtectionRuleEvent))
+	})
+}
+
+// OnDeploymentStatus registers handler for "deployment_status" events.
+func (d *EventDispatcher) OnDeploymentStatus(handler func(context.Context, *DeploymentStatusEvent) error) {
+	d.On("deployment_status", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*DeploymentStatusEvent))
+	})
+}
+
+// OnDiscussionComment registers handler for "discussion_comment" events.
+func (d *EventDispatcher) OnDiscussionComment(handler func(context.Context, *DiscussionCommentEvent) error) {
+	d.On("discussion_comment", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*DiscussionCommentEvent))
+	})
+}
+
+// OnDiscussion registers handler for "discussion" events.
+func (d *EventDispatcher) OnDiscussion(handler func(context.Context, *DiscussionEvent) error) {
+	d.On("discussion", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*DiscussionEvent))
+	})
+}
+
+// OnFork registers handler for "fork" events.
+func (d *EventDispatcher) OnFork(handler func(context.Context, *ForkEvent) error) {
+	d.On("fork", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*ForkEvent))
+	})
+}
+
+// OnGitHubAppAuthorization registers handler for "github_app_authorization" events.
+func (d *EventDispatcher) OnGitHubAppAuthorization(handler func(context.Context, *GitHubAppAuthorizationEvent) error) {
+	d.On("github_app_authorization", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*GitHubAppAuthorizationEvent))
+	})
+}
+
+// OnGollum registers handler for "gollum" events.
+func (d *EventDispatcher) OnGollum(handler func(context.Context, *GollumEvent) error) {
+	d.On("gollum", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*GollumEvent))
+	})
+}
+
+// OnInstallation registers handler for "installation" events.
+func (d *EventDispatcher) OnInstallation(handler func(context.Context, *InstallationEvent) error) {
+	d.On("installation", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*InstallationEvent))
+	})
+}
+
+// OnInstallationRepositories registers handler for "installation_repositories" events.
+func (d *EventDispatcher) OnInstallationRepositories(handler func(context.Context, *InstallationRepositoriesEvent) error) {
+	d.On("installation_repositories", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*InstallationRepositoriesEvent))
+	})
+}
+
+// OnIssueComment registers handler for "issue_comment" events.
+func (d *EventDispatcher) OnIssueComment(handler func(context.Context, *IssueCommentEvent) error) {
+	d.On("issue_comment", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*IssueCommentEvent))
+	})
+}
+
+// OnIssues registers handler for "issues" events.
+func (d *EventDispatcher) OnIssues(handler func(context.Context, *IssuesEvent) error) {
+	d.On("issues", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*IssuesEvent))
+	})
+}
+
+// OnLabel registers handler for "label" events.
+func (d *EventDispatcher) OnLabel(handler func(context.Context, *LabelEvent) error) {
+	d.On("label", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*LabelEvent))
+	})
+}
+
+// OnMarketplacePurchase registers handler for "marketplace_purchase" events.
+func (d *EventDispatcher) OnMarketplacePurchase(handler func(context.Context, *MarketplacePurchaseEvent) error) {
+	d.On("marketplace_purchase", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*MarketplacePurchaseEvent))
+	})
+}
+
+// OnMember registers handler for "member" events.
+func (d *EventDispatcher) OnMember(handler func(context.Context, *MemberEvent) error) {
+	d.On("member", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*MemberEvent))
+	})
+}
+
+// OnMembership registers handler for "membership" events.
+func (d *EventDispatcher) OnMembership(handler func(context.Context, *MembershipEvent) error) {
+	d.On("membership", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*MembershipEvent))
+	})
+}
+
+// OnMergeGroup registers handler for "merge_group" events.
+func (d *EventDispatcher) OnMergeGroup(handler func(context.Context, *MergeGroupEvent) error) {
+	d.On("merge_group", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*MergeGroupEvent))
+	})
+}
+
+// OnMeta registers handler for "meta" events.
+func (d *EventDispatcher) OnMeta(handler func(context.Context, *MetaEvent) error) {
+	d.On("meta", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*MetaEvent))
+	})
+}
+
+// OnMilestone registers handler for "milestone" events.
+func (d *EventDispatcher) OnMilestone(handler func(context.Context, *MilestoneEvent) error) {
+	d.On("milestone", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*MilestoneEvent))
+	})
+}
+
+// OnOrgBlock registers handler for "org_block" events.
+func (d *EventDispatcher) OnOrgBlock(handler func(context.Context, *OrgBlockEvent) error) {
+	d.On("org_block", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*OrgBlockEvent))
+	})
+}
+
+// OnOrganization registers handler for "organization" events.
+func (d *EventDispatcher) OnOrganization(handler func(context.Context, *OrganizationEvent) error) {
+	d.On("organization", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*OrganizationEvent))
+	})
+}
+
+// OnPackage registers handler for "package" events.
+func (d *EventDispatcher) OnPackage(handler func(context.Context, *PackageEvent) error) {
+	d.On("package", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PackageEvent))
+	})
+}
+
+// OnPageBuild registers handler for "page_build" events.
+func (d *EventDispatcher) OnPageBuild(handler func(context.Context, *PageBuildEvent) error) {
+	d.On("page_build", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PageBuildEvent))
+	})
+}
+
+// OnPing registers handler for "ping" events.
+func (d *EventDispatcher) OnPing(handler func(context.Context, *PingEvent) error) {
+	d.On("ping", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PingEvent))
+	})
+}
+
+// OnProjectCard registers handler for "project_card" events.
+func (d *EventDispatcher) OnProjectCard(handler func(context.Context, *ProjectCardEvent) error) {
+	d.On("project_card", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*ProjectCardEvent))
+	})
+}
+
+// OnProjectColumn registers handler for "project_column" events.
+func (d *EventDispatcher) OnProjectColumn(handler func(context.Context, *ProjectColumnEvent) error) {
+	d.On("project_column", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*ProjectColumnEvent))
+	})
+}
+
+// OnProject registers handler for "project" events.
+func (d *EventDispatcher) OnProject(handler func(context.Context, *ProjectEvent) error) {
+	d.On("project", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*ProjectEvent))
+	})
+}
+
+// OnProjectV2 registers handler for "projects_v2" events.
+func (d *EventDispatcher) OnProjectV2(handler func(context.Context, *ProjectV2Event) error) {
+	d.On("projects_v2", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*ProjectV2Event))
+	})
+}
+
+// OnProjectV2Item registers handler for "projects_v2_item" events.
+func (d *EventDispatcher) OnProjectV2Item(handler func(context.Context, *ProjectV2ItemEvent) error) {
+	d.On("projects_v2_item", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*ProjectV2ItemEvent))
+	})
+}
+
+// OnPublic registers handler for "public" events.
+func (d *EventDispatcher) OnPublic(handler func(context.Context, *PublicEvent) error) {
+	d.On("public", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PublicEvent))
+	})
+}
+
+// OnPullRequest registers handler for "pull_request" events.
+func (d *EventDispatcher) OnPullRequest(handler func(context.Context, *PullRequestEvent) error) {
+	d.On("pull_request", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PullRequestEvent))
+	})
+}
+
+// OnPullRequestReviewComment registers handler for "pull_request_review_comment" events.
+func (d *EventDispatcher) OnPullRequestReviewComment(handler func(context.Context, *PullRequestReviewCommentEvent) error) {
+	d.On("pull_request_review_comment", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PullRequestReviewCommentEvent))
+	})
+}
+
+// OnPullRequestReview registers handler for "pull_request_review" events.
+func (d *EventDispatcher) OnPullRequestReview(handler func(context.Context, *PullRequestReviewEvent) error) {
+	d.On("pull_request_review", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PullRequestReviewEvent))
+	})
+}
+
+// OnPullRequestReviewThread registers handler for "pull_request_review_thread" events.
+func (d *EventDispatcher) OnPullRequestReviewThread(handler func(context.Context, *PullRequestReviewThreadEvent) error) {
+	d.On("pull_request_review_thread", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PullRequestReviewThreadEvent))
+	})
+}
+
+// OnPullRequestTarget registers handler for "pull_request_target" events.
+func (d *EventDispatcher) OnPullRequestTarget(handler func(context.Context, *PullRequestTargetEvent) error) {
+	d.On("pull_request_target", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PullRequestTargetEvent))
+	})
+}
+
+// OnPush registers handler for "push" events.
+func (d *EventDispatcher) OnPush(handler func(context.Context, *PushEvent) error) {
+	d.On("push", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*PushEvent))
+	})
+}
+
+// OnRegistryPackage registers handler for "registry_package" events.
+func (d *EventDispatcher) OnRegistryPackage(handler func(context.Context, *RegistryPackageEvent) error) {
+	d.On("registry_package", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*RegistryPackageEvent))
+	})
+}
+
+// OnRelease registers handler for "release" events.
+func (d *EventDispatcher) OnRelease(handler func(context.Context, *ReleaseEvent) error) {
+	d.On("release", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*ReleaseEvent))
+	})
+}
+
+// OnRepositoryDispatch registers handler for "repository_dispatch" events.
+func (d *EventDispatcher) OnRepositoryDispatch(handler func(context.Context, *RepositoryDispatchEvent) error) {
+	d.On("repository_dispatch", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*RepositoryDispatchEvent))
+	})
+}
+
+// OnRepository registers handler for "repository" events.
+func (d *EventDispatcher) OnRepository(handler func(context.Context, *RepositoryEvent) error) {
+	d.On("repository", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*RepositoryEvent))
+	})
+}
+
+// OnRepositoryImport registers handler for "repository_import" events.
+func (d *EventDispatcher) OnRepositoryImport(handler func(context.Context, *RepositoryImportEvent) error) {
+	d.On("repository_import", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*RepositoryImportEvent))
+	})
+}
+
+// OnRepositoryRuleset registers handler for "repository_ruleset" events.
+func (d *EventDispatcher) OnRepositoryRuleset(handler func(context.Context, *RepositoryRulesetEvent) error) {
+	d.On("repository_ruleset", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*RepositoryRulesetEvent))
+	})
+}
+
+// OnRepositoryVulnerabilityAlert registers handler for "repository_vulnerability_alert" events.
+func (d *EventDispatcher) OnRepositoryVulnerabilityAlert(handler func(context.Context, *RepositoryVulnerabilityAlertEvent) error) {
+	d.On("repository_vulnerability_alert", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*RepositoryVulnerabilityAlertEvent))
+	})
+}
+
+// OnSecretScanningAlert registers handler for "secret_scanning_alert" events.
+func (d *EventDispatcher) OnSecretScanningAlert(handler func(context.Context, *SecretScanningAlertEvent) error) {
+	d.On("secret_scanning_alert", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*SecretScanningAlertEvent))
+	})
+}
+
+// OnSecretScanningAlertLocation registers handler for "secret_scanning_alert_location" events.
+func (d *EventDispatcher) OnSecretScanningAlertLocation(handler func(context.Context, *SecretScanningAlertLocationEvent) error) {
+	d.On("secret_scanning_alert_location", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*SecretScanningAlertLocationEvent))
+	})
+}
+
+// OnStar registers handler for "star" events.
+func (d *EventDispatcher) OnStar(handler func(context.Context, *StarEvent) error) {
+	d.On("star", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*StarEvent))
+	})
+}
+
+// OnStatus registers handler for "status" events.
+func (d *EventDispatcher) OnStatus(handler func(context.Context, *StatusEvent) error) {
+	d.On("status", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*StatusEvent))
+	})
+}
+
+// OnSubIssues registers handler for "sub_issues" events.
+func (d *EventDispatcher) OnSubIssues(handler func(context.Context, *SubIssuesEvent) error) {
+	d.On("sub_issues", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*SubIssuesEvent))
+	})
+}
+
+// OnTeamAdd registers handler for "team_add" events.
+func (d *EventDispatcher) OnTeamAdd(handler func(context.Context, *TeamAddEvent) error) {
+	d.On("team_add", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*TeamAddEvent))
+	})
+}
+
+// OnTeam registers handler for "team" events.
+func (d *EventDispatcher) OnTeam(handler func(context.Context, *TeamEvent) error) {
+	d.On("team", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*TeamEvent))
+	})
+}
+
+// OnUser registers handler for "user" events.
+func (d *EventDispatcher) OnUser(handler func(context.Context, *UserEvent) error) {
+	d.On("user", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*UserEvent))
+	})
+}
+
+// OnWatch registers handler for "watch" events.
+func (d *EventDispatcher) OnWatch(handler func(context.Context, *WatchEvent) error) {
+	d.On("watch", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*WatchEvent))
+	})
+}
+
+// OnWorkflowDispatch registers handler for "workflow_dispatch" events.
+func (d *EventDispatcher) OnWorkflowDispatch(handler func(context.Context, *WorkflowDispatchEvent) error) {
+	d.On("workflow_dispatch", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*WorkflowDispatchEvent))
+	})
+}
+
+// OnWorkflowJob registers handler for "workflow_job" events.
+func (d *EventDispatcher) OnWorkflowJob(handler func(context.Context, *WorkflowJobEvent) error) {
+	d.On("workflow_job", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*WorkflowJobEvent))
+	})
+}
+
+// OnWorkflowRun registers handler for "workflow_run" events.
+func (d *EventDispatcher) OnWorkflowRun(handler func(context.Context, *WorkflowRunEvent) error) {
+	d.On("workflow_run", func(ctx context.Context, event interface{}) error {
+		return handler(ctx, event.(*WorkflowRunEvent))
+	})
+}
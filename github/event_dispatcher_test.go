@@ -0,0 +1,274 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newWebhookRequest(t *testing.T, eventType, deliveryID, payload string, secretKey []byte) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest("POST", "http://localhost/webhook", strings.NewReader(payload))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, eventType)
+	req.Header.Set(DeliveryIDHeader, deliveryID)
+	if secretKey != nil {
+		mac := genMAC([]byte(payload), secretKey, sha256.New)
+		req.Header.Set(SHA256SignatureHeader, "sha256="+hex.EncodeToString(mac))
+	}
+	return req
+}
+
+func TestEventDispatcher_ServeHTTP(t *testing.T) {
+	secretKey := []byte("0123456789abcdef")
+	d := NewEventDispatcher(secretKey)
+
+	var got []*PullRequestEvent
+	d.OnPullRequest(func(ctx context.Context, event *PullRequestEvent) error {
+		got = append(got, event)
+		return nil
+	})
+	d.OnPush(func(ctx context.Context, event *PushEvent) error {
+		t.Error("push handler called for a pull_request event")
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	d.ServeHTTP(w, newWebhookRequest(t, "pull_request", "d1", `{"action":"opened","number":1}`, secretKey))
+	if w.Code != http.StatusNoContent {
+		t.Errorf("ServeHTTP responded %v, want %v", w.Code, http.StatusNoContent)
+	}
+
+	want := []*PullRequestEvent{{Action: String("opened"), Number: Int(1)}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("OnPullRequest handler got %+v, want %+v", got, want)
+	}
+}
+
+func TestEventDispatcher_ServeHTTP_invalidSignature(t *testing.T) {
+	d := NewEventDispatcher([]byte("0123456789abcdef"))
+	d.OnPing(func(ctx context.Context, event *PingEvent) error {
+		t.Error("handler called for an invalid delivery")
+		return nil
+	})
+	var handledErr error
+	d.ErrorHandler = func(r *http.Request, err error) { handledErr = err }
+
+	w := httptest.NewRecorder()
+	d.ServeHTTP(w, newWebhookRequest(t, "ping", "d1", `{}`, []byte("wrong")))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("ServeHTTP responded %v, want %v", w.Code, http.StatusBadRequest)
+	}
+	if handledErr == nil {
+		t.Error("ErrorHandler was not called")
+	}
+}
+
+func TestEventDispatcher_ServeHTTP_deduplicate(t *testing.T) {
+	d := NewEventDispatcher(nil)
+
+	calls := 0
+	fail := true
+	d.OnPing(func(ctx context.Context, event *PingEvent) error {
+		calls++
+		if fail {
+			fail = false
+			return errors.New("boom")
+		}
+		return nil
+	})
+	seen := map[string]bool{}
+	d.Deduplicate = func(ctx context.Context, deliveryID string) (bool, error) {
+		return seen[deliveryID], nil
+	}
+	d.Delivered = func(ctx context.Context, deliveryID string) error {
+		seen[deliveryID] = true
+		return nil
+	}
+
+	// The first delivery of d1 fails, so its redelivery is dispatched again.
+	for i, id := range []string{"d1", "d1", "d2", "d1"} {
+		want := http.StatusNoContent
+		if i == 0 {
+			want = http.StatusInternalServerError
+		}
+		w := httptest.NewRecorder()
+		d.ServeHTTP(w, newWebhookRequest(t, "ping", id, `{"zen":"z"}`, nil))
+		if w.Code != want {
+			t.Errorf("ServeHTTP(%v) #%v responded %v, want %v", id, i, w.Code, want)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("OnPing handler called %v times, want 3", calls)
+	}
+
+	d.Deduplicate = func(ctx context.Context, deliveryID string) (bool, error) {
+		return false, errors.New("store unavailable")
+	}
+	w := httptest.NewRecorder()
+	d.ServeHTTP(w, newWebhookRequest(t, "ping", "d3", `{}`, nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("ServeHTTP responded %v, want %v", w.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestEventDispatcher_ServeHTTP_handlerError(t *testing.T) {
+	d := NewEventDispatcher(nil)
+
+	second := false
+	d.OnIssues(func(ctx context.Context, event *IssuesEvent) error {
+		return errors.New("boom")
+	})
+	d.OnIssues(func(ctx context.Context, event *IssuesEvent) error {
+		second = true
+		return nil
+	})
+	var handledErr error
+	d.ErrorHandler = func(r *http.Request, err error) { handledErr = err }
+
+	w := httptest.NewRecorder()
+	d.ServeHTTP(w, newWebhookRequest(t, "issues", "d1", `{}`, nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("ServeHTTP responded %v, want %v", w.Code, http.StatusInternalServerError)
+	}
+	if handledErr == nil || handledErr.Error() != "boom" {
+		t.Errorf("ErrorHandler got %v, want boom", handledErr)
+	}
+	if second {
+		t.Error("second handler called after an error")
+	}
+}
+
+func TestEventDispatcher_Dispatch_unhandled(t *testing.T) {
+	d := NewEventDispatcher(nil)
+
+	var gotType, gotPayload string
+	d.Unhandled = func(ctx context.Context, eventType string, payload []byte) error {
+		gotType, gotPayload = eventType, string(payload)
+		return nil
+	}
+
+	ctx := context.Background()
+	if err := d.Dispatch(ctx, "unknown_event", []byte(`{"a":1}`)); err != nil {
+		t.Errorf("Dispatch returned error: %v", err)
+	}
+	if gotType != "unknown_event" || gotPayload != `{"a":1}` {
+		t.Errorf("Unhandled got %q %q, want %q %q", gotType, gotPayload, "unknown_event", `{"a":1}`)
+	}
+
+	d.Unhandled = nil
+	if err := d.Dispatch(ctx, "push", []byte(`{}`)); err != nil {
+		t.Errorf("Dispatch without handlers returned error: %v", err)
+	}
+}
+
+func TestEventDispatcher_Dispatch_unknownEventHandler(t *testing.T) {
+	d := NewEventDispatcher(nil)
+
+	var got interface{}
+	d.On("new_event", func(ctx context.Context, event interface{}) error {
+		got = event
+		return nil
+	})
+
+	if err := d.Dispatch(context.Background(), "new_event", []byte(`{"a":1}`)); err != nil {
+		t.Errorf("Dispatch returned error: %v", err)
+	}
+	if want := json.RawMessage(`{"a":1}`); !cmp.Equal(got, want) {
+		t.Errorf("handler got %#v, want %#v", got, want)
+	}
+}
+
+func TestEventDispatcher_Dispatch_invalidPayload(t *testing.T) {
+	d := NewEventDispatcher(nil)
+	d.OnPush(func(ctx context.Context, event *PushEvent) error {
+		t.Error("handler called for an invalid payload")
+		return nil
+	})
+
+	if err := d.Dispatch(context.Background(), "push", []byte(`{`)); err == nil {
+		t.Error("Dispatch returned no error, want one")
+	}
+}
+
+func TestEventDispatcher_handlersForAllEvents(t *testing.T) {
+	typ := reflect.TypeOf(&EventDispatcher{})
+	for eventType, structName := range eventTypeMapping {
+		name := "On" + strings.TrimSuffix(structName, "Event")
+		if _, ok := typ.MethodByName(name); !ok {
+			t.Errorf("EventDispatcher has no %v method for %q events", name, eventType)
+		}
+	}
+}
+
+func TestEventDispatcher_ServeHTTP_ping(t *testing.T) {
+	d := NewEventDispatcher(nil)
+	d.Unhandled = func(ctx context.Context, eventType string, payload []byte) error {
+		t.Errorf("Unhandled called for %q", eventType)
+		return nil
+	}
+
+	w := httptest.NewRecorder()
+	d.ServeHTTP(w, newWebhookRequest(t, "ping", "d1", `{"zen":"z","hook_id":1}`, nil))
+	if w.Code != http.StatusNoContent {
+		t.Errorf("ServeHTTP responded %v, want %v", w.Code, http.StatusNoContent)
+	}
+
+	var got *PingEvent
+	var gotHeaders *WebhookHeaders
+	d.OnPing(func(ctx context.Context, event *PingEvent) error {
+		got = event
+		gotHeaders = WebhookHeadersFromContext(ctx)
+		return nil
+	})
+
+	req := newWebhookRequest(t, "ping", "d2", `{"zen":"z","hook_id":1}`, nil)
+	req.Header.Set(HookIDHeader, "1")
+	w = httptest.NewRecorder()
+	d.ServeHTTP(w, req)
+	if w.Code != http.StatusNoContent {
+		t.Errorf("ServeHTTP responded %v, want %v", w.Code, http.StatusNoContent)
+	}
+
+	if want := (&PingEvent{Zen: String("z"), HookID: Int64(1)}); !cmp.Equal(got, want) {
+		t.Errorf("OnPing handler got %+v, want %+v", got, want)
+	}
+	if want := (&WebhookHeaders{Event: "ping", DeliveryID: "d2", HookID: 1}); !cmp.Equal(gotHeaders, want) {
+		t.Errorf("WebhookHeadersFromContext = %+v, want %+v", gotHeaders, want)
+	}
+}
+
+func TestEventDispatcher_ServeHTTP_invalidHeaders(t *testing.T) {
+	d := NewEventDispatcher(nil)
+
+	req := newWebhookRequest(t, "ping", "d1", `{}`, nil)
+	req.Header.Set(HookIDHeader, "x")
+	w := httptest.NewRecorder()
+	d.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("ServeHTTP responded %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestWebhookHeadersFromContext_missing(t *testing.T) {
+	if got := WebhookHeadersFromContext(context.Background()); got != nil {
+		t.Errorf("WebhookHeadersFromContext = %+v, want nil", got)
+	}
+}
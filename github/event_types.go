@@ -74,6 +74,7 @@ type CommitCommentEvent struct {
 	// The following fields are only populated by Webhook events.
 	Action       *string       `json:"action,omitempty"`
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -88,10 +89,47 @@ type ContentReferenceEvent struct {
 	Action           *string           `json:"action,omitempty"`
 	ContentReference *ContentReference `json:"content_reference,omitempty"`
 	Repo             *Repository       `json:"repository,omitempty"`
+	Org              *Organization     `json:"organization,omitempty"`
 	Sender           *User             `json:"sender,omitempty"`
 	Installation     *Installation     `json:"installation,omitempty"`
 }
 
+// CustomPropertyEvent is triggered when a custom property of an organization
+// is created, updated or deleted.
+// The Webhook event name is "custom_property".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#custom_property
+type CustomPropertyEvent struct {
+	// Action is the action that was performed. Possible values are: "created", "deleted", "updated".
+	Action     *string         `json:"action,omitempty"`
+	Definition *CustomProperty `json:"definition,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
+// CustomPropertyValuesEvent is triggered when the custom property values of
+// a repository are updated.
+// The Webhook event name is "custom_property_values".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#custom_property_values
+type CustomPropertyValuesEvent struct {
+	// Action is the action that was performed. Possible value is: "updated".
+	Action            *string                `json:"action,omitempty"`
+	NewPropertyValues []*CustomPropertyValue `json:"new_property_values,omitempty"`
+	OldPropertyValues []*CustomPropertyValue `json:"old_property_values,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // CreateEvent represents a created repository, branch, or tag.
 // The Webhook event name is "create".
 //
@@ -130,6 +168,7 @@ type DeleteEvent struct {
 	// The following fields are only populated by Webhook events.
 	PusherType   *string       `json:"pusher_type,omitempty"`
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -165,14 +204,38 @@ type DeployKeyEvent struct {
 //
 // GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhook-events-and-payloads#deployment
 type DeploymentEvent struct {
-	Deployment *Deployment `json:"deployment,omitempty"`
-	Repo       *Repository `json:"repository,omitempty"`
+	Deployment *Deployment   `json:"deployment,omitempty"`
+	Repo       *Repository   `json:"repository,omitempty"`
+	Org        *Organization `json:"organization,omitempty"`
 
 	// The following fields are only populated by Webhook events.
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// DeploymentProtectionRuleEvent is triggered when a deployment waits for a
+// custom deployment protection rule. The GitHub App of the rule approves or
+// rejects the deployment by posting to DeploymentCallbackURL.
+// The Webhook event name is "deployment_protection_rule".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#deployment_protection_rule
+type DeploymentProtectionRuleEvent struct {
+	// Action is the action that was performed. Possible value is: "requested".
+	Action      *string `json:"action,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+	// Event is the event that triggered the deployment, such as "push".
+	Event                 *string        `json:"event,omitempty"`
+	DeploymentCallbackURL *string        `json:"deployment_callback_url,omitempty"`
+	Deployment            *Deployment    `json:"deployment,omitempty"`
+	PullRequests          []*PullRequest `json:"pull_requests,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // DeploymentStatusEvent represents a deployment status.
 // The Webhook event name is "deployment_status".
 //
@@ -183,6 +246,7 @@ type DeploymentStatusEvent struct {
 	Deployment       *Deployment       `json:"deployment,omitempty"`
 	DeploymentStatus *DeploymentStatus `json:"deployment_status,omitempty"`
 	Repo             *Repository       `json:"repository,omitempty"`
+	Org              *Organization     `json:"organization,omitempty"`
 
 	// The following fields are only populated by Webhook events.
 	Sender       *User         `json:"sender,omitempty"`
@@ -285,6 +349,7 @@ type ForkEvent struct {
 
 	// The following fields are only populated by Webhook events.
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -321,6 +386,7 @@ type GollumEvent struct {
 
 	// The following fields are only populated by Webhook events.
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -516,6 +582,7 @@ type IssuesEvent struct {
 	// The following fields are only populated by Webhook events.
 	Changes      *EditChange   `json:"changes,omitempty"`
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 	Milestone    *Milestone    `json:"milestone,omitempty"`
@@ -568,6 +635,7 @@ type MemberEvent struct {
 
 	// The following fields are only populated by Webhook events.
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -612,8 +680,11 @@ type MergeGroup struct {
 //
 // GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhook-events-and-payloads#merge_group
 type MergeGroupEvent struct {
-	// The action that was performed. Currently, can only be checks_requested.
+	// The action that was performed. Possible values are: "checks_requested", "destroyed".
 	Action *string `json:"action,omitempty"`
+	// The reason the merge group was destroyed. Only provided for "destroyed" actions.
+	// Possible values are: "merged", "invalidated", "dequeued".
+	Reason *string `json:"reason,omitempty"`
 	// The merge group.
 	MergeGroup *MergeGroup `json:"merge_group,omitempty"`
 
@@ -739,6 +810,7 @@ type PageBuildEvent struct {
 	// The following fields are only populated by Webhook events.
 	ID           *int64        `json:"id,omitempty"`
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -777,6 +849,53 @@ type ProjectEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// ProjectV2Event is triggered when there is activity relating to an organization-level project.
+// The Webhook event name is "projects_v2".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks-and-events/webhooks/webhook-events-and-payloads#projects_v2
+type ProjectV2Event struct {
+	Action     *string    `json:"action,omitempty"`
+	ProjectsV2 *ProjectV2 `json:"projects_v2,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// ProjectV2ItemEvent is triggered when there is activity relating to an item on an organization-level project.
+// The Webhook event name is "projects_v2_item".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks-and-events/webhooks/webhook-events-and-payloads#projects_v2_item
+type ProjectV2ItemEvent struct {
+	Action        *string              `json:"action,omitempty"`
+	Changes       *ProjectV2ItemChange `json:"changes,omitempty"`
+	ProjectV2Item *ProjectV2Item       `json:"projects_v2_item,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// ProjectV2ItemChange represents a change to a project item.
+type ProjectV2ItemChange struct {
+	ArchivedAt *ProjectV2ItemArchivedAtChange `json:"archived_at,omitempty"`
+	FieldValue *ProjectV2ItemFieldValueChange `json:"field_value,omitempty"`
+}
+
+// ProjectV2ItemArchivedAtChange represents a change to the archived state of a project item.
+type ProjectV2ItemArchivedAtChange struct {
+	From *Timestamp `json:"from,omitempty"`
+	To   *Timestamp `json:"to,omitempty"`
+}
+
+// ProjectV2ItemFieldValueChange represents a change to a field value of a project item.
+type ProjectV2ItemFieldValueChange struct {
+	FieldNodeID *string `json:"field_node_id,omitempty"`
+	FieldType   *string `json:"field_type,omitempty"`
+}
+
 // ProjectCardEvent is triggered when a project card is created, updated, moved, converted to an issue, or deleted.
 // The webhook event name is "project_card".
 //
@@ -819,6 +938,7 @@ type ProjectColumnEvent struct {
 type PublicEvent struct {
 	// The following fields are only populated by Webhook events.
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -901,6 +1021,7 @@ type PullRequestReviewCommentEvent struct {
 	// The following fields are only populated by Webhook events.
 	Changes      *EditChange   `json:"changes,omitempty"`
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -919,6 +1040,7 @@ type PullRequestReviewThreadEvent struct {
 
 	// The following fields are only populated by Webhook events.
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -1070,6 +1192,25 @@ type PushEventRepoOwner struct {
 	Email *string `json:"email,omitempty"`
 }
 
+// RegistryPackageEvent is triggered when a package is published or updated
+// in GitHub Packages.
+// The Webhook event name is "registry_package".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#registry_package
+type RegistryPackageEvent struct {
+	// Action is the action that was performed.
+	// Can be "published" or "updated".
+	Action          *string       `json:"action,omitempty"`
+	RegistryPackage *Package      `json:"registry_package,omitempty"`
+	Repo            *Repository   `json:"repository,omitempty"`
+	Org             *Organization `json:"organization,omitempty"`
+	Sender          *User         `json:"sender,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // ReleaseEvent is triggered when a release is published, unpublished, created,
 // edited, deleted, or prereleased.
 // The Webhook event name is "release".
@@ -1083,6 +1224,7 @@ type ReleaseEvent struct {
 
 	// The following fields are only populated by Webhook events.
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -1135,6 +1277,45 @@ type RepositoryImportEvent struct {
 	Repo   *Repository   `json:"repository,omitempty"`
 	Org    *Organization `json:"organization,omitempty"`
 	Sender *User         `json:"sender,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// RepositoryRulesetEvent is triggered when a repository ruleset is created,
+// edited or deleted.
+// The Webhook event name is "repository_ruleset".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#repository_ruleset
+type RepositoryRulesetEvent struct {
+	// Action is the action that was performed. Possible values are: "created", "deleted", "edited".
+	Action            *string                   `json:"action,omitempty"`
+	RepositoryRuleset *RepositoryRuleset        `json:"repository_ruleset,omitempty"`
+	Changes           *RepositoryRulesetChanges `json:"changes,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
+// RepositoryRulesetChanges represents the changes made to a repository
+// ruleset by an "edited" RepositoryRulesetEvent. The changes to the
+// conditions and rules are nested by condition and rule and are kept as
+// raw JSON.
+type RepositoryRulesetChanges struct {
+	Name        *RepositoryRulesetChangeSource `json:"name,omitempty"`
+	Enforcement *RepositoryRulesetChangeSource `json:"enforcement,omitempty"`
+	Conditions  *json.RawMessage               `json:"conditions,omitempty"`
+	Rules       *json.RawMessage               `json:"rules,omitempty"`
+}
+
+// RepositoryRulesetChangeSource represents the previous value of a changed
+// field of a repository ruleset.
+type RepositoryRulesetChangeSource struct {
+	From *string `json:"from,omitempty"`
 }
 
 // RepositoryVulnerabilityAlertEvent is triggered when a security alert is created, dismissed, or resolved.
@@ -1152,6 +1333,7 @@ type RepositoryVulnerabilityAlertEvent struct {
 
 	// The following fields are only populated by Webhook events.
 	Installation *Installation `json:"installation,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 
 	// The user that triggered the event.
 	Sender *User `json:"sender,omitempty"`
@@ -1193,6 +1375,25 @@ type SecretScanningAlertEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// SecretScanningAlertLocationEvent is triggered when a secret found by secret
+// scanning is found in a new location.
+// The Webhook event name is "secret_scanning_alert_location".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#secret_scanning_alert_location
+type SecretScanningAlertLocationEvent struct {
+	// Action is the action that was performed. Possible value is: "created".
+	Action   *string                      `json:"action,omitempty"`
+	Alert    *SecretScanningAlert         `json:"alert,omitempty"`
+	Location *SecretScanningAlertLocation `json:"location,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // StarEvent is triggered when a star is added or removed from a repository.
 // The Webhook event name is "star".
 //
@@ -1234,10 +1435,34 @@ type StatusEvent struct {
 	CreatedAt    *Timestamp        `json:"created_at,omitempty"`
 	UpdatedAt    *Timestamp        `json:"updated_at,omitempty"`
 	Repo         *Repository       `json:"repository,omitempty"`
+	Org          *Organization     `json:"organization,omitempty"`
 	Sender       *User             `json:"sender,omitempty"`
 	Installation *Installation     `json:"installation,omitempty"`
 }
 
+// SubIssuesEvent is triggered when a sub-issue is added to or removed from an
+// issue, or when an issue gets or loses its parent.
+// The Webhook event name is "sub_issues".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#sub_issues
+type SubIssuesEvent struct {
+	// Action is the action that was performed. Possible values are: "sub_issue_added",
+	// "sub_issue_removed", "parent_issue_added", "parent_issue_removed".
+	Action          *string     `json:"action,omitempty"`
+	ParentIssueID   *int64      `json:"parent_issue_id,omitempty"`
+	ParentIssue     *Issue      `json:"parent_issue,omitempty"`
+	ParentIssueRepo *Repository `json:"parent_issue_repo,omitempty"`
+	SubIssueID      *int64      `json:"sub_issue_id,omitempty"`
+	SubIssue        *Issue      `json:"sub_issue,omitempty"`
+	SubIssueRepo    *Repository `json:"sub_issue_repo,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // TeamEvent is triggered when an organization's team is created, modified or deleted.
 // The Webhook event name is "team".
 //
@@ -1304,6 +1529,7 @@ type WatchEvent struct {
 
 	// The following fields are only populated by Webhook events.
 	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
 	Installation *Installation `json:"installation,omitempty"`
 }
@@ -1357,21 +1583,75 @@ type WorkflowRunEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
-// SecurityAdvisory represents the advisory object in SecurityAdvisoryEvent payload.
+// SecurityAdvisory represents the advisory object in SecurityAdvisoryEvent payload,
+// and a repository security advisory returned by the SecurityAdvisoriesService.
 //
 // GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#security_advisory
 type SecurityAdvisory struct {
 	GHSAID          *string                  `json:"ghsa_id,omitempty"`
+	CVEID           *string                  `json:"cve_id,omitempty"`
+	URL             *string                  `json:"url,omitempty"`
+	HTMLURL         *string                  `json:"html_url,omitempty"`
 	Summary         *string                  `json:"summary,omitempty"`
 	Description     *string                  `json:"description,omitempty"`
 	Severity        *string                  `json:"severity,omitempty"`
+	CVSs            *AdvisoryCVSs            `json:"cvss,omitempty"`
+	CVSSSeverities  *AdvisoryCVSSSeverities  `json:"cvss_severities,omitempty"`
+	EPSS            *AdvisoryEPSS            `json:"epss,omitempty"`
+	CWEs            []*AdvisoryCWEs          `json:"cwes,omitempty"`
+	CWEIDs          []string                 `json:"cwe_ids,omitempty"`
 	Identifiers     []*AdvisoryIdentifier    `json:"identifiers,omitempty"`
 	References      []*AdvisoryReference     `json:"references,omitempty"`
 	PublishedAt     *Timestamp               `json:"published_at,omitempty"`
 	UpdatedAt       *Timestamp               `json:"updated_at,omitempty"`
 	WithdrawnAt     *Timestamp               `json:"withdrawn_at,omitempty"`
 	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
-}
+
+	// The following fields are only populated for repository security advisories.
+	State              *string                     `json:"state,omitempty"`
+	CreatedAt          *Timestamp                  `json:"created_at,omitempty"`
+	ClosedAt           *Timestamp                  `json:"closed_at,omitempty"`
+	Author             *User                       `json:"author,omitempty"`
+	Publisher          *User                       `json:"publisher,omitempty"`
+	Submission         *SecurityAdvisorySubmission `json:"submission,omitempty"`
+	Credits            []*AdvisoryCredit           `json:"credits,omitempty"`
+	CreditsDetailed    []*AdvisoryCreditDetailed   `json:"credits_detailed,omitempty"`
+	CollaboratingUsers []*User                     `json:"collaborating_users,omitempty"`
+	CollaboratingTeams []*Team                     `json:"collaborating_teams,omitempty"`
+	PrivateFork        *Repository                 `json:"private_fork,omitempty"`
+}
+
+// SecurityAdvisorySubmission represents the private vulnerability report
+// a repository security advisory was created from.
+type SecurityAdvisorySubmission struct {
+	// Accepted reports whether the report was accepted by the repository maintainers.
+	Accepted *bool `json:"accepted,omitempty"`
+}
+
+// AdvisoryCredit represents a user credited for a security advisory.
+type AdvisoryCredit struct {
+	Login *string `json:"login,omitempty"`
+	// Type is one of analyst, finder, reporter, coordinator, remediation_developer,
+	// remediation_reviewer, remediation_verifier, tool, sponsor or other.
+	Type *string `json:"type,omitempty"`
+}
+
+// AdvisoryCreditDetailed represents a credit of a security advisory, with
+// the credited user and whether they accepted the credit.
+type AdvisoryCreditDetailed struct {
+	User *User   `json:"user,omitempty"`
+	Type *string `json:"type,omitempty"`
+	// State is one of the AdvisoryCreditState constants.
+	State *string `json:"state,omitempty"`
+}
+
+// States of an AdvisoryCreditDetailed. Users credited in an advisory are
+// invited to accept the credit; the credit is pending until they answer.
+const (
+	AdvisoryCreditStateAccepted = "accepted"
+	AdvisoryCreditStateDeclined = "declined"
+	AdvisoryCreditStatePending  = "pending"
+)
 
 // AdvisoryIdentifier represents the identifier for a Security Advisory.
 type AdvisoryIdentifier struct {
@@ -1390,6 +1670,10 @@ type AdvisoryVulnerability struct {
 	Severity               *string               `json:"severity,omitempty"`
 	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
 	FirstPatchedVersion    *FirstPatchedVersion  `json:"first_patched_version,omitempty"`
+
+	// The following fields are only populated for repository security advisories.
+	PatchedVersions     *string  `json:"patched_versions,omitempty"`
+	VulnerableFunctions []string `json:"vulnerable_functions,omitempty"`
 }
 
 // VulnerabilityPackage represents the package object for an Advisory Vulnerability.
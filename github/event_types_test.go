@@ -15273,3 +15273,325 @@ func TestCodeScanningAlertEvent_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestProjectV2Event_Marshal(t *testing.T) {
+	testJSONMarshal(t, &ProjectV2Event{}, "{}")
+
+	u := &ProjectV2Event{
+		Action: String("closed"),
+		ProjectsV2: &ProjectV2{
+			ID:        Int64(1),
+			NodeID:    String("nid"),
+			Owner:     &User{Login: String("o")},
+			Creator:   &User{Login: String("c")},
+			Title:     String("t"),
+			Number:    Int(2),
+			ClosedAt:  &Timestamp{referenceTime},
+			CreatedAt: &Timestamp{referenceTime},
+			UpdatedAt: &Timestamp{referenceTime},
+		},
+		Org:          &Organization{Login: String("o")},
+		Sender:       &User{Login: String("s")},
+		Installation: &Installation{ID: Int64(1)},
+	}
+
+	want := `{
+		"action": "closed",
+		"projects_v2": {
+			"id": 1,
+			"node_id": "nid",
+			"owner": {"login": "o"},
+			"creator": {"login": "c"},
+			"title": "t",
+			"number": 2,
+			"closed_at": ` + referenceTimeStr + `,
+			"created_at": ` + referenceTimeStr + `,
+			"updated_at": ` + referenceTimeStr + `
+		},
+		"organization": {"login": "o"},
+		"sender": {"login": "s"},
+		"installation": {"id": 1}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestProjectV2ItemEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &ProjectV2ItemEvent{}, "{}")
+
+	u := &ProjectV2ItemEvent{
+		Action: String("archived"),
+		Changes: &ProjectV2ItemChange{
+			ArchivedAt: &ProjectV2ItemArchivedAtChange{To: &Timestamp{referenceTime}},
+			FieldValue: &ProjectV2ItemFieldValueChange{FieldNodeID: String("fnid"), FieldType: String("single_select")},
+		},
+		ProjectV2Item: &ProjectV2Item{
+			ID:            Int64(1),
+			NodeID:        String("nid"),
+			ProjectNodeID: String("pnid"),
+			ContentNodeID: String("cnid"),
+			ContentType:   String("Issue"),
+			Creator:       &User{Login: String("c")},
+			ArchivedAt:    &Timestamp{referenceTime},
+		},
+		Org:          &Organization{Login: String("o")},
+		Sender:       &User{Login: String("s")},
+		Installation: &Installation{ID: Int64(1)},
+	}
+
+	want := `{
+		"action": "archived",
+		"changes": {
+			"archived_at": {"to": ` + referenceTimeStr + `},
+			"field_value": {"field_node_id": "fnid", "field_type": "single_select"}
+		},
+		"projects_v2_item": {
+			"id": 1,
+			"node_id": "nid",
+			"project_node_id": "pnid",
+			"content_node_id": "cnid",
+			"content_type": "Issue",
+			"creator": {"login": "c"},
+			"archived_at": ` + referenceTimeStr + `
+		},
+		"organization": {"login": "o"},
+		"sender": {"login": "s"},
+		"installation": {"id": 1}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestCustomPropertyEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &CustomPropertyEvent{}, "{}")
+
+	u := &CustomPropertyEvent{
+		Action: String("created"),
+		Definition: &CustomProperty{
+			PropertyName:     String("team"),
+			ValueType:        "single_select",
+			Required:         Bool(true),
+			DefaultValue:     String("core"),
+			AllowedValues:    []string{"core", "docs"},
+			ValuesEditableBy: String("org_actors"),
+		},
+		Org:    &Organization{Login: String("o")},
+		Sender: &User{Login: String("l")},
+	}
+
+	want := `{
+		"action": "created",
+		"definition": {
+			"property_name": "team",
+			"value_type": "single_select",
+			"required": true,
+			"default_value": "core",
+			"allowed_values": ["core", "docs"],
+			"values_editable_by": "org_actors"
+		},
+		"organization": {"login": "o"},
+		"sender": {"login": "l"}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestCustomPropertyValuesEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &CustomPropertyValuesEvent{}, "{}")
+
+	u := &CustomPropertyValuesEvent{
+		Action: String("updated"),
+		NewPropertyValues: []*CustomPropertyValue{
+			{PropertyName: "team", Value: "core"},
+			{PropertyName: "langs", Value: []interface{}{"go"}},
+		},
+		OldPropertyValues: []*CustomPropertyValue{
+			{PropertyName: "team", Value: nil},
+		},
+		Repo: &Repository{ID: Int64(1)},
+	}
+
+	want := `{
+		"action": "updated",
+		"new_property_values": [
+			{"property_name": "team", "value": "core"},
+			{"property_name": "langs", "value": ["go"]}
+		],
+		"old_property_values": [
+			{"property_name": "team", "value": null}
+		],
+		"repository": {"id": 1}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestDeploymentProtectionRuleEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &DeploymentProtectionRuleEvent{}, "{}")
+
+	u := &DeploymentProtectionRuleEvent{
+		Action:                String("requested"),
+		Environment:           String("production"),
+		Event:                 String("push"),
+		DeploymentCallbackURL: String("https://api.github.com/repos/o/r/actions/runs/1/deployment_protection_rule"),
+		Deployment:            &Deployment{ID: Int64(1)},
+		PullRequests:          []*PullRequest{{Number: Int(2)}},
+		Repo:                  &Repository{ID: Int64(3)},
+		Installation:          &Installation{ID: Int64(4)},
+	}
+
+	want := `{
+		"action": "requested",
+		"environment": "production",
+		"event": "push",
+		"deployment_callback_url": "https://api.github.com/repos/o/r/actions/runs/1/deployment_protection_rule",
+		"deployment": {"id": 1},
+		"pull_requests": [{"number": 2}],
+		"repository": {"id": 3},
+		"installation": {"id": 4}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestRegistryPackageEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &RegistryPackageEvent{}, "{}")
+
+	u := &RegistryPackageEvent{
+		Action: String("published"),
+		RegistryPackage: &Package{
+			ID:             Int64(1),
+			Name:           String("n"),
+			PackageType:    String("container"),
+			PackageVersion: &PackageVersion{ID: Int64(2), Version: String("v1")},
+		},
+		Repo:       &Repository{ID: Int64(3)},
+		Sender:     &User{Login: String("l")},
+		Enterprise: &Enterprise{ID: Int(4)},
+	}
+
+	want := `{
+		"action": "published",
+		"registry_package": {
+			"id": 1,
+			"name": "n",
+			"package_type": "container",
+			"package_version": {"id": 2, "version": "v1"}
+		},
+		"repository": {"id": 3},
+		"sender": {"login": "l"},
+		"enterprise": {"id": 4}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestRepositoryRulesetEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &RepositoryRulesetEvent{}, "{}")
+
+	params := json.RawMessage(`{"required_approving_review_count":1}`)
+	conditions := json.RawMessage(`{"updated":[]}`)
+	u := &RepositoryRulesetEvent{
+		Action: String("edited"),
+		RepositoryRuleset: &RepositoryRuleset{
+			ID:          Int64(1),
+			Name:        String("main"),
+			Target:      String("branch"),
+			SourceType:  String("Repository"),
+			Source:      String("o/r"),
+			Enforcement: String("active"),
+			BypassActors: []*RulesetBypassActor{
+				{ActorID: Int64(2), ActorType: String("Team"), BypassMode: String("always")},
+			},
+			Conditions: &RulesetConditions{
+				RefName: &RulesetRefConditionParameters{Include: []string{"~DEFAULT_BRANCH"}, Exclude: []string{}},
+			},
+			Rules: []*RepositoryRule{
+				{Type: "deletion"},
+				{Type: "pull_request", Parameters: &params},
+			},
+		},
+		Changes: &RepositoryRulesetChanges{
+			Name:       &RepositoryRulesetChangeSource{From: String("old")},
+			Conditions: &conditions,
+		},
+		Repo: &Repository{ID: Int64(3)},
+	}
+
+	want := `{
+		"action": "edited",
+		"repository_ruleset": {
+			"id": 1,
+			"name": "main",
+			"target": "branch",
+			"source_type": "Repository",
+			"source": "o/r",
+			"enforcement": "active",
+			"bypass_actors": [{"actor_id": 2, "actor_type": "Team", "bypass_mode": "always"}],
+			"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+			"rules": [
+				{"type": "deletion"},
+				{"type": "pull_request", "parameters": {"required_approving_review_count": 1}}
+			]
+		},
+		"changes": {
+			"name": {"from": "old"},
+			"conditions": {"updated": []}
+		},
+		"repository": {"id": 3}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestSecretScanningAlertLocationEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SecretScanningAlertLocationEvent{}, "{}")
+
+	u := &SecretScanningAlertLocationEvent{
+		Action: String("created"),
+		Alert:  &SecretScanningAlert{Number: Int(1)},
+		Location: &SecretScanningAlertLocation{
+			Type:    String(SecretScanningLocationCommit),
+			Details: &SecretScanningAlertLocationDetails{Path: String("p"), CommitSHA: String("s")},
+		},
+		Repo: &Repository{ID: Int64(2)},
+	}
+
+	want := `{
+		"action": "created",
+		"alert": {"number": 1},
+		"location": {
+			"type": "commit",
+			"details": {"path": "p", "commit_sha": "s"}
+		},
+		"repository": {"id": 2}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestSubIssuesEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SubIssuesEvent{}, "{}")
+
+	u := &SubIssuesEvent{
+		Action:          String("sub_issue_added"),
+		ParentIssueID:   Int64(1),
+		ParentIssue:     &Issue{ID: Int64(1), Number: Int(10)},
+		ParentIssueRepo: &Repository{ID: Int64(3)},
+		SubIssueID:      Int64(2),
+		SubIssue:        &Issue{ID: Int64(2), Number: Int(11)},
+		Repo:            &Repository{ID: Int64(3)},
+	}
+
+	want := `{
+		"action": "sub_issue_added",
+		"parent_issue_id": 1,
+		"parent_issue": {"id": 1, "number": 10},
+		"parent_issue_repo": {"id": 3},
+		"sub_issue_id": 2,
+		"sub_issue": {"id": 2, "number": 11},
+		"repository": {"id": 3}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
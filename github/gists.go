@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"time"
 )
 
@@ -31,6 +33,9 @@ type Gist struct {
 	CreatedAt   *Timestamp                `json:"created_at,omitempty"`
 	UpdatedAt   *Timestamp                `json:"updated_at,omitempty"`
 	NodeID      *string                   `json:"node_id,omitempty"`
+	// Truncated reports whether the list of files was truncated because
+	// the gist has more than 300 files.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (g Gist) String() string {
@@ -48,6 +53,9 @@ type GistFile struct {
 	Type     *string `json:"type,omitempty"`
 	RawURL   *string `json:"raw_url,omitempty"`
 	Content  *string `json:"content,omitempty"`
+	// Truncated reports whether Content holds only the beginning of a file
+	// larger than one megabyte.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (g GistFile) String() string {
@@ -172,6 +180,9 @@ func (s *GistsService) ListStarred(ctx context.Context, opts *GistListOptions) (
 
 // Get a single gist.
 //
+// The content of files that GitHub truncates is fetched from their RawURL,
+// so Content always holds the complete file.
+//
 // GitHub API docs: https://docs.github.com/en/rest/gists/gists#get-a-gist
 func (s *GistsService) Get(ctx context.Context, id string) (*Gist, *Response, error) {
 	u := fmt.Sprintf("gists/%v", id)
@@ -186,11 +197,17 @@ func (s *GistsService) Get(ctx context.Context, id string) (*Gist, *Response, er
 		return nil, resp, err
 	}
 
+	if err := s.fetchTruncatedFiles(ctx, gist); err != nil {
+		return nil, resp, err
+	}
+
 	return gist, resp, nil
 }
 
 // GetRevision gets a specific revision of a gist.
 //
+// Like Get, it fetches the complete content of truncated files.
+//
 // GitHub API docs: https://docs.github.com/en/rest/gists/gists#get-a-gist-revision
 func (s *GistsService) GetRevision(ctx context.Context, id, sha string) (*Gist, *Response, error) {
 	u := fmt.Sprintf("gists/%v/%v", id, sha)
@@ -205,6 +222,10 @@ func (s *GistsService) GetRevision(ctx context.Context, id, sha string) (*Gist,
 		return nil, resp, err
 	}
 
+	if err := s.fetchTruncatedFiles(ctx, gist); err != nil {
+		return nil, resp, err
+	}
+
 	return gist, resp, nil
 }
 
@@ -324,6 +345,23 @@ func (s *GistsService) IsStarred(ctx context.Context, id string) (bool, *Respons
 	return starred, resp, err
 }
 
+// SetStarred stars or unstars a gist on behalf of the authenticated user,
+// depending on starred. It does nothing when the gist is already in the
+// requested state.
+//
+// GitHub API docs: https://docs.github.com/en/rest/gists/gists#star-a-gist
+func (s *GistsService) SetStarred(ctx context.Context, id string, starred bool) (*Response, error) {
+	isStarred, resp, err := s.IsStarred(ctx, id)
+	if err != nil || isStarred == starred {
+		return resp, err
+	}
+
+	if starred {
+		return s.Star(ctx, id)
+	}
+	return s.Unstar(ctx, id)
+}
+
 // Fork a gist.
 //
 // GitHub API docs: https://docs.github.com/en/rest/gists/gists#fork-a-gist
@@ -366,3 +404,34 @@ func (s *GistsService) ListForks(ctx context.Context, id string, opts *ListOptio
 
 	return gistForks, resp, nil
 }
+
+// fetchTruncatedFiles replaces the content of the truncated files of gist with
+// their complete content, read from their raw URL.
+func (s *GistsService) fetchTruncatedFiles(ctx context.Context, gist *Gist) error {
+	for name, file := range gist.Files {
+		if !file.GetTruncated() || file.RawURL == nil {
+			continue
+		}
+
+		req, err := http.NewRequest("GET", file.GetRawURL(), nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.client.BareDo(ctx, req)
+		if err != nil {
+			return err
+		}
+		content, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		file.Content = String(string(content))
+		file.Truncated = Bool(false)
+		gist.Files[name] = file
+	}
+
+	return nil
+}
@@ -417,6 +417,59 @@ func TestGistsService_Get(t *testing.T) {
 	})
 }
 
+func TestGistsService_Get_truncatedFile(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	rawURL := serverURL + baseURLPath + "/raw/big.txt"
+	mux.HandleFunc("/gists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"id":"1","files":{"big.txt":{"filename":"big.txt","raw_url":%q,"content":"abc","truncated":true},"small.txt":{"content":"s"}}}`, rawURL)
+	})
+	mux.HandleFunc("/raw/big.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "abcdef")
+	})
+
+	ctx := context.Background()
+	gist, _, err := client.Gists.Get(ctx, "1")
+	if err != nil {
+		t.Errorf("Gists.Get returned error: %v", err)
+	}
+
+	want := &Gist{
+		ID: String("1"),
+		Files: map[GistFilename]GistFile{
+			"big.txt":   {Filename: String("big.txt"), RawURL: String(rawURL), Content: String("abcdef"), Truncated: Bool(false)},
+			"small.txt": {Content: String("s")},
+		},
+	}
+	if !cmp.Equal(gist, want) {
+		t.Errorf("Gists.Get returned %+v, want %+v", gist, want)
+	}
+}
+
+func TestGistsService_GetRevision_truncatedFileError(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/gists/1/s", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":"1","files":{"big.txt":{"raw_url":%q,"truncated":true}}}`, serverURL+baseURLPath+"/raw/big.txt")
+	})
+	mux.HandleFunc("/raw/big.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	gist, _, err := client.Gists.GetRevision(ctx, "1", "s")
+	if err == nil {
+		t.Error("Gists.GetRevision returned no error, want one")
+	}
+	if gist != nil {
+		t.Errorf("Gists.GetRevision returned %+v, want nil", gist)
+	}
+}
+
 func TestGistsService_Get_invalidID(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -894,6 +947,50 @@ func TestGistsService_IsStarred_invalidID(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestGistsService_SetStarred(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var starred bool
+	var calls []string
+	mux.HandleFunc("/gists/1/star", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+		switch r.Method {
+		case "GET":
+			if !starred {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		case "PUT":
+			starred = true
+		case "DELETE":
+			starred = false
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	for _, star := range []bool{true, true, false, false} {
+		if _, err := client.Gists.SetStarred(ctx, "1", star); err != nil {
+			t.Errorf("Gists.SetStarred(%v) returned error: %v", star, err)
+		}
+		if starred != star {
+			t.Errorf("Gists.SetStarred(%v) left starred = %v", star, starred)
+		}
+	}
+
+	want := []string{"GET", "PUT", "GET", "GET", "DELETE", "GET"}
+	if !cmp.Equal(calls, want) {
+		t.Errorf("Gists.SetStarred made requests %v, want %v", calls, want)
+	}
+
+	const methodName = "SetStarred"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Gists.SetStarred(ctx, "\n", true)
+		return err
+	})
+}
+
 func TestGistsService_Fork(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 )
 
 // Blob represents a blob object.
@@ -62,6 +63,29 @@ func (s *GitService) GetBlobRaw(ctx context.Context, owner, repo, sha string) ([
 	return buf.Bytes(), resp, nil
 }
 
+// DownloadBlob returns an io.ReadCloser that reads the raw contents of a
+// blob. Unlike GetBlobRaw, the contents are streamed rather than held in
+// memory, which suits blobs of up to the 100 MB the API serves. It is the
+// caller's responsibility to close the ReadCloser.
+//
+// GitHub API docs: https://docs.github.com/en/rest/git/blobs#get-a-blob
+func (s *GitService) DownloadBlob(ctx context.Context, owner, repo, sha string) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", owner, repo, sha)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", mediaTypeRaw)
+
+	resp, err := s.client.BareDo(ctx, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resp.Body, resp, nil
+}
+
 // CreateBlob creates a blob object.
 //
 // GitHub API docs: https://docs.github.com/en/rest/git/blobs#create-a-blob
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
@@ -105,6 +106,47 @@ func TestGitService_GetBlobRaw(t *testing.T) {
 	})
 }
 
+func TestGitService_DownloadBlob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeRaw)
+
+		fmt.Fprint(w, `raw contents here`)
+	})
+
+	ctx := context.Background()
+	rc, _, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+	if err != nil {
+		t.Fatalf("Git.DownloadBlob returned error: %v", err)
+	}
+	defer rc.Close()
+
+	blob, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("reading the blob returned error: %v", err)
+	}
+	if want := []byte("raw contents here"); !bytes.Equal(blob, want) {
+		t.Errorf("DownloadBlob returned %q, want %q", blob, want)
+	}
+
+	const methodName = "DownloadBlob"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.DownloadBlob(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestGitService_CreateBlob(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
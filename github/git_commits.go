@@ -15,12 +15,36 @@ import (
 	"github.com/ProtonMail/go-crypto/openpgp"
 )
 
-// SignatureVerification represents GPG signature verification.
+// Reasons of a SignatureVerification.
+const (
+	SignatureReasonExpiredKey           = "expired_key"
+	SignatureReasonNotSigningKey        = "not_signing_key"
+	SignatureReasonGPGVerifyError       = "gpgverify_error"
+	SignatureReasonGPGVerifyUnavailable = "gpgverify_unavailable"
+	SignatureReasonUnsigned             = "unsigned"
+	SignatureReasonUnknownSignatureType = "unknown_signature_type"
+	SignatureReasonNoUser               = "no_user"
+	SignatureReasonUnverifiedEmail      = "unverified_email"
+	SignatureReasonBadEmail             = "bad_email"
+	SignatureReasonUnknownKey           = "unknown_key"
+	SignatureReasonMalformedSignature   = "malformed_signature"
+	SignatureReasonInvalid              = "invalid"
+	SignatureReasonValid                = "valid"
+	SignatureReasonBadCert              = "bad_cert"
+	SignatureReasonOCSPPending          = "ocsp_pending"
+)
+
+// SignatureVerification represents the verification of the GPG, SSH or
+// S/MIME signature of a commit or tag.
 type SignatureVerification struct {
-	Verified  *bool   `json:"verified,omitempty"`
-	Reason    *string `json:"reason,omitempty"`
-	Signature *string `json:"signature,omitempty"`
-	Payload   *string `json:"payload,omitempty"`
+	Verified *bool `json:"verified,omitempty"`
+	// Reason is one of the SignatureReason constants.
+	Reason *string `json:"reason,omitempty"`
+	// Signature is the armored signature, and Payload the signed commit or
+	// tag object, which can be checked with UsersService.VerifySignature.
+	Signature  *string    `json:"signature,omitempty"`
+	Payload    *string    `json:"payload,omitempty"`
+	VerifiedAt *Timestamp `json:"verified_at,omitempty"`
 }
 
 // Commit represents a GitHub commit.
@@ -160,3 +160,40 @@ func (s *GitService) CreateTree(ctx context.Context, owner string, repo string,
 
 	return t, resp, nil
 }
+
+// defaultTreeChunkSize is the number of entries CreateTreeChunked sends per
+// request by default.
+const defaultTreeChunkSize = 1000
+
+// CreateTreeChunked creates a new tree like CreateTree, sending entries in
+// chunks of at most chunkSize entries, 1000 if chunkSize is not positive.
+// Each chunk is applied on top of the tree created from the previous ones,
+// starting from baseTree, so trees with tens of thousands of entries can be
+// created without hitting the request size limit.
+//
+// On error, the returned Tree is the last one created successfully, if any,
+// from which creation can be resumed.
+func (s *GitService) CreateTreeChunked(ctx context.Context, owner string, repo string, baseTree string, entries []*TreeEntry, chunkSize int) (*Tree, *Response, error) {
+	if chunkSize <= 0 {
+		chunkSize = defaultTreeChunkSize
+	}
+
+	var tree *Tree
+	var resp *Response
+	for start := 0; start == 0 || start < len(entries); start += chunkSize {
+		end := start + chunkSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		t, r, err := s.CreateTree(ctx, owner, repo, baseTree, entries[start:end])
+		resp = r
+		if err != nil {
+			return tree, resp, err
+		}
+		tree = t
+		baseTree = t.GetSHA()
+	}
+
+	return tree, resp, nil
+}
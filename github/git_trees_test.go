@@ -8,6 +8,7 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -446,3 +447,68 @@ func TestCreateTree_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestGitService_CreateTreeChunked(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var entries []*TreeEntry
+	for _, path := range []string{"a", "b", "c"} {
+		entries = append(entries, &TreeEntry{Path: String(path), Mode: String("100644"), Type: String("blob"), Content: String(path)})
+	}
+
+	var bodies []*createTree
+	mux.HandleFunc("/repos/o/r/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := new(createTree)
+		json.NewDecoder(r.Body).Decode(body)
+		bodies = append(bodies, body)
+		fmt.Fprintf(w, `{"sha":"t%v"}`, len(bodies))
+	})
+
+	ctx := context.Background()
+	tree, _, err := client.Git.CreateTreeChunked(ctx, "o", "r", "b", entries, 2)
+	if err != nil {
+		t.Fatalf("Git.CreateTreeChunked returned error: %v", err)
+	}
+
+	if want := "t2"; tree.GetSHA() != want {
+		t.Errorf("Git.CreateTreeChunked returned tree %v, want %v", tree.GetSHA(), want)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("Git.CreateTreeChunked made %v requests, want 2", len(bodies))
+	}
+	for i, want := range []struct {
+		baseTree string
+		entries  int
+	}{{"b", 2}, {"t1", 1}} {
+		if bodies[i].BaseTree != want.baseTree || len(bodies[i].Entries) != want.entries {
+			t.Errorf("Git.CreateTreeChunked request %v has base tree %q and %v entries, want %q and %v", i, bodies[i].BaseTree, len(bodies[i].Entries), want.baseTree, want.entries)
+		}
+	}
+}
+
+func TestGitService_CreateTreeChunked_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			http.Error(w, "boom", http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"sha":"t1"}`)
+	})
+
+	entries := []*TreeEntry{{Path: String("a"), SHA: String("s")}, {Path: String("b"), SHA: String("s")}}
+	ctx := context.Background()
+	tree, _, err := client.Git.CreateTreeChunked(ctx, "o", "r", "", entries, 1)
+	if err == nil {
+		t.Fatal("Git.CreateTreeChunked returned nil error")
+	}
+	if want := "t1"; tree.GetSHA() != want {
+		t.Errorf("Git.CreateTreeChunked returned tree %v, want the last created tree %v", tree.GetSHA(), want)
+	}
+}
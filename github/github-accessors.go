@@ -334,6 +334,46 @@ func (a *AdvancedSecurityCommittersBreakdown) GetUserLogin() string {
 	return *a.UserLogin
 }
 
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCredit) GetLogin() string {
+	if a == nil || a.Login == nil {
+		return ""
+	}
+	return *a.Login
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCredit) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCreditDetailed) GetState() string {
+	if a == nil || a.State == nil {
+		return ""
+	}
+	return *a.State
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCreditDetailed) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetUser returns the User field.
+func (a *AdvisoryCreditDetailed) GetUser() *User {
+	if a == nil {
+		return nil
+	}
+	return a.User
+}
+
 // GetScore returns the Score field.
 func (a *AdvisoryCVSs) GetScore() *float64 {
 	if a == nil {
@@ -350,6 +390,22 @@ func (a *AdvisoryCVSs) GetVectorString() string {
 	return *a.VectorString
 }
 
+// GetCVSSV3 returns the CVSSV3 field.
+func (a *AdvisoryCVSSSeverities) GetCVSSV3() *AdvisoryCVSs {
+	if a == nil {
+		return nil
+	}
+	return a.CVSSV3
+}
+
+// GetCVSSV4 returns the CVSSV4 field.
+func (a *AdvisoryCVSSSeverities) GetCVSSV4() *AdvisoryCVSs {
+	if a == nil {
+		return nil
+	}
+	return a.CVSSV4
+}
+
 // GetCWEID returns the CWEID field if it's non-nil, zero value otherwise.
 func (a *AdvisoryCWEs) GetCWEID() string {
 	if a == nil || a.CWEID == nil {
@@ -406,6 +462,14 @@ func (a *AdvisoryVulnerability) GetPackage() *VulnerabilityPackage {
 	return a.Package
 }
 
+// GetPatchedVersions returns the PatchedVersions field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetPatchedVersions() string {
+	if a == nil || a.PatchedVersions == nil {
+		return ""
+	}
+	return *a.PatchedVersions
+}
+
 // GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
 func (a *AdvisoryVulnerability) GetSeverity() string {
 	if a == nil || a.Severity == nil {
@@ -606,6 +670,94 @@ func (a *AllowForkSyncing) GetEnabled() bool {
 	return *a.Enabled
 }
 
+// GetAuthenticationType returns the AuthenticationType field if it's non-nil, zero value otherwise.
+func (a *AmazonS3AccessKeysStreamConfig) GetAuthenticationType() string {
+	if a == nil || a.AuthenticationType == nil {
+		return ""
+	}
+	return *a.AuthenticationType
+}
+
+// GetBucket returns the Bucket field if it's non-nil, zero value otherwise.
+func (a *AmazonS3AccessKeysStreamConfig) GetBucket() string {
+	if a == nil || a.Bucket == nil {
+		return ""
+	}
+	return *a.Bucket
+}
+
+// GetEncryptedAccessKeyID returns the EncryptedAccessKeyID field if it's non-nil, zero value otherwise.
+func (a *AmazonS3AccessKeysStreamConfig) GetEncryptedAccessKeyID() string {
+	if a == nil || a.EncryptedAccessKeyID == nil {
+		return ""
+	}
+	return *a.EncryptedAccessKeyID
+}
+
+// GetEncryptedSecretKey returns the EncryptedSecretKey field if it's non-nil, zero value otherwise.
+func (a *AmazonS3AccessKeysStreamConfig) GetEncryptedSecretKey() string {
+	if a == nil || a.EncryptedSecretKey == nil {
+		return ""
+	}
+	return *a.EncryptedSecretKey
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (a *AmazonS3AccessKeysStreamConfig) GetKeyID() string {
+	if a == nil || a.KeyID == nil {
+		return ""
+	}
+	return *a.KeyID
+}
+
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (a *AmazonS3AccessKeysStreamConfig) GetRegion() string {
+	if a == nil || a.Region == nil {
+		return ""
+	}
+	return *a.Region
+}
+
+// GetARNRole returns the ARNRole field if it's non-nil, zero value otherwise.
+func (a *AmazonS3OIDCStreamConfig) GetARNRole() string {
+	if a == nil || a.ARNRole == nil {
+		return ""
+	}
+	return *a.ARNRole
+}
+
+// GetAuthenticationType returns the AuthenticationType field if it's non-nil, zero value otherwise.
+func (a *AmazonS3OIDCStreamConfig) GetAuthenticationType() string {
+	if a == nil || a.AuthenticationType == nil {
+		return ""
+	}
+	return *a.AuthenticationType
+}
+
+// GetBucket returns the Bucket field if it's non-nil, zero value otherwise.
+func (a *AmazonS3OIDCStreamConfig) GetBucket() string {
+	if a == nil || a.Bucket == nil {
+		return ""
+	}
+	return *a.Bucket
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (a *AmazonS3OIDCStreamConfig) GetKeyID() string {
+	if a == nil || a.KeyID == nil {
+		return ""
+	}
+	return *a.KeyID
+}
+
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (a *AmazonS3OIDCStreamConfig) GetRegion() string {
+	if a == nil || a.Region == nil {
+		return ""
+	}
+	return *a.Region
+}
+
 // GetRef returns the Ref field if it's non-nil, zero value otherwise.
 func (a *AnalysesListOptions) GetRef() string {
 	if a == nil || a.Ref == nil {
@@ -622,6 +774,254 @@ func (a *AnalysesListOptions) GetSarifID() string {
 	return *a.SarifID
 }
 
+// GetAnnouncement returns the Announcement field if it's non-nil, zero value otherwise.
+func (a *AnnouncementBanner) GetAnnouncement() string {
+	if a == nil || a.Announcement == nil {
+		return ""
+	}
+	return *a.Announcement
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (a *AnnouncementBanner) GetExpiresAt() Timestamp {
+	if a == nil || a.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *a.ExpiresAt
+}
+
+// GetUserDismissible returns the UserDismissible field if it's non-nil, zero value otherwise.
+func (a *AnnouncementBanner) GetUserDismissible() bool {
+	if a == nil || a.UserDismissible == nil {
+		return false
+	}
+	return *a.UserDismissible
+}
+
+// GetAPIRoute returns the APIRoute field if it's non-nil, zero value otherwise.
+func (a *APIInsightsRouteStats) GetAPIRoute() string {
+	if a == nil || a.APIRoute == nil {
+		return ""
+	}
+	return *a.APIRoute
+}
+
+// GetHTTPMethod returns the HTTPMethod field if it's non-nil, zero value otherwise.
+func (a *APIInsightsRouteStats) GetHTTPMethod() string {
+	if a == nil || a.HTTPMethod == nil {
+		return ""
+	}
+	return *a.HTTPMethod
+}
+
+// GetLastRateLimitedTimestamp returns the LastRateLimitedTimestamp field if it's non-nil, zero value otherwise.
+func (a *APIInsightsRouteStats) GetLastRateLimitedTimestamp() string {
+	if a == nil || a.LastRateLimitedTimestamp == nil {
+		return ""
+	}
+	return *a.LastRateLimitedTimestamp
+}
+
+// GetLastRequestTimestamp returns the LastRequestTimestamp field if it's non-nil, zero value otherwise.
+func (a *APIInsightsRouteStats) GetLastRequestTimestamp() string {
+	if a == nil || a.LastRequestTimestamp == nil {
+		return ""
+	}
+	return *a.LastRequestTimestamp
+}
+
+// GetRateLimitedRequestCount returns the RateLimitedRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsRouteStats) GetRateLimitedRequestCount() int64 {
+	if a == nil || a.RateLimitedRequestCount == nil {
+		return 0
+	}
+	return *a.RateLimitedRequestCount
+}
+
+// GetTotalRequestCount returns the TotalRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsRouteStats) GetTotalRequestCount() int64 {
+	if a == nil || a.TotalRequestCount == nil {
+		return 0
+	}
+	return *a.TotalRequestCount
+}
+
+// GetLastRateLimitedTimestamp returns the LastRateLimitedTimestamp field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSubjectStats) GetLastRateLimitedTimestamp() string {
+	if a == nil || a.LastRateLimitedTimestamp == nil {
+		return ""
+	}
+	return *a.LastRateLimitedTimestamp
+}
+
+// GetLastRequestTimestamp returns the LastRequestTimestamp field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSubjectStats) GetLastRequestTimestamp() string {
+	if a == nil || a.LastRequestTimestamp == nil {
+		return ""
+	}
+	return *a.LastRequestTimestamp
+}
+
+// GetRateLimitedRequestCount returns the RateLimitedRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSubjectStats) GetRateLimitedRequestCount() int64 {
+	if a == nil || a.RateLimitedRequestCount == nil {
+		return 0
+	}
+	return *a.RateLimitedRequestCount
+}
+
+// GetSubjectID returns the SubjectID field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSubjectStats) GetSubjectID() int64 {
+	if a == nil || a.SubjectID == nil {
+		return 0
+	}
+	return *a.SubjectID
+}
+
+// GetSubjectName returns the SubjectName field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSubjectStats) GetSubjectName() string {
+	if a == nil || a.SubjectName == nil {
+		return ""
+	}
+	return *a.SubjectName
+}
+
+// GetSubjectType returns the SubjectType field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSubjectStats) GetSubjectType() string {
+	if a == nil || a.SubjectType == nil {
+		return ""
+	}
+	return *a.SubjectType
+}
+
+// GetTotalRequestCount returns the TotalRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSubjectStats) GetTotalRequestCount() int64 {
+	if a == nil || a.TotalRequestCount == nil {
+		return 0
+	}
+	return *a.TotalRequestCount
+}
+
+// GetRateLimitedRequestCount returns the RateLimitedRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSummaryStats) GetRateLimitedRequestCount() int64 {
+	if a == nil || a.RateLimitedRequestCount == nil {
+		return 0
+	}
+	return *a.RateLimitedRequestCount
+}
+
+// GetTotalRequestCount returns the TotalRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsSummaryStats) GetTotalRequestCount() int64 {
+	if a == nil || a.TotalRequestCount == nil {
+		return 0
+	}
+	return *a.TotalRequestCount
+}
+
+// GetRateLimitedRequestCount returns the RateLimitedRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsTimeStats) GetRateLimitedRequestCount() int64 {
+	if a == nil || a.RateLimitedRequestCount == nil {
+		return 0
+	}
+	return *a.RateLimitedRequestCount
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (a *APIInsightsTimeStats) GetTimestamp() string {
+	if a == nil || a.Timestamp == nil {
+		return ""
+	}
+	return *a.Timestamp
+}
+
+// GetTotalRequestCount returns the TotalRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsTimeStats) GetTotalRequestCount() int64 {
+	if a == nil || a.TotalRequestCount == nil {
+		return 0
+	}
+	return *a.TotalRequestCount
+}
+
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetActorID() int64 {
+	if a == nil || a.ActorID == nil {
+		return 0
+	}
+	return *a.ActorID
+}
+
+// GetActorName returns the ActorName field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetActorName() string {
+	if a == nil || a.ActorName == nil {
+		return ""
+	}
+	return *a.ActorName
+}
+
+// GetActorType returns the ActorType field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetActorType() string {
+	if a == nil || a.ActorType == nil {
+		return ""
+	}
+	return *a.ActorType
+}
+
+// GetIntegrationID returns the IntegrationID field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetIntegrationID() int64 {
+	if a == nil || a.IntegrationID == nil {
+		return 0
+	}
+	return *a.IntegrationID
+}
+
+// GetLastRateLimitedTimestamp returns the LastRateLimitedTimestamp field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetLastRateLimitedTimestamp() string {
+	if a == nil || a.LastRateLimitedTimestamp == nil {
+		return ""
+	}
+	return *a.LastRateLimitedTimestamp
+}
+
+// GetLastRequestTimestamp returns the LastRequestTimestamp field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetLastRequestTimestamp() string {
+	if a == nil || a.LastRequestTimestamp == nil {
+		return ""
+	}
+	return *a.LastRequestTimestamp
+}
+
+// GetOAuthApplicationID returns the OAuthApplicationID field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetOAuthApplicationID() int64 {
+	if a == nil || a.OAuthApplicationID == nil {
+		return 0
+	}
+	return *a.OAuthApplicationID
+}
+
+// GetRateLimitedRequestCount returns the RateLimitedRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetRateLimitedRequestCount() int64 {
+	if a == nil || a.RateLimitedRequestCount == nil {
+		return 0
+	}
+	return *a.RateLimitedRequestCount
+}
+
+// GetTotalRequestCount returns the TotalRequestCount field if it's non-nil, zero value otherwise.
+func (a *APIInsightsUserStats) GetTotalRequestCount() int64 {
+	if a == nil || a.TotalRequestCount == nil {
+		return 0
+	}
+	return *a.TotalRequestCount
+}
+
+// GetDomains returns the Domains field.
+func (a *APIMeta) GetDomains() *APIMetaDomains {
+	if a == nil {
+		return nil
+	}
+	return a.Domains
+}
+
 // GetSSHKeyFingerprints returns the SSHKeyFingerprints map if it's non-nil, an empty map otherwise.
 func (a *APIMeta) GetSSHKeyFingerprints() map[string]string {
 	if a == nil || a.SSHKeyFingerprints == nil {
@@ -814,6 +1214,14 @@ func (a *AppConfig) GetPEM() string {
 	return *a.PEM
 }
 
+// GetPermissions returns the Permissions field.
+func (a *AppConfig) GetPermissions() *InstallationPermissions {
+	if a == nil {
+		return nil
+	}
+	return a.Permissions
+}
+
 // GetSlug returns the Slug field if it's non-nil, zero value otherwise.
 func (a *AppConfig) GetSlug() string {
 	if a == nil || a.Slug == nil {
@@ -998,6 +1406,14 @@ func (a *Attachment) GetTitle() string {
 	return *a.Title
 }
 
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (a *Attestation) GetRepositoryID() int64 {
+	if a == nil || a.RepositoryID == nil {
+		return 0
+	}
+	return *a.RepositoryID
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (a *AuditEntry) GetAction() string {
 	if a == nil || a.Action == nil {
@@ -1446,28 +1862,108 @@ func (a *AuditEntry) GetWorkflowRunID() int64 {
 	return *a.WorkflowRunID
 }
 
-// GetApp returns the App field.
-func (a *Authorization) GetApp() *AuthorizationApp {
-	if a == nil {
-		return nil
-	}
-	return a.App
-}
-
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetCreatedAt() Timestamp {
+func (a *AuditLogStream) GetCreatedAt() Timestamp {
 	if a == nil || a.CreatedAt == nil {
 		return Timestamp{}
 	}
 	return *a.CreatedAt
 }
 
-// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetFingerprint() string {
-	if a == nil || a.Fingerprint == nil {
-		return ""
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetEnabled() bool {
+	if a == nil || a.Enabled == nil {
+		return false
 	}
-	return *a.Fingerprint
+	return *a.Enabled
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetID() int64 {
+	if a == nil || a.ID == nil {
+		return 0
+	}
+	return *a.ID
+}
+
+// GetPausedAt returns the PausedAt field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetPausedAt() Timestamp {
+	if a == nil || a.PausedAt == nil {
+		return Timestamp{}
+	}
+	return *a.PausedAt
+}
+
+// GetStreamDetails returns the StreamDetails field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetStreamDetails() string {
+	if a == nil || a.StreamDetails == nil {
+		return ""
+	}
+	return *a.StreamDetails
+}
+
+// GetStreamType returns the StreamType field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetStreamType() string {
+	if a == nil || a.StreamType == nil {
+		return ""
+	}
+	return *a.StreamType
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetUpdatedAt() Timestamp {
+	if a == nil || a.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *a.UpdatedAt
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (a *AuditLogStreamConfig) GetEnabled() bool {
+	if a == nil || a.Enabled == nil {
+		return false
+	}
+	return *a.Enabled
+}
+
+// GetStreamType returns the StreamType field if it's non-nil, zero value otherwise.
+func (a *AuditLogStreamConfig) GetStreamType() string {
+	if a == nil || a.StreamType == nil {
+		return ""
+	}
+	return *a.StreamType
+}
+
+// GetApp returns the App field.
+func (a *Authorization) GetApp() *AuthorizationApp {
+	if a == nil {
+		return nil
+	}
+	return a.App
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetCreatedAt() Timestamp {
+	if a == nil || a.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *a.CreatedAt
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetExpiresAt() Timestamp {
+	if a == nil || a.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *a.ExpiresAt
+}
+
+// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetFingerprint() string {
+	if a == nil || a.Fingerprint == nil {
+		return ""
+	}
+	return *a.Fingerprint
 }
 
 // GetHashedToken returns the HashedToken field if it's non-nil, zero value otherwise.
@@ -1486,6 +1982,14 @@ func (a *Authorization) GetID() int64 {
 	return *a.ID
 }
 
+// GetInstallation returns the Installation field.
+func (a *Authorization) GetInstallation() *Installation {
+	if a == nil {
+		return nil
+	}
+	return a.Installation
+}
+
 // GetNote returns the Note field if it's non-nil, zero value otherwise.
 func (a *Authorization) GetNote() string {
 	if a == nil || a.Note == nil {
@@ -1718,6 +2222,54 @@ func (a *AutoTriggerCheck) GetSetting() bool {
 	return *a.Setting
 }
 
+// GetContainer returns the Container field if it's non-nil, zero value otherwise.
+func (a *AzureBlobStreamConfig) GetContainer() string {
+	if a == nil || a.Container == nil {
+		return ""
+	}
+	return *a.Container
+}
+
+// GetEncryptedSASURL returns the EncryptedSASURL field if it's non-nil, zero value otherwise.
+func (a *AzureBlobStreamConfig) GetEncryptedSASURL() string {
+	if a == nil || a.EncryptedSASURL == nil {
+		return ""
+	}
+	return *a.EncryptedSASURL
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (a *AzureBlobStreamConfig) GetKeyID() string {
+	if a == nil || a.KeyID == nil {
+		return ""
+	}
+	return *a.KeyID
+}
+
+// GetEncryptedConnstring returns the EncryptedConnstring field if it's non-nil, zero value otherwise.
+func (a *AzureHubStreamConfig) GetEncryptedConnstring() string {
+	if a == nil || a.EncryptedConnstring == nil {
+		return ""
+	}
+	return *a.EncryptedConnstring
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (a *AzureHubStreamConfig) GetKeyID() string {
+	if a == nil || a.KeyID == nil {
+		return ""
+	}
+	return *a.KeyID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AzureHubStreamConfig) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
 // GetContent returns the Content field if it's non-nil, zero value otherwise.
 func (b *Blob) GetContent() string {
 	if b == nil || b.Content == nil {
@@ -2078,6 +2630,118 @@ func (b *BranchProtectionRuleEvent) GetSender() *User {
 	return b.Sender
 }
 
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (b *BypassActor) GetActorID() int64 {
+	if b == nil || b.ActorID == nil {
+		return 0
+	}
+	return *b.ActorID
+}
+
+// GetActorName returns the ActorName field if it's non-nil, zero value otherwise.
+func (b *BypassActor) GetActorName() string {
+	if b == nil || b.ActorName == nil {
+		return ""
+	}
+	return *b.ActorName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BypassRequestOrganization) GetID() int64 {
+	if b == nil || b.ID == nil {
+		return 0
+	}
+	return *b.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BypassRequestOrganization) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (b *BypassRequestRepository) GetFullName() string {
+	if b == nil || b.FullName == nil {
+		return ""
+	}
+	return *b.FullName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BypassRequestRepository) GetID() int64 {
+	if b == nil || b.ID == nil {
+		return 0
+	}
+	return *b.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BypassRequestRepository) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (b *BypassResponse) GetCreatedAt() Timestamp {
+	if b == nil || b.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *b.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BypassResponse) GetID() int64 {
+	if b == nil || b.ID == nil {
+		return 0
+	}
+	return *b.ID
+}
+
+// GetReviewer returns the Reviewer field.
+func (b *BypassResponse) GetReviewer() *BypassActor {
+	if b == nil {
+		return nil
+	}
+	return b.Reviewer
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (b *BypassResponse) GetStatus() string {
+	if b == nil || b.Status == nil {
+		return ""
+	}
+	return *b.Status
+}
+
+// GetBypassReviewID returns the BypassReviewID field if it's non-nil, zero value otherwise.
+func (b *BypassReview) GetBypassReviewID() int64 {
+	if b == nil || b.BypassReviewID == nil {
+		return 0
+	}
+	return *b.BypassReviewID
+}
+
+// GetReviewedAt returns the ReviewedAt field if it's non-nil, zero value otherwise.
+func (b *BypassReview) GetReviewedAt() Timestamp {
+	if b == nil || b.ReviewedAt == nil {
+		return Timestamp{}
+	}
+	return *b.ReviewedAt
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (b *BypassReview) GetStatus() string {
+	if b == nil || b.Status == nil {
+		return ""
+	}
+	return *b.Status
+}
+
 // GetApp returns the App field.
 func (c *CheckRun) GetApp() *App {
 	if c == nil {
@@ -2638,6 +3302,14 @@ func (c *CodeResult) GetRepository() *Repository {
 	return c.Repository
 }
 
+// GetScore returns the Score field.
+func (c *CodeResult) GetScore() *float64 {
+	if c == nil {
+		return nil
+	}
+	return c.Score
+}
+
 // GetSHA returns the SHA field if it's non-nil, zero value otherwise.
 func (c *CodeResult) GetSHA() string {
 	if c == nil || c.SHA == nil {
@@ -2742,864 +3414,872 @@ func (c *CodeSearchResult) GetTotal() int {
 	return *c.Total
 }
 
+// GetBillableOwner returns the BillableOwner field.
+func (c *Codespace) GetBillableOwner() *User {
+	if c == nil {
+		return nil
+	}
+	return c.BillableOwner
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetCreatedAt() Timestamp {
+func (c *Codespace) GetCreatedAt() Timestamp {
 	if c == nil || c.CreatedAt == nil {
 		return Timestamp{}
 	}
 	return *c.CreatedAt
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetDevcontainerPath returns the DevcontainerPath field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetDevcontainerPath() string {
+	if c == nil || c.DevcontainerPath == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *c.DevcontainerPath
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetID() int64 {
-	if c == nil || c.ID == nil {
-		return 0
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetDisplayName() string {
+	if c == nil || c.DisplayName == nil {
+		return ""
 	}
-	return *c.ID
+	return *c.DisplayName
 }
 
-// GetInvitee returns the Invitee field.
-func (c *CollaboratorInvitation) GetInvitee() *User {
-	if c == nil {
-		return nil
+// GetEnvironmentID returns the EnvironmentID field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetEnvironmentID() string {
+	if c == nil || c.EnvironmentID == nil {
+		return ""
 	}
-	return c.Invitee
+	return *c.EnvironmentID
 }
 
-// GetInviter returns the Inviter field.
-func (c *CollaboratorInvitation) GetInviter() *User {
+// GetGitStatus returns the GitStatus field.
+func (c *Codespace) GetGitStatus() *CodespacesGitStatus {
 	if c == nil {
 		return nil
 	}
-	return c.Inviter
+	return c.GitStatus
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetPermissions() string {
-	if c == nil || c.Permissions == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *c.Permissions
+	return *c.ID
 }
 
-// GetRepo returns the Repo field.
-func (c *CollaboratorInvitation) GetRepo() *Repository {
-	if c == nil {
-		return nil
+// GetIdleTimeoutMinutes returns the IdleTimeoutMinutes field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetIdleTimeoutMinutes() int {
+	if c == nil || c.IdleTimeoutMinutes == nil {
+		return 0
 	}
-	return c.Repo
+	return *c.IdleTimeoutMinutes
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetIdleTimeoutNotice returns the IdleTimeoutNotice field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetIdleTimeoutNotice() string {
+	if c == nil || c.IdleTimeoutNotice == nil {
 		return ""
 	}
-	return *c.URL
+	return *c.IdleTimeoutNotice
 }
 
-// GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetCommitURL() string {
-	if c == nil || c.CommitURL == nil {
+// GetLastKnownStopNotice returns the LastKnownStopNotice field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetLastKnownStopNotice() string {
+	if c == nil || c.LastKnownStopNotice == nil {
 		return ""
 	}
-	return *c.CommitURL
+	return *c.LastKnownStopNotice
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetName() string {
-	if c == nil || c.Name == nil {
-		return ""
+// GetLastUsedAt returns the LastUsedAt field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetLastUsedAt() Timestamp {
+	if c == nil || c.LastUsedAt == nil {
+		return Timestamp{}
 	}
-	return *c.Name
+	return *c.LastUsedAt
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetRepositoryURL() string {
-	if c == nil || c.RepositoryURL == nil {
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetLocation() string {
+	if c == nil || c.Location == nil {
 		return ""
 	}
-	return *c.RepositoryURL
+	return *c.Location
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetSHA() string {
-	if c == nil || c.SHA == nil {
-		return ""
+// GetMachine returns the Machine field.
+func (c *Codespace) GetMachine() *CodespacesMachine {
+	if c == nil {
+		return nil
 	}
-	return *c.SHA
+	return c.Machine
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetState() string {
-	if c == nil || c.State == nil {
+// GetMachinesURL returns the MachinesURL field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetMachinesURL() string {
+	if c == nil || c.MachinesURL == nil {
 		return ""
 	}
-	return *c.State
+	return *c.MachinesURL
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetTotalCount() int {
-	if c == nil || c.TotalCount == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return *c.TotalCount
+	return *c.Name
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (c *Comment) GetCreatedAt() Timestamp {
-	if c == nil || c.CreatedAt == nil {
-		return Timestamp{}
+// GetOwner returns the Owner field.
+func (c *Codespace) GetOwner() *User {
+	if c == nil {
+		return nil
 	}
-	return *c.CreatedAt
+	return c.Owner
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetAuthorAssociation() string {
-	if c == nil || c.AuthorAssociation == nil {
-		return ""
+// GetPendingOperation returns the PendingOperation field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetPendingOperation() bool {
+	if c == nil || c.PendingOperation == nil {
+		return false
 	}
-	return *c.AuthorAssociation
+	return *c.PendingOperation
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetBody() string {
-	if c == nil || c.Body == nil {
+// GetPendingOperationDisabledReason returns the PendingOperationDisabledReason field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetPendingOperationDisabledReason() string {
+	if c == nil || c.PendingOperationDisabledReason == nil {
 		return ""
 	}
-	return *c.Body
-}
-
-// GetChildCommentCount returns the ChildCommentCount field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetChildCommentCount() int {
-	if c == nil || c.ChildCommentCount == nil {
-		return 0
-	}
-	return *c.ChildCommentCount
+	return *c.PendingOperationDisabledReason
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetCreatedAt() Timestamp {
-	if c == nil || c.CreatedAt == nil {
-		return Timestamp{}
+// GetPrebuild returns the Prebuild field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetPrebuild() bool {
+	if c == nil || c.Prebuild == nil {
+		return false
 	}
-	return *c.CreatedAt
+	return *c.Prebuild
 }
 
-// GetDiscussionID returns the DiscussionID field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetDiscussionID() int64 {
-	if c == nil || c.DiscussionID == nil {
-		return 0
+// GetPublishURL returns the PublishURL field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetPublishURL() string {
+	if c == nil || c.PublishURL == nil {
+		return ""
 	}
-	return *c.DiscussionID
+	return *c.PublishURL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetPullsURL() string {
+	if c == nil || c.PullsURL == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *c.PullsURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetID() int64 {
-	if c == nil || c.ID == nil {
-		return 0
+// GetRepository returns the Repository field.
+func (c *Codespace) GetRepository() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *c.ID
+	return c.Repository
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
-		return ""
+// GetRetentionExpiresAt returns the RetentionExpiresAt field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetRetentionExpiresAt() Timestamp {
+	if c == nil || c.RetentionExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *c.NodeID
+	return *c.RetentionExpiresAt
 }
 
-// GetParentID returns the ParentID field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetParentID() int64 {
-	if c == nil || c.ParentID == nil {
+// GetRetentionPeriodMinutes returns the RetentionPeriodMinutes field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetRetentionPeriodMinutes() int {
+	if c == nil || c.RetentionPeriodMinutes == nil {
 		return 0
 	}
-	return *c.ParentID
+	return *c.RetentionPeriodMinutes
 }
 
-// GetReactions returns the Reactions field.
-func (c *CommentDiscussion) GetReactions() *Reactions {
+// GetRuntimeConstraints returns the RuntimeConstraints field.
+func (c *Codespace) GetRuntimeConstraints() *CodespacesRuntimeConstraints {
 	if c == nil {
 		return nil
 	}
-	return c.Reactions
+	return c.RuntimeConstraints
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetRepositoryURL() string {
-	if c == nil || c.RepositoryURL == nil {
+// GetStartURL returns the StartURL field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetStartURL() string {
+	if c == nil || c.StartURL == nil {
 		return ""
 	}
-	return *c.RepositoryURL
+	return *c.StartURL
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetState() string {
+	if c == nil || c.State == nil {
+		return ""
+	}
+	return *c.State
+}
+
+// GetStopURL returns the StopURL field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetStopURL() string {
+	if c == nil || c.StopURL == nil {
+		return ""
+	}
+	return *c.StopURL
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (c *CommentDiscussion) GetUpdatedAt() Timestamp {
+func (c *Codespace) GetUpdatedAt() Timestamp {
 	if c == nil || c.UpdatedAt == nil {
 		return Timestamp{}
 	}
 	return *c.UpdatedAt
 }
 
-// GetUser returns the User field.
-func (c *CommentDiscussion) GetUser() *User {
-	if c == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
 	}
-	return c.User
+	return *c.URL
 }
 
-// GetTotalCommitComments returns the TotalCommitComments field if it's non-nil, zero value otherwise.
-func (c *CommentStats) GetTotalCommitComments() int {
-	if c == nil || c.TotalCommitComments == nil {
-		return 0
+// GetWebURL returns the WebURL field if it's non-nil, zero value otherwise.
+func (c *Codespace) GetWebURL() string {
+	if c == nil || c.WebURL == nil {
+		return ""
 	}
-	return *c.TotalCommitComments
+	return *c.WebURL
 }
 
-// GetTotalGistComments returns the TotalGistComments field if it's non-nil, zero value otherwise.
-func (c *CommentStats) GetTotalGistComments() int {
-	if c == nil || c.TotalGistComments == nil {
-		return 0
+// GetBillableOwner returns the BillableOwner field.
+func (c *CodespaceDefaultAttributes) GetBillableOwner() *User {
+	if c == nil {
+		return nil
 	}
-	return *c.TotalGistComments
+	return c.BillableOwner
 }
 
-// GetTotalIssueComments returns the TotalIssueComments field if it's non-nil, zero value otherwise.
-func (c *CommentStats) GetTotalIssueComments() int {
-	if c == nil || c.TotalIssueComments == nil {
-		return 0
+// GetDefaults returns the Defaults field.
+func (c *CodespaceDefaultAttributes) GetDefaults() *CodespaceDefaults {
+	if c == nil {
+		return nil
 	}
-	return *c.TotalIssueComments
+	return c.Defaults
 }
 
-// GetTotalPullRequestComments returns the TotalPullRequestComments field if it's non-nil, zero value otherwise.
-func (c *CommentStats) GetTotalPullRequestComments() int {
-	if c == nil || c.TotalPullRequestComments == nil {
-		return 0
+// GetDevcontainerPath returns the DevcontainerPath field if it's non-nil, zero value otherwise.
+func (c *CodespaceDefaults) GetDevcontainerPath() string {
+	if c == nil || c.DevcontainerPath == nil {
+		return ""
 	}
-	return *c.TotalPullRequestComments
+	return *c.DevcontainerPath
 }
 
-// GetAuthor returns the Author field.
-func (c *Commit) GetAuthor() *CommitAuthor {
-	if c == nil {
-		return nil
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (c *CodespaceDefaults) GetLocation() string {
+	if c == nil || c.Location == nil {
+		return ""
 	}
-	return c.Author
+	return *c.Location
 }
 
-// GetCommentCount returns the CommentCount field if it's non-nil, zero value otherwise.
-func (c *Commit) GetCommentCount() int {
-	if c == nil || c.CommentCount == nil {
-		return 0
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (c *CodespaceExport) GetBranch() string {
+	if c == nil || c.Branch == nil {
+		return ""
 	}
-	return *c.CommentCount
+	return *c.Branch
 }
 
-// GetCommitter returns the Committer field.
-func (c *Commit) GetCommitter() *CommitAuthor {
-	if c == nil {
-		return nil
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (c *CodespaceExport) GetCompletedAt() Timestamp {
+	if c == nil || c.CompletedAt == nil {
+		return Timestamp{}
 	}
-	return c.Committer
+	return *c.CompletedAt
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *Commit) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetExportURL returns the ExportURL field if it's non-nil, zero value otherwise.
+func (c *CodespaceExport) GetExportURL() string {
+	if c == nil || c.ExportURL == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *c.ExportURL
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (c *Commit) GetMessage() string {
-	if c == nil || c.Message == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CodespaceExport) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
 		return ""
 	}
-	return *c.Message
+	return *c.HTMLURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *Commit) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CodespaceExport) GetID() string {
+	if c == nil || c.ID == nil {
 		return ""
 	}
-	return *c.NodeID
+	return *c.ID
 }
 
 // GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *Commit) GetSHA() string {
+func (c *CodespaceExport) GetSHA() string {
 	if c == nil || c.SHA == nil {
 		return ""
 	}
 	return *c.SHA
 }
 
-// GetStats returns the Stats field.
-func (c *Commit) GetStats() *CommitStats {
-	if c == nil {
-		return nil
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (c *CodespaceExport) GetState() string {
+	if c == nil || c.State == nil {
+		return ""
 	}
-	return c.Stats
+	return *c.State
 }
 
-// GetTree returns the Tree field.
-func (c *Commit) GetTree() *Tree {
-	if c == nil {
-		return nil
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (c *CodespacesDevcontainer) GetDisplayName() string {
+	if c == nil || c.DisplayName == nil {
+		return ""
 	}
-	return c.Tree
+	return *c.DisplayName
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *Commit) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CodespacesDevcontainer) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *c.URL
+	return *c.Name
 }
 
-// GetVerification returns the Verification field.
-func (c *Commit) GetVerification() *SignatureVerification {
-	if c == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (c *CodespacesDevcontainer) GetPath() string {
+	if c == nil || c.Path == nil {
+		return ""
 	}
-	return c.Verification
+	return *c.Path
 }
 
-// GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (c *CommitAuthor) GetDate() Timestamp {
-	if c == nil || c.Date == nil {
-		return Timestamp{}
+// GetAhead returns the Ahead field if it's non-nil, zero value otherwise.
+func (c *CodespacesGitStatus) GetAhead() int {
+	if c == nil || c.Ahead == nil {
+		return 0
 	}
-	return *c.Date
+	return *c.Ahead
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *CommitAuthor) GetEmail() string {
-	if c == nil || c.Email == nil {
-		return ""
+// GetBehind returns the Behind field if it's non-nil, zero value otherwise.
+func (c *CodespacesGitStatus) GetBehind() int {
+	if c == nil || c.Behind == nil {
+		return 0
 	}
-	return *c.Email
+	return *c.Behind
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (c *CommitAuthor) GetLogin() string {
-	if c == nil || c.Login == nil {
-		return ""
+// GetHasUncommittedChanges returns the HasUncommittedChanges field if it's non-nil, zero value otherwise.
+func (c *CodespacesGitStatus) GetHasUncommittedChanges() bool {
+	if c == nil || c.HasUncommittedChanges == nil {
+		return false
 	}
-	return *c.Login
+	return *c.HasUncommittedChanges
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CommitAuthor) GetName() string {
-	if c == nil || c.Name == nil {
-		return ""
+// GetHasUnpushedChanges returns the HasUnpushedChanges field if it's non-nil, zero value otherwise.
+func (c *CodespacesGitStatus) GetHasUnpushedChanges() bool {
+	if c == nil || c.HasUnpushedChanges == nil {
+		return false
 	}
-	return *c.Name
+	return *c.HasUnpushedChanges
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (c *CommitCommentEvent) GetAction() string {
-	if c == nil || c.Action == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (c *CodespacesGitStatus) GetRef() string {
+	if c == nil || c.Ref == nil {
 		return ""
 	}
-	return *c.Action
+	return *c.Ref
 }
 
-// GetComment returns the Comment field.
-func (c *CommitCommentEvent) GetComment() *RepositoryComment {
-	if c == nil {
-		return nil
+// GetCPUs returns the CPUs field if it's non-nil, zero value otherwise.
+func (c *CodespacesMachine) GetCPUs() int {
+	if c == nil || c.CPUs == nil {
+		return 0
 	}
-	return c.Comment
+	return *c.CPUs
 }
 
-// GetInstallation returns the Installation field.
-func (c *CommitCommentEvent) GetInstallation() *Installation {
-	if c == nil {
-		return nil
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (c *CodespacesMachine) GetDisplayName() string {
+	if c == nil || c.DisplayName == nil {
+		return ""
 	}
-	return c.Installation
+	return *c.DisplayName
 }
 
-// GetRepo returns the Repo field.
-func (c *CommitCommentEvent) GetRepo() *Repository {
-	if c == nil {
-		return nil
+// GetMemoryInBytes returns the MemoryInBytes field if it's non-nil, zero value otherwise.
+func (c *CodespacesMachine) GetMemoryInBytes() int64 {
+	if c == nil || c.MemoryInBytes == nil {
+		return 0
 	}
-	return c.Repo
+	return *c.MemoryInBytes
 }
 
-// GetSender returns the Sender field.
-func (c *CommitCommentEvent) GetSender() *User {
-	if c == nil {
-		return nil
-	}
-	return c.Sender
-}
-
-// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetAdditions() int {
-	if c == nil || c.Additions == nil {
-		return 0
-	}
-	return *c.Additions
-}
-
-// GetBlobURL returns the BlobURL field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetBlobURL() string {
-	if c == nil || c.BlobURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CodespacesMachine) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *c.BlobURL
-}
-
-// GetChanges returns the Changes field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetChanges() int {
-	if c == nil || c.Changes == nil {
-		return 0
-	}
-	return *c.Changes
+	return *c.Name
 }
 
-// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetContentsURL() string {
-	if c == nil || c.ContentsURL == nil {
+// GetOperatingSystem returns the OperatingSystem field if it's non-nil, zero value otherwise.
+func (c *CodespacesMachine) GetOperatingSystem() string {
+	if c == nil || c.OperatingSystem == nil {
 		return ""
 	}
-	return *c.ContentsURL
-}
-
-// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetDeletions() int {
-	if c == nil || c.Deletions == nil {
-		return 0
-	}
-	return *c.Deletions
+	return *c.OperatingSystem
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetFilename() string {
-	if c == nil || c.Filename == nil {
+// GetPrebuildAvailability returns the PrebuildAvailability field if it's non-nil, zero value otherwise.
+func (c *CodespacesMachine) GetPrebuildAvailability() string {
+	if c == nil || c.PrebuildAvailability == nil {
 		return ""
 	}
-	return *c.Filename
+	return *c.PrebuildAvailability
 }
 
-// GetPatch returns the Patch field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetPatch() string {
-	if c == nil || c.Patch == nil {
-		return ""
+// GetStorageInBytes returns the StorageInBytes field if it's non-nil, zero value otherwise.
+func (c *CodespacesMachine) GetStorageInBytes() int64 {
+	if c == nil || c.StorageInBytes == nil {
+		return 0
 	}
-	return *c.Patch
+	return *c.StorageInBytes
 }
 
-// GetPreviousFilename returns the PreviousFilename field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetPreviousFilename() string {
-	if c == nil || c.PreviousFilename == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CollaboratorInvitation) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *c.PreviousFilename
+	return *c.CreatedAt
 }
 
-// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetRawURL() string {
-	if c == nil || c.RawURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CollaboratorInvitation) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
 		return ""
 	}
-	return *c.RawURL
+	return *c.HTMLURL
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetSHA() string {
-	if c == nil || c.SHA == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CollaboratorInvitation) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *c.SHA
+	return *c.ID
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetStatus() string {
-	if c == nil || c.Status == nil {
-		return ""
+// GetInvitee returns the Invitee field.
+func (c *CollaboratorInvitation) GetInvitee() *User {
+	if c == nil {
+		return nil
 	}
-	return *c.Status
+	return c.Invitee
 }
 
-// GetAuthor returns the Author field.
-func (c *CommitResult) GetAuthor() *User {
+// GetInviter returns the Inviter field.
+func (c *CollaboratorInvitation) GetInviter() *User {
 	if c == nil {
 		return nil
 	}
-	return c.Author
+	return c.Inviter
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (c *CommitResult) GetCommentsURL() string {
-	if c == nil || c.CommentsURL == nil {
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (c *CollaboratorInvitation) GetPermissions() string {
+	if c == nil || c.Permissions == nil {
 		return ""
 	}
-	return *c.CommentsURL
+	return *c.Permissions
 }
 
-// GetCommit returns the Commit field.
-func (c *CommitResult) GetCommit() *Commit {
+// GetRepo returns the Repo field.
+func (c *CollaboratorInvitation) GetRepo() *Repository {
 	if c == nil {
 		return nil
 	}
-	return c.Commit
+	return c.Repo
 }
 
-// GetCommitter returns the Committer field.
-func (c *CommitResult) GetCommitter() *User {
-	if c == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CollaboratorInvitation) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
 	}
-	return c.Committer
+	return *c.URL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CommitResult) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetCommitURL() string {
+	if c == nil || c.CommitURL == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *c.CommitURL
 }
 
-// GetRepository returns the Repository field.
-func (c *CommitResult) GetRepository() *Repository {
-	if c == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return c.Repository
+	return *c.Name
 }
 
-// GetScore returns the Score field.
-func (c *CommitResult) GetScore() *float64 {
-	if c == nil {
-		return nil
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetRepositoryURL() string {
+	if c == nil || c.RepositoryURL == nil {
+		return ""
 	}
-	return c.Score
+	return *c.RepositoryURL
 }
 
 // GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *CommitResult) GetSHA() string {
+func (c *CombinedStatus) GetSHA() string {
 	if c == nil || c.SHA == nil {
 		return ""
 	}
 	return *c.SHA
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CommitResult) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetState() string {
+	if c == nil || c.State == nil {
 		return ""
 	}
-	return *c.URL
+	return *c.State
 }
 
-// GetAheadBy returns the AheadBy field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetAheadBy() int {
-	if c == nil || c.AheadBy == nil {
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetTotalCount() int {
+	if c == nil || c.TotalCount == nil {
 		return 0
 	}
-	return *c.AheadBy
-}
-
-// GetBaseCommit returns the BaseCommit field.
-func (c *CommitsComparison) GetBaseCommit() *RepositoryCommit {
-	if c == nil {
-		return nil
-	}
-	return c.BaseCommit
+	return *c.TotalCount
 }
 
-// GetBehindBy returns the BehindBy field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetBehindBy() int {
-	if c == nil || c.BehindBy == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *Comment) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *c.BehindBy
+	return *c.CreatedAt
 }
 
-// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetDiffURL() string {
-	if c == nil || c.DiffURL == nil {
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetAuthorAssociation() string {
+	if c == nil || c.AuthorAssociation == nil {
 		return ""
 	}
-	return *c.DiffURL
+	return *c.AuthorAssociation
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetBody() string {
+	if c == nil || c.Body == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *c.Body
 }
 
-// GetMergeBaseCommit returns the MergeBaseCommit field.
-func (c *CommitsComparison) GetMergeBaseCommit() *RepositoryCommit {
-	if c == nil {
-		return nil
+// GetChildCommentCount returns the ChildCommentCount field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetChildCommentCount() int {
+	if c == nil || c.ChildCommentCount == nil {
+		return 0
 	}
-	return c.MergeBaseCommit
+	return *c.ChildCommentCount
 }
 
-// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetPatchURL() string {
-	if c == nil || c.PatchURL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *c.PatchURL
+	return *c.CreatedAt
 }
 
-// GetPermalinkURL returns the PermalinkURL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetPermalinkURL() string {
-	if c == nil || c.PermalinkURL == nil {
-		return ""
+// GetDiscussionID returns the DiscussionID field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetDiscussionID() int64 {
+	if c == nil || c.DiscussionID == nil {
+		return 0
 	}
-	return *c.PermalinkURL
+	return *c.DiscussionID
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetStatus() string {
-	if c == nil || c.Status == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
 		return ""
 	}
-	return *c.Status
+	return *c.HTMLURL
 }
 
-// GetTotalCommits returns the TotalCommits field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetTotalCommits() int {
-	if c == nil || c.TotalCommits == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetID() int64 {
+	if c == nil || c.ID == nil {
 		return 0
 	}
-	return *c.TotalCommits
+	return *c.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
 		return ""
 	}
-	return *c.URL
+	return *c.NodeID
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (c *CommitsSearchResult) GetIncompleteResults() bool {
-	if c == nil || c.IncompleteResults == nil {
-		return false
+// GetParentID returns the ParentID field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetParentID() int64 {
+	if c == nil || c.ParentID == nil {
+		return 0
 	}
-	return *c.IncompleteResults
+	return *c.ParentID
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (c *CommitsSearchResult) GetTotal() int {
-	if c == nil || c.Total == nil {
-		return 0
+// GetReactions returns the Reactions field.
+func (c *CommentDiscussion) GetReactions() *Reactions {
+	if c == nil {
+		return nil
 	}
-	return *c.Total
+	return c.Reactions
 }
 
-// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
-func (c *CommitStats) GetAdditions() int {
-	if c == nil || c.Additions == nil {
-		return 0
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetRepositoryURL() string {
+	if c == nil || c.RepositoryURL == nil {
+		return ""
 	}
-	return *c.Additions
+	return *c.RepositoryURL
 }
 
-// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
-func (c *CommitStats) GetDeletions() int {
-	if c == nil || c.Deletions == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *c.Deletions
+	return *c.UpdatedAt
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (c *CommitStats) GetTotal() int {
-	if c == nil || c.Total == nil {
+// GetUser returns the User field.
+func (c *CommentDiscussion) GetUser() *User {
+	if c == nil {
+		return nil
+	}
+	return c.User
+}
+
+// GetTotalCommitComments returns the TotalCommitComments field if it's non-nil, zero value otherwise.
+func (c *CommentStats) GetTotalCommitComments() int {
+	if c == nil || c.TotalCommitComments == nil {
 		return 0
 	}
-	return *c.Total
+	return *c.TotalCommitComments
 }
 
-// GetCodeOfConduct returns the CodeOfConduct field.
-func (c *CommunityHealthFiles) GetCodeOfConduct() *Metric {
-	if c == nil {
-		return nil
+// GetTotalGistComments returns the TotalGistComments field if it's non-nil, zero value otherwise.
+func (c *CommentStats) GetTotalGistComments() int {
+	if c == nil || c.TotalGistComments == nil {
+		return 0
 	}
-	return c.CodeOfConduct
+	return *c.TotalGistComments
 }
 
-// GetCodeOfConductFile returns the CodeOfConductFile field.
-func (c *CommunityHealthFiles) GetCodeOfConductFile() *Metric {
-	if c == nil {
-		return nil
+// GetTotalIssueComments returns the TotalIssueComments field if it's non-nil, zero value otherwise.
+func (c *CommentStats) GetTotalIssueComments() int {
+	if c == nil || c.TotalIssueComments == nil {
+		return 0
 	}
-	return c.CodeOfConductFile
+	return *c.TotalIssueComments
 }
 
-// GetContributing returns the Contributing field.
-func (c *CommunityHealthFiles) GetContributing() *Metric {
-	if c == nil {
-		return nil
+// GetTotalPullRequestComments returns the TotalPullRequestComments field if it's non-nil, zero value otherwise.
+func (c *CommentStats) GetTotalPullRequestComments() int {
+	if c == nil || c.TotalPullRequestComments == nil {
+		return 0
 	}
-	return c.Contributing
+	return *c.TotalPullRequestComments
 }
 
-// GetIssueTemplate returns the IssueTemplate field.
-func (c *CommunityHealthFiles) GetIssueTemplate() *Metric {
+// GetAuthor returns the Author field.
+func (c *Commit) GetAuthor() *CommitAuthor {
 	if c == nil {
 		return nil
 	}
-	return c.IssueTemplate
+	return c.Author
 }
 
-// GetLicense returns the License field.
-func (c *CommunityHealthFiles) GetLicense() *Metric {
-	if c == nil {
-		return nil
+// GetCommentCount returns the CommentCount field if it's non-nil, zero value otherwise.
+func (c *Commit) GetCommentCount() int {
+	if c == nil || c.CommentCount == nil {
+		return 0
 	}
-	return c.License
+	return *c.CommentCount
 }
 
-// GetPullRequestTemplate returns the PullRequestTemplate field.
-func (c *CommunityHealthFiles) GetPullRequestTemplate() *Metric {
+// GetCommitter returns the Committer field.
+func (c *Commit) GetCommitter() *CommitAuthor {
 	if c == nil {
 		return nil
 	}
-	return c.PullRequestTemplate
+	return c.Committer
 }
 
-// GetReadme returns the Readme field.
-func (c *CommunityHealthFiles) GetReadme() *Metric {
-	if c == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *Commit) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
 	}
-	return c.Readme
+	return *c.HTMLURL
 }
 
-// GetContentReportsEnabled returns the ContentReportsEnabled field if it's non-nil, zero value otherwise.
-func (c *CommunityHealthMetrics) GetContentReportsEnabled() bool {
-	if c == nil || c.ContentReportsEnabled == nil {
-		return false
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *Commit) GetMessage() string {
+	if c == nil || c.Message == nil {
+		return ""
 	}
-	return *c.ContentReportsEnabled
+	return *c.Message
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (c *CommunityHealthMetrics) GetDescription() string {
-	if c == nil || c.Description == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *Commit) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
 		return ""
 	}
-	return *c.Description
+	return *c.NodeID
 }
 
-// GetDocumentation returns the Documentation field if it's non-nil, zero value otherwise.
-func (c *CommunityHealthMetrics) GetDocumentation() string {
-	if c == nil || c.Documentation == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *Commit) GetSHA() string {
+	if c == nil || c.SHA == nil {
 		return ""
 	}
-	return *c.Documentation
+	return *c.SHA
 }
 
-// GetFiles returns the Files field.
-func (c *CommunityHealthMetrics) GetFiles() *CommunityHealthFiles {
+// GetStats returns the Stats field.
+func (c *Commit) GetStats() *CommitStats {
 	if c == nil {
 		return nil
 	}
-	return c.Files
+	return c.Stats
 }
 
-// GetHealthPercentage returns the HealthPercentage field if it's non-nil, zero value otherwise.
-func (c *CommunityHealthMetrics) GetHealthPercentage() int {
-	if c == nil || c.HealthPercentage == nil {
-		return 0
+// GetTree returns the Tree field.
+func (c *Commit) GetTree() *Tree {
+	if c == nil {
+		return nil
 	}
-	return *c.HealthPercentage
+	return c.Tree
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (c *CommunityHealthMetrics) GetUpdatedAt() Timestamp {
-	if c == nil || c.UpdatedAt == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *Commit) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
+	}
+	return *c.URL
+}
+
+// GetVerification returns the Verification field.
+func (c *Commit) GetVerification() *SignatureVerification {
+	if c == nil {
+		return nil
+	}
+	return c.Verification
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (c *CommitAuthor) GetDate() Timestamp {
+	if c == nil || c.Date == nil {
 		return Timestamp{}
 	}
-	return *c.UpdatedAt
+	return *c.Date
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *ContentReference) GetID() int64 {
-	if c == nil || c.ID == nil {
-		return 0
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *CommitAuthor) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
 	}
-	return *c.ID
+	return *c.Email
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *ContentReference) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (c *CommitAuthor) GetLogin() string {
+	if c == nil || c.Login == nil {
 		return ""
 	}
-	return *c.NodeID
+	return *c.Login
 }
 
-// GetReference returns the Reference field if it's non-nil, zero value otherwise.
-func (c *ContentReference) GetReference() string {
-	if c == nil || c.Reference == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CommitAuthor) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *c.Reference
+	return *c.Name
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (c *ContentReferenceEvent) GetAction() string {
+func (c *CommitCommentEvent) GetAction() string {
 	if c == nil || c.Action == nil {
 		return ""
 	}
 	return *c.Action
 }
 
-// GetContentReference returns the ContentReference field.
-func (c *ContentReferenceEvent) GetContentReference() *ContentReference {
+// GetComment returns the Comment field.
+func (c *CommitCommentEvent) GetComment() *RepositoryComment {
 	if c == nil {
 		return nil
 	}
-	return c.ContentReference
+	return c.Comment
 }
 
 // GetInstallation returns the Installation field.
-func (c *ContentReferenceEvent) GetInstallation() *Installation {
+func (c *CommitCommentEvent) GetInstallation() *Installation {
 	if c == nil {
 		return nil
 	}
 	return c.Installation
 }
 
+// GetOrg returns the Org field.
+func (c *CommitCommentEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
+}
+
 // GetRepo returns the Repo field.
-func (c *ContentReferenceEvent) GetRepo() *Repository {
+func (c *CommitCommentEvent) GetRepo() *Repository {
 	if c == nil {
 		return nil
 	}
@@ -3607,1583 +4287,1783 @@ func (c *ContentReferenceEvent) GetRepo() *Repository {
 }
 
 // GetSender returns the Sender field.
-func (c *ContentReferenceEvent) GetSender() *User {
+func (c *CommitCommentEvent) GetSender() *User {
 	if c == nil {
 		return nil
 	}
 	return c.Sender
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetAvatarURL() string {
-	if c == nil || c.AvatarURL == nil {
-		return ""
-	}
-	return *c.AvatarURL
-}
-
-// GetContributions returns the Contributions field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetContributions() int {
-	if c == nil || c.Contributions == nil {
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetAdditions() int {
+	if c == nil || c.Additions == nil {
 		return 0
 	}
-	return *c.Contributions
+	return *c.Additions
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetEmail() string {
-	if c == nil || c.Email == nil {
+// GetBlobURL returns the BlobURL field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetBlobURL() string {
+	if c == nil || c.BlobURL == nil {
 		return ""
 	}
-	return *c.Email
+	return *c.BlobURL
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetEventsURL() string {
-	if c == nil || c.EventsURL == nil {
-		return ""
-	}
-	return *c.EventsURL
+// GetChanges returns the Changes field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetChanges() int {
+	if c == nil || c.Changes == nil {
+		return 0
+	}
+	return *c.Changes
 }
 
-// GetFollowersURL returns the FollowersURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetFollowersURL() string {
-	if c == nil || c.FollowersURL == nil {
+// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetContentsURL() string {
+	if c == nil || c.ContentsURL == nil {
 		return ""
 	}
-	return *c.FollowersURL
+	return *c.ContentsURL
 }
 
-// GetFollowingURL returns the FollowingURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetFollowingURL() string {
-	if c == nil || c.FollowingURL == nil {
-		return ""
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetDeletions() int {
+	if c == nil || c.Deletions == nil {
+		return 0
 	}
-	return *c.FollowingURL
+	return *c.Deletions
 }
 
-// GetGistsURL returns the GistsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetGistsURL() string {
-	if c == nil || c.GistsURL == nil {
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetFilename() string {
+	if c == nil || c.Filename == nil {
 		return ""
 	}
-	return *c.GistsURL
+	return *c.Filename
 }
 
-// GetGravatarID returns the GravatarID field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetGravatarID() string {
-	if c == nil || c.GravatarID == nil {
+// GetPatch returns the Patch field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetPatch() string {
+	if c == nil || c.Patch == nil {
 		return ""
 	}
-	return *c.GravatarID
+	return *c.Patch
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetPreviousFilename returns the PreviousFilename field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetPreviousFilename() string {
+	if c == nil || c.PreviousFilename == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *c.PreviousFilename
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetID() int64 {
-	if c == nil || c.ID == nil {
-		return 0
+// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetRawURL() string {
+	if c == nil || c.RawURL == nil {
+		return ""
 	}
-	return *c.ID
+	return *c.RawURL
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetLogin() string {
-	if c == nil || c.Login == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetSHA() string {
+	if c == nil || c.SHA == nil {
 		return ""
 	}
-	return *c.Login
+	return *c.SHA
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetName() string {
-	if c == nil || c.Name == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetStatus() string {
+	if c == nil || c.Status == nil {
 		return ""
 	}
-	return *c.Name
+	return *c.Status
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (c *CommitResult) GetAuthor() *User {
+	if c == nil {
+		return nil
 	}
-	return *c.NodeID
+	return c.Author
 }
 
-// GetOrganizationsURL returns the OrganizationsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetOrganizationsURL() string {
-	if c == nil || c.OrganizationsURL == nil {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (c *CommitResult) GetCommentsURL() string {
+	if c == nil || c.CommentsURL == nil {
 		return ""
 	}
-	return *c.OrganizationsURL
+	return *c.CommentsURL
 }
 
-// GetReceivedEventsURL returns the ReceivedEventsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetReceivedEventsURL() string {
-	if c == nil || c.ReceivedEventsURL == nil {
-		return ""
+// GetCommit returns the Commit field.
+func (c *CommitResult) GetCommit() *Commit {
+	if c == nil {
+		return nil
 	}
-	return *c.ReceivedEventsURL
+	return c.Commit
 }
 
-// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetReposURL() string {
-	if c == nil || c.ReposURL == nil {
-		return ""
+// GetCommitter returns the Committer field.
+func (c *CommitResult) GetCommitter() *User {
+	if c == nil {
+		return nil
 	}
-	return *c.ReposURL
+	return c.Committer
 }
 
-// GetSiteAdmin returns the SiteAdmin field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetSiteAdmin() bool {
-	if c == nil || c.SiteAdmin == nil {
-		return false
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CommitResult) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
 	}
-	return *c.SiteAdmin
+	return *c.HTMLURL
 }
 
-// GetStarredURL returns the StarredURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetStarredURL() string {
-	if c == nil || c.StarredURL == nil {
-		return ""
+// GetRepository returns the Repository field.
+func (c *CommitResult) GetRepository() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *c.StarredURL
+	return c.Repository
 }
 
-// GetSubscriptionsURL returns the SubscriptionsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetSubscriptionsURL() string {
-	if c == nil || c.SubscriptionsURL == nil {
-		return ""
+// GetScore returns the Score field.
+func (c *CommitResult) GetScore() *float64 {
+	if c == nil {
+		return nil
 	}
-	return *c.SubscriptionsURL
+	return c.Score
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetType() string {
-	if c == nil || c.Type == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *CommitResult) GetSHA() string {
+	if c == nil || c.SHA == nil {
 		return ""
 	}
-	return *c.Type
+	return *c.SHA
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetURL() string {
+func (c *CommitResult) GetURL() string {
 	if c == nil || c.URL == nil {
 		return ""
 	}
 	return *c.URL
 }
 
-// GetAuthor returns the Author field.
-func (c *ContributorStats) GetAuthor() *Contributor {
-	if c == nil {
-		return nil
-	}
-	return c.Author
-}
-
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (c *ContributorStats) GetTotal() int {
-	if c == nil || c.Total == nil {
+// GetAheadBy returns the AheadBy field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetAheadBy() int {
+	if c == nil || c.AheadBy == nil {
 		return 0
 	}
-	return *c.Total
+	return *c.AheadBy
 }
 
-// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetCompletedAt() Timestamp {
-	if c == nil || c.CompletedAt == nil {
-		return Timestamp{}
+// GetBaseCommit returns the BaseCommit field.
+func (c *CommitsComparison) GetBaseCommit() *RepositoryCommit {
+	if c == nil {
+		return nil
 	}
-	return *c.CompletedAt
+	return c.BaseCommit
 }
 
-// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetConclusion() string {
-	if c == nil || c.Conclusion == nil {
-		return ""
+// GetBehindBy returns the BehindBy field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetBehindBy() int {
+	if c == nil || c.BehindBy == nil {
+		return 0
 	}
-	return *c.Conclusion
+	return *c.BehindBy
 }
 
-// GetDetailsURL returns the DetailsURL field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetDetailsURL() string {
-	if c == nil || c.DetailsURL == nil {
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetDiffURL() string {
+	if c == nil || c.DiffURL == nil {
 		return ""
 	}
-	return *c.DetailsURL
+	return *c.DiffURL
 }
 
-// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetExternalID() string {
-	if c == nil || c.ExternalID == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
 		return ""
 	}
-	return *c.ExternalID
+	return *c.HTMLURL
 }
 
-// GetOutput returns the Output field.
-func (c *CreateCheckRunOptions) GetOutput() *CheckRunOutput {
+// GetMergeBaseCommit returns the MergeBaseCommit field.
+func (c *CommitsComparison) GetMergeBaseCommit() *RepositoryCommit {
 	if c == nil {
 		return nil
 	}
-	return c.Output
+	return c.MergeBaseCommit
 }
 
-// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetStartedAt() Timestamp {
-	if c == nil || c.StartedAt == nil {
-		return Timestamp{}
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetPatchURL() string {
+	if c == nil || c.PatchURL == nil {
+		return ""
 	}
-	return *c.StartedAt
+	return *c.PatchURL
+}
+
+// GetPermalinkURL returns the PermalinkURL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetPermalinkURL() string {
+	if c == nil || c.PermalinkURL == nil {
+		return ""
+	}
+	return *c.PermalinkURL
 }
 
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetStatus() string {
+func (c *CommitsComparison) GetStatus() string {
 	if c == nil || c.Status == nil {
 		return ""
 	}
 	return *c.Status
 }
 
-// GetHeadBranch returns the HeadBranch field if it's non-nil, zero value otherwise.
-func (c *CreateCheckSuiteOptions) GetHeadBranch() string {
-	if c == nil || c.HeadBranch == nil {
-		return ""
+// GetTotalCommits returns the TotalCommits field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetTotalCommits() int {
+	if c == nil || c.TotalCommits == nil {
+		return 0
 	}
-	return *c.HeadBranch
+	return *c.TotalCommits
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetDescription() string {
-	if c == nil || c.Description == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetURL() string {
+	if c == nil || c.URL == nil {
 		return ""
 	}
-	return *c.Description
+	return *c.URL
 }
 
-// GetInstallation returns the Installation field.
-func (c *CreateEvent) GetInstallation() *Installation {
-	if c == nil {
-		return nil
-	}
-	return c.Installation
-}
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (c *CommitsSearchResult) GetIncompleteResults() bool {
+	if c == nil || c.IncompleteResults == nil {
+		return false
+	}
+	return *c.IncompleteResults
+}
 
-// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetMasterBranch() string {
-	if c == nil || c.MasterBranch == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (c *CommitsSearchResult) GetTotal() int {
+	if c == nil || c.Total == nil {
+		return 0
 	}
-	return *c.MasterBranch
+	return *c.Total
 }
 
-// GetOrg returns the Org field.
-func (c *CreateEvent) GetOrg() *Organization {
-	if c == nil {
-		return nil
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (c *CommitStats) GetAdditions() int {
+	if c == nil || c.Additions == nil {
+		return 0
 	}
-	return c.Org
+	return *c.Additions
 }
 
-// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetPusherType() string {
-	if c == nil || c.PusherType == nil {
-		return ""
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (c *CommitStats) GetDeletions() int {
+	if c == nil || c.Deletions == nil {
+		return 0
 	}
-	return *c.PusherType
+	return *c.Deletions
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetRef() string {
-	if c == nil || c.Ref == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (c *CommitStats) GetTotal() int {
+	if c == nil || c.Total == nil {
+		return 0
 	}
-	return *c.Ref
+	return *c.Total
 }
 
-// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetRefType() string {
-	if c == nil || c.RefType == nil {
-		return ""
+// GetCodeOfConduct returns the CodeOfConduct field.
+func (c *CommunityHealthFiles) GetCodeOfConduct() *Metric {
+	if c == nil {
+		return nil
 	}
-	return *c.RefType
+	return c.CodeOfConduct
 }
 
-// GetRepo returns the Repo field.
-func (c *CreateEvent) GetRepo() *Repository {
+// GetCodeOfConductFile returns the CodeOfConductFile field.
+func (c *CommunityHealthFiles) GetCodeOfConductFile() *Metric {
 	if c == nil {
 		return nil
 	}
-	return c.Repo
+	return c.CodeOfConductFile
 }
 
-// GetSender returns the Sender field.
-func (c *CreateEvent) GetSender() *User {
+// GetContributing returns the Contributing field.
+func (c *CommunityHealthFiles) GetContributing() *Metric {
 	if c == nil {
 		return nil
 	}
-	return c.Sender
+	return c.Contributing
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *CreateOrgInvitationOptions) GetEmail() string {
-	if c == nil || c.Email == nil {
-		return ""
+// GetIssueTemplate returns the IssueTemplate field.
+func (c *CommunityHealthFiles) GetIssueTemplate() *Metric {
+	if c == nil {
+		return nil
 	}
-	return *c.Email
+	return c.IssueTemplate
 }
 
-// GetInviteeID returns the InviteeID field if it's non-nil, zero value otherwise.
-func (c *CreateOrgInvitationOptions) GetInviteeID() int64 {
-	if c == nil || c.InviteeID == nil {
-		return 0
+// GetLicense returns the License field.
+func (c *CommunityHealthFiles) GetLicense() *Metric {
+	if c == nil {
+		return nil
 	}
-	return *c.InviteeID
+	return c.License
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (c *CreateOrgInvitationOptions) GetRole() string {
-	if c == nil || c.Role == nil {
-		return ""
+// GetPullRequestTemplate returns the PullRequestTemplate field.
+func (c *CommunityHealthFiles) GetPullRequestTemplate() *Metric {
+	if c == nil {
+		return nil
 	}
-	return *c.Role
+	return c.PullRequestTemplate
 }
 
-// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
-func (c *CreateOrUpdateCustomRoleOptions) GetBaseRole() string {
-	if c == nil || c.BaseRole == nil {
-		return ""
+// GetReadme returns the Readme field.
+func (c *CommunityHealthFiles) GetReadme() *Metric {
+	if c == nil {
+		return nil
 	}
-	return *c.BaseRole
+	return c.Readme
+}
+
+// GetContentReportsEnabled returns the ContentReportsEnabled field if it's non-nil, zero value otherwise.
+func (c *CommunityHealthMetrics) GetContentReportsEnabled() bool {
+	if c == nil || c.ContentReportsEnabled == nil {
+		return false
+	}
+	return *c.ContentReportsEnabled
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (c *CreateOrUpdateCustomRoleOptions) GetDescription() string {
+func (c *CommunityHealthMetrics) GetDescription() string {
 	if c == nil || c.Description == nil {
 		return ""
 	}
 	return *c.Description
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CreateOrUpdateCustomRoleOptions) GetName() string {
-	if c == nil || c.Name == nil {
+// GetDocumentation returns the Documentation field if it's non-nil, zero value otherwise.
+func (c *CommunityHealthMetrics) GetDocumentation() string {
+	if c == nil || c.Documentation == nil {
 		return ""
 	}
-	return *c.Name
+	return *c.Documentation
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (c *CreateProtectedChanges) GetFrom() bool {
-	if c == nil || c.From == nil {
-		return false
+// GetFiles returns the Files field.
+func (c *CommunityHealthMetrics) GetFiles() *CommunityHealthFiles {
+	if c == nil {
+		return nil
 	}
-	return *c.From
+	return c.Files
 }
 
-// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
-func (c *CreateRunnerGroupRequest) GetAllowsPublicRepositories() bool {
-	if c == nil || c.AllowsPublicRepositories == nil {
-		return false
+// GetHealthPercentage returns the HealthPercentage field if it's non-nil, zero value otherwise.
+func (c *CommunityHealthMetrics) GetHealthPercentage() int {
+	if c == nil || c.HealthPercentage == nil {
+		return 0
 	}
-	return *c.AllowsPublicRepositories
+	return *c.HealthPercentage
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CreateRunnerGroupRequest) GetName() string {
-	if c == nil || c.Name == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CommunityHealthMetrics) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *c.Name
+	return *c.UpdatedAt
 }
 
-// GetRestrictedToWorkflows returns the RestrictedToWorkflows field if it's non-nil, zero value otherwise.
-func (c *CreateRunnerGroupRequest) GetRestrictedToWorkflows() bool {
-	if c == nil || c.RestrictedToWorkflows == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ContentReference) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *c.RestrictedToWorkflows
+	return *c.ID
 }
 
-// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
-func (c *CreateRunnerGroupRequest) GetVisibility() string {
-	if c == nil || c.Visibility == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *ContentReference) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
 		return ""
 	}
-	return *c.Visibility
+	return *c.NodeID
 }
 
-// GetCanAdminsBypass returns the CanAdminsBypass field if it's non-nil, zero value otherwise.
-func (c *CreateUpdateEnvironment) GetCanAdminsBypass() bool {
-	if c == nil || c.CanAdminsBypass == nil {
-		return false
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (c *ContentReference) GetReference() string {
+	if c == nil || c.Reference == nil {
+		return ""
 	}
-	return *c.CanAdminsBypass
+	return *c.Reference
 }
 
-// GetDeploymentBranchPolicy returns the DeploymentBranchPolicy field.
-func (c *CreateUpdateEnvironment) GetDeploymentBranchPolicy() *BranchPolicy {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *ContentReferenceEvent) GetAction() string {
+	if c == nil || c.Action == nil {
+		return ""
+	}
+	return *c.Action
+}
+
+// GetContentReference returns the ContentReference field.
+func (c *ContentReferenceEvent) GetContentReference() *ContentReference {
 	if c == nil {
 		return nil
 	}
-	return c.DeploymentBranchPolicy
+	return c.ContentReference
 }
 
-// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
-func (c *CreateUpdateEnvironment) GetWaitTimer() int {
-	if c == nil || c.WaitTimer == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (c *ContentReferenceEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
 	}
-	return *c.WaitTimer
+	return c.Installation
 }
 
-// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
-func (c *CreateUpdateRequiredWorkflowOptions) GetRepositoryID() int64 {
-	if c == nil || c.RepositoryID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (c *ContentReferenceEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
 	}
-	return *c.RepositoryID
+	return c.Org
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (c *CreateUpdateRequiredWorkflowOptions) GetScope() string {
-	if c == nil || c.Scope == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (c *ContentReferenceEvent) GetRepo() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *c.Scope
+	return c.Repo
 }
 
-// GetSelectedRepositoryIDs returns the SelectedRepositoryIDs field.
-func (c *CreateUpdateRequiredWorkflowOptions) GetSelectedRepositoryIDs() *SelectedRepoIDs {
+// GetSender returns the Sender field.
+func (c *ContentReferenceEvent) GetSender() *User {
 	if c == nil {
 		return nil
 	}
-	return c.SelectedRepositoryIDs
+	return c.Sender
 }
 
-// GetWorkflowFilePath returns the WorkflowFilePath field if it's non-nil, zero value otherwise.
-func (c *CreateUpdateRequiredWorkflowOptions) GetWorkflowFilePath() string {
-	if c == nil || c.WorkflowFilePath == nil {
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetAvatarURL() string {
+	if c == nil || c.AvatarURL == nil {
 		return ""
 	}
-	return *c.WorkflowFilePath
+	return *c.AvatarURL
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (c *CreateUserProjectOptions) GetBody() string {
-	if c == nil || c.Body == nil {
-		return ""
+// GetContributions returns the Contributions field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetContributions() int {
+	if c == nil || c.Contributions == nil {
+		return 0
 	}
-	return *c.Body
+	return *c.Contributions
 }
 
-// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
-func (c *CustomRepoRoles) GetBaseRole() string {
-	if c == nil || c.BaseRole == nil {
-		return ""
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
 	}
-	return *c.BaseRole
+	return *c.Email
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (c *CustomRepoRoles) GetDescription() string {
-	if c == nil || c.Description == nil {
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetEventsURL() string {
+	if c == nil || c.EventsURL == nil {
 		return ""
 	}
-	return *c.Description
+	return *c.EventsURL
+}
+
+// GetFollowersURL returns the FollowersURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetFollowersURL() string {
+	if c == nil || c.FollowersURL == nil {
+		return ""
+	}
+	return *c.FollowersURL
+}
+
+// GetFollowingURL returns the FollowingURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetFollowingURL() string {
+	if c == nil || c.FollowingURL == nil {
+		return ""
+	}
+	return *c.FollowingURL
+}
+
+// GetGistsURL returns the GistsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetGistsURL() string {
+	if c == nil || c.GistsURL == nil {
+		return ""
+	}
+	return *c.GistsURL
+}
+
+// GetGravatarID returns the GravatarID field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetGravatarID() string {
+	if c == nil || c.GravatarID == nil {
+		return ""
+	}
+	return *c.GravatarID
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
+	}
+	return *c.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *CustomRepoRoles) GetID() int64 {
+func (c *Contributor) GetID() int64 {
 	if c == nil || c.ID == nil {
 		return 0
 	}
 	return *c.ID
 }
 
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetLogin() string {
+	if c == nil || c.Login == nil {
+		return ""
+	}
+	return *c.Login
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CustomRepoRoles) GetName() string {
+func (c *Contributor) GetName() string {
 	if c == nil || c.Name == nil {
 		return ""
 	}
 	return *c.Name
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeleteEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
 	}
-	return d.Installation
+	return *c.NodeID
 }
 
-// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetPusherType() string {
-	if d == nil || d.PusherType == nil {
+// GetOrganizationsURL returns the OrganizationsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetOrganizationsURL() string {
+	if c == nil || c.OrganizationsURL == nil {
 		return ""
 	}
-	return *d.PusherType
+	return *c.OrganizationsURL
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetRef() string {
-	if d == nil || d.Ref == nil {
+// GetReceivedEventsURL returns the ReceivedEventsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetReceivedEventsURL() string {
+	if c == nil || c.ReceivedEventsURL == nil {
 		return ""
 	}
-	return *d.Ref
+	return *c.ReceivedEventsURL
 }
 
-// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetRefType() string {
-	if d == nil || d.RefType == nil {
+// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetReposURL() string {
+	if c == nil || c.ReposURL == nil {
 		return ""
 	}
-	return *d.RefType
+	return *c.ReposURL
 }
 
-// GetRepo returns the Repo field.
-func (d *DeleteEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetSiteAdmin returns the SiteAdmin field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetSiteAdmin() bool {
+	if c == nil || c.SiteAdmin == nil {
+		return false
 	}
-	return d.Repo
+	return *c.SiteAdmin
 }
 
-// GetSender returns the Sender field.
-func (d *DeleteEvent) GetSender() *User {
-	if d == nil {
-		return nil
+// GetStarredURL returns the StarredURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetStarredURL() string {
+	if c == nil || c.StarredURL == nil {
+		return ""
 	}
-	return d.Sender
+	return *c.StarredURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
+// GetSubscriptionsURL returns the SubscriptionsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetSubscriptionsURL() string {
+	if c == nil || c.SubscriptionsURL == nil {
+		return ""
 	}
-	return *d.CreatedAt
+	return *c.SubscriptionsURL
 }
 
-// GetDependency returns the Dependency field.
-func (d *DependabotAlert) GetDependency() *Dependency {
-	if d == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
 	}
-	return d.Dependency
+	return *c.Type
 }
 
-// GetDismissedAt returns the DismissedAt field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetDismissedAt() Timestamp {
-	if d == nil || d.DismissedAt == nil {
-		return Timestamp{}
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
 	}
-	return *d.DismissedAt
+	return *c.URL
 }
 
-// GetDismissedBy returns the DismissedBy field.
-func (d *DependabotAlert) GetDismissedBy() *User {
-	if d == nil {
+// GetAuthor returns the Author field.
+func (c *ContributorStats) GetAuthor() *Contributor {
+	if c == nil {
 		return nil
 	}
-	return d.DismissedBy
+	return c.Author
 }
 
-// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetDismissedComment() string {
-	if d == nil || d.DismissedComment == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (c *ContributorStats) GetTotal() int {
+	if c == nil || c.Total == nil {
+		return 0
 	}
-	return *d.DismissedComment
+	return *c.Total
 }
 
-// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetDismissedReason() string {
-	if d == nil || d.DismissedReason == nil {
+// GetCustomModelTrainingDate returns the CustomModelTrainingDate field if it's non-nil, zero value otherwise.
+func (c *CopilotDotcomChatModel) GetCustomModelTrainingDate() string {
+	if c == nil || c.CustomModelTrainingDate == nil {
 		return ""
 	}
-	return *d.DismissedReason
+	return *c.CustomModelTrainingDate
 }
 
-// GetFixedAt returns the FixedAt field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetFixedAt() Timestamp {
-	if d == nil || d.FixedAt == nil {
-		return Timestamp{}
+// GetCustomModelTrainingDate returns the CustomModelTrainingDate field if it's non-nil, zero value otherwise.
+func (c *CopilotDotcomPullRequestsModel) GetCustomModelTrainingDate() string {
+	if c == nil || c.CustomModelTrainingDate == nil {
+		return ""
 	}
-	return *d.FixedAt
+	return *c.CustomModelTrainingDate
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetHTMLURL() string {
-	if d == nil || d.HTMLURL == nil {
+// GetCustomModelTrainingDate returns the CustomModelTrainingDate field if it's non-nil, zero value otherwise.
+func (c *CopilotIDEChatModel) GetCustomModelTrainingDate() string {
+	if c == nil || c.CustomModelTrainingDate == nil {
 		return ""
 	}
-	return *d.HTMLURL
+	return *c.CustomModelTrainingDate
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetNumber() int {
-	if d == nil || d.Number == nil {
-		return 0
+// GetCustomModelTrainingDate returns the CustomModelTrainingDate field if it's non-nil, zero value otherwise.
+func (c *CopilotIDECodeCompletionsModel) GetCustomModelTrainingDate() string {
+	if c == nil || c.CustomModelTrainingDate == nil {
+		return ""
 	}
-	return *d.Number
+	return *c.CustomModelTrainingDate
 }
 
-// GetSecurityAdvisory returns the SecurityAdvisory field.
-func (d *DependabotAlert) GetSecurityAdvisory() *DependabotSecurityAdvisory {
-	if d == nil {
+// GetCopilotDotcomChat returns the CopilotDotcomChat field.
+func (c *CopilotMetrics) GetCopilotDotcomChat() *CopilotDotcomChat {
+	if c == nil {
 		return nil
 	}
-	return d.SecurityAdvisory
+	return c.CopilotDotcomChat
 }
 
-// GetSecurityVulnerability returns the SecurityVulnerability field.
-func (d *DependabotAlert) GetSecurityVulnerability() *AdvisoryVulnerability {
-	if d == nil {
+// GetCopilotDotcomPullRequests returns the CopilotDotcomPullRequests field.
+func (c *CopilotMetrics) GetCopilotDotcomPullRequests() *CopilotDotcomPullRequests {
+	if c == nil {
 		return nil
 	}
-	return d.SecurityVulnerability
+	return c.CopilotDotcomPullRequests
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetState() string {
-	if d == nil || d.State == nil {
-		return ""
+// GetCopilotIDEChat returns the CopilotIDEChat field.
+func (c *CopilotMetrics) GetCopilotIDEChat() *CopilotIDEChat {
+	if c == nil {
+		return nil
 	}
-	return *d.State
+	return c.CopilotIDEChat
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetCopilotIDECodeCompletions returns the CopilotIDECodeCompletions field.
+func (c *CopilotMetrics) GetCopilotIDECodeCompletions() *CopilotIDECodeCompletions {
+	if c == nil {
+		return nil
 	}
-	return *d.UpdatedAt
+	return c.CopilotIDECodeCompletions
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DependabotAlert) GetURL() string {
-	if d == nil || d.URL == nil {
-		return ""
+// GetTotalActiveUsers returns the TotalActiveUsers field if it's non-nil, zero value otherwise.
+func (c *CopilotMetrics) GetTotalActiveUsers() int {
+	if c == nil || c.TotalActiveUsers == nil {
+		return 0
 	}
-	return *d.URL
+	return *c.TotalActiveUsers
 }
 
-// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
-func (d *DependabotSecurityAdvisory) GetCVEID() string {
-	if d == nil || d.CVEID == nil {
-		return ""
+// GetTotalEngagedUsers returns the TotalEngagedUsers field if it's non-nil, zero value otherwise.
+func (c *CopilotMetrics) GetTotalEngagedUsers() int {
+	if c == nil || c.TotalEngagedUsers == nil {
+		return 0
 	}
-	return *d.CVEID
+	return *c.TotalEngagedUsers
 }
 
-// GetCVSs returns the CVSs field.
-func (d *DependabotSecurityAdvisory) GetCVSs() *AdvisoryCVSs {
-	if d == nil {
+// GetSeatBreakdown returns the SeatBreakdown field.
+func (c *CopilotOrganizationDetails) GetSeatBreakdown() *CopilotSeatBreakdown {
+	if c == nil {
 		return nil
 	}
-	return d.CVSs
+	return c.SeatBreakdown
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DependabotSecurityAdvisory) GetDescription() string {
-	if d == nil || d.Description == nil {
-		return ""
+// GetAssigningTeam returns the AssigningTeam field.
+func (c *CopilotSeatDetails) GetAssigningTeam() *Team {
+	if c == nil {
+		return nil
 	}
-	return *d.Description
+	return c.AssigningTeam
 }
 
-// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
-func (d *DependabotSecurityAdvisory) GetGHSAID() string {
-	if d == nil || d.GHSAID == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *d.GHSAID
+	return *c.CreatedAt
 }
 
-// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
-func (d *DependabotSecurityAdvisory) GetPublishedAt() Timestamp {
-	if d == nil || d.PublishedAt == nil {
+// GetLastActivityAt returns the LastActivityAt field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetLastActivityAt() Timestamp {
+	if c == nil || c.LastActivityAt == nil {
 		return Timestamp{}
 	}
-	return *d.PublishedAt
+	return *c.LastActivityAt
 }
 
-// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
-func (d *DependabotSecurityAdvisory) GetSeverity() string {
-	if d == nil || d.Severity == nil {
+// GetLastActivityEditor returns the LastActivityEditor field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetLastActivityEditor() string {
+	if c == nil || c.LastActivityEditor == nil {
 		return ""
 	}
-	return *d.Severity
+	return *c.LastActivityEditor
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (d *DependabotSecurityAdvisory) GetSummary() string {
-	if d == nil || d.Summary == nil {
+// GetPendingCancellationDate returns the PendingCancellationDate field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetPendingCancellationDate() string {
+	if c == nil || c.PendingCancellationDate == nil {
 		return ""
 	}
-	return *d.Summary
+	return *c.PendingCancellationDate
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DependabotSecurityAdvisory) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
+func (c *CopilotSeatDetails) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *d.UpdatedAt
+	return *c.UpdatedAt
 }
 
-// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
-func (d *DependabotSecurityAdvisory) GetWithdrawnAt() Timestamp {
-	if d == nil || d.WithdrawnAt == nil {
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetCompletedAt() Timestamp {
+	if c == nil || c.CompletedAt == nil {
 		return Timestamp{}
 	}
-	return *d.WithdrawnAt
+	return *c.CompletedAt
 }
 
-// GetManifestPath returns the ManifestPath field if it's non-nil, zero value otherwise.
-func (d *Dependency) GetManifestPath() string {
-	if d == nil || d.ManifestPath == nil {
+// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetConclusion() string {
+	if c == nil || c.Conclusion == nil {
 		return ""
 	}
-	return *d.ManifestPath
-}
-
-// GetPackage returns the Package field.
-func (d *Dependency) GetPackage() *VulnerabilityPackage {
-	if d == nil {
-		return nil
-	}
-	return d.Package
+	return *c.Conclusion
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (d *Dependency) GetScope() string {
-	if d == nil || d.Scope == nil {
+// GetDetailsURL returns the DetailsURL field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetDetailsURL() string {
+	if c == nil || c.DetailsURL == nil {
 		return ""
 	}
-	return *d.Scope
+	return *c.DetailsURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (d *DeployKeyEvent) GetAction() string {
-	if d == nil || d.Action == nil {
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetExternalID() string {
+	if c == nil || c.ExternalID == nil {
 		return ""
 	}
-	return *d.Action
-}
-
-// GetInstallation returns the Installation field.
-func (d *DeployKeyEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
-	}
-	return d.Installation
+	return *c.ExternalID
 }
 
-// GetKey returns the Key field.
-func (d *DeployKeyEvent) GetKey() *Key {
-	if d == nil {
+// GetOutput returns the Output field.
+func (c *CreateCheckRunOptions) GetOutput() *CheckRunOutput {
+	if c == nil {
 		return nil
 	}
-	return d.Key
+	return c.Output
 }
 
-// GetOrganization returns the Organization field.
-func (d *DeployKeyEvent) GetOrganization() *Organization {
-	if d == nil {
-		return nil
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetStartedAt() Timestamp {
+	if c == nil || c.StartedAt == nil {
+		return Timestamp{}
 	}
-	return d.Organization
+	return *c.StartedAt
 }
 
-// GetRepo returns the Repo field.
-func (d *DeployKeyEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetStatus() string {
+	if c == nil || c.Status == nil {
+		return ""
 	}
-	return d.Repo
+	return *c.Status
 }
 
-// GetSender returns the Sender field.
-func (d *DeployKeyEvent) GetSender() *User {
-	if d == nil {
-		return nil
+// GetHeadBranch returns the HeadBranch field if it's non-nil, zero value otherwise.
+func (c *CreateCheckSuiteOptions) GetHeadBranch() string {
+	if c == nil || c.HeadBranch == nil {
+		return ""
 	}
-	return d.Sender
+	return *c.HeadBranch
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
+// GetClientIP returns the ClientIP field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetClientIP() string {
+	if c == nil || c.ClientIP == nil {
+		return ""
 	}
-	return *d.CreatedAt
+	return *c.ClientIP
 }
 
-// GetCreator returns the Creator field.
-func (d *Deployment) GetCreator() *User {
-	if d == nil {
-		return nil
+// GetDevcontainerPath returns the DevcontainerPath field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetDevcontainerPath() string {
+	if c == nil || c.DevcontainerPath == nil {
+		return ""
 	}
-	return d.Creator
+	return *c.DevcontainerPath
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetDisplayName() string {
+	if c == nil || c.DisplayName == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.DisplayName
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetGeo returns the Geo field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetGeo() string {
+	if c == nil || c.Geo == nil {
 		return ""
 	}
-	return *d.Environment
+	return *c.Geo
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetID() int64 {
-	if d == nil || d.ID == nil {
+// GetIdleTimeoutMinutes returns the IdleTimeoutMinutes field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetIdleTimeoutMinutes() int {
+	if c == nil || c.IdleTimeoutMinutes == nil {
 		return 0
 	}
-	return *d.ID
+	return *c.IdleTimeoutMinutes
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetMachine returns the Machine field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetMachine() string {
+	if c == nil || c.Machine == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *c.Machine
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetRef() string {
-	if d == nil || d.Ref == nil {
-		return ""
+// GetMultiRepoPermissionsOptOut returns the MultiRepoPermissionsOptOut field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetMultiRepoPermissionsOptOut() bool {
+	if c == nil || c.MultiRepoPermissionsOptOut == nil {
+		return false
 	}
-	return *d.Ref
+	return *c.MultiRepoPermissionsOptOut
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetRef() string {
+	if c == nil || c.Ref == nil {
 		return ""
 	}
-	return *d.RepositoryURL
+	return *c.Ref
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetSHA() string {
-	if d == nil || d.SHA == nil {
-		return ""
+// GetRetentionPeriodMinutes returns the RetentionPeriodMinutes field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetRetentionPeriodMinutes() int {
+	if c == nil || c.RetentionPeriodMinutes == nil {
+		return 0
 	}
-	return *d.SHA
+	return *c.RetentionPeriodMinutes
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetStatusesURL() string {
-	if d == nil || d.StatusesURL == nil {
+// GetWorkingDirectory returns the WorkingDirectory field if it's non-nil, zero value otherwise.
+func (c *CreateCodespaceOptions) GetWorkingDirectory() string {
+	if c == nil || c.WorkingDirectory == nil {
 		return ""
 	}
-	return *d.StatusesURL
+	return *c.WorkingDirectory
 }
 
-// GetTask returns the Task field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetTask() string {
-	if d == nil || d.Task == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *d.Task
+	return *c.Description
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetInstallation returns the Installation field.
+func (c *CreateEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
 	}
-	return *d.UpdatedAt
+	return c.Installation
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetURL() string {
-	if d == nil || d.URL == nil {
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetMasterBranch() string {
+	if c == nil || c.MasterBranch == nil {
 		return ""
 	}
-	return *d.URL
+	return *c.MasterBranch
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DeploymentBranchPolicy) GetID() int64 {
-	if d == nil || d.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (c *CreateEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
 	}
-	return *d.ID
+	return c.Org
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (d *DeploymentBranchPolicy) GetName() string {
-	if d == nil || d.Name == nil {
+// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetPusherType() string {
+	if c == nil || c.PusherType == nil {
 		return ""
 	}
-	return *d.Name
+	return *c.PusherType
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DeploymentBranchPolicy) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetRef() string {
+	if c == nil || c.Ref == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *c.Ref
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (d *DeploymentBranchPolicyRequest) GetName() string {
-	if d == nil || d.Name == nil {
+// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetRefType() string {
+	if c == nil || c.RefType == nil {
 		return ""
 	}
-	return *d.Name
+	return *c.RefType
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (d *DeploymentBranchPolicyResponse) GetTotalCount() int {
-	if d == nil || d.TotalCount == nil {
-		return 0
+// GetRepo returns the Repo field.
+func (c *CreateEvent) GetRepo() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *d.TotalCount
+	return c.Repo
 }
 
-// GetDeployment returns the Deployment field.
-func (d *DeploymentEvent) GetDeployment() *Deployment {
-	if d == nil {
+// GetSender returns the Sender field.
+func (c *CreateEvent) GetSender() *User {
+	if c == nil {
 		return nil
 	}
-	return d.Deployment
+	return c.Sender
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeploymentEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *CreateOrgInvitationOptions) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
 	}
-	return d.Installation
+	return *c.Email
 }
 
-// GetRepo returns the Repo field.
-func (d *DeploymentEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetInviteeID returns the InviteeID field if it's non-nil, zero value otherwise.
+func (c *CreateOrgInvitationOptions) GetInviteeID() int64 {
+	if c == nil || c.InviteeID == nil {
+		return 0
 	}
-	return d.Repo
+	return *c.InviteeID
 }
 
-// GetSender returns the Sender field.
-func (d *DeploymentEvent) GetSender() *User {
-	if d == nil {
-		return nil
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (c *CreateOrgInvitationOptions) GetRole() string {
+	if c == nil || c.Role == nil {
+		return ""
 	}
-	return d.Sender
+	return *c.Role
 }
 
-// GetAutoMerge returns the AutoMerge field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetAutoMerge() bool {
-	if d == nil || d.AutoMerge == nil {
-		return false
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateCustomRoleOptions) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
+		return ""
 	}
-	return *d.AutoMerge
+	return *c.BaseRole
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetDescription() string {
-	if d == nil || d.Description == nil {
+func (c *CreateOrUpdateCustomRoleOptions) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.Description
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateCustomRoleOptions) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *d.Environment
+	return *c.Name
 }
 
-// GetProductionEnvironment returns the ProductionEnvironment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetProductionEnvironment() bool {
-	if d == nil || d.ProductionEnvironment == nil {
-		return false
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateIssueTypesOptions) GetColor() string {
+	if c == nil || c.Color == nil {
+		return ""
 	}
-	return *d.ProductionEnvironment
+	return *c.Color
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetRef() string {
-	if d == nil || d.Ref == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateIssueTypesOptions) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *d.Ref
+	return *c.Description
 }
 
-// GetRequiredContexts returns the RequiredContexts field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetRequiredContexts() []string {
-	if d == nil || d.RequiredContexts == nil {
-		return nil
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateOrgRoleOptions) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
+		return ""
 	}
-	return *d.RequiredContexts
+	return *c.BaseRole
 }
 
-// GetTask returns the Task field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetTask() string {
-	if d == nil || d.Task == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateOrgRoleOptions) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *d.Task
+	return *c.Description
 }
 
-// GetTransientEnvironment returns the TransientEnvironment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetTransientEnvironment() bool {
-	if d == nil || d.TransientEnvironment == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateOrgRoleOptions) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return *d.TransientEnvironment
+	return *c.Name
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (c *CreateProtectedChanges) GetFrom() bool {
+	if c == nil || c.From == nil {
+		return false
 	}
-	return *d.CreatedAt
+	return *c.From
 }
 
-// GetCreator returns the Creator field.
-func (d *DeploymentStatus) GetCreator() *User {
-	if d == nil {
-		return nil
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (c *CreateRunnerGroupRequest) GetAllowsPublicRepositories() bool {
+	if c == nil || c.AllowsPublicRepositories == nil {
+		return false
 	}
-	return d.Creator
+	return *c.AllowsPublicRepositories
 }
 
-// GetDeploymentURL returns the DeploymentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetDeploymentURL() string {
-	if d == nil || d.DeploymentURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateRunnerGroupRequest) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *d.DeploymentURL
+	return *c.Name
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetDescription() string {
-	if d == nil || d.Description == nil {
-		return ""
+// GetRestrictedToWorkflows returns the RestrictedToWorkflows field if it's non-nil, zero value otherwise.
+func (c *CreateRunnerGroupRequest) GetRestrictedToWorkflows() bool {
+	if c == nil || c.RestrictedToWorkflows == nil {
+		return false
 	}
-	return *d.Description
+	return *c.RestrictedToWorkflows
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (c *CreateRunnerGroupRequest) GetVisibility() string {
+	if c == nil || c.Visibility == nil {
 		return ""
 	}
-	return *d.Environment
+	return *c.Visibility
 }
 
-// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetEnvironmentURL() string {
-	if d == nil || d.EnvironmentURL == nil {
-		return ""
+// GetCanAdminsBypass returns the CanAdminsBypass field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateEnvironment) GetCanAdminsBypass() bool {
+	if c == nil || c.CanAdminsBypass == nil {
+		return false
 	}
-	return *d.EnvironmentURL
+	return *c.CanAdminsBypass
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetID() int64 {
-	if d == nil || d.ID == nil {
+// GetDeploymentBranchPolicy returns the DeploymentBranchPolicy field.
+func (c *CreateUpdateEnvironment) GetDeploymentBranchPolicy() *BranchPolicy {
+	if c == nil {
+		return nil
+	}
+	return c.DeploymentBranchPolicy
+}
+
+// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateEnvironment) GetWaitTimer() int {
+	if c == nil || c.WaitTimer == nil {
 		return 0
 	}
-	return *d.ID
+	return *c.WaitTimer
 }
 
-// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetLogURL() string {
-	if d == nil || d.LogURL == nil {
-		return ""
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateRequiredWorkflowOptions) GetRepositoryID() int64 {
+	if c == nil || c.RepositoryID == nil {
+		return 0
 	}
-	return *d.LogURL
+	return *c.RepositoryID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateRequiredWorkflowOptions) GetScope() string {
+	if c == nil || c.Scope == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *c.Scope
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
-		return ""
+// GetSelectedRepositoryIDs returns the SelectedRepositoryIDs field.
+func (c *CreateUpdateRequiredWorkflowOptions) GetSelectedRepositoryIDs() *SelectedRepoIDs {
+	if c == nil {
+		return nil
 	}
-	return *d.RepositoryURL
+	return c.SelectedRepositoryIDs
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetState() string {
-	if d == nil || d.State == nil {
+// GetWorkflowFilePath returns the WorkflowFilePath field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateRequiredWorkflowOptions) GetWorkflowFilePath() string {
+	if c == nil || c.WorkflowFilePath == nil {
 		return ""
 	}
-	return *d.State
+	return *c.WorkflowFilePath
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetTargetURL() string {
-	if d == nil || d.TargetURL == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (c *CreateUserProjectOptions) GetBody() string {
+	if c == nil || c.Body == nil {
 		return ""
 	}
-	return *d.TargetURL
+	return *c.Body
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (c *CreationInfo) GetCreated() Timestamp {
+	if c == nil || c.Created == nil {
 		return Timestamp{}
 	}
-	return *d.UpdatedAt
+	return *c.Created
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetURL() string {
-	if d == nil || d.URL == nil {
-		return ""
+// GetApp returns the App field.
+func (c *CustomDeploymentProtectionRule) GetApp() *CustomDeploymentProtectionRuleApp {
+	if c == nil {
+		return nil
 	}
-	return *d.URL
+	return c.App
 }
 
-// GetDeployment returns the Deployment field.
-func (d *DeploymentStatusEvent) GetDeployment() *Deployment {
-	if d == nil {
-		return nil
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetEnabled() bool {
+	if c == nil || c.Enabled == nil {
+		return false
 	}
-	return d.Deployment
+	return *c.Enabled
 }
 
-// GetDeploymentStatus returns the DeploymentStatus field.
-func (d *DeploymentStatusEvent) GetDeploymentStatus() *DeploymentStatus {
-	if d == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return d.DeploymentStatus
+	return *c.ID
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeploymentStatusEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
 	}
-	return d.Installation
+	return *c.NodeID
 }
 
-// GetRepo returns the Repo field.
-func (d *DeploymentStatusEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return d.Repo
+	return *c.ID
 }
 
-// GetSender returns the Sender field.
-func (d *DeploymentStatusEvent) GetSender() *User {
-	if d == nil {
-		return nil
+// GetIntegrationURL returns the IntegrationURL field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetIntegrationURL() string {
+	if c == nil || c.IntegrationURL == nil {
+		return ""
 	}
-	return d.Sender
+	return *c.IntegrationURL
 }
 
-// GetAutoInactive returns the AutoInactive field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetAutoInactive() bool {
-	if d == nil || d.AutoInactive == nil {
-		return false
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
 	}
-	return *d.AutoInactive
+	return *c.NodeID
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetSlug() string {
+	if c == nil || c.Slug == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.Slug
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
-		return ""
+// GetIntegrationID returns the IntegrationID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleRequest) GetIntegrationID() int64 {
+	if c == nil || c.IntegrationID == nil {
+		return 0
 	}
-	return *d.Environment
+	return *c.IntegrationID
 }
 
-// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetEnvironmentURL() string {
-	if d == nil || d.EnvironmentURL == nil {
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
 		return ""
 	}
-	return *d.EnvironmentURL
+	return *c.BaseRole
 }
 
-// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetLogURL() string {
-	if d == nil || d.LogURL == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *c.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *d.LogURL
+	return *c.Description
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetState() string {
-	if d == nil || d.State == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+	return *c.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *d.State
+	return *c.Name
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetActiveLockReason() string {
-	if d == nil || d.ActiveLockReason == nil {
+// GetOrg returns the Org field.
+func (c *CustomOrgRoles) GetOrg() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetSource() string {
+	if c == nil || c.Source == nil {
 		return ""
 	}
-	return *d.ActiveLockReason
+	return *c.Source
 }
 
-// GetAnswerChosenAt returns the AnswerChosenAt field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetAnswerChosenAt() Timestamp {
-	if d == nil || d.AnswerChosenAt == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *d.AnswerChosenAt
+	return *c.UpdatedAt
 }
 
-// GetAnswerChosenBy returns the AnswerChosenBy field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetAnswerChosenBy() string {
-	if d == nil || d.AnswerChosenBy == nil {
+// GetDefaultValue returns the DefaultValue field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetDefaultValue() string {
+	if c == nil || c.DefaultValue == nil {
 		return ""
 	}
-	return *d.AnswerChosenBy
+	return *c.DefaultValue
 }
 
-// GetAnswerHTMLURL returns the AnswerHTMLURL field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetAnswerHTMLURL() string {
-	if d == nil || d.AnswerHTMLURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *d.AnswerHTMLURL
+	return *c.Description
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetAuthorAssociation() string {
-	if d == nil || d.AuthorAssociation == nil {
+// GetPropertyName returns the PropertyName field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetPropertyName() string {
+	if c == nil || c.PropertyName == nil {
 		return ""
 	}
-	return *d.AuthorAssociation
+	return *c.PropertyName
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetBody() string {
-	if d == nil || d.Body == nil {
+// GetRequired returns the Required field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetRequired() bool {
+	if c == nil || c.Required == nil {
+		return false
+	}
+	return *c.Required
+}
+
+// GetValuesEditableBy returns the ValuesEditableBy field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetValuesEditableBy() string {
+	if c == nil || c.ValuesEditableBy == nil {
 		return ""
 	}
-	return *d.Body
+	return *c.ValuesEditableBy
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetComments() int {
-	if d == nil || d.Comments == nil {
-		return 0
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CustomPropertyEvent) GetAction() string {
+	if c == nil || c.Action == nil {
+		return ""
 	}
-	return *d.Comments
+	return *c.Action
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
+// GetDefinition returns the Definition field.
+func (c *CustomPropertyEvent) GetDefinition() *CustomProperty {
+	if c == nil {
+		return nil
 	}
-	return *d.CreatedAt
+	return c.Definition
 }
 
-// GetDiscussionCategory returns the DiscussionCategory field.
-func (d *Discussion) GetDiscussionCategory() *DiscussionCategory {
-	if d == nil {
+// GetEnterprise returns the Enterprise field.
+func (c *CustomPropertyEvent) GetEnterprise() *Enterprise {
+	if c == nil {
 		return nil
 	}
-	return d.DiscussionCategory
+	return c.Enterprise
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetHTMLURL() string {
-	if d == nil || d.HTMLURL == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (c *CustomPropertyEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
 	}
-	return *d.HTMLURL
+	return c.Installation
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetID() int64 {
-	if d == nil || d.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (c *CustomPropertyEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
 	}
-	return *d.ID
+	return c.Org
 }
 
-// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetLocked() bool {
-	if d == nil || d.Locked == nil {
-		return false
+// GetSender returns the Sender field.
+func (c *CustomPropertyEvent) GetSender() *User {
+	if c == nil {
+		return nil
 	}
-	return *d.Locked
+	return c.Sender
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CustomPropertyValuesEvent) GetAction() string {
+	if c == nil || c.Action == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *c.Action
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetNumber() int {
-	if d == nil || d.Number == nil {
-		return 0
+// GetEnterprise returns the Enterprise field.
+func (c *CustomPropertyValuesEvent) GetEnterprise() *Enterprise {
+	if c == nil {
+		return nil
 	}
-	return *d.Number
+	return c.Enterprise
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (c *CustomPropertyValuesEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
 	}
-	return *d.RepositoryURL
+	return c.Installation
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetState() string {
-	if d == nil || d.State == nil {
-		return ""
+// GetOrg returns the Org field.
+func (c *CustomPropertyValuesEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
 	}
-	return *d.State
+	return c.Org
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetTitle() string {
-	if d == nil || d.Title == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (c *CustomPropertyValuesEvent) GetRepo() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *d.Title
+	return c.Repo
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetSender returns the Sender field.
+func (c *CustomPropertyValuesEvent) GetSender() *User {
+	if c == nil {
+		return nil
 	}
-	return *d.UpdatedAt
+	return c.Sender
 }
 
-// GetUser returns the User field.
-func (d *Discussion) GetUser() *User {
-	if d == nil {
-		return nil
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
+		return ""
 	}
-	return d.User
+	return *c.BaseRole
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
+func (c *CustomRepoRoles) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *d.CreatedAt
+	return *c.CreatedAt
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetDescription() string {
-	if d == nil || d.Description == nil {
+func (c *CustomRepoRoles) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.Description
 }
 
-// GetEmoji returns the Emoji field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetEmoji() string {
-	if d == nil || d.Emoji == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+	return *c.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *d.Emoji
+	return *c.Name
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetID() int64 {
-	if d == nil || d.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (c *CustomRepoRoles) GetOrg() *Organization {
+	if c == nil {
+		return nil
 	}
-	return *d.ID
+	return c.Org
 }
 
-// GetIsAnswerable returns the IsAnswerable field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetIsAnswerable() bool {
-	if d == nil || d.IsAnswerable == nil {
-		return false
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *d.IsAnswerable
+	return *c.UpdatedAt
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetName() string {
-	if d == nil || d.Name == nil {
+// GetEncryptedToken returns the EncryptedToken field if it's non-nil, zero value otherwise.
+func (d *DatadogStreamConfig) GetEncryptedToken() string {
+	if d == nil || d.EncryptedToken == nil {
 		return ""
 	}
-	return *d.Name
+	return *d.EncryptedToken
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (d *DatadogStreamConfig) GetKeyID() string {
+	if d == nil || d.KeyID == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *d.KeyID
 }
 
-// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetRepositoryID() int64 {
-	if d == nil || d.RepositoryID == nil {
-		return 0
+// GetSite returns the Site field if it's non-nil, zero value otherwise.
+func (d *DatadogStreamConfig) GetSite() string {
+	if d == nil || d.Site == nil {
+		return ""
 	}
-	return *d.RepositoryID
+	return *d.Site
 }
 
-// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetSlug() string {
-	if d == nil || d.Slug == nil {
+// GetQuerySuite returns the QuerySuite field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetQuerySuite() string {
+	if d == nil || d.QuerySuite == nil {
 		return ""
 	}
-	return *d.Slug
+	return *d.QuerySuite
+}
+
+// GetSchedule returns the Schedule field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetSchedule() string {
+	if d == nil || d.Schedule == nil {
+		return ""
+	}
+	return *d.Schedule
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
+	}
+	return *d.State
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionCategory) GetUpdatedAt() Timestamp {
+func (d *DefaultSetupConfiguration) GetUpdatedAt() Timestamp {
 	if d == nil || d.UpdatedAt == nil {
 		return Timestamp{}
 	}
 	return *d.UpdatedAt
 }
 
-// GetAuthor returns the Author field.
-func (d *DiscussionComment) GetAuthor() *User {
+// GetConfirmDeleteURL returns the ConfirmDeleteURL field if it's non-nil, zero value otherwise.
+func (d *DeleteAnalysis) GetConfirmDeleteURL() string {
+	if d == nil || d.ConfirmDeleteURL == nil {
+		return ""
+	}
+	return *d.ConfirmDeleteURL
+}
+
+// GetNextAnalysisURL returns the NextAnalysisURL field if it's non-nil, zero value otherwise.
+func (d *DeleteAnalysis) GetNextAnalysisURL() string {
+	if d == nil || d.NextAnalysisURL == nil {
+		return ""
+	}
+	return *d.NextAnalysisURL
+}
+
+// GetInstallation returns the Installation field.
+func (d *DeleteEvent) GetInstallation() *Installation {
 	if d == nil {
 		return nil
 	}
-	return d.Author
+	return d.Installation
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBody() string {
-	if d == nil || d.Body == nil {
+// GetOrg returns the Org field.
+func (d *DeleteEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
+	}
+	return d.Org
+}
+
+// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetPusherType() string {
+	if d == nil || d.PusherType == nil {
 		return ""
 	}
-	return *d.Body
+	return *d.PusherType
 }
 
-// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBodyHTML() string {
-	if d == nil || d.BodyHTML == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetRef() string {
+	if d == nil || d.Ref == nil {
 		return ""
 	}
-	return *d.BodyHTML
+	return *d.Ref
 }
 
-// GetBodyVersion returns the BodyVersion field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBodyVersion() string {
-	if d == nil || d.BodyVersion == nil {
+// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetRefType() string {
+	if d == nil || d.RefType == nil {
 		return ""
 	}
-	return *d.BodyVersion
+	return *d.RefType
+}
+
+// GetRepo returns the Repo field.
+func (d *DeleteEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repo
+}
+
+// GetSender returns the Sender field.
+func (d *DeleteEvent) GetSender() *User {
+	if d == nil {
+		return nil
+	}
+	return d.Sender
+}
+
+// GetAutoDismissedAt returns the AutoDismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetAutoDismissedAt() Timestamp {
+	if d == nil || d.AutoDismissedAt == nil {
+		return Timestamp{}
+	}
+	return *d.AutoDismissedAt
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetCreatedAt() Timestamp {
+func (d *DependabotAlert) GetCreatedAt() Timestamp {
 	if d == nil || d.CreatedAt == nil {
 		return Timestamp{}
 	}
 	return *d.CreatedAt
 }
 
-// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetDiscussionURL() string {
-	if d == nil || d.DiscussionURL == nil {
+// GetDependency returns the Dependency field.
+func (d *DependabotAlert) GetDependency() *Dependency {
+	if d == nil {
+		return nil
+	}
+	return d.Dependency
+}
+
+// GetDismissedAt returns the DismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedAt() Timestamp {
+	if d == nil || d.DismissedAt == nil {
+		return Timestamp{}
+	}
+	return *d.DismissedAt
+}
+
+// GetDismissedBy returns the DismissedBy field.
+func (d *DependabotAlert) GetDismissedBy() *User {
+	if d == nil {
+		return nil
+	}
+	return d.DismissedBy
+}
+
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
 		return ""
 	}
-	return *d.DiscussionURL
+	return *d.DismissedComment
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetHTMLURL() string {
-	if d == nil || d.HTMLURL == nil {
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
 		return ""
 	}
-	return *d.HTMLURL
+	return *d.DismissedReason
 }
 
-// GetLastEditedAt returns the LastEditedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetLastEditedAt() Timestamp {
-	if d == nil || d.LastEditedAt == nil {
+// GetFixedAt returns the FixedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetFixedAt() Timestamp {
+	if d == nil || d.FixedAt == nil {
 		return Timestamp{}
 	}
-	return *d.LastEditedAt
+	return *d.FixedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *d.HTMLURL
 }
 
 // GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetNumber() int {
+func (d *DependabotAlert) GetNumber() int {
 	if d == nil || d.Number == nil {
 		return 0
 	}
 	return *d.Number
 }
 
-// GetReactions returns the Reactions field.
-func (d *DiscussionComment) GetReactions() *Reactions {
+// GetRepository returns the Repository field.
+func (d *DependabotAlert) GetRepository() *Repository {
 	if d == nil {
 		return nil
 	}
-	return d.Reactions
+	return d.Repository
+}
+
+// GetSecurityAdvisory returns the SecurityAdvisory field.
+func (d *DependabotAlert) GetSecurityAdvisory() *DependabotSecurityAdvisory {
+	if d == nil {
+		return nil
+	}
+	return d.SecurityAdvisory
+}
+
+// GetSecurityVulnerability returns the SecurityVulnerability field.
+func (d *DependabotAlert) GetSecurityVulnerability() *AdvisoryVulnerability {
+	if d == nil {
+		return nil
+	}
+	return d.SecurityVulnerability
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
+	}
+	return *d.State
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetUpdatedAt() Timestamp {
+func (d *DependabotAlert) GetUpdatedAt() Timestamp {
 	if d == nil || d.UpdatedAt == nil {
 		return Timestamp{}
 	}
@@ -5191,6975 +6071,10311 @@ func (d *DiscussionComment) GetUpdatedAt() Timestamp {
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetURL() string {
+func (d *DependabotAlert) GetURL() string {
 	if d == nil || d.URL == nil {
 		return ""
 	}
 	return *d.URL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (d *DiscussionCommentEvent) GetAction() string {
-	if d == nil || d.Action == nil {
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlertState) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
 		return ""
 	}
-	return *d.Action
+	return *d.DismissedComment
 }
 
-// GetComment returns the Comment field.
-func (d *DiscussionCommentEvent) GetComment() *CommentDiscussion {
-	if d == nil {
-		return nil
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlertState) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
+		return ""
 	}
-	return d.Comment
+	return *d.DismissedReason
 }
 
-// GetDiscussion returns the Discussion field.
-func (d *DiscussionCommentEvent) GetDiscussion() *Discussion {
-	if d == nil {
-		return nil
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetCVEID() string {
+	if d == nil || d.CVEID == nil {
+		return ""
 	}
-	return d.Discussion
+	return *d.CVEID
 }
 
-// GetInstallation returns the Installation field.
-func (d *DiscussionCommentEvent) GetInstallation() *Installation {
+// GetCVSs returns the CVSs field.
+func (d *DependabotSecurityAdvisory) GetCVSs() *AdvisoryCVSs {
 	if d == nil {
 		return nil
 	}
-	return d.Installation
+	return d.CVSs
 }
 
-// GetOrg returns the Org field.
-func (d *DiscussionCommentEvent) GetOrg() *Organization {
+// GetCVSSSeverities returns the CVSSSeverities field.
+func (d *DependabotSecurityAdvisory) GetCVSSSeverities() *AdvisoryCVSSSeverities {
 	if d == nil {
 		return nil
 	}
-	return d.Org
+	return d.CVSSSeverities
 }
 
-// GetRepo returns the Repo field.
-func (d *DiscussionCommentEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
 	}
-	return d.Repo
+	return *d.Description
 }
 
-// GetSender returns the Sender field.
-func (d *DiscussionCommentEvent) GetSender() *User {
+// GetEPSS returns the EPSS field.
+func (d *DependabotSecurityAdvisory) GetEPSS() *AdvisoryEPSS {
 	if d == nil {
 		return nil
 	}
-	return d.Sender
+	return d.EPSS
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (d *DiscussionEvent) GetAction() string {
-	if d == nil || d.Action == nil {
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetGHSAID() string {
+	if d == nil || d.GHSAID == nil {
 		return ""
 	}
-	return *d.Action
+	return *d.GHSAID
 }
 
-// GetDiscussion returns the Discussion field.
-func (d *DiscussionEvent) GetDiscussion() *Discussion {
-	if d == nil {
-		return nil
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetPublishedAt() Timestamp {
+	if d == nil || d.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return d.Discussion
+	return *d.PublishedAt
 }
 
-// GetInstallation returns the Installation field.
-func (d *DiscussionEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetSeverity() string {
+	if d == nil || d.Severity == nil {
+		return ""
 	}
-	return d.Installation
+	return *d.Severity
 }
 
-// GetOrg returns the Org field.
-func (d *DiscussionEvent) GetOrg() *Organization {
-	if d == nil {
-		return nil
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetSummary() string {
+	if d == nil || d.Summary == nil {
+		return ""
 	}
-	return d.Org
+	return *d.Summary
 }
 
-// GetRepo returns the Repo field.
-func (d *DiscussionEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return d.Repo
+	return *d.UpdatedAt
 }
 
-// GetSender returns the Sender field.
-func (d *DiscussionEvent) GetSender() *User {
-	if d == nil {
-		return nil
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if d == nil || d.WithdrawnAt == nil {
+		return Timestamp{}
 	}
-	return d.Sender
+	return *d.WithdrawnAt
 }
 
-// GetApps returns the Apps field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetApps() []string {
-	if d == nil || d.Apps == nil {
-		return nil
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityUpdates) GetStatus() string {
+	if d == nil || d.Status == nil {
+		return ""
 	}
-	return *d.Apps
+	return *d.Status
 }
 
-// GetTeams returns the Teams field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetTeams() []string {
-	if d == nil || d.Teams == nil {
-		return nil
+// GetManifestPath returns the ManifestPath field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetManifestPath() string {
+	if d == nil || d.ManifestPath == nil {
+		return ""
 	}
-	return *d.Teams
+	return *d.ManifestPath
 }
 
-// GetUsers returns the Users field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetUsers() []string {
-	if d == nil || d.Users == nil {
+// GetPackage returns the Package field.
+func (d *Dependency) GetPackage() *VulnerabilityPackage {
+	if d == nil {
 		return nil
 	}
-	return *d.Users
+	return d.Package
 }
 
-// GetDismissalCommitID returns the DismissalCommitID field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetDismissalCommitID() string {
-	if d == nil || d.DismissalCommitID == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetScope() string {
+	if d == nil || d.Scope == nil {
 		return ""
 	}
-	return *d.DismissalCommitID
+	return *d.Scope
 }
 
-// GetDismissalMessage returns the DismissalMessage field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetDismissalMessage() string {
-	if d == nil || d.DismissalMessage == nil {
+// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetChangeType() string {
+	if d == nil || d.ChangeType == nil {
 		return ""
 	}
-	return *d.DismissalMessage
+	return *d.ChangeType
 }
 
-// GetReviewID returns the ReviewID field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetReviewID() int64 {
-	if d == nil || d.ReviewID == nil {
-		return 0
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetEcosystem() string {
+	if d == nil || d.Ecosystem == nil {
+		return ""
 	}
-	return *d.ReviewID
+	return *d.Ecosystem
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetState() string {
-	if d == nil || d.State == nil {
+// GetLicense returns the License field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetLicense() string {
+	if d == nil || d.License == nil {
 		return ""
 	}
-	return *d.State
+	return *d.License
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (d *DismissStaleReviewsOnPushChanges) GetFrom() bool {
-	if d == nil || d.From == nil {
-		return false
+// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetManifest() string {
+	if d == nil || d.Manifest == nil {
+		return ""
 	}
-	return *d.From
+	return *d.Manifest
 }
 
-// GetClientPayload returns the ClientPayload field if it's non-nil, zero value otherwise.
-func (d *DispatchRequestOptions) GetClientPayload() json.RawMessage {
-	if d == nil || d.ClientPayload == nil {
-		return json.RawMessage{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return *d.ClientPayload
+	return *d.Name
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetBody() string {
-	if d == nil || d.Body == nil {
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
 		return ""
 	}
-	return *d.Body
+	return *d.PackageURL
 }
 
-// GetLine returns the Line field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetLine() int {
-	if d == nil || d.Line == nil {
-		return 0
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetScope() string {
+	if d == nil || d.Scope == nil {
+		return ""
 	}
-	return *d.Line
+	return *d.Scope
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetPath() string {
-	if d == nil || d.Path == nil {
+// GetSourceRepositoryURL returns the SourceRepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetSourceRepositoryURL() string {
+	if d == nil || d.SourceRepositoryURL == nil {
 		return ""
 	}
-	return *d.Path
+	return *d.SourceRepositoryURL
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetPosition() int {
-	if d == nil || d.Position == nil {
-		return 0
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetVersion() string {
+	if d == nil || d.Version == nil {
+		return ""
 	}
-	return *d.Position
+	return *d.Version
 }
 
-// GetSide returns the Side field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetSide() string {
-	if d == nil || d.Side == nil {
-		return ""
+// GetDetector returns the Detector field.
+func (d *DependencyGraphSnapshot) GetDetector() *DependencyGraphSnapshotDetector {
+	if d == nil {
+		return nil
 	}
-	return *d.Side
+	return d.Detector
 }
 
-// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetStartLine() int {
-	if d == nil || d.StartLine == nil {
-		return 0
+// GetJob returns the Job field.
+func (d *DependencyGraphSnapshot) GetJob() *DependencyGraphSnapshotJob {
+	if d == nil {
+		return nil
 	}
-	return *d.StartLine
+	return d.Job
 }
 
-// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetStartSide() string {
-	if d == nil || d.StartSide == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetRef() string {
+	if d == nil || d.Ref == nil {
 		return ""
 	}
-	return *d.StartSide
+	return *d.Ref
 }
 
-// GetRef returns the Ref field.
-func (e *EditBase) GetRef() *EditRef {
-	if e == nil {
-		return nil
+// GetScanned returns the Scanned field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetScanned() Timestamp {
+	if d == nil || d.Scanned == nil {
+		return Timestamp{}
 	}
-	return e.Ref
+	return *d.Scanned
 }
 
-// GetSHA returns the SHA field.
-func (e *EditBase) GetSHA() *EditSHA {
-	if e == nil {
-		return nil
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetSHA() string {
+	if d == nil || d.SHA == nil {
+		return ""
 	}
-	return e.SHA
+	return *d.SHA
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (e *EditBody) GetFrom() string {
-	if e == nil || e.From == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *e.From
+	return *d.CreatedAt
 }
 
-// GetBase returns the Base field.
-func (e *EditChange) GetBase() *EditBase {
-	if e == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return e.Base
+	return *d.ID
 }
 
-// GetBody returns the Body field.
-func (e *EditChange) GetBody() *EditBody {
-	if e == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetMessage() string {
+	if d == nil || d.Message == nil {
+		return ""
 	}
-	return e.Body
+	return *d.Message
 }
 
-// GetRepo returns the Repo field.
-func (e *EditChange) GetRepo() *EditRepo {
-	if e == nil {
-		return nil
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetResult() string {
+	if d == nil || d.Result == nil {
+		return ""
 	}
-	return e.Repo
+	return *d.Result
 }
 
-// GetTitle returns the Title field.
-func (e *EditChange) GetTitle() *EditTitle {
-	if e == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return e.Title
+	return *d.Name
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (e *EditRef) GetFrom() string {
-	if e == nil || e.From == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *e.From
+	return *d.URL
 }
 
-// GetName returns the Name field.
-func (e *EditRepo) GetName() *RepoName {
-	if e == nil {
-		return nil
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetVersion() string {
+	if d == nil || d.Version == nil {
+		return ""
 	}
-	return e.Name
+	return *d.Version
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (e *EditSHA) GetFrom() string {
-	if e == nil || e.From == nil {
+// GetCorrelator returns the Correlator field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetCorrelator() string {
+	if d == nil || d.Correlator == nil {
 		return ""
 	}
-	return *e.From
+	return *d.Correlator
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (e *EditTitle) GetFrom() string {
-	if e == nil || e.From == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
 		return ""
 	}
-	return *e.From
+	return *d.HTMLURL
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetAvatarURL() string {
-	if e == nil || e.AvatarURL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetID() string {
+	if d == nil || d.ID == nil {
 		return ""
 	}
-	return *e.AvatarURL
+	return *d.ID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetCreatedAt() Timestamp {
-	if e == nil || e.CreatedAt == nil {
-		return Timestamp{}
+// GetFile returns the File field.
+func (d *DependencyGraphSnapshotManifest) GetFile() *DependencyGraphSnapshotManifestFile {
+	if d == nil {
+		return nil
 	}
-	return *e.CreatedAt
+	return d.File
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetDescription() string {
-	if e == nil || e.Description == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotManifest) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *e.Description
+	return *d.Name
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetHTMLURL() string {
-	if e == nil || e.HTMLURL == nil {
+// GetSourceLocation returns the SourceLocation field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotManifestFile) GetSourceLocation() string {
+	if d == nil || d.SourceLocation == nil {
 		return ""
 	}
-	return *e.HTMLURL
+	return *d.SourceLocation
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetID() int {
-	if e == nil || e.ID == nil {
-		return 0
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
+		return ""
 	}
-	return *e.ID
+	return *d.PackageURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetName() string {
-	if e == nil || e.Name == nil {
+// GetRelationship returns the Relationship field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetRelationship() string {
+	if d == nil || d.Relationship == nil {
 		return ""
 	}
-	return *e.Name
+	return *d.Relationship
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetNodeID() string {
-	if e == nil || e.NodeID == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetScope() string {
+	if d == nil || d.Scope == nil {
 		return ""
 	}
-	return *e.NodeID
+	return *d.Scope
 }
 
-// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetSlug() string {
-	if e == nil || e.Slug == nil {
+// GetAdvisoryGHSAID returns the AdvisoryGHSAID field if it's non-nil, zero value otherwise.
+func (d *DependencyVulnerability) GetAdvisoryGHSAID() string {
+	if d == nil || d.AdvisoryGHSAID == nil {
 		return ""
 	}
-	return *e.Slug
+	return *d.AdvisoryGHSAID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetUpdatedAt() Timestamp {
-	if e == nil || e.UpdatedAt == nil {
-		return Timestamp{}
+// GetAdvisorySummary returns the AdvisorySummary field if it's non-nil, zero value otherwise.
+func (d *DependencyVulnerability) GetAdvisorySummary() string {
+	if d == nil || d.AdvisorySummary == nil {
+		return ""
 	}
-	return *e.UpdatedAt
+	return *d.AdvisorySummary
 }
 
-// GetWebsiteURL returns the WebsiteURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetWebsiteURL() string {
-	if e == nil || e.WebsiteURL == nil {
+// GetAdvisoryURL returns the AdvisoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyVulnerability) GetAdvisoryURL() string {
+	if d == nil || d.AdvisoryURL == nil {
 		return ""
 	}
-	return *e.WebsiteURL
+	return *d.AdvisoryURL
 }
 
-// GetAdvancedSecurityEnabledForNewRepositories returns the AdvancedSecurityEnabledForNewRepositories field if it's non-nil, zero value otherwise.
-func (e *EnterpriseSecurityAnalysisSettings) GetAdvancedSecurityEnabledForNewRepositories() bool {
-	if e == nil || e.AdvancedSecurityEnabledForNewRepositories == nil {
-		return false
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependencyVulnerability) GetSeverity() string {
+	if d == nil || d.Severity == nil {
+		return ""
 	}
-	return *e.AdvancedSecurityEnabledForNewRepositories
+	return *d.Severity
 }
 
-// GetSecretScanningEnabledForNewRepositories returns the SecretScanningEnabledForNewRepositories field if it's non-nil, zero value otherwise.
-func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningEnabledForNewRepositories() bool {
-	if e == nil || e.SecretScanningEnabledForNewRepositories == nil {
-		return false
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DeployKeyEvent) GetAction() string {
+	if d == nil || d.Action == nil {
+		return ""
 	}
-	return *e.SecretScanningEnabledForNewRepositories
+	return *d.Action
 }
 
-// GetSecretScanningPushProtectionCustomLink returns the SecretScanningPushProtectionCustomLink field if it's non-nil, zero value otherwise.
-func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningPushProtectionCustomLink() string {
-	if e == nil || e.SecretScanningPushProtectionCustomLink == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (d *DeployKeyEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *e.SecretScanningPushProtectionCustomLink
+	return d.Installation
 }
 
-// GetSecretScanningPushProtectionEnabledForNewRepositories returns the SecretScanningPushProtectionEnabledForNewRepositories field if it's non-nil, zero value otherwise.
-func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningPushProtectionEnabledForNewRepositories() bool {
-	if e == nil || e.SecretScanningPushProtectionEnabledForNewRepositories == nil {
-		return false
+// GetKey returns the Key field.
+func (d *DeployKeyEvent) GetKey() *Key {
+	if d == nil {
+		return nil
 	}
-	return *e.SecretScanningPushProtectionEnabledForNewRepositories
+	return d.Key
 }
 
-// GetCanAdminsBypass returns the CanAdminsBypass field if it's non-nil, zero value otherwise.
-func (e *Environment) GetCanAdminsBypass() bool {
-	if e == nil || e.CanAdminsBypass == nil {
-		return false
+// GetOrganization returns the Organization field.
+func (d *DeployKeyEvent) GetOrganization() *Organization {
+	if d == nil {
+		return nil
 	}
-	return *e.CanAdminsBypass
+	return d.Organization
+}
+
+// GetRepo returns the Repo field.
+func (d *DeployKeyEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repo
+}
+
+// GetSender returns the Sender field.
+func (d *DeployKeyEvent) GetSender() *User {
+	if d == nil {
+		return nil
+	}
+	return d.Sender
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Environment) GetCreatedAt() Timestamp {
-	if e == nil || e.CreatedAt == nil {
+func (d *Deployment) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *e.CreatedAt
+	return *d.CreatedAt
 }
 
-// GetDeploymentBranchPolicy returns the DeploymentBranchPolicy field.
-func (e *Environment) GetDeploymentBranchPolicy() *BranchPolicy {
-	if e == nil {
+// GetCreator returns the Creator field.
+func (d *Deployment) GetCreator() *User {
+	if d == nil {
 		return nil
 	}
-	return e.DeploymentBranchPolicy
+	return d.Creator
 }
 
-// GetEnvironmentName returns the EnvironmentName field if it's non-nil, zero value otherwise.
-func (e *Environment) GetEnvironmentName() string {
-	if e == nil || e.EnvironmentName == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *e.EnvironmentName
+	return *d.Description
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (e *Environment) GetHTMLURL() string {
-	if e == nil || e.HTMLURL == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *e.HTMLURL
+	return *d.Environment
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Environment) GetID() int64 {
-	if e == nil || e.ID == nil {
+func (d *Deployment) GetID() int64 {
+	if d == nil || d.ID == nil {
 		return 0
 	}
-	return *e.ID
+	return *d.ID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (e *Environment) GetName() string {
-	if e == nil || e.Name == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *e.Name
+	return *d.NodeID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (e *Environment) GetNodeID() string {
-	if e == nil || e.NodeID == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetRef() string {
+	if d == nil || d.Ref == nil {
 		return ""
 	}
-	return *e.NodeID
+	return *d.Ref
 }
 
-// GetOwner returns the Owner field if it's non-nil, zero value otherwise.
-func (e *Environment) GetOwner() string {
-	if e == nil || e.Owner == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
 		return ""
 	}
-	return *e.Owner
+	return *d.RepositoryURL
 }
 
-// GetRepo returns the Repo field if it's non-nil, zero value otherwise.
-func (e *Environment) GetRepo() string {
-	if e == nil || e.Repo == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetSHA() string {
+	if d == nil || d.SHA == nil {
 		return ""
 	}
-	return *e.Repo
+	return *d.SHA
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (e *Environment) GetUpdatedAt() Timestamp {
-	if e == nil || e.UpdatedAt == nil {
-		return Timestamp{}
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetStatusesURL() string {
+	if d == nil || d.StatusesURL == nil {
+		return ""
 	}
-	return *e.UpdatedAt
+	return *d.StatusesURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (e *Environment) GetURL() string {
-	if e == nil || e.URL == nil {
+// GetTask returns the Task field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetTask() string {
+	if d == nil || d.Task == nil {
 		return ""
 	}
-	return *e.URL
+	return *d.Task
 }
 
-// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
-func (e *Environment) GetWaitTimer() int {
-	if e == nil || e.WaitTimer == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *e.WaitTimer
+	return *d.UpdatedAt
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (e *EnvResponse) GetTotalCount() int {
-	if e == nil || e.TotalCount == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetURL() string {
+	if d == nil || d.URL == nil {
+		return ""
 	}
-	return *e.TotalCount
+	return *d.URL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *EnvReviewers) GetID() int64 {
-	if e == nil || e.ID == nil {
+func (d *DeploymentBranchPolicy) GetID() int64 {
+	if d == nil || d.ID == nil {
 		return 0
 	}
-	return *e.ID
+	return *d.ID
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (e *EnvReviewers) GetType() string {
-	if e == nil || e.Type == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicy) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *e.Type
+	return *d.Name
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *ErrorBlock) GetCreatedAt() Timestamp {
-	if e == nil || e.CreatedAt == nil {
-		return Timestamp{}
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicy) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
+		return ""
 	}
-	return *e.CreatedAt
+	return *d.NodeID
 }
 
-// GetBlock returns the Block field.
-func (e *ErrorResponse) GetBlock() *ErrorBlock {
-	if e == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicy) GetType() string {
+	if d == nil || d.Type == nil {
+		return ""
 	}
-	return e.Block
+	return *d.Type
 }
 
-// GetActor returns the Actor field.
-func (e *Event) GetActor() *User {
-	if e == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicyRequest) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return e.Actor
+	return *d.Name
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Event) GetCreatedAt() Timestamp {
-	if e == nil || e.CreatedAt == nil {
-		return Timestamp{}
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicyRequest) GetType() string {
+	if d == nil || d.Type == nil {
+		return ""
 	}
-	return *e.CreatedAt
+	return *d.Type
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Event) GetID() string {
-	if e == nil || e.ID == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicyResponse) GetTotalCount() int {
+	if d == nil || d.TotalCount == nil {
+		return 0
 	}
-	return *e.ID
+	return *d.TotalCount
 }
 
-// GetOrg returns the Org field.
-func (e *Event) GetOrg() *Organization {
-	if e == nil {
+// GetDeployment returns the Deployment field.
+func (d *DeploymentEvent) GetDeployment() *Deployment {
+	if d == nil {
 		return nil
 	}
-	return e.Org
+	return d.Deployment
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (e *Event) GetPublic() bool {
-	if e == nil || e.Public == nil {
-		return false
+// GetInstallation returns the Installation field.
+func (d *DeploymentEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *e.Public
+	return d.Installation
 }
 
-// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
-func (e *Event) GetRawPayload() json.RawMessage {
-	if e == nil || e.RawPayload == nil {
-		return json.RawMessage{}
+// GetOrg returns the Org field.
+func (d *DeploymentEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
 	}
-	return *e.RawPayload
+	return d.Org
 }
 
 // GetRepo returns the Repo field.
-func (e *Event) GetRepo() *Repository {
-	if e == nil {
+func (d *DeploymentEvent) GetRepo() *Repository {
+	if d == nil {
 		return nil
 	}
-	return e.Repo
+	return d.Repo
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (e *Event) GetType() string {
-	if e == nil || e.Type == nil {
+// GetSender returns the Sender field.
+func (d *DeploymentEvent) GetSender() *User {
+	if d == nil {
+		return nil
+	}
+	return d.Sender
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DeploymentProtectionRuleEvent) GetAction() string {
+	if d == nil || d.Action == nil {
 		return ""
 	}
-	return *e.Type
+	return *d.Action
 }
 
-// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
-func (e *ExternalGroup) GetGroupID() int64 {
-	if e == nil || e.GroupID == nil {
-		return 0
+// GetDeployment returns the Deployment field.
+func (d *DeploymentProtectionRuleEvent) GetDeployment() *Deployment {
+	if d == nil {
+		return nil
 	}
-	return *e.GroupID
+	return d.Deployment
 }
 
-// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
-func (e *ExternalGroup) GetGroupName() string {
-	if e == nil || e.GroupName == nil {
+// GetDeploymentCallbackURL returns the DeploymentCallbackURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentProtectionRuleEvent) GetDeploymentCallbackURL() string {
+	if d == nil || d.DeploymentCallbackURL == nil {
 		return ""
 	}
-	return *e.GroupName
+	return *d.DeploymentCallbackURL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (e *ExternalGroup) GetUpdatedAt() Timestamp {
-	if e == nil || e.UpdatedAt == nil {
-		return Timestamp{}
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentProtectionRuleEvent) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
+		return ""
 	}
-	return *e.UpdatedAt
+	return *d.Environment
 }
 
-// GetMemberEmail returns the MemberEmail field if it's non-nil, zero value otherwise.
-func (e *ExternalGroupMember) GetMemberEmail() string {
-	if e == nil || e.MemberEmail == nil {
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (d *DeploymentProtectionRuleEvent) GetEvent() string {
+	if d == nil || d.Event == nil {
 		return ""
 	}
-	return *e.MemberEmail
+	return *d.Event
 }
 
-// GetMemberID returns the MemberID field if it's non-nil, zero value otherwise.
-func (e *ExternalGroupMember) GetMemberID() int64 {
-	if e == nil || e.MemberID == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (d *DeploymentProtectionRuleEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *e.MemberID
+	return d.Installation
 }
 
-// GetMemberLogin returns the MemberLogin field if it's non-nil, zero value otherwise.
-func (e *ExternalGroupMember) GetMemberLogin() string {
-	if e == nil || e.MemberLogin == nil {
-		return ""
+// GetOrg returns the Org field.
+func (d *DeploymentProtectionRuleEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
 	}
-	return *e.MemberLogin
+	return d.Org
 }
 
-// GetMemberName returns the MemberName field if it's non-nil, zero value otherwise.
-func (e *ExternalGroupMember) GetMemberName() string {
-	if e == nil || e.MemberName == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (d *DeploymentProtectionRuleEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *e.MemberName
+	return d.Repo
 }
 
-// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
-func (e *ExternalGroupTeam) GetTeamID() int64 {
-	if e == nil || e.TeamID == nil {
-		return 0
+// GetSender returns the Sender field.
+func (d *DeploymentProtectionRuleEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *e.TeamID
+	return d.Sender
 }
 
-// GetTeamName returns the TeamName field if it's non-nil, zero value otherwise.
-func (e *ExternalGroupTeam) GetTeamName() string {
-	if e == nil || e.TeamName == nil {
-		return ""
+// GetAutoMerge returns the AutoMerge field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetAutoMerge() bool {
+	if d == nil || d.AutoMerge == nil {
+		return false
 	}
-	return *e.TeamName
+	return *d.AutoMerge
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (f *FeedLink) GetHRef() string {
-	if f == nil || f.HRef == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *f.HRef
+	return *d.Description
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (f *FeedLink) GetType() string {
-	if f == nil || f.Type == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *f.Type
+	return *d.Environment
 }
 
-// GetCurrentUser returns the CurrentUser field.
-func (f *FeedLinks) GetCurrentUser() *FeedLink {
-	if f == nil {
-		return nil
+// GetProductionEnvironment returns the ProductionEnvironment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetProductionEnvironment() bool {
+	if d == nil || d.ProductionEnvironment == nil {
+		return false
 	}
-	return f.CurrentUser
+	return *d.ProductionEnvironment
 }
 
-// GetCurrentUserActor returns the CurrentUserActor field.
-func (f *FeedLinks) GetCurrentUserActor() *FeedLink {
-	if f == nil {
-		return nil
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetRef() string {
+	if d == nil || d.Ref == nil {
+		return ""
 	}
-	return f.CurrentUserActor
+	return *d.Ref
 }
 
-// GetCurrentUserOrganization returns the CurrentUserOrganization field.
-func (f *FeedLinks) GetCurrentUserOrganization() *FeedLink {
-	if f == nil {
+// GetRequiredContexts returns the RequiredContexts field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetRequiredContexts() []string {
+	if d == nil || d.RequiredContexts == nil {
 		return nil
 	}
-	return f.CurrentUserOrganization
+	return *d.RequiredContexts
 }
 
-// GetCurrentUserPublic returns the CurrentUserPublic field.
-func (f *FeedLinks) GetCurrentUserPublic() *FeedLink {
-	if f == nil {
-		return nil
+// GetTask returns the Task field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetTask() string {
+	if d == nil || d.Task == nil {
+		return ""
 	}
-	return f.CurrentUserPublic
+	return *d.Task
 }
 
-// GetTimeline returns the Timeline field.
-func (f *FeedLinks) GetTimeline() *FeedLink {
-	if f == nil {
-		return nil
+// GetTransientEnvironment returns the TransientEnvironment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetTransientEnvironment() bool {
+	if d == nil || d.TransientEnvironment == nil {
+		return false
 	}
-	return f.Timeline
+	return *d.TransientEnvironment
 }
 
-// GetUser returns the User field.
-func (f *FeedLinks) GetUser() *FeedLink {
-	if f == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *d.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (d *DeploymentStatus) GetCreator() *User {
+	if d == nil {
 		return nil
 	}
-	return f.User
+	return d.Creator
 }
 
-// GetCurrentUserActorURL returns the CurrentUserActorURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserActorURL() string {
-	if f == nil || f.CurrentUserActorURL == nil {
+// GetDeploymentURL returns the DeploymentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetDeploymentURL() string {
+	if d == nil || d.DeploymentURL == nil {
 		return ""
 	}
-	return *f.CurrentUserActorURL
+	return *d.DeploymentURL
 }
 
-// GetCurrentUserOrganizationURL returns the CurrentUserOrganizationURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserOrganizationURL() string {
-	if f == nil || f.CurrentUserOrganizationURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *f.CurrentUserOrganizationURL
+	return *d.Description
 }
 
-// GetCurrentUserPublicURL returns the CurrentUserPublicURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserPublicURL() string {
-	if f == nil || f.CurrentUserPublicURL == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *f.CurrentUserPublicURL
+	return *d.Environment
 }
 
-// GetCurrentUserURL returns the CurrentUserURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserURL() string {
-	if f == nil || f.CurrentUserURL == nil {
+// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetEnvironmentURL() string {
+	if d == nil || d.EnvironmentURL == nil {
 		return ""
 	}
-	return *f.CurrentUserURL
+	return *d.EnvironmentURL
 }
 
-// GetLinks returns the Links field.
-func (f *Feeds) GetLinks() *FeedLinks {
-	if f == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return f.Links
+	return *d.ID
 }
 
-// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetTimelineURL() string {
-	if f == nil || f.TimelineURL == nil {
+// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetLogURL() string {
+	if d == nil || d.LogURL == nil {
 		return ""
 	}
-	return *f.TimelineURL
+	return *d.LogURL
 }
 
-// GetUserURL returns the UserURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetUserURL() string {
-	if f == nil || f.UserURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *f.UserURL
+	return *d.NodeID
 }
 
-// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
-func (f *FirstPatchedVersion) GetIdentifier() string {
-	if f == nil || f.Identifier == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
 		return ""
 	}
-	return *f.Identifier
+	return *d.RepositoryURL
 }
 
-// GetForkee returns the Forkee field.
-func (f *ForkEvent) GetForkee() *Repository {
-	if f == nil {
-		return nil
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
 	}
-	return f.Forkee
+	return *d.State
 }
 
-// GetInstallation returns the Installation field.
-func (f *ForkEvent) GetInstallation() *Installation {
-	if f == nil {
-		return nil
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetTargetURL() string {
+	if d == nil || d.TargetURL == nil {
+		return ""
 	}
-	return f.Installation
+	return *d.TargetURL
 }
 
-// GetRepo returns the Repo field.
-func (f *ForkEvent) GetRepo() *Repository {
-	if f == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return f.Repo
+	return *d.UpdatedAt
 }
 
-// GetSender returns the Sender field.
-func (f *ForkEvent) GetSender() *User {
-	if f == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetURL() string {
+	if d == nil || d.URL == nil {
+		return ""
 	}
-	return f.Sender
+	return *d.URL
 }
 
-// GetPreviousTagName returns the PreviousTagName field if it's non-nil, zero value otherwise.
-func (g *GenerateNotesOptions) GetPreviousTagName() string {
-	if g == nil || g.PreviousTagName == nil {
-		return ""
+// GetDeployment returns the Deployment field.
+func (d *DeploymentStatusEvent) GetDeployment() *Deployment {
+	if d == nil {
+		return nil
 	}
-	return *g.PreviousTagName
+	return d.Deployment
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (g *GenerateNotesOptions) GetTargetCommitish() string {
-	if g == nil || g.TargetCommitish == nil {
-		return ""
+// GetDeploymentStatus returns the DeploymentStatus field.
+func (d *DeploymentStatusEvent) GetDeploymentStatus() *DeploymentStatus {
+	if d == nil {
+		return nil
 	}
-	return *g.TargetCommitish
+	return d.DeploymentStatus
 }
 
-// GetInclude returns the Include field if it's non-nil, zero value otherwise.
-func (g *GetAuditLogOptions) GetInclude() string {
-	if g == nil || g.Include == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (d *DeploymentStatusEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *g.Include
+	return d.Installation
 }
 
-// GetOrder returns the Order field if it's non-nil, zero value otherwise.
-func (g *GetAuditLogOptions) GetOrder() string {
-	if g == nil || g.Order == nil {
-		return ""
+// GetOrg returns the Org field.
+func (d *DeploymentStatusEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
 	}
-	return *g.Order
+	return d.Org
 }
 
-// GetPhrase returns the Phrase field if it's non-nil, zero value otherwise.
-func (g *GetAuditLogOptions) GetPhrase() string {
-	if g == nil || g.Phrase == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (d *DeploymentStatusEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *g.Phrase
+	return d.Repo
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (g *Gist) GetComments() int {
-	if g == nil || g.Comments == nil {
-		return 0
+// GetSender returns the Sender field.
+func (d *DeploymentStatusEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.Comments
+	return d.Sender
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *Gist) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
-		return Timestamp{}
+// GetAutoInactive returns the AutoInactive field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetAutoInactive() bool {
+	if d == nil || d.AutoInactive == nil {
+		return false
 	}
-	return *g.CreatedAt
+	return *d.AutoInactive
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (g *Gist) GetDescription() string {
-	if g == nil || g.Description == nil {
+func (d *DeploymentStatusRequest) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *g.Description
-}
-
-// GetFiles returns the Files map if it's non-nil, an empty map otherwise.
-func (g *Gist) GetFiles() map[GistFilename]GistFile {
-	if g == nil || g.Files == nil {
-		return map[GistFilename]GistFile{}
-	}
-	return g.Files
+	return *d.Description
 }
 
-// GetGitPullURL returns the GitPullURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetGitPullURL() string {
-	if g == nil || g.GitPullURL == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *g.GitPullURL
+	return *d.Environment
 }
 
-// GetGitPushURL returns the GitPushURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetGitPushURL() string {
-	if g == nil || g.GitPushURL == nil {
+// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetEnvironmentURL() string {
+	if d == nil || d.EnvironmentURL == nil {
 		return ""
 	}
-	return *g.GitPushURL
+	return *d.EnvironmentURL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetHTMLURL() string {
-	if g == nil || g.HTMLURL == nil {
+// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetLogURL() string {
+	if d == nil || d.LogURL == nil {
 		return ""
 	}
-	return *g.HTMLURL
+	return *d.LogURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Gist) GetID() string {
-	if g == nil || g.ID == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *g.ID
+	return *d.State
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *Gist) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetActiveLockReason() string {
+	if d == nil || d.ActiveLockReason == nil {
 		return ""
 	}
-	return *g.NodeID
+	return *d.ActiveLockReason
 }
 
-// GetOwner returns the Owner field.
-func (g *Gist) GetOwner() *User {
-	if g == nil {
-		return nil
+// GetAnswerChosenAt returns the AnswerChosenAt field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetAnswerChosenAt() Timestamp {
+	if d == nil || d.AnswerChosenAt == nil {
+		return Timestamp{}
 	}
-	return g.Owner
+	return *d.AnswerChosenAt
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (g *Gist) GetPublic() bool {
-	if g == nil || g.Public == nil {
-		return false
+// GetAnswerChosenBy returns the AnswerChosenBy field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetAnswerChosenBy() string {
+	if d == nil || d.AnswerChosenBy == nil {
+		return ""
 	}
-	return *g.Public
+	return *d.AnswerChosenBy
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *Gist) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
-		return Timestamp{}
+// GetAnswerHTMLURL returns the AnswerHTMLURL field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetAnswerHTMLURL() string {
+	if d == nil || d.AnswerHTMLURL == nil {
+		return ""
 	}
-	return *g.UpdatedAt
+	return *d.AnswerHTMLURL
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetBody() string {
-	if g == nil || g.Body == nil {
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetAuthorAssociation() string {
+	if d == nil || d.AuthorAssociation == nil {
 		return ""
 	}
-	return *g.Body
+	return *d.AuthorAssociation
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
-		return Timestamp{}
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
 	}
-	return *g.CreatedAt
+	return *d.Body
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetID() int64 {
-	if g == nil || g.ID == nil {
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetComments() int {
+	if d == nil || d.Comments == nil {
 		return 0
 	}
-	return *g.ID
+	return *d.Comments
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.URL
+	return *d.CreatedAt
 }
 
-// GetUser returns the User field.
-func (g *GistComment) GetUser() *User {
-	if g == nil {
+// GetDiscussionCategory returns the DiscussionCategory field.
+func (d *Discussion) GetDiscussionCategory() *DiscussionCategory {
+	if d == nil {
 		return nil
 	}
-	return g.User
+	return d.DiscussionCategory
 }
 
-// GetChangeStatus returns the ChangeStatus field.
-func (g *GistCommit) GetChangeStatus() *CommitStats {
-	if g == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
+		return ""
 	}
-	return g.ChangeStatus
+	return *d.HTMLURL
 }
 
-// GetCommittedAt returns the CommittedAt field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetCommittedAt() Timestamp {
-	if g == nil || g.CommittedAt == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *g.CommittedAt
+	return *d.ID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
-		return ""
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetLocked() bool {
+	if d == nil || d.Locked == nil {
+		return false
 	}
-	return *g.NodeID
+	return *d.Locked
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *g.URL
+	return *d.NodeID
 }
 
-// GetUser returns the User field.
-func (g *GistCommit) GetUser() *User {
-	if g == nil {
-		return nil
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return g.User
+	return *d.Number
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetVersion() string {
-	if g == nil || g.Version == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
 		return ""
 	}
-	return *g.Version
+	return *d.RepositoryURL
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetContent() string {
-	if g == nil || g.Content == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *g.Content
+	return *d.State
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetFilename() string {
-	if g == nil || g.Filename == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetTitle() string {
+	if d == nil || d.Title == nil {
 		return ""
 	}
-	return *g.Filename
+	return *d.Title
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetLanguage() string {
-	if g == nil || g.Language == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Language
+	return *d.UpdatedAt
 }
 
-// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetRawURL() string {
-	if g == nil || g.RawURL == nil {
-		return ""
+// GetUser returns the User field.
+func (d *Discussion) GetUser() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.RawURL
+	return d.User
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetSize() int {
-	if g == nil || g.Size == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Size
+	return *d.CreatedAt
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetType() string {
-	if g == nil || g.Type == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *g.Type
+	return *d.Description
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
-		return Timestamp{}
+// GetEmoji returns the Emoji field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetEmoji() string {
+	if d == nil || d.Emoji == nil {
+		return ""
 	}
-	return *g.CreatedAt
+	return *d.Emoji
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetID() string {
-	if g == nil || g.ID == nil {
-		return ""
+func (d *DiscussionCategory) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *g.ID
+	return *d.ID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
-		return ""
+// GetIsAnswerable returns the IsAnswerable field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetIsAnswerable() bool {
+	if d == nil || d.IsAnswerable == nil {
+		return false
 	}
-	return *g.NodeID
+	return *d.IsAnswerable
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
-		return Timestamp{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return *g.UpdatedAt
+	return *d.Name
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *g.URL
+	return *d.NodeID
 }
 
-// GetUser returns the User field.
-func (g *GistFork) GetUser() *User {
-	if g == nil {
-		return nil
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetRepositoryID() int64 {
+	if d == nil || d.RepositoryID == nil {
+		return 0
 	}
-	return g.User
+	return *d.RepositoryID
 }
 
-// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetPrivateGists() int {
-	if g == nil || g.PrivateGists == nil {
-		return 0
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetSlug() string {
+	if d == nil || d.Slug == nil {
+		return ""
 	}
-	return *g.PrivateGists
+	return *d.Slug
 }
 
-// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetPublicGists() int {
-	if g == nil || g.PublicGists == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.PublicGists
+	return *d.UpdatedAt
 }
 
-// GetTotalGists returns the TotalGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetTotalGists() int {
-	if g == nil || g.TotalGists == nil {
-		return 0
+// GetAuthor returns the Author field.
+func (d *DiscussionComment) GetAuthor() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.TotalGists
+	return d.Author
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (g *GitHubAppAuthorizationEvent) GetAction() string {
-	if g == nil || g.Action == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBody() string {
+	if d == nil || d.Body == nil {
 		return ""
 	}
-	return *g.Action
+	return *d.Body
 }
 
-// GetInstallation returns the Installation field.
-func (g *GitHubAppAuthorizationEvent) GetInstallation() *Installation {
-	if g == nil {
-		return nil
+// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBodyHTML() string {
+	if d == nil || d.BodyHTML == nil {
+		return ""
 	}
-	return g.Installation
+	return *d.BodyHTML
 }
 
-// GetSender returns the Sender field.
-func (g *GitHubAppAuthorizationEvent) GetSender() *User {
-	if g == nil {
-		return nil
+// GetBodyVersion returns the BodyVersion field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBodyVersion() string {
+	if d == nil || d.BodyVersion == nil {
+		return ""
 	}
-	return g.Sender
+	return *d.BodyVersion
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *Gitignore) GetName() string {
-	if g == nil || g.Name == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Name
+	return *d.CreatedAt
 }
 
-// GetSource returns the Source field if it's non-nil, zero value otherwise.
-func (g *Gitignore) GetSource() string {
-	if g == nil || g.Source == nil {
+// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetDiscussionURL() string {
+	if d == nil || d.DiscussionURL == nil {
 		return ""
 	}
-	return *g.Source
+	return *d.DiscussionURL
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetSHA() string {
-	if g == nil || g.SHA == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
 		return ""
 	}
-	return *g.SHA
+	return *d.HTMLURL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetType() string {
-	if g == nil || g.Type == nil {
-		return ""
+// GetLastEditedAt returns the LastEditedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetLastEditedAt() Timestamp {
+	if d == nil || d.LastEditedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Type
+	return *d.LastEditedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *g.URL
+	return *d.NodeID
 }
 
-// GetInstallation returns the Installation field.
-func (g *GollumEvent) GetInstallation() *Installation {
-	if g == nil {
-		return nil
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return g.Installation
+	return *d.Number
 }
 
-// GetRepo returns the Repo field.
-func (g *GollumEvent) GetRepo() *Repository {
-	if g == nil {
+// GetReactions returns the Reactions field.
+func (d *DiscussionComment) GetReactions() *Reactions {
+	if d == nil {
 		return nil
 	}
-	return g.Repo
+	return d.Reactions
 }
 
-// GetSender returns the Sender field.
-func (g *GollumEvent) GetSender() *User {
-	if g == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return g.Sender
+	return *d.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetURL() string {
+	if d == nil || d.URL == nil {
+		return ""
+	}
+	return *d.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DiscussionCommentEvent) GetAction() string {
+	if d == nil || d.Action == nil {
+		return ""
+	}
+	return *d.Action
+}
+
+// GetComment returns the Comment field.
+func (d *DiscussionCommentEvent) GetComment() *CommentDiscussion {
+	if d == nil {
+		return nil
+	}
+	return d.Comment
+}
+
+// GetDiscussion returns the Discussion field.
+func (d *DiscussionCommentEvent) GetDiscussion() *Discussion {
+	if d == nil {
+		return nil
+	}
+	return d.Discussion
+}
+
+// GetInstallation returns the Installation field.
+func (d *DiscussionCommentEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
+	}
+	return d.Installation
+}
+
+// GetOrg returns the Org field.
+func (d *DiscussionCommentEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
+	}
+	return d.Org
+}
+
+// GetRepo returns the Repo field.
+func (d *DiscussionCommentEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repo
+}
+
+// GetSender returns the Sender field.
+func (d *DiscussionCommentEvent) GetSender() *User {
+	if d == nil {
+		return nil
+	}
+	return d.Sender
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DiscussionEvent) GetAction() string {
+	if d == nil || d.Action == nil {
+		return ""
+	}
+	return *d.Action
+}
+
+// GetDiscussion returns the Discussion field.
+func (d *DiscussionEvent) GetDiscussion() *Discussion {
+	if d == nil {
+		return nil
+	}
+	return d.Discussion
+}
+
+// GetInstallation returns the Installation field.
+func (d *DiscussionEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
+	}
+	return d.Installation
+}
+
+// GetOrg returns the Org field.
+func (d *DiscussionEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
+	}
+	return d.Org
+}
+
+// GetRepo returns the Repo field.
+func (d *DiscussionEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repo
+}
+
+// GetSender returns the Sender field.
+func (d *DiscussionEvent) GetSender() *User {
+	if d == nil {
+		return nil
+	}
+	return d.Sender
+}
+
+// GetApps returns the Apps field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetApps() []string {
+	if d == nil || d.Apps == nil {
+		return nil
+	}
+	return *d.Apps
+}
+
+// GetTeams returns the Teams field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetTeams() []string {
+	if d == nil || d.Teams == nil {
+		return nil
+	}
+	return *d.Teams
+}
+
+// GetUsers returns the Users field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetUsers() []string {
+	if d == nil || d.Users == nil {
+		return nil
+	}
+	return *d.Users
+}
+
+// GetDismissalCommitID returns the DismissalCommitID field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetDismissalCommitID() string {
+	if d == nil || d.DismissalCommitID == nil {
+		return ""
+	}
+	return *d.DismissalCommitID
+}
+
+// GetDismissalMessage returns the DismissalMessage field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetDismissalMessage() string {
+	if d == nil || d.DismissalMessage == nil {
+		return ""
+	}
+	return *d.DismissalMessage
+}
+
+// GetReviewID returns the ReviewID field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetReviewID() int64 {
+	if d == nil || d.ReviewID == nil {
+		return 0
+	}
+	return *d.ReviewID
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
+	}
+	return *d.State
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (d *DismissStaleReviewsOnPushChanges) GetFrom() bool {
+	if d == nil || d.From == nil {
+		return false
+	}
+	return *d.From
+}
+
+// GetClientPayload returns the ClientPayload field if it's non-nil, zero value otherwise.
+func (d *DispatchRequestOptions) GetClientPayload() json.RawMessage {
+	if d == nil || d.ClientPayload == nil {
+		return json.RawMessage{}
+	}
+	return *d.ClientPayload
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
+	}
+	return *d.Body
+}
+
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetLine() int {
+	if d == nil || d.Line == nil {
+		return 0
+	}
+	return *d.Line
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetPath() string {
+	if d == nil || d.Path == nil {
+		return ""
+	}
+	return *d.Path
+}
+
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetPosition() int {
+	if d == nil || d.Position == nil {
+		return 0
+	}
+	return *d.Position
+}
+
+// GetSide returns the Side field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetSide() string {
+	if d == nil || d.Side == nil {
+		return ""
+	}
+	return *d.Side
+}
+
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetStartLine() int {
+	if d == nil || d.StartLine == nil {
+		return 0
+	}
+	return *d.StartLine
+}
+
+// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetStartSide() string {
+	if d == nil || d.StartSide == nil {
+		return ""
+	}
+	return *d.StartSide
+}
+
+// GetRef returns the Ref field.
+func (e *EditBase) GetRef() *EditRef {
+	if e == nil {
+		return nil
+	}
+	return e.Ref
+}
+
+// GetSHA returns the SHA field.
+func (e *EditBase) GetSHA() *EditSHA {
+	if e == nil {
+		return nil
+	}
+	return e.SHA
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (e *EditBody) GetFrom() string {
+	if e == nil || e.From == nil {
+		return ""
+	}
+	return *e.From
+}
+
+// GetBase returns the Base field.
+func (e *EditChange) GetBase() *EditBase {
+	if e == nil {
+		return nil
+	}
+	return e.Base
+}
+
+// GetBody returns the Body field.
+func (e *EditChange) GetBody() *EditBody {
+	if e == nil {
+		return nil
+	}
+	return e.Body
+}
+
+// GetRepo returns the Repo field.
+func (e *EditChange) GetRepo() *EditRepo {
+	if e == nil {
+		return nil
+	}
+	return e.Repo
+}
+
+// GetTitle returns the Title field.
+func (e *EditChange) GetTitle() *EditTitle {
+	if e == nil {
+		return nil
+	}
+	return e.Title
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (e *EditRef) GetFrom() string {
+	if e == nil || e.From == nil {
+		return ""
+	}
+	return *e.From
+}
+
+// GetName returns the Name field.
+func (e *EditRepo) GetName() *RepoName {
+	if e == nil {
+		return nil
+	}
+	return e.Name
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (e *EditSHA) GetFrom() string {
+	if e == nil || e.From == nil {
+		return ""
+	}
+	return *e.From
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (e *EditTitle) GetFrom() string {
+	if e == nil || e.From == nil {
+		return ""
+	}
+	return *e.From
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetAvatarURL() string {
+	if e == nil || e.AvatarURL == nil {
+		return ""
+	}
+	return *e.AvatarURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetDescription() string {
+	if e == nil || e.Description == nil {
+		return ""
+	}
+	return *e.Description
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetHTMLURL() string {
+	if e == nil || e.HTMLURL == nil {
+		return ""
+	}
+	return *e.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetID() int {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+	return *e.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetNodeID() string {
+	if e == nil || e.NodeID == nil {
+		return ""
+	}
+	return *e.NodeID
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetSlug() string {
+	if e == nil || e.Slug == nil {
+		return ""
+	}
+	return *e.Slug
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetUpdatedAt() Timestamp {
+	if e == nil || e.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.UpdatedAt
+}
+
+// GetWebsiteURL returns the WebsiteURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetWebsiteURL() string {
+	if e == nil || e.WebsiteURL == nil {
+		return ""
+	}
+	return *e.WebsiteURL
+}
+
+// GetTotalSeatsConsumed returns the TotalSeatsConsumed field if it's non-nil, zero value otherwise.
+func (e *EnterpriseConsumedLicenses) GetTotalSeatsConsumed() int {
+	if e == nil || e.TotalSeatsConsumed == nil {
+		return 0
+	}
+	return *e.TotalSeatsConsumed
+}
+
+// GetTotalSeatsPurchased returns the TotalSeatsPurchased field if it's non-nil, zero value otherwise.
+func (e *EnterpriseConsumedLicenses) GetTotalSeatsPurchased() int {
+	if e == nil || e.TotalSeatsPurchased == nil {
+		return 0
+	}
+	return *e.TotalSeatsPurchased
+}
+
+// GetEnterpriseServerUser returns the EnterpriseServerUser field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetEnterpriseServerUser() bool {
+	if e == nil || e.EnterpriseServerUser == nil {
+		return false
+	}
+	return *e.EnterpriseServerUser
+}
+
+// GetGitHubComLogin returns the GitHubComLogin field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetGitHubComLogin() string {
+	if e == nil || e.GitHubComLogin == nil {
+		return ""
+	}
+	return *e.GitHubComLogin
+}
+
+// GetGitHubComName returns the GitHubComName field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetGitHubComName() string {
+	if e == nil || e.GitHubComName == nil {
+		return ""
+	}
+	return *e.GitHubComName
+}
+
+// GetGitHubComProfile returns the GitHubComProfile field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetGitHubComProfile() string {
+	if e == nil || e.GitHubComProfile == nil {
+		return ""
+	}
+	return *e.GitHubComProfile
+}
+
+// GetGitHubComSAMLNameID returns the GitHubComSAMLNameID field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetGitHubComSAMLNameID() string {
+	if e == nil || e.GitHubComSAMLNameID == nil {
+		return ""
+	}
+	return *e.GitHubComSAMLNameID
+}
+
+// GetGitHubComTwoFactorAuth returns the GitHubComTwoFactorAuth field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetGitHubComTwoFactorAuth() bool {
+	if e == nil || e.GitHubComTwoFactorAuth == nil {
+		return false
+	}
+	return *e.GitHubComTwoFactorAuth
+}
+
+// GetGitHubComUser returns the GitHubComUser field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetGitHubComUser() bool {
+	if e == nil || e.GitHubComUser == nil {
+		return false
+	}
+	return *e.GitHubComUser
+}
+
+// GetLicenseType returns the LicenseType field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetLicenseType() string {
+	if e == nil || e.LicenseType == nil {
+		return ""
+	}
+	return *e.LicenseType
+}
+
+// GetTotalUserAccounts returns the TotalUserAccounts field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetTotalUserAccounts() int {
+	if e == nil || e.TotalUserAccounts == nil {
+		return 0
+	}
+	return *e.TotalUserAccounts
+}
+
+// GetVisualStudioLicenseStatus returns the VisualStudioLicenseStatus field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetVisualStudioLicenseStatus() string {
+	if e == nil || e.VisualStudioLicenseStatus == nil {
+		return ""
+	}
+	return *e.VisualStudioLicenseStatus
+}
+
+// GetVisualStudioSubscriptionEmail returns the VisualStudioSubscriptionEmail field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetVisualStudioSubscriptionEmail() string {
+	if e == nil || e.VisualStudioSubscriptionEmail == nil {
+		return ""
+	}
+	return *e.VisualStudioSubscriptionEmail
+}
+
+// GetVisualStudioSubscriptionUser returns the VisualStudioSubscriptionUser field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUser) GetVisualStudioSubscriptionUser() bool {
+	if e == nil || e.VisualStudioSubscriptionUser == nil {
+		return false
+	}
+	return *e.VisualStudioSubscriptionUser
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicenseSync) GetDate() Timestamp {
+	if e == nil || e.Date == nil {
+		return Timestamp{}
+	}
+	return *e.Date
+}
+
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicenseSync) GetError() string {
+	if e == nil || e.Error == nil {
+		return ""
+	}
+	return *e.Error
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicenseSync) GetStatus() string {
+	if e == nil || e.Status == nil {
+		return ""
+	}
+	return *e.Status
+}
+
+// GetAdvancedSecurityEnabledForNewRepositories returns the AdvancedSecurityEnabledForNewRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetAdvancedSecurityEnabledForNewRepositories() bool {
+	if e == nil || e.AdvancedSecurityEnabledForNewRepositories == nil {
+		return false
+	}
+	return *e.AdvancedSecurityEnabledForNewRepositories
+}
+
+// GetDependabotAlertsEnabledForNewRepositories returns the DependabotAlertsEnabledForNewRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetDependabotAlertsEnabledForNewRepositories() bool {
+	if e == nil || e.DependabotAlertsEnabledForNewRepositories == nil {
+		return false
+	}
+	return *e.DependabotAlertsEnabledForNewRepositories
+}
+
+// GetSecretScanningEnabledForNewRepositories returns the SecretScanningEnabledForNewRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningEnabledForNewRepositories() bool {
+	if e == nil || e.SecretScanningEnabledForNewRepositories == nil {
+		return false
+	}
+	return *e.SecretScanningEnabledForNewRepositories
+}
+
+// GetSecretScanningPushProtectionCustomLink returns the SecretScanningPushProtectionCustomLink field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningPushProtectionCustomLink() string {
+	if e == nil || e.SecretScanningPushProtectionCustomLink == nil {
+		return ""
+	}
+	return *e.SecretScanningPushProtectionCustomLink
+}
+
+// GetSecretScanningPushProtectionEnabledForNewRepositories returns the SecretScanningPushProtectionEnabledForNewRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningPushProtectionEnabledForNewRepositories() bool {
+	if e == nil || e.SecretScanningPushProtectionEnabledForNewRepositories == nil {
+		return false
+	}
+	return *e.SecretScanningPushProtectionEnabledForNewRepositories
+}
+
+// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
+func (e *EnterpriseServerInstance) GetHostname() string {
+	if e == nil || e.Hostname == nil {
+		return ""
+	}
+	return *e.Hostname
+}
+
+// GetLastSync returns the LastSync field.
+func (e *EnterpriseServerInstance) GetLastSync() *EnterpriseLicenseSync {
+	if e == nil {
+		return nil
+	}
+	return e.LastSync
+}
+
+// GetServerID returns the ServerID field if it's non-nil, zero value otherwise.
+func (e *EnterpriseServerInstance) GetServerID() string {
+	if e == nil || e.ServerID == nil {
+		return ""
+	}
+	return *e.ServerID
+}
+
+// GetCanAdminsBypass returns the CanAdminsBypass field if it's non-nil, zero value otherwise.
+func (e *Environment) GetCanAdminsBypass() bool {
+	if e == nil || e.CanAdminsBypass == nil {
+		return false
+	}
+	return *e.CanAdminsBypass
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Environment) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.CreatedAt
+}
+
+// GetDeploymentBranchPolicy returns the DeploymentBranchPolicy field.
+func (e *Environment) GetDeploymentBranchPolicy() *BranchPolicy {
+	if e == nil {
+		return nil
+	}
+	return e.DeploymentBranchPolicy
+}
+
+// GetEnvironmentName returns the EnvironmentName field if it's non-nil, zero value otherwise.
+func (e *Environment) GetEnvironmentName() string {
+	if e == nil || e.EnvironmentName == nil {
+		return ""
+	}
+	return *e.EnvironmentName
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (e *Environment) GetHTMLURL() string {
+	if e == nil || e.HTMLURL == nil {
+		return ""
+	}
+	return *e.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Environment) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+	return *e.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Environment) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (e *Environment) GetNodeID() string {
+	if e == nil || e.NodeID == nil {
+		return ""
+	}
+	return *e.NodeID
+}
+
+// GetOwner returns the Owner field if it's non-nil, zero value otherwise.
+func (e *Environment) GetOwner() string {
+	if e == nil || e.Owner == nil {
+		return ""
+	}
+	return *e.Owner
+}
+
+// GetRepo returns the Repo field if it's non-nil, zero value otherwise.
+func (e *Environment) GetRepo() string {
+	if e == nil || e.Repo == nil {
+		return ""
+	}
+	return *e.Repo
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *Environment) GetUpdatedAt() Timestamp {
+	if e == nil || e.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (e *Environment) GetURL() string {
+	if e == nil || e.URL == nil {
+		return ""
+	}
+	return *e.URL
+}
+
+// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
+func (e *Environment) GetWaitTimer() int {
+	if e == nil || e.WaitTimer == nil {
+		return 0
+	}
+	return *e.WaitTimer
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (e *EnvResponse) GetTotalCount() int {
+	if e == nil || e.TotalCount == nil {
+		return 0
+	}
+	return *e.TotalCount
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *EnvReviewers) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+	return *e.ID
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *EnvReviewers) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
+	}
+	return *e.Type
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *ErrorBlock) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.CreatedAt
+}
+
+// GetBlock returns the Block field.
+func (e *ErrorResponse) GetBlock() *ErrorBlock {
+	if e == nil {
+		return nil
+	}
+	return e.Block
+}
+
+// GetActor returns the Actor field.
+func (e *Event) GetActor() *User {
+	if e == nil {
+		return nil
+	}
+	return e.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Event) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Event) GetID() string {
+	if e == nil || e.ID == nil {
+		return ""
+	}
+	return *e.ID
+}
+
+// GetOrg returns the Org field.
+func (e *Event) GetOrg() *Organization {
+	if e == nil {
+		return nil
+	}
+	return e.Org
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (e *Event) GetPublic() bool {
+	if e == nil || e.Public == nil {
+		return false
+	}
+	return *e.Public
+}
+
+// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
+func (e *Event) GetRawPayload() json.RawMessage {
+	if e == nil || e.RawPayload == nil {
+		return json.RawMessage{}
+	}
+	return *e.RawPayload
+}
+
+// GetRepo returns the Repo field.
+func (e *Event) GetRepo() *Repository {
+	if e == nil {
+		return nil
+	}
+	return e.Repo
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *Event) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
+	}
+	return *e.Type
+}
+
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (e *ExternalGroup) GetGroupID() int64 {
+	if e == nil || e.GroupID == nil {
+		return 0
+	}
+	return *e.GroupID
+}
+
+// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
+func (e *ExternalGroup) GetGroupName() string {
+	if e == nil || e.GroupName == nil {
+		return ""
+	}
+	return *e.GroupName
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *ExternalGroup) GetUpdatedAt() Timestamp {
+	if e == nil || e.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.UpdatedAt
+}
+
+// GetMemberEmail returns the MemberEmail field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupMember) GetMemberEmail() string {
+	if e == nil || e.MemberEmail == nil {
+		return ""
+	}
+	return *e.MemberEmail
+}
+
+// GetMemberID returns the MemberID field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupMember) GetMemberID() int64 {
+	if e == nil || e.MemberID == nil {
+		return 0
+	}
+	return *e.MemberID
+}
+
+// GetMemberLogin returns the MemberLogin field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupMember) GetMemberLogin() string {
+	if e == nil || e.MemberLogin == nil {
+		return ""
+	}
+	return *e.MemberLogin
+}
+
+// GetMemberName returns the MemberName field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupMember) GetMemberName() string {
+	if e == nil || e.MemberName == nil {
+		return ""
+	}
+	return *e.MemberName
+}
+
+// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupTeam) GetTeamID() int64 {
+	if e == nil || e.TeamID == nil {
+		return 0
+	}
+	return *e.TeamID
+}
+
+// GetTeamName returns the TeamName field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupTeam) GetTeamName() string {
+	if e == nil || e.TeamName == nil {
+		return ""
+	}
+	return *e.TeamName
+}
+
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (f *FeedLink) GetHRef() string {
+	if f == nil || f.HRef == nil {
+		return ""
+	}
+	return *f.HRef
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (f *FeedLink) GetType() string {
+	if f == nil || f.Type == nil {
+		return ""
+	}
+	return *f.Type
+}
+
+// GetCurrentUser returns the CurrentUser field.
+func (f *FeedLinks) GetCurrentUser() *FeedLink {
+	if f == nil {
+		return nil
+	}
+	return f.CurrentUser
+}
+
+// GetCurrentUserActor returns the CurrentUserActor field.
+func (f *FeedLinks) GetCurrentUserActor() *FeedLink {
+	if f == nil {
+		return nil
+	}
+	return f.CurrentUserActor
+}
+
+// GetCurrentUserOrganization returns the CurrentUserOrganization field.
+func (f *FeedLinks) GetCurrentUserOrganization() *FeedLink {
+	if f == nil {
+		return nil
+	}
+	return f.CurrentUserOrganization
+}
+
+// GetCurrentUserPublic returns the CurrentUserPublic field.
+func (f *FeedLinks) GetCurrentUserPublic() *FeedLink {
+	if f == nil {
+		return nil
+	}
+	return f.CurrentUserPublic
+}
+
+// GetTimeline returns the Timeline field.
+func (f *FeedLinks) GetTimeline() *FeedLink {
+	if f == nil {
+		return nil
+	}
+	return f.Timeline
+}
+
+// GetUser returns the User field.
+func (f *FeedLinks) GetUser() *FeedLink {
+	if f == nil {
+		return nil
+	}
+	return f.User
+}
+
+// GetCurrentUserActorURL returns the CurrentUserActorURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserActorURL() string {
+	if f == nil || f.CurrentUserActorURL == nil {
+		return ""
+	}
+	return *f.CurrentUserActorURL
+}
+
+// GetCurrentUserOrganizationURL returns the CurrentUserOrganizationURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserOrganizationURL() string {
+	if f == nil || f.CurrentUserOrganizationURL == nil {
+		return ""
+	}
+	return *f.CurrentUserOrganizationURL
+}
+
+// GetCurrentUserPublicURL returns the CurrentUserPublicURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserPublicURL() string {
+	if f == nil || f.CurrentUserPublicURL == nil {
+		return ""
+	}
+	return *f.CurrentUserPublicURL
+}
+
+// GetCurrentUserURL returns the CurrentUserURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserURL() string {
+	if f == nil || f.CurrentUserURL == nil {
+		return ""
+	}
+	return *f.CurrentUserURL
+}
+
+// GetLinks returns the Links field.
+func (f *Feeds) GetLinks() *FeedLinks {
+	if f == nil {
+		return nil
+	}
+	return f.Links
+}
+
+// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetTimelineURL() string {
+	if f == nil || f.TimelineURL == nil {
+		return ""
+	}
+	return *f.TimelineURL
+}
+
+// GetUserURL returns the UserURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetUserURL() string {
+	if f == nil || f.UserURL == nil {
+		return ""
+	}
+	return *f.UserURL
+}
+
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (f *FirstPatchedVersion) GetIdentifier() string {
+	if f == nil || f.Identifier == nil {
+		return ""
+	}
+	return *f.Identifier
+}
+
+// GetForkee returns the Forkee field.
+func (f *ForkEvent) GetForkee() *Repository {
+	if f == nil {
+		return nil
+	}
+	return f.Forkee
+}
+
+// GetInstallation returns the Installation field.
+func (f *ForkEvent) GetInstallation() *Installation {
+	if f == nil {
+		return nil
+	}
+	return f.Installation
+}
+
+// GetOrg returns the Org field.
+func (f *ForkEvent) GetOrg() *Organization {
+	if f == nil {
+		return nil
+	}
+	return f.Org
+}
+
+// GetRepo returns the Repo field.
+func (f *ForkEvent) GetRepo() *Repository {
+	if f == nil {
+		return nil
+	}
+	return f.Repo
+}
+
+// GetSender returns the Sender field.
+func (f *ForkEvent) GetSender() *User {
+	if f == nil {
+		return nil
+	}
+	return f.Sender
+}
+
+// GetPreviousTagName returns the PreviousTagName field if it's non-nil, zero value otherwise.
+func (g *GenerateNotesOptions) GetPreviousTagName() string {
+	if g == nil || g.PreviousTagName == nil {
+		return ""
+	}
+	return *g.PreviousTagName
+}
+
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (g *GenerateNotesOptions) GetTargetCommitish() string {
+	if g == nil || g.TargetCommitish == nil {
+		return ""
+	}
+	return *g.TargetCommitish
+}
+
+// GetInclude returns the Include field if it's non-nil, zero value otherwise.
+func (g *GetAuditLogOptions) GetInclude() string {
+	if g == nil || g.Include == nil {
+		return ""
+	}
+	return *g.Include
+}
+
+// GetOrder returns the Order field if it's non-nil, zero value otherwise.
+func (g *GetAuditLogOptions) GetOrder() string {
+	if g == nil || g.Order == nil {
+		return ""
+	}
+	return *g.Order
+}
+
+// GetPhrase returns the Phrase field if it's non-nil, zero value otherwise.
+func (g *GetAuditLogOptions) GetPhrase() string {
+	if g == nil || g.Phrase == nil {
+		return ""
+	}
+	return *g.Phrase
+}
+
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (g *Gist) GetComments() int {
+	if g == nil || g.Comments == nil {
+		return 0
+	}
+	return *g.Comments
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *Gist) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *Gist) GetDescription() string {
+	if g == nil || g.Description == nil {
+		return ""
+	}
+	return *g.Description
+}
+
+// GetFiles returns the Files map if it's non-nil, an empty map otherwise.
+func (g *Gist) GetFiles() map[GistFilename]GistFile {
+	if g == nil || g.Files == nil {
+		return map[GistFilename]GistFile{}
+	}
+	return g.Files
+}
+
+// GetGitPullURL returns the GitPullURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetGitPullURL() string {
+	if g == nil || g.GitPullURL == nil {
+		return ""
+	}
+	return *g.GitPullURL
+}
+
+// GetGitPushURL returns the GitPushURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetGitPushURL() string {
+	if g == nil || g.GitPushURL == nil {
+		return ""
+	}
+	return *g.GitPushURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetHTMLURL() string {
+	if g == nil || g.HTMLURL == nil {
+		return ""
+	}
+	return *g.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Gist) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *Gist) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
+		return ""
+	}
+	return *g.NodeID
+}
+
+// GetOwner returns the Owner field.
+func (g *Gist) GetOwner() *User {
+	if g == nil {
+		return nil
+	}
+	return g.Owner
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (g *Gist) GetPublic() bool {
+	if g == nil || g.Public == nil {
+		return false
+	}
+	return *g.Public
+}
+
+// GetTruncated returns the Truncated field if it's non-nil, zero value otherwise.
+func (g *Gist) GetTruncated() bool {
+	if g == nil || g.Truncated == nil {
+		return false
+	}
+	return *g.Truncated
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *Gist) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.UpdatedAt
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetBody() string {
+	if g == nil || g.Body == nil {
+		return ""
+	}
+	return *g.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetUser returns the User field.
+func (g *GistComment) GetUser() *User {
+	if g == nil {
+		return nil
+	}
+	return g.User
+}
+
+// GetChangeStatus returns the ChangeStatus field.
+func (g *GistCommit) GetChangeStatus() *CommitStats {
+	if g == nil {
+		return nil
+	}
+	return g.ChangeStatus
+}
+
+// GetCommittedAt returns the CommittedAt field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetCommittedAt() Timestamp {
+	if g == nil || g.CommittedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CommittedAt
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
+		return ""
+	}
+	return *g.NodeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetUser returns the User field.
+func (g *GistCommit) GetUser() *User {
+	if g == nil {
+		return nil
+	}
+	return g.User
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetVersion() string {
+	if g == nil || g.Version == nil {
+		return ""
+	}
+	return *g.Version
+}
+
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetContent() string {
+	if g == nil || g.Content == nil {
+		return ""
+	}
+	return *g.Content
+}
+
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetFilename() string {
+	if g == nil || g.Filename == nil {
+		return ""
+	}
+	return *g.Filename
+}
+
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetLanguage() string {
+	if g == nil || g.Language == nil {
+		return ""
+	}
+	return *g.Language
+}
+
+// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetRawURL() string {
+	if g == nil || g.RawURL == nil {
+		return ""
+	}
+	return *g.RawURL
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetSize() int {
+	if g == nil || g.Size == nil {
+		return 0
+	}
+	return *g.Size
+}
+
+// GetTruncated returns the Truncated field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetTruncated() bool {
+	if g == nil || g.Truncated == nil {
+		return false
+	}
+	return *g.Truncated
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
+		return ""
+	}
+	return *g.NodeID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetUser returns the User field.
+func (g *GistFork) GetUser() *User {
+	if g == nil {
+		return nil
+	}
+	return g.User
+}
+
+// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetPrivateGists() int {
+	if g == nil || g.PrivateGists == nil {
+		return 0
+	}
+	return *g.PrivateGists
+}
+
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetPublicGists() int {
+	if g == nil || g.PublicGists == nil {
+		return 0
+	}
+	return *g.PublicGists
+}
+
+// GetTotalGists returns the TotalGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetTotalGists() int {
+	if g == nil || g.TotalGists == nil {
+		return 0
+	}
+	return *g.TotalGists
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (g *GitHubAppAuthorizationEvent) GetAction() string {
+	if g == nil || g.Action == nil {
+		return ""
+	}
+	return *g.Action
+}
+
+// GetInstallation returns the Installation field.
+func (g *GitHubAppAuthorizationEvent) GetInstallation() *Installation {
+	if g == nil {
+		return nil
+	}
+	return g.Installation
+}
+
+// GetSender returns the Sender field.
+func (g *GitHubAppAuthorizationEvent) GetSender() *User {
+	if g == nil {
+		return nil
+	}
+	return g.Sender
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *Gitignore) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (g *Gitignore) GetSource() string {
+	if g == nil || g.Source == nil {
+		return ""
+	}
+	return *g.Source
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetSHA() string {
+	if g == nil || g.SHA == nil {
+		return ""
+	}
+	return *g.SHA
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetGithubReviewedAt returns the GithubReviewedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetGithubReviewedAt() Timestamp {
+	if g == nil || g.GithubReviewedAt == nil {
+		return Timestamp{}
+	}
+	return *g.GithubReviewedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetNVDPublishedAt returns the NVDPublishedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetNVDPublishedAt() Timestamp {
+	if g == nil || g.NVDPublishedAt == nil {
+		return Timestamp{}
+	}
+	return *g.NVDPublishedAt
+}
+
+// GetRepositoryAdvisoryURL returns the RepositoryAdvisoryURL field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetRepositoryAdvisoryURL() string {
+	if g == nil || g.RepositoryAdvisoryURL == nil {
+		return ""
+	}
+	return *g.RepositoryAdvisoryURL
+}
+
+// GetSourceCodeLocation returns the SourceCodeLocation field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetSourceCodeLocation() string {
+	if g == nil || g.SourceCodeLocation == nil {
+		return ""
+	}
+	return *g.SourceCodeLocation
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetFirstPatchedVersion returns the FirstPatchedVersion field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityVulnerability) GetFirstPatchedVersion() string {
+	if g == nil || g.FirstPatchedVersion == nil {
+		return ""
+	}
+	return *g.FirstPatchedVersion
+}
+
+// GetPackage returns the Package field.
+func (g *GlobalSecurityVulnerability) GetPackage() *VulnerabilityPackage {
+	if g == nil {
+		return nil
+	}
+	return g.Package
+}
+
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityVulnerability) GetVulnerableVersionRange() string {
+	if g == nil || g.VulnerableVersionRange == nil {
+		return ""
+	}
+	return *g.VulnerableVersionRange
+}
+
+// GetInstallation returns the Installation field.
+func (g *GollumEvent) GetInstallation() *Installation {
+	if g == nil {
+		return nil
+	}
+	return g.Installation
+}
+
+// GetOrg returns the Org field.
+func (g *GollumEvent) GetOrg() *Organization {
+	if g == nil {
+		return nil
+	}
+	return g.Org
+}
+
+// GetRepo returns the Repo field.
+func (g *GollumEvent) GetRepo() *Repository {
+	if g == nil {
+		return nil
+	}
+	return g.Repo
+}
+
+// GetSender returns the Sender field.
+func (g *GollumEvent) GetSender() *User {
+	if g == nil {
+		return nil
+	}
+	return g.Sender
+}
+
+// GetBucket returns the Bucket field if it's non-nil, zero value otherwise.
+func (g *GoogleCloudStreamConfig) GetBucket() string {
+	if g == nil || g.Bucket == nil {
+		return ""
+	}
+	return *g.Bucket
+}
+
+// GetEncryptedJSONCredentials returns the EncryptedJSONCredentials field if it's non-nil, zero value otherwise.
+func (g *GoogleCloudStreamConfig) GetEncryptedJSONCredentials() string {
+	if g == nil || g.EncryptedJSONCredentials == nil {
+		return ""
+	}
+	return *g.EncryptedJSONCredentials
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (g *GoogleCloudStreamConfig) GetKeyID() string {
+	if g == nil || g.KeyID == nil {
+		return ""
+	}
+	return *g.KeyID
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (g *GPGEmail) GetEmail() string {
+	if g == nil || g.Email == nil {
+		return ""
+	}
+	return *g.Email
+}
+
+// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
+func (g *GPGEmail) GetVerified() bool {
+	if g == nil || g.Verified == nil {
+		return false
+	}
+	return *g.Verified
+}
+
+// GetCanCertify returns the CanCertify field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanCertify() bool {
+	if g == nil || g.CanCertify == nil {
+		return false
+	}
+	return *g.CanCertify
+}
+
+// GetCanEncryptComms returns the CanEncryptComms field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanEncryptComms() bool {
+	if g == nil || g.CanEncryptComms == nil {
+		return false
+	}
+	return *g.CanEncryptComms
+}
+
+// GetCanEncryptStorage returns the CanEncryptStorage field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanEncryptStorage() bool {
+	if g == nil || g.CanEncryptStorage == nil {
+		return false
+	}
+	return *g.CanEncryptStorage
+}
+
+// GetCanSign returns the CanSign field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanSign() bool {
+	if g == nil || g.CanSign == nil {
+		return false
+	}
+	return *g.CanSign
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CreatedAt
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetExpiresAt() Timestamp {
+	if g == nil || g.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *g.ExpiresAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetKeyID() string {
+	if g == nil || g.KeyID == nil {
+		return ""
+	}
+	return *g.KeyID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetPrimaryKeyID() int64 {
+	if g == nil || g.PrimaryKeyID == nil {
+		return 0
+	}
+	return *g.PrimaryKeyID
+}
+
+// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetPublicKey() string {
+	if g == nil || g.PublicKey == nil {
+		return ""
+	}
+	return *g.PublicKey
+}
+
+// GetRawKey returns the RawKey field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetRawKey() string {
+	if g == nil || g.RawKey == nil {
+		return ""
+	}
+	return *g.RawKey
+}
+
+// GetRevoked returns the Revoked field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetRevoked() bool {
+	if g == nil || g.Revoked == nil {
+		return false
+	}
+	return *g.Revoked
+}
+
+// GetApp returns the App field.
+func (g *Grant) GetApp() *AuthorizationApp {
+	if g == nil {
+		return nil
+	}
+	return g.App
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *Grant) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Grant) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *Grant) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *Grant) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetAuthor returns the Author field.
+func (h *HeadCommit) GetAuthor() *CommitAuthor {
+	if h == nil {
+		return nil
+	}
+	return h.Author
+}
+
+// GetCommitter returns the Committer field.
+func (h *HeadCommit) GetCommitter() *CommitAuthor {
+	if h == nil {
+		return nil
+	}
+	return h.Committer
+}
+
+// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetDistinct() bool {
+	if h == nil || h.Distinct == nil {
+		return false
+	}
+	return *h.Distinct
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
+	}
+	return *h.ID
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetMessage() string {
+	if h == nil || h.Message == nil {
+		return ""
+	}
+	return *h.Message
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetSHA() string {
+	if h == nil || h.SHA == nil {
+		return ""
+	}
+	return *h.SHA
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetTimestamp() Timestamp {
+	if h == nil || h.Timestamp == nil {
+		return Timestamp{}
+	}
+	return *h.Timestamp
+}
+
+// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetTreeID() string {
+	if h == nil || h.TreeID == nil {
+		return ""
+	}
+	return *h.TreeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (h *HECStreamConfig) GetDomain() string {
+	if h == nil || h.Domain == nil {
+		return ""
+	}
+	return *h.Domain
+}
+
+// GetEncryptedToken returns the EncryptedToken field if it's non-nil, zero value otherwise.
+func (h *HECStreamConfig) GetEncryptedToken() string {
+	if h == nil || h.EncryptedToken == nil {
+		return ""
+	}
+	return *h.EncryptedToken
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (h *HECStreamConfig) GetKeyID() string {
+	if h == nil || h.KeyID == nil {
+		return ""
+	}
+	return *h.KeyID
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (h *HECStreamConfig) GetPath() string {
+	if h == nil || h.Path == nil {
+		return ""
+	}
+	return *h.Path
+}
+
+// GetPort returns the Port field if it's non-nil, zero value otherwise.
+func (h *HECStreamConfig) GetPort() int {
+	if h == nil || h.Port == nil {
+		return 0
+	}
+	return *h.Port
+}
+
+// GetSSLVerify returns the SSLVerify field if it's non-nil, zero value otherwise.
+func (h *HECStreamConfig) GetSSLVerify() bool {
+	if h == nil || h.SSLVerify == nil {
+		return false
+	}
+	return *h.SSLVerify
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (h *Hook) GetActive() bool {
+	if h == nil || h.Active == nil {
+		return false
+	}
+	return *h.Active
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (h *Hook) GetCreatedAt() Timestamp {
+	if h == nil || h.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *h.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *Hook) GetID() int64 {
+	if h == nil || h.ID == nil {
+		return 0
+	}
+	return *h.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (h *Hook) GetName() string {
+	if h == nil || h.Name == nil {
+		return ""
+	}
+	return *h.Name
+}
+
+// GetPingURL returns the PingURL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetPingURL() string {
+	if h == nil || h.PingURL == nil {
+		return ""
+	}
+	return *h.PingURL
+}
+
+// GetTestURL returns the TestURL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetTestURL() string {
+	if h == nil || h.TestURL == nil {
+		return ""
+	}
+	return *h.TestURL
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (h *Hook) GetType() string {
+	if h == nil || h.Type == nil {
+		return ""
+	}
+	return *h.Type
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (h *Hook) GetUpdatedAt() Timestamp {
+	if h == nil || h.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *h.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetContentType() string {
+	if h == nil || h.ContentType == nil {
+		return ""
+	}
+	return *h.ContentType
+}
+
+// GetInsecureSSL returns the InsecureSSL field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetInsecureSSL() string {
+	if h == nil || h.InsecureSSL == nil {
+		return ""
+	}
+	return *h.InsecureSSL
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetSecret() string {
+	if h == nil || h.Secret == nil {
+		return ""
+	}
+	return *h.Secret
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetAction() string {
+	if h == nil || h.Action == nil {
+		return ""
+	}
+	return *h.Action
+}
+
+// GetDeliveredAt returns the DeliveredAt field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetDeliveredAt() Timestamp {
+	if h == nil || h.DeliveredAt == nil {
+		return Timestamp{}
+	}
+	return *h.DeliveredAt
+}
+
+// GetDuration returns the Duration field.
+func (h *HookDelivery) GetDuration() *float64 {
+	if h == nil {
+		return nil
+	}
+	return h.Duration
+}
+
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetEvent() string {
+	if h == nil || h.Event == nil {
+		return ""
+	}
+	return *h.Event
+}
+
+// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetGUID() string {
+	if h == nil || h.GUID == nil {
+		return ""
+	}
+	return *h.GUID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetID() int64 {
+	if h == nil || h.ID == nil {
+		return 0
+	}
+	return *h.ID
+}
+
+// GetInstallationID returns the InstallationID field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetInstallationID() int64 {
+	if h == nil || h.InstallationID == nil {
+		return 0
+	}
+	return *h.InstallationID
+}
+
+// GetRedelivery returns the Redelivery field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetRedelivery() bool {
+	if h == nil || h.Redelivery == nil {
+		return false
+	}
+	return *h.Redelivery
+}
+
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetRepositoryID() int64 {
+	if h == nil || h.RepositoryID == nil {
+		return 0
+	}
+	return *h.RepositoryID
+}
+
+// GetRequest returns the Request field.
+func (h *HookDelivery) GetRequest() *HookRequest {
+	if h == nil {
+		return nil
+	}
+	return h.Request
+}
+
+// GetResponse returns the Response field.
+func (h *HookDelivery) GetResponse() *HookResponse {
+	if h == nil {
+		return nil
+	}
+	return h.Response
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetStatus() string {
+	if h == nil || h.Status == nil {
+		return ""
+	}
+	return *h.Status
+}
+
+// GetStatusCode returns the StatusCode field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetStatusCode() int {
+	if h == nil || h.StatusCode == nil {
+		return 0
+	}
+	return *h.StatusCode
+}
+
+// GetHeaders returns the Headers map if it's non-nil, an empty map otherwise.
+func (h *HookRequest) GetHeaders() map[string]string {
+	if h == nil || h.Headers == nil {
+		return map[string]string{}
+	}
+	return h.Headers
+}
+
+// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
+func (h *HookRequest) GetRawPayload() json.RawMessage {
+	if h == nil || h.RawPayload == nil {
+		return json.RawMessage{}
+	}
+	return *h.RawPayload
+}
+
+// GetHeaders returns the Headers map if it's non-nil, an empty map otherwise.
+func (h *HookResponse) GetHeaders() map[string]string {
+	if h == nil || h.Headers == nil {
+		return map[string]string{}
+	}
+	return h.Headers
+}
+
+// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
+func (h *HookResponse) GetRawPayload() json.RawMessage {
+	if h == nil || h.RawPayload == nil {
+		return json.RawMessage{}
+	}
+	return *h.RawPayload
+}
+
+// GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetActiveHooks() int {
+	if h == nil || h.ActiveHooks == nil {
+		return 0
+	}
+	return *h.ActiveHooks
+}
+
+// GetInactiveHooks returns the InactiveHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetInactiveHooks() int {
+	if h == nil || h.InactiveHooks == nil {
+		return 0
+	}
+	return *h.InactiveHooks
+}
+
+// GetTotalHooks returns the TotalHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetTotalHooks() int {
+	if h == nil || h.TotalHooks == nil {
+		return 0
+	}
+	return *h.TotalHooks
+}
+
+// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupDescription() string {
+	if i == nil || i.GroupDescription == nil {
+		return ""
+	}
+	return *i.GroupDescription
+}
+
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupID() string {
+	if i == nil || i.GroupID == nil {
+		return ""
+	}
+	return *i.GroupID
+}
+
+// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupName() string {
+	if i == nil || i.GroupName == nil {
+		return ""
+	}
+	return *i.GroupName
+}
+
+// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsCount() int {
+	if i == nil || i.AuthorsCount == nil {
+		return 0
+	}
+	return *i.AuthorsCount
+}
+
+// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsURL() string {
+	if i == nil || i.AuthorsURL == nil {
+		return ""
+	}
+	return *i.AuthorsURL
+}
+
+// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetCommitCount() int {
+	if i == nil || i.CommitCount == nil {
+		return 0
+	}
+	return *i.CommitCount
+}
+
+// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
+func (i *Import) GetFailedStep() string {
+	if i == nil || i.FailedStep == nil {
+		return ""
+	}
+	return *i.FailedStep
+}
+
+// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
+func (i *Import) GetHasLargeFiles() bool {
+	if i == nil || i.HasLargeFiles == nil {
+		return false
+	}
+	return *i.HasLargeFiles
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
+func (i *Import) GetHumanName() string {
+	if i == nil || i.HumanName == nil {
+		return ""
+	}
+	return *i.HumanName
+}
+
+// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesCount() int {
+	if i == nil || i.LargeFilesCount == nil {
+		return 0
+	}
+	return *i.LargeFilesCount
+}
+
+// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesSize() int {
+	if i == nil || i.LargeFilesSize == nil {
+		return 0
+	}
+	return *i.LargeFilesSize
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *Import) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
+	}
+	return *i.Message
+}
+
+// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPercent() int {
+	if i == nil || i.Percent == nil {
+		return 0
+	}
+	return *i.Percent
+}
+
+// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPushPercent() int {
+	if i == nil || i.PushPercent == nil {
+		return 0
+	}
+	return *i.PushPercent
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatus() string {
+	if i == nil || i.Status == nil {
+		return ""
+	}
+	return *i.Status
+}
+
+// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatusText() string {
+	if i == nil || i.StatusText == nil {
+		return ""
+	}
+	return *i.StatusText
+}
+
+// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
+func (i *Import) GetTFVCProject() string {
+	if i == nil || i.TFVCProject == nil {
+		return ""
+	}
+	return *i.TFVCProject
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Import) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUseLFS returns the UseLFS field if it's non-nil, zero value otherwise.
+func (i *Import) GetUseLFS() string {
+	if i == nil || i.UseLFS == nil {
+		return ""
+	}
+	return *i.UseLFS
+}
+
+// GetVCS returns the VCS field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCS() string {
+	if i == nil || i.VCS == nil {
+		return ""
+	}
+	return *i.VCS
+}
+
+// GetVCSPassword returns the VCSPassword field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSPassword() string {
+	if i == nil || i.VCSPassword == nil {
+		return ""
+	}
+	return *i.VCSPassword
+}
+
+// GetVCSURL returns the VCSURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSURL() string {
+	if i == nil || i.VCSURL == nil {
+		return ""
+	}
+	return *i.VCSURL
+}
+
+// GetVCSUsername returns the VCSUsername field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSUsername() string {
+	if i == nil || i.VCSUsername == nil {
+		return ""
+	}
+	return *i.VCSUsername
+}
+
+// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAccessTokensURL() string {
+	if i == nil || i.AccessTokensURL == nil {
+		return ""
+	}
+	return *i.AccessTokensURL
+}
+
+// GetAccount returns the Account field.
+func (i *Installation) GetAccount() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Account
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAppID() int64 {
+	if i == nil || i.AppID == nil {
+		return 0
+	}
+	return *i.AppID
+}
+
+// GetAppSlug returns the AppSlug field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAppSlug() string {
+	if i == nil || i.AppSlug == nil {
+		return ""
+	}
+	return *i.AppSlug
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.CreatedAt
+}
+
+// GetHasMultipleSingleFiles returns the HasMultipleSingleFiles field if it's non-nil, zero value otherwise.
+func (i *Installation) GetHasMultipleSingleFiles() bool {
+	if i == nil || i.HasMultipleSingleFiles == nil {
+		return false
+	}
+	return *i.HasMultipleSingleFiles
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetPermissions returns the Permissions field.
+func (i *Installation) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositoriesURL() string {
+	if i == nil || i.RepositoriesURL == nil {
+		return ""
+	}
+	return *i.RepositoriesURL
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
+func (i *Installation) GetSingleFileName() string {
+	if i == nil || i.SingleFileName == nil {
+		return ""
+	}
+	return *i.SingleFileName
+}
+
+// GetSuspendedAt returns the SuspendedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetSuspendedAt() Timestamp {
+	if i == nil || i.SuspendedAt == nil {
+		return Timestamp{}
+	}
+	return *i.SuspendedAt
+}
+
+// GetSuspendedBy returns the SuspendedBy field.
+func (i *Installation) GetSuspendedBy() *User {
+	if i == nil {
+		return nil
+	}
+	return i.SuspendedBy
+}
+
+// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetID() int64 {
+	if i == nil || i.TargetID == nil {
+		return 0
+	}
+	return *i.TargetID
+}
+
+// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetType() string {
+	if i == nil || i.TargetType == nil {
+		return ""
+	}
+	return *i.TargetType
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetInstallation returns the Installation field.
+func (i *InstallationEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetSender returns the Sender field.
+func (i *InstallationEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetActions returns the Actions field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetActions() string {
+	if i == nil || i.Actions == nil {
+		return ""
+	}
+	return *i.Actions
+}
+
+// GetAdministration returns the Administration field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetAdministration() string {
+	if i == nil || i.Administration == nil {
+		return ""
+	}
+	return *i.Administration
+}
+
+// GetBlocking returns the Blocking field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetBlocking() string {
+	if i == nil || i.Blocking == nil {
+		return ""
+	}
+	return *i.Blocking
+}
+
+// GetChecks returns the Checks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetChecks() string {
+	if i == nil || i.Checks == nil {
+		return ""
+	}
+	return *i.Checks
+}
+
+// GetCodespaces returns the Codespaces field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetCodespaces() string {
+	if i == nil || i.Codespaces == nil {
+		return ""
+	}
+	return *i.Codespaces
+}
+
+// GetContentReferences returns the ContentReferences field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetContentReferences() string {
+	if i == nil || i.ContentReferences == nil {
+		return ""
+	}
+	return *i.ContentReferences
+}
+
+// GetContents returns the Contents field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetContents() string {
+	if i == nil || i.Contents == nil {
+		return ""
+	}
+	return *i.Contents
+}
+
+// GetDependabotSecrets returns the DependabotSecrets field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetDependabotSecrets() string {
+	if i == nil || i.DependabotSecrets == nil {
+		return ""
+	}
+	return *i.DependabotSecrets
+}
+
+// GetDeployments returns the Deployments field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetDeployments() string {
+	if i == nil || i.Deployments == nil {
+		return ""
+	}
+	return *i.Deployments
+}
+
+// GetEmailAddresses returns the EmailAddresses field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetEmailAddresses() string {
+	if i == nil || i.EmailAddresses == nil {
+		return ""
+	}
+	return *i.EmailAddresses
+}
+
+// GetEmails returns the Emails field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetEmails() string {
+	if i == nil || i.Emails == nil {
+		return ""
+	}
+	return *i.Emails
+}
+
+// GetEnvironments returns the Environments field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetEnvironments() string {
+	if i == nil || i.Environments == nil {
+		return ""
+	}
+	return *i.Environments
+}
+
+// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetFollowers() string {
+	if i == nil || i.Followers == nil {
+		return ""
+	}
+	return *i.Followers
+}
+
+// GetGitSSHKeys returns the GitSSHKeys field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetGitSSHKeys() string {
+	if i == nil || i.GitSSHKeys == nil {
+		return ""
+	}
+	return *i.GitSSHKeys
+}
+
+// GetGPGKeys returns the GPGKeys field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetGPGKeys() string {
+	if i == nil || i.GPGKeys == nil {
+		return ""
+	}
+	return *i.GPGKeys
+}
+
+// GetInteractionLimits returns the InteractionLimits field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetInteractionLimits() string {
+	if i == nil || i.InteractionLimits == nil {
+		return ""
+	}
+	return *i.InteractionLimits
+}
+
+// GetIssues returns the Issues field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetIssues() string {
+	if i == nil || i.Issues == nil {
+		return ""
+	}
+	return *i.Issues
+}
+
+// GetMembers returns the Members field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMembers() string {
+	if i == nil || i.Members == nil {
+		return ""
+	}
+	return *i.Members
+}
+
+// GetMergeQueues returns the MergeQueues field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMergeQueues() string {
+	if i == nil || i.MergeQueues == nil {
+		return ""
+	}
+	return *i.MergeQueues
+}
+
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMetadata() string {
+	if i == nil || i.Metadata == nil {
+		return ""
+	}
+	return *i.Metadata
+}
+
+// GetOrganizationAdministration returns the OrganizationAdministration field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationAdministration() string {
+	if i == nil || i.OrganizationAdministration == nil {
+		return ""
+	}
+	return *i.OrganizationAdministration
+}
+
+// GetOrganizationAnnouncementBanners returns the OrganizationAnnouncementBanners field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationAnnouncementBanners() string {
+	if i == nil || i.OrganizationAnnouncementBanners == nil {
+		return ""
+	}
+	return *i.OrganizationAnnouncementBanners
+}
+
+// GetOrganizationCopilotSeatManagement returns the OrganizationCopilotSeatManagement field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationCopilotSeatManagement() string {
+	if i == nil || i.OrganizationCopilotSeatManagement == nil {
+		return ""
+	}
+	return *i.OrganizationCopilotSeatManagement
+}
+
+// GetOrganizationCustomOrgRoles returns the OrganizationCustomOrgRoles field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationCustomOrgRoles() string {
+	if i == nil || i.OrganizationCustomOrgRoles == nil {
+		return ""
+	}
+	return *i.OrganizationCustomOrgRoles
+}
+
+// GetOrganizationCustomProperties returns the OrganizationCustomProperties field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationCustomProperties() string {
+	if i == nil || i.OrganizationCustomProperties == nil {
+		return ""
+	}
+	return *i.OrganizationCustomProperties
+}
+
+// GetOrganizationCustomRoles returns the OrganizationCustomRoles field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationCustomRoles() string {
+	if i == nil || i.OrganizationCustomRoles == nil {
+		return ""
+	}
+	return *i.OrganizationCustomRoles
+}
+
+// GetOrganizationEvents returns the OrganizationEvents field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationEvents() string {
+	if i == nil || i.OrganizationEvents == nil {
+		return ""
+	}
+	return *i.OrganizationEvents
+}
+
+// GetOrganizationHooks returns the OrganizationHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationHooks() string {
+	if i == nil || i.OrganizationHooks == nil {
+		return ""
+	}
+	return *i.OrganizationHooks
+}
+
+// GetOrganizationPackages returns the OrganizationPackages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPackages() string {
+	if i == nil || i.OrganizationPackages == nil {
+		return ""
+	}
+	return *i.OrganizationPackages
+}
+
+// GetOrganizationPersonalAccessTokenRequests returns the OrganizationPersonalAccessTokenRequests field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPersonalAccessTokenRequests() string {
+	if i == nil || i.OrganizationPersonalAccessTokenRequests == nil {
+		return ""
+	}
+	return *i.OrganizationPersonalAccessTokenRequests
+}
+
+// GetOrganizationPersonalAccessTokens returns the OrganizationPersonalAccessTokens field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPersonalAccessTokens() string {
+	if i == nil || i.OrganizationPersonalAccessTokens == nil {
+		return ""
+	}
+	return *i.OrganizationPersonalAccessTokens
+}
+
+// GetOrganizationPlan returns the OrganizationPlan field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPlan() string {
+	if i == nil || i.OrganizationPlan == nil {
+		return ""
+	}
+	return *i.OrganizationPlan
+}
+
+// GetOrganizationPreReceiveHooks returns the OrganizationPreReceiveHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPreReceiveHooks() string {
+	if i == nil || i.OrganizationPreReceiveHooks == nil {
+		return ""
+	}
+	return *i.OrganizationPreReceiveHooks
+}
+
+// GetOrganizationProjects returns the OrganizationProjects field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationProjects() string {
+	if i == nil || i.OrganizationProjects == nil {
+		return ""
+	}
+	return *i.OrganizationProjects
+}
+
+// GetOrganizationSecrets returns the OrganizationSecrets field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationSecrets() string {
+	if i == nil || i.OrganizationSecrets == nil {
+		return ""
+	}
+	return *i.OrganizationSecrets
+}
+
+// GetOrganizationSelfHostedRunners returns the OrganizationSelfHostedRunners field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationSelfHostedRunners() string {
+	if i == nil || i.OrganizationSelfHostedRunners == nil {
+		return ""
+	}
+	return *i.OrganizationSelfHostedRunners
+}
+
+// GetOrganizationUserBlocking returns the OrganizationUserBlocking field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationUserBlocking() string {
+	if i == nil || i.OrganizationUserBlocking == nil {
+		return ""
+	}
+	return *i.OrganizationUserBlocking
+}
+
+// GetPackages returns the Packages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPackages() string {
+	if i == nil || i.Packages == nil {
+		return ""
+	}
+	return *i.Packages
+}
+
+// GetPages returns the Pages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPages() string {
+	if i == nil || i.Pages == nil {
+		return ""
+	}
+	return *i.Pages
+}
+
+// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetProfile() string {
+	if i == nil || i.Profile == nil {
+		return ""
+	}
+	return *i.Profile
+}
+
+// GetPullRequests returns the PullRequests field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPullRequests() string {
+	if i == nil || i.PullRequests == nil {
+		return ""
+	}
+	return *i.PullRequests
+}
+
+// GetRepositoryCustomProperties returns the RepositoryCustomProperties field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryCustomProperties() string {
+	if i == nil || i.RepositoryCustomProperties == nil {
+		return ""
+	}
+	return *i.RepositoryCustomProperties
+}
+
+// GetRepositoryHooks returns the RepositoryHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryHooks() string {
+	if i == nil || i.RepositoryHooks == nil {
+		return ""
+	}
+	return *i.RepositoryHooks
+}
+
+// GetRepositoryPreReceiveHooks returns the RepositoryPreReceiveHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryPreReceiveHooks() string {
+	if i == nil || i.RepositoryPreReceiveHooks == nil {
+		return ""
+	}
+	return *i.RepositoryPreReceiveHooks
+}
+
+// GetRepositoryProjects returns the RepositoryProjects field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryProjects() string {
+	if i == nil || i.RepositoryProjects == nil {
+		return ""
+	}
+	return *i.RepositoryProjects
+}
+
+// GetSecrets returns the Secrets field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSecrets() string {
+	if i == nil || i.Secrets == nil {
+		return ""
+	}
+	return *i.Secrets
+}
+
+// GetSecretScanningAlerts returns the SecretScanningAlerts field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSecretScanningAlerts() string {
+	if i == nil || i.SecretScanningAlerts == nil {
+		return ""
+	}
+	return *i.SecretScanningAlerts
+}
+
+// GetSecurityEvents returns the SecurityEvents field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSecurityEvents() string {
+	if i == nil || i.SecurityEvents == nil {
+		return ""
+	}
+	return *i.SecurityEvents
+}
+
+// GetSingleFile returns the SingleFile field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSingleFile() string {
+	if i == nil || i.SingleFile == nil {
+		return ""
+	}
+	return *i.SingleFile
+}
+
+// GetStarring returns the Starring field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetStarring() string {
+	if i == nil || i.Starring == nil {
+		return ""
+	}
+	return *i.Starring
+}
+
+// GetStatuses returns the Statuses field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetStatuses() string {
+	if i == nil || i.Statuses == nil {
+		return ""
+	}
+	return *i.Statuses
+}
+
+// GetTeamDiscussions returns the TeamDiscussions field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetTeamDiscussions() string {
+	if i == nil || i.TeamDiscussions == nil {
+		return ""
+	}
+	return *i.TeamDiscussions
+}
+
+// GetVulnerabilityAlerts returns the VulnerabilityAlerts field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetVulnerabilityAlerts() string {
+	if i == nil || i.VulnerabilityAlerts == nil {
+		return ""
+	}
+	return *i.VulnerabilityAlerts
+}
+
+// GetWorkflows returns the Workflows field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetWorkflows() string {
+	if i == nil || i.Workflows == nil {
+		return ""
+	}
+	return *i.Workflows
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetInstallation returns the Installation field.
+func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetSender returns the Sender field.
+func (i *InstallationRepositoriesEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetAccount returns the Account field.
+func (i *InstallationRequest) GetAccount() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Account
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *InstallationRequest) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *InstallationRequest) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *InstallationRequest) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetRequester returns the Requester field.
+func (i *InstallationRequest) GetRequester() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Requester
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetExpiresAt() Timestamp {
+	if i == nil || i.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *i.ExpiresAt
+}
+
+// GetPermissions returns the Permissions field.
+func (i *InstallationToken) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetToken() string {
+	if i == nil || i.Token == nil {
+		return ""
+	}
+	return *i.Token
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (g *GPGEmail) GetEmail() string {
-	if g == nil || g.Email == nil {
-		return ""
+// GetPermissions returns the Permissions field.
+func (i *InstallationTokenOptions) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
 	}
-	return *g.Email
+	return i.Permissions
 }
 
-// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
-func (g *GPGEmail) GetVerified() bool {
-	if g == nil || g.Verified == nil {
-		return false
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetExpiresAt() Timestamp {
+	if i == nil || i.ExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *g.Verified
+	return *i.ExpiresAt
 }
 
-// GetCanCertify returns the CanCertify field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanCertify() bool {
-	if g == nil || g.CanCertify == nil {
-		return false
+// GetExpiry returns the Expiry field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetExpiry() string {
+	if i == nil || i.Expiry == nil {
+		return ""
 	}
-	return *g.CanCertify
+	return *i.Expiry
 }
 
-// GetCanEncryptComms returns the CanEncryptComms field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanEncryptComms() bool {
-	if g == nil || g.CanEncryptComms == nil {
-		return false
+// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetLimit() string {
+	if i == nil || i.Limit == nil {
+		return ""
 	}
-	return *g.CanEncryptComms
+	return *i.Limit
 }
 
-// GetCanEncryptStorage returns the CanEncryptStorage field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanEncryptStorage() bool {
-	if g == nil || g.CanEncryptStorage == nil {
-		return false
+// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetOrigin() string {
+	if i == nil || i.Origin == nil {
+		return ""
 	}
-	return *g.CanEncryptStorage
+	return *i.Origin
 }
 
-// GetCanSign returns the CanSign field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanSign() bool {
-	if g == nil || g.CanSign == nil {
-		return false
+// GetDigest returns the Digest map if it's non-nil, an empty map otherwise.
+func (i *InTotoSubject) GetDigest() map[string]string {
+	if i == nil || i.Digest == nil {
+		return map[string]string{}
 	}
-	return *g.CanSign
+	return i.Digest
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
+func (i *Invitation) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *g.CreatedAt
+	return *i.CreatedAt
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetExpiresAt() Timestamp {
-	if g == nil || g.ExpiresAt == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetEmail() string {
+	if i == nil || i.Email == nil {
+		return ""
+	}
+	return *i.Email
+}
+
+// GetFailedAt returns the FailedAt field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetFailedAt() Timestamp {
+	if i == nil || i.FailedAt == nil {
 		return Timestamp{}
 	}
-	return *g.ExpiresAt
+	return *i.FailedAt
+}
+
+// GetFailedReason returns the FailedReason field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetFailedReason() string {
+	if i == nil || i.FailedReason == nil {
+		return ""
+	}
+	return *i.FailedReason
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetID() int64 {
-	if g == nil || g.ID == nil {
+func (i *Invitation) GetID() int64 {
+	if i == nil || i.ID == nil {
 		return 0
 	}
-	return *g.ID
+	return *i.ID
 }
 
-// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetKeyID() string {
-	if g == nil || g.KeyID == nil {
+// GetInvitationTeamURL returns the InvitationTeamURL field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetInvitationTeamURL() string {
+	if i == nil || i.InvitationTeamURL == nil {
 		return ""
 	}
-	return *g.KeyID
+	return *i.InvitationTeamURL
 }
 
-// GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetPrimaryKeyID() int64 {
-	if g == nil || g.PrimaryKeyID == nil {
-		return 0
+// GetInviter returns the Inviter field.
+func (i *Invitation) GetInviter() *User {
+	if i == nil {
+		return nil
 	}
-	return *g.PrimaryKeyID
+	return i.Inviter
 }
 
-// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetPublicKey() string {
-	if g == nil || g.PublicKey == nil {
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetLogin() string {
+	if i == nil || i.Login == nil {
 		return ""
 	}
-	return *g.PublicKey
+	return *i.Login
 }
 
-// GetRawKey returns the RawKey field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetRawKey() string {
-	if g == nil || g.RawKey == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
 		return ""
 	}
-	return *g.RawKey
+	return *i.NodeID
 }
 
-// GetApp returns the App field.
-func (g *Grant) GetApp() *AuthorizationApp {
-	if g == nil {
-		return nil
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetRole() string {
+	if i == nil || i.Role == nil {
+		return ""
 	}
-	return g.App
+	return *i.Role
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *Grant) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
-		return Timestamp{}
+// GetTeamCount returns the TeamCount field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetTeamCount() int {
+	if i == nil || i.TeamCount == nil {
+		return 0
 	}
-	return *g.CreatedAt
+	return *i.TeamCount
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Grant) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (i *Issue) GetActiveLockReason() string {
+	if i == nil || i.ActiveLockReason == nil {
+		return ""
 	}
-	return *g.ID
+	return *i.ActiveLockReason
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *Grant) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
-		return Timestamp{}
+// GetAssignee returns the Assignee field.
+func (i *Issue) GetAssignee() *User {
+	if i == nil {
+		return nil
 	}
-	return *g.UpdatedAt
+	return i.Assignee
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *Grant) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *Issue) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
 		return ""
 	}
-	return *g.URL
+	return *i.AuthorAssociation
 }
 
-// GetAuthor returns the Author field.
-func (h *HeadCommit) GetAuthor() *CommitAuthor {
-	if h == nil {
-		return nil
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *Issue) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
 	}
-	return h.Author
+	return *i.Body
 }
 
-// GetCommitter returns the Committer field.
-func (h *HeadCommit) GetCommitter() *CommitAuthor {
-	if h == nil {
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetClosedAt() Timestamp {
+	if i == nil || i.ClosedAt == nil {
+		return Timestamp{}
+	}
+	return *i.ClosedAt
+}
+
+// GetClosedBy returns the ClosedBy field.
+func (i *Issue) GetClosedBy() *User {
+	if i == nil {
 		return nil
 	}
-	return h.Committer
+	return i.ClosedBy
 }
 
-// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetDistinct() bool {
-	if h == nil || h.Distinct == nil {
-		return false
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (i *Issue) GetComments() int {
+	if i == nil || i.Comments == nil {
+		return 0
 	}
-	return *h.Distinct
+	return *i.Comments
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetID() string {
-	if h == nil || h.ID == nil {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCommentsURL() string {
+	if i == nil || i.CommentsURL == nil {
 		return ""
 	}
-	return *h.ID
+	return *i.CommentsURL
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetMessage() string {
-	if h == nil || h.Message == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.CreatedAt
+}
+
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetEventsURL() string {
+	if i == nil || i.EventsURL == nil {
 		return ""
 	}
-	return *h.Message
+	return *i.EventsURL
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetSHA() string {
-	if h == nil || h.SHA == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
 		return ""
 	}
-	return *h.SHA
+	return *i.HTMLURL
 }
 
-// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetTimestamp() Timestamp {
-	if h == nil || h.Timestamp == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
 	}
-	return *h.Timestamp
+	return *i.ID
 }
 
-// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetTreeID() string {
-	if h == nil || h.TreeID == nil {
-		return ""
+// GetIssueDependenciesSummary returns the IssueDependenciesSummary field.
+func (i *Issue) GetIssueDependenciesSummary() *IssueDependenciesSummary {
+	if i == nil {
+		return nil
 	}
-	return *h.TreeID
+	return i.IssueDependenciesSummary
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetURL() string {
-	if h == nil || h.URL == nil {
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLabelsURL() string {
+	if i == nil || i.LabelsURL == nil {
 		return ""
 	}
-	return *h.URL
+	return *i.LabelsURL
 }
 
-// GetActive returns the Active field if it's non-nil, zero value otherwise.
-func (h *Hook) GetActive() bool {
-	if h == nil || h.Active == nil {
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLocked() bool {
+	if i == nil || i.Locked == nil {
 		return false
 	}
-	return *h.Active
+	return *i.Locked
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (h *Hook) GetCreatedAt() Timestamp {
-	if h == nil || h.CreatedAt == nil {
-		return Timestamp{}
+// GetMilestone returns the Milestone field.
+func (i *Issue) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
 	}
-	return *h.CreatedAt
+	return i.Milestone
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *Hook) GetID() int64 {
-	if h == nil || h.ID == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
 	}
-	return *h.ID
+	return *i.NodeID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (h *Hook) GetName() string {
-	if h == nil || h.Name == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNumber() int {
+	if i == nil || i.Number == nil {
+		return 0
 	}
-	return *h.Name
+	return *i.Number
 }
 
-// GetPingURL returns the PingURL field if it's non-nil, zero value otherwise.
-func (h *Hook) GetPingURL() string {
-	if h == nil || h.PingURL == nil {
-		return ""
+// GetPinned returns the Pinned field if it's non-nil, zero value otherwise.
+func (i *Issue) GetPinned() bool {
+	if i == nil || i.Pinned == nil {
+		return false
 	}
-	return *h.PingURL
+	return *i.Pinned
 }
 
-// GetTestURL returns the TestURL field if it's non-nil, zero value otherwise.
-func (h *Hook) GetTestURL() string {
-	if h == nil || h.TestURL == nil {
-		return ""
+// GetPullRequestLinks returns the PullRequestLinks field.
+func (i *Issue) GetPullRequestLinks() *PullRequestLinks {
+	if i == nil {
+		return nil
 	}
-	return *h.TestURL
+	return i.PullRequestLinks
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (h *Hook) GetType() string {
-	if h == nil || h.Type == nil {
-		return ""
+// GetReactions returns the Reactions field.
+func (i *Issue) GetReactions() *Reactions {
+	if i == nil {
+		return nil
 	}
-	return *h.Type
+	return i.Reactions
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (h *Hook) GetUpdatedAt() Timestamp {
-	if h == nil || h.UpdatedAt == nil {
-		return Timestamp{}
+// GetRepository returns the Repository field.
+func (i *Issue) GetRepository() *Repository {
+	if i == nil {
+		return nil
 	}
-	return *h.UpdatedAt
+	return i.Repository
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (h *Hook) GetURL() string {
-	if h == nil || h.URL == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
 		return ""
 	}
-	return *h.URL
+	return *i.RepositoryURL
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (h *HookConfig) GetContentType() string {
-	if h == nil || h.ContentType == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *Issue) GetState() string {
+	if i == nil || i.State == nil {
 		return ""
 	}
-	return *h.ContentType
+	return *i.State
 }
 
-// GetInsecureSSL returns the InsecureSSL field if it's non-nil, zero value otherwise.
-func (h *HookConfig) GetInsecureSSL() string {
-	if h == nil || h.InsecureSSL == nil {
+// GetStateReason returns the StateReason field if it's non-nil, zero value otherwise.
+func (i *Issue) GetStateReason() string {
+	if i == nil || i.StateReason == nil {
 		return ""
 	}
-	return *h.InsecureSSL
+	return *i.StateReason
 }
 
-// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
-func (h *HookConfig) GetSecret() string {
-	if h == nil || h.Secret == nil {
-		return ""
+// GetSubIssuesSummary returns the SubIssuesSummary field.
+func (i *Issue) GetSubIssuesSummary() *SubIssuesSummary {
+	if i == nil {
+		return nil
 	}
-	return *h.Secret
+	return i.SubIssuesSummary
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (h *HookConfig) GetURL() string {
-	if h == nil || h.URL == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *Issue) GetTitle() string {
+	if i == nil || i.Title == nil {
 		return ""
 	}
-	return *h.URL
+	return *i.Title
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetAction() string {
-	if h == nil || h.Action == nil {
-		return ""
+// GetType returns the Type field.
+func (i *Issue) GetType() *IssueType {
+	if i == nil {
+		return nil
 	}
-	return *h.Action
+	return i.Type
 }
 
-// GetDeliveredAt returns the DeliveredAt field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetDeliveredAt() Timestamp {
-	if h == nil || h.DeliveredAt == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *h.DeliveredAt
+	return *i.UpdatedAt
 }
 
-// GetDuration returns the Duration field.
-func (h *HookDelivery) GetDuration() *float64 {
-	if h == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUser returns the User field.
+func (i *Issue) GetUser() *User {
+	if i == nil {
 		return nil
 	}
-	return h.Duration
+	return i.User
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetEvent() string {
-	if h == nil || h.Event == nil {
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
 		return ""
 	}
-	return *h.Event
+	return *i.AuthorAssociation
 }
 
-// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetGUID() string {
-	if h == nil || h.GUID == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetBody() string {
+	if i == nil || i.Body == nil {
 		return ""
 	}
-	return *h.GUID
+	return *i.Body
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetID() int64 {
-	if h == nil || h.ID == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *h.ID
+	return *i.CreatedAt
 }
 
-// GetInstallationID returns the InstallationID field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetInstallationID() int64 {
-	if h == nil || h.InstallationID == nil {
-		return 0
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
 	}
-	return *h.InstallationID
+	return *i.HTMLURL
 }
 
-// GetRedelivery returns the Redelivery field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetRedelivery() bool {
-	if h == nil || h.Redelivery == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
 	}
-	return *h.Redelivery
+	return *i.ID
 }
 
-// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetRepositoryID() int64 {
-	if h == nil || h.RepositoryID == nil {
-		return 0
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetIssueURL() string {
+	if i == nil || i.IssueURL == nil {
+		return ""
 	}
-	return *h.RepositoryID
+	return *i.IssueURL
 }
 
-// GetRequest returns the Request field.
-func (h *HookDelivery) GetRequest() *HookRequest {
-	if h == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
 	}
-	return h.Request
+	return *i.NodeID
 }
 
-// GetResponse returns the Response field.
-func (h *HookDelivery) GetResponse() *HookResponse {
-	if h == nil {
+// GetReactions returns the Reactions field.
+func (i *IssueComment) GetReactions() *Reactions {
+	if i == nil {
 		return nil
 	}
-	return h.Response
+	return i.Reactions
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetStatus() string {
-	if h == nil || h.Status == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *h.Status
+	return *i.UpdatedAt
 }
 
-// GetStatusCode returns the StatusCode field if it's non-nil, zero value otherwise.
-func (h *HookDelivery) GetStatusCode() int {
-	if h == nil || h.StatusCode == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
 	}
-	return *h.StatusCode
+	return *i.URL
 }
 
-// GetHeaders returns the Headers map if it's non-nil, an empty map otherwise.
-func (h *HookRequest) GetHeaders() map[string]string {
-	if h == nil || h.Headers == nil {
-		return map[string]string{}
+// GetUser returns the User field.
+func (i *IssueComment) GetUser() *User {
+	if i == nil {
+		return nil
 	}
-	return h.Headers
+	return i.User
 }
 
-// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
-func (h *HookRequest) GetRawPayload() json.RawMessage {
-	if h == nil || h.RawPayload == nil {
-		return json.RawMessage{}
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssueCommentEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
 	}
-	return *h.RawPayload
+	return *i.Action
 }
 
-// GetHeaders returns the Headers map if it's non-nil, an empty map otherwise.
-func (h *HookResponse) GetHeaders() map[string]string {
-	if h == nil || h.Headers == nil {
-		return map[string]string{}
+// GetChanges returns the Changes field.
+func (i *IssueCommentEvent) GetChanges() *EditChange {
+	if i == nil {
+		return nil
 	}
-	return h.Headers
+	return i.Changes
 }
 
-// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
-func (h *HookResponse) GetRawPayload() json.RawMessage {
-	if h == nil || h.RawPayload == nil {
-		return json.RawMessage{}
+// GetComment returns the Comment field.
+func (i *IssueCommentEvent) GetComment() *IssueComment {
+	if i == nil {
+		return nil
 	}
-	return *h.RawPayload
+	return i.Comment
 }
 
-// GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetActiveHooks() int {
-	if h == nil || h.ActiveHooks == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (i *IssueCommentEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
 	}
-	return *h.ActiveHooks
+	return i.Installation
 }
 
-// GetInactiveHooks returns the InactiveHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetInactiveHooks() int {
-	if h == nil || h.InactiveHooks == nil {
-		return 0
+// GetIssue returns the Issue field.
+func (i *IssueCommentEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
 	}
-	return *h.InactiveHooks
+	return i.Issue
 }
 
-// GetTotalHooks returns the TotalHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetTotalHooks() int {
-	if h == nil || h.TotalHooks == nil {
-		return 0
+// GetOrganization returns the Organization field.
+func (i *IssueCommentEvent) GetOrganization() *Organization {
+	if i == nil {
+		return nil
 	}
-	return *h.TotalHooks
+	return i.Organization
 }
 
-// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupDescription() string {
-	if i == nil || i.GroupDescription == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (i *IssueCommentEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
 	}
-	return *i.GroupDescription
+	return i.Repo
 }
 
-// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupID() string {
-	if i == nil || i.GroupID == nil {
-		return ""
+// GetSender returns the Sender field.
+func (i *IssueCommentEvent) GetSender() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.GroupID
+	return i.Sender
 }
 
-// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupName() string {
-	if i == nil || i.GroupName == nil {
-		return ""
+// GetBlockedBy returns the BlockedBy field if it's non-nil, zero value otherwise.
+func (i *IssueDependenciesSummary) GetBlockedBy() int {
+	if i == nil || i.BlockedBy == nil {
+		return 0
 	}
-	return *i.GroupName
+	return *i.BlockedBy
 }
 
-// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsCount() int {
-	if i == nil || i.AuthorsCount == nil {
+// GetBlocking returns the Blocking field if it's non-nil, zero value otherwise.
+func (i *IssueDependenciesSummary) GetBlocking() int {
+	if i == nil || i.Blocking == nil {
 		return 0
 	}
-	return *i.AuthorsCount
+	return *i.Blocking
 }
 
-// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsURL() string {
-	if i == nil || i.AuthorsURL == nil {
-		return ""
+// GetTotalBlockedBy returns the TotalBlockedBy field if it's non-nil, zero value otherwise.
+func (i *IssueDependenciesSummary) GetTotalBlockedBy() int {
+	if i == nil || i.TotalBlockedBy == nil {
+		return 0
 	}
-	return *i.AuthorsURL
+	return *i.TotalBlockedBy
 }
 
-// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetCommitCount() int {
-	if i == nil || i.CommitCount == nil {
+// GetTotalBlocking returns the TotalBlocking field if it's non-nil, zero value otherwise.
+func (i *IssueDependenciesSummary) GetTotalBlocking() int {
+	if i == nil || i.TotalBlocking == nil {
 		return 0
 	}
-	return *i.CommitCount
+	return *i.TotalBlocking
 }
 
-// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
-func (i *Import) GetFailedStep() string {
-	if i == nil || i.FailedStep == nil {
-		return ""
+// GetActor returns the Actor field.
+func (i *IssueEvent) GetActor() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.FailedStep
+	return i.Actor
 }
 
-// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
-func (i *Import) GetHasLargeFiles() bool {
-	if i == nil || i.HasLargeFiles == nil {
-		return false
+// GetAssignee returns the Assignee field.
+func (i *IssueEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.HasLargeFiles
+	return i.Assignee
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
-		return ""
+// GetAssigner returns the Assigner field.
+func (i *IssueEvent) GetAssigner() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.HTMLURL
+	return i.Assigner
 }
 
-// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
-func (i *Import) GetHumanName() string {
-	if i == nil || i.HumanName == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCommitID() string {
+	if i == nil || i.CommitID == nil {
 		return ""
 	}
-	return *i.HumanName
+	return *i.CommitID
 }
 
-// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesCount() int {
-	if i == nil || i.LargeFilesCount == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.LargeFilesCount
+	return *i.CreatedAt
 }
 
-// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesSize() int {
-	if i == nil || i.LargeFilesSize == nil {
-		return 0
+// GetDismissedReview returns the DismissedReview field.
+func (i *IssueEvent) GetDismissedReview() *DismissedReview {
+	if i == nil {
+		return nil
 	}
-	return *i.LargeFilesSize
+	return i.DismissedReview
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *Import) GetMessage() string {
-	if i == nil || i.Message == nil {
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetEvent() string {
+	if i == nil || i.Event == nil {
 		return ""
 	}
-	return *i.Message
+	return *i.Event
 }
 
-// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPercent() int {
-	if i == nil || i.Percent == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetID() int64 {
+	if i == nil || i.ID == nil {
 		return 0
 	}
-	return *i.Percent
+	return *i.ID
 }
 
-// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPushPercent() int {
-	if i == nil || i.PushPercent == nil {
-		return 0
+// GetIssue returns the Issue field.
+func (i *IssueEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
 	}
-	return *i.PushPercent
+	return i.Issue
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
-		return ""
+// GetLabel returns the Label field.
+func (i *IssueEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
 	}
-	return *i.RepositoryURL
+	return i.Label
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatus() string {
-	if i == nil || i.Status == nil {
+// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetLockReason() string {
+	if i == nil || i.LockReason == nil {
 		return ""
 	}
-	return *i.Status
+	return *i.LockReason
 }
 
-// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatusText() string {
-	if i == nil || i.StatusText == nil {
-		return ""
+// GetMilestone returns the Milestone field.
+func (i *IssueEvent) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
 	}
-	return *i.StatusText
+	return i.Milestone
 }
 
-// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
-func (i *Import) GetTFVCProject() string {
-	if i == nil || i.TFVCProject == nil {
-		return ""
+// GetProjectCard returns the ProjectCard field.
+func (i *IssueEvent) GetProjectCard() *ProjectCard {
+	if i == nil {
+		return nil
 	}
-	return *i.TFVCProject
+	return i.ProjectCard
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Import) GetURL() string {
-	if i == nil || i.URL == nil {
-		return ""
+// GetRename returns the Rename field.
+func (i *IssueEvent) GetRename() *Rename {
+	if i == nil {
+		return nil
 	}
-	return *i.URL
+	return i.Rename
 }
 
-// GetUseLFS returns the UseLFS field if it's non-nil, zero value otherwise.
-func (i *Import) GetUseLFS() string {
-	if i == nil || i.UseLFS == nil {
-		return ""
+// GetRequestedReviewer returns the RequestedReviewer field.
+func (i *IssueEvent) GetRequestedReviewer() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.UseLFS
+	return i.RequestedReviewer
 }
 
-// GetVCS returns the VCS field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCS() string {
-	if i == nil || i.VCS == nil {
-		return ""
+// GetReviewRequester returns the ReviewRequester field.
+func (i *IssueEvent) GetReviewRequester() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.VCS
+	return i.ReviewRequester
 }
 
-// GetVCSPassword returns the VCSPassword field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSPassword() string {
-	if i == nil || i.VCSPassword == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetURL() string {
+	if i == nil || i.URL == nil {
 		return ""
 	}
-	return *i.VCSPassword
+	return *i.URL
 }
 
-// GetVCSURL returns the VCSURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSURL() string {
-	if i == nil || i.VCSURL == nil {
-		return ""
+// GetAttributes returns the Attributes field.
+func (i *IssueFormField) GetAttributes() *IssueFormFieldAttributes {
+	if i == nil {
+		return nil
 	}
-	return *i.VCSURL
+	return i.Attributes
 }
 
-// GetVCSUsername returns the VCSUsername field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSUsername() string {
-	if i == nil || i.VCSUsername == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueFormField) GetID() string {
+	if i == nil || i.ID == nil {
 		return ""
 	}
-	return *i.VCSUsername
+	return *i.ID
 }
 
-// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAccessTokensURL() string {
-	if i == nil || i.AccessTokensURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (i *IssueFormField) GetType() string {
+	if i == nil || i.Type == nil {
 		return ""
 	}
-	return *i.AccessTokensURL
+	return *i.Type
 }
 
-// GetAccount returns the Account field.
-func (i *Installation) GetAccount() *User {
+// GetValidations returns the Validations field.
+func (i *IssueFormField) GetValidations() *IssueFormFieldValidations {
 	if i == nil {
 		return nil
 	}
-	return i.Account
+	return i.Validations
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAppID() int64 {
-	if i == nil || i.AppID == nil {
-		return 0
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldAttributes) GetDescription() string {
+	if i == nil || i.Description == nil {
+		return ""
 	}
-	return *i.AppID
+	return *i.Description
 }
 
-// GetAppSlug returns the AppSlug field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAppSlug() string {
-	if i == nil || i.AppSlug == nil {
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldAttributes) GetLabel() string {
+	if i == nil || i.Label == nil {
 		return ""
 	}
-	return *i.AppSlug
+	return *i.Label
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetMultiple returns the Multiple field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldAttributes) GetMultiple() bool {
+	if i == nil || i.Multiple == nil {
+		return false
 	}
-	return *i.CreatedAt
+	return *i.Multiple
 }
 
-// GetHasMultipleSingleFiles returns the HasMultipleSingleFiles field if it's non-nil, zero value otherwise.
-func (i *Installation) GetHasMultipleSingleFiles() bool {
-	if i == nil || i.HasMultipleSingleFiles == nil {
-		return false
+// GetPlaceholder returns the Placeholder field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldAttributes) GetPlaceholder() string {
+	if i == nil || i.Placeholder == nil {
+		return ""
 	}
-	return *i.HasMultipleSingleFiles
+	return *i.Placeholder
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetRender returns the Render field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldAttributes) GetRender() string {
+	if i == nil || i.Render == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *i.Render
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldAttributes) GetValue() string {
+	if i == nil || i.Value == nil {
+		return ""
 	}
-	return *i.ID
+	return *i.Value
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldOption) GetLabel() string {
+	if i == nil || i.Label == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *i.Label
 }
 
-// GetPermissions returns the Permissions field.
-func (i *Installation) GetPermissions() *InstallationPermissions {
-	if i == nil {
-		return nil
+// GetRequired returns the Required field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldOption) GetRequired() bool {
+	if i == nil || i.Required == nil {
+		return false
 	}
-	return i.Permissions
+	return *i.Required
 }
 
-// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetRepositoriesURL() string {
-	if i == nil || i.RepositoriesURL == nil {
-		return ""
+// GetRequired returns the Required field if it's non-nil, zero value otherwise.
+func (i *IssueFormFieldValidations) GetRequired() bool {
+	if i == nil || i.Required == nil {
+		return false
 	}
-	return *i.RepositoriesURL
+	return *i.Required
 }
 
-// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
-func (i *Installation) GetRepositorySelection() string {
-	if i == nil || i.RepositorySelection == nil {
+// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetAssignee() string {
+	if i == nil || i.Assignee == nil {
 		return ""
 	}
-	return *i.RepositorySelection
+	return *i.Assignee
 }
 
-// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
-func (i *Installation) GetSingleFileName() string {
-	if i == nil || i.SingleFileName == nil {
-		return ""
+// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetClosed() bool {
+	if i == nil || i.Closed == nil {
+		return false
 	}
-	return *i.SingleFileName
+	return *i.Closed
 }
 
-// GetSuspendedAt returns the SuspendedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetSuspendedAt() Timestamp {
-	if i == nil || i.SuspendedAt == nil {
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetClosedAt() Timestamp {
+	if i == nil || i.ClosedAt == nil {
 		return Timestamp{}
 	}
-	return *i.SuspendedAt
+	return *i.ClosedAt
 }
 
-// GetSuspendedBy returns the SuspendedBy field.
-func (i *Installation) GetSuspendedBy() *User {
-	if i == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return i.SuspendedBy
+	return *i.CreatedAt
 }
 
-// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetTargetID() int64 {
-	if i == nil || i.TargetID == nil {
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
 		return 0
 	}
-	return *i.TargetID
-}
-
-// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
-func (i *Installation) GetTargetType() string {
-	if i == nil || i.TargetType == nil {
-		return ""
-	}
-	return *i.TargetType
+	return *i.Milestone
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetUpdatedAt() Timestamp {
+func (i *IssueImport) GetUpdatedAt() Timestamp {
 	if i == nil || i.UpdatedAt == nil {
 		return Timestamp{}
 	}
 	return *i.UpdatedAt
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *InstallationEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetCode() string {
+	if i == nil || i.Code == nil {
 		return ""
 	}
-	return *i.Action
+	return *i.Code
 }
 
-// GetInstallation returns the Installation field.
-func (i *InstallationEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetField returns the Field field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetField() string {
+	if i == nil || i.Field == nil {
+		return ""
 	}
-	return i.Installation
+	return *i.Field
 }
 
-// GetSender returns the Sender field.
-func (i *InstallationEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetLocation() string {
+	if i == nil || i.Location == nil {
+		return ""
 	}
-	return i.Sender
+	return *i.Location
 }
 
-// GetActions returns the Actions field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetActions() string {
-	if i == nil || i.Actions == nil {
+// GetResource returns the Resource field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetResource() string {
+	if i == nil || i.Resource == nil {
 		return ""
 	}
-	return *i.Actions
+	return *i.Resource
 }
 
-// GetAdministration returns the Administration field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetAdministration() string {
-	if i == nil || i.Administration == nil {
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetValue() string {
+	if i == nil || i.Value == nil {
 		return ""
 	}
-	return *i.Administration
+	return *i.Value
 }
 
-// GetBlocking returns the Blocking field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetBlocking() string {
-	if i == nil || i.Blocking == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.Blocking
+	return *i.CreatedAt
 }
 
-// GetChecks returns the Checks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetChecks() string {
-	if i == nil || i.Checks == nil {
+// GetDocumentationURL returns the DocumentationURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetDocumentationURL() string {
+	if i == nil || i.DocumentationURL == nil {
 		return ""
 	}
-	return *i.Checks
+	return *i.DocumentationURL
 }
 
-// GetContentReferences returns the ContentReferences field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetContentReferences() string {
-	if i == nil || i.ContentReferences == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetID() int {
+	if i == nil || i.ID == nil {
+		return 0
 	}
-	return *i.ContentReferences
+	return *i.ID
 }
 
-// GetContents returns the Contents field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetContents() string {
-	if i == nil || i.Contents == nil {
+// GetImportIssuesURL returns the ImportIssuesURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetImportIssuesURL() string {
+	if i == nil || i.ImportIssuesURL == nil {
 		return ""
 	}
-	return *i.Contents
+	return *i.ImportIssuesURL
 }
 
-// GetDeployments returns the Deployments field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetDeployments() string {
-	if i == nil || i.Deployments == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetMessage() string {
+	if i == nil || i.Message == nil {
 		return ""
 	}
-	return *i.Deployments
+	return *i.Message
 }
 
-// GetEmails returns the Emails field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetEmails() string {
-	if i == nil || i.Emails == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
 		return ""
 	}
-	return *i.Emails
+	return *i.RepositoryURL
 }
 
-// GetEnvironments returns the Environments field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetEnvironments() string {
-	if i == nil || i.Environments == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetStatus() string {
+	if i == nil || i.Status == nil {
 		return ""
 	}
-	return *i.Environments
+	return *i.Status
 }
 
-// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetFollowers() string {
-	if i == nil || i.Followers == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetURL() string {
+	if i == nil || i.URL == nil {
 		return ""
 	}
-	return *i.Followers
+	return *i.URL
 }
 
-// GetIssues returns the Issues field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetIssues() string {
-	if i == nil || i.Issues == nil {
+// GetDirection returns the Direction field if it's non-nil, zero value otherwise.
+func (i *IssueListCommentsOptions) GetDirection() string {
+	if i == nil || i.Direction == nil {
 		return ""
 	}
-	return *i.Issues
+	return *i.Direction
 }
 
-// GetMembers returns the Members field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetMembers() string {
-	if i == nil || i.Members == nil {
-		return ""
+// GetSince returns the Since field if it's non-nil, zero value otherwise.
+func (i *IssueListCommentsOptions) GetSince() time.Time {
+	if i == nil || i.Since == nil {
+		return time.Time{}
 	}
-	return *i.Members
+	return *i.Since
 }
 
-// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetMetadata() string {
-	if i == nil || i.Metadata == nil {
+// GetSort returns the Sort field if it's non-nil, zero value otherwise.
+func (i *IssueListCommentsOptions) GetSort() string {
+	if i == nil || i.Sort == nil {
 		return ""
 	}
-	return *i.Metadata
+	return *i.Sort
 }
 
-// GetOrganizationAdministration returns the OrganizationAdministration field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationAdministration() string {
-	if i == nil || i.OrganizationAdministration == nil {
+// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetAssignee() string {
+	if i == nil || i.Assignee == nil {
 		return ""
 	}
-	return *i.OrganizationAdministration
+	return *i.Assignee
 }
 
-// GetOrganizationCustomRoles returns the OrganizationCustomRoles field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationCustomRoles() string {
-	if i == nil || i.OrganizationCustomRoles == nil {
-		return ""
+// GetAssignees returns the Assignees field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetAssignees() []string {
+	if i == nil || i.Assignees == nil {
+		return nil
 	}
-	return *i.OrganizationCustomRoles
+	return *i.Assignees
 }
 
-// GetOrganizationHooks returns the OrganizationHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationHooks() string {
-	if i == nil || i.OrganizationHooks == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetBody() string {
+	if i == nil || i.Body == nil {
 		return ""
 	}
-	return *i.OrganizationHooks
+	return *i.Body
 }
 
-// GetOrganizationPackages returns the OrganizationPackages field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationPackages() string {
-	if i == nil || i.OrganizationPackages == nil {
-		return ""
+// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetLabels() []string {
+	if i == nil || i.Labels == nil {
+		return nil
 	}
-	return *i.OrganizationPackages
+	return *i.Labels
 }
 
-// GetOrganizationPlan returns the OrganizationPlan field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationPlan() string {
-	if i == nil || i.OrganizationPlan == nil {
-		return ""
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
+		return 0
 	}
-	return *i.OrganizationPlan
+	return *i.Milestone
 }
 
-// GetOrganizationPreReceiveHooks returns the OrganizationPreReceiveHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationPreReceiveHooks() string {
-	if i == nil || i.OrganizationPreReceiveHooks == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetState() string {
+	if i == nil || i.State == nil {
 		return ""
 	}
-	return *i.OrganizationPreReceiveHooks
+	return *i.State
 }
 
-// GetOrganizationProjects returns the OrganizationProjects field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationProjects() string {
-	if i == nil || i.OrganizationProjects == nil {
+// GetStateReason returns the StateReason field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetStateReason() string {
+	if i == nil || i.StateReason == nil {
 		return ""
 	}
-	return *i.OrganizationProjects
+	return *i.StateReason
 }
 
-// GetOrganizationSecrets returns the OrganizationSecrets field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationSecrets() string {
-	if i == nil || i.OrganizationSecrets == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetTitle() string {
+	if i == nil || i.Title == nil {
 		return ""
 	}
-	return *i.OrganizationSecrets
+	return *i.Title
 }
 
-// GetOrganizationSelfHostedRunners returns the OrganizationSelfHostedRunners field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationSelfHostedRunners() string {
-	if i == nil || i.OrganizationSelfHostedRunners == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetType() string {
+	if i == nil || i.Type == nil {
 		return ""
 	}
-	return *i.OrganizationSelfHostedRunners
+	return *i.Type
 }
 
-// GetOrganizationUserBlocking returns the OrganizationUserBlocking field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationUserBlocking() string {
-	if i == nil || i.OrganizationUserBlocking == nil {
-		return ""
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssuesBatch) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
+		return 0
 	}
-	return *i.OrganizationUserBlocking
+	return *i.Milestone
 }
 
-// GetPackages returns the Packages field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPackages() string {
-	if i == nil || i.Packages == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssuesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
 		return ""
 	}
-	return *i.Packages
+	return *i.Action
 }
 
-// GetPages returns the Pages field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPages() string {
-	if i == nil || i.Pages == nil {
-		return ""
+// GetAssignee returns the Assignee field.
+func (i *IssuesEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.Pages
+	return i.Assignee
 }
 
-// GetPullRequests returns the PullRequests field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPullRequests() string {
-	if i == nil || i.PullRequests == nil {
-		return ""
+// GetChanges returns the Changes field.
+func (i *IssuesEvent) GetChanges() *EditChange {
+	if i == nil {
+		return nil
 	}
-	return *i.PullRequests
+	return i.Changes
 }
 
-// GetRepositoryHooks returns the RepositoryHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryHooks() string {
-	if i == nil || i.RepositoryHooks == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (i *IssuesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
 	}
-	return *i.RepositoryHooks
+	return i.Installation
 }
 
-// GetRepositoryPreReceiveHooks returns the RepositoryPreReceiveHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryPreReceiveHooks() string {
-	if i == nil || i.RepositoryPreReceiveHooks == nil {
-		return ""
+// GetIssue returns the Issue field.
+func (i *IssuesEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
 	}
-	return *i.RepositoryPreReceiveHooks
+	return i.Issue
 }
 
-// GetRepositoryProjects returns the RepositoryProjects field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryProjects() string {
-	if i == nil || i.RepositoryProjects == nil {
-		return ""
+// GetLabel returns the Label field.
+func (i *IssuesEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
 	}
-	return *i.RepositoryProjects
+	return i.Label
 }
 
-// GetSecrets returns the Secrets field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetSecrets() string {
-	if i == nil || i.Secrets == nil {
-		return ""
+// GetMilestone returns the Milestone field.
+func (i *IssuesEvent) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
 	}
-	return *i.Secrets
+	return i.Milestone
 }
 
-// GetSecretScanningAlerts returns the SecretScanningAlerts field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetSecretScanningAlerts() string {
-	if i == nil || i.SecretScanningAlerts == nil {
-		return ""
+// GetOrg returns the Org field.
+func (i *IssuesEvent) GetOrg() *Organization {
+	if i == nil {
+		return nil
 	}
-	return *i.SecretScanningAlerts
+	return i.Org
 }
 
-// GetSecurityEvents returns the SecurityEvents field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetSecurityEvents() string {
-	if i == nil || i.SecurityEvents == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (i *IssuesEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
 	}
-	return *i.SecurityEvents
+	return i.Repo
 }
 
-// GetSingleFile returns the SingleFile field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetSingleFile() string {
-	if i == nil || i.SingleFile == nil {
-		return ""
+// GetSender returns the Sender field.
+func (i *IssuesEvent) GetSender() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.SingleFile
+	return i.Sender
 }
 
-// GetStatuses returns the Statuses field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetStatuses() string {
-	if i == nil || i.Statuses == nil {
-		return ""
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (i *IssuesSearchResult) GetIncompleteResults() bool {
+	if i == nil || i.IncompleteResults == nil {
+		return false
 	}
-	return *i.Statuses
+	return *i.IncompleteResults
 }
 
-// GetTeamDiscussions returns the TeamDiscussions field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetTeamDiscussions() string {
-	if i == nil || i.TeamDiscussions == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (i *IssuesSearchResult) GetTotal() int {
+	if i == nil || i.Total == nil {
+		return 0
 	}
-	return *i.TeamDiscussions
+	return *i.Total
 }
 
-// GetVulnerabilityAlerts returns the VulnerabilityAlerts field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetVulnerabilityAlerts() string {
-	if i == nil || i.VulnerabilityAlerts == nil {
-		return ""
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetClosedIssues() int {
+	if i == nil || i.ClosedIssues == nil {
+		return 0
 	}
-	return *i.VulnerabilityAlerts
+	return *i.ClosedIssues
 }
 
-// GetWorkflows returns the Workflows field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetWorkflows() string {
-	if i == nil || i.Workflows == nil {
-		return ""
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetOpenIssues() int {
+	if i == nil || i.OpenIssues == nil {
+		return 0
 	}
-	return *i.Workflows
+	return *i.OpenIssues
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *InstallationRepositoriesEvent) GetAction() string {
-	if i == nil || i.Action == nil {
-		return ""
+// GetTotalIssues returns the TotalIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetTotalIssues() int {
+	if i == nil || i.TotalIssues == nil {
+		return 0
 	}
-	return *i.Action
+	return *i.TotalIssues
 }
 
-// GetInstallation returns the Installation field.
-func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetAbout returns the About field if it's non-nil, zero value otherwise.
+func (i *IssueTemplate) GetAbout() string {
+	if i == nil || i.About == nil {
+		return ""
 	}
-	return i.Installation
+	return *i.About
 }
 
-// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
-func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
-	if i == nil || i.RepositorySelection == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueTemplate) GetBody() string {
+	if i == nil || i.Body == nil {
 		return ""
 	}
-	return *i.RepositorySelection
+	return *i.Body
 }
 
-// GetSender returns the Sender field.
-func (i *InstallationRepositoriesEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (i *IssueTemplate) GetDescription() string {
+	if i == nil || i.Description == nil {
+		return ""
 	}
-	return i.Sender
+	return *i.Description
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (i *InstallationToken) GetExpiresAt() Timestamp {
-	if i == nil || i.ExpiresAt == nil {
-		return Timestamp{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *IssueTemplate) GetName() string {
+	if i == nil || i.Name == nil {
+		return ""
 	}
-	return *i.ExpiresAt
+	return *i.Name
 }
 
-// GetPermissions returns the Permissions field.
-func (i *InstallationToken) GetPermissions() *InstallationPermissions {
-	if i == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (i *IssueTemplate) GetPath() string {
+	if i == nil || i.Path == nil {
+		return ""
 	}
-	return i.Permissions
+	return *i.Path
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (i *InstallationToken) GetToken() string {
-	if i == nil || i.Token == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *IssueTemplate) GetTitle() string {
+	if i == nil || i.Title == nil {
 		return ""
 	}
-	return *i.Token
+	return *i.Title
 }
 
-// GetPermissions returns the Permissions field.
-func (i *InstallationTokenOptions) GetPermissions() *InstallationPermissions {
-	if i == nil {
-		return nil
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetColor() string {
+	if i == nil || i.Color == nil {
+		return ""
 	}
-	return i.Permissions
+	return *i.Color
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetExpiresAt() Timestamp {
-	if i == nil || i.ExpiresAt == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *i.ExpiresAt
+	return *i.CreatedAt
 }
 
-// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetLimit() string {
-	if i == nil || i.Limit == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetDescription() string {
+	if i == nil || i.Description == nil {
 		return ""
 	}
-	return *i.Limit
+	return *i.Description
 }
 
-// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetOrigin() string {
-	if i == nil || i.Origin == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
 	}
-	return *i.Origin
+	return *i.ID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetIsEnabled returns the IsEnabled field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetIsEnabled() bool {
+	if i == nil || i.IsEnabled == nil {
+		return false
 	}
-	return *i.CreatedAt
+	return *i.IsEnabled
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetEmail() string {
-	if i == nil || i.Email == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetName() string {
+	if i == nil || i.Name == nil {
 		return ""
 	}
-	return *i.Email
+	return *i.Name
 }
 
-// GetFailedAt returns the FailedAt field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetFailedAt() Timestamp {
-	if i == nil || i.FailedAt == nil {
-		return Timestamp{}
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
 	}
-	return *i.FailedAt
+	return *i.NodeID
 }
 
-// GetFailedReason returns the FailedReason field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetFailedReason() string {
-	if i == nil || i.FailedReason == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.FailedReason
+	return *i.UpdatedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetID() int64 {
-	if i == nil || i.ID == nil {
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (j *Jobs) GetTotalCount() int {
+	if j == nil || j.TotalCount == nil {
 		return 0
 	}
-	return *i.ID
+	return *j.TotalCount
 }
 
-// GetInvitationTeamURL returns the InvitationTeamURL field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetInvitationTeamURL() string {
-	if i == nil || i.InvitationTeamURL == nil {
+// GetAddedBy returns the AddedBy field if it's non-nil, zero value otherwise.
+func (k *Key) GetAddedBy() string {
+	if k == nil || k.AddedBy == nil {
 		return ""
 	}
-	return *i.InvitationTeamURL
+	return *k.AddedBy
 }
 
-// GetInviter returns the Inviter field.
-func (i *Invitation) GetInviter() *User {
-	if i == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (k *Key) GetCreatedAt() Timestamp {
+	if k == nil || k.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return i.Inviter
+	return *k.CreatedAt
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetLogin() string {
-	if i == nil || i.Login == nil {
-		return ""
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (k *Key) GetEnabled() bool {
+	if k == nil || k.Enabled == nil {
+		return false
 	}
-	return *i.Login
+	return *k.Enabled
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (k *Key) GetID() int64 {
+	if k == nil || k.ID == nil {
+		return 0
 	}
-	return *i.NodeID
+	return *k.ID
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetRole() string {
-	if i == nil || i.Role == nil {
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (k *Key) GetKey() string {
+	if k == nil || k.Key == nil {
 		return ""
 	}
-	return *i.Role
+	return *k.Key
 }
 
-// GetTeamCount returns the TeamCount field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetTeamCount() int {
-	if i == nil || i.TeamCount == nil {
-		return 0
+// GetLastUsed returns the LastUsed field if it's non-nil, zero value otherwise.
+func (k *Key) GetLastUsed() Timestamp {
+	if k == nil || k.LastUsed == nil {
+		return Timestamp{}
 	}
-	return *i.TeamCount
+	return *k.LastUsed
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (i *Issue) GetActiveLockReason() string {
-	if i == nil || i.ActiveLockReason == nil {
-		return ""
+// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
+func (k *Key) GetReadOnly() bool {
+	if k == nil || k.ReadOnly == nil {
+		return false
 	}
-	return *i.ActiveLockReason
+	return *k.ReadOnly
 }
 
-// GetAssignee returns the Assignee field.
-func (i *Issue) GetAssignee() *User {
-	if i == nil {
-		return nil
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (k *Key) GetTitle() string {
+	if k == nil || k.Title == nil {
+		return ""
 	}
-	return i.Assignee
+	return *k.Title
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (i *Issue) GetAuthorAssociation() string {
-	if i == nil || i.AuthorAssociation == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (k *Key) GetURL() string {
+	if k == nil || k.URL == nil {
 		return ""
 	}
-	return *i.AuthorAssociation
+	return *k.URL
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *Issue) GetBody() string {
-	if i == nil || i.Body == nil {
+// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
+func (k *Key) GetVerified() bool {
+	if k == nil || k.Verified == nil {
+		return false
+	}
+	return *k.Verified
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *Label) GetColor() string {
+	if l == nil || l.Color == nil {
 		return ""
 	}
-	return *i.Body
+	return *l.Color
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetClosedAt() Timestamp {
-	if i == nil || i.ClosedAt == nil {
-		return Timestamp{}
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *Label) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
 	}
-	return *i.ClosedAt
+	return *l.Default
 }
 
-// GetClosedBy returns the ClosedBy field.
-func (i *Issue) GetClosedBy() *User {
-	if i == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *Label) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
 	}
-	return i.ClosedBy
+	return *l.Description
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (i *Issue) GetComments() int {
-	if i == nil || i.Comments == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *Label) GetID() int64 {
+	if l == nil || l.ID == nil {
 		return 0
 	}
-	return *i.Comments
+	return *l.ID
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCommentsURL() string {
-	if i == nil || i.CommentsURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *Label) GetName() string {
+	if l == nil || l.Name == nil {
 		return ""
 	}
-	return *i.CommentsURL
+	return *l.Name
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetNewName returns the NewName field if it's non-nil, zero value otherwise.
+func (l *Label) GetNewName() string {
+	if l == nil || l.NewName == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *l.NewName
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetEventsURL() string {
-	if i == nil || i.EventsURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (l *Label) GetNodeID() string {
+	if l == nil || l.NodeID == nil {
 		return ""
 	}
-	return *i.EventsURL
+	return *l.NodeID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *Label) GetURL() string {
+	if l == nil || l.URL == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *l.URL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetLabel returns the Label field.
+func (l *LabelChange) GetLabel() *Label {
+	if l == nil {
+		return nil
 	}
-	return *i.ID
+	return l.Label
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetLabelsURL() string {
-	if i == nil || i.LabelsURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (l *LabelEvent) GetAction() string {
+	if l == nil || l.Action == nil {
 		return ""
 	}
-	return *i.LabelsURL
+	return *l.Action
 }
 
-// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
-func (i *Issue) GetLocked() bool {
-	if i == nil || i.Locked == nil {
-		return false
+// GetChanges returns the Changes field.
+func (l *LabelEvent) GetChanges() *EditChange {
+	if l == nil {
+		return nil
 	}
-	return *i.Locked
+	return l.Changes
 }
 
-// GetMilestone returns the Milestone field.
-func (i *Issue) GetMilestone() *Milestone {
-	if i == nil {
+// GetInstallation returns the Installation field.
+func (l *LabelEvent) GetInstallation() *Installation {
+	if l == nil {
 		return nil
 	}
-	return i.Milestone
+	return l.Installation
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
-		return ""
+// GetLabel returns the Label field.
+func (l *LabelEvent) GetLabel() *Label {
+	if l == nil {
+		return nil
 	}
-	return *i.NodeID
+	return l.Label
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (i *Issue) GetNumber() int {
-	if i == nil || i.Number == nil {
-		return 0
+// GetOrg returns the Org field.
+func (l *LabelEvent) GetOrg() *Organization {
+	if l == nil {
+		return nil
 	}
-	return *i.Number
+	return l.Org
 }
 
-// GetPullRequestLinks returns the PullRequestLinks field.
-func (i *Issue) GetPullRequestLinks() *PullRequestLinks {
-	if i == nil {
+// GetRepo returns the Repo field.
+func (l *LabelEvent) GetRepo() *Repository {
+	if l == nil {
 		return nil
 	}
-	return i.PullRequestLinks
+	return l.Repo
 }
 
-// GetReactions returns the Reactions field.
-func (i *Issue) GetReactions() *Reactions {
-	if i == nil {
+// GetSender returns the Sender field.
+func (l *LabelEvent) GetSender() *User {
+	if l == nil {
 		return nil
 	}
-	return i.Reactions
+	return l.Sender
 }
 
-// GetRepository returns the Repository field.
-func (i *Issue) GetRepository() *Repository {
-	if i == nil {
-		return nil
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetColor() string {
+	if l == nil || l.Color == nil {
+		return ""
 	}
-	return i.Repository
+	return *l.Color
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
-		return ""
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
 	}
-	return *i.RepositoryURL
+	return *l.Default
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *Issue) GetState() string {
-	if i == nil || i.State == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetDescription() string {
+	if l == nil || l.Description == nil {
 		return ""
 	}
-	return *i.State
+	return *l.Description
 }
 
-// GetStateReason returns the StateReason field if it's non-nil, zero value otherwise.
-func (i *Issue) GetStateReason() string {
-	if i == nil || i.StateReason == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetID() int64 {
+	if l == nil || l.ID == nil {
+		return 0
 	}
-	return *i.StateReason
+	return *l.ID
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *Issue) GetTitle() string {
-	if i == nil || i.Title == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetName() string {
+	if l == nil || l.Name == nil {
 		return ""
 	}
-	return *i.Title
+	return *l.Name
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetUpdatedAt() Timestamp {
-	if i == nil || i.UpdatedAt == nil {
-		return Timestamp{}
+// GetScore returns the Score field.
+func (l *LabelResult) GetScore() *float64 {
+	if l == nil {
+		return nil
 	}
-	return *i.UpdatedAt
+	return l.Score
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetURL() string {
-	if i == nil || i.URL == nil {
+func (l *LabelResult) GetURL() string {
+	if l == nil || l.URL == nil {
 		return ""
 	}
-	return *i.URL
+	return *l.URL
 }
 
-// GetUser returns the User field.
-func (i *Issue) GetUser() *User {
-	if i == nil {
-		return nil
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (l *LabelsSearchResult) GetIncompleteResults() bool {
+	if l == nil || l.IncompleteResults == nil {
+		return false
 	}
-	return i.User
+	return *l.IncompleteResults
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetAuthorAssociation() string {
-	if i == nil || i.AuthorAssociation == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *LabelsSearchResult) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
 	}
-	return *i.AuthorAssociation
+	return *l.Total
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetBody() string {
-	if i == nil || i.Body == nil {
+// GetOID returns the OID field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetOID() string {
+	if l == nil || l.OID == nil {
 		return ""
 	}
-	return *i.Body
+	return *l.OID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *l.Path
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetRefName() string {
+	if l == nil || l.RefName == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *l.RefName
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetID() int64 {
-	if i == nil || i.ID == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetSize() int {
+	if l == nil || l.Size == nil {
 		return 0
 	}
-	return *i.ID
+	return *l.Size
 }
 
-// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetIssueURL() string {
-	if i == nil || i.IssueURL == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (l *License) GetBody() string {
+	if l == nil || l.Body == nil {
 		return ""
 	}
-	return *i.IssueURL
+	return *l.Body
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
-		return ""
+// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
+func (l *License) GetConditions() []string {
+	if l == nil || l.Conditions == nil {
+		return nil
 	}
-	return *i.NodeID
+	return *l.Conditions
 }
 
-// GetReactions returns the Reactions field.
-func (i *IssueComment) GetReactions() *Reactions {
-	if i == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *License) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
 	}
-	return i.Reactions
+	return *l.Description
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetUpdatedAt() Timestamp {
-	if i == nil || i.UpdatedAt == nil {
-		return Timestamp{}
+// GetFeatured returns the Featured field if it's non-nil, zero value otherwise.
+func (l *License) GetFeatured() bool {
+	if l == nil || l.Featured == nil {
+		return false
 	}
-	return *i.UpdatedAt
+	return *l.Featured
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (l *License) GetHTMLURL() string {
+	if l == nil || l.HTMLURL == nil {
 		return ""
 	}
-	return *i.URL
+	return *l.HTMLURL
 }
 
-// GetUser returns the User field.
-func (i *IssueComment) GetUser() *User {
-	if i == nil {
-		return nil
+// GetImplementation returns the Implementation field if it's non-nil, zero value otherwise.
+func (l *License) GetImplementation() string {
+	if l == nil || l.Implementation == nil {
+		return ""
 	}
-	return i.User
+	return *l.Implementation
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *IssueCommentEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (l *License) GetKey() string {
+	if l == nil || l.Key == nil {
 		return ""
 	}
-	return *i.Action
+	return *l.Key
 }
 
-// GetChanges returns the Changes field.
-func (i *IssueCommentEvent) GetChanges() *EditChange {
-	if i == nil {
+// GetLimitations returns the Limitations field if it's non-nil, zero value otherwise.
+func (l *License) GetLimitations() []string {
+	if l == nil || l.Limitations == nil {
 		return nil
 	}
-	return i.Changes
+	return *l.Limitations
 }
 
-// GetComment returns the Comment field.
-func (i *IssueCommentEvent) GetComment() *IssueComment {
-	if i == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *License) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
 	}
-	return i.Comment
+	return *l.Name
 }
 
-// GetInstallation returns the Installation field.
-func (i *IssueCommentEvent) GetInstallation() *Installation {
-	if i == nil {
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (l *License) GetPermissions() []string {
+	if l == nil || l.Permissions == nil {
 		return nil
 	}
-	return i.Installation
+	return *l.Permissions
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueCommentEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (l *License) GetSPDXID() string {
+	if l == nil || l.SPDXID == nil {
+		return ""
 	}
-	return i.Issue
+	return *l.SPDXID
 }
 
-// GetOrganization returns the Organization field.
-func (i *IssueCommentEvent) GetOrganization() *Organization {
-	if i == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *License) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
 	}
-	return i.Organization
+	return *l.URL
 }
 
-// GetRepo returns the Repo field.
-func (i *IssueCommentEvent) GetRepo() *Repository {
-	if i == nil {
-		return nil
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (l *LinearHistoryRequirementEnforcementLevelChanges) GetFrom() string {
+	if l == nil || l.From == nil {
+		return ""
 	}
-	return i.Repo
+	return *l.From
 }
 
-// GetSender returns the Sender field.
-func (i *IssueCommentEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetDirection returns the Direction field if it's non-nil, zero value otherwise.
+func (l *ListAlertsOptions) GetDirection() string {
+	if l == nil || l.Direction == nil {
+		return ""
 	}
-	return i.Sender
+	return *l.Direction
 }
 
-// GetActor returns the Actor field.
-func (i *IssueEvent) GetActor() *User {
-	if i == nil {
-		return nil
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (l *ListAlertsOptions) GetEcosystem() string {
+	if l == nil || l.Ecosystem == nil {
+		return ""
 	}
-	return i.Actor
+	return *l.Ecosystem
 }
 
-// GetAssignee returns the Assignee field.
-func (i *IssueEvent) GetAssignee() *User {
-	if i == nil {
-		return nil
+// GetEPSSPercentage returns the EPSSPercentage field if it's non-nil, zero value otherwise.
+func (l *ListAlertsOptions) GetEPSSPercentage() string {
+	if l == nil || l.EPSSPercentage == nil {
+		return ""
 	}
-	return i.Assignee
+	return *l.EPSSPercentage
 }
 
-// GetAssigner returns the Assigner field.
-func (i *IssueEvent) GetAssigner() *User {
-	if i == nil {
-		return nil
+// GetPackage returns the Package field if it's non-nil, zero value otherwise.
+func (l *ListAlertsOptions) GetPackage() string {
+	if l == nil || l.Package == nil {
+		return ""
 	}
-	return i.Assigner
+	return *l.Package
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCommitID() string {
-	if i == nil || i.CommitID == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (l *ListAlertsOptions) GetScope() string {
+	if l == nil || l.Scope == nil {
 		return ""
 	}
-	return *i.CommitID
+	return *l.Scope
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (l *ListAlertsOptions) GetSeverity() string {
+	if l == nil || l.Severity == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *l.Severity
 }
 
-// GetDismissedReview returns the DismissedReview field.
-func (i *IssueEvent) GetDismissedReview() *DismissedReview {
-	if i == nil {
-		return nil
+// GetSort returns the Sort field if it's non-nil, zero value otherwise.
+func (l *ListAlertsOptions) GetSort() string {
+	if l == nil || l.Sort == nil {
+		return ""
 	}
-	return i.DismissedReview
+	return *l.Sort
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetEvent() string {
-	if i == nil || i.Event == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (l *ListAlertsOptions) GetState() string {
+	if l == nil || l.State == nil {
 		return ""
 	}
-	return *i.Event
+	return *l.State
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetID() int64 {
-	if i == nil || i.ID == nil {
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetAppID() int64 {
+	if l == nil || l.AppID == nil {
 		return 0
 	}
-	return *i.ID
+	return *l.AppID
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetCheckName() string {
+	if l == nil || l.CheckName == nil {
+		return ""
 	}
-	return i.Issue
+	return *l.CheckName
 }
 
-// GetLabel returns the Label field.
-func (i *IssueEvent) GetLabel() *Label {
-	if i == nil {
-		return nil
+// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetFilter() string {
+	if l == nil || l.Filter == nil {
+		return ""
 	}
-	return i.Label
+	return *l.Filter
 }
 
-// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetLockReason() string {
-	if i == nil || i.LockReason == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetStatus() string {
+	if l == nil || l.Status == nil {
 		return ""
 	}
-	return *i.LockReason
+	return *l.Status
 }
 
-// GetMilestone returns the Milestone field.
-func (i *IssueEvent) GetMilestone() *Milestone {
-	if i == nil {
-		return nil
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsResults) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
 	}
-	return i.Milestone
+	return *l.Total
 }
 
-// GetProjectCard returns the ProjectCard field.
-func (i *IssueEvent) GetProjectCard() *ProjectCard {
-	if i == nil {
-		return nil
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteOptions) GetAppID() int {
+	if l == nil || l.AppID == nil {
+		return 0
 	}
-	return i.ProjectCard
+	return *l.AppID
 }
 
-// GetRename returns the Rename field.
-func (i *IssueEvent) GetRename() *Rename {
-	if i == nil {
-		return nil
+// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteOptions) GetCheckName() string {
+	if l == nil || l.CheckName == nil {
+		return ""
 	}
-	return i.Rename
+	return *l.CheckName
 }
 
-// GetRequestedReviewer returns the RequestedReviewer field.
-func (i *IssueEvent) GetRequestedReviewer() *User {
-	if i == nil {
-		return nil
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteResults) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
 	}
-	return i.RequestedReviewer
+	return *l.Total
 }
 
-// GetReviewRequester returns the ReviewRequester field.
-func (i *IssueEvent) GetReviewRequester() *User {
-	if i == nil {
-		return nil
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListCodespaces) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
 	}
-	return i.ReviewRequester
+	return *l.TotalCount
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetAffiliation returns the Affiliation field if it's non-nil, zero value otherwise.
+func (l *ListCollaboratorOptions) GetAffiliation() string {
+	if l == nil || l.Affiliation == nil {
 		return ""
 	}
-	return *i.URL
+	return *l.Affiliation
 }
 
-// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetAssignee() string {
-	if i == nil || i.Assignee == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListCustomDeploymentRuleIntegrationsResponse) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
 	}
-	return *i.Assignee
+	return *l.TotalCount
 }
 
-// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetClosed() bool {
-	if i == nil || i.Closed == nil {
-		return false
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListDeploymentProtectionRuleResponse) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
 	}
-	return *i.Closed
+	return *l.TotalCount
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetClosedAt() Timestamp {
-	if i == nil || i.ClosedAt == nil {
-		return Timestamp{}
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (l *ListExternalGroupsOptions) GetDisplayName() string {
+	if l == nil || l.DisplayName == nil {
+		return ""
 	}
-	return *i.ClosedAt
+	return *l.DisplayName
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetIsWithdrawn returns the IsWithdrawn field if it's non-nil, zero value otherwise.
+func (l *ListGlobalAdvisoriesOptions) GetIsWithdrawn() bool {
+	if l == nil || l.IsWithdrawn == nil {
+		return false
 	}
-	return *i.CreatedAt
+	return *l.IsWithdrawn
 }
 
-// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetMilestone() int {
-	if i == nil || i.Milestone == nil {
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListRepositories) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
 		return 0
 	}
-	return *i.Milestone
+	return *l.TotalCount
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetUpdatedAt() Timestamp {
-	if i == nil || i.UpdatedAt == nil {
-		return Timestamp{}
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (l *ListSCIMEnterpriseOptions) GetCount() int {
+	if l == nil || l.Count == nil {
+		return 0
 	}
-	return *i.UpdatedAt
+	return *l.Count
 }
 
-// GetCode returns the Code field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetCode() string {
-	if i == nil || i.Code == nil {
+// GetExcludedAttributes returns the ExcludedAttributes field if it's non-nil, zero value otherwise.
+func (l *ListSCIMEnterpriseOptions) GetExcludedAttributes() string {
+	if l == nil || l.ExcludedAttributes == nil {
 		return ""
 	}
-	return *i.Code
+	return *l.ExcludedAttributes
 }
 
-// GetField returns the Field field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetField() string {
-	if i == nil || i.Field == nil {
+// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
+func (l *ListSCIMEnterpriseOptions) GetFilter() string {
+	if l == nil || l.Filter == nil {
 		return ""
 	}
-	return *i.Field
+	return *l.Filter
 }
 
-// GetLocation returns the Location field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetLocation() string {
-	if i == nil || i.Location == nil {
-		return ""
+// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
+func (l *ListSCIMEnterpriseOptions) GetStartIndex() int {
+	if l == nil || l.StartIndex == nil {
+		return 0
 	}
-	return *i.Location
+	return *l.StartIndex
 }
 
-// GetResource returns the Resource field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetResource() string {
-	if i == nil || i.Resource == nil {
-		return ""
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (l *ListSCIMProvisionedIdentitiesOptions) GetCount() int {
+	if l == nil || l.Count == nil {
+		return 0
 	}
-	return *i.Resource
+	return *l.Count
 }
 
-// GetValue returns the Value field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetValue() string {
-	if i == nil || i.Value == nil {
+// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
+func (l *ListSCIMProvisionedIdentitiesOptions) GetFilter() string {
+	if l == nil || l.Filter == nil {
 		return ""
 	}
-	return *i.Value
+	return *l.Filter
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
+func (l *ListSCIMProvisionedIdentitiesOptions) GetStartIndex() int {
+	if l == nil || l.StartIndex == nil {
+		return 0
 	}
-	return *i.CreatedAt
+	return *l.StartIndex
 }
 
-// GetDocumentationURL returns the DocumentationURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetDocumentationURL() string {
-	if i == nil || i.DocumentationURL == nil {
-		return ""
+// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
+func (l *Location) GetEndColumn() int {
+	if l == nil || l.EndColumn == nil {
+		return 0
 	}
-	return *i.DocumentationURL
+	return *l.EndColumn
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetID() int {
-	if i == nil || i.ID == nil {
+// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
+func (l *Location) GetEndLine() int {
+	if l == nil || l.EndLine == nil {
 		return 0
 	}
-	return *i.ID
+	return *l.EndLine
 }
 
-// GetImportIssuesURL returns the ImportIssuesURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetImportIssuesURL() string {
-	if i == nil || i.ImportIssuesURL == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (l *Location) GetPath() string {
+	if l == nil || l.Path == nil {
 		return ""
 	}
-	return *i.ImportIssuesURL
+	return *l.Path
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetMessage() string {
-	if i == nil || i.Message == nil {
-		return ""
+// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
+func (l *Location) GetStartColumn() int {
+	if l == nil || l.StartColumn == nil {
+		return 0
 	}
-	return *i.Message
+	return *l.StartColumn
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
-		return ""
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (l *Location) GetStartLine() int {
+	if l == nil || l.StartLine == nil {
+		return 0
 	}
-	return *i.RepositoryURL
+	return *l.StartLine
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetStatus() string {
-	if i == nil || i.Status == nil {
-		return ""
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (l *LockBranch) GetEnabled() bool {
+	if l == nil || l.Enabled == nil {
+		return false
 	}
-	return *i.Status
+	return *l.Enabled
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetUpdatedAt() Timestamp {
-	if i == nil || i.UpdatedAt == nil {
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetEffectiveDate() Timestamp {
+	if m == nil || m.EffectiveDate == nil {
 		return Timestamp{}
 	}
-	return *i.UpdatedAt
+	return *m.EffectiveDate
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetURL() string {
-	if i == nil || i.URL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
 	}
-	return *i.URL
+	return *m.ID
 }
 
-// GetDirection returns the Direction field if it's non-nil, zero value otherwise.
-func (i *IssueListCommentsOptions) GetDirection() string {
-	if i == nil || i.Direction == nil {
-		return ""
+// GetPlan returns the Plan field.
+func (m *MarketplacePendingChange) GetPlan() *MarketplacePlan {
+	if m == nil {
+		return nil
 	}
-	return *i.Direction
+	return m.Plan
 }
 
-// GetSince returns the Since field if it's non-nil, zero value otherwise.
-func (i *IssueListCommentsOptions) GetSince() time.Time {
-	if i == nil || i.Since == nil {
-		return time.Time{}
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
 	}
-	return *i.Since
+	return *m.UnitCount
 }
 
-// GetSort returns the Sort field if it's non-nil, zero value otherwise.
-func (i *IssueListCommentsOptions) GetSort() string {
-	if i == nil || i.Sort == nil {
+// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetAccountsURL() string {
+	if m == nil || m.AccountsURL == nil {
 		return ""
 	}
-	return *i.Sort
+	return *m.AccountsURL
 }
 
-// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetAssignee() string {
-	if i == nil || i.Assignee == nil {
+// GetBullets returns the Bullets field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetBullets() []string {
+	if m == nil || m.Bullets == nil {
+		return nil
+	}
+	return *m.Bullets
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetDescription() string {
+	if m == nil || m.Description == nil {
 		return ""
 	}
-	return *i.Assignee
+	return *m.Description
 }
 
-// GetAssignees returns the Assignees field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetAssignees() []string {
-	if i == nil || i.Assignees == nil {
-		return nil
+// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetHasFreeTrial() bool {
+	if m == nil || m.HasFreeTrial == nil {
+		return false
 	}
-	return *i.Assignees
+	return *m.HasFreeTrial
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetBody() string {
-	if i == nil || i.Body == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
 	}
-	return *i.Body
+	return *m.ID
 }
 
-// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetLabels() []string {
-	if i == nil || i.Labels == nil {
-		return nil
+// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetMonthlyPriceInCents() int {
+	if m == nil || m.MonthlyPriceInCents == nil {
+		return 0
 	}
-	return *i.Labels
+	return *m.MonthlyPriceInCents
 }
 
-// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetMilestone() int {
-	if i == nil || i.Milestone == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetNumber() int {
+	if m == nil || m.Number == nil {
 		return 0
 	}
-	return *i.Milestone
+	return *m.Number
+}
+
+// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetPriceModel() string {
+	if m == nil || m.PriceModel == nil {
+		return ""
+	}
+	return *m.PriceModel
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetState() string {
-	if i == nil || i.State == nil {
+func (m *MarketplacePlan) GetState() string {
+	if m == nil || m.State == nil {
 		return ""
 	}
-	return *i.State
+	return *m.State
 }
 
-// GetStateReason returns the StateReason field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetStateReason() string {
-	if i == nil || i.StateReason == nil {
+// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetUnitName() string {
+	if m == nil || m.UnitName == nil {
 		return ""
 	}
-	return *i.StateReason
+	return *m.UnitName
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetTitle() string {
-	if i == nil || i.Title == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetURL() string {
+	if m == nil || m.URL == nil {
 		return ""
 	}
-	return *i.Title
+	return *m.URL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *IssuesEvent) GetAction() string {
-	if i == nil || i.Action == nil {
-		return ""
+// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetYearlyPriceInCents() int {
+	if m == nil || m.YearlyPriceInCents == nil {
+		return 0
 	}
-	return *i.Action
+	return *m.YearlyPriceInCents
 }
 
-// GetAssignee returns the Assignee field.
-func (i *IssuesEvent) GetAssignee() *User {
-	if i == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
 	}
-	return i.Assignee
+	return *m.ID
 }
 
-// GetChanges returns the Changes field.
-func (i *IssuesEvent) GetChanges() *EditChange {
-	if i == nil {
-		return nil
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetLogin() string {
+	if m == nil || m.Login == nil {
+		return ""
 	}
-	return i.Changes
+	return *m.Login
 }
 
-// GetInstallation returns the Installation field.
-func (i *IssuesEvent) GetInstallation() *Installation {
-	if i == nil {
+// GetMarketplacePendingChange returns the MarketplacePendingChange field.
+func (m *MarketplacePlanAccount) GetMarketplacePendingChange() *MarketplacePendingChange {
+	if m == nil {
 		return nil
 	}
-	return i.Installation
+	return m.MarketplacePendingChange
 }
 
-// GetIssue returns the Issue field.
-func (i *IssuesEvent) GetIssue() *Issue {
-	if i == nil {
+// GetMarketplacePurchase returns the MarketplacePurchase field.
+func (m *MarketplacePlanAccount) GetMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
 		return nil
 	}
-	return i.Issue
+	return m.MarketplacePurchase
 }
 
-// GetLabel returns the Label field.
-func (i *IssuesEvent) GetLabel() *Label {
-	if i == nil {
-		return nil
+// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetOrganizationBillingEmail() string {
+	if m == nil || m.OrganizationBillingEmail == nil {
+		return ""
 	}
-	return i.Label
+	return *m.OrganizationBillingEmail
 }
 
-// GetMilestone returns the Milestone field.
-func (i *IssuesEvent) GetMilestone() *Milestone {
-	if i == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
 	}
-	return i.Milestone
+	return *m.Type
 }
 
-// GetRepo returns the Repo field.
-func (i *IssuesEvent) GetRepo() *Repository {
-	if i == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
 	}
-	return i.Repo
+	return *m.URL
 }
 
-// GetSender returns the Sender field.
-func (i *IssuesEvent) GetSender() *User {
-	if i == nil {
+// GetAccount returns the Account field.
+func (m *MarketplacePurchase) GetAccount() *MarketplacePurchaseAccount {
+	if m == nil {
 		return nil
 	}
-	return i.Sender
+	return m.Account
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (i *IssuesSearchResult) GetIncompleteResults() bool {
-	if i == nil || i.IncompleteResults == nil {
-		return false
+// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetBillingCycle() string {
+	if m == nil || m.BillingCycle == nil {
+		return ""
 	}
-	return *i.IncompleteResults
+	return *m.BillingCycle
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (i *IssuesSearchResult) GetTotal() int {
-	if i == nil || i.Total == nil {
-		return 0
+// GetFreeTrialEndsOn returns the FreeTrialEndsOn field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetFreeTrialEndsOn() Timestamp {
+	if m == nil || m.FreeTrialEndsOn == nil {
+		return Timestamp{}
 	}
-	return *i.Total
+	return *m.FreeTrialEndsOn
 }
 
-// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetClosedIssues() int {
-	if i == nil || i.ClosedIssues == nil {
-		return 0
+// GetNextBillingDate returns the NextBillingDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetNextBillingDate() Timestamp {
+	if m == nil || m.NextBillingDate == nil {
+		return Timestamp{}
 	}
-	return *i.ClosedIssues
+	return *m.NextBillingDate
 }
 
-// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetOpenIssues() int {
-	if i == nil || i.OpenIssues == nil {
-		return 0
+// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetOnFreeTrial() bool {
+	if m == nil || m.OnFreeTrial == nil {
+		return false
 	}
-	return *i.OpenIssues
+	return *m.OnFreeTrial
 }
 
-// GetTotalIssues returns the TotalIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetTotalIssues() int {
-	if i == nil || i.TotalIssues == nil {
-		return 0
+// GetPlan returns the Plan field.
+func (m *MarketplacePurchase) GetPlan() *MarketplacePlan {
+	if m == nil {
+		return nil
 	}
-	return *i.TotalIssues
+	return m.Plan
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (j *Jobs) GetTotalCount() int {
-	if j == nil || j.TotalCount == nil {
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
 		return 0
 	}
-	return *j.TotalCount
+	return *m.UnitCount
 }
 
-// GetAddedBy returns the AddedBy field if it's non-nil, zero value otherwise.
-func (k *Key) GetAddedBy() string {
-	if k == nil || k.AddedBy == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetUpdatedAt() Timestamp {
+	if m == nil || m.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *k.AddedBy
+	return *m.UpdatedAt
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (k *Key) GetCreatedAt() Timestamp {
-	if k == nil || k.CreatedAt == nil {
-		return Timestamp{}
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseAccount) GetEmail() string {
+	if m == nil || m.Email == nil {
+		return ""
 	}
-	return *k.CreatedAt
+	return *m.Email
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (k *Key) GetID() int64 {
-	if k == nil || k.ID == nil {
+func (m *MarketplacePurchaseAccount) GetID() int64 {
+	if m == nil || m.ID == nil {
 		return 0
 	}
-	return *k.ID
+	return *m.ID
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (k *Key) GetKey() string {
-	if k == nil || k.Key == nil {
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseAccount) GetLogin() string {
+	if m == nil || m.Login == nil {
 		return ""
 	}
-	return *k.Key
+	return *m.Login
 }
 
-// GetLastUsed returns the LastUsed field if it's non-nil, zero value otherwise.
-func (k *Key) GetLastUsed() Timestamp {
-	if k == nil || k.LastUsed == nil {
-		return Timestamp{}
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseAccount) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
+		return ""
 	}
-	return *k.LastUsed
+	return *m.NodeID
 }
 
-// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
-func (k *Key) GetReadOnly() bool {
-	if k == nil || k.ReadOnly == nil {
-		return false
+// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseAccount) GetOrganizationBillingEmail() string {
+	if m == nil || m.OrganizationBillingEmail == nil {
+		return ""
 	}
-	return *k.ReadOnly
+	return *m.OrganizationBillingEmail
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (k *Key) GetTitle() string {
-	if k == nil || k.Title == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseAccount) GetType() string {
+	if m == nil || m.Type == nil {
 		return ""
 	}
-	return *k.Title
+	return *m.Type
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (k *Key) GetURL() string {
-	if k == nil || k.URL == nil {
+func (m *MarketplacePurchaseAccount) GetURL() string {
+	if m == nil || m.URL == nil {
 		return ""
 	}
-	return *k.URL
-}
-
-// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
-func (k *Key) GetVerified() bool {
-	if k == nil || k.Verified == nil {
-		return false
-	}
-	return *k.Verified
+	return *m.URL
 }
 
-// GetColor returns the Color field if it's non-nil, zero value otherwise.
-func (l *Label) GetColor() string {
-	if l == nil || l.Color == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseEvent) GetAction() string {
+	if m == nil || m.Action == nil {
 		return ""
 	}
-	return *l.Color
+	return *m.Action
 }
 
-// GetDefault returns the Default field if it's non-nil, zero value otherwise.
-func (l *Label) GetDefault() bool {
-	if l == nil || l.Default == nil {
-		return false
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseEvent) GetEffectiveDate() Timestamp {
+	if m == nil || m.EffectiveDate == nil {
+		return Timestamp{}
 	}
-	return *l.Default
+	return *m.EffectiveDate
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *Label) GetDescription() string {
-	if l == nil || l.Description == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (m *MarketplacePurchaseEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
 	}
-	return *l.Description
+	return m.Installation
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *Label) GetID() int64 {
-	if l == nil || l.ID == nil {
-		return 0
+// GetMarketplacePurchase returns the MarketplacePurchase field.
+func (m *MarketplacePurchaseEvent) GetMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
 	}
-	return *l.ID
+	return m.MarketplacePurchase
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *Label) GetName() string {
-	if l == nil || l.Name == nil {
-		return ""
+// GetPreviousMarketplacePurchase returns the PreviousMarketplacePurchase field.
+func (m *MarketplacePurchaseEvent) GetPreviousMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
 	}
-	return *l.Name
+	return m.PreviousMarketplacePurchase
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (l *Label) GetNodeID() string {
-	if l == nil || l.NodeID == nil {
-		return ""
+// GetSender returns the Sender field.
+func (m *MarketplacePurchaseEvent) GetSender() *User {
+	if m == nil {
+		return nil
 	}
-	return *l.NodeID
+	return m.Sender
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *Label) GetURL() string {
-	if l == nil || l.URL == nil {
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (m *Match) GetText() string {
+	if m == nil || m.Text == nil {
 		return ""
 	}
-	return *l.URL
+	return *m.Text
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (l *LabelEvent) GetAction() string {
-	if l == nil || l.Action == nil {
+func (m *MemberEvent) GetAction() string {
+	if m == nil || m.Action == nil {
 		return ""
 	}
-	return *l.Action
-}
-
-// GetChanges returns the Changes field.
-func (l *LabelEvent) GetChanges() *EditChange {
-	if l == nil {
-		return nil
-	}
-	return l.Changes
+	return *m.Action
 }
 
 // GetInstallation returns the Installation field.
-func (l *LabelEvent) GetInstallation() *Installation {
-	if l == nil {
+func (m *MemberEvent) GetInstallation() *Installation {
+	if m == nil {
 		return nil
 	}
-	return l.Installation
+	return m.Installation
 }
 
-// GetLabel returns the Label field.
-func (l *LabelEvent) GetLabel() *Label {
-	if l == nil {
+// GetMember returns the Member field.
+func (m *MemberEvent) GetMember() *User {
+	if m == nil {
 		return nil
 	}
-	return l.Label
+	return m.Member
 }
 
 // GetOrg returns the Org field.
-func (l *LabelEvent) GetOrg() *Organization {
-	if l == nil {
+func (m *MemberEvent) GetOrg() *Organization {
+	if m == nil {
 		return nil
 	}
-	return l.Org
+	return m.Org
 }
 
 // GetRepo returns the Repo field.
-func (l *LabelEvent) GetRepo() *Repository {
-	if l == nil {
+func (m *MemberEvent) GetRepo() *Repository {
+	if m == nil {
 		return nil
 	}
-	return l.Repo
+	return m.Repo
 }
 
 // GetSender returns the Sender field.
-func (l *LabelEvent) GetSender() *User {
-	if l == nil {
+func (m *MemberEvent) GetSender() *User {
+	if m == nil {
 		return nil
 	}
-	return l.Sender
+	return m.Sender
 }
 
-// GetColor returns the Color field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetColor() string {
-	if l == nil || l.Color == nil {
-		return ""
+// GetOrganization returns the Organization field.
+func (m *Membership) GetOrganization() *Organization {
+	if m == nil {
+		return nil
 	}
-	return *l.Color
+	return m.Organization
 }
 
-// GetDefault returns the Default field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetDefault() bool {
-	if l == nil || l.Default == nil {
-		return false
+// GetOrganizationURL returns the OrganizationURL field if it's non-nil, zero value otherwise.
+func (m *Membership) GetOrganizationURL() string {
+	if m == nil || m.OrganizationURL == nil {
+		return ""
 	}
-	return *l.Default
+	return *m.OrganizationURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (m *Membership) GetRole() string {
+	if m == nil || m.Role == nil {
 		return ""
 	}
-	return *l.Description
+	return *m.Role
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetID() int64 {
-	if l == nil || l.ID == nil {
-		return 0
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Membership) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
 	}
-	return *l.ID
+	return *m.State
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetName() string {
-	if l == nil || l.Name == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Membership) GetURL() string {
+	if m == nil || m.URL == nil {
 		return ""
 	}
-	return *l.Name
+	return *m.URL
 }
 
-// GetScore returns the Score field.
-func (l *LabelResult) GetScore() *float64 {
-	if l == nil {
+// GetUser returns the User field.
+func (m *Membership) GetUser() *User {
+	if m == nil {
 		return nil
 	}
-	return l.Score
+	return m.User
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetURL() string {
-	if l == nil || l.URL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MembershipEvent) GetAction() string {
+	if m == nil || m.Action == nil {
 		return ""
 	}
-	return *l.URL
+	return *m.Action
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (l *LabelsSearchResult) GetIncompleteResults() bool {
-	if l == nil || l.IncompleteResults == nil {
-		return false
+// GetInstallation returns the Installation field.
+func (m *MembershipEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
 	}
-	return *l.IncompleteResults
+	return m.Installation
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *LabelsSearchResult) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
+// GetMember returns the Member field.
+func (m *MembershipEvent) GetMember() *User {
+	if m == nil {
+		return nil
 	}
-	return *l.Total
+	return m.Member
 }
 
-// GetOID returns the OID field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetOID() string {
-	if l == nil || l.OID == nil {
-		return ""
+// GetOrg returns the Org field.
+func (m *MembershipEvent) GetOrg() *Organization {
+	if m == nil {
+		return nil
 	}
-	return *l.OID
+	return m.Org
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetPath() string {
-	if l == nil || l.Path == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (m *MembershipEvent) GetScope() string {
+	if m == nil || m.Scope == nil {
 		return ""
 	}
-	return *l.Path
+	return *m.Scope
 }
 
-// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetRefName() string {
-	if l == nil || l.RefName == nil {
-		return ""
+// GetSender returns the Sender field.
+func (m *MembershipEvent) GetSender() *User {
+	if m == nil {
+		return nil
 	}
-	return *l.RefName
+	return m.Sender
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetSize() int {
-	if l == nil || l.Size == nil {
-		return 0
+// GetTeam returns the Team field.
+func (m *MembershipEvent) GetTeam() *Team {
+	if m == nil {
+		return nil
 	}
-	return *l.Size
+	return m.Team
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (l *License) GetBody() string {
-	if l == nil || l.Body == nil {
+// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetBaseRef() string {
+	if m == nil || m.BaseRef == nil {
 		return ""
 	}
-	return *l.Body
+	return *m.BaseRef
 }
 
-// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
-func (l *License) GetConditions() []string {
-	if l == nil || l.Conditions == nil {
+// GetBaseSHA returns the BaseSHA field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetBaseSHA() string {
+	if m == nil || m.BaseSHA == nil {
+		return ""
+	}
+	return *m.BaseSHA
+}
+
+// GetHeadCommit returns the HeadCommit field.
+func (m *MergeGroup) GetHeadCommit() *Commit {
+	if m == nil {
 		return nil
 	}
-	return *l.Conditions
+	return m.HeadCommit
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *License) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetHeadRef returns the HeadRef field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetHeadRef() string {
+	if m == nil || m.HeadRef == nil {
 		return ""
 	}
-	return *l.Description
+	return *m.HeadRef
 }
 
-// GetFeatured returns the Featured field if it's non-nil, zero value otherwise.
-func (l *License) GetFeatured() bool {
-	if l == nil || l.Featured == nil {
-		return false
+// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetHeadSHA() string {
+	if m == nil || m.HeadSHA == nil {
+		return ""
 	}
-	return *l.Featured
+	return *m.HeadSHA
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (l *License) GetHTMLURL() string {
-	if l == nil || l.HTMLURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MergeGroupEvent) GetAction() string {
+	if m == nil || m.Action == nil {
 		return ""
 	}
-	return *l.HTMLURL
+	return *m.Action
 }
 
-// GetImplementation returns the Implementation field if it's non-nil, zero value otherwise.
-func (l *License) GetImplementation() string {
-	if l == nil || l.Implementation == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (m *MergeGroupEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
 	}
-	return *l.Implementation
+	return m.Installation
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (l *License) GetKey() string {
-	if l == nil || l.Key == nil {
-		return ""
+// GetMergeGroup returns the MergeGroup field.
+func (m *MergeGroupEvent) GetMergeGroup() *MergeGroup {
+	if m == nil {
+		return nil
 	}
-	return *l.Key
+	return m.MergeGroup
 }
 
-// GetLimitations returns the Limitations field if it's non-nil, zero value otherwise.
-func (l *License) GetLimitations() []string {
-	if l == nil || l.Limitations == nil {
+// GetOrg returns the Org field.
+func (m *MergeGroupEvent) GetOrg() *Organization {
+	if m == nil {
 		return nil
 	}
-	return *l.Limitations
+	return m.Org
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *License) GetName() string {
-	if l == nil || l.Name == nil {
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (m *MergeGroupEvent) GetReason() string {
+	if m == nil || m.Reason == nil {
 		return ""
 	}
-	return *l.Name
+	return *m.Reason
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (l *License) GetPermissions() []string {
-	if l == nil || l.Permissions == nil {
+// GetRepo returns the Repo field.
+func (m *MergeGroupEvent) GetRepo() *Repository {
+	if m == nil {
 		return nil
 	}
-	return *l.Permissions
+	return m.Repo
 }
 
-// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
-func (l *License) GetSPDXID() string {
-	if l == nil || l.SPDXID == nil {
-		return ""
+// GetSender returns the Sender field.
+func (m *MergeGroupEvent) GetSender() *User {
+	if m == nil {
+		return nil
 	}
-	return *l.SPDXID
+	return m.Sender
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *License) GetURL() string {
-	if l == nil || l.URL == nil {
-		return ""
+// GetEnqueuedAt returns the EnqueuedAt field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetEnqueuedAt() Timestamp {
+	if m == nil || m.EnqueuedAt == nil {
+		return Timestamp{}
 	}
-	return *l.URL
+	return *m.EnqueuedAt
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (l *LinearHistoryRequirementEnforcementLevelChanges) GetFrom() string {
-	if l == nil || l.From == nil {
-		return ""
+// GetEstimatedTimeToMerge returns the EstimatedTimeToMerge field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetEstimatedTimeToMerge() int {
+	if m == nil || m.EstimatedTimeToMerge == nil {
+		return 0
 	}
-	return *l.From
+	return *m.EstimatedTimeToMerge
 }
 
-// GetDirection returns the Direction field if it's non-nil, zero value otherwise.
-func (l *ListAlertsOptions) GetDirection() string {
-	if l == nil || l.Direction == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetID() string {
+	if m == nil || m.ID == nil {
 		return ""
 	}
-	return *l.Direction
+	return *m.ID
 }
 
-// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
-func (l *ListAlertsOptions) GetEcosystem() string {
-	if l == nil || l.Ecosystem == nil {
-		return ""
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetPosition() int {
+	if m == nil || m.Position == nil {
+		return 0
 	}
-	return *l.Ecosystem
+	return *m.Position
 }
 
-// GetPackage returns the Package field if it's non-nil, zero value otherwise.
-func (l *ListAlertsOptions) GetPackage() string {
-	if l == nil || l.Package == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetState() string {
+	if m == nil || m.State == nil {
 		return ""
 	}
-	return *l.Package
+	return *m.State
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (l *ListAlertsOptions) GetScope() string {
-	if l == nil || l.Scope == nil {
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (m *Message) GetText() string {
+	if m == nil || m.Text == nil {
 		return ""
 	}
-	return *l.Scope
+	return *m.Text
 }
 
-// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
-func (l *ListAlertsOptions) GetSeverity() string {
-	if l == nil || l.Severity == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MetaEvent) GetAction() string {
+	if m == nil || m.Action == nil {
 		return ""
 	}
-	return *l.Severity
+	return *m.Action
 }
 
-// GetSort returns the Sort field if it's non-nil, zero value otherwise.
-func (l *ListAlertsOptions) GetSort() string {
-	if l == nil || l.Sort == nil {
-		return ""
+// GetHook returns the Hook field.
+func (m *MetaEvent) GetHook() *Hook {
+	if m == nil {
+		return nil
 	}
-	return *l.Sort
+	return m.Hook
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (l *ListAlertsOptions) GetState() string {
-	if l == nil || l.State == nil {
-		return ""
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (m *MetaEvent) GetHookID() int64 {
+	if m == nil || m.HookID == nil {
+		return 0
 	}
-	return *l.State
+	return *m.HookID
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetAppID() int64 {
-	if l == nil || l.AppID == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (m *MetaEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
 	}
-	return *l.AppID
+	return m.Installation
 }
 
-// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetCheckName() string {
-	if l == nil || l.CheckName == nil {
-		return ""
+// GetOrg returns the Org field.
+func (m *MetaEvent) GetOrg() *Organization {
+	if m == nil {
+		return nil
 	}
-	return *l.CheckName
+	return m.Org
 }
 
-// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetFilter() string {
-	if l == nil || l.Filter == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (m *MetaEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
 	}
-	return *l.Filter
+	return m.Repo
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetStatus() string {
-	if l == nil || l.Status == nil {
-		return ""
+// GetSender returns the Sender field.
+func (m *MetaEvent) GetSender() *User {
+	if m == nil {
+		return nil
 	}
-	return *l.Status
+	return m.Sender
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsResults) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Metric) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
+		return ""
 	}
-	return *l.Total
+	return *m.HTMLURL
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteOptions) GetAppID() int {
-	if l == nil || l.AppID == nil {
-		return 0
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (m *Metric) GetKey() string {
+	if m == nil || m.Key == nil {
+		return ""
 	}
-	return *l.AppID
+	return *m.Key
 }
 
-// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteOptions) GetCheckName() string {
-	if l == nil || l.CheckName == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *Metric) GetName() string {
+	if m == nil || m.Name == nil {
 		return ""
 	}
-	return *l.CheckName
+	return *m.Name
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteResults) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *Metric) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
+		return ""
 	}
-	return *l.Total
+	return *m.NodeID
 }
 
-// GetAffiliation returns the Affiliation field if it's non-nil, zero value otherwise.
-func (l *ListCollaboratorOptions) GetAffiliation() string {
-	if l == nil || l.Affiliation == nil {
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (m *Metric) GetSPDXID() string {
+	if m == nil || m.SPDXID == nil {
 		return ""
 	}
-	return *l.Affiliation
+	return *m.SPDXID
 }
 
-// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
-func (l *ListExternalGroupsOptions) GetDisplayName() string {
-	if l == nil || l.DisplayName == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Metric) GetURL() string {
+	if m == nil || m.URL == nil {
 		return ""
 	}
-	return *l.DisplayName
+	return *m.URL
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (l *ListRepositories) GetTotalCount() int {
-	if l == nil || l.TotalCount == nil {
-		return 0
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (m *Migration) GetArchiveURL() string {
+	if m == nil || m.ArchiveURL == nil {
+		return ""
 	}
-	return *l.TotalCount
+	return *m.ArchiveURL
 }
 
-// GetCount returns the Count field if it's non-nil, zero value otherwise.
-func (l *ListSCIMProvisionedIdentitiesOptions) GetCount() int {
-	if l == nil || l.Count == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (m *Migration) GetCreatedAt() string {
+	if m == nil || m.CreatedAt == nil {
+		return ""
 	}
-	return *l.Count
+	return *m.CreatedAt
 }
 
-// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
-func (l *ListSCIMProvisionedIdentitiesOptions) GetFilter() string {
-	if l == nil || l.Filter == nil {
-		return ""
+// GetExcludeAttachments returns the ExcludeAttachments field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeAttachments() bool {
+	if m == nil || m.ExcludeAttachments == nil {
+		return false
 	}
-	return *l.Filter
+	return *m.ExcludeAttachments
 }
 
-// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
-func (l *ListSCIMProvisionedIdentitiesOptions) GetStartIndex() int {
-	if l == nil || l.StartIndex == nil {
-		return 0
+// GetExcludeGitData returns the ExcludeGitData field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeGitData() bool {
+	if m == nil || m.ExcludeGitData == nil {
+		return false
 	}
-	return *l.StartIndex
+	return *m.ExcludeGitData
 }
 
-// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
-func (l *Location) GetEndColumn() int {
-	if l == nil || l.EndColumn == nil {
-		return 0
+// GetExcludeMetadata returns the ExcludeMetadata field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeMetadata() bool {
+	if m == nil || m.ExcludeMetadata == nil {
+		return false
 	}
-	return *l.EndColumn
+	return *m.ExcludeMetadata
 }
 
-// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
-func (l *Location) GetEndLine() int {
-	if l == nil || l.EndLine == nil {
-		return 0
+// GetExcludeOwnerProjects returns the ExcludeOwnerProjects field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeOwnerProjects() bool {
+	if m == nil || m.ExcludeOwnerProjects == nil {
+		return false
 	}
-	return *l.EndLine
+	return *m.ExcludeOwnerProjects
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (l *Location) GetPath() string {
-	if l == nil || l.Path == nil {
+// GetExcludeReleases returns the ExcludeReleases field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeReleases() bool {
+	if m == nil || m.ExcludeReleases == nil {
+		return false
+	}
+	return *m.ExcludeReleases
+}
+
+// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
+func (m *Migration) GetGUID() string {
+	if m == nil || m.GUID == nil {
 		return ""
 	}
-	return *l.Path
+	return *m.GUID
 }
 
-// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
-func (l *Location) GetStartColumn() int {
-	if l == nil || l.StartColumn == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Migration) GetID() int64 {
+	if m == nil || m.ID == nil {
 		return 0
 	}
-	return *l.StartColumn
+	return *m.ID
 }
 
-// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
-func (l *Location) GetStartLine() int {
-	if l == nil || l.StartLine == nil {
-		return 0
+// GetLockRepositories returns the LockRepositories field if it's non-nil, zero value otherwise.
+func (m *Migration) GetLockRepositories() bool {
+	if m == nil || m.LockRepositories == nil {
+		return false
 	}
-	return *l.StartLine
+	return *m.LockRepositories
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (l *LockBranch) GetEnabled() bool {
-	if l == nil || l.Enabled == nil {
+// GetOrgMetadataOnly returns the OrgMetadataOnly field if it's non-nil, zero value otherwise.
+func (m *Migration) GetOrgMetadataOnly() bool {
+	if m == nil || m.OrgMetadataOnly == nil {
 		return false
 	}
-	return *l.Enabled
+	return *m.OrgMetadataOnly
 }
 
-// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetEffectiveDate() Timestamp {
-	if m == nil || m.EffectiveDate == nil {
-		return Timestamp{}
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Migration) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
 	}
-	return *m.EffectiveDate
+	return *m.State
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *Migration) GetUpdatedAt() string {
+	if m == nil || m.UpdatedAt == nil {
+		return ""
 	}
-	return *m.ID
+	return *m.UpdatedAt
 }
 
-// GetPlan returns the Plan field.
-func (m *MarketplacePendingChange) GetPlan() *MarketplacePlan {
-	if m == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Migration) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
 	}
-	return m.Plan
+	return *m.URL
 }
 
-// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetUnitCount() int {
-	if m == nil || m.UnitCount == nil {
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedAt() Timestamp {
+	if m == nil || m.ClosedAt == nil {
+		return Timestamp{}
+	}
+	return *m.ClosedAt
+}
+
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedIssues() int {
+	if m == nil || m.ClosedIssues == nil {
 		return 0
 	}
-	return *m.UnitCount
+	return *m.ClosedIssues
 }
 
-// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetAccountsURL() string {
-	if m == nil || m.AccountsURL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetCreatedAt() Timestamp {
+	if m == nil || m.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.AccountsURL
+	return *m.CreatedAt
 }
 
-// GetBullets returns the Bullets field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetBullets() []string {
-	if m == nil || m.Bullets == nil {
+// GetCreator returns the Creator field.
+func (m *Milestone) GetCreator() *User {
+	if m == nil {
 		return nil
 	}
-	return *m.Bullets
+	return m.Creator
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetDescription() string {
+func (m *Milestone) GetDescription() string {
 	if m == nil || m.Description == nil {
 		return ""
 	}
 	return *m.Description
 }
 
-// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetHasFreeTrial() bool {
-	if m == nil || m.HasFreeTrial == nil {
-		return false
+// GetDueOn returns the DueOn field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetDueOn() Timestamp {
+	if m == nil || m.DueOn == nil {
+		return Timestamp{}
 	}
-	return *m.HasFreeTrial
+	return *m.DueOn
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
+		return ""
+	}
+	return *m.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetID() int64 {
+func (m *Milestone) GetID() int64 {
 	if m == nil || m.ID == nil {
 		return 0
 	}
 	return *m.ID
 }
 
-// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetMonthlyPriceInCents() int {
-	if m == nil || m.MonthlyPriceInCents == nil {
-		return 0
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetLabelsURL() string {
+	if m == nil || m.LabelsURL == nil {
+		return ""
 	}
-	return *m.MonthlyPriceInCents
+	return *m.LabelsURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetName() string {
-	if m == nil || m.Name == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
 		return ""
 	}
-	return *m.Name
+	return *m.NodeID
 }
 
 // GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetNumber() int {
+func (m *Milestone) GetNumber() int {
 	if m == nil || m.Number == nil {
 		return 0
 	}
 	return *m.Number
 }
 
-// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetPriceModel() string {
-	if m == nil || m.PriceModel == nil {
-		return ""
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetOpenIssues() int {
+	if m == nil || m.OpenIssues == nil {
+		return 0
 	}
-	return *m.PriceModel
+	return *m.OpenIssues
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetState() string {
+func (m *Milestone) GetState() string {
 	if m == nil || m.State == nil {
 		return ""
 	}
 	return *m.State
 }
 
-// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetUnitName() string {
-	if m == nil || m.UnitName == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetTitle() string {
+	if m == nil || m.Title == nil {
 		return ""
 	}
-	return *m.UnitName
+	return *m.Title
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetUpdatedAt() Timestamp {
+	if m == nil || m.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *m.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetURL() string {
+func (m *Milestone) GetURL() string {
 	if m == nil || m.URL == nil {
 		return ""
 	}
 	return *m.URL
 }
 
-// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetYearlyPriceInCents() int {
-	if m == nil || m.YearlyPriceInCents == nil {
-		return 0
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MilestoneEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
 	}
-	return *m.YearlyPriceInCents
+	return *m.Action
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetChanges returns the Changes field.
+func (m *MilestoneEvent) GetChanges() *EditChange {
+	if m == nil {
+		return nil
 	}
-	return *m.ID
+	return m.Changes
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetLogin() string {
-	if m == nil || m.Login == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (m *MilestoneEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
 	}
-	return *m.Login
+	return m.Installation
 }
 
-// GetMarketplacePendingChange returns the MarketplacePendingChange field.
-func (m *MarketplacePlanAccount) GetMarketplacePendingChange() *MarketplacePendingChange {
+// GetMilestone returns the Milestone field.
+func (m *MilestoneEvent) GetMilestone() *Milestone {
 	if m == nil {
 		return nil
 	}
-	return m.MarketplacePendingChange
+	return m.Milestone
 }
 
-// GetMarketplacePurchase returns the MarketplacePurchase field.
-func (m *MarketplacePlanAccount) GetMarketplacePurchase() *MarketplacePurchase {
+// GetOrg returns the Org field.
+func (m *MilestoneEvent) GetOrg() *Organization {
 	if m == nil {
 		return nil
 	}
-	return m.MarketplacePurchase
+	return m.Org
 }
 
-// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetOrganizationBillingEmail() string {
-	if m == nil || m.OrganizationBillingEmail == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (m *MilestoneEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
 	}
-	return *m.OrganizationBillingEmail
+	return m.Repo
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetType() string {
-	if m == nil || m.Type == nil {
-		return ""
+// GetSender returns the Sender field.
+func (m *MilestoneEvent) GetSender() *User {
+	if m == nil {
+		return nil
 	}
-	return *m.Type
+	return m.Sender
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetURL() string {
-	if m == nil || m.URL == nil {
-		return ""
+// GetMilestone returns the Milestone field.
+func (m *MilestoneProgress) GetMilestone() *Milestone {
+	if m == nil {
+		return nil
 	}
-	return *m.URL
+	return m.Milestone
 }
 
-// GetAccount returns the Account field.
-func (m *MarketplacePurchase) GetAccount() *MarketplacePurchaseAccount {
-	if m == nil {
-		return nil
+// GetClosedMilestones returns the ClosedMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetClosedMilestones() int {
+	if m == nil || m.ClosedMilestones == nil {
+		return 0
+	}
+	return *m.ClosedMilestones
+}
+
+// GetOpenMilestones returns the OpenMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetOpenMilestones() int {
+	if m == nil || m.OpenMilestones == nil {
+		return 0
+	}
+	return *m.OpenMilestones
+}
+
+// GetTotalMilestones returns the TotalMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetTotalMilestones() int {
+	if m == nil || m.TotalMilestones == nil {
+		return 0
 	}
-	return m.Account
+	return *m.TotalMilestones
 }
 
-// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetBillingCycle() string {
-	if m == nil || m.BillingCycle == nil {
+// GetAnalysisKey returns the AnalysisKey field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetAnalysisKey() string {
+	if m == nil || m.AnalysisKey == nil {
 		return ""
 	}
-	return *m.BillingCycle
+	return *m.AnalysisKey
 }
 
-// GetFreeTrialEndsOn returns the FreeTrialEndsOn field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetFreeTrialEndsOn() Timestamp {
-	if m == nil || m.FreeTrialEndsOn == nil {
-		return Timestamp{}
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetCommitSHA() string {
+	if m == nil || m.CommitSHA == nil {
+		return ""
 	}
-	return *m.FreeTrialEndsOn
+	return *m.CommitSHA
 }
 
-// GetNextBillingDate returns the NextBillingDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetNextBillingDate() Timestamp {
-	if m == nil || m.NextBillingDate == nil {
-		return Timestamp{}
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetEnvironment() string {
+	if m == nil || m.Environment == nil {
+		return ""
 	}
-	return *m.NextBillingDate
+	return *m.Environment
 }
 
-// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetOnFreeTrial() bool {
-	if m == nil || m.OnFreeTrial == nil {
-		return false
+// GetLocation returns the Location field.
+func (m *MostRecentInstance) GetLocation() *Location {
+	if m == nil {
+		return nil
 	}
-	return *m.OnFreeTrial
+	return m.Location
 }
 
-// GetPlan returns the Plan field.
-func (m *MarketplacePurchase) GetPlan() *MarketplacePlan {
+// GetMessage returns the Message field.
+func (m *MostRecentInstance) GetMessage() *Message {
 	if m == nil {
 		return nil
 	}
-	return m.Plan
+	return m.Message
 }
 
-// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetUnitCount() int {
-	if m == nil || m.UnitCount == nil {
-		return 0
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetRef() string {
+	if m == nil || m.Ref == nil {
+		return ""
 	}
-	return *m.UnitCount
+	return *m.Ref
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetUpdatedAt() Timestamp {
-	if m == nil || m.UpdatedAt == nil {
-		return Timestamp{}
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
 	}
-	return *m.UpdatedAt
+	return *m.State
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseAccount) GetEmail() string {
-	if m == nil || m.Email == nil {
+// GetBase returns the Base field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetBase() string {
+	if n == nil || n.Base == nil {
 		return ""
 	}
-	return *m.Email
+	return *n.Base
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseAccount) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetBody() string {
+	if n == nil || n.Body == nil {
+		return ""
 	}
-	return *m.ID
+	return *n.Body
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseAccount) GetLogin() string {
-	if m == nil || m.Login == nil {
-		return ""
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetDraft() bool {
+	if n == nil || n.Draft == nil {
+		return false
 	}
-	return *m.Login
+	return *n.Draft
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseAccount) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetHead() string {
+	if n == nil || n.Head == nil {
 		return ""
 	}
-	return *m.NodeID
+	return *n.Head
 }
 
-// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseAccount) GetOrganizationBillingEmail() string {
-	if m == nil || m.OrganizationBillingEmail == nil {
-		return ""
+// GetIssue returns the Issue field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetIssue() int {
+	if n == nil || n.Issue == nil {
+		return 0
 	}
-	return *m.OrganizationBillingEmail
+	return *n.Issue
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseAccount) GetType() string {
-	if m == nil || m.Type == nil {
-		return ""
+// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetMaintainerCanModify() bool {
+	if n == nil || n.MaintainerCanModify == nil {
+		return false
 	}
-	return *m.Type
+	return *n.MaintainerCanModify
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseAccount) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetTitle() string {
+	if n == nil || n.Title == nil {
 		return ""
 	}
-	return *m.URL
+	return *n.Title
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetDescription() string {
+	if n == nil || n.Description == nil {
 		return ""
 	}
-	return *m.Action
+	return *n.Description
 }
 
-// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseEvent) GetEffectiveDate() Timestamp {
-	if m == nil || m.EffectiveDate == nil {
-		return Timestamp{}
+// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetLDAPDN() string {
+	if n == nil || n.LDAPDN == nil {
+		return ""
 	}
-	return *m.EffectiveDate
+	return *n.LDAPDN
 }
 
-// GetInstallation returns the Installation field.
-func (m *MarketplacePurchaseEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetParentTeamID returns the ParentTeamID field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetParentTeamID() int64 {
+	if n == nil || n.ParentTeamID == nil {
+		return 0
 	}
-	return m.Installation
+	return *n.ParentTeamID
 }
 
-// GetMarketplacePurchase returns the MarketplacePurchase field.
-func (m *MarketplacePurchaseEvent) GetMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
-		return nil
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetPermission() string {
+	if n == nil || n.Permission == nil {
+		return ""
 	}
-	return m.MarketplacePurchase
+	return *n.Permission
 }
 
-// GetPreviousMarketplacePurchase returns the PreviousMarketplacePurchase field.
-func (m *MarketplacePurchaseEvent) GetPreviousMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
-		return nil
+// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetPrivacy() string {
+	if n == nil || n.Privacy == nil {
+		return ""
 	}
-	return m.PreviousMarketplacePurchase
+	return *n.Privacy
 }
 
-// GetSender returns the Sender field.
-func (m *MarketplacePurchaseEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *Notification) GetID() string {
+	if n == nil || n.ID == nil {
+		return ""
 	}
-	return m.Sender
+	return *n.ID
 }
 
-// GetText returns the Text field if it's non-nil, zero value otherwise.
-func (m *Match) GetText() string {
-	if m == nil || m.Text == nil {
-		return ""
+// GetLastReadAt returns the LastReadAt field if it's non-nil, zero value otherwise.
+func (n *Notification) GetLastReadAt() Timestamp {
+	if n == nil || n.LastReadAt == nil {
+		return Timestamp{}
 	}
-	return *m.Text
+	return *n.LastReadAt
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MemberEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (n *Notification) GetReason() string {
+	if n == nil || n.Reason == nil {
 		return ""
 	}
-	return *m.Action
+	return *n.Reason
 }
 
-// GetInstallation returns the Installation field.
-func (m *MemberEvent) GetInstallation() *Installation {
-	if m == nil {
+// GetRepository returns the Repository field.
+func (n *Notification) GetRepository() *Repository {
+	if n == nil {
 		return nil
 	}
-	return m.Installation
+	return n.Repository
 }
 
-// GetMember returns the Member field.
-func (m *MemberEvent) GetMember() *User {
-	if m == nil {
+// GetSubject returns the Subject field.
+func (n *Notification) GetSubject() *NotificationSubject {
+	if n == nil {
 		return nil
 	}
-	return m.Member
+	return n.Subject
 }
 
-// GetRepo returns the Repo field.
-func (m *MemberEvent) GetRepo() *Repository {
-	if m == nil {
-		return nil
+// GetUnread returns the Unread field if it's non-nil, zero value otherwise.
+func (n *Notification) GetUnread() bool {
+	if n == nil || n.Unread == nil {
+		return false
 	}
-	return m.Repo
+	return *n.Unread
 }
 
-// GetSender returns the Sender field.
-func (m *MemberEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (n *Notification) GetUpdatedAt() Timestamp {
+	if n == nil || n.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return m.Sender
+	return *n.UpdatedAt
 }
 
-// GetOrganization returns the Organization field.
-func (m *Membership) GetOrganization() *Organization {
-	if m == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (n *Notification) GetURL() string {
+	if n == nil || n.URL == nil {
+		return ""
 	}
-	return m.Organization
+	return *n.URL
 }
 
-// GetOrganizationURL returns the OrganizationURL field if it's non-nil, zero value otherwise.
-func (m *Membership) GetOrganizationURL() string {
-	if m == nil || m.OrganizationURL == nil {
+// GetLatestCommentURL returns the LatestCommentURL field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetLatestCommentURL() string {
+	if n == nil || n.LatestCommentURL == nil {
 		return ""
 	}
-	return *m.OrganizationURL
+	return *n.LatestCommentURL
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (m *Membership) GetRole() string {
-	if m == nil || m.Role == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetTitle() string {
+	if n == nil || n.Title == nil {
 		return ""
 	}
-	return *m.Role
+	return *n.Title
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Membership) GetState() string {
-	if m == nil || m.State == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetType() string {
+	if n == nil || n.Type == nil {
 		return ""
 	}
-	return *m.State
+	return *n.Type
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Membership) GetURL() string {
-	if m == nil || m.URL == nil {
+func (n *NotificationSubject) GetURL() string {
+	if n == nil || n.URL == nil {
 		return ""
 	}
-	return *m.URL
+	return *n.URL
 }
 
-// GetUser returns the User field.
-func (m *Membership) GetUser() *User {
-	if m == nil {
-		return nil
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetClientID() string {
+	if o == nil || o.ClientID == nil {
+		return ""
 	}
-	return m.User
+	return *o.ClientID
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MembershipEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetName() string {
+	if o == nil || o.Name == nil {
 		return ""
 	}
-	return *m.Action
+	return *o.Name
 }
 
-// GetInstallation returns the Installation field.
-func (m *MembershipEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetURL() string {
+	if o == nil || o.URL == nil {
+		return ""
 	}
-	return m.Installation
+	return *o.URL
 }
 
-// GetMember returns the Member field.
-func (m *MembershipEvent) GetMember() *User {
-	if m == nil {
-		return nil
+// GetUseDefault returns the UseDefault field if it's non-nil, zero value otherwise.
+func (o *OIDCSubjectClaimCustomTemplate) GetUseDefault() bool {
+	if o == nil || o.UseDefault == nil {
+		return false
 	}
-	return m.Member
+	return *o.UseDefault
 }
 
-// GetOrg returns the Org field.
-func (m *MembershipEvent) GetOrg() *Organization {
-	if m == nil {
-		return nil
+// GetAdvancedSecurityEnabledForNewRepos returns the AdvancedSecurityEnabledForNewRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetAdvancedSecurityEnabledForNewRepos() bool {
+	if o == nil || o.AdvancedSecurityEnabledForNewRepos == nil {
+		return false
 	}
-	return m.Org
+	return *o.AdvancedSecurityEnabledForNewRepos
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (m *MembershipEvent) GetScope() string {
-	if m == nil || m.Scope == nil {
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetAvatarURL() string {
+	if o == nil || o.AvatarURL == nil {
 		return ""
 	}
-	return *m.Scope
+	return *o.AvatarURL
 }
 
-// GetSender returns the Sender field.
-func (m *MembershipEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetBillingEmail returns the BillingEmail field if it's non-nil, zero value otherwise.
+func (o *Organization) GetBillingEmail() string {
+	if o == nil || o.BillingEmail == nil {
+		return ""
 	}
-	return m.Sender
+	return *o.BillingEmail
 }
 
-// GetTeam returns the Team field.
-func (m *MembershipEvent) GetTeam() *Team {
-	if m == nil {
-		return nil
+// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
+func (o *Organization) GetBlog() string {
+	if o == nil || o.Blog == nil {
+		return ""
 	}
-	return m.Team
+	return *o.Blog
 }
 
-// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
-func (m *MergeGroup) GetBaseRef() string {
-	if m == nil || m.BaseRef == nil {
-		return ""
+// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCollaborators() int {
+	if o == nil || o.Collaborators == nil {
+		return 0
 	}
-	return *m.BaseRef
+	return *o.Collaborators
 }
 
-// GetBaseSHA returns the BaseSHA field if it's non-nil, zero value otherwise.
-func (m *MergeGroup) GetBaseSHA() string {
-	if m == nil || m.BaseSHA == nil {
+// GetCompany returns the Company field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCompany() string {
+	if o == nil || o.Company == nil {
 		return ""
 	}
-	return *m.BaseSHA
+	return *o.Company
 }
 
-// GetHeadCommit returns the HeadCommit field.
-func (m *MergeGroup) GetHeadCommit() *Commit {
-	if m == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCreatedAt() Timestamp {
+	if o == nil || o.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return m.HeadCommit
+	return *o.CreatedAt
 }
 
-// GetHeadRef returns the HeadRef field if it's non-nil, zero value otherwise.
-func (m *MergeGroup) GetHeadRef() string {
-	if m == nil || m.HeadRef == nil {
+// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDefaultRepoPermission() string {
+	if o == nil || o.DefaultRepoPermission == nil {
 		return ""
 	}
-	return *m.HeadRef
+	return *o.DefaultRepoPermission
 }
 
-// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
-func (m *MergeGroup) GetHeadSHA() string {
-	if m == nil || m.HeadSHA == nil {
+// GetDefaultRepoSettings returns the DefaultRepoSettings field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDefaultRepoSettings() string {
+	if o == nil || o.DefaultRepoSettings == nil {
 		return ""
 	}
-	return *m.HeadSHA
+	return *o.DefaultRepoSettings
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MergeGroupEvent) GetAction() string {
-	if m == nil || m.Action == nil {
-		return ""
+// GetDependabotAlertsEnabledForNewRepos returns the DependabotAlertsEnabledForNewRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDependabotAlertsEnabledForNewRepos() bool {
+	if o == nil || o.DependabotAlertsEnabledForNewRepos == nil {
+		return false
 	}
-	return *m.Action
+	return *o.DependabotAlertsEnabledForNewRepos
 }
 
-// GetInstallation returns the Installation field.
-func (m *MergeGroupEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetDependabotSecurityUpdatesEnabledForNewRepos returns the DependabotSecurityUpdatesEnabledForNewRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDependabotSecurityUpdatesEnabledForNewRepos() bool {
+	if o == nil || o.DependabotSecurityUpdatesEnabledForNewRepos == nil {
+		return false
 	}
-	return m.Installation
+	return *o.DependabotSecurityUpdatesEnabledForNewRepos
 }
 
-// GetMergeGroup returns the MergeGroup field.
-func (m *MergeGroupEvent) GetMergeGroup() *MergeGroup {
-	if m == nil {
-		return nil
+// GetDependencyGraphEnabledForNewRepos returns the DependencyGraphEnabledForNewRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDependencyGraphEnabledForNewRepos() bool {
+	if o == nil || o.DependencyGraphEnabledForNewRepos == nil {
+		return false
 	}
-	return m.MergeGroup
+	return *o.DependencyGraphEnabledForNewRepos
 }
 
-// GetOrg returns the Org field.
-func (m *MergeGroupEvent) GetOrg() *Organization {
-	if m == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
 	}
-	return m.Org
+	return *o.Description
 }
 
-// GetRepo returns the Repo field.
-func (m *MergeGroupEvent) GetRepo() *Repository {
-	if m == nil {
-		return nil
+// GetDiskUsage returns the DiskUsage field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDiskUsage() int {
+	if o == nil || o.DiskUsage == nil {
+		return 0
 	}
-	return m.Repo
+	return *o.DiskUsage
 }
 
-// GetSender returns the Sender field.
-func (m *MergeGroupEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (o *Organization) GetEmail() string {
+	if o == nil || o.Email == nil {
+		return ""
 	}
-	return m.Sender
+	return *o.Email
 }
 
-// GetText returns the Text field if it's non-nil, zero value otherwise.
-func (m *Message) GetText() string {
-	if m == nil || m.Text == nil {
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetEventsURL() string {
+	if o == nil || o.EventsURL == nil {
 		return ""
 	}
-	return *m.Text
+	return *o.EventsURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MetaEvent) GetAction() string {
-	if m == nil || m.Action == nil {
-		return ""
+// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
+func (o *Organization) GetFollowers() int {
+	if o == nil || o.Followers == nil {
+		return 0
 	}
-	return *m.Action
+	return *o.Followers
 }
 
-// GetHook returns the Hook field.
-func (m *MetaEvent) GetHook() *Hook {
-	if m == nil {
-		return nil
+// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
+func (o *Organization) GetFollowing() int {
+	if o == nil || o.Following == nil {
+		return 0
 	}
-	return m.Hook
+	return *o.Following
 }
 
-// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
-func (m *MetaEvent) GetHookID() int64 {
-	if m == nil || m.HookID == nil {
-		return 0
+// GetHasOrganizationProjects returns the HasOrganizationProjects field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHasOrganizationProjects() bool {
+	if o == nil || o.HasOrganizationProjects == nil {
+		return false
 	}
-	return *m.HookID
+	return *o.HasOrganizationProjects
 }
 
-// GetInstallation returns the Installation field.
-func (m *MetaEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetHasRepositoryProjects returns the HasRepositoryProjects field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHasRepositoryProjects() bool {
+	if o == nil || o.HasRepositoryProjects == nil {
+		return false
 	}
-	return m.Installation
+	return *o.HasRepositoryProjects
 }
 
-// GetOrg returns the Org field.
-func (m *MetaEvent) GetOrg() *Organization {
-	if m == nil {
-		return nil
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHooksURL() string {
+	if o == nil || o.HooksURL == nil {
+		return ""
 	}
-	return m.Org
+	return *o.HooksURL
 }
 
-// GetRepo returns the Repo field.
-func (m *MetaEvent) GetRepo() *Repository {
-	if m == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHTMLURL() string {
+	if o == nil || o.HTMLURL == nil {
+		return ""
 	}
-	return m.Repo
+	return *o.HTMLURL
 }
 
-// GetSender returns the Sender field.
-func (m *MetaEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *Organization) GetID() int64 {
+	if o == nil || o.ID == nil {
+		return 0
 	}
-	return m.Sender
+	return *o.ID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (m *Metric) GetHTMLURL() string {
-	if m == nil || m.HTMLURL == nil {
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetIssuesURL() string {
+	if o == nil || o.IssuesURL == nil {
 		return ""
 	}
-	return *m.HTMLURL
+	return *o.IssuesURL
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (m *Metric) GetKey() string {
-	if m == nil || m.Key == nil {
-		return ""
+// GetIsVerified returns the IsVerified field if it's non-nil, zero value otherwise.
+func (o *Organization) GetIsVerified() bool {
+	if o == nil || o.IsVerified == nil {
+		return false
 	}
-	return *m.Key
+	return *o.IsVerified
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *Metric) GetName() string {
-	if m == nil || m.Name == nil {
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (o *Organization) GetLocation() string {
+	if o == nil || o.Location == nil {
 		return ""
 	}
-	return *m.Name
+	return *o.Location
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *Metric) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (o *Organization) GetLogin() string {
+	if o == nil || o.Login == nil {
 		return ""
 	}
-	return *m.NodeID
+	return *o.Login
 }
 
-// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
-func (m *Metric) GetSPDXID() string {
-	if m == nil || m.SPDXID == nil {
+// GetMembersAllowedRepositoryCreationType returns the MembersAllowedRepositoryCreationType field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersAllowedRepositoryCreationType() string {
+	if o == nil || o.MembersAllowedRepositoryCreationType == nil {
 		return ""
 	}
-	return *m.SPDXID
+	return *o.MembersAllowedRepositoryCreationType
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Metric) GetURL() string {
-	if m == nil || m.URL == nil {
-		return ""
+// GetMembersCanCreateInternalRepos returns the MembersCanCreateInternalRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreateInternalRepos() bool {
+	if o == nil || o.MembersCanCreateInternalRepos == nil {
+		return false
 	}
-	return *m.URL
+	return *o.MembersCanCreateInternalRepos
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetCreatedAt() string {
-	if m == nil || m.CreatedAt == nil {
-		return ""
+// GetMembersCanCreatePages returns the MembersCanCreatePages field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePages() bool {
+	if o == nil || o.MembersCanCreatePages == nil {
+		return false
 	}
-	return *m.CreatedAt
+	return *o.MembersCanCreatePages
 }
 
-// GetExcludeAttachments returns the ExcludeAttachments field if it's non-nil, zero value otherwise.
-func (m *Migration) GetExcludeAttachments() bool {
-	if m == nil || m.ExcludeAttachments == nil {
+// GetMembersCanCreatePrivatePages returns the MembersCanCreatePrivatePages field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePrivatePages() bool {
+	if o == nil || o.MembersCanCreatePrivatePages == nil {
 		return false
 	}
-	return *m.ExcludeAttachments
+	return *o.MembersCanCreatePrivatePages
 }
 
-// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
-func (m *Migration) GetGUID() string {
-	if m == nil || m.GUID == nil {
-		return ""
+// GetMembersCanCreatePrivateRepos returns the MembersCanCreatePrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePrivateRepos() bool {
+	if o == nil || o.MembersCanCreatePrivateRepos == nil {
+		return false
 	}
-	return *m.GUID
+	return *o.MembersCanCreatePrivateRepos
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Migration) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetMembersCanCreatePublicPages returns the MembersCanCreatePublicPages field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePublicPages() bool {
+	if o == nil || o.MembersCanCreatePublicPages == nil {
+		return false
 	}
-	return *m.ID
+	return *o.MembersCanCreatePublicPages
 }
 
-// GetLockRepositories returns the LockRepositories field if it's non-nil, zero value otherwise.
-func (m *Migration) GetLockRepositories() bool {
-	if m == nil || m.LockRepositories == nil {
+// GetMembersCanCreatePublicRepos returns the MembersCanCreatePublicRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePublicRepos() bool {
+	if o == nil || o.MembersCanCreatePublicRepos == nil {
 		return false
 	}
-	return *m.LockRepositories
+	return *o.MembersCanCreatePublicRepos
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Migration) GetState() string {
-	if m == nil || m.State == nil {
-		return ""
+// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreateRepos() bool {
+	if o == nil || o.MembersCanCreateRepos == nil {
+		return false
 	}
-	return *m.State
+	return *o.MembersCanCreateRepos
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetUpdatedAt() string {
-	if m == nil || m.UpdatedAt == nil {
-		return ""
+// GetMembersCanForkPrivateRepos returns the MembersCanForkPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanForkPrivateRepos() bool {
+	if o == nil || o.MembersCanForkPrivateRepos == nil {
+		return false
 	}
-	return *m.UpdatedAt
+	return *o.MembersCanForkPrivateRepos
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Migration) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersURL() string {
+	if o == nil || o.MembersURL == nil {
 		return ""
 	}
-	return *m.URL
+	return *o.MembersURL
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetClosedAt() Timestamp {
-	if m == nil || m.ClosedAt == nil {
-		return Timestamp{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *Organization) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
 	}
-	return *m.ClosedAt
+	return *o.Name
 }
 
-// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetClosedIssues() int {
-	if m == nil || m.ClosedIssues == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (o *Organization) GetNodeID() string {
+	if o == nil || o.NodeID == nil {
+		return ""
 	}
-	return *m.ClosedIssues
+	return *o.NodeID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetCreatedAt() Timestamp {
-	if m == nil || m.CreatedAt == nil {
-		return Timestamp{}
+// GetOwnedPrivateRepos returns the OwnedPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetOwnedPrivateRepos() int64 {
+	if o == nil || o.OwnedPrivateRepos == nil {
+		return 0
 	}
-	return *m.CreatedAt
+	return *o.OwnedPrivateRepos
 }
 
-// GetCreator returns the Creator field.
-func (m *Milestone) GetCreator() *User {
-	if m == nil {
+// GetPlan returns the Plan field.
+func (o *Organization) GetPlan() *Plan {
+	if o == nil {
 		return nil
 	}
-	return m.Creator
+	return o.Plan
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetDescription() string {
-	if m == nil || m.Description == nil {
-		return ""
+// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPrivateGists() int {
+	if o == nil || o.PrivateGists == nil {
+		return 0
 	}
-	return *m.Description
+	return *o.PrivateGists
 }
 
-// GetDueOn returns the DueOn field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetDueOn() Timestamp {
-	if m == nil || m.DueOn == nil {
-		return Timestamp{}
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicGists() int {
+	if o == nil || o.PublicGists == nil {
+		return 0
 	}
-	return *m.DueOn
+	return *o.PublicGists
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetHTMLURL() string {
-	if m == nil || m.HTMLURL == nil {
+// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicMembersURL() string {
+	if o == nil || o.PublicMembersURL == nil {
 		return ""
 	}
-	return *m.HTMLURL
+	return *o.PublicMembersURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetID() int64 {
-	if m == nil || m.ID == nil {
+// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicRepos() int {
+	if o == nil || o.PublicRepos == nil {
 		return 0
 	}
-	return *m.ID
+	return *o.PublicRepos
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetLabelsURL() string {
-	if m == nil || m.LabelsURL == nil {
+// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetReposURL() string {
+	if o == nil || o.ReposURL == nil {
 		return ""
 	}
-	return *m.LabelsURL
+	return *o.ReposURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
-		return ""
+// GetSecretScanningEnabledForNewRepos returns the SecretScanningEnabledForNewRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetSecretScanningEnabledForNewRepos() bool {
+	if o == nil || o.SecretScanningEnabledForNewRepos == nil {
+		return false
+	}
+	return *o.SecretScanningEnabledForNewRepos
+}
+
+// GetSecretScanningPushProtectionEnabledForNewRepos returns the SecretScanningPushProtectionEnabledForNewRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetSecretScanningPushProtectionEnabledForNewRepos() bool {
+	if o == nil || o.SecretScanningPushProtectionEnabledForNewRepos == nil {
+		return false
 	}
-	return *m.NodeID
+	return *o.SecretScanningPushProtectionEnabledForNewRepos
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetNumber() int {
-	if m == nil || m.Number == nil {
+// GetTotalPrivateRepos returns the TotalPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTotalPrivateRepos() int64 {
+	if o == nil || o.TotalPrivateRepos == nil {
 		return 0
 	}
-	return *m.Number
+	return *o.TotalPrivateRepos
 }
 
-// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetOpenIssues() int {
-	if m == nil || m.OpenIssues == nil {
-		return 0
+// GetTwitterUsername returns the TwitterUsername field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTwitterUsername() string {
+	if o == nil || o.TwitterUsername == nil {
+		return ""
 	}
-	return *m.OpenIssues
+	return *o.TwitterUsername
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetState() string {
-	if m == nil || m.State == nil {
-		return ""
+// GetTwoFactorRequirementEnabled returns the TwoFactorRequirementEnabled field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTwoFactorRequirementEnabled() bool {
+	if o == nil || o.TwoFactorRequirementEnabled == nil {
+		return false
 	}
-	return *m.State
+	return *o.TwoFactorRequirementEnabled
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetTitle() string {
-	if m == nil || m.Title == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (o *Organization) GetType() string {
+	if o == nil || o.Type == nil {
 		return ""
 	}
-	return *m.Title
+	return *o.Type
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetUpdatedAt() Timestamp {
-	if m == nil || m.UpdatedAt == nil {
+func (o *Organization) GetUpdatedAt() Timestamp {
+	if o == nil || o.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *m.UpdatedAt
+	return *o.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetURL() string {
-	if m == nil || m.URL == nil {
+func (o *Organization) GetURL() string {
+	if o == nil || o.URL == nil {
 		return ""
 	}
-	return *m.URL
+	return *o.URL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MilestoneEvent) GetAction() string {
-	if m == nil || m.Action == nil {
-		return ""
+// GetWebCommitSignoffRequired returns the WebCommitSignoffRequired field if it's non-nil, zero value otherwise.
+func (o *Organization) GetWebCommitSignoffRequired() bool {
+	if o == nil || o.WebCommitSignoffRequired == nil {
+		return false
 	}
-	return *m.Action
+	return *o.WebCommitSignoffRequired
 }
 
-// GetChanges returns the Changes field.
-func (m *MilestoneEvent) GetChanges() *EditChange {
-	if m == nil {
-		return nil
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationCustomRepoRoles) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
 	}
-	return m.Changes
+	return *o.TotalCount
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationCustomRoles) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
+	}
+	return *o.TotalCount
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrganizationEvent) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
+	}
+	return *o.Action
 }
 
 // GetInstallation returns the Installation field.
-func (m *MilestoneEvent) GetInstallation() *Installation {
-	if m == nil {
+func (o *OrganizationEvent) GetInstallation() *Installation {
+	if o == nil {
 		return nil
 	}
-	return m.Installation
+	return o.Installation
 }
 
-// GetMilestone returns the Milestone field.
-func (m *MilestoneEvent) GetMilestone() *Milestone {
-	if m == nil {
+// GetInvitation returns the Invitation field.
+func (o *OrganizationEvent) GetInvitation() *Invitation {
+	if o == nil {
 		return nil
 	}
-	return m.Milestone
+	return o.Invitation
 }
 
-// GetOrg returns the Org field.
-func (m *MilestoneEvent) GetOrg() *Organization {
-	if m == nil {
+// GetMembership returns the Membership field.
+func (o *OrganizationEvent) GetMembership() *Membership {
+	if o == nil {
 		return nil
 	}
-	return m.Org
+	return o.Membership
 }
 
-// GetRepo returns the Repo field.
-func (m *MilestoneEvent) GetRepo() *Repository {
-	if m == nil {
+// GetOrganization returns the Organization field.
+func (o *OrganizationEvent) GetOrganization() *Organization {
+	if o == nil {
 		return nil
 	}
-	return m.Repo
+	return o.Organization
 }
 
 // GetSender returns the Sender field.
-func (m *MilestoneEvent) GetSender() *User {
-	if m == nil {
+func (o *OrganizationEvent) GetSender() *User {
+	if o == nil {
 		return nil
 	}
-	return m.Sender
+	return o.Sender
 }
 
-// GetClosedMilestones returns the ClosedMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetClosedMilestones() int {
-	if m == nil || m.ClosedMilestones == nil {
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationInstallations) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
 		return 0
 	}
-	return *m.ClosedMilestones
+	return *o.TotalCount
 }
 
-// GetOpenMilestones returns the OpenMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetOpenMilestones() int {
-	if m == nil || m.OpenMilestones == nil {
-		return 0
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrgBlockEvent) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
 	}
-	return *m.OpenMilestones
+	return *o.Action
 }
 
-// GetTotalMilestones returns the TotalMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetTotalMilestones() int {
-	if m == nil || m.TotalMilestones == nil {
-		return 0
+// GetBlockedUser returns the BlockedUser field.
+func (o *OrgBlockEvent) GetBlockedUser() *User {
+	if o == nil {
+		return nil
 	}
-	return *m.TotalMilestones
+	return o.BlockedUser
 }
 
-// GetAnalysisKey returns the AnalysisKey field if it's non-nil, zero value otherwise.
-func (m *MostRecentInstance) GetAnalysisKey() string {
-	if m == nil || m.AnalysisKey == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (o *OrgBlockEvent) GetInstallation() *Installation {
+	if o == nil {
+		return nil
 	}
-	return *m.AnalysisKey
+	return o.Installation
 }
 
-// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
-func (m *MostRecentInstance) GetCommitSHA() string {
-	if m == nil || m.CommitSHA == nil {
-		return ""
+// GetOrganization returns the Organization field.
+func (o *OrgBlockEvent) GetOrganization() *Organization {
+	if o == nil {
+		return nil
 	}
-	return *m.CommitSHA
+	return o.Organization
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (m *MostRecentInstance) GetEnvironment() string {
-	if m == nil || m.Environment == nil {
-		return ""
+// GetSender returns the Sender field.
+func (o *OrgBlockEvent) GetSender() *User {
+	if o == nil {
+		return nil
 	}
-	return *m.Environment
+	return o.Sender
 }
 
-// GetLocation returns the Location field.
-func (m *MostRecentInstance) GetLocation() *Location {
-	if m == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetCreatedAt() Timestamp {
+	if o == nil || o.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return m.Location
+	return *o.CreatedAt
 }
 
-// GetMessage returns the Message field.
-func (m *MostRecentInstance) GetMessage() *Message {
-	if m == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetID() int64 {
+	if o == nil || o.ID == nil {
+		return 0
 	}
-	return m.Message
+	return *o.ID
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (m *MostRecentInstance) GetRef() string {
-	if m == nil || m.Ref == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetName() string {
+	if o == nil || o.Name == nil {
 		return ""
 	}
-	return *m.Ref
+	return *o.Name
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *MostRecentInstance) GetState() string {
-	if m == nil || m.State == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetPath() string {
+	if o == nil || o.Path == nil {
 		return ""
 	}
-	return *m.State
+	return *o.Path
 }
 
-// GetBase returns the Base field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetBase() string {
-	if n == nil || n.Base == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetRef() string {
+	if o == nil || o.Ref == nil {
 		return ""
 	}
-	return *n.Base
+	return *o.Ref
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetBody() string {
-	if n == nil || n.Body == nil {
+// GetRepository returns the Repository field.
+func (o *OrgRequiredWorkflow) GetRepository() *Repository {
+	if o == nil {
+		return nil
+	}
+	return o.Repository
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetScope() string {
+	if o == nil || o.Scope == nil {
 		return ""
 	}
-	return *n.Body
+	return *o.Scope
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetDraft() bool {
-	if n == nil || n.Draft == nil {
-		return false
+// GetSelectedRepositoriesURL returns the SelectedRepositoriesURL field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetSelectedRepositoriesURL() string {
+	if o == nil || o.SelectedRepositoriesURL == nil {
+		return ""
 	}
-	return *n.Draft
+	return *o.SelectedRepositoriesURL
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetHead() string {
-	if n == nil || n.Head == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetState() string {
+	if o == nil || o.State == nil {
 		return ""
 	}
-	return *n.Head
+	return *o.State
 }
 
-// GetIssue returns the Issue field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetIssue() int {
-	if n == nil || n.Issue == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetUpdatedAt() Timestamp {
+	if o == nil || o.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *n.Issue
+	return *o.UpdatedAt
 }
 
-// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetMaintainerCanModify() bool {
-	if n == nil || n.MaintainerCanModify == nil {
-		return false
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflows) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
 	}
-	return *n.MaintainerCanModify
+	return *o.TotalCount
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetTitle() string {
-	if n == nil || n.Title == nil {
-		return ""
+// GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetDisabledOrgs() int {
+	if o == nil || o.DisabledOrgs == nil {
+		return 0
 	}
-	return *n.Title
+	return *o.DisabledOrgs
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetDescription() string {
-	if n == nil || n.Description == nil {
-		return ""
+// GetTotalOrgs returns the TotalOrgs field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalOrgs() int {
+	if o == nil || o.TotalOrgs == nil {
+		return 0
 	}
-	return *n.Description
+	return *o.TotalOrgs
 }
 
-// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetLDAPDN() string {
-	if n == nil || n.LDAPDN == nil {
-		return ""
+// GetTotalTeamMembers returns the TotalTeamMembers field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalTeamMembers() int {
+	if o == nil || o.TotalTeamMembers == nil {
+		return 0
 	}
-	return *n.LDAPDN
+	return *o.TotalTeamMembers
 }
 
-// GetParentTeamID returns the ParentTeamID field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetParentTeamID() int64 {
-	if n == nil || n.ParentTeamID == nil {
+// GetTotalTeams returns the TotalTeams field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalTeams() int {
+	if o == nil || o.TotalTeams == nil {
 		return 0
 	}
-	return *n.ParentTeamID
+	return *o.TotalTeams
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetPermission() string {
-	if n == nil || n.Permission == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Package) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *n.Permission
+	return *p.CreatedAt
 }
 
-// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetPrivacy() string {
-	if n == nil || n.Privacy == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Package) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *n.Privacy
+	return *p.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (n *Notification) GetID() string {
-	if n == nil || n.ID == nil {
+func (p *Package) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Package) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *n.ID
+	return *p.Name
 }
 
-// GetLastReadAt returns the LastReadAt field if it's non-nil, zero value otherwise.
-func (n *Notification) GetLastReadAt() Timestamp {
-	if n == nil || n.LastReadAt == nil {
-		return Timestamp{}
+// GetOwner returns the Owner field.
+func (p *Package) GetOwner() *User {
+	if p == nil {
+		return nil
 	}
-	return *n.LastReadAt
+	return p.Owner
 }
 
-// GetReason returns the Reason field if it's non-nil, zero value otherwise.
-func (n *Notification) GetReason() string {
-	if n == nil || n.Reason == nil {
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *Package) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
 		return ""
 	}
-	return *n.Reason
+	return *p.PackageType
 }
 
-// GetRepository returns the Repository field.
-func (n *Notification) GetRepository() *Repository {
-	if n == nil {
+// GetPackageVersion returns the PackageVersion field.
+func (p *Package) GetPackageVersion() *PackageVersion {
+	if p == nil {
 		return nil
 	}
-	return n.Repository
+	return p.PackageVersion
 }
 
-// GetSubject returns the Subject field.
-func (n *Notification) GetSubject() *NotificationSubject {
-	if n == nil {
+// GetRegistry returns the Registry field.
+func (p *Package) GetRegistry() *PackageRegistry {
+	if p == nil {
 		return nil
 	}
-	return n.Subject
+	return p.Registry
 }
 
-// GetUnread returns the Unread field if it's non-nil, zero value otherwise.
-func (n *Notification) GetUnread() bool {
-	if n == nil || n.Unread == nil {
-		return false
+// GetRepository returns the Repository field.
+func (p *Package) GetRepository() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *n.Unread
+	return p.Repository
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (n *Notification) GetUpdatedAt() Timestamp {
-	if n == nil || n.UpdatedAt == nil {
+func (p *Package) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *n.UpdatedAt
+	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (n *Notification) GetURL() string {
-	if n == nil || n.URL == nil {
+func (p *Package) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *n.URL
+	return *p.URL
 }
 
-// GetLatestCommentURL returns the LatestCommentURL field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetLatestCommentURL() string {
-	if n == nil || n.LatestCommentURL == nil {
-		return ""
+// GetVersionCount returns the VersionCount field if it's non-nil, zero value otherwise.
+func (p *Package) GetVersionCount() int64 {
+	if p == nil || p.VersionCount == nil {
+		return 0
 	}
-	return *n.LatestCommentURL
+	return *p.VersionCount
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetTitle() string {
-	if n == nil || n.Title == nil {
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (p *Package) GetVisibility() string {
+	if p == nil || p.Visibility == nil {
 		return ""
 	}
-	return *n.Title
+	return *p.Visibility
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetType() string {
-	if n == nil || n.Type == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PackageEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *n.Type
+	return *p.Action
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetURL() string {
-	if n == nil || n.URL == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (p *PackageEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *n.URL
+	return p.Installation
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetClientID() string {
-	if o == nil || o.ClientID == nil {
-		return ""
+// GetOrg returns the Org field.
+func (p *PackageEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *o.ClientID
+	return p.Org
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetName() string {
-	if o == nil || o.Name == nil {
+// GetPackage returns the Package field.
+func (p *PackageEvent) GetPackage() *Package {
+	if p == nil {
+		return nil
+	}
+	return p.Package
+}
+
+// GetRepo returns the Repo field.
+func (p *PackageEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetSender returns the Sender field.
+func (p *PackageEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetAuthor returns the Author field.
+func (p *PackageFile) GetAuthor() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Author
+}
+
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetContentType() string {
+	if p == nil || p.ContentType == nil {
 		return ""
 	}
-	return *o.Name
+	return *p.ContentType
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetURL() string {
-	if o == nil || o.URL == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetDownloadURL() string {
+	if p == nil || p.DownloadURL == nil {
 		return ""
 	}
-	return *o.URL
+	return *p.DownloadURL
 }
 
-// GetUseDefault returns the UseDefault field if it's non-nil, zero value otherwise.
-func (o *OIDCSubjectClaimCustomTemplate) GetUseDefault() bool {
-	if o == nil || o.UseDefault == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *o.UseDefault
+	return *p.ID
 }
 
-// GetAdvancedSecurityEnabledForNewRepos returns the AdvancedSecurityEnabledForNewRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetAdvancedSecurityEnabledForNewRepos() bool {
-	if o == nil || o.AdvancedSecurityEnabledForNewRepos == nil {
-		return false
+// GetMD5 returns the MD5 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetMD5() string {
+	if p == nil || p.MD5 == nil {
+		return ""
 	}
-	return *o.AdvancedSecurityEnabledForNewRepos
+	return *p.MD5
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetAvatarURL() string {
-	if o == nil || o.AvatarURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *o.AvatarURL
+	return *p.Name
 }
 
-// GetBillingEmail returns the BillingEmail field if it's non-nil, zero value otherwise.
-func (o *Organization) GetBillingEmail() string {
-	if o == nil || o.BillingEmail == nil {
+// GetSHA1 returns the SHA1 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSHA1() string {
+	if p == nil || p.SHA1 == nil {
 		return ""
 	}
-	return *o.BillingEmail
+	return *p.SHA1
 }
 
-// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
-func (o *Organization) GetBlog() string {
-	if o == nil || o.Blog == nil {
+// GetSHA256 returns the SHA256 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSHA256() string {
+	if p == nil || p.SHA256 == nil {
 		return ""
 	}
-	return *o.Blog
+	return *p.SHA256
 }
 
-// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCollaborators() int {
-	if o == nil || o.Collaborators == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSize() int64 {
+	if p == nil || p.Size == nil {
 		return 0
 	}
-	return *o.Collaborators
+	return *p.Size
 }
 
-// GetCompany returns the Company field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCompany() string {
-	if o == nil || o.Company == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *o.Company
+	return *p.State
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCreatedAt() Timestamp {
-	if o == nil || o.CreatedAt == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *o.CreatedAt
+	return *p.UpdatedAt
 }
 
-// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDefaultRepoPermission() string {
-	if o == nil || o.DefaultRepoPermission == nil {
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *PackageListOptions) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
 		return ""
 	}
-	return *o.DefaultRepoPermission
+	return *p.PackageType
 }
 
-// GetDefaultRepoSettings returns the DefaultRepoSettings field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDefaultRepoSettings() string {
-	if o == nil || o.DefaultRepoSettings == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PackageListOptions) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *o.DefaultRepoSettings
+	return *p.State
 }
 
-// GetDependabotAlertsEnabledForNewRepos returns the DependabotAlertsEnabledForNewRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDependabotAlertsEnabledForNewRepos() bool {
-	if o == nil || o.DependabotAlertsEnabledForNewRepos == nil {
-		return false
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (p *PackageListOptions) GetVisibility() string {
+	if p == nil || p.Visibility == nil {
+		return ""
 	}
-	return *o.DependabotAlertsEnabledForNewRepos
+	return *p.Visibility
 }
 
-// GetDependabotSecurityUpdatesEnabledForNewRepos returns the DependabotSecurityUpdatesEnabledForNewRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDependabotSecurityUpdatesEnabledForNewRepos() bool {
-	if o == nil || o.DependabotSecurityUpdatesEnabledForNewRepos == nil {
-		return false
+// GetContainer returns the Container field.
+func (p *PackageMetadata) GetContainer() *PackageContainerMetadata {
+	if p == nil {
+		return nil
 	}
-	return *o.DependabotSecurityUpdatesEnabledForNewRepos
+	return p.Container
 }
 
-// GetDependencyGraphEnabledForNewRepos returns the DependencyGraphEnabledForNewRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDependencyGraphEnabledForNewRepos() bool {
-	if o == nil || o.DependencyGraphEnabledForNewRepos == nil {
-		return false
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *PackageMetadata) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
+		return ""
 	}
-	return *o.DependencyGraphEnabledForNewRepos
+	return *p.PackageType
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDescription() string {
-	if o == nil || o.Description == nil {
+// GetAboutURL returns the AboutURL field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetAboutURL() string {
+	if p == nil || p.AboutURL == nil {
 		return ""
 	}
-	return *o.Description
+	return *p.AboutURL
 }
 
-// GetDiskUsage returns the DiskUsage field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDiskUsage() int {
-	if o == nil || o.DiskUsage == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *o.DiskUsage
+	return *p.Name
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (o *Organization) GetEmail() string {
-	if o == nil || o.Email == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetType() string {
+	if p == nil || p.Type == nil {
 		return ""
 	}
-	return *o.Email
+	return *p.Type
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetEventsURL() string {
-	if o == nil || o.EventsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *o.EventsURL
+	return *p.URL
 }
 
-// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
-func (o *Organization) GetFollowers() int {
-	if o == nil || o.Followers == nil {
-		return 0
+// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetVendor() string {
+	if p == nil || p.Vendor == nil {
+		return ""
 	}
-	return *o.Followers
+	return *p.Vendor
 }
 
-// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
-func (o *Organization) GetFollowing() int {
-	if o == nil || o.Following == nil {
-		return 0
+// GetAuthor returns the Author field.
+func (p *PackageRelease) GetAuthor() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.Following
+	return p.Author
 }
 
-// GetHasOrganizationProjects returns the HasOrganizationProjects field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHasOrganizationProjects() bool {
-	if o == nil || o.HasOrganizationProjects == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *o.HasOrganizationProjects
+	return *p.CreatedAt
 }
 
-// GetHasRepositoryProjects returns the HasRepositoryProjects field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHasRepositoryProjects() bool {
-	if o == nil || o.HasRepositoryProjects == nil {
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetDraft() bool {
+	if p == nil || p.Draft == nil {
 		return false
 	}
-	return *o.HasRepositoryProjects
-}
-
-// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHooksURL() string {
-	if o == nil || o.HooksURL == nil {
-		return ""
-	}
-	return *o.HooksURL
+	return *p.Draft
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHTMLURL() string {
-	if o == nil || o.HTMLURL == nil {
+func (p *PackageRelease) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *o.HTMLURL
+	return *p.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetID() int64 {
-	if o == nil || o.ID == nil {
+func (p *PackageRelease) GetID() int64 {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *o.ID
+	return *p.ID
 }
 
-// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetIssuesURL() string {
-	if o == nil || o.IssuesURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *o.IssuesURL
+	return *p.Name
 }
 
-// GetIsVerified returns the IsVerified field if it's non-nil, zero value otherwise.
-func (o *Organization) GetIsVerified() bool {
-	if o == nil || o.IsVerified == nil {
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetPrerelease() bool {
+	if p == nil || p.Prerelease == nil {
 		return false
 	}
-	return *o.IsVerified
+	return *p.Prerelease
 }
 
-// GetLocation returns the Location field if it's non-nil, zero value otherwise.
-func (o *Organization) GetLocation() string {
-	if o == nil || o.Location == nil {
-		return ""
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetPublishedAt() Timestamp {
+	if p == nil || p.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *o.Location
+	return *p.PublishedAt
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (o *Organization) GetLogin() string {
-	if o == nil || o.Login == nil {
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetTagName() string {
+	if p == nil || p.TagName == nil {
 		return ""
 	}
-	return *o.Login
+	return *p.TagName
 }
 
-// GetMembersAllowedRepositoryCreationType returns the MembersAllowedRepositoryCreationType field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersAllowedRepositoryCreationType() string {
-	if o == nil || o.MembersAllowedRepositoryCreationType == nil {
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetTargetCommitish() string {
+	if p == nil || p.TargetCommitish == nil {
 		return ""
 	}
-	return *o.MembersAllowedRepositoryCreationType
-}
-
-// GetMembersCanCreateInternalRepos returns the MembersCanCreateInternalRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreateInternalRepos() bool {
-	if o == nil || o.MembersCanCreateInternalRepos == nil {
-		return false
-	}
-	return *o.MembersCanCreateInternalRepos
-}
-
-// GetMembersCanCreatePages returns the MembersCanCreatePages field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePages() bool {
-	if o == nil || o.MembersCanCreatePages == nil {
-		return false
-	}
-	return *o.MembersCanCreatePages
+	return *p.TargetCommitish
 }
 
-// GetMembersCanCreatePrivatePages returns the MembersCanCreatePrivatePages field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePrivatePages() bool {
-	if o == nil || o.MembersCanCreatePrivatePages == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *o.MembersCanCreatePrivatePages
+	return *p.URL
 }
 
-// GetMembersCanCreatePrivateRepos returns the MembersCanCreatePrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePrivateRepos() bool {
-	if o == nil || o.MembersCanCreatePrivateRepos == nil {
-		return false
+// GetAuthor returns the Author field.
+func (p *PackageVersion) GetAuthor() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.MembersCanCreatePrivateRepos
+	return p.Author
 }
 
-// GetMembersCanCreatePublicPages returns the MembersCanCreatePublicPages field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePublicPages() bool {
-	if o == nil || o.MembersCanCreatePublicPages == nil {
-		return false
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return *o.MembersCanCreatePublicPages
+	return *p.Body
 }
 
-// GetMembersCanCreatePublicRepos returns the MembersCanCreatePublicRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePublicRepos() bool {
-	if o == nil || o.MembersCanCreatePublicRepos == nil {
-		return false
+// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetBodyHTML() string {
+	if p == nil || p.BodyHTML == nil {
+		return ""
 	}
-	return *o.MembersCanCreatePublicRepos
+	return *p.BodyHTML
 }
 
-// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreateRepos() bool {
-	if o == nil || o.MembersCanCreateRepos == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *o.MembersCanCreateRepos
+	return *p.CreatedAt
 }
 
-// GetMembersCanForkPrivateRepos returns the MembersCanForkPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanForkPrivateRepos() bool {
-	if o == nil || o.MembersCanForkPrivateRepos == nil {
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetDraft() bool {
+	if p == nil || p.Draft == nil {
 		return false
 	}
-	return *o.MembersCanForkPrivateRepos
+	return *p.Draft
 }
 
-// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersURL() string {
-	if o == nil || o.MembersURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *o.MembersURL
+	return *p.HTMLURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *Organization) GetName() string {
-	if o == nil || o.Name == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *o.Name
+	return *p.ID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetNodeID() string {
-	if o == nil || o.NodeID == nil {
+// GetInstallationCommand returns the InstallationCommand field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetInstallationCommand() string {
+	if p == nil || p.InstallationCommand == nil {
 		return ""
 	}
-	return *o.NodeID
+	return *p.InstallationCommand
 }
 
-// GetOwnedPrivateRepos returns the OwnedPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetOwnedPrivateRepos() int64 {
-	if o == nil || o.OwnedPrivateRepos == nil {
-		return 0
+// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetManifest() string {
+	if p == nil || p.Manifest == nil {
+		return ""
 	}
-	return *o.OwnedPrivateRepos
+	return *p.Manifest
 }
 
-// GetPlan returns the Plan field.
-func (o *Organization) GetPlan() *Plan {
-	if o == nil {
+// GetMetadata returns the Metadata field.
+func (p *PackageVersion) GetMetadata() *PackageMetadata {
+	if p == nil {
 		return nil
 	}
-	return o.Plan
+	return p.Metadata
 }
 
-// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPrivateGists() int {
-	if o == nil || o.PrivateGists == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *o.PrivateGists
+	return *p.Name
 }
 
-// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicGists() int {
-	if o == nil || o.PublicGists == nil {
-		return 0
+// GetPackageHTMLURL returns the PackageHTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetPackageHTMLURL() string {
+	if p == nil || p.PackageHTMLURL == nil {
+		return ""
 	}
-	return *o.PublicGists
+	return *p.PackageHTMLURL
 }
 
-// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicMembersURL() string {
-	if o == nil || o.PublicMembersURL == nil {
-		return ""
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetPrerelease() bool {
+	if p == nil || p.Prerelease == nil {
+		return false
 	}
-	return *o.PublicMembersURL
+	return *p.Prerelease
 }
 
-// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicRepos() int {
-	if o == nil || o.PublicRepos == nil {
-		return 0
+// GetRelease returns the Release field.
+func (p *PackageVersion) GetRelease() *PackageRelease {
+	if p == nil {
+		return nil
 	}
-	return *o.PublicRepos
+	return p.Release
 }
 
-// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetReposURL() string {
-	if o == nil || o.ReposURL == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetSummary() string {
+	if p == nil || p.Summary == nil {
 		return ""
 	}
-	return *o.ReposURL
+	return *p.Summary
 }
 
-// GetSecretScanningEnabledForNewRepos returns the SecretScanningEnabledForNewRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetSecretScanningEnabledForNewRepos() bool {
-	if o == nil || o.SecretScanningEnabledForNewRepos == nil {
-		return false
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTagName() string {
+	if p == nil || p.TagName == nil {
+		return ""
 	}
-	return *o.SecretScanningEnabledForNewRepos
+	return *p.TagName
 }
 
-// GetSecretScanningPushProtectionEnabledForNewRepos returns the SecretScanningPushProtectionEnabledForNewRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetSecretScanningPushProtectionEnabledForNewRepos() bool {
-	if o == nil || o.SecretScanningPushProtectionEnabledForNewRepos == nil {
-		return false
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTargetCommitish() string {
+	if p == nil || p.TargetCommitish == nil {
+		return ""
 	}
-	return *o.SecretScanningPushProtectionEnabledForNewRepos
+	return *p.TargetCommitish
 }
 
-// GetTotalPrivateRepos returns the TotalPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTotalPrivateRepos() int64 {
-	if o == nil || o.TotalPrivateRepos == nil {
-		return 0
+// GetTargetOID returns the TargetOID field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTargetOID() string {
+	if p == nil || p.TargetOID == nil {
+		return ""
 	}
-	return *o.TotalPrivateRepos
+	return *p.TargetOID
 }
 
-// GetTwitterUsername returns the TwitterUsername field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTwitterUsername() string {
-	if o == nil || o.TwitterUsername == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *o.TwitterUsername
+	return *p.URL
 }
 
-// GetTwoFactorRequirementEnabled returns the TwoFactorRequirementEnabled field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTwoFactorRequirementEnabled() bool {
-	if o == nil || o.TwoFactorRequirementEnabled == nil {
-		return false
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetVersion() string {
+	if p == nil || p.Version == nil {
+		return ""
 	}
-	return *o.TwoFactorRequirementEnabled
+	return *p.Version
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (o *Organization) GetType() string {
-	if o == nil || o.Type == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *Page) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *o.Type
+	return *p.Action
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (o *Organization) GetUpdatedAt() Timestamp {
-	if o == nil || o.UpdatedAt == nil {
-		return Timestamp{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Page) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return *o.UpdatedAt
+	return *p.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetURL() string {
-	if o == nil || o.URL == nil {
+// GetPageName returns the PageName field if it's non-nil, zero value otherwise.
+func (p *Page) GetPageName() string {
+	if p == nil || p.PageName == nil {
 		return ""
 	}
-	return *o.URL
+	return *p.PageName
 }
 
-// GetWebCommitSignoffRequired returns the WebCommitSignoffRequired field if it's non-nil, zero value otherwise.
-func (o *Organization) GetWebCommitSignoffRequired() bool {
-	if o == nil || o.WebCommitSignoffRequired == nil {
-		return false
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *Page) GetSHA() string {
+	if p == nil || p.SHA == nil {
+		return ""
 	}
-	return *o.WebCommitSignoffRequired
+	return *p.SHA
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (o *OrganizationCustomRepoRoles) GetTotalCount() int {
-	if o == nil || o.TotalCount == nil {
-		return 0
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (p *Page) GetSummary() string {
+	if p == nil || p.Summary == nil {
+		return ""
 	}
-	return *o.TotalCount
+	return *p.Summary
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (o *OrganizationEvent) GetAction() string {
-	if o == nil || o.Action == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *Page) GetTitle() string {
+	if p == nil || p.Title == nil {
 		return ""
 	}
-	return *o.Action
+	return *p.Title
 }
 
-// GetInstallation returns the Installation field.
-func (o *OrganizationEvent) GetInstallation() *Installation {
-	if o == nil {
+// GetBuild returns the Build field.
+func (p *PageBuildEvent) GetBuild() *PagesBuild {
+	if p == nil {
 		return nil
 	}
-	return o.Installation
+	return p.Build
 }
 
-// GetInvitation returns the Invitation field.
-func (o *OrganizationEvent) GetInvitation() *Invitation {
-	if o == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PageBuildEvent) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetInstallation returns the Installation field.
+func (p *PageBuildEvent) GetInstallation() *Installation {
+	if p == nil {
 		return nil
 	}
-	return o.Invitation
+	return p.Installation
 }
 
-// GetMembership returns the Membership field.
-func (o *OrganizationEvent) GetMembership() *Membership {
-	if o == nil {
+// GetOrg returns the Org field.
+func (p *PageBuildEvent) GetOrg() *Organization {
+	if p == nil {
 		return nil
 	}
-	return o.Membership
+	return p.Org
 }
 
-// GetOrganization returns the Organization field.
-func (o *OrganizationEvent) GetOrganization() *Organization {
-	if o == nil {
+// GetRepo returns the Repo field.
+func (p *PageBuildEvent) GetRepo() *Repository {
+	if p == nil {
 		return nil
 	}
-	return o.Organization
+	return p.Repo
 }
 
 // GetSender returns the Sender field.
-func (o *OrganizationEvent) GetSender() *User {
-	if o == nil {
+func (p *PageBuildEvent) GetSender() *User {
+	if p == nil {
 		return nil
 	}
-	return o.Sender
+	return p.Sender
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (o *OrganizationInstallations) GetTotalCount() int {
-	if o == nil || o.TotalCount == nil {
-		return 0
+// GetBuildType returns the BuildType field if it's non-nil, zero value otherwise.
+func (p *Pages) GetBuildType() string {
+	if p == nil || p.BuildType == nil {
+		return ""
 	}
-	return *o.TotalCount
+	return *p.BuildType
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (o *OrgBlockEvent) GetAction() string {
-	if o == nil || o.Action == nil {
+// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
+func (p *Pages) GetCNAME() string {
+	if p == nil || p.CNAME == nil {
 		return ""
 	}
-	return *o.Action
+	return *p.CNAME
 }
 
-// GetBlockedUser returns the BlockedUser field.
-func (o *OrgBlockEvent) GetBlockedUser() *User {
-	if o == nil {
-		return nil
+// GetCustom404 returns the Custom404 field if it's non-nil, zero value otherwise.
+func (p *Pages) GetCustom404() bool {
+	if p == nil || p.Custom404 == nil {
+		return false
 	}
-	return o.BlockedUser
+	return *p.Custom404
 }
 
-// GetInstallation returns the Installation field.
-func (o *OrgBlockEvent) GetInstallation() *Installation {
-	if o == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Pages) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return o.Installation
+	return *p.HTMLURL
 }
 
-// GetOrganization returns the Organization field.
-func (o *OrgBlockEvent) GetOrganization() *Organization {
-	if o == nil {
+// GetHTTPSCertificate returns the HTTPSCertificate field.
+func (p *Pages) GetHTTPSCertificate() *PagesHTTPSCertificate {
+	if p == nil {
 		return nil
 	}
-	return o.Organization
+	return p.HTTPSCertificate
 }
 
-// GetSender returns the Sender field.
-func (o *OrgBlockEvent) GetSender() *User {
-	if o == nil {
-		return nil
+// GetHTTPSEnforced returns the HTTPSEnforced field if it's non-nil, zero value otherwise.
+func (p *Pages) GetHTTPSEnforced() bool {
+	if p == nil || p.HTTPSEnforced == nil {
+		return false
 	}
-	return o.Sender
+	return *p.HTTPSEnforced
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetCreatedAt() Timestamp {
-	if o == nil || o.CreatedAt == nil {
-		return Timestamp{}
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *Pages) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
 	}
-	return *o.CreatedAt
+	return *p.Public
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetID() int64 {
-	if o == nil || o.ID == nil {
-		return 0
+// GetSource returns the Source field.
+func (p *Pages) GetSource() *PagesSource {
+	if p == nil {
+		return nil
 	}
-	return *o.ID
+	return p.Source
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetName() string {
-	if o == nil || o.Name == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *Pages) GetStatus() string {
+	if p == nil || p.Status == nil {
 		return ""
 	}
-	return *o.Name
+	return *p.Status
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetPath() string {
-	if o == nil || o.Path == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Pages) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *o.Path
+	return *p.URL
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetRef() string {
-	if o == nil || o.Ref == nil {
+// GetCommit returns the Commit field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetCommit() string {
+	if p == nil || p.Commit == nil {
 		return ""
 	}
-	return *o.Ref
+	return *p.Commit
 }
 
-// GetRepository returns the Repository field.
-func (o *OrgRequiredWorkflow) GetRepository() *Repository {
-	if o == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return o.Repository
+	return *p.CreatedAt
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetScope() string {
-	if o == nil || o.Scope == nil {
-		return ""
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetDuration() int {
+	if p == nil || p.Duration == nil {
+		return 0
 	}
-	return *o.Scope
+	return *p.Duration
 }
 
-// GetSelectedRepositoriesURL returns the SelectedRepositoriesURL field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetSelectedRepositoriesURL() string {
-	if o == nil || o.SelectedRepositoriesURL == nil {
-		return ""
+// GetError returns the Error field.
+func (p *PagesBuild) GetError() *PagesError {
+	if p == nil {
+		return nil
 	}
-	return *o.SelectedRepositoriesURL
+	return p.Error
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetState() string {
-	if o == nil || o.State == nil {
-		return ""
+// GetPusher returns the Pusher field.
+func (p *PagesBuild) GetPusher() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.State
+	return p.Pusher
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflow) GetUpdatedAt() Timestamp {
-	if o == nil || o.UpdatedAt == nil {
-		return Timestamp{}
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
 	}
-	return *o.UpdatedAt
+	return *p.Status
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (o *OrgRequiredWorkflows) GetTotalCount() int {
-	if o == nil || o.TotalCount == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *o.TotalCount
+	return *p.UpdatedAt
 }
 
-// GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetDisabledOrgs() int {
-	if o == nil || o.DisabledOrgs == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *o.DisabledOrgs
+	return *p.URL
 }
 
-// GetTotalOrgs returns the TotalOrgs field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalOrgs() int {
-	if o == nil || o.TotalOrgs == nil {
-		return 0
+// GetCAAError returns the CAAError field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetCAAError() string {
+	if p == nil || p.CAAError == nil {
+		return ""
 	}
-	return *o.TotalOrgs
+	return *p.CAAError
 }
 
-// GetTotalTeamMembers returns the TotalTeamMembers field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalTeamMembers() int {
-	if o == nil || o.TotalTeamMembers == nil {
-		return 0
+// GetDNSResolves returns the DNSResolves field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetDNSResolves() bool {
+	if p == nil || p.DNSResolves == nil {
+		return false
 	}
-	return *o.TotalTeamMembers
+	return *p.DNSResolves
 }
 
-// GetTotalTeams returns the TotalTeams field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalTeams() int {
-	if o == nil || o.TotalTeams == nil {
-		return 0
+// GetEnforcesHTTPS returns the EnforcesHTTPS field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetEnforcesHTTPS() bool {
+	if p == nil || p.EnforcesHTTPS == nil {
+		return false
 	}
-	return *o.TotalTeams
+	return *p.EnforcesHTTPS
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Package) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetHasCNAMERecord returns the HasCNAMERecord field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetHasCNAMERecord() bool {
+	if p == nil || p.HasCNAMERecord == nil {
+		return false
 	}
-	return *p.CreatedAt
+	return *p.HasCNAMERecord
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Package) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetHasMXRecordsPresent returns the HasMXRecordsPresent field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetHasMXRecordsPresent() bool {
+	if p == nil || p.HasMXRecordsPresent == nil {
+		return false
 	}
-	return *p.HTMLURL
-}
-
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *Package) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+	return *p.HasMXRecordsPresent
+}
+
+// GetHost returns the Host field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetHost() string {
+	if p == nil || p.Host == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.Host
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Package) GetName() string {
-	if p == nil || p.Name == nil {
+// GetHTTPSError returns the HTTPSError field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetHTTPSError() string {
+	if p == nil || p.HTTPSError == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.HTTPSError
 }
 
-// GetOwner returns the Owner field.
-func (p *Package) GetOwner() *User {
-	if p == nil {
-		return nil
+// GetIsApexDomain returns the IsApexDomain field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsApexDomain() bool {
+	if p == nil || p.IsApexDomain == nil {
+		return false
 	}
-	return p.Owner
+	return *p.IsApexDomain
 }
 
-// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
-func (p *Package) GetPackageType() string {
-	if p == nil || p.PackageType == nil {
-		return ""
+// GetIsARecord returns the IsARecord field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsARecord() bool {
+	if p == nil || p.IsARecord == nil {
+		return false
 	}
-	return *p.PackageType
+	return *p.IsARecord
 }
 
-// GetPackageVersion returns the PackageVersion field.
-func (p *Package) GetPackageVersion() *PackageVersion {
-	if p == nil {
-		return nil
+// GetIsCloudflareIP returns the IsCloudflareIP field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsCloudflareIP() bool {
+	if p == nil || p.IsCloudflareIP == nil {
+		return false
 	}
-	return p.PackageVersion
+	return *p.IsCloudflareIP
 }
 
-// GetRegistry returns the Registry field.
-func (p *Package) GetRegistry() *PackageRegistry {
-	if p == nil {
-		return nil
+// GetIsCNAMEToFastly returns the IsCNAMEToFastly field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsCNAMEToFastly() bool {
+	if p == nil || p.IsCNAMEToFastly == nil {
+		return false
 	}
-	return p.Registry
+	return *p.IsCNAMEToFastly
 }
 
-// GetRepository returns the Repository field.
-func (p *Package) GetRepository() *Repository {
-	if p == nil {
-		return nil
+// GetIsCNAMEToGithubUserDomain returns the IsCNAMEToGithubUserDomain field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsCNAMEToGithubUserDomain() bool {
+	if p == nil || p.IsCNAMEToGithubUserDomain == nil {
+		return false
 	}
-	return p.Repository
+	return *p.IsCNAMEToGithubUserDomain
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Package) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetIsCNAMEToPagesDotGithubDotCom returns the IsCNAMEToPagesDotGithubDotCom field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsCNAMEToPagesDotGithubDotCom() bool {
+	if p == nil || p.IsCNAMEToPagesDotGithubDotCom == nil {
+		return false
 	}
-	return *p.UpdatedAt
+	return *p.IsCNAMEToPagesDotGithubDotCom
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Package) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetIsFastlyIP returns the IsFastlyIP field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsFastlyIP() bool {
+	if p == nil || p.IsFastlyIP == nil {
+		return false
 	}
-	return *p.URL
+	return *p.IsFastlyIP
 }
 
-// GetVersionCount returns the VersionCount field if it's non-nil, zero value otherwise.
-func (p *Package) GetVersionCount() int64 {
-	if p == nil || p.VersionCount == nil {
-		return 0
+// GetIsHTTPSEligible returns the IsHTTPSEligible field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsHTTPSEligible() bool {
+	if p == nil || p.IsHTTPSEligible == nil {
+		return false
 	}
-	return *p.VersionCount
+	return *p.IsHTTPSEligible
 }
 
-// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
-func (p *Package) GetVisibility() string {
-	if p == nil || p.Visibility == nil {
-		return ""
+// GetIsNonGithubPagesIPPresent returns the IsNonGithubPagesIPPresent field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsNonGithubPagesIPPresent() bool {
+	if p == nil || p.IsNonGithubPagesIPPresent == nil {
+		return false
 	}
-	return *p.Visibility
+	return *p.IsNonGithubPagesIPPresent
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PackageEvent) GetAction() string {
-	if p == nil || p.Action == nil {
-		return ""
+// GetIsOldIPAddress returns the IsOldIPAddress field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsOldIPAddress() bool {
+	if p == nil || p.IsOldIPAddress == nil {
+		return false
 	}
-	return *p.Action
+	return *p.IsOldIPAddress
 }
 
-// GetInstallation returns the Installation field.
-func (p *PackageEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetIsPagesDomain returns the IsPagesDomain field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsPagesDomain() bool {
+	if p == nil || p.IsPagesDomain == nil {
+		return false
 	}
-	return p.Installation
+	return *p.IsPagesDomain
 }
 
-// GetOrg returns the Org field.
-func (p *PackageEvent) GetOrg() *Organization {
-	if p == nil {
-		return nil
+// GetIsPointedToGithubPagesIP returns the IsPointedToGithubPagesIP field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsPointedToGithubPagesIP() bool {
+	if p == nil || p.IsPointedToGithubPagesIP == nil {
+		return false
 	}
-	return p.Org
+	return *p.IsPointedToGithubPagesIP
 }
 
-// GetPackage returns the Package field.
-func (p *PackageEvent) GetPackage() *Package {
-	if p == nil {
-		return nil
+// GetIsProxied returns the IsProxied field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsProxied() bool {
+	if p == nil || p.IsProxied == nil {
+		return false
 	}
-	return p.Package
+	return *p.IsProxied
 }
 
-// GetRepo returns the Repo field.
-func (p *PackageEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetIsServedByPages returns the IsServedByPages field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsServedByPages() bool {
+	if p == nil || p.IsServedByPages == nil {
+		return false
 	}
-	return p.Repo
+	return *p.IsServedByPages
 }
 
-// GetSender returns the Sender field.
-func (p *PackageEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetIsValid returns the IsValid field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsValid() bool {
+	if p == nil || p.IsValid == nil {
+		return false
 	}
-	return p.Sender
+	return *p.IsValid
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageFile) GetAuthor() *User {
-	if p == nil {
-		return nil
+// GetIsValidDomain returns the IsValidDomain field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetIsValidDomain() bool {
+	if p == nil || p.IsValidDomain == nil {
+		return false
 	}
-	return p.Author
+	return *p.IsValidDomain
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetContentType() string {
-	if p == nil || p.ContentType == nil {
+// GetNameservers returns the Nameservers field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetNameservers() string {
+	if p == nil || p.Nameservers == nil {
 		return ""
 	}
-	return *p.ContentType
+	return *p.Nameservers
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetReason() string {
+	if p == nil || p.Reason == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.Reason
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetDownloadURL() string {
-	if p == nil || p.DownloadURL == nil {
-		return ""
+// GetRespondsToHTTPS returns the RespondsToHTTPS field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetRespondsToHTTPS() bool {
+	if p == nil || p.RespondsToHTTPS == nil {
+		return false
 	}
-	return *p.DownloadURL
+	return *p.RespondsToHTTPS
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetShouldBeARecord returns the ShouldBeARecord field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetShouldBeARecord() bool {
+	if p == nil || p.ShouldBeARecord == nil {
+		return false
 	}
-	return *p.ID
+	return *p.ShouldBeARecord
 }
 
-// GetMD5 returns the MD5 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetMD5() string {
-	if p == nil || p.MD5 == nil {
+// GetURI returns the URI field if it's non-nil, zero value otherwise.
+func (p *PagesDomain) GetURI() string {
+	if p == nil || p.URI == nil {
 		return ""
 	}
-	return *p.MD5
+	return *p.URI
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetName() string {
-	if p == nil || p.Name == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PagesError) GetMessage() string {
+	if p == nil || p.Message == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.Message
 }
 
-// GetSHA1 returns the SHA1 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSHA1() string {
-	if p == nil || p.SHA1 == nil {
-		return ""
+// GetAltDomain returns the AltDomain field.
+func (p *PagesHealthCheckResponse) GetAltDomain() *PagesDomain {
+	if p == nil {
+		return nil
 	}
-	return *p.SHA1
+	return p.AltDomain
 }
 
-// GetSHA256 returns the SHA256 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSHA256() string {
-	if p == nil || p.SHA256 == nil {
+// GetDomain returns the Domain field.
+func (p *PagesHealthCheckResponse) GetDomain() *PagesDomain {
+	if p == nil {
+		return nil
+	}
+	return p.Domain
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PagesHTTPSCertificate) GetDescription() string {
+	if p == nil || p.Description == nil {
 		return ""
 	}
-	return *p.SHA256
+	return *p.Description
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSize() int64 {
-	if p == nil || p.Size == nil {
-		return 0
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (p *PagesHTTPSCertificate) GetExpiresAt() string {
+	if p == nil || p.ExpiresAt == nil {
+		return ""
 	}
-	return *p.Size
+	return *p.ExpiresAt
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetState() string {
+func (p *PagesHTTPSCertificate) GetState() string {
 	if p == nil || p.State == nil {
 		return ""
 	}
 	return *p.State
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (p *PagesSource) GetBranch() string {
+	if p == nil || p.Branch == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *p.Branch
 }
 
-// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
-func (p *PackageListOptions) GetPackageType() string {
-	if p == nil || p.PackageType == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PagesSource) GetPath() string {
+	if p == nil || p.Path == nil {
 		return ""
 	}
-	return *p.PackageType
+	return *p.Path
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PackageListOptions) GetState() string {
-	if p == nil || p.State == nil {
+// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
+func (p *PageStats) GetTotalPages() int {
+	if p == nil || p.TotalPages == nil {
+		return 0
+	}
+	return *p.TotalPages
+}
+
+// GetBuildType returns the BuildType field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetBuildType() string {
+	if p == nil || p.BuildType == nil {
 		return ""
 	}
-	return *p.State
+	return *p.BuildType
 }
 
-// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
-func (p *PackageListOptions) GetVisibility() string {
-	if p == nil || p.Visibility == nil {
+// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetCNAME() string {
+	if p == nil || p.CNAME == nil {
 		return ""
 	}
-	return *p.Visibility
+	return *p.CNAME
 }
 
-// GetContainer returns the Container field.
-func (p *PackageMetadata) GetContainer() *PackageContainerMetadata {
+// GetHTTPSEnforced returns the HTTPSEnforced field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetHTTPSEnforced() bool {
+	if p == nil || p.HTTPSEnforced == nil {
+		return false
+	}
+	return *p.HTTPSEnforced
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
+	}
+	return *p.Public
+}
+
+// GetSource returns the Source field.
+func (p *PagesUpdate) GetSource() *PagesSource {
 	if p == nil {
 		return nil
 	}
-	return p.Container
+	return p.Source
 }
 
-// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
-func (p *PackageMetadata) GetPackageType() string {
-	if p == nil || p.PackageType == nil {
-		return ""
+// GetAccessGrantedAt returns the AccessGrantedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetAccessGrantedAt() Timestamp {
+	if p == nil || p.AccessGrantedAt == nil {
+		return Timestamp{}
 	}
-	return *p.PackageType
+	return *p.AccessGrantedAt
 }
 
-// GetAboutURL returns the AboutURL field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetAboutURL() string {
-	if p == nil || p.AboutURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.AboutURL
+	return *p.ID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetOwner returns the Owner field.
+func (p *PersonalAccessToken) GetOwner() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Name
+	return p.Owner
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetType() string {
-	if p == nil || p.Type == nil {
-		return ""
+// GetPermissions returns the Permissions field.
+func (p *PersonalAccessToken) GetPermissions() *PersonalAccessTokenPermissions {
+	if p == nil {
+		return nil
 	}
-	return *p.Type
+	return p.Permissions
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetRepositoriesURL() string {
+	if p == nil || p.RepositoriesURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.RepositoriesURL
 }
 
-// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetVendor() string {
-	if p == nil || p.Vendor == nil {
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetRepositorySelection() string {
+	if p == nil || p.RepositorySelection == nil {
 		return ""
 	}
-	return *p.Vendor
+	return *p.RepositorySelection
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageRelease) GetAuthor() *User {
-	if p == nil {
-		return nil
+// GetTokenExpired returns the TokenExpired field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenExpired() bool {
+	if p == nil || p.TokenExpired == nil {
+		return false
 	}
-	return p.Author
+	return *p.TokenExpired
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
+// GetTokenExpiresAt returns the TokenExpiresAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenExpiresAt() Timestamp {
+	if p == nil || p.TokenExpiresAt == nil {
 		return Timestamp{}
 	}
-	return *p.CreatedAt
+	return *p.TokenExpiresAt
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetDraft() bool {
-	if p == nil || p.Draft == nil {
-		return false
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenID() int64 {
+	if p == nil || p.TokenID == nil {
+		return 0
 	}
-	return *p.Draft
+	return *p.TokenID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetTokenLastUsedAt returns the TokenLastUsedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenLastUsedAt() Timestamp {
+	if p == nil || p.TokenLastUsedAt == nil {
+		return Timestamp{}
 	}
-	return *p.HTMLURL
+	return *p.TokenLastUsedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetTokenName returns the TokenName field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenName() string {
+	if p == nil || p.TokenName == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.TokenName
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetOrg returns the Org map if it's non-nil, an empty map otherwise.
+func (p *PersonalAccessTokenPermissions) GetOrg() map[string]string {
+	if p == nil || p.Org == nil {
+		return map[string]string{}
 	}
-	return *p.Name
+	return p.Org
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetPrerelease() bool {
-	if p == nil || p.Prerelease == nil {
-		return false
+// GetOther returns the Other map if it's non-nil, an empty map otherwise.
+func (p *PersonalAccessTokenPermissions) GetOther() map[string]string {
+	if p == nil || p.Other == nil {
+		return map[string]string{}
 	}
-	return *p.Prerelease
+	return p.Other
 }
 
-// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetPublishedAt() Timestamp {
-	if p == nil || p.PublishedAt == nil {
-		return Timestamp{}
+// GetRepo returns the Repo map if it's non-nil, an empty map otherwise.
+func (p *PersonalAccessTokenPermissions) GetRepo() map[string]string {
+	if p == nil || p.Repo == nil {
+		return map[string]string{}
 	}
-	return *p.PublishedAt
+	return p.Repo
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetTagName() string {
-	if p == nil || p.TagName == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.TagName
+	return *p.CreatedAt
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetTargetCommitish() string {
-	if p == nil || p.TargetCommitish == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.TargetCommitish
+	return *p.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetOwner returns the Owner field.
+func (p *PersonalAccessTokenRequest) GetOwner() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.URL
+	return p.Owner
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageVersion) GetAuthor() *User {
+// GetPermissions returns the Permissions field.
+func (p *PersonalAccessTokenRequest) GetPermissions() *PersonalAccessTokenPermissions {
 	if p == nil {
 		return nil
 	}
-	return p.Author
+	return p.Permissions
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetReason() string {
+	if p == nil || p.Reason == nil {
 		return ""
 	}
-	return *p.Body
+	return *p.Reason
 }
 
-// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetBodyHTML() string {
-	if p == nil || p.BodyHTML == nil {
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetRepositoriesURL() string {
+	if p == nil || p.RepositoriesURL == nil {
 		return ""
 	}
-	return *p.BodyHTML
+	return *p.RepositoriesURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetRepositorySelection() string {
+	if p == nil || p.RepositorySelection == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.RepositorySelection
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetDraft() bool {
-	if p == nil || p.Draft == nil {
+// GetTokenExpired returns the TokenExpired field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenExpired() bool {
+	if p == nil || p.TokenExpired == nil {
 		return false
 	}
-	return *p.Draft
+	return *p.TokenExpired
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetTokenExpiresAt returns the TokenExpiresAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenExpiresAt() Timestamp {
+	if p == nil || p.TokenExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *p.HTMLURL
+	return *p.TokenExpiresAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetID() int64 {
-	if p == nil || p.ID == nil {
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenID() int64 {
+	if p == nil || p.TokenID == nil {
 		return 0
 	}
-	return *p.ID
+	return *p.TokenID
 }
 
-// GetInstallationCommand returns the InstallationCommand field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetInstallationCommand() string {
-	if p == nil || p.InstallationCommand == nil {
-		return ""
+// GetTokenLastUsedAt returns the TokenLastUsedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenLastUsedAt() Timestamp {
+	if p == nil || p.TokenLastUsedAt == nil {
+		return Timestamp{}
 	}
-	return *p.InstallationCommand
+	return *p.TokenLastUsedAt
 }
 
-// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetManifest() string {
-	if p == nil || p.Manifest == nil {
+// GetTokenName returns the TokenName field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenName() string {
+	if p == nil || p.TokenName == nil {
 		return ""
 	}
-	return *p.Manifest
+	return *p.TokenName
 }
 
-// GetMetadata returns the Metadata field.
-func (p *PackageVersion) GetMetadata() *PackageMetadata {
+// GetHook returns the Hook field.
+func (p *PingEvent) GetHook() *Hook {
 	if p == nil {
 		return nil
 	}
-	return p.Metadata
+	return p.Hook
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetHookID() int64 {
+	if p == nil || p.HookID == nil {
+		return 0
 	}
-	return *p.Name
+	return *p.HookID
 }
 
-// GetPackageHTMLURL returns the PackageHTMLURL field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetPackageHTMLURL() string {
-	if p == nil || p.PackageHTMLURL == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (p *PingEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *p.PackageHTMLURL
+	return p.Installation
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetPrerelease() bool {
-	if p == nil || p.Prerelease == nil {
-		return false
+// GetOrg returns the Org field.
+func (p *PingEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *p.Prerelease
+	return p.Org
 }
 
-// GetRelease returns the Release field.
-func (p *PackageVersion) GetRelease() *PackageRelease {
+// GetRepo returns the Repo field.
+func (p *PingEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.Release
+	return p.Repo
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetSummary() string {
-	if p == nil || p.Summary == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *PingEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Summary
+	return p.Sender
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTagName() string {
-	if p == nil || p.TagName == nil {
+// GetZen returns the Zen field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetZen() string {
+	if p == nil || p.Zen == nil {
 		return ""
 	}
-	return *p.TagName
+	return *p.Zen
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTargetCommitish() string {
-	if p == nil || p.TargetCommitish == nil {
-		return ""
+// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
+func (p *Plan) GetCollaborators() int {
+	if p == nil || p.Collaborators == nil {
+		return 0
 	}
-	return *p.TargetCommitish
+	return *p.Collaborators
 }
 
-// GetTargetOID returns the TargetOID field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTargetOID() string {
-	if p == nil || p.TargetOID == nil {
+// GetFilledSeats returns the FilledSeats field if it's non-nil, zero value otherwise.
+func (p *Plan) GetFilledSeats() int {
+	if p == nil || p.FilledSeats == nil {
+		return 0
+	}
+	return *p.FilledSeats
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Plan) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *p.TargetOID
+	return *p.Name
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetPrivateRepos returns the PrivateRepos field if it's non-nil, zero value otherwise.
+func (p *Plan) GetPrivateRepos() int64 {
+	if p == nil || p.PrivateRepos == nil {
+		return 0
 	}
-	return *p.UpdatedAt
+	return *p.PrivateRepos
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetSeats returns the Seats field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSeats() int {
+	if p == nil || p.Seats == nil {
+		return 0
+	}
+	return *p.Seats
+}
+
+// GetSpace returns the Space field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSpace() int {
+	if p == nil || p.Space == nil {
+		return 0
+	}
+	return *p.Space
+}
+
+// GetConfigURL returns the ConfigURL field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetConfigURL() string {
+	if p == nil || p.ConfigURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.ConfigURL
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetVersion() string {
-	if p == nil || p.Version == nil {
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetEnforcement() string {
+	if p == nil || p.Enforcement == nil {
 		return ""
 	}
-	return *p.Version
+	return *p.Enforcement
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *Page) GetAction() string {
-	if p == nil || p.Action == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.Action
+	return *p.ID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Page) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *p.Name
 }
 
-// GetPageName returns the PageName field if it's non-nil, zero value otherwise.
-func (p *Page) GetPageName() string {
-	if p == nil || p.PageName == nil {
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (p *PRLink) GetHRef() string {
+	if p == nil || p.HRef == nil {
 		return ""
 	}
-	return *p.PageName
+	return *p.HRef
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *Page) GetSHA() string {
-	if p == nil || p.SHA == nil {
-		return ""
+// GetComments returns the Comments field.
+func (p *PRLinks) GetComments() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *p.SHA
+	return p.Comments
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (p *Page) GetSummary() string {
-	if p == nil || p.Summary == nil {
-		return ""
+// GetCommits returns the Commits field.
+func (p *PRLinks) GetCommits() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *p.Summary
+	return p.Commits
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *Page) GetTitle() string {
-	if p == nil || p.Title == nil {
-		return ""
+// GetHTML returns the HTML field.
+func (p *PRLinks) GetHTML() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *p.Title
+	return p.HTML
 }
 
-// GetBuild returns the Build field.
-func (p *PageBuildEvent) GetBuild() *PagesBuild {
+// GetIssue returns the Issue field.
+func (p *PRLinks) GetIssue() *PRLink {
 	if p == nil {
 		return nil
 	}
-	return p.Build
+	return p.Issue
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PageBuildEvent) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetReviewComment returns the ReviewComment field.
+func (p *PRLinks) GetReviewComment() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *p.ID
+	return p.ReviewComment
 }
 
-// GetInstallation returns the Installation field.
-func (p *PageBuildEvent) GetInstallation() *Installation {
+// GetReviewComments returns the ReviewComments field.
+func (p *PRLinks) GetReviewComments() *PRLink {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.ReviewComments
 }
 
-// GetRepo returns the Repo field.
-func (p *PageBuildEvent) GetRepo() *Repository {
+// GetSelf returns the Self field.
+func (p *PRLinks) GetSelf() *PRLink {
 	if p == nil {
 		return nil
 	}
-	return p.Repo
+	return p.Self
 }
 
-// GetSender returns the Sender field.
-func (p *PageBuildEvent) GetSender() *User {
+// GetStatuses returns the Statuses field.
+func (p *PRLinks) GetStatuses() *PRLink {
 	if p == nil {
 		return nil
 	}
-	return p.Sender
+	return p.Statuses
 }
 
-// GetBuildType returns the BuildType field if it's non-nil, zero value otherwise.
-func (p *Pages) GetBuildType() string {
-	if p == nil || p.BuildType == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *Project) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *p.BuildType
+	return *p.Body
 }
 
-// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
-func (p *Pages) GetCNAME() string {
-	if p == nil || p.CNAME == nil {
+// GetColumnsURL returns the ColumnsURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetColumnsURL() string {
+	if p == nil || p.ColumnsURL == nil {
 		return ""
 	}
-	return *p.CNAME
+	return *p.ColumnsURL
 }
 
-// GetCustom404 returns the Custom404 field if it's non-nil, zero value otherwise.
-func (p *Pages) GetCustom404() bool {
-	if p == nil || p.Custom404 == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Project) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.Custom404
+	return *p.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (p *Project) GetCreator() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Creator
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Pages) GetHTMLURL() string {
+func (p *Project) GetHTMLURL() string {
 	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
 	return *p.HTMLURL
 }
 
-// GetHTTPSCertificate returns the HTTPSCertificate field.
-func (p *Pages) GetHTTPSCertificate() *PagesHTTPSCertificate {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Project) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return p.HTTPSCertificate
+	return *p.ID
 }
 
-// GetHTTPSEnforced returns the HTTPSEnforced field if it's non-nil, zero value otherwise.
-func (p *Pages) GetHTTPSEnforced() bool {
-	if p == nil || p.HTTPSEnforced == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Project) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *p.HTTPSEnforced
+	return *p.Name
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (p *Pages) GetPublic() bool {
-	if p == nil || p.Public == nil {
-		return false
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *Project) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return *p.Public
+	return *p.NodeID
 }
 
-// GetSource returns the Source field.
-func (p *Pages) GetSource() *PagesSource {
-	if p == nil {
-		return nil
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *Project) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
 	}
-	return p.Source
+	return *p.Number
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *Pages) GetStatus() string {
-	if p == nil || p.Status == nil {
+// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
+func (p *Project) GetOrganizationPermission() string {
+	if p == nil || p.OrganizationPermission == nil {
 		return ""
 	}
-	return *p.Status
+	return *p.OrganizationPermission
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Pages) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetOwnerURL returns the OwnerURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetOwnerURL() string {
+	if p == nil || p.OwnerURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.OwnerURL
 }
 
-// GetCommit returns the Commit field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetCommit() string {
-	if p == nil || p.Commit == nil {
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (p *Project) GetPrivate() bool {
+	if p == nil || p.Private == nil {
+		return false
+	}
+	return *p.Private
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *Project) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *p.Commit
+	return *p.State
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Project) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *p.CreatedAt
+	return *p.UpdatedAt
 }
 
-// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetDuration() int {
-	if p == nil || p.Duration == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Project) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *p.Duration
+	return *p.URL
 }
 
-// GetError returns the Error field.
-func (p *PagesBuild) GetError() *PagesError {
-	if p == nil {
-		return nil
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (p *ProjectBody) GetFrom() string {
+	if p == nil || p.From == nil {
+		return ""
 	}
-	return p.Error
+	return *p.From
 }
 
-// GetPusher returns the Pusher field.
-func (p *PagesBuild) GetPusher() *User {
-	if p == nil {
-		return nil
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
 	}
-	return p.Pusher
+	return *p.Archived
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetStatus() string {
-	if p == nil || p.Status == nil {
-		return ""
+// GetColumnID returns the ColumnID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnID() int64 {
+	if p == nil || p.ColumnID == nil {
+		return 0
 	}
-	return *p.Status
+	return *p.ColumnID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetColumnName returns the ColumnName field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnName() string {
+	if p == nil || p.ColumnName == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *p.ColumnName
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetColumnURL returns the ColumnURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnURL() string {
+	if p == nil || p.ColumnURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.ColumnURL
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PagesError) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetContentURL returns the ContentURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetContentURL() string {
+	if p == nil || p.ContentURL == nil {
 		return ""
 	}
-	return *p.Message
+	return *p.ContentURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PagesHTTPSCertificate) GetDescription() string {
-	if p == nil || p.Description == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.Description
+	return *p.CreatedAt
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (p *PagesHTTPSCertificate) GetExpiresAt() string {
-	if p == nil || p.ExpiresAt == nil {
-		return ""
+// GetCreator returns the Creator field.
+func (p *ProjectCard) GetCreator() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.ExpiresAt
+	return p.Creator
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PagesHTTPSCertificate) GetState() string {
-	if p == nil || p.State == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.State
+	return *p.ID
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (p *PagesSource) GetBranch() string {
-	if p == nil || p.Branch == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.Branch
+	return *p.NodeID
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (p *PagesSource) GetPath() string {
-	if p == nil || p.Path == nil {
+// GetNote returns the Note field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetNote() string {
+	if p == nil || p.Note == nil {
 		return ""
 	}
-	return *p.Path
+	return *p.Note
 }
 
-// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
-func (p *PageStats) GetTotalPages() int {
-	if p == nil || p.TotalPages == nil {
-		return 0
+// GetPreviousColumnName returns the PreviousColumnName field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetPreviousColumnName() string {
+	if p == nil || p.PreviousColumnName == nil {
+		return ""
 	}
-	return *p.TotalPages
+	return *p.PreviousColumnName
 }
 
-// GetBuildType returns the BuildType field if it's non-nil, zero value otherwise.
-func (p *PagesUpdate) GetBuildType() string {
-	if p == nil || p.BuildType == nil {
-		return ""
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetProjectID() int64 {
+	if p == nil || p.ProjectID == nil {
+		return 0
 	}
-	return *p.BuildType
+	return *p.ProjectID
 }
 
-// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
-func (p *PagesUpdate) GetCNAME() string {
-	if p == nil || p.CNAME == nil {
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
 		return ""
 	}
-	return *p.CNAME
+	return *p.ProjectURL
 }
 
-// GetHTTPSEnforced returns the HTTPSEnforced field if it's non-nil, zero value otherwise.
-func (p *PagesUpdate) GetHTTPSEnforced() bool {
-	if p == nil || p.HTTPSEnforced == nil {
-		return false
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.HTTPSEnforced
+	return *p.UpdatedAt
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (p *PagesUpdate) GetPublic() bool {
-	if p == nil || p.Public == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *p.Public
+	return *p.URL
 }
 
-// GetSource returns the Source field.
-func (p *PagesUpdate) GetSource() *PagesSource {
+// GetNote returns the Note field.
+func (p *ProjectCardChange) GetNote() *ProjectCardNote {
 	if p == nil {
 		return nil
 	}
-	return p.Source
+	return p.Note
 }
 
-// GetHook returns the Hook field.
-func (p *PingEvent) GetHook() *Hook {
-	if p == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectCardEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
 	}
-	return p.Hook
+	return *p.Action
 }
 
-// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
-func (p *PingEvent) GetHookID() int64 {
-	if p == nil || p.HookID == nil {
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (p *ProjectCardEvent) GetAfterID() int64 {
+	if p == nil || p.AfterID == nil {
 		return 0
 	}
-	return *p.HookID
+	return *p.AfterID
+}
+
+// GetChanges returns the Changes field.
+func (p *ProjectCardEvent) GetChanges() *ProjectCardChange {
+	if p == nil {
+		return nil
+	}
+	return p.Changes
 }
 
 // GetInstallation returns the Installation field.
-func (p *PingEvent) GetInstallation() *Installation {
+func (p *ProjectCardEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
@@ -12167,15 +16383,23 @@ func (p *PingEvent) GetInstallation() *Installation {
 }
 
 // GetOrg returns the Org field.
-func (p *PingEvent) GetOrg() *Organization {
+func (p *ProjectCardEvent) GetOrg() *Organization {
 	if p == nil {
 		return nil
 	}
 	return p.Org
 }
 
+// GetProjectCard returns the ProjectCard field.
+func (p *ProjectCardEvent) GetProjectCard() *ProjectCard {
+	if p == nil {
+		return nil
+	}
+	return p.ProjectCard
+}
+
 // GetRepo returns the Repo field.
-func (p *PingEvent) GetRepo() *Repository {
+func (p *ProjectCardEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
@@ -12183,87 +16407,79 @@ func (p *PingEvent) GetRepo() *Repository {
 }
 
 // GetSender returns the Sender field.
-func (p *PingEvent) GetSender() *User {
+func (p *ProjectCardEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
-// GetZen returns the Zen field if it's non-nil, zero value otherwise.
-func (p *PingEvent) GetZen() string {
-	if p == nil || p.Zen == nil {
+// GetArchivedState returns the ArchivedState field if it's non-nil, zero value otherwise.
+func (p *ProjectCardListOptions) GetArchivedState() string {
+	if p == nil || p.ArchivedState == nil {
 		return ""
 	}
-	return *p.Zen
-}
-
-// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
-func (p *Plan) GetCollaborators() int {
-	if p == nil || p.Collaborators == nil {
-		return 0
-	}
-	return *p.Collaborators
+	return *p.ArchivedState
 }
 
-// GetFilledSeats returns the FilledSeats field if it's non-nil, zero value otherwise.
-func (p *Plan) GetFilledSeats() int {
-	if p == nil || p.FilledSeats == nil {
-		return 0
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (p *ProjectCardNote) GetFrom() string {
+	if p == nil || p.From == nil {
+		return ""
 	}
-	return *p.FilledSeats
+	return *p.From
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Plan) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *ProjectCardOptions) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
 	}
-	return *p.Name
+	return *p.Archived
 }
 
-// GetPrivateRepos returns the PrivateRepos field if it's non-nil, zero value otherwise.
-func (p *Plan) GetPrivateRepos() int64 {
-	if p == nil || p.PrivateRepos == nil {
-		return 0
+// GetBody returns the Body field.
+func (p *ProjectChange) GetBody() *ProjectBody {
+	if p == nil {
+		return nil
 	}
-	return *p.PrivateRepos
+	return p.Body
 }
 
-// GetSeats returns the Seats field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSeats() int {
-	if p == nil || p.Seats == nil {
-		return 0
+// GetName returns the Name field.
+func (p *ProjectChange) GetName() *ProjectName {
+	if p == nil {
+		return nil
 	}
-	return *p.Seats
+	return p.Name
 }
 
-// GetSpace returns the Space field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSpace() int {
-	if p == nil || p.Space == nil {
-		return 0
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (p *ProjectCollaboratorOptions) GetPermission() string {
+	if p == nil || p.Permission == nil {
+		return ""
 	}
-	return *p.Space
+	return *p.Permission
 }
 
-// GetConfigURL returns the ConfigURL field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetConfigURL() string {
-	if p == nil || p.ConfigURL == nil {
+// GetCardsURL returns the CardsURL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetCardsURL() string {
+	if p == nil || p.CardsURL == nil {
 		return ""
 	}
-	return *p.ConfigURL
+	return *p.CardsURL
 }
 
-// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetEnforcement() string {
-	if p == nil || p.Enforcement == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.Enforcement
+	return *p.CreatedAt
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetID() int64 {
+func (p *ProjectColumn) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
@@ -12271,175 +16487,215 @@ func (p *PreReceiveHook) GetID() int64 {
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetName() string {
+func (p *ProjectColumn) GetName() string {
 	if p == nil || p.Name == nil {
 		return ""
 	}
 	return *p.Name
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (p *PRLink) GetHRef() string {
-	if p == nil || p.HRef == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.HRef
+	return *p.NodeID
 }
 
-// GetComments returns the Comments field.
-func (p *PRLinks) GetComments() *PRLink {
-	if p == nil {
-		return nil
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
+		return ""
 	}
-	return p.Comments
+	return *p.ProjectURL
 }
 
-// GetCommits returns the Commits field.
-func (p *PRLinks) GetCommits() *PRLink {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetName returns the Name field.
+func (p *ProjectColumnChange) GetName() *ProjectColumnName {
 	if p == nil {
 		return nil
 	}
-	return p.Commits
+	return p.Name
 }
 
-// GetHTML returns the HTML field.
-func (p *PRLinks) GetHTML() *PRLink {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnEvent) GetAfterID() int64 {
+	if p == nil || p.AfterID == nil {
+		return 0
+	}
+	return *p.AfterID
+}
+
+// GetChanges returns the Changes field.
+func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
 	if p == nil {
 		return nil
 	}
-	return p.HTML
+	return p.Changes
 }
 
-// GetIssue returns the Issue field.
-func (p *PRLinks) GetIssue() *PRLink {
+// GetInstallation returns the Installation field.
+func (p *ProjectColumnEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
-	return p.Issue
+	return p.Installation
 }
 
-// GetReviewComment returns the ReviewComment field.
-func (p *PRLinks) GetReviewComment() *PRLink {
+// GetOrg returns the Org field.
+func (p *ProjectColumnEvent) GetOrg() *Organization {
 	if p == nil {
 		return nil
 	}
-	return p.ReviewComment
+	return p.Org
 }
 
-// GetReviewComments returns the ReviewComments field.
-func (p *PRLinks) GetReviewComments() *PRLink {
+// GetProjectColumn returns the ProjectColumn field.
+func (p *ProjectColumnEvent) GetProjectColumn() *ProjectColumn {
 	if p == nil {
 		return nil
 	}
-	return p.ReviewComments
+	return p.ProjectColumn
 }
 
-// GetSelf returns the Self field.
-func (p *PRLinks) GetSelf() *PRLink {
+// GetRepo returns the Repo field.
+func (p *ProjectColumnEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.Self
+	return p.Repo
 }
 
-// GetStatuses returns the Statuses field.
-func (p *PRLinks) GetStatuses() *PRLink {
+// GetSender returns the Sender field.
+func (p *ProjectColumnEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Statuses
+	return p.Sender
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *Project) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnName) GetFrom() string {
+	if p == nil || p.From == nil {
 		return ""
 	}
-	return *p.Body
+	return *p.From
 }
 
-// GetColumnsURL returns the ColumnsURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetColumnsURL() string {
-	if p == nil || p.ColumnsURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *p.ColumnsURL
+	return *p.Action
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Project) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetChanges returns the Changes field.
+func (p *ProjectEvent) GetChanges() *ProjectChange {
+	if p == nil {
+		return nil
 	}
-	return *p.CreatedAt
+	return p.Changes
 }
 
-// GetCreator returns the Creator field.
-func (p *Project) GetCreator() *User {
+// GetInstallation returns the Installation field.
+func (p *ProjectEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
-	return p.Creator
+	return p.Installation
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetOrg returns the Org field.
+func (p *ProjectEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *p.HTMLURL
+	return p.Org
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *Project) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetProject returns the Project field.
+func (p *ProjectEvent) GetProject() *Project {
+	if p == nil {
+		return nil
 	}
-	return *p.ID
+	return p.Project
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Project) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (p *ProjectEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *p.Name
+	return p.Repo
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *Project) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetSender returns the Sender field.
+func (p *ProjectEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (p *ProjectName) GetFrom() string {
+	if p == nil || p.From == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.From
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *Project) GetNumber() int {
-	if p == nil || p.Number == nil {
-		return 0
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return *p.Number
+	return *p.Body
 }
 
-// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
-func (p *Project) GetOrganizationPermission() string {
-	if p == nil || p.OrganizationPermission == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *p.OrganizationPermission
+	return *p.Name
 }
 
-// GetOwnerURL returns the OwnerURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetOwnerURL() string {
-	if p == nil || p.OwnerURL == nil {
+// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetOrganizationPermission() string {
+	if p == nil || p.OrganizationPermission == nil {
 		return ""
 	}
-	return *p.OwnerURL
+	return *p.OrganizationPermission
 }
 
 // GetPrivate returns the Private field if it's non-nil, zero value otherwise.
-func (p *Project) GetPrivate() bool {
+func (p *ProjectOptions) GetPrivate() bool {
 	if p == nil || p.Private == nil {
 		return false
 	}
@@ -12447,79 +16703,39 @@ func (p *Project) GetPrivate() bool {
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *Project) GetState() string {
+func (p *ProjectOptions) GetState() string {
 	if p == nil || p.State == nil {
 		return ""
 	}
 	return *p.State
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Project) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
-	}
-	return *p.UpdatedAt
-}
-
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Project) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
-	}
-	return *p.URL
-}
-
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (p *ProjectBody) GetFrom() string {
-	if p == nil || p.From == nil {
-		return ""
-	}
-	return *p.From
-}
-
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetArchived() bool {
-	if p == nil || p.Archived == nil {
-		return false
-	}
-	return *p.Archived
-}
-
-// GetColumnID returns the ColumnID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnID() int64 {
-	if p == nil || p.ColumnID == nil {
-		return 0
-	}
-	return *p.ColumnID
-}
-
-// GetColumnName returns the ColumnName field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnName() string {
-	if p == nil || p.ColumnName == nil {
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (p *ProjectPermissionLevel) GetPermission() string {
+	if p == nil || p.Permission == nil {
 		return ""
 	}
-	return *p.ColumnName
+	return *p.Permission
 }
 
-// GetColumnURL returns the ColumnURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnURL() string {
-	if p == nil || p.ColumnURL == nil {
-		return ""
+// GetUser returns the User field.
+func (p *ProjectPermissionLevel) GetUser() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.ColumnURL
+	return p.User
 }
 
-// GetContentURL returns the ContentURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetContentURL() string {
-	if p == nil || p.ContentURL == nil {
-		return ""
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetClosedAt() Timestamp {
+	if p == nil || p.ClosedAt == nil {
+		return Timestamp{}
 	}
-	return *p.ContentURL
+	return *p.ClosedAt
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetCreatedAt() Timestamp {
+func (p *ProjectV2) GetCreatedAt() Timestamp {
 	if p == nil || p.CreatedAt == nil {
 		return Timestamp{}
 	}
@@ -12527,15 +16743,39 @@ func (p *ProjectCard) GetCreatedAt() Timestamp {
 }
 
 // GetCreator returns the Creator field.
-func (p *ProjectCard) GetCreator() *User {
+func (p *ProjectV2) GetCreator() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Creator
 }
 
+// GetDeletedAt returns the DeletedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetDeletedAt() Timestamp {
+	if p == nil || p.DeletedAt == nil {
+		return Timestamp{}
+	}
+	return *p.DeletedAt
+}
+
+// GetDeletedBy returns the DeletedBy field.
+func (p *ProjectV2) GetDeletedBy() *User {
+	if p == nil {
+		return nil
+	}
+	return p.DeletedBy
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetID() int64 {
+func (p *ProjectV2) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
@@ -12543,95 +16783,79 @@ func (p *ProjectCard) GetID() int64 {
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetNodeID() string {
+func (p *ProjectV2) GetNodeID() string {
 	if p == nil || p.NodeID == nil {
 		return ""
 	}
 	return *p.NodeID
 }
 
-// GetNote returns the Note field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetNote() string {
-	if p == nil || p.Note == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
 	}
-	return *p.Note
+	return *p.Number
 }
 
-// GetPreviousColumnName returns the PreviousColumnName field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetPreviousColumnName() string {
-	if p == nil || p.PreviousColumnName == nil {
-		return ""
+// GetOwner returns the Owner field.
+func (p *ProjectV2) GetOwner() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.PreviousColumnName
+	return p.Owner
 }
 
-// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetProjectID() int64 {
-	if p == nil || p.ProjectID == nil {
-		return 0
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
 	}
-	return *p.ProjectID
+	return *p.Public
 }
 
-// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetProjectURL() string {
-	if p == nil || p.ProjectURL == nil {
+// GetShortDescription returns the ShortDescription field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetShortDescription() string {
+	if p == nil || p.ShortDescription == nil {
 		return ""
 	}
-	return *p.ProjectURL
+	return *p.ShortDescription
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *p.State
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetTitle() string {
+	if p == nil || p.Title == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.Title
 }
 
-// GetNote returns the Note field.
-func (p *ProjectCardChange) GetNote() *ProjectCardNote {
-	if p == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Note
+	return *p.UpdatedAt
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectCardEvent) GetAction() string {
+func (p *ProjectV2Event) GetAction() string {
 	if p == nil || p.Action == nil {
 		return ""
 	}
 	return *p.Action
 }
 
-// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
-func (p *ProjectCardEvent) GetAfterID() int64 {
-	if p == nil || p.AfterID == nil {
-		return 0
-	}
-	return *p.AfterID
-}
-
-// GetChanges returns the Changes field.
-func (p *ProjectCardEvent) GetChanges() *ProjectCardChange {
-	if p == nil {
-		return nil
-	}
-	return p.Changes
-}
-
 // GetInstallation returns the Installation field.
-func (p *ProjectCardEvent) GetInstallation() *Installation {
+func (p *ProjectV2Event) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
@@ -12639,231 +16863,271 @@ func (p *ProjectCardEvent) GetInstallation() *Installation {
 }
 
 // GetOrg returns the Org field.
-func (p *ProjectCardEvent) GetOrg() *Organization {
+func (p *ProjectV2Event) GetOrg() *Organization {
 	if p == nil {
 		return nil
 	}
 	return p.Org
 }
 
-// GetProjectCard returns the ProjectCard field.
-func (p *ProjectCardEvent) GetProjectCard() *ProjectCard {
-	if p == nil {
-		return nil
-	}
-	return p.ProjectCard
-}
-
-// GetRepo returns the Repo field.
-func (p *ProjectCardEvent) GetRepo() *Repository {
+// GetProjectsV2 returns the ProjectsV2 field.
+func (p *ProjectV2Event) GetProjectsV2() *ProjectV2 {
 	if p == nil {
 		return nil
 	}
-	return p.Repo
+	return p.ProjectsV2
 }
 
 // GetSender returns the Sender field.
-func (p *ProjectCardEvent) GetSender() *User {
+func (p *ProjectV2Event) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
-// GetArchivedState returns the ArchivedState field if it's non-nil, zero value otherwise.
-func (p *ProjectCardListOptions) GetArchivedState() string {
-	if p == nil || p.ArchivedState == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.ArchivedState
+	return *p.CreatedAt
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (p *ProjectCardNote) GetFrom() string {
-	if p == nil || p.From == nil {
+// GetDataType returns the DataType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetDataType() string {
+	if p == nil || p.DataType == nil {
 		return ""
 	}
-	return *p.From
+	return *p.DataType
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *ProjectCardOptions) GetArchived() bool {
-	if p == nil || p.Archived == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.Archived
+	return *p.ID
 }
 
-// GetBody returns the Body field.
-func (p *ProjectChange) GetBody() *ProjectBody {
-	if p == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return p.Body
+	return *p.Name
 }
 
-// GetName returns the Name field.
-func (p *ProjectChange) GetName() *ProjectName {
-	if p == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return p.Name
+	return *p.NodeID
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (p *ProjectCollaboratorOptions) GetPermission() string {
-	if p == nil || p.Permission == nil {
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
 		return ""
 	}
-	return *p.Permission
+	return *p.ProjectURL
 }
 
-// GetCardsURL returns the CardsURL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetCardsURL() string {
-	if p == nil || p.CardsURL == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldOption) GetColor() string {
+	if p == nil || p.Color == nil {
 		return ""
 	}
-	return *p.CardsURL
+	return *p.Color
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldOption) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.Description
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetID() int64 {
+func (p *ProjectV2FieldOption) GetID() string {
 	if p == nil || p.ID == nil {
-		return 0
+		return ""
 	}
 	return *p.ID
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetName() string {
+func (p *ProjectV2FieldOption) GetName() string {
 	if p == nil || p.Name == nil {
 		return ""
 	}
 	return *p.Name
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetDataType returns the DataType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetDataType() string {
+	if p == nil || p.DataType == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.DataType
 }
 
-// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetProjectURL() string {
-	if p == nil || p.ProjectURL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *p.ProjectURL
+	return *p.Name
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
+// GetArchivedAt returns the ArchivedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetArchivedAt() Timestamp {
+	if p == nil || p.ArchivedAt == nil {
 		return Timestamp{}
 	}
-	return *p.UpdatedAt
+	return *p.ArchivedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetContent returns the Content field.
+func (p *ProjectV2Item) GetContent() *Issue {
+	if p == nil {
+		return nil
+	}
+	return p.Content
+}
+
+// GetContentNodeID returns the ContentNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetContentNodeID() string {
+	if p == nil || p.ContentNodeID == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.ContentNodeID
 }
 
-// GetName returns the Name field.
-func (p *ProjectColumnChange) GetName() *ProjectColumnName {
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetContentType() string {
+	if p == nil || p.ContentType == nil {
+		return ""
+	}
+	return *p.ContentType
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (p *ProjectV2Item) GetCreator() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Name
+	return p.Creator
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetItemURL returns the ItemURL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetItemURL() string {
+	if p == nil || p.ItemURL == nil {
+		return ""
+	}
+	return *p.ItemURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.NodeID
 }
 
-// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnEvent) GetAfterID() int64 {
-	if p == nil || p.AfterID == nil {
-		return 0
+// GetProjectNodeID returns the ProjectNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetProjectNodeID() string {
+	if p == nil || p.ProjectNodeID == nil {
+		return ""
 	}
-	return *p.AfterID
+	return *p.ProjectNodeID
 }
 
-// GetChanges returns the Changes field.
-func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
-	if p == nil {
-		return nil
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
+		return ""
 	}
-	return p.Changes
+	return *p.ProjectURL
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectColumnEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Installation
+	return *p.UpdatedAt
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectColumnEvent) GetOrg() *Organization {
-	if p == nil {
-		return nil
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemArchivedAtChange) GetFrom() Timestamp {
+	if p == nil || p.From == nil {
+		return Timestamp{}
 	}
-	return p.Org
+	return *p.From
 }
 
-// GetProjectColumn returns the ProjectColumn field.
-func (p *ProjectColumnEvent) GetProjectColumn() *ProjectColumn {
-	if p == nil {
-		return nil
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemArchivedAtChange) GetTo() Timestamp {
+	if p == nil || p.To == nil {
+		return Timestamp{}
 	}
-	return p.ProjectColumn
+	return *p.To
 }
 
-// GetRepo returns the Repo field.
-func (p *ProjectColumnEvent) GetRepo() *Repository {
+// GetArchivedAt returns the ArchivedAt field.
+func (p *ProjectV2ItemChange) GetArchivedAt() *ProjectV2ItemArchivedAtChange {
 	if p == nil {
 		return nil
 	}
-	return p.Repo
+	return p.ArchivedAt
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectColumnEvent) GetSender() *User {
+// GetFieldValue returns the FieldValue field.
+func (p *ProjectV2ItemChange) GetFieldValue() *ProjectV2ItemFieldValueChange {
 	if p == nil {
 		return nil
 	}
-	return p.Sender
-}
-
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnName) GetFrom() string {
-	if p == nil || p.From == nil {
-		return ""
-	}
-	return *p.From
+	return p.FieldValue
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectEvent) GetAction() string {
+func (p *ProjectV2ItemEvent) GetAction() string {
 	if p == nil || p.Action == nil {
 		return ""
 	}
@@ -12871,7 +17135,7 @@ func (p *ProjectEvent) GetAction() string {
 }
 
 // GetChanges returns the Changes field.
-func (p *ProjectEvent) GetChanges() *ProjectChange {
+func (p *ProjectV2ItemEvent) GetChanges() *ProjectV2ItemChange {
 	if p == nil {
 		return nil
 	}
@@ -12879,7 +17143,7 @@ func (p *ProjectEvent) GetChanges() *ProjectChange {
 }
 
 // GetInstallation returns the Installation field.
-func (p *ProjectEvent) GetInstallation() *Installation {
+func (p *ProjectV2ItemEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
@@ -12887,99 +17151,43 @@ func (p *ProjectEvent) GetInstallation() *Installation {
 }
 
 // GetOrg returns the Org field.
-func (p *ProjectEvent) GetOrg() *Organization {
+func (p *ProjectV2ItemEvent) GetOrg() *Organization {
 	if p == nil {
 		return nil
 	}
 	return p.Org
 }
 
-// GetProject returns the Project field.
-func (p *ProjectEvent) GetProject() *Project {
-	if p == nil {
-		return nil
-	}
-	return p.Project
-}
-
-// GetRepo returns the Repo field.
-func (p *ProjectEvent) GetRepo() *Repository {
+// GetProjectV2Item returns the ProjectV2Item field.
+func (p *ProjectV2ItemEvent) GetProjectV2Item() *ProjectV2Item {
 	if p == nil {
 		return nil
 	}
-	return p.Repo
+	return p.ProjectV2Item
 }
 
 // GetSender returns the Sender field.
-func (p *ProjectEvent) GetSender() *User {
+func (p *ProjectV2ItemEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (p *ProjectName) GetFrom() string {
-	if p == nil || p.From == nil {
-		return ""
-	}
-	return *p.From
-}
-
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
-	}
-	return *p.Body
-}
-
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
-	}
-	return *p.Name
-}
-
-// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetOrganizationPermission() string {
-	if p == nil || p.OrganizationPermission == nil {
-		return ""
-	}
-	return *p.OrganizationPermission
-}
-
-// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetPrivate() bool {
-	if p == nil || p.Private == nil {
-		return false
-	}
-	return *p.Private
-}
-
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetState() string {
-	if p == nil || p.State == nil {
+// GetFieldNodeID returns the FieldNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValueChange) GetFieldNodeID() string {
+	if p == nil || p.FieldNodeID == nil {
 		return ""
 	}
-	return *p.State
+	return *p.FieldNodeID
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (p *ProjectPermissionLevel) GetPermission() string {
-	if p == nil || p.Permission == nil {
+// GetFieldType returns the FieldType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValueChange) GetFieldType() string {
+	if p == nil || p.FieldType == nil {
 		return ""
 	}
-	return *p.Permission
-}
-
-// GetUser returns the User field.
-func (p *ProjectPermissionLevel) GetUser() *User {
-	if p == nil {
-		return nil
-	}
-	return p.User
+	return *p.FieldType
 }
 
 // GetAllowDeletions returns the AllowDeletions field.
@@ -13310,6 +17518,14 @@ func (p *PublicEvent) GetInstallation() *Installation {
 	return p.Installation
 }
 
+// GetOrg returns the Org field.
+func (p *PublicEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
+	}
+	return p.Org
+}
+
 // GetRepo returns the Repo field.
 func (p *PublicEvent) GetRepo() *Repository {
 	if p == nil {
@@ -13342,6 +17558,22 @@ func (p *PublicKey) GetKeyID() string {
 	return *p.KeyID
 }
 
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PublishCodespaceOptions) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (p *PublishCodespaceOptions) GetPrivate() bool {
+	if p == nil || p.Private == nil {
+		return false
+	}
+	return *p.Private
+}
+
 // GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
 func (p *PullRequest) GetActiveLockReason() string {
 	if p == nil || p.ActiveLockReason == nil {
@@ -14270,6 +18502,14 @@ func (p *PullRequestReviewCommentEvent) GetInstallation() *Installation {
 	return p.Installation
 }
 
+// GetOrg returns the Org field.
+func (p *PullRequestReviewCommentEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
+	}
+	return p.Org
+}
+
 // GetPullRequest returns the PullRequest field.
 func (p *PullRequestReviewCommentEvent) GetPullRequest() *PullRequest {
 	if p == nil {
@@ -14478,6 +18718,54 @@ func (p *PullRequestReviewsEnforcementUpdate) GetRequireLastPushApproval() bool
 	return *p.RequireLastPushApproval
 }
 
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetIsOutdated returns the IsOutdated field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetIsOutdated() bool {
+	if p == nil || p.IsOutdated == nil {
+		return false
+	}
+	return *p.IsOutdated
+}
+
+// GetIsResolved returns the IsResolved field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetIsResolved() bool {
+	if p == nil || p.IsResolved == nil {
+		return false
+	}
+	return *p.IsResolved
+}
+
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetLine() int {
+	if p == nil || p.Line == nil {
+		return 0
+	}
+	return *p.Line
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetPath() string {
+	if p == nil || p.Path == nil {
+		return ""
+	}
+	return *p.Path
+}
+
+// GetResolvedBy returns the ResolvedBy field.
+func (p *PullRequestReviewThread) GetResolvedBy() *User {
+	if p == nil {
+		return nil
+	}
+	return p.ResolvedBy
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (p *PullRequestReviewThreadEvent) GetAction() string {
 	if p == nil || p.Action == nil {
@@ -14494,6 +18782,14 @@ func (p *PullRequestReviewThreadEvent) GetInstallation() *Installation {
 	return p.Installation
 }
 
+// GetOrg returns the Org field.
+func (p *PullRequestReviewThreadEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
+	}
+	return p.Org
+}
+
 // GetPullRequest returns the PullRequest field.
 func (p *PullRequestReviewThreadEvent) GetPullRequest() *PullRequest {
 	if p == nil {
@@ -15374,6 +19670,22 @@ func (r *Reference) GetURL() string {
 	return *r.URL
 }
 
+// GetCheckRun returns the CheckRun field.
+func (r *RefStatusContext) GetCheckRun() *CheckRun {
+	if r == nil {
+		return nil
+	}
+	return r.CheckRun
+}
+
+// GetStatus returns the Status field.
+func (r *RefStatusContext) GetStatus() *RepoStatus {
+	if r == nil {
+		return nil
+	}
+	return r.Status
+}
+
 // GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
 func (r *RegistrationToken) GetExpiresAt() Timestamp {
 	if r == nil || r.ExpiresAt == nil {
@@ -15387,7 +19699,63 @@ func (r *RegistrationToken) GetToken() string {
 	if r == nil || r.Token == nil {
 		return ""
 	}
-	return *r.Token
+	return *r.Token
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RegistryPackageEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
+	}
+	return *r.Action
+}
+
+// GetEnterprise returns the Enterprise field.
+func (r *RegistryPackageEvent) GetEnterprise() *Enterprise {
+	if r == nil {
+		return nil
+	}
+	return r.Enterprise
+}
+
+// GetInstallation returns the Installation field.
+func (r *RegistryPackageEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
+}
+
+// GetOrg returns the Org field.
+func (r *RegistryPackageEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Org
+}
+
+// GetRegistryPackage returns the RegistryPackage field.
+func (r *RegistryPackageEvent) GetRegistryPackage() *Package {
+	if r == nil {
+		return nil
+	}
+	return r.RegistryPackage
+}
+
+// GetRepo returns the Repo field.
+func (r *RegistryPackageEvent) GetRepo() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Repo
+}
+
+// GetSender returns the Sender field.
+func (r *RegistryPackageEvent) GetSender() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Sender
 }
 
 // GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
@@ -15510,6 +19878,14 @@ func (r *ReleaseEvent) GetInstallation() *Installation {
 	return r.Installation
 }
 
+// GetOrg returns the Org field.
+func (r *ReleaseEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Org
+}
+
 // GetRelease returns the Release field.
 func (r *ReleaseEvent) GetRelease() *RepositoryRelease {
 	if r == nil {
@@ -15582,6 +19958,94 @@ func (r *RenameOrgResponse) GetURL() string {
 	return *r.URL
 }
 
+// GetCopyrightText returns the CopyrightText field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetCopyrightText() string {
+	if r == nil || r.CopyrightText == nil {
+		return ""
+	}
+	return *r.CopyrightText
+}
+
+// GetDownloadLocation returns the DownloadLocation field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetDownloadLocation() string {
+	if r == nil || r.DownloadLocation == nil {
+		return ""
+	}
+	return *r.DownloadLocation
+}
+
+// GetFilesAnalyzed returns the FilesAnalyzed field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetFilesAnalyzed() bool {
+	if r == nil || r.FilesAnalyzed == nil {
+		return false
+	}
+	return *r.FilesAnalyzed
+}
+
+// GetLicenseConcluded returns the LicenseConcluded field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetLicenseConcluded() string {
+	if r == nil || r.LicenseConcluded == nil {
+		return ""
+	}
+	return *r.LicenseConcluded
+}
+
+// GetLicenseDeclared returns the LicenseDeclared field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetLicenseDeclared() string {
+	if r == nil || r.LicenseDeclared == nil {
+		return ""
+	}
+	return *r.LicenseDeclared
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetSPDXID() string {
+	if r == nil || r.SPDXID == nil {
+		return ""
+	}
+	return *r.SPDXID
+}
+
+// GetSupplier returns the Supplier field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetSupplier() string {
+	if r == nil || r.Supplier == nil {
+		return ""
+	}
+	return *r.Supplier
+}
+
+// GetVersionInfo returns the VersionInfo field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetVersionInfo() string {
+	if r == nil || r.VersionInfo == nil {
+		return ""
+	}
+	return *r.VersionInfo
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepoFineGrainedPermission) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
+	}
+	return *r.Description
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepoFineGrainedPermission) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
 // GetBranch returns the Branch field if it's non-nil, zero value otherwise.
 func (r *RepoMergeUpstreamRequest) GetBranch() string {
 	if r == nil || r.Branch == nil {
@@ -16982,6 +21446,14 @@ func (r *RepositoryEvent) GetSender() *User {
 	return r.Sender
 }
 
+// GetInstallation returns the Installation field.
+func (r *RepositoryImportEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
+}
+
 // GetOrg returns the Org field.
 func (r *RepositoryImportEvent) GetOrg() *Organization {
 	if r == nil {
@@ -17022,6 +21494,14 @@ func (r *RepositoryInvitation) GetCreatedAt() Timestamp {
 	return *r.CreatedAt
 }
 
+// GetExpired returns the Expired field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetExpired() bool {
+	if r == nil || r.Expired == nil {
+		return false
+	}
+	return *r.Expired
+}
+
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
 func (r *RepositoryInvitation) GetHTMLURL() string {
 	if r == nil || r.HTMLURL == nil {
@@ -17198,6 +21678,30 @@ func (r *RepositoryMergeRequest) GetHead() string {
 	return *r.Head
 }
 
+// GetNewDescription returns the NewDescription field if it's non-nil, zero value otherwise.
+func (r *RepositoryMetadataChange) GetNewDescription() string {
+	if r == nil || r.NewDescription == nil {
+		return ""
+	}
+	return *r.NewDescription
+}
+
+// GetOldDescription returns the OldDescription field if it's non-nil, zero value otherwise.
+func (r *RepositoryMetadataChange) GetOldDescription() string {
+	if r == nil || r.OldDescription == nil {
+		return ""
+	}
+	return *r.OldDescription
+}
+
+// GetRepository returns the Repository field.
+func (r *RepositoryMetadataChange) GetRepository() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Repository
+}
+
 // GetPermission returns the Permission field if it's non-nil, zero value otherwise.
 func (r *RepositoryPermissionLevel) GetPermission() string {
 	if r == nil || r.Permission == nil {
@@ -17206,6 +21710,14 @@ func (r *RepositoryPermissionLevel) GetPermission() string {
 	return *r.Permission
 }
 
+// GetRoleName returns the RoleName field if it's non-nil, zero value otherwise.
+func (r *RepositoryPermissionLevel) GetRoleName() string {
+	if r == nil || r.RoleName == nil {
+		return ""
+	}
+	return *r.RoleName
+}
+
 // GetUser returns the User field.
 func (r *RepositoryPermissionLevel) GetUser() *User {
 	if r == nil {
@@ -17326,6 +21838,14 @@ func (r *RepositoryRelease) GetPublishedAt() Timestamp {
 	return *r.PublishedAt
 }
 
+// GetReactions returns the Reactions field.
+func (r *RepositoryRelease) GetReactions() *Reactions {
+	if r == nil {
+		return nil
+	}
+	return r.Reactions
+}
+
 // GetTagName returns the TagName field if it's non-nil, zero value otherwise.
 func (r *RepositoryRelease) GetTagName() string {
 	if r == nil || r.TagName == nil {
@@ -17363,15 +21883,215 @@ func (r *RepositoryRelease) GetURL() string {
 	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.URL
+}
+
+// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetZipballURL() string {
+	if r == nil || r.ZipballURL == nil {
+		return ""
+	}
+	return *r.ZipballURL
+}
+
+// GetParameters returns the Parameters field if it's non-nil, zero value otherwise.
+func (r *RepositoryRule) GetParameters() json.RawMessage {
+	if r == nil || r.Parameters == nil {
+		return json.RawMessage{}
+	}
+	return *r.Parameters
+}
+
+// GetConditions returns the Conditions field.
+func (r *RepositoryRuleset) GetConditions() *RulesetConditions {
+	if r == nil {
+		return nil
+	}
+	return r.Conditions
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.CreatedAt
+}
+
+// GetCurrentUserCanBypass returns the CurrentUserCanBypass field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetCurrentUserCanBypass() string {
+	if r == nil || r.CurrentUserCanBypass == nil {
+		return ""
+	}
+	return *r.CurrentUserCanBypass
+}
+
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetEnforcement() string {
+	if r == nil || r.Enforcement == nil {
+		return ""
+	}
+	return *r.Enforcement
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
+	}
+	return *r.NodeID
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetSource() string {
+	if r == nil || r.Source == nil {
+		return ""
+	}
+	return *r.Source
+}
+
+// GetSourceType returns the SourceType field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetSourceType() string {
+	if r == nil || r.SourceType == nil {
+		return ""
+	}
+	return *r.SourceType
+}
+
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetTarget() string {
+	if r == nil || r.Target == nil {
+		return ""
+	}
+	return *r.Target
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryRuleset) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.UpdatedAt
+}
+
+// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
+func (r *RepositoryRulesetChanges) GetConditions() json.RawMessage {
+	if r == nil || r.Conditions == nil {
+		return json.RawMessage{}
+	}
+	return *r.Conditions
+}
+
+// GetEnforcement returns the Enforcement field.
+func (r *RepositoryRulesetChanges) GetEnforcement() *RepositoryRulesetChangeSource {
+	if r == nil {
+		return nil
+	}
+	return r.Enforcement
+}
+
+// GetName returns the Name field.
+func (r *RepositoryRulesetChanges) GetName() *RepositoryRulesetChangeSource {
+	if r == nil {
+		return nil
+	}
+	return r.Name
+}
+
+// GetRules returns the Rules field if it's non-nil, zero value otherwise.
+func (r *RepositoryRulesetChanges) GetRules() json.RawMessage {
+	if r == nil || r.Rules == nil {
+		return json.RawMessage{}
+	}
+	return *r.Rules
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (r *RepositoryRulesetChangeSource) GetFrom() string {
+	if r == nil || r.From == nil {
+		return ""
+	}
+	return *r.From
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryRulesetEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
+	}
+	return *r.Action
+}
+
+// GetChanges returns the Changes field.
+func (r *RepositoryRulesetEvent) GetChanges() *RepositoryRulesetChanges {
+	if r == nil {
+		return nil
+	}
+	return r.Changes
+}
+
+// GetEnterprise returns the Enterprise field.
+func (r *RepositoryRulesetEvent) GetEnterprise() *Enterprise {
+	if r == nil {
+		return nil
+	}
+	return r.Enterprise
+}
+
+// GetInstallation returns the Installation field.
+func (r *RepositoryRulesetEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
+}
+
+// GetOrg returns the Org field.
+func (r *RepositoryRulesetEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Org
+}
+
+// GetRepo returns the Repo field.
+func (r *RepositoryRulesetEvent) GetRepo() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Repo
+}
+
+// GetRepositoryRuleset returns the RepositoryRuleset field.
+func (r *RepositoryRulesetEvent) GetRepositoryRuleset() *RepositoryRuleset {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryRuleset
 }
 
-// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetZipballURL() string {
-	if r == nil || r.ZipballURL == nil {
-		return ""
+// GetSender returns the Sender field.
+func (r *RepositoryRulesetEvent) GetSender() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.ZipballURL
+	return r.Sender
 }
 
 // GetCommit returns the Commit field.
@@ -17526,6 +22246,14 @@ func (r *RepositoryVulnerabilityAlertEvent) GetInstallation() *Installation {
 	return r.Installation
 }
 
+// GetOrg returns the Org field.
+func (r *RepositoryVulnerabilityAlertEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Org
+}
+
 // GetRepository returns the Repository field.
 func (r *RepositoryVulnerabilityAlertEvent) GetRepository() *Repository {
 	if r == nil {
@@ -17718,6 +22446,22 @@ func (r *RequiredStatusCheck) GetAppID() int64 {
 	return *r.AppID
 }
 
+// GetContextsURL returns the ContextsURL field if it's non-nil, zero value otherwise.
+func (r *RequiredStatusChecks) GetContextsURL() string {
+	if r == nil || r.ContextsURL == nil {
+		return ""
+	}
+	return *r.ContextsURL
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RequiredStatusChecks) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
+	}
+	return *r.URL
+}
+
 // GetFrom returns the From field if it's non-nil, zero value otherwise.
 func (r *RequiredStatusChecksEnforcementLevelChanges) GetFrom() string {
 	if r == nil || r.From == nil {
@@ -17750,6 +22494,14 @@ func (r *ReviewersRequest) GetNodeID() string {
 	return *r.NodeID
 }
 
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (r *ReviewPersonalAccessTokenRequestOptions) GetReason() string {
+	if r == nil || r.Reason == nil {
+		return ""
+	}
+	return *r.Reason
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (r *Rule) GetDescription() string {
 	if r == nil || r.Description == nil {
@@ -17806,6 +22558,54 @@ func (r *Rule) GetSeverity() string {
 	return *r.Severity
 }
 
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (r *RulesetBypassActor) GetActorID() int64 {
+	if r == nil || r.ActorID == nil {
+		return 0
+	}
+	return *r.ActorID
+}
+
+// GetActorType returns the ActorType field if it's non-nil, zero value otherwise.
+func (r *RulesetBypassActor) GetActorType() string {
+	if r == nil || r.ActorType == nil {
+		return ""
+	}
+	return *r.ActorType
+}
+
+// GetBypassMode returns the BypassMode field if it's non-nil, zero value otherwise.
+func (r *RulesetBypassActor) GetBypassMode() string {
+	if r == nil || r.BypassMode == nil {
+		return ""
+	}
+	return *r.BypassMode
+}
+
+// GetRefName returns the RefName field.
+func (r *RulesetConditions) GetRefName() *RulesetRefConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.RefName
+}
+
+// GetRepositoryName returns the RepositoryName field.
+func (r *RulesetConditions) GetRepositoryName() *RulesetRepositoryNamesConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryName
+}
+
+// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
+func (r *RulesetRepositoryNamesConditionParameters) GetProtected() bool {
+	if r == nil || r.Protected == nil {
+		return false
+	}
+	return *r.Protected
+}
+
 // GetBusy returns the Busy field if it's non-nil, zero value otherwise.
 func (r *Runner) GetBusy() bool {
 	if r == nil || r.Busy == nil {
@@ -18062,6 +22862,126 @@ func (s *SarifID) GetURL() string {
 	return *s.URL
 }
 
+// GetAnalysesURL returns the AnalysesURL field if it's non-nil, zero value otherwise.
+func (s *SARIFUpload) GetAnalysesURL() string {
+	if s == nil || s.AnalysesURL == nil {
+		return ""
+	}
+	return *s.AnalysesURL
+}
+
+// GetProcessingStatus returns the ProcessingStatus field if it's non-nil, zero value otherwise.
+func (s *SARIFUpload) GetProcessingStatus() string {
+	if s == nil || s.ProcessingStatus == nil {
+		return ""
+	}
+	return *s.ProcessingStatus
+}
+
+// GetSBOM returns the SBOM field.
+func (s *SBOM) GetSBOM() *SBOMInfo {
+	if s == nil {
+		return nil
+	}
+	return s.SBOM
+}
+
+// GetReferenceCategory returns the ReferenceCategory field if it's non-nil, zero value otherwise.
+func (s *SBOMExternalRef) GetReferenceCategory() string {
+	if s == nil || s.ReferenceCategory == nil {
+		return ""
+	}
+	return *s.ReferenceCategory
+}
+
+// GetReferenceLocator returns the ReferenceLocator field if it's non-nil, zero value otherwise.
+func (s *SBOMExternalRef) GetReferenceLocator() string {
+	if s == nil || s.ReferenceLocator == nil {
+		return ""
+	}
+	return *s.ReferenceLocator
+}
+
+// GetReferenceType returns the ReferenceType field if it's non-nil, zero value otherwise.
+func (s *SBOMExternalRef) GetReferenceType() string {
+	if s == nil || s.ReferenceType == nil {
+		return ""
+	}
+	return *s.ReferenceType
+}
+
+// GetCreationInfo returns the CreationInfo field.
+func (s *SBOMInfo) GetCreationInfo() *CreationInfo {
+	if s == nil {
+		return nil
+	}
+	return s.CreationInfo
+}
+
+// GetDataLicense returns the DataLicense field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetDataLicense() string {
+	if s == nil || s.DataLicense == nil {
+		return ""
+	}
+	return *s.DataLicense
+}
+
+// GetDocumentNamespace returns the DocumentNamespace field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetDocumentNamespace() string {
+	if s == nil || s.DocumentNamespace == nil {
+		return ""
+	}
+	return *s.DocumentNamespace
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetSPDXID() string {
+	if s == nil || s.SPDXID == nil {
+		return ""
+	}
+	return *s.SPDXID
+}
+
+// GetSPDXVersion returns the SPDXVersion field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetSPDXVersion() string {
+	if s == nil || s.SPDXVersion == nil {
+		return ""
+	}
+	return *s.SPDXVersion
+}
+
+// GetRelatedSPDXElement returns the RelatedSPDXElement field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetRelatedSPDXElement() string {
+	if s == nil || s.RelatedSPDXElement == nil {
+		return ""
+	}
+	return *s.RelatedSPDXElement
+}
+
+// GetRelationshipType returns the RelationshipType field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetRelationshipType() string {
+	if s == nil || s.RelationshipType == nil {
+		return ""
+	}
+	return *s.RelationshipType
+}
+
+// GetSPDXElementID returns the SPDXElementID field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetSPDXElementID() string {
+	if s == nil || s.SPDXElementID == nil {
+		return ""
+	}
+	return *s.SPDXElementID
+}
+
 // GetAnalysisKey returns the AnalysisKey field if it's non-nil, zero value otherwise.
 func (s *ScanningAnalysis) GetAnalysisKey() string {
 	if s == nil || s.AnalysisKey == nil {
@@ -18179,7 +23099,159 @@ func (s *ScanningAnalysis) GetWarning() string {
 	if s == nil || s.Warning == nil {
 		return ""
 	}
-	return *s.Warning
+	return *s.Warning
+}
+
+// GetDisplay returns the Display field if it's non-nil, zero value otherwise.
+func (s *SCIMDisplayReference) GetDisplay() string {
+	if s == nil || s.Display == nil {
+		return ""
+	}
+	return *s.Display
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (s *SCIMDisplayReference) GetRef() string {
+	if s == nil || s.Ref == nil {
+		return ""
+	}
+	return *s.Ref
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroupAttributes) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroupAttributes) GetExternalID() string {
+	if s == nil || s.ExternalID == nil {
+		return ""
+	}
+	return *s.ExternalID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroupAttributes) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// GetMeta returns the Meta field.
+func (s *SCIMEnterpriseGroupAttributes) GetMeta() *SCIMMeta {
+	if s == nil {
+		return nil
+	}
+	return s.Meta
+}
+
+// GetItemsPerPage returns the ItemsPerPage field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroups) GetItemsPerPage() int {
+	if s == nil || s.ItemsPerPage == nil {
+		return 0
+	}
+	return *s.ItemsPerPage
+}
+
+// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroups) GetStartIndex() int {
+	if s == nil || s.StartIndex == nil {
+		return 0
+	}
+	return *s.StartIndex
+}
+
+// GetTotalResults returns the TotalResults field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroups) GetTotalResults() int {
+	if s == nil || s.TotalResults == nil {
+		return 0
+	}
+	return *s.TotalResults
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetActive() bool {
+	if s == nil || s.Active == nil {
+		return false
+	}
+	return *s.Active
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetExternalID() string {
+	if s == nil || s.ExternalID == nil {
+		return ""
+	}
+	return *s.ExternalID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// GetMeta returns the Meta field.
+func (s *SCIMEnterpriseUserAttributes) GetMeta() *SCIMMeta {
+	if s == nil {
+		return nil
+	}
+	return s.Meta
+}
+
+// GetName returns the Name field.
+func (s *SCIMEnterpriseUserAttributes) GetName() *SCIMUserName {
+	if s == nil {
+		return nil
+	}
+	return s.Name
+}
+
+// GetItemsPerPage returns the ItemsPerPage field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUsers) GetItemsPerPage() int {
+	if s == nil || s.ItemsPerPage == nil {
+		return 0
+	}
+	return *s.ItemsPerPage
+}
+
+// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUsers) GetStartIndex() int {
+	if s == nil || s.StartIndex == nil {
+		return 0
+	}
+	return *s.StartIndex
+}
+
+// GetTotalResults returns the TotalResults field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUsers) GetTotalResults() int {
+	if s == nil || s.TotalResults == nil {
+		return 0
+	}
+	return *s.TotalResults
+}
+
+// GetErr returns the Err field.
+func (s *SCIMError) GetErr() *ErrorResponse {
+	if s == nil {
+		return nil
+	}
+	return s.Err
 }
 
 // GetCreated returns the Created field if it's non-nil, zero value otherwise.
@@ -18214,6 +23286,14 @@ func (s *SCIMMeta) GetResourceType() string {
 	return *s.ResourceType
 }
 
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (s *SCIMPatchOperation) GetPath() string {
+	if s == nil || s.Path == nil {
+		return ""
+	}
+	return *s.Path
+}
+
 // GetItemsPerPage returns the ItemsPerPage field if it's non-nil, zero value otherwise.
 func (s *SCIMProvisionedIdentities) GetItemsPerPage() int {
 	if s == nil || s.ItemsPerPage == nil {
@@ -18302,6 +23382,54 @@ func (s *SCIMUserName) GetFormatted() string {
 	return *s.Formatted
 }
 
+// GetDisplay returns the Display field if it's non-nil, zero value otherwise.
+func (s *SCIMUserRole) GetDisplay() string {
+	if s == nil || s.Display == nil {
+		return ""
+	}
+	return *s.Display
+}
+
+// GetPrimary returns the Primary field if it's non-nil, zero value otherwise.
+func (s *SCIMUserRole) GetPrimary() bool {
+	if s == nil || s.Primary == nil {
+		return false
+	}
+	return *s.Primary
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (s *SCIMUserRole) GetType() string {
+	if s == nil || s.Type == nil {
+		return ""
+	}
+	return *s.Type
+}
+
+// GetPermissions returns the Permissions field.
+func (s *ScopedTokenOptions) GetPermissions() *InstallationPermissions {
+	if s == nil {
+		return nil
+	}
+	return s.Permissions
+}
+
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (s *ScopedTokenOptions) GetTarget() string {
+	if s == nil || s.Target == nil {
+		return ""
+	}
+	return *s.Target
+}
+
+// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
+func (s *ScopedTokenOptions) GetTargetID() int64 {
+	if s == nil || s.TargetID == nil {
+		return 0
+	}
+	return *s.TargetID
+}
+
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
 func (s *SecretScanning) GetStatus() string {
 	if s == nil || s.Status == nil {
@@ -18339,67 +23467,323 @@ func (s *SecretScanningAlert) GetNumber() int {
 	if s == nil || s.Number == nil {
 		return 0
 	}
-	return *s.Number
+	return *s.Number
+}
+
+// GetRepository returns the Repository field.
+func (s *SecretScanningAlert) GetRepository() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repository
+}
+
+// GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolution() string {
+	if s == nil || s.Resolution == nil {
+		return ""
+	}
+	return *s.Resolution
+}
+
+// GetResolvedAt returns the ResolvedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolvedAt() Timestamp {
+	if s == nil || s.ResolvedAt == nil {
+		return Timestamp{}
+	}
+	return *s.ResolvedAt
+}
+
+// GetResolvedBy returns the ResolvedBy field.
+func (s *SecretScanningAlert) GetResolvedBy() *User {
+	if s == nil {
+		return nil
+	}
+	return s.ResolvedBy
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecret() string {
+	if s == nil || s.Secret == nil {
+		return ""
+	}
+	return *s.Secret
+}
+
+// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecretType() string {
+	if s == nil || s.SecretType == nil {
+		return ""
+	}
+	return *s.SecretType
+}
+
+// GetSecretTypeDisplayName returns the SecretTypeDisplayName field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecretTypeDisplayName() string {
+	if s == nil || s.SecretTypeDisplayName == nil {
+		return ""
+	}
+	return *s.SecretTypeDisplayName
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetState() string {
+	if s == nil || s.State == nil {
+		return ""
+	}
+	return *s.State
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
+	}
+	return *s.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
+	}
+	return *s.Action
+}
+
+// GetAlert returns the Alert field.
+func (s *SecretScanningAlertEvent) GetAlert() *SecretScanningAlert {
+	if s == nil {
+		return nil
+	}
+	return s.Alert
+}
+
+// GetEnterprise returns the Enterprise field.
+func (s *SecretScanningAlertEvent) GetEnterprise() *Enterprise {
+	if s == nil {
+		return nil
+	}
+	return s.Enterprise
+}
+
+// GetInstallation returns the Installation field.
+func (s *SecretScanningAlertEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
+
+// GetOrganization returns the Organization field.
+func (s *SecretScanningAlertEvent) GetOrganization() *Organization {
+	if s == nil {
+		return nil
+	}
+	return s.Organization
+}
+
+// GetRepo returns the Repo field.
+func (s *SecretScanningAlertEvent) GetRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repo
+}
+
+// GetSender returns the Sender field.
+func (s *SecretScanningAlertEvent) GetSender() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetDetails returns the Details field.
+func (s *SecretScanningAlertLocation) GetDetails() *SecretScanningAlertLocationDetails {
+	if s == nil {
+		return nil
+	}
+	return s.Details
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocation) GetType() string {
+	if s == nil || s.Type == nil {
+		return ""
+	}
+	return *s.Type
+}
+
+// GetBlobSHA returns the BlobSHA field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetBlobSHA() string {
+	if s == nil || s.BlobSHA == nil {
+		return ""
+	}
+	return *s.BlobSHA
+}
+
+// GetBlobURL returns the BlobURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetBlobURL() string {
+	if s == nil || s.BlobURL == nil {
+		return ""
+	}
+	return *s.BlobURL
+}
+
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetCommitSHA() string {
+	if s == nil || s.CommitSHA == nil {
+		return ""
+	}
+	return *s.CommitSHA
+}
+
+// GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetCommitURL() string {
+	if s == nil || s.CommitURL == nil {
+		return ""
+	}
+	return *s.CommitURL
+}
+
+// GetDiscussionBodyURL returns the DiscussionBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionBodyURL() string {
+	if s == nil || s.DiscussionBodyURL == nil {
+		return ""
+	}
+	return *s.DiscussionBodyURL
+}
+
+// GetDiscussionCommentURL returns the DiscussionCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionCommentURL() string {
+	if s == nil || s.DiscussionCommentURL == nil {
+		return ""
+	}
+	return *s.DiscussionCommentURL
+}
+
+// GetDiscussionTitleURL returns the DiscussionTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionTitleURL() string {
+	if s == nil || s.DiscussionTitleURL == nil {
+		return ""
+	}
+	return *s.DiscussionTitleURL
+}
+
+// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetEndColumn() int {
+	if s == nil || s.EndColumn == nil {
+		return 0
+	}
+	return *s.EndColumn
+}
+
+// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetEndLine() int {
+	if s == nil || s.EndLine == nil {
+		return 0
+	}
+	return *s.EndLine
+}
+
+// GetIssueBodyURL returns the IssueBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueBodyURL() string {
+	if s == nil || s.IssueBodyURL == nil {
+		return ""
+	}
+	return *s.IssueBodyURL
+}
+
+// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueCommentURL() string {
+	if s == nil || s.IssueCommentURL == nil {
+		return ""
+	}
+	return *s.IssueCommentURL
+}
+
+// GetIssueTitleURL returns the IssueTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueTitleURL() string {
+	if s == nil || s.IssueTitleURL == nil {
+		return ""
+	}
+	return *s.IssueTitleURL
 }
 
-// GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlert) GetResolution() string {
-	if s == nil || s.Resolution == nil {
+// GetPageURL returns the PageURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPageURL() string {
+	if s == nil || s.PageURL == nil {
 		return ""
 	}
-	return *s.Resolution
+	return *s.PageURL
 }
 
-// GetResolvedAt returns the ResolvedAt field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlert) GetResolvedAt() Timestamp {
-	if s == nil || s.ResolvedAt == nil {
-		return Timestamp{}
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPath() string {
+	if s == nil || s.Path == nil {
+		return ""
 	}
-	return *s.ResolvedAt
+	return *s.Path
 }
 
-// GetResolvedBy returns the ResolvedBy field.
-func (s *SecretScanningAlert) GetResolvedBy() *User {
-	if s == nil {
-		return nil
+// GetPullRequestBodyURL returns the PullRequestBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestBodyURL() string {
+	if s == nil || s.PullRequestBodyURL == nil {
+		return ""
 	}
-	return s.ResolvedBy
+	return *s.PullRequestBodyURL
 }
 
-// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlert) GetSecret() string {
-	if s == nil || s.Secret == nil {
+// GetPullRequestCommentURL returns the PullRequestCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestCommentURL() string {
+	if s == nil || s.PullRequestCommentURL == nil {
 		return ""
 	}
-	return *s.Secret
+	return *s.PullRequestCommentURL
 }
 
-// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlert) GetSecretType() string {
-	if s == nil || s.SecretType == nil {
+// GetPullRequestReviewCommentURL returns the PullRequestReviewCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestReviewCommentURL() string {
+	if s == nil || s.PullRequestReviewCommentURL == nil {
 		return ""
 	}
-	return *s.SecretType
+	return *s.PullRequestReviewCommentURL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlert) GetState() string {
-	if s == nil || s.State == nil {
+// GetPullRequestReviewURL returns the PullRequestReviewURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestReviewURL() string {
+	if s == nil || s.PullRequestReviewURL == nil {
 		return ""
 	}
-	return *s.State
+	return *s.PullRequestReviewURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlert) GetURL() string {
-	if s == nil || s.URL == nil {
+// GetPullRequestTitleURL returns the PullRequestTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestTitleURL() string {
+	if s == nil || s.PullRequestTitleURL == nil {
 		return ""
 	}
-	return *s.URL
+	return *s.PullRequestTitleURL
+}
+
+// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetStartColumn() int {
+	if s == nil || s.StartColumn == nil {
+		return 0
+	}
+	return *s.StartColumn
+}
+
+// GetStartline returns the Startline field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetStartline() int {
+	if s == nil || s.Startline == nil {
+		return 0
+	}
+	return *s.Startline
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertEvent) GetAction() string {
+func (s *SecretScanningAlertLocationEvent) GetAction() string {
 	if s == nil || s.Action == nil {
 		return ""
 	}
@@ -18407,7 +23791,7 @@ func (s *SecretScanningAlertEvent) GetAction() string {
 }
 
 // GetAlert returns the Alert field.
-func (s *SecretScanningAlertEvent) GetAlert() *SecretScanningAlert {
+func (s *SecretScanningAlertLocationEvent) GetAlert() *SecretScanningAlert {
 	if s == nil {
 		return nil
 	}
@@ -18415,7 +23799,7 @@ func (s *SecretScanningAlertEvent) GetAlert() *SecretScanningAlert {
 }
 
 // GetEnterprise returns the Enterprise field.
-func (s *SecretScanningAlertEvent) GetEnterprise() *Enterprise {
+func (s *SecretScanningAlertLocationEvent) GetEnterprise() *Enterprise {
 	if s == nil {
 		return nil
 	}
@@ -18423,15 +23807,23 @@ func (s *SecretScanningAlertEvent) GetEnterprise() *Enterprise {
 }
 
 // GetInstallation returns the Installation field.
-func (s *SecretScanningAlertEvent) GetInstallation() *Installation {
+func (s *SecretScanningAlertLocationEvent) GetInstallation() *Installation {
 	if s == nil {
 		return nil
 	}
 	return s.Installation
 }
 
+// GetLocation returns the Location field.
+func (s *SecretScanningAlertLocationEvent) GetLocation() *SecretScanningAlertLocation {
+	if s == nil {
+		return nil
+	}
+	return s.Location
+}
+
 // GetOrganization returns the Organization field.
-func (s *SecretScanningAlertEvent) GetOrganization() *Organization {
+func (s *SecretScanningAlertLocationEvent) GetOrganization() *Organization {
 	if s == nil {
 		return nil
 	}
@@ -18439,7 +23831,7 @@ func (s *SecretScanningAlertEvent) GetOrganization() *Organization {
 }
 
 // GetRepo returns the Repo field.
-func (s *SecretScanningAlertEvent) GetRepo() *Repository {
+func (s *SecretScanningAlertLocationEvent) GetRepo() *Repository {
 	if s == nil {
 		return nil
 	}
@@ -18447,123 +23839,171 @@ func (s *SecretScanningAlertEvent) GetRepo() *Repository {
 }
 
 // GetSender returns the Sender field.
-func (s *SecretScanningAlertEvent) GetSender() *User {
+func (s *SecretScanningAlertLocationEvent) GetSender() *User {
 	if s == nil {
 		return nil
 	}
 	return s.Sender
 }
 
-// GetDetails returns the Details field.
-func (s *SecretScanningAlertLocation) GetDetails() *SecretScanningAlertLocationDetails {
-	if s == nil {
-		return nil
+// GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertUpdateOptions) GetResolution() string {
+	if s == nil || s.Resolution == nil {
+		return ""
 	}
-	return s.Details
+	return *s.Resolution
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocation) GetType() string {
-	if s == nil || s.Type == nil {
+// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertUpdateOptions) GetSecretType() string {
+	if s == nil || s.SecretType == nil {
 		return ""
 	}
-	return *s.Type
+	return *s.SecretType
 }
 
-// GetBlobSHA returns the BlobSHA field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetBlobSHA() string {
-	if s == nil || s.BlobSHA == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertUpdateOptions) GetState() string {
+	if s == nil || s.State == nil {
 		return ""
 	}
-	return *s.BlobSHA
+	return *s.State
 }
 
-// GetBlobURL returns the BlobURL field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetBlobURL() string {
-	if s == nil || s.BlobURL == nil {
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassData) GetLocation() string {
+	if s == nil || s.Location == nil {
 		return ""
 	}
-	return *s.BlobURL
+	return *s.Location
 }
 
-// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetCommitSHA() string {
-	if s == nil || s.CommitSHA == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassData) GetPath() string {
+	if s == nil || s.Path == nil {
 		return ""
 	}
-	return *s.CommitSHA
+	return *s.Path
 }
 
-// GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetCommitURL() string {
-	if s == nil || s.CommitURL == nil {
+// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassData) GetSecretType() string {
+	if s == nil || s.SecretType == nil {
 		return ""
 	}
-	return *s.CommitURL
+	return *s.SecretType
 }
 
-// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetEndColumn() int {
-	if s == nil || s.EndColumn == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *s.EndColumn
+	return *s.CreatedAt
 }
 
-// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetEndLine() int {
-	if s == nil || s.EndLine == nil {
-		return 0
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetExpiresAt() Timestamp {
+	if s == nil || s.ExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *s.EndLine
+	return *s.ExpiresAt
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetPath() string {
-	if s == nil || s.Path == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
 		return ""
 	}
-	return *s.Path
+	return *s.HTMLURL
 }
 
-// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetStartColumn() int {
-	if s == nil || s.StartColumn == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetID() int64 {
+	if s == nil || s.ID == nil {
 		return 0
 	}
-	return *s.StartColumn
+	return *s.ID
 }
 
-// GetStartline returns the Startline field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertLocationDetails) GetStartline() int {
-	if s == nil || s.Startline == nil {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetNumber() int64 {
+	if s == nil || s.Number == nil {
 		return 0
 	}
-	return *s.Startline
+	return *s.Number
 }
 
-// GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertUpdateOptions) GetResolution() string {
-	if s == nil || s.Resolution == nil {
+// GetOrganization returns the Organization field.
+func (s *SecretScanningBypassRequest) GetOrganization() *BypassRequestOrganization {
+	if s == nil {
+		return nil
+	}
+	return s.Organization
+}
+
+// GetRepository returns the Repository field.
+func (s *SecretScanningBypassRequest) GetRepository() *BypassRequestRepository {
+	if s == nil {
+		return nil
+	}
+	return s.Repository
+}
+
+// GetRequester returns the Requester field.
+func (s *SecretScanningBypassRequest) GetRequester() *BypassActor {
+	if s == nil {
+		return nil
+	}
+	return s.Requester
+}
+
+// GetRequesterComment returns the RequesterComment field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetRequesterComment() string {
+	if s == nil || s.RequesterComment == nil {
 		return ""
 	}
-	return *s.Resolution
+	return *s.RequesterComment
 }
 
-// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertUpdateOptions) GetSecretType() string {
-	if s == nil || s.SecretType == nil {
+// GetRequestType returns the RequestType field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetRequestType() string {
+	if s == nil || s.RequestType == nil {
 		return ""
 	}
-	return *s.SecretType
+	return *s.RequestType
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (s *SecretScanningAlertUpdateOptions) GetState() string {
-	if s == nil || s.State == nil {
+// GetResourceIdentifier returns the ResourceIdentifier field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetResourceIdentifier() string {
+	if s == nil || s.ResourceIdentifier == nil {
 		return ""
 	}
-	return *s.State
+	return *s.ResourceIdentifier
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
+	}
+	return *s.URL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (s *SecretScanningNonProviderPatterns) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
 }
 
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
@@ -18574,6 +24014,54 @@ func (s *SecretScanningPushProtection) GetStatus() string {
 	return *s.Status
 }
 
+// GetAuthor returns the Author field.
+func (s *SecurityAdvisory) GetAuthor() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Author
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetClosedAt() Timestamp {
+	if s == nil || s.ClosedAt == nil {
+		return Timestamp{}
+	}
+	return *s.ClosedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *s.CreatedAt
+}
+
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetCVEID() string {
+	if s == nil || s.CVEID == nil {
+		return ""
+	}
+	return *s.CVEID
+}
+
+// GetCVSs returns the CVSs field.
+func (s *SecurityAdvisory) GetCVSs() *AdvisoryCVSs {
+	if s == nil {
+		return nil
+	}
+	return s.CVSs
+}
+
+// GetCVSSSeverities returns the CVSSSeverities field.
+func (s *SecurityAdvisory) GetCVSSSeverities() *AdvisoryCVSSSeverities {
+	if s == nil {
+		return nil
+	}
+	return s.CVSSSeverities
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (s *SecurityAdvisory) GetDescription() string {
 	if s == nil || s.Description == nil {
@@ -18582,12 +24070,36 @@ func (s *SecurityAdvisory) GetDescription() string {
 	return *s.Description
 }
 
-// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
-func (s *SecurityAdvisory) GetGHSAID() string {
-	if s == nil || s.GHSAID == nil {
-		return ""
+// GetEPSS returns the EPSS field.
+func (s *SecurityAdvisory) GetEPSS() *AdvisoryEPSS {
+	if s == nil {
+		return nil
+	}
+	return s.EPSS
+}
+
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetGHSAID() string {
+	if s == nil || s.GHSAID == nil {
+		return ""
+	}
+	return *s.GHSAID
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
+		return ""
+	}
+	return *s.HTMLURL
+}
+
+// GetPrivateFork returns the PrivateFork field.
+func (s *SecurityAdvisory) GetPrivateFork() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *s.GHSAID
+	return s.PrivateFork
 }
 
 // GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
@@ -18598,6 +24110,14 @@ func (s *SecurityAdvisory) GetPublishedAt() Timestamp {
 	return *s.PublishedAt
 }
 
+// GetPublisher returns the Publisher field.
+func (s *SecurityAdvisory) GetPublisher() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Publisher
+}
+
 // GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
 func (s *SecurityAdvisory) GetSeverity() string {
 	if s == nil || s.Severity == nil {
@@ -18606,6 +24126,22 @@ func (s *SecurityAdvisory) GetSeverity() string {
 	return *s.Severity
 }
 
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetState() string {
+	if s == nil || s.State == nil {
+		return ""
+	}
+	return *s.State
+}
+
+// GetSubmission returns the Submission field.
+func (s *SecurityAdvisory) GetSubmission() *SecurityAdvisorySubmission {
+	if s == nil {
+		return nil
+	}
+	return s.Submission
+}
+
 // GetSummary returns the Summary field if it's non-nil, zero value otherwise.
 func (s *SecurityAdvisory) GetSummary() string {
 	if s == nil || s.Summary == nil {
@@ -18622,6 +24158,14 @@ func (s *SecurityAdvisory) GetUpdatedAt() Timestamp {
 	return *s.UpdatedAt
 }
 
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
+	}
+	return *s.URL
+}
+
 // GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
 func (s *SecurityAdvisory) GetWithdrawnAt() Timestamp {
 	if s == nil || s.WithdrawnAt == nil {
@@ -18646,6 +24190,70 @@ func (s *SecurityAdvisoryEvent) GetSecurityAdvisory() *SecurityAdvisory {
 	return s.SecurityAdvisory
 }
 
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetCVEID() string {
+	if s == nil || s.CVEID == nil {
+		return ""
+	}
+	return *s.CVEID
+}
+
+// GetCVSSVectorString returns the CVSSVectorString field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetCVSSVectorString() string {
+	if s == nil || s.CVSSVectorString == nil {
+		return ""
+	}
+	return *s.CVSSVectorString
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetDescription() string {
+	if s == nil || s.Description == nil {
+		return ""
+	}
+	return *s.Description
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetSeverity() string {
+	if s == nil || s.Severity == nil {
+		return ""
+	}
+	return *s.Severity
+}
+
+// GetStartPrivateFork returns the StartPrivateFork field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetStartPrivateFork() bool {
+	if s == nil || s.StartPrivateFork == nil {
+		return false
+	}
+	return *s.StartPrivateFork
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetState() string {
+	if s == nil || s.State == nil {
+		return ""
+	}
+	return *s.State
+}
+
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetSummary() string {
+	if s == nil || s.Summary == nil {
+		return ""
+	}
+	return *s.Summary
+}
+
+// GetAccepted returns the Accepted field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisorySubmission) GetAccepted() bool {
+	if s == nil || s.Accepted == nil {
+		return false
+	}
+	return *s.Accepted
+}
+
 // GetAdvancedSecurity returns the AdvancedSecurity field.
 func (s *SecurityAndAnalysis) GetAdvancedSecurity() *AdvancedSecurity {
 	if s == nil {
@@ -18654,6 +24262,14 @@ func (s *SecurityAndAnalysis) GetAdvancedSecurity() *AdvancedSecurity {
 	return s.AdvancedSecurity
 }
 
+// GetDependabotSecurityUpdates returns the DependabotSecurityUpdates field.
+func (s *SecurityAndAnalysis) GetDependabotSecurityUpdates() *DependabotSecurityUpdates {
+	if s == nil {
+		return nil
+	}
+	return s.DependabotSecurityUpdates
+}
+
 // GetSecretScanning returns the SecretScanning field.
 func (s *SecurityAndAnalysis) GetSecretScanning() *SecretScanning {
 	if s == nil {
@@ -18662,6 +24278,14 @@ func (s *SecurityAndAnalysis) GetSecretScanning() *SecretScanning {
 	return s.SecretScanning
 }
 
+// GetSecretScanningNonProviderPatterns returns the SecretScanningNonProviderPatterns field.
+func (s *SecurityAndAnalysis) GetSecretScanningNonProviderPatterns() *SecretScanningNonProviderPatterns {
+	if s == nil {
+		return nil
+	}
+	return s.SecretScanningNonProviderPatterns
+}
+
 // GetSecretScanningPushProtection returns the SecretScanningPushProtection field.
 func (s *SecurityAndAnalysis) GetSecretScanningPushProtection() *SecretScanningPushProtection {
 	if s == nil {
@@ -18742,6 +24366,30 @@ func (s *SignatureVerification) GetVerified() bool {
 	return *s.Verified
 }
 
+// GetVerifiedAt returns the VerifiedAt field if it's non-nil, zero value otherwise.
+func (s *SignatureVerification) GetVerifiedAt() Timestamp {
+	if s == nil || s.VerifiedAt == nil {
+		return Timestamp{}
+	}
+	return *s.VerifiedAt
+}
+
+// GetProvider returns the Provider field if it's non-nil, zero value otherwise.
+func (s *SocialAccount) GetProvider() string {
+	if s == nil || s.Provider == nil {
+		return ""
+	}
+	return *s.Provider
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SocialAccount) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
+	}
+	return *s.URL
+}
+
 // GetActor returns the Actor field.
 func (s *Source) GetActor() *User {
 	if s == nil {
@@ -18838,6 +24486,46 @@ func (s *SourceImportAuthor) GetURL() string {
 	return *s.URL
 }
 
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (s *SplunkStreamConfig) GetDomain() string {
+	if s == nil || s.Domain == nil {
+		return ""
+	}
+	return *s.Domain
+}
+
+// GetEncryptedToken returns the EncryptedToken field if it's non-nil, zero value otherwise.
+func (s *SplunkStreamConfig) GetEncryptedToken() string {
+	if s == nil || s.EncryptedToken == nil {
+		return ""
+	}
+	return *s.EncryptedToken
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (s *SplunkStreamConfig) GetKeyID() string {
+	if s == nil || s.KeyID == nil {
+		return ""
+	}
+	return *s.KeyID
+}
+
+// GetPort returns the Port field if it's non-nil, zero value otherwise.
+func (s *SplunkStreamConfig) GetPort() int {
+	if s == nil || s.Port == nil {
+		return 0
+	}
+	return *s.Port
+}
+
+// GetSSLVerify returns the SSLVerify field if it's non-nil, zero value otherwise.
+func (s *SplunkStreamConfig) GetSSLVerify() bool {
+	if s == nil || s.SSLVerify == nil {
+		return false
+	}
+	return *s.SSLVerify
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (s *SSHSigningKey) GetCreatedAt() Timestamp {
 	if s == nil || s.CreatedAt == nil {
@@ -18870,6 +24558,22 @@ func (s *SSHSigningKey) GetTitle() string {
 	return *s.Title
 }
 
+// GetKey returns the Key field.
+func (s *StaleDeployKey) GetKey() *Key {
+	if s == nil {
+		return nil
+	}
+	return s.Key
+}
+
+// GetRepository returns the Repository field.
+func (s *StaleDeployKey) GetRepository() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repository
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (s *StarEvent) GetAction() string {
 	if s == nil || s.Action == nil {
@@ -18974,40 +24678,168 @@ func (s *StatusEvent) GetCreatedAt() Timestamp {
 	return *s.CreatedAt
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (s *StatusEvent) GetDescription() string {
-	if s == nil || s.Description == nil {
-		return ""
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *StatusEvent) GetDescription() string {
+	if s == nil || s.Description == nil {
+		return ""
+	}
+	return *s.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *StatusEvent) GetID() int64 {
+	if s == nil || s.ID == nil {
+		return 0
+	}
+	return *s.ID
+}
+
+// GetInstallation returns the Installation field.
+func (s *StatusEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *StatusEvent) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetOrg returns the Org field.
+func (s *StatusEvent) GetOrg() *Organization {
+	if s == nil {
+		return nil
+	}
+	return s.Org
+}
+
+// GetRepo returns the Repo field.
+func (s *StatusEvent) GetRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repo
+}
+
+// GetSender returns the Sender field.
+func (s *StatusEvent) GetSender() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (s *StatusEvent) GetSHA() string {
+	if s == nil || s.SHA == nil {
+		return ""
+	}
+	return *s.SHA
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *StatusEvent) GetState() string {
+	if s == nil || s.State == nil {
+		return ""
+	}
+	return *s.State
+}
+
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (s *StatusEvent) GetTargetURL() string {
+	if s == nil || s.TargetURL == nil {
+		return ""
+	}
+	return *s.TargetURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *StatusEvent) GetUpdatedAt() Timestamp {
+	if s == nil || s.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *s.UpdatedAt
+}
+
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (s *SubIssueReprioritizeRequest) GetAfterID() int64 {
+	if s == nil || s.AfterID == nil {
+		return 0
+	}
+	return *s.AfterID
+}
+
+// GetBeforeID returns the BeforeID field if it's non-nil, zero value otherwise.
+func (s *SubIssueReprioritizeRequest) GetBeforeID() int64 {
+	if s == nil || s.BeforeID == nil {
+		return 0
+	}
+	return *s.BeforeID
+}
+
+// GetReplaceParent returns the ReplaceParent field if it's non-nil, zero value otherwise.
+func (s *SubIssueRequest) GetReplaceParent() bool {
+	if s == nil || s.ReplaceParent == nil {
+		return false
+	}
+	return *s.ReplaceParent
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
+	}
+	return *s.Action
+}
+
+// GetInstallation returns the Installation field.
+func (s *SubIssuesEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
+
+// GetOrg returns the Org field.
+func (s *SubIssuesEvent) GetOrg() *Organization {
+	if s == nil {
+		return nil
+	}
+	return s.Org
+}
+
+// GetParentIssue returns the ParentIssue field.
+func (s *SubIssuesEvent) GetParentIssue() *Issue {
+	if s == nil {
+		return nil
 	}
-	return *s.Description
+	return s.ParentIssue
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (s *StatusEvent) GetID() int64 {
-	if s == nil || s.ID == nil {
+// GetParentIssueID returns the ParentIssueID field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetParentIssueID() int64 {
+	if s == nil || s.ParentIssueID == nil {
 		return 0
 	}
-	return *s.ID
+	return *s.ParentIssueID
 }
 
-// GetInstallation returns the Installation field.
-func (s *StatusEvent) GetInstallation() *Installation {
+// GetParentIssueRepo returns the ParentIssueRepo field.
+func (s *SubIssuesEvent) GetParentIssueRepo() *Repository {
 	if s == nil {
 		return nil
 	}
-	return s.Installation
-}
-
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (s *StatusEvent) GetName() string {
-	if s == nil || s.Name == nil {
-		return ""
-	}
-	return *s.Name
+	return s.ParentIssueRepo
 }
 
 // GetRepo returns the Repo field.
-func (s *StatusEvent) GetRepo() *Repository {
+func (s *SubIssuesEvent) GetRepo() *Repository {
 	if s == nil {
 		return nil
 	}
@@ -19015,43 +24847,59 @@ func (s *StatusEvent) GetRepo() *Repository {
 }
 
 // GetSender returns the Sender field.
-func (s *StatusEvent) GetSender() *User {
+func (s *SubIssuesEvent) GetSender() *User {
 	if s == nil {
 		return nil
 	}
 	return s.Sender
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (s *StatusEvent) GetSHA() string {
-	if s == nil || s.SHA == nil {
-		return ""
+// GetSubIssue returns the SubIssue field.
+func (s *SubIssuesEvent) GetSubIssue() *Issue {
+	if s == nil {
+		return nil
 	}
-	return *s.SHA
+	return s.SubIssue
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (s *StatusEvent) GetState() string {
-	if s == nil || s.State == nil {
-		return ""
+// GetSubIssueID returns the SubIssueID field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetSubIssueID() int64 {
+	if s == nil || s.SubIssueID == nil {
+		return 0
 	}
-	return *s.State
+	return *s.SubIssueID
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (s *StatusEvent) GetTargetURL() string {
-	if s == nil || s.TargetURL == nil {
-		return ""
+// GetSubIssueRepo returns the SubIssueRepo field.
+func (s *SubIssuesEvent) GetSubIssueRepo() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *s.TargetURL
+	return s.SubIssueRepo
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (s *StatusEvent) GetUpdatedAt() Timestamp {
-	if s == nil || s.UpdatedAt == nil {
-		return Timestamp{}
+// GetCompleted returns the Completed field if it's non-nil, zero value otherwise.
+func (s *SubIssuesSummary) GetCompleted() int {
+	if s == nil || s.Completed == nil {
+		return 0
 	}
-	return *s.UpdatedAt
+	return *s.Completed
+}
+
+// GetPercentCompleted returns the PercentCompleted field if it's non-nil, zero value otherwise.
+func (s *SubIssuesSummary) GetPercentCompleted() int {
+	if s == nil || s.PercentCompleted == nil {
+		return 0
+	}
+	return *s.PercentCompleted
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (s *SubIssuesSummary) GetTotal() int {
+	if s == nil || s.Total == nil {
+		return 0
+	}
+	return *s.Total
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
@@ -19902,6 +25750,14 @@ func (t *Timeline) GetAuthor() *CommitAuthor {
 	return t.Author
 }
 
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (t *Timeline) GetAuthorAssociation() string {
+	if t == nil || t.AuthorAssociation == nil {
+		return ""
+	}
+	return *t.AuthorAssociation
+}
+
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
 func (t *Timeline) GetBody() string {
 	if t == nil || t.Body == nil {
@@ -19942,6 +25798,14 @@ func (t *Timeline) GetCreatedAt() Timestamp {
 	return *t.CreatedAt
 }
 
+// GetDismissedReview returns the DismissedReview field.
+func (t *Timeline) GetDismissedReview() *DismissedReview {
+	if t == nil {
+		return nil
+	}
+	return t.DismissedReview
+}
+
 // GetEvent returns the Event field if it's non-nil, zero value otherwise.
 func (t *Timeline) GetEvent() string {
 	if t == nil || t.Event == nil {
@@ -19950,6 +25814,14 @@ func (t *Timeline) GetEvent() string {
 	return *t.Event
 }
 
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (t *Timeline) GetHTMLURL() string {
+	if t == nil || t.HTMLURL == nil {
+		return ""
+	}
+	return *t.HTMLURL
+}
+
 // GetID returns the ID field if it's non-nil, zero value otherwise.
 func (t *Timeline) GetID() int64 {
 	if t == nil || t.ID == nil {
@@ -19966,6 +25838,14 @@ func (t *Timeline) GetLabel() *Label {
 	return t.Label
 }
 
+// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
+func (t *Timeline) GetLockReason() string {
+	if t == nil || t.LockReason == nil {
+		return ""
+	}
+	return *t.LockReason
+}
+
 // GetMessage returns the Message field if it's non-nil, zero value otherwise.
 func (t *Timeline) GetMessage() string {
 	if t == nil || t.Message == nil {
@@ -19982,6 +25862,22 @@ func (t *Timeline) GetMilestone() *Milestone {
 	return t.Milestone
 }
 
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (t *Timeline) GetNodeID() string {
+	if t == nil || t.NodeID == nil {
+		return ""
+	}
+	return *t.NodeID
+}
+
+// GetPerformedViaGitHubApp returns the PerformedViaGitHubApp field.
+func (t *Timeline) GetPerformedViaGitHubApp() *App {
+	if t == nil {
+		return nil
+	}
+	return t.PerformedViaGitHubApp
+}
+
 // GetProjectCard returns the ProjectCard field.
 func (t *Timeline) GetProjectCard() *ProjectCard {
 	if t == nil {
@@ -20046,6 +25942,14 @@ func (t *Timeline) GetState() string {
 	return *t.State
 }
 
+// GetStateReason returns the StateReason field if it's non-nil, zero value otherwise.
+func (t *Timeline) GetStateReason() string {
+	if t == nil || t.StateReason == nil {
+		return ""
+	}
+	return *t.StateReason
+}
+
 // GetSubmittedAt returns the SubmittedAt field if it's non-nil, zero value otherwise.
 func (t *Timeline) GetSubmittedAt() Timestamp {
 	if t == nil || t.SubmittedAt == nil {
@@ -20054,6 +25958,14 @@ func (t *Timeline) GetSubmittedAt() Timestamp {
 	return *t.SubmittedAt
 }
 
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (t *Timeline) GetUpdatedAt() Timestamp {
+	if t == nil || t.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *t.UpdatedAt
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (t *Timeline) GetURL() string {
 	if t == nil || t.URL == nil {
@@ -20230,6 +26142,22 @@ func (t *TrafficData) GetUniques() int {
 	return *t.Uniques
 }
 
+// GetClones returns the Clones field.
+func (t *TrafficDay) GetClones() *TrafficData {
+	if t == nil {
+		return nil
+	}
+	return t.Clones
+}
+
+// GetViews returns the Views field.
+func (t *TrafficDay) GetViews() *TrafficData {
+	if t == nil {
+		return nil
+	}
+	return t.Views
+}
+
 // GetCount returns the Count field if it's non-nil, zero value otherwise.
 func (t *TrafficPath) GetCount() int {
 	if t == nil || t.Count == nil {
@@ -20286,6 +26214,22 @@ func (t *TrafficReferrer) GetUniques() int {
 	return *t.Uniques
 }
 
+// GetClones returns the Clones field.
+func (t *TrafficSummary) GetClones() *TrafficData {
+	if t == nil {
+		return nil
+	}
+	return t.Clones
+}
+
+// GetViews returns the Views field.
+func (t *TrafficSummary) GetViews() *TrafficData {
+	if t == nil {
+		return nil
+	}
+	return t.Views
+}
+
 // GetCount returns the Count field if it's non-nil, zero value otherwise.
 func (t *TrafficViews) GetCount() int {
 	if t == nil || t.Count == nil {
@@ -20302,6 +26246,14 @@ func (t *TrafficViews) GetUniques() int {
 	return *t.Uniques
 }
 
+// GetNewName returns the NewName field if it's non-nil, zero value otherwise.
+func (t *TransferRequest) GetNewName() string {
+	if t == nil || t.NewName == nil {
+		return ""
+	}
+	return *t.NewName
+}
+
 // GetSHA returns the SHA field if it's non-nil, zero value otherwise.
 func (t *Tree) GetSHA() string {
 	if t == nil || t.SHA == nil {
@@ -20374,6 +26326,14 @@ func (t *TreeEntry) GetURL() string {
 	return *t.URL
 }
 
+// GetRepository returns the Repository field.
+func (u *UncheckedRepo) GetRepository() *Repository {
+	if u == nil {
+		return nil
+	}
+	return u.Repository
+}
+
 // GetPath returns the Path field if it's non-nil, zero value otherwise.
 func (u *UpdateAttributeForSCIMUserOperations) GetPath() string {
 	if u == nil || u.Path == nil {
@@ -20430,6 +26390,38 @@ func (u *UpdateCheckRunOptions) GetStatus() string {
 	return *u.Status
 }
 
+// GetQuerySuite returns the QuerySuite field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationOptions) GetQuerySuite() string {
+	if u == nil || u.QuerySuite == nil {
+		return ""
+	}
+	return *u.QuerySuite
+}
+
+// GetRunID returns the RunID field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationResponse) GetRunID() int64 {
+	if u == nil || u.RunID == nil {
+		return 0
+	}
+	return *u.RunID
+}
+
+// GetRunURL returns the RunURL field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationResponse) GetRunURL() string {
+	if u == nil || u.RunURL == nil {
+		return ""
+	}
+	return *u.RunURL
+}
+
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (u *UpdateProjectV2ItemOptions) GetArchived() bool {
+	if u == nil || u.Archived == nil {
+		return false
+	}
+	return *u.Archived
+}
+
 // GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
 func (u *UpdateRunnerGroupRequest) GetAllowsPublicRepositories() bool {
 	if u == nil || u.AllowsPublicRepositories == nil {
@@ -20462,6 +26454,134 @@ func (u *UpdateRunnerGroupRequest) GetVisibility() string {
 	return *u.Visibility
 }
 
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetDate() string {
+	if u == nil || u.Date == nil {
+		return ""
+	}
+	return *u.Date
+}
+
+// GetDiscountAmount returns the DiscountAmount field.
+func (u *UsageItem) GetDiscountAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.DiscountAmount
+}
+
+// GetGrossAmount returns the GrossAmount field.
+func (u *UsageItem) GetGrossAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.GrossAmount
+}
+
+// GetNetAmount returns the NetAmount field.
+func (u *UsageItem) GetNetAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.NetAmount
+}
+
+// GetOrganizationName returns the OrganizationName field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetOrganizationName() string {
+	if u == nil || u.OrganizationName == nil {
+		return ""
+	}
+	return *u.OrganizationName
+}
+
+// GetPricePerUnit returns the PricePerUnit field.
+func (u *UsageItem) GetPricePerUnit() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.PricePerUnit
+}
+
+// GetProduct returns the Product field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetProduct() string {
+	if u == nil || u.Product == nil {
+		return ""
+	}
+	return *u.Product
+}
+
+// GetQuantity returns the Quantity field.
+func (u *UsageItem) GetQuantity() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.Quantity
+}
+
+// GetRepositoryName returns the RepositoryName field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetRepositoryName() string {
+	if u == nil || u.RepositoryName == nil {
+		return ""
+	}
+	return *u.RepositoryName
+}
+
+// GetSKU returns the SKU field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetSKU() string {
+	if u == nil || u.SKU == nil {
+		return ""
+	}
+	return *u.SKU
+}
+
+// GetUnitType returns the UnitType field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetUnitType() string {
+	if u == nil || u.UnitType == nil {
+		return ""
+	}
+	return *u.UnitType
+}
+
+// GetCostCenterID returns the CostCenterID field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetCostCenterID() string {
+	if u == nil || u.CostCenterID == nil {
+		return ""
+	}
+	return *u.CostCenterID
+}
+
+// GetDay returns the Day field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetDay() int {
+	if u == nil || u.Day == nil {
+		return 0
+	}
+	return *u.Day
+}
+
+// GetHour returns the Hour field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetHour() int {
+	if u == nil || u.Hour == nil {
+		return 0
+	}
+	return *u.Hour
+}
+
+// GetMonth returns the Month field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetMonth() int {
+	if u == nil || u.Month == nil {
+		return 0
+	}
+	return *u.Month
+}
+
+// GetYear returns the Year field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetYear() int {
+	if u == nil || u.Year == nil {
+		return 0
+	}
+	return *u.Year
+}
+
 // GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
 func (u *User) GetAvatarURL() string {
 	if u == nil || u.AvatarURL == nil {
@@ -21118,6 +27238,14 @@ func (u *UserLDAPMapping) GetURL() string {
 	return *u.URL
 }
 
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetArchiveURL() string {
+	if u == nil || u.ArchiveURL == nil {
+		return ""
+	}
+	return *u.ArchiveURL
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (u *UserMigration) GetCreatedAt() string {
 	if u == nil || u.CreatedAt == nil {
@@ -21134,6 +27262,38 @@ func (u *UserMigration) GetExcludeAttachments() bool {
 	return *u.ExcludeAttachments
 }
 
+// GetExcludeGitData returns the ExcludeGitData field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetExcludeGitData() bool {
+	if u == nil || u.ExcludeGitData == nil {
+		return false
+	}
+	return *u.ExcludeGitData
+}
+
+// GetExcludeMetadata returns the ExcludeMetadata field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetExcludeMetadata() bool {
+	if u == nil || u.ExcludeMetadata == nil {
+		return false
+	}
+	return *u.ExcludeMetadata
+}
+
+// GetExcludeOwnerProjects returns the ExcludeOwnerProjects field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetExcludeOwnerProjects() bool {
+	if u == nil || u.ExcludeOwnerProjects == nil {
+		return false
+	}
+	return *u.ExcludeOwnerProjects
+}
+
+// GetExcludeReleases returns the ExcludeReleases field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetExcludeReleases() bool {
+	if u == nil || u.ExcludeReleases == nil {
+		return false
+	}
+	return *u.ExcludeReleases
+}
+
 // GetGUID returns the GUID field if it's non-nil, zero value otherwise.
 func (u *UserMigration) GetGUID() string {
 	if u == nil || u.GUID == nil {
@@ -21262,6 +27422,14 @@ func (w *WatchEvent) GetInstallation() *Installation {
 	return w.Installation
 }
 
+// GetOrg returns the Org field.
+func (w *WatchEvent) GetOrg() *Organization {
+	if w == nil {
+		return nil
+	}
+	return w.Org
+}
+
 // GetRepo returns the Repo field.
 func (w *WatchEvent) GetRepo() *Repository {
 	if w == nil {
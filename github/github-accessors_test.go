@@ -382,6 +382,53 @@ func TestAdvancedSecurityCommittersBreakdown_GetUserLogin(tt *testing.T) {
 	a.GetUserLogin()
 }
 
+func TestAdvisoryCredit_GetLogin(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCredit{Login: &zeroValue}
+	a.GetLogin()
+	a = &AdvisoryCredit{}
+	a.GetLogin()
+	a = nil
+	a.GetLogin()
+}
+
+func TestAdvisoryCredit_GetType(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCredit{Type: &zeroValue}
+	a.GetType()
+	a = &AdvisoryCredit{}
+	a.GetType()
+	a = nil
+	a.GetType()
+}
+
+func TestAdvisoryCreditDetailed_GetState(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCreditDetailed{State: &zeroValue}
+	a.GetState()
+	a = &AdvisoryCreditDetailed{}
+	a.GetState()
+	a = nil
+	a.GetState()
+}
+
+func TestAdvisoryCreditDetailed_GetType(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCreditDetailed{Type: &zeroValue}
+	a.GetType()
+	a = &AdvisoryCreditDetailed{}
+	a.GetType()
+	a = nil
+	a.GetType()
+}
+
+func TestAdvisoryCreditDetailed_GetUser(tt *testing.T) {
+	a := &AdvisoryCreditDetailed{}
+	a.GetUser()
+	a = nil
+	a.GetUser()
+}
+
 func TestAdvisoryCVSs_GetScore(tt *testing.T) {
 	a := &AdvisoryCVSs{}
 	a.GetScore()
@@ -399,6 +446,20 @@ func TestAdvisoryCVSs_GetVectorString(tt *testing.T) {
 	a.GetVectorString()
 }
 
+func TestAdvisoryCVSSSeverities_GetCVSSV3(tt *testing.T) {
+	a := &AdvisoryCVSSSeverities{}
+	a.GetCVSSV3()
+	a = nil
+	a.GetCVSSV3()
+}
+
+func TestAdvisoryCVSSSeverities_GetCVSSV4(tt *testing.T) {
+	a := &AdvisoryCVSSSeverities{}
+	a.GetCVSSV4()
+	a = nil
+	a.GetCVSSV4()
+}
+
 func TestAdvisoryCWEs_GetCWEID(tt *testing.T) {
 	var zeroValue string
 	a := &AdvisoryCWEs{CWEID: &zeroValue}
@@ -463,6 +524,16 @@ func TestAdvisoryVulnerability_GetPackage(tt *testing.T) {
 	a.GetPackage()
 }
 
+func TestAdvisoryVulnerability_GetPatchedVersions(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{PatchedVersions: &zeroValue}
+	a.GetPatchedVersions()
+	a = &AdvisoryVulnerability{}
+	a.GetPatchedVersions()
+	a = nil
+	a.GetPatchedVersions()
+}
+
 func TestAdvisoryVulnerability_GetSeverity(tt *testing.T) {
 	var zeroValue string
 	a := &AdvisoryVulnerability{Severity: &zeroValue}
@@ -695,6 +766,116 @@ func TestAllowForkSyncing_GetEnabled(tt *testing.T) {
 	a.GetEnabled()
 }
 
+func TestAmazonS3AccessKeysStreamConfig_GetAuthenticationType(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3AccessKeysStreamConfig{AuthenticationType: &zeroValue}
+	a.GetAuthenticationType()
+	a = &AmazonS3AccessKeysStreamConfig{}
+	a.GetAuthenticationType()
+	a = nil
+	a.GetAuthenticationType()
+}
+
+func TestAmazonS3AccessKeysStreamConfig_GetBucket(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3AccessKeysStreamConfig{Bucket: &zeroValue}
+	a.GetBucket()
+	a = &AmazonS3AccessKeysStreamConfig{}
+	a.GetBucket()
+	a = nil
+	a.GetBucket()
+}
+
+func TestAmazonS3AccessKeysStreamConfig_GetEncryptedAccessKeyID(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3AccessKeysStreamConfig{EncryptedAccessKeyID: &zeroValue}
+	a.GetEncryptedAccessKeyID()
+	a = &AmazonS3AccessKeysStreamConfig{}
+	a.GetEncryptedAccessKeyID()
+	a = nil
+	a.GetEncryptedAccessKeyID()
+}
+
+func TestAmazonS3AccessKeysStreamConfig_GetEncryptedSecretKey(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3AccessKeysStreamConfig{EncryptedSecretKey: &zeroValue}
+	a.GetEncryptedSecretKey()
+	a = &AmazonS3AccessKeysStreamConfig{}
+	a.GetEncryptedSecretKey()
+	a = nil
+	a.GetEncryptedSecretKey()
+}
+
+func TestAmazonS3AccessKeysStreamConfig_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3AccessKeysStreamConfig{KeyID: &zeroValue}
+	a.GetKeyID()
+	a = &AmazonS3AccessKeysStreamConfig{}
+	a.GetKeyID()
+	a = nil
+	a.GetKeyID()
+}
+
+func TestAmazonS3AccessKeysStreamConfig_GetRegion(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3AccessKeysStreamConfig{Region: &zeroValue}
+	a.GetRegion()
+	a = &AmazonS3AccessKeysStreamConfig{}
+	a.GetRegion()
+	a = nil
+	a.GetRegion()
+}
+
+func TestAmazonS3OIDCStreamConfig_GetARNRole(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3OIDCStreamConfig{ARNRole: &zeroValue}
+	a.GetARNRole()
+	a = &AmazonS3OIDCStreamConfig{}
+	a.GetARNRole()
+	a = nil
+	a.GetARNRole()
+}
+
+func TestAmazonS3OIDCStreamConfig_GetAuthenticationType(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3OIDCStreamConfig{AuthenticationType: &zeroValue}
+	a.GetAuthenticationType()
+	a = &AmazonS3OIDCStreamConfig{}
+	a.GetAuthenticationType()
+	a = nil
+	a.GetAuthenticationType()
+}
+
+func TestAmazonS3OIDCStreamConfig_GetBucket(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3OIDCStreamConfig{Bucket: &zeroValue}
+	a.GetBucket()
+	a = &AmazonS3OIDCStreamConfig{}
+	a.GetBucket()
+	a = nil
+	a.GetBucket()
+}
+
+func TestAmazonS3OIDCStreamConfig_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3OIDCStreamConfig{KeyID: &zeroValue}
+	a.GetKeyID()
+	a = &AmazonS3OIDCStreamConfig{}
+	a.GetKeyID()
+	a = nil
+	a.GetKeyID()
+}
+
+func TestAmazonS3OIDCStreamConfig_GetRegion(tt *testing.T) {
+	var zeroValue string
+	a := &AmazonS3OIDCStreamConfig{Region: &zeroValue}
+	a.GetRegion()
+	a = &AmazonS3OIDCStreamConfig{}
+	a.GetRegion()
+	a = nil
+	a.GetRegion()
+}
+
 func TestAnalysesListOptions_GetRef(tt *testing.T) {
 	var zeroValue string
 	a := &AnalysesListOptions{Ref: &zeroValue}
@@ -715,6 +896,313 @@ func TestAnalysesListOptions_GetSarifID(tt *testing.T) {
 	a.GetSarifID()
 }
 
+func TestAnnouncementBanner_GetAnnouncement(tt *testing.T) {
+	var zeroValue string
+	a := &AnnouncementBanner{Announcement: &zeroValue}
+	a.GetAnnouncement()
+	a = &AnnouncementBanner{}
+	a.GetAnnouncement()
+	a = nil
+	a.GetAnnouncement()
+}
+
+func TestAnnouncementBanner_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &AnnouncementBanner{ExpiresAt: &zeroValue}
+	a.GetExpiresAt()
+	a = &AnnouncementBanner{}
+	a.GetExpiresAt()
+	a = nil
+	a.GetExpiresAt()
+}
+
+func TestAnnouncementBanner_GetUserDismissible(tt *testing.T) {
+	var zeroValue bool
+	a := &AnnouncementBanner{UserDismissible: &zeroValue}
+	a.GetUserDismissible()
+	a = &AnnouncementBanner{}
+	a.GetUserDismissible()
+	a = nil
+	a.GetUserDismissible()
+}
+
+func TestAPIInsightsRouteStats_GetAPIRoute(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsRouteStats{APIRoute: &zeroValue}
+	a.GetAPIRoute()
+	a = &APIInsightsRouteStats{}
+	a.GetAPIRoute()
+	a = nil
+	a.GetAPIRoute()
+}
+
+func TestAPIInsightsRouteStats_GetHTTPMethod(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsRouteStats{HTTPMethod: &zeroValue}
+	a.GetHTTPMethod()
+	a = &APIInsightsRouteStats{}
+	a.GetHTTPMethod()
+	a = nil
+	a.GetHTTPMethod()
+}
+
+func TestAPIInsightsRouteStats_GetLastRateLimitedTimestamp(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsRouteStats{LastRateLimitedTimestamp: &zeroValue}
+	a.GetLastRateLimitedTimestamp()
+	a = &APIInsightsRouteStats{}
+	a.GetLastRateLimitedTimestamp()
+	a = nil
+	a.GetLastRateLimitedTimestamp()
+}
+
+func TestAPIInsightsRouteStats_GetLastRequestTimestamp(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsRouteStats{LastRequestTimestamp: &zeroValue}
+	a.GetLastRequestTimestamp()
+	a = &APIInsightsRouteStats{}
+	a.GetLastRequestTimestamp()
+	a = nil
+	a.GetLastRequestTimestamp()
+}
+
+func TestAPIInsightsRouteStats_GetRateLimitedRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsRouteStats{RateLimitedRequestCount: &zeroValue}
+	a.GetRateLimitedRequestCount()
+	a = &APIInsightsRouteStats{}
+	a.GetRateLimitedRequestCount()
+	a = nil
+	a.GetRateLimitedRequestCount()
+}
+
+func TestAPIInsightsRouteStats_GetTotalRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsRouteStats{TotalRequestCount: &zeroValue}
+	a.GetTotalRequestCount()
+	a = &APIInsightsRouteStats{}
+	a.GetTotalRequestCount()
+	a = nil
+	a.GetTotalRequestCount()
+}
+
+func TestAPIInsightsSubjectStats_GetLastRateLimitedTimestamp(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsSubjectStats{LastRateLimitedTimestamp: &zeroValue}
+	a.GetLastRateLimitedTimestamp()
+	a = &APIInsightsSubjectStats{}
+	a.GetLastRateLimitedTimestamp()
+	a = nil
+	a.GetLastRateLimitedTimestamp()
+}
+
+func TestAPIInsightsSubjectStats_GetLastRequestTimestamp(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsSubjectStats{LastRequestTimestamp: &zeroValue}
+	a.GetLastRequestTimestamp()
+	a = &APIInsightsSubjectStats{}
+	a.GetLastRequestTimestamp()
+	a = nil
+	a.GetLastRequestTimestamp()
+}
+
+func TestAPIInsightsSubjectStats_GetRateLimitedRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsSubjectStats{RateLimitedRequestCount: &zeroValue}
+	a.GetRateLimitedRequestCount()
+	a = &APIInsightsSubjectStats{}
+	a.GetRateLimitedRequestCount()
+	a = nil
+	a.GetRateLimitedRequestCount()
+}
+
+func TestAPIInsightsSubjectStats_GetSubjectID(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsSubjectStats{SubjectID: &zeroValue}
+	a.GetSubjectID()
+	a = &APIInsightsSubjectStats{}
+	a.GetSubjectID()
+	a = nil
+	a.GetSubjectID()
+}
+
+func TestAPIInsightsSubjectStats_GetSubjectName(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsSubjectStats{SubjectName: &zeroValue}
+	a.GetSubjectName()
+	a = &APIInsightsSubjectStats{}
+	a.GetSubjectName()
+	a = nil
+	a.GetSubjectName()
+}
+
+func TestAPIInsightsSubjectStats_GetSubjectType(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsSubjectStats{SubjectType: &zeroValue}
+	a.GetSubjectType()
+	a = &APIInsightsSubjectStats{}
+	a.GetSubjectType()
+	a = nil
+	a.GetSubjectType()
+}
+
+func TestAPIInsightsSubjectStats_GetTotalRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsSubjectStats{TotalRequestCount: &zeroValue}
+	a.GetTotalRequestCount()
+	a = &APIInsightsSubjectStats{}
+	a.GetTotalRequestCount()
+	a = nil
+	a.GetTotalRequestCount()
+}
+
+func TestAPIInsightsSummaryStats_GetRateLimitedRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsSummaryStats{RateLimitedRequestCount: &zeroValue}
+	a.GetRateLimitedRequestCount()
+	a = &APIInsightsSummaryStats{}
+	a.GetRateLimitedRequestCount()
+	a = nil
+	a.GetRateLimitedRequestCount()
+}
+
+func TestAPIInsightsSummaryStats_GetTotalRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsSummaryStats{TotalRequestCount: &zeroValue}
+	a.GetTotalRequestCount()
+	a = &APIInsightsSummaryStats{}
+	a.GetTotalRequestCount()
+	a = nil
+	a.GetTotalRequestCount()
+}
+
+func TestAPIInsightsTimeStats_GetRateLimitedRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsTimeStats{RateLimitedRequestCount: &zeroValue}
+	a.GetRateLimitedRequestCount()
+	a = &APIInsightsTimeStats{}
+	a.GetRateLimitedRequestCount()
+	a = nil
+	a.GetRateLimitedRequestCount()
+}
+
+func TestAPIInsightsTimeStats_GetTimestamp(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsTimeStats{Timestamp: &zeroValue}
+	a.GetTimestamp()
+	a = &APIInsightsTimeStats{}
+	a.GetTimestamp()
+	a = nil
+	a.GetTimestamp()
+}
+
+func TestAPIInsightsTimeStats_GetTotalRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsTimeStats{TotalRequestCount: &zeroValue}
+	a.GetTotalRequestCount()
+	a = &APIInsightsTimeStats{}
+	a.GetTotalRequestCount()
+	a = nil
+	a.GetTotalRequestCount()
+}
+
+func TestAPIInsightsUserStats_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsUserStats{ActorID: &zeroValue}
+	a.GetActorID()
+	a = &APIInsightsUserStats{}
+	a.GetActorID()
+	a = nil
+	a.GetActorID()
+}
+
+func TestAPIInsightsUserStats_GetActorName(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsUserStats{ActorName: &zeroValue}
+	a.GetActorName()
+	a = &APIInsightsUserStats{}
+	a.GetActorName()
+	a = nil
+	a.GetActorName()
+}
+
+func TestAPIInsightsUserStats_GetActorType(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsUserStats{ActorType: &zeroValue}
+	a.GetActorType()
+	a = &APIInsightsUserStats{}
+	a.GetActorType()
+	a = nil
+	a.GetActorType()
+}
+
+func TestAPIInsightsUserStats_GetIntegrationID(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsUserStats{IntegrationID: &zeroValue}
+	a.GetIntegrationID()
+	a = &APIInsightsUserStats{}
+	a.GetIntegrationID()
+	a = nil
+	a.GetIntegrationID()
+}
+
+func TestAPIInsightsUserStats_GetLastRateLimitedTimestamp(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsUserStats{LastRateLimitedTimestamp: &zeroValue}
+	a.GetLastRateLimitedTimestamp()
+	a = &APIInsightsUserStats{}
+	a.GetLastRateLimitedTimestamp()
+	a = nil
+	a.GetLastRateLimitedTimestamp()
+}
+
+func TestAPIInsightsUserStats_GetLastRequestTimestamp(tt *testing.T) {
+	var zeroValue string
+	a := &APIInsightsUserStats{LastRequestTimestamp: &zeroValue}
+	a.GetLastRequestTimestamp()
+	a = &APIInsightsUserStats{}
+	a.GetLastRequestTimestamp()
+	a = nil
+	a.GetLastRequestTimestamp()
+}
+
+func TestAPIInsightsUserStats_GetOAuthApplicationID(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsUserStats{OAuthApplicationID: &zeroValue}
+	a.GetOAuthApplicationID()
+	a = &APIInsightsUserStats{}
+	a.GetOAuthApplicationID()
+	a = nil
+	a.GetOAuthApplicationID()
+}
+
+func TestAPIInsightsUserStats_GetRateLimitedRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsUserStats{RateLimitedRequestCount: &zeroValue}
+	a.GetRateLimitedRequestCount()
+	a = &APIInsightsUserStats{}
+	a.GetRateLimitedRequestCount()
+	a = nil
+	a.GetRateLimitedRequestCount()
+}
+
+func TestAPIInsightsUserStats_GetTotalRequestCount(tt *testing.T) {
+	var zeroValue int64
+	a := &APIInsightsUserStats{TotalRequestCount: &zeroValue}
+	a.GetTotalRequestCount()
+	a = &APIInsightsUserStats{}
+	a.GetTotalRequestCount()
+	a = nil
+	a.GetTotalRequestCount()
+}
+
+func TestAPIMeta_GetDomains(tt *testing.T) {
+	a := &APIMeta{}
+	a.GetDomains()
+	a = nil
+	a.GetDomains()
+}
+
 func TestAPIMeta_GetSSHKeyFingerprints(tt *testing.T) {
 	zeroValue := map[string]string{}
 	a := &APIMeta{SSHKeyFingerprints: zeroValue}
@@ -946,6 +1434,13 @@ func TestAppConfig_GetPEM(tt *testing.T) {
 	a.GetPEM()
 }
 
+func TestAppConfig_GetPermissions(tt *testing.T) {
+	a := &AppConfig{}
+	a.GetPermissions()
+	a = nil
+	a.GetPermissions()
+}
+
 func TestAppConfig_GetSlug(tt *testing.T) {
 	var zeroValue string
 	a := &AppConfig{Slug: &zeroValue}
@@ -1173,6 +1668,16 @@ func TestAttachment_GetTitle(tt *testing.T) {
 	a.GetTitle()
 }
 
+func TestAttestation_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	a := &Attestation{RepositoryID: &zeroValue}
+	a.GetRepositoryID()
+	a = &Attestation{}
+	a.GetRepositoryID()
+	a = nil
+	a.GetRepositoryID()
+}
+
 func TestAuditEntry_GetAction(tt *testing.T) {
 	var zeroValue string
 	a := &AuditEntry{Action: &zeroValue}
@@ -1727,41 +2232,141 @@ func TestAuditEntry_GetWorkflowRunID(tt *testing.T) {
 	a.GetWorkflowRunID()
 }
 
-func TestAuthorization_GetApp(tt *testing.T) {
-	a := &Authorization{}
-	a.GetApp()
-	a = nil
-	a.GetApp()
-}
-
-func TestAuthorization_GetCreatedAt(tt *testing.T) {
+func TestAuditLogStream_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	a := &Authorization{CreatedAt: &zeroValue}
+	a := &AuditLogStream{CreatedAt: &zeroValue}
 	a.GetCreatedAt()
-	a = &Authorization{}
+	a = &AuditLogStream{}
 	a.GetCreatedAt()
 	a = nil
 	a.GetCreatedAt()
 }
 
-func TestAuthorization_GetFingerprint(tt *testing.T) {
-	var zeroValue string
-	a := &Authorization{Fingerprint: &zeroValue}
-	a.GetFingerprint()
-	a = &Authorization{}
-	a.GetFingerprint()
+func TestAuditLogStream_GetEnabled(tt *testing.T) {
+	var zeroValue bool
+	a := &AuditLogStream{Enabled: &zeroValue}
+	a.GetEnabled()
+	a = &AuditLogStream{}
+	a.GetEnabled()
 	a = nil
-	a.GetFingerprint()
+	a.GetEnabled()
 }
 
-func TestAuthorization_GetHashedToken(tt *testing.T) {
-	var zeroValue string
-	a := &Authorization{HashedToken: &zeroValue}
-	a.GetHashedToken()
-	a = &Authorization{}
-	a.GetHashedToken()
-	a = nil
-	a.GetHashedToken()
+func TestAuditLogStream_GetID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditLogStream{ID: &zeroValue}
+	a.GetID()
+	a = &AuditLogStream{}
+	a.GetID()
+	a = nil
+	a.GetID()
+}
+
+func TestAuditLogStream_GetPausedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &AuditLogStream{PausedAt: &zeroValue}
+	a.GetPausedAt()
+	a = &AuditLogStream{}
+	a.GetPausedAt()
+	a = nil
+	a.GetPausedAt()
+}
+
+func TestAuditLogStream_GetStreamDetails(tt *testing.T) {
+	var zeroValue string
+	a := &AuditLogStream{StreamDetails: &zeroValue}
+	a.GetStreamDetails()
+	a = &AuditLogStream{}
+	a.GetStreamDetails()
+	a = nil
+	a.GetStreamDetails()
+}
+
+func TestAuditLogStream_GetStreamType(tt *testing.T) {
+	var zeroValue string
+	a := &AuditLogStream{StreamType: &zeroValue}
+	a.GetStreamType()
+	a = &AuditLogStream{}
+	a.GetStreamType()
+	a = nil
+	a.GetStreamType()
+}
+
+func TestAuditLogStream_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &AuditLogStream{UpdatedAt: &zeroValue}
+	a.GetUpdatedAt()
+	a = &AuditLogStream{}
+	a.GetUpdatedAt()
+	a = nil
+	a.GetUpdatedAt()
+}
+
+func TestAuditLogStreamConfig_GetEnabled(tt *testing.T) {
+	var zeroValue bool
+	a := &AuditLogStreamConfig{Enabled: &zeroValue}
+	a.GetEnabled()
+	a = &AuditLogStreamConfig{}
+	a.GetEnabled()
+	a = nil
+	a.GetEnabled()
+}
+
+func TestAuditLogStreamConfig_GetStreamType(tt *testing.T) {
+	var zeroValue string
+	a := &AuditLogStreamConfig{StreamType: &zeroValue}
+	a.GetStreamType()
+	a = &AuditLogStreamConfig{}
+	a.GetStreamType()
+	a = nil
+	a.GetStreamType()
+}
+
+func TestAuthorization_GetApp(tt *testing.T) {
+	a := &Authorization{}
+	a.GetApp()
+	a = nil
+	a.GetApp()
+}
+
+func TestAuthorization_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Authorization{CreatedAt: &zeroValue}
+	a.GetCreatedAt()
+	a = &Authorization{}
+	a.GetCreatedAt()
+	a = nil
+	a.GetCreatedAt()
+}
+
+func TestAuthorization_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Authorization{ExpiresAt: &zeroValue}
+	a.GetExpiresAt()
+	a = &Authorization{}
+	a.GetExpiresAt()
+	a = nil
+	a.GetExpiresAt()
+}
+
+func TestAuthorization_GetFingerprint(tt *testing.T) {
+	var zeroValue string
+	a := &Authorization{Fingerprint: &zeroValue}
+	a.GetFingerprint()
+	a = &Authorization{}
+	a.GetFingerprint()
+	a = nil
+	a.GetFingerprint()
+}
+
+func TestAuthorization_GetHashedToken(tt *testing.T) {
+	var zeroValue string
+	a := &Authorization{HashedToken: &zeroValue}
+	a.GetHashedToken()
+	a = &Authorization{}
+	a.GetHashedToken()
+	a = nil
+	a.GetHashedToken()
 }
 
 func TestAuthorization_GetID(tt *testing.T) {
@@ -1774,6 +2379,13 @@ func TestAuthorization_GetID(tt *testing.T) {
 	a.GetID()
 }
 
+func TestAuthorization_GetInstallation(tt *testing.T) {
+	a := &Authorization{}
+	a.GetInstallation()
+	a = nil
+	a.GetInstallation()
+}
+
 func TestAuthorization_GetNote(tt *testing.T) {
 	var zeroValue string
 	a := &Authorization{Note: &zeroValue}
@@ -2061,6 +2673,66 @@ func TestAutoTriggerCheck_GetSetting(tt *testing.T) {
 	a.GetSetting()
 }
 
+func TestAzureBlobStreamConfig_GetContainer(tt *testing.T) {
+	var zeroValue string
+	a := &AzureBlobStreamConfig{Container: &zeroValue}
+	a.GetContainer()
+	a = &AzureBlobStreamConfig{}
+	a.GetContainer()
+	a = nil
+	a.GetContainer()
+}
+
+func TestAzureBlobStreamConfig_GetEncryptedSASURL(tt *testing.T) {
+	var zeroValue string
+	a := &AzureBlobStreamConfig{EncryptedSASURL: &zeroValue}
+	a.GetEncryptedSASURL()
+	a = &AzureBlobStreamConfig{}
+	a.GetEncryptedSASURL()
+	a = nil
+	a.GetEncryptedSASURL()
+}
+
+func TestAzureBlobStreamConfig_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	a := &AzureBlobStreamConfig{KeyID: &zeroValue}
+	a.GetKeyID()
+	a = &AzureBlobStreamConfig{}
+	a.GetKeyID()
+	a = nil
+	a.GetKeyID()
+}
+
+func TestAzureHubStreamConfig_GetEncryptedConnstring(tt *testing.T) {
+	var zeroValue string
+	a := &AzureHubStreamConfig{EncryptedConnstring: &zeroValue}
+	a.GetEncryptedConnstring()
+	a = &AzureHubStreamConfig{}
+	a.GetEncryptedConnstring()
+	a = nil
+	a.GetEncryptedConnstring()
+}
+
+func TestAzureHubStreamConfig_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	a := &AzureHubStreamConfig{KeyID: &zeroValue}
+	a.GetKeyID()
+	a = &AzureHubStreamConfig{}
+	a.GetKeyID()
+	a = nil
+	a.GetKeyID()
+}
+
+func TestAzureHubStreamConfig_GetName(tt *testing.T) {
+	var zeroValue string
+	a := &AzureHubStreamConfig{Name: &zeroValue}
+	a.GetName()
+	a = &AzureHubStreamConfig{}
+	a.GetName()
+	a = nil
+	a.GetName()
+}
+
 func TestBlob_GetContent(tt *testing.T) {
 	var zeroValue string
 	b := &Blob{Content: &zeroValue}
@@ -2487,6 +3159,143 @@ func TestBranchProtectionRuleEvent_GetSender(tt *testing.T) {
 	b.GetSender()
 }
 
+func TestBypassActor_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassActor{ActorID: &zeroValue}
+	b.GetActorID()
+	b = &BypassActor{}
+	b.GetActorID()
+	b = nil
+	b.GetActorID()
+}
+
+func TestBypassActor_GetActorName(tt *testing.T) {
+	var zeroValue string
+	b := &BypassActor{ActorName: &zeroValue}
+	b.GetActorName()
+	b = &BypassActor{}
+	b.GetActorName()
+	b = nil
+	b.GetActorName()
+}
+
+func TestBypassRequestOrganization_GetID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassRequestOrganization{ID: &zeroValue}
+	b.GetID()
+	b = &BypassRequestOrganization{}
+	b.GetID()
+	b = nil
+	b.GetID()
+}
+
+func TestBypassRequestOrganization_GetName(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestOrganization{Name: &zeroValue}
+	b.GetName()
+	b = &BypassRequestOrganization{}
+	b.GetName()
+	b = nil
+	b.GetName()
+}
+
+func TestBypassRequestRepository_GetFullName(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestRepository{FullName: &zeroValue}
+	b.GetFullName()
+	b = &BypassRequestRepository{}
+	b.GetFullName()
+	b = nil
+	b.GetFullName()
+}
+
+func TestBypassRequestRepository_GetID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassRequestRepository{ID: &zeroValue}
+	b.GetID()
+	b = &BypassRequestRepository{}
+	b.GetID()
+	b = nil
+	b.GetID()
+}
+
+func TestBypassRequestRepository_GetName(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestRepository{Name: &zeroValue}
+	b.GetName()
+	b = &BypassRequestRepository{}
+	b.GetName()
+	b = nil
+	b.GetName()
+}
+
+func TestBypassResponse_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	b := &BypassResponse{CreatedAt: &zeroValue}
+	b.GetCreatedAt()
+	b = &BypassResponse{}
+	b.GetCreatedAt()
+	b = nil
+	b.GetCreatedAt()
+}
+
+func TestBypassResponse_GetID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassResponse{ID: &zeroValue}
+	b.GetID()
+	b = &BypassResponse{}
+	b.GetID()
+	b = nil
+	b.GetID()
+}
+
+func TestBypassResponse_GetReviewer(tt *testing.T) {
+	b := &BypassResponse{}
+	b.GetReviewer()
+	b = nil
+	b.GetReviewer()
+}
+
+func TestBypassResponse_GetStatus(tt *testing.T) {
+	var zeroValue string
+	b := &BypassResponse{Status: &zeroValue}
+	b.GetStatus()
+	b = &BypassResponse{}
+	b.GetStatus()
+	b = nil
+	b.GetStatus()
+}
+
+func TestBypassReview_GetBypassReviewID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassReview{BypassReviewID: &zeroValue}
+	b.GetBypassReviewID()
+	b = &BypassReview{}
+	b.GetBypassReviewID()
+	b = nil
+	b.GetBypassReviewID()
+}
+
+func TestBypassReview_GetReviewedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	b := &BypassReview{ReviewedAt: &zeroValue}
+	b.GetReviewedAt()
+	b = &BypassReview{}
+	b.GetReviewedAt()
+	b = nil
+	b.GetReviewedAt()
+}
+
+func TestBypassReview_GetStatus(tt *testing.T) {
+	var zeroValue string
+	b := &BypassReview{Status: &zeroValue}
+	b.GetStatus()
+	b = &BypassReview{}
+	b.GetStatus()
+	b = nil
+	b.GetStatus()
+}
+
 func TestCheckRun_GetApp(tt *testing.T) {
 	c := &CheckRun{}
 	c.GetApp()
@@ -3127,6 +3936,13 @@ func TestCodeResult_GetRepository(tt *testing.T) {
 	c.GetRepository()
 }
 
+func TestCodeResult_GetScore(tt *testing.T) {
+	c := &CodeResult{}
+	c.GetScore()
+	c = nil
+	c.GetScore()
+}
+
 func TestCodeResult_GetSHA(tt *testing.T) {
 	var zeroValue string
 	c := &CodeResult{SHA: &zeroValue}
@@ -3242,18441 +4058,24068 @@ func TestCodeSearchResult_GetTotal(tt *testing.T) {
 	c.GetTotal()
 }
 
-func TestCollaboratorInvitation_GetCreatedAt(tt *testing.T) {
+func TestCodespace_GetBillableOwner(tt *testing.T) {
+	c := &Codespace{}
+	c.GetBillableOwner()
+	c = nil
+	c.GetBillableOwner()
+}
+
+func TestCodespace_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	c := &CollaboratorInvitation{CreatedAt: &zeroValue}
+	c := &Codespace{CreatedAt: &zeroValue}
 	c.GetCreatedAt()
-	c = &CollaboratorInvitation{}
+	c = &Codespace{}
 	c.GetCreatedAt()
 	c = nil
 	c.GetCreatedAt()
 }
 
-func TestCollaboratorInvitation_GetHTMLURL(tt *testing.T) {
+func TestCodespace_GetDevcontainerPath(tt *testing.T) {
 	var zeroValue string
-	c := &CollaboratorInvitation{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CollaboratorInvitation{}
-	c.GetHTMLURL()
+	c := &Codespace{DevcontainerPath: &zeroValue}
+	c.GetDevcontainerPath()
+	c = &Codespace{}
+	c.GetDevcontainerPath()
 	c = nil
-	c.GetHTMLURL()
+	c.GetDevcontainerPath()
 }
 
-func TestCollaboratorInvitation_GetID(tt *testing.T) {
+func TestCodespace_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{DisplayName: &zeroValue}
+	c.GetDisplayName()
+	c = &Codespace{}
+	c.GetDisplayName()
+	c = nil
+	c.GetDisplayName()
+}
+
+func TestCodespace_GetEnvironmentID(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{EnvironmentID: &zeroValue}
+	c.GetEnvironmentID()
+	c = &Codespace{}
+	c.GetEnvironmentID()
+	c = nil
+	c.GetEnvironmentID()
+}
+
+func TestCodespace_GetGitStatus(tt *testing.T) {
+	c := &Codespace{}
+	c.GetGitStatus()
+	c = nil
+	c.GetGitStatus()
+}
+
+func TestCodespace_GetID(tt *testing.T) {
 	var zeroValue int64
-	c := &CollaboratorInvitation{ID: &zeroValue}
+	c := &Codespace{ID: &zeroValue}
 	c.GetID()
-	c = &CollaboratorInvitation{}
+	c = &Codespace{}
 	c.GetID()
 	c = nil
 	c.GetID()
 }
 
-func TestCollaboratorInvitation_GetInvitee(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetInvitee()
+func TestCodespace_GetIdleTimeoutMinutes(tt *testing.T) {
+	var zeroValue int
+	c := &Codespace{IdleTimeoutMinutes: &zeroValue}
+	c.GetIdleTimeoutMinutes()
+	c = &Codespace{}
+	c.GetIdleTimeoutMinutes()
 	c = nil
-	c.GetInvitee()
+	c.GetIdleTimeoutMinutes()
 }
 
-func TestCollaboratorInvitation_GetInviter(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetInviter()
+func TestCodespace_GetIdleTimeoutNotice(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{IdleTimeoutNotice: &zeroValue}
+	c.GetIdleTimeoutNotice()
+	c = &Codespace{}
+	c.GetIdleTimeoutNotice()
 	c = nil
-	c.GetInviter()
+	c.GetIdleTimeoutNotice()
 }
 
-func TestCollaboratorInvitation_GetPermissions(tt *testing.T) {
+func TestCodespace_GetLastKnownStopNotice(tt *testing.T) {
 	var zeroValue string
-	c := &CollaboratorInvitation{Permissions: &zeroValue}
-	c.GetPermissions()
-	c = &CollaboratorInvitation{}
-	c.GetPermissions()
+	c := &Codespace{LastKnownStopNotice: &zeroValue}
+	c.GetLastKnownStopNotice()
+	c = &Codespace{}
+	c.GetLastKnownStopNotice()
 	c = nil
-	c.GetPermissions()
+	c.GetLastKnownStopNotice()
 }
 
-func TestCollaboratorInvitation_GetRepo(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetRepo()
+func TestCodespace_GetLastUsedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &Codespace{LastUsedAt: &zeroValue}
+	c.GetLastUsedAt()
+	c = &Codespace{}
+	c.GetLastUsedAt()
 	c = nil
-	c.GetRepo()
+	c.GetLastUsedAt()
 }
 
-func TestCollaboratorInvitation_GetURL(tt *testing.T) {
+func TestCodespace_GetLocation(tt *testing.T) {
 	var zeroValue string
-	c := &CollaboratorInvitation{URL: &zeroValue}
-	c.GetURL()
-	c = &CollaboratorInvitation{}
-	c.GetURL()
+	c := &Codespace{Location: &zeroValue}
+	c.GetLocation()
+	c = &Codespace{}
+	c.GetLocation()
 	c = nil
-	c.GetURL()
+	c.GetLocation()
 }
 
-func TestCombinedStatus_GetCommitURL(tt *testing.T) {
+func TestCodespace_GetMachine(tt *testing.T) {
+	c := &Codespace{}
+	c.GetMachine()
+	c = nil
+	c.GetMachine()
+}
+
+func TestCodespace_GetMachinesURL(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{CommitURL: &zeroValue}
-	c.GetCommitURL()
-	c = &CombinedStatus{}
-	c.GetCommitURL()
+	c := &Codespace{MachinesURL: &zeroValue}
+	c.GetMachinesURL()
+	c = &Codespace{}
+	c.GetMachinesURL()
 	c = nil
-	c.GetCommitURL()
+	c.GetMachinesURL()
 }
 
-func TestCombinedStatus_GetName(tt *testing.T) {
+func TestCodespace_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{Name: &zeroValue}
+	c := &Codespace{Name: &zeroValue}
 	c.GetName()
-	c = &CombinedStatus{}
+	c = &Codespace{}
 	c.GetName()
 	c = nil
 	c.GetName()
 }
 
-func TestCombinedStatus_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	c := &CombinedStatus{RepositoryURL: &zeroValue}
-	c.GetRepositoryURL()
-	c = &CombinedStatus{}
-	c.GetRepositoryURL()
+func TestCodespace_GetOwner(tt *testing.T) {
+	c := &Codespace{}
+	c.GetOwner()
 	c = nil
-	c.GetRepositoryURL()
+	c.GetOwner()
 }
 
-func TestCombinedStatus_GetSHA(tt *testing.T) {
-	var zeroValue string
-	c := &CombinedStatus{SHA: &zeroValue}
-	c.GetSHA()
-	c = &CombinedStatus{}
-	c.GetSHA()
+func TestCodespace_GetPendingOperation(tt *testing.T) {
+	var zeroValue bool
+	c := &Codespace{PendingOperation: &zeroValue}
+	c.GetPendingOperation()
+	c = &Codespace{}
+	c.GetPendingOperation()
 	c = nil
-	c.GetSHA()
+	c.GetPendingOperation()
 }
 
-func TestCombinedStatus_GetState(tt *testing.T) {
+func TestCodespace_GetPendingOperationDisabledReason(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{State: &zeroValue}
-	c.GetState()
-	c = &CombinedStatus{}
-	c.GetState()
-	c = nil
-	c.GetState()
-}
-
-func TestCombinedStatus_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	c := &CombinedStatus{TotalCount: &zeroValue}
-	c.GetTotalCount()
-	c = &CombinedStatus{}
-	c.GetTotalCount()
+	c := &Codespace{PendingOperationDisabledReason: &zeroValue}
+	c.GetPendingOperationDisabledReason()
+	c = &Codespace{}
+	c.GetPendingOperationDisabledReason()
 	c = nil
-	c.GetTotalCount()
+	c.GetPendingOperationDisabledReason()
 }
 
-func TestComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	c := &Comment{CreatedAt: &zeroValue}
-	c.GetCreatedAt()
-	c = &Comment{}
-	c.GetCreatedAt()
+func TestCodespace_GetPrebuild(tt *testing.T) {
+	var zeroValue bool
+	c := &Codespace{Prebuild: &zeroValue}
+	c.GetPrebuild()
+	c = &Codespace{}
+	c.GetPrebuild()
 	c = nil
-	c.GetCreatedAt()
+	c.GetPrebuild()
 }
 
-func TestCommentDiscussion_GetAuthorAssociation(tt *testing.T) {
+func TestCodespace_GetPublishURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommentDiscussion{AuthorAssociation: &zeroValue}
-	c.GetAuthorAssociation()
-	c = &CommentDiscussion{}
-	c.GetAuthorAssociation()
+	c := &Codespace{PublishURL: &zeroValue}
+	c.GetPublishURL()
+	c = &Codespace{}
+	c.GetPublishURL()
 	c = nil
-	c.GetAuthorAssociation()
+	c.GetPublishURL()
 }
 
-func TestCommentDiscussion_GetBody(tt *testing.T) {
+func TestCodespace_GetPullsURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommentDiscussion{Body: &zeroValue}
-	c.GetBody()
-	c = &CommentDiscussion{}
-	c.GetBody()
+	c := &Codespace{PullsURL: &zeroValue}
+	c.GetPullsURL()
+	c = &Codespace{}
+	c.GetPullsURL()
 	c = nil
-	c.GetBody()
+	c.GetPullsURL()
 }
 
-func TestCommentDiscussion_GetChildCommentCount(tt *testing.T) {
-	var zeroValue int
-	c := &CommentDiscussion{ChildCommentCount: &zeroValue}
-	c.GetChildCommentCount()
-	c = &CommentDiscussion{}
-	c.GetChildCommentCount()
+func TestCodespace_GetRepository(tt *testing.T) {
+	c := &Codespace{}
+	c.GetRepository()
 	c = nil
-	c.GetChildCommentCount()
+	c.GetRepository()
 }
 
-func TestCommentDiscussion_GetCreatedAt(tt *testing.T) {
+func TestCodespace_GetRetentionExpiresAt(tt *testing.T) {
 	var zeroValue Timestamp
-	c := &CommentDiscussion{CreatedAt: &zeroValue}
-	c.GetCreatedAt()
-	c = &CommentDiscussion{}
-	c.GetCreatedAt()
+	c := &Codespace{RetentionExpiresAt: &zeroValue}
+	c.GetRetentionExpiresAt()
+	c = &Codespace{}
+	c.GetRetentionExpiresAt()
 	c = nil
-	c.GetCreatedAt()
-}
-
-func TestCommentDiscussion_GetDiscussionID(tt *testing.T) {
-	var zeroValue int64
-	c := &CommentDiscussion{DiscussionID: &zeroValue}
-	c.GetDiscussionID()
-	c = &CommentDiscussion{}
-	c.GetDiscussionID()
-	c = nil
-	c.GetDiscussionID()
+	c.GetRetentionExpiresAt()
 }
 
-func TestCommentDiscussion_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommentDiscussion{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CommentDiscussion{}
-	c.GetHTMLURL()
+func TestCodespace_GetRetentionPeriodMinutes(tt *testing.T) {
+	var zeroValue int
+	c := &Codespace{RetentionPeriodMinutes: &zeroValue}
+	c.GetRetentionPeriodMinutes()
+	c = &Codespace{}
+	c.GetRetentionPeriodMinutes()
 	c = nil
-	c.GetHTMLURL()
+	c.GetRetentionPeriodMinutes()
 }
 
-func TestCommentDiscussion_GetID(tt *testing.T) {
-	var zeroValue int64
-	c := &CommentDiscussion{ID: &zeroValue}
-	c.GetID()
-	c = &CommentDiscussion{}
-	c.GetID()
+func TestCodespace_GetRuntimeConstraints(tt *testing.T) {
+	c := &Codespace{}
+	c.GetRuntimeConstraints()
 	c = nil
-	c.GetID()
+	c.GetRuntimeConstraints()
 }
 
-func TestCommentDiscussion_GetNodeID(tt *testing.T) {
+func TestCodespace_GetStartURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommentDiscussion{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &CommentDiscussion{}
-	c.GetNodeID()
-	c = nil
-	c.GetNodeID()
-}
-
-func TestCommentDiscussion_GetParentID(tt *testing.T) {
-	var zeroValue int64
-	c := &CommentDiscussion{ParentID: &zeroValue}
-	c.GetParentID()
-	c = &CommentDiscussion{}
-	c.GetParentID()
+	c := &Codespace{StartURL: &zeroValue}
+	c.GetStartURL()
+	c = &Codespace{}
+	c.GetStartURL()
 	c = nil
-	c.GetParentID()
+	c.GetStartURL()
 }
 
-func TestCommentDiscussion_GetReactions(tt *testing.T) {
-	c := &CommentDiscussion{}
-	c.GetReactions()
+func TestCodespace_GetState(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{State: &zeroValue}
+	c.GetState()
+	c = &Codespace{}
+	c.GetState()
 	c = nil
-	c.GetReactions()
+	c.GetState()
 }
 
-func TestCommentDiscussion_GetRepositoryURL(tt *testing.T) {
+func TestCodespace_GetStopURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommentDiscussion{RepositoryURL: &zeroValue}
-	c.GetRepositoryURL()
-	c = &CommentDiscussion{}
-	c.GetRepositoryURL()
+	c := &Codespace{StopURL: &zeroValue}
+	c.GetStopURL()
+	c = &Codespace{}
+	c.GetStopURL()
 	c = nil
-	c.GetRepositoryURL()
+	c.GetStopURL()
 }
 
-func TestCommentDiscussion_GetUpdatedAt(tt *testing.T) {
+func TestCodespace_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	c := &CommentDiscussion{UpdatedAt: &zeroValue}
+	c := &Codespace{UpdatedAt: &zeroValue}
 	c.GetUpdatedAt()
-	c = &CommentDiscussion{}
+	c = &Codespace{}
 	c.GetUpdatedAt()
 	c = nil
 	c.GetUpdatedAt()
 }
 
-func TestCommentDiscussion_GetUser(tt *testing.T) {
-	c := &CommentDiscussion{}
-	c.GetUser()
+func TestCodespace_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{URL: &zeroValue}
+	c.GetURL()
+	c = &Codespace{}
+	c.GetURL()
 	c = nil
-	c.GetUser()
+	c.GetURL()
 }
 
-func TestCommentStats_GetTotalCommitComments(tt *testing.T) {
-	var zeroValue int
-	c := &CommentStats{TotalCommitComments: &zeroValue}
-	c.GetTotalCommitComments()
-	c = &CommentStats{}
-	c.GetTotalCommitComments()
+func TestCodespace_GetWebURL(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{WebURL: &zeroValue}
+	c.GetWebURL()
+	c = &Codespace{}
+	c.GetWebURL()
 	c = nil
-	c.GetTotalCommitComments()
+	c.GetWebURL()
 }
 
-func TestCommentStats_GetTotalGistComments(tt *testing.T) {
-	var zeroValue int
-	c := &CommentStats{TotalGistComments: &zeroValue}
-	c.GetTotalGistComments()
-	c = &CommentStats{}
-	c.GetTotalGistComments()
+func TestCodespaceDefaultAttributes_GetBillableOwner(tt *testing.T) {
+	c := &CodespaceDefaultAttributes{}
+	c.GetBillableOwner()
 	c = nil
-	c.GetTotalGistComments()
+	c.GetBillableOwner()
 }
 
-func TestCommentStats_GetTotalIssueComments(tt *testing.T) {
-	var zeroValue int
-	c := &CommentStats{TotalIssueComments: &zeroValue}
-	c.GetTotalIssueComments()
-	c = &CommentStats{}
-	c.GetTotalIssueComments()
+func TestCodespaceDefaultAttributes_GetDefaults(tt *testing.T) {
+	c := &CodespaceDefaultAttributes{}
+	c.GetDefaults()
 	c = nil
-	c.GetTotalIssueComments()
+	c.GetDefaults()
 }
 
-func TestCommentStats_GetTotalPullRequestComments(tt *testing.T) {
-	var zeroValue int
-	c := &CommentStats{TotalPullRequestComments: &zeroValue}
-	c.GetTotalPullRequestComments()
-	c = &CommentStats{}
-	c.GetTotalPullRequestComments()
+func TestCodespaceDefaults_GetDevcontainerPath(tt *testing.T) {
+	var zeroValue string
+	c := &CodespaceDefaults{DevcontainerPath: &zeroValue}
+	c.GetDevcontainerPath()
+	c = &CodespaceDefaults{}
+	c.GetDevcontainerPath()
 	c = nil
-	c.GetTotalPullRequestComments()
+	c.GetDevcontainerPath()
 }
 
-func TestCommit_GetAuthor(tt *testing.T) {
-	c := &Commit{}
-	c.GetAuthor()
+func TestCodespaceDefaults_GetLocation(tt *testing.T) {
+	var zeroValue string
+	c := &CodespaceDefaults{Location: &zeroValue}
+	c.GetLocation()
+	c = &CodespaceDefaults{}
+	c.GetLocation()
 	c = nil
-	c.GetAuthor()
+	c.GetLocation()
 }
 
-func TestCommit_GetCommentCount(tt *testing.T) {
-	var zeroValue int
-	c := &Commit{CommentCount: &zeroValue}
-	c.GetCommentCount()
-	c = &Commit{}
-	c.GetCommentCount()
+func TestCodespaceExport_GetBranch(tt *testing.T) {
+	var zeroValue string
+	c := &CodespaceExport{Branch: &zeroValue}
+	c.GetBranch()
+	c = &CodespaceExport{}
+	c.GetBranch()
 	c = nil
-	c.GetCommentCount()
+	c.GetBranch()
 }
 
-func TestCommit_GetCommitter(tt *testing.T) {
-	c := &Commit{}
-	c.GetCommitter()
+func TestCodespaceExport_GetCompletedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CodespaceExport{CompletedAt: &zeroValue}
+	c.GetCompletedAt()
+	c = &CodespaceExport{}
+	c.GetCompletedAt()
 	c = nil
-	c.GetCommitter()
+	c.GetCompletedAt()
 }
 
-func TestCommit_GetHTMLURL(tt *testing.T) {
+func TestCodespaceExport_GetExportURL(tt *testing.T) {
 	var zeroValue string
-	c := &Commit{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &Commit{}
-	c.GetHTMLURL()
+	c := &CodespaceExport{ExportURL: &zeroValue}
+	c.GetExportURL()
+	c = &CodespaceExport{}
+	c.GetExportURL()
 	c = nil
-	c.GetHTMLURL()
+	c.GetExportURL()
 }
 
-func TestCommit_GetMessage(tt *testing.T) {
+func TestCodespaceExport_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	c := &Commit{Message: &zeroValue}
-	c.GetMessage()
-	c = &Commit{}
-	c.GetMessage()
+	c := &CodespaceExport{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CodespaceExport{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetMessage()
+	c.GetHTMLURL()
 }
 
-func TestCommit_GetNodeID(tt *testing.T) {
+func TestCodespaceExport_GetID(tt *testing.T) {
 	var zeroValue string
-	c := &Commit{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &Commit{}
-	c.GetNodeID()
+	c := &CodespaceExport{ID: &zeroValue}
+	c.GetID()
+	c = &CodespaceExport{}
+	c.GetID()
 	c = nil
-	c.GetNodeID()
+	c.GetID()
 }
 
-func TestCommit_GetSHA(tt *testing.T) {
+func TestCodespaceExport_GetSHA(tt *testing.T) {
 	var zeroValue string
-	c := &Commit{SHA: &zeroValue}
+	c := &CodespaceExport{SHA: &zeroValue}
 	c.GetSHA()
-	c = &Commit{}
+	c = &CodespaceExport{}
 	c.GetSHA()
 	c = nil
 	c.GetSHA()
 }
 
-func TestCommit_GetStats(tt *testing.T) {
-	c := &Commit{}
-	c.GetStats()
+func TestCodespaceExport_GetState(tt *testing.T) {
+	var zeroValue string
+	c := &CodespaceExport{State: &zeroValue}
+	c.GetState()
+	c = &CodespaceExport{}
+	c.GetState()
 	c = nil
-	c.GetStats()
+	c.GetState()
 }
 
-func TestCommit_GetTree(tt *testing.T) {
-	c := &Commit{}
-	c.GetTree()
+func TestCodespacesDevcontainer_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesDevcontainer{DisplayName: &zeroValue}
+	c.GetDisplayName()
+	c = &CodespacesDevcontainer{}
+	c.GetDisplayName()
 	c = nil
-	c.GetTree()
+	c.GetDisplayName()
 }
 
-func TestCommit_GetURL(tt *testing.T) {
+func TestCodespacesDevcontainer_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &Commit{URL: &zeroValue}
-	c.GetURL()
-	c = &Commit{}
-	c.GetURL()
+	c := &CodespacesDevcontainer{Name: &zeroValue}
+	c.GetName()
+	c = &CodespacesDevcontainer{}
+	c.GetName()
 	c = nil
-	c.GetURL()
+	c.GetName()
 }
 
-func TestCommit_GetVerification(tt *testing.T) {
-	c := &Commit{}
-	c.GetVerification()
+func TestCodespacesDevcontainer_GetPath(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesDevcontainer{Path: &zeroValue}
+	c.GetPath()
+	c = &CodespacesDevcontainer{}
+	c.GetPath()
 	c = nil
-	c.GetVerification()
+	c.GetPath()
 }
 
-func TestCommitAuthor_GetDate(tt *testing.T) {
-	var zeroValue Timestamp
-	c := &CommitAuthor{Date: &zeroValue}
-	c.GetDate()
-	c = &CommitAuthor{}
-	c.GetDate()
+func TestCodespacesGitStatus_GetAhead(tt *testing.T) {
+	var zeroValue int
+	c := &CodespacesGitStatus{Ahead: &zeroValue}
+	c.GetAhead()
+	c = &CodespacesGitStatus{}
+	c.GetAhead()
 	c = nil
-	c.GetDate()
+	c.GetAhead()
 }
 
-func TestCommitAuthor_GetEmail(tt *testing.T) {
-	var zeroValue string
-	c := &CommitAuthor{Email: &zeroValue}
-	c.GetEmail()
-	c = &CommitAuthor{}
-	c.GetEmail()
+func TestCodespacesGitStatus_GetBehind(tt *testing.T) {
+	var zeroValue int
+	c := &CodespacesGitStatus{Behind: &zeroValue}
+	c.GetBehind()
+	c = &CodespacesGitStatus{}
+	c.GetBehind()
 	c = nil
-	c.GetEmail()
+	c.GetBehind()
 }
 
-func TestCommitAuthor_GetLogin(tt *testing.T) {
+func TestCodespacesGitStatus_GetHasUncommittedChanges(tt *testing.T) {
+	var zeroValue bool
+	c := &CodespacesGitStatus{HasUncommittedChanges: &zeroValue}
+	c.GetHasUncommittedChanges()
+	c = &CodespacesGitStatus{}
+	c.GetHasUncommittedChanges()
+	c = nil
+	c.GetHasUncommittedChanges()
+}
+
+func TestCodespacesGitStatus_GetHasUnpushedChanges(tt *testing.T) {
+	var zeroValue bool
+	c := &CodespacesGitStatus{HasUnpushedChanges: &zeroValue}
+	c.GetHasUnpushedChanges()
+	c = &CodespacesGitStatus{}
+	c.GetHasUnpushedChanges()
+	c = nil
+	c.GetHasUnpushedChanges()
+}
+
+func TestCodespacesGitStatus_GetRef(tt *testing.T) {
 	var zeroValue string
-	c := &CommitAuthor{Login: &zeroValue}
-	c.GetLogin()
-	c = &CommitAuthor{}
-	c.GetLogin()
+	c := &CodespacesGitStatus{Ref: &zeroValue}
+	c.GetRef()
+	c = &CodespacesGitStatus{}
+	c.GetRef()
 	c = nil
-	c.GetLogin()
+	c.GetRef()
 }
 
-func TestCommitAuthor_GetName(tt *testing.T) {
+func TestCodespacesMachine_GetCPUs(tt *testing.T) {
+	var zeroValue int
+	c := &CodespacesMachine{CPUs: &zeroValue}
+	c.GetCPUs()
+	c = &CodespacesMachine{}
+	c.GetCPUs()
+	c = nil
+	c.GetCPUs()
+}
+
+func TestCodespacesMachine_GetDisplayName(tt *testing.T) {
 	var zeroValue string
-	c := &CommitAuthor{Name: &zeroValue}
+	c := &CodespacesMachine{DisplayName: &zeroValue}
+	c.GetDisplayName()
+	c = &CodespacesMachine{}
+	c.GetDisplayName()
+	c = nil
+	c.GetDisplayName()
+}
+
+func TestCodespacesMachine_GetMemoryInBytes(tt *testing.T) {
+	var zeroValue int64
+	c := &CodespacesMachine{MemoryInBytes: &zeroValue}
+	c.GetMemoryInBytes()
+	c = &CodespacesMachine{}
+	c.GetMemoryInBytes()
+	c = nil
+	c.GetMemoryInBytes()
+}
+
+func TestCodespacesMachine_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesMachine{Name: &zeroValue}
 	c.GetName()
-	c = &CommitAuthor{}
+	c = &CodespacesMachine{}
 	c.GetName()
 	c = nil
 	c.GetName()
 }
 
-func TestCommitCommentEvent_GetAction(tt *testing.T) {
+func TestCodespacesMachine_GetOperatingSystem(tt *testing.T) {
 	var zeroValue string
-	c := &CommitCommentEvent{Action: &zeroValue}
-	c.GetAction()
-	c = &CommitCommentEvent{}
-	c.GetAction()
+	c := &CodespacesMachine{OperatingSystem: &zeroValue}
+	c.GetOperatingSystem()
+	c = &CodespacesMachine{}
+	c.GetOperatingSystem()
 	c = nil
-	c.GetAction()
+	c.GetOperatingSystem()
 }
 
-func TestCommitCommentEvent_GetComment(tt *testing.T) {
-	c := &CommitCommentEvent{}
-	c.GetComment()
+func TestCodespacesMachine_GetPrebuildAvailability(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesMachine{PrebuildAvailability: &zeroValue}
+	c.GetPrebuildAvailability()
+	c = &CodespacesMachine{}
+	c.GetPrebuildAvailability()
 	c = nil
-	c.GetComment()
+	c.GetPrebuildAvailability()
 }
 
-func TestCommitCommentEvent_GetInstallation(tt *testing.T) {
-	c := &CommitCommentEvent{}
-	c.GetInstallation()
+func TestCodespacesMachine_GetStorageInBytes(tt *testing.T) {
+	var zeroValue int64
+	c := &CodespacesMachine{StorageInBytes: &zeroValue}
+	c.GetStorageInBytes()
+	c = &CodespacesMachine{}
+	c.GetStorageInBytes()
 	c = nil
-	c.GetInstallation()
+	c.GetStorageInBytes()
 }
 
-func TestCommitCommentEvent_GetRepo(tt *testing.T) {
-	c := &CommitCommentEvent{}
-	c.GetRepo()
+func TestCollaboratorInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CollaboratorInvitation{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CollaboratorInvitation{}
+	c.GetCreatedAt()
 	c = nil
-	c.GetRepo()
+	c.GetCreatedAt()
 }
 
-func TestCommitCommentEvent_GetSender(tt *testing.T) {
-	c := &CommitCommentEvent{}
-	c.GetSender()
+func TestCollaboratorInvitation_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	c := &CollaboratorInvitation{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CollaboratorInvitation{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetSender()
+	c.GetHTMLURL()
 }
 
-func TestCommitFile_GetAdditions(tt *testing.T) {
-	var zeroValue int
-	c := &CommitFile{Additions: &zeroValue}
-	c.GetAdditions()
-	c = &CommitFile{}
-	c.GetAdditions()
+func TestCollaboratorInvitation_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CollaboratorInvitation{ID: &zeroValue}
+	c.GetID()
+	c = &CollaboratorInvitation{}
+	c.GetID()
 	c = nil
-	c.GetAdditions()
+	c.GetID()
 }
 
-func TestCommitFile_GetBlobURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitFile{BlobURL: &zeroValue}
-	c.GetBlobURL()
-	c = &CommitFile{}
-	c.GetBlobURL()
+func TestCollaboratorInvitation_GetInvitee(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetInvitee()
 	c = nil
-	c.GetBlobURL()
+	c.GetInvitee()
 }
 
-func TestCommitFile_GetChanges(tt *testing.T) {
-	var zeroValue int
-	c := &CommitFile{Changes: &zeroValue}
-	c.GetChanges()
-	c = &CommitFile{}
-	c.GetChanges()
+func TestCollaboratorInvitation_GetInviter(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetInviter()
 	c = nil
-	c.GetChanges()
+	c.GetInviter()
 }
 
-func TestCommitFile_GetContentsURL(tt *testing.T) {
+func TestCollaboratorInvitation_GetPermissions(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{ContentsURL: &zeroValue}
-	c.GetContentsURL()
-	c = &CommitFile{}
-	c.GetContentsURL()
+	c := &CollaboratorInvitation{Permissions: &zeroValue}
+	c.GetPermissions()
+	c = &CollaboratorInvitation{}
+	c.GetPermissions()
 	c = nil
-	c.GetContentsURL()
+	c.GetPermissions()
 }
 
-func TestCommitFile_GetDeletions(tt *testing.T) {
-	var zeroValue int
-	c := &CommitFile{Deletions: &zeroValue}
-	c.GetDeletions()
-	c = &CommitFile{}
-	c.GetDeletions()
+func TestCollaboratorInvitation_GetRepo(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetRepo()
 	c = nil
-	c.GetDeletions()
+	c.GetRepo()
 }
 
-func TestCommitFile_GetFilename(tt *testing.T) {
+func TestCollaboratorInvitation_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{Filename: &zeroValue}
-	c.GetFilename()
-	c = &CommitFile{}
-	c.GetFilename()
+	c := &CollaboratorInvitation{URL: &zeroValue}
+	c.GetURL()
+	c = &CollaboratorInvitation{}
+	c.GetURL()
 	c = nil
-	c.GetFilename()
+	c.GetURL()
 }
 
-func TestCommitFile_GetPatch(tt *testing.T) {
+func TestCombinedStatus_GetCommitURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{Patch: &zeroValue}
-	c.GetPatch()
-	c = &CommitFile{}
-	c.GetPatch()
+	c := &CombinedStatus{CommitURL: &zeroValue}
+	c.GetCommitURL()
+	c = &CombinedStatus{}
+	c.GetCommitURL()
 	c = nil
-	c.GetPatch()
+	c.GetCommitURL()
 }
 
-func TestCommitFile_GetPreviousFilename(tt *testing.T) {
+func TestCombinedStatus_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{PreviousFilename: &zeroValue}
-	c.GetPreviousFilename()
-	c = &CommitFile{}
-	c.GetPreviousFilename()
+	c := &CombinedStatus{Name: &zeroValue}
+	c.GetName()
+	c = &CombinedStatus{}
+	c.GetName()
 	c = nil
-	c.GetPreviousFilename()
+	c.GetName()
 }
 
-func TestCommitFile_GetRawURL(tt *testing.T) {
+func TestCombinedStatus_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{RawURL: &zeroValue}
-	c.GetRawURL()
-	c = &CommitFile{}
-	c.GetRawURL()
+	c := &CombinedStatus{RepositoryURL: &zeroValue}
+	c.GetRepositoryURL()
+	c = &CombinedStatus{}
+	c.GetRepositoryURL()
 	c = nil
-	c.GetRawURL()
+	c.GetRepositoryURL()
 }
 
-func TestCommitFile_GetSHA(tt *testing.T) {
+func TestCombinedStatus_GetSHA(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{SHA: &zeroValue}
+	c := &CombinedStatus{SHA: &zeroValue}
 	c.GetSHA()
-	c = &CommitFile{}
+	c = &CombinedStatus{}
 	c.GetSHA()
 	c = nil
 	c.GetSHA()
 }
 
-func TestCommitFile_GetStatus(tt *testing.T) {
+func TestCombinedStatus_GetState(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{Status: &zeroValue}
-	c.GetStatus()
-	c = &CommitFile{}
-	c.GetStatus()
+	c := &CombinedStatus{State: &zeroValue}
+	c.GetState()
+	c = &CombinedStatus{}
+	c.GetState()
 	c = nil
-	c.GetStatus()
+	c.GetState()
 }
 
-func TestCommitResult_GetAuthor(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetAuthor()
+func TestCombinedStatus_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	c := &CombinedStatus{TotalCount: &zeroValue}
+	c.GetTotalCount()
+	c = &CombinedStatus{}
+	c.GetTotalCount()
 	c = nil
-	c.GetAuthor()
+	c.GetTotalCount()
 }
 
-func TestCommitResult_GetCommentsURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitResult{CommentsURL: &zeroValue}
-	c.GetCommentsURL()
-	c = &CommitResult{}
-	c.GetCommentsURL()
+func TestComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &Comment{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &Comment{}
+	c.GetCreatedAt()
 	c = nil
-	c.GetCommentsURL()
+	c.GetCreatedAt()
 }
 
-func TestCommitResult_GetCommit(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetCommit()
+func TestCommentDiscussion_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	c := &CommentDiscussion{AuthorAssociation: &zeroValue}
+	c.GetAuthorAssociation()
+	c = &CommentDiscussion{}
+	c.GetAuthorAssociation()
 	c = nil
-	c.GetCommit()
+	c.GetAuthorAssociation()
 }
 
-func TestCommitResult_GetCommitter(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetCommitter()
+func TestCommentDiscussion_GetBody(tt *testing.T) {
+	var zeroValue string
+	c := &CommentDiscussion{Body: &zeroValue}
+	c.GetBody()
+	c = &CommentDiscussion{}
+	c.GetBody()
 	c = nil
-	c.GetCommitter()
+	c.GetBody()
 }
 
-func TestCommitResult_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitResult{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CommitResult{}
-	c.GetHTMLURL()
+func TestCommentDiscussion_GetChildCommentCount(tt *testing.T) {
+	var zeroValue int
+	c := &CommentDiscussion{ChildCommentCount: &zeroValue}
+	c.GetChildCommentCount()
+	c = &CommentDiscussion{}
+	c.GetChildCommentCount()
 	c = nil
-	c.GetHTMLURL()
+	c.GetChildCommentCount()
 }
 
-func TestCommitResult_GetRepository(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetRepository()
-	c = nil
-	c.GetRepository()
-}
-
-func TestCommitResult_GetScore(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetScore()
+func TestCommentDiscussion_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CommentDiscussion{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CommentDiscussion{}
+	c.GetCreatedAt()
 	c = nil
-	c.GetScore()
+	c.GetCreatedAt()
 }
 
-func TestCommitResult_GetSHA(tt *testing.T) {
-	var zeroValue string
-	c := &CommitResult{SHA: &zeroValue}
-	c.GetSHA()
-	c = &CommitResult{}
-	c.GetSHA()
+func TestCommentDiscussion_GetDiscussionID(tt *testing.T) {
+	var zeroValue int64
+	c := &CommentDiscussion{DiscussionID: &zeroValue}
+	c.GetDiscussionID()
+	c = &CommentDiscussion{}
+	c.GetDiscussionID()
 	c = nil
-	c.GetSHA()
+	c.GetDiscussionID()
 }
 
-func TestCommitResult_GetURL(tt *testing.T) {
+func TestCommentDiscussion_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommitResult{URL: &zeroValue}
-	c.GetURL()
-	c = &CommitResult{}
-	c.GetURL()
+	c := &CommentDiscussion{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CommentDiscussion{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetURL()
+	c.GetHTMLURL()
 }
 
-func TestCommitsComparison_GetAheadBy(tt *testing.T) {
-	var zeroValue int
-	c := &CommitsComparison{AheadBy: &zeroValue}
-	c.GetAheadBy()
-	c = &CommitsComparison{}
-	c.GetAheadBy()
+func TestCommentDiscussion_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CommentDiscussion{ID: &zeroValue}
+	c.GetID()
+	c = &CommentDiscussion{}
+	c.GetID()
 	c = nil
-	c.GetAheadBy()
+	c.GetID()
 }
 
-func TestCommitsComparison_GetBaseCommit(tt *testing.T) {
-	c := &CommitsComparison{}
-	c.GetBaseCommit()
+func TestCommentDiscussion_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &CommentDiscussion{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &CommentDiscussion{}
+	c.GetNodeID()
 	c = nil
-	c.GetBaseCommit()
+	c.GetNodeID()
 }
 
-func TestCommitsComparison_GetBehindBy(tt *testing.T) {
-	var zeroValue int
-	c := &CommitsComparison{BehindBy: &zeroValue}
-	c.GetBehindBy()
-	c = &CommitsComparison{}
-	c.GetBehindBy()
+func TestCommentDiscussion_GetParentID(tt *testing.T) {
+	var zeroValue int64
+	c := &CommentDiscussion{ParentID: &zeroValue}
+	c.GetParentID()
+	c = &CommentDiscussion{}
+	c.GetParentID()
 	c = nil
-	c.GetBehindBy()
+	c.GetParentID()
 }
 
-func TestCommitsComparison_GetDiffURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitsComparison{DiffURL: &zeroValue}
-	c.GetDiffURL()
-	c = &CommitsComparison{}
-	c.GetDiffURL()
+func TestCommentDiscussion_GetReactions(tt *testing.T) {
+	c := &CommentDiscussion{}
+	c.GetReactions()
 	c = nil
-	c.GetDiffURL()
+	c.GetReactions()
 }
 
-func TestCommitsComparison_GetHTMLURL(tt *testing.T) {
+func TestCommentDiscussion_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommitsComparison{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CommitsComparison{}
-	c.GetHTMLURL()
+	c := &CommentDiscussion{RepositoryURL: &zeroValue}
+	c.GetRepositoryURL()
+	c = &CommentDiscussion{}
+	c.GetRepositoryURL()
 	c = nil
-	c.GetHTMLURL()
+	c.GetRepositoryURL()
 }
 
-func TestCommitsComparison_GetMergeBaseCommit(tt *testing.T) {
-	c := &CommitsComparison{}
-	c.GetMergeBaseCommit()
+func TestCommentDiscussion_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CommentDiscussion{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CommentDiscussion{}
+	c.GetUpdatedAt()
 	c = nil
-	c.GetMergeBaseCommit()
+	c.GetUpdatedAt()
 }
 
-func TestCommitsComparison_GetPatchURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitsComparison{PatchURL: &zeroValue}
-	c.GetPatchURL()
-	c = &CommitsComparison{}
-	c.GetPatchURL()
+func TestCommentDiscussion_GetUser(tt *testing.T) {
+	c := &CommentDiscussion{}
+	c.GetUser()
 	c = nil
-	c.GetPatchURL()
+	c.GetUser()
 }
 
-func TestCommitsComparison_GetPermalinkURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitsComparison{PermalinkURL: &zeroValue}
-	c.GetPermalinkURL()
-	c = &CommitsComparison{}
-	c.GetPermalinkURL()
+func TestCommentStats_GetTotalCommitComments(tt *testing.T) {
+	var zeroValue int
+	c := &CommentStats{TotalCommitComments: &zeroValue}
+	c.GetTotalCommitComments()
+	c = &CommentStats{}
+	c.GetTotalCommitComments()
 	c = nil
-	c.GetPermalinkURL()
+	c.GetTotalCommitComments()
 }
 
-func TestCommitsComparison_GetStatus(tt *testing.T) {
-	var zeroValue string
-	c := &CommitsComparison{Status: &zeroValue}
-	c.GetStatus()
-	c = &CommitsComparison{}
-	c.GetStatus()
+func TestCommentStats_GetTotalGistComments(tt *testing.T) {
+	var zeroValue int
+	c := &CommentStats{TotalGistComments: &zeroValue}
+	c.GetTotalGistComments()
+	c = &CommentStats{}
+	c.GetTotalGistComments()
 	c = nil
-	c.GetStatus()
+	c.GetTotalGistComments()
 }
 
-func TestCommitsComparison_GetTotalCommits(tt *testing.T) {
+func TestCommentStats_GetTotalIssueComments(tt *testing.T) {
 	var zeroValue int
-	c := &CommitsComparison{TotalCommits: &zeroValue}
-	c.GetTotalCommits()
-	c = &CommitsComparison{}
-	c.GetTotalCommits()
+	c := &CommentStats{TotalIssueComments: &zeroValue}
+	c.GetTotalIssueComments()
+	c = &CommentStats{}
+	c.GetTotalIssueComments()
 	c = nil
-	c.GetTotalCommits()
+	c.GetTotalIssueComments()
 }
 
-func TestCommitsComparison_GetURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitsComparison{URL: &zeroValue}
-	c.GetURL()
-	c = &CommitsComparison{}
-	c.GetURL()
+func TestCommentStats_GetTotalPullRequestComments(tt *testing.T) {
+	var zeroValue int
+	c := &CommentStats{TotalPullRequestComments: &zeroValue}
+	c.GetTotalPullRequestComments()
+	c = &CommentStats{}
+	c.GetTotalPullRequestComments()
 	c = nil
-	c.GetURL()
+	c.GetTotalPullRequestComments()
 }
 
-func TestCommitsSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	c := &CommitsSearchResult{IncompleteResults: &zeroValue}
-	c.GetIncompleteResults()
-	c = &CommitsSearchResult{}
-	c.GetIncompleteResults()
+func TestCommit_GetAuthor(tt *testing.T) {
+	c := &Commit{}
+	c.GetAuthor()
 	c = nil
-	c.GetIncompleteResults()
+	c.GetAuthor()
 }
 
-func TestCommitsSearchResult_GetTotal(tt *testing.T) {
+func TestCommit_GetCommentCount(tt *testing.T) {
 	var zeroValue int
-	c := &CommitsSearchResult{Total: &zeroValue}
-	c.GetTotal()
-	c = &CommitsSearchResult{}
-	c.GetTotal()
+	c := &Commit{CommentCount: &zeroValue}
+	c.GetCommentCount()
+	c = &Commit{}
+	c.GetCommentCount()
 	c = nil
-	c.GetTotal()
+	c.GetCommentCount()
 }
 
-func TestCommitStats_GetAdditions(tt *testing.T) {
-	var zeroValue int
-	c := &CommitStats{Additions: &zeroValue}
-	c.GetAdditions()
-	c = &CommitStats{}
-	c.GetAdditions()
+func TestCommit_GetCommitter(tt *testing.T) {
+	c := &Commit{}
+	c.GetCommitter()
 	c = nil
-	c.GetAdditions()
+	c.GetCommitter()
 }
 
-func TestCommitStats_GetDeletions(tt *testing.T) {
-	var zeroValue int
-	c := &CommitStats{Deletions: &zeroValue}
-	c.GetDeletions()
-	c = &CommitStats{}
-	c.GetDeletions()
+func TestCommit_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	c := &Commit{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &Commit{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetDeletions()
+	c.GetHTMLURL()
 }
 
-func TestCommitStats_GetTotal(tt *testing.T) {
-	var zeroValue int
-	c := &CommitStats{Total: &zeroValue}
-	c.GetTotal()
-	c = &CommitStats{}
-	c.GetTotal()
+func TestCommit_GetMessage(tt *testing.T) {
+	var zeroValue string
+	c := &Commit{Message: &zeroValue}
+	c.GetMessage()
+	c = &Commit{}
+	c.GetMessage()
 	c = nil
-	c.GetTotal()
+	c.GetMessage()
 }
 
-func TestCommunityHealthFiles_GetCodeOfConduct(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetCodeOfConduct()
+func TestCommit_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &Commit{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &Commit{}
+	c.GetNodeID()
 	c = nil
-	c.GetCodeOfConduct()
+	c.GetNodeID()
 }
 
-func TestCommunityHealthFiles_GetCodeOfConductFile(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetCodeOfConductFile()
-	c = nil
-	c.GetCodeOfConductFile()
-}
-
-func TestCommunityHealthFiles_GetContributing(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetContributing()
-	c = nil
-	c.GetContributing()
-}
-
-func TestCommunityHealthFiles_GetIssueTemplate(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetIssueTemplate()
-	c = nil
-	c.GetIssueTemplate()
-}
-
-func TestCommunityHealthFiles_GetLicense(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetLicense()
-	c = nil
-	c.GetLicense()
-}
-
-func TestCommunityHealthFiles_GetPullRequestTemplate(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetPullRequestTemplate()
-	c = nil
-	c.GetPullRequestTemplate()
-}
-
-func TestCommunityHealthFiles_GetReadme(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetReadme()
+func TestCommit_GetSHA(tt *testing.T) {
+	var zeroValue string
+	c := &Commit{SHA: &zeroValue}
+	c.GetSHA()
+	c = &Commit{}
+	c.GetSHA()
 	c = nil
-	c.GetReadme()
+	c.GetSHA()
 }
 
-func TestCommunityHealthMetrics_GetContentReportsEnabled(tt *testing.T) {
-	var zeroValue bool
-	c := &CommunityHealthMetrics{ContentReportsEnabled: &zeroValue}
-	c.GetContentReportsEnabled()
-	c = &CommunityHealthMetrics{}
-	c.GetContentReportsEnabled()
+func TestCommit_GetStats(tt *testing.T) {
+	c := &Commit{}
+	c.GetStats()
 	c = nil
-	c.GetContentReportsEnabled()
+	c.GetStats()
 }
 
-func TestCommunityHealthMetrics_GetDescription(tt *testing.T) {
-	var zeroValue string
-	c := &CommunityHealthMetrics{Description: &zeroValue}
-	c.GetDescription()
-	c = &CommunityHealthMetrics{}
-	c.GetDescription()
+func TestCommit_GetTree(tt *testing.T) {
+	c := &Commit{}
+	c.GetTree()
 	c = nil
-	c.GetDescription()
+	c.GetTree()
 }
 
-func TestCommunityHealthMetrics_GetDocumentation(tt *testing.T) {
+func TestCommit_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommunityHealthMetrics{Documentation: &zeroValue}
-	c.GetDocumentation()
-	c = &CommunityHealthMetrics{}
-	c.GetDocumentation()
-	c = nil
-	c.GetDocumentation()
-}
-
-func TestCommunityHealthMetrics_GetFiles(tt *testing.T) {
-	c := &CommunityHealthMetrics{}
-	c.GetFiles()
+	c := &Commit{URL: &zeroValue}
+	c.GetURL()
+	c = &Commit{}
+	c.GetURL()
 	c = nil
-	c.GetFiles()
+	c.GetURL()
 }
 
-func TestCommunityHealthMetrics_GetHealthPercentage(tt *testing.T) {
-	var zeroValue int
-	c := &CommunityHealthMetrics{HealthPercentage: &zeroValue}
-	c.GetHealthPercentage()
-	c = &CommunityHealthMetrics{}
-	c.GetHealthPercentage()
+func TestCommit_GetVerification(tt *testing.T) {
+	c := &Commit{}
+	c.GetVerification()
 	c = nil
-	c.GetHealthPercentage()
+	c.GetVerification()
 }
 
-func TestCommunityHealthMetrics_GetUpdatedAt(tt *testing.T) {
+func TestCommitAuthor_GetDate(tt *testing.T) {
 	var zeroValue Timestamp
-	c := &CommunityHealthMetrics{UpdatedAt: &zeroValue}
-	c.GetUpdatedAt()
-	c = &CommunityHealthMetrics{}
-	c.GetUpdatedAt()
+	c := &CommitAuthor{Date: &zeroValue}
+	c.GetDate()
+	c = &CommitAuthor{}
+	c.GetDate()
 	c = nil
-	c.GetUpdatedAt()
+	c.GetDate()
 }
 
-func TestContentReference_GetID(tt *testing.T) {
-	var zeroValue int64
-	c := &ContentReference{ID: &zeroValue}
-	c.GetID()
-	c = &ContentReference{}
-	c.GetID()
+func TestCommitAuthor_GetEmail(tt *testing.T) {
+	var zeroValue string
+	c := &CommitAuthor{Email: &zeroValue}
+	c.GetEmail()
+	c = &CommitAuthor{}
+	c.GetEmail()
 	c = nil
-	c.GetID()
+	c.GetEmail()
 }
 
-func TestContentReference_GetNodeID(tt *testing.T) {
+func TestCommitAuthor_GetLogin(tt *testing.T) {
 	var zeroValue string
-	c := &ContentReference{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &ContentReference{}
-	c.GetNodeID()
+	c := &CommitAuthor{Login: &zeroValue}
+	c.GetLogin()
+	c = &CommitAuthor{}
+	c.GetLogin()
 	c = nil
-	c.GetNodeID()
+	c.GetLogin()
 }
 
-func TestContentReference_GetReference(tt *testing.T) {
+func TestCommitAuthor_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &ContentReference{Reference: &zeroValue}
-	c.GetReference()
-	c = &ContentReference{}
-	c.GetReference()
+	c := &CommitAuthor{Name: &zeroValue}
+	c.GetName()
+	c = &CommitAuthor{}
+	c.GetName()
 	c = nil
-	c.GetReference()
+	c.GetName()
 }
 
-func TestContentReferenceEvent_GetAction(tt *testing.T) {
+func TestCommitCommentEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	c := &ContentReferenceEvent{Action: &zeroValue}
+	c := &CommitCommentEvent{Action: &zeroValue}
 	c.GetAction()
-	c = &ContentReferenceEvent{}
+	c = &CommitCommentEvent{}
 	c.GetAction()
 	c = nil
 	c.GetAction()
 }
 
-func TestContentReferenceEvent_GetContentReference(tt *testing.T) {
-	c := &ContentReferenceEvent{}
-	c.GetContentReference()
+func TestCommitCommentEvent_GetComment(tt *testing.T) {
+	c := &CommitCommentEvent{}
+	c.GetComment()
 	c = nil
-	c.GetContentReference()
+	c.GetComment()
 }
 
-func TestContentReferenceEvent_GetInstallation(tt *testing.T) {
-	c := &ContentReferenceEvent{}
+func TestCommitCommentEvent_GetInstallation(tt *testing.T) {
+	c := &CommitCommentEvent{}
 	c.GetInstallation()
 	c = nil
 	c.GetInstallation()
 }
 
-func TestContentReferenceEvent_GetRepo(tt *testing.T) {
-	c := &ContentReferenceEvent{}
+func TestCommitCommentEvent_GetOrg(tt *testing.T) {
+	c := &CommitCommentEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestCommitCommentEvent_GetRepo(tt *testing.T) {
+	c := &CommitCommentEvent{}
 	c.GetRepo()
 	c = nil
 	c.GetRepo()
 }
 
-func TestContentReferenceEvent_GetSender(tt *testing.T) {
-	c := &ContentReferenceEvent{}
+func TestCommitCommentEvent_GetSender(tt *testing.T) {
+	c := &CommitCommentEvent{}
 	c.GetSender()
 	c = nil
 	c.GetSender()
 }
 
-func TestContributor_GetAvatarURL(tt *testing.T) {
+func TestCommitFile_GetAdditions(tt *testing.T) {
+	var zeroValue int
+	c := &CommitFile{Additions: &zeroValue}
+	c.GetAdditions()
+	c = &CommitFile{}
+	c.GetAdditions()
+	c = nil
+	c.GetAdditions()
+}
+
+func TestCommitFile_GetBlobURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{AvatarURL: &zeroValue}
-	c.GetAvatarURL()
-	c = &Contributor{}
-	c.GetAvatarURL()
+	c := &CommitFile{BlobURL: &zeroValue}
+	c.GetBlobURL()
+	c = &CommitFile{}
+	c.GetBlobURL()
 	c = nil
-	c.GetAvatarURL()
+	c.GetBlobURL()
 }
 
-func TestContributor_GetContributions(tt *testing.T) {
+func TestCommitFile_GetChanges(tt *testing.T) {
 	var zeroValue int
-	c := &Contributor{Contributions: &zeroValue}
-	c.GetContributions()
-	c = &Contributor{}
-	c.GetContributions()
+	c := &CommitFile{Changes: &zeroValue}
+	c.GetChanges()
+	c = &CommitFile{}
+	c.GetChanges()
 	c = nil
-	c.GetContributions()
+	c.GetChanges()
 }
 
-func TestContributor_GetEmail(tt *testing.T) {
+func TestCommitFile_GetContentsURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{Email: &zeroValue}
-	c.GetEmail()
-	c = &Contributor{}
-	c.GetEmail()
+	c := &CommitFile{ContentsURL: &zeroValue}
+	c.GetContentsURL()
+	c = &CommitFile{}
+	c.GetContentsURL()
 	c = nil
-	c.GetEmail()
+	c.GetContentsURL()
 }
 
-func TestContributor_GetEventsURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{EventsURL: &zeroValue}
-	c.GetEventsURL()
-	c = &Contributor{}
-	c.GetEventsURL()
+func TestCommitFile_GetDeletions(tt *testing.T) {
+	var zeroValue int
+	c := &CommitFile{Deletions: &zeroValue}
+	c.GetDeletions()
+	c = &CommitFile{}
+	c.GetDeletions()
 	c = nil
-	c.GetEventsURL()
+	c.GetDeletions()
 }
 
-func TestContributor_GetFollowersURL(tt *testing.T) {
+func TestCommitFile_GetFilename(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{FollowersURL: &zeroValue}
-	c.GetFollowersURL()
-	c = &Contributor{}
-	c.GetFollowersURL()
+	c := &CommitFile{Filename: &zeroValue}
+	c.GetFilename()
+	c = &CommitFile{}
+	c.GetFilename()
 	c = nil
-	c.GetFollowersURL()
+	c.GetFilename()
 }
 
-func TestContributor_GetFollowingURL(tt *testing.T) {
+func TestCommitFile_GetPatch(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{FollowingURL: &zeroValue}
-	c.GetFollowingURL()
-	c = &Contributor{}
-	c.GetFollowingURL()
+	c := &CommitFile{Patch: &zeroValue}
+	c.GetPatch()
+	c = &CommitFile{}
+	c.GetPatch()
 	c = nil
-	c.GetFollowingURL()
+	c.GetPatch()
 }
 
-func TestContributor_GetGistsURL(tt *testing.T) {
+func TestCommitFile_GetPreviousFilename(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{GistsURL: &zeroValue}
-	c.GetGistsURL()
-	c = &Contributor{}
-	c.GetGistsURL()
+	c := &CommitFile{PreviousFilename: &zeroValue}
+	c.GetPreviousFilename()
+	c = &CommitFile{}
+	c.GetPreviousFilename()
 	c = nil
-	c.GetGistsURL()
+	c.GetPreviousFilename()
 }
 
-func TestContributor_GetGravatarID(tt *testing.T) {
+func TestCommitFile_GetRawURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{GravatarID: &zeroValue}
-	c.GetGravatarID()
-	c = &Contributor{}
-	c.GetGravatarID()
+	c := &CommitFile{RawURL: &zeroValue}
+	c.GetRawURL()
+	c = &CommitFile{}
+	c.GetRawURL()
 	c = nil
-	c.GetGravatarID()
+	c.GetRawURL()
 }
 
-func TestContributor_GetHTMLURL(tt *testing.T) {
+func TestCommitFile_GetSHA(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &Contributor{}
-	c.GetHTMLURL()
-	c = nil
-	c.GetHTMLURL()
-}
-
-func TestContributor_GetID(tt *testing.T) {
-	var zeroValue int64
-	c := &Contributor{ID: &zeroValue}
-	c.GetID()
-	c = &Contributor{}
-	c.GetID()
+	c := &CommitFile{SHA: &zeroValue}
+	c.GetSHA()
+	c = &CommitFile{}
+	c.GetSHA()
 	c = nil
-	c.GetID()
+	c.GetSHA()
 }
 
-func TestContributor_GetLogin(tt *testing.T) {
+func TestCommitFile_GetStatus(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{Login: &zeroValue}
-	c.GetLogin()
-	c = &Contributor{}
-	c.GetLogin()
+	c := &CommitFile{Status: &zeroValue}
+	c.GetStatus()
+	c = &CommitFile{}
+	c.GetStatus()
 	c = nil
-	c.GetLogin()
+	c.GetStatus()
 }
 
-func TestContributor_GetName(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{Name: &zeroValue}
-	c.GetName()
-	c = &Contributor{}
-	c.GetName()
+func TestCommitResult_GetAuthor(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetAuthor()
 	c = nil
-	c.GetName()
+	c.GetAuthor()
 }
 
-func TestContributor_GetNodeID(tt *testing.T) {
+func TestCommitResult_GetCommentsURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &Contributor{}
-	c.GetNodeID()
+	c := &CommitResult{CommentsURL: &zeroValue}
+	c.GetCommentsURL()
+	c = &CommitResult{}
+	c.GetCommentsURL()
 	c = nil
-	c.GetNodeID()
+	c.GetCommentsURL()
 }
 
-func TestContributor_GetOrganizationsURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{OrganizationsURL: &zeroValue}
-	c.GetOrganizationsURL()
-	c = &Contributor{}
-	c.GetOrganizationsURL()
+func TestCommitResult_GetCommit(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetCommit()
 	c = nil
-	c.GetOrganizationsURL()
+	c.GetCommit()
 }
 
-func TestContributor_GetReceivedEventsURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{ReceivedEventsURL: &zeroValue}
-	c.GetReceivedEventsURL()
-	c = &Contributor{}
-	c.GetReceivedEventsURL()
+func TestCommitResult_GetCommitter(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetCommitter()
 	c = nil
-	c.GetReceivedEventsURL()
+	c.GetCommitter()
 }
 
-func TestContributor_GetReposURL(tt *testing.T) {
+func TestCommitResult_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{ReposURL: &zeroValue}
-	c.GetReposURL()
-	c = &Contributor{}
-	c.GetReposURL()
-	c = nil
-	c.GetReposURL()
-}
-
-func TestContributor_GetSiteAdmin(tt *testing.T) {
-	var zeroValue bool
-	c := &Contributor{SiteAdmin: &zeroValue}
-	c.GetSiteAdmin()
-	c = &Contributor{}
-	c.GetSiteAdmin()
+	c := &CommitResult{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CommitResult{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetSiteAdmin()
+	c.GetHTMLURL()
 }
 
-func TestContributor_GetStarredURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{StarredURL: &zeroValue}
-	c.GetStarredURL()
-	c = &Contributor{}
-	c.GetStarredURL()
+func TestCommitResult_GetRepository(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetRepository()
 	c = nil
-	c.GetStarredURL()
+	c.GetRepository()
 }
 
-func TestContributor_GetSubscriptionsURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{SubscriptionsURL: &zeroValue}
-	c.GetSubscriptionsURL()
-	c = &Contributor{}
-	c.GetSubscriptionsURL()
+func TestCommitResult_GetScore(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetScore()
 	c = nil
-	c.GetSubscriptionsURL()
+	c.GetScore()
 }
 
-func TestContributor_GetType(tt *testing.T) {
+func TestCommitResult_GetSHA(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{Type: &zeroValue}
-	c.GetType()
-	c = &Contributor{}
-	c.GetType()
+	c := &CommitResult{SHA: &zeroValue}
+	c.GetSHA()
+	c = &CommitResult{}
+	c.GetSHA()
 	c = nil
-	c.GetType()
+	c.GetSHA()
 }
 
-func TestContributor_GetURL(tt *testing.T) {
+func TestCommitResult_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{URL: &zeroValue}
+	c := &CommitResult{URL: &zeroValue}
 	c.GetURL()
-	c = &Contributor{}
+	c = &CommitResult{}
 	c.GetURL()
 	c = nil
 	c.GetURL()
 }
 
-func TestContributorStats_GetAuthor(tt *testing.T) {
-	c := &ContributorStats{}
-	c.GetAuthor()
+func TestCommitsComparison_GetAheadBy(tt *testing.T) {
+	var zeroValue int
+	c := &CommitsComparison{AheadBy: &zeroValue}
+	c.GetAheadBy()
+	c = &CommitsComparison{}
+	c.GetAheadBy()
 	c = nil
-	c.GetAuthor()
+	c.GetAheadBy()
 }
 
-func TestContributorStats_GetTotal(tt *testing.T) {
-	var zeroValue int
-	c := &ContributorStats{Total: &zeroValue}
-	c.GetTotal()
-	c = &ContributorStats{}
-	c.GetTotal()
+func TestCommitsComparison_GetBaseCommit(tt *testing.T) {
+	c := &CommitsComparison{}
+	c.GetBaseCommit()
 	c = nil
-	c.GetTotal()
+	c.GetBaseCommit()
 }
 
-func TestCreateCheckRunOptions_GetCompletedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	c := &CreateCheckRunOptions{CompletedAt: &zeroValue}
-	c.GetCompletedAt()
-	c = &CreateCheckRunOptions{}
-	c.GetCompletedAt()
+func TestCommitsComparison_GetBehindBy(tt *testing.T) {
+	var zeroValue int
+	c := &CommitsComparison{BehindBy: &zeroValue}
+	c.GetBehindBy()
+	c = &CommitsComparison{}
+	c.GetBehindBy()
 	c = nil
-	c.GetCompletedAt()
+	c.GetBehindBy()
 }
 
-func TestCreateCheckRunOptions_GetConclusion(tt *testing.T) {
+func TestCommitsComparison_GetDiffURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateCheckRunOptions{Conclusion: &zeroValue}
-	c.GetConclusion()
-	c = &CreateCheckRunOptions{}
-	c.GetConclusion()
+	c := &CommitsComparison{DiffURL: &zeroValue}
+	c.GetDiffURL()
+	c = &CommitsComparison{}
+	c.GetDiffURL()
 	c = nil
-	c.GetConclusion()
+	c.GetDiffURL()
 }
 
-func TestCreateCheckRunOptions_GetDetailsURL(tt *testing.T) {
+func TestCommitsComparison_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateCheckRunOptions{DetailsURL: &zeroValue}
-	c.GetDetailsURL()
-	c = &CreateCheckRunOptions{}
-	c.GetDetailsURL()
+	c := &CommitsComparison{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CommitsComparison{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetDetailsURL()
+	c.GetHTMLURL()
 }
 
-func TestCreateCheckRunOptions_GetExternalID(tt *testing.T) {
-	var zeroValue string
-	c := &CreateCheckRunOptions{ExternalID: &zeroValue}
-	c.GetExternalID()
-	c = &CreateCheckRunOptions{}
-	c.GetExternalID()
+func TestCommitsComparison_GetMergeBaseCommit(tt *testing.T) {
+	c := &CommitsComparison{}
+	c.GetMergeBaseCommit()
 	c = nil
-	c.GetExternalID()
+	c.GetMergeBaseCommit()
 }
 
-func TestCreateCheckRunOptions_GetOutput(tt *testing.T) {
-	c := &CreateCheckRunOptions{}
-	c.GetOutput()
+func TestCommitsComparison_GetPatchURL(tt *testing.T) {
+	var zeroValue string
+	c := &CommitsComparison{PatchURL: &zeroValue}
+	c.GetPatchURL()
+	c = &CommitsComparison{}
+	c.GetPatchURL()
 	c = nil
-	c.GetOutput()
+	c.GetPatchURL()
 }
 
-func TestCreateCheckRunOptions_GetStartedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	c := &CreateCheckRunOptions{StartedAt: &zeroValue}
-	c.GetStartedAt()
-	c = &CreateCheckRunOptions{}
-	c.GetStartedAt()
+func TestCommitsComparison_GetPermalinkURL(tt *testing.T) {
+	var zeroValue string
+	c := &CommitsComparison{PermalinkURL: &zeroValue}
+	c.GetPermalinkURL()
+	c = &CommitsComparison{}
+	c.GetPermalinkURL()
 	c = nil
-	c.GetStartedAt()
+	c.GetPermalinkURL()
 }
 
-func TestCreateCheckRunOptions_GetStatus(tt *testing.T) {
+func TestCommitsComparison_GetStatus(tt *testing.T) {
 	var zeroValue string
-	c := &CreateCheckRunOptions{Status: &zeroValue}
+	c := &CommitsComparison{Status: &zeroValue}
 	c.GetStatus()
-	c = &CreateCheckRunOptions{}
+	c = &CommitsComparison{}
 	c.GetStatus()
 	c = nil
 	c.GetStatus()
 }
 
-func TestCreateCheckSuiteOptions_GetHeadBranch(tt *testing.T) {
-	var zeroValue string
-	c := &CreateCheckSuiteOptions{HeadBranch: &zeroValue}
-	c.GetHeadBranch()
-	c = &CreateCheckSuiteOptions{}
-	c.GetHeadBranch()
+func TestCommitsComparison_GetTotalCommits(tt *testing.T) {
+	var zeroValue int
+	c := &CommitsComparison{TotalCommits: &zeroValue}
+	c.GetTotalCommits()
+	c = &CommitsComparison{}
+	c.GetTotalCommits()
 	c = nil
-	c.GetHeadBranch()
+	c.GetTotalCommits()
 }
 
-func TestCreateEvent_GetDescription(tt *testing.T) {
+func TestCommitsComparison_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateEvent{Description: &zeroValue}
-	c.GetDescription()
-	c = &CreateEvent{}
-	c.GetDescription()
+	c := &CommitsComparison{URL: &zeroValue}
+	c.GetURL()
+	c = &CommitsComparison{}
+	c.GetURL()
 	c = nil
-	c.GetDescription()
+	c.GetURL()
 }
 
-func TestCreateEvent_GetInstallation(tt *testing.T) {
-	c := &CreateEvent{}
-	c.GetInstallation()
+func TestCommitsSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	c := &CommitsSearchResult{IncompleteResults: &zeroValue}
+	c.GetIncompleteResults()
+	c = &CommitsSearchResult{}
+	c.GetIncompleteResults()
 	c = nil
-	c.GetInstallation()
+	c.GetIncompleteResults()
 }
 
-func TestCreateEvent_GetMasterBranch(tt *testing.T) {
-	var zeroValue string
-	c := &CreateEvent{MasterBranch: &zeroValue}
-	c.GetMasterBranch()
-	c = &CreateEvent{}
-	c.GetMasterBranch()
+func TestCommitsSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	c := &CommitsSearchResult{Total: &zeroValue}
+	c.GetTotal()
+	c = &CommitsSearchResult{}
+	c.GetTotal()
 	c = nil
-	c.GetMasterBranch()
+	c.GetTotal()
 }
 
-func TestCreateEvent_GetOrg(tt *testing.T) {
-	c := &CreateEvent{}
-	c.GetOrg()
+func TestCommitStats_GetAdditions(tt *testing.T) {
+	var zeroValue int
+	c := &CommitStats{Additions: &zeroValue}
+	c.GetAdditions()
+	c = &CommitStats{}
+	c.GetAdditions()
 	c = nil
-	c.GetOrg()
+	c.GetAdditions()
 }
 
-func TestCreateEvent_GetPusherType(tt *testing.T) {
-	var zeroValue string
-	c := &CreateEvent{PusherType: &zeroValue}
-	c.GetPusherType()
-	c = &CreateEvent{}
-	c.GetPusherType()
+func TestCommitStats_GetDeletions(tt *testing.T) {
+	var zeroValue int
+	c := &CommitStats{Deletions: &zeroValue}
+	c.GetDeletions()
+	c = &CommitStats{}
+	c.GetDeletions()
 	c = nil
-	c.GetPusherType()
+	c.GetDeletions()
 }
 
-func TestCreateEvent_GetRef(tt *testing.T) {
-	var zeroValue string
-	c := &CreateEvent{Ref: &zeroValue}
-	c.GetRef()
-	c = &CreateEvent{}
-	c.GetRef()
+func TestCommitStats_GetTotal(tt *testing.T) {
+	var zeroValue int
+	c := &CommitStats{Total: &zeroValue}
+	c.GetTotal()
+	c = &CommitStats{}
+	c.GetTotal()
 	c = nil
-	c.GetRef()
+	c.GetTotal()
 }
 
-func TestCreateEvent_GetRefType(tt *testing.T) {
-	var zeroValue string
-	c := &CreateEvent{RefType: &zeroValue}
-	c.GetRefType()
-	c = &CreateEvent{}
-	c.GetRefType()
+func TestCommunityHealthFiles_GetCodeOfConduct(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetCodeOfConduct()
 	c = nil
-	c.GetRefType()
+	c.GetCodeOfConduct()
 }
 
-func TestCreateEvent_GetRepo(tt *testing.T) {
-	c := &CreateEvent{}
-	c.GetRepo()
+func TestCommunityHealthFiles_GetCodeOfConductFile(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetCodeOfConductFile()
 	c = nil
-	c.GetRepo()
+	c.GetCodeOfConductFile()
 }
 
-func TestCreateEvent_GetSender(tt *testing.T) {
-	c := &CreateEvent{}
-	c.GetSender()
+func TestCommunityHealthFiles_GetContributing(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetContributing()
 	c = nil
-	c.GetSender()
+	c.GetContributing()
 }
 
-func TestCreateOrgInvitationOptions_GetEmail(tt *testing.T) {
-	var zeroValue string
-	c := &CreateOrgInvitationOptions{Email: &zeroValue}
-	c.GetEmail()
-	c = &CreateOrgInvitationOptions{}
-	c.GetEmail()
+func TestCommunityHealthFiles_GetIssueTemplate(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetIssueTemplate()
 	c = nil
-	c.GetEmail()
+	c.GetIssueTemplate()
 }
 
-func TestCreateOrgInvitationOptions_GetInviteeID(tt *testing.T) {
-	var zeroValue int64
-	c := &CreateOrgInvitationOptions{InviteeID: &zeroValue}
-	c.GetInviteeID()
-	c = &CreateOrgInvitationOptions{}
-	c.GetInviteeID()
+func TestCommunityHealthFiles_GetLicense(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetLicense()
 	c = nil
-	c.GetInviteeID()
+	c.GetLicense()
 }
 
-func TestCreateOrgInvitationOptions_GetRole(tt *testing.T) {
-	var zeroValue string
-	c := &CreateOrgInvitationOptions{Role: &zeroValue}
-	c.GetRole()
-	c = &CreateOrgInvitationOptions{}
-	c.GetRole()
+func TestCommunityHealthFiles_GetPullRequestTemplate(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetPullRequestTemplate()
 	c = nil
-	c.GetRole()
+	c.GetPullRequestTemplate()
 }
 
-func TestCreateOrUpdateCustomRoleOptions_GetBaseRole(tt *testing.T) {
-	var zeroValue string
-	c := &CreateOrUpdateCustomRoleOptions{BaseRole: &zeroValue}
-	c.GetBaseRole()
-	c = &CreateOrUpdateCustomRoleOptions{}
-	c.GetBaseRole()
+func TestCommunityHealthFiles_GetReadme(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetReadme()
 	c = nil
-	c.GetBaseRole()
+	c.GetReadme()
 }
 
-func TestCreateOrUpdateCustomRoleOptions_GetDescription(tt *testing.T) {
+func TestCommunityHealthMetrics_GetContentReportsEnabled(tt *testing.T) {
+	var zeroValue bool
+	c := &CommunityHealthMetrics{ContentReportsEnabled: &zeroValue}
+	c.GetContentReportsEnabled()
+	c = &CommunityHealthMetrics{}
+	c.GetContentReportsEnabled()
+	c = nil
+	c.GetContentReportsEnabled()
+}
+
+func TestCommunityHealthMetrics_GetDescription(tt *testing.T) {
 	var zeroValue string
-	c := &CreateOrUpdateCustomRoleOptions{Description: &zeroValue}
+	c := &CommunityHealthMetrics{Description: &zeroValue}
 	c.GetDescription()
-	c = &CreateOrUpdateCustomRoleOptions{}
+	c = &CommunityHealthMetrics{}
 	c.GetDescription()
 	c = nil
 	c.GetDescription()
 }
 
-func TestCreateOrUpdateCustomRoleOptions_GetName(tt *testing.T) {
+func TestCommunityHealthMetrics_GetDocumentation(tt *testing.T) {
 	var zeroValue string
-	c := &CreateOrUpdateCustomRoleOptions{Name: &zeroValue}
-	c.GetName()
-	c = &CreateOrUpdateCustomRoleOptions{}
-	c.GetName()
-	c = nil
-	c.GetName()
-}
-
-func TestCreateProtectedChanges_GetFrom(tt *testing.T) {
-	var zeroValue bool
-	c := &CreateProtectedChanges{From: &zeroValue}
-	c.GetFrom()
-	c = &CreateProtectedChanges{}
-	c.GetFrom()
+	c := &CommunityHealthMetrics{Documentation: &zeroValue}
+	c.GetDocumentation()
+	c = &CommunityHealthMetrics{}
+	c.GetDocumentation()
 	c = nil
-	c.GetFrom()
+	c.GetDocumentation()
 }
 
-func TestCreateRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
-	var zeroValue bool
-	c := &CreateRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
-	c.GetAllowsPublicRepositories()
-	c = &CreateRunnerGroupRequest{}
-	c.GetAllowsPublicRepositories()
+func TestCommunityHealthMetrics_GetFiles(tt *testing.T) {
+	c := &CommunityHealthMetrics{}
+	c.GetFiles()
 	c = nil
-	c.GetAllowsPublicRepositories()
+	c.GetFiles()
 }
 
-func TestCreateRunnerGroupRequest_GetName(tt *testing.T) {
-	var zeroValue string
-	c := &CreateRunnerGroupRequest{Name: &zeroValue}
-	c.GetName()
-	c = &CreateRunnerGroupRequest{}
-	c.GetName()
+func TestCommunityHealthMetrics_GetHealthPercentage(tt *testing.T) {
+	var zeroValue int
+	c := &CommunityHealthMetrics{HealthPercentage: &zeroValue}
+	c.GetHealthPercentage()
+	c = &CommunityHealthMetrics{}
+	c.GetHealthPercentage()
 	c = nil
-	c.GetName()
+	c.GetHealthPercentage()
 }
 
-func TestCreateRunnerGroupRequest_GetRestrictedToWorkflows(tt *testing.T) {
-	var zeroValue bool
-	c := &CreateRunnerGroupRequest{RestrictedToWorkflows: &zeroValue}
-	c.GetRestrictedToWorkflows()
-	c = &CreateRunnerGroupRequest{}
-	c.GetRestrictedToWorkflows()
+func TestCommunityHealthMetrics_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CommunityHealthMetrics{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CommunityHealthMetrics{}
+	c.GetUpdatedAt()
 	c = nil
-	c.GetRestrictedToWorkflows()
+	c.GetUpdatedAt()
 }
 
-func TestCreateRunnerGroupRequest_GetVisibility(tt *testing.T) {
+func TestContentReference_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &ContentReference{ID: &zeroValue}
+	c.GetID()
+	c = &ContentReference{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestContentReference_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	c := &CreateRunnerGroupRequest{Visibility: &zeroValue}
-	c.GetVisibility()
-	c = &CreateRunnerGroupRequest{}
-	c.GetVisibility()
+	c := &ContentReference{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &ContentReference{}
+	c.GetNodeID()
 	c = nil
-	c.GetVisibility()
+	c.GetNodeID()
 }
 
-func TestCreateUpdateEnvironment_GetCanAdminsBypass(tt *testing.T) {
-	var zeroValue bool
-	c := &CreateUpdateEnvironment{CanAdminsBypass: &zeroValue}
-	c.GetCanAdminsBypass()
-	c = &CreateUpdateEnvironment{}
-	c.GetCanAdminsBypass()
+func TestContentReference_GetReference(tt *testing.T) {
+	var zeroValue string
+	c := &ContentReference{Reference: &zeroValue}
+	c.GetReference()
+	c = &ContentReference{}
+	c.GetReference()
 	c = nil
-	c.GetCanAdminsBypass()
+	c.GetReference()
 }
 
-func TestCreateUpdateEnvironment_GetDeploymentBranchPolicy(tt *testing.T) {
-	c := &CreateUpdateEnvironment{}
-	c.GetDeploymentBranchPolicy()
+func TestContentReferenceEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	c := &ContentReferenceEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &ContentReferenceEvent{}
+	c.GetAction()
 	c = nil
-	c.GetDeploymentBranchPolicy()
+	c.GetAction()
 }
 
-func TestCreateUpdateEnvironment_GetWaitTimer(tt *testing.T) {
+func TestContentReferenceEvent_GetContentReference(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetContentReference()
+	c = nil
+	c.GetContentReference()
+}
+
+func TestContentReferenceEvent_GetInstallation(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
+}
+
+func TestContentReferenceEvent_GetOrg(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestContentReferenceEvent_GetRepo(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
+}
+
+func TestContentReferenceEvent_GetSender(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
+}
+
+func TestContributor_GetAvatarURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{AvatarURL: &zeroValue}
+	c.GetAvatarURL()
+	c = &Contributor{}
+	c.GetAvatarURL()
+	c = nil
+	c.GetAvatarURL()
+}
+
+func TestContributor_GetContributions(tt *testing.T) {
 	var zeroValue int
-	c := &CreateUpdateEnvironment{WaitTimer: &zeroValue}
-	c.GetWaitTimer()
-	c = &CreateUpdateEnvironment{}
-	c.GetWaitTimer()
+	c := &Contributor{Contributions: &zeroValue}
+	c.GetContributions()
+	c = &Contributor{}
+	c.GetContributions()
 	c = nil
-	c.GetWaitTimer()
+	c.GetContributions()
 }
 
-func TestCreateUpdateRequiredWorkflowOptions_GetRepositoryID(tt *testing.T) {
-	var zeroValue int64
-	c := &CreateUpdateRequiredWorkflowOptions{RepositoryID: &zeroValue}
-	c.GetRepositoryID()
-	c = &CreateUpdateRequiredWorkflowOptions{}
-	c.GetRepositoryID()
+func TestContributor_GetEmail(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{Email: &zeroValue}
+	c.GetEmail()
+	c = &Contributor{}
+	c.GetEmail()
 	c = nil
-	c.GetRepositoryID()
+	c.GetEmail()
 }
 
-func TestCreateUpdateRequiredWorkflowOptions_GetScope(tt *testing.T) {
+func TestContributor_GetEventsURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateUpdateRequiredWorkflowOptions{Scope: &zeroValue}
-	c.GetScope()
-	c = &CreateUpdateRequiredWorkflowOptions{}
-	c.GetScope()
+	c := &Contributor{EventsURL: &zeroValue}
+	c.GetEventsURL()
+	c = &Contributor{}
+	c.GetEventsURL()
 	c = nil
-	c.GetScope()
+	c.GetEventsURL()
 }
 
-func TestCreateUpdateRequiredWorkflowOptions_GetSelectedRepositoryIDs(tt *testing.T) {
-	c := &CreateUpdateRequiredWorkflowOptions{}
-	c.GetSelectedRepositoryIDs()
+func TestContributor_GetFollowersURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{FollowersURL: &zeroValue}
+	c.GetFollowersURL()
+	c = &Contributor{}
+	c.GetFollowersURL()
 	c = nil
-	c.GetSelectedRepositoryIDs()
+	c.GetFollowersURL()
 }
 
-func TestCreateUpdateRequiredWorkflowOptions_GetWorkflowFilePath(tt *testing.T) {
+func TestContributor_GetFollowingURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateUpdateRequiredWorkflowOptions{WorkflowFilePath: &zeroValue}
-	c.GetWorkflowFilePath()
-	c = &CreateUpdateRequiredWorkflowOptions{}
-	c.GetWorkflowFilePath()
+	c := &Contributor{FollowingURL: &zeroValue}
+	c.GetFollowingURL()
+	c = &Contributor{}
+	c.GetFollowingURL()
 	c = nil
-	c.GetWorkflowFilePath()
+	c.GetFollowingURL()
 }
 
-func TestCreateUserProjectOptions_GetBody(tt *testing.T) {
+func TestContributor_GetGistsURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateUserProjectOptions{Body: &zeroValue}
-	c.GetBody()
-	c = &CreateUserProjectOptions{}
-	c.GetBody()
+	c := &Contributor{GistsURL: &zeroValue}
+	c.GetGistsURL()
+	c = &Contributor{}
+	c.GetGistsURL()
 	c = nil
-	c.GetBody()
+	c.GetGistsURL()
 }
 
-func TestCustomRepoRoles_GetBaseRole(tt *testing.T) {
+func TestContributor_GetGravatarID(tt *testing.T) {
 	var zeroValue string
-	c := &CustomRepoRoles{BaseRole: &zeroValue}
-	c.GetBaseRole()
-	c = &CustomRepoRoles{}
-	c.GetBaseRole()
+	c := &Contributor{GravatarID: &zeroValue}
+	c.GetGravatarID()
+	c = &Contributor{}
+	c.GetGravatarID()
 	c = nil
-	c.GetBaseRole()
+	c.GetGravatarID()
 }
 
-func TestCustomRepoRoles_GetDescription(tt *testing.T) {
+func TestContributor_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	c := &CustomRepoRoles{Description: &zeroValue}
-	c.GetDescription()
-	c = &CustomRepoRoles{}
-	c.GetDescription()
+	c := &Contributor{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &Contributor{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetDescription()
+	c.GetHTMLURL()
 }
 
-func TestCustomRepoRoles_GetID(tt *testing.T) {
+func TestContributor_GetID(tt *testing.T) {
 	var zeroValue int64
-	c := &CustomRepoRoles{ID: &zeroValue}
+	c := &Contributor{ID: &zeroValue}
 	c.GetID()
-	c = &CustomRepoRoles{}
+	c = &Contributor{}
 	c.GetID()
 	c = nil
 	c.GetID()
 }
 
-func TestCustomRepoRoles_GetName(tt *testing.T) {
+func TestContributor_GetLogin(tt *testing.T) {
 	var zeroValue string
-	c := &CustomRepoRoles{Name: &zeroValue}
+	c := &Contributor{Login: &zeroValue}
+	c.GetLogin()
+	c = &Contributor{}
+	c.GetLogin()
+	c = nil
+	c.GetLogin()
+}
+
+func TestContributor_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{Name: &zeroValue}
 	c.GetName()
-	c = &CustomRepoRoles{}
+	c = &Contributor{}
 	c.GetName()
 	c = nil
 	c.GetName()
 }
 
-func TestDeleteEvent_GetInstallation(tt *testing.T) {
-	d := &DeleteEvent{}
-	d.GetInstallation()
-	d = nil
-	d.GetInstallation()
+func TestContributor_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &Contributor{}
+	c.GetNodeID()
+	c = nil
+	c.GetNodeID()
 }
 
-func TestDeleteEvent_GetPusherType(tt *testing.T) {
+func TestContributor_GetOrganizationsURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeleteEvent{PusherType: &zeroValue}
-	d.GetPusherType()
-	d = &DeleteEvent{}
-	d.GetPusherType()
-	d = nil
-	d.GetPusherType()
+	c := &Contributor{OrganizationsURL: &zeroValue}
+	c.GetOrganizationsURL()
+	c = &Contributor{}
+	c.GetOrganizationsURL()
+	c = nil
+	c.GetOrganizationsURL()
 }
 
-func TestDeleteEvent_GetRef(tt *testing.T) {
+func TestContributor_GetReceivedEventsURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeleteEvent{Ref: &zeroValue}
-	d.GetRef()
-	d = &DeleteEvent{}
-	d.GetRef()
-	d = nil
-	d.GetRef()
+	c := &Contributor{ReceivedEventsURL: &zeroValue}
+	c.GetReceivedEventsURL()
+	c = &Contributor{}
+	c.GetReceivedEventsURL()
+	c = nil
+	c.GetReceivedEventsURL()
 }
 
-func TestDeleteEvent_GetRefType(tt *testing.T) {
+func TestContributor_GetReposURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeleteEvent{RefType: &zeroValue}
-	d.GetRefType()
-	d = &DeleteEvent{}
-	d.GetRefType()
-	d = nil
-	d.GetRefType()
+	c := &Contributor{ReposURL: &zeroValue}
+	c.GetReposURL()
+	c = &Contributor{}
+	c.GetReposURL()
+	c = nil
+	c.GetReposURL()
 }
 
-func TestDeleteEvent_GetRepo(tt *testing.T) {
-	d := &DeleteEvent{}
-	d.GetRepo()
-	d = nil
-	d.GetRepo()
+func TestContributor_GetSiteAdmin(tt *testing.T) {
+	var zeroValue bool
+	c := &Contributor{SiteAdmin: &zeroValue}
+	c.GetSiteAdmin()
+	c = &Contributor{}
+	c.GetSiteAdmin()
+	c = nil
+	c.GetSiteAdmin()
 }
 
-func TestDeleteEvent_GetSender(tt *testing.T) {
-	d := &DeleteEvent{}
-	d.GetSender()
-	d = nil
-	d.GetSender()
+func TestContributor_GetStarredURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{StarredURL: &zeroValue}
+	c.GetStarredURL()
+	c = &Contributor{}
+	c.GetStarredURL()
+	c = nil
+	c.GetStarredURL()
 }
 
-func TestDependabotAlert_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DependabotAlert{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &DependabotAlert{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestContributor_GetSubscriptionsURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{SubscriptionsURL: &zeroValue}
+	c.GetSubscriptionsURL()
+	c = &Contributor{}
+	c.GetSubscriptionsURL()
+	c = nil
+	c.GetSubscriptionsURL()
 }
 
-func TestDependabotAlert_GetDependency(tt *testing.T) {
-	d := &DependabotAlert{}
-	d.GetDependency()
-	d = nil
-	d.GetDependency()
+func TestContributor_GetType(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{Type: &zeroValue}
+	c.GetType()
+	c = &Contributor{}
+	c.GetType()
+	c = nil
+	c.GetType()
 }
 
-func TestDependabotAlert_GetDismissedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DependabotAlert{DismissedAt: &zeroValue}
-	d.GetDismissedAt()
-	d = &DependabotAlert{}
-	d.GetDismissedAt()
-	d = nil
-	d.GetDismissedAt()
+func TestContributor_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{URL: &zeroValue}
+	c.GetURL()
+	c = &Contributor{}
+	c.GetURL()
+	c = nil
+	c.GetURL()
 }
 
-func TestDependabotAlert_GetDismissedBy(tt *testing.T) {
-	d := &DependabotAlert{}
-	d.GetDismissedBy()
-	d = nil
-	d.GetDismissedBy()
+func TestContributorStats_GetAuthor(tt *testing.T) {
+	c := &ContributorStats{}
+	c.GetAuthor()
+	c = nil
+	c.GetAuthor()
 }
 
-func TestDependabotAlert_GetDismissedComment(tt *testing.T) {
-	var zeroValue string
-	d := &DependabotAlert{DismissedComment: &zeroValue}
-	d.GetDismissedComment()
-	d = &DependabotAlert{}
-	d.GetDismissedComment()
-	d = nil
-	d.GetDismissedComment()
+func TestContributorStats_GetTotal(tt *testing.T) {
+	var zeroValue int
+	c := &ContributorStats{Total: &zeroValue}
+	c.GetTotal()
+	c = &ContributorStats{}
+	c.GetTotal()
+	c = nil
+	c.GetTotal()
 }
 
-func TestDependabotAlert_GetDismissedReason(tt *testing.T) {
+func TestCopilotDotcomChatModel_GetCustomModelTrainingDate(tt *testing.T) {
 	var zeroValue string
-	d := &DependabotAlert{DismissedReason: &zeroValue}
-	d.GetDismissedReason()
-	d = &DependabotAlert{}
-	d.GetDismissedReason()
-	d = nil
-	d.GetDismissedReason()
+	c := &CopilotDotcomChatModel{CustomModelTrainingDate: &zeroValue}
+	c.GetCustomModelTrainingDate()
+	c = &CopilotDotcomChatModel{}
+	c.GetCustomModelTrainingDate()
+	c = nil
+	c.GetCustomModelTrainingDate()
 }
 
-func TestDependabotAlert_GetFixedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DependabotAlert{FixedAt: &zeroValue}
-	d.GetFixedAt()
-	d = &DependabotAlert{}
-	d.GetFixedAt()
-	d = nil
-	d.GetFixedAt()
+func TestCopilotDotcomPullRequestsModel_GetCustomModelTrainingDate(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotDotcomPullRequestsModel{CustomModelTrainingDate: &zeroValue}
+	c.GetCustomModelTrainingDate()
+	c = &CopilotDotcomPullRequestsModel{}
+	c.GetCustomModelTrainingDate()
+	c = nil
+	c.GetCustomModelTrainingDate()
 }
 
-func TestDependabotAlert_GetHTMLURL(tt *testing.T) {
+func TestCopilotIDEChatModel_GetCustomModelTrainingDate(tt *testing.T) {
 	var zeroValue string
-	d := &DependabotAlert{HTMLURL: &zeroValue}
-	d.GetHTMLURL()
-	d = &DependabotAlert{}
-	d.GetHTMLURL()
-	d = nil
-	d.GetHTMLURL()
+	c := &CopilotIDEChatModel{CustomModelTrainingDate: &zeroValue}
+	c.GetCustomModelTrainingDate()
+	c = &CopilotIDEChatModel{}
+	c.GetCustomModelTrainingDate()
+	c = nil
+	c.GetCustomModelTrainingDate()
 }
 
-func TestDependabotAlert_GetNumber(tt *testing.T) {
-	var zeroValue int
-	d := &DependabotAlert{Number: &zeroValue}
-	d.GetNumber()
-	d = &DependabotAlert{}
-	d.GetNumber()
-	d = nil
-	d.GetNumber()
+func TestCopilotIDECodeCompletionsModel_GetCustomModelTrainingDate(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotIDECodeCompletionsModel{CustomModelTrainingDate: &zeroValue}
+	c.GetCustomModelTrainingDate()
+	c = &CopilotIDECodeCompletionsModel{}
+	c.GetCustomModelTrainingDate()
+	c = nil
+	c.GetCustomModelTrainingDate()
 }
 
-func TestDependabotAlert_GetSecurityAdvisory(tt *testing.T) {
-	d := &DependabotAlert{}
-	d.GetSecurityAdvisory()
-	d = nil
-	d.GetSecurityAdvisory()
+func TestCopilotMetrics_GetCopilotDotcomChat(tt *testing.T) {
+	c := &CopilotMetrics{}
+	c.GetCopilotDotcomChat()
+	c = nil
+	c.GetCopilotDotcomChat()
 }
 
-func TestDependabotAlert_GetSecurityVulnerability(tt *testing.T) {
-	d := &DependabotAlert{}
-	d.GetSecurityVulnerability()
-	d = nil
-	d.GetSecurityVulnerability()
+func TestCopilotMetrics_GetCopilotDotcomPullRequests(tt *testing.T) {
+	c := &CopilotMetrics{}
+	c.GetCopilotDotcomPullRequests()
+	c = nil
+	c.GetCopilotDotcomPullRequests()
 }
 
-func TestDependabotAlert_GetState(tt *testing.T) {
-	var zeroValue string
-	d := &DependabotAlert{State: &zeroValue}
-	d.GetState()
-	d = &DependabotAlert{}
-	d.GetState()
-	d = nil
-	d.GetState()
+func TestCopilotMetrics_GetCopilotIDEChat(tt *testing.T) {
+	c := &CopilotMetrics{}
+	c.GetCopilotIDEChat()
+	c = nil
+	c.GetCopilotIDEChat()
 }
 
-func TestDependabotAlert_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DependabotAlert{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &DependabotAlert{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+func TestCopilotMetrics_GetCopilotIDECodeCompletions(tt *testing.T) {
+	c := &CopilotMetrics{}
+	c.GetCopilotIDECodeCompletions()
+	c = nil
+	c.GetCopilotIDECodeCompletions()
 }
 
-func TestDependabotAlert_GetURL(tt *testing.T) {
-	var zeroValue string
-	d := &DependabotAlert{URL: &zeroValue}
-	d.GetURL()
-	d = &DependabotAlert{}
-	d.GetURL()
-	d = nil
-	d.GetURL()
+func TestCopilotMetrics_GetTotalActiveUsers(tt *testing.T) {
+	var zeroValue int
+	c := &CopilotMetrics{TotalActiveUsers: &zeroValue}
+	c.GetTotalActiveUsers()
+	c = &CopilotMetrics{}
+	c.GetTotalActiveUsers()
+	c = nil
+	c.GetTotalActiveUsers()
 }
 
-func TestDependabotSecurityAdvisory_GetCVEID(tt *testing.T) {
-	var zeroValue string
-	d := &DependabotSecurityAdvisory{CVEID: &zeroValue}
-	d.GetCVEID()
-	d = &DependabotSecurityAdvisory{}
-	d.GetCVEID()
-	d = nil
-	d.GetCVEID()
+func TestCopilotMetrics_GetTotalEngagedUsers(tt *testing.T) {
+	var zeroValue int
+	c := &CopilotMetrics{TotalEngagedUsers: &zeroValue}
+	c.GetTotalEngagedUsers()
+	c = &CopilotMetrics{}
+	c.GetTotalEngagedUsers()
+	c = nil
+	c.GetTotalEngagedUsers()
 }
 
-func TestDependabotSecurityAdvisory_GetCVSs(tt *testing.T) {
-	d := &DependabotSecurityAdvisory{}
-	d.GetCVSs()
-	d = nil
-	d.GetCVSs()
+func TestCopilotOrganizationDetails_GetSeatBreakdown(tt *testing.T) {
+	c := &CopilotOrganizationDetails{}
+	c.GetSeatBreakdown()
+	c = nil
+	c.GetSeatBreakdown()
 }
 
-func TestDependabotSecurityAdvisory_GetDescription(tt *testing.T) {
-	var zeroValue string
-	d := &DependabotSecurityAdvisory{Description: &zeroValue}
-	d.GetDescription()
-	d = &DependabotSecurityAdvisory{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+func TestCopilotSeatDetails_GetAssigningTeam(tt *testing.T) {
+	c := &CopilotSeatDetails{}
+	c.GetAssigningTeam()
+	c = nil
+	c.GetAssigningTeam()
 }
 
-func TestDependabotSecurityAdvisory_GetGHSAID(tt *testing.T) {
-	var zeroValue string
-	d := &DependabotSecurityAdvisory{GHSAID: &zeroValue}
-	d.GetGHSAID()
-	d = &DependabotSecurityAdvisory{}
-	d.GetGHSAID()
-	d = nil
-	d.GetGHSAID()
+func TestCopilotSeatDetails_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CopilotSeatDetails{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CopilotSeatDetails{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
 }
 
-func TestDependabotSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+func TestCopilotSeatDetails_GetLastActivityAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DependabotSecurityAdvisory{PublishedAt: &zeroValue}
-	d.GetPublishedAt()
-	d = &DependabotSecurityAdvisory{}
-	d.GetPublishedAt()
-	d = nil
-	d.GetPublishedAt()
+	c := &CopilotSeatDetails{LastActivityAt: &zeroValue}
+	c.GetLastActivityAt()
+	c = &CopilotSeatDetails{}
+	c.GetLastActivityAt()
+	c = nil
+	c.GetLastActivityAt()
 }
 
-func TestDependabotSecurityAdvisory_GetSeverity(tt *testing.T) {
+func TestCopilotSeatDetails_GetLastActivityEditor(tt *testing.T) {
 	var zeroValue string
-	d := &DependabotSecurityAdvisory{Severity: &zeroValue}
-	d.GetSeverity()
-	d = &DependabotSecurityAdvisory{}
-	d.GetSeverity()
-	d = nil
-	d.GetSeverity()
+	c := &CopilotSeatDetails{LastActivityEditor: &zeroValue}
+	c.GetLastActivityEditor()
+	c = &CopilotSeatDetails{}
+	c.GetLastActivityEditor()
+	c = nil
+	c.GetLastActivityEditor()
 }
 
-func TestDependabotSecurityAdvisory_GetSummary(tt *testing.T) {
+func TestCopilotSeatDetails_GetPendingCancellationDate(tt *testing.T) {
 	var zeroValue string
-	d := &DependabotSecurityAdvisory{Summary: &zeroValue}
-	d.GetSummary()
-	d = &DependabotSecurityAdvisory{}
-	d.GetSummary()
-	d = nil
-	d.GetSummary()
+	c := &CopilotSeatDetails{PendingCancellationDate: &zeroValue}
+	c.GetPendingCancellationDate()
+	c = &CopilotSeatDetails{}
+	c.GetPendingCancellationDate()
+	c = nil
+	c.GetPendingCancellationDate()
 }
 
-func TestDependabotSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+func TestCopilotSeatDetails_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DependabotSecurityAdvisory{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &DependabotSecurityAdvisory{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+	c := &CopilotSeatDetails{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CopilotSeatDetails{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
 }
 
-func TestDependabotSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+func TestCreateCheckRunOptions_GetCompletedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DependabotSecurityAdvisory{WithdrawnAt: &zeroValue}
-	d.GetWithdrawnAt()
-	d = &DependabotSecurityAdvisory{}
-	d.GetWithdrawnAt()
-	d = nil
-	d.GetWithdrawnAt()
+	c := &CreateCheckRunOptions{CompletedAt: &zeroValue}
+	c.GetCompletedAt()
+	c = &CreateCheckRunOptions{}
+	c.GetCompletedAt()
+	c = nil
+	c.GetCompletedAt()
 }
 
-func TestDependency_GetManifestPath(tt *testing.T) {
+func TestCreateCheckRunOptions_GetConclusion(tt *testing.T) {
 	var zeroValue string
-	d := &Dependency{ManifestPath: &zeroValue}
-	d.GetManifestPath()
-	d = &Dependency{}
-	d.GetManifestPath()
-	d = nil
-	d.GetManifestPath()
-}
-
-func TestDependency_GetPackage(tt *testing.T) {
-	d := &Dependency{}
-	d.GetPackage()
-	d = nil
-	d.GetPackage()
+	c := &CreateCheckRunOptions{Conclusion: &zeroValue}
+	c.GetConclusion()
+	c = &CreateCheckRunOptions{}
+	c.GetConclusion()
+	c = nil
+	c.GetConclusion()
 }
 
-func TestDependency_GetScope(tt *testing.T) {
+func TestCreateCheckRunOptions_GetDetailsURL(tt *testing.T) {
 	var zeroValue string
-	d := &Dependency{Scope: &zeroValue}
-	d.GetScope()
-	d = &Dependency{}
-	d.GetScope()
-	d = nil
-	d.GetScope()
+	c := &CreateCheckRunOptions{DetailsURL: &zeroValue}
+	c.GetDetailsURL()
+	c = &CreateCheckRunOptions{}
+	c.GetDetailsURL()
+	c = nil
+	c.GetDetailsURL()
 }
 
-func TestDeployKeyEvent_GetAction(tt *testing.T) {
+func TestCreateCheckRunOptions_GetExternalID(tt *testing.T) {
 	var zeroValue string
-	d := &DeployKeyEvent{Action: &zeroValue}
-	d.GetAction()
-	d = &DeployKeyEvent{}
-	d.GetAction()
-	d = nil
-	d.GetAction()
-}
-
-func TestDeployKeyEvent_GetInstallation(tt *testing.T) {
-	d := &DeployKeyEvent{}
-	d.GetInstallation()
-	d = nil
-	d.GetInstallation()
+	c := &CreateCheckRunOptions{ExternalID: &zeroValue}
+	c.GetExternalID()
+	c = &CreateCheckRunOptions{}
+	c.GetExternalID()
+	c = nil
+	c.GetExternalID()
 }
 
-func TestDeployKeyEvent_GetKey(tt *testing.T) {
-	d := &DeployKeyEvent{}
-	d.GetKey()
-	d = nil
-	d.GetKey()
+func TestCreateCheckRunOptions_GetOutput(tt *testing.T) {
+	c := &CreateCheckRunOptions{}
+	c.GetOutput()
+	c = nil
+	c.GetOutput()
 }
 
-func TestDeployKeyEvent_GetOrganization(tt *testing.T) {
-	d := &DeployKeyEvent{}
-	d.GetOrganization()
-	d = nil
-	d.GetOrganization()
+func TestCreateCheckRunOptions_GetStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CreateCheckRunOptions{StartedAt: &zeroValue}
+	c.GetStartedAt()
+	c = &CreateCheckRunOptions{}
+	c.GetStartedAt()
+	c = nil
+	c.GetStartedAt()
 }
 
-func TestDeployKeyEvent_GetRepo(tt *testing.T) {
-	d := &DeployKeyEvent{}
-	d.GetRepo()
-	d = nil
-	d.GetRepo()
+func TestCreateCheckRunOptions_GetStatus(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCheckRunOptions{Status: &zeroValue}
+	c.GetStatus()
+	c = &CreateCheckRunOptions{}
+	c.GetStatus()
+	c = nil
+	c.GetStatus()
 }
 
-func TestDeployKeyEvent_GetSender(tt *testing.T) {
-	d := &DeployKeyEvent{}
-	d.GetSender()
-	d = nil
-	d.GetSender()
+func TestCreateCheckSuiteOptions_GetHeadBranch(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCheckSuiteOptions{HeadBranch: &zeroValue}
+	c.GetHeadBranch()
+	c = &CreateCheckSuiteOptions{}
+	c.GetHeadBranch()
+	c = nil
+	c.GetHeadBranch()
 }
 
-func TestDeployment_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &Deployment{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &Deployment{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestCreateCodespaceOptions_GetClientIP(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCodespaceOptions{ClientIP: &zeroValue}
+	c.GetClientIP()
+	c = &CreateCodespaceOptions{}
+	c.GetClientIP()
+	c = nil
+	c.GetClientIP()
 }
 
-func TestDeployment_GetCreator(tt *testing.T) {
-	d := &Deployment{}
-	d.GetCreator()
-	d = nil
-	d.GetCreator()
+func TestCreateCodespaceOptions_GetDevcontainerPath(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCodespaceOptions{DevcontainerPath: &zeroValue}
+	c.GetDevcontainerPath()
+	c = &CreateCodespaceOptions{}
+	c.GetDevcontainerPath()
+	c = nil
+	c.GetDevcontainerPath()
 }
 
-func TestDeployment_GetDescription(tt *testing.T) {
+func TestCreateCodespaceOptions_GetDisplayName(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Description: &zeroValue}
-	d.GetDescription()
-	d = &Deployment{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+	c := &CreateCodespaceOptions{DisplayName: &zeroValue}
+	c.GetDisplayName()
+	c = &CreateCodespaceOptions{}
+	c.GetDisplayName()
+	c = nil
+	c.GetDisplayName()
 }
 
-func TestDeployment_GetEnvironment(tt *testing.T) {
+func TestCreateCodespaceOptions_GetGeo(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &Deployment{}
-	d.GetEnvironment()
-	d = nil
-	d.GetEnvironment()
+	c := &CreateCodespaceOptions{Geo: &zeroValue}
+	c.GetGeo()
+	c = &CreateCodespaceOptions{}
+	c.GetGeo()
+	c = nil
+	c.GetGeo()
 }
 
-func TestDeployment_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &Deployment{ID: &zeroValue}
-	d.GetID()
-	d = &Deployment{}
-	d.GetID()
-	d = nil
-	d.GetID()
+func TestCreateCodespaceOptions_GetIdleTimeoutMinutes(tt *testing.T) {
+	var zeroValue int
+	c := &CreateCodespaceOptions{IdleTimeoutMinutes: &zeroValue}
+	c.GetIdleTimeoutMinutes()
+	c = &CreateCodespaceOptions{}
+	c.GetIdleTimeoutMinutes()
+	c = nil
+	c.GetIdleTimeoutMinutes()
 }
 
-func TestDeployment_GetNodeID(tt *testing.T) {
+func TestCreateCodespaceOptions_GetMachine(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &Deployment{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
+	c := &CreateCodespaceOptions{Machine: &zeroValue}
+	c.GetMachine()
+	c = &CreateCodespaceOptions{}
+	c.GetMachine()
+	c = nil
+	c.GetMachine()
 }
 
-func TestDeployment_GetRef(tt *testing.T) {
-	var zeroValue string
-	d := &Deployment{Ref: &zeroValue}
-	d.GetRef()
-	d = &Deployment{}
-	d.GetRef()
-	d = nil
-	d.GetRef()
+func TestCreateCodespaceOptions_GetMultiRepoPermissionsOptOut(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateCodespaceOptions{MultiRepoPermissionsOptOut: &zeroValue}
+	c.GetMultiRepoPermissionsOptOut()
+	c = &CreateCodespaceOptions{}
+	c.GetMultiRepoPermissionsOptOut()
+	c = nil
+	c.GetMultiRepoPermissionsOptOut()
 }
 
-func TestDeployment_GetRepositoryURL(tt *testing.T) {
+func TestCreateCodespaceOptions_GetRef(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{RepositoryURL: &zeroValue}
-	d.GetRepositoryURL()
-	d = &Deployment{}
-	d.GetRepositoryURL()
-	d = nil
-	d.GetRepositoryURL()
+	c := &CreateCodespaceOptions{Ref: &zeroValue}
+	c.GetRef()
+	c = &CreateCodespaceOptions{}
+	c.GetRef()
+	c = nil
+	c.GetRef()
 }
 
-func TestDeployment_GetSHA(tt *testing.T) {
-	var zeroValue string
-	d := &Deployment{SHA: &zeroValue}
-	d.GetSHA()
-	d = &Deployment{}
-	d.GetSHA()
-	d = nil
-	d.GetSHA()
+func TestCreateCodespaceOptions_GetRetentionPeriodMinutes(tt *testing.T) {
+	var zeroValue int
+	c := &CreateCodespaceOptions{RetentionPeriodMinutes: &zeroValue}
+	c.GetRetentionPeriodMinutes()
+	c = &CreateCodespaceOptions{}
+	c.GetRetentionPeriodMinutes()
+	c = nil
+	c.GetRetentionPeriodMinutes()
 }
 
-func TestDeployment_GetStatusesURL(tt *testing.T) {
+func TestCreateCodespaceOptions_GetWorkingDirectory(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{StatusesURL: &zeroValue}
-	d.GetStatusesURL()
-	d = &Deployment{}
-	d.GetStatusesURL()
-	d = nil
-	d.GetStatusesURL()
+	c := &CreateCodespaceOptions{WorkingDirectory: &zeroValue}
+	c.GetWorkingDirectory()
+	c = &CreateCodespaceOptions{}
+	c.GetWorkingDirectory()
+	c = nil
+	c.GetWorkingDirectory()
 }
 
-func TestDeployment_GetTask(tt *testing.T) {
+func TestCreateEvent_GetDescription(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Task: &zeroValue}
-	d.GetTask()
-	d = &Deployment{}
-	d.GetTask()
-	d = nil
-	d.GetTask()
+	c := &CreateEvent{Description: &zeroValue}
+	c.GetDescription()
+	c = &CreateEvent{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
 }
 
-func TestDeployment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &Deployment{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &Deployment{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+func TestCreateEvent_GetInstallation(tt *testing.T) {
+	c := &CreateEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
 }
 
-func TestDeployment_GetURL(tt *testing.T) {
+func TestCreateEvent_GetMasterBranch(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{URL: &zeroValue}
-	d.GetURL()
-	d = &Deployment{}
-	d.GetURL()
-	d = nil
-	d.GetURL()
+	c := &CreateEvent{MasterBranch: &zeroValue}
+	c.GetMasterBranch()
+	c = &CreateEvent{}
+	c.GetMasterBranch()
+	c = nil
+	c.GetMasterBranch()
 }
 
-func TestDeploymentBranchPolicy_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &DeploymentBranchPolicy{ID: &zeroValue}
-	d.GetID()
-	d = &DeploymentBranchPolicy{}
-	d.GetID()
-	d = nil
-	d.GetID()
+func TestCreateEvent_GetOrg(tt *testing.T) {
+	c := &CreateEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
 }
 
-func TestDeploymentBranchPolicy_GetName(tt *testing.T) {
+func TestCreateEvent_GetPusherType(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentBranchPolicy{Name: &zeroValue}
-	d.GetName()
-	d = &DeploymentBranchPolicy{}
-	d.GetName()
-	d = nil
-	d.GetName()
+	c := &CreateEvent{PusherType: &zeroValue}
+	c.GetPusherType()
+	c = &CreateEvent{}
+	c.GetPusherType()
+	c = nil
+	c.GetPusherType()
 }
 
-func TestDeploymentBranchPolicy_GetNodeID(tt *testing.T) {
+func TestCreateEvent_GetRef(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentBranchPolicy{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &DeploymentBranchPolicy{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
+	c := &CreateEvent{Ref: &zeroValue}
+	c.GetRef()
+	c = &CreateEvent{}
+	c.GetRef()
+	c = nil
+	c.GetRef()
 }
 
-func TestDeploymentBranchPolicyRequest_GetName(tt *testing.T) {
+func TestCreateEvent_GetRefType(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentBranchPolicyRequest{Name: &zeroValue}
-	d.GetName()
-	d = &DeploymentBranchPolicyRequest{}
-	d.GetName()
-	d = nil
-	d.GetName()
+	c := &CreateEvent{RefType: &zeroValue}
+	c.GetRefType()
+	c = &CreateEvent{}
+	c.GetRefType()
+	c = nil
+	c.GetRefType()
 }
 
-func TestDeploymentBranchPolicyResponse_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	d := &DeploymentBranchPolicyResponse{TotalCount: &zeroValue}
-	d.GetTotalCount()
-	d = &DeploymentBranchPolicyResponse{}
-	d.GetTotalCount()
-	d = nil
-	d.GetTotalCount()
+func TestCreateEvent_GetRepo(tt *testing.T) {
+	c := &CreateEvent{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
 }
 
-func TestDeploymentEvent_GetDeployment(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetDeployment()
-	d = nil
-	d.GetDeployment()
+func TestCreateEvent_GetSender(tt *testing.T) {
+	c := &CreateEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
 }
 
-func TestDeploymentEvent_GetInstallation(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetInstallation()
-	d = nil
-	d.GetInstallation()
+func TestCreateOrgInvitationOptions_GetEmail(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrgInvitationOptions{Email: &zeroValue}
+	c.GetEmail()
+	c = &CreateOrgInvitationOptions{}
+	c.GetEmail()
+	c = nil
+	c.GetEmail()
 }
 
-func TestDeploymentEvent_GetRepo(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetRepo()
-	d = nil
-	d.GetRepo()
+func TestCreateOrgInvitationOptions_GetInviteeID(tt *testing.T) {
+	var zeroValue int64
+	c := &CreateOrgInvitationOptions{InviteeID: &zeroValue}
+	c.GetInviteeID()
+	c = &CreateOrgInvitationOptions{}
+	c.GetInviteeID()
+	c = nil
+	c.GetInviteeID()
 }
 
-func TestDeploymentEvent_GetSender(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetSender()
-	d = nil
-	d.GetSender()
+func TestCreateOrgInvitationOptions_GetRole(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrgInvitationOptions{Role: &zeroValue}
+	c.GetRole()
+	c = &CreateOrgInvitationOptions{}
+	c.GetRole()
+	c = nil
+	c.GetRole()
 }
 
-func TestDeploymentRequest_GetAutoMerge(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{AutoMerge: &zeroValue}
-	d.GetAutoMerge()
-	d = &DeploymentRequest{}
-	d.GetAutoMerge()
-	d = nil
-	d.GetAutoMerge()
+func TestCreateOrUpdateCustomRoleOptions_GetBaseRole(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateCustomRoleOptions{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CreateOrUpdateCustomRoleOptions{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
 }
 
-func TestDeploymentRequest_GetDescription(tt *testing.T) {
+func TestCreateOrUpdateCustomRoleOptions_GetDescription(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentRequest{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+	c := &CreateOrUpdateCustomRoleOptions{Description: &zeroValue}
+	c.GetDescription()
+	c = &CreateOrUpdateCustomRoleOptions{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
 }
 
-func TestDeploymentRequest_GetEnvironment(tt *testing.T) {
+func TestCreateOrUpdateCustomRoleOptions_GetName(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentRequest{}
-	d.GetEnvironment()
-	d = nil
-	d.GetEnvironment()
+	c := &CreateOrUpdateCustomRoleOptions{Name: &zeroValue}
+	c.GetName()
+	c = &CreateOrUpdateCustomRoleOptions{}
+	c.GetName()
+	c = nil
+	c.GetName()
 }
 
-func TestDeploymentRequest_GetProductionEnvironment(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{ProductionEnvironment: &zeroValue}
-	d.GetProductionEnvironment()
-	d = &DeploymentRequest{}
-	d.GetProductionEnvironment()
-	d = nil
-	d.GetProductionEnvironment()
+func TestCreateOrUpdateIssueTypesOptions_GetColor(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateIssueTypesOptions{Color: &zeroValue}
+	c.GetColor()
+	c = &CreateOrUpdateIssueTypesOptions{}
+	c.GetColor()
+	c = nil
+	c.GetColor()
 }
 
-func TestDeploymentRequest_GetRef(tt *testing.T) {
+func TestCreateOrUpdateIssueTypesOptions_GetDescription(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Ref: &zeroValue}
-	d.GetRef()
-	d = &DeploymentRequest{}
-	d.GetRef()
-	d = nil
-	d.GetRef()
+	c := &CreateOrUpdateIssueTypesOptions{Description: &zeroValue}
+	c.GetDescription()
+	c = &CreateOrUpdateIssueTypesOptions{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
 }
 
-func TestDeploymentRequest_GetRequiredContexts(tt *testing.T) {
-	var zeroValue []string
-	d := &DeploymentRequest{RequiredContexts: &zeroValue}
-	d.GetRequiredContexts()
-	d = &DeploymentRequest{}
-	d.GetRequiredContexts()
-	d = nil
-	d.GetRequiredContexts()
+func TestCreateOrUpdateOrgRoleOptions_GetBaseRole(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateOrgRoleOptions{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CreateOrUpdateOrgRoleOptions{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
 }
 
-func TestDeploymentRequest_GetTask(tt *testing.T) {
+func TestCreateOrUpdateOrgRoleOptions_GetDescription(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Task: &zeroValue}
-	d.GetTask()
-	d = &DeploymentRequest{}
-	d.GetTask()
-	d = nil
-	d.GetTask()
+	c := &CreateOrUpdateOrgRoleOptions{Description: &zeroValue}
+	c.GetDescription()
+	c = &CreateOrUpdateOrgRoleOptions{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
 }
 
-func TestDeploymentRequest_GetTransientEnvironment(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{TransientEnvironment: &zeroValue}
-	d.GetTransientEnvironment()
-	d = &DeploymentRequest{}
-	d.GetTransientEnvironment()
-	d = nil
-	d.GetTransientEnvironment()
+func TestCreateOrUpdateOrgRoleOptions_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateOrgRoleOptions{Name: &zeroValue}
+	c.GetName()
+	c = &CreateOrUpdateOrgRoleOptions{}
+	c.GetName()
+	c = nil
+	c.GetName()
 }
 
-func TestDeploymentStatus_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DeploymentStatus{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &DeploymentStatus{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestCreateProtectedChanges_GetFrom(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateProtectedChanges{From: &zeroValue}
+	c.GetFrom()
+	c = &CreateProtectedChanges{}
+	c.GetFrom()
+	c = nil
+	c.GetFrom()
 }
 
-func TestDeploymentStatus_GetCreator(tt *testing.T) {
-	d := &DeploymentStatus{}
-	d.GetCreator()
-	d = nil
-	d.GetCreator()
+func TestCreateRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
+	c.GetAllowsPublicRepositories()
+	c = &CreateRunnerGroupRequest{}
+	c.GetAllowsPublicRepositories()
+	c = nil
+	c.GetAllowsPublicRepositories()
 }
 
-func TestDeploymentStatus_GetDeploymentURL(tt *testing.T) {
+func TestCreateRunnerGroupRequest_GetName(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{DeploymentURL: &zeroValue}
-	d.GetDeploymentURL()
-	d = &DeploymentStatus{}
-	d.GetDeploymentURL()
-	d = nil
-	d.GetDeploymentURL()
+	c := &CreateRunnerGroupRequest{Name: &zeroValue}
+	c.GetName()
+	c = &CreateRunnerGroupRequest{}
+	c.GetName()
+	c = nil
+	c.GetName()
 }
 
-func TestDeploymentStatus_GetDescription(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentStatus{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+func TestCreateRunnerGroupRequest_GetRestrictedToWorkflows(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateRunnerGroupRequest{RestrictedToWorkflows: &zeroValue}
+	c.GetRestrictedToWorkflows()
+	c = &CreateRunnerGroupRequest{}
+	c.GetRestrictedToWorkflows()
+	c = nil
+	c.GetRestrictedToWorkflows()
 }
 
-func TestDeploymentStatus_GetEnvironment(tt *testing.T) {
+func TestCreateRunnerGroupRequest_GetVisibility(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentStatus{}
-	d.GetEnvironment()
-	d = nil
-	d.GetEnvironment()
+	c := &CreateRunnerGroupRequest{Visibility: &zeroValue}
+	c.GetVisibility()
+	c = &CreateRunnerGroupRequest{}
+	c.GetVisibility()
+	c = nil
+	c.GetVisibility()
 }
 
-func TestDeploymentStatus_GetEnvironmentURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{EnvironmentURL: &zeroValue}
-	d.GetEnvironmentURL()
-	d = &DeploymentStatus{}
-	d.GetEnvironmentURL()
-	d = nil
-	d.GetEnvironmentURL()
+func TestCreateUpdateEnvironment_GetCanAdminsBypass(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateUpdateEnvironment{CanAdminsBypass: &zeroValue}
+	c.GetCanAdminsBypass()
+	c = &CreateUpdateEnvironment{}
+	c.GetCanAdminsBypass()
+	c = nil
+	c.GetCanAdminsBypass()
 }
 
-func TestDeploymentStatus_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &DeploymentStatus{ID: &zeroValue}
-	d.GetID()
-	d = &DeploymentStatus{}
-	d.GetID()
-	d = nil
-	d.GetID()
+func TestCreateUpdateEnvironment_GetDeploymentBranchPolicy(tt *testing.T) {
+	c := &CreateUpdateEnvironment{}
+	c.GetDeploymentBranchPolicy()
+	c = nil
+	c.GetDeploymentBranchPolicy()
 }
 
-func TestDeploymentStatus_GetLogURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{LogURL: &zeroValue}
-	d.GetLogURL()
-	d = &DeploymentStatus{}
-	d.GetLogURL()
-	d = nil
-	d.GetLogURL()
+func TestCreateUpdateEnvironment_GetWaitTimer(tt *testing.T) {
+	var zeroValue int
+	c := &CreateUpdateEnvironment{WaitTimer: &zeroValue}
+	c.GetWaitTimer()
+	c = &CreateUpdateEnvironment{}
+	c.GetWaitTimer()
+	c = nil
+	c.GetWaitTimer()
 }
 
-func TestDeploymentStatus_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &DeploymentStatus{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
+func TestCreateUpdateRequiredWorkflowOptions_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	c := &CreateUpdateRequiredWorkflowOptions{RepositoryID: &zeroValue}
+	c.GetRepositoryID()
+	c = &CreateUpdateRequiredWorkflowOptions{}
+	c.GetRepositoryID()
+	c = nil
+	c.GetRepositoryID()
 }
 
-func TestDeploymentStatus_GetRepositoryURL(tt *testing.T) {
+func TestCreateUpdateRequiredWorkflowOptions_GetScope(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{RepositoryURL: &zeroValue}
-	d.GetRepositoryURL()
-	d = &DeploymentStatus{}
-	d.GetRepositoryURL()
-	d = nil
-	d.GetRepositoryURL()
+	c := &CreateUpdateRequiredWorkflowOptions{Scope: &zeroValue}
+	c.GetScope()
+	c = &CreateUpdateRequiredWorkflowOptions{}
+	c.GetScope()
+	c = nil
+	c.GetScope()
 }
 
-func TestDeploymentStatus_GetState(tt *testing.T) {
+func TestCreateUpdateRequiredWorkflowOptions_GetSelectedRepositoryIDs(tt *testing.T) {
+	c := &CreateUpdateRequiredWorkflowOptions{}
+	c.GetSelectedRepositoryIDs()
+	c = nil
+	c.GetSelectedRepositoryIDs()
+}
+
+func TestCreateUpdateRequiredWorkflowOptions_GetWorkflowFilePath(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{State: &zeroValue}
-	d.GetState()
-	d = &DeploymentStatus{}
-	d.GetState()
-	d = nil
-	d.GetState()
+	c := &CreateUpdateRequiredWorkflowOptions{WorkflowFilePath: &zeroValue}
+	c.GetWorkflowFilePath()
+	c = &CreateUpdateRequiredWorkflowOptions{}
+	c.GetWorkflowFilePath()
+	c = nil
+	c.GetWorkflowFilePath()
 }
 
-func TestDeploymentStatus_GetTargetURL(tt *testing.T) {
+func TestCreateUserProjectOptions_GetBody(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{TargetURL: &zeroValue}
-	d.GetTargetURL()
-	d = &DeploymentStatus{}
-	d.GetTargetURL()
-	d = nil
-	d.GetTargetURL()
+	c := &CreateUserProjectOptions{Body: &zeroValue}
+	c.GetBody()
+	c = &CreateUserProjectOptions{}
+	c.GetBody()
+	c = nil
+	c.GetBody()
 }
 
-func TestDeploymentStatus_GetUpdatedAt(tt *testing.T) {
+func TestCreationInfo_GetCreated(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DeploymentStatus{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &DeploymentStatus{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+	c := &CreationInfo{Created: &zeroValue}
+	c.GetCreated()
+	c = &CreationInfo{}
+	c.GetCreated()
+	c = nil
+	c.GetCreated()
 }
 
-func TestDeploymentStatus_GetURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{URL: &zeroValue}
-	d.GetURL()
-	d = &DeploymentStatus{}
-	d.GetURL()
-	d = nil
-	d.GetURL()
+func TestCustomDeploymentProtectionRule_GetApp(tt *testing.T) {
+	c := &CustomDeploymentProtectionRule{}
+	c.GetApp()
+	c = nil
+	c.GetApp()
 }
 
-func TestDeploymentStatusEvent_GetDeployment(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetDeployment()
-	d = nil
-	d.GetDeployment()
+func TestCustomDeploymentProtectionRule_GetEnabled(tt *testing.T) {
+	var zeroValue bool
+	c := &CustomDeploymentProtectionRule{Enabled: &zeroValue}
+	c.GetEnabled()
+	c = &CustomDeploymentProtectionRule{}
+	c.GetEnabled()
+	c = nil
+	c.GetEnabled()
 }
 
-func TestDeploymentStatusEvent_GetDeploymentStatus(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetDeploymentStatus()
-	d = nil
-	d.GetDeploymentStatus()
+func TestCustomDeploymentProtectionRule_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomDeploymentProtectionRule{ID: &zeroValue}
+	c.GetID()
+	c = &CustomDeploymentProtectionRule{}
+	c.GetID()
+	c = nil
+	c.GetID()
 }
 
-func TestDeploymentStatusEvent_GetInstallation(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetInstallation()
-	d = nil
-	d.GetInstallation()
+func TestCustomDeploymentProtectionRule_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDeploymentProtectionRule{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &CustomDeploymentProtectionRule{}
+	c.GetNodeID()
+	c = nil
+	c.GetNodeID()
 }
 
-func TestDeploymentStatusEvent_GetRepo(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetRepo()
-	d = nil
-	d.GetRepo()
+func TestCustomDeploymentProtectionRuleApp_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomDeploymentProtectionRuleApp{ID: &zeroValue}
+	c.GetID()
+	c = &CustomDeploymentProtectionRuleApp{}
+	c.GetID()
+	c = nil
+	c.GetID()
 }
 
-func TestDeploymentStatusEvent_GetSender(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetSender()
-	d = nil
-	d.GetSender()
+func TestCustomDeploymentProtectionRuleApp_GetIntegrationURL(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDeploymentProtectionRuleApp{IntegrationURL: &zeroValue}
+	c.GetIntegrationURL()
+	c = &CustomDeploymentProtectionRuleApp{}
+	c.GetIntegrationURL()
+	c = nil
+	c.GetIntegrationURL()
 }
 
-func TestDeploymentStatusRequest_GetAutoInactive(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentStatusRequest{AutoInactive: &zeroValue}
-	d.GetAutoInactive()
-	d = &DeploymentStatusRequest{}
-	d.GetAutoInactive()
-	d = nil
-	d.GetAutoInactive()
+func TestCustomDeploymentProtectionRuleApp_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDeploymentProtectionRuleApp{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &CustomDeploymentProtectionRuleApp{}
+	c.GetNodeID()
+	c = nil
+	c.GetNodeID()
 }
 
-func TestDeploymentStatusRequest_GetDescription(tt *testing.T) {
+func TestCustomDeploymentProtectionRuleApp_GetSlug(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentStatusRequest{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+	c := &CustomDeploymentProtectionRuleApp{Slug: &zeroValue}
+	c.GetSlug()
+	c = &CustomDeploymentProtectionRuleApp{}
+	c.GetSlug()
+	c = nil
+	c.GetSlug()
 }
 
-func TestDeploymentStatusRequest_GetEnvironment(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatusRequest{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentStatusRequest{}
-	d.GetEnvironment()
-	d = nil
-	d.GetEnvironment()
+func TestCustomDeploymentProtectionRuleRequest_GetIntegrationID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomDeploymentProtectionRuleRequest{IntegrationID: &zeroValue}
+	c.GetIntegrationID()
+	c = &CustomDeploymentProtectionRuleRequest{}
+	c.GetIntegrationID()
+	c = nil
+	c.GetIntegrationID()
 }
 
-func TestDeploymentStatusRequest_GetEnvironmentURL(tt *testing.T) {
+func TestCustomOrgRoles_GetBaseRole(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{EnvironmentURL: &zeroValue}
-	d.GetEnvironmentURL()
-	d = &DeploymentStatusRequest{}
-	d.GetEnvironmentURL()
-	d = nil
-	d.GetEnvironmentURL()
+	c := &CustomOrgRoles{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CustomOrgRoles{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
 }
 
-func TestDeploymentStatusRequest_GetLogURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatusRequest{LogURL: &zeroValue}
-	d.GetLogURL()
-	d = &DeploymentStatusRequest{}
-	d.GetLogURL()
-	d = nil
-	d.GetLogURL()
+func TestCustomOrgRoles_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomOrgRoles{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CustomOrgRoles{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
 }
 
-func TestDeploymentStatusRequest_GetState(tt *testing.T) {
+func TestCustomOrgRoles_GetDescription(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{State: &zeroValue}
-	d.GetState()
-	d = &DeploymentStatusRequest{}
-	d.GetState()
-	d = nil
-	d.GetState()
+	c := &CustomOrgRoles{Description: &zeroValue}
+	c.GetDescription()
+	c = &CustomOrgRoles{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
 }
 
-func TestDiscussion_GetActiveLockReason(tt *testing.T) {
+func TestCustomOrgRoles_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomOrgRoles{ID: &zeroValue}
+	c.GetID()
+	c = &CustomOrgRoles{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestCustomOrgRoles_GetName(tt *testing.T) {
 	var zeroValue string
-	d := &Discussion{ActiveLockReason: &zeroValue}
-	d.GetActiveLockReason()
-	d = &Discussion{}
-	d.GetActiveLockReason()
-	d = nil
-	d.GetActiveLockReason()
+	c := &CustomOrgRoles{Name: &zeroValue}
+	c.GetName()
+	c = &CustomOrgRoles{}
+	c.GetName()
+	c = nil
+	c.GetName()
 }
 
-func TestDiscussion_GetAnswerChosenAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &Discussion{AnswerChosenAt: &zeroValue}
-	d.GetAnswerChosenAt()
-	d = &Discussion{}
-	d.GetAnswerChosenAt()
-	d = nil
-	d.GetAnswerChosenAt()
+func TestCustomOrgRoles_GetOrg(tt *testing.T) {
+	c := &CustomOrgRoles{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
 }
 
-func TestDiscussion_GetAnswerChosenBy(tt *testing.T) {
+func TestCustomOrgRoles_GetSource(tt *testing.T) {
 	var zeroValue string
-	d := &Discussion{AnswerChosenBy: &zeroValue}
-	d.GetAnswerChosenBy()
-	d = &Discussion{}
-	d.GetAnswerChosenBy()
-	d = nil
-	d.GetAnswerChosenBy()
+	c := &CustomOrgRoles{Source: &zeroValue}
+	c.GetSource()
+	c = &CustomOrgRoles{}
+	c.GetSource()
+	c = nil
+	c.GetSource()
 }
 
-func TestDiscussion_GetAnswerHTMLURL(tt *testing.T) {
-	var zeroValue string
-	d := &Discussion{AnswerHTMLURL: &zeroValue}
-	d.GetAnswerHTMLURL()
-	d = &Discussion{}
-	d.GetAnswerHTMLURL()
-	d = nil
-	d.GetAnswerHTMLURL()
+func TestCustomOrgRoles_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomOrgRoles{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CustomOrgRoles{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
 }
 
-func TestDiscussion_GetAuthorAssociation(tt *testing.T) {
+func TestCustomProperty_GetDefaultValue(tt *testing.T) {
 	var zeroValue string
-	d := &Discussion{AuthorAssociation: &zeroValue}
-	d.GetAuthorAssociation()
-	d = &Discussion{}
-	d.GetAuthorAssociation()
-	d = nil
-	d.GetAuthorAssociation()
+	c := &CustomProperty{DefaultValue: &zeroValue}
+	c.GetDefaultValue()
+	c = &CustomProperty{}
+	c.GetDefaultValue()
+	c = nil
+	c.GetDefaultValue()
 }
 
-func TestDiscussion_GetBody(tt *testing.T) {
+func TestCustomProperty_GetDescription(tt *testing.T) {
 	var zeroValue string
-	d := &Discussion{Body: &zeroValue}
-	d.GetBody()
-	d = &Discussion{}
-	d.GetBody()
-	d = nil
-	d.GetBody()
+	c := &CustomProperty{Description: &zeroValue}
+	c.GetDescription()
+	c = &CustomProperty{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
 }
 
-func TestDiscussion_GetComments(tt *testing.T) {
-	var zeroValue int
-	d := &Discussion{Comments: &zeroValue}
-	d.GetComments()
-	d = &Discussion{}
-	d.GetComments()
-	d = nil
-	d.GetComments()
+func TestCustomProperty_GetPropertyName(tt *testing.T) {
+	var zeroValue string
+	c := &CustomProperty{PropertyName: &zeroValue}
+	c.GetPropertyName()
+	c = &CustomProperty{}
+	c.GetPropertyName()
+	c = nil
+	c.GetPropertyName()
 }
 
-func TestDiscussion_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &Discussion{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &Discussion{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestCustomProperty_GetRequired(tt *testing.T) {
+	var zeroValue bool
+	c := &CustomProperty{Required: &zeroValue}
+	c.GetRequired()
+	c = &CustomProperty{}
+	c.GetRequired()
+	c = nil
+	c.GetRequired()
 }
 
-func TestDiscussion_GetDiscussionCategory(tt *testing.T) {
-	d := &Discussion{}
-	d.GetDiscussionCategory()
-	d = nil
-	d.GetDiscussionCategory()
+func TestCustomProperty_GetValuesEditableBy(tt *testing.T) {
+	var zeroValue string
+	c := &CustomProperty{ValuesEditableBy: &zeroValue}
+	c.GetValuesEditableBy()
+	c = &CustomProperty{}
+	c.GetValuesEditableBy()
+	c = nil
+	c.GetValuesEditableBy()
 }
 
-func TestDiscussion_GetHTMLURL(tt *testing.T) {
+func TestCustomPropertyEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	d := &Discussion{HTMLURL: &zeroValue}
-	d.GetHTMLURL()
-	d = &Discussion{}
-	d.GetHTMLURL()
-	d = nil
-	d.GetHTMLURL()
+	c := &CustomPropertyEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CustomPropertyEvent{}
+	c.GetAction()
+	c = nil
+	c.GetAction()
 }
 
-func TestDiscussion_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &Discussion{ID: &zeroValue}
-	d.GetID()
-	d = &Discussion{}
-	d.GetID()
-	d = nil
-	d.GetID()
+func TestCustomPropertyEvent_GetDefinition(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetDefinition()
+	c = nil
+	c.GetDefinition()
 }
 
-func TestDiscussion_GetLocked(tt *testing.T) {
-	var zeroValue bool
-	d := &Discussion{Locked: &zeroValue}
-	d.GetLocked()
-	d = &Discussion{}
-	d.GetLocked()
-	d = nil
-	d.GetLocked()
+func TestCustomPropertyEvent_GetEnterprise(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetEnterprise()
+	c = nil
+	c.GetEnterprise()
 }
 
-func TestDiscussion_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	d := &Discussion{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &Discussion{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
+func TestCustomPropertyEvent_GetInstallation(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
 }
 
-func TestDiscussion_GetNumber(tt *testing.T) {
-	var zeroValue int
-	d := &Discussion{Number: &zeroValue}
-	d.GetNumber()
-	d = &Discussion{}
-	d.GetNumber()
-	d = nil
-	d.GetNumber()
+func TestCustomPropertyEvent_GetOrg(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
 }
 
-func TestDiscussion_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	d := &Discussion{RepositoryURL: &zeroValue}
-	d.GetRepositoryURL()
-	d = &Discussion{}
-	d.GetRepositoryURL()
-	d = nil
-	d.GetRepositoryURL()
+func TestCustomPropertyEvent_GetSender(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
 }
 
-func TestDiscussion_GetState(tt *testing.T) {
+func TestCustomPropertyValuesEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	d := &Discussion{State: &zeroValue}
-	d.GetState()
-	d = &Discussion{}
-	d.GetState()
-	d = nil
-	d.GetState()
+	c := &CustomPropertyValuesEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CustomPropertyValuesEvent{}
+	c.GetAction()
+	c = nil
+	c.GetAction()
 }
 
-func TestDiscussion_GetTitle(tt *testing.T) {
-	var zeroValue string
-	d := &Discussion{Title: &zeroValue}
-	d.GetTitle()
-	d = &Discussion{}
-	d.GetTitle()
-	d = nil
-	d.GetTitle()
+func TestCustomPropertyValuesEvent_GetEnterprise(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetEnterprise()
+	c = nil
+	c.GetEnterprise()
 }
 
-func TestDiscussion_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &Discussion{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &Discussion{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+func TestCustomPropertyValuesEvent_GetInstallation(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
 }
 
-func TestDiscussion_GetUser(tt *testing.T) {
-	d := &Discussion{}
-	d.GetUser()
-	d = nil
-	d.GetUser()
+func TestCustomPropertyValuesEvent_GetOrg(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
 }
 
-func TestDiscussionCategory_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionCategory{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &DiscussionCategory{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestCustomPropertyValuesEvent_GetRepo(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
 }
 
-func TestDiscussionCategory_GetDescription(tt *testing.T) {
-	var zeroValue string
-	d := &DiscussionCategory{Description: &zeroValue}
-	d.GetDescription()
-	d = &DiscussionCategory{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+func TestCustomPropertyValuesEvent_GetSender(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
 }
 
-func TestDiscussionCategory_GetEmoji(tt *testing.T) {
+func TestCustomRepoRoles_GetBaseRole(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionCategory{Emoji: &zeroValue}
-	d.GetEmoji()
-	d = &DiscussionCategory{}
-	d.GetEmoji()
-	d = nil
-	d.GetEmoji()
-}
-
-func TestDiscussionCategory_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &DiscussionCategory{ID: &zeroValue}
-	d.GetID()
-	d = &DiscussionCategory{}
-	d.GetID()
-	d = nil
-	d.GetID()
+	c := &CustomRepoRoles{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CustomRepoRoles{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
 }
 
-func TestDiscussionCategory_GetIsAnswerable(tt *testing.T) {
-	var zeroValue bool
-	d := &DiscussionCategory{IsAnswerable: &zeroValue}
-	d.GetIsAnswerable()
-	d = &DiscussionCategory{}
-	d.GetIsAnswerable()
-	d = nil
-	d.GetIsAnswerable()
-}
-
-func TestDiscussionCategory_GetName(tt *testing.T) {
-	var zeroValue string
-	d := &DiscussionCategory{Name: &zeroValue}
-	d.GetName()
-	d = &DiscussionCategory{}
-	d.GetName()
-	d = nil
-	d.GetName()
+func TestCustomRepoRoles_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomRepoRoles{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CustomRepoRoles{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
 }
 
-func TestDiscussionCategory_GetNodeID(tt *testing.T) {
+func TestCustomRepoRoles_GetDescription(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionCategory{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &DiscussionCategory{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
+	c := &CustomRepoRoles{Description: &zeroValue}
+	c.GetDescription()
+	c = &CustomRepoRoles{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
 }
 
-func TestDiscussionCategory_GetRepositoryID(tt *testing.T) {
+func TestCustomRepoRoles_GetID(tt *testing.T) {
 	var zeroValue int64
-	d := &DiscussionCategory{RepositoryID: &zeroValue}
-	d.GetRepositoryID()
-	d = &DiscussionCategory{}
-	d.GetRepositoryID()
-	d = nil
-	d.GetRepositoryID()
+	c := &CustomRepoRoles{ID: &zeroValue}
+	c.GetID()
+	c = &CustomRepoRoles{}
+	c.GetID()
+	c = nil
+	c.GetID()
 }
 
-func TestDiscussionCategory_GetSlug(tt *testing.T) {
+func TestCustomRepoRoles_GetName(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionCategory{Slug: &zeroValue}
-	d.GetSlug()
-	d = &DiscussionCategory{}
-	d.GetSlug()
-	d = nil
-	d.GetSlug()
+	c := &CustomRepoRoles{Name: &zeroValue}
+	c.GetName()
+	c = &CustomRepoRoles{}
+	c.GetName()
+	c = nil
+	c.GetName()
 }
 
-func TestDiscussionCategory_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionCategory{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &DiscussionCategory{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+func TestCustomRepoRoles_GetOrg(tt *testing.T) {
+	c := &CustomRepoRoles{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
 }
 
-func TestDiscussionComment_GetAuthor(tt *testing.T) {
-	d := &DiscussionComment{}
-	d.GetAuthor()
-	d = nil
-	d.GetAuthor()
+func TestCustomRepoRoles_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomRepoRoles{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CustomRepoRoles{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
 }
 
-func TestDiscussionComment_GetBody(tt *testing.T) {
+func TestDatadogStreamConfig_GetEncryptedToken(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{Body: &zeroValue}
-	d.GetBody()
-	d = &DiscussionComment{}
-	d.GetBody()
+	d := &DatadogStreamConfig{EncryptedToken: &zeroValue}
+	d.GetEncryptedToken()
+	d = &DatadogStreamConfig{}
+	d.GetEncryptedToken()
 	d = nil
-	d.GetBody()
+	d.GetEncryptedToken()
 }
 
-func TestDiscussionComment_GetBodyHTML(tt *testing.T) {
+func TestDatadogStreamConfig_GetKeyID(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{BodyHTML: &zeroValue}
-	d.GetBodyHTML()
-	d = &DiscussionComment{}
-	d.GetBodyHTML()
+	d := &DatadogStreamConfig{KeyID: &zeroValue}
+	d.GetKeyID()
+	d = &DatadogStreamConfig{}
+	d.GetKeyID()
 	d = nil
-	d.GetBodyHTML()
+	d.GetKeyID()
 }
 
-func TestDiscussionComment_GetBodyVersion(tt *testing.T) {
+func TestDatadogStreamConfig_GetSite(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{BodyVersion: &zeroValue}
-	d.GetBodyVersion()
-	d = &DiscussionComment{}
-	d.GetBodyVersion()
-	d = nil
-	d.GetBodyVersion()
-}
-
-func TestDiscussionComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionComment{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &DiscussionComment{}
-	d.GetCreatedAt()
+	d := &DatadogStreamConfig{Site: &zeroValue}
+	d.GetSite()
+	d = &DatadogStreamConfig{}
+	d.GetSite()
 	d = nil
-	d.GetCreatedAt()
+	d.GetSite()
 }
 
-func TestDiscussionComment_GetDiscussionURL(tt *testing.T) {
+func TestDefaultSetupConfiguration_GetQuerySuite(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{DiscussionURL: &zeroValue}
-	d.GetDiscussionURL()
-	d = &DiscussionComment{}
-	d.GetDiscussionURL()
+	d := &DefaultSetupConfiguration{QuerySuite: &zeroValue}
+	d.GetQuerySuite()
+	d = &DefaultSetupConfiguration{}
+	d.GetQuerySuite()
 	d = nil
-	d.GetDiscussionURL()
+	d.GetQuerySuite()
 }
 
-func TestDiscussionComment_GetHTMLURL(tt *testing.T) {
+func TestDefaultSetupConfiguration_GetSchedule(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{HTMLURL: &zeroValue}
-	d.GetHTMLURL()
-	d = &DiscussionComment{}
-	d.GetHTMLURL()
-	d = nil
-	d.GetHTMLURL()
-}
-
-func TestDiscussionComment_GetLastEditedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionComment{LastEditedAt: &zeroValue}
-	d.GetLastEditedAt()
-	d = &DiscussionComment{}
-	d.GetLastEditedAt()
+	d := &DefaultSetupConfiguration{Schedule: &zeroValue}
+	d.GetSchedule()
+	d = &DefaultSetupConfiguration{}
+	d.GetSchedule()
 	d = nil
-	d.GetLastEditedAt()
+	d.GetSchedule()
 }
 
-func TestDiscussionComment_GetNodeID(tt *testing.T) {
+func TestDefaultSetupConfiguration_GetState(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &DiscussionComment{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
-}
-
-func TestDiscussionComment_GetNumber(tt *testing.T) {
-	var zeroValue int
-	d := &DiscussionComment{Number: &zeroValue}
-	d.GetNumber()
-	d = &DiscussionComment{}
-	d.GetNumber()
-	d = nil
-	d.GetNumber()
-}
-
-func TestDiscussionComment_GetReactions(tt *testing.T) {
-	d := &DiscussionComment{}
-	d.GetReactions()
+	d := &DefaultSetupConfiguration{State: &zeroValue}
+	d.GetState()
+	d = &DefaultSetupConfiguration{}
+	d.GetState()
 	d = nil
-	d.GetReactions()
+	d.GetState()
 }
 
-func TestDiscussionComment_GetUpdatedAt(tt *testing.T) {
+func TestDefaultSetupConfiguration_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DiscussionComment{UpdatedAt: &zeroValue}
+	d := &DefaultSetupConfiguration{UpdatedAt: &zeroValue}
 	d.GetUpdatedAt()
-	d = &DiscussionComment{}
+	d = &DefaultSetupConfiguration{}
 	d.GetUpdatedAt()
 	d = nil
 	d.GetUpdatedAt()
 }
 
-func TestDiscussionComment_GetURL(tt *testing.T) {
+func TestDeleteAnalysis_GetConfirmDeleteURL(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{URL: &zeroValue}
-	d.GetURL()
-	d = &DiscussionComment{}
-	d.GetURL()
+	d := &DeleteAnalysis{ConfirmDeleteURL: &zeroValue}
+	d.GetConfirmDeleteURL()
+	d = &DeleteAnalysis{}
+	d.GetConfirmDeleteURL()
 	d = nil
-	d.GetURL()
+	d.GetConfirmDeleteURL()
 }
 
-func TestDiscussionCommentEvent_GetAction(tt *testing.T) {
+func TestDeleteAnalysis_GetNextAnalysisURL(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionCommentEvent{Action: &zeroValue}
-	d.GetAction()
-	d = &DiscussionCommentEvent{}
-	d.GetAction()
+	d := &DeleteAnalysis{NextAnalysisURL: &zeroValue}
+	d.GetNextAnalysisURL()
+	d = &DeleteAnalysis{}
+	d.GetNextAnalysisURL()
 	d = nil
-	d.GetAction()
-}
-
-func TestDiscussionCommentEvent_GetComment(tt *testing.T) {
-	d := &DiscussionCommentEvent{}
-	d.GetComment()
-	d = nil
-	d.GetComment()
+	d.GetNextAnalysisURL()
 }
 
-func TestDiscussionCommentEvent_GetDiscussion(tt *testing.T) {
-	d := &DiscussionCommentEvent{}
-	d.GetDiscussion()
-	d = nil
-	d.GetDiscussion()
-}
-
-func TestDiscussionCommentEvent_GetInstallation(tt *testing.T) {
-	d := &DiscussionCommentEvent{}
+func TestDeleteEvent_GetInstallation(tt *testing.T) {
+	d := &DeleteEvent{}
 	d.GetInstallation()
 	d = nil
 	d.GetInstallation()
 }
 
-func TestDiscussionCommentEvent_GetOrg(tt *testing.T) {
-	d := &DiscussionCommentEvent{}
+func TestDeleteEvent_GetOrg(tt *testing.T) {
+	d := &DeleteEvent{}
 	d.GetOrg()
 	d = nil
 	d.GetOrg()
 }
 
-func TestDiscussionCommentEvent_GetRepo(tt *testing.T) {
-	d := &DiscussionCommentEvent{}
-	d.GetRepo()
+func TestDeleteEvent_GetPusherType(tt *testing.T) {
+	var zeroValue string
+	d := &DeleteEvent{PusherType: &zeroValue}
+	d.GetPusherType()
+	d = &DeleteEvent{}
+	d.GetPusherType()
 	d = nil
-	d.GetRepo()
+	d.GetPusherType()
 }
 
-func TestDiscussionCommentEvent_GetSender(tt *testing.T) {
-	d := &DiscussionCommentEvent{}
-	d.GetSender()
+func TestDeleteEvent_GetRef(tt *testing.T) {
+	var zeroValue string
+	d := &DeleteEvent{Ref: &zeroValue}
+	d.GetRef()
+	d = &DeleteEvent{}
+	d.GetRef()
 	d = nil
-	d.GetSender()
+	d.GetRef()
 }
 
-func TestDiscussionEvent_GetAction(tt *testing.T) {
+func TestDeleteEvent_GetRefType(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionEvent{Action: &zeroValue}
-	d.GetAction()
-	d = &DiscussionEvent{}
-	d.GetAction()
+	d := &DeleteEvent{RefType: &zeroValue}
+	d.GetRefType()
+	d = &DeleteEvent{}
+	d.GetRefType()
 	d = nil
-	d.GetAction()
+	d.GetRefType()
 }
 
-func TestDiscussionEvent_GetDiscussion(tt *testing.T) {
-	d := &DiscussionEvent{}
-	d.GetDiscussion()
+func TestDeleteEvent_GetRepo(tt *testing.T) {
+	d := &DeleteEvent{}
+	d.GetRepo()
 	d = nil
-	d.GetDiscussion()
+	d.GetRepo()
 }
 
-func TestDiscussionEvent_GetInstallation(tt *testing.T) {
-	d := &DiscussionEvent{}
-	d.GetInstallation()
+func TestDeleteEvent_GetSender(tt *testing.T) {
+	d := &DeleteEvent{}
+	d.GetSender()
 	d = nil
-	d.GetInstallation()
+	d.GetSender()
 }
 
-func TestDiscussionEvent_GetOrg(tt *testing.T) {
-	d := &DiscussionEvent{}
-	d.GetOrg()
+func TestDependabotAlert_GetAutoDismissedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{AutoDismissedAt: &zeroValue}
+	d.GetAutoDismissedAt()
+	d = &DependabotAlert{}
+	d.GetAutoDismissedAt()
 	d = nil
-	d.GetOrg()
+	d.GetAutoDismissedAt()
 }
 
-func TestDiscussionEvent_GetRepo(tt *testing.T) {
-	d := &DiscussionEvent{}
-	d.GetRepo()
-	d = nil
-	d.GetRepo()
-}
-
-func TestDiscussionEvent_GetSender(tt *testing.T) {
-	d := &DiscussionEvent{}
-	d.GetSender()
+func TestDependabotAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DependabotAlert{}
+	d.GetCreatedAt()
 	d = nil
-	d.GetSender()
+	d.GetCreatedAt()
 }
 
-func TestDismissalRestrictionsRequest_GetApps(tt *testing.T) {
-	var zeroValue []string
-	d := &DismissalRestrictionsRequest{Apps: &zeroValue}
-	d.GetApps()
-	d = &DismissalRestrictionsRequest{}
-	d.GetApps()
+func TestDependabotAlert_GetDependency(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetDependency()
 	d = nil
-	d.GetApps()
+	d.GetDependency()
 }
 
-func TestDismissalRestrictionsRequest_GetTeams(tt *testing.T) {
-	var zeroValue []string
-	d := &DismissalRestrictionsRequest{Teams: &zeroValue}
-	d.GetTeams()
-	d = &DismissalRestrictionsRequest{}
-	d.GetTeams()
+func TestDependabotAlert_GetDismissedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{DismissedAt: &zeroValue}
+	d.GetDismissedAt()
+	d = &DependabotAlert{}
+	d.GetDismissedAt()
 	d = nil
-	d.GetTeams()
+	d.GetDismissedAt()
 }
 
-func TestDismissalRestrictionsRequest_GetUsers(tt *testing.T) {
-	var zeroValue []string
-	d := &DismissalRestrictionsRequest{Users: &zeroValue}
-	d.GetUsers()
-	d = &DismissalRestrictionsRequest{}
-	d.GetUsers()
+func TestDependabotAlert_GetDismissedBy(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetDismissedBy()
 	d = nil
-	d.GetUsers()
+	d.GetDismissedBy()
 }
 
-func TestDismissedReview_GetDismissalCommitID(tt *testing.T) {
+func TestDependabotAlert_GetDismissedComment(tt *testing.T) {
 	var zeroValue string
-	d := &DismissedReview{DismissalCommitID: &zeroValue}
-	d.GetDismissalCommitID()
-	d = &DismissedReview{}
-	d.GetDismissalCommitID()
+	d := &DependabotAlert{DismissedComment: &zeroValue}
+	d.GetDismissedComment()
+	d = &DependabotAlert{}
+	d.GetDismissedComment()
 	d = nil
-	d.GetDismissalCommitID()
+	d.GetDismissedComment()
 }
 
-func TestDismissedReview_GetDismissalMessage(tt *testing.T) {
+func TestDependabotAlert_GetDismissedReason(tt *testing.T) {
 	var zeroValue string
-	d := &DismissedReview{DismissalMessage: &zeroValue}
-	d.GetDismissalMessage()
-	d = &DismissedReview{}
-	d.GetDismissalMessage()
+	d := &DependabotAlert{DismissedReason: &zeroValue}
+	d.GetDismissedReason()
+	d = &DependabotAlert{}
+	d.GetDismissedReason()
 	d = nil
-	d.GetDismissalMessage()
+	d.GetDismissedReason()
 }
 
-func TestDismissedReview_GetReviewID(tt *testing.T) {
-	var zeroValue int64
-	d := &DismissedReview{ReviewID: &zeroValue}
-	d.GetReviewID()
-	d = &DismissedReview{}
-	d.GetReviewID()
+func TestDependabotAlert_GetFixedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{FixedAt: &zeroValue}
+	d.GetFixedAt()
+	d = &DependabotAlert{}
+	d.GetFixedAt()
 	d = nil
-	d.GetReviewID()
+	d.GetFixedAt()
 }
 
-func TestDismissedReview_GetState(tt *testing.T) {
+func TestDependabotAlert_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	d := &DismissedReview{State: &zeroValue}
-	d.GetState()
-	d = &DismissedReview{}
-	d.GetState()
+	d := &DependabotAlert{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DependabotAlert{}
+	d.GetHTMLURL()
 	d = nil
-	d.GetState()
+	d.GetHTMLURL()
 }
 
-func TestDismissStaleReviewsOnPushChanges_GetFrom(tt *testing.T) {
-	var zeroValue bool
-	d := &DismissStaleReviewsOnPushChanges{From: &zeroValue}
-	d.GetFrom()
-	d = &DismissStaleReviewsOnPushChanges{}
-	d.GetFrom()
+func TestDependabotAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &DependabotAlert{Number: &zeroValue}
+	d.GetNumber()
+	d = &DependabotAlert{}
+	d.GetNumber()
 	d = nil
-	d.GetFrom()
+	d.GetNumber()
 }
 
-func TestDispatchRequestOptions_GetClientPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	d := &DispatchRequestOptions{ClientPayload: &zeroValue}
-	d.GetClientPayload()
-	d = &DispatchRequestOptions{}
-	d.GetClientPayload()
+func TestDependabotAlert_GetRepository(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetRepository()
 	d = nil
-	d.GetClientPayload()
+	d.GetRepository()
 }
 
-func TestDraftReviewComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	d := &DraftReviewComment{Body: &zeroValue}
-	d.GetBody()
-	d = &DraftReviewComment{}
-	d.GetBody()
+func TestDependabotAlert_GetSecurityAdvisory(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetSecurityAdvisory()
 	d = nil
-	d.GetBody()
+	d.GetSecurityAdvisory()
 }
 
-func TestDraftReviewComment_GetLine(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{Line: &zeroValue}
-	d.GetLine()
-	d = &DraftReviewComment{}
-	d.GetLine()
+func TestDependabotAlert_GetSecurityVulnerability(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetSecurityVulnerability()
 	d = nil
-	d.GetLine()
+	d.GetSecurityVulnerability()
 }
 
-func TestDraftReviewComment_GetPath(tt *testing.T) {
+func TestDependabotAlert_GetState(tt *testing.T) {
 	var zeroValue string
-	d := &DraftReviewComment{Path: &zeroValue}
-	d.GetPath()
-	d = &DraftReviewComment{}
-	d.GetPath()
+	d := &DependabotAlert{State: &zeroValue}
+	d.GetState()
+	d = &DependabotAlert{}
+	d.GetState()
 	d = nil
-	d.GetPath()
+	d.GetState()
 }
 
-func TestDraftReviewComment_GetPosition(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{Position: &zeroValue}
-	d.GetPosition()
-	d = &DraftReviewComment{}
-	d.GetPosition()
+func TestDependabotAlert_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DependabotAlert{}
+	d.GetUpdatedAt()
 	d = nil
-	d.GetPosition()
+	d.GetUpdatedAt()
 }
 
-func TestDraftReviewComment_GetSide(tt *testing.T) {
+func TestDependabotAlert_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &DraftReviewComment{Side: &zeroValue}
-	d.GetSide()
-	d = &DraftReviewComment{}
-	d.GetSide()
+	d := &DependabotAlert{URL: &zeroValue}
+	d.GetURL()
+	d = &DependabotAlert{}
+	d.GetURL()
 	d = nil
-	d.GetSide()
+	d.GetURL()
 }
 
-func TestDraftReviewComment_GetStartLine(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{StartLine: &zeroValue}
-	d.GetStartLine()
-	d = &DraftReviewComment{}
-	d.GetStartLine()
+func TestDependabotAlertState_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlertState{DismissedComment: &zeroValue}
+	d.GetDismissedComment()
+	d = &DependabotAlertState{}
+	d.GetDismissedComment()
 	d = nil
-	d.GetStartLine()
+	d.GetDismissedComment()
 }
 
-func TestDraftReviewComment_GetStartSide(tt *testing.T) {
+func TestDependabotAlertState_GetDismissedReason(tt *testing.T) {
 	var zeroValue string
-	d := &DraftReviewComment{StartSide: &zeroValue}
-	d.GetStartSide()
-	d = &DraftReviewComment{}
-	d.GetStartSide()
+	d := &DependabotAlertState{DismissedReason: &zeroValue}
+	d.GetDismissedReason()
+	d = &DependabotAlertState{}
+	d.GetDismissedReason()
 	d = nil
-	d.GetStartSide()
+	d.GetDismissedReason()
 }
 
-func TestEditBase_GetRef(tt *testing.T) {
-	e := &EditBase{}
-	e.GetRef()
-	e = nil
-	e.GetRef()
+func TestDependabotSecurityAdvisory_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{CVEID: &zeroValue}
+	d.GetCVEID()
+	d = &DependabotSecurityAdvisory{}
+	d.GetCVEID()
+	d = nil
+	d.GetCVEID()
 }
 
-func TestEditBase_GetSHA(tt *testing.T) {
-	e := &EditBase{}
-	e.GetSHA()
-	e = nil
-	e.GetSHA()
+func TestDependabotSecurityAdvisory_GetCVSs(tt *testing.T) {
+	d := &DependabotSecurityAdvisory{}
+	d.GetCVSs()
+	d = nil
+	d.GetCVSs()
 }
 
-func TestEditBody_GetFrom(tt *testing.T) {
+func TestDependabotSecurityAdvisory_GetCVSSSeverities(tt *testing.T) {
+	d := &DependabotSecurityAdvisory{}
+	d.GetCVSSSeverities()
+	d = nil
+	d.GetCVSSSeverities()
+}
+
+func TestDependabotSecurityAdvisory_GetDescription(tt *testing.T) {
 	var zeroValue string
-	e := &EditBody{From: &zeroValue}
-	e.GetFrom()
-	e = &EditBody{}
-	e.GetFrom()
-	e = nil
-	e.GetFrom()
+	d := &DependabotSecurityAdvisory{Description: &zeroValue}
+	d.GetDescription()
+	d = &DependabotSecurityAdvisory{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
 }
 
-func TestEditChange_GetBase(tt *testing.T) {
-	e := &EditChange{}
-	e.GetBase()
-	e = nil
-	e.GetBase()
+func TestDependabotSecurityAdvisory_GetEPSS(tt *testing.T) {
+	d := &DependabotSecurityAdvisory{}
+	d.GetEPSS()
+	d = nil
+	d.GetEPSS()
 }
 
-func TestEditChange_GetBody(tt *testing.T) {
-	e := &EditChange{}
-	e.GetBody()
-	e = nil
-	e.GetBody()
+func TestDependabotSecurityAdvisory_GetGHSAID(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{GHSAID: &zeroValue}
+	d.GetGHSAID()
+	d = &DependabotSecurityAdvisory{}
+	d.GetGHSAID()
+	d = nil
+	d.GetGHSAID()
 }
 
-func TestEditChange_GetRepo(tt *testing.T) {
-	e := &EditChange{}
-	e.GetRepo()
-	e = nil
-	e.GetRepo()
+func TestDependabotSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{PublishedAt: &zeroValue}
+	d.GetPublishedAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetPublishedAt()
+	d = nil
+	d.GetPublishedAt()
 }
 
-func TestEditChange_GetTitle(tt *testing.T) {
-	e := &EditChange{}
-	e.GetTitle()
-	e = nil
-	e.GetTitle()
+func TestDependabotSecurityAdvisory_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{Severity: &zeroValue}
+	d.GetSeverity()
+	d = &DependabotSecurityAdvisory{}
+	d.GetSeverity()
+	d = nil
+	d.GetSeverity()
 }
 
-func TestEditRef_GetFrom(tt *testing.T) {
+func TestDependabotSecurityAdvisory_GetSummary(tt *testing.T) {
 	var zeroValue string
-	e := &EditRef{From: &zeroValue}
-	e.GetFrom()
-	e = &EditRef{}
-	e.GetFrom()
-	e = nil
-	e.GetFrom()
+	d := &DependabotSecurityAdvisory{Summary: &zeroValue}
+	d.GetSummary()
+	d = &DependabotSecurityAdvisory{}
+	d.GetSummary()
+	d = nil
+	d.GetSummary()
 }
 
-func TestEditRepo_GetName(tt *testing.T) {
-	e := &EditRepo{}
-	e.GetName()
-	e = nil
-	e.GetName()
+func TestDependabotSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
 }
 
-func TestEditSHA_GetFrom(tt *testing.T) {
-	var zeroValue string
-	e := &EditSHA{From: &zeroValue}
-	e.GetFrom()
-	e = &EditSHA{}
-	e.GetFrom()
-	e = nil
-	e.GetFrom()
+func TestDependabotSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{WithdrawnAt: &zeroValue}
+	d.GetWithdrawnAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetWithdrawnAt()
+	d = nil
+	d.GetWithdrawnAt()
 }
 
-func TestEditTitle_GetFrom(tt *testing.T) {
+func TestDependabotSecurityUpdates_GetStatus(tt *testing.T) {
 	var zeroValue string
-	e := &EditTitle{From: &zeroValue}
-	e.GetFrom()
-	e = &EditTitle{}
-	e.GetFrom()
-	e = nil
-	e.GetFrom()
+	d := &DependabotSecurityUpdates{Status: &zeroValue}
+	d.GetStatus()
+	d = &DependabotSecurityUpdates{}
+	d.GetStatus()
+	d = nil
+	d.GetStatus()
 }
 
-func TestEnterprise_GetAvatarURL(tt *testing.T) {
+func TestDependency_GetManifestPath(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{AvatarURL: &zeroValue}
-	e.GetAvatarURL()
-	e = &Enterprise{}
-	e.GetAvatarURL()
-	e = nil
-	e.GetAvatarURL()
+	d := &Dependency{ManifestPath: &zeroValue}
+	d.GetManifestPath()
+	d = &Dependency{}
+	d.GetManifestPath()
+	d = nil
+	d.GetManifestPath()
 }
 
-func TestEnterprise_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &Enterprise{CreatedAt: &zeroValue}
-	e.GetCreatedAt()
-	e = &Enterprise{}
-	e.GetCreatedAt()
-	e = nil
-	e.GetCreatedAt()
+func TestDependency_GetPackage(tt *testing.T) {
+	d := &Dependency{}
+	d.GetPackage()
+	d = nil
+	d.GetPackage()
 }
 
-func TestEnterprise_GetDescription(tt *testing.T) {
+func TestDependency_GetScope(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{Description: &zeroValue}
-	e.GetDescription()
-	e = &Enterprise{}
-	e.GetDescription()
-	e = nil
-	e.GetDescription()
+	d := &Dependency{Scope: &zeroValue}
+	d.GetScope()
+	d = &Dependency{}
+	d.GetScope()
+	d = nil
+	d.GetScope()
 }
 
-func TestEnterprise_GetHTMLURL(tt *testing.T) {
+func TestDependencyChange_GetChangeType(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{HTMLURL: &zeroValue}
-	e.GetHTMLURL()
-	e = &Enterprise{}
-	e.GetHTMLURL()
-	e = nil
-	e.GetHTMLURL()
+	d := &DependencyChange{ChangeType: &zeroValue}
+	d.GetChangeType()
+	d = &DependencyChange{}
+	d.GetChangeType()
+	d = nil
+	d.GetChangeType()
 }
 
-func TestEnterprise_GetID(tt *testing.T) {
-	var zeroValue int
-	e := &Enterprise{ID: &zeroValue}
-	e.GetID()
-	e = &Enterprise{}
-	e.GetID()
-	e = nil
-	e.GetID()
+func TestDependencyChange_GetEcosystem(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{Ecosystem: &zeroValue}
+	d.GetEcosystem()
+	d = &DependencyChange{}
+	d.GetEcosystem()
+	d = nil
+	d.GetEcosystem()
 }
 
-func TestEnterprise_GetName(tt *testing.T) {
+func TestDependencyChange_GetLicense(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{Name: &zeroValue}
-	e.GetName()
-	e = &Enterprise{}
-	e.GetName()
-	e = nil
-	e.GetName()
+	d := &DependencyChange{License: &zeroValue}
+	d.GetLicense()
+	d = &DependencyChange{}
+	d.GetLicense()
+	d = nil
+	d.GetLicense()
 }
 
-func TestEnterprise_GetNodeID(tt *testing.T) {
+func TestDependencyChange_GetManifest(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{NodeID: &zeroValue}
-	e.GetNodeID()
-	e = &Enterprise{}
-	e.GetNodeID()
-	e = nil
-	e.GetNodeID()
+	d := &DependencyChange{Manifest: &zeroValue}
+	d.GetManifest()
+	d = &DependencyChange{}
+	d.GetManifest()
+	d = nil
+	d.GetManifest()
 }
 
-func TestEnterprise_GetSlug(tt *testing.T) {
+func TestDependencyChange_GetName(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{Slug: &zeroValue}
-	e.GetSlug()
-	e = &Enterprise{}
-	e.GetSlug()
-	e = nil
-	e.GetSlug()
+	d := &DependencyChange{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyChange{}
+	d.GetName()
+	d = nil
+	d.GetName()
 }
 
-func TestEnterprise_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &Enterprise{UpdatedAt: &zeroValue}
-	e.GetUpdatedAt()
-	e = &Enterprise{}
-	e.GetUpdatedAt()
-	e = nil
-	e.GetUpdatedAt()
+func TestDependencyChange_GetPackageURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{PackageURL: &zeroValue}
+	d.GetPackageURL()
+	d = &DependencyChange{}
+	d.GetPackageURL()
+	d = nil
+	d.GetPackageURL()
 }
 
-func TestEnterprise_GetWebsiteURL(tt *testing.T) {
+func TestDependencyChange_GetScope(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{WebsiteURL: &zeroValue}
-	e.GetWebsiteURL()
-	e = &Enterprise{}
-	e.GetWebsiteURL()
-	e = nil
-	e.GetWebsiteURL()
+	d := &DependencyChange{Scope: &zeroValue}
+	d.GetScope()
+	d = &DependencyChange{}
+	d.GetScope()
+	d = nil
+	d.GetScope()
 }
 
-func TestEnterpriseSecurityAnalysisSettings_GetAdvancedSecurityEnabledForNewRepositories(tt *testing.T) {
-	var zeroValue bool
-	e := &EnterpriseSecurityAnalysisSettings{AdvancedSecurityEnabledForNewRepositories: &zeroValue}
-	e.GetAdvancedSecurityEnabledForNewRepositories()
-	e = &EnterpriseSecurityAnalysisSettings{}
-	e.GetAdvancedSecurityEnabledForNewRepositories()
-	e = nil
-	e.GetAdvancedSecurityEnabledForNewRepositories()
+func TestDependencyChange_GetSourceRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{SourceRepositoryURL: &zeroValue}
+	d.GetSourceRepositoryURL()
+	d = &DependencyChange{}
+	d.GetSourceRepositoryURL()
+	d = nil
+	d.GetSourceRepositoryURL()
 }
 
-func TestEnterpriseSecurityAnalysisSettings_GetSecretScanningEnabledForNewRepositories(tt *testing.T) {
-	var zeroValue bool
-	e := &EnterpriseSecurityAnalysisSettings{SecretScanningEnabledForNewRepositories: &zeroValue}
-	e.GetSecretScanningEnabledForNewRepositories()
-	e = &EnterpriseSecurityAnalysisSettings{}
-	e.GetSecretScanningEnabledForNewRepositories()
-	e = nil
-	e.GetSecretScanningEnabledForNewRepositories()
+func TestDependencyChange_GetVersion(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{Version: &zeroValue}
+	d.GetVersion()
+	d = &DependencyChange{}
+	d.GetVersion()
+	d = nil
+	d.GetVersion()
 }
 
-func TestEnterpriseSecurityAnalysisSettings_GetSecretScanningPushProtectionCustomLink(tt *testing.T) {
-	var zeroValue string
-	e := &EnterpriseSecurityAnalysisSettings{SecretScanningPushProtectionCustomLink: &zeroValue}
-	e.GetSecretScanningPushProtectionCustomLink()
-	e = &EnterpriseSecurityAnalysisSettings{}
-	e.GetSecretScanningPushProtectionCustomLink()
-	e = nil
-	e.GetSecretScanningPushProtectionCustomLink()
+func TestDependencyGraphSnapshot_GetDetector(tt *testing.T) {
+	d := &DependencyGraphSnapshot{}
+	d.GetDetector()
+	d = nil
+	d.GetDetector()
 }
 
-func TestEnterpriseSecurityAnalysisSettings_GetSecretScanningPushProtectionEnabledForNewRepositories(tt *testing.T) {
-	var zeroValue bool
-	e := &EnterpriseSecurityAnalysisSettings{SecretScanningPushProtectionEnabledForNewRepositories: &zeroValue}
-	e.GetSecretScanningPushProtectionEnabledForNewRepositories()
-	e = &EnterpriseSecurityAnalysisSettings{}
-	e.GetSecretScanningPushProtectionEnabledForNewRepositories()
-	e = nil
-	e.GetSecretScanningPushProtectionEnabledForNewRepositories()
+func TestDependencyGraphSnapshot_GetJob(tt *testing.T) {
+	d := &DependencyGraphSnapshot{}
+	d.GetJob()
+	d = nil
+	d.GetJob()
 }
 
-func TestEnvironment_GetCanAdminsBypass(tt *testing.T) {
-	var zeroValue bool
-	e := &Environment{CanAdminsBypass: &zeroValue}
-	e.GetCanAdminsBypass()
-	e = &Environment{}
-	e.GetCanAdminsBypass()
-	e = nil
-	e.GetCanAdminsBypass()
+func TestDependencyGraphSnapshot_GetRef(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshot{Ref: &zeroValue}
+	d.GetRef()
+	d = &DependencyGraphSnapshot{}
+	d.GetRef()
+	d = nil
+	d.GetRef()
 }
 
-func TestEnvironment_GetCreatedAt(tt *testing.T) {
+func TestDependencyGraphSnapshot_GetScanned(tt *testing.T) {
 	var zeroValue Timestamp
-	e := &Environment{CreatedAt: &zeroValue}
-	e.GetCreatedAt()
-	e = &Environment{}
-	e.GetCreatedAt()
-	e = nil
-	e.GetCreatedAt()
+	d := &DependencyGraphSnapshot{Scanned: &zeroValue}
+	d.GetScanned()
+	d = &DependencyGraphSnapshot{}
+	d.GetScanned()
+	d = nil
+	d.GetScanned()
 }
 
-func TestEnvironment_GetDeploymentBranchPolicy(tt *testing.T) {
-	e := &Environment{}
-	e.GetDeploymentBranchPolicy()
-	e = nil
-	e.GetDeploymentBranchPolicy()
-}
-
-func TestEnvironment_GetEnvironmentName(tt *testing.T) {
+func TestDependencyGraphSnapshot_GetSHA(tt *testing.T) {
 	var zeroValue string
-	e := &Environment{EnvironmentName: &zeroValue}
-	e.GetEnvironmentName()
-	e = &Environment{}
-	e.GetEnvironmentName()
-	e = nil
-	e.GetEnvironmentName()
+	d := &DependencyGraphSnapshot{SHA: &zeroValue}
+	d.GetSHA()
+	d = &DependencyGraphSnapshot{}
+	d.GetSHA()
+	d = nil
+	d.GetSHA()
 }
 
-func TestEnvironment_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	e := &Environment{HTMLURL: &zeroValue}
-	e.GetHTMLURL()
-	e = &Environment{}
-	e.GetHTMLURL()
-	e = nil
-	e.GetHTMLURL()
+func TestDependencyGraphSnapshotCreationData_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependencyGraphSnapshotCreationData{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
 }
 
-func TestEnvironment_GetID(tt *testing.T) {
+func TestDependencyGraphSnapshotCreationData_GetID(tt *testing.T) {
 	var zeroValue int64
-	e := &Environment{ID: &zeroValue}
-	e.GetID()
-	e = &Environment{}
-	e.GetID()
-	e = nil
-	e.GetID()
+	d := &DependencyGraphSnapshotCreationData{ID: &zeroValue}
+	d.GetID()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetID()
+	d = nil
+	d.GetID()
 }
 
-func TestEnvironment_GetName(tt *testing.T) {
+func TestDependencyGraphSnapshotCreationData_GetMessage(tt *testing.T) {
 	var zeroValue string
-	e := &Environment{Name: &zeroValue}
-	e.GetName()
-	e = &Environment{}
-	e.GetName()
-	e = nil
-	e.GetName()
+	d := &DependencyGraphSnapshotCreationData{Message: &zeroValue}
+	d.GetMessage()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetMessage()
+	d = nil
+	d.GetMessage()
 }
 
-func TestEnvironment_GetNodeID(tt *testing.T) {
+func TestDependencyGraphSnapshotCreationData_GetResult(tt *testing.T) {
 	var zeroValue string
-	e := &Environment{NodeID: &zeroValue}
-	e.GetNodeID()
-	e = &Environment{}
-	e.GetNodeID()
-	e = nil
-	e.GetNodeID()
+	d := &DependencyGraphSnapshotCreationData{Result: &zeroValue}
+	d.GetResult()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetResult()
+	d = nil
+	d.GetResult()
 }
 
-func TestEnvironment_GetOwner(tt *testing.T) {
+func TestDependencyGraphSnapshotDetector_GetName(tt *testing.T) {
 	var zeroValue string
-	e := &Environment{Owner: &zeroValue}
-	e.GetOwner()
-	e = &Environment{}
-	e.GetOwner()
-	e = nil
-	e.GetOwner()
+	d := &DependencyGraphSnapshotDetector{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetName()
+	d = nil
+	d.GetName()
 }
 
-func TestEnvironment_GetRepo(tt *testing.T) {
+func TestDependencyGraphSnapshotDetector_GetURL(tt *testing.T) {
 	var zeroValue string
-	e := &Environment{Repo: &zeroValue}
-	e.GetRepo()
-	e = &Environment{}
-	e.GetRepo()
-	e = nil
-	e.GetRepo()
+	d := &DependencyGraphSnapshotDetector{URL: &zeroValue}
+	d.GetURL()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
 }
 
-func TestEnvironment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &Environment{UpdatedAt: &zeroValue}
-	e.GetUpdatedAt()
-	e = &Environment{}
-	e.GetUpdatedAt()
-	e = nil
-	e.GetUpdatedAt()
+func TestDependencyGraphSnapshotDetector_GetVersion(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotDetector{Version: &zeroValue}
+	d.GetVersion()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetVersion()
+	d = nil
+	d.GetVersion()
 }
 
-func TestEnvironment_GetURL(tt *testing.T) {
+func TestDependencyGraphSnapshotJob_GetCorrelator(tt *testing.T) {
 	var zeroValue string
-	e := &Environment{URL: &zeroValue}
-	e.GetURL()
-	e = &Environment{}
-	e.GetURL()
-	e = nil
-	e.GetURL()
+	d := &DependencyGraphSnapshotJob{Correlator: &zeroValue}
+	d.GetCorrelator()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetCorrelator()
+	d = nil
+	d.GetCorrelator()
 }
 
-func TestEnvironment_GetWaitTimer(tt *testing.T) {
-	var zeroValue int
-	e := &Environment{WaitTimer: &zeroValue}
-	e.GetWaitTimer()
-	e = &Environment{}
-	e.GetWaitTimer()
-	e = nil
-	e.GetWaitTimer()
+func TestDependencyGraphSnapshotJob_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotJob{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
 }
 
-func TestEnvResponse_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	e := &EnvResponse{TotalCount: &zeroValue}
-	e.GetTotalCount()
-	e = &EnvResponse{}
-	e.GetTotalCount()
-	e = nil
-	e.GetTotalCount()
+func TestDependencyGraphSnapshotJob_GetID(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotJob{ID: &zeroValue}
+	d.GetID()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetID()
+	d = nil
+	d.GetID()
 }
 
-func TestEnvReviewers_GetID(tt *testing.T) {
-	var zeroValue int64
-	e := &EnvReviewers{ID: &zeroValue}
-	e.GetID()
-	e = &EnvReviewers{}
-	e.GetID()
-	e = nil
-	e.GetID()
+func TestDependencyGraphSnapshotManifest_GetFile(tt *testing.T) {
+	d := &DependencyGraphSnapshotManifest{}
+	d.GetFile()
+	d = nil
+	d.GetFile()
 }
 
-func TestEnvReviewers_GetType(tt *testing.T) {
+func TestDependencyGraphSnapshotManifest_GetName(tt *testing.T) {
 	var zeroValue string
-	e := &EnvReviewers{Type: &zeroValue}
-	e.GetType()
-	e = &EnvReviewers{}
-	e.GetType()
-	e = nil
-	e.GetType()
+	d := &DependencyGraphSnapshotManifest{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyGraphSnapshotManifest{}
+	d.GetName()
+	d = nil
+	d.GetName()
 }
 
-func TestErrorBlock_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &ErrorBlock{CreatedAt: &zeroValue}
-	e.GetCreatedAt()
-	e = &ErrorBlock{}
-	e.GetCreatedAt()
-	e = nil
-	e.GetCreatedAt()
+func TestDependencyGraphSnapshotManifestFile_GetSourceLocation(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotManifestFile{SourceLocation: &zeroValue}
+	d.GetSourceLocation()
+	d = &DependencyGraphSnapshotManifestFile{}
+	d.GetSourceLocation()
+	d = nil
+	d.GetSourceLocation()
 }
 
-func TestErrorResponse_GetBlock(tt *testing.T) {
-	e := &ErrorResponse{}
-	e.GetBlock()
-	e = nil
-	e.GetBlock()
+func TestDependencyGraphSnapshotResolvedDependency_GetPackageURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotResolvedDependency{PackageURL: &zeroValue}
+	d.GetPackageURL()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetPackageURL()
+	d = nil
+	d.GetPackageURL()
 }
 
-func TestEvent_GetActor(tt *testing.T) {
-	e := &Event{}
-	e.GetActor()
-	e = nil
-	e.GetActor()
+func TestDependencyGraphSnapshotResolvedDependency_GetRelationship(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotResolvedDependency{Relationship: &zeroValue}
+	d.GetRelationship()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetRelationship()
+	d = nil
+	d.GetRelationship()
 }
 
-func TestEvent_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &Event{CreatedAt: &zeroValue}
-	e.GetCreatedAt()
-	e = &Event{}
-	e.GetCreatedAt()
-	e = nil
-	e.GetCreatedAt()
+func TestDependencyGraphSnapshotResolvedDependency_GetScope(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotResolvedDependency{Scope: &zeroValue}
+	d.GetScope()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetScope()
+	d = nil
+	d.GetScope()
 }
 
-func TestEvent_GetID(tt *testing.T) {
+func TestDependencyVulnerability_GetAdvisoryGHSAID(tt *testing.T) {
 	var zeroValue string
-	e := &Event{ID: &zeroValue}
-	e.GetID()
-	e = &Event{}
-	e.GetID()
-	e = nil
-	e.GetID()
+	d := &DependencyVulnerability{AdvisoryGHSAID: &zeroValue}
+	d.GetAdvisoryGHSAID()
+	d = &DependencyVulnerability{}
+	d.GetAdvisoryGHSAID()
+	d = nil
+	d.GetAdvisoryGHSAID()
 }
 
-func TestEvent_GetOrg(tt *testing.T) {
-	e := &Event{}
-	e.GetOrg()
-	e = nil
-	e.GetOrg()
+func TestDependencyVulnerability_GetAdvisorySummary(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyVulnerability{AdvisorySummary: &zeroValue}
+	d.GetAdvisorySummary()
+	d = &DependencyVulnerability{}
+	d.GetAdvisorySummary()
+	d = nil
+	d.GetAdvisorySummary()
 }
 
-func TestEvent_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	e := &Event{Public: &zeroValue}
-	e.GetPublic()
-	e = &Event{}
-	e.GetPublic()
-	e = nil
-	e.GetPublic()
+func TestDependencyVulnerability_GetAdvisoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyVulnerability{AdvisoryURL: &zeroValue}
+	d.GetAdvisoryURL()
+	d = &DependencyVulnerability{}
+	d.GetAdvisoryURL()
+	d = nil
+	d.GetAdvisoryURL()
 }
 
-func TestEvent_GetRawPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	e := &Event{RawPayload: &zeroValue}
-	e.GetRawPayload()
-	e = &Event{}
-	e.GetRawPayload()
-	e = nil
-	e.GetRawPayload()
+func TestDependencyVulnerability_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyVulnerability{Severity: &zeroValue}
+	d.GetSeverity()
+	d = &DependencyVulnerability{}
+	d.GetSeverity()
+	d = nil
+	d.GetSeverity()
 }
 
-func TestEvent_GetRepo(tt *testing.T) {
-	e := &Event{}
-	e.GetRepo()
-	e = nil
-	e.GetRepo()
+func TestDeployKeyEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	d := &DeployKeyEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DeployKeyEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
 }
 
-func TestEvent_GetType(tt *testing.T) {
-	var zeroValue string
-	e := &Event{Type: &zeroValue}
-	e.GetType()
-	e = &Event{}
-	e.GetType()
-	e = nil
-	e.GetType()
+func TestDeployKeyEvent_GetInstallation(tt *testing.T) {
+	d := &DeployKeyEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
 }
 
-func TestExternalGroup_GetGroupID(tt *testing.T) {
-	var zeroValue int64
-	e := &ExternalGroup{GroupID: &zeroValue}
-	e.GetGroupID()
-	e = &ExternalGroup{}
-	e.GetGroupID()
-	e = nil
-	e.GetGroupID()
+func TestDeployKeyEvent_GetKey(tt *testing.T) {
+	d := &DeployKeyEvent{}
+	d.GetKey()
+	d = nil
+	d.GetKey()
 }
 
-func TestExternalGroup_GetGroupName(tt *testing.T) {
-	var zeroValue string
-	e := &ExternalGroup{GroupName: &zeroValue}
-	e.GetGroupName()
-	e = &ExternalGroup{}
-	e.GetGroupName()
-	e = nil
-	e.GetGroupName()
+func TestDeployKeyEvent_GetOrganization(tt *testing.T) {
+	d := &DeployKeyEvent{}
+	d.GetOrganization()
+	d = nil
+	d.GetOrganization()
 }
 
-func TestExternalGroup_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &ExternalGroup{UpdatedAt: &zeroValue}
-	e.GetUpdatedAt()
-	e = &ExternalGroup{}
-	e.GetUpdatedAt()
-	e = nil
-	e.GetUpdatedAt()
+func TestDeployKeyEvent_GetRepo(tt *testing.T) {
+	d := &DeployKeyEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
 }
 
-func TestExternalGroupMember_GetMemberEmail(tt *testing.T) {
-	var zeroValue string
-	e := &ExternalGroupMember{MemberEmail: &zeroValue}
-	e.GetMemberEmail()
-	e = &ExternalGroupMember{}
-	e.GetMemberEmail()
-	e = nil
-	e.GetMemberEmail()
+func TestDeployKeyEvent_GetSender(tt *testing.T) {
+	d := &DeployKeyEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
-func TestExternalGroupMember_GetMemberID(tt *testing.T) {
-	var zeroValue int64
-	e := &ExternalGroupMember{MemberID: &zeroValue}
-	e.GetMemberID()
-	e = &ExternalGroupMember{}
-	e.GetMemberID()
-	e = nil
-	e.GetMemberID()
+func TestDeployment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Deployment{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &Deployment{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
 }
 
-func TestExternalGroupMember_GetMemberLogin(tt *testing.T) {
+func TestDeployment_GetCreator(tt *testing.T) {
+	d := &Deployment{}
+	d.GetCreator()
+	d = nil
+	d.GetCreator()
+}
+
+func TestDeployment_GetDescription(tt *testing.T) {
 	var zeroValue string
-	e := &ExternalGroupMember{MemberLogin: &zeroValue}
-	e.GetMemberLogin()
-	e = &ExternalGroupMember{}
-	e.GetMemberLogin()
-	e = nil
-	e.GetMemberLogin()
+	d := &Deployment{Description: &zeroValue}
+	d.GetDescription()
+	d = &Deployment{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
 }
 
-func TestExternalGroupMember_GetMemberName(tt *testing.T) {
+func TestDeployment_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	e := &ExternalGroupMember{MemberName: &zeroValue}
-	e.GetMemberName()
-	e = &ExternalGroupMember{}
-	e.GetMemberName()
-	e = nil
-	e.GetMemberName()
+	d := &Deployment{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &Deployment{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
 }
 
-func TestExternalGroupTeam_GetTeamID(tt *testing.T) {
+func TestDeployment_GetID(tt *testing.T) {
 	var zeroValue int64
-	e := &ExternalGroupTeam{TeamID: &zeroValue}
-	e.GetTeamID()
-	e = &ExternalGroupTeam{}
-	e.GetTeamID()
-	e = nil
-	e.GetTeamID()
+	d := &Deployment{ID: &zeroValue}
+	d.GetID()
+	d = &Deployment{}
+	d.GetID()
+	d = nil
+	d.GetID()
 }
 
-func TestExternalGroupTeam_GetTeamName(tt *testing.T) {
+func TestDeployment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	e := &ExternalGroupTeam{TeamName: &zeroValue}
-	e.GetTeamName()
-	e = &ExternalGroupTeam{}
-	e.GetTeamName()
-	e = nil
-	e.GetTeamName()
+	d := &Deployment{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &Deployment{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
 }
 
-func TestFeedLink_GetHRef(tt *testing.T) {
+func TestDeployment_GetRef(tt *testing.T) {
 	var zeroValue string
-	f := &FeedLink{HRef: &zeroValue}
-	f.GetHRef()
-	f = &FeedLink{}
-	f.GetHRef()
-	f = nil
-	f.GetHRef()
+	d := &Deployment{Ref: &zeroValue}
+	d.GetRef()
+	d = &Deployment{}
+	d.GetRef()
+	d = nil
+	d.GetRef()
 }
 
-func TestFeedLink_GetType(tt *testing.T) {
+func TestDeployment_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	f := &FeedLink{Type: &zeroValue}
-	f.GetType()
-	f = &FeedLink{}
-	f.GetType()
-	f = nil
-	f.GetType()
+	d := &Deployment{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &Deployment{}
+	d.GetRepositoryURL()
+	d = nil
+	d.GetRepositoryURL()
 }
 
-func TestFeedLinks_GetCurrentUser(tt *testing.T) {
-	f := &FeedLinks{}
-	f.GetCurrentUser()
-	f = nil
-	f.GetCurrentUser()
+func TestDeployment_GetSHA(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{SHA: &zeroValue}
+	d.GetSHA()
+	d = &Deployment{}
+	d.GetSHA()
+	d = nil
+	d.GetSHA()
 }
 
-func TestFeedLinks_GetCurrentUserActor(tt *testing.T) {
-	f := &FeedLinks{}
-	f.GetCurrentUserActor()
-	f = nil
-	f.GetCurrentUserActor()
+func TestDeployment_GetStatusesURL(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{StatusesURL: &zeroValue}
+	d.GetStatusesURL()
+	d = &Deployment{}
+	d.GetStatusesURL()
+	d = nil
+	d.GetStatusesURL()
 }
 
-func TestFeedLinks_GetCurrentUserOrganization(tt *testing.T) {
-	f := &FeedLinks{}
-	f.GetCurrentUserOrganization()
-	f = nil
-	f.GetCurrentUserOrganization()
+func TestDeployment_GetTask(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{Task: &zeroValue}
+	d.GetTask()
+	d = &Deployment{}
+	d.GetTask()
+	d = nil
+	d.GetTask()
 }
 
-func TestFeedLinks_GetCurrentUserPublic(tt *testing.T) {
-	f := &FeedLinks{}
-	f.GetCurrentUserPublic()
-	f = nil
-	f.GetCurrentUserPublic()
+func TestDeployment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Deployment{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &Deployment{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
 }
 
-func TestFeedLinks_GetTimeline(tt *testing.T) {
-	f := &FeedLinks{}
-	f.GetTimeline()
-	f = nil
-	f.GetTimeline()
+func TestDeployment_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{URL: &zeroValue}
+	d.GetURL()
+	d = &Deployment{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
 }
 
-func TestFeedLinks_GetUser(tt *testing.T) {
-	f := &FeedLinks{}
-	f.GetUser()
-	f = nil
-	f.GetUser()
+func TestDeploymentBranchPolicy_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DeploymentBranchPolicy{ID: &zeroValue}
+	d.GetID()
+	d = &DeploymentBranchPolicy{}
+	d.GetID()
+	d = nil
+	d.GetID()
 }
 
-func TestFeeds_GetCurrentUserActorURL(tt *testing.T) {
+func TestDeploymentBranchPolicy_GetName(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{CurrentUserActorURL: &zeroValue}
-	f.GetCurrentUserActorURL()
-	f = &Feeds{}
-	f.GetCurrentUserActorURL()
-	f = nil
-	f.GetCurrentUserActorURL()
+	d := &DeploymentBranchPolicy{Name: &zeroValue}
+	d.GetName()
+	d = &DeploymentBranchPolicy{}
+	d.GetName()
+	d = nil
+	d.GetName()
 }
 
-func TestFeeds_GetCurrentUserOrganizationURL(tt *testing.T) {
+func TestDeploymentBranchPolicy_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{CurrentUserOrganizationURL: &zeroValue}
-	f.GetCurrentUserOrganizationURL()
-	f = &Feeds{}
-	f.GetCurrentUserOrganizationURL()
-	f = nil
-	f.GetCurrentUserOrganizationURL()
+	d := &DeploymentBranchPolicy{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DeploymentBranchPolicy{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
 }
 
-func TestFeeds_GetCurrentUserPublicURL(tt *testing.T) {
+func TestDeploymentBranchPolicy_GetType(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{CurrentUserPublicURL: &zeroValue}
-	f.GetCurrentUserPublicURL()
-	f = &Feeds{}
-	f.GetCurrentUserPublicURL()
-	f = nil
-	f.GetCurrentUserPublicURL()
+	d := &DeploymentBranchPolicy{Type: &zeroValue}
+	d.GetType()
+	d = &DeploymentBranchPolicy{}
+	d.GetType()
+	d = nil
+	d.GetType()
 }
 
-func TestFeeds_GetCurrentUserURL(tt *testing.T) {
+func TestDeploymentBranchPolicyRequest_GetName(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{CurrentUserURL: &zeroValue}
-	f.GetCurrentUserURL()
-	f = &Feeds{}
-	f.GetCurrentUserURL()
-	f = nil
-	f.GetCurrentUserURL()
-}
-
-func TestFeeds_GetLinks(tt *testing.T) {
-	f := &Feeds{}
-	f.GetLinks()
-	f = nil
-	f.GetLinks()
+	d := &DeploymentBranchPolicyRequest{Name: &zeroValue}
+	d.GetName()
+	d = &DeploymentBranchPolicyRequest{}
+	d.GetName()
+	d = nil
+	d.GetName()
 }
 
-func TestFeeds_GetTimelineURL(tt *testing.T) {
+func TestDeploymentBranchPolicyRequest_GetType(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{TimelineURL: &zeroValue}
-	f.GetTimelineURL()
-	f = &Feeds{}
-	f.GetTimelineURL()
-	f = nil
-	f.GetTimelineURL()
+	d := &DeploymentBranchPolicyRequest{Type: &zeroValue}
+	d.GetType()
+	d = &DeploymentBranchPolicyRequest{}
+	d.GetType()
+	d = nil
+	d.GetType()
 }
 
-func TestFeeds_GetUserURL(tt *testing.T) {
-	var zeroValue string
-	f := &Feeds{UserURL: &zeroValue}
-	f.GetUserURL()
-	f = &Feeds{}
-	f.GetUserURL()
-	f = nil
-	f.GetUserURL()
+func TestDeploymentBranchPolicyResponse_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	d := &DeploymentBranchPolicyResponse{TotalCount: &zeroValue}
+	d.GetTotalCount()
+	d = &DeploymentBranchPolicyResponse{}
+	d.GetTotalCount()
+	d = nil
+	d.GetTotalCount()
 }
 
-func TestFirstPatchedVersion_GetIdentifier(tt *testing.T) {
-	var zeroValue string
-	f := &FirstPatchedVersion{Identifier: &zeroValue}
-	f.GetIdentifier()
-	f = &FirstPatchedVersion{}
-	f.GetIdentifier()
-	f = nil
-	f.GetIdentifier()
+func TestDeploymentEvent_GetDeployment(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetDeployment()
+	d = nil
+	d.GetDeployment()
 }
 
-func TestForkEvent_GetForkee(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetForkee()
-	f = nil
-	f.GetForkee()
+func TestDeploymentEvent_GetInstallation(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
 }
 
-func TestForkEvent_GetInstallation(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetInstallation()
-	f = nil
-	f.GetInstallation()
+func TestDeploymentEvent_GetOrg(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
 }
 
-func TestForkEvent_GetRepo(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetRepo()
-	f = nil
-	f.GetRepo()
+func TestDeploymentEvent_GetRepo(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
 }
 
-func TestForkEvent_GetSender(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetSender()
-	f = nil
-	f.GetSender()
+func TestDeploymentEvent_GetSender(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
-func TestGenerateNotesOptions_GetPreviousTagName(tt *testing.T) {
+func TestDeploymentProtectionRuleEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	g := &GenerateNotesOptions{PreviousTagName: &zeroValue}
-	g.GetPreviousTagName()
-	g = &GenerateNotesOptions{}
-	g.GetPreviousTagName()
-	g = nil
-	g.GetPreviousTagName()
+	d := &DeploymentProtectionRuleEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DeploymentProtectionRuleEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
 }
 
-func TestGenerateNotesOptions_GetTargetCommitish(tt *testing.T) {
-	var zeroValue string
-	g := &GenerateNotesOptions{TargetCommitish: &zeroValue}
-	g.GetTargetCommitish()
-	g = &GenerateNotesOptions{}
-	g.GetTargetCommitish()
-	g = nil
-	g.GetTargetCommitish()
+func TestDeploymentProtectionRuleEvent_GetDeployment(tt *testing.T) {
+	d := &DeploymentProtectionRuleEvent{}
+	d.GetDeployment()
+	d = nil
+	d.GetDeployment()
 }
 
-func TestGetAuditLogOptions_GetInclude(tt *testing.T) {
+func TestDeploymentProtectionRuleEvent_GetDeploymentCallbackURL(tt *testing.T) {
 	var zeroValue string
-	g := &GetAuditLogOptions{Include: &zeroValue}
-	g.GetInclude()
-	g = &GetAuditLogOptions{}
-	g.GetInclude()
-	g = nil
-	g.GetInclude()
+	d := &DeploymentProtectionRuleEvent{DeploymentCallbackURL: &zeroValue}
+	d.GetDeploymentCallbackURL()
+	d = &DeploymentProtectionRuleEvent{}
+	d.GetDeploymentCallbackURL()
+	d = nil
+	d.GetDeploymentCallbackURL()
 }
 
-func TestGetAuditLogOptions_GetOrder(tt *testing.T) {
+func TestDeploymentProtectionRuleEvent_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	g := &GetAuditLogOptions{Order: &zeroValue}
-	g.GetOrder()
-	g = &GetAuditLogOptions{}
-	g.GetOrder()
-	g = nil
-	g.GetOrder()
+	d := &DeploymentProtectionRuleEvent{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentProtectionRuleEvent{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
 }
 
-func TestGetAuditLogOptions_GetPhrase(tt *testing.T) {
+func TestDeploymentProtectionRuleEvent_GetEvent(tt *testing.T) {
 	var zeroValue string
-	g := &GetAuditLogOptions{Phrase: &zeroValue}
-	g.GetPhrase()
-	g = &GetAuditLogOptions{}
-	g.GetPhrase()
-	g = nil
-	g.GetPhrase()
+	d := &DeploymentProtectionRuleEvent{Event: &zeroValue}
+	d.GetEvent()
+	d = &DeploymentProtectionRuleEvent{}
+	d.GetEvent()
+	d = nil
+	d.GetEvent()
 }
 
-func TestGist_GetComments(tt *testing.T) {
-	var zeroValue int
-	g := &Gist{Comments: &zeroValue}
-	g.GetComments()
-	g = &Gist{}
-	g.GetComments()
-	g = nil
-	g.GetComments()
+func TestDeploymentProtectionRuleEvent_GetInstallation(tt *testing.T) {
+	d := &DeploymentProtectionRuleEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
 }
 
-func TestGist_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &Gist{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &Gist{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestDeploymentProtectionRuleEvent_GetOrg(tt *testing.T) {
+	d := &DeploymentProtectionRuleEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
 }
 
-func TestGist_GetDescription(tt *testing.T) {
-	var zeroValue string
-	g := &Gist{Description: &zeroValue}
-	g.GetDescription()
-	g = &Gist{}
-	g.GetDescription()
-	g = nil
-	g.GetDescription()
+func TestDeploymentProtectionRuleEvent_GetRepo(tt *testing.T) {
+	d := &DeploymentProtectionRuleEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
 }
 
-func TestGist_GetFiles(tt *testing.T) {
-	zeroValue := map[GistFilename]GistFile{}
-	g := &Gist{Files: zeroValue}
-	g.GetFiles()
-	g = &Gist{}
-	g.GetFiles()
-	g = nil
-	g.GetFiles()
+func TestDeploymentProtectionRuleEvent_GetSender(tt *testing.T) {
+	d := &DeploymentProtectionRuleEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
-func TestGist_GetGitPullURL(tt *testing.T) {
-	var zeroValue string
-	g := &Gist{GitPullURL: &zeroValue}
-	g.GetGitPullURL()
-	g = &Gist{}
-	g.GetGitPullURL()
-	g = nil
-	g.GetGitPullURL()
+func TestDeploymentRequest_GetAutoMerge(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{AutoMerge: &zeroValue}
+	d.GetAutoMerge()
+	d = &DeploymentRequest{}
+	d.GetAutoMerge()
+	d = nil
+	d.GetAutoMerge()
 }
 
-func TestGist_GetGitPushURL(tt *testing.T) {
+func TestDeploymentRequest_GetDescription(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{GitPushURL: &zeroValue}
-	g.GetGitPushURL()
-	g = &Gist{}
-	g.GetGitPushURL()
-	g = nil
-	g.GetGitPushURL()
+	d := &DeploymentRequest{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentRequest{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
 }
 
-func TestGist_GetHTMLURL(tt *testing.T) {
+func TestDeploymentRequest_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{HTMLURL: &zeroValue}
-	g.GetHTMLURL()
-	g = &Gist{}
-	g.GetHTMLURL()
-	g = nil
-	g.GetHTMLURL()
+	d := &DeploymentRequest{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentRequest{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
 }
 
-func TestGist_GetID(tt *testing.T) {
-	var zeroValue string
-	g := &Gist{ID: &zeroValue}
-	g.GetID()
-	g = &Gist{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestDeploymentRequest_GetProductionEnvironment(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{ProductionEnvironment: &zeroValue}
+	d.GetProductionEnvironment()
+	d = &DeploymentRequest{}
+	d.GetProductionEnvironment()
+	d = nil
+	d.GetProductionEnvironment()
 }
 
-func TestGist_GetNodeID(tt *testing.T) {
+func TestDeploymentRequest_GetRef(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &Gist{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
-}
-
-func TestGist_GetOwner(tt *testing.T) {
-	g := &Gist{}
-	g.GetOwner()
-	g = nil
-	g.GetOwner()
+	d := &DeploymentRequest{Ref: &zeroValue}
+	d.GetRef()
+	d = &DeploymentRequest{}
+	d.GetRef()
+	d = nil
+	d.GetRef()
 }
 
-func TestGist_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	g := &Gist{Public: &zeroValue}
-	g.GetPublic()
-	g = &Gist{}
-	g.GetPublic()
-	g = nil
-	g.GetPublic()
+func TestDeploymentRequest_GetRequiredContexts(tt *testing.T) {
+	var zeroValue []string
+	d := &DeploymentRequest{RequiredContexts: &zeroValue}
+	d.GetRequiredContexts()
+	d = &DeploymentRequest{}
+	d.GetRequiredContexts()
+	d = nil
+	d.GetRequiredContexts()
 }
 
-func TestGist_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &Gist{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &Gist{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+func TestDeploymentRequest_GetTask(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentRequest{Task: &zeroValue}
+	d.GetTask()
+	d = &DeploymentRequest{}
+	d.GetTask()
+	d = nil
+	d.GetTask()
 }
 
-func TestGistComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	g := &GistComment{Body: &zeroValue}
-	g.GetBody()
-	g = &GistComment{}
-	g.GetBody()
-	g = nil
-	g.GetBody()
+func TestDeploymentRequest_GetTransientEnvironment(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{TransientEnvironment: &zeroValue}
+	d.GetTransientEnvironment()
+	d = &DeploymentRequest{}
+	d.GetTransientEnvironment()
+	d = nil
+	d.GetTransientEnvironment()
 }
 
-func TestGistComment_GetCreatedAt(tt *testing.T) {
+func TestDeploymentStatus_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	g := &GistComment{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GistComment{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+	d := &DeploymentStatus{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DeploymentStatus{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
 }
 
-func TestGistComment_GetID(tt *testing.T) {
-	var zeroValue int64
-	g := &GistComment{ID: &zeroValue}
-	g.GetID()
-	g = &GistComment{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestDeploymentStatus_GetCreator(tt *testing.T) {
+	d := &DeploymentStatus{}
+	d.GetCreator()
+	d = nil
+	d.GetCreator()
 }
 
-func TestGistComment_GetURL(tt *testing.T) {
+func TestDeploymentStatus_GetDeploymentURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistComment{URL: &zeroValue}
-	g.GetURL()
-	g = &GistComment{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
-}
-
-func TestGistComment_GetUser(tt *testing.T) {
-	g := &GistComment{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
-}
-
-func TestGistCommit_GetChangeStatus(tt *testing.T) {
-	g := &GistCommit{}
-	g.GetChangeStatus()
-	g = nil
-	g.GetChangeStatus()
+	d := &DeploymentStatus{DeploymentURL: &zeroValue}
+	d.GetDeploymentURL()
+	d = &DeploymentStatus{}
+	d.GetDeploymentURL()
+	d = nil
+	d.GetDeploymentURL()
 }
 
-func TestGistCommit_GetCommittedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &GistCommit{CommittedAt: &zeroValue}
-	g.GetCommittedAt()
-	g = &GistCommit{}
-	g.GetCommittedAt()
-	g = nil
-	g.GetCommittedAt()
+func TestDeploymentStatus_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentStatus{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
 }
 
-func TestGistCommit_GetNodeID(tt *testing.T) {
+func TestDeploymentStatus_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	g := &GistCommit{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &GistCommit{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
+	d := &DeploymentStatus{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentStatus{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
 }
 
-func TestGistCommit_GetURL(tt *testing.T) {
+func TestDeploymentStatus_GetEnvironmentURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistCommit{URL: &zeroValue}
-	g.GetURL()
-	g = &GistCommit{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	d := &DeploymentStatus{EnvironmentURL: &zeroValue}
+	d.GetEnvironmentURL()
+	d = &DeploymentStatus{}
+	d.GetEnvironmentURL()
+	d = nil
+	d.GetEnvironmentURL()
 }
 
-func TestGistCommit_GetUser(tt *testing.T) {
-	g := &GistCommit{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
+func TestDeploymentStatus_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DeploymentStatus{ID: &zeroValue}
+	d.GetID()
+	d = &DeploymentStatus{}
+	d.GetID()
+	d = nil
+	d.GetID()
 }
 
-func TestGistCommit_GetVersion(tt *testing.T) {
+func TestDeploymentStatus_GetLogURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistCommit{Version: &zeroValue}
-	g.GetVersion()
-	g = &GistCommit{}
-	g.GetVersion()
-	g = nil
-	g.GetVersion()
+	d := &DeploymentStatus{LogURL: &zeroValue}
+	d.GetLogURL()
+	d = &DeploymentStatus{}
+	d.GetLogURL()
+	d = nil
+	d.GetLogURL()
 }
 
-func TestGistFile_GetContent(tt *testing.T) {
+func TestDeploymentStatus_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Content: &zeroValue}
-	g.GetContent()
-	g = &GistFile{}
-	g.GetContent()
-	g = nil
-	g.GetContent()
+	d := &DeploymentStatus{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DeploymentStatus{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
 }
 
-func TestGistFile_GetFilename(tt *testing.T) {
+func TestDeploymentStatus_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Filename: &zeroValue}
-	g.GetFilename()
-	g = &GistFile{}
-	g.GetFilename()
-	g = nil
-	g.GetFilename()
+	d := &DeploymentStatus{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &DeploymentStatus{}
+	d.GetRepositoryURL()
+	d = nil
+	d.GetRepositoryURL()
 }
 
-func TestGistFile_GetLanguage(tt *testing.T) {
+func TestDeploymentStatus_GetState(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Language: &zeroValue}
-	g.GetLanguage()
-	g = &GistFile{}
-	g.GetLanguage()
-	g = nil
-	g.GetLanguage()
+	d := &DeploymentStatus{State: &zeroValue}
+	d.GetState()
+	d = &DeploymentStatus{}
+	d.GetState()
+	d = nil
+	d.GetState()
 }
 
-func TestGistFile_GetRawURL(tt *testing.T) {
+func TestDeploymentStatus_GetTargetURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{RawURL: &zeroValue}
-	g.GetRawURL()
-	g = &GistFile{}
-	g.GetRawURL()
-	g = nil
-	g.GetRawURL()
+	d := &DeploymentStatus{TargetURL: &zeroValue}
+	d.GetTargetURL()
+	d = &DeploymentStatus{}
+	d.GetTargetURL()
+	d = nil
+	d.GetTargetURL()
 }
 
-func TestGistFile_GetSize(tt *testing.T) {
-	var zeroValue int
-	g := &GistFile{Size: &zeroValue}
-	g.GetSize()
-	g = &GistFile{}
-	g.GetSize()
-	g = nil
-	g.GetSize()
-}
-
-func TestGistFile_GetType(tt *testing.T) {
-	var zeroValue string
-	g := &GistFile{Type: &zeroValue}
-	g.GetType()
-	g = &GistFile{}
-	g.GetType()
-	g = nil
-	g.GetType()
-}
-
-func TestGistFork_GetCreatedAt(tt *testing.T) {
+func TestDeploymentStatus_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	g := &GistFork{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GistFork{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+	d := &DeploymentStatus{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DeploymentStatus{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
 }
 
-func TestGistFork_GetID(tt *testing.T) {
+func TestDeploymentStatus_GetURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistFork{ID: &zeroValue}
-	g.GetID()
-	g = &GistFork{}
-	g.GetID()
-	g = nil
-	g.GetID()
+	d := &DeploymentStatus{URL: &zeroValue}
+	d.GetURL()
+	d = &DeploymentStatus{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
 }
 
-func TestGistFork_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	g := &GistFork{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &GistFork{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
+func TestDeploymentStatusEvent_GetDeployment(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetDeployment()
+	d = nil
+	d.GetDeployment()
 }
 
-func TestGistFork_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &GistFork{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &GistFork{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+func TestDeploymentStatusEvent_GetDeploymentStatus(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetDeploymentStatus()
+	d = nil
+	d.GetDeploymentStatus()
 }
 
-func TestGistFork_GetURL(tt *testing.T) {
-	var zeroValue string
-	g := &GistFork{URL: &zeroValue}
-	g.GetURL()
-	g = &GistFork{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+func TestDeploymentStatusEvent_GetInstallation(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
 }
 
-func TestGistFork_GetUser(tt *testing.T) {
-	g := &GistFork{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
+func TestDeploymentStatusEvent_GetOrg(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
 }
 
-func TestGistStats_GetPrivateGists(tt *testing.T) {
-	var zeroValue int
-	g := &GistStats{PrivateGists: &zeroValue}
-	g.GetPrivateGists()
-	g = &GistStats{}
-	g.GetPrivateGists()
-	g = nil
-	g.GetPrivateGists()
+func TestDeploymentStatusEvent_GetRepo(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
 }
 
-func TestGistStats_GetPublicGists(tt *testing.T) {
-	var zeroValue int
-	g := &GistStats{PublicGists: &zeroValue}
-	g.GetPublicGists()
-	g = &GistStats{}
-	g.GetPublicGists()
-	g = nil
-	g.GetPublicGists()
+func TestDeploymentStatusEvent_GetSender(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
-func TestGistStats_GetTotalGists(tt *testing.T) {
-	var zeroValue int
-	g := &GistStats{TotalGists: &zeroValue}
-	g.GetTotalGists()
-	g = &GistStats{}
-	g.GetTotalGists()
-	g = nil
-	g.GetTotalGists()
+func TestDeploymentStatusRequest_GetAutoInactive(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentStatusRequest{AutoInactive: &zeroValue}
+	d.GetAutoInactive()
+	d = &DeploymentStatusRequest{}
+	d.GetAutoInactive()
+	d = nil
+	d.GetAutoInactive()
 }
 
-func TestGitHubAppAuthorizationEvent_GetAction(tt *testing.T) {
+func TestDeploymentStatusRequest_GetDescription(tt *testing.T) {
 	var zeroValue string
-	g := &GitHubAppAuthorizationEvent{Action: &zeroValue}
-	g.GetAction()
-	g = &GitHubAppAuthorizationEvent{}
-	g.GetAction()
-	g = nil
-	g.GetAction()
+	d := &DeploymentStatusRequest{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentStatusRequest{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
 }
 
-func TestGitHubAppAuthorizationEvent_GetInstallation(tt *testing.T) {
-	g := &GitHubAppAuthorizationEvent{}
-	g.GetInstallation()
-	g = nil
-	g.GetInstallation()
+func TestDeploymentStatusRequest_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentStatusRequest{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
 }
 
-func TestGitHubAppAuthorizationEvent_GetSender(tt *testing.T) {
-	g := &GitHubAppAuthorizationEvent{}
-	g.GetSender()
-	g = nil
-	g.GetSender()
+func TestDeploymentStatusRequest_GetEnvironmentURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{EnvironmentURL: &zeroValue}
+	d.GetEnvironmentURL()
+	d = &DeploymentStatusRequest{}
+	d.GetEnvironmentURL()
+	d = nil
+	d.GetEnvironmentURL()
 }
 
-func TestGitignore_GetName(tt *testing.T) {
+func TestDeploymentStatusRequest_GetLogURL(tt *testing.T) {
 	var zeroValue string
-	g := &Gitignore{Name: &zeroValue}
-	g.GetName()
-	g = &Gitignore{}
-	g.GetName()
-	g = nil
-	g.GetName()
+	d := &DeploymentStatusRequest{LogURL: &zeroValue}
+	d.GetLogURL()
+	d = &DeploymentStatusRequest{}
+	d.GetLogURL()
+	d = nil
+	d.GetLogURL()
 }
 
-func TestGitignore_GetSource(tt *testing.T) {
+func TestDeploymentStatusRequest_GetState(tt *testing.T) {
 	var zeroValue string
-	g := &Gitignore{Source: &zeroValue}
-	g.GetSource()
-	g = &Gitignore{}
-	g.GetSource()
-	g = nil
-	g.GetSource()
+	d := &DeploymentStatusRequest{State: &zeroValue}
+	d.GetState()
+	d = &DeploymentStatusRequest{}
+	d.GetState()
+	d = nil
+	d.GetState()
 }
 
-func TestGitObject_GetSHA(tt *testing.T) {
+func TestDiscussion_GetActiveLockReason(tt *testing.T) {
 	var zeroValue string
-	g := &GitObject{SHA: &zeroValue}
-	g.GetSHA()
-	g = &GitObject{}
-	g.GetSHA()
-	g = nil
-	g.GetSHA()
+	d := &Discussion{ActiveLockReason: &zeroValue}
+	d.GetActiveLockReason()
+	d = &Discussion{}
+	d.GetActiveLockReason()
+	d = nil
+	d.GetActiveLockReason()
 }
 
-func TestGitObject_GetType(tt *testing.T) {
-	var zeroValue string
-	g := &GitObject{Type: &zeroValue}
-	g.GetType()
-	g = &GitObject{}
-	g.GetType()
-	g = nil
-	g.GetType()
+func TestDiscussion_GetAnswerChosenAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Discussion{AnswerChosenAt: &zeroValue}
+	d.GetAnswerChosenAt()
+	d = &Discussion{}
+	d.GetAnswerChosenAt()
+	d = nil
+	d.GetAnswerChosenAt()
 }
 
-func TestGitObject_GetURL(tt *testing.T) {
+func TestDiscussion_GetAnswerChosenBy(tt *testing.T) {
 	var zeroValue string
-	g := &GitObject{URL: &zeroValue}
-	g.GetURL()
-	g = &GitObject{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	d := &Discussion{AnswerChosenBy: &zeroValue}
+	d.GetAnswerChosenBy()
+	d = &Discussion{}
+	d.GetAnswerChosenBy()
+	d = nil
+	d.GetAnswerChosenBy()
 }
 
-func TestGollumEvent_GetInstallation(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetInstallation()
-	g = nil
-	g.GetInstallation()
+func TestDiscussion_GetAnswerHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{AnswerHTMLURL: &zeroValue}
+	d.GetAnswerHTMLURL()
+	d = &Discussion{}
+	d.GetAnswerHTMLURL()
+	d = nil
+	d.GetAnswerHTMLURL()
+}
+
+func TestDiscussion_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{AuthorAssociation: &zeroValue}
+	d.GetAuthorAssociation()
+	d = &Discussion{}
+	d.GetAuthorAssociation()
+	d = nil
+	d.GetAuthorAssociation()
+}
+
+func TestDiscussion_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{Body: &zeroValue}
+	d.GetBody()
+	d = &Discussion{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDiscussion_GetComments(tt *testing.T) {
+	var zeroValue int
+	d := &Discussion{Comments: &zeroValue}
+	d.GetComments()
+	d = &Discussion{}
+	d.GetComments()
+	d = nil
+	d.GetComments()
+}
+
+func TestDiscussion_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Discussion{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &Discussion{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDiscussion_GetDiscussionCategory(tt *testing.T) {
+	d := &Discussion{}
+	d.GetDiscussionCategory()
+	d = nil
+	d.GetDiscussionCategory()
+}
+
+func TestDiscussion_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &Discussion{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
+}
+
+func TestDiscussion_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &Discussion{ID: &zeroValue}
+	d.GetID()
+	d = &Discussion{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDiscussion_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	d := &Discussion{Locked: &zeroValue}
+	d.GetLocked()
+	d = &Discussion{}
+	d.GetLocked()
+	d = nil
+	d.GetLocked()
+}
+
+func TestDiscussion_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &Discussion{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDiscussion_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &Discussion{Number: &zeroValue}
+	d.GetNumber()
+	d = &Discussion{}
+	d.GetNumber()
+	d = nil
+	d.GetNumber()
+}
+
+func TestDiscussion_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &Discussion{}
+	d.GetRepositoryURL()
+	d = nil
+	d.GetRepositoryURL()
+}
+
+func TestDiscussion_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{State: &zeroValue}
+	d.GetState()
+	d = &Discussion{}
+	d.GetState()
+	d = nil
+	d.GetState()
+}
+
+func TestDiscussion_GetTitle(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{Title: &zeroValue}
+	d.GetTitle()
+	d = &Discussion{}
+	d.GetTitle()
+	d = nil
+	d.GetTitle()
+}
+
+func TestDiscussion_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Discussion{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &Discussion{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDiscussion_GetUser(tt *testing.T) {
+	d := &Discussion{}
+	d.GetUser()
+	d = nil
+	d.GetUser()
+}
+
+func TestDiscussionCategory_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionCategory{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DiscussionCategory{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDiscussionCategory_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Description: &zeroValue}
+	d.GetDescription()
+	d = &DiscussionCategory{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDiscussionCategory_GetEmoji(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Emoji: &zeroValue}
+	d.GetEmoji()
+	d = &DiscussionCategory{}
+	d.GetEmoji()
+	d = nil
+	d.GetEmoji()
+}
+
+func TestDiscussionCategory_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DiscussionCategory{ID: &zeroValue}
+	d.GetID()
+	d = &DiscussionCategory{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDiscussionCategory_GetIsAnswerable(tt *testing.T) {
+	var zeroValue bool
+	d := &DiscussionCategory{IsAnswerable: &zeroValue}
+	d.GetIsAnswerable()
+	d = &DiscussionCategory{}
+	d.GetIsAnswerable()
+	d = nil
+	d.GetIsAnswerable()
+}
+
+func TestDiscussionCategory_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Name: &zeroValue}
+	d.GetName()
+	d = &DiscussionCategory{}
+	d.GetName()
+	d = nil
+	d.GetName()
+}
+
+func TestDiscussionCategory_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionCategory{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDiscussionCategory_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	d := &DiscussionCategory{RepositoryID: &zeroValue}
+	d.GetRepositoryID()
+	d = &DiscussionCategory{}
+	d.GetRepositoryID()
+	d = nil
+	d.GetRepositoryID()
+}
+
+func TestDiscussionCategory_GetSlug(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Slug: &zeroValue}
+	d.GetSlug()
+	d = &DiscussionCategory{}
+	d.GetSlug()
+	d = nil
+	d.GetSlug()
+}
+
+func TestDiscussionCategory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionCategory{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DiscussionCategory{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDiscussionComment_GetAuthor(tt *testing.T) {
+	d := &DiscussionComment{}
+	d.GetAuthor()
+	d = nil
+	d.GetAuthor()
+}
+
+func TestDiscussionComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{Body: &zeroValue}
+	d.GetBody()
+	d = &DiscussionComment{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDiscussionComment_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{BodyHTML: &zeroValue}
+	d.GetBodyHTML()
+	d = &DiscussionComment{}
+	d.GetBodyHTML()
+	d = nil
+	d.GetBodyHTML()
+}
+
+func TestDiscussionComment_GetBodyVersion(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{BodyVersion: &zeroValue}
+	d.GetBodyVersion()
+	d = &DiscussionComment{}
+	d.GetBodyVersion()
+	d = nil
+	d.GetBodyVersion()
+}
+
+func TestDiscussionComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DiscussionComment{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDiscussionComment_GetDiscussionURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{DiscussionURL: &zeroValue}
+	d.GetDiscussionURL()
+	d = &DiscussionComment{}
+	d.GetDiscussionURL()
+	d = nil
+	d.GetDiscussionURL()
+}
+
+func TestDiscussionComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DiscussionComment{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
+}
+
+func TestDiscussionComment_GetLastEditedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{LastEditedAt: &zeroValue}
+	d.GetLastEditedAt()
+	d = &DiscussionComment{}
+	d.GetLastEditedAt()
+	d = nil
+	d.GetLastEditedAt()
+}
+
+func TestDiscussionComment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionComment{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDiscussionComment_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &DiscussionComment{Number: &zeroValue}
+	d.GetNumber()
+	d = &DiscussionComment{}
+	d.GetNumber()
+	d = nil
+	d.GetNumber()
+}
+
+func TestDiscussionComment_GetReactions(tt *testing.T) {
+	d := &DiscussionComment{}
+	d.GetReactions()
+	d = nil
+	d.GetReactions()
+}
+
+func TestDiscussionComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DiscussionComment{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDiscussionComment_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{URL: &zeroValue}
+	d.GetURL()
+	d = &DiscussionComment{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
+}
+
+func TestDiscussionCommentEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCommentEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DiscussionCommentEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
+}
+
+func TestDiscussionCommentEvent_GetComment(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetComment()
+	d = nil
+	d.GetComment()
+}
+
+func TestDiscussionCommentEvent_GetDiscussion(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetDiscussion()
+	d = nil
+	d.GetDiscussion()
+}
+
+func TestDiscussionCommentEvent_GetInstallation(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
+}
+
+func TestDiscussionCommentEvent_GetOrg(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
+}
+
+func TestDiscussionCommentEvent_GetRepo(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
+}
+
+func TestDiscussionCommentEvent_GetSender(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
+}
+
+func TestDiscussionEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DiscussionEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
+}
+
+func TestDiscussionEvent_GetDiscussion(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetDiscussion()
+	d = nil
+	d.GetDiscussion()
+}
+
+func TestDiscussionEvent_GetInstallation(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
+}
+
+func TestDiscussionEvent_GetOrg(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
+}
+
+func TestDiscussionEvent_GetRepo(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
+}
+
+func TestDiscussionEvent_GetSender(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
+}
+
+func TestDismissalRestrictionsRequest_GetApps(tt *testing.T) {
+	var zeroValue []string
+	d := &DismissalRestrictionsRequest{Apps: &zeroValue}
+	d.GetApps()
+	d = &DismissalRestrictionsRequest{}
+	d.GetApps()
+	d = nil
+	d.GetApps()
+}
+
+func TestDismissalRestrictionsRequest_GetTeams(tt *testing.T) {
+	var zeroValue []string
+	d := &DismissalRestrictionsRequest{Teams: &zeroValue}
+	d.GetTeams()
+	d = &DismissalRestrictionsRequest{}
+	d.GetTeams()
+	d = nil
+	d.GetTeams()
+}
+
+func TestDismissalRestrictionsRequest_GetUsers(tt *testing.T) {
+	var zeroValue []string
+	d := &DismissalRestrictionsRequest{Users: &zeroValue}
+	d.GetUsers()
+	d = &DismissalRestrictionsRequest{}
+	d.GetUsers()
+	d = nil
+	d.GetUsers()
+}
+
+func TestDismissedReview_GetDismissalCommitID(tt *testing.T) {
+	var zeroValue string
+	d := &DismissedReview{DismissalCommitID: &zeroValue}
+	d.GetDismissalCommitID()
+	d = &DismissedReview{}
+	d.GetDismissalCommitID()
+	d = nil
+	d.GetDismissalCommitID()
+}
+
+func TestDismissedReview_GetDismissalMessage(tt *testing.T) {
+	var zeroValue string
+	d := &DismissedReview{DismissalMessage: &zeroValue}
+	d.GetDismissalMessage()
+	d = &DismissedReview{}
+	d.GetDismissalMessage()
+	d = nil
+	d.GetDismissalMessage()
+}
+
+func TestDismissedReview_GetReviewID(tt *testing.T) {
+	var zeroValue int64
+	d := &DismissedReview{ReviewID: &zeroValue}
+	d.GetReviewID()
+	d = &DismissedReview{}
+	d.GetReviewID()
+	d = nil
+	d.GetReviewID()
+}
+
+func TestDismissedReview_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DismissedReview{State: &zeroValue}
+	d.GetState()
+	d = &DismissedReview{}
+	d.GetState()
+	d = nil
+	d.GetState()
+}
+
+func TestDismissStaleReviewsOnPushChanges_GetFrom(tt *testing.T) {
+	var zeroValue bool
+	d := &DismissStaleReviewsOnPushChanges{From: &zeroValue}
+	d.GetFrom()
+	d = &DismissStaleReviewsOnPushChanges{}
+	d.GetFrom()
+	d = nil
+	d.GetFrom()
+}
+
+func TestDispatchRequestOptions_GetClientPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	d := &DispatchRequestOptions{ClientPayload: &zeroValue}
+	d.GetClientPayload()
+	d = &DispatchRequestOptions{}
+	d.GetClientPayload()
+	d = nil
+	d.GetClientPayload()
+}
+
+func TestDraftReviewComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{Body: &zeroValue}
+	d.GetBody()
+	d = &DraftReviewComment{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDraftReviewComment_GetLine(tt *testing.T) {
+	var zeroValue int
+	d := &DraftReviewComment{Line: &zeroValue}
+	d.GetLine()
+	d = &DraftReviewComment{}
+	d.GetLine()
+	d = nil
+	d.GetLine()
+}
+
+func TestDraftReviewComment_GetPath(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{Path: &zeroValue}
+	d.GetPath()
+	d = &DraftReviewComment{}
+	d.GetPath()
+	d = nil
+	d.GetPath()
+}
+
+func TestDraftReviewComment_GetPosition(tt *testing.T) {
+	var zeroValue int
+	d := &DraftReviewComment{Position: &zeroValue}
+	d.GetPosition()
+	d = &DraftReviewComment{}
+	d.GetPosition()
+	d = nil
+	d.GetPosition()
+}
+
+func TestDraftReviewComment_GetSide(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{Side: &zeroValue}
+	d.GetSide()
+	d = &DraftReviewComment{}
+	d.GetSide()
+	d = nil
+	d.GetSide()
+}
+
+func TestDraftReviewComment_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	d := &DraftReviewComment{StartLine: &zeroValue}
+	d.GetStartLine()
+	d = &DraftReviewComment{}
+	d.GetStartLine()
+	d = nil
+	d.GetStartLine()
+}
+
+func TestDraftReviewComment_GetStartSide(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{StartSide: &zeroValue}
+	d.GetStartSide()
+	d = &DraftReviewComment{}
+	d.GetStartSide()
+	d = nil
+	d.GetStartSide()
+}
+
+func TestEditBase_GetRef(tt *testing.T) {
+	e := &EditBase{}
+	e.GetRef()
+	e = nil
+	e.GetRef()
+}
+
+func TestEditBase_GetSHA(tt *testing.T) {
+	e := &EditBase{}
+	e.GetSHA()
+	e = nil
+	e.GetSHA()
+}
+
+func TestEditBody_GetFrom(tt *testing.T) {
+	var zeroValue string
+	e := &EditBody{From: &zeroValue}
+	e.GetFrom()
+	e = &EditBody{}
+	e.GetFrom()
+	e = nil
+	e.GetFrom()
+}
+
+func TestEditChange_GetBase(tt *testing.T) {
+	e := &EditChange{}
+	e.GetBase()
+	e = nil
+	e.GetBase()
+}
+
+func TestEditChange_GetBody(tt *testing.T) {
+	e := &EditChange{}
+	e.GetBody()
+	e = nil
+	e.GetBody()
+}
+
+func TestEditChange_GetRepo(tt *testing.T) {
+	e := &EditChange{}
+	e.GetRepo()
+	e = nil
+	e.GetRepo()
+}
+
+func TestEditChange_GetTitle(tt *testing.T) {
+	e := &EditChange{}
+	e.GetTitle()
+	e = nil
+	e.GetTitle()
+}
+
+func TestEditRef_GetFrom(tt *testing.T) {
+	var zeroValue string
+	e := &EditRef{From: &zeroValue}
+	e.GetFrom()
+	e = &EditRef{}
+	e.GetFrom()
+	e = nil
+	e.GetFrom()
+}
+
+func TestEditRepo_GetName(tt *testing.T) {
+	e := &EditRepo{}
+	e.GetName()
+	e = nil
+	e.GetName()
+}
+
+func TestEditSHA_GetFrom(tt *testing.T) {
+	var zeroValue string
+	e := &EditSHA{From: &zeroValue}
+	e.GetFrom()
+	e = &EditSHA{}
+	e.GetFrom()
+	e = nil
+	e.GetFrom()
+}
+
+func TestEditTitle_GetFrom(tt *testing.T) {
+	var zeroValue string
+	e := &EditTitle{From: &zeroValue}
+	e.GetFrom()
+	e = &EditTitle{}
+	e.GetFrom()
+	e = nil
+	e.GetFrom()
+}
+
+func TestEnterprise_GetAvatarURL(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{AvatarURL: &zeroValue}
+	e.GetAvatarURL()
+	e = &Enterprise{}
+	e.GetAvatarURL()
+	e = nil
+	e.GetAvatarURL()
+}
+
+func TestEnterprise_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Enterprise{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &Enterprise{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
+}
+
+func TestEnterprise_GetDescription(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{Description: &zeroValue}
+	e.GetDescription()
+	e = &Enterprise{}
+	e.GetDescription()
+	e = nil
+	e.GetDescription()
+}
+
+func TestEnterprise_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{HTMLURL: &zeroValue}
+	e.GetHTMLURL()
+	e = &Enterprise{}
+	e.GetHTMLURL()
+	e = nil
+	e.GetHTMLURL()
+}
+
+func TestEnterprise_GetID(tt *testing.T) {
+	var zeroValue int
+	e := &Enterprise{ID: &zeroValue}
+	e.GetID()
+	e = &Enterprise{}
+	e.GetID()
+	e = nil
+	e.GetID()
+}
+
+func TestEnterprise_GetName(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{Name: &zeroValue}
+	e.GetName()
+	e = &Enterprise{}
+	e.GetName()
+	e = nil
+	e.GetName()
+}
+
+func TestEnterprise_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{NodeID: &zeroValue}
+	e.GetNodeID()
+	e = &Enterprise{}
+	e.GetNodeID()
+	e = nil
+	e.GetNodeID()
+}
+
+func TestEnterprise_GetSlug(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{Slug: &zeroValue}
+	e.GetSlug()
+	e = &Enterprise{}
+	e.GetSlug()
+	e = nil
+	e.GetSlug()
+}
+
+func TestEnterprise_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Enterprise{UpdatedAt: &zeroValue}
+	e.GetUpdatedAt()
+	e = &Enterprise{}
+	e.GetUpdatedAt()
+	e = nil
+	e.GetUpdatedAt()
+}
+
+func TestEnterprise_GetWebsiteURL(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{WebsiteURL: &zeroValue}
+	e.GetWebsiteURL()
+	e = &Enterprise{}
+	e.GetWebsiteURL()
+	e = nil
+	e.GetWebsiteURL()
+}
+
+func TestEnterpriseConsumedLicenses_GetTotalSeatsConsumed(tt *testing.T) {
+	var zeroValue int
+	e := &EnterpriseConsumedLicenses{TotalSeatsConsumed: &zeroValue}
+	e.GetTotalSeatsConsumed()
+	e = &EnterpriseConsumedLicenses{}
+	e.GetTotalSeatsConsumed()
+	e = nil
+	e.GetTotalSeatsConsumed()
+}
+
+func TestEnterpriseConsumedLicenses_GetTotalSeatsPurchased(tt *testing.T) {
+	var zeroValue int
+	e := &EnterpriseConsumedLicenses{TotalSeatsPurchased: &zeroValue}
+	e.GetTotalSeatsPurchased()
+	e = &EnterpriseConsumedLicenses{}
+	e.GetTotalSeatsPurchased()
+	e = nil
+	e.GetTotalSeatsPurchased()
+}
+
+func TestEnterpriseLicensedUser_GetEnterpriseServerUser(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseLicensedUser{EnterpriseServerUser: &zeroValue}
+	e.GetEnterpriseServerUser()
+	e = &EnterpriseLicensedUser{}
+	e.GetEnterpriseServerUser()
+	e = nil
+	e.GetEnterpriseServerUser()
+}
+
+func TestEnterpriseLicensedUser_GetGitHubComLogin(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicensedUser{GitHubComLogin: &zeroValue}
+	e.GetGitHubComLogin()
+	e = &EnterpriseLicensedUser{}
+	e.GetGitHubComLogin()
+	e = nil
+	e.GetGitHubComLogin()
+}
+
+func TestEnterpriseLicensedUser_GetGitHubComName(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicensedUser{GitHubComName: &zeroValue}
+	e.GetGitHubComName()
+	e = &EnterpriseLicensedUser{}
+	e.GetGitHubComName()
+	e = nil
+	e.GetGitHubComName()
+}
+
+func TestEnterpriseLicensedUser_GetGitHubComProfile(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicensedUser{GitHubComProfile: &zeroValue}
+	e.GetGitHubComProfile()
+	e = &EnterpriseLicensedUser{}
+	e.GetGitHubComProfile()
+	e = nil
+	e.GetGitHubComProfile()
+}
+
+func TestEnterpriseLicensedUser_GetGitHubComSAMLNameID(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicensedUser{GitHubComSAMLNameID: &zeroValue}
+	e.GetGitHubComSAMLNameID()
+	e = &EnterpriseLicensedUser{}
+	e.GetGitHubComSAMLNameID()
+	e = nil
+	e.GetGitHubComSAMLNameID()
+}
+
+func TestEnterpriseLicensedUser_GetGitHubComTwoFactorAuth(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseLicensedUser{GitHubComTwoFactorAuth: &zeroValue}
+	e.GetGitHubComTwoFactorAuth()
+	e = &EnterpriseLicensedUser{}
+	e.GetGitHubComTwoFactorAuth()
+	e = nil
+	e.GetGitHubComTwoFactorAuth()
+}
+
+func TestEnterpriseLicensedUser_GetGitHubComUser(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseLicensedUser{GitHubComUser: &zeroValue}
+	e.GetGitHubComUser()
+	e = &EnterpriseLicensedUser{}
+	e.GetGitHubComUser()
+	e = nil
+	e.GetGitHubComUser()
+}
+
+func TestEnterpriseLicensedUser_GetLicenseType(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicensedUser{LicenseType: &zeroValue}
+	e.GetLicenseType()
+	e = &EnterpriseLicensedUser{}
+	e.GetLicenseType()
+	e = nil
+	e.GetLicenseType()
+}
+
+func TestEnterpriseLicensedUser_GetTotalUserAccounts(tt *testing.T) {
+	var zeroValue int
+	e := &EnterpriseLicensedUser{TotalUserAccounts: &zeroValue}
+	e.GetTotalUserAccounts()
+	e = &EnterpriseLicensedUser{}
+	e.GetTotalUserAccounts()
+	e = nil
+	e.GetTotalUserAccounts()
+}
+
+func TestEnterpriseLicensedUser_GetVisualStudioLicenseStatus(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicensedUser{VisualStudioLicenseStatus: &zeroValue}
+	e.GetVisualStudioLicenseStatus()
+	e = &EnterpriseLicensedUser{}
+	e.GetVisualStudioLicenseStatus()
+	e = nil
+	e.GetVisualStudioLicenseStatus()
+}
+
+func TestEnterpriseLicensedUser_GetVisualStudioSubscriptionEmail(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicensedUser{VisualStudioSubscriptionEmail: &zeroValue}
+	e.GetVisualStudioSubscriptionEmail()
+	e = &EnterpriseLicensedUser{}
+	e.GetVisualStudioSubscriptionEmail()
+	e = nil
+	e.GetVisualStudioSubscriptionEmail()
+}
+
+func TestEnterpriseLicensedUser_GetVisualStudioSubscriptionUser(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseLicensedUser{VisualStudioSubscriptionUser: &zeroValue}
+	e.GetVisualStudioSubscriptionUser()
+	e = &EnterpriseLicensedUser{}
+	e.GetVisualStudioSubscriptionUser()
+	e = nil
+	e.GetVisualStudioSubscriptionUser()
+}
+
+func TestEnterpriseLicenseSync_GetDate(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &EnterpriseLicenseSync{Date: &zeroValue}
+	e.GetDate()
+	e = &EnterpriseLicenseSync{}
+	e.GetDate()
+	e = nil
+	e.GetDate()
+}
+
+func TestEnterpriseLicenseSync_GetError(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicenseSync{Error: &zeroValue}
+	e.GetError()
+	e = &EnterpriseLicenseSync{}
+	e.GetError()
+	e = nil
+	e.GetError()
+}
+
+func TestEnterpriseLicenseSync_GetStatus(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseLicenseSync{Status: &zeroValue}
+	e.GetStatus()
+	e = &EnterpriseLicenseSync{}
+	e.GetStatus()
+	e = nil
+	e.GetStatus()
+}
+
+func TestEnterpriseSecurityAnalysisSettings_GetAdvancedSecurityEnabledForNewRepositories(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseSecurityAnalysisSettings{AdvancedSecurityEnabledForNewRepositories: &zeroValue}
+	e.GetAdvancedSecurityEnabledForNewRepositories()
+	e = &EnterpriseSecurityAnalysisSettings{}
+	e.GetAdvancedSecurityEnabledForNewRepositories()
+	e = nil
+	e.GetAdvancedSecurityEnabledForNewRepositories()
+}
+
+func TestEnterpriseSecurityAnalysisSettings_GetDependabotAlertsEnabledForNewRepositories(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseSecurityAnalysisSettings{DependabotAlertsEnabledForNewRepositories: &zeroValue}
+	e.GetDependabotAlertsEnabledForNewRepositories()
+	e = &EnterpriseSecurityAnalysisSettings{}
+	e.GetDependabotAlertsEnabledForNewRepositories()
+	e = nil
+	e.GetDependabotAlertsEnabledForNewRepositories()
+}
+
+func TestEnterpriseSecurityAnalysisSettings_GetSecretScanningEnabledForNewRepositories(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseSecurityAnalysisSettings{SecretScanningEnabledForNewRepositories: &zeroValue}
+	e.GetSecretScanningEnabledForNewRepositories()
+	e = &EnterpriseSecurityAnalysisSettings{}
+	e.GetSecretScanningEnabledForNewRepositories()
+	e = nil
+	e.GetSecretScanningEnabledForNewRepositories()
+}
+
+func TestEnterpriseSecurityAnalysisSettings_GetSecretScanningPushProtectionCustomLink(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseSecurityAnalysisSettings{SecretScanningPushProtectionCustomLink: &zeroValue}
+	e.GetSecretScanningPushProtectionCustomLink()
+	e = &EnterpriseSecurityAnalysisSettings{}
+	e.GetSecretScanningPushProtectionCustomLink()
+	e = nil
+	e.GetSecretScanningPushProtectionCustomLink()
+}
+
+func TestEnterpriseSecurityAnalysisSettings_GetSecretScanningPushProtectionEnabledForNewRepositories(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseSecurityAnalysisSettings{SecretScanningPushProtectionEnabledForNewRepositories: &zeroValue}
+	e.GetSecretScanningPushProtectionEnabledForNewRepositories()
+	e = &EnterpriseSecurityAnalysisSettings{}
+	e.GetSecretScanningPushProtectionEnabledForNewRepositories()
+	e = nil
+	e.GetSecretScanningPushProtectionEnabledForNewRepositories()
+}
+
+func TestEnterpriseServerInstance_GetHostname(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseServerInstance{Hostname: &zeroValue}
+	e.GetHostname()
+	e = &EnterpriseServerInstance{}
+	e.GetHostname()
+	e = nil
+	e.GetHostname()
+}
+
+func TestEnterpriseServerInstance_GetLastSync(tt *testing.T) {
+	e := &EnterpriseServerInstance{}
+	e.GetLastSync()
+	e = nil
+	e.GetLastSync()
+}
+
+func TestEnterpriseServerInstance_GetServerID(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseServerInstance{ServerID: &zeroValue}
+	e.GetServerID()
+	e = &EnterpriseServerInstance{}
+	e.GetServerID()
+	e = nil
+	e.GetServerID()
+}
+
+func TestEnvironment_GetCanAdminsBypass(tt *testing.T) {
+	var zeroValue bool
+	e := &Environment{CanAdminsBypass: &zeroValue}
+	e.GetCanAdminsBypass()
+	e = &Environment{}
+	e.GetCanAdminsBypass()
+	e = nil
+	e.GetCanAdminsBypass()
+}
+
+func TestEnvironment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Environment{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &Environment{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
+}
+
+func TestEnvironment_GetDeploymentBranchPolicy(tt *testing.T) {
+	e := &Environment{}
+	e.GetDeploymentBranchPolicy()
+	e = nil
+	e.GetDeploymentBranchPolicy()
+}
+
+func TestEnvironment_GetEnvironmentName(tt *testing.T) {
+	var zeroValue string
+	e := &Environment{EnvironmentName: &zeroValue}
+	e.GetEnvironmentName()
+	e = &Environment{}
+	e.GetEnvironmentName()
+	e = nil
+	e.GetEnvironmentName()
+}
+
+func TestEnvironment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	e := &Environment{HTMLURL: &zeroValue}
+	e.GetHTMLURL()
+	e = &Environment{}
+	e.GetHTMLURL()
+	e = nil
+	e.GetHTMLURL()
+}
+
+func TestEnvironment_GetID(tt *testing.T) {
+	var zeroValue int64
+	e := &Environment{ID: &zeroValue}
+	e.GetID()
+	e = &Environment{}
+	e.GetID()
+	e = nil
+	e.GetID()
+}
+
+func TestEnvironment_GetName(tt *testing.T) {
+	var zeroValue string
+	e := &Environment{Name: &zeroValue}
+	e.GetName()
+	e = &Environment{}
+	e.GetName()
+	e = nil
+	e.GetName()
+}
+
+func TestEnvironment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	e := &Environment{NodeID: &zeroValue}
+	e.GetNodeID()
+	e = &Environment{}
+	e.GetNodeID()
+	e = nil
+	e.GetNodeID()
+}
+
+func TestEnvironment_GetOwner(tt *testing.T) {
+	var zeroValue string
+	e := &Environment{Owner: &zeroValue}
+	e.GetOwner()
+	e = &Environment{}
+	e.GetOwner()
+	e = nil
+	e.GetOwner()
+}
+
+func TestEnvironment_GetRepo(tt *testing.T) {
+	var zeroValue string
+	e := &Environment{Repo: &zeroValue}
+	e.GetRepo()
+	e = &Environment{}
+	e.GetRepo()
+	e = nil
+	e.GetRepo()
+}
+
+func TestEnvironment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Environment{UpdatedAt: &zeroValue}
+	e.GetUpdatedAt()
+	e = &Environment{}
+	e.GetUpdatedAt()
+	e = nil
+	e.GetUpdatedAt()
+}
+
+func TestEnvironment_GetURL(tt *testing.T) {
+	var zeroValue string
+	e := &Environment{URL: &zeroValue}
+	e.GetURL()
+	e = &Environment{}
+	e.GetURL()
+	e = nil
+	e.GetURL()
+}
+
+func TestEnvironment_GetWaitTimer(tt *testing.T) {
+	var zeroValue int
+	e := &Environment{WaitTimer: &zeroValue}
+	e.GetWaitTimer()
+	e = &Environment{}
+	e.GetWaitTimer()
+	e = nil
+	e.GetWaitTimer()
+}
+
+func TestEnvResponse_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	e := &EnvResponse{TotalCount: &zeroValue}
+	e.GetTotalCount()
+	e = &EnvResponse{}
+	e.GetTotalCount()
+	e = nil
+	e.GetTotalCount()
+}
+
+func TestEnvReviewers_GetID(tt *testing.T) {
+	var zeroValue int64
+	e := &EnvReviewers{ID: &zeroValue}
+	e.GetID()
+	e = &EnvReviewers{}
+	e.GetID()
+	e = nil
+	e.GetID()
+}
+
+func TestEnvReviewers_GetType(tt *testing.T) {
+	var zeroValue string
+	e := &EnvReviewers{Type: &zeroValue}
+	e.GetType()
+	e = &EnvReviewers{}
+	e.GetType()
+	e = nil
+	e.GetType()
+}
+
+func TestErrorBlock_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &ErrorBlock{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &ErrorBlock{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
+}
+
+func TestErrorResponse_GetBlock(tt *testing.T) {
+	e := &ErrorResponse{}
+	e.GetBlock()
+	e = nil
+	e.GetBlock()
+}
+
+func TestEvent_GetActor(tt *testing.T) {
+	e := &Event{}
+	e.GetActor()
+	e = nil
+	e.GetActor()
+}
+
+func TestEvent_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Event{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &Event{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
+}
+
+func TestEvent_GetID(tt *testing.T) {
+	var zeroValue string
+	e := &Event{ID: &zeroValue}
+	e.GetID()
+	e = &Event{}
+	e.GetID()
+	e = nil
+	e.GetID()
+}
+
+func TestEvent_GetOrg(tt *testing.T) {
+	e := &Event{}
+	e.GetOrg()
+	e = nil
+	e.GetOrg()
+}
+
+func TestEvent_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	e := &Event{Public: &zeroValue}
+	e.GetPublic()
+	e = &Event{}
+	e.GetPublic()
+	e = nil
+	e.GetPublic()
+}
+
+func TestEvent_GetRawPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	e := &Event{RawPayload: &zeroValue}
+	e.GetRawPayload()
+	e = &Event{}
+	e.GetRawPayload()
+	e = nil
+	e.GetRawPayload()
+}
+
+func TestEvent_GetRepo(tt *testing.T) {
+	e := &Event{}
+	e.GetRepo()
+	e = nil
+	e.GetRepo()
+}
+
+func TestEvent_GetType(tt *testing.T) {
+	var zeroValue string
+	e := &Event{Type: &zeroValue}
+	e.GetType()
+	e = &Event{}
+	e.GetType()
+	e = nil
+	e.GetType()
+}
+
+func TestExternalGroup_GetGroupID(tt *testing.T) {
+	var zeroValue int64
+	e := &ExternalGroup{GroupID: &zeroValue}
+	e.GetGroupID()
+	e = &ExternalGroup{}
+	e.GetGroupID()
+	e = nil
+	e.GetGroupID()
+}
+
+func TestExternalGroup_GetGroupName(tt *testing.T) {
+	var zeroValue string
+	e := &ExternalGroup{GroupName: &zeroValue}
+	e.GetGroupName()
+	e = &ExternalGroup{}
+	e.GetGroupName()
+	e = nil
+	e.GetGroupName()
+}
+
+func TestExternalGroup_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &ExternalGroup{UpdatedAt: &zeroValue}
+	e.GetUpdatedAt()
+	e = &ExternalGroup{}
+	e.GetUpdatedAt()
+	e = nil
+	e.GetUpdatedAt()
+}
+
+func TestExternalGroupMember_GetMemberEmail(tt *testing.T) {
+	var zeroValue string
+	e := &ExternalGroupMember{MemberEmail: &zeroValue}
+	e.GetMemberEmail()
+	e = &ExternalGroupMember{}
+	e.GetMemberEmail()
+	e = nil
+	e.GetMemberEmail()
+}
+
+func TestExternalGroupMember_GetMemberID(tt *testing.T) {
+	var zeroValue int64
+	e := &ExternalGroupMember{MemberID: &zeroValue}
+	e.GetMemberID()
+	e = &ExternalGroupMember{}
+	e.GetMemberID()
+	e = nil
+	e.GetMemberID()
+}
+
+func TestExternalGroupMember_GetMemberLogin(tt *testing.T) {
+	var zeroValue string
+	e := &ExternalGroupMember{MemberLogin: &zeroValue}
+	e.GetMemberLogin()
+	e = &ExternalGroupMember{}
+	e.GetMemberLogin()
+	e = nil
+	e.GetMemberLogin()
+}
+
+func TestExternalGroupMember_GetMemberName(tt *testing.T) {
+	var zeroValue string
+	e := &ExternalGroupMember{MemberName: &zeroValue}
+	e.GetMemberName()
+	e = &ExternalGroupMember{}
+	e.GetMemberName()
+	e = nil
+	e.GetMemberName()
+}
+
+func TestExternalGroupTeam_GetTeamID(tt *testing.T) {
+	var zeroValue int64
+	e := &ExternalGroupTeam{TeamID: &zeroValue}
+	e.GetTeamID()
+	e = &ExternalGroupTeam{}
+	e.GetTeamID()
+	e = nil
+	e.GetTeamID()
+}
+
+func TestExternalGroupTeam_GetTeamName(tt *testing.T) {
+	var zeroValue string
+	e := &ExternalGroupTeam{TeamName: &zeroValue}
+	e.GetTeamName()
+	e = &ExternalGroupTeam{}
+	e.GetTeamName()
+	e = nil
+	e.GetTeamName()
+}
+
+func TestFeedLink_GetHRef(tt *testing.T) {
+	var zeroValue string
+	f := &FeedLink{HRef: &zeroValue}
+	f.GetHRef()
+	f = &FeedLink{}
+	f.GetHRef()
+	f = nil
+	f.GetHRef()
+}
+
+func TestFeedLink_GetType(tt *testing.T) {
+	var zeroValue string
+	f := &FeedLink{Type: &zeroValue}
+	f.GetType()
+	f = &FeedLink{}
+	f.GetType()
+	f = nil
+	f.GetType()
+}
+
+func TestFeedLinks_GetCurrentUser(tt *testing.T) {
+	f := &FeedLinks{}
+	f.GetCurrentUser()
+	f = nil
+	f.GetCurrentUser()
+}
+
+func TestFeedLinks_GetCurrentUserActor(tt *testing.T) {
+	f := &FeedLinks{}
+	f.GetCurrentUserActor()
+	f = nil
+	f.GetCurrentUserActor()
+}
+
+func TestFeedLinks_GetCurrentUserOrganization(tt *testing.T) {
+	f := &FeedLinks{}
+	f.GetCurrentUserOrganization()
+	f = nil
+	f.GetCurrentUserOrganization()
+}
+
+func TestFeedLinks_GetCurrentUserPublic(tt *testing.T) {
+	f := &FeedLinks{}
+	f.GetCurrentUserPublic()
+	f = nil
+	f.GetCurrentUserPublic()
+}
+
+func TestFeedLinks_GetTimeline(tt *testing.T) {
+	f := &FeedLinks{}
+	f.GetTimeline()
+	f = nil
+	f.GetTimeline()
+}
+
+func TestFeedLinks_GetUser(tt *testing.T) {
+	f := &FeedLinks{}
+	f.GetUser()
+	f = nil
+	f.GetUser()
+}
+
+func TestFeeds_GetCurrentUserActorURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserActorURL: &zeroValue}
+	f.GetCurrentUserActorURL()
+	f = &Feeds{}
+	f.GetCurrentUserActorURL()
+	f = nil
+	f.GetCurrentUserActorURL()
+}
+
+func TestFeeds_GetCurrentUserOrganizationURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserOrganizationURL: &zeroValue}
+	f.GetCurrentUserOrganizationURL()
+	f = &Feeds{}
+	f.GetCurrentUserOrganizationURL()
+	f = nil
+	f.GetCurrentUserOrganizationURL()
+}
+
+func TestFeeds_GetCurrentUserPublicURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserPublicURL: &zeroValue}
+	f.GetCurrentUserPublicURL()
+	f = &Feeds{}
+	f.GetCurrentUserPublicURL()
+	f = nil
+	f.GetCurrentUserPublicURL()
+}
+
+func TestFeeds_GetCurrentUserURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserURL: &zeroValue}
+	f.GetCurrentUserURL()
+	f = &Feeds{}
+	f.GetCurrentUserURL()
+	f = nil
+	f.GetCurrentUserURL()
+}
+
+func TestFeeds_GetLinks(tt *testing.T) {
+	f := &Feeds{}
+	f.GetLinks()
+	f = nil
+	f.GetLinks()
+}
+
+func TestFeeds_GetTimelineURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{TimelineURL: &zeroValue}
+	f.GetTimelineURL()
+	f = &Feeds{}
+	f.GetTimelineURL()
+	f = nil
+	f.GetTimelineURL()
+}
+
+func TestFeeds_GetUserURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{UserURL: &zeroValue}
+	f.GetUserURL()
+	f = &Feeds{}
+	f.GetUserURL()
+	f = nil
+	f.GetUserURL()
+}
+
+func TestFirstPatchedVersion_GetIdentifier(tt *testing.T) {
+	var zeroValue string
+	f := &FirstPatchedVersion{Identifier: &zeroValue}
+	f.GetIdentifier()
+	f = &FirstPatchedVersion{}
+	f.GetIdentifier()
+	f = nil
+	f.GetIdentifier()
+}
+
+func TestForkEvent_GetForkee(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetForkee()
+	f = nil
+	f.GetForkee()
+}
+
+func TestForkEvent_GetInstallation(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetInstallation()
+	f = nil
+	f.GetInstallation()
+}
+
+func TestForkEvent_GetOrg(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetOrg()
+	f = nil
+	f.GetOrg()
+}
+
+func TestForkEvent_GetRepo(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetRepo()
+	f = nil
+	f.GetRepo()
+}
+
+func TestForkEvent_GetSender(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetSender()
+	f = nil
+	f.GetSender()
+}
+
+func TestGenerateNotesOptions_GetPreviousTagName(tt *testing.T) {
+	var zeroValue string
+	g := &GenerateNotesOptions{PreviousTagName: &zeroValue}
+	g.GetPreviousTagName()
+	g = &GenerateNotesOptions{}
+	g.GetPreviousTagName()
+	g = nil
+	g.GetPreviousTagName()
+}
+
+func TestGenerateNotesOptions_GetTargetCommitish(tt *testing.T) {
+	var zeroValue string
+	g := &GenerateNotesOptions{TargetCommitish: &zeroValue}
+	g.GetTargetCommitish()
+	g = &GenerateNotesOptions{}
+	g.GetTargetCommitish()
+	g = nil
+	g.GetTargetCommitish()
+}
+
+func TestGetAuditLogOptions_GetInclude(tt *testing.T) {
+	var zeroValue string
+	g := &GetAuditLogOptions{Include: &zeroValue}
+	g.GetInclude()
+	g = &GetAuditLogOptions{}
+	g.GetInclude()
+	g = nil
+	g.GetInclude()
+}
+
+func TestGetAuditLogOptions_GetOrder(tt *testing.T) {
+	var zeroValue string
+	g := &GetAuditLogOptions{Order: &zeroValue}
+	g.GetOrder()
+	g = &GetAuditLogOptions{}
+	g.GetOrder()
+	g = nil
+	g.GetOrder()
+}
+
+func TestGetAuditLogOptions_GetPhrase(tt *testing.T) {
+	var zeroValue string
+	g := &GetAuditLogOptions{Phrase: &zeroValue}
+	g.GetPhrase()
+	g = &GetAuditLogOptions{}
+	g.GetPhrase()
+	g = nil
+	g.GetPhrase()
+}
+
+func TestGist_GetComments(tt *testing.T) {
+	var zeroValue int
+	g := &Gist{Comments: &zeroValue}
+	g.GetComments()
+	g = &Gist{}
+	g.GetComments()
+	g = nil
+	g.GetComments()
+}
+
+func TestGist_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &Gist{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &Gist{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGist_GetDescription(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{Description: &zeroValue}
+	g.GetDescription()
+	g = &Gist{}
+	g.GetDescription()
+	g = nil
+	g.GetDescription()
+}
+
+func TestGist_GetFiles(tt *testing.T) {
+	zeroValue := map[GistFilename]GistFile{}
+	g := &Gist{Files: zeroValue}
+	g.GetFiles()
+	g = &Gist{}
+	g.GetFiles()
+	g = nil
+	g.GetFiles()
+}
+
+func TestGist_GetGitPullURL(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{GitPullURL: &zeroValue}
+	g.GetGitPullURL()
+	g = &Gist{}
+	g.GetGitPullURL()
+	g = nil
+	g.GetGitPullURL()
+}
+
+func TestGist_GetGitPushURL(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{GitPushURL: &zeroValue}
+	g.GetGitPushURL()
+	g = &Gist{}
+	g.GetGitPushURL()
+	g = nil
+	g.GetGitPushURL()
+}
+
+func TestGist_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{HTMLURL: &zeroValue}
+	g.GetHTMLURL()
+	g = &Gist{}
+	g.GetHTMLURL()
+	g = nil
+	g.GetHTMLURL()
+}
+
+func TestGist_GetID(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{ID: &zeroValue}
+	g.GetID()
+	g = &Gist{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGist_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &Gist{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
+}
+
+func TestGist_GetOwner(tt *testing.T) {
+	g := &Gist{}
+	g.GetOwner()
+	g = nil
+	g.GetOwner()
+}
+
+func TestGist_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	g := &Gist{Public: &zeroValue}
+	g.GetPublic()
+	g = &Gist{}
+	g.GetPublic()
+	g = nil
+	g.GetPublic()
+}
+
+func TestGist_GetTruncated(tt *testing.T) {
+	var zeroValue bool
+	g := &Gist{Truncated: &zeroValue}
+	g.GetTruncated()
+	g = &Gist{}
+	g.GetTruncated()
+	g = nil
+	g.GetTruncated()
+}
+
+func TestGist_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &Gist{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &Gist{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
+}
+
+func TestGistComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	g := &GistComment{Body: &zeroValue}
+	g.GetBody()
+	g = &GistComment{}
+	g.GetBody()
+	g = nil
+	g.GetBody()
+}
+
+func TestGistComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistComment{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GistComment{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGistComment_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &GistComment{ID: &zeroValue}
+	g.GetID()
+	g = &GistComment{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGistComment_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistComment{URL: &zeroValue}
+	g.GetURL()
+	g = &GistComment{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGistComment_GetUser(tt *testing.T) {
+	g := &GistComment{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
+}
+
+func TestGistCommit_GetChangeStatus(tt *testing.T) {
+	g := &GistCommit{}
+	g.GetChangeStatus()
+	g = nil
+	g.GetChangeStatus()
+}
+
+func TestGistCommit_GetCommittedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistCommit{CommittedAt: &zeroValue}
+	g.GetCommittedAt()
+	g = &GistCommit{}
+	g.GetCommittedAt()
+	g = nil
+	g.GetCommittedAt()
+}
+
+func TestGistCommit_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	g := &GistCommit{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &GistCommit{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
+}
+
+func TestGistCommit_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistCommit{URL: &zeroValue}
+	g.GetURL()
+	g = &GistCommit{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGistCommit_GetUser(tt *testing.T) {
+	g := &GistCommit{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
+}
+
+func TestGistCommit_GetVersion(tt *testing.T) {
+	var zeroValue string
+	g := &GistCommit{Version: &zeroValue}
+	g.GetVersion()
+	g = &GistCommit{}
+	g.GetVersion()
+	g = nil
+	g.GetVersion()
+}
+
+func TestGistFile_GetContent(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Content: &zeroValue}
+	g.GetContent()
+	g = &GistFile{}
+	g.GetContent()
+	g = nil
+	g.GetContent()
+}
+
+func TestGistFile_GetFilename(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Filename: &zeroValue}
+	g.GetFilename()
+	g = &GistFile{}
+	g.GetFilename()
+	g = nil
+	g.GetFilename()
+}
+
+func TestGistFile_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Language: &zeroValue}
+	g.GetLanguage()
+	g = &GistFile{}
+	g.GetLanguage()
+	g = nil
+	g.GetLanguage()
+}
+
+func TestGistFile_GetRawURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{RawURL: &zeroValue}
+	g.GetRawURL()
+	g = &GistFile{}
+	g.GetRawURL()
+	g = nil
+	g.GetRawURL()
+}
+
+func TestGistFile_GetSize(tt *testing.T) {
+	var zeroValue int
+	g := &GistFile{Size: &zeroValue}
+	g.GetSize()
+	g = &GistFile{}
+	g.GetSize()
+	g = nil
+	g.GetSize()
+}
+
+func TestGistFile_GetTruncated(tt *testing.T) {
+	var zeroValue bool
+	g := &GistFile{Truncated: &zeroValue}
+	g.GetTruncated()
+	g = &GistFile{}
+	g.GetTruncated()
+	g = nil
+	g.GetTruncated()
+}
+
+func TestGistFile_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Type: &zeroValue}
+	g.GetType()
+	g = &GistFile{}
+	g.GetType()
+	g = nil
+	g.GetType()
+}
+
+func TestGistFork_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistFork{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GistFork{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGistFork_GetID(tt *testing.T) {
+	var zeroValue string
+	g := &GistFork{ID: &zeroValue}
+	g.GetID()
+	g = &GistFork{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGistFork_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	g := &GistFork{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &GistFork{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
+}
+
+func TestGistFork_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistFork{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &GistFork{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
+}
+
+func TestGistFork_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistFork{URL: &zeroValue}
+	g.GetURL()
+	g = &GistFork{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGistFork_GetUser(tt *testing.T) {
+	g := &GistFork{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
+}
+
+func TestGistStats_GetPrivateGists(tt *testing.T) {
+	var zeroValue int
+	g := &GistStats{PrivateGists: &zeroValue}
+	g.GetPrivateGists()
+	g = &GistStats{}
+	g.GetPrivateGists()
+	g = nil
+	g.GetPrivateGists()
+}
+
+func TestGistStats_GetPublicGists(tt *testing.T) {
+	var zeroValue int
+	g := &GistStats{PublicGists: &zeroValue}
+	g.GetPublicGists()
+	g = &GistStats{}
+	g.GetPublicGists()
+	g = nil
+	g.GetPublicGists()
+}
+
+func TestGistStats_GetTotalGists(tt *testing.T) {
+	var zeroValue int
+	g := &GistStats{TotalGists: &zeroValue}
+	g.GetTotalGists()
+	g = &GistStats{}
+	g.GetTotalGists()
+	g = nil
+	g.GetTotalGists()
+}
+
+func TestGitHubAppAuthorizationEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	g := &GitHubAppAuthorizationEvent{Action: &zeroValue}
+	g.GetAction()
+	g = &GitHubAppAuthorizationEvent{}
+	g.GetAction()
+	g = nil
+	g.GetAction()
+}
+
+func TestGitHubAppAuthorizationEvent_GetInstallation(tt *testing.T) {
+	g := &GitHubAppAuthorizationEvent{}
+	g.GetInstallation()
+	g = nil
+	g.GetInstallation()
+}
+
+func TestGitHubAppAuthorizationEvent_GetSender(tt *testing.T) {
+	g := &GitHubAppAuthorizationEvent{}
+	g.GetSender()
+	g = nil
+	g.GetSender()
+}
+
+func TestGitignore_GetName(tt *testing.T) {
+	var zeroValue string
+	g := &Gitignore{Name: &zeroValue}
+	g.GetName()
+	g = &Gitignore{}
+	g.GetName()
+	g = nil
+	g.GetName()
+}
+
+func TestGitignore_GetSource(tt *testing.T) {
+	var zeroValue string
+	g := &Gitignore{Source: &zeroValue}
+	g.GetSource()
+	g = &Gitignore{}
+	g.GetSource()
+	g = nil
+	g.GetSource()
+}
+
+func TestGitObject_GetSHA(tt *testing.T) {
+	var zeroValue string
+	g := &GitObject{SHA: &zeroValue}
+	g.GetSHA()
+	g = &GitObject{}
+	g.GetSHA()
+	g = nil
+	g.GetSHA()
+}
+
+func TestGitObject_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GitObject{Type: &zeroValue}
+	g.GetType()
+	g = &GitObject{}
+	g.GetType()
+	g = nil
+	g.GetType()
+}
+
+func TestGitObject_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GitObject{URL: &zeroValue}
+	g.GetURL()
+	g = &GitObject{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGlobalSecurityAdvisory_GetGithubReviewedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{GithubReviewedAt: &zeroValue}
+	g.GetGithubReviewedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetGithubReviewedAt()
+	g = nil
+	g.GetGithubReviewedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &GlobalSecurityAdvisory{ID: &zeroValue}
+	g.GetID()
+	g = &GlobalSecurityAdvisory{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGlobalSecurityAdvisory_GetNVDPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{NVDPublishedAt: &zeroValue}
+	g.GetNVDPublishedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetNVDPublishedAt()
+	g = nil
+	g.GetNVDPublishedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetRepositoryAdvisoryURL(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{RepositoryAdvisoryURL: &zeroValue}
+	g.GetRepositoryAdvisoryURL()
+	g = &GlobalSecurityAdvisory{}
+	g.GetRepositoryAdvisoryURL()
+	g = nil
+	g.GetRepositoryAdvisoryURL()
+}
+
+func TestGlobalSecurityAdvisory_GetSourceCodeLocation(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{SourceCodeLocation: &zeroValue}
+	g.GetSourceCodeLocation()
+	g = &GlobalSecurityAdvisory{}
+	g.GetSourceCodeLocation()
+	g = nil
+	g.GetSourceCodeLocation()
+}
+
+func TestGlobalSecurityAdvisory_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Type: &zeroValue}
+	g.GetType()
+	g = &GlobalSecurityAdvisory{}
+	g.GetType()
+	g = nil
+	g.GetType()
+}
+
+func TestGlobalSecurityVulnerability_GetFirstPatchedVersion(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityVulnerability{FirstPatchedVersion: &zeroValue}
+	g.GetFirstPatchedVersion()
+	g = &GlobalSecurityVulnerability{}
+	g.GetFirstPatchedVersion()
+	g = nil
+	g.GetFirstPatchedVersion()
+}
+
+func TestGlobalSecurityVulnerability_GetPackage(tt *testing.T) {
+	g := &GlobalSecurityVulnerability{}
+	g.GetPackage()
+	g = nil
+	g.GetPackage()
+}
+
+func TestGlobalSecurityVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityVulnerability{VulnerableVersionRange: &zeroValue}
+	g.GetVulnerableVersionRange()
+	g = &GlobalSecurityVulnerability{}
+	g.GetVulnerableVersionRange()
+	g = nil
+	g.GetVulnerableVersionRange()
+}
+
+func TestGollumEvent_GetInstallation(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetInstallation()
+	g = nil
+	g.GetInstallation()
+}
+
+func TestGollumEvent_GetOrg(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetOrg()
+	g = nil
+	g.GetOrg()
 }
 
 func TestGollumEvent_GetRepo(tt *testing.T) {
 	g := &GollumEvent{}
 	g.GetRepo()
 	g = nil
-	g.GetRepo()
+	g.GetRepo()
+}
+
+func TestGollumEvent_GetSender(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetSender()
+	g = nil
+	g.GetSender()
+}
+
+func TestGoogleCloudStreamConfig_GetBucket(tt *testing.T) {
+	var zeroValue string
+	g := &GoogleCloudStreamConfig{Bucket: &zeroValue}
+	g.GetBucket()
+	g = &GoogleCloudStreamConfig{}
+	g.GetBucket()
+	g = nil
+	g.GetBucket()
+}
+
+func TestGoogleCloudStreamConfig_GetEncryptedJSONCredentials(tt *testing.T) {
+	var zeroValue string
+	g := &GoogleCloudStreamConfig{EncryptedJSONCredentials: &zeroValue}
+	g.GetEncryptedJSONCredentials()
+	g = &GoogleCloudStreamConfig{}
+	g.GetEncryptedJSONCredentials()
+	g = nil
+	g.GetEncryptedJSONCredentials()
+}
+
+func TestGoogleCloudStreamConfig_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	g := &GoogleCloudStreamConfig{KeyID: &zeroValue}
+	g.GetKeyID()
+	g = &GoogleCloudStreamConfig{}
+	g.GetKeyID()
+	g = nil
+	g.GetKeyID()
+}
+
+func TestGPGEmail_GetEmail(tt *testing.T) {
+	var zeroValue string
+	g := &GPGEmail{Email: &zeroValue}
+	g.GetEmail()
+	g = &GPGEmail{}
+	g.GetEmail()
+	g = nil
+	g.GetEmail()
+}
+
+func TestGPGEmail_GetVerified(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGEmail{Verified: &zeroValue}
+	g.GetVerified()
+	g = &GPGEmail{}
+	g.GetVerified()
+	g = nil
+	g.GetVerified()
+}
+
+func TestGPGKey_GetCanCertify(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanCertify: &zeroValue}
+	g.GetCanCertify()
+	g = &GPGKey{}
+	g.GetCanCertify()
+	g = nil
+	g.GetCanCertify()
+}
+
+func TestGPGKey_GetCanEncryptComms(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanEncryptComms: &zeroValue}
+	g.GetCanEncryptComms()
+	g = &GPGKey{}
+	g.GetCanEncryptComms()
+	g = nil
+	g.GetCanEncryptComms()
+}
+
+func TestGPGKey_GetCanEncryptStorage(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanEncryptStorage: &zeroValue}
+	g.GetCanEncryptStorage()
+	g = &GPGKey{}
+	g.GetCanEncryptStorage()
+	g = nil
+	g.GetCanEncryptStorage()
+}
+
+func TestGPGKey_GetCanSign(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanSign: &zeroValue}
+	g.GetCanSign()
+	g = &GPGKey{}
+	g.GetCanSign()
+	g = nil
+	g.GetCanSign()
+}
+
+func TestGPGKey_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GPGKey{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GPGKey{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGPGKey_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GPGKey{ExpiresAt: &zeroValue}
+	g.GetExpiresAt()
+	g = &GPGKey{}
+	g.GetExpiresAt()
+	g = nil
+	g.GetExpiresAt()
+}
+
+func TestGPGKey_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &GPGKey{ID: &zeroValue}
+	g.GetID()
+	g = &GPGKey{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGPGKey_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	g := &GPGKey{KeyID: &zeroValue}
+	g.GetKeyID()
+	g = &GPGKey{}
+	g.GetKeyID()
+	g = nil
+	g.GetKeyID()
+}
+
+func TestGPGKey_GetName(tt *testing.T) {
+	var zeroValue string
+	g := &GPGKey{Name: &zeroValue}
+	g.GetName()
+	g = &GPGKey{}
+	g.GetName()
+	g = nil
+	g.GetName()
+}
+
+func TestGPGKey_GetPrimaryKeyID(tt *testing.T) {
+	var zeroValue int64
+	g := &GPGKey{PrimaryKeyID: &zeroValue}
+	g.GetPrimaryKeyID()
+	g = &GPGKey{}
+	g.GetPrimaryKeyID()
+	g = nil
+	g.GetPrimaryKeyID()
+}
+
+func TestGPGKey_GetPublicKey(tt *testing.T) {
+	var zeroValue string
+	g := &GPGKey{PublicKey: &zeroValue}
+	g.GetPublicKey()
+	g = &GPGKey{}
+	g.GetPublicKey()
+	g = nil
+	g.GetPublicKey()
+}
+
+func TestGPGKey_GetRawKey(tt *testing.T) {
+	var zeroValue string
+	g := &GPGKey{RawKey: &zeroValue}
+	g.GetRawKey()
+	g = &GPGKey{}
+	g.GetRawKey()
+	g = nil
+	g.GetRawKey()
+}
+
+func TestGPGKey_GetRevoked(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{Revoked: &zeroValue}
+	g.GetRevoked()
+	g = &GPGKey{}
+	g.GetRevoked()
+	g = nil
+	g.GetRevoked()
+}
+
+func TestGrant_GetApp(tt *testing.T) {
+	g := &Grant{}
+	g.GetApp()
+	g = nil
+	g.GetApp()
+}
+
+func TestGrant_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &Grant{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &Grant{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGrant_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &Grant{ID: &zeroValue}
+	g.GetID()
+	g = &Grant{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGrant_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &Grant{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &Grant{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
+}
+
+func TestGrant_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &Grant{URL: &zeroValue}
+	g.GetURL()
+	g = &Grant{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestHeadCommit_GetAuthor(tt *testing.T) {
+	h := &HeadCommit{}
+	h.GetAuthor()
+	h = nil
+	h.GetAuthor()
+}
+
+func TestHeadCommit_GetCommitter(tt *testing.T) {
+	h := &HeadCommit{}
+	h.GetCommitter()
+	h = nil
+	h.GetCommitter()
+}
+
+func TestHeadCommit_GetDistinct(tt *testing.T) {
+	var zeroValue bool
+	h := &HeadCommit{Distinct: &zeroValue}
+	h.GetDistinct()
+	h = &HeadCommit{}
+	h.GetDistinct()
+	h = nil
+	h.GetDistinct()
+}
+
+func TestHeadCommit_GetID(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{ID: &zeroValue}
+	h.GetID()
+	h = &HeadCommit{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHeadCommit_GetMessage(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{Message: &zeroValue}
+	h.GetMessage()
+	h = &HeadCommit{}
+	h.GetMessage()
+	h = nil
+	h.GetMessage()
+}
+
+func TestHeadCommit_GetSHA(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{SHA: &zeroValue}
+	h.GetSHA()
+	h = &HeadCommit{}
+	h.GetSHA()
+	h = nil
+	h.GetSHA()
+}
+
+func TestHeadCommit_GetTimestamp(tt *testing.T) {
+	var zeroValue Timestamp
+	h := &HeadCommit{Timestamp: &zeroValue}
+	h.GetTimestamp()
+	h = &HeadCommit{}
+	h.GetTimestamp()
+	h = nil
+	h.GetTimestamp()
+}
+
+func TestHeadCommit_GetTreeID(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{TreeID: &zeroValue}
+	h.GetTreeID()
+	h = &HeadCommit{}
+	h.GetTreeID()
+	h = nil
+	h.GetTreeID()
+}
+
+func TestHeadCommit_GetURL(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{URL: &zeroValue}
+	h.GetURL()
+	h = &HeadCommit{}
+	h.GetURL()
+	h = nil
+	h.GetURL()
+}
+
+func TestHECStreamConfig_GetDomain(tt *testing.T) {
+	var zeroValue string
+	h := &HECStreamConfig{Domain: &zeroValue}
+	h.GetDomain()
+	h = &HECStreamConfig{}
+	h.GetDomain()
+	h = nil
+	h.GetDomain()
+}
+
+func TestHECStreamConfig_GetEncryptedToken(tt *testing.T) {
+	var zeroValue string
+	h := &HECStreamConfig{EncryptedToken: &zeroValue}
+	h.GetEncryptedToken()
+	h = &HECStreamConfig{}
+	h.GetEncryptedToken()
+	h = nil
+	h.GetEncryptedToken()
+}
+
+func TestHECStreamConfig_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	h := &HECStreamConfig{KeyID: &zeroValue}
+	h.GetKeyID()
+	h = &HECStreamConfig{}
+	h.GetKeyID()
+	h = nil
+	h.GetKeyID()
+}
+
+func TestHECStreamConfig_GetPath(tt *testing.T) {
+	var zeroValue string
+	h := &HECStreamConfig{Path: &zeroValue}
+	h.GetPath()
+	h = &HECStreamConfig{}
+	h.GetPath()
+	h = nil
+	h.GetPath()
+}
+
+func TestHECStreamConfig_GetPort(tt *testing.T) {
+	var zeroValue int
+	h := &HECStreamConfig{Port: &zeroValue}
+	h.GetPort()
+	h = &HECStreamConfig{}
+	h.GetPort()
+	h = nil
+	h.GetPort()
+}
+
+func TestHECStreamConfig_GetSSLVerify(tt *testing.T) {
+	var zeroValue bool
+	h := &HECStreamConfig{SSLVerify: &zeroValue}
+	h.GetSSLVerify()
+	h = &HECStreamConfig{}
+	h.GetSSLVerify()
+	h = nil
+	h.GetSSLVerify()
+}
+
+func TestHook_GetActive(tt *testing.T) {
+	var zeroValue bool
+	h := &Hook{Active: &zeroValue}
+	h.GetActive()
+	h = &Hook{}
+	h.GetActive()
+	h = nil
+	h.GetActive()
+}
+
+func TestHook_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	h := &Hook{CreatedAt: &zeroValue}
+	h.GetCreatedAt()
+	h = &Hook{}
+	h.GetCreatedAt()
+	h = nil
+	h.GetCreatedAt()
+}
+
+func TestHook_GetID(tt *testing.T) {
+	var zeroValue int64
+	h := &Hook{ID: &zeroValue}
+	h.GetID()
+	h = &Hook{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHook_GetName(tt *testing.T) {
+	var zeroValue string
+	h := &Hook{Name: &zeroValue}
+	h.GetName()
+	h = &Hook{}
+	h.GetName()
+	h = nil
+	h.GetName()
+}
+
+func TestHook_GetPingURL(tt *testing.T) {
+	var zeroValue string
+	h := &Hook{PingURL: &zeroValue}
+	h.GetPingURL()
+	h = &Hook{}
+	h.GetPingURL()
+	h = nil
+	h.GetPingURL()
+}
+
+func TestHook_GetTestURL(tt *testing.T) {
+	var zeroValue string
+	h := &Hook{TestURL: &zeroValue}
+	h.GetTestURL()
+	h = &Hook{}
+	h.GetTestURL()
+	h = nil
+	h.GetTestURL()
+}
+
+func TestHook_GetType(tt *testing.T) {
+	var zeroValue string
+	h := &Hook{Type: &zeroValue}
+	h.GetType()
+	h = &Hook{}
+	h.GetType()
+	h = nil
+	h.GetType()
+}
+
+func TestHook_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	h := &Hook{UpdatedAt: &zeroValue}
+	h.GetUpdatedAt()
+	h = &Hook{}
+	h.GetUpdatedAt()
+	h = nil
+	h.GetUpdatedAt()
+}
+
+func TestHook_GetURL(tt *testing.T) {
+	var zeroValue string
+	h := &Hook{URL: &zeroValue}
+	h.GetURL()
+	h = &Hook{}
+	h.GetURL()
+	h = nil
+	h.GetURL()
+}
+
+func TestHookConfig_GetContentType(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{ContentType: &zeroValue}
+	h.GetContentType()
+	h = &HookConfig{}
+	h.GetContentType()
+	h = nil
+	h.GetContentType()
+}
+
+func TestHookConfig_GetInsecureSSL(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{InsecureSSL: &zeroValue}
+	h.GetInsecureSSL()
+	h = &HookConfig{}
+	h.GetInsecureSSL()
+	h = nil
+	h.GetInsecureSSL()
+}
+
+func TestHookConfig_GetSecret(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{Secret: &zeroValue}
+	h.GetSecret()
+	h = &HookConfig{}
+	h.GetSecret()
+	h = nil
+	h.GetSecret()
+}
+
+func TestHookConfig_GetURL(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{URL: &zeroValue}
+	h.GetURL()
+	h = &HookConfig{}
+	h.GetURL()
+	h = nil
+	h.GetURL()
+}
+
+func TestHookDelivery_GetAction(tt *testing.T) {
+	var zeroValue string
+	h := &HookDelivery{Action: &zeroValue}
+	h.GetAction()
+	h = &HookDelivery{}
+	h.GetAction()
+	h = nil
+	h.GetAction()
+}
+
+func TestHookDelivery_GetDeliveredAt(tt *testing.T) {
+	var zeroValue Timestamp
+	h := &HookDelivery{DeliveredAt: &zeroValue}
+	h.GetDeliveredAt()
+	h = &HookDelivery{}
+	h.GetDeliveredAt()
+	h = nil
+	h.GetDeliveredAt()
+}
+
+func TestHookDelivery_GetDuration(tt *testing.T) {
+	h := &HookDelivery{}
+	h.GetDuration()
+	h = nil
+	h.GetDuration()
+}
+
+func TestHookDelivery_GetEvent(tt *testing.T) {
+	var zeroValue string
+	h := &HookDelivery{Event: &zeroValue}
+	h.GetEvent()
+	h = &HookDelivery{}
+	h.GetEvent()
+	h = nil
+	h.GetEvent()
+}
+
+func TestHookDelivery_GetGUID(tt *testing.T) {
+	var zeroValue string
+	h := &HookDelivery{GUID: &zeroValue}
+	h.GetGUID()
+	h = &HookDelivery{}
+	h.GetGUID()
+	h = nil
+	h.GetGUID()
+}
+
+func TestHookDelivery_GetID(tt *testing.T) {
+	var zeroValue int64
+	h := &HookDelivery{ID: &zeroValue}
+	h.GetID()
+	h = &HookDelivery{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHookDelivery_GetInstallationID(tt *testing.T) {
+	var zeroValue int64
+	h := &HookDelivery{InstallationID: &zeroValue}
+	h.GetInstallationID()
+	h = &HookDelivery{}
+	h.GetInstallationID()
+	h = nil
+	h.GetInstallationID()
+}
+
+func TestHookDelivery_GetRedelivery(tt *testing.T) {
+	var zeroValue bool
+	h := &HookDelivery{Redelivery: &zeroValue}
+	h.GetRedelivery()
+	h = &HookDelivery{}
+	h.GetRedelivery()
+	h = nil
+	h.GetRedelivery()
+}
+
+func TestHookDelivery_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	h := &HookDelivery{RepositoryID: &zeroValue}
+	h.GetRepositoryID()
+	h = &HookDelivery{}
+	h.GetRepositoryID()
+	h = nil
+	h.GetRepositoryID()
+}
+
+func TestHookDelivery_GetRequest(tt *testing.T) {
+	h := &HookDelivery{}
+	h.GetRequest()
+	h = nil
+	h.GetRequest()
+}
+
+func TestHookDelivery_GetResponse(tt *testing.T) {
+	h := &HookDelivery{}
+	h.GetResponse()
+	h = nil
+	h.GetResponse()
+}
+
+func TestHookDelivery_GetStatus(tt *testing.T) {
+	var zeroValue string
+	h := &HookDelivery{Status: &zeroValue}
+	h.GetStatus()
+	h = &HookDelivery{}
+	h.GetStatus()
+	h = nil
+	h.GetStatus()
+}
+
+func TestHookDelivery_GetStatusCode(tt *testing.T) {
+	var zeroValue int
+	h := &HookDelivery{StatusCode: &zeroValue}
+	h.GetStatusCode()
+	h = &HookDelivery{}
+	h.GetStatusCode()
+	h = nil
+	h.GetStatusCode()
+}
+
+func TestHookRequest_GetHeaders(tt *testing.T) {
+	zeroValue := map[string]string{}
+	h := &HookRequest{Headers: zeroValue}
+	h.GetHeaders()
+	h = &HookRequest{}
+	h.GetHeaders()
+	h = nil
+	h.GetHeaders()
+}
+
+func TestHookRequest_GetRawPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	h := &HookRequest{RawPayload: &zeroValue}
+	h.GetRawPayload()
+	h = &HookRequest{}
+	h.GetRawPayload()
+	h = nil
+	h.GetRawPayload()
+}
+
+func TestHookResponse_GetHeaders(tt *testing.T) {
+	zeroValue := map[string]string{}
+	h := &HookResponse{Headers: zeroValue}
+	h.GetHeaders()
+	h = &HookResponse{}
+	h.GetHeaders()
+	h = nil
+	h.GetHeaders()
+}
+
+func TestHookResponse_GetRawPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	h := &HookResponse{RawPayload: &zeroValue}
+	h.GetRawPayload()
+	h = &HookResponse{}
+	h.GetRawPayload()
+	h = nil
+	h.GetRawPayload()
+}
+
+func TestHookStats_GetActiveHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{ActiveHooks: &zeroValue}
+	h.GetActiveHooks()
+	h = &HookStats{}
+	h.GetActiveHooks()
+	h = nil
+	h.GetActiveHooks()
+}
+
+func TestHookStats_GetInactiveHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{InactiveHooks: &zeroValue}
+	h.GetInactiveHooks()
+	h = &HookStats{}
+	h.GetInactiveHooks()
+	h = nil
+	h.GetInactiveHooks()
+}
+
+func TestHookStats_GetTotalHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{TotalHooks: &zeroValue}
+	h.GetTotalHooks()
+	h = &HookStats{}
+	h.GetTotalHooks()
+	h = nil
+	h.GetTotalHooks()
+}
+
+func TestIDPGroup_GetGroupDescription(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupDescription: &zeroValue}
+	i.GetGroupDescription()
+	i = &IDPGroup{}
+	i.GetGroupDescription()
+	i = nil
+	i.GetGroupDescription()
+}
+
+func TestIDPGroup_GetGroupID(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupID: &zeroValue}
+	i.GetGroupID()
+	i = &IDPGroup{}
+	i.GetGroupID()
+	i = nil
+	i.GetGroupID()
+}
+
+func TestIDPGroup_GetGroupName(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupName: &zeroValue}
+	i.GetGroupName()
+	i = &IDPGroup{}
+	i.GetGroupName()
+	i = nil
+	i.GetGroupName()
+}
+
+func TestImport_GetAuthorsCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{AuthorsCount: &zeroValue}
+	i.GetAuthorsCount()
+	i = &Import{}
+	i.GetAuthorsCount()
+	i = nil
+	i.GetAuthorsCount()
+}
+
+func TestImport_GetAuthorsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{AuthorsURL: &zeroValue}
+	i.GetAuthorsURL()
+	i = &Import{}
+	i.GetAuthorsURL()
+	i = nil
+	i.GetAuthorsURL()
+}
+
+func TestImport_GetCommitCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{CommitCount: &zeroValue}
+	i.GetCommitCount()
+	i = &Import{}
+	i.GetCommitCount()
+	i = nil
+	i.GetCommitCount()
+}
+
+func TestImport_GetFailedStep(tt *testing.T) {
+	var zeroValue string
+	i := &Import{FailedStep: &zeroValue}
+	i.GetFailedStep()
+	i = &Import{}
+	i.GetFailedStep()
+	i = nil
+	i.GetFailedStep()
+}
+
+func TestImport_GetHasLargeFiles(tt *testing.T) {
+	var zeroValue bool
+	i := &Import{HasLargeFiles: &zeroValue}
+	i.GetHasLargeFiles()
+	i = &Import{}
+	i.GetHasLargeFiles()
+	i = nil
+	i.GetHasLargeFiles()
+}
+
+func TestImport_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Import{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
+}
+
+func TestImport_GetHumanName(tt *testing.T) {
+	var zeroValue string
+	i := &Import{HumanName: &zeroValue}
+	i.GetHumanName()
+	i = &Import{}
+	i.GetHumanName()
+	i = nil
+	i.GetHumanName()
+}
+
+func TestImport_GetLargeFilesCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{LargeFilesCount: &zeroValue}
+	i.GetLargeFilesCount()
+	i = &Import{}
+	i.GetLargeFilesCount()
+	i = nil
+	i.GetLargeFilesCount()
+}
+
+func TestImport_GetLargeFilesSize(tt *testing.T) {
+	var zeroValue int
+	i := &Import{LargeFilesSize: &zeroValue}
+	i.GetLargeFilesSize()
+	i = &Import{}
+	i.GetLargeFilesSize()
+	i = nil
+	i.GetLargeFilesSize()
+}
+
+func TestImport_GetMessage(tt *testing.T) {
+	var zeroValue string
+	i := &Import{Message: &zeroValue}
+	i.GetMessage()
+	i = &Import{}
+	i.GetMessage()
+	i = nil
+	i.GetMessage()
+}
+
+func TestImport_GetPercent(tt *testing.T) {
+	var zeroValue int
+	i := &Import{Percent: &zeroValue}
+	i.GetPercent()
+	i = &Import{}
+	i.GetPercent()
+	i = nil
+	i.GetPercent()
+}
+
+func TestImport_GetPushPercent(tt *testing.T) {
+	var zeroValue int
+	i := &Import{PushPercent: &zeroValue}
+	i.GetPushPercent()
+	i = &Import{}
+	i.GetPushPercent()
+	i = nil
+	i.GetPushPercent()
+}
+
+func TestImport_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &Import{}
+	i.GetRepositoryURL()
+	i = nil
+	i.GetRepositoryURL()
+}
+
+func TestImport_GetStatus(tt *testing.T) {
+	var zeroValue string
+	i := &Import{Status: &zeroValue}
+	i.GetStatus()
+	i = &Import{}
+	i.GetStatus()
+	i = nil
+	i.GetStatus()
+}
+
+func TestImport_GetStatusText(tt *testing.T) {
+	var zeroValue string
+	i := &Import{StatusText: &zeroValue}
+	i.GetStatusText()
+	i = &Import{}
+	i.GetStatusText()
+	i = nil
+	i.GetStatusText()
+}
+
+func TestImport_GetTFVCProject(tt *testing.T) {
+	var zeroValue string
+	i := &Import{TFVCProject: &zeroValue}
+	i.GetTFVCProject()
+	i = &Import{}
+	i.GetTFVCProject()
+	i = nil
+	i.GetTFVCProject()
+}
+
+func TestImport_GetURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{URL: &zeroValue}
+	i.GetURL()
+	i = &Import{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
+}
+
+func TestImport_GetUseLFS(tt *testing.T) {
+	var zeroValue string
+	i := &Import{UseLFS: &zeroValue}
+	i.GetUseLFS()
+	i = &Import{}
+	i.GetUseLFS()
+	i = nil
+	i.GetUseLFS()
+}
+
+func TestImport_GetVCS(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCS: &zeroValue}
+	i.GetVCS()
+	i = &Import{}
+	i.GetVCS()
+	i = nil
+	i.GetVCS()
+}
+
+func TestImport_GetVCSPassword(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCSPassword: &zeroValue}
+	i.GetVCSPassword()
+	i = &Import{}
+	i.GetVCSPassword()
+	i = nil
+	i.GetVCSPassword()
+}
+
+func TestImport_GetVCSURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCSURL: &zeroValue}
+	i.GetVCSURL()
+	i = &Import{}
+	i.GetVCSURL()
+	i = nil
+	i.GetVCSURL()
+}
+
+func TestImport_GetVCSUsername(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCSUsername: &zeroValue}
+	i.GetVCSUsername()
+	i = &Import{}
+	i.GetVCSUsername()
+	i = nil
+	i.GetVCSUsername()
+}
+
+func TestInstallation_GetAccessTokensURL(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{AccessTokensURL: &zeroValue}
+	i.GetAccessTokensURL()
+	i = &Installation{}
+	i.GetAccessTokensURL()
+	i = nil
+	i.GetAccessTokensURL()
+}
+
+func TestInstallation_GetAccount(tt *testing.T) {
+	i := &Installation{}
+	i.GetAccount()
+	i = nil
+	i.GetAccount()
+}
+
+func TestInstallation_GetAppID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{AppID: &zeroValue}
+	i.GetAppID()
+	i = &Installation{}
+	i.GetAppID()
+	i = nil
+	i.GetAppID()
+}
+
+func TestInstallation_GetAppSlug(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{AppSlug: &zeroValue}
+	i.GetAppSlug()
+	i = &Installation{}
+	i.GetAppSlug()
+	i = nil
+	i.GetAppSlug()
+}
+
+func TestInstallation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Installation{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Installation{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestInstallation_GetHasMultipleSingleFiles(tt *testing.T) {
+	var zeroValue bool
+	i := &Installation{HasMultipleSingleFiles: &zeroValue}
+	i.GetHasMultipleSingleFiles()
+	i = &Installation{}
+	i.GetHasMultipleSingleFiles()
+	i = nil
+	i.GetHasMultipleSingleFiles()
+}
+
+func TestInstallation_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Installation{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
+}
+
+func TestInstallation_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{ID: &zeroValue}
+	i.GetID()
+	i = &Installation{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestInstallation_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Installation{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
+}
+
+func TestInstallation_GetPermissions(tt *testing.T) {
+	i := &Installation{}
+	i.GetPermissions()
+	i = nil
+	i.GetPermissions()
+}
+
+func TestInstallation_GetRepositoriesURL(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{RepositoriesURL: &zeroValue}
+	i.GetRepositoriesURL()
+	i = &Installation{}
+	i.GetRepositoriesURL()
+	i = nil
+	i.GetRepositoriesURL()
+}
+
+func TestInstallation_GetRepositorySelection(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{RepositorySelection: &zeroValue}
+	i.GetRepositorySelection()
+	i = &Installation{}
+	i.GetRepositorySelection()
+	i = nil
+	i.GetRepositorySelection()
+}
+
+func TestInstallation_GetSingleFileName(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{SingleFileName: &zeroValue}
+	i.GetSingleFileName()
+	i = &Installation{}
+	i.GetSingleFileName()
+	i = nil
+	i.GetSingleFileName()
+}
+
+func TestInstallation_GetSuspendedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Installation{SuspendedAt: &zeroValue}
+	i.GetSuspendedAt()
+	i = &Installation{}
+	i.GetSuspendedAt()
+	i = nil
+	i.GetSuspendedAt()
+}
+
+func TestInstallation_GetSuspendedBy(tt *testing.T) {
+	i := &Installation{}
+	i.GetSuspendedBy()
+	i = nil
+	i.GetSuspendedBy()
+}
+
+func TestInstallation_GetTargetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{TargetID: &zeroValue}
+	i.GetTargetID()
+	i = &Installation{}
+	i.GetTargetID()
+	i = nil
+	i.GetTargetID()
+}
+
+func TestInstallation_GetTargetType(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{TargetType: &zeroValue}
+	i.GetTargetType()
+	i = &Installation{}
+	i.GetTargetType()
+	i = nil
+	i.GetTargetType()
+}
+
+func TestInstallation_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Installation{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &Installation{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
+}
+
+func TestInstallationEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &InstallationEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
+}
+
+func TestInstallationEvent_GetInstallation(tt *testing.T) {
+	i := &InstallationEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
+}
+
+func TestInstallationEvent_GetSender(tt *testing.T) {
+	i := &InstallationEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
+func TestInstallationPermissions_GetActions(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Actions: &zeroValue}
+	i.GetActions()
+	i = &InstallationPermissions{}
+	i.GetActions()
+	i = nil
+	i.GetActions()
+}
+
+func TestInstallationPermissions_GetAdministration(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Administration: &zeroValue}
+	i.GetAdministration()
+	i = &InstallationPermissions{}
+	i.GetAdministration()
+	i = nil
+	i.GetAdministration()
+}
+
+func TestInstallationPermissions_GetBlocking(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Blocking: &zeroValue}
+	i.GetBlocking()
+	i = &InstallationPermissions{}
+	i.GetBlocking()
+	i = nil
+	i.GetBlocking()
+}
+
+func TestInstallationPermissions_GetChecks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Checks: &zeroValue}
+	i.GetChecks()
+	i = &InstallationPermissions{}
+	i.GetChecks()
+	i = nil
+	i.GetChecks()
+}
+
+func TestInstallationPermissions_GetCodespaces(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Codespaces: &zeroValue}
+	i.GetCodespaces()
+	i = &InstallationPermissions{}
+	i.GetCodespaces()
+	i = nil
+	i.GetCodespaces()
+}
+
+func TestInstallationPermissions_GetContentReferences(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{ContentReferences: &zeroValue}
+	i.GetContentReferences()
+	i = &InstallationPermissions{}
+	i.GetContentReferences()
+	i = nil
+	i.GetContentReferences()
+}
+
+func TestInstallationPermissions_GetContents(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Contents: &zeroValue}
+	i.GetContents()
+	i = &InstallationPermissions{}
+	i.GetContents()
+	i = nil
+	i.GetContents()
+}
+
+func TestInstallationPermissions_GetDependabotSecrets(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{DependabotSecrets: &zeroValue}
+	i.GetDependabotSecrets()
+	i = &InstallationPermissions{}
+	i.GetDependabotSecrets()
+	i = nil
+	i.GetDependabotSecrets()
+}
+
+func TestInstallationPermissions_GetDeployments(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Deployments: &zeroValue}
+	i.GetDeployments()
+	i = &InstallationPermissions{}
+	i.GetDeployments()
+	i = nil
+	i.GetDeployments()
+}
+
+func TestInstallationPermissions_GetEmailAddresses(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{EmailAddresses: &zeroValue}
+	i.GetEmailAddresses()
+	i = &InstallationPermissions{}
+	i.GetEmailAddresses()
+	i = nil
+	i.GetEmailAddresses()
+}
+
+func TestInstallationPermissions_GetEmails(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Emails: &zeroValue}
+	i.GetEmails()
+	i = &InstallationPermissions{}
+	i.GetEmails()
+	i = nil
+	i.GetEmails()
+}
+
+func TestInstallationPermissions_GetEnvironments(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Environments: &zeroValue}
+	i.GetEnvironments()
+	i = &InstallationPermissions{}
+	i.GetEnvironments()
+	i = nil
+	i.GetEnvironments()
+}
+
+func TestInstallationPermissions_GetFollowers(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Followers: &zeroValue}
+	i.GetFollowers()
+	i = &InstallationPermissions{}
+	i.GetFollowers()
+	i = nil
+	i.GetFollowers()
+}
+
+func TestInstallationPermissions_GetGitSSHKeys(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{GitSSHKeys: &zeroValue}
+	i.GetGitSSHKeys()
+	i = &InstallationPermissions{}
+	i.GetGitSSHKeys()
+	i = nil
+	i.GetGitSSHKeys()
+}
+
+func TestInstallationPermissions_GetGPGKeys(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{GPGKeys: &zeroValue}
+	i.GetGPGKeys()
+	i = &InstallationPermissions{}
+	i.GetGPGKeys()
+	i = nil
+	i.GetGPGKeys()
+}
+
+func TestInstallationPermissions_GetInteractionLimits(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{InteractionLimits: &zeroValue}
+	i.GetInteractionLimits()
+	i = &InstallationPermissions{}
+	i.GetInteractionLimits()
+	i = nil
+	i.GetInteractionLimits()
+}
+
+func TestInstallationPermissions_GetIssues(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Issues: &zeroValue}
+	i.GetIssues()
+	i = &InstallationPermissions{}
+	i.GetIssues()
+	i = nil
+	i.GetIssues()
+}
+
+func TestInstallationPermissions_GetMembers(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Members: &zeroValue}
+	i.GetMembers()
+	i = &InstallationPermissions{}
+	i.GetMembers()
+	i = nil
+	i.GetMembers()
+}
+
+func TestInstallationPermissions_GetMergeQueues(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{MergeQueues: &zeroValue}
+	i.GetMergeQueues()
+	i = &InstallationPermissions{}
+	i.GetMergeQueues()
+	i = nil
+	i.GetMergeQueues()
+}
+
+func TestInstallationPermissions_GetMetadata(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Metadata: &zeroValue}
+	i.GetMetadata()
+	i = &InstallationPermissions{}
+	i.GetMetadata()
+	i = nil
+	i.GetMetadata()
+}
+
+func TestInstallationPermissions_GetOrganizationAdministration(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationAdministration: &zeroValue}
+	i.GetOrganizationAdministration()
+	i = &InstallationPermissions{}
+	i.GetOrganizationAdministration()
+	i = nil
+	i.GetOrganizationAdministration()
+}
+
+func TestInstallationPermissions_GetOrganizationAnnouncementBanners(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationAnnouncementBanners: &zeroValue}
+	i.GetOrganizationAnnouncementBanners()
+	i = &InstallationPermissions{}
+	i.GetOrganizationAnnouncementBanners()
+	i = nil
+	i.GetOrganizationAnnouncementBanners()
+}
+
+func TestInstallationPermissions_GetOrganizationCopilotSeatManagement(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationCopilotSeatManagement: &zeroValue}
+	i.GetOrganizationCopilotSeatManagement()
+	i = &InstallationPermissions{}
+	i.GetOrganizationCopilotSeatManagement()
+	i = nil
+	i.GetOrganizationCopilotSeatManagement()
+}
+
+func TestInstallationPermissions_GetOrganizationCustomOrgRoles(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationCustomOrgRoles: &zeroValue}
+	i.GetOrganizationCustomOrgRoles()
+	i = &InstallationPermissions{}
+	i.GetOrganizationCustomOrgRoles()
+	i = nil
+	i.GetOrganizationCustomOrgRoles()
+}
+
+func TestInstallationPermissions_GetOrganizationCustomProperties(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationCustomProperties: &zeroValue}
+	i.GetOrganizationCustomProperties()
+	i = &InstallationPermissions{}
+	i.GetOrganizationCustomProperties()
+	i = nil
+	i.GetOrganizationCustomProperties()
+}
+
+func TestInstallationPermissions_GetOrganizationCustomRoles(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationCustomRoles: &zeroValue}
+	i.GetOrganizationCustomRoles()
+	i = &InstallationPermissions{}
+	i.GetOrganizationCustomRoles()
+	i = nil
+	i.GetOrganizationCustomRoles()
+}
+
+func TestInstallationPermissions_GetOrganizationEvents(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationEvents: &zeroValue}
+	i.GetOrganizationEvents()
+	i = &InstallationPermissions{}
+	i.GetOrganizationEvents()
+	i = nil
+	i.GetOrganizationEvents()
+}
+
+func TestInstallationPermissions_GetOrganizationHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationHooks: &zeroValue}
+	i.GetOrganizationHooks()
+	i = &InstallationPermissions{}
+	i.GetOrganizationHooks()
+	i = nil
+	i.GetOrganizationHooks()
+}
+
+func TestInstallationPermissions_GetOrganizationPackages(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPackages: &zeroValue}
+	i.GetOrganizationPackages()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPackages()
+	i = nil
+	i.GetOrganizationPackages()
+}
+
+func TestInstallationPermissions_GetOrganizationPersonalAccessTokenRequests(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPersonalAccessTokenRequests: &zeroValue}
+	i.GetOrganizationPersonalAccessTokenRequests()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPersonalAccessTokenRequests()
+	i = nil
+	i.GetOrganizationPersonalAccessTokenRequests()
+}
+
+func TestInstallationPermissions_GetOrganizationPersonalAccessTokens(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPersonalAccessTokens: &zeroValue}
+	i.GetOrganizationPersonalAccessTokens()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPersonalAccessTokens()
+	i = nil
+	i.GetOrganizationPersonalAccessTokens()
+}
+
+func TestInstallationPermissions_GetOrganizationPlan(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPlan: &zeroValue}
+	i.GetOrganizationPlan()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPlan()
+	i = nil
+	i.GetOrganizationPlan()
+}
+
+func TestInstallationPermissions_GetOrganizationPreReceiveHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPreReceiveHooks: &zeroValue}
+	i.GetOrganizationPreReceiveHooks()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPreReceiveHooks()
+	i = nil
+	i.GetOrganizationPreReceiveHooks()
+}
+
+func TestInstallationPermissions_GetOrganizationProjects(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationProjects: &zeroValue}
+	i.GetOrganizationProjects()
+	i = &InstallationPermissions{}
+	i.GetOrganizationProjects()
+	i = nil
+	i.GetOrganizationProjects()
+}
+
+func TestInstallationPermissions_GetOrganizationSecrets(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationSecrets: &zeroValue}
+	i.GetOrganizationSecrets()
+	i = &InstallationPermissions{}
+	i.GetOrganizationSecrets()
+	i = nil
+	i.GetOrganizationSecrets()
+}
+
+func TestInstallationPermissions_GetOrganizationSelfHostedRunners(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationSelfHostedRunners: &zeroValue}
+	i.GetOrganizationSelfHostedRunners()
+	i = &InstallationPermissions{}
+	i.GetOrganizationSelfHostedRunners()
+	i = nil
+	i.GetOrganizationSelfHostedRunners()
+}
+
+func TestInstallationPermissions_GetOrganizationUserBlocking(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationUserBlocking: &zeroValue}
+	i.GetOrganizationUserBlocking()
+	i = &InstallationPermissions{}
+	i.GetOrganizationUserBlocking()
+	i = nil
+	i.GetOrganizationUserBlocking()
+}
+
+func TestInstallationPermissions_GetPackages(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Packages: &zeroValue}
+	i.GetPackages()
+	i = &InstallationPermissions{}
+	i.GetPackages()
+	i = nil
+	i.GetPackages()
+}
+
+func TestInstallationPermissions_GetPages(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Pages: &zeroValue}
+	i.GetPages()
+	i = &InstallationPermissions{}
+	i.GetPages()
+	i = nil
+	i.GetPages()
+}
+
+func TestInstallationPermissions_GetProfile(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Profile: &zeroValue}
+	i.GetProfile()
+	i = &InstallationPermissions{}
+	i.GetProfile()
+	i = nil
+	i.GetProfile()
+}
+
+func TestInstallationPermissions_GetPullRequests(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{PullRequests: &zeroValue}
+	i.GetPullRequests()
+	i = &InstallationPermissions{}
+	i.GetPullRequests()
+	i = nil
+	i.GetPullRequests()
+}
+
+func TestInstallationPermissions_GetRepositoryCustomProperties(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryCustomProperties: &zeroValue}
+	i.GetRepositoryCustomProperties()
+	i = &InstallationPermissions{}
+	i.GetRepositoryCustomProperties()
+	i = nil
+	i.GetRepositoryCustomProperties()
+}
+
+func TestInstallationPermissions_GetRepositoryHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryHooks: &zeroValue}
+	i.GetRepositoryHooks()
+	i = &InstallationPermissions{}
+	i.GetRepositoryHooks()
+	i = nil
+	i.GetRepositoryHooks()
+}
+
+func TestInstallationPermissions_GetRepositoryPreReceiveHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryPreReceiveHooks: &zeroValue}
+	i.GetRepositoryPreReceiveHooks()
+	i = &InstallationPermissions{}
+	i.GetRepositoryPreReceiveHooks()
+	i = nil
+	i.GetRepositoryPreReceiveHooks()
+}
+
+func TestInstallationPermissions_GetRepositoryProjects(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryProjects: &zeroValue}
+	i.GetRepositoryProjects()
+	i = &InstallationPermissions{}
+	i.GetRepositoryProjects()
+	i = nil
+	i.GetRepositoryProjects()
+}
+
+func TestInstallationPermissions_GetSecrets(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Secrets: &zeroValue}
+	i.GetSecrets()
+	i = &InstallationPermissions{}
+	i.GetSecrets()
+	i = nil
+	i.GetSecrets()
+}
+
+func TestInstallationPermissions_GetSecretScanningAlerts(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{SecretScanningAlerts: &zeroValue}
+	i.GetSecretScanningAlerts()
+	i = &InstallationPermissions{}
+	i.GetSecretScanningAlerts()
+	i = nil
+	i.GetSecretScanningAlerts()
+}
+
+func TestInstallationPermissions_GetSecurityEvents(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{SecurityEvents: &zeroValue}
+	i.GetSecurityEvents()
+	i = &InstallationPermissions{}
+	i.GetSecurityEvents()
+	i = nil
+	i.GetSecurityEvents()
+}
+
+func TestInstallationPermissions_GetSingleFile(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{SingleFile: &zeroValue}
+	i.GetSingleFile()
+	i = &InstallationPermissions{}
+	i.GetSingleFile()
+	i = nil
+	i.GetSingleFile()
+}
+
+func TestInstallationPermissions_GetStarring(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Starring: &zeroValue}
+	i.GetStarring()
+	i = &InstallationPermissions{}
+	i.GetStarring()
+	i = nil
+	i.GetStarring()
+}
+
+func TestInstallationPermissions_GetStatuses(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Statuses: &zeroValue}
+	i.GetStatuses()
+	i = &InstallationPermissions{}
+	i.GetStatuses()
+	i = nil
+	i.GetStatuses()
+}
+
+func TestInstallationPermissions_GetTeamDiscussions(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{TeamDiscussions: &zeroValue}
+	i.GetTeamDiscussions()
+	i = &InstallationPermissions{}
+	i.GetTeamDiscussions()
+	i = nil
+	i.GetTeamDiscussions()
+}
+
+func TestInstallationPermissions_GetVulnerabilityAlerts(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{VulnerabilityAlerts: &zeroValue}
+	i.GetVulnerabilityAlerts()
+	i = &InstallationPermissions{}
+	i.GetVulnerabilityAlerts()
+	i = nil
+	i.GetVulnerabilityAlerts()
+}
+
+func TestInstallationPermissions_GetWorkflows(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Workflows: &zeroValue}
+	i.GetWorkflows()
+	i = &InstallationPermissions{}
+	i.GetWorkflows()
+	i = nil
+	i.GetWorkflows()
+}
+
+func TestInstallationRepositoriesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationRepositoriesEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &InstallationRepositoriesEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
+}
+
+func TestInstallationRepositoriesEvent_GetInstallation(tt *testing.T) {
+	i := &InstallationRepositoriesEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
+}
+
+func TestInstallationRepositoriesEvent_GetRepositorySelection(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationRepositoriesEvent{RepositorySelection: &zeroValue}
+	i.GetRepositorySelection()
+	i = &InstallationRepositoriesEvent{}
+	i.GetRepositorySelection()
+	i = nil
+	i.GetRepositorySelection()
+}
+
+func TestInstallationRepositoriesEvent_GetSender(tt *testing.T) {
+	i := &InstallationRepositoriesEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
+func TestInstallationRequest_GetAccount(tt *testing.T) {
+	i := &InstallationRequest{}
+	i.GetAccount()
+	i = nil
+	i.GetAccount()
+}
+
+func TestInstallationRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &InstallationRequest{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &InstallationRequest{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestInstallationRequest_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &InstallationRequest{ID: &zeroValue}
+	i.GetID()
+	i = &InstallationRequest{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestInstallationRequest_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationRequest{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &InstallationRequest{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
+}
+
+func TestInstallationRequest_GetRequester(tt *testing.T) {
+	i := &InstallationRequest{}
+	i.GetRequester()
+	i = nil
+	i.GetRequester()
+}
+
+func TestInstallationToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &InstallationToken{ExpiresAt: &zeroValue}
+	i.GetExpiresAt()
+	i = &InstallationToken{}
+	i.GetExpiresAt()
+	i = nil
+	i.GetExpiresAt()
+}
+
+func TestInstallationToken_GetPermissions(tt *testing.T) {
+	i := &InstallationToken{}
+	i.GetPermissions()
+	i = nil
+	i.GetPermissions()
+}
+
+func TestInstallationToken_GetToken(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationToken{Token: &zeroValue}
+	i.GetToken()
+	i = &InstallationToken{}
+	i.GetToken()
+	i = nil
+	i.GetToken()
+}
+
+func TestInstallationTokenOptions_GetPermissions(tt *testing.T) {
+	i := &InstallationTokenOptions{}
+	i.GetPermissions()
+	i = nil
+	i.GetPermissions()
+}
+
+func TestInteractionRestriction_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &InteractionRestriction{ExpiresAt: &zeroValue}
+	i.GetExpiresAt()
+	i = &InteractionRestriction{}
+	i.GetExpiresAt()
+	i = nil
+	i.GetExpiresAt()
+}
+
+func TestInteractionRestriction_GetExpiry(tt *testing.T) {
+	var zeroValue string
+	i := &InteractionRestriction{Expiry: &zeroValue}
+	i.GetExpiry()
+	i = &InteractionRestriction{}
+	i.GetExpiry()
+	i = nil
+	i.GetExpiry()
+}
+
+func TestInteractionRestriction_GetLimit(tt *testing.T) {
+	var zeroValue string
+	i := &InteractionRestriction{Limit: &zeroValue}
+	i.GetLimit()
+	i = &InteractionRestriction{}
+	i.GetLimit()
+	i = nil
+	i.GetLimit()
+}
+
+func TestInteractionRestriction_GetOrigin(tt *testing.T) {
+	var zeroValue string
+	i := &InteractionRestriction{Origin: &zeroValue}
+	i.GetOrigin()
+	i = &InteractionRestriction{}
+	i.GetOrigin()
+	i = nil
+	i.GetOrigin()
+}
+
+func TestInTotoSubject_GetDigest(tt *testing.T) {
+	zeroValue := map[string]string{}
+	i := &InTotoSubject{Digest: zeroValue}
+	i.GetDigest()
+	i = &InTotoSubject{}
+	i.GetDigest()
+	i = nil
+	i.GetDigest()
+}
+
+func TestInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Invitation{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Invitation{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestInvitation_GetEmail(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{Email: &zeroValue}
+	i.GetEmail()
+	i = &Invitation{}
+	i.GetEmail()
+	i = nil
+	i.GetEmail()
+}
+
+func TestInvitation_GetFailedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Invitation{FailedAt: &zeroValue}
+	i.GetFailedAt()
+	i = &Invitation{}
+	i.GetFailedAt()
+	i = nil
+	i.GetFailedAt()
+}
+
+func TestInvitation_GetFailedReason(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{FailedReason: &zeroValue}
+	i.GetFailedReason()
+	i = &Invitation{}
+	i.GetFailedReason()
+	i = nil
+	i.GetFailedReason()
+}
+
+func TestInvitation_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Invitation{ID: &zeroValue}
+	i.GetID()
+	i = &Invitation{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestInvitation_GetInvitationTeamURL(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{InvitationTeamURL: &zeroValue}
+	i.GetInvitationTeamURL()
+	i = &Invitation{}
+	i.GetInvitationTeamURL()
+	i = nil
+	i.GetInvitationTeamURL()
+}
+
+func TestInvitation_GetInviter(tt *testing.T) {
+	i := &Invitation{}
+	i.GetInviter()
+	i = nil
+	i.GetInviter()
+}
+
+func TestInvitation_GetLogin(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{Login: &zeroValue}
+	i.GetLogin()
+	i = &Invitation{}
+	i.GetLogin()
+	i = nil
+	i.GetLogin()
+}
+
+func TestInvitation_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Invitation{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
+}
+
+func TestInvitation_GetRole(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{Role: &zeroValue}
+	i.GetRole()
+	i = &Invitation{}
+	i.GetRole()
+	i = nil
+	i.GetRole()
+}
+
+func TestInvitation_GetTeamCount(tt *testing.T) {
+	var zeroValue int
+	i := &Invitation{TeamCount: &zeroValue}
+	i.GetTeamCount()
+	i = &Invitation{}
+	i.GetTeamCount()
+	i = nil
+	i.GetTeamCount()
+}
+
+func TestIssue_GetActiveLockReason(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{ActiveLockReason: &zeroValue}
+	i.GetActiveLockReason()
+	i = &Issue{}
+	i.GetActiveLockReason()
+	i = nil
+	i.GetActiveLockReason()
+}
+
+func TestIssue_GetAssignee(tt *testing.T) {
+	i := &Issue{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
+}
+
+func TestIssue_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{AuthorAssociation: &zeroValue}
+	i.GetAuthorAssociation()
+	i = &Issue{}
+	i.GetAuthorAssociation()
+	i = nil
+	i.GetAuthorAssociation()
+}
+
+func TestIssue_GetBody(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{Body: &zeroValue}
+	i.GetBody()
+	i = &Issue{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
+}
+
+func TestIssue_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Issue{ClosedAt: &zeroValue}
+	i.GetClosedAt()
+	i = &Issue{}
+	i.GetClosedAt()
+	i = nil
+	i.GetClosedAt()
+}
+
+func TestIssue_GetClosedBy(tt *testing.T) {
+	i := &Issue{}
+	i.GetClosedBy()
+	i = nil
+	i.GetClosedBy()
+}
+
+func TestIssue_GetComments(tt *testing.T) {
+	var zeroValue int
+	i := &Issue{Comments: &zeroValue}
+	i.GetComments()
+	i = &Issue{}
+	i.GetComments()
+	i = nil
+	i.GetComments()
+}
+
+func TestIssue_GetCommentsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{CommentsURL: &zeroValue}
+	i.GetCommentsURL()
+	i = &Issue{}
+	i.GetCommentsURL()
+	i = nil
+	i.GetCommentsURL()
+}
+
+func TestIssue_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Issue{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Issue{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestIssue_GetEventsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{EventsURL: &zeroValue}
+	i.GetEventsURL()
+	i = &Issue{}
+	i.GetEventsURL()
+	i = nil
+	i.GetEventsURL()
+}
+
+func TestIssue_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Issue{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
+}
+
+func TestIssue_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Issue{ID: &zeroValue}
+	i.GetID()
+	i = &Issue{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestIssue_GetIssueDependenciesSummary(tt *testing.T) {
+	i := &Issue{}
+	i.GetIssueDependenciesSummary()
+	i = nil
+	i.GetIssueDependenciesSummary()
+}
+
+func TestIssue_GetLabelsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{LabelsURL: &zeroValue}
+	i.GetLabelsURL()
+	i = &Issue{}
+	i.GetLabelsURL()
+	i = nil
+	i.GetLabelsURL()
+}
+
+func TestIssue_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	i := &Issue{Locked: &zeroValue}
+	i.GetLocked()
+	i = &Issue{}
+	i.GetLocked()
+	i = nil
+	i.GetLocked()
+}
+
+func TestIssue_GetMilestone(tt *testing.T) {
+	i := &Issue{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
+}
+
+func TestIssue_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Issue{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
+}
+
+func TestIssue_GetNumber(tt *testing.T) {
+	var zeroValue int
+	i := &Issue{Number: &zeroValue}
+	i.GetNumber()
+	i = &Issue{}
+	i.GetNumber()
+	i = nil
+	i.GetNumber()
+}
+
+func TestIssue_GetPinned(tt *testing.T) {
+	var zeroValue bool
+	i := &Issue{Pinned: &zeroValue}
+	i.GetPinned()
+	i = &Issue{}
+	i.GetPinned()
+	i = nil
+	i.GetPinned()
+}
+
+func TestIssue_GetPullRequestLinks(tt *testing.T) {
+	i := &Issue{}
+	i.GetPullRequestLinks()
+	i = nil
+	i.GetPullRequestLinks()
+}
+
+func TestIssue_GetReactions(tt *testing.T) {
+	i := &Issue{}
+	i.GetReactions()
+	i = nil
+	i.GetReactions()
+}
+
+func TestIssue_GetRepository(tt *testing.T) {
+	i := &Issue{}
+	i.GetRepository()
+	i = nil
+	i.GetRepository()
+}
+
+func TestIssue_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &Issue{}
+	i.GetRepositoryURL()
+	i = nil
+	i.GetRepositoryURL()
+}
+
+func TestIssue_GetState(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{State: &zeroValue}
+	i.GetState()
+	i = &Issue{}
+	i.GetState()
+	i = nil
+	i.GetState()
+}
+
+func TestIssue_GetStateReason(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{StateReason: &zeroValue}
+	i.GetStateReason()
+	i = &Issue{}
+	i.GetStateReason()
+	i = nil
+	i.GetStateReason()
+}
+
+func TestIssue_GetSubIssuesSummary(tt *testing.T) {
+	i := &Issue{}
+	i.GetSubIssuesSummary()
+	i = nil
+	i.GetSubIssuesSummary()
+}
+
+func TestIssue_GetTitle(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{Title: &zeroValue}
+	i.GetTitle()
+	i = &Issue{}
+	i.GetTitle()
+	i = nil
+	i.GetTitle()
+}
+
+func TestIssue_GetType(tt *testing.T) {
+	i := &Issue{}
+	i.GetType()
+	i = nil
+	i.GetType()
+}
+
+func TestIssue_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Issue{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &Issue{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
+}
+
+func TestIssue_GetURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{URL: &zeroValue}
+	i.GetURL()
+	i = &Issue{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
+}
+
+func TestIssue_GetUser(tt *testing.T) {
+	i := &Issue{}
+	i.GetUser()
+	i = nil
+	i.GetUser()
+}
+
+func TestIssueComment_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{AuthorAssociation: &zeroValue}
+	i.GetAuthorAssociation()
+	i = &IssueComment{}
+	i.GetAuthorAssociation()
+	i = nil
+	i.GetAuthorAssociation()
+}
+
+func TestIssueComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{Body: &zeroValue}
+	i.GetBody()
+	i = &IssueComment{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
+}
+
+func TestIssueComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &IssueComment{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueComment{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestIssueComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &IssueComment{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
+}
+
+func TestIssueComment_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &IssueComment{ID: &zeroValue}
+	i.GetID()
+	i = &IssueComment{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestIssueComment_GetIssueURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{IssueURL: &zeroValue}
+	i.GetIssueURL()
+	i = &IssueComment{}
+	i.GetIssueURL()
+	i = nil
+	i.GetIssueURL()
+}
+
+func TestIssueComment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &IssueComment{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
+}
+
+func TestIssueComment_GetReactions(tt *testing.T) {
+	i := &IssueComment{}
+	i.GetReactions()
+	i = nil
+	i.GetReactions()
+}
+
+func TestIssueComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &IssueComment{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueComment{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
+}
+
+func TestIssueComment_GetURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueComment{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
+}
+
+func TestIssueComment_GetUser(tt *testing.T) {
+	i := &IssueComment{}
+	i.GetUser()
+	i = nil
+	i.GetUser()
+}
+
+func TestIssueCommentEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &IssueCommentEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &IssueCommentEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
+}
+
+func TestIssueCommentEvent_GetChanges(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetChanges()
+	i = nil
+	i.GetChanges()
+}
+
+func TestIssueCommentEvent_GetComment(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetComment()
+	i = nil
+	i.GetComment()
+}
+
+func TestIssueCommentEvent_GetInstallation(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
+}
+
+func TestIssueCommentEvent_GetIssue(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
+}
+
+func TestIssueCommentEvent_GetOrganization(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetOrganization()
+	i = nil
+	i.GetOrganization()
+}
+
+func TestIssueCommentEvent_GetRepo(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetRepo()
+	i = nil
+	i.GetRepo()
+}
+
+func TestIssueCommentEvent_GetSender(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
+func TestIssueDependenciesSummary_GetBlockedBy(tt *testing.T) {
+	var zeroValue int
+	i := &IssueDependenciesSummary{BlockedBy: &zeroValue}
+	i.GetBlockedBy()
+	i = &IssueDependenciesSummary{}
+	i.GetBlockedBy()
+	i = nil
+	i.GetBlockedBy()
+}
+
+func TestIssueDependenciesSummary_GetBlocking(tt *testing.T) {
+	var zeroValue int
+	i := &IssueDependenciesSummary{Blocking: &zeroValue}
+	i.GetBlocking()
+	i = &IssueDependenciesSummary{}
+	i.GetBlocking()
+	i = nil
+	i.GetBlocking()
+}
+
+func TestIssueDependenciesSummary_GetTotalBlockedBy(tt *testing.T) {
+	var zeroValue int
+	i := &IssueDependenciesSummary{TotalBlockedBy: &zeroValue}
+	i.GetTotalBlockedBy()
+	i = &IssueDependenciesSummary{}
+	i.GetTotalBlockedBy()
+	i = nil
+	i.GetTotalBlockedBy()
+}
+
+func TestIssueDependenciesSummary_GetTotalBlocking(tt *testing.T) {
+	var zeroValue int
+	i := &IssueDependenciesSummary{TotalBlocking: &zeroValue}
+	i.GetTotalBlocking()
+	i = &IssueDependenciesSummary{}
+	i.GetTotalBlocking()
+	i = nil
+	i.GetTotalBlocking()
+}
+
+func TestIssueEvent_GetActor(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetActor()
+	i = nil
+	i.GetActor()
+}
+
+func TestIssueEvent_GetAssignee(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
+}
+
+func TestIssueEvent_GetAssigner(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetAssigner()
+	i = nil
+	i.GetAssigner()
+}
+
+func TestIssueEvent_GetCommitID(tt *testing.T) {
+	var zeroValue string
+	i := &IssueEvent{CommitID: &zeroValue}
+	i.GetCommitID()
+	i = &IssueEvent{}
+	i.GetCommitID()
+	i = nil
+	i.GetCommitID()
+}
+
+func TestIssueEvent_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &IssueEvent{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueEvent{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestIssueEvent_GetDismissedReview(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetDismissedReview()
+	i = nil
+	i.GetDismissedReview()
+}
+
+func TestIssueEvent_GetEvent(tt *testing.T) {
+	var zeroValue string
+	i := &IssueEvent{Event: &zeroValue}
+	i.GetEvent()
+	i = &IssueEvent{}
+	i.GetEvent()
+	i = nil
+	i.GetEvent()
+}
+
+func TestIssueEvent_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &IssueEvent{ID: &zeroValue}
+	i.GetID()
+	i = &IssueEvent{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestIssueEvent_GetIssue(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
+}
+
+func TestIssueEvent_GetLabel(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetLabel()
+	i = nil
+	i.GetLabel()
+}
+
+func TestIssueEvent_GetLockReason(tt *testing.T) {
+	var zeroValue string
+	i := &IssueEvent{LockReason: &zeroValue}
+	i.GetLockReason()
+	i = &IssueEvent{}
+	i.GetLockReason()
+	i = nil
+	i.GetLockReason()
+}
+
+func TestIssueEvent_GetMilestone(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
+}
+
+func TestIssueEvent_GetProjectCard(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetProjectCard()
+	i = nil
+	i.GetProjectCard()
+}
+
+func TestIssueEvent_GetRename(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetRename()
+	i = nil
+	i.GetRename()
+}
+
+func TestIssueEvent_GetRequestedReviewer(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetRequestedReviewer()
+	i = nil
+	i.GetRequestedReviewer()
+}
+
+func TestIssueEvent_GetReviewRequester(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetReviewRequester()
+	i = nil
+	i.GetReviewRequester()
+}
+
+func TestIssueEvent_GetURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueEvent{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueEvent{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
+}
+
+func TestIssueFormField_GetAttributes(tt *testing.T) {
+	i := &IssueFormField{}
+	i.GetAttributes()
+	i = nil
+	i.GetAttributes()
+}
+
+func TestIssueFormField_GetID(tt *testing.T) {
+	var zeroValue string
+	i := &IssueFormField{ID: &zeroValue}
+	i.GetID()
+	i = &IssueFormField{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestIssueFormField_GetType(tt *testing.T) {
+	var zeroValue string
+	i := &IssueFormField{Type: &zeroValue}
+	i.GetType()
+	i = &IssueFormField{}
+	i.GetType()
+	i = nil
+	i.GetType()
+}
+
+func TestIssueFormField_GetValidations(tt *testing.T) {
+	i := &IssueFormField{}
+	i.GetValidations()
+	i = nil
+	i.GetValidations()
+}
+
+func TestIssueFormFieldAttributes_GetDescription(tt *testing.T) {
+	var zeroValue string
+	i := &IssueFormFieldAttributes{Description: &zeroValue}
+	i.GetDescription()
+	i = &IssueFormFieldAttributes{}
+	i.GetDescription()
+	i = nil
+	i.GetDescription()
+}
+
+func TestIssueFormFieldAttributes_GetLabel(tt *testing.T) {
+	var zeroValue string
+	i := &IssueFormFieldAttributes{Label: &zeroValue}
+	i.GetLabel()
+	i = &IssueFormFieldAttributes{}
+	i.GetLabel()
+	i = nil
+	i.GetLabel()
+}
+
+func TestIssueFormFieldAttributes_GetMultiple(tt *testing.T) {
+	var zeroValue bool
+	i := &IssueFormFieldAttributes{Multiple: &zeroValue}
+	i.GetMultiple()
+	i = &IssueFormFieldAttributes{}
+	i.GetMultiple()
+	i = nil
+	i.GetMultiple()
+}
+
+func TestIssueFormFieldAttributes_GetPlaceholder(tt *testing.T) {
+	var zeroValue string
+	i := &IssueFormFieldAttributes{Placeholder: &zeroValue}
+	i.GetPlaceholder()
+	i = &IssueFormFieldAttributes{}
+	i.GetPlaceholder()
+	i = nil
+	i.GetPlaceholder()
+}
+
+func TestIssueFormFieldAttributes_GetRender(tt *testing.T) {
+	var zeroValue string
+	i := &IssueFormFieldAttributes{Render: &zeroValue}
+	i.GetRender()
+	i = &IssueFormFieldAttributes{}
+	i.GetRender()
+	i = nil
+	i.GetRender()
+}
+
+func TestIssueFormFieldAttributes_GetValue(tt *testing.T) {
+	var zeroValue string
+	i := &IssueFormFieldAttributes{Value: &zeroValue}
+	i.GetValue()
+	i = &IssueFormFieldAttributes{}
+	i.GetValue()
+	i = nil
+	i.GetValue()
+}
+
+func TestIssueFormFieldOption_GetLabel(tt *testing.T) {
+	var zeroValue string
+	i := &IssueFormFieldOption{Label: &zeroValue}
+	i.GetLabel()
+	i = &IssueFormFieldOption{}
+	i.GetLabel()
+	i = nil
+	i.GetLabel()
+}
+
+func TestIssueFormFieldOption_GetRequired(tt *testing.T) {
+	var zeroValue bool
+	i := &IssueFormFieldOption{Required: &zeroValue}
+	i.GetRequired()
+	i = &IssueFormFieldOption{}
+	i.GetRequired()
+	i = nil
+	i.GetRequired()
+}
+
+func TestIssueFormFieldValidations_GetRequired(tt *testing.T) {
+	var zeroValue bool
+	i := &IssueFormFieldValidations{Required: &zeroValue}
+	i.GetRequired()
+	i = &IssueFormFieldValidations{}
+	i.GetRequired()
+	i = nil
+	i.GetRequired()
+}
+
+func TestIssueImport_GetAssignee(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImport{Assignee: &zeroValue}
+	i.GetAssignee()
+	i = &IssueImport{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
+}
+
+func TestIssueImport_GetClosed(tt *testing.T) {
+	var zeroValue bool
+	i := &IssueImport{Closed: &zeroValue}
+	i.GetClosed()
+	i = &IssueImport{}
+	i.GetClosed()
+	i = nil
+	i.GetClosed()
+}
+
+func TestIssueImport_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &IssueImport{ClosedAt: &zeroValue}
+	i.GetClosedAt()
+	i = &IssueImport{}
+	i.GetClosedAt()
+	i = nil
+	i.GetClosedAt()
+}
+
+func TestIssueImport_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &IssueImport{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueImport{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestIssueImport_GetMilestone(tt *testing.T) {
+	var zeroValue int
+	i := &IssueImport{Milestone: &zeroValue}
+	i.GetMilestone()
+	i = &IssueImport{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
+}
+
+func TestIssueImport_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &IssueImport{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueImport{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
+}
+
+func TestIssueImportError_GetCode(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportError{Code: &zeroValue}
+	i.GetCode()
+	i = &IssueImportError{}
+	i.GetCode()
+	i = nil
+	i.GetCode()
+}
+
+func TestIssueImportError_GetField(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportError{Field: &zeroValue}
+	i.GetField()
+	i = &IssueImportError{}
+	i.GetField()
+	i = nil
+	i.GetField()
+}
+
+func TestIssueImportError_GetLocation(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportError{Location: &zeroValue}
+	i.GetLocation()
+	i = &IssueImportError{}
+	i.GetLocation()
+	i = nil
+	i.GetLocation()
+}
+
+func TestIssueImportError_GetResource(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportError{Resource: &zeroValue}
+	i.GetResource()
+	i = &IssueImportError{}
+	i.GetResource()
+	i = nil
+	i.GetResource()
+}
+
+func TestIssueImportError_GetValue(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportError{Value: &zeroValue}
+	i.GetValue()
+	i = &IssueImportError{}
+	i.GetValue()
+	i = nil
+	i.GetValue()
+}
+
+func TestIssueImportResponse_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &IssueImportResponse{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueImportResponse{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestIssueImportResponse_GetDocumentationURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{DocumentationURL: &zeroValue}
+	i.GetDocumentationURL()
+	i = &IssueImportResponse{}
+	i.GetDocumentationURL()
+	i = nil
+	i.GetDocumentationURL()
+}
+
+func TestIssueImportResponse_GetID(tt *testing.T) {
+	var zeroValue int
+	i := &IssueImportResponse{ID: &zeroValue}
+	i.GetID()
+	i = &IssueImportResponse{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestIssueImportResponse_GetImportIssuesURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{ImportIssuesURL: &zeroValue}
+	i.GetImportIssuesURL()
+	i = &IssueImportResponse{}
+	i.GetImportIssuesURL()
+	i = nil
+	i.GetImportIssuesURL()
+}
+
+func TestIssueImportResponse_GetMessage(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{Message: &zeroValue}
+	i.GetMessage()
+	i = &IssueImportResponse{}
+	i.GetMessage()
+	i = nil
+	i.GetMessage()
+}
+
+func TestIssueImportResponse_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &IssueImportResponse{}
+	i.GetRepositoryURL()
+	i = nil
+	i.GetRepositoryURL()
+}
+
+func TestIssueImportResponse_GetStatus(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{Status: &zeroValue}
+	i.GetStatus()
+	i = &IssueImportResponse{}
+	i.GetStatus()
+	i = nil
+	i.GetStatus()
+}
+
+func TestIssueImportResponse_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &IssueImportResponse{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueImportResponse{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
+}
+
+func TestIssueImportResponse_GetURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueImportResponse{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
+}
+
+func TestIssueListCommentsOptions_GetDirection(tt *testing.T) {
+	var zeroValue string
+	i := &IssueListCommentsOptions{Direction: &zeroValue}
+	i.GetDirection()
+	i = &IssueListCommentsOptions{}
+	i.GetDirection()
+	i = nil
+	i.GetDirection()
+}
+
+func TestIssueListCommentsOptions_GetSince(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueListCommentsOptions{Since: &zeroValue}
+	i.GetSince()
+	i = &IssueListCommentsOptions{}
+	i.GetSince()
+	i = nil
+	i.GetSince()
+}
+
+func TestIssueListCommentsOptions_GetSort(tt *testing.T) {
+	var zeroValue string
+	i := &IssueListCommentsOptions{Sort: &zeroValue}
+	i.GetSort()
+	i = &IssueListCommentsOptions{}
+	i.GetSort()
+	i = nil
+	i.GetSort()
+}
+
+func TestIssueRequest_GetAssignee(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{Assignee: &zeroValue}
+	i.GetAssignee()
+	i = &IssueRequest{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
+}
+
+func TestIssueRequest_GetAssignees(tt *testing.T) {
+	var zeroValue []string
+	i := &IssueRequest{Assignees: &zeroValue}
+	i.GetAssignees()
+	i = &IssueRequest{}
+	i.GetAssignees()
+	i = nil
+	i.GetAssignees()
+}
+
+func TestIssueRequest_GetBody(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{Body: &zeroValue}
+	i.GetBody()
+	i = &IssueRequest{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
+}
+
+func TestIssueRequest_GetLabels(tt *testing.T) {
+	var zeroValue []string
+	i := &IssueRequest{Labels: &zeroValue}
+	i.GetLabels()
+	i = &IssueRequest{}
+	i.GetLabels()
+	i = nil
+	i.GetLabels()
+}
+
+func TestIssueRequest_GetMilestone(tt *testing.T) {
+	var zeroValue int
+	i := &IssueRequest{Milestone: &zeroValue}
+	i.GetMilestone()
+	i = &IssueRequest{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
+}
+
+func TestIssueRequest_GetState(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{State: &zeroValue}
+	i.GetState()
+	i = &IssueRequest{}
+	i.GetState()
+	i = nil
+	i.GetState()
+}
+
+func TestIssueRequest_GetStateReason(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{StateReason: &zeroValue}
+	i.GetStateReason()
+	i = &IssueRequest{}
+	i.GetStateReason()
+	i = nil
+	i.GetStateReason()
+}
+
+func TestIssueRequest_GetTitle(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{Title: &zeroValue}
+	i.GetTitle()
+	i = &IssueRequest{}
+	i.GetTitle()
+	i = nil
+	i.GetTitle()
+}
+
+func TestIssueRequest_GetType(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{Type: &zeroValue}
+	i.GetType()
+	i = &IssueRequest{}
+	i.GetType()
+	i = nil
+	i.GetType()
+}
+
+func TestIssuesBatch_GetMilestone(tt *testing.T) {
+	var zeroValue int
+	i := &IssuesBatch{Milestone: &zeroValue}
+	i.GetMilestone()
+	i = &IssuesBatch{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
+}
+
+func TestIssuesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &IssuesEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &IssuesEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
 }
 
-func TestGollumEvent_GetSender(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetSender()
-	g = nil
-	g.GetSender()
+func TestIssuesEvent_GetAssignee(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
 }
 
-func TestGPGEmail_GetEmail(tt *testing.T) {
-	var zeroValue string
-	g := &GPGEmail{Email: &zeroValue}
-	g.GetEmail()
-	g = &GPGEmail{}
-	g.GetEmail()
-	g = nil
-	g.GetEmail()
+func TestIssuesEvent_GetChanges(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetChanges()
+	i = nil
+	i.GetChanges()
 }
 
-func TestGPGEmail_GetVerified(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGEmail{Verified: &zeroValue}
-	g.GetVerified()
-	g = &GPGEmail{}
-	g.GetVerified()
-	g = nil
-	g.GetVerified()
+func TestIssuesEvent_GetInstallation(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
 }
 
-func TestGPGKey_GetCanCertify(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanCertify: &zeroValue}
-	g.GetCanCertify()
-	g = &GPGKey{}
-	g.GetCanCertify()
-	g = nil
-	g.GetCanCertify()
+func TestIssuesEvent_GetIssue(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
 }
 
-func TestGPGKey_GetCanEncryptComms(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanEncryptComms: &zeroValue}
-	g.GetCanEncryptComms()
-	g = &GPGKey{}
-	g.GetCanEncryptComms()
-	g = nil
-	g.GetCanEncryptComms()
+func TestIssuesEvent_GetLabel(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetLabel()
+	i = nil
+	i.GetLabel()
 }
 
-func TestGPGKey_GetCanEncryptStorage(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanEncryptStorage: &zeroValue}
-	g.GetCanEncryptStorage()
-	g = &GPGKey{}
-	g.GetCanEncryptStorage()
-	g = nil
-	g.GetCanEncryptStorage()
+func TestIssuesEvent_GetMilestone(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
 }
 
-func TestGPGKey_GetCanSign(tt *testing.T) {
+func TestIssuesEvent_GetOrg(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetOrg()
+	i = nil
+	i.GetOrg()
+}
+
+func TestIssuesEvent_GetRepo(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetRepo()
+	i = nil
+	i.GetRepo()
+}
+
+func TestIssuesEvent_GetSender(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
+func TestIssuesSearchResult_GetIncompleteResults(tt *testing.T) {
 	var zeroValue bool
-	g := &GPGKey{CanSign: &zeroValue}
-	g.GetCanSign()
-	g = &GPGKey{}
-	g.GetCanSign()
-	g = nil
-	g.GetCanSign()
+	i := &IssuesSearchResult{IncompleteResults: &zeroValue}
+	i.GetIncompleteResults()
+	i = &IssuesSearchResult{}
+	i.GetIncompleteResults()
+	i = nil
+	i.GetIncompleteResults()
 }
 
-func TestGPGKey_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &GPGKey{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GPGKey{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestIssuesSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	i := &IssuesSearchResult{Total: &zeroValue}
+	i.GetTotal()
+	i = &IssuesSearchResult{}
+	i.GetTotal()
+	i = nil
+	i.GetTotal()
 }
 
-func TestGPGKey_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &GPGKey{ExpiresAt: &zeroValue}
-	g.GetExpiresAt()
-	g = &GPGKey{}
-	g.GetExpiresAt()
-	g = nil
-	g.GetExpiresAt()
+func TestIssueStats_GetClosedIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{ClosedIssues: &zeroValue}
+	i.GetClosedIssues()
+	i = &IssueStats{}
+	i.GetClosedIssues()
+	i = nil
+	i.GetClosedIssues()
 }
 
-func TestGPGKey_GetID(tt *testing.T) {
-	var zeroValue int64
-	g := &GPGKey{ID: &zeroValue}
-	g.GetID()
-	g = &GPGKey{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestIssueStats_GetOpenIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{OpenIssues: &zeroValue}
+	i.GetOpenIssues()
+	i = &IssueStats{}
+	i.GetOpenIssues()
+	i = nil
+	i.GetOpenIssues()
 }
 
-func TestGPGKey_GetKeyID(tt *testing.T) {
+func TestIssueStats_GetTotalIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{TotalIssues: &zeroValue}
+	i.GetTotalIssues()
+	i = &IssueStats{}
+	i.GetTotalIssues()
+	i = nil
+	i.GetTotalIssues()
+}
+
+func TestIssueTemplate_GetAbout(tt *testing.T) {
 	var zeroValue string
-	g := &GPGKey{KeyID: &zeroValue}
-	g.GetKeyID()
-	g = &GPGKey{}
-	g.GetKeyID()
-	g = nil
-	g.GetKeyID()
+	i := &IssueTemplate{About: &zeroValue}
+	i.GetAbout()
+	i = &IssueTemplate{}
+	i.GetAbout()
+	i = nil
+	i.GetAbout()
 }
 
-func TestGPGKey_GetPrimaryKeyID(tt *testing.T) {
-	var zeroValue int64
-	g := &GPGKey{PrimaryKeyID: &zeroValue}
-	g.GetPrimaryKeyID()
-	g = &GPGKey{}
-	g.GetPrimaryKeyID()
-	g = nil
-	g.GetPrimaryKeyID()
+func TestIssueTemplate_GetBody(tt *testing.T) {
+	var zeroValue string
+	i := &IssueTemplate{Body: &zeroValue}
+	i.GetBody()
+	i = &IssueTemplate{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
 }
 
-func TestGPGKey_GetPublicKey(tt *testing.T) {
+func TestIssueTemplate_GetDescription(tt *testing.T) {
 	var zeroValue string
-	g := &GPGKey{PublicKey: &zeroValue}
-	g.GetPublicKey()
-	g = &GPGKey{}
-	g.GetPublicKey()
-	g = nil
-	g.GetPublicKey()
+	i := &IssueTemplate{Description: &zeroValue}
+	i.GetDescription()
+	i = &IssueTemplate{}
+	i.GetDescription()
+	i = nil
+	i.GetDescription()
 }
 
-func TestGPGKey_GetRawKey(tt *testing.T) {
+func TestIssueTemplate_GetName(tt *testing.T) {
 	var zeroValue string
-	g := &GPGKey{RawKey: &zeroValue}
-	g.GetRawKey()
-	g = &GPGKey{}
-	g.GetRawKey()
-	g = nil
-	g.GetRawKey()
+	i := &IssueTemplate{Name: &zeroValue}
+	i.GetName()
+	i = &IssueTemplate{}
+	i.GetName()
+	i = nil
+	i.GetName()
 }
 
-func TestGrant_GetApp(tt *testing.T) {
-	g := &Grant{}
-	g.GetApp()
-	g = nil
-	g.GetApp()
+func TestIssueTemplate_GetPath(tt *testing.T) {
+	var zeroValue string
+	i := &IssueTemplate{Path: &zeroValue}
+	i.GetPath()
+	i = &IssueTemplate{}
+	i.GetPath()
+	i = nil
+	i.GetPath()
 }
 
-func TestGrant_GetCreatedAt(tt *testing.T) {
+func TestIssueTemplate_GetTitle(tt *testing.T) {
+	var zeroValue string
+	i := &IssueTemplate{Title: &zeroValue}
+	i.GetTitle()
+	i = &IssueTemplate{}
+	i.GetTitle()
+	i = nil
+	i.GetTitle()
+}
+
+func TestIssueType_GetColor(tt *testing.T) {
+	var zeroValue string
+	i := &IssueType{Color: &zeroValue}
+	i.GetColor()
+	i = &IssueType{}
+	i.GetColor()
+	i = nil
+	i.GetColor()
+}
+
+func TestIssueType_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	g := &Grant{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &Grant{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+	i := &IssueType{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueType{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestIssueType_GetDescription(tt *testing.T) {
+	var zeroValue string
+	i := &IssueType{Description: &zeroValue}
+	i.GetDescription()
+	i = &IssueType{}
+	i.GetDescription()
+	i = nil
+	i.GetDescription()
+}
+
+func TestIssueType_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &IssueType{ID: &zeroValue}
+	i.GetID()
+	i = &IssueType{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestIssueType_GetIsEnabled(tt *testing.T) {
+	var zeroValue bool
+	i := &IssueType{IsEnabled: &zeroValue}
+	i.GetIsEnabled()
+	i = &IssueType{}
+	i.GetIsEnabled()
+	i = nil
+	i.GetIsEnabled()
+}
+
+func TestIssueType_GetName(tt *testing.T) {
+	var zeroValue string
+	i := &IssueType{Name: &zeroValue}
+	i.GetName()
+	i = &IssueType{}
+	i.GetName()
+	i = nil
+	i.GetName()
 }
 
-func TestGrant_GetID(tt *testing.T) {
-	var zeroValue int64
-	g := &Grant{ID: &zeroValue}
-	g.GetID()
-	g = &Grant{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestIssueType_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &IssueType{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &IssueType{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
 }
 
-func TestGrant_GetUpdatedAt(tt *testing.T) {
+func TestIssueType_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	g := &Grant{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &Grant{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+	i := &IssueType{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueType{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
 }
 
-func TestGrant_GetURL(tt *testing.T) {
-	var zeroValue string
-	g := &Grant{URL: &zeroValue}
-	g.GetURL()
-	g = &Grant{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+func TestJobs_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	j := &Jobs{TotalCount: &zeroValue}
+	j.GetTotalCount()
+	j = &Jobs{}
+	j.GetTotalCount()
+	j = nil
+	j.GetTotalCount()
 }
 
-func TestHeadCommit_GetAuthor(tt *testing.T) {
-	h := &HeadCommit{}
-	h.GetAuthor()
-	h = nil
-	h.GetAuthor()
+func TestKey_GetAddedBy(tt *testing.T) {
+	var zeroValue string
+	k := &Key{AddedBy: &zeroValue}
+	k.GetAddedBy()
+	k = &Key{}
+	k.GetAddedBy()
+	k = nil
+	k.GetAddedBy()
 }
 
-func TestHeadCommit_GetCommitter(tt *testing.T) {
-	h := &HeadCommit{}
-	h.GetCommitter()
-	h = nil
-	h.GetCommitter()
+func TestKey_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	k := &Key{CreatedAt: &zeroValue}
+	k.GetCreatedAt()
+	k = &Key{}
+	k.GetCreatedAt()
+	k = nil
+	k.GetCreatedAt()
 }
 
-func TestHeadCommit_GetDistinct(tt *testing.T) {
+func TestKey_GetEnabled(tt *testing.T) {
 	var zeroValue bool
-	h := &HeadCommit{Distinct: &zeroValue}
-	h.GetDistinct()
-	h = &HeadCommit{}
-	h.GetDistinct()
-	h = nil
-	h.GetDistinct()
+	k := &Key{Enabled: &zeroValue}
+	k.GetEnabled()
+	k = &Key{}
+	k.GetEnabled()
+	k = nil
+	k.GetEnabled()
 }
 
-func TestHeadCommit_GetID(tt *testing.T) {
-	var zeroValue string
-	h := &HeadCommit{ID: &zeroValue}
-	h.GetID()
-	h = &HeadCommit{}
-	h.GetID()
-	h = nil
-	h.GetID()
+func TestKey_GetID(tt *testing.T) {
+	var zeroValue int64
+	k := &Key{ID: &zeroValue}
+	k.GetID()
+	k = &Key{}
+	k.GetID()
+	k = nil
+	k.GetID()
 }
 
-func TestHeadCommit_GetMessage(tt *testing.T) {
+func TestKey_GetKey(tt *testing.T) {
 	var zeroValue string
-	h := &HeadCommit{Message: &zeroValue}
-	h.GetMessage()
-	h = &HeadCommit{}
-	h.GetMessage()
-	h = nil
-	h.GetMessage()
+	k := &Key{Key: &zeroValue}
+	k.GetKey()
+	k = &Key{}
+	k.GetKey()
+	k = nil
+	k.GetKey()
 }
 
-func TestHeadCommit_GetSHA(tt *testing.T) {
-	var zeroValue string
-	h := &HeadCommit{SHA: &zeroValue}
-	h.GetSHA()
-	h = &HeadCommit{}
-	h.GetSHA()
-	h = nil
-	h.GetSHA()
+func TestKey_GetLastUsed(tt *testing.T) {
+	var zeroValue Timestamp
+	k := &Key{LastUsed: &zeroValue}
+	k.GetLastUsed()
+	k = &Key{}
+	k.GetLastUsed()
+	k = nil
+	k.GetLastUsed()
 }
 
-func TestHeadCommit_GetTimestamp(tt *testing.T) {
-	var zeroValue Timestamp
-	h := &HeadCommit{Timestamp: &zeroValue}
-	h.GetTimestamp()
-	h = &HeadCommit{}
-	h.GetTimestamp()
-	h = nil
-	h.GetTimestamp()
+func TestKey_GetReadOnly(tt *testing.T) {
+	var zeroValue bool
+	k := &Key{ReadOnly: &zeroValue}
+	k.GetReadOnly()
+	k = &Key{}
+	k.GetReadOnly()
+	k = nil
+	k.GetReadOnly()
 }
 
-func TestHeadCommit_GetTreeID(tt *testing.T) {
+func TestKey_GetTitle(tt *testing.T) {
 	var zeroValue string
-	h := &HeadCommit{TreeID: &zeroValue}
-	h.GetTreeID()
-	h = &HeadCommit{}
-	h.GetTreeID()
-	h = nil
-	h.GetTreeID()
+	k := &Key{Title: &zeroValue}
+	k.GetTitle()
+	k = &Key{}
+	k.GetTitle()
+	k = nil
+	k.GetTitle()
 }
 
-func TestHeadCommit_GetURL(tt *testing.T) {
+func TestKey_GetURL(tt *testing.T) {
 	var zeroValue string
-	h := &HeadCommit{URL: &zeroValue}
-	h.GetURL()
-	h = &HeadCommit{}
-	h.GetURL()
-	h = nil
-	h.GetURL()
+	k := &Key{URL: &zeroValue}
+	k.GetURL()
+	k = &Key{}
+	k.GetURL()
+	k = nil
+	k.GetURL()
 }
 
-func TestHook_GetActive(tt *testing.T) {
+func TestKey_GetVerified(tt *testing.T) {
 	var zeroValue bool
-	h := &Hook{Active: &zeroValue}
-	h.GetActive()
-	h = &Hook{}
-	h.GetActive()
-	h = nil
-	h.GetActive()
+	k := &Key{Verified: &zeroValue}
+	k.GetVerified()
+	k = &Key{}
+	k.GetVerified()
+	k = nil
+	k.GetVerified()
 }
 
-func TestHook_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	h := &Hook{CreatedAt: &zeroValue}
-	h.GetCreatedAt()
-	h = &Hook{}
-	h.GetCreatedAt()
-	h = nil
-	h.GetCreatedAt()
+func TestLabel_GetColor(tt *testing.T) {
+	var zeroValue string
+	l := &Label{Color: &zeroValue}
+	l.GetColor()
+	l = &Label{}
+	l.GetColor()
+	l = nil
+	l.GetColor()
 }
 
-func TestHook_GetID(tt *testing.T) {
-	var zeroValue int64
-	h := &Hook{ID: &zeroValue}
-	h.GetID()
-	h = &Hook{}
-	h.GetID()
-	h = nil
-	h.GetID()
+func TestLabel_GetDefault(tt *testing.T) {
+	var zeroValue bool
+	l := &Label{Default: &zeroValue}
+	l.GetDefault()
+	l = &Label{}
+	l.GetDefault()
+	l = nil
+	l.GetDefault()
 }
 
-func TestHook_GetName(tt *testing.T) {
+func TestLabel_GetDescription(tt *testing.T) {
 	var zeroValue string
-	h := &Hook{Name: &zeroValue}
-	h.GetName()
-	h = &Hook{}
-	h.GetName()
-	h = nil
-	h.GetName()
+	l := &Label{Description: &zeroValue}
+	l.GetDescription()
+	l = &Label{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
 }
 
-func TestHook_GetPingURL(tt *testing.T) {
-	var zeroValue string
-	h := &Hook{PingURL: &zeroValue}
-	h.GetPingURL()
-	h = &Hook{}
-	h.GetPingURL()
-	h = nil
-	h.GetPingURL()
+func TestLabel_GetID(tt *testing.T) {
+	var zeroValue int64
+	l := &Label{ID: &zeroValue}
+	l.GetID()
+	l = &Label{}
+	l.GetID()
+	l = nil
+	l.GetID()
 }
 
-func TestHook_GetTestURL(tt *testing.T) {
+func TestLabel_GetName(tt *testing.T) {
 	var zeroValue string
-	h := &Hook{TestURL: &zeroValue}
-	h.GetTestURL()
-	h = &Hook{}
-	h.GetTestURL()
-	h = nil
-	h.GetTestURL()
+	l := &Label{Name: &zeroValue}
+	l.GetName()
+	l = &Label{}
+	l.GetName()
+	l = nil
+	l.GetName()
 }
 
-func TestHook_GetType(tt *testing.T) {
+func TestLabel_GetNewName(tt *testing.T) {
 	var zeroValue string
-	h := &Hook{Type: &zeroValue}
-	h.GetType()
-	h = &Hook{}
-	h.GetType()
-	h = nil
-	h.GetType()
+	l := &Label{NewName: &zeroValue}
+	l.GetNewName()
+	l = &Label{}
+	l.GetNewName()
+	l = nil
+	l.GetNewName()
 }
 
-func TestHook_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	h := &Hook{UpdatedAt: &zeroValue}
-	h.GetUpdatedAt()
-	h = &Hook{}
-	h.GetUpdatedAt()
-	h = nil
-	h.GetUpdatedAt()
+func TestLabel_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	l := &Label{NodeID: &zeroValue}
+	l.GetNodeID()
+	l = &Label{}
+	l.GetNodeID()
+	l = nil
+	l.GetNodeID()
 }
 
-func TestHook_GetURL(tt *testing.T) {
+func TestLabel_GetURL(tt *testing.T) {
 	var zeroValue string
-	h := &Hook{URL: &zeroValue}
-	h.GetURL()
-	h = &Hook{}
-	h.GetURL()
-	h = nil
-	h.GetURL()
+	l := &Label{URL: &zeroValue}
+	l.GetURL()
+	l = &Label{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
 }
 
-func TestHookConfig_GetContentType(tt *testing.T) {
-	var zeroValue string
-	h := &HookConfig{ContentType: &zeroValue}
-	h.GetContentType()
-	h = &HookConfig{}
-	h.GetContentType()
-	h = nil
-	h.GetContentType()
+func TestLabelChange_GetLabel(tt *testing.T) {
+	l := &LabelChange{}
+	l.GetLabel()
+	l = nil
+	l.GetLabel()
 }
 
-func TestHookConfig_GetInsecureSSL(tt *testing.T) {
+func TestLabelEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	h := &HookConfig{InsecureSSL: &zeroValue}
-	h.GetInsecureSSL()
-	h = &HookConfig{}
-	h.GetInsecureSSL()
-	h = nil
-	h.GetInsecureSSL()
+	l := &LabelEvent{Action: &zeroValue}
+	l.GetAction()
+	l = &LabelEvent{}
+	l.GetAction()
+	l = nil
+	l.GetAction()
 }
 
-func TestHookConfig_GetSecret(tt *testing.T) {
-	var zeroValue string
-	h := &HookConfig{Secret: &zeroValue}
-	h.GetSecret()
-	h = &HookConfig{}
-	h.GetSecret()
-	h = nil
-	h.GetSecret()
+func TestLabelEvent_GetChanges(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetChanges()
+	l = nil
+	l.GetChanges()
 }
 
-func TestHookConfig_GetURL(tt *testing.T) {
-	var zeroValue string
-	h := &HookConfig{URL: &zeroValue}
-	h.GetURL()
-	h = &HookConfig{}
-	h.GetURL()
-	h = nil
-	h.GetURL()
+func TestLabelEvent_GetInstallation(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetInstallation()
+	l = nil
+	l.GetInstallation()
 }
 
-func TestHookDelivery_GetAction(tt *testing.T) {
-	var zeroValue string
-	h := &HookDelivery{Action: &zeroValue}
-	h.GetAction()
-	h = &HookDelivery{}
-	h.GetAction()
-	h = nil
-	h.GetAction()
+func TestLabelEvent_GetLabel(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetLabel()
+	l = nil
+	l.GetLabel()
 }
 
-func TestHookDelivery_GetDeliveredAt(tt *testing.T) {
-	var zeroValue Timestamp
-	h := &HookDelivery{DeliveredAt: &zeroValue}
-	h.GetDeliveredAt()
-	h = &HookDelivery{}
-	h.GetDeliveredAt()
-	h = nil
-	h.GetDeliveredAt()
+func TestLabelEvent_GetOrg(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetOrg()
+	l = nil
+	l.GetOrg()
 }
 
-func TestHookDelivery_GetDuration(tt *testing.T) {
-	h := &HookDelivery{}
-	h.GetDuration()
-	h = nil
-	h.GetDuration()
+func TestLabelEvent_GetRepo(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetRepo()
+	l = nil
+	l.GetRepo()
 }
 
-func TestHookDelivery_GetEvent(tt *testing.T) {
+func TestLabelEvent_GetSender(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetSender()
+	l = nil
+	l.GetSender()
+}
+
+func TestLabelResult_GetColor(tt *testing.T) {
 	var zeroValue string
-	h := &HookDelivery{Event: &zeroValue}
-	h.GetEvent()
-	h = &HookDelivery{}
-	h.GetEvent()
-	h = nil
-	h.GetEvent()
+	l := &LabelResult{Color: &zeroValue}
+	l.GetColor()
+	l = &LabelResult{}
+	l.GetColor()
+	l = nil
+	l.GetColor()
 }
 
-func TestHookDelivery_GetGUID(tt *testing.T) {
+func TestLabelResult_GetDefault(tt *testing.T) {
+	var zeroValue bool
+	l := &LabelResult{Default: &zeroValue}
+	l.GetDefault()
+	l = &LabelResult{}
+	l.GetDefault()
+	l = nil
+	l.GetDefault()
+}
+
+func TestLabelResult_GetDescription(tt *testing.T) {
 	var zeroValue string
-	h := &HookDelivery{GUID: &zeroValue}
-	h.GetGUID()
-	h = &HookDelivery{}
-	h.GetGUID()
-	h = nil
-	h.GetGUID()
+	l := &LabelResult{Description: &zeroValue}
+	l.GetDescription()
+	l = &LabelResult{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
 }
 
-func TestHookDelivery_GetID(tt *testing.T) {
+func TestLabelResult_GetID(tt *testing.T) {
 	var zeroValue int64
-	h := &HookDelivery{ID: &zeroValue}
-	h.GetID()
-	h = &HookDelivery{}
-	h.GetID()
-	h = nil
-	h.GetID()
+	l := &LabelResult{ID: &zeroValue}
+	l.GetID()
+	l = &LabelResult{}
+	l.GetID()
+	l = nil
+	l.GetID()
 }
 
-func TestHookDelivery_GetInstallationID(tt *testing.T) {
-	var zeroValue int64
-	h := &HookDelivery{InstallationID: &zeroValue}
-	h.GetInstallationID()
-	h = &HookDelivery{}
-	h.GetInstallationID()
-	h = nil
-	h.GetInstallationID()
+func TestLabelResult_GetName(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{Name: &zeroValue}
+	l.GetName()
+	l = &LabelResult{}
+	l.GetName()
+	l = nil
+	l.GetName()
 }
 
-func TestHookDelivery_GetRedelivery(tt *testing.T) {
+func TestLabelResult_GetScore(tt *testing.T) {
+	l := &LabelResult{}
+	l.GetScore()
+	l = nil
+	l.GetScore()
+}
+
+func TestLabelResult_GetURL(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{URL: &zeroValue}
+	l.GetURL()
+	l = &LabelResult{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
+}
+
+func TestLabelsSearchResult_GetIncompleteResults(tt *testing.T) {
 	var zeroValue bool
-	h := &HookDelivery{Redelivery: &zeroValue}
-	h.GetRedelivery()
-	h = &HookDelivery{}
-	h.GetRedelivery()
-	h = nil
-	h.GetRedelivery()
+	l := &LabelsSearchResult{IncompleteResults: &zeroValue}
+	l.GetIncompleteResults()
+	l = &LabelsSearchResult{}
+	l.GetIncompleteResults()
+	l = nil
+	l.GetIncompleteResults()
 }
 
-func TestHookDelivery_GetRepositoryID(tt *testing.T) {
-	var zeroValue int64
-	h := &HookDelivery{RepositoryID: &zeroValue}
-	h.GetRepositoryID()
-	h = &HookDelivery{}
-	h.GetRepositoryID()
-	h = nil
-	h.GetRepositoryID()
+func TestLabelsSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &LabelsSearchResult{Total: &zeroValue}
+	l.GetTotal()
+	l = &LabelsSearchResult{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
 }
 
-func TestHookDelivery_GetRequest(tt *testing.T) {
-	h := &HookDelivery{}
-	h.GetRequest()
-	h = nil
-	h.GetRequest()
+func TestLargeFile_GetOID(tt *testing.T) {
+	var zeroValue string
+	l := &LargeFile{OID: &zeroValue}
+	l.GetOID()
+	l = &LargeFile{}
+	l.GetOID()
+	l = nil
+	l.GetOID()
 }
 
-func TestHookDelivery_GetResponse(tt *testing.T) {
-	h := &HookDelivery{}
-	h.GetResponse()
-	h = nil
-	h.GetResponse()
+func TestLargeFile_GetPath(tt *testing.T) {
+	var zeroValue string
+	l := &LargeFile{Path: &zeroValue}
+	l.GetPath()
+	l = &LargeFile{}
+	l.GetPath()
+	l = nil
+	l.GetPath()
 }
 
-func TestHookDelivery_GetStatus(tt *testing.T) {
+func TestLargeFile_GetRefName(tt *testing.T) {
 	var zeroValue string
-	h := &HookDelivery{Status: &zeroValue}
-	h.GetStatus()
-	h = &HookDelivery{}
-	h.GetStatus()
-	h = nil
-	h.GetStatus()
+	l := &LargeFile{RefName: &zeroValue}
+	l.GetRefName()
+	l = &LargeFile{}
+	l.GetRefName()
+	l = nil
+	l.GetRefName()
 }
 
-func TestHookDelivery_GetStatusCode(tt *testing.T) {
+func TestLargeFile_GetSize(tt *testing.T) {
 	var zeroValue int
-	h := &HookDelivery{StatusCode: &zeroValue}
-	h.GetStatusCode()
-	h = &HookDelivery{}
-	h.GetStatusCode()
-	h = nil
-	h.GetStatusCode()
+	l := &LargeFile{Size: &zeroValue}
+	l.GetSize()
+	l = &LargeFile{}
+	l.GetSize()
+	l = nil
+	l.GetSize()
 }
 
-func TestHookRequest_GetHeaders(tt *testing.T) {
-	zeroValue := map[string]string{}
-	h := &HookRequest{Headers: zeroValue}
-	h.GetHeaders()
-	h = &HookRequest{}
-	h.GetHeaders()
-	h = nil
-	h.GetHeaders()
+func TestLicense_GetBody(tt *testing.T) {
+	var zeroValue string
+	l := &License{Body: &zeroValue}
+	l.GetBody()
+	l = &License{}
+	l.GetBody()
+	l = nil
+	l.GetBody()
 }
 
-func TestHookRequest_GetRawPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	h := &HookRequest{RawPayload: &zeroValue}
-	h.GetRawPayload()
-	h = &HookRequest{}
-	h.GetRawPayload()
-	h = nil
-	h.GetRawPayload()
+func TestLicense_GetConditions(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Conditions: &zeroValue}
+	l.GetConditions()
+	l = &License{}
+	l.GetConditions()
+	l = nil
+	l.GetConditions()
 }
 
-func TestHookResponse_GetHeaders(tt *testing.T) {
-	zeroValue := map[string]string{}
-	h := &HookResponse{Headers: zeroValue}
-	h.GetHeaders()
-	h = &HookResponse{}
-	h.GetHeaders()
-	h = nil
-	h.GetHeaders()
+func TestLicense_GetDescription(tt *testing.T) {
+	var zeroValue string
+	l := &License{Description: &zeroValue}
+	l.GetDescription()
+	l = &License{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
 }
 
-func TestHookResponse_GetRawPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	h := &HookResponse{RawPayload: &zeroValue}
-	h.GetRawPayload()
-	h = &HookResponse{}
-	h.GetRawPayload()
-	h = nil
-	h.GetRawPayload()
+func TestLicense_GetFeatured(tt *testing.T) {
+	var zeroValue bool
+	l := &License{Featured: &zeroValue}
+	l.GetFeatured()
+	l = &License{}
+	l.GetFeatured()
+	l = nil
+	l.GetFeatured()
 }
 
-func TestHookStats_GetActiveHooks(tt *testing.T) {
-	var zeroValue int
-	h := &HookStats{ActiveHooks: &zeroValue}
-	h.GetActiveHooks()
-	h = &HookStats{}
-	h.GetActiveHooks()
-	h = nil
-	h.GetActiveHooks()
+func TestLicense_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	l := &License{HTMLURL: &zeroValue}
+	l.GetHTMLURL()
+	l = &License{}
+	l.GetHTMLURL()
+	l = nil
+	l.GetHTMLURL()
 }
 
-func TestHookStats_GetInactiveHooks(tt *testing.T) {
-	var zeroValue int
-	h := &HookStats{InactiveHooks: &zeroValue}
-	h.GetInactiveHooks()
-	h = &HookStats{}
-	h.GetInactiveHooks()
-	h = nil
-	h.GetInactiveHooks()
+func TestLicense_GetImplementation(tt *testing.T) {
+	var zeroValue string
+	l := &License{Implementation: &zeroValue}
+	l.GetImplementation()
+	l = &License{}
+	l.GetImplementation()
+	l = nil
+	l.GetImplementation()
 }
 
-func TestHookStats_GetTotalHooks(tt *testing.T) {
-	var zeroValue int
-	h := &HookStats{TotalHooks: &zeroValue}
-	h.GetTotalHooks()
-	h = &HookStats{}
-	h.GetTotalHooks()
-	h = nil
-	h.GetTotalHooks()
+func TestLicense_GetKey(tt *testing.T) {
+	var zeroValue string
+	l := &License{Key: &zeroValue}
+	l.GetKey()
+	l = &License{}
+	l.GetKey()
+	l = nil
+	l.GetKey()
 }
 
-func TestIDPGroup_GetGroupDescription(tt *testing.T) {
-	var zeroValue string
-	i := &IDPGroup{GroupDescription: &zeroValue}
-	i.GetGroupDescription()
-	i = &IDPGroup{}
-	i.GetGroupDescription()
-	i = nil
-	i.GetGroupDescription()
+func TestLicense_GetLimitations(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Limitations: &zeroValue}
+	l.GetLimitations()
+	l = &License{}
+	l.GetLimitations()
+	l = nil
+	l.GetLimitations()
 }
 
-func TestIDPGroup_GetGroupID(tt *testing.T) {
+func TestLicense_GetName(tt *testing.T) {
 	var zeroValue string
-	i := &IDPGroup{GroupID: &zeroValue}
-	i.GetGroupID()
-	i = &IDPGroup{}
-	i.GetGroupID()
-	i = nil
-	i.GetGroupID()
+	l := &License{Name: &zeroValue}
+	l.GetName()
+	l = &License{}
+	l.GetName()
+	l = nil
+	l.GetName()
 }
 
-func TestIDPGroup_GetGroupName(tt *testing.T) {
+func TestLicense_GetPermissions(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Permissions: &zeroValue}
+	l.GetPermissions()
+	l = &License{}
+	l.GetPermissions()
+	l = nil
+	l.GetPermissions()
+}
+
+func TestLicense_GetSPDXID(tt *testing.T) {
 	var zeroValue string
-	i := &IDPGroup{GroupName: &zeroValue}
-	i.GetGroupName()
-	i = &IDPGroup{}
-	i.GetGroupName()
-	i = nil
-	i.GetGroupName()
+	l := &License{SPDXID: &zeroValue}
+	l.GetSPDXID()
+	l = &License{}
+	l.GetSPDXID()
+	l = nil
+	l.GetSPDXID()
 }
 
-func TestImport_GetAuthorsCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{AuthorsCount: &zeroValue}
-	i.GetAuthorsCount()
-	i = &Import{}
-	i.GetAuthorsCount()
-	i = nil
-	i.GetAuthorsCount()
+func TestLicense_GetURL(tt *testing.T) {
+	var zeroValue string
+	l := &License{URL: &zeroValue}
+	l.GetURL()
+	l = &License{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
 }
 
-func TestImport_GetAuthorsURL(tt *testing.T) {
+func TestLinearHistoryRequirementEnforcementLevelChanges_GetFrom(tt *testing.T) {
 	var zeroValue string
-	i := &Import{AuthorsURL: &zeroValue}
-	i.GetAuthorsURL()
-	i = &Import{}
-	i.GetAuthorsURL()
-	i = nil
-	i.GetAuthorsURL()
+	l := &LinearHistoryRequirementEnforcementLevelChanges{From: &zeroValue}
+	l.GetFrom()
+	l = &LinearHistoryRequirementEnforcementLevelChanges{}
+	l.GetFrom()
+	l = nil
+	l.GetFrom()
 }
 
-func TestImport_GetCommitCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{CommitCount: &zeroValue}
-	i.GetCommitCount()
-	i = &Import{}
-	i.GetCommitCount()
-	i = nil
-	i.GetCommitCount()
+func TestListAlertsOptions_GetDirection(tt *testing.T) {
+	var zeroValue string
+	l := &ListAlertsOptions{Direction: &zeroValue}
+	l.GetDirection()
+	l = &ListAlertsOptions{}
+	l.GetDirection()
+	l = nil
+	l.GetDirection()
 }
 
-func TestImport_GetFailedStep(tt *testing.T) {
+func TestListAlertsOptions_GetEcosystem(tt *testing.T) {
 	var zeroValue string
-	i := &Import{FailedStep: &zeroValue}
-	i.GetFailedStep()
-	i = &Import{}
-	i.GetFailedStep()
-	i = nil
-	i.GetFailedStep()
+	l := &ListAlertsOptions{Ecosystem: &zeroValue}
+	l.GetEcosystem()
+	l = &ListAlertsOptions{}
+	l.GetEcosystem()
+	l = nil
+	l.GetEcosystem()
 }
 
-func TestImport_GetHasLargeFiles(tt *testing.T) {
-	var zeroValue bool
-	i := &Import{HasLargeFiles: &zeroValue}
-	i.GetHasLargeFiles()
-	i = &Import{}
-	i.GetHasLargeFiles()
-	i = nil
-	i.GetHasLargeFiles()
+func TestListAlertsOptions_GetEPSSPercentage(tt *testing.T) {
+	var zeroValue string
+	l := &ListAlertsOptions{EPSSPercentage: &zeroValue}
+	l.GetEPSSPercentage()
+	l = &ListAlertsOptions{}
+	l.GetEPSSPercentage()
+	l = nil
+	l.GetEPSSPercentage()
 }
 
-func TestImport_GetHTMLURL(tt *testing.T) {
+func TestListAlertsOptions_GetPackage(tt *testing.T) {
 	var zeroValue string
-	i := &Import{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Import{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+	l := &ListAlertsOptions{Package: &zeroValue}
+	l.GetPackage()
+	l = &ListAlertsOptions{}
+	l.GetPackage()
+	l = nil
+	l.GetPackage()
 }
 
-func TestImport_GetHumanName(tt *testing.T) {
+func TestListAlertsOptions_GetScope(tt *testing.T) {
 	var zeroValue string
-	i := &Import{HumanName: &zeroValue}
-	i.GetHumanName()
-	i = &Import{}
-	i.GetHumanName()
-	i = nil
-	i.GetHumanName()
+	l := &ListAlertsOptions{Scope: &zeroValue}
+	l.GetScope()
+	l = &ListAlertsOptions{}
+	l.GetScope()
+	l = nil
+	l.GetScope()
 }
 
-func TestImport_GetLargeFilesCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{LargeFilesCount: &zeroValue}
-	i.GetLargeFilesCount()
-	i = &Import{}
-	i.GetLargeFilesCount()
-	i = nil
-	i.GetLargeFilesCount()
+func TestListAlertsOptions_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	l := &ListAlertsOptions{Severity: &zeroValue}
+	l.GetSeverity()
+	l = &ListAlertsOptions{}
+	l.GetSeverity()
+	l = nil
+	l.GetSeverity()
 }
 
-func TestImport_GetLargeFilesSize(tt *testing.T) {
-	var zeroValue int
-	i := &Import{LargeFilesSize: &zeroValue}
-	i.GetLargeFilesSize()
-	i = &Import{}
-	i.GetLargeFilesSize()
-	i = nil
-	i.GetLargeFilesSize()
+func TestListAlertsOptions_GetSort(tt *testing.T) {
+	var zeroValue string
+	l := &ListAlertsOptions{Sort: &zeroValue}
+	l.GetSort()
+	l = &ListAlertsOptions{}
+	l.GetSort()
+	l = nil
+	l.GetSort()
 }
 
-func TestImport_GetMessage(tt *testing.T) {
+func TestListAlertsOptions_GetState(tt *testing.T) {
 	var zeroValue string
-	i := &Import{Message: &zeroValue}
-	i.GetMessage()
-	i = &Import{}
-	i.GetMessage()
-	i = nil
-	i.GetMessage()
+	l := &ListAlertsOptions{State: &zeroValue}
+	l.GetState()
+	l = &ListAlertsOptions{}
+	l.GetState()
+	l = nil
+	l.GetState()
 }
 
-func TestImport_GetPercent(tt *testing.T) {
-	var zeroValue int
-	i := &Import{Percent: &zeroValue}
-	i.GetPercent()
-	i = &Import{}
-	i.GetPercent()
-	i = nil
-	i.GetPercent()
+func TestListCheckRunsOptions_GetAppID(tt *testing.T) {
+	var zeroValue int64
+	l := &ListCheckRunsOptions{AppID: &zeroValue}
+	l.GetAppID()
+	l = &ListCheckRunsOptions{}
+	l.GetAppID()
+	l = nil
+	l.GetAppID()
 }
 
-func TestImport_GetPushPercent(tt *testing.T) {
-	var zeroValue int
-	i := &Import{PushPercent: &zeroValue}
-	i.GetPushPercent()
-	i = &Import{}
-	i.GetPushPercent()
-	i = nil
-	i.GetPushPercent()
+func TestListCheckRunsOptions_GetCheckName(tt *testing.T) {
+	var zeroValue string
+	l := &ListCheckRunsOptions{CheckName: &zeroValue}
+	l.GetCheckName()
+	l = &ListCheckRunsOptions{}
+	l.GetCheckName()
+	l = nil
+	l.GetCheckName()
 }
 
-func TestImport_GetRepositoryURL(tt *testing.T) {
+func TestListCheckRunsOptions_GetFilter(tt *testing.T) {
 	var zeroValue string
-	i := &Import{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &Import{}
-	i.GetRepositoryURL()
-	i = nil
-	i.GetRepositoryURL()
+	l := &ListCheckRunsOptions{Filter: &zeroValue}
+	l.GetFilter()
+	l = &ListCheckRunsOptions{}
+	l.GetFilter()
+	l = nil
+	l.GetFilter()
 }
 
-func TestImport_GetStatus(tt *testing.T) {
+func TestListCheckRunsOptions_GetStatus(tt *testing.T) {
 	var zeroValue string
-	i := &Import{Status: &zeroValue}
-	i.GetStatus()
-	i = &Import{}
-	i.GetStatus()
-	i = nil
-	i.GetStatus()
+	l := &ListCheckRunsOptions{Status: &zeroValue}
+	l.GetStatus()
+	l = &ListCheckRunsOptions{}
+	l.GetStatus()
+	l = nil
+	l.GetStatus()
 }
 
-func TestImport_GetStatusText(tt *testing.T) {
-	var zeroValue string
-	i := &Import{StatusText: &zeroValue}
-	i.GetStatusText()
-	i = &Import{}
-	i.GetStatusText()
-	i = nil
-	i.GetStatusText()
+func TestListCheckRunsResults_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckRunsResults{Total: &zeroValue}
+	l.GetTotal()
+	l = &ListCheckRunsResults{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
 }
 
-func TestImport_GetTFVCProject(tt *testing.T) {
-	var zeroValue string
-	i := &Import{TFVCProject: &zeroValue}
-	i.GetTFVCProject()
-	i = &Import{}
-	i.GetTFVCProject()
-	i = nil
-	i.GetTFVCProject()
+func TestListCheckSuiteOptions_GetAppID(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckSuiteOptions{AppID: &zeroValue}
+	l.GetAppID()
+	l = &ListCheckSuiteOptions{}
+	l.GetAppID()
+	l = nil
+	l.GetAppID()
 }
 
-func TestImport_GetURL(tt *testing.T) {
+func TestListCheckSuiteOptions_GetCheckName(tt *testing.T) {
 	var zeroValue string
-	i := &Import{URL: &zeroValue}
-	i.GetURL()
-	i = &Import{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+	l := &ListCheckSuiteOptions{CheckName: &zeroValue}
+	l.GetCheckName()
+	l = &ListCheckSuiteOptions{}
+	l.GetCheckName()
+	l = nil
+	l.GetCheckName()
 }
 
-func TestImport_GetUseLFS(tt *testing.T) {
-	var zeroValue string
-	i := &Import{UseLFS: &zeroValue}
-	i.GetUseLFS()
-	i = &Import{}
-	i.GetUseLFS()
-	i = nil
-	i.GetUseLFS()
+func TestListCheckSuiteResults_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckSuiteResults{Total: &zeroValue}
+	l.GetTotal()
+	l = &ListCheckSuiteResults{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
 }
 
-func TestImport_GetVCS(tt *testing.T) {
-	var zeroValue string
-	i := &Import{VCS: &zeroValue}
-	i.GetVCS()
-	i = &Import{}
-	i.GetVCS()
-	i = nil
-	i.GetVCS()
+func TestListCodespaces_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	l := &ListCodespaces{TotalCount: &zeroValue}
+	l.GetTotalCount()
+	l = &ListCodespaces{}
+	l.GetTotalCount()
+	l = nil
+	l.GetTotalCount()
 }
 
-func TestImport_GetVCSPassword(tt *testing.T) {
+func TestListCollaboratorOptions_GetAffiliation(tt *testing.T) {
 	var zeroValue string
-	i := &Import{VCSPassword: &zeroValue}
-	i.GetVCSPassword()
-	i = &Import{}
-	i.GetVCSPassword()
-	i = nil
-	i.GetVCSPassword()
+	l := &ListCollaboratorOptions{Affiliation: &zeroValue}
+	l.GetAffiliation()
+	l = &ListCollaboratorOptions{}
+	l.GetAffiliation()
+	l = nil
+	l.GetAffiliation()
+}
+
+func TestListCustomDeploymentRuleIntegrationsResponse_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	l := &ListCustomDeploymentRuleIntegrationsResponse{TotalCount: &zeroValue}
+	l.GetTotalCount()
+	l = &ListCustomDeploymentRuleIntegrationsResponse{}
+	l.GetTotalCount()
+	l = nil
+	l.GetTotalCount()
 }
 
-func TestImport_GetVCSURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{VCSURL: &zeroValue}
-	i.GetVCSURL()
-	i = &Import{}
-	i.GetVCSURL()
-	i = nil
-	i.GetVCSURL()
+func TestListDeploymentProtectionRuleResponse_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	l := &ListDeploymentProtectionRuleResponse{TotalCount: &zeroValue}
+	l.GetTotalCount()
+	l = &ListDeploymentProtectionRuleResponse{}
+	l.GetTotalCount()
+	l = nil
+	l.GetTotalCount()
 }
 
-func TestImport_GetVCSUsername(tt *testing.T) {
+func TestListExternalGroupsOptions_GetDisplayName(tt *testing.T) {
 	var zeroValue string
-	i := &Import{VCSUsername: &zeroValue}
-	i.GetVCSUsername()
-	i = &Import{}
-	i.GetVCSUsername()
-	i = nil
-	i.GetVCSUsername()
+	l := &ListExternalGroupsOptions{DisplayName: &zeroValue}
+	l.GetDisplayName()
+	l = &ListExternalGroupsOptions{}
+	l.GetDisplayName()
+	l = nil
+	l.GetDisplayName()
 }
 
-func TestInstallation_GetAccessTokensURL(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{AccessTokensURL: &zeroValue}
-	i.GetAccessTokensURL()
-	i = &Installation{}
-	i.GetAccessTokensURL()
-	i = nil
-	i.GetAccessTokensURL()
+func TestListGlobalAdvisoriesOptions_GetIsWithdrawn(tt *testing.T) {
+	var zeroValue bool
+	l := &ListGlobalAdvisoriesOptions{IsWithdrawn: &zeroValue}
+	l.GetIsWithdrawn()
+	l = &ListGlobalAdvisoriesOptions{}
+	l.GetIsWithdrawn()
+	l = nil
+	l.GetIsWithdrawn()
 }
 
-func TestInstallation_GetAccount(tt *testing.T) {
-	i := &Installation{}
-	i.GetAccount()
-	i = nil
-	i.GetAccount()
+func TestListRepositories_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	l := &ListRepositories{TotalCount: &zeroValue}
+	l.GetTotalCount()
+	l = &ListRepositories{}
+	l.GetTotalCount()
+	l = nil
+	l.GetTotalCount()
 }
 
-func TestInstallation_GetAppID(tt *testing.T) {
-	var zeroValue int64
-	i := &Installation{AppID: &zeroValue}
-	i.GetAppID()
-	i = &Installation{}
-	i.GetAppID()
-	i = nil
-	i.GetAppID()
+func TestListSCIMEnterpriseOptions_GetCount(tt *testing.T) {
+	var zeroValue int
+	l := &ListSCIMEnterpriseOptions{Count: &zeroValue}
+	l.GetCount()
+	l = &ListSCIMEnterpriseOptions{}
+	l.GetCount()
+	l = nil
+	l.GetCount()
 }
 
-func TestInstallation_GetAppSlug(tt *testing.T) {
+func TestListSCIMEnterpriseOptions_GetExcludedAttributes(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{AppSlug: &zeroValue}
-	i.GetAppSlug()
-	i = &Installation{}
-	i.GetAppSlug()
-	i = nil
-	i.GetAppSlug()
+	l := &ListSCIMEnterpriseOptions{ExcludedAttributes: &zeroValue}
+	l.GetExcludedAttributes()
+	l = &ListSCIMEnterpriseOptions{}
+	l.GetExcludedAttributes()
+	l = nil
+	l.GetExcludedAttributes()
 }
 
-func TestInstallation_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Installation{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Installation{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestListSCIMEnterpriseOptions_GetFilter(tt *testing.T) {
+	var zeroValue string
+	l := &ListSCIMEnterpriseOptions{Filter: &zeroValue}
+	l.GetFilter()
+	l = &ListSCIMEnterpriseOptions{}
+	l.GetFilter()
+	l = nil
+	l.GetFilter()
 }
 
-func TestInstallation_GetHasMultipleSingleFiles(tt *testing.T) {
-	var zeroValue bool
-	i := &Installation{HasMultipleSingleFiles: &zeroValue}
-	i.GetHasMultipleSingleFiles()
-	i = &Installation{}
-	i.GetHasMultipleSingleFiles()
-	i = nil
-	i.GetHasMultipleSingleFiles()
+func TestListSCIMEnterpriseOptions_GetStartIndex(tt *testing.T) {
+	var zeroValue int
+	l := &ListSCIMEnterpriseOptions{StartIndex: &zeroValue}
+	l.GetStartIndex()
+	l = &ListSCIMEnterpriseOptions{}
+	l.GetStartIndex()
+	l = nil
+	l.GetStartIndex()
 }
 
-func TestInstallation_GetHTMLURL(tt *testing.T) {
+func TestListSCIMProvisionedIdentitiesOptions_GetCount(tt *testing.T) {
+	var zeroValue int
+	l := &ListSCIMProvisionedIdentitiesOptions{Count: &zeroValue}
+	l.GetCount()
+	l = &ListSCIMProvisionedIdentitiesOptions{}
+	l.GetCount()
+	l = nil
+	l.GetCount()
+}
+
+func TestListSCIMProvisionedIdentitiesOptions_GetFilter(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Installation{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+	l := &ListSCIMProvisionedIdentitiesOptions{Filter: &zeroValue}
+	l.GetFilter()
+	l = &ListSCIMProvisionedIdentitiesOptions{}
+	l.GetFilter()
+	l = nil
+	l.GetFilter()
 }
 
-func TestInstallation_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Installation{ID: &zeroValue}
-	i.GetID()
-	i = &Installation{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestListSCIMProvisionedIdentitiesOptions_GetStartIndex(tt *testing.T) {
+	var zeroValue int
+	l := &ListSCIMProvisionedIdentitiesOptions{StartIndex: &zeroValue}
+	l.GetStartIndex()
+	l = &ListSCIMProvisionedIdentitiesOptions{}
+	l.GetStartIndex()
+	l = nil
+	l.GetStartIndex()
 }
 
-func TestInstallation_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Installation{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+func TestLocation_GetEndColumn(tt *testing.T) {
+	var zeroValue int
+	l := &Location{EndColumn: &zeroValue}
+	l.GetEndColumn()
+	l = &Location{}
+	l.GetEndColumn()
+	l = nil
+	l.GetEndColumn()
 }
 
-func TestInstallation_GetPermissions(tt *testing.T) {
-	i := &Installation{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestLocation_GetEndLine(tt *testing.T) {
+	var zeroValue int
+	l := &Location{EndLine: &zeroValue}
+	l.GetEndLine()
+	l = &Location{}
+	l.GetEndLine()
+	l = nil
+	l.GetEndLine()
 }
 
-func TestInstallation_GetRepositoriesURL(tt *testing.T) {
+func TestLocation_GetPath(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{RepositoriesURL: &zeroValue}
-	i.GetRepositoriesURL()
-	i = &Installation{}
-	i.GetRepositoriesURL()
-	i = nil
-	i.GetRepositoriesURL()
+	l := &Location{Path: &zeroValue}
+	l.GetPath()
+	l = &Location{}
+	l.GetPath()
+	l = nil
+	l.GetPath()
 }
 
-func TestInstallation_GetRepositorySelection(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{RepositorySelection: &zeroValue}
-	i.GetRepositorySelection()
-	i = &Installation{}
-	i.GetRepositorySelection()
-	i = nil
-	i.GetRepositorySelection()
+func TestLocation_GetStartColumn(tt *testing.T) {
+	var zeroValue int
+	l := &Location{StartColumn: &zeroValue}
+	l.GetStartColumn()
+	l = &Location{}
+	l.GetStartColumn()
+	l = nil
+	l.GetStartColumn()
 }
 
-func TestInstallation_GetSingleFileName(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{SingleFileName: &zeroValue}
-	i.GetSingleFileName()
-	i = &Installation{}
-	i.GetSingleFileName()
-	i = nil
-	i.GetSingleFileName()
+func TestLocation_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	l := &Location{StartLine: &zeroValue}
+	l.GetStartLine()
+	l = &Location{}
+	l.GetStartLine()
+	l = nil
+	l.GetStartLine()
 }
 
-func TestInstallation_GetSuspendedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Installation{SuspendedAt: &zeroValue}
-	i.GetSuspendedAt()
-	i = &Installation{}
-	i.GetSuspendedAt()
-	i = nil
-	i.GetSuspendedAt()
+func TestLockBranch_GetEnabled(tt *testing.T) {
+	var zeroValue bool
+	l := &LockBranch{Enabled: &zeroValue}
+	l.GetEnabled()
+	l = &LockBranch{}
+	l.GetEnabled()
+	l = nil
+	l.GetEnabled()
 }
 
-func TestInstallation_GetSuspendedBy(tt *testing.T) {
-	i := &Installation{}
-	i.GetSuspendedBy()
-	i = nil
-	i.GetSuspendedBy()
+func TestMarketplacePendingChange_GetEffectiveDate(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePendingChange{EffectiveDate: &zeroValue}
+	m.GetEffectiveDate()
+	m = &MarketplacePendingChange{}
+	m.GetEffectiveDate()
+	m = nil
+	m.GetEffectiveDate()
 }
 
-func TestInstallation_GetTargetID(tt *testing.T) {
+func TestMarketplacePendingChange_GetID(tt *testing.T) {
 	var zeroValue int64
-	i := &Installation{TargetID: &zeroValue}
-	i.GetTargetID()
-	i = &Installation{}
-	i.GetTargetID()
-	i = nil
-	i.GetTargetID()
+	m := &MarketplacePendingChange{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePendingChange{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestInstallation_GetTargetType(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{TargetType: &zeroValue}
-	i.GetTargetType()
-	i = &Installation{}
-	i.GetTargetType()
-	i = nil
-	i.GetTargetType()
+func TestMarketplacePendingChange_GetPlan(tt *testing.T) {
+	m := &MarketplacePendingChange{}
+	m.GetPlan()
+	m = nil
+	m.GetPlan()
 }
 
-func TestInstallation_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Installation{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &Installation{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestMarketplacePendingChange_GetUnitCount(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePendingChange{UnitCount: &zeroValue}
+	m.GetUnitCount()
+	m = &MarketplacePendingChange{}
+	m.GetUnitCount()
+	m = nil
+	m.GetUnitCount()
 }
 
-func TestInstallationEvent_GetAction(tt *testing.T) {
+func TestMarketplacePlan_GetAccountsURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &InstallationEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+	m := &MarketplacePlan{AccountsURL: &zeroValue}
+	m.GetAccountsURL()
+	m = &MarketplacePlan{}
+	m.GetAccountsURL()
+	m = nil
+	m.GetAccountsURL()
 }
 
-func TestInstallationEvent_GetInstallation(tt *testing.T) {
-	i := &InstallationEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestMarketplacePlan_GetBullets(tt *testing.T) {
+	var zeroValue []string
+	m := &MarketplacePlan{Bullets: &zeroValue}
+	m.GetBullets()
+	m = &MarketplacePlan{}
+	m.GetBullets()
+	m = nil
+	m.GetBullets()
 }
 
-func TestInstallationEvent_GetSender(tt *testing.T) {
-	i := &InstallationEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestMarketplacePlan_GetDescription(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{Description: &zeroValue}
+	m.GetDescription()
+	m = &MarketplacePlan{}
+	m.GetDescription()
+	m = nil
+	m.GetDescription()
 }
 
-func TestInstallationPermissions_GetActions(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Actions: &zeroValue}
-	i.GetActions()
-	i = &InstallationPermissions{}
-	i.GetActions()
-	i = nil
-	i.GetActions()
+func TestMarketplacePlan_GetHasFreeTrial(tt *testing.T) {
+	var zeroValue bool
+	m := &MarketplacePlan{HasFreeTrial: &zeroValue}
+	m.GetHasFreeTrial()
+	m = &MarketplacePlan{}
+	m.GetHasFreeTrial()
+	m = nil
+	m.GetHasFreeTrial()
 }
 
-func TestInstallationPermissions_GetAdministration(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Administration: &zeroValue}
-	i.GetAdministration()
-	i = &InstallationPermissions{}
-	i.GetAdministration()
-	i = nil
-	i.GetAdministration()
+func TestMarketplacePlan_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &MarketplacePlan{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePlan{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestInstallationPermissions_GetBlocking(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Blocking: &zeroValue}
-	i.GetBlocking()
-	i = &InstallationPermissions{}
-	i.GetBlocking()
-	i = nil
-	i.GetBlocking()
+func TestMarketplacePlan_GetMonthlyPriceInCents(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePlan{MonthlyPriceInCents: &zeroValue}
+	m.GetMonthlyPriceInCents()
+	m = &MarketplacePlan{}
+	m.GetMonthlyPriceInCents()
+	m = nil
+	m.GetMonthlyPriceInCents()
 }
 
-func TestInstallationPermissions_GetChecks(tt *testing.T) {
+func TestMarketplacePlan_GetName(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Checks: &zeroValue}
-	i.GetChecks()
-	i = &InstallationPermissions{}
-	i.GetChecks()
-	i = nil
-	i.GetChecks()
+	m := &MarketplacePlan{Name: &zeroValue}
+	m.GetName()
+	m = &MarketplacePlan{}
+	m.GetName()
+	m = nil
+	m.GetName()
 }
 
-func TestInstallationPermissions_GetContentReferences(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{ContentReferences: &zeroValue}
-	i.GetContentReferences()
-	i = &InstallationPermissions{}
-	i.GetContentReferences()
-	i = nil
-	i.GetContentReferences()
+func TestMarketplacePlan_GetNumber(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePlan{Number: &zeroValue}
+	m.GetNumber()
+	m = &MarketplacePlan{}
+	m.GetNumber()
+	m = nil
+	m.GetNumber()
 }
 
-func TestInstallationPermissions_GetContents(tt *testing.T) {
+func TestMarketplacePlan_GetPriceModel(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Contents: &zeroValue}
-	i.GetContents()
-	i = &InstallationPermissions{}
-	i.GetContents()
-	i = nil
-	i.GetContents()
+	m := &MarketplacePlan{PriceModel: &zeroValue}
+	m.GetPriceModel()
+	m = &MarketplacePlan{}
+	m.GetPriceModel()
+	m = nil
+	m.GetPriceModel()
 }
 
-func TestInstallationPermissions_GetDeployments(tt *testing.T) {
+func TestMarketplacePlan_GetState(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Deployments: &zeroValue}
-	i.GetDeployments()
-	i = &InstallationPermissions{}
-	i.GetDeployments()
-	i = nil
-	i.GetDeployments()
+	m := &MarketplacePlan{State: &zeroValue}
+	m.GetState()
+	m = &MarketplacePlan{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestInstallationPermissions_GetEmails(tt *testing.T) {
+func TestMarketplacePlan_GetUnitName(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Emails: &zeroValue}
-	i.GetEmails()
-	i = &InstallationPermissions{}
-	i.GetEmails()
-	i = nil
-	i.GetEmails()
+	m := &MarketplacePlan{UnitName: &zeroValue}
+	m.GetUnitName()
+	m = &MarketplacePlan{}
+	m.GetUnitName()
+	m = nil
+	m.GetUnitName()
 }
 
-func TestInstallationPermissions_GetEnvironments(tt *testing.T) {
+func TestMarketplacePlan_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Environments: &zeroValue}
-	i.GetEnvironments()
-	i = &InstallationPermissions{}
-	i.GetEnvironments()
-	i = nil
-	i.GetEnvironments()
+	m := &MarketplacePlan{URL: &zeroValue}
+	m.GetURL()
+	m = &MarketplacePlan{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestInstallationPermissions_GetFollowers(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Followers: &zeroValue}
-	i.GetFollowers()
-	i = &InstallationPermissions{}
-	i.GetFollowers()
-	i = nil
-	i.GetFollowers()
+func TestMarketplacePlan_GetYearlyPriceInCents(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePlan{YearlyPriceInCents: &zeroValue}
+	m.GetYearlyPriceInCents()
+	m = &MarketplacePlan{}
+	m.GetYearlyPriceInCents()
+	m = nil
+	m.GetYearlyPriceInCents()
 }
 
-func TestInstallationPermissions_GetIssues(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Issues: &zeroValue}
-	i.GetIssues()
-	i = &InstallationPermissions{}
-	i.GetIssues()
-	i = nil
-	i.GetIssues()
+func TestMarketplacePlanAccount_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &MarketplacePlanAccount{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePlanAccount{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestInstallationPermissions_GetMembers(tt *testing.T) {
+func TestMarketplacePlanAccount_GetLogin(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Members: &zeroValue}
-	i.GetMembers()
-	i = &InstallationPermissions{}
-	i.GetMembers()
-	i = nil
-	i.GetMembers()
+	m := &MarketplacePlanAccount{Login: &zeroValue}
+	m.GetLogin()
+	m = &MarketplacePlanAccount{}
+	m.GetLogin()
+	m = nil
+	m.GetLogin()
+}
+
+func TestMarketplacePlanAccount_GetMarketplacePendingChange(tt *testing.T) {
+	m := &MarketplacePlanAccount{}
+	m.GetMarketplacePendingChange()
+	m = nil
+	m.GetMarketplacePendingChange()
 }
 
-func TestInstallationPermissions_GetMetadata(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Metadata: &zeroValue}
-	i.GetMetadata()
-	i = &InstallationPermissions{}
-	i.GetMetadata()
-	i = nil
-	i.GetMetadata()
+func TestMarketplacePlanAccount_GetMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePlanAccount{}
+	m.GetMarketplacePurchase()
+	m = nil
+	m.GetMarketplacePurchase()
 }
 
-func TestInstallationPermissions_GetOrganizationAdministration(tt *testing.T) {
+func TestMarketplacePlanAccount_GetOrganizationBillingEmail(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationAdministration: &zeroValue}
-	i.GetOrganizationAdministration()
-	i = &InstallationPermissions{}
-	i.GetOrganizationAdministration()
-	i = nil
-	i.GetOrganizationAdministration()
+	m := &MarketplacePlanAccount{OrganizationBillingEmail: &zeroValue}
+	m.GetOrganizationBillingEmail()
+	m = &MarketplacePlanAccount{}
+	m.GetOrganizationBillingEmail()
+	m = nil
+	m.GetOrganizationBillingEmail()
 }
 
-func TestInstallationPermissions_GetOrganizationCustomRoles(tt *testing.T) {
+func TestMarketplacePlanAccount_GetType(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationCustomRoles: &zeroValue}
-	i.GetOrganizationCustomRoles()
-	i = &InstallationPermissions{}
-	i.GetOrganizationCustomRoles()
-	i = nil
-	i.GetOrganizationCustomRoles()
+	m := &MarketplacePlanAccount{Type: &zeroValue}
+	m.GetType()
+	m = &MarketplacePlanAccount{}
+	m.GetType()
+	m = nil
+	m.GetType()
 }
 
-func TestInstallationPermissions_GetOrganizationHooks(tt *testing.T) {
+func TestMarketplacePlanAccount_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationHooks: &zeroValue}
-	i.GetOrganizationHooks()
-	i = &InstallationPermissions{}
-	i.GetOrganizationHooks()
-	i = nil
-	i.GetOrganizationHooks()
+	m := &MarketplacePlanAccount{URL: &zeroValue}
+	m.GetURL()
+	m = &MarketplacePlanAccount{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestInstallationPermissions_GetOrganizationPackages(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{OrganizationPackages: &zeroValue}
-	i.GetOrganizationPackages()
-	i = &InstallationPermissions{}
-	i.GetOrganizationPackages()
-	i = nil
-	i.GetOrganizationPackages()
+func TestMarketplacePurchase_GetAccount(tt *testing.T) {
+	m := &MarketplacePurchase{}
+	m.GetAccount()
+	m = nil
+	m.GetAccount()
 }
 
-func TestInstallationPermissions_GetOrganizationPlan(tt *testing.T) {
+func TestMarketplacePurchase_GetBillingCycle(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationPlan: &zeroValue}
-	i.GetOrganizationPlan()
-	i = &InstallationPermissions{}
-	i.GetOrganizationPlan()
-	i = nil
-	i.GetOrganizationPlan()
+	m := &MarketplacePurchase{BillingCycle: &zeroValue}
+	m.GetBillingCycle()
+	m = &MarketplacePurchase{}
+	m.GetBillingCycle()
+	m = nil
+	m.GetBillingCycle()
 }
 
-func TestInstallationPermissions_GetOrganizationPreReceiveHooks(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{OrganizationPreReceiveHooks: &zeroValue}
-	i.GetOrganizationPreReceiveHooks()
-	i = &InstallationPermissions{}
-	i.GetOrganizationPreReceiveHooks()
-	i = nil
-	i.GetOrganizationPreReceiveHooks()
+func TestMarketplacePurchase_GetFreeTrialEndsOn(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchase{FreeTrialEndsOn: &zeroValue}
+	m.GetFreeTrialEndsOn()
+	m = &MarketplacePurchase{}
+	m.GetFreeTrialEndsOn()
+	m = nil
+	m.GetFreeTrialEndsOn()
 }
 
-func TestInstallationPermissions_GetOrganizationProjects(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{OrganizationProjects: &zeroValue}
-	i.GetOrganizationProjects()
-	i = &InstallationPermissions{}
-	i.GetOrganizationProjects()
-	i = nil
-	i.GetOrganizationProjects()
+func TestMarketplacePurchase_GetNextBillingDate(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchase{NextBillingDate: &zeroValue}
+	m.GetNextBillingDate()
+	m = &MarketplacePurchase{}
+	m.GetNextBillingDate()
+	m = nil
+	m.GetNextBillingDate()
 }
 
-func TestInstallationPermissions_GetOrganizationSecrets(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{OrganizationSecrets: &zeroValue}
-	i.GetOrganizationSecrets()
-	i = &InstallationPermissions{}
-	i.GetOrganizationSecrets()
-	i = nil
-	i.GetOrganizationSecrets()
+func TestMarketplacePurchase_GetOnFreeTrial(tt *testing.T) {
+	var zeroValue bool
+	m := &MarketplacePurchase{OnFreeTrial: &zeroValue}
+	m.GetOnFreeTrial()
+	m = &MarketplacePurchase{}
+	m.GetOnFreeTrial()
+	m = nil
+	m.GetOnFreeTrial()
 }
 
-func TestInstallationPermissions_GetOrganizationSelfHostedRunners(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{OrganizationSelfHostedRunners: &zeroValue}
-	i.GetOrganizationSelfHostedRunners()
-	i = &InstallationPermissions{}
-	i.GetOrganizationSelfHostedRunners()
-	i = nil
-	i.GetOrganizationSelfHostedRunners()
+func TestMarketplacePurchase_GetPlan(tt *testing.T) {
+	m := &MarketplacePurchase{}
+	m.GetPlan()
+	m = nil
+	m.GetPlan()
 }
 
-func TestInstallationPermissions_GetOrganizationUserBlocking(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{OrganizationUserBlocking: &zeroValue}
-	i.GetOrganizationUserBlocking()
-	i = &InstallationPermissions{}
-	i.GetOrganizationUserBlocking()
-	i = nil
-	i.GetOrganizationUserBlocking()
+func TestMarketplacePurchase_GetUnitCount(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePurchase{UnitCount: &zeroValue}
+	m.GetUnitCount()
+	m = &MarketplacePurchase{}
+	m.GetUnitCount()
+	m = nil
+	m.GetUnitCount()
 }
 
-func TestInstallationPermissions_GetPackages(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Packages: &zeroValue}
-	i.GetPackages()
-	i = &InstallationPermissions{}
-	i.GetPackages()
-	i = nil
-	i.GetPackages()
+func TestMarketplacePurchase_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchase{UpdatedAt: &zeroValue}
+	m.GetUpdatedAt()
+	m = &MarketplacePurchase{}
+	m.GetUpdatedAt()
+	m = nil
+	m.GetUpdatedAt()
 }
 
-func TestInstallationPermissions_GetPages(tt *testing.T) {
+func TestMarketplacePurchaseAccount_GetEmail(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Pages: &zeroValue}
-	i.GetPages()
-	i = &InstallationPermissions{}
-	i.GetPages()
-	i = nil
-	i.GetPages()
+	m := &MarketplacePurchaseAccount{Email: &zeroValue}
+	m.GetEmail()
+	m = &MarketplacePurchaseAccount{}
+	m.GetEmail()
+	m = nil
+	m.GetEmail()
 }
 
-func TestInstallationPermissions_GetPullRequests(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{PullRequests: &zeroValue}
-	i.GetPullRequests()
-	i = &InstallationPermissions{}
-	i.GetPullRequests()
-	i = nil
-	i.GetPullRequests()
+func TestMarketplacePurchaseAccount_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &MarketplacePurchaseAccount{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePurchaseAccount{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestInstallationPermissions_GetRepositoryHooks(tt *testing.T) {
+func TestMarketplacePurchaseAccount_GetLogin(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{RepositoryHooks: &zeroValue}
-	i.GetRepositoryHooks()
-	i = &InstallationPermissions{}
-	i.GetRepositoryHooks()
-	i = nil
-	i.GetRepositoryHooks()
+	m := &MarketplacePurchaseAccount{Login: &zeroValue}
+	m.GetLogin()
+	m = &MarketplacePurchaseAccount{}
+	m.GetLogin()
+	m = nil
+	m.GetLogin()
 }
 
-func TestInstallationPermissions_GetRepositoryPreReceiveHooks(tt *testing.T) {
+func TestMarketplacePurchaseAccount_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{RepositoryPreReceiveHooks: &zeroValue}
-	i.GetRepositoryPreReceiveHooks()
-	i = &InstallationPermissions{}
-	i.GetRepositoryPreReceiveHooks()
-	i = nil
-	i.GetRepositoryPreReceiveHooks()
+	m := &MarketplacePurchaseAccount{NodeID: &zeroValue}
+	m.GetNodeID()
+	m = &MarketplacePurchaseAccount{}
+	m.GetNodeID()
+	m = nil
+	m.GetNodeID()
 }
 
-func TestInstallationPermissions_GetRepositoryProjects(tt *testing.T) {
+func TestMarketplacePurchaseAccount_GetOrganizationBillingEmail(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{RepositoryProjects: &zeroValue}
-	i.GetRepositoryProjects()
-	i = &InstallationPermissions{}
-	i.GetRepositoryProjects()
-	i = nil
-	i.GetRepositoryProjects()
+	m := &MarketplacePurchaseAccount{OrganizationBillingEmail: &zeroValue}
+	m.GetOrganizationBillingEmail()
+	m = &MarketplacePurchaseAccount{}
+	m.GetOrganizationBillingEmail()
+	m = nil
+	m.GetOrganizationBillingEmail()
 }
 
-func TestInstallationPermissions_GetSecrets(tt *testing.T) {
+func TestMarketplacePurchaseAccount_GetType(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Secrets: &zeroValue}
-	i.GetSecrets()
-	i = &InstallationPermissions{}
-	i.GetSecrets()
-	i = nil
-	i.GetSecrets()
+	m := &MarketplacePurchaseAccount{Type: &zeroValue}
+	m.GetType()
+	m = &MarketplacePurchaseAccount{}
+	m.GetType()
+	m = nil
+	m.GetType()
 }
 
-func TestInstallationPermissions_GetSecretScanningAlerts(tt *testing.T) {
+func TestMarketplacePurchaseAccount_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{SecretScanningAlerts: &zeroValue}
-	i.GetSecretScanningAlerts()
-	i = &InstallationPermissions{}
-	i.GetSecretScanningAlerts()
-	i = nil
-	i.GetSecretScanningAlerts()
+	m := &MarketplacePurchaseAccount{URL: &zeroValue}
+	m.GetURL()
+	m = &MarketplacePurchaseAccount{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestInstallationPermissions_GetSecurityEvents(tt *testing.T) {
+func TestMarketplacePurchaseEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{SecurityEvents: &zeroValue}
-	i.GetSecurityEvents()
-	i = &InstallationPermissions{}
-	i.GetSecurityEvents()
-	i = nil
-	i.GetSecurityEvents()
+	m := &MarketplacePurchaseEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MarketplacePurchaseEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestInstallationPermissions_GetSingleFile(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{SingleFile: &zeroValue}
-	i.GetSingleFile()
-	i = &InstallationPermissions{}
-	i.GetSingleFile()
-	i = nil
-	i.GetSingleFile()
+func TestMarketplacePurchaseEvent_GetEffectiveDate(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchaseEvent{EffectiveDate: &zeroValue}
+	m.GetEffectiveDate()
+	m = &MarketplacePurchaseEvent{}
+	m.GetEffectiveDate()
+	m = nil
+	m.GetEffectiveDate()
 }
 
-func TestInstallationPermissions_GetStatuses(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Statuses: &zeroValue}
-	i.GetStatuses()
-	i = &InstallationPermissions{}
-	i.GetStatuses()
-	i = nil
-	i.GetStatuses()
+func TestMarketplacePurchaseEvent_GetInstallation(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestInstallationPermissions_GetTeamDiscussions(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{TeamDiscussions: &zeroValue}
-	i.GetTeamDiscussions()
-	i = &InstallationPermissions{}
-	i.GetTeamDiscussions()
-	i = nil
-	i.GetTeamDiscussions()
+func TestMarketplacePurchaseEvent_GetMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetMarketplacePurchase()
+	m = nil
+	m.GetMarketplacePurchase()
 }
 
-func TestInstallationPermissions_GetVulnerabilityAlerts(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{VulnerabilityAlerts: &zeroValue}
-	i.GetVulnerabilityAlerts()
-	i = &InstallationPermissions{}
-	i.GetVulnerabilityAlerts()
-	i = nil
-	i.GetVulnerabilityAlerts()
+func TestMarketplacePurchaseEvent_GetPreviousMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetPreviousMarketplacePurchase()
+	m = nil
+	m.GetPreviousMarketplacePurchase()
 }
 
-func TestInstallationPermissions_GetWorkflows(tt *testing.T) {
+func TestMarketplacePurchaseEvent_GetSender(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
+}
+
+func TestMatch_GetText(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Workflows: &zeroValue}
-	i.GetWorkflows()
-	i = &InstallationPermissions{}
-	i.GetWorkflows()
-	i = nil
-	i.GetWorkflows()
+	m := &Match{Text: &zeroValue}
+	m.GetText()
+	m = &Match{}
+	m.GetText()
+	m = nil
+	m.GetText()
 }
 
-func TestInstallationRepositoriesEvent_GetAction(tt *testing.T) {
+func TestMemberEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationRepositoriesEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &InstallationRepositoriesEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+	m := &MemberEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MemberEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestInstallationRepositoriesEvent_GetInstallation(tt *testing.T) {
-	i := &InstallationRepositoriesEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestMemberEvent_GetInstallation(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestInstallationRepositoriesEvent_GetRepositorySelection(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationRepositoriesEvent{RepositorySelection: &zeroValue}
-	i.GetRepositorySelection()
-	i = &InstallationRepositoriesEvent{}
-	i.GetRepositorySelection()
-	i = nil
-	i.GetRepositorySelection()
+func TestMemberEvent_GetMember(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetMember()
+	m = nil
+	m.GetMember()
 }
 
-func TestInstallationRepositoriesEvent_GetSender(tt *testing.T) {
-	i := &InstallationRepositoriesEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestMemberEvent_GetOrg(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
 }
 
-func TestInstallationToken_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &InstallationToken{ExpiresAt: &zeroValue}
-	i.GetExpiresAt()
-	i = &InstallationToken{}
-	i.GetExpiresAt()
-	i = nil
-	i.GetExpiresAt()
+func TestMemberEvent_GetRepo(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
 }
 
-func TestInstallationToken_GetPermissions(tt *testing.T) {
-	i := &InstallationToken{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestMemberEvent_GetSender(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
 }
 
-func TestInstallationToken_GetToken(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationToken{Token: &zeroValue}
-	i.GetToken()
-	i = &InstallationToken{}
-	i.GetToken()
-	i = nil
-	i.GetToken()
+func TestMembership_GetOrganization(tt *testing.T) {
+	m := &Membership{}
+	m.GetOrganization()
+	m = nil
+	m.GetOrganization()
 }
 
-func TestInstallationTokenOptions_GetPermissions(tt *testing.T) {
-	i := &InstallationTokenOptions{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestMembership_GetOrganizationURL(tt *testing.T) {
+	var zeroValue string
+	m := &Membership{OrganizationURL: &zeroValue}
+	m.GetOrganizationURL()
+	m = &Membership{}
+	m.GetOrganizationURL()
+	m = nil
+	m.GetOrganizationURL()
 }
 
-func TestInteractionRestriction_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &InteractionRestriction{ExpiresAt: &zeroValue}
-	i.GetExpiresAt()
-	i = &InteractionRestriction{}
-	i.GetExpiresAt()
-	i = nil
-	i.GetExpiresAt()
+func TestMembership_GetRole(tt *testing.T) {
+	var zeroValue string
+	m := &Membership{Role: &zeroValue}
+	m.GetRole()
+	m = &Membership{}
+	m.GetRole()
+	m = nil
+	m.GetRole()
 }
 
-func TestInteractionRestriction_GetLimit(tt *testing.T) {
+func TestMembership_GetState(tt *testing.T) {
 	var zeroValue string
-	i := &InteractionRestriction{Limit: &zeroValue}
-	i.GetLimit()
-	i = &InteractionRestriction{}
-	i.GetLimit()
-	i = nil
-	i.GetLimit()
+	m := &Membership{State: &zeroValue}
+	m.GetState()
+	m = &Membership{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestInteractionRestriction_GetOrigin(tt *testing.T) {
+func TestMembership_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &InteractionRestriction{Origin: &zeroValue}
-	i.GetOrigin()
-	i = &InteractionRestriction{}
-	i.GetOrigin()
-	i = nil
-	i.GetOrigin()
+	m := &Membership{URL: &zeroValue}
+	m.GetURL()
+	m = &Membership{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestInvitation_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Invitation{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Invitation{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestMembership_GetUser(tt *testing.T) {
+	m := &Membership{}
+	m.GetUser()
+	m = nil
+	m.GetUser()
 }
 
-func TestInvitation_GetEmail(tt *testing.T) {
+func TestMembershipEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{Email: &zeroValue}
-	i.GetEmail()
-	i = &Invitation{}
-	i.GetEmail()
-	i = nil
-	i.GetEmail()
+	m := &MembershipEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MembershipEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestInvitation_GetFailedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Invitation{FailedAt: &zeroValue}
-	i.GetFailedAt()
-	i = &Invitation{}
-	i.GetFailedAt()
-	i = nil
-	i.GetFailedAt()
+func TestMembershipEvent_GetInstallation(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestInvitation_GetFailedReason(tt *testing.T) {
-	var zeroValue string
-	i := &Invitation{FailedReason: &zeroValue}
-	i.GetFailedReason()
-	i = &Invitation{}
-	i.GetFailedReason()
-	i = nil
-	i.GetFailedReason()
+func TestMembershipEvent_GetMember(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetMember()
+	m = nil
+	m.GetMember()
 }
 
-func TestInvitation_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Invitation{ID: &zeroValue}
-	i.GetID()
-	i = &Invitation{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestMembershipEvent_GetOrg(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
 }
 
-func TestInvitation_GetInvitationTeamURL(tt *testing.T) {
+func TestMembershipEvent_GetScope(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{InvitationTeamURL: &zeroValue}
-	i.GetInvitationTeamURL()
-	i = &Invitation{}
-	i.GetInvitationTeamURL()
-	i = nil
-	i.GetInvitationTeamURL()
+	m := &MembershipEvent{Scope: &zeroValue}
+	m.GetScope()
+	m = &MembershipEvent{}
+	m.GetScope()
+	m = nil
+	m.GetScope()
 }
 
-func TestInvitation_GetInviter(tt *testing.T) {
-	i := &Invitation{}
-	i.GetInviter()
-	i = nil
-	i.GetInviter()
+func TestMembershipEvent_GetSender(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
 }
 
-func TestInvitation_GetLogin(tt *testing.T) {
-	var zeroValue string
-	i := &Invitation{Login: &zeroValue}
-	i.GetLogin()
-	i = &Invitation{}
-	i.GetLogin()
-	i = nil
-	i.GetLogin()
+func TestMembershipEvent_GetTeam(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetTeam()
+	m = nil
+	m.GetTeam()
 }
 
-func TestInvitation_GetNodeID(tt *testing.T) {
+func TestMergeGroup_GetBaseRef(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Invitation{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+	m := &MergeGroup{BaseRef: &zeroValue}
+	m.GetBaseRef()
+	m = &MergeGroup{}
+	m.GetBaseRef()
+	m = nil
+	m.GetBaseRef()
 }
 
-func TestInvitation_GetRole(tt *testing.T) {
+func TestMergeGroup_GetBaseSHA(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{Role: &zeroValue}
-	i.GetRole()
-	i = &Invitation{}
-	i.GetRole()
-	i = nil
-	i.GetRole()
+	m := &MergeGroup{BaseSHA: &zeroValue}
+	m.GetBaseSHA()
+	m = &MergeGroup{}
+	m.GetBaseSHA()
+	m = nil
+	m.GetBaseSHA()
 }
 
-func TestInvitation_GetTeamCount(tt *testing.T) {
-	var zeroValue int
-	i := &Invitation{TeamCount: &zeroValue}
-	i.GetTeamCount()
-	i = &Invitation{}
-	i.GetTeamCount()
-	i = nil
-	i.GetTeamCount()
+func TestMergeGroup_GetHeadCommit(tt *testing.T) {
+	m := &MergeGroup{}
+	m.GetHeadCommit()
+	m = nil
+	m.GetHeadCommit()
 }
 
-func TestIssue_GetActiveLockReason(tt *testing.T) {
+func TestMergeGroup_GetHeadRef(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{ActiveLockReason: &zeroValue}
-	i.GetActiveLockReason()
-	i = &Issue{}
-	i.GetActiveLockReason()
-	i = nil
-	i.GetActiveLockReason()
-}
-
-func TestIssue_GetAssignee(tt *testing.T) {
-	i := &Issue{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+	m := &MergeGroup{HeadRef: &zeroValue}
+	m.GetHeadRef()
+	m = &MergeGroup{}
+	m.GetHeadRef()
+	m = nil
+	m.GetHeadRef()
 }
 
-func TestIssue_GetAuthorAssociation(tt *testing.T) {
+func TestMergeGroup_GetHeadSHA(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{AuthorAssociation: &zeroValue}
-	i.GetAuthorAssociation()
-	i = &Issue{}
-	i.GetAuthorAssociation()
-	i = nil
-	i.GetAuthorAssociation()
+	m := &MergeGroup{HeadSHA: &zeroValue}
+	m.GetHeadSHA()
+	m = &MergeGroup{}
+	m.GetHeadSHA()
+	m = nil
+	m.GetHeadSHA()
 }
 
-func TestIssue_GetBody(tt *testing.T) {
+func TestMergeGroupEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{Body: &zeroValue}
-	i.GetBody()
-	i = &Issue{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
+	m := &MergeGroupEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MergeGroupEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestIssue_GetClosedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Issue{ClosedAt: &zeroValue}
-	i.GetClosedAt()
-	i = &Issue{}
-	i.GetClosedAt()
-	i = nil
-	i.GetClosedAt()
+func TestMergeGroupEvent_GetInstallation(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestIssue_GetClosedBy(tt *testing.T) {
-	i := &Issue{}
-	i.GetClosedBy()
-	i = nil
-	i.GetClosedBy()
+func TestMergeGroupEvent_GetMergeGroup(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetMergeGroup()
+	m = nil
+	m.GetMergeGroup()
 }
 
-func TestIssue_GetComments(tt *testing.T) {
-	var zeroValue int
-	i := &Issue{Comments: &zeroValue}
-	i.GetComments()
-	i = &Issue{}
-	i.GetComments()
-	i = nil
-	i.GetComments()
+func TestMergeGroupEvent_GetOrg(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
 }
 
-func TestIssue_GetCommentsURL(tt *testing.T) {
+func TestMergeGroupEvent_GetReason(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{CommentsURL: &zeroValue}
-	i.GetCommentsURL()
-	i = &Issue{}
-	i.GetCommentsURL()
-	i = nil
-	i.GetCommentsURL()
+	m := &MergeGroupEvent{Reason: &zeroValue}
+	m.GetReason()
+	m = &MergeGroupEvent{}
+	m.GetReason()
+	m = nil
+	m.GetReason()
 }
 
-func TestIssue_GetCreatedAt(tt *testing.T) {
+func TestMergeGroupEvent_GetRepo(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
+}
+
+func TestMergeGroupEvent_GetSender(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
+}
+
+func TestMergeQueueEntry_GetEnqueuedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	i := &Issue{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Issue{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+	m := &MergeQueueEntry{EnqueuedAt: &zeroValue}
+	m.GetEnqueuedAt()
+	m = &MergeQueueEntry{}
+	m.GetEnqueuedAt()
+	m = nil
+	m.GetEnqueuedAt()
+}
+
+func TestMergeQueueEntry_GetEstimatedTimeToMerge(tt *testing.T) {
+	var zeroValue int
+	m := &MergeQueueEntry{EstimatedTimeToMerge: &zeroValue}
+	m.GetEstimatedTimeToMerge()
+	m = &MergeQueueEntry{}
+	m.GetEstimatedTimeToMerge()
+	m = nil
+	m.GetEstimatedTimeToMerge()
 }
 
-func TestIssue_GetEventsURL(tt *testing.T) {
+func TestMergeQueueEntry_GetID(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{EventsURL: &zeroValue}
-	i.GetEventsURL()
-	i = &Issue{}
-	i.GetEventsURL()
-	i = nil
-	i.GetEventsURL()
+	m := &MergeQueueEntry{ID: &zeroValue}
+	m.GetID()
+	m = &MergeQueueEntry{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestIssue_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Issue{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+func TestMergeQueueEntry_GetPosition(tt *testing.T) {
+	var zeroValue int
+	m := &MergeQueueEntry{Position: &zeroValue}
+	m.GetPosition()
+	m = &MergeQueueEntry{}
+	m.GetPosition()
+	m = nil
+	m.GetPosition()
 }
 
-func TestIssue_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Issue{ID: &zeroValue}
-	i.GetID()
-	i = &Issue{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestMergeQueueEntry_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &MergeQueueEntry{State: &zeroValue}
+	m.GetState()
+	m = &MergeQueueEntry{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestIssue_GetLabelsURL(tt *testing.T) {
+func TestMessage_GetText(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{LabelsURL: &zeroValue}
-	i.GetLabelsURL()
-	i = &Issue{}
-	i.GetLabelsURL()
-	i = nil
-	i.GetLabelsURL()
+	m := &Message{Text: &zeroValue}
+	m.GetText()
+	m = &Message{}
+	m.GetText()
+	m = nil
+	m.GetText()
 }
 
-func TestIssue_GetLocked(tt *testing.T) {
-	var zeroValue bool
-	i := &Issue{Locked: &zeroValue}
-	i.GetLocked()
-	i = &Issue{}
-	i.GetLocked()
-	i = nil
-	i.GetLocked()
+func TestMetaEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MetaEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MetaEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestIssue_GetMilestone(tt *testing.T) {
-	i := &Issue{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+func TestMetaEvent_GetHook(tt *testing.T) {
+	m := &MetaEvent{}
+	m.GetHook()
+	m = nil
+	m.GetHook()
 }
 
-func TestIssue_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Issue{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+func TestMetaEvent_GetHookID(tt *testing.T) {
+	var zeroValue int64
+	m := &MetaEvent{HookID: &zeroValue}
+	m.GetHookID()
+	m = &MetaEvent{}
+	m.GetHookID()
+	m = nil
+	m.GetHookID()
 }
 
-func TestIssue_GetNumber(tt *testing.T) {
-	var zeroValue int
-	i := &Issue{Number: &zeroValue}
-	i.GetNumber()
-	i = &Issue{}
-	i.GetNumber()
-	i = nil
-	i.GetNumber()
+func TestMetaEvent_GetInstallation(tt *testing.T) {
+	m := &MetaEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestIssue_GetPullRequestLinks(tt *testing.T) {
-	i := &Issue{}
-	i.GetPullRequestLinks()
-	i = nil
-	i.GetPullRequestLinks()
+func TestMetaEvent_GetOrg(tt *testing.T) {
+	m := &MetaEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
 }
 
-func TestIssue_GetReactions(tt *testing.T) {
-	i := &Issue{}
-	i.GetReactions()
-	i = nil
-	i.GetReactions()
+func TestMetaEvent_GetRepo(tt *testing.T) {
+	m := &MetaEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
 }
 
-func TestIssue_GetRepository(tt *testing.T) {
-	i := &Issue{}
-	i.GetRepository()
-	i = nil
-	i.GetRepository()
+func TestMetaEvent_GetSender(tt *testing.T) {
+	m := &MetaEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
 }
 
-func TestIssue_GetRepositoryURL(tt *testing.T) {
+func TestMetric_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &Issue{}
-	i.GetRepositoryURL()
-	i = nil
-	i.GetRepositoryURL()
+	m := &Metric{HTMLURL: &zeroValue}
+	m.GetHTMLURL()
+	m = &Metric{}
+	m.GetHTMLURL()
+	m = nil
+	m.GetHTMLURL()
 }
 
-func TestIssue_GetState(tt *testing.T) {
+func TestMetric_GetKey(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{State: &zeroValue}
-	i.GetState()
-	i = &Issue{}
-	i.GetState()
-	i = nil
-	i.GetState()
+	m := &Metric{Key: &zeroValue}
+	m.GetKey()
+	m = &Metric{}
+	m.GetKey()
+	m = nil
+	m.GetKey()
 }
 
-func TestIssue_GetStateReason(tt *testing.T) {
+func TestMetric_GetName(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{StateReason: &zeroValue}
-	i.GetStateReason()
-	i = &Issue{}
-	i.GetStateReason()
-	i = nil
-	i.GetStateReason()
+	m := &Metric{Name: &zeroValue}
+	m.GetName()
+	m = &Metric{}
+	m.GetName()
+	m = nil
+	m.GetName()
 }
 
-func TestIssue_GetTitle(tt *testing.T) {
+func TestMetric_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{Title: &zeroValue}
-	i.GetTitle()
-	i = &Issue{}
-	i.GetTitle()
-	i = nil
-	i.GetTitle()
+	m := &Metric{NodeID: &zeroValue}
+	m.GetNodeID()
+	m = &Metric{}
+	m.GetNodeID()
+	m = nil
+	m.GetNodeID()
 }
 
-func TestIssue_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Issue{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &Issue{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestMetric_GetSPDXID(tt *testing.T) {
+	var zeroValue string
+	m := &Metric{SPDXID: &zeroValue}
+	m.GetSPDXID()
+	m = &Metric{}
+	m.GetSPDXID()
+	m = nil
+	m.GetSPDXID()
 }
 
-func TestIssue_GetURL(tt *testing.T) {
+func TestMetric_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{URL: &zeroValue}
-	i.GetURL()
-	i = &Issue{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+	m := &Metric{URL: &zeroValue}
+	m.GetURL()
+	m = &Metric{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestIssue_GetUser(tt *testing.T) {
-	i := &Issue{}
-	i.GetUser()
-	i = nil
-	i.GetUser()
+func TestMigration_GetArchiveURL(tt *testing.T) {
+	var zeroValue string
+	m := &Migration{ArchiveURL: &zeroValue}
+	m.GetArchiveURL()
+	m = &Migration{}
+	m.GetArchiveURL()
+	m = nil
+	m.GetArchiveURL()
 }
 
-func TestIssueComment_GetAuthorAssociation(tt *testing.T) {
+func TestMigration_GetCreatedAt(tt *testing.T) {
 	var zeroValue string
-	i := &IssueComment{AuthorAssociation: &zeroValue}
-	i.GetAuthorAssociation()
-	i = &IssueComment{}
-	i.GetAuthorAssociation()
-	i = nil
-	i.GetAuthorAssociation()
+	m := &Migration{CreatedAt: &zeroValue}
+	m.GetCreatedAt()
+	m = &Migration{}
+	m.GetCreatedAt()
+	m = nil
+	m.GetCreatedAt()
 }
 
-func TestIssueComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{Body: &zeroValue}
-	i.GetBody()
-	i = &IssueComment{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
+func TestMigration_GetExcludeAttachments(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeAttachments: &zeroValue}
+	m.GetExcludeAttachments()
+	m = &Migration{}
+	m.GetExcludeAttachments()
+	m = nil
+	m.GetExcludeAttachments()
 }
 
-func TestIssueComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &IssueComment{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueComment{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestMigration_GetExcludeGitData(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeGitData: &zeroValue}
+	m.GetExcludeGitData()
+	m = &Migration{}
+	m.GetExcludeGitData()
+	m = nil
+	m.GetExcludeGitData()
 }
 
-func TestIssueComment_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &IssueComment{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+func TestMigration_GetExcludeMetadata(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeMetadata: &zeroValue}
+	m.GetExcludeMetadata()
+	m = &Migration{}
+	m.GetExcludeMetadata()
+	m = nil
+	m.GetExcludeMetadata()
 }
 
-func TestIssueComment_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &IssueComment{ID: &zeroValue}
-	i.GetID()
-	i = &IssueComment{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestMigration_GetExcludeOwnerProjects(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeOwnerProjects: &zeroValue}
+	m.GetExcludeOwnerProjects()
+	m = &Migration{}
+	m.GetExcludeOwnerProjects()
+	m = nil
+	m.GetExcludeOwnerProjects()
 }
 
-func TestIssueComment_GetIssueURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{IssueURL: &zeroValue}
-	i.GetIssueURL()
-	i = &IssueComment{}
-	i.GetIssueURL()
-	i = nil
-	i.GetIssueURL()
+func TestMigration_GetExcludeReleases(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeReleases: &zeroValue}
+	m.GetExcludeReleases()
+	m = &Migration{}
+	m.GetExcludeReleases()
+	m = nil
+	m.GetExcludeReleases()
 }
 
-func TestIssueComment_GetNodeID(tt *testing.T) {
+func TestMigration_GetGUID(tt *testing.T) {
 	var zeroValue string
-	i := &IssueComment{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &IssueComment{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
-}
-
-func TestIssueComment_GetReactions(tt *testing.T) {
-	i := &IssueComment{}
-	i.GetReactions()
-	i = nil
-	i.GetReactions()
+	m := &Migration{GUID: &zeroValue}
+	m.GetGUID()
+	m = &Migration{}
+	m.GetGUID()
+	m = nil
+	m.GetGUID()
 }
 
-func TestIssueComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &IssueComment{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueComment{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestMigration_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &Migration{ID: &zeroValue}
+	m.GetID()
+	m = &Migration{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestIssueComment_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueComment{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+func TestMigration_GetLockRepositories(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{LockRepositories: &zeroValue}
+	m.GetLockRepositories()
+	m = &Migration{}
+	m.GetLockRepositories()
+	m = nil
+	m.GetLockRepositories()
 }
 
-func TestIssueComment_GetUser(tt *testing.T) {
-	i := &IssueComment{}
-	i.GetUser()
-	i = nil
-	i.GetUser()
+func TestMigration_GetOrgMetadataOnly(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{OrgMetadataOnly: &zeroValue}
+	m.GetOrgMetadataOnly()
+	m = &Migration{}
+	m.GetOrgMetadataOnly()
+	m = nil
+	m.GetOrgMetadataOnly()
 }
 
-func TestIssueCommentEvent_GetAction(tt *testing.T) {
+func TestMigration_GetState(tt *testing.T) {
 	var zeroValue string
-	i := &IssueCommentEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &IssueCommentEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+	m := &Migration{State: &zeroValue}
+	m.GetState()
+	m = &Migration{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestIssueCommentEvent_GetChanges(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetChanges()
-	i = nil
-	i.GetChanges()
+func TestMigration_GetUpdatedAt(tt *testing.T) {
+	var zeroValue string
+	m := &Migration{UpdatedAt: &zeroValue}
+	m.GetUpdatedAt()
+	m = &Migration{}
+	m.GetUpdatedAt()
+	m = nil
+	m.GetUpdatedAt()
 }
 
-func TestIssueCommentEvent_GetComment(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetComment()
-	i = nil
-	i.GetComment()
+func TestMigration_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &Migration{URL: &zeroValue}
+	m.GetURL()
+	m = &Migration{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestIssueCommentEvent_GetInstallation(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestMilestone_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &Milestone{ClosedAt: &zeroValue}
+	m.GetClosedAt()
+	m = &Milestone{}
+	m.GetClosedAt()
+	m = nil
+	m.GetClosedAt()
 }
 
-func TestIssueCommentEvent_GetIssue(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetIssue()
-	i = nil
-	i.GetIssue()
+func TestMilestone_GetClosedIssues(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{ClosedIssues: &zeroValue}
+	m.GetClosedIssues()
+	m = &Milestone{}
+	m.GetClosedIssues()
+	m = nil
+	m.GetClosedIssues()
 }
 
-func TestIssueCommentEvent_GetOrganization(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetOrganization()
-	i = nil
-	i.GetOrganization()
+func TestMilestone_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &Milestone{CreatedAt: &zeroValue}
+	m.GetCreatedAt()
+	m = &Milestone{}
+	m.GetCreatedAt()
+	m = nil
+	m.GetCreatedAt()
 }
 
-func TestIssueCommentEvent_GetRepo(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetRepo()
-	i = nil
-	i.GetRepo()
+func TestMilestone_GetCreator(tt *testing.T) {
+	m := &Milestone{}
+	m.GetCreator()
+	m = nil
+	m.GetCreator()
 }
 
-func TestIssueCommentEvent_GetSender(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestMilestone_GetDescription(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{Description: &zeroValue}
+	m.GetDescription()
+	m = &Milestone{}
+	m.GetDescription()
+	m = nil
+	m.GetDescription()
 }
 
-func TestIssueEvent_GetActor(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetActor()
-	i = nil
-	i.GetActor()
+func TestMilestone_GetDueOn(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &Milestone{DueOn: &zeroValue}
+	m.GetDueOn()
+	m = &Milestone{}
+	m.GetDueOn()
+	m = nil
+	m.GetDueOn()
 }
 
-func TestIssueEvent_GetAssignee(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestMilestone_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{HTMLURL: &zeroValue}
+	m.GetHTMLURL()
+	m = &Milestone{}
+	m.GetHTMLURL()
+	m = nil
+	m.GetHTMLURL()
 }
 
-func TestIssueEvent_GetAssigner(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetAssigner()
-	i = nil
-	i.GetAssigner()
+func TestMilestone_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &Milestone{ID: &zeroValue}
+	m.GetID()
+	m = &Milestone{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestIssueEvent_GetCommitID(tt *testing.T) {
+func TestMilestone_GetLabelsURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueEvent{CommitID: &zeroValue}
-	i.GetCommitID()
-	i = &IssueEvent{}
-	i.GetCommitID()
-	i = nil
-	i.GetCommitID()
+	m := &Milestone{LabelsURL: &zeroValue}
+	m.GetLabelsURL()
+	m = &Milestone{}
+	m.GetLabelsURL()
+	m = nil
+	m.GetLabelsURL()
 }
 
-func TestIssueEvent_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &IssueEvent{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueEvent{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestMilestone_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{NodeID: &zeroValue}
+	m.GetNodeID()
+	m = &Milestone{}
+	m.GetNodeID()
+	m = nil
+	m.GetNodeID()
 }
 
-func TestIssueEvent_GetDismissedReview(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetDismissedReview()
-	i = nil
-	i.GetDismissedReview()
+func TestMilestone_GetNumber(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{Number: &zeroValue}
+	m.GetNumber()
+	m = &Milestone{}
+	m.GetNumber()
+	m = nil
+	m.GetNumber()
 }
 
-func TestIssueEvent_GetEvent(tt *testing.T) {
-	var zeroValue string
-	i := &IssueEvent{Event: &zeroValue}
-	i.GetEvent()
-	i = &IssueEvent{}
-	i.GetEvent()
-	i = nil
-	i.GetEvent()
+func TestMilestone_GetOpenIssues(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{OpenIssues: &zeroValue}
+	m.GetOpenIssues()
+	m = &Milestone{}
+	m.GetOpenIssues()
+	m = nil
+	m.GetOpenIssues()
 }
 
-func TestIssueEvent_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &IssueEvent{ID: &zeroValue}
-	i.GetID()
-	i = &IssueEvent{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestMilestone_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{State: &zeroValue}
+	m.GetState()
+	m = &Milestone{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestIssueEvent_GetIssue(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetIssue()
-	i = nil
-	i.GetIssue()
+func TestMilestone_GetTitle(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{Title: &zeroValue}
+	m.GetTitle()
+	m = &Milestone{}
+	m.GetTitle()
+	m = nil
+	m.GetTitle()
 }
 
-func TestIssueEvent_GetLabel(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetLabel()
-	i = nil
-	i.GetLabel()
+func TestMilestone_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &Milestone{UpdatedAt: &zeroValue}
+	m.GetUpdatedAt()
+	m = &Milestone{}
+	m.GetUpdatedAt()
+	m = nil
+	m.GetUpdatedAt()
 }
 
-func TestIssueEvent_GetLockReason(tt *testing.T) {
+func TestMilestone_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueEvent{LockReason: &zeroValue}
-	i.GetLockReason()
-	i = &IssueEvent{}
-	i.GetLockReason()
-	i = nil
-	i.GetLockReason()
+	m := &Milestone{URL: &zeroValue}
+	m.GetURL()
+	m = &Milestone{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestIssueEvent_GetMilestone(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+func TestMilestoneEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MilestoneEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MilestoneEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestIssueEvent_GetProjectCard(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetProjectCard()
-	i = nil
-	i.GetProjectCard()
+func TestMilestoneEvent_GetChanges(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetChanges()
+	m = nil
+	m.GetChanges()
 }
 
-func TestIssueEvent_GetRename(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetRename()
-	i = nil
-	i.GetRename()
+func TestMilestoneEvent_GetInstallation(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestIssueEvent_GetRequestedReviewer(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetRequestedReviewer()
-	i = nil
-	i.GetRequestedReviewer()
+func TestMilestoneEvent_GetMilestone(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetMilestone()
+	m = nil
+	m.GetMilestone()
 }
 
-func TestIssueEvent_GetReviewRequester(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetReviewRequester()
-	i = nil
-	i.GetReviewRequester()
+func TestMilestoneEvent_GetOrg(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
 }
 
-func TestIssueEvent_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueEvent{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueEvent{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+func TestMilestoneEvent_GetRepo(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
 }
 
-func TestIssueImport_GetAssignee(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImport{Assignee: &zeroValue}
-	i.GetAssignee()
-	i = &IssueImport{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestMilestoneEvent_GetSender(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
 }
 
-func TestIssueImport_GetClosed(tt *testing.T) {
-	var zeroValue bool
-	i := &IssueImport{Closed: &zeroValue}
-	i.GetClosed()
-	i = &IssueImport{}
-	i.GetClosed()
-	i = nil
-	i.GetClosed()
+func TestMilestoneProgress_GetMilestone(tt *testing.T) {
+	m := &MilestoneProgress{}
+	m.GetMilestone()
+	m = nil
+	m.GetMilestone()
 }
 
-func TestIssueImport_GetClosedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &IssueImport{ClosedAt: &zeroValue}
-	i.GetClosedAt()
-	i = &IssueImport{}
-	i.GetClosedAt()
-	i = nil
-	i.GetClosedAt()
+func TestMilestoneStats_GetClosedMilestones(tt *testing.T) {
+	var zeroValue int
+	m := &MilestoneStats{ClosedMilestones: &zeroValue}
+	m.GetClosedMilestones()
+	m = &MilestoneStats{}
+	m.GetClosedMilestones()
+	m = nil
+	m.GetClosedMilestones()
 }
 
-func TestIssueImport_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &IssueImport{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueImport{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestMilestoneStats_GetOpenMilestones(tt *testing.T) {
+	var zeroValue int
+	m := &MilestoneStats{OpenMilestones: &zeroValue}
+	m.GetOpenMilestones()
+	m = &MilestoneStats{}
+	m.GetOpenMilestones()
+	m = nil
+	m.GetOpenMilestones()
 }
 
-func TestIssueImport_GetMilestone(tt *testing.T) {
+func TestMilestoneStats_GetTotalMilestones(tt *testing.T) {
 	var zeroValue int
-	i := &IssueImport{Milestone: &zeroValue}
-	i.GetMilestone()
-	i = &IssueImport{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+	m := &MilestoneStats{TotalMilestones: &zeroValue}
+	m.GetTotalMilestones()
+	m = &MilestoneStats{}
+	m.GetTotalMilestones()
+	m = nil
+	m.GetTotalMilestones()
 }
 
-func TestIssueImport_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &IssueImport{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueImport{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestMostRecentInstance_GetAnalysisKey(tt *testing.T) {
+	var zeroValue string
+	m := &MostRecentInstance{AnalysisKey: &zeroValue}
+	m.GetAnalysisKey()
+	m = &MostRecentInstance{}
+	m.GetAnalysisKey()
+	m = nil
+	m.GetAnalysisKey()
 }
 
-func TestIssueImportError_GetCode(tt *testing.T) {
+func TestMostRecentInstance_GetCommitSHA(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Code: &zeroValue}
-	i.GetCode()
-	i = &IssueImportError{}
-	i.GetCode()
-	i = nil
-	i.GetCode()
+	m := &MostRecentInstance{CommitSHA: &zeroValue}
+	m.GetCommitSHA()
+	m = &MostRecentInstance{}
+	m.GetCommitSHA()
+	m = nil
+	m.GetCommitSHA()
 }
 
-func TestIssueImportError_GetField(tt *testing.T) {
+func TestMostRecentInstance_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Field: &zeroValue}
-	i.GetField()
-	i = &IssueImportError{}
-	i.GetField()
-	i = nil
-	i.GetField()
+	m := &MostRecentInstance{Environment: &zeroValue}
+	m.GetEnvironment()
+	m = &MostRecentInstance{}
+	m.GetEnvironment()
+	m = nil
+	m.GetEnvironment()
 }
 
-func TestIssueImportError_GetLocation(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportError{Location: &zeroValue}
-	i.GetLocation()
-	i = &IssueImportError{}
-	i.GetLocation()
-	i = nil
-	i.GetLocation()
+func TestMostRecentInstance_GetLocation(tt *testing.T) {
+	m := &MostRecentInstance{}
+	m.GetLocation()
+	m = nil
+	m.GetLocation()
 }
 
-func TestIssueImportError_GetResource(tt *testing.T) {
+func TestMostRecentInstance_GetMessage(tt *testing.T) {
+	m := &MostRecentInstance{}
+	m.GetMessage()
+	m = nil
+	m.GetMessage()
+}
+
+func TestMostRecentInstance_GetRef(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Resource: &zeroValue}
-	i.GetResource()
-	i = &IssueImportError{}
-	i.GetResource()
-	i = nil
-	i.GetResource()
+	m := &MostRecentInstance{Ref: &zeroValue}
+	m.GetRef()
+	m = &MostRecentInstance{}
+	m.GetRef()
+	m = nil
+	m.GetRef()
 }
 
-func TestIssueImportError_GetValue(tt *testing.T) {
+func TestMostRecentInstance_GetState(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Value: &zeroValue}
-	i.GetValue()
-	i = &IssueImportError{}
-	i.GetValue()
-	i = nil
-	i.GetValue()
+	m := &MostRecentInstance{State: &zeroValue}
+	m.GetState()
+	m = &MostRecentInstance{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestIssueImportResponse_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &IssueImportResponse{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueImportResponse{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestNewPullRequest_GetBase(tt *testing.T) {
+	var zeroValue string
+	n := &NewPullRequest{Base: &zeroValue}
+	n.GetBase()
+	n = &NewPullRequest{}
+	n.GetBase()
+	n = nil
+	n.GetBase()
 }
 
-func TestIssueImportResponse_GetDocumentationURL(tt *testing.T) {
+func TestNewPullRequest_GetBody(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{DocumentationURL: &zeroValue}
-	i.GetDocumentationURL()
-	i = &IssueImportResponse{}
-	i.GetDocumentationURL()
-	i = nil
-	i.GetDocumentationURL()
+	n := &NewPullRequest{Body: &zeroValue}
+	n.GetBody()
+	n = &NewPullRequest{}
+	n.GetBody()
+	n = nil
+	n.GetBody()
 }
 
-func TestIssueImportResponse_GetID(tt *testing.T) {
-	var zeroValue int
-	i := &IssueImportResponse{ID: &zeroValue}
-	i.GetID()
-	i = &IssueImportResponse{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestNewPullRequest_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	n := &NewPullRequest{Draft: &zeroValue}
+	n.GetDraft()
+	n = &NewPullRequest{}
+	n.GetDraft()
+	n = nil
+	n.GetDraft()
 }
 
-func TestIssueImportResponse_GetImportIssuesURL(tt *testing.T) {
+func TestNewPullRequest_GetHead(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{ImportIssuesURL: &zeroValue}
-	i.GetImportIssuesURL()
-	i = &IssueImportResponse{}
-	i.GetImportIssuesURL()
-	i = nil
-	i.GetImportIssuesURL()
+	n := &NewPullRequest{Head: &zeroValue}
+	n.GetHead()
+	n = &NewPullRequest{}
+	n.GetHead()
+	n = nil
+	n.GetHead()
 }
 
-func TestIssueImportResponse_GetMessage(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportResponse{Message: &zeroValue}
-	i.GetMessage()
-	i = &IssueImportResponse{}
-	i.GetMessage()
-	i = nil
-	i.GetMessage()
+func TestNewPullRequest_GetIssue(tt *testing.T) {
+	var zeroValue int
+	n := &NewPullRequest{Issue: &zeroValue}
+	n.GetIssue()
+	n = &NewPullRequest{}
+	n.GetIssue()
+	n = nil
+	n.GetIssue()
 }
 
-func TestIssueImportResponse_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportResponse{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &IssueImportResponse{}
-	i.GetRepositoryURL()
-	i = nil
-	i.GetRepositoryURL()
+func TestNewPullRequest_GetMaintainerCanModify(tt *testing.T) {
+	var zeroValue bool
+	n := &NewPullRequest{MaintainerCanModify: &zeroValue}
+	n.GetMaintainerCanModify()
+	n = &NewPullRequest{}
+	n.GetMaintainerCanModify()
+	n = nil
+	n.GetMaintainerCanModify()
 }
 
-func TestIssueImportResponse_GetStatus(tt *testing.T) {
+func TestNewPullRequest_GetTitle(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{Status: &zeroValue}
-	i.GetStatus()
-	i = &IssueImportResponse{}
-	i.GetStatus()
-	i = nil
-	i.GetStatus()
+	n := &NewPullRequest{Title: &zeroValue}
+	n.GetTitle()
+	n = &NewPullRequest{}
+	n.GetTitle()
+	n = nil
+	n.GetTitle()
 }
 
-func TestIssueImportResponse_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &IssueImportResponse{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueImportResponse{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestNewTeam_GetDescription(tt *testing.T) {
+	var zeroValue string
+	n := &NewTeam{Description: &zeroValue}
+	n.GetDescription()
+	n = &NewTeam{}
+	n.GetDescription()
+	n = nil
+	n.GetDescription()
 }
 
-func TestIssueImportResponse_GetURL(tt *testing.T) {
+func TestNewTeam_GetLDAPDN(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueImportResponse{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+	n := &NewTeam{LDAPDN: &zeroValue}
+	n.GetLDAPDN()
+	n = &NewTeam{}
+	n.GetLDAPDN()
+	n = nil
+	n.GetLDAPDN()
 }
 
-func TestIssueListCommentsOptions_GetDirection(tt *testing.T) {
-	var zeroValue string
-	i := &IssueListCommentsOptions{Direction: &zeroValue}
-	i.GetDirection()
-	i = &IssueListCommentsOptions{}
-	i.GetDirection()
-	i = nil
-	i.GetDirection()
+func TestNewTeam_GetParentTeamID(tt *testing.T) {
+	var zeroValue int64
+	n := &NewTeam{ParentTeamID: &zeroValue}
+	n.GetParentTeamID()
+	n = &NewTeam{}
+	n.GetParentTeamID()
+	n = nil
+	n.GetParentTeamID()
 }
 
-func TestIssueListCommentsOptions_GetSince(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueListCommentsOptions{Since: &zeroValue}
-	i.GetSince()
-	i = &IssueListCommentsOptions{}
-	i.GetSince()
-	i = nil
-	i.GetSince()
+func TestNewTeam_GetPermission(tt *testing.T) {
+	var zeroValue string
+	n := &NewTeam{Permission: &zeroValue}
+	n.GetPermission()
+	n = &NewTeam{}
+	n.GetPermission()
+	n = nil
+	n.GetPermission()
 }
 
-func TestIssueListCommentsOptions_GetSort(tt *testing.T) {
+func TestNewTeam_GetPrivacy(tt *testing.T) {
 	var zeroValue string
-	i := &IssueListCommentsOptions{Sort: &zeroValue}
-	i.GetSort()
-	i = &IssueListCommentsOptions{}
-	i.GetSort()
-	i = nil
-	i.GetSort()
+	n := &NewTeam{Privacy: &zeroValue}
+	n.GetPrivacy()
+	n = &NewTeam{}
+	n.GetPrivacy()
+	n = nil
+	n.GetPrivacy()
 }
 
-func TestIssueRequest_GetAssignee(tt *testing.T) {
+func TestNotification_GetID(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{Assignee: &zeroValue}
-	i.GetAssignee()
-	i = &IssueRequest{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+	n := &Notification{ID: &zeroValue}
+	n.GetID()
+	n = &Notification{}
+	n.GetID()
+	n = nil
+	n.GetID()
 }
 
-func TestIssueRequest_GetAssignees(tt *testing.T) {
-	var zeroValue []string
-	i := &IssueRequest{Assignees: &zeroValue}
-	i.GetAssignees()
-	i = &IssueRequest{}
-	i.GetAssignees()
-	i = nil
-	i.GetAssignees()
+func TestNotification_GetLastReadAt(tt *testing.T) {
+	var zeroValue Timestamp
+	n := &Notification{LastReadAt: &zeroValue}
+	n.GetLastReadAt()
+	n = &Notification{}
+	n.GetLastReadAt()
+	n = nil
+	n.GetLastReadAt()
 }
 
-func TestIssueRequest_GetBody(tt *testing.T) {
+func TestNotification_GetReason(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{Body: &zeroValue}
-	i.GetBody()
-	i = &IssueRequest{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
+	n := &Notification{Reason: &zeroValue}
+	n.GetReason()
+	n = &Notification{}
+	n.GetReason()
+	n = nil
+	n.GetReason()
 }
 
-func TestIssueRequest_GetLabels(tt *testing.T) {
-	var zeroValue []string
-	i := &IssueRequest{Labels: &zeroValue}
-	i.GetLabels()
-	i = &IssueRequest{}
-	i.GetLabels()
-	i = nil
-	i.GetLabels()
+func TestNotification_GetRepository(tt *testing.T) {
+	n := &Notification{}
+	n.GetRepository()
+	n = nil
+	n.GetRepository()
 }
 
-func TestIssueRequest_GetMilestone(tt *testing.T) {
-	var zeroValue int
-	i := &IssueRequest{Milestone: &zeroValue}
-	i.GetMilestone()
-	i = &IssueRequest{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+func TestNotification_GetSubject(tt *testing.T) {
+	n := &Notification{}
+	n.GetSubject()
+	n = nil
+	n.GetSubject()
 }
 
-func TestIssueRequest_GetState(tt *testing.T) {
-	var zeroValue string
-	i := &IssueRequest{State: &zeroValue}
-	i.GetState()
-	i = &IssueRequest{}
-	i.GetState()
-	i = nil
-	i.GetState()
+func TestNotification_GetUnread(tt *testing.T) {
+	var zeroValue bool
+	n := &Notification{Unread: &zeroValue}
+	n.GetUnread()
+	n = &Notification{}
+	n.GetUnread()
+	n = nil
+	n.GetUnread()
 }
 
-func TestIssueRequest_GetStateReason(tt *testing.T) {
-	var zeroValue string
-	i := &IssueRequest{StateReason: &zeroValue}
-	i.GetStateReason()
-	i = &IssueRequest{}
-	i.GetStateReason()
-	i = nil
-	i.GetStateReason()
+func TestNotification_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	n := &Notification{UpdatedAt: &zeroValue}
+	n.GetUpdatedAt()
+	n = &Notification{}
+	n.GetUpdatedAt()
+	n = nil
+	n.GetUpdatedAt()
 }
 
-func TestIssueRequest_GetTitle(tt *testing.T) {
+func TestNotification_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{Title: &zeroValue}
-	i.GetTitle()
-	i = &IssueRequest{}
-	i.GetTitle()
-	i = nil
-	i.GetTitle()
+	n := &Notification{URL: &zeroValue}
+	n.GetURL()
+	n = &Notification{}
+	n.GetURL()
+	n = nil
+	n.GetURL()
 }
 
-func TestIssuesEvent_GetAction(tt *testing.T) {
+func TestNotificationSubject_GetLatestCommentURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssuesEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &IssuesEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
-}
-
-func TestIssuesEvent_GetAssignee(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
-}
-
-func TestIssuesEvent_GetChanges(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetChanges()
-	i = nil
-	i.GetChanges()
+	n := &NotificationSubject{LatestCommentURL: &zeroValue}
+	n.GetLatestCommentURL()
+	n = &NotificationSubject{}
+	n.GetLatestCommentURL()
+	n = nil
+	n.GetLatestCommentURL()
 }
 
-func TestIssuesEvent_GetInstallation(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestNotificationSubject_GetTitle(tt *testing.T) {
+	var zeroValue string
+	n := &NotificationSubject{Title: &zeroValue}
+	n.GetTitle()
+	n = &NotificationSubject{}
+	n.GetTitle()
+	n = nil
+	n.GetTitle()
 }
 
-func TestIssuesEvent_GetIssue(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetIssue()
-	i = nil
-	i.GetIssue()
+func TestNotificationSubject_GetType(tt *testing.T) {
+	var zeroValue string
+	n := &NotificationSubject{Type: &zeroValue}
+	n.GetType()
+	n = &NotificationSubject{}
+	n.GetType()
+	n = nil
+	n.GetType()
 }
 
-func TestIssuesEvent_GetLabel(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetLabel()
-	i = nil
-	i.GetLabel()
+func TestNotificationSubject_GetURL(tt *testing.T) {
+	var zeroValue string
+	n := &NotificationSubject{URL: &zeroValue}
+	n.GetURL()
+	n = &NotificationSubject{}
+	n.GetURL()
+	n = nil
+	n.GetURL()
 }
 
-func TestIssuesEvent_GetMilestone(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+func TestOAuthAPP_GetClientID(tt *testing.T) {
+	var zeroValue string
+	o := &OAuthAPP{ClientID: &zeroValue}
+	o.GetClientID()
+	o = &OAuthAPP{}
+	o.GetClientID()
+	o = nil
+	o.GetClientID()
 }
 
-func TestIssuesEvent_GetRepo(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetRepo()
-	i = nil
-	i.GetRepo()
+func TestOAuthAPP_GetName(tt *testing.T) {
+	var zeroValue string
+	o := &OAuthAPP{Name: &zeroValue}
+	o.GetName()
+	o = &OAuthAPP{}
+	o.GetName()
+	o = nil
+	o.GetName()
 }
 
-func TestIssuesEvent_GetSender(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestOAuthAPP_GetURL(tt *testing.T) {
+	var zeroValue string
+	o := &OAuthAPP{URL: &zeroValue}
+	o.GetURL()
+	o = &OAuthAPP{}
+	o.GetURL()
+	o = nil
+	o.GetURL()
 }
 
-func TestIssuesSearchResult_GetIncompleteResults(tt *testing.T) {
+func TestOIDCSubjectClaimCustomTemplate_GetUseDefault(tt *testing.T) {
 	var zeroValue bool
-	i := &IssuesSearchResult{IncompleteResults: &zeroValue}
-	i.GetIncompleteResults()
-	i = &IssuesSearchResult{}
-	i.GetIncompleteResults()
-	i = nil
-	i.GetIncompleteResults()
+	o := &OIDCSubjectClaimCustomTemplate{UseDefault: &zeroValue}
+	o.GetUseDefault()
+	o = &OIDCSubjectClaimCustomTemplate{}
+	o.GetUseDefault()
+	o = nil
+	o.GetUseDefault()
 }
 
-func TestIssuesSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	i := &IssuesSearchResult{Total: &zeroValue}
-	i.GetTotal()
-	i = &IssuesSearchResult{}
-	i.GetTotal()
-	i = nil
-	i.GetTotal()
+func TestOrganization_GetAdvancedSecurityEnabledForNewRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{AdvancedSecurityEnabledForNewRepos: &zeroValue}
+	o.GetAdvancedSecurityEnabledForNewRepos()
+	o = &Organization{}
+	o.GetAdvancedSecurityEnabledForNewRepos()
+	o = nil
+	o.GetAdvancedSecurityEnabledForNewRepos()
 }
 
-func TestIssueStats_GetClosedIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{ClosedIssues: &zeroValue}
-	i.GetClosedIssues()
-	i = &IssueStats{}
-	i.GetClosedIssues()
-	i = nil
-	i.GetClosedIssues()
+func TestOrganization_GetAvatarURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{AvatarURL: &zeroValue}
+	o.GetAvatarURL()
+	o = &Organization{}
+	o.GetAvatarURL()
+	o = nil
+	o.GetAvatarURL()
 }
 
-func TestIssueStats_GetOpenIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{OpenIssues: &zeroValue}
-	i.GetOpenIssues()
-	i = &IssueStats{}
-	i.GetOpenIssues()
-	i = nil
-	i.GetOpenIssues()
+func TestOrganization_GetBillingEmail(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{BillingEmail: &zeroValue}
+	o.GetBillingEmail()
+	o = &Organization{}
+	o.GetBillingEmail()
+	o = nil
+	o.GetBillingEmail()
 }
 
-func TestIssueStats_GetTotalIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{TotalIssues: &zeroValue}
-	i.GetTotalIssues()
-	i = &IssueStats{}
-	i.GetTotalIssues()
-	i = nil
-	i.GetTotalIssues()
+func TestOrganization_GetBlog(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Blog: &zeroValue}
+	o.GetBlog()
+	o = &Organization{}
+	o.GetBlog()
+	o = nil
+	o.GetBlog()
 }
 
-func TestJobs_GetTotalCount(tt *testing.T) {
+func TestOrganization_GetCollaborators(tt *testing.T) {
 	var zeroValue int
-	j := &Jobs{TotalCount: &zeroValue}
-	j.GetTotalCount()
-	j = &Jobs{}
-	j.GetTotalCount()
-	j = nil
-	j.GetTotalCount()
+	o := &Organization{Collaborators: &zeroValue}
+	o.GetCollaborators()
+	o = &Organization{}
+	o.GetCollaborators()
+	o = nil
+	o.GetCollaborators()
 }
 
-func TestKey_GetAddedBy(tt *testing.T) {
+func TestOrganization_GetCompany(tt *testing.T) {
 	var zeroValue string
-	k := &Key{AddedBy: &zeroValue}
-	k.GetAddedBy()
-	k = &Key{}
-	k.GetAddedBy()
-	k = nil
-	k.GetAddedBy()
+	o := &Organization{Company: &zeroValue}
+	o.GetCompany()
+	o = &Organization{}
+	o.GetCompany()
+	o = nil
+	o.GetCompany()
 }
 
-func TestKey_GetCreatedAt(tt *testing.T) {
+func TestOrganization_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	k := &Key{CreatedAt: &zeroValue}
-	k.GetCreatedAt()
-	k = &Key{}
-	k.GetCreatedAt()
-	k = nil
-	k.GetCreatedAt()
+	o := &Organization{CreatedAt: &zeroValue}
+	o.GetCreatedAt()
+	o = &Organization{}
+	o.GetCreatedAt()
+	o = nil
+	o.GetCreatedAt()
 }
 
-func TestKey_GetID(tt *testing.T) {
-	var zeroValue int64
-	k := &Key{ID: &zeroValue}
-	k.GetID()
-	k = &Key{}
-	k.GetID()
-	k = nil
-	k.GetID()
+func TestOrganization_GetDefaultRepoPermission(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{DefaultRepoPermission: &zeroValue}
+	o.GetDefaultRepoPermission()
+	o = &Organization{}
+	o.GetDefaultRepoPermission()
+	o = nil
+	o.GetDefaultRepoPermission()
 }
 
-func TestKey_GetKey(tt *testing.T) {
+func TestOrganization_GetDefaultRepoSettings(tt *testing.T) {
 	var zeroValue string
-	k := &Key{Key: &zeroValue}
-	k.GetKey()
-	k = &Key{}
-	k.GetKey()
-	k = nil
-	k.GetKey()
+	o := &Organization{DefaultRepoSettings: &zeroValue}
+	o.GetDefaultRepoSettings()
+	o = &Organization{}
+	o.GetDefaultRepoSettings()
+	o = nil
+	o.GetDefaultRepoSettings()
 }
 
-func TestKey_GetLastUsed(tt *testing.T) {
-	var zeroValue Timestamp
-	k := &Key{LastUsed: &zeroValue}
-	k.GetLastUsed()
-	k = &Key{}
-	k.GetLastUsed()
-	k = nil
-	k.GetLastUsed()
+func TestOrganization_GetDependabotAlertsEnabledForNewRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{DependabotAlertsEnabledForNewRepos: &zeroValue}
+	o.GetDependabotAlertsEnabledForNewRepos()
+	o = &Organization{}
+	o.GetDependabotAlertsEnabledForNewRepos()
+	o = nil
+	o.GetDependabotAlertsEnabledForNewRepos()
 }
 
-func TestKey_GetReadOnly(tt *testing.T) {
+func TestOrganization_GetDependabotSecurityUpdatesEnabledForNewRepos(tt *testing.T) {
 	var zeroValue bool
-	k := &Key{ReadOnly: &zeroValue}
-	k.GetReadOnly()
-	k = &Key{}
-	k.GetReadOnly()
-	k = nil
-	k.GetReadOnly()
+	o := &Organization{DependabotSecurityUpdatesEnabledForNewRepos: &zeroValue}
+	o.GetDependabotSecurityUpdatesEnabledForNewRepos()
+	o = &Organization{}
+	o.GetDependabotSecurityUpdatesEnabledForNewRepos()
+	o = nil
+	o.GetDependabotSecurityUpdatesEnabledForNewRepos()
 }
 
-func TestKey_GetTitle(tt *testing.T) {
-	var zeroValue string
-	k := &Key{Title: &zeroValue}
-	k.GetTitle()
-	k = &Key{}
-	k.GetTitle()
-	k = nil
-	k.GetTitle()
+func TestOrganization_GetDependencyGraphEnabledForNewRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{DependencyGraphEnabledForNewRepos: &zeroValue}
+	o.GetDependencyGraphEnabledForNewRepos()
+	o = &Organization{}
+	o.GetDependencyGraphEnabledForNewRepos()
+	o = nil
+	o.GetDependencyGraphEnabledForNewRepos()
 }
 
-func TestKey_GetURL(tt *testing.T) {
+func TestOrganization_GetDescription(tt *testing.T) {
 	var zeroValue string
-	k := &Key{URL: &zeroValue}
-	k.GetURL()
-	k = &Key{}
-	k.GetURL()
-	k = nil
-	k.GetURL()
+	o := &Organization{Description: &zeroValue}
+	o.GetDescription()
+	o = &Organization{}
+	o.GetDescription()
+	o = nil
+	o.GetDescription()
 }
 
-func TestKey_GetVerified(tt *testing.T) {
-	var zeroValue bool
-	k := &Key{Verified: &zeroValue}
-	k.GetVerified()
-	k = &Key{}
-	k.GetVerified()
-	k = nil
-	k.GetVerified()
+func TestOrganization_GetDiskUsage(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{DiskUsage: &zeroValue}
+	o.GetDiskUsage()
+	o = &Organization{}
+	o.GetDiskUsage()
+	o = nil
+	o.GetDiskUsage()
 }
 
-func TestLabel_GetColor(tt *testing.T) {
+func TestOrganization_GetEmail(tt *testing.T) {
 	var zeroValue string
-	l := &Label{Color: &zeroValue}
-	l.GetColor()
-	l = &Label{}
-	l.GetColor()
-	l = nil
-	l.GetColor()
+	o := &Organization{Email: &zeroValue}
+	o.GetEmail()
+	o = &Organization{}
+	o.GetEmail()
+	o = nil
+	o.GetEmail()
 }
 
-func TestLabel_GetDefault(tt *testing.T) {
-	var zeroValue bool
-	l := &Label{Default: &zeroValue}
-	l.GetDefault()
-	l = &Label{}
-	l.GetDefault()
-	l = nil
-	l.GetDefault()
+func TestOrganization_GetEventsURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{EventsURL: &zeroValue}
+	o.GetEventsURL()
+	o = &Organization{}
+	o.GetEventsURL()
+	o = nil
+	o.GetEventsURL()
 }
 
-func TestLabel_GetDescription(tt *testing.T) {
-	var zeroValue string
-	l := &Label{Description: &zeroValue}
-	l.GetDescription()
-	l = &Label{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+func TestOrganization_GetFollowers(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{Followers: &zeroValue}
+	o.GetFollowers()
+	o = &Organization{}
+	o.GetFollowers()
+	o = nil
+	o.GetFollowers()
 }
 
-func TestLabel_GetID(tt *testing.T) {
-	var zeroValue int64
-	l := &Label{ID: &zeroValue}
-	l.GetID()
-	l = &Label{}
-	l.GetID()
-	l = nil
-	l.GetID()
+func TestOrganization_GetFollowing(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{Following: &zeroValue}
+	o.GetFollowing()
+	o = &Organization{}
+	o.GetFollowing()
+	o = nil
+	o.GetFollowing()
 }
 
-func TestLabel_GetName(tt *testing.T) {
-	var zeroValue string
-	l := &Label{Name: &zeroValue}
-	l.GetName()
-	l = &Label{}
-	l.GetName()
-	l = nil
-	l.GetName()
+func TestOrganization_GetHasOrganizationProjects(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{HasOrganizationProjects: &zeroValue}
+	o.GetHasOrganizationProjects()
+	o = &Organization{}
+	o.GetHasOrganizationProjects()
+	o = nil
+	o.GetHasOrganizationProjects()
 }
 
-func TestLabel_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	l := &Label{NodeID: &zeroValue}
-	l.GetNodeID()
-	l = &Label{}
-	l.GetNodeID()
-	l = nil
-	l.GetNodeID()
+func TestOrganization_GetHasRepositoryProjects(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{HasRepositoryProjects: &zeroValue}
+	o.GetHasRepositoryProjects()
+	o = &Organization{}
+	o.GetHasRepositoryProjects()
+	o = nil
+	o.GetHasRepositoryProjects()
 }
 
-func TestLabel_GetURL(tt *testing.T) {
+func TestOrganization_GetHooksURL(tt *testing.T) {
 	var zeroValue string
-	l := &Label{URL: &zeroValue}
-	l.GetURL()
-	l = &Label{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+	o := &Organization{HooksURL: &zeroValue}
+	o.GetHooksURL()
+	o = &Organization{}
+	o.GetHooksURL()
+	o = nil
+	o.GetHooksURL()
 }
 
-func TestLabelEvent_GetAction(tt *testing.T) {
+func TestOrganization_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	l := &LabelEvent{Action: &zeroValue}
-	l.GetAction()
-	l = &LabelEvent{}
-	l.GetAction()
-	l = nil
-	l.GetAction()
+	o := &Organization{HTMLURL: &zeroValue}
+	o.GetHTMLURL()
+	o = &Organization{}
+	o.GetHTMLURL()
+	o = nil
+	o.GetHTMLURL()
 }
 
-func TestLabelEvent_GetChanges(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetChanges()
-	l = nil
-	l.GetChanges()
+func TestOrganization_GetID(tt *testing.T) {
+	var zeroValue int64
+	o := &Organization{ID: &zeroValue}
+	o.GetID()
+	o = &Organization{}
+	o.GetID()
+	o = nil
+	o.GetID()
 }
 
-func TestLabelEvent_GetInstallation(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetInstallation()
-	l = nil
-	l.GetInstallation()
+func TestOrganization_GetIssuesURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{IssuesURL: &zeroValue}
+	o.GetIssuesURL()
+	o = &Organization{}
+	o.GetIssuesURL()
+	o = nil
+	o.GetIssuesURL()
 }
 
-func TestLabelEvent_GetLabel(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetLabel()
-	l = nil
-	l.GetLabel()
+func TestOrganization_GetIsVerified(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{IsVerified: &zeroValue}
+	o.GetIsVerified()
+	o = &Organization{}
+	o.GetIsVerified()
+	o = nil
+	o.GetIsVerified()
 }
 
-func TestLabelEvent_GetOrg(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetOrg()
-	l = nil
-	l.GetOrg()
+func TestOrganization_GetLocation(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Location: &zeroValue}
+	o.GetLocation()
+	o = &Organization{}
+	o.GetLocation()
+	o = nil
+	o.GetLocation()
 }
 
-func TestLabelEvent_GetRepo(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetRepo()
-	l = nil
-	l.GetRepo()
+func TestOrganization_GetLogin(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Login: &zeroValue}
+	o.GetLogin()
+	o = &Organization{}
+	o.GetLogin()
+	o = nil
+	o.GetLogin()
 }
 
-func TestLabelEvent_GetSender(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetSender()
-	l = nil
-	l.GetSender()
+func TestOrganization_GetMembersAllowedRepositoryCreationType(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{MembersAllowedRepositoryCreationType: &zeroValue}
+	o.GetMembersAllowedRepositoryCreationType()
+	o = &Organization{}
+	o.GetMembersAllowedRepositoryCreationType()
+	o = nil
+	o.GetMembersAllowedRepositoryCreationType()
 }
 
-func TestLabelResult_GetColor(tt *testing.T) {
-	var zeroValue string
-	l := &LabelResult{Color: &zeroValue}
-	l.GetColor()
-	l = &LabelResult{}
-	l.GetColor()
-	l = nil
-	l.GetColor()
+func TestOrganization_GetMembersCanCreateInternalRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreateInternalRepos: &zeroValue}
+	o.GetMembersCanCreateInternalRepos()
+	o = &Organization{}
+	o.GetMembersCanCreateInternalRepos()
+	o = nil
+	o.GetMembersCanCreateInternalRepos()
 }
 
-func TestLabelResult_GetDefault(tt *testing.T) {
+func TestOrganization_GetMembersCanCreatePages(tt *testing.T) {
 	var zeroValue bool
-	l := &LabelResult{Default: &zeroValue}
-	l.GetDefault()
-	l = &LabelResult{}
-	l.GetDefault()
-	l = nil
-	l.GetDefault()
+	o := &Organization{MembersCanCreatePages: &zeroValue}
+	o.GetMembersCanCreatePages()
+	o = &Organization{}
+	o.GetMembersCanCreatePages()
+	o = nil
+	o.GetMembersCanCreatePages()
 }
 
-func TestLabelResult_GetDescription(tt *testing.T) {
-	var zeroValue string
-	l := &LabelResult{Description: &zeroValue}
-	l.GetDescription()
-	l = &LabelResult{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+func TestOrganization_GetMembersCanCreatePrivatePages(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreatePrivatePages: &zeroValue}
+	o.GetMembersCanCreatePrivatePages()
+	o = &Organization{}
+	o.GetMembersCanCreatePrivatePages()
+	o = nil
+	o.GetMembersCanCreatePrivatePages()
 }
 
-func TestLabelResult_GetID(tt *testing.T) {
-	var zeroValue int64
-	l := &LabelResult{ID: &zeroValue}
-	l.GetID()
-	l = &LabelResult{}
-	l.GetID()
-	l = nil
-	l.GetID()
+func TestOrganization_GetMembersCanCreatePrivateRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreatePrivateRepos: &zeroValue}
+	o.GetMembersCanCreatePrivateRepos()
+	o = &Organization{}
+	o.GetMembersCanCreatePrivateRepos()
+	o = nil
+	o.GetMembersCanCreatePrivateRepos()
 }
 
-func TestLabelResult_GetName(tt *testing.T) {
-	var zeroValue string
-	l := &LabelResult{Name: &zeroValue}
-	l.GetName()
-	l = &LabelResult{}
-	l.GetName()
-	l = nil
-	l.GetName()
+func TestOrganization_GetMembersCanCreatePublicPages(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreatePublicPages: &zeroValue}
+	o.GetMembersCanCreatePublicPages()
+	o = &Organization{}
+	o.GetMembersCanCreatePublicPages()
+	o = nil
+	o.GetMembersCanCreatePublicPages()
 }
 
-func TestLabelResult_GetScore(tt *testing.T) {
-	l := &LabelResult{}
-	l.GetScore()
-	l = nil
-	l.GetScore()
+func TestOrganization_GetMembersCanCreatePublicRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreatePublicRepos: &zeroValue}
+	o.GetMembersCanCreatePublicRepos()
+	o = &Organization{}
+	o.GetMembersCanCreatePublicRepos()
+	o = nil
+	o.GetMembersCanCreatePublicRepos()
 }
 
-func TestLabelResult_GetURL(tt *testing.T) {
-	var zeroValue string
-	l := &LabelResult{URL: &zeroValue}
-	l.GetURL()
-	l = &LabelResult{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+func TestOrganization_GetMembersCanCreateRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreateRepos: &zeroValue}
+	o.GetMembersCanCreateRepos()
+	o = &Organization{}
+	o.GetMembersCanCreateRepos()
+	o = nil
+	o.GetMembersCanCreateRepos()
 }
 
-func TestLabelsSearchResult_GetIncompleteResults(tt *testing.T) {
+func TestOrganization_GetMembersCanForkPrivateRepos(tt *testing.T) {
 	var zeroValue bool
-	l := &LabelsSearchResult{IncompleteResults: &zeroValue}
-	l.GetIncompleteResults()
-	l = &LabelsSearchResult{}
-	l.GetIncompleteResults()
-	l = nil
-	l.GetIncompleteResults()
+	o := &Organization{MembersCanForkPrivateRepos: &zeroValue}
+	o.GetMembersCanForkPrivateRepos()
+	o = &Organization{}
+	o.GetMembersCanForkPrivateRepos()
+	o = nil
+	o.GetMembersCanForkPrivateRepos()
 }
 
-func TestLabelsSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &LabelsSearchResult{Total: &zeroValue}
-	l.GetTotal()
-	l = &LabelsSearchResult{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+func TestOrganization_GetMembersURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{MembersURL: &zeroValue}
+	o.GetMembersURL()
+	o = &Organization{}
+	o.GetMembersURL()
+	o = nil
+	o.GetMembersURL()
 }
 
-func TestLargeFile_GetOID(tt *testing.T) {
+func TestOrganization_GetName(tt *testing.T) {
 	var zeroValue string
-	l := &LargeFile{OID: &zeroValue}
-	l.GetOID()
-	l = &LargeFile{}
-	l.GetOID()
-	l = nil
-	l.GetOID()
+	o := &Organization{Name: &zeroValue}
+	o.GetName()
+	o = &Organization{}
+	o.GetName()
+	o = nil
+	o.GetName()
 }
 
-func TestLargeFile_GetPath(tt *testing.T) {
+func TestOrganization_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	l := &LargeFile{Path: &zeroValue}
-	l.GetPath()
-	l = &LargeFile{}
-	l.GetPath()
-	l = nil
-	l.GetPath()
+	o := &Organization{NodeID: &zeroValue}
+	o.GetNodeID()
+	o = &Organization{}
+	o.GetNodeID()
+	o = nil
+	o.GetNodeID()
 }
 
-func TestLargeFile_GetRefName(tt *testing.T) {
-	var zeroValue string
-	l := &LargeFile{RefName: &zeroValue}
-	l.GetRefName()
-	l = &LargeFile{}
-	l.GetRefName()
-	l = nil
-	l.GetRefName()
+func TestOrganization_GetOwnedPrivateRepos(tt *testing.T) {
+	var zeroValue int64
+	o := &Organization{OwnedPrivateRepos: &zeroValue}
+	o.GetOwnedPrivateRepos()
+	o = &Organization{}
+	o.GetOwnedPrivateRepos()
+	o = nil
+	o.GetOwnedPrivateRepos()
 }
 
-func TestLargeFile_GetSize(tt *testing.T) {
-	var zeroValue int
-	l := &LargeFile{Size: &zeroValue}
-	l.GetSize()
-	l = &LargeFile{}
-	l.GetSize()
-	l = nil
-	l.GetSize()
+func TestOrganization_GetPlan(tt *testing.T) {
+	o := &Organization{}
+	o.GetPlan()
+	o = nil
+	o.GetPlan()
 }
 
-func TestLicense_GetBody(tt *testing.T) {
-	var zeroValue string
-	l := &License{Body: &zeroValue}
-	l.GetBody()
-	l = &License{}
-	l.GetBody()
-	l = nil
-	l.GetBody()
+func TestOrganization_GetPrivateGists(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PrivateGists: &zeroValue}
+	o.GetPrivateGists()
+	o = &Organization{}
+	o.GetPrivateGists()
+	o = nil
+	o.GetPrivateGists()
 }
 
-func TestLicense_GetConditions(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Conditions: &zeroValue}
-	l.GetConditions()
-	l = &License{}
-	l.GetConditions()
-	l = nil
-	l.GetConditions()
+func TestOrganization_GetPublicGists(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PublicGists: &zeroValue}
+	o.GetPublicGists()
+	o = &Organization{}
+	o.GetPublicGists()
+	o = nil
+	o.GetPublicGists()
 }
 
-func TestLicense_GetDescription(tt *testing.T) {
+func TestOrganization_GetPublicMembersURL(tt *testing.T) {
 	var zeroValue string
-	l := &License{Description: &zeroValue}
-	l.GetDescription()
-	l = &License{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+	o := &Organization{PublicMembersURL: &zeroValue}
+	o.GetPublicMembersURL()
+	o = &Organization{}
+	o.GetPublicMembersURL()
+	o = nil
+	o.GetPublicMembersURL()
 }
 
-func TestLicense_GetFeatured(tt *testing.T) {
-	var zeroValue bool
-	l := &License{Featured: &zeroValue}
-	l.GetFeatured()
-	l = &License{}
-	l.GetFeatured()
-	l = nil
-	l.GetFeatured()
+func TestOrganization_GetPublicRepos(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PublicRepos: &zeroValue}
+	o.GetPublicRepos()
+	o = &Organization{}
+	o.GetPublicRepos()
+	o = nil
+	o.GetPublicRepos()
 }
 
-func TestLicense_GetHTMLURL(tt *testing.T) {
+func TestOrganization_GetReposURL(tt *testing.T) {
 	var zeroValue string
-	l := &License{HTMLURL: &zeroValue}
-	l.GetHTMLURL()
-	l = &License{}
-	l.GetHTMLURL()
-	l = nil
-	l.GetHTMLURL()
+	o := &Organization{ReposURL: &zeroValue}
+	o.GetReposURL()
+	o = &Organization{}
+	o.GetReposURL()
+	o = nil
+	o.GetReposURL()
 }
 
-func TestLicense_GetImplementation(tt *testing.T) {
-	var zeroValue string
-	l := &License{Implementation: &zeroValue}
-	l.GetImplementation()
-	l = &License{}
-	l.GetImplementation()
-	l = nil
-	l.GetImplementation()
+func TestOrganization_GetSecretScanningEnabledForNewRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{SecretScanningEnabledForNewRepos: &zeroValue}
+	o.GetSecretScanningEnabledForNewRepos()
+	o = &Organization{}
+	o.GetSecretScanningEnabledForNewRepos()
+	o = nil
+	o.GetSecretScanningEnabledForNewRepos()
 }
 
-func TestLicense_GetKey(tt *testing.T) {
-	var zeroValue string
-	l := &License{Key: &zeroValue}
-	l.GetKey()
-	l = &License{}
-	l.GetKey()
-	l = nil
-	l.GetKey()
+func TestOrganization_GetSecretScanningPushProtectionEnabledForNewRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{SecretScanningPushProtectionEnabledForNewRepos: &zeroValue}
+	o.GetSecretScanningPushProtectionEnabledForNewRepos()
+	o = &Organization{}
+	o.GetSecretScanningPushProtectionEnabledForNewRepos()
+	o = nil
+	o.GetSecretScanningPushProtectionEnabledForNewRepos()
 }
 
-func TestLicense_GetLimitations(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Limitations: &zeroValue}
-	l.GetLimitations()
-	l = &License{}
-	l.GetLimitations()
-	l = nil
-	l.GetLimitations()
+func TestOrganization_GetTotalPrivateRepos(tt *testing.T) {
+	var zeroValue int64
+	o := &Organization{TotalPrivateRepos: &zeroValue}
+	o.GetTotalPrivateRepos()
+	o = &Organization{}
+	o.GetTotalPrivateRepos()
+	o = nil
+	o.GetTotalPrivateRepos()
 }
 
-func TestLicense_GetName(tt *testing.T) {
+func TestOrganization_GetTwitterUsername(tt *testing.T) {
 	var zeroValue string
-	l := &License{Name: &zeroValue}
-	l.GetName()
-	l = &License{}
-	l.GetName()
-	l = nil
-	l.GetName()
+	o := &Organization{TwitterUsername: &zeroValue}
+	o.GetTwitterUsername()
+	o = &Organization{}
+	o.GetTwitterUsername()
+	o = nil
+	o.GetTwitterUsername()
 }
 
-func TestLicense_GetPermissions(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Permissions: &zeroValue}
-	l.GetPermissions()
-	l = &License{}
-	l.GetPermissions()
-	l = nil
-	l.GetPermissions()
+func TestOrganization_GetTwoFactorRequirementEnabled(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{TwoFactorRequirementEnabled: &zeroValue}
+	o.GetTwoFactorRequirementEnabled()
+	o = &Organization{}
+	o.GetTwoFactorRequirementEnabled()
+	o = nil
+	o.GetTwoFactorRequirementEnabled()
 }
 
-func TestLicense_GetSPDXID(tt *testing.T) {
+func TestOrganization_GetType(tt *testing.T) {
 	var zeroValue string
-	l := &License{SPDXID: &zeroValue}
-	l.GetSPDXID()
-	l = &License{}
-	l.GetSPDXID()
-	l = nil
-	l.GetSPDXID()
+	o := &Organization{Type: &zeroValue}
+	o.GetType()
+	o = &Organization{}
+	o.GetType()
+	o = nil
+	o.GetType()
+}
+
+func TestOrganization_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	o := &Organization{UpdatedAt: &zeroValue}
+	o.GetUpdatedAt()
+	o = &Organization{}
+	o.GetUpdatedAt()
+	o = nil
+	o.GetUpdatedAt()
 }
 
-func TestLicense_GetURL(tt *testing.T) {
+func TestOrganization_GetURL(tt *testing.T) {
 	var zeroValue string
-	l := &License{URL: &zeroValue}
-	l.GetURL()
-	l = &License{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+	o := &Organization{URL: &zeroValue}
+	o.GetURL()
+	o = &Organization{}
+	o.GetURL()
+	o = nil
+	o.GetURL()
 }
 
-func TestLinearHistoryRequirementEnforcementLevelChanges_GetFrom(tt *testing.T) {
-	var zeroValue string
-	l := &LinearHistoryRequirementEnforcementLevelChanges{From: &zeroValue}
-	l.GetFrom()
-	l = &LinearHistoryRequirementEnforcementLevelChanges{}
-	l.GetFrom()
-	l = nil
-	l.GetFrom()
+func TestOrganization_GetWebCommitSignoffRequired(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{WebCommitSignoffRequired: &zeroValue}
+	o.GetWebCommitSignoffRequired()
+	o = &Organization{}
+	o.GetWebCommitSignoffRequired()
+	o = nil
+	o.GetWebCommitSignoffRequired()
 }
 
-func TestListAlertsOptions_GetDirection(tt *testing.T) {
-	var zeroValue string
-	l := &ListAlertsOptions{Direction: &zeroValue}
-	l.GetDirection()
-	l = &ListAlertsOptions{}
-	l.GetDirection()
-	l = nil
-	l.GetDirection()
+func TestOrganizationCustomRepoRoles_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationCustomRepoRoles{TotalCount: &zeroValue}
+	o.GetTotalCount()
+	o = &OrganizationCustomRepoRoles{}
+	o.GetTotalCount()
+	o = nil
+	o.GetTotalCount()
 }
 
-func TestListAlertsOptions_GetEcosystem(tt *testing.T) {
-	var zeroValue string
-	l := &ListAlertsOptions{Ecosystem: &zeroValue}
-	l.GetEcosystem()
-	l = &ListAlertsOptions{}
-	l.GetEcosystem()
-	l = nil
-	l.GetEcosystem()
+func TestOrganizationCustomRoles_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationCustomRoles{TotalCount: &zeroValue}
+	o.GetTotalCount()
+	o = &OrganizationCustomRoles{}
+	o.GetTotalCount()
+	o = nil
+	o.GetTotalCount()
 }
 
-func TestListAlertsOptions_GetPackage(tt *testing.T) {
+func TestOrganizationEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	l := &ListAlertsOptions{Package: &zeroValue}
-	l.GetPackage()
-	l = &ListAlertsOptions{}
-	l.GetPackage()
-	l = nil
-	l.GetPackage()
+	o := &OrganizationEvent{Action: &zeroValue}
+	o.GetAction()
+	o = &OrganizationEvent{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
 }
 
-func TestListAlertsOptions_GetScope(tt *testing.T) {
-	var zeroValue string
-	l := &ListAlertsOptions{Scope: &zeroValue}
-	l.GetScope()
-	l = &ListAlertsOptions{}
-	l.GetScope()
-	l = nil
-	l.GetScope()
+func TestOrganizationEvent_GetInstallation(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetInstallation()
+	o = nil
+	o.GetInstallation()
 }
 
-func TestListAlertsOptions_GetSeverity(tt *testing.T) {
-	var zeroValue string
-	l := &ListAlertsOptions{Severity: &zeroValue}
-	l.GetSeverity()
-	l = &ListAlertsOptions{}
-	l.GetSeverity()
-	l = nil
-	l.GetSeverity()
+func TestOrganizationEvent_GetInvitation(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetInvitation()
+	o = nil
+	o.GetInvitation()
 }
 
-func TestListAlertsOptions_GetSort(tt *testing.T) {
-	var zeroValue string
-	l := &ListAlertsOptions{Sort: &zeroValue}
-	l.GetSort()
-	l = &ListAlertsOptions{}
-	l.GetSort()
-	l = nil
-	l.GetSort()
+func TestOrganizationEvent_GetMembership(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetMembership()
+	o = nil
+	o.GetMembership()
 }
 
-func TestListAlertsOptions_GetState(tt *testing.T) {
-	var zeroValue string
-	l := &ListAlertsOptions{State: &zeroValue}
-	l.GetState()
-	l = &ListAlertsOptions{}
-	l.GetState()
-	l = nil
-	l.GetState()
+func TestOrganizationEvent_GetOrganization(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetOrganization()
+	o = nil
+	o.GetOrganization()
 }
 
-func TestListCheckRunsOptions_GetAppID(tt *testing.T) {
-	var zeroValue int64
-	l := &ListCheckRunsOptions{AppID: &zeroValue}
-	l.GetAppID()
-	l = &ListCheckRunsOptions{}
-	l.GetAppID()
-	l = nil
-	l.GetAppID()
+func TestOrganizationEvent_GetSender(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetSender()
+	o = nil
+	o.GetSender()
 }
 
-func TestListCheckRunsOptions_GetCheckName(tt *testing.T) {
-	var zeroValue string
-	l := &ListCheckRunsOptions{CheckName: &zeroValue}
-	l.GetCheckName()
-	l = &ListCheckRunsOptions{}
-	l.GetCheckName()
-	l = nil
-	l.GetCheckName()
+func TestOrganizationInstallations_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationInstallations{TotalCount: &zeroValue}
+	o.GetTotalCount()
+	o = &OrganizationInstallations{}
+	o.GetTotalCount()
+	o = nil
+	o.GetTotalCount()
 }
 
-func TestListCheckRunsOptions_GetFilter(tt *testing.T) {
+func TestOrgBlockEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	l := &ListCheckRunsOptions{Filter: &zeroValue}
-	l.GetFilter()
-	l = &ListCheckRunsOptions{}
-	l.GetFilter()
-	l = nil
-	l.GetFilter()
+	o := &OrgBlockEvent{Action: &zeroValue}
+	o.GetAction()
+	o = &OrgBlockEvent{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
 }
 
-func TestListCheckRunsOptions_GetStatus(tt *testing.T) {
-	var zeroValue string
-	l := &ListCheckRunsOptions{Status: &zeroValue}
-	l.GetStatus()
-	l = &ListCheckRunsOptions{}
-	l.GetStatus()
-	l = nil
-	l.GetStatus()
+func TestOrgBlockEvent_GetBlockedUser(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetBlockedUser()
+	o = nil
+	o.GetBlockedUser()
 }
 
-func TestListCheckRunsResults_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckRunsResults{Total: &zeroValue}
-	l.GetTotal()
-	l = &ListCheckRunsResults{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+func TestOrgBlockEvent_GetInstallation(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetInstallation()
+	o = nil
+	o.GetInstallation()
 }
 
-func TestListCheckSuiteOptions_GetAppID(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckSuiteOptions{AppID: &zeroValue}
-	l.GetAppID()
-	l = &ListCheckSuiteOptions{}
-	l.GetAppID()
-	l = nil
-	l.GetAppID()
+func TestOrgBlockEvent_GetOrganization(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetOrganization()
+	o = nil
+	o.GetOrganization()
 }
 
-func TestListCheckSuiteOptions_GetCheckName(tt *testing.T) {
-	var zeroValue string
-	l := &ListCheckSuiteOptions{CheckName: &zeroValue}
-	l.GetCheckName()
-	l = &ListCheckSuiteOptions{}
-	l.GetCheckName()
-	l = nil
-	l.GetCheckName()
+func TestOrgBlockEvent_GetSender(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetSender()
+	o = nil
+	o.GetSender()
 }
 
-func TestListCheckSuiteResults_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckSuiteResults{Total: &zeroValue}
-	l.GetTotal()
-	l = &ListCheckSuiteResults{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+func TestOrgRequiredWorkflow_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	o := &OrgRequiredWorkflow{CreatedAt: &zeroValue}
+	o.GetCreatedAt()
+	o = &OrgRequiredWorkflow{}
+	o.GetCreatedAt()
+	o = nil
+	o.GetCreatedAt()
+}
+
+func TestOrgRequiredWorkflow_GetID(tt *testing.T) {
+	var zeroValue int64
+	o := &OrgRequiredWorkflow{ID: &zeroValue}
+	o.GetID()
+	o = &OrgRequiredWorkflow{}
+	o.GetID()
+	o = nil
+	o.GetID()
+}
+
+func TestOrgRequiredWorkflow_GetName(tt *testing.T) {
+	var zeroValue string
+	o := &OrgRequiredWorkflow{Name: &zeroValue}
+	o.GetName()
+	o = &OrgRequiredWorkflow{}
+	o.GetName()
+	o = nil
+	o.GetName()
 }
 
-func TestListCollaboratorOptions_GetAffiliation(tt *testing.T) {
+func TestOrgRequiredWorkflow_GetPath(tt *testing.T) {
 	var zeroValue string
-	l := &ListCollaboratorOptions{Affiliation: &zeroValue}
-	l.GetAffiliation()
-	l = &ListCollaboratorOptions{}
-	l.GetAffiliation()
-	l = nil
-	l.GetAffiliation()
+	o := &OrgRequiredWorkflow{Path: &zeroValue}
+	o.GetPath()
+	o = &OrgRequiredWorkflow{}
+	o.GetPath()
+	o = nil
+	o.GetPath()
 }
 
-func TestListExternalGroupsOptions_GetDisplayName(tt *testing.T) {
+func TestOrgRequiredWorkflow_GetRef(tt *testing.T) {
 	var zeroValue string
-	l := &ListExternalGroupsOptions{DisplayName: &zeroValue}
-	l.GetDisplayName()
-	l = &ListExternalGroupsOptions{}
-	l.GetDisplayName()
-	l = nil
-	l.GetDisplayName()
+	o := &OrgRequiredWorkflow{Ref: &zeroValue}
+	o.GetRef()
+	o = &OrgRequiredWorkflow{}
+	o.GetRef()
+	o = nil
+	o.GetRef()
 }
 
-func TestListRepositories_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	l := &ListRepositories{TotalCount: &zeroValue}
-	l.GetTotalCount()
-	l = &ListRepositories{}
-	l.GetTotalCount()
-	l = nil
-	l.GetTotalCount()
+func TestOrgRequiredWorkflow_GetRepository(tt *testing.T) {
+	o := &OrgRequiredWorkflow{}
+	o.GetRepository()
+	o = nil
+	o.GetRepository()
 }
 
-func TestListSCIMProvisionedIdentitiesOptions_GetCount(tt *testing.T) {
-	var zeroValue int
-	l := &ListSCIMProvisionedIdentitiesOptions{Count: &zeroValue}
-	l.GetCount()
-	l = &ListSCIMProvisionedIdentitiesOptions{}
-	l.GetCount()
-	l = nil
-	l.GetCount()
+func TestOrgRequiredWorkflow_GetScope(tt *testing.T) {
+	var zeroValue string
+	o := &OrgRequiredWorkflow{Scope: &zeroValue}
+	o.GetScope()
+	o = &OrgRequiredWorkflow{}
+	o.GetScope()
+	o = nil
+	o.GetScope()
 }
 
-func TestListSCIMProvisionedIdentitiesOptions_GetFilter(tt *testing.T) {
+func TestOrgRequiredWorkflow_GetSelectedRepositoriesURL(tt *testing.T) {
 	var zeroValue string
-	l := &ListSCIMProvisionedIdentitiesOptions{Filter: &zeroValue}
-	l.GetFilter()
-	l = &ListSCIMProvisionedIdentitiesOptions{}
-	l.GetFilter()
-	l = nil
-	l.GetFilter()
+	o := &OrgRequiredWorkflow{SelectedRepositoriesURL: &zeroValue}
+	o.GetSelectedRepositoriesURL()
+	o = &OrgRequiredWorkflow{}
+	o.GetSelectedRepositoriesURL()
+	o = nil
+	o.GetSelectedRepositoriesURL()
 }
 
-func TestListSCIMProvisionedIdentitiesOptions_GetStartIndex(tt *testing.T) {
-	var zeroValue int
-	l := &ListSCIMProvisionedIdentitiesOptions{StartIndex: &zeroValue}
-	l.GetStartIndex()
-	l = &ListSCIMProvisionedIdentitiesOptions{}
-	l.GetStartIndex()
-	l = nil
-	l.GetStartIndex()
+func TestOrgRequiredWorkflow_GetState(tt *testing.T) {
+	var zeroValue string
+	o := &OrgRequiredWorkflow{State: &zeroValue}
+	o.GetState()
+	o = &OrgRequiredWorkflow{}
+	o.GetState()
+	o = nil
+	o.GetState()
 }
 
-func TestLocation_GetEndColumn(tt *testing.T) {
-	var zeroValue int
-	l := &Location{EndColumn: &zeroValue}
-	l.GetEndColumn()
-	l = &Location{}
-	l.GetEndColumn()
-	l = nil
-	l.GetEndColumn()
+func TestOrgRequiredWorkflow_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	o := &OrgRequiredWorkflow{UpdatedAt: &zeroValue}
+	o.GetUpdatedAt()
+	o = &OrgRequiredWorkflow{}
+	o.GetUpdatedAt()
+	o = nil
+	o.GetUpdatedAt()
 }
 
-func TestLocation_GetEndLine(tt *testing.T) {
+func TestOrgRequiredWorkflows_GetTotalCount(tt *testing.T) {
 	var zeroValue int
-	l := &Location{EndLine: &zeroValue}
-	l.GetEndLine()
-	l = &Location{}
-	l.GetEndLine()
-	l = nil
-	l.GetEndLine()
+	o := &OrgRequiredWorkflows{TotalCount: &zeroValue}
+	o.GetTotalCount()
+	o = &OrgRequiredWorkflows{}
+	o.GetTotalCount()
+	o = nil
+	o.GetTotalCount()
 }
 
-func TestLocation_GetPath(tt *testing.T) {
-	var zeroValue string
-	l := &Location{Path: &zeroValue}
-	l.GetPath()
-	l = &Location{}
-	l.GetPath()
-	l = nil
-	l.GetPath()
+func TestOrgStats_GetDisabledOrgs(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{DisabledOrgs: &zeroValue}
+	o.GetDisabledOrgs()
+	o = &OrgStats{}
+	o.GetDisabledOrgs()
+	o = nil
+	o.GetDisabledOrgs()
 }
 
-func TestLocation_GetStartColumn(tt *testing.T) {
+func TestOrgStats_GetTotalOrgs(tt *testing.T) {
 	var zeroValue int
-	l := &Location{StartColumn: &zeroValue}
-	l.GetStartColumn()
-	l = &Location{}
-	l.GetStartColumn()
-	l = nil
-	l.GetStartColumn()
+	o := &OrgStats{TotalOrgs: &zeroValue}
+	o.GetTotalOrgs()
+	o = &OrgStats{}
+	o.GetTotalOrgs()
+	o = nil
+	o.GetTotalOrgs()
 }
 
-func TestLocation_GetStartLine(tt *testing.T) {
+func TestOrgStats_GetTotalTeamMembers(tt *testing.T) {
 	var zeroValue int
-	l := &Location{StartLine: &zeroValue}
-	l.GetStartLine()
-	l = &Location{}
-	l.GetStartLine()
-	l = nil
-	l.GetStartLine()
+	o := &OrgStats{TotalTeamMembers: &zeroValue}
+	o.GetTotalTeamMembers()
+	o = &OrgStats{}
+	o.GetTotalTeamMembers()
+	o = nil
+	o.GetTotalTeamMembers()
 }
 
-func TestLockBranch_GetEnabled(tt *testing.T) {
-	var zeroValue bool
-	l := &LockBranch{Enabled: &zeroValue}
-	l.GetEnabled()
-	l = &LockBranch{}
-	l.GetEnabled()
-	l = nil
-	l.GetEnabled()
+func TestOrgStats_GetTotalTeams(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{TotalTeams: &zeroValue}
+	o.GetTotalTeams()
+	o = &OrgStats{}
+	o.GetTotalTeams()
+	o = nil
+	o.GetTotalTeams()
 }
 
-func TestMarketplacePendingChange_GetEffectiveDate(tt *testing.T) {
+func TestPackage_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	m := &MarketplacePendingChange{EffectiveDate: &zeroValue}
-	m.GetEffectiveDate()
-	m = &MarketplacePendingChange{}
-	m.GetEffectiveDate()
-	m = nil
-	m.GetEffectiveDate()
+	p := &Package{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &Package{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMarketplacePendingChange_GetID(tt *testing.T) {
+func TestPackage_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &Package{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Package{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
+}
+
+func TestPackage_GetID(tt *testing.T) {
 	var zeroValue int64
-	m := &MarketplacePendingChange{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePendingChange{}
-	m.GetID()
-	m = nil
-	m.GetID()
+	p := &Package{ID: &zeroValue}
+	p.GetID()
+	p = &Package{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMarketplacePendingChange_GetPlan(tt *testing.T) {
-	m := &MarketplacePendingChange{}
-	m.GetPlan()
-	m = nil
-	m.GetPlan()
+func TestPackage_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &Package{Name: &zeroValue}
+	p.GetName()
+	p = &Package{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMarketplacePendingChange_GetUnitCount(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePendingChange{UnitCount: &zeroValue}
-	m.GetUnitCount()
-	m = &MarketplacePendingChange{}
-	m.GetUnitCount()
-	m = nil
-	m.GetUnitCount()
+func TestPackage_GetOwner(tt *testing.T) {
+	p := &Package{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
+}
+
+func TestPackage_GetPackageType(tt *testing.T) {
+	var zeroValue string
+	p := &Package{PackageType: &zeroValue}
+	p.GetPackageType()
+	p = &Package{}
+	p.GetPackageType()
+	p = nil
+	p.GetPackageType()
+}
+
+func TestPackage_GetPackageVersion(tt *testing.T) {
+	p := &Package{}
+	p.GetPackageVersion()
+	p = nil
+	p.GetPackageVersion()
 }
 
-func TestMarketplacePlan_GetAccountsURL(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{AccountsURL: &zeroValue}
-	m.GetAccountsURL()
-	m = &MarketplacePlan{}
-	m.GetAccountsURL()
-	m = nil
-	m.GetAccountsURL()
+func TestPackage_GetRegistry(tt *testing.T) {
+	p := &Package{}
+	p.GetRegistry()
+	p = nil
+	p.GetRegistry()
 }
 
-func TestMarketplacePlan_GetBullets(tt *testing.T) {
-	var zeroValue []string
-	m := &MarketplacePlan{Bullets: &zeroValue}
-	m.GetBullets()
-	m = &MarketplacePlan{}
-	m.GetBullets()
-	m = nil
-	m.GetBullets()
+func TestPackage_GetRepository(tt *testing.T) {
+	p := &Package{}
+	p.GetRepository()
+	p = nil
+	p.GetRepository()
 }
 
-func TestMarketplacePlan_GetDescription(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{Description: &zeroValue}
-	m.GetDescription()
-	m = &MarketplacePlan{}
-	m.GetDescription()
-	m = nil
-	m.GetDescription()
+func TestPackage_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Package{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &Package{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestMarketplacePlan_GetHasFreeTrial(tt *testing.T) {
-	var zeroValue bool
-	m := &MarketplacePlan{HasFreeTrial: &zeroValue}
-	m.GetHasFreeTrial()
-	m = &MarketplacePlan{}
-	m.GetHasFreeTrial()
-	m = nil
-	m.GetHasFreeTrial()
+func TestPackage_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &Package{URL: &zeroValue}
+	p.GetURL()
+	p = &Package{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMarketplacePlan_GetID(tt *testing.T) {
+func TestPackage_GetVersionCount(tt *testing.T) {
 	var zeroValue int64
-	m := &MarketplacePlan{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePlan{}
-	m.GetID()
-	m = nil
-	m.GetID()
+	p := &Package{VersionCount: &zeroValue}
+	p.GetVersionCount()
+	p = &Package{}
+	p.GetVersionCount()
+	p = nil
+	p.GetVersionCount()
 }
 
-func TestMarketplacePlan_GetMonthlyPriceInCents(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePlan{MonthlyPriceInCents: &zeroValue}
-	m.GetMonthlyPriceInCents()
-	m = &MarketplacePlan{}
-	m.GetMonthlyPriceInCents()
-	m = nil
-	m.GetMonthlyPriceInCents()
+func TestPackage_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	p := &Package{Visibility: &zeroValue}
+	p.GetVisibility()
+	p = &Package{}
+	p.GetVisibility()
+	p = nil
+	p.GetVisibility()
 }
 
-func TestMarketplacePlan_GetName(tt *testing.T) {
+func TestPackageEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{Name: &zeroValue}
-	m.GetName()
-	m = &MarketplacePlan{}
-	m.GetName()
-	m = nil
-	m.GetName()
+	p := &PackageEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PackageEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestMarketplacePlan_GetNumber(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePlan{Number: &zeroValue}
-	m.GetNumber()
-	m = &MarketplacePlan{}
-	m.GetNumber()
-	m = nil
-	m.GetNumber()
+func TestPackageEvent_GetInstallation(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestMarketplacePlan_GetPriceModel(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{PriceModel: &zeroValue}
-	m.GetPriceModel()
-	m = &MarketplacePlan{}
-	m.GetPriceModel()
-	m = nil
-	m.GetPriceModel()
+func TestPackageEvent_GetOrg(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestMarketplacePlan_GetState(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{State: &zeroValue}
-	m.GetState()
-	m = &MarketplacePlan{}
-	m.GetState()
-	m = nil
-	m.GetState()
+func TestPackageEvent_GetPackage(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetPackage()
+	p = nil
+	p.GetPackage()
 }
 
-func TestMarketplacePlan_GetUnitName(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{UnitName: &zeroValue}
-	m.GetUnitName()
-	m = &MarketplacePlan{}
-	m.GetUnitName()
-	m = nil
-	m.GetUnitName()
+func TestPackageEvent_GetRepo(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestMarketplacePlan_GetURL(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{URL: &zeroValue}
-	m.GetURL()
-	m = &MarketplacePlan{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+func TestPackageEvent_GetSender(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestMarketplacePlan_GetYearlyPriceInCents(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePlan{YearlyPriceInCents: &zeroValue}
-	m.GetYearlyPriceInCents()
-	m = &MarketplacePlan{}
-	m.GetYearlyPriceInCents()
-	m = nil
-	m.GetYearlyPriceInCents()
+func TestPackageFile_GetAuthor(tt *testing.T) {
+	p := &PackageFile{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
 }
 
-func TestMarketplacePlanAccount_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &MarketplacePlanAccount{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePlanAccount{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestPackageFile_GetContentType(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{ContentType: &zeroValue}
+	p.GetContentType()
+	p = &PackageFile{}
+	p.GetContentType()
+	p = nil
+	p.GetContentType()
 }
 
-func TestMarketplacePlanAccount_GetLogin(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlanAccount{Login: &zeroValue}
-	m.GetLogin()
-	m = &MarketplacePlanAccount{}
-	m.GetLogin()
-	m = nil
-	m.GetLogin()
+func TestPackageFile_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageFile{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageFile{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMarketplacePlanAccount_GetMarketplacePendingChange(tt *testing.T) {
-	m := &MarketplacePlanAccount{}
-	m.GetMarketplacePendingChange()
-	m = nil
-	m.GetMarketplacePendingChange()
+func TestPackageFile_GetDownloadURL(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{DownloadURL: &zeroValue}
+	p.GetDownloadURL()
+	p = &PackageFile{}
+	p.GetDownloadURL()
+	p = nil
+	p.GetDownloadURL()
 }
 
-func TestMarketplacePlanAccount_GetMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePlanAccount{}
-	m.GetMarketplacePurchase()
-	m = nil
-	m.GetMarketplacePurchase()
+func TestPackageFile_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageFile{ID: &zeroValue}
+	p.GetID()
+	p = &PackageFile{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMarketplacePlanAccount_GetOrganizationBillingEmail(tt *testing.T) {
+func TestPackageFile_GetMD5(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{OrganizationBillingEmail: &zeroValue}
-	m.GetOrganizationBillingEmail()
-	m = &MarketplacePlanAccount{}
-	m.GetOrganizationBillingEmail()
-	m = nil
-	m.GetOrganizationBillingEmail()
+	p := &PackageFile{MD5: &zeroValue}
+	p.GetMD5()
+	p = &PackageFile{}
+	p.GetMD5()
+	p = nil
+	p.GetMD5()
+}
+
+func TestPackageFile_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{Name: &zeroValue}
+	p.GetName()
+	p = &PackageFile{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMarketplacePlanAccount_GetType(tt *testing.T) {
+func TestPackageFile_GetSHA1(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{Type: &zeroValue}
-	m.GetType()
-	m = &MarketplacePlanAccount{}
-	m.GetType()
-	m = nil
-	m.GetType()
+	p := &PackageFile{SHA1: &zeroValue}
+	p.GetSHA1()
+	p = &PackageFile{}
+	p.GetSHA1()
+	p = nil
+	p.GetSHA1()
 }
 
-func TestMarketplacePlanAccount_GetURL(tt *testing.T) {
+func TestPackageFile_GetSHA256(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{URL: &zeroValue}
-	m.GetURL()
-	m = &MarketplacePlanAccount{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &PackageFile{SHA256: &zeroValue}
+	p.GetSHA256()
+	p = &PackageFile{}
+	p.GetSHA256()
+	p = nil
+	p.GetSHA256()
 }
 
-func TestMarketplacePurchase_GetAccount(tt *testing.T) {
-	m := &MarketplacePurchase{}
-	m.GetAccount()
-	m = nil
-	m.GetAccount()
+func TestPackageFile_GetSize(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageFile{Size: &zeroValue}
+	p.GetSize()
+	p = &PackageFile{}
+	p.GetSize()
+	p = nil
+	p.GetSize()
 }
 
-func TestMarketplacePurchase_GetBillingCycle(tt *testing.T) {
+func TestPackageFile_GetState(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePurchase{BillingCycle: &zeroValue}
-	m.GetBillingCycle()
-	m = &MarketplacePurchase{}
-	m.GetBillingCycle()
-	m = nil
-	m.GetBillingCycle()
+	p := &PackageFile{State: &zeroValue}
+	p.GetState()
+	p = &PackageFile{}
+	p.GetState()
+	p = nil
+	p.GetState()
 }
 
-func TestMarketplacePurchase_GetFreeTrialEndsOn(tt *testing.T) {
+func TestPackageFile_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	m := &MarketplacePurchase{FreeTrialEndsOn: &zeroValue}
-	m.GetFreeTrialEndsOn()
-	m = &MarketplacePurchase{}
-	m.GetFreeTrialEndsOn()
-	m = nil
-	m.GetFreeTrialEndsOn()
+	p := &PackageFile{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PackageFile{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestMarketplacePurchase_GetNextBillingDate(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePurchase{NextBillingDate: &zeroValue}
-	m.GetNextBillingDate()
-	m = &MarketplacePurchase{}
-	m.GetNextBillingDate()
-	m = nil
-	m.GetNextBillingDate()
+func TestPackageListOptions_GetPackageType(tt *testing.T) {
+	var zeroValue string
+	p := &PackageListOptions{PackageType: &zeroValue}
+	p.GetPackageType()
+	p = &PackageListOptions{}
+	p.GetPackageType()
+	p = nil
+	p.GetPackageType()
 }
 
-func TestMarketplacePurchase_GetOnFreeTrial(tt *testing.T) {
-	var zeroValue bool
-	m := &MarketplacePurchase{OnFreeTrial: &zeroValue}
-	m.GetOnFreeTrial()
-	m = &MarketplacePurchase{}
-	m.GetOnFreeTrial()
-	m = nil
-	m.GetOnFreeTrial()
+func TestPackageListOptions_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &PackageListOptions{State: &zeroValue}
+	p.GetState()
+	p = &PackageListOptions{}
+	p.GetState()
+	p = nil
+	p.GetState()
 }
 
-func TestMarketplacePurchase_GetPlan(tt *testing.T) {
-	m := &MarketplacePurchase{}
-	m.GetPlan()
-	m = nil
-	m.GetPlan()
+func TestPackageListOptions_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	p := &PackageListOptions{Visibility: &zeroValue}
+	p.GetVisibility()
+	p = &PackageListOptions{}
+	p.GetVisibility()
+	p = nil
+	p.GetVisibility()
 }
 
-func TestMarketplacePurchase_GetUnitCount(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePurchase{UnitCount: &zeroValue}
-	m.GetUnitCount()
-	m = &MarketplacePurchase{}
-	m.GetUnitCount()
-	m = nil
-	m.GetUnitCount()
+func TestPackageMetadata_GetContainer(tt *testing.T) {
+	p := &PackageMetadata{}
+	p.GetContainer()
+	p = nil
+	p.GetContainer()
 }
 
-func TestMarketplacePurchase_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePurchase{UpdatedAt: &zeroValue}
-	m.GetUpdatedAt()
-	m = &MarketplacePurchase{}
-	m.GetUpdatedAt()
-	m = nil
-	m.GetUpdatedAt()
+func TestPackageMetadata_GetPackageType(tt *testing.T) {
+	var zeroValue string
+	p := &PackageMetadata{PackageType: &zeroValue}
+	p.GetPackageType()
+	p = &PackageMetadata{}
+	p.GetPackageType()
+	p = nil
+	p.GetPackageType()
 }
 
-func TestMarketplacePurchaseAccount_GetEmail(tt *testing.T) {
+func TestPackageRegistry_GetAboutURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePurchaseAccount{Email: &zeroValue}
-	m.GetEmail()
-	m = &MarketplacePurchaseAccount{}
-	m.GetEmail()
-	m = nil
-	m.GetEmail()
+	p := &PackageRegistry{AboutURL: &zeroValue}
+	p.GetAboutURL()
+	p = &PackageRegistry{}
+	p.GetAboutURL()
+	p = nil
+	p.GetAboutURL()
 }
 
-func TestMarketplacePurchaseAccount_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &MarketplacePurchaseAccount{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePurchaseAccount{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestPackageRegistry_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRegistry{Name: &zeroValue}
+	p.GetName()
+	p = &PackageRegistry{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMarketplacePurchaseAccount_GetLogin(tt *testing.T) {
+func TestPackageRegistry_GetType(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePurchaseAccount{Login: &zeroValue}
-	m.GetLogin()
-	m = &MarketplacePurchaseAccount{}
-	m.GetLogin()
-	m = nil
-	m.GetLogin()
+	p := &PackageRegistry{Type: &zeroValue}
+	p.GetType()
+	p = &PackageRegistry{}
+	p.GetType()
+	p = nil
+	p.GetType()
 }
 
-func TestMarketplacePurchaseAccount_GetNodeID(tt *testing.T) {
+func TestPackageRegistry_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePurchaseAccount{NodeID: &zeroValue}
-	m.GetNodeID()
-	m = &MarketplacePurchaseAccount{}
-	m.GetNodeID()
-	m = nil
-	m.GetNodeID()
+	p := &PackageRegistry{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageRegistry{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMarketplacePurchaseAccount_GetOrganizationBillingEmail(tt *testing.T) {
+func TestPackageRegistry_GetVendor(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePurchaseAccount{OrganizationBillingEmail: &zeroValue}
-	m.GetOrganizationBillingEmail()
-	m = &MarketplacePurchaseAccount{}
-	m.GetOrganizationBillingEmail()
-	m = nil
-	m.GetOrganizationBillingEmail()
+	p := &PackageRegistry{Vendor: &zeroValue}
+	p.GetVendor()
+	p = &PackageRegistry{}
+	p.GetVendor()
+	p = nil
+	p.GetVendor()
 }
 
-func TestMarketplacePurchaseAccount_GetType(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePurchaseAccount{Type: &zeroValue}
-	m.GetType()
-	m = &MarketplacePurchaseAccount{}
-	m.GetType()
-	m = nil
-	m.GetType()
+func TestPackageRelease_GetAuthor(tt *testing.T) {
+	p := &PackageRelease{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
 }
 
-func TestMarketplacePurchaseAccount_GetURL(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePurchaseAccount{URL: &zeroValue}
-	m.GetURL()
-	m = &MarketplacePurchaseAccount{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+func TestPackageRelease_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageRelease{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageRelease{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestPackageRelease_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageRelease{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PackageRelease{}
+	p.GetDraft()
+	p = nil
+	p.GetDraft()
 }
 
-func TestMarketplacePurchaseEvent_GetAction(tt *testing.T) {
+func TestPackageRelease_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePurchaseEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MarketplacePurchaseEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+	p := &PackageRelease{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PackageRelease{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestMarketplacePurchaseEvent_GetEffectiveDate(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePurchaseEvent{EffectiveDate: &zeroValue}
-	m.GetEffectiveDate()
-	m = &MarketplacePurchaseEvent{}
-	m.GetEffectiveDate()
-	m = nil
-	m.GetEffectiveDate()
+func TestPackageRelease_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageRelease{ID: &zeroValue}
+	p.GetID()
+	p = &PackageRelease{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMarketplacePurchaseEvent_GetInstallation(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPackageRelease_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRelease{Name: &zeroValue}
+	p.GetName()
+	p = &PackageRelease{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMarketplacePurchaseEvent_GetMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetMarketplacePurchase()
-	m = nil
-	m.GetMarketplacePurchase()
+func TestPackageRelease_GetPrerelease(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageRelease{Prerelease: &zeroValue}
+	p.GetPrerelease()
+	p = &PackageRelease{}
+	p.GetPrerelease()
+	p = nil
+	p.GetPrerelease()
 }
 
-func TestMarketplacePurchaseEvent_GetPreviousMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetPreviousMarketplacePurchase()
-	m = nil
-	m.GetPreviousMarketplacePurchase()
+func TestPackageRelease_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageRelease{PublishedAt: &zeroValue}
+	p.GetPublishedAt()
+	p = &PackageRelease{}
+	p.GetPublishedAt()
+	p = nil
+	p.GetPublishedAt()
 }
 
-func TestMarketplacePurchaseEvent_GetSender(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPackageRelease_GetTagName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRelease{TagName: &zeroValue}
+	p.GetTagName()
+	p = &PackageRelease{}
+	p.GetTagName()
+	p = nil
+	p.GetTagName()
 }
 
-func TestMatch_GetText(tt *testing.T) {
+func TestPackageRelease_GetTargetCommitish(tt *testing.T) {
 	var zeroValue string
-	m := &Match{Text: &zeroValue}
-	m.GetText()
-	m = &Match{}
-	m.GetText()
-	m = nil
-	m.GetText()
+	p := &PackageRelease{TargetCommitish: &zeroValue}
+	p.GetTargetCommitish()
+	p = &PackageRelease{}
+	p.GetTargetCommitish()
+	p = nil
+	p.GetTargetCommitish()
 }
 
-func TestMemberEvent_GetAction(tt *testing.T) {
+func TestPackageRelease_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &MemberEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MemberEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+	p := &PackageRelease{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageRelease{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMemberEvent_GetInstallation(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPackageVersion_GetAuthor(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
 }
 
-func TestMemberEvent_GetMember(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetMember()
-	m = nil
-	m.GetMember()
+func TestPackageVersion_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{Body: &zeroValue}
+	p.GetBody()
+	p = &PackageVersion{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestMemberEvent_GetRepo(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetRepo()
-	m = nil
-	m.GetRepo()
+func TestPackageVersion_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{BodyHTML: &zeroValue}
+	p.GetBodyHTML()
+	p = &PackageVersion{}
+	p.GetBodyHTML()
+	p = nil
+	p.GetBodyHTML()
 }
 
-func TestMemberEvent_GetSender(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPackageVersion_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageVersion{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageVersion{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMembership_GetOrganization(tt *testing.T) {
-	m := &Membership{}
-	m.GetOrganization()
-	m = nil
-	m.GetOrganization()
+func TestPackageVersion_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageVersion{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PackageVersion{}
+	p.GetDraft()
+	p = nil
+	p.GetDraft()
 }
 
-func TestMembership_GetOrganizationURL(tt *testing.T) {
+func TestPackageVersion_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	m := &Membership{OrganizationURL: &zeroValue}
-	m.GetOrganizationURL()
-	m = &Membership{}
-	m.GetOrganizationURL()
-	m = nil
-	m.GetOrganizationURL()
+	p := &PackageVersion{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PackageVersion{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestMembership_GetRole(tt *testing.T) {
-	var zeroValue string
-	m := &Membership{Role: &zeroValue}
-	m.GetRole()
-	m = &Membership{}
-	m.GetRole()
-	m = nil
-	m.GetRole()
+func TestPackageVersion_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageVersion{ID: &zeroValue}
+	p.GetID()
+	p = &PackageVersion{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMembership_GetState(tt *testing.T) {
+func TestPackageVersion_GetInstallationCommand(tt *testing.T) {
 	var zeroValue string
-	m := &Membership{State: &zeroValue}
-	m.GetState()
-	m = &Membership{}
-	m.GetState()
-	m = nil
-	m.GetState()
+	p := &PackageVersion{InstallationCommand: &zeroValue}
+	p.GetInstallationCommand()
+	p = &PackageVersion{}
+	p.GetInstallationCommand()
+	p = nil
+	p.GetInstallationCommand()
 }
 
-func TestMembership_GetURL(tt *testing.T) {
+func TestPackageVersion_GetManifest(tt *testing.T) {
 	var zeroValue string
-	m := &Membership{URL: &zeroValue}
-	m.GetURL()
-	m = &Membership{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &PackageVersion{Manifest: &zeroValue}
+	p.GetManifest()
+	p = &PackageVersion{}
+	p.GetManifest()
+	p = nil
+	p.GetManifest()
 }
 
-func TestMembership_GetUser(tt *testing.T) {
-	m := &Membership{}
-	m.GetUser()
-	m = nil
-	m.GetUser()
+func TestPackageVersion_GetMetadata(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetMetadata()
+	p = nil
+	p.GetMetadata()
+}
+
+func TestPackageVersion_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{Name: &zeroValue}
+	p.GetName()
+	p = &PackageVersion{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMembershipEvent_GetAction(tt *testing.T) {
+func TestPackageVersion_GetPackageHTMLURL(tt *testing.T) {
 	var zeroValue string
-	m := &MembershipEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MembershipEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
-}
-
-func TestMembershipEvent_GetInstallation(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+	p := &PackageVersion{PackageHTMLURL: &zeroValue}
+	p.GetPackageHTMLURL()
+	p = &PackageVersion{}
+	p.GetPackageHTMLURL()
+	p = nil
+	p.GetPackageHTMLURL()
 }
 
-func TestMembershipEvent_GetMember(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetMember()
-	m = nil
-	m.GetMember()
+func TestPackageVersion_GetPrerelease(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageVersion{Prerelease: &zeroValue}
+	p.GetPrerelease()
+	p = &PackageVersion{}
+	p.GetPrerelease()
+	p = nil
+	p.GetPrerelease()
 }
 
-func TestMembershipEvent_GetOrg(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetOrg()
-	m = nil
-	m.GetOrg()
+func TestPackageVersion_GetRelease(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetRelease()
+	p = nil
+	p.GetRelease()
 }
 
-func TestMembershipEvent_GetScope(tt *testing.T) {
+func TestPackageVersion_GetSummary(tt *testing.T) {
 	var zeroValue string
-	m := &MembershipEvent{Scope: &zeroValue}
-	m.GetScope()
-	m = &MembershipEvent{}
-	m.GetScope()
-	m = nil
-	m.GetScope()
+	p := &PackageVersion{Summary: &zeroValue}
+	p.GetSummary()
+	p = &PackageVersion{}
+	p.GetSummary()
+	p = nil
+	p.GetSummary()
 }
 
-func TestMembershipEvent_GetSender(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPackageVersion_GetTagName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{TagName: &zeroValue}
+	p.GetTagName()
+	p = &PackageVersion{}
+	p.GetTagName()
+	p = nil
+	p.GetTagName()
 }
 
-func TestMembershipEvent_GetTeam(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetTeam()
-	m = nil
-	m.GetTeam()
+func TestPackageVersion_GetTargetCommitish(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{TargetCommitish: &zeroValue}
+	p.GetTargetCommitish()
+	p = &PackageVersion{}
+	p.GetTargetCommitish()
+	p = nil
+	p.GetTargetCommitish()
 }
 
-func TestMergeGroup_GetBaseRef(tt *testing.T) {
+func TestPackageVersion_GetTargetOID(tt *testing.T) {
 	var zeroValue string
-	m := &MergeGroup{BaseRef: &zeroValue}
-	m.GetBaseRef()
-	m = &MergeGroup{}
-	m.GetBaseRef()
-	m = nil
-	m.GetBaseRef()
+	p := &PackageVersion{TargetOID: &zeroValue}
+	p.GetTargetOID()
+	p = &PackageVersion{}
+	p.GetTargetOID()
+	p = nil
+	p.GetTargetOID()
 }
 
-func TestMergeGroup_GetBaseSHA(tt *testing.T) {
-	var zeroValue string
-	m := &MergeGroup{BaseSHA: &zeroValue}
-	m.GetBaseSHA()
-	m = &MergeGroup{}
-	m.GetBaseSHA()
-	m = nil
-	m.GetBaseSHA()
+func TestPackageVersion_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageVersion{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PackageVersion{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestMergeGroup_GetHeadCommit(tt *testing.T) {
-	m := &MergeGroup{}
-	m.GetHeadCommit()
-	m = nil
-	m.GetHeadCommit()
+func TestPackageVersion_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageVersion{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMergeGroup_GetHeadRef(tt *testing.T) {
+func TestPackageVersion_GetVersion(tt *testing.T) {
 	var zeroValue string
-	m := &MergeGroup{HeadRef: &zeroValue}
-	m.GetHeadRef()
-	m = &MergeGroup{}
-	m.GetHeadRef()
-	m = nil
-	m.GetHeadRef()
+	p := &PackageVersion{Version: &zeroValue}
+	p.GetVersion()
+	p = &PackageVersion{}
+	p.GetVersion()
+	p = nil
+	p.GetVersion()
 }
 
-func TestMergeGroup_GetHeadSHA(tt *testing.T) {
+func TestPage_GetAction(tt *testing.T) {
 	var zeroValue string
-	m := &MergeGroup{HeadSHA: &zeroValue}
-	m.GetHeadSHA()
-	m = &MergeGroup{}
-	m.GetHeadSHA()
-	m = nil
-	m.GetHeadSHA()
+	p := &Page{Action: &zeroValue}
+	p.GetAction()
+	p = &Page{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestMergeGroupEvent_GetAction(tt *testing.T) {
+func TestPage_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	m := &MergeGroupEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MergeGroupEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+	p := &Page{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Page{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestMergeGroupEvent_GetInstallation(tt *testing.T) {
-	m := &MergeGroupEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPage_GetPageName(tt *testing.T) {
+	var zeroValue string
+	p := &Page{PageName: &zeroValue}
+	p.GetPageName()
+	p = &Page{}
+	p.GetPageName()
+	p = nil
+	p.GetPageName()
 }
 
-func TestMergeGroupEvent_GetMergeGroup(tt *testing.T) {
-	m := &MergeGroupEvent{}
-	m.GetMergeGroup()
-	m = nil
-	m.GetMergeGroup()
+func TestPage_GetSHA(tt *testing.T) {
+	var zeroValue string
+	p := &Page{SHA: &zeroValue}
+	p.GetSHA()
+	p = &Page{}
+	p.GetSHA()
+	p = nil
+	p.GetSHA()
 }
 
-func TestMergeGroupEvent_GetOrg(tt *testing.T) {
-	m := &MergeGroupEvent{}
-	m.GetOrg()
-	m = nil
-	m.GetOrg()
+func TestPage_GetSummary(tt *testing.T) {
+	var zeroValue string
+	p := &Page{Summary: &zeroValue}
+	p.GetSummary()
+	p = &Page{}
+	p.GetSummary()
+	p = nil
+	p.GetSummary()
 }
 
-func TestMergeGroupEvent_GetRepo(tt *testing.T) {
-	m := &MergeGroupEvent{}
-	m.GetRepo()
-	m = nil
-	m.GetRepo()
+func TestPage_GetTitle(tt *testing.T) {
+	var zeroValue string
+	p := &Page{Title: &zeroValue}
+	p.GetTitle()
+	p = &Page{}
+	p.GetTitle()
+	p = nil
+	p.GetTitle()
 }
 
-func TestMergeGroupEvent_GetSender(tt *testing.T) {
-	m := &MergeGroupEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPageBuildEvent_GetBuild(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetBuild()
+	p = nil
+	p.GetBuild()
 }
 
-func TestMessage_GetText(tt *testing.T) {
-	var zeroValue string
-	m := &Message{Text: &zeroValue}
-	m.GetText()
-	m = &Message{}
-	m.GetText()
-	m = nil
-	m.GetText()
+func TestPageBuildEvent_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PageBuildEvent{ID: &zeroValue}
+	p.GetID()
+	p = &PageBuildEvent{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMetaEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	m := &MetaEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MetaEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+func TestPageBuildEvent_GetInstallation(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestMetaEvent_GetHook(tt *testing.T) {
-	m := &MetaEvent{}
-	m.GetHook()
-	m = nil
-	m.GetHook()
+func TestPageBuildEvent_GetOrg(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestMetaEvent_GetHookID(tt *testing.T) {
-	var zeroValue int64
-	m := &MetaEvent{HookID: &zeroValue}
-	m.GetHookID()
-	m = &MetaEvent{}
-	m.GetHookID()
-	m = nil
-	m.GetHookID()
+func TestPageBuildEvent_GetRepo(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestMetaEvent_GetInstallation(tt *testing.T) {
-	m := &MetaEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPageBuildEvent_GetSender(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestMetaEvent_GetOrg(tt *testing.T) {
-	m := &MetaEvent{}
-	m.GetOrg()
-	m = nil
-	m.GetOrg()
+func TestPages_GetBuildType(tt *testing.T) {
+	var zeroValue string
+	p := &Pages{BuildType: &zeroValue}
+	p.GetBuildType()
+	p = &Pages{}
+	p.GetBuildType()
+	p = nil
+	p.GetBuildType()
 }
 
-func TestMetaEvent_GetRepo(tt *testing.T) {
-	m := &MetaEvent{}
-	m.GetRepo()
-	m = nil
-	m.GetRepo()
+func TestPages_GetCNAME(tt *testing.T) {
+	var zeroValue string
+	p := &Pages{CNAME: &zeroValue}
+	p.GetCNAME()
+	p = &Pages{}
+	p.GetCNAME()
+	p = nil
+	p.GetCNAME()
 }
 
-func TestMetaEvent_GetSender(tt *testing.T) {
-	m := &MetaEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPages_GetCustom404(tt *testing.T) {
+	var zeroValue bool
+	p := &Pages{Custom404: &zeroValue}
+	p.GetCustom404()
+	p = &Pages{}
+	p.GetCustom404()
+	p = nil
+	p.GetCustom404()
 }
 
-func TestMetric_GetHTMLURL(tt *testing.T) {
+func TestPages_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	m := &Metric{HTMLURL: &zeroValue}
-	m.GetHTMLURL()
-	m = &Metric{}
-	m.GetHTMLURL()
-	m = nil
-	m.GetHTMLURL()
+	p := &Pages{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Pages{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestMetric_GetKey(tt *testing.T) {
-	var zeroValue string
-	m := &Metric{Key: &zeroValue}
-	m.GetKey()
-	m = &Metric{}
-	m.GetKey()
-	m = nil
-	m.GetKey()
+func TestPages_GetHTTPSCertificate(tt *testing.T) {
+	p := &Pages{}
+	p.GetHTTPSCertificate()
+	p = nil
+	p.GetHTTPSCertificate()
 }
 
-func TestMetric_GetName(tt *testing.T) {
-	var zeroValue string
-	m := &Metric{Name: &zeroValue}
-	m.GetName()
-	m = &Metric{}
-	m.GetName()
-	m = nil
-	m.GetName()
+func TestPages_GetHTTPSEnforced(tt *testing.T) {
+	var zeroValue bool
+	p := &Pages{HTTPSEnforced: &zeroValue}
+	p.GetHTTPSEnforced()
+	p = &Pages{}
+	p.GetHTTPSEnforced()
+	p = nil
+	p.GetHTTPSEnforced()
 }
 
-func TestMetric_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	m := &Metric{NodeID: &zeroValue}
-	m.GetNodeID()
-	m = &Metric{}
-	m.GetNodeID()
-	m = nil
-	m.GetNodeID()
+func TestPages_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	p := &Pages{Public: &zeroValue}
+	p.GetPublic()
+	p = &Pages{}
+	p.GetPublic()
+	p = nil
+	p.GetPublic()
 }
 
-func TestMetric_GetSPDXID(tt *testing.T) {
+func TestPages_GetSource(tt *testing.T) {
+	p := &Pages{}
+	p.GetSource()
+	p = nil
+	p.GetSource()
+}
+
+func TestPages_GetStatus(tt *testing.T) {
 	var zeroValue string
-	m := &Metric{SPDXID: &zeroValue}
-	m.GetSPDXID()
-	m = &Metric{}
-	m.GetSPDXID()
-	m = nil
-	m.GetSPDXID()
+	p := &Pages{Status: &zeroValue}
+	p.GetStatus()
+	p = &Pages{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
 }
 
-func TestMetric_GetURL(tt *testing.T) {
+func TestPages_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &Metric{URL: &zeroValue}
-	m.GetURL()
-	m = &Metric{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &Pages{URL: &zeroValue}
+	p.GetURL()
+	p = &Pages{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMigration_GetCreatedAt(tt *testing.T) {
+func TestPagesBuild_GetCommit(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{CreatedAt: &zeroValue}
-	m.GetCreatedAt()
-	m = &Migration{}
-	m.GetCreatedAt()
-	m = nil
-	m.GetCreatedAt()
+	p := &PagesBuild{Commit: &zeroValue}
+	p.GetCommit()
+	p = &PagesBuild{}
+	p.GetCommit()
+	p = nil
+	p.GetCommit()
 }
 
-func TestMigration_GetExcludeAttachments(tt *testing.T) {
-	var zeroValue bool
-	m := &Migration{ExcludeAttachments: &zeroValue}
-	m.GetExcludeAttachments()
-	m = &Migration{}
-	m.GetExcludeAttachments()
-	m = nil
-	m.GetExcludeAttachments()
+func TestPagesBuild_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PagesBuild{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PagesBuild{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMigration_GetGUID(tt *testing.T) {
-	var zeroValue string
-	m := &Migration{GUID: &zeroValue}
-	m.GetGUID()
-	m = &Migration{}
-	m.GetGUID()
-	m = nil
-	m.GetGUID()
+func TestPagesBuild_GetDuration(tt *testing.T) {
+	var zeroValue int
+	p := &PagesBuild{Duration: &zeroValue}
+	p.GetDuration()
+	p = &PagesBuild{}
+	p.GetDuration()
+	p = nil
+	p.GetDuration()
 }
 
-func TestMigration_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &Migration{ID: &zeroValue}
-	m.GetID()
-	m = &Migration{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestPagesBuild_GetError(tt *testing.T) {
+	p := &PagesBuild{}
+	p.GetError()
+	p = nil
+	p.GetError()
 }
 
-func TestMigration_GetLockRepositories(tt *testing.T) {
-	var zeroValue bool
-	m := &Migration{LockRepositories: &zeroValue}
-	m.GetLockRepositories()
-	m = &Migration{}
-	m.GetLockRepositories()
-	m = nil
-	m.GetLockRepositories()
+func TestPagesBuild_GetPusher(tt *testing.T) {
+	p := &PagesBuild{}
+	p.GetPusher()
+	p = nil
+	p.GetPusher()
 }
 
-func TestMigration_GetState(tt *testing.T) {
+func TestPagesBuild_GetStatus(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{State: &zeroValue}
-	m.GetState()
-	m = &Migration{}
-	m.GetState()
-	m = nil
-	m.GetState()
+	p := &PagesBuild{Status: &zeroValue}
+	p.GetStatus()
+	p = &PagesBuild{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
+}
+
+func TestPagesBuild_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PagesBuild{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PagesBuild{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestMigration_GetUpdatedAt(tt *testing.T) {
+func TestPagesBuild_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{UpdatedAt: &zeroValue}
-	m.GetUpdatedAt()
-	m = &Migration{}
-	m.GetUpdatedAt()
-	m = nil
-	m.GetUpdatedAt()
+	p := &PagesBuild{URL: &zeroValue}
+	p.GetURL()
+	p = &PagesBuild{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMigration_GetURL(tt *testing.T) {
+func TestPagesDomain_GetCAAError(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{URL: &zeroValue}
-	m.GetURL()
-	m = &Migration{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &PagesDomain{CAAError: &zeroValue}
+	p.GetCAAError()
+	p = &PagesDomain{}
+	p.GetCAAError()
+	p = nil
+	p.GetCAAError()
 }
 
-func TestMilestone_GetClosedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &Milestone{ClosedAt: &zeroValue}
-	m.GetClosedAt()
-	m = &Milestone{}
-	m.GetClosedAt()
-	m = nil
-	m.GetClosedAt()
+func TestPagesDomain_GetDNSResolves(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{DNSResolves: &zeroValue}
+	p.GetDNSResolves()
+	p = &PagesDomain{}
+	p.GetDNSResolves()
+	p = nil
+	p.GetDNSResolves()
 }
 
-func TestMilestone_GetClosedIssues(tt *testing.T) {
-	var zeroValue int
-	m := &Milestone{ClosedIssues: &zeroValue}
-	m.GetClosedIssues()
-	m = &Milestone{}
-	m.GetClosedIssues()
-	m = nil
-	m.GetClosedIssues()
+func TestPagesDomain_GetEnforcesHTTPS(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{EnforcesHTTPS: &zeroValue}
+	p.GetEnforcesHTTPS()
+	p = &PagesDomain{}
+	p.GetEnforcesHTTPS()
+	p = nil
+	p.GetEnforcesHTTPS()
 }
 
-func TestMilestone_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &Milestone{CreatedAt: &zeroValue}
-	m.GetCreatedAt()
-	m = &Milestone{}
-	m.GetCreatedAt()
-	m = nil
-	m.GetCreatedAt()
+func TestPagesDomain_GetHasCNAMERecord(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{HasCNAMERecord: &zeroValue}
+	p.GetHasCNAMERecord()
+	p = &PagesDomain{}
+	p.GetHasCNAMERecord()
+	p = nil
+	p.GetHasCNAMERecord()
 }
 
-func TestMilestone_GetCreator(tt *testing.T) {
-	m := &Milestone{}
-	m.GetCreator()
-	m = nil
-	m.GetCreator()
+func TestPagesDomain_GetHasMXRecordsPresent(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{HasMXRecordsPresent: &zeroValue}
+	p.GetHasMXRecordsPresent()
+	p = &PagesDomain{}
+	p.GetHasMXRecordsPresent()
+	p = nil
+	p.GetHasMXRecordsPresent()
 }
 
-func TestMilestone_GetDescription(tt *testing.T) {
+func TestPagesDomain_GetHost(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{Description: &zeroValue}
-	m.GetDescription()
-	m = &Milestone{}
-	m.GetDescription()
-	m = nil
-	m.GetDescription()
-}
-
-func TestMilestone_GetDueOn(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &Milestone{DueOn: &zeroValue}
-	m.GetDueOn()
-	m = &Milestone{}
-	m.GetDueOn()
-	m = nil
-	m.GetDueOn()
+	p := &PagesDomain{Host: &zeroValue}
+	p.GetHost()
+	p = &PagesDomain{}
+	p.GetHost()
+	p = nil
+	p.GetHost()
 }
 
-func TestMilestone_GetHTMLURL(tt *testing.T) {
+func TestPagesDomain_GetHTTPSError(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{HTMLURL: &zeroValue}
-	m.GetHTMLURL()
-	m = &Milestone{}
-	m.GetHTMLURL()
-	m = nil
-	m.GetHTMLURL()
+	p := &PagesDomain{HTTPSError: &zeroValue}
+	p.GetHTTPSError()
+	p = &PagesDomain{}
+	p.GetHTTPSError()
+	p = nil
+	p.GetHTTPSError()
 }
 
-func TestMilestone_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &Milestone{ID: &zeroValue}
-	m.GetID()
-	m = &Milestone{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestPagesDomain_GetIsApexDomain(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsApexDomain: &zeroValue}
+	p.GetIsApexDomain()
+	p = &PagesDomain{}
+	p.GetIsApexDomain()
+	p = nil
+	p.GetIsApexDomain()
 }
 
-func TestMilestone_GetLabelsURL(tt *testing.T) {
-	var zeroValue string
-	m := &Milestone{LabelsURL: &zeroValue}
-	m.GetLabelsURL()
-	m = &Milestone{}
-	m.GetLabelsURL()
-	m = nil
-	m.GetLabelsURL()
+func TestPagesDomain_GetIsARecord(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsARecord: &zeroValue}
+	p.GetIsARecord()
+	p = &PagesDomain{}
+	p.GetIsARecord()
+	p = nil
+	p.GetIsARecord()
 }
 
-func TestMilestone_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	m := &Milestone{NodeID: &zeroValue}
-	m.GetNodeID()
-	m = &Milestone{}
-	m.GetNodeID()
-	m = nil
-	m.GetNodeID()
+func TestPagesDomain_GetIsCloudflareIP(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsCloudflareIP: &zeroValue}
+	p.GetIsCloudflareIP()
+	p = &PagesDomain{}
+	p.GetIsCloudflareIP()
+	p = nil
+	p.GetIsCloudflareIP()
 }
 
-func TestMilestone_GetNumber(tt *testing.T) {
-	var zeroValue int
-	m := &Milestone{Number: &zeroValue}
-	m.GetNumber()
-	m = &Milestone{}
-	m.GetNumber()
-	m = nil
-	m.GetNumber()
+func TestPagesDomain_GetIsCNAMEToFastly(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsCNAMEToFastly: &zeroValue}
+	p.GetIsCNAMEToFastly()
+	p = &PagesDomain{}
+	p.GetIsCNAMEToFastly()
+	p = nil
+	p.GetIsCNAMEToFastly()
 }
 
-func TestMilestone_GetOpenIssues(tt *testing.T) {
-	var zeroValue int
-	m := &Milestone{OpenIssues: &zeroValue}
-	m.GetOpenIssues()
-	m = &Milestone{}
-	m.GetOpenIssues()
-	m = nil
-	m.GetOpenIssues()
+func TestPagesDomain_GetIsCNAMEToGithubUserDomain(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsCNAMEToGithubUserDomain: &zeroValue}
+	p.GetIsCNAMEToGithubUserDomain()
+	p = &PagesDomain{}
+	p.GetIsCNAMEToGithubUserDomain()
+	p = nil
+	p.GetIsCNAMEToGithubUserDomain()
 }
 
-func TestMilestone_GetState(tt *testing.T) {
-	var zeroValue string
-	m := &Milestone{State: &zeroValue}
-	m.GetState()
-	m = &Milestone{}
-	m.GetState()
-	m = nil
-	m.GetState()
+func TestPagesDomain_GetIsCNAMEToPagesDotGithubDotCom(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsCNAMEToPagesDotGithubDotCom: &zeroValue}
+	p.GetIsCNAMEToPagesDotGithubDotCom()
+	p = &PagesDomain{}
+	p.GetIsCNAMEToPagesDotGithubDotCom()
+	p = nil
+	p.GetIsCNAMEToPagesDotGithubDotCom()
 }
 
-func TestMilestone_GetTitle(tt *testing.T) {
-	var zeroValue string
-	m := &Milestone{Title: &zeroValue}
-	m.GetTitle()
-	m = &Milestone{}
-	m.GetTitle()
-	m = nil
-	m.GetTitle()
+func TestPagesDomain_GetIsFastlyIP(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsFastlyIP: &zeroValue}
+	p.GetIsFastlyIP()
+	p = &PagesDomain{}
+	p.GetIsFastlyIP()
+	p = nil
+	p.GetIsFastlyIP()
 }
 
-func TestMilestone_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &Milestone{UpdatedAt: &zeroValue}
-	m.GetUpdatedAt()
-	m = &Milestone{}
-	m.GetUpdatedAt()
-	m = nil
-	m.GetUpdatedAt()
+func TestPagesDomain_GetIsHTTPSEligible(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsHTTPSEligible: &zeroValue}
+	p.GetIsHTTPSEligible()
+	p = &PagesDomain{}
+	p.GetIsHTTPSEligible()
+	p = nil
+	p.GetIsHTTPSEligible()
 }
 
-func TestMilestone_GetURL(tt *testing.T) {
-	var zeroValue string
-	m := &Milestone{URL: &zeroValue}
-	m.GetURL()
-	m = &Milestone{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+func TestPagesDomain_GetIsNonGithubPagesIPPresent(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsNonGithubPagesIPPresent: &zeroValue}
+	p.GetIsNonGithubPagesIPPresent()
+	p = &PagesDomain{}
+	p.GetIsNonGithubPagesIPPresent()
+	p = nil
+	p.GetIsNonGithubPagesIPPresent()
 }
 
-func TestMilestoneEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	m := &MilestoneEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MilestoneEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+func TestPagesDomain_GetIsOldIPAddress(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsOldIPAddress: &zeroValue}
+	p.GetIsOldIPAddress()
+	p = &PagesDomain{}
+	p.GetIsOldIPAddress()
+	p = nil
+	p.GetIsOldIPAddress()
 }
 
-func TestMilestoneEvent_GetChanges(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetChanges()
-	m = nil
-	m.GetChanges()
+func TestPagesDomain_GetIsPagesDomain(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsPagesDomain: &zeroValue}
+	p.GetIsPagesDomain()
+	p = &PagesDomain{}
+	p.GetIsPagesDomain()
+	p = nil
+	p.GetIsPagesDomain()
 }
 
-func TestMilestoneEvent_GetInstallation(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPagesDomain_GetIsPointedToGithubPagesIP(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsPointedToGithubPagesIP: &zeroValue}
+	p.GetIsPointedToGithubPagesIP()
+	p = &PagesDomain{}
+	p.GetIsPointedToGithubPagesIP()
+	p = nil
+	p.GetIsPointedToGithubPagesIP()
 }
 
-func TestMilestoneEvent_GetMilestone(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetMilestone()
-	m = nil
-	m.GetMilestone()
+func TestPagesDomain_GetIsProxied(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsProxied: &zeroValue}
+	p.GetIsProxied()
+	p = &PagesDomain{}
+	p.GetIsProxied()
+	p = nil
+	p.GetIsProxied()
 }
 
-func TestMilestoneEvent_GetOrg(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetOrg()
-	m = nil
-	m.GetOrg()
+func TestPagesDomain_GetIsServedByPages(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsServedByPages: &zeroValue}
+	p.GetIsServedByPages()
+	p = &PagesDomain{}
+	p.GetIsServedByPages()
+	p = nil
+	p.GetIsServedByPages()
 }
 
-func TestMilestoneEvent_GetRepo(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetRepo()
-	m = nil
-	m.GetRepo()
+func TestPagesDomain_GetIsValid(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsValid: &zeroValue}
+	p.GetIsValid()
+	p = &PagesDomain{}
+	p.GetIsValid()
+	p = nil
+	p.GetIsValid()
 }
 
-func TestMilestoneEvent_GetSender(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPagesDomain_GetIsValidDomain(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{IsValidDomain: &zeroValue}
+	p.GetIsValidDomain()
+	p = &PagesDomain{}
+	p.GetIsValidDomain()
+	p = nil
+	p.GetIsValidDomain()
 }
 
-func TestMilestoneStats_GetClosedMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{ClosedMilestones: &zeroValue}
-	m.GetClosedMilestones()
-	m = &MilestoneStats{}
-	m.GetClosedMilestones()
-	m = nil
-	m.GetClosedMilestones()
+func TestPagesDomain_GetNameservers(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDomain{Nameservers: &zeroValue}
+	p.GetNameservers()
+	p = &PagesDomain{}
+	p.GetNameservers()
+	p = nil
+	p.GetNameservers()
 }
 
-func TestMilestoneStats_GetOpenMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{OpenMilestones: &zeroValue}
-	m.GetOpenMilestones()
-	m = &MilestoneStats{}
-	m.GetOpenMilestones()
-	m = nil
-	m.GetOpenMilestones()
+func TestPagesDomain_GetReason(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDomain{Reason: &zeroValue}
+	p.GetReason()
+	p = &PagesDomain{}
+	p.GetReason()
+	p = nil
+	p.GetReason()
 }
 
-func TestMilestoneStats_GetTotalMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{TotalMilestones: &zeroValue}
-	m.GetTotalMilestones()
-	m = &MilestoneStats{}
-	m.GetTotalMilestones()
-	m = nil
-	m.GetTotalMilestones()
+func TestPagesDomain_GetRespondsToHTTPS(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{RespondsToHTTPS: &zeroValue}
+	p.GetRespondsToHTTPS()
+	p = &PagesDomain{}
+	p.GetRespondsToHTTPS()
+	p = nil
+	p.GetRespondsToHTTPS()
 }
 
-func TestMostRecentInstance_GetAnalysisKey(tt *testing.T) {
-	var zeroValue string
-	m := &MostRecentInstance{AnalysisKey: &zeroValue}
-	m.GetAnalysisKey()
-	m = &MostRecentInstance{}
-	m.GetAnalysisKey()
-	m = nil
-	m.GetAnalysisKey()
+func TestPagesDomain_GetShouldBeARecord(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesDomain{ShouldBeARecord: &zeroValue}
+	p.GetShouldBeARecord()
+	p = &PagesDomain{}
+	p.GetShouldBeARecord()
+	p = nil
+	p.GetShouldBeARecord()
 }
 
-func TestMostRecentInstance_GetCommitSHA(tt *testing.T) {
+func TestPagesDomain_GetURI(tt *testing.T) {
 	var zeroValue string
-	m := &MostRecentInstance{CommitSHA: &zeroValue}
-	m.GetCommitSHA()
-	m = &MostRecentInstance{}
-	m.GetCommitSHA()
-	m = nil
-	m.GetCommitSHA()
+	p := &PagesDomain{URI: &zeroValue}
+	p.GetURI()
+	p = &PagesDomain{}
+	p.GetURI()
+	p = nil
+	p.GetURI()
 }
 
-func TestMostRecentInstance_GetEnvironment(tt *testing.T) {
+func TestPagesError_GetMessage(tt *testing.T) {
 	var zeroValue string
-	m := &MostRecentInstance{Environment: &zeroValue}
-	m.GetEnvironment()
-	m = &MostRecentInstance{}
-	m.GetEnvironment()
-	m = nil
-	m.GetEnvironment()
+	p := &PagesError{Message: &zeroValue}
+	p.GetMessage()
+	p = &PagesError{}
+	p.GetMessage()
+	p = nil
+	p.GetMessage()
 }
 
-func TestMostRecentInstance_GetLocation(tt *testing.T) {
-	m := &MostRecentInstance{}
-	m.GetLocation()
-	m = nil
-	m.GetLocation()
+func TestPagesHealthCheckResponse_GetAltDomain(tt *testing.T) {
+	p := &PagesHealthCheckResponse{}
+	p.GetAltDomain()
+	p = nil
+	p.GetAltDomain()
 }
 
-func TestMostRecentInstance_GetMessage(tt *testing.T) {
-	m := &MostRecentInstance{}
-	m.GetMessage()
-	m = nil
-	m.GetMessage()
+func TestPagesHealthCheckResponse_GetDomain(tt *testing.T) {
+	p := &PagesHealthCheckResponse{}
+	p.GetDomain()
+	p = nil
+	p.GetDomain()
 }
 
-func TestMostRecentInstance_GetRef(tt *testing.T) {
+func TestPagesHTTPSCertificate_GetDescription(tt *testing.T) {
 	var zeroValue string
-	m := &MostRecentInstance{Ref: &zeroValue}
-	m.GetRef()
-	m = &MostRecentInstance{}
-	m.GetRef()
-	m = nil
-	m.GetRef()
+	p := &PagesHTTPSCertificate{Description: &zeroValue}
+	p.GetDescription()
+	p = &PagesHTTPSCertificate{}
+	p.GetDescription()
+	p = nil
+	p.GetDescription()
 }
 
-func TestMostRecentInstance_GetState(tt *testing.T) {
+func TestPagesHTTPSCertificate_GetExpiresAt(tt *testing.T) {
 	var zeroValue string
-	m := &MostRecentInstance{State: &zeroValue}
-	m.GetState()
-	m = &MostRecentInstance{}
-	m.GetState()
-	m = nil
-	m.GetState()
+	p := &PagesHTTPSCertificate{ExpiresAt: &zeroValue}
+	p.GetExpiresAt()
+	p = &PagesHTTPSCertificate{}
+	p.GetExpiresAt()
+	p = nil
+	p.GetExpiresAt()
 }
 
-func TestNewPullRequest_GetBase(tt *testing.T) {
+func TestPagesHTTPSCertificate_GetState(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Base: &zeroValue}
-	n.GetBase()
-	n = &NewPullRequest{}
-	n.GetBase()
-	n = nil
-	n.GetBase()
+	p := &PagesHTTPSCertificate{State: &zeroValue}
+	p.GetState()
+	p = &PagesHTTPSCertificate{}
+	p.GetState()
+	p = nil
+	p.GetState()
 }
 
-func TestNewPullRequest_GetBody(tt *testing.T) {
+func TestPagesSource_GetBranch(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Body: &zeroValue}
-	n.GetBody()
-	n = &NewPullRequest{}
-	n.GetBody()
-	n = nil
-	n.GetBody()
+	p := &PagesSource{Branch: &zeroValue}
+	p.GetBranch()
+	p = &PagesSource{}
+	p.GetBranch()
+	p = nil
+	p.GetBranch()
 }
 
-func TestNewPullRequest_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	n := &NewPullRequest{Draft: &zeroValue}
-	n.GetDraft()
-	n = &NewPullRequest{}
-	n.GetDraft()
-	n = nil
-	n.GetDraft()
+func TestPagesSource_GetPath(tt *testing.T) {
+	var zeroValue string
+	p := &PagesSource{Path: &zeroValue}
+	p.GetPath()
+	p = &PagesSource{}
+	p.GetPath()
+	p = nil
+	p.GetPath()
 }
 
-func TestNewPullRequest_GetHead(tt *testing.T) {
+func TestPageStats_GetTotalPages(tt *testing.T) {
+	var zeroValue int
+	p := &PageStats{TotalPages: &zeroValue}
+	p.GetTotalPages()
+	p = &PageStats{}
+	p.GetTotalPages()
+	p = nil
+	p.GetTotalPages()
+}
+
+func TestPagesUpdate_GetBuildType(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Head: &zeroValue}
-	n.GetHead()
-	n = &NewPullRequest{}
-	n.GetHead()
-	n = nil
-	n.GetHead()
+	p := &PagesUpdate{BuildType: &zeroValue}
+	p.GetBuildType()
+	p = &PagesUpdate{}
+	p.GetBuildType()
+	p = nil
+	p.GetBuildType()
 }
 
-func TestNewPullRequest_GetIssue(tt *testing.T) {
-	var zeroValue int
-	n := &NewPullRequest{Issue: &zeroValue}
-	n.GetIssue()
-	n = &NewPullRequest{}
-	n.GetIssue()
-	n = nil
-	n.GetIssue()
+func TestPagesUpdate_GetCNAME(tt *testing.T) {
+	var zeroValue string
+	p := &PagesUpdate{CNAME: &zeroValue}
+	p.GetCNAME()
+	p = &PagesUpdate{}
+	p.GetCNAME()
+	p = nil
+	p.GetCNAME()
 }
 
-func TestNewPullRequest_GetMaintainerCanModify(tt *testing.T) {
+func TestPagesUpdate_GetHTTPSEnforced(tt *testing.T) {
 	var zeroValue bool
-	n := &NewPullRequest{MaintainerCanModify: &zeroValue}
-	n.GetMaintainerCanModify()
-	n = &NewPullRequest{}
-	n.GetMaintainerCanModify()
-	n = nil
-	n.GetMaintainerCanModify()
+	p := &PagesUpdate{HTTPSEnforced: &zeroValue}
+	p.GetHTTPSEnforced()
+	p = &PagesUpdate{}
+	p.GetHTTPSEnforced()
+	p = nil
+	p.GetHTTPSEnforced()
 }
 
-func TestNewPullRequest_GetTitle(tt *testing.T) {
-	var zeroValue string
-	n := &NewPullRequest{Title: &zeroValue}
-	n.GetTitle()
-	n = &NewPullRequest{}
-	n.GetTitle()
-	n = nil
-	n.GetTitle()
+func TestPagesUpdate_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	p := &PagesUpdate{Public: &zeroValue}
+	p.GetPublic()
+	p = &PagesUpdate{}
+	p.GetPublic()
+	p = nil
+	p.GetPublic()
 }
 
-func TestNewTeam_GetDescription(tt *testing.T) {
-	var zeroValue string
-	n := &NewTeam{Description: &zeroValue}
-	n.GetDescription()
-	n = &NewTeam{}
-	n.GetDescription()
-	n = nil
-	n.GetDescription()
+func TestPagesUpdate_GetSource(tt *testing.T) {
+	p := &PagesUpdate{}
+	p.GetSource()
+	p = nil
+	p.GetSource()
 }
 
-func TestNewTeam_GetLDAPDN(tt *testing.T) {
-	var zeroValue string
-	n := &NewTeam{LDAPDN: &zeroValue}
-	n.GetLDAPDN()
-	n = &NewTeam{}
-	n.GetLDAPDN()
-	n = nil
-	n.GetLDAPDN()
+func TestPersonalAccessToken_GetAccessGrantedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{AccessGrantedAt: &zeroValue}
+	p.GetAccessGrantedAt()
+	p = &PersonalAccessToken{}
+	p.GetAccessGrantedAt()
+	p = nil
+	p.GetAccessGrantedAt()
 }
 
-func TestNewTeam_GetParentTeamID(tt *testing.T) {
+func TestPersonalAccessToken_GetID(tt *testing.T) {
 	var zeroValue int64
-	n := &NewTeam{ParentTeamID: &zeroValue}
-	n.GetParentTeamID()
-	n = &NewTeam{}
-	n.GetParentTeamID()
-	n = nil
-	n.GetParentTeamID()
+	p := &PersonalAccessToken{ID: &zeroValue}
+	p.GetID()
+	p = &PersonalAccessToken{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestNewTeam_GetPermission(tt *testing.T) {
+func TestPersonalAccessToken_GetOwner(tt *testing.T) {
+	p := &PersonalAccessToken{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
+}
+
+func TestPersonalAccessToken_GetPermissions(tt *testing.T) {
+	p := &PersonalAccessToken{}
+	p.GetPermissions()
+	p = nil
+	p.GetPermissions()
+}
+
+func TestPersonalAccessToken_GetRepositoriesURL(tt *testing.T) {
 	var zeroValue string
-	n := &NewTeam{Permission: &zeroValue}
-	n.GetPermission()
-	n = &NewTeam{}
-	n.GetPermission()
-	n = nil
-	n.GetPermission()
+	p := &PersonalAccessToken{RepositoriesURL: &zeroValue}
+	p.GetRepositoriesURL()
+	p = &PersonalAccessToken{}
+	p.GetRepositoriesURL()
+	p = nil
+	p.GetRepositoriesURL()
 }
 
-func TestNewTeam_GetPrivacy(tt *testing.T) {
+func TestPersonalAccessToken_GetRepositorySelection(tt *testing.T) {
 	var zeroValue string
-	n := &NewTeam{Privacy: &zeroValue}
-	n.GetPrivacy()
-	n = &NewTeam{}
-	n.GetPrivacy()
-	n = nil
-	n.GetPrivacy()
+	p := &PersonalAccessToken{RepositorySelection: &zeroValue}
+	p.GetRepositorySelection()
+	p = &PersonalAccessToken{}
+	p.GetRepositorySelection()
+	p = nil
+	p.GetRepositorySelection()
+}
+
+func TestPersonalAccessToken_GetTokenExpired(tt *testing.T) {
+	var zeroValue bool
+	p := &PersonalAccessToken{TokenExpired: &zeroValue}
+	p.GetTokenExpired()
+	p = &PersonalAccessToken{}
+	p.GetTokenExpired()
+	p = nil
+	p.GetTokenExpired()
 }
 
-func TestNotification_GetID(tt *testing.T) {
-	var zeroValue string
-	n := &Notification{ID: &zeroValue}
-	n.GetID()
-	n = &Notification{}
-	n.GetID()
-	n = nil
-	n.GetID()
+func TestPersonalAccessToken_GetTokenExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{TokenExpiresAt: &zeroValue}
+	p.GetTokenExpiresAt()
+	p = &PersonalAccessToken{}
+	p.GetTokenExpiresAt()
+	p = nil
+	p.GetTokenExpiresAt()
 }
 
-func TestNotification_GetLastReadAt(tt *testing.T) {
+func TestPersonalAccessToken_GetTokenID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessToken{TokenID: &zeroValue}
+	p.GetTokenID()
+	p = &PersonalAccessToken{}
+	p.GetTokenID()
+	p = nil
+	p.GetTokenID()
+}
+
+func TestPersonalAccessToken_GetTokenLastUsedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	n := &Notification{LastReadAt: &zeroValue}
-	n.GetLastReadAt()
-	n = &Notification{}
-	n.GetLastReadAt()
-	n = nil
-	n.GetLastReadAt()
+	p := &PersonalAccessToken{TokenLastUsedAt: &zeroValue}
+	p.GetTokenLastUsedAt()
+	p = &PersonalAccessToken{}
+	p.GetTokenLastUsedAt()
+	p = nil
+	p.GetTokenLastUsedAt()
 }
 
-func TestNotification_GetReason(tt *testing.T) {
+func TestPersonalAccessToken_GetTokenName(tt *testing.T) {
 	var zeroValue string
-	n := &Notification{Reason: &zeroValue}
-	n.GetReason()
-	n = &Notification{}
-	n.GetReason()
-	n = nil
-	n.GetReason()
+	p := &PersonalAccessToken{TokenName: &zeroValue}
+	p.GetTokenName()
+	p = &PersonalAccessToken{}
+	p.GetTokenName()
+	p = nil
+	p.GetTokenName()
 }
 
-func TestNotification_GetRepository(tt *testing.T) {
-	n := &Notification{}
-	n.GetRepository()
-	n = nil
-	n.GetRepository()
+func TestPersonalAccessTokenPermissions_GetOrg(tt *testing.T) {
+	zeroValue := map[string]string{}
+	p := &PersonalAccessTokenPermissions{Org: zeroValue}
+	p.GetOrg()
+	p = &PersonalAccessTokenPermissions{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestNotification_GetSubject(tt *testing.T) {
-	n := &Notification{}
-	n.GetSubject()
-	n = nil
-	n.GetSubject()
+func TestPersonalAccessTokenPermissions_GetOther(tt *testing.T) {
+	zeroValue := map[string]string{}
+	p := &PersonalAccessTokenPermissions{Other: zeroValue}
+	p.GetOther()
+	p = &PersonalAccessTokenPermissions{}
+	p.GetOther()
+	p = nil
+	p.GetOther()
 }
 
-func TestNotification_GetUnread(tt *testing.T) {
-	var zeroValue bool
-	n := &Notification{Unread: &zeroValue}
-	n.GetUnread()
-	n = &Notification{}
-	n.GetUnread()
-	n = nil
-	n.GetUnread()
+func TestPersonalAccessTokenPermissions_GetRepo(tt *testing.T) {
+	zeroValue := map[string]string{}
+	p := &PersonalAccessTokenPermissions{Repo: zeroValue}
+	p.GetRepo()
+	p = &PersonalAccessTokenPermissions{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestNotification_GetUpdatedAt(tt *testing.T) {
+func TestPersonalAccessTokenRequest_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	n := &Notification{UpdatedAt: &zeroValue}
-	n.GetUpdatedAt()
-	n = &Notification{}
-	n.GetUpdatedAt()
-	n = nil
-	n.GetUpdatedAt()
+	p := &PersonalAccessTokenRequest{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestNotification_GetURL(tt *testing.T) {
-	var zeroValue string
-	n := &Notification{URL: &zeroValue}
-	n.GetURL()
-	n = &Notification{}
-	n.GetURL()
-	n = nil
-	n.GetURL()
+func TestPersonalAccessTokenRequest_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessTokenRequest{ID: &zeroValue}
+	p.GetID()
+	p = &PersonalAccessTokenRequest{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestNotificationSubject_GetLatestCommentURL(tt *testing.T) {
-	var zeroValue string
-	n := &NotificationSubject{LatestCommentURL: &zeroValue}
-	n.GetLatestCommentURL()
-	n = &NotificationSubject{}
-	n.GetLatestCommentURL()
-	n = nil
-	n.GetLatestCommentURL()
+func TestPersonalAccessTokenRequest_GetOwner(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
 }
 
-func TestNotificationSubject_GetTitle(tt *testing.T) {
-	var zeroValue string
-	n := &NotificationSubject{Title: &zeroValue}
-	n.GetTitle()
-	n = &NotificationSubject{}
-	n.GetTitle()
-	n = nil
-	n.GetTitle()
+func TestPersonalAccessTokenRequest_GetPermissions(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetPermissions()
+	p = nil
+	p.GetPermissions()
 }
 
-func TestNotificationSubject_GetType(tt *testing.T) {
+func TestPersonalAccessTokenRequest_GetReason(tt *testing.T) {
 	var zeroValue string
-	n := &NotificationSubject{Type: &zeroValue}
-	n.GetType()
-	n = &NotificationSubject{}
-	n.GetType()
-	n = nil
-	n.GetType()
+	p := &PersonalAccessTokenRequest{Reason: &zeroValue}
+	p.GetReason()
+	p = &PersonalAccessTokenRequest{}
+	p.GetReason()
+	p = nil
+	p.GetReason()
 }
 
-func TestNotificationSubject_GetURL(tt *testing.T) {
+func TestPersonalAccessTokenRequest_GetRepositoriesURL(tt *testing.T) {
 	var zeroValue string
-	n := &NotificationSubject{URL: &zeroValue}
-	n.GetURL()
-	n = &NotificationSubject{}
-	n.GetURL()
-	n = nil
-	n.GetURL()
+	p := &PersonalAccessTokenRequest{RepositoriesURL: &zeroValue}
+	p.GetRepositoriesURL()
+	p = &PersonalAccessTokenRequest{}
+	p.GetRepositoriesURL()
+	p = nil
+	p.GetRepositoriesURL()
 }
 
-func TestOAuthAPP_GetClientID(tt *testing.T) {
+func TestPersonalAccessTokenRequest_GetRepositorySelection(tt *testing.T) {
 	var zeroValue string
-	o := &OAuthAPP{ClientID: &zeroValue}
-	o.GetClientID()
-	o = &OAuthAPP{}
-	o.GetClientID()
-	o = nil
-	o.GetClientID()
+	p := &PersonalAccessTokenRequest{RepositorySelection: &zeroValue}
+	p.GetRepositorySelection()
+	p = &PersonalAccessTokenRequest{}
+	p.GetRepositorySelection()
+	p = nil
+	p.GetRepositorySelection()
 }
 
-func TestOAuthAPP_GetName(tt *testing.T) {
-	var zeroValue string
-	o := &OAuthAPP{Name: &zeroValue}
-	o.GetName()
-	o = &OAuthAPP{}
-	o.GetName()
-	o = nil
-	o.GetName()
+func TestPersonalAccessTokenRequest_GetTokenExpired(tt *testing.T) {
+	var zeroValue bool
+	p := &PersonalAccessTokenRequest{TokenExpired: &zeroValue}
+	p.GetTokenExpired()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenExpired()
+	p = nil
+	p.GetTokenExpired()
 }
 
-func TestOAuthAPP_GetURL(tt *testing.T) {
-	var zeroValue string
-	o := &OAuthAPP{URL: &zeroValue}
-	o.GetURL()
-	o = &OAuthAPP{}
-	o.GetURL()
-	o = nil
-	o.GetURL()
+func TestPersonalAccessTokenRequest_GetTokenExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessTokenRequest{TokenExpiresAt: &zeroValue}
+	p.GetTokenExpiresAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenExpiresAt()
+	p = nil
+	p.GetTokenExpiresAt()
 }
 
-func TestOIDCSubjectClaimCustomTemplate_GetUseDefault(tt *testing.T) {
-	var zeroValue bool
-	o := &OIDCSubjectClaimCustomTemplate{UseDefault: &zeroValue}
-	o.GetUseDefault()
-	o = &OIDCSubjectClaimCustomTemplate{}
-	o.GetUseDefault()
-	o = nil
-	o.GetUseDefault()
+func TestPersonalAccessTokenRequest_GetTokenID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessTokenRequest{TokenID: &zeroValue}
+	p.GetTokenID()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenID()
+	p = nil
+	p.GetTokenID()
 }
 
-func TestOrganization_GetAdvancedSecurityEnabledForNewRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{AdvancedSecurityEnabledForNewRepos: &zeroValue}
-	o.GetAdvancedSecurityEnabledForNewRepos()
-	o = &Organization{}
-	o.GetAdvancedSecurityEnabledForNewRepos()
-	o = nil
-	o.GetAdvancedSecurityEnabledForNewRepos()
+func TestPersonalAccessTokenRequest_GetTokenLastUsedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessTokenRequest{TokenLastUsedAt: &zeroValue}
+	p.GetTokenLastUsedAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenLastUsedAt()
+	p = nil
+	p.GetTokenLastUsedAt()
 }
 
-func TestOrganization_GetAvatarURL(tt *testing.T) {
+func TestPersonalAccessTokenRequest_GetTokenName(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{AvatarURL: &zeroValue}
-	o.GetAvatarURL()
-	o = &Organization{}
-	o.GetAvatarURL()
-	o = nil
-	o.GetAvatarURL()
+	p := &PersonalAccessTokenRequest{TokenName: &zeroValue}
+	p.GetTokenName()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenName()
+	p = nil
+	p.GetTokenName()
 }
 
-func TestOrganization_GetBillingEmail(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{BillingEmail: &zeroValue}
-	o.GetBillingEmail()
-	o = &Organization{}
-	o.GetBillingEmail()
-	o = nil
-	o.GetBillingEmail()
+func TestPingEvent_GetHook(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetHook()
+	p = nil
+	p.GetHook()
 }
 
-func TestOrganization_GetBlog(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Blog: &zeroValue}
-	o.GetBlog()
-	o = &Organization{}
-	o.GetBlog()
-	o = nil
-	o.GetBlog()
+func TestPingEvent_GetHookID(tt *testing.T) {
+	var zeroValue int64
+	p := &PingEvent{HookID: &zeroValue}
+	p.GetHookID()
+	p = &PingEvent{}
+	p.GetHookID()
+	p = nil
+	p.GetHookID()
 }
 
-func TestOrganization_GetCollaborators(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Collaborators: &zeroValue}
-	o.GetCollaborators()
-	o = &Organization{}
-	o.GetCollaborators()
-	o = nil
-	o.GetCollaborators()
+func TestPingEvent_GetInstallation(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestOrganization_GetCompany(tt *testing.T) {
+func TestPingEvent_GetOrg(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
+}
+
+func TestPingEvent_GetRepo(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
+}
+
+func TestPingEvent_GetSender(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
+}
+
+func TestPingEvent_GetZen(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Company: &zeroValue}
-	o.GetCompany()
-	o = &Organization{}
-	o.GetCompany()
-	o = nil
-	o.GetCompany()
+	p := &PingEvent{Zen: &zeroValue}
+	p.GetZen()
+	p = &PingEvent{}
+	p.GetZen()
+	p = nil
+	p.GetZen()
+}
+
+func TestPlan_GetCollaborators(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Collaborators: &zeroValue}
+	p.GetCollaborators()
+	p = &Plan{}
+	p.GetCollaborators()
+	p = nil
+	p.GetCollaborators()
 }
 
-func TestOrganization_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	o := &Organization{CreatedAt: &zeroValue}
-	o.GetCreatedAt()
-	o = &Organization{}
-	o.GetCreatedAt()
-	o = nil
-	o.GetCreatedAt()
+func TestPlan_GetFilledSeats(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{FilledSeats: &zeroValue}
+	p.GetFilledSeats()
+	p = &Plan{}
+	p.GetFilledSeats()
+	p = nil
+	p.GetFilledSeats()
 }
 
-func TestOrganization_GetDefaultRepoPermission(tt *testing.T) {
+func TestPlan_GetName(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{DefaultRepoPermission: &zeroValue}
-	o.GetDefaultRepoPermission()
-	o = &Organization{}
-	o.GetDefaultRepoPermission()
-	o = nil
-	o.GetDefaultRepoPermission()
+	p := &Plan{Name: &zeroValue}
+	p.GetName()
+	p = &Plan{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrganization_GetDefaultRepoSettings(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{DefaultRepoSettings: &zeroValue}
-	o.GetDefaultRepoSettings()
-	o = &Organization{}
-	o.GetDefaultRepoSettings()
-	o = nil
-	o.GetDefaultRepoSettings()
+func TestPlan_GetPrivateRepos(tt *testing.T) {
+	var zeroValue int64
+	p := &Plan{PrivateRepos: &zeroValue}
+	p.GetPrivateRepos()
+	p = &Plan{}
+	p.GetPrivateRepos()
+	p = nil
+	p.GetPrivateRepos()
 }
 
-func TestOrganization_GetDependabotAlertsEnabledForNewRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{DependabotAlertsEnabledForNewRepos: &zeroValue}
-	o.GetDependabotAlertsEnabledForNewRepos()
-	o = &Organization{}
-	o.GetDependabotAlertsEnabledForNewRepos()
-	o = nil
-	o.GetDependabotAlertsEnabledForNewRepos()
+func TestPlan_GetSeats(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Seats: &zeroValue}
+	p.GetSeats()
+	p = &Plan{}
+	p.GetSeats()
+	p = nil
+	p.GetSeats()
 }
 
-func TestOrganization_GetDependabotSecurityUpdatesEnabledForNewRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{DependabotSecurityUpdatesEnabledForNewRepos: &zeroValue}
-	o.GetDependabotSecurityUpdatesEnabledForNewRepos()
-	o = &Organization{}
-	o.GetDependabotSecurityUpdatesEnabledForNewRepos()
-	o = nil
-	o.GetDependabotSecurityUpdatesEnabledForNewRepos()
+func TestPlan_GetSpace(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Space: &zeroValue}
+	p.GetSpace()
+	p = &Plan{}
+	p.GetSpace()
+	p = nil
+	p.GetSpace()
 }
 
-func TestOrganization_GetDependencyGraphEnabledForNewRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{DependencyGraphEnabledForNewRepos: &zeroValue}
-	o.GetDependencyGraphEnabledForNewRepos()
-	o = &Organization{}
-	o.GetDependencyGraphEnabledForNewRepos()
-	o = nil
-	o.GetDependencyGraphEnabledForNewRepos()
+func TestPreReceiveHook_GetConfigURL(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveHook{ConfigURL: &zeroValue}
+	p.GetConfigURL()
+	p = &PreReceiveHook{}
+	p.GetConfigURL()
+	p = nil
+	p.GetConfigURL()
 }
 
-func TestOrganization_GetDescription(tt *testing.T) {
+func TestPreReceiveHook_GetEnforcement(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Description: &zeroValue}
-	o.GetDescription()
-	o = &Organization{}
-	o.GetDescription()
-	o = nil
-	o.GetDescription()
+	p := &PreReceiveHook{Enforcement: &zeroValue}
+	p.GetEnforcement()
+	p = &PreReceiveHook{}
+	p.GetEnforcement()
+	p = nil
+	p.GetEnforcement()
 }
 
-func TestOrganization_GetDiskUsage(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{DiskUsage: &zeroValue}
-	o.GetDiskUsage()
-	o = &Organization{}
-	o.GetDiskUsage()
-	o = nil
-	o.GetDiskUsage()
+func TestPreReceiveHook_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PreReceiveHook{ID: &zeroValue}
+	p.GetID()
+	p = &PreReceiveHook{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestOrganization_GetEmail(tt *testing.T) {
+func TestPreReceiveHook_GetName(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Email: &zeroValue}
-	o.GetEmail()
-	o = &Organization{}
-	o.GetEmail()
-	o = nil
-	o.GetEmail()
+	p := &PreReceiveHook{Name: &zeroValue}
+	p.GetName()
+	p = &PreReceiveHook{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrganization_GetEventsURL(tt *testing.T) {
+func TestPRLink_GetHRef(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{EventsURL: &zeroValue}
-	o.GetEventsURL()
-	o = &Organization{}
-	o.GetEventsURL()
-	o = nil
-	o.GetEventsURL()
+	p := &PRLink{HRef: &zeroValue}
+	p.GetHRef()
+	p = &PRLink{}
+	p.GetHRef()
+	p = nil
+	p.GetHRef()
 }
 
-func TestOrganization_GetFollowers(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Followers: &zeroValue}
-	o.GetFollowers()
-	o = &Organization{}
-	o.GetFollowers()
-	o = nil
-	o.GetFollowers()
+func TestPRLinks_GetComments(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetComments()
+	p = nil
+	p.GetComments()
 }
 
-func TestOrganization_GetFollowing(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Following: &zeroValue}
-	o.GetFollowing()
-	o = &Organization{}
-	o.GetFollowing()
-	o = nil
-	o.GetFollowing()
+func TestPRLinks_GetCommits(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetCommits()
+	p = nil
+	p.GetCommits()
 }
 
-func TestOrganization_GetHasOrganizationProjects(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{HasOrganizationProjects: &zeroValue}
-	o.GetHasOrganizationProjects()
-	o = &Organization{}
-	o.GetHasOrganizationProjects()
-	o = nil
-	o.GetHasOrganizationProjects()
+func TestPRLinks_GetHTML(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetHTML()
+	p = nil
+	p.GetHTML()
 }
 
-func TestOrganization_GetHasRepositoryProjects(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{HasRepositoryProjects: &zeroValue}
-	o.GetHasRepositoryProjects()
-	o = &Organization{}
-	o.GetHasRepositoryProjects()
-	o = nil
-	o.GetHasRepositoryProjects()
+func TestPRLinks_GetIssue(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetIssue()
+	p = nil
+	p.GetIssue()
 }
 
-func TestOrganization_GetHooksURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{HooksURL: &zeroValue}
-	o.GetHooksURL()
-	o = &Organization{}
-	o.GetHooksURL()
-	o = nil
-	o.GetHooksURL()
+func TestPRLinks_GetReviewComment(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetReviewComment()
+	p = nil
+	p.GetReviewComment()
 }
 
-func TestOrganization_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{HTMLURL: &zeroValue}
-	o.GetHTMLURL()
-	o = &Organization{}
-	o.GetHTMLURL()
-	o = nil
-	o.GetHTMLURL()
+func TestPRLinks_GetReviewComments(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetReviewComments()
+	p = nil
+	p.GetReviewComments()
 }
 
-func TestOrganization_GetID(tt *testing.T) {
-	var zeroValue int64
-	o := &Organization{ID: &zeroValue}
-	o.GetID()
-	o = &Organization{}
-	o.GetID()
-	o = nil
-	o.GetID()
+func TestPRLinks_GetSelf(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetSelf()
+	p = nil
+	p.GetSelf()
+}
+
+func TestPRLinks_GetStatuses(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetStatuses()
+	p = nil
+	p.GetStatuses()
 }
 
-func TestOrganization_GetIssuesURL(tt *testing.T) {
+func TestProject_GetBody(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{IssuesURL: &zeroValue}
-	o.GetIssuesURL()
-	o = &Organization{}
-	o.GetIssuesURL()
-	o = nil
-	o.GetIssuesURL()
+	p := &Project{Body: &zeroValue}
+	p.GetBody()
+	p = &Project{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestOrganization_GetIsVerified(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{IsVerified: &zeroValue}
-	o.GetIsVerified()
-	o = &Organization{}
-	o.GetIsVerified()
-	o = nil
-	o.GetIsVerified()
+func TestProject_GetColumnsURL(tt *testing.T) {
+	var zeroValue string
+	p := &Project{ColumnsURL: &zeroValue}
+	p.GetColumnsURL()
+	p = &Project{}
+	p.GetColumnsURL()
+	p = nil
+	p.GetColumnsURL()
 }
 
-func TestOrganization_GetLocation(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Location: &zeroValue}
-	o.GetLocation()
-	o = &Organization{}
-	o.GetLocation()
-	o = nil
-	o.GetLocation()
+func TestProject_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Project{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &Project{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestOrganization_GetLogin(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Login: &zeroValue}
-	o.GetLogin()
-	o = &Organization{}
-	o.GetLogin()
-	o = nil
-	o.GetLogin()
+func TestProject_GetCreator(tt *testing.T) {
+	p := &Project{}
+	p.GetCreator()
+	p = nil
+	p.GetCreator()
 }
 
-func TestOrganization_GetMembersAllowedRepositoryCreationType(tt *testing.T) {
+func TestProject_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{MembersAllowedRepositoryCreationType: &zeroValue}
-	o.GetMembersAllowedRepositoryCreationType()
-	o = &Organization{}
-	o.GetMembersAllowedRepositoryCreationType()
-	o = nil
-	o.GetMembersAllowedRepositoryCreationType()
+	p := &Project{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Project{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestOrganization_GetMembersCanCreateInternalRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreateInternalRepos: &zeroValue}
-	o.GetMembersCanCreateInternalRepos()
-	o = &Organization{}
-	o.GetMembersCanCreateInternalRepos()
-	o = nil
-	o.GetMembersCanCreateInternalRepos()
+func TestProject_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &Project{ID: &zeroValue}
+	p.GetID()
+	p = &Project{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestOrganization_GetMembersCanCreatePages(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePages: &zeroValue}
-	o.GetMembersCanCreatePages()
-	o = &Organization{}
-	o.GetMembersCanCreatePages()
-	o = nil
-	o.GetMembersCanCreatePages()
+func TestProject_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &Project{Name: &zeroValue}
+	p.GetName()
+	p = &Project{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrganization_GetMembersCanCreatePrivatePages(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePrivatePages: &zeroValue}
-	o.GetMembersCanCreatePrivatePages()
-	o = &Organization{}
-	o.GetMembersCanCreatePrivatePages()
-	o = nil
-	o.GetMembersCanCreatePrivatePages()
+func TestProject_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &Project{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &Project{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
 }
 
-func TestOrganization_GetMembersCanCreatePrivateRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePrivateRepos: &zeroValue}
-	o.GetMembersCanCreatePrivateRepos()
-	o = &Organization{}
-	o.GetMembersCanCreatePrivateRepos()
-	o = nil
-	o.GetMembersCanCreatePrivateRepos()
+func TestProject_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &Project{Number: &zeroValue}
+	p.GetNumber()
+	p = &Project{}
+	p.GetNumber()
+	p = nil
+	p.GetNumber()
 }
 
-func TestOrganization_GetMembersCanCreatePublicPages(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePublicPages: &zeroValue}
-	o.GetMembersCanCreatePublicPages()
-	o = &Organization{}
-	o.GetMembersCanCreatePublicPages()
-	o = nil
-	o.GetMembersCanCreatePublicPages()
+func TestProject_GetOrganizationPermission(tt *testing.T) {
+	var zeroValue string
+	p := &Project{OrganizationPermission: &zeroValue}
+	p.GetOrganizationPermission()
+	p = &Project{}
+	p.GetOrganizationPermission()
+	p = nil
+	p.GetOrganizationPermission()
 }
 
-func TestOrganization_GetMembersCanCreatePublicRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePublicRepos: &zeroValue}
-	o.GetMembersCanCreatePublicRepos()
-	o = &Organization{}
-	o.GetMembersCanCreatePublicRepos()
-	o = nil
-	o.GetMembersCanCreatePublicRepos()
+func TestProject_GetOwnerURL(tt *testing.T) {
+	var zeroValue string
+	p := &Project{OwnerURL: &zeroValue}
+	p.GetOwnerURL()
+	p = &Project{}
+	p.GetOwnerURL()
+	p = nil
+	p.GetOwnerURL()
 }
 
-func TestOrganization_GetMembersCanCreateRepos(tt *testing.T) {
+func TestProject_GetPrivate(tt *testing.T) {
 	var zeroValue bool
-	o := &Organization{MembersCanCreateRepos: &zeroValue}
-	o.GetMembersCanCreateRepos()
-	o = &Organization{}
-	o.GetMembersCanCreateRepos()
-	o = nil
-	o.GetMembersCanCreateRepos()
+	p := &Project{Private: &zeroValue}
+	p.GetPrivate()
+	p = &Project{}
+	p.GetPrivate()
+	p = nil
+	p.GetPrivate()
 }
 
-func TestOrganization_GetMembersCanForkPrivateRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanForkPrivateRepos: &zeroValue}
-	o.GetMembersCanForkPrivateRepos()
-	o = &Organization{}
-	o.GetMembersCanForkPrivateRepos()
-	o = nil
-	o.GetMembersCanForkPrivateRepos()
+func TestProject_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &Project{State: &zeroValue}
+	p.GetState()
+	p = &Project{}
+	p.GetState()
+	p = nil
+	p.GetState()
 }
 
-func TestOrganization_GetMembersURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{MembersURL: &zeroValue}
-	o.GetMembersURL()
-	o = &Organization{}
-	o.GetMembersURL()
-	o = nil
-	o.GetMembersURL()
+func TestProject_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Project{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &Project{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestOrganization_GetName(tt *testing.T) {
+func TestProject_GetURL(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Name: &zeroValue}
-	o.GetName()
-	o = &Organization{}
-	o.GetName()
-	o = nil
-	o.GetName()
+	p := &Project{URL: &zeroValue}
+	p.GetURL()
+	p = &Project{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestOrganization_GetNodeID(tt *testing.T) {
+func TestProjectBody_GetFrom(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{NodeID: &zeroValue}
-	o.GetNodeID()
-	o = &Organization{}
-	o.GetNodeID()
-	o = nil
-	o.GetNodeID()
+	p := &ProjectBody{From: &zeroValue}
+	p.GetFrom()
+	p = &ProjectBody{}
+	p.GetFrom()
+	p = nil
+	p.GetFrom()
+}
+
+func TestProjectCard_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	p := &ProjectCard{Archived: &zeroValue}
+	p.GetArchived()
+	p = &ProjectCard{}
+	p.GetArchived()
+	p = nil
+	p.GetArchived()
 }
 
-func TestOrganization_GetOwnedPrivateRepos(tt *testing.T) {
+func TestProjectCard_GetColumnID(tt *testing.T) {
 	var zeroValue int64
-	o := &Organization{OwnedPrivateRepos: &zeroValue}
-	o.GetOwnedPrivateRepos()
-	o = &Organization{}
-	o.GetOwnedPrivateRepos()
-	o = nil
-	o.GetOwnedPrivateRepos()
+	p := &ProjectCard{ColumnID: &zeroValue}
+	p.GetColumnID()
+	p = &ProjectCard{}
+	p.GetColumnID()
+	p = nil
+	p.GetColumnID()
 }
 
-func TestOrganization_GetPlan(tt *testing.T) {
-	o := &Organization{}
-	o.GetPlan()
-	o = nil
-	o.GetPlan()
+func TestProjectCard_GetColumnName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{ColumnName: &zeroValue}
+	p.GetColumnName()
+	p = &ProjectCard{}
+	p.GetColumnName()
+	p = nil
+	p.GetColumnName()
 }
 
-func TestOrganization_GetPrivateGists(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PrivateGists: &zeroValue}
-	o.GetPrivateGists()
-	o = &Organization{}
-	o.GetPrivateGists()
-	o = nil
-	o.GetPrivateGists()
+func TestProjectCard_GetColumnURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{ColumnURL: &zeroValue}
+	p.GetColumnURL()
+	p = &ProjectCard{}
+	p.GetColumnURL()
+	p = nil
+	p.GetColumnURL()
 }
 
-func TestOrganization_GetPublicGists(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PublicGists: &zeroValue}
-	o.GetPublicGists()
-	o = &Organization{}
-	o.GetPublicGists()
-	o = nil
-	o.GetPublicGists()
+func TestProjectCard_GetContentURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{ContentURL: &zeroValue}
+	p.GetContentURL()
+	p = &ProjectCard{}
+	p.GetContentURL()
+	p = nil
+	p.GetContentURL()
 }
 
-func TestOrganization_GetPublicMembersURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{PublicMembersURL: &zeroValue}
-	o.GetPublicMembersURL()
-	o = &Organization{}
-	o.GetPublicMembersURL()
-	o = nil
-	o.GetPublicMembersURL()
+func TestProjectCard_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectCard{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectCard{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestOrganization_GetPublicRepos(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PublicRepos: &zeroValue}
-	o.GetPublicRepos()
-	o = &Organization{}
-	o.GetPublicRepos()
-	o = nil
-	o.GetPublicRepos()
+func TestProjectCard_GetCreator(tt *testing.T) {
+	p := &ProjectCard{}
+	p.GetCreator()
+	p = nil
+	p.GetCreator()
 }
 
-func TestOrganization_GetReposURL(tt *testing.T) {
+func TestProjectCard_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectCard{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectCard{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectCard_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{ReposURL: &zeroValue}
-	o.GetReposURL()
-	o = &Organization{}
-	o.GetReposURL()
-	o = nil
-	o.GetReposURL()
+	p := &ProjectCard{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectCard{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
 }
 
-func TestOrganization_GetSecretScanningEnabledForNewRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{SecretScanningEnabledForNewRepos: &zeroValue}
-	o.GetSecretScanningEnabledForNewRepos()
-	o = &Organization{}
-	o.GetSecretScanningEnabledForNewRepos()
-	o = nil
-	o.GetSecretScanningEnabledForNewRepos()
+func TestProjectCard_GetNote(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{Note: &zeroValue}
+	p.GetNote()
+	p = &ProjectCard{}
+	p.GetNote()
+	p = nil
+	p.GetNote()
 }
 
-func TestOrganization_GetSecretScanningPushProtectionEnabledForNewRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{SecretScanningPushProtectionEnabledForNewRepos: &zeroValue}
-	o.GetSecretScanningPushProtectionEnabledForNewRepos()
-	o = &Organization{}
-	o.GetSecretScanningPushProtectionEnabledForNewRepos()
-	o = nil
-	o.GetSecretScanningPushProtectionEnabledForNewRepos()
+func TestProjectCard_GetPreviousColumnName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{PreviousColumnName: &zeroValue}
+	p.GetPreviousColumnName()
+	p = &ProjectCard{}
+	p.GetPreviousColumnName()
+	p = nil
+	p.GetPreviousColumnName()
 }
 
-func TestOrganization_GetTotalPrivateRepos(tt *testing.T) {
+func TestProjectCard_GetProjectID(tt *testing.T) {
 	var zeroValue int64
-	o := &Organization{TotalPrivateRepos: &zeroValue}
-	o.GetTotalPrivateRepos()
-	o = &Organization{}
-	o.GetTotalPrivateRepos()
-	o = nil
-	o.GetTotalPrivateRepos()
+	p := &ProjectCard{ProjectID: &zeroValue}
+	p.GetProjectID()
+	p = &ProjectCard{}
+	p.GetProjectID()
+	p = nil
+	p.GetProjectID()
 }
 
-func TestOrganization_GetTwitterUsername(tt *testing.T) {
+func TestProjectCard_GetProjectURL(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{TwitterUsername: &zeroValue}
-	o.GetTwitterUsername()
-	o = &Organization{}
-	o.GetTwitterUsername()
-	o = nil
-	o.GetTwitterUsername()
+	p := &ProjectCard{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectCard{}
+	p.GetProjectURL()
+	p = nil
+	p.GetProjectURL()
 }
 
-func TestOrganization_GetTwoFactorRequirementEnabled(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{TwoFactorRequirementEnabled: &zeroValue}
-	o.GetTwoFactorRequirementEnabled()
-	o = &Organization{}
-	o.GetTwoFactorRequirementEnabled()
-	o = nil
-	o.GetTwoFactorRequirementEnabled()
+func TestProjectCard_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectCard{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectCard{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestOrganization_GetType(tt *testing.T) {
+func TestProjectCard_GetURL(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Type: &zeroValue}
-	o.GetType()
-	o = &Organization{}
-	o.GetType()
-	o = nil
-	o.GetType()
+	p := &ProjectCard{URL: &zeroValue}
+	p.GetURL()
+	p = &ProjectCard{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestOrganization_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	o := &Organization{UpdatedAt: &zeroValue}
-	o.GetUpdatedAt()
-	o = &Organization{}
-	o.GetUpdatedAt()
-	o = nil
-	o.GetUpdatedAt()
+func TestProjectCardChange_GetNote(tt *testing.T) {
+	p := &ProjectCardChange{}
+	p.GetNote()
+	p = nil
+	p.GetNote()
 }
 
-func TestOrganization_GetURL(tt *testing.T) {
+func TestProjectCardEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{URL: &zeroValue}
-	o.GetURL()
-	o = &Organization{}
-	o.GetURL()
-	o = nil
-	o.GetURL()
+	p := &ProjectCardEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectCardEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestOrganization_GetWebCommitSignoffRequired(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{WebCommitSignoffRequired: &zeroValue}
-	o.GetWebCommitSignoffRequired()
-	o = &Organization{}
-	o.GetWebCommitSignoffRequired()
-	o = nil
-	o.GetWebCommitSignoffRequired()
+func TestProjectCardEvent_GetAfterID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectCardEvent{AfterID: &zeroValue}
+	p.GetAfterID()
+	p = &ProjectCardEvent{}
+	p.GetAfterID()
+	p = nil
+	p.GetAfterID()
 }
 
-func TestOrganizationCustomRepoRoles_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	o := &OrganizationCustomRepoRoles{TotalCount: &zeroValue}
-	o.GetTotalCount()
-	o = &OrganizationCustomRepoRoles{}
-	o.GetTotalCount()
-	o = nil
-	o.GetTotalCount()
+func TestProjectCardEvent_GetChanges(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetChanges()
+	p = nil
+	p.GetChanges()
 }
 
-func TestOrganizationEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	o := &OrganizationEvent{Action: &zeroValue}
-	o.GetAction()
-	o = &OrganizationEvent{}
-	o.GetAction()
-	o = nil
-	o.GetAction()
+func TestProjectCardEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestOrganizationEvent_GetInstallation(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetInstallation()
-	o = nil
-	o.GetInstallation()
+func TestProjectCardEvent_GetOrg(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestOrganizationEvent_GetInvitation(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetInvitation()
-	o = nil
-	o.GetInvitation()
+func TestProjectCardEvent_GetProjectCard(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetProjectCard()
+	p = nil
+	p.GetProjectCard()
 }
 
-func TestOrganizationEvent_GetMembership(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetMembership()
-	o = nil
-	o.GetMembership()
+func TestProjectCardEvent_GetRepo(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestOrganizationEvent_GetOrganization(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetOrganization()
-	o = nil
-	o.GetOrganization()
+func TestProjectCardEvent_GetSender(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestOrganizationEvent_GetSender(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetSender()
-	o = nil
-	o.GetSender()
+func TestProjectCardListOptions_GetArchivedState(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCardListOptions{ArchivedState: &zeroValue}
+	p.GetArchivedState()
+	p = &ProjectCardListOptions{}
+	p.GetArchivedState()
+	p = nil
+	p.GetArchivedState()
 }
 
-func TestOrganizationInstallations_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	o := &OrganizationInstallations{TotalCount: &zeroValue}
-	o.GetTotalCount()
-	o = &OrganizationInstallations{}
-	o.GetTotalCount()
-	o = nil
-	o.GetTotalCount()
+func TestProjectCardNote_GetFrom(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCardNote{From: &zeroValue}
+	p.GetFrom()
+	p = &ProjectCardNote{}
+	p.GetFrom()
+	p = nil
+	p.GetFrom()
 }
 
-func TestOrgBlockEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	o := &OrgBlockEvent{Action: &zeroValue}
-	o.GetAction()
-	o = &OrgBlockEvent{}
-	o.GetAction()
-	o = nil
-	o.GetAction()
+func TestProjectCardOptions_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	p := &ProjectCardOptions{Archived: &zeroValue}
+	p.GetArchived()
+	p = &ProjectCardOptions{}
+	p.GetArchived()
+	p = nil
+	p.GetArchived()
 }
 
-func TestOrgBlockEvent_GetBlockedUser(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetBlockedUser()
-	o = nil
-	o.GetBlockedUser()
+func TestProjectChange_GetBody(tt *testing.T) {
+	p := &ProjectChange{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestOrgBlockEvent_GetInstallation(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetInstallation()
-	o = nil
-	o.GetInstallation()
+func TestProjectChange_GetName(tt *testing.T) {
+	p := &ProjectChange{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrgBlockEvent_GetOrganization(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetOrganization()
-	o = nil
-	o.GetOrganization()
+func TestProjectCollaboratorOptions_GetPermission(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCollaboratorOptions{Permission: &zeroValue}
+	p.GetPermission()
+	p = &ProjectCollaboratorOptions{}
+	p.GetPermission()
+	p = nil
+	p.GetPermission()
 }
 
-func TestOrgBlockEvent_GetSender(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetSender()
-	o = nil
-	o.GetSender()
+func TestProjectColumn_GetCardsURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectColumn{CardsURL: &zeroValue}
+	p.GetCardsURL()
+	p = &ProjectColumn{}
+	p.GetCardsURL()
+	p = nil
+	p.GetCardsURL()
 }
 
-func TestOrgRequiredWorkflow_GetCreatedAt(tt *testing.T) {
+func TestProjectColumn_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	o := &OrgRequiredWorkflow{CreatedAt: &zeroValue}
-	o.GetCreatedAt()
-	o = &OrgRequiredWorkflow{}
-	o.GetCreatedAt()
-	o = nil
-	o.GetCreatedAt()
+	p := &ProjectColumn{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectColumn{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestOrgRequiredWorkflow_GetID(tt *testing.T) {
+func TestProjectColumn_GetID(tt *testing.T) {
 	var zeroValue int64
-	o := &OrgRequiredWorkflow{ID: &zeroValue}
-	o.GetID()
-	o = &OrgRequiredWorkflow{}
-	o.GetID()
-	o = nil
-	o.GetID()
+	p := &ProjectColumn{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectColumn{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestOrgRequiredWorkflow_GetName(tt *testing.T) {
+func TestProjectColumn_GetName(tt *testing.T) {
 	var zeroValue string
-	o := &OrgRequiredWorkflow{Name: &zeroValue}
-	o.GetName()
-	o = &OrgRequiredWorkflow{}
-	o.GetName()
-	o = nil
-	o.GetName()
+	p := &ProjectColumn{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectColumn{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrgRequiredWorkflow_GetPath(tt *testing.T) {
+func TestProjectColumn_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	o := &OrgRequiredWorkflow{Path: &zeroValue}
-	o.GetPath()
-	o = &OrgRequiredWorkflow{}
-	o.GetPath()
-	o = nil
-	o.GetPath()
+	p := &ProjectColumn{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectColumn{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
 }
 
-func TestOrgRequiredWorkflow_GetRef(tt *testing.T) {
+func TestProjectColumn_GetProjectURL(tt *testing.T) {
 	var zeroValue string
-	o := &OrgRequiredWorkflow{Ref: &zeroValue}
-	o.GetRef()
-	o = &OrgRequiredWorkflow{}
-	o.GetRef()
-	o = nil
-	o.GetRef()
+	p := &ProjectColumn{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectColumn{}
+	p.GetProjectURL()
+	p = nil
+	p.GetProjectURL()
 }
 
-func TestOrgRequiredWorkflow_GetRepository(tt *testing.T) {
-	o := &OrgRequiredWorkflow{}
-	o.GetRepository()
-	o = nil
-	o.GetRepository()
+func TestProjectColumn_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectColumn{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectColumn{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestOrgRequiredWorkflow_GetScope(tt *testing.T) {
+func TestProjectColumn_GetURL(tt *testing.T) {
 	var zeroValue string
-	o := &OrgRequiredWorkflow{Scope: &zeroValue}
-	o.GetScope()
-	o = &OrgRequiredWorkflow{}
-	o.GetScope()
-	o = nil
-	o.GetScope()
+	p := &ProjectColumn{URL: &zeroValue}
+	p.GetURL()
+	p = &ProjectColumn{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestOrgRequiredWorkflow_GetSelectedRepositoriesURL(tt *testing.T) {
-	var zeroValue string
-	o := &OrgRequiredWorkflow{SelectedRepositoriesURL: &zeroValue}
-	o.GetSelectedRepositoriesURL()
-	o = &OrgRequiredWorkflow{}
-	o.GetSelectedRepositoriesURL()
-	o = nil
-	o.GetSelectedRepositoriesURL()
+func TestProjectColumnChange_GetName(tt *testing.T) {
+	p := &ProjectColumnChange{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrgRequiredWorkflow_GetState(tt *testing.T) {
+func TestProjectColumnEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	o := &OrgRequiredWorkflow{State: &zeroValue}
-	o.GetState()
-	o = &OrgRequiredWorkflow{}
-	o.GetState()
-	o = nil
-	o.GetState()
+	p := &ProjectColumnEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectColumnEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestOrgRequiredWorkflow_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	o := &OrgRequiredWorkflow{UpdatedAt: &zeroValue}
-	o.GetUpdatedAt()
-	o = &OrgRequiredWorkflow{}
-	o.GetUpdatedAt()
-	o = nil
-	o.GetUpdatedAt()
+func TestProjectColumnEvent_GetAfterID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectColumnEvent{AfterID: &zeroValue}
+	p.GetAfterID()
+	p = &ProjectColumnEvent{}
+	p.GetAfterID()
+	p = nil
+	p.GetAfterID()
 }
 
-func TestOrgRequiredWorkflows_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	o := &OrgRequiredWorkflows{TotalCount: &zeroValue}
-	o.GetTotalCount()
-	o = &OrgRequiredWorkflows{}
-	o.GetTotalCount()
-	o = nil
-	o.GetTotalCount()
+func TestProjectColumnEvent_GetChanges(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetChanges()
+	p = nil
+	p.GetChanges()
 }
 
-func TestOrgStats_GetDisabledOrgs(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{DisabledOrgs: &zeroValue}
-	o.GetDisabledOrgs()
-	o = &OrgStats{}
-	o.GetDisabledOrgs()
-	o = nil
-	o.GetDisabledOrgs()
+func TestProjectColumnEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestOrgStats_GetTotalOrgs(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{TotalOrgs: &zeroValue}
-	o.GetTotalOrgs()
-	o = &OrgStats{}
-	o.GetTotalOrgs()
-	o = nil
-	o.GetTotalOrgs()
+func TestProjectColumnEvent_GetOrg(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestOrgStats_GetTotalTeamMembers(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{TotalTeamMembers: &zeroValue}
-	o.GetTotalTeamMembers()
-	o = &OrgStats{}
-	o.GetTotalTeamMembers()
-	o = nil
-	o.GetTotalTeamMembers()
+func TestProjectColumnEvent_GetProjectColumn(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetProjectColumn()
+	p = nil
+	p.GetProjectColumn()
 }
 
-func TestOrgStats_GetTotalTeams(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{TotalTeams: &zeroValue}
-	o.GetTotalTeams()
-	o = &OrgStats{}
-	o.GetTotalTeams()
-	o = nil
-	o.GetTotalTeams()
+func TestProjectColumnEvent_GetRepo(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestPackage_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Package{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &Package{}
-	p.GetCreatedAt()
+func TestProjectColumnEvent_GetSender(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetSender()
 	p = nil
-	p.GetCreatedAt()
+	p.GetSender()
 }
 
-func TestPackage_GetHTMLURL(tt *testing.T) {
+func TestProjectColumnName_GetFrom(tt *testing.T) {
 	var zeroValue string
-	p := &Package{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Package{}
-	p.GetHTMLURL()
+	p := &ProjectColumnName{From: &zeroValue}
+	p.GetFrom()
+	p = &ProjectColumnName{}
+	p.GetFrom()
 	p = nil
-	p.GetHTMLURL()
+	p.GetFrom()
 }
 
-func TestPackage_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &Package{ID: &zeroValue}
-	p.GetID()
-	p = &Package{}
-	p.GetID()
+func TestProjectEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectEvent{}
+	p.GetAction()
 	p = nil
-	p.GetID()
+	p.GetAction()
 }
 
-func TestPackage_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &Package{Name: &zeroValue}
-	p.GetName()
-	p = &Package{}
-	p.GetName()
+func TestProjectEvent_GetChanges(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetName()
+	p.GetChanges()
 }
 
-func TestPackage_GetOwner(tt *testing.T) {
-	p := &Package{}
-	p.GetOwner()
+func TestProjectEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetOwner()
+	p.GetInstallation()
 }
 
-func TestPackage_GetPackageType(tt *testing.T) {
-	var zeroValue string
-	p := &Package{PackageType: &zeroValue}
-	p.GetPackageType()
-	p = &Package{}
-	p.GetPackageType()
+func TestProjectEvent_GetOrg(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetOrg()
 	p = nil
-	p.GetPackageType()
+	p.GetOrg()
 }
 
-func TestPackage_GetPackageVersion(tt *testing.T) {
-	p := &Package{}
-	p.GetPackageVersion()
+func TestProjectEvent_GetProject(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetProject()
 	p = nil
-	p.GetPackageVersion()
+	p.GetProject()
 }
 
-func TestPackage_GetRegistry(tt *testing.T) {
-	p := &Package{}
-	p.GetRegistry()
+func TestProjectEvent_GetRepo(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetRegistry()
+	p.GetRepo()
 }
 
-func TestPackage_GetRepository(tt *testing.T) {
-	p := &Package{}
-	p.GetRepository()
+func TestProjectEvent_GetSender(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetSender()
 	p = nil
-	p.GetRepository()
+	p.GetSender()
 }
 
-func TestPackage_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Package{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &Package{}
-	p.GetUpdatedAt()
+func TestProjectName_GetFrom(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectName{From: &zeroValue}
+	p.GetFrom()
+	p = &ProjectName{}
+	p.GetFrom()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetFrom()
 }
 
-func TestPackage_GetURL(tt *testing.T) {
+func TestProjectOptions_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &Package{URL: &zeroValue}
-	p.GetURL()
-	p = &Package{}
-	p.GetURL()
+	p := &ProjectOptions{Body: &zeroValue}
+	p.GetBody()
+	p = &ProjectOptions{}
+	p.GetBody()
 	p = nil
-	p.GetURL()
+	p.GetBody()
 }
 
-func TestPackage_GetVersionCount(tt *testing.T) {
-	var zeroValue int64
-	p := &Package{VersionCount: &zeroValue}
-	p.GetVersionCount()
-	p = &Package{}
-	p.GetVersionCount()
+func TestProjectOptions_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectOptions{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectOptions{}
+	p.GetName()
 	p = nil
-	p.GetVersionCount()
+	p.GetName()
 }
 
-func TestPackage_GetVisibility(tt *testing.T) {
+func TestProjectOptions_GetOrganizationPermission(tt *testing.T) {
 	var zeroValue string
-	p := &Package{Visibility: &zeroValue}
-	p.GetVisibility()
-	p = &Package{}
-	p.GetVisibility()
+	p := &ProjectOptions{OrganizationPermission: &zeroValue}
+	p.GetOrganizationPermission()
+	p = &ProjectOptions{}
+	p.GetOrganizationPermission()
 	p = nil
-	p.GetVisibility()
+	p.GetOrganizationPermission()
 }
 
-func TestPackageEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	p := &PackageEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PackageEvent{}
-	p.GetAction()
+func TestProjectOptions_GetPrivate(tt *testing.T) {
+	var zeroValue bool
+	p := &ProjectOptions{Private: &zeroValue}
+	p.GetPrivate()
+	p = &ProjectOptions{}
+	p.GetPrivate()
 	p = nil
-	p.GetAction()
+	p.GetPrivate()
 }
 
-func TestPackageEvent_GetInstallation(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetInstallation()
+func TestProjectOptions_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectOptions{State: &zeroValue}
+	p.GetState()
+	p = &ProjectOptions{}
+	p.GetState()
 	p = nil
-	p.GetInstallation()
+	p.GetState()
 }
 
-func TestPackageEvent_GetOrg(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetOrg()
+func TestProjectPermissionLevel_GetPermission(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectPermissionLevel{Permission: &zeroValue}
+	p.GetPermission()
+	p = &ProjectPermissionLevel{}
+	p.GetPermission()
 	p = nil
-	p.GetOrg()
+	p.GetPermission()
 }
 
-func TestPackageEvent_GetPackage(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetPackage()
+func TestProjectPermissionLevel_GetUser(tt *testing.T) {
+	p := &ProjectPermissionLevel{}
+	p.GetUser()
 	p = nil
-	p.GetPackage()
+	p.GetUser()
 }
 
-func TestPackageEvent_GetRepo(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetRepo()
+func TestProjectV2_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2{ClosedAt: &zeroValue}
+	p.GetClosedAt()
+	p = &ProjectV2{}
+	p.GetClosedAt()
 	p = nil
-	p.GetRepo()
+	p.GetClosedAt()
 }
 
-func TestPackageEvent_GetSender(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetSender()
+func TestProjectV2_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectV2{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetSender()
+	p.GetCreatedAt()
 }
 
-func TestPackageFile_GetAuthor(tt *testing.T) {
-	p := &PackageFile{}
-	p.GetAuthor()
+func TestProjectV2_GetCreator(tt *testing.T) {
+	p := &ProjectV2{}
+	p.GetCreator()
 	p = nil
-	p.GetAuthor()
+	p.GetCreator()
 }
 
-func TestPackageFile_GetContentType(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{ContentType: &zeroValue}
-	p.GetContentType()
-	p = &PackageFile{}
-	p.GetContentType()
+func TestProjectV2_GetDeletedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2{DeletedAt: &zeroValue}
+	p.GetDeletedAt()
+	p = &ProjectV2{}
+	p.GetDeletedAt()
 	p = nil
-	p.GetContentType()
+	p.GetDeletedAt()
 }
 
-func TestPackageFile_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageFile{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PackageFile{}
-	p.GetCreatedAt()
+func TestProjectV2_GetDeletedBy(tt *testing.T) {
+	p := &ProjectV2{}
+	p.GetDeletedBy()
 	p = nil
-	p.GetCreatedAt()
+	p.GetDeletedBy()
 }
 
-func TestPackageFile_GetDownloadURL(tt *testing.T) {
+func TestProjectV2_GetDescription(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{DownloadURL: &zeroValue}
-	p.GetDownloadURL()
-	p = &PackageFile{}
-	p.GetDownloadURL()
+	p := &ProjectV2{Description: &zeroValue}
+	p.GetDescription()
+	p = &ProjectV2{}
+	p.GetDescription()
 	p = nil
-	p.GetDownloadURL()
+	p.GetDescription()
 }
 
-func TestPackageFile_GetID(tt *testing.T) {
+func TestProjectV2_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PackageFile{ID: &zeroValue}
+	p := &ProjectV2{ID: &zeroValue}
 	p.GetID()
-	p = &PackageFile{}
+	p = &ProjectV2{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPackageFile_GetMD5(tt *testing.T) {
+func TestProjectV2_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{MD5: &zeroValue}
-	p.GetMD5()
-	p = &PackageFile{}
-	p.GetMD5()
+	p := &ProjectV2{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2{}
+	p.GetNodeID()
 	p = nil
-	p.GetMD5()
+	p.GetNodeID()
 }
 
-func TestPackageFile_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{Name: &zeroValue}
-	p.GetName()
-	p = &PackageFile{}
-	p.GetName()
+func TestProjectV2_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &ProjectV2{Number: &zeroValue}
+	p.GetNumber()
+	p = &ProjectV2{}
+	p.GetNumber()
 	p = nil
-	p.GetName()
+	p.GetNumber()
 }
 
-func TestPackageFile_GetSHA1(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{SHA1: &zeroValue}
-	p.GetSHA1()
-	p = &PackageFile{}
-	p.GetSHA1()
+func TestProjectV2_GetOwner(tt *testing.T) {
+	p := &ProjectV2{}
+	p.GetOwner()
 	p = nil
-	p.GetSHA1()
+	p.GetOwner()
 }
 
-func TestPackageFile_GetSHA256(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{SHA256: &zeroValue}
-	p.GetSHA256()
-	p = &PackageFile{}
-	p.GetSHA256()
+func TestProjectV2_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	p := &ProjectV2{Public: &zeroValue}
+	p.GetPublic()
+	p = &ProjectV2{}
+	p.GetPublic()
 	p = nil
-	p.GetSHA256()
+	p.GetPublic()
 }
 
-func TestPackageFile_GetSize(tt *testing.T) {
-	var zeroValue int64
-	p := &PackageFile{Size: &zeroValue}
-	p.GetSize()
-	p = &PackageFile{}
-	p.GetSize()
+func TestProjectV2_GetShortDescription(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2{ShortDescription: &zeroValue}
+	p.GetShortDescription()
+	p = &ProjectV2{}
+	p.GetShortDescription()
 	p = nil
-	p.GetSize()
+	p.GetShortDescription()
 }
 
-func TestPackageFile_GetState(tt *testing.T) {
+func TestProjectV2_GetState(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{State: &zeroValue}
+	p := &ProjectV2{State: &zeroValue}
 	p.GetState()
-	p = &PackageFile{}
+	p = &ProjectV2{}
 	p.GetState()
 	p = nil
 	p.GetState()
 }
 
-func TestPackageFile_GetUpdatedAt(tt *testing.T) {
+func TestProjectV2_GetTitle(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2{Title: &zeroValue}
+	p.GetTitle()
+	p = &ProjectV2{}
+	p.GetTitle()
+	p = nil
+	p.GetTitle()
+}
+
+func TestProjectV2_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	p := &PackageFile{UpdatedAt: &zeroValue}
+	p := &ProjectV2{UpdatedAt: &zeroValue}
 	p.GetUpdatedAt()
-	p = &PackageFile{}
+	p = &ProjectV2{}
 	p.GetUpdatedAt()
 	p = nil
 	p.GetUpdatedAt()
 }
 
-func TestPackageListOptions_GetPackageType(tt *testing.T) {
+func TestProjectV2Event_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PackageListOptions{PackageType: &zeroValue}
-	p.GetPackageType()
-	p = &PackageListOptions{}
-	p.GetPackageType()
+	p := &ProjectV2Event{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectV2Event{}
+	p.GetAction()
 	p = nil
-	p.GetPackageType()
+	p.GetAction()
 }
 
-func TestPackageListOptions_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &PackageListOptions{State: &zeroValue}
-	p.GetState()
-	p = &PackageListOptions{}
-	p.GetState()
+func TestProjectV2Event_GetInstallation(tt *testing.T) {
+	p := &ProjectV2Event{}
+	p.GetInstallation()
 	p = nil
-	p.GetState()
+	p.GetInstallation()
 }
 
-func TestPackageListOptions_GetVisibility(tt *testing.T) {
-	var zeroValue string
-	p := &PackageListOptions{Visibility: &zeroValue}
-	p.GetVisibility()
-	p = &PackageListOptions{}
-	p.GetVisibility()
+func TestProjectV2Event_GetOrg(tt *testing.T) {
+	p := &ProjectV2Event{}
+	p.GetOrg()
 	p = nil
-	p.GetVisibility()
+	p.GetOrg()
 }
 
-func TestPackageMetadata_GetContainer(tt *testing.T) {
-	p := &PackageMetadata{}
-	p.GetContainer()
+func TestProjectV2Event_GetProjectsV2(tt *testing.T) {
+	p := &ProjectV2Event{}
+	p.GetProjectsV2()
 	p = nil
-	p.GetContainer()
+	p.GetProjectsV2()
 }
 
-func TestPackageMetadata_GetPackageType(tt *testing.T) {
-	var zeroValue string
-	p := &PackageMetadata{PackageType: &zeroValue}
-	p.GetPackageType()
-	p = &PackageMetadata{}
-	p.GetPackageType()
+func TestProjectV2Event_GetSender(tt *testing.T) {
+	p := &ProjectV2Event{}
+	p.GetSender()
 	p = nil
-	p.GetPackageType()
+	p.GetSender()
 }
 
-func TestPackageRegistry_GetAboutURL(tt *testing.T) {
+func TestProjectV2Field_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2Field{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectV2Field{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestProjectV2Field_GetDataType(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{AboutURL: &zeroValue}
-	p.GetAboutURL()
-	p = &PackageRegistry{}
-	p.GetAboutURL()
+	p := &ProjectV2Field{DataType: &zeroValue}
+	p.GetDataType()
+	p = &ProjectV2Field{}
+	p.GetDataType()
 	p = nil
-	p.GetAboutURL()
+	p.GetDataType()
 }
 
-func TestPackageRegistry_GetName(tt *testing.T) {
+func TestProjectV2Field_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectV2Field{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2Field{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2Field_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{Name: &zeroValue}
+	p := &ProjectV2Field{Name: &zeroValue}
 	p.GetName()
-	p = &PackageRegistry{}
+	p = &ProjectV2Field{}
 	p.GetName()
 	p = nil
 	p.GetName()
 }
 
-func TestPackageRegistry_GetType(tt *testing.T) {
+func TestProjectV2Field_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{Type: &zeroValue}
-	p.GetType()
-	p = &PackageRegistry{}
-	p.GetType()
+	p := &ProjectV2Field{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2Field{}
+	p.GetNodeID()
 	p = nil
-	p.GetType()
+	p.GetNodeID()
 }
 
-func TestPackageRegistry_GetURL(tt *testing.T) {
+func TestProjectV2Field_GetProjectURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{URL: &zeroValue}
-	p.GetURL()
-	p = &PackageRegistry{}
-	p.GetURL()
+	p := &ProjectV2Field{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectV2Field{}
+	p.GetProjectURL()
 	p = nil
-	p.GetURL()
+	p.GetProjectURL()
 }
 
-func TestPackageRegistry_GetVendor(tt *testing.T) {
+func TestProjectV2Field_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2Field{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectV2Field{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
+}
+
+func TestProjectV2FieldOption_GetColor(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{Vendor: &zeroValue}
-	p.GetVendor()
-	p = &PackageRegistry{}
-	p.GetVendor()
+	p := &ProjectV2FieldOption{Color: &zeroValue}
+	p.GetColor()
+	p = &ProjectV2FieldOption{}
+	p.GetColor()
 	p = nil
-	p.GetVendor()
+	p.GetColor()
 }
 
-func TestPackageRelease_GetAuthor(tt *testing.T) {
-	p := &PackageRelease{}
-	p.GetAuthor()
+func TestProjectV2FieldOption_GetDescription(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2FieldOption{Description: &zeroValue}
+	p.GetDescription()
+	p = &ProjectV2FieldOption{}
+	p.GetDescription()
 	p = nil
-	p.GetAuthor()
+	p.GetDescription()
 }
 
-func TestPackageRelease_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageRelease{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PackageRelease{}
-	p.GetCreatedAt()
+func TestProjectV2FieldOption_GetID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2FieldOption{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2FieldOption{}
+	p.GetID()
 	p = nil
-	p.GetCreatedAt()
+	p.GetID()
 }
 
-func TestPackageRelease_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageRelease{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PackageRelease{}
-	p.GetDraft()
+func TestProjectV2FieldOption_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2FieldOption{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectV2FieldOption{}
+	p.GetName()
 	p = nil
-	p.GetDraft()
+	p.GetName()
 }
 
-func TestPackageRelease_GetHTMLURL(tt *testing.T) {
+func TestProjectV2FieldValue_GetDataType(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PackageRelease{}
-	p.GetHTMLURL()
+	p := &ProjectV2FieldValue{DataType: &zeroValue}
+	p.GetDataType()
+	p = &ProjectV2FieldValue{}
+	p.GetDataType()
 	p = nil
-	p.GetHTMLURL()
+	p.GetDataType()
 }
 
-func TestPackageRelease_GetID(tt *testing.T) {
+func TestProjectV2FieldValue_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PackageRelease{ID: &zeroValue}
+	p := &ProjectV2FieldValue{ID: &zeroValue}
 	p.GetID()
-	p = &PackageRelease{}
+	p = &ProjectV2FieldValue{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPackageRelease_GetName(tt *testing.T) {
+func TestProjectV2FieldValue_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{Name: &zeroValue}
+	p := &ProjectV2FieldValue{Name: &zeroValue}
 	p.GetName()
-	p = &PackageRelease{}
+	p = &ProjectV2FieldValue{}
 	p.GetName()
 	p = nil
 	p.GetName()
 }
 
-func TestPackageRelease_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageRelease{Prerelease: &zeroValue}
-	p.GetPrerelease()
-	p = &PackageRelease{}
-	p.GetPrerelease()
+func TestProjectV2Item_GetArchivedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2Item{ArchivedAt: &zeroValue}
+	p.GetArchivedAt()
+	p = &ProjectV2Item{}
+	p.GetArchivedAt()
 	p = nil
-	p.GetPrerelease()
+	p.GetArchivedAt()
 }
 
-func TestPackageRelease_GetPublishedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageRelease{PublishedAt: &zeroValue}
-	p.GetPublishedAt()
-	p = &PackageRelease{}
-	p.GetPublishedAt()
+func TestProjectV2Item_GetContent(tt *testing.T) {
+	p := &ProjectV2Item{}
+	p.GetContent()
 	p = nil
-	p.GetPublishedAt()
+	p.GetContent()
 }
 
-func TestPackageRelease_GetTagName(tt *testing.T) {
+func TestProjectV2Item_GetContentNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{TagName: &zeroValue}
-	p.GetTagName()
-	p = &PackageRelease{}
-	p.GetTagName()
+	p := &ProjectV2Item{ContentNodeID: &zeroValue}
+	p.GetContentNodeID()
+	p = &ProjectV2Item{}
+	p.GetContentNodeID()
 	p = nil
-	p.GetTagName()
+	p.GetContentNodeID()
 }
 
-func TestPackageRelease_GetTargetCommitish(tt *testing.T) {
+func TestProjectV2Item_GetContentType(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{TargetCommitish: &zeroValue}
-	p.GetTargetCommitish()
-	p = &PackageRelease{}
-	p.GetTargetCommitish()
+	p := &ProjectV2Item{ContentType: &zeroValue}
+	p.GetContentType()
+	p = &ProjectV2Item{}
+	p.GetContentType()
 	p = nil
-	p.GetTargetCommitish()
+	p.GetContentType()
 }
 
-func TestPackageRelease_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PackageRelease{URL: &zeroValue}
-	p.GetURL()
-	p = &PackageRelease{}
-	p.GetURL()
+func TestProjectV2Item_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2Item{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectV2Item{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetURL()
+	p.GetCreatedAt()
 }
 
-func TestPackageVersion_GetAuthor(tt *testing.T) {
-	p := &PackageVersion{}
-	p.GetAuthor()
+func TestProjectV2Item_GetCreator(tt *testing.T) {
+	p := &ProjectV2Item{}
+	p.GetCreator()
 	p = nil
-	p.GetAuthor()
+	p.GetCreator()
 }
 
-func TestPackageVersion_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{Body: &zeroValue}
-	p.GetBody()
-	p = &PackageVersion{}
-	p.GetBody()
+func TestProjectV2Item_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectV2Item{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2Item{}
+	p.GetID()
 	p = nil
-	p.GetBody()
+	p.GetID()
 }
 
-func TestPackageVersion_GetBodyHTML(tt *testing.T) {
+func TestProjectV2Item_GetItemURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{BodyHTML: &zeroValue}
-	p.GetBodyHTML()
-	p = &PackageVersion{}
-	p.GetBodyHTML()
+	p := &ProjectV2Item{ItemURL: &zeroValue}
+	p.GetItemURL()
+	p = &ProjectV2Item{}
+	p.GetItemURL()
 	p = nil
-	p.GetBodyHTML()
+	p.GetItemURL()
 }
 
-func TestPackageVersion_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageVersion{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PackageVersion{}
-	p.GetCreatedAt()
+func TestProjectV2Item_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2Item{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2Item{}
+	p.GetNodeID()
 	p = nil
-	p.GetCreatedAt()
+	p.GetNodeID()
 }
 
-func TestPackageVersion_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageVersion{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PackageVersion{}
-	p.GetDraft()
+func TestProjectV2Item_GetProjectNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2Item{ProjectNodeID: &zeroValue}
+	p.GetProjectNodeID()
+	p = &ProjectV2Item{}
+	p.GetProjectNodeID()
 	p = nil
-	p.GetDraft()
+	p.GetProjectNodeID()
 }
 
-func TestPackageVersion_GetHTMLURL(tt *testing.T) {
+func TestProjectV2Item_GetProjectURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PackageVersion{}
-	p.GetHTMLURL()
+	p := &ProjectV2Item{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectV2Item{}
+	p.GetProjectURL()
 	p = nil
-	p.GetHTMLURL()
+	p.GetProjectURL()
 }
 
-func TestPackageVersion_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PackageVersion{ID: &zeroValue}
-	p.GetID()
-	p = &PackageVersion{}
-	p.GetID()
+func TestProjectV2Item_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2Item{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectV2Item{}
+	p.GetUpdatedAt()
 	p = nil
-	p.GetID()
+	p.GetUpdatedAt()
 }
 
-func TestPackageVersion_GetInstallationCommand(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{InstallationCommand: &zeroValue}
-	p.GetInstallationCommand()
-	p = &PackageVersion{}
-	p.GetInstallationCommand()
+func TestProjectV2ItemArchivedAtChange_GetFrom(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2ItemArchivedAtChange{From: &zeroValue}
+	p.GetFrom()
+	p = &ProjectV2ItemArchivedAtChange{}
+	p.GetFrom()
 	p = nil
-	p.GetInstallationCommand()
+	p.GetFrom()
 }
 
-func TestPackageVersion_GetManifest(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{Manifest: &zeroValue}
-	p.GetManifest()
-	p = &PackageVersion{}
-	p.GetManifest()
+func TestProjectV2ItemArchivedAtChange_GetTo(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2ItemArchivedAtChange{To: &zeroValue}
+	p.GetTo()
+	p = &ProjectV2ItemArchivedAtChange{}
+	p.GetTo()
 	p = nil
-	p.GetManifest()
+	p.GetTo()
 }
 
-func TestPackageVersion_GetMetadata(tt *testing.T) {
-	p := &PackageVersion{}
-	p.GetMetadata()
+func TestProjectV2ItemChange_GetArchivedAt(tt *testing.T) {
+	p := &ProjectV2ItemChange{}
+	p.GetArchivedAt()
 	p = nil
-	p.GetMetadata()
+	p.GetArchivedAt()
 }
 
-func TestPackageVersion_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{Name: &zeroValue}
-	p.GetName()
-	p = &PackageVersion{}
-	p.GetName()
+func TestProjectV2ItemChange_GetFieldValue(tt *testing.T) {
+	p := &ProjectV2ItemChange{}
+	p.GetFieldValue()
 	p = nil
-	p.GetName()
+	p.GetFieldValue()
 }
 
-func TestPackageVersion_GetPackageHTMLURL(tt *testing.T) {
+func TestProjectV2ItemEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{PackageHTMLURL: &zeroValue}
-	p.GetPackageHTMLURL()
-	p = &PackageVersion{}
-	p.GetPackageHTMLURL()
+	p := &ProjectV2ItemEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectV2ItemEvent{}
+	p.GetAction()
 	p = nil
-	p.GetPackageHTMLURL()
+	p.GetAction()
 }
 
-func TestPackageVersion_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageVersion{Prerelease: &zeroValue}
-	p.GetPrerelease()
-	p = &PackageVersion{}
-	p.GetPrerelease()
+func TestProjectV2ItemEvent_GetChanges(tt *testing.T) {
+	p := &ProjectV2ItemEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetPrerelease()
+	p.GetChanges()
 }
 
-func TestPackageVersion_GetRelease(tt *testing.T) {
-	p := &PackageVersion{}
-	p.GetRelease()
+func TestProjectV2ItemEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectV2ItemEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetRelease()
+	p.GetInstallation()
 }
 
-func TestPackageVersion_GetSummary(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{Summary: &zeroValue}
-	p.GetSummary()
-	p = &PackageVersion{}
-	p.GetSummary()
+func TestProjectV2ItemEvent_GetOrg(tt *testing.T) {
+	p := &ProjectV2ItemEvent{}
+	p.GetOrg()
 	p = nil
-	p.GetSummary()
+	p.GetOrg()
 }
 
-func TestPackageVersion_GetTagName(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{TagName: &zeroValue}
-	p.GetTagName()
-	p = &PackageVersion{}
-	p.GetTagName()
+func TestProjectV2ItemEvent_GetProjectV2Item(tt *testing.T) {
+	p := &ProjectV2ItemEvent{}
+	p.GetProjectV2Item()
 	p = nil
-	p.GetTagName()
+	p.GetProjectV2Item()
 }
 
-func TestPackageVersion_GetTargetCommitish(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{TargetCommitish: &zeroValue}
-	p.GetTargetCommitish()
-	p = &PackageVersion{}
-	p.GetTargetCommitish()
+func TestProjectV2ItemEvent_GetSender(tt *testing.T) {
+	p := &ProjectV2ItemEvent{}
+	p.GetSender()
 	p = nil
-	p.GetTargetCommitish()
+	p.GetSender()
 }
 
-func TestPackageVersion_GetTargetOID(tt *testing.T) {
+func TestProjectV2ItemFieldValueChange_GetFieldNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{TargetOID: &zeroValue}
-	p.GetTargetOID()
-	p = &PackageVersion{}
-	p.GetTargetOID()
+	p := &ProjectV2ItemFieldValueChange{FieldNodeID: &zeroValue}
+	p.GetFieldNodeID()
+	p = &ProjectV2ItemFieldValueChange{}
+	p.GetFieldNodeID()
 	p = nil
-	p.GetTargetOID()
+	p.GetFieldNodeID()
 }
 
-func TestPackageVersion_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageVersion{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PackageVersion{}
-	p.GetUpdatedAt()
+func TestProjectV2ItemFieldValueChange_GetFieldType(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemFieldValueChange{FieldType: &zeroValue}
+	p.GetFieldType()
+	p = &ProjectV2ItemFieldValueChange{}
+	p.GetFieldType()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetFieldType()
 }
 
-func TestPackageVersion_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{URL: &zeroValue}
-	p.GetURL()
-	p = &PackageVersion{}
-	p.GetURL()
+func TestProtection_GetAllowDeletions(tt *testing.T) {
+	p := &Protection{}
+	p.GetAllowDeletions()
 	p = nil
-	p.GetURL()
+	p.GetAllowDeletions()
 }
 
-func TestPackageVersion_GetVersion(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{Version: &zeroValue}
-	p.GetVersion()
-	p = &PackageVersion{}
-	p.GetVersion()
+func TestProtection_GetAllowForcePushes(tt *testing.T) {
+	p := &Protection{}
+	p.GetAllowForcePushes()
 	p = nil
-	p.GetVersion()
+	p.GetAllowForcePushes()
 }
 
-func TestPage_GetAction(tt *testing.T) {
-	var zeroValue string
-	p := &Page{Action: &zeroValue}
-	p.GetAction()
-	p = &Page{}
-	p.GetAction()
+func TestProtection_GetAllowForkSyncing(tt *testing.T) {
+	p := &Protection{}
+	p.GetAllowForkSyncing()
 	p = nil
-	p.GetAction()
+	p.GetAllowForkSyncing()
 }
 
-func TestPage_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &Page{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Page{}
-	p.GetHTMLURL()
+func TestProtection_GetBlockCreations(tt *testing.T) {
+	p := &Protection{}
+	p.GetBlockCreations()
+	p = nil
+	p.GetBlockCreations()
+}
+
+func TestProtection_GetEnforceAdmins(tt *testing.T) {
+	p := &Protection{}
+	p.GetEnforceAdmins()
 	p = nil
-	p.GetHTMLURL()
+	p.GetEnforceAdmins()
 }
 
-func TestPage_GetPageName(tt *testing.T) {
-	var zeroValue string
-	p := &Page{PageName: &zeroValue}
-	p.GetPageName()
-	p = &Page{}
-	p.GetPageName()
+func TestProtection_GetLockBranch(tt *testing.T) {
+	p := &Protection{}
+	p.GetLockBranch()
 	p = nil
-	p.GetPageName()
+	p.GetLockBranch()
 }
 
-func TestPage_GetSHA(tt *testing.T) {
-	var zeroValue string
-	p := &Page{SHA: &zeroValue}
-	p.GetSHA()
-	p = &Page{}
-	p.GetSHA()
+func TestProtection_GetRequiredConversationResolution(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequiredConversationResolution()
 	p = nil
-	p.GetSHA()
+	p.GetRequiredConversationResolution()
 }
 
-func TestPage_GetSummary(tt *testing.T) {
-	var zeroValue string
-	p := &Page{Summary: &zeroValue}
-	p.GetSummary()
-	p = &Page{}
-	p.GetSummary()
+func TestProtection_GetRequiredPullRequestReviews(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequiredPullRequestReviews()
 	p = nil
-	p.GetSummary()
+	p.GetRequiredPullRequestReviews()
 }
 
-func TestPage_GetTitle(tt *testing.T) {
-	var zeroValue string
-	p := &Page{Title: &zeroValue}
-	p.GetTitle()
-	p = &Page{}
-	p.GetTitle()
+func TestProtection_GetRequiredStatusChecks(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequiredStatusChecks()
 	p = nil
-	p.GetTitle()
+	p.GetRequiredStatusChecks()
 }
 
-func TestPageBuildEvent_GetBuild(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetBuild()
+func TestProtection_GetRequireLinearHistory(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequireLinearHistory()
 	p = nil
-	p.GetBuild()
+	p.GetRequireLinearHistory()
 }
 
-func TestPageBuildEvent_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PageBuildEvent{ID: &zeroValue}
-	p.GetID()
-	p = &PageBuildEvent{}
-	p.GetID()
+func TestProtection_GetRestrictions(tt *testing.T) {
+	p := &Protection{}
+	p.GetRestrictions()
 	p = nil
-	p.GetID()
+	p.GetRestrictions()
 }
 
-func TestPageBuildEvent_GetInstallation(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetInstallation()
+func TestProtectionChanges_GetAdminEnforced(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetAdminEnforced()
 	p = nil
-	p.GetInstallation()
+	p.GetAdminEnforced()
 }
 
-func TestPageBuildEvent_GetRepo(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetRepo()
+func TestProtectionChanges_GetAllowDeletionsEnforcementLevel(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetAllowDeletionsEnforcementLevel()
 	p = nil
-	p.GetRepo()
+	p.GetAllowDeletionsEnforcementLevel()
 }
 
-func TestPageBuildEvent_GetSender(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetSender()
+func TestProtectionChanges_GetAuthorizedActorNames(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetAuthorizedActorNames()
 	p = nil
-	p.GetSender()
+	p.GetAuthorizedActorNames()
 }
 
-func TestPages_GetBuildType(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{BuildType: &zeroValue}
-	p.GetBuildType()
-	p = &Pages{}
-	p.GetBuildType()
+func TestProtectionChanges_GetAuthorizedActorsOnly(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetAuthorizedActorsOnly()
 	p = nil
-	p.GetBuildType()
+	p.GetAuthorizedActorsOnly()
 }
 
-func TestPages_GetCNAME(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{CNAME: &zeroValue}
-	p.GetCNAME()
-	p = &Pages{}
-	p.GetCNAME()
+func TestProtectionChanges_GetAuthorizedDismissalActorsOnly(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetAuthorizedDismissalActorsOnly()
 	p = nil
-	p.GetCNAME()
+	p.GetAuthorizedDismissalActorsOnly()
 }
 
-func TestPages_GetCustom404(tt *testing.T) {
-	var zeroValue bool
-	p := &Pages{Custom404: &zeroValue}
-	p.GetCustom404()
-	p = &Pages{}
-	p.GetCustom404()
+func TestProtectionChanges_GetCreateProtected(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetCreateProtected()
 	p = nil
-	p.GetCustom404()
+	p.GetCreateProtected()
 }
 
-func TestPages_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Pages{}
-	p.GetHTMLURL()
+func TestProtectionChanges_GetDismissStaleReviewsOnPush(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetDismissStaleReviewsOnPush()
 	p = nil
-	p.GetHTMLURL()
+	p.GetDismissStaleReviewsOnPush()
 }
 
-func TestPages_GetHTTPSCertificate(tt *testing.T) {
-	p := &Pages{}
-	p.GetHTTPSCertificate()
+func TestProtectionChanges_GetLinearHistoryRequirementEnforcementLevel(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetLinearHistoryRequirementEnforcementLevel()
 	p = nil
-	p.GetHTTPSCertificate()
+	p.GetLinearHistoryRequirementEnforcementLevel()
 }
 
-func TestPages_GetHTTPSEnforced(tt *testing.T) {
-	var zeroValue bool
-	p := &Pages{HTTPSEnforced: &zeroValue}
-	p.GetHTTPSEnforced()
-	p = &Pages{}
-	p.GetHTTPSEnforced()
+func TestProtectionChanges_GetPullRequestReviewsEnforcementLevel(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetPullRequestReviewsEnforcementLevel()
 	p = nil
-	p.GetHTTPSEnforced()
+	p.GetPullRequestReviewsEnforcementLevel()
 }
 
-func TestPages_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	p := &Pages{Public: &zeroValue}
-	p.GetPublic()
-	p = &Pages{}
-	p.GetPublic()
+func TestProtectionChanges_GetRequireCodeOwnerReview(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetRequireCodeOwnerReview()
 	p = nil
-	p.GetPublic()
+	p.GetRequireCodeOwnerReview()
 }
 
-func TestPages_GetSource(tt *testing.T) {
-	p := &Pages{}
-	p.GetSource()
+func TestProtectionChanges_GetRequiredConversationResolutionLevel(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetRequiredConversationResolutionLevel()
 	p = nil
-	p.GetSource()
+	p.GetRequiredConversationResolutionLevel()
 }
 
-func TestPages_GetStatus(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{Status: &zeroValue}
-	p.GetStatus()
-	p = &Pages{}
-	p.GetStatus()
+func TestProtectionChanges_GetRequiredDeploymentsEnforcementLevel(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetRequiredDeploymentsEnforcementLevel()
 	p = nil
-	p.GetStatus()
+	p.GetRequiredDeploymentsEnforcementLevel()
 }
 
-func TestPages_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{URL: &zeroValue}
-	p.GetURL()
-	p = &Pages{}
-	p.GetURL()
+func TestProtectionChanges_GetRequiredStatusChecks(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetRequiredStatusChecks()
 	p = nil
-	p.GetURL()
+	p.GetRequiredStatusChecks()
 }
 
-func TestPagesBuild_GetCommit(tt *testing.T) {
-	var zeroValue string
-	p := &PagesBuild{Commit: &zeroValue}
-	p.GetCommit()
-	p = &PagesBuild{}
-	p.GetCommit()
+func TestProtectionChanges_GetRequiredStatusChecksEnforcementLevel(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetRequiredStatusChecksEnforcementLevel()
 	p = nil
-	p.GetCommit()
+	p.GetRequiredStatusChecksEnforcementLevel()
 }
 
-func TestPagesBuild_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PagesBuild{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PagesBuild{}
-	p.GetCreatedAt()
+func TestProtectionChanges_GetSignatureRequirementEnforcementLevel(tt *testing.T) {
+	p := &ProtectionChanges{}
+	p.GetSignatureRequirementEnforcementLevel()
 	p = nil
-	p.GetCreatedAt()
+	p.GetSignatureRequirementEnforcementLevel()
 }
 
-func TestPagesBuild_GetDuration(tt *testing.T) {
-	var zeroValue int
-	p := &PagesBuild{Duration: &zeroValue}
-	p.GetDuration()
-	p = &PagesBuild{}
-	p.GetDuration()
+func TestProtectionRequest_GetAllowDeletions(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{AllowDeletions: &zeroValue}
+	p.GetAllowDeletions()
+	p = &ProtectionRequest{}
+	p.GetAllowDeletions()
 	p = nil
-	p.GetDuration()
+	p.GetAllowDeletions()
 }
 
-func TestPagesBuild_GetError(tt *testing.T) {
-	p := &PagesBuild{}
-	p.GetError()
+func TestProtectionRequest_GetAllowForcePushes(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{AllowForcePushes: &zeroValue}
+	p.GetAllowForcePushes()
+	p = &ProtectionRequest{}
+	p.GetAllowForcePushes()
 	p = nil
-	p.GetError()
+	p.GetAllowForcePushes()
 }
 
-func TestPagesBuild_GetPusher(tt *testing.T) {
-	p := &PagesBuild{}
-	p.GetPusher()
+func TestProtectionRequest_GetAllowForkSyncing(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{AllowForkSyncing: &zeroValue}
+	p.GetAllowForkSyncing()
+	p = &ProtectionRequest{}
+	p.GetAllowForkSyncing()
+	p = nil
+	p.GetAllowForkSyncing()
+}
+
+func TestProtectionRequest_GetBlockCreations(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{BlockCreations: &zeroValue}
+	p.GetBlockCreations()
+	p = &ProtectionRequest{}
+	p.GetBlockCreations()
 	p = nil
-	p.GetPusher()
+	p.GetBlockCreations()
 }
 
-func TestPagesBuild_GetStatus(tt *testing.T) {
-	var zeroValue string
-	p := &PagesBuild{Status: &zeroValue}
-	p.GetStatus()
-	p = &PagesBuild{}
-	p.GetStatus()
+func TestProtectionRequest_GetLockBranch(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{LockBranch: &zeroValue}
+	p.GetLockBranch()
+	p = &ProtectionRequest{}
+	p.GetLockBranch()
 	p = nil
-	p.GetStatus()
+	p.GetLockBranch()
 }
 
-func TestPagesBuild_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PagesBuild{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PagesBuild{}
-	p.GetUpdatedAt()
+func TestProtectionRequest_GetRequiredConversationResolution(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{RequiredConversationResolution: &zeroValue}
+	p.GetRequiredConversationResolution()
+	p = &ProtectionRequest{}
+	p.GetRequiredConversationResolution()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetRequiredConversationResolution()
 }
 
-func TestPagesBuild_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PagesBuild{URL: &zeroValue}
-	p.GetURL()
-	p = &PagesBuild{}
-	p.GetURL()
+func TestProtectionRequest_GetRequiredPullRequestReviews(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRequiredPullRequestReviews()
 	p = nil
-	p.GetURL()
+	p.GetRequiredPullRequestReviews()
 }
 
-func TestPagesError_GetMessage(tt *testing.T) {
-	var zeroValue string
-	p := &PagesError{Message: &zeroValue}
-	p.GetMessage()
-	p = &PagesError{}
-	p.GetMessage()
+func TestProtectionRequest_GetRequiredStatusChecks(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRequiredStatusChecks()
 	p = nil
-	p.GetMessage()
+	p.GetRequiredStatusChecks()
 }
 
-func TestPagesHTTPSCertificate_GetDescription(tt *testing.T) {
-	var zeroValue string
-	p := &PagesHTTPSCertificate{Description: &zeroValue}
-	p.GetDescription()
-	p = &PagesHTTPSCertificate{}
-	p.GetDescription()
+func TestProtectionRequest_GetRequireLinearHistory(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{RequireLinearHistory: &zeroValue}
+	p.GetRequireLinearHistory()
+	p = &ProtectionRequest{}
+	p.GetRequireLinearHistory()
 	p = nil
-	p.GetDescription()
+	p.GetRequireLinearHistory()
 }
 
-func TestPagesHTTPSCertificate_GetExpiresAt(tt *testing.T) {
-	var zeroValue string
-	p := &PagesHTTPSCertificate{ExpiresAt: &zeroValue}
-	p.GetExpiresAt()
-	p = &PagesHTTPSCertificate{}
-	p.GetExpiresAt()
+func TestProtectionRequest_GetRestrictions(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRestrictions()
 	p = nil
-	p.GetExpiresAt()
+	p.GetRestrictions()
 }
 
-func TestPagesHTTPSCertificate_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &PagesHTTPSCertificate{State: &zeroValue}
-	p.GetState()
-	p = &PagesHTTPSCertificate{}
-	p.GetState()
+func TestProtectionRule_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProtectionRule{ID: &zeroValue}
+	p.GetID()
+	p = &ProtectionRule{}
+	p.GetID()
 	p = nil
-	p.GetState()
+	p.GetID()
 }
 
-func TestPagesSource_GetBranch(tt *testing.T) {
+func TestProtectionRule_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PagesSource{Branch: &zeroValue}
-	p.GetBranch()
-	p = &PagesSource{}
-	p.GetBranch()
+	p := &ProtectionRule{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProtectionRule{}
+	p.GetNodeID()
 	p = nil
-	p.GetBranch()
+	p.GetNodeID()
 }
 
-func TestPagesSource_GetPath(tt *testing.T) {
+func TestProtectionRule_GetType(tt *testing.T) {
 	var zeroValue string
-	p := &PagesSource{Path: &zeroValue}
-	p.GetPath()
-	p = &PagesSource{}
-	p.GetPath()
+	p := &ProtectionRule{Type: &zeroValue}
+	p.GetType()
+	p = &ProtectionRule{}
+	p.GetType()
 	p = nil
-	p.GetPath()
+	p.GetType()
 }
 
-func TestPageStats_GetTotalPages(tt *testing.T) {
+func TestProtectionRule_GetWaitTimer(tt *testing.T) {
 	var zeroValue int
-	p := &PageStats{TotalPages: &zeroValue}
-	p.GetTotalPages()
-	p = &PageStats{}
-	p.GetTotalPages()
+	p := &ProtectionRule{WaitTimer: &zeroValue}
+	p.GetWaitTimer()
+	p = &ProtectionRule{}
+	p.GetWaitTimer()
 	p = nil
-	p.GetTotalPages()
+	p.GetWaitTimer()
 }
 
-func TestPagesUpdate_GetBuildType(tt *testing.T) {
-	var zeroValue string
-	p := &PagesUpdate{BuildType: &zeroValue}
-	p.GetBuildType()
-	p = &PagesUpdate{}
-	p.GetBuildType()
+func TestPublicEvent_GetInstallation(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetBuildType()
+	p.GetInstallation()
 }
 
-func TestPagesUpdate_GetCNAME(tt *testing.T) {
-	var zeroValue string
-	p := &PagesUpdate{CNAME: &zeroValue}
-	p.GetCNAME()
-	p = &PagesUpdate{}
-	p.GetCNAME()
+func TestPublicEvent_GetOrg(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetOrg()
 	p = nil
-	p.GetCNAME()
+	p.GetOrg()
 }
 
-func TestPagesUpdate_GetHTTPSEnforced(tt *testing.T) {
-	var zeroValue bool
-	p := &PagesUpdate{HTTPSEnforced: &zeroValue}
-	p.GetHTTPSEnforced()
-	p = &PagesUpdate{}
-	p.GetHTTPSEnforced()
+func TestPublicEvent_GetRepo(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetHTTPSEnforced()
+	p.GetRepo()
 }
 
-func TestPagesUpdate_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	p := &PagesUpdate{Public: &zeroValue}
-	p.GetPublic()
-	p = &PagesUpdate{}
-	p.GetPublic()
+func TestPublicEvent_GetSender(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetSender()
 	p = nil
-	p.GetPublic()
+	p.GetSender()
 }
 
-func TestPagesUpdate_GetSource(tt *testing.T) {
-	p := &PagesUpdate{}
-	p.GetSource()
+func TestPublicKey_GetKey(tt *testing.T) {
+	var zeroValue string
+	p := &PublicKey{Key: &zeroValue}
+	p.GetKey()
+	p = &PublicKey{}
+	p.GetKey()
 	p = nil
-	p.GetSource()
+	p.GetKey()
 }
 
-func TestPingEvent_GetHook(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetHook()
+func TestPublicKey_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	p := &PublicKey{KeyID: &zeroValue}
+	p.GetKeyID()
+	p = &PublicKey{}
+	p.GetKeyID()
 	p = nil
-	p.GetHook()
+	p.GetKeyID()
 }
 
-func TestPingEvent_GetHookID(tt *testing.T) {
-	var zeroValue int64
-	p := &PingEvent{HookID: &zeroValue}
-	p.GetHookID()
-	p = &PingEvent{}
-	p.GetHookID()
+func TestPublishCodespaceOptions_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PublishCodespaceOptions{Name: &zeroValue}
+	p.GetName()
+	p = &PublishCodespaceOptions{}
+	p.GetName()
 	p = nil
-	p.GetHookID()
+	p.GetName()
 }
 
-func TestPingEvent_GetInstallation(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetInstallation()
+func TestPublishCodespaceOptions_GetPrivate(tt *testing.T) {
+	var zeroValue bool
+	p := &PublishCodespaceOptions{Private: &zeroValue}
+	p.GetPrivate()
+	p = &PublishCodespaceOptions{}
+	p.GetPrivate()
 	p = nil
-	p.GetInstallation()
+	p.GetPrivate()
 }
 
-func TestPingEvent_GetOrg(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetOrg()
+func TestPullRequest_GetActiveLockReason(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{ActiveLockReason: &zeroValue}
+	p.GetActiveLockReason()
+	p = &PullRequest{}
+	p.GetActiveLockReason()
 	p = nil
-	p.GetOrg()
+	p.GetActiveLockReason()
 }
 
-func TestPingEvent_GetRepo(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetRepo()
+func TestPullRequest_GetAdditions(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{Additions: &zeroValue}
+	p.GetAdditions()
+	p = &PullRequest{}
+	p.GetAdditions()
 	p = nil
-	p.GetRepo()
+	p.GetAdditions()
 }
 
-func TestPingEvent_GetSender(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetSender()
+func TestPullRequest_GetAssignee(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetAssignee()
 	p = nil
-	p.GetSender()
+	p.GetAssignee()
 }
 
-func TestPingEvent_GetZen(tt *testing.T) {
+func TestPullRequest_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	p := &PingEvent{Zen: &zeroValue}
-	p.GetZen()
-	p = &PingEvent{}
-	p.GetZen()
+	p := &PullRequest{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequest{}
+	p.GetAuthorAssociation()
 	p = nil
-	p.GetZen()
+	p.GetAuthorAssociation()
 }
 
-func TestPlan_GetCollaborators(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{Collaborators: &zeroValue}
-	p.GetCollaborators()
-	p = &Plan{}
-	p.GetCollaborators()
+func TestPullRequest_GetAutoMerge(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetAutoMerge()
 	p = nil
-	p.GetCollaborators()
+	p.GetAutoMerge()
 }
 
-func TestPlan_GetFilledSeats(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{FilledSeats: &zeroValue}
-	p.GetFilledSeats()
-	p = &Plan{}
-	p.GetFilledSeats()
+func TestPullRequest_GetBase(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetBase()
 	p = nil
-	p.GetFilledSeats()
+	p.GetBase()
 }
 
-func TestPlan_GetName(tt *testing.T) {
+func TestPullRequest_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &Plan{Name: &zeroValue}
-	p.GetName()
-	p = &Plan{}
-	p.GetName()
+	p := &PullRequest{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequest{}
+	p.GetBody()
 	p = nil
-	p.GetName()
+	p.GetBody()
 }
 
-func TestPlan_GetPrivateRepos(tt *testing.T) {
-	var zeroValue int64
-	p := &Plan{PrivateRepos: &zeroValue}
-	p.GetPrivateRepos()
-	p = &Plan{}
-	p.GetPrivateRepos()
+func TestPullRequest_GetChangedFiles(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{ChangedFiles: &zeroValue}
+	p.GetChangedFiles()
+	p = &PullRequest{}
+	p.GetChangedFiles()
 	p = nil
-	p.GetPrivateRepos()
+	p.GetChangedFiles()
 }
 
-func TestPlan_GetSeats(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{Seats: &zeroValue}
-	p.GetSeats()
-	p = &Plan{}
-	p.GetSeats()
+func TestPullRequest_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PullRequest{ClosedAt: &zeroValue}
+	p.GetClosedAt()
+	p = &PullRequest{}
+	p.GetClosedAt()
 	p = nil
-	p.GetSeats()
+	p.GetClosedAt()
 }
 
-func TestPlan_GetSpace(tt *testing.T) {
+func TestPullRequest_GetComments(tt *testing.T) {
 	var zeroValue int
-	p := &Plan{Space: &zeroValue}
-	p.GetSpace()
-	p = &Plan{}
-	p.GetSpace()
+	p := &PullRequest{Comments: &zeroValue}
+	p.GetComments()
+	p = &PullRequest{}
+	p.GetComments()
 	p = nil
-	p.GetSpace()
+	p.GetComments()
 }
 
-func TestPreReceiveHook_GetConfigURL(tt *testing.T) {
+func TestPullRequest_GetCommentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PreReceiveHook{ConfigURL: &zeroValue}
-	p.GetConfigURL()
-	p = &PreReceiveHook{}
-	p.GetConfigURL()
+	p := &PullRequest{CommentsURL: &zeroValue}
+	p.GetCommentsURL()
+	p = &PullRequest{}
+	p.GetCommentsURL()
 	p = nil
-	p.GetConfigURL()
+	p.GetCommentsURL()
 }
 
-func TestPreReceiveHook_GetEnforcement(tt *testing.T) {
+func TestPullRequest_GetCommits(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{Commits: &zeroValue}
+	p.GetCommits()
+	p = &PullRequest{}
+	p.GetCommits()
+	p = nil
+	p.GetCommits()
+}
+
+func TestPullRequest_GetCommitsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PreReceiveHook{Enforcement: &zeroValue}
-	p.GetEnforcement()
-	p = &PreReceiveHook{}
-	p.GetEnforcement()
+	p := &PullRequest{CommitsURL: &zeroValue}
+	p.GetCommitsURL()
+	p = &PullRequest{}
+	p.GetCommitsURL()
 	p = nil
-	p.GetEnforcement()
+	p.GetCommitsURL()
 }
 
-func TestPreReceiveHook_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PreReceiveHook{ID: &zeroValue}
-	p.GetID()
-	p = &PreReceiveHook{}
-	p.GetID()
+func TestPullRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PullRequest{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PullRequest{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetID()
+	p.GetCreatedAt()
 }
 
-func TestPreReceiveHook_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &PreReceiveHook{Name: &zeroValue}
-	p.GetName()
-	p = &PreReceiveHook{}
-	p.GetName()
+func TestPullRequest_GetDeletions(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{Deletions: &zeroValue}
+	p.GetDeletions()
+	p = &PullRequest{}
+	p.GetDeletions()
 	p = nil
-	p.GetName()
+	p.GetDeletions()
 }
 
-func TestPRLink_GetHRef(tt *testing.T) {
+func TestPullRequest_GetDiffURL(tt *testing.T) {
 	var zeroValue string
-	p := &PRLink{HRef: &zeroValue}
-	p.GetHRef()
-	p = &PRLink{}
-	p.GetHRef()
+	p := &PullRequest{DiffURL: &zeroValue}
+	p.GetDiffURL()
+	p = &PullRequest{}
+	p.GetDiffURL()
 	p = nil
-	p.GetHRef()
+	p.GetDiffURL()
 }
 
-func TestPRLinks_GetComments(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetComments()
+func TestPullRequest_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PullRequest{}
+	p.GetDraft()
 	p = nil
-	p.GetComments()
+	p.GetDraft()
 }
 
-func TestPRLinks_GetCommits(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetCommits()
+func TestPullRequest_GetHead(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetHead()
 	p = nil
-	p.GetCommits()
+	p.GetHead()
 }
 
-func TestPRLinks_GetHTML(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetHTML()
+func TestPullRequest_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequest{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetHTML()
+	p.GetHTMLURL()
 }
 
-func TestPRLinks_GetIssue(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetIssue()
+func TestPullRequest_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequest{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequest{}
+	p.GetID()
 	p = nil
-	p.GetIssue()
+	p.GetID()
 }
 
-func TestPRLinks_GetReviewComment(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetReviewComment()
+func TestPullRequest_GetIssueURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{IssueURL: &zeroValue}
+	p.GetIssueURL()
+	p = &PullRequest{}
+	p.GetIssueURL()
 	p = nil
-	p.GetReviewComment()
+	p.GetIssueURL()
 }
 
-func TestPRLinks_GetReviewComments(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetReviewComments()
+func TestPullRequest_GetLinks(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetLinks()
 	p = nil
-	p.GetReviewComments()
+	p.GetLinks()
 }
 
-func TestPRLinks_GetSelf(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetSelf()
+func TestPullRequest_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Locked: &zeroValue}
+	p.GetLocked()
+	p = &PullRequest{}
+	p.GetLocked()
 	p = nil
-	p.GetSelf()
+	p.GetLocked()
 }
 
-func TestPRLinks_GetStatuses(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetStatuses()
+func TestPullRequest_GetMaintainerCanModify(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{MaintainerCanModify: &zeroValue}
+	p.GetMaintainerCanModify()
+	p = &PullRequest{}
+	p.GetMaintainerCanModify()
 	p = nil
-	p.GetStatuses()
+	p.GetMaintainerCanModify()
 }
 
-func TestProject_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &Project{Body: &zeroValue}
-	p.GetBody()
-	p = &Project{}
-	p.GetBody()
+func TestPullRequest_GetMergeable(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Mergeable: &zeroValue}
+	p.GetMergeable()
+	p = &PullRequest{}
+	p.GetMergeable()
 	p = nil
-	p.GetBody()
+	p.GetMergeable()
 }
 
-func TestProject_GetColumnsURL(tt *testing.T) {
-	var zeroValue string
-	p := &Project{ColumnsURL: &zeroValue}
-	p.GetColumnsURL()
-	p = &Project{}
-	p.GetColumnsURL()
+func TestPullRequest_GetMergeableState(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{MergeableState: &zeroValue}
+	p.GetMergeableState()
+	p = &PullRequest{}
+	p.GetMergeableState()
 	p = nil
-	p.GetColumnsURL()
+	p.GetMergeableState()
 }
 
-func TestProject_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Project{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &Project{}
-	p.GetCreatedAt()
+func TestPullRequest_GetMergeCommitSHA(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{MergeCommitSHA: &zeroValue}
+	p.GetMergeCommitSHA()
+	p = &PullRequest{}
+	p.GetMergeCommitSHA()
 	p = nil
-	p.GetCreatedAt()
+	p.GetMergeCommitSHA()
 }
 
-func TestProject_GetCreator(tt *testing.T) {
-	p := &Project{}
-	p.GetCreator()
+func TestPullRequest_GetMerged(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Merged: &zeroValue}
+	p.GetMerged()
+	p = &PullRequest{}
+	p.GetMerged()
 	p = nil
-	p.GetCreator()
+	p.GetMerged()
 }
 
-func TestProject_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &Project{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Project{}
-	p.GetHTMLURL()
+func TestPullRequest_GetMergedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PullRequest{MergedAt: &zeroValue}
+	p.GetMergedAt()
+	p = &PullRequest{}
+	p.GetMergedAt()
 	p = nil
-	p.GetHTMLURL()
+	p.GetMergedAt()
 }
 
-func TestProject_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &Project{ID: &zeroValue}
-	p.GetID()
-	p = &Project{}
-	p.GetID()
+func TestPullRequest_GetMergedBy(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetMergedBy()
 	p = nil
-	p.GetID()
+	p.GetMergedBy()
 }
 
-func TestProject_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &Project{Name: &zeroValue}
-	p.GetName()
-	p = &Project{}
-	p.GetName()
+func TestPullRequest_GetMilestone(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetMilestone()
 	p = nil
-	p.GetName()
+	p.GetMilestone()
 }
 
-func TestProject_GetNodeID(tt *testing.T) {
+func TestPullRequest_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &Project{NodeID: &zeroValue}
+	p := &PullRequest{NodeID: &zeroValue}
 	p.GetNodeID()
-	p = &Project{}
+	p = &PullRequest{}
 	p.GetNodeID()
 	p = nil
 	p.GetNodeID()
 }
 
-func TestProject_GetNumber(tt *testing.T) {
+func TestPullRequest_GetNumber(tt *testing.T) {
 	var zeroValue int
-	p := &Project{Number: &zeroValue}
+	p := &PullRequest{Number: &zeroValue}
 	p.GetNumber()
-	p = &Project{}
+	p = &PullRequest{}
 	p.GetNumber()
 	p = nil
 	p.GetNumber()
 }
 
-func TestProject_GetOrganizationPermission(tt *testing.T) {
+func TestPullRequest_GetPatchURL(tt *testing.T) {
 	var zeroValue string
-	p := &Project{OrganizationPermission: &zeroValue}
-	p.GetOrganizationPermission()
-	p = &Project{}
-	p.GetOrganizationPermission()
+	p := &PullRequest{PatchURL: &zeroValue}
+	p.GetPatchURL()
+	p = &PullRequest{}
+	p.GetPatchURL()
 	p = nil
-	p.GetOrganizationPermission()
+	p.GetPatchURL()
 }
 
-func TestProject_GetOwnerURL(tt *testing.T) {
-	var zeroValue string
-	p := &Project{OwnerURL: &zeroValue}
-	p.GetOwnerURL()
-	p = &Project{}
-	p.GetOwnerURL()
+func TestPullRequest_GetRebaseable(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Rebaseable: &zeroValue}
+	p.GetRebaseable()
+	p = &PullRequest{}
+	p.GetRebaseable()
 	p = nil
-	p.GetOwnerURL()
+	p.GetRebaseable()
 }
 
-func TestProject_GetPrivate(tt *testing.T) {
-	var zeroValue bool
-	p := &Project{Private: &zeroValue}
-	p.GetPrivate()
-	p = &Project{}
-	p.GetPrivate()
+func TestPullRequest_GetReviewComments(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{ReviewComments: &zeroValue}
+	p.GetReviewComments()
+	p = &PullRequest{}
+	p.GetReviewComments()
 	p = nil
-	p.GetPrivate()
+	p.GetReviewComments()
 }
 
-func TestProject_GetState(tt *testing.T) {
+func TestPullRequest_GetReviewCommentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &Project{State: &zeroValue}
-	p.GetState()
-	p = &Project{}
-	p.GetState()
+	p := &PullRequest{ReviewCommentsURL: &zeroValue}
+	p.GetReviewCommentsURL()
+	p = &PullRequest{}
+	p.GetReviewCommentsURL()
 	p = nil
-	p.GetState()
+	p.GetReviewCommentsURL()
 }
 
-func TestProject_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Project{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &Project{}
-	p.GetUpdatedAt()
+func TestPullRequest_GetReviewCommentURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{ReviewCommentURL: &zeroValue}
+	p.GetReviewCommentURL()
+	p = &PullRequest{}
+	p.GetReviewCommentURL()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetReviewCommentURL()
 }
 
-func TestProject_GetURL(tt *testing.T) {
+func TestPullRequest_GetState(tt *testing.T) {
 	var zeroValue string
-	p := &Project{URL: &zeroValue}
-	p.GetURL()
-	p = &Project{}
-	p.GetURL()
+	p := &PullRequest{State: &zeroValue}
+	p.GetState()
+	p = &PullRequest{}
+	p.GetState()
 	p = nil
-	p.GetURL()
+	p.GetState()
 }
 
-func TestProjectBody_GetFrom(tt *testing.T) {
+func TestPullRequest_GetStatusesURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectBody{From: &zeroValue}
-	p.GetFrom()
-	p = &ProjectBody{}
-	p.GetFrom()
+	p := &PullRequest{StatusesURL: &zeroValue}
+	p.GetStatusesURL()
+	p = &PullRequest{}
+	p.GetStatusesURL()
 	p = nil
-	p.GetFrom()
+	p.GetStatusesURL()
 }
 
-func TestProjectCard_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectCard{Archived: &zeroValue}
-	p.GetArchived()
-	p = &ProjectCard{}
-	p.GetArchived()
+func TestPullRequest_GetTitle(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{Title: &zeroValue}
+	p.GetTitle()
+	p = &PullRequest{}
+	p.GetTitle()
 	p = nil
-	p.GetArchived()
+	p.GetTitle()
 }
 
-func TestProjectCard_GetColumnID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ColumnID: &zeroValue}
-	p.GetColumnID()
-	p = &ProjectCard{}
-	p.GetColumnID()
+func TestPullRequest_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PullRequest{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PullRequest{}
+	p.GetUpdatedAt()
 	p = nil
-	p.GetColumnID()
+	p.GetUpdatedAt()
 }
 
-func TestProjectCard_GetColumnName(tt *testing.T) {
+func TestPullRequest_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ColumnName: &zeroValue}
-	p.GetColumnName()
-	p = &ProjectCard{}
-	p.GetColumnName()
+	p := &PullRequest{URL: &zeroValue}
+	p.GetURL()
+	p = &PullRequest{}
+	p.GetURL()
 	p = nil
-	p.GetColumnName()
+	p.GetURL()
 }
 
-func TestProjectCard_GetColumnURL(tt *testing.T) {
+func TestPullRequest_GetUser(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetUser()
+	p = nil
+	p.GetUser()
+}
+
+func TestPullRequestAutoMerge_GetCommitMessage(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ColumnURL: &zeroValue}
-	p.GetColumnURL()
-	p = &ProjectCard{}
-	p.GetColumnURL()
+	p := &PullRequestAutoMerge{CommitMessage: &zeroValue}
+	p.GetCommitMessage()
+	p = &PullRequestAutoMerge{}
+	p.GetCommitMessage()
 	p = nil
-	p.GetColumnURL()
+	p.GetCommitMessage()
 }
 
-func TestProjectCard_GetContentURL(tt *testing.T) {
+func TestPullRequestAutoMerge_GetCommitTitle(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ContentURL: &zeroValue}
-	p.GetContentURL()
-	p = &ProjectCard{}
-	p.GetContentURL()
+	p := &PullRequestAutoMerge{CommitTitle: &zeroValue}
+	p.GetCommitTitle()
+	p = &PullRequestAutoMerge{}
+	p.GetCommitTitle()
 	p = nil
-	p.GetContentURL()
+	p.GetCommitTitle()
 }
 
-func TestProjectCard_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectCard{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &ProjectCard{}
-	p.GetCreatedAt()
+func TestPullRequestAutoMerge_GetEnabledBy(tt *testing.T) {
+	p := &PullRequestAutoMerge{}
+	p.GetEnabledBy()
 	p = nil
-	p.GetCreatedAt()
+	p.GetEnabledBy()
 }
 
-func TestProjectCard_GetCreator(tt *testing.T) {
-	p := &ProjectCard{}
-	p.GetCreator()
+func TestPullRequestAutoMerge_GetMergeMethod(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestAutoMerge{MergeMethod: &zeroValue}
+	p.GetMergeMethod()
+	p = &PullRequestAutoMerge{}
+	p.GetMergeMethod()
 	p = nil
-	p.GetCreator()
+	p.GetMergeMethod()
 }
 
-func TestProjectCard_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ID: &zeroValue}
-	p.GetID()
-	p = &ProjectCard{}
-	p.GetID()
+func TestPullRequestBranch_GetLabel(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestBranch{Label: &zeroValue}
+	p.GetLabel()
+	p = &PullRequestBranch{}
+	p.GetLabel()
 	p = nil
-	p.GetID()
+	p.GetLabel()
 }
 
-func TestProjectCard_GetNodeID(tt *testing.T) {
+func TestPullRequestBranch_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &ProjectCard{}
-	p.GetNodeID()
+	p := &PullRequestBranch{Ref: &zeroValue}
+	p.GetRef()
+	p = &PullRequestBranch{}
+	p.GetRef()
 	p = nil
-	p.GetNodeID()
+	p.GetRef()
 }
 
-func TestProjectCard_GetNote(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCard{Note: &zeroValue}
-	p.GetNote()
-	p = &ProjectCard{}
-	p.GetNote()
+func TestPullRequestBranch_GetRepo(tt *testing.T) {
+	p := &PullRequestBranch{}
+	p.GetRepo()
 	p = nil
-	p.GetNote()
+	p.GetRepo()
 }
 
-func TestProjectCard_GetPreviousColumnName(tt *testing.T) {
+func TestPullRequestBranch_GetSHA(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{PreviousColumnName: &zeroValue}
-	p.GetPreviousColumnName()
-	p = &ProjectCard{}
-	p.GetPreviousColumnName()
+	p := &PullRequestBranch{SHA: &zeroValue}
+	p.GetSHA()
+	p = &PullRequestBranch{}
+	p.GetSHA()
 	p = nil
-	p.GetPreviousColumnName()
+	p.GetSHA()
 }
 
-func TestProjectCard_GetProjectID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ProjectID: &zeroValue}
-	p.GetProjectID()
-	p = &ProjectCard{}
-	p.GetProjectID()
+func TestPullRequestBranch_GetUser(tt *testing.T) {
+	p := &PullRequestBranch{}
+	p.GetUser()
 	p = nil
-	p.GetProjectID()
+	p.GetUser()
 }
 
-func TestProjectCard_GetProjectURL(tt *testing.T) {
+func TestPullRequestBranchUpdateOptions_GetExpectedHeadSHA(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ProjectURL: &zeroValue}
-	p.GetProjectURL()
-	p = &ProjectCard{}
-	p.GetProjectURL()
+	p := &PullRequestBranchUpdateOptions{ExpectedHeadSHA: &zeroValue}
+	p.GetExpectedHeadSHA()
+	p = &PullRequestBranchUpdateOptions{}
+	p.GetExpectedHeadSHA()
 	p = nil
-	p.GetProjectURL()
+	p.GetExpectedHeadSHA()
 }
 
-func TestProjectCard_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectCard{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &ProjectCard{}
-	p.GetUpdatedAt()
+func TestPullRequestBranchUpdateResponse_GetMessage(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestBranchUpdateResponse{Message: &zeroValue}
+	p.GetMessage()
+	p = &PullRequestBranchUpdateResponse{}
+	p.GetMessage()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetMessage()
 }
 
-func TestProjectCard_GetURL(tt *testing.T) {
+func TestPullRequestBranchUpdateResponse_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{URL: &zeroValue}
+	p := &PullRequestBranchUpdateResponse{URL: &zeroValue}
 	p.GetURL()
-	p = &ProjectCard{}
+	p = &PullRequestBranchUpdateResponse{}
 	p.GetURL()
 	p = nil
 	p.GetURL()
 }
 
-func TestProjectCardChange_GetNote(tt *testing.T) {
-	p := &ProjectCardChange{}
-	p.GetNote()
+func TestPullRequestComment_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequestComment{}
+	p.GetAuthorAssociation()
 	p = nil
-	p.GetNote()
+	p.GetAuthorAssociation()
 }
 
-func TestProjectCardEvent_GetAction(tt *testing.T) {
+func TestPullRequestComment_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCardEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &ProjectCardEvent{}
-	p.GetAction()
+	p := &PullRequestComment{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequestComment{}
+	p.GetBody()
 	p = nil
-	p.GetAction()
+	p.GetBody()
 }
 
-func TestProjectCardEvent_GetAfterID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCardEvent{AfterID: &zeroValue}
-	p.GetAfterID()
-	p = &ProjectCardEvent{}
-	p.GetAfterID()
+func TestPullRequestComment_GetCommitID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestComment{}
+	p.GetCommitID()
 	p = nil
-	p.GetAfterID()
+	p.GetCommitID()
 }
 
-func TestProjectCardEvent_GetChanges(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetChanges()
+func TestPullRequestComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PullRequestComment{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PullRequestComment{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetChanges()
+	p.GetCreatedAt()
 }
 
-func TestProjectCardEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetInstallation()
+func TestPullRequestComment_GetDiffHunk(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{DiffHunk: &zeroValue}
+	p.GetDiffHunk()
+	p = &PullRequestComment{}
+	p.GetDiffHunk()
 	p = nil
-	p.GetInstallation()
+	p.GetDiffHunk()
 }
 
-func TestProjectCardEvent_GetOrg(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetOrg()
+func TestPullRequestComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequestComment{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetOrg()
+	p.GetHTMLURL()
 }
 
-func TestProjectCardEvent_GetProjectCard(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetProjectCard()
+func TestPullRequestComment_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestComment{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequestComment{}
+	p.GetID()
 	p = nil
-	p.GetProjectCard()
+	p.GetID()
 }
 
-func TestProjectCardEvent_GetRepo(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetRepo()
+func TestPullRequestComment_GetInReplyTo(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestComment{InReplyTo: &zeroValue}
+	p.GetInReplyTo()
+	p = &PullRequestComment{}
+	p.GetInReplyTo()
 	p = nil
-	p.GetRepo()
+	p.GetInReplyTo()
 }
 
-func TestProjectCardEvent_GetSender(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetSender()
+func TestPullRequestComment_GetLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{Line: &zeroValue}
+	p.GetLine()
+	p = &PullRequestComment{}
+	p.GetLine()
 	p = nil
-	p.GetSender()
+	p.GetLine()
 }
 
-func TestProjectCardListOptions_GetArchivedState(tt *testing.T) {
+func TestPullRequestComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCardListOptions{ArchivedState: &zeroValue}
-	p.GetArchivedState()
-	p = &ProjectCardListOptions{}
-	p.GetArchivedState()
+	p := &PullRequestComment{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestComment{}
+	p.GetNodeID()
 	p = nil
-	p.GetArchivedState()
+	p.GetNodeID()
 }
 
-func TestProjectCardNote_GetFrom(tt *testing.T) {
+func TestPullRequestComment_GetOriginalCommitID(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCardNote{From: &zeroValue}
-	p.GetFrom()
-	p = &ProjectCardNote{}
-	p.GetFrom()
-	p = nil
-	p.GetFrom()
-}
-
-func TestProjectCardOptions_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectCardOptions{Archived: &zeroValue}
-	p.GetArchived()
-	p = &ProjectCardOptions{}
-	p.GetArchived()
+	p := &PullRequestComment{OriginalCommitID: &zeroValue}
+	p.GetOriginalCommitID()
+	p = &PullRequestComment{}
+	p.GetOriginalCommitID()
 	p = nil
-	p.GetArchived()
+	p.GetOriginalCommitID()
 }
 
-func TestProjectChange_GetBody(tt *testing.T) {
-	p := &ProjectChange{}
-	p.GetBody()
+func TestPullRequestComment_GetOriginalLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalLine: &zeroValue}
+	p.GetOriginalLine()
+	p = &PullRequestComment{}
+	p.GetOriginalLine()
 	p = nil
-	p.GetBody()
+	p.GetOriginalLine()
 }
 
-func TestProjectChange_GetName(tt *testing.T) {
-	p := &ProjectChange{}
-	p.GetName()
+func TestPullRequestComment_GetOriginalPosition(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalPosition: &zeroValue}
+	p.GetOriginalPosition()
+	p = &PullRequestComment{}
+	p.GetOriginalPosition()
 	p = nil
-	p.GetName()
+	p.GetOriginalPosition()
 }
 
-func TestProjectCollaboratorOptions_GetPermission(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCollaboratorOptions{Permission: &zeroValue}
-	p.GetPermission()
-	p = &ProjectCollaboratorOptions{}
-	p.GetPermission()
+func TestPullRequestComment_GetOriginalStartLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalStartLine: &zeroValue}
+	p.GetOriginalStartLine()
+	p = &PullRequestComment{}
+	p.GetOriginalStartLine()
 	p = nil
-	p.GetPermission()
+	p.GetOriginalStartLine()
 }
 
-func TestProjectColumn_GetCardsURL(tt *testing.T) {
+func TestPullRequestComment_GetPath(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{CardsURL: &zeroValue}
-	p.GetCardsURL()
-	p = &ProjectColumn{}
-	p.GetCardsURL()
+	p := &PullRequestComment{Path: &zeroValue}
+	p.GetPath()
+	p = &PullRequestComment{}
+	p.GetPath()
 	p = nil
-	p.GetCardsURL()
+	p.GetPath()
 }
 
-func TestProjectColumn_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectColumn{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &ProjectColumn{}
-	p.GetCreatedAt()
+func TestPullRequestComment_GetPosition(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{Position: &zeroValue}
+	p.GetPosition()
+	p = &PullRequestComment{}
+	p.GetPosition()
 	p = nil
-	p.GetCreatedAt()
+	p.GetPosition()
 }
 
-func TestProjectColumn_GetID(tt *testing.T) {
+func TestPullRequestComment_GetPullRequestReviewID(tt *testing.T) {
 	var zeroValue int64
-	p := &ProjectColumn{ID: &zeroValue}
-	p.GetID()
-	p = &ProjectColumn{}
-	p.GetID()
+	p := &PullRequestComment{PullRequestReviewID: &zeroValue}
+	p.GetPullRequestReviewID()
+	p = &PullRequestComment{}
+	p.GetPullRequestReviewID()
 	p = nil
-	p.GetID()
+	p.GetPullRequestReviewID()
 }
 
-func TestProjectColumn_GetName(tt *testing.T) {
+func TestPullRequestComment_GetPullRequestURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{Name: &zeroValue}
-	p.GetName()
-	p = &ProjectColumn{}
-	p.GetName()
+	p := &PullRequestComment{PullRequestURL: &zeroValue}
+	p.GetPullRequestURL()
+	p = &PullRequestComment{}
+	p.GetPullRequestURL()
 	p = nil
-	p.GetName()
+	p.GetPullRequestURL()
 }
 
-func TestProjectColumn_GetNodeID(tt *testing.T) {
+func TestPullRequestComment_GetReactions(tt *testing.T) {
+	p := &PullRequestComment{}
+	p.GetReactions()
+	p = nil
+	p.GetReactions()
+}
+
+func TestPullRequestComment_GetSide(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &ProjectColumn{}
-	p.GetNodeID()
+	p := &PullRequestComment{Side: &zeroValue}
+	p.GetSide()
+	p = &PullRequestComment{}
+	p.GetSide()
 	p = nil
-	p.GetNodeID()
+	p.GetSide()
 }
 
-func TestProjectColumn_GetProjectURL(tt *testing.T) {
+func TestPullRequestComment_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{StartLine: &zeroValue}
+	p.GetStartLine()
+	p = &PullRequestComment{}
+	p.GetStartLine()
+	p = nil
+	p.GetStartLine()
+}
+
+func TestPullRequestComment_GetStartSide(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{ProjectURL: &zeroValue}
-	p.GetProjectURL()
-	p = &ProjectColumn{}
-	p.GetProjectURL()
+	p := &PullRequestComment{StartSide: &zeroValue}
+	p.GetStartSide()
+	p = &PullRequestComment{}
+	p.GetStartSide()
 	p = nil
-	p.GetProjectURL()
+	p.GetStartSide()
 }
 
-func TestProjectColumn_GetUpdatedAt(tt *testing.T) {
+func TestPullRequestComment_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	p := &ProjectColumn{UpdatedAt: &zeroValue}
+	p := &PullRequestComment{UpdatedAt: &zeroValue}
 	p.GetUpdatedAt()
-	p = &ProjectColumn{}
+	p = &PullRequestComment{}
 	p.GetUpdatedAt()
 	p = nil
 	p.GetUpdatedAt()
 }
 
-func TestProjectColumn_GetURL(tt *testing.T) {
+func TestPullRequestComment_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{URL: &zeroValue}
+	p := &PullRequestComment{URL: &zeroValue}
 	p.GetURL()
-	p = &ProjectColumn{}
+	p = &PullRequestComment{}
 	p.GetURL()
 	p = nil
 	p.GetURL()
 }
 
-func TestProjectColumnChange_GetName(tt *testing.T) {
-	p := &ProjectColumnChange{}
-	p.GetName()
+func TestPullRequestComment_GetUser(tt *testing.T) {
+	p := &PullRequestComment{}
+	p.GetUser()
 	p = nil
-	p.GetName()
+	p.GetUser()
 }
 
-func TestProjectColumnEvent_GetAction(tt *testing.T) {
+func TestPullRequestEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumnEvent{Action: &zeroValue}
+	p := &PullRequestEvent{Action: &zeroValue}
 	p.GetAction()
-	p = &ProjectColumnEvent{}
+	p = &PullRequestEvent{}
 	p.GetAction()
 	p = nil
 	p.GetAction()
 }
 
-func TestProjectColumnEvent_GetAfterID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectColumnEvent{AfterID: &zeroValue}
-	p.GetAfterID()
-	p = &ProjectColumnEvent{}
-	p.GetAfterID()
+func TestPullRequestEvent_GetAfter(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestEvent{After: &zeroValue}
+	p.GetAfter()
+	p = &PullRequestEvent{}
+	p.GetAfter()
 	p = nil
-	p.GetAfterID()
+	p.GetAfter()
 }
 
-func TestProjectColumnEvent_GetChanges(tt *testing.T) {
-	p := &ProjectColumnEvent{}
+func TestPullRequestEvent_GetAssignee(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetAssignee()
+	p = nil
+	p.GetAssignee()
+}
+
+func TestPullRequestEvent_GetBefore(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestEvent{Before: &zeroValue}
+	p.GetBefore()
+	p = &PullRequestEvent{}
+	p.GetBefore()
+	p = nil
+	p.GetBefore()
+}
+
+func TestPullRequestEvent_GetChanges(tt *testing.T) {
+	p := &PullRequestEvent{}
 	p.GetChanges()
 	p = nil
 	p.GetChanges()
 }
 
-func TestProjectColumnEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectColumnEvent{}
+func TestPullRequestEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestEvent{}
 	p.GetInstallation()
 	p = nil
 	p.GetInstallation()
 }
 
-func TestProjectColumnEvent_GetOrg(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetOrg()
+func TestPullRequestEvent_GetLabel(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetLabel()
 	p = nil
-	p.GetOrg()
+	p.GetLabel()
 }
 
-func TestProjectColumnEvent_GetProjectColumn(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetProjectColumn()
+func TestPullRequestEvent_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestEvent{Number: &zeroValue}
+	p.GetNumber()
+	p = &PullRequestEvent{}
+	p.GetNumber()
 	p = nil
-	p.GetProjectColumn()
+	p.GetNumber()
 }
 
-func TestProjectColumnEvent_GetRepo(tt *testing.T) {
-	p := &ProjectColumnEvent{}
+func TestPullRequestEvent_GetOrganization(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetOrganization()
+	p = nil
+	p.GetOrganization()
+}
+
+func TestPullRequestEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetPullRequest()
+	p = nil
+	p.GetPullRequest()
+}
+
+func TestPullRequestEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestEvent{}
 	p.GetRepo()
 	p = nil
 	p.GetRepo()
 }
 
-func TestProjectColumnEvent_GetSender(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetSender()
+func TestPullRequestEvent_GetRequestedReviewer(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetRequestedReviewer()
 	p = nil
-	p.GetSender()
+	p.GetRequestedReviewer()
 }
 
-func TestProjectColumnName_GetFrom(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectColumnName{From: &zeroValue}
-	p.GetFrom()
-	p = &ProjectColumnName{}
-	p.GetFrom()
+func TestPullRequestEvent_GetRequestedTeam(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetRequestedTeam()
 	p = nil
-	p.GetFrom()
+	p.GetRequestedTeam()
 }
 
-func TestProjectEvent_GetAction(tt *testing.T) {
+func TestPullRequestEvent_GetSender(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
+}
+
+func TestPullRequestLinks_GetDiffURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &ProjectEvent{}
-	p.GetAction()
+	p := &PullRequestLinks{DiffURL: &zeroValue}
+	p.GetDiffURL()
+	p = &PullRequestLinks{}
+	p.GetDiffURL()
 	p = nil
-	p.GetAction()
+	p.GetDiffURL()
 }
 
-func TestProjectEvent_GetChanges(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetChanges()
+func TestPullRequestLinks_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestLinks{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequestLinks{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetChanges()
+	p.GetHTMLURL()
 }
 
-func TestProjectEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetInstallation()
+func TestPullRequestLinks_GetPatchURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestLinks{PatchURL: &zeroValue}
+	p.GetPatchURL()
+	p = &PullRequestLinks{}
+	p.GetPatchURL()
 	p = nil
-	p.GetInstallation()
+	p.GetPatchURL()
 }
 
-func TestProjectEvent_GetOrg(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetOrg()
+func TestPullRequestLinks_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestLinks{URL: &zeroValue}
+	p.GetURL()
+	p = &PullRequestLinks{}
+	p.GetURL()
 	p = nil
-	p.GetOrg()
+	p.GetURL()
 }
 
-func TestProjectEvent_GetProject(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetProject()
+func TestPullRequestMergeResult_GetMerged(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestMergeResult{Merged: &zeroValue}
+	p.GetMerged()
+	p = &PullRequestMergeResult{}
+	p.GetMerged()
 	p = nil
-	p.GetProject()
+	p.GetMerged()
 }
 
-func TestProjectEvent_GetRepo(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetRepo()
+func TestPullRequestMergeResult_GetMessage(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestMergeResult{Message: &zeroValue}
+	p.GetMessage()
+	p = &PullRequestMergeResult{}
+	p.GetMessage()
 	p = nil
-	p.GetRepo()
+	p.GetMessage()
 }
 
-func TestProjectEvent_GetSender(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetSender()
+func TestPullRequestMergeResult_GetSHA(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestMergeResult{SHA: &zeroValue}
+	p.GetSHA()
+	p = &PullRequestMergeResult{}
+	p.GetSHA()
 	p = nil
-	p.GetSender()
+	p.GetSHA()
 }
 
-func TestProjectName_GetFrom(tt *testing.T) {
+func TestPullRequestReview_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectName{From: &zeroValue}
-	p.GetFrom()
-	p = &ProjectName{}
-	p.GetFrom()
+	p := &PullRequestReview{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequestReview{}
+	p.GetAuthorAssociation()
 	p = nil
-	p.GetFrom()
+	p.GetAuthorAssociation()
 }
 
-func TestProjectOptions_GetBody(tt *testing.T) {
+func TestPullRequestReview_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{Body: &zeroValue}
+	p := &PullRequestReview{Body: &zeroValue}
 	p.GetBody()
-	p = &ProjectOptions{}
+	p = &PullRequestReview{}
 	p.GetBody()
 	p = nil
 	p.GetBody()
 }
 
-func TestProjectOptions_GetName(tt *testing.T) {
+func TestPullRequestReview_GetCommitID(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{Name: &zeroValue}
-	p.GetName()
-	p = &ProjectOptions{}
-	p.GetName()
+	p := &PullRequestReview{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestReview{}
+	p.GetCommitID()
 	p = nil
-	p.GetName()
+	p.GetCommitID()
 }
 
-func TestProjectOptions_GetOrganizationPermission(tt *testing.T) {
+func TestPullRequestReview_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{OrganizationPermission: &zeroValue}
-	p.GetOrganizationPermission()
-	p = &ProjectOptions{}
-	p.GetOrganizationPermission()
+	p := &PullRequestReview{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequestReview{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetOrganizationPermission()
+	p.GetHTMLURL()
 }
 
-func TestProjectOptions_GetPrivate(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectOptions{Private: &zeroValue}
-	p.GetPrivate()
-	p = &ProjectOptions{}
-	p.GetPrivate()
+func TestPullRequestReview_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestReview{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequestReview{}
+	p.GetID()
 	p = nil
-	p.GetPrivate()
+	p.GetID()
 }
 
-func TestProjectOptions_GetState(tt *testing.T) {
+func TestPullRequestReview_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{State: &zeroValue}
-	p.GetState()
-	p = &ProjectOptions{}
-	p.GetState()
+	p := &PullRequestReview{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestReview{}
+	p.GetNodeID()
 	p = nil
-	p.GetState()
+	p.GetNodeID()
 }
 
-func TestProjectPermissionLevel_GetPermission(tt *testing.T) {
+func TestPullRequestReview_GetPullRequestURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectPermissionLevel{Permission: &zeroValue}
-	p.GetPermission()
-	p = &ProjectPermissionLevel{}
-	p.GetPermission()
+	p := &PullRequestReview{PullRequestURL: &zeroValue}
+	p.GetPullRequestURL()
+	p = &PullRequestReview{}
+	p.GetPullRequestURL()
 	p = nil
-	p.GetPermission()
+	p.GetPullRequestURL()
 }
 
-func TestProjectPermissionLevel_GetUser(tt *testing.T) {
-	p := &ProjectPermissionLevel{}
-	p.GetUser()
+func TestPullRequestReview_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{State: &zeroValue}
+	p.GetState()
+	p = &PullRequestReview{}
+	p.GetState()
 	p = nil
-	p.GetUser()
+	p.GetState()
 }
 
-func TestProtection_GetAllowDeletions(tt *testing.T) {
-	p := &Protection{}
-	p.GetAllowDeletions()
+func TestPullRequestReview_GetSubmittedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PullRequestReview{SubmittedAt: &zeroValue}
+	p.GetSubmittedAt()
+	p = &PullRequestReview{}
+	p.GetSubmittedAt()
 	p = nil
-	p.GetAllowDeletions()
+	p.GetSubmittedAt()
 }
 
-func TestProtection_GetAllowForcePushes(tt *testing.T) {
-	p := &Protection{}
-	p.GetAllowForcePushes()
+func TestPullRequestReview_GetUser(tt *testing.T) {
+	p := &PullRequestReview{}
+	p.GetUser()
 	p = nil
-	p.GetAllowForcePushes()
+	p.GetUser()
 }
 
-func TestProtection_GetAllowForkSyncing(tt *testing.T) {
-	p := &Protection{}
-	p.GetAllowForkSyncing()
+func TestPullRequestReviewCommentEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewCommentEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestReviewCommentEvent{}
+	p.GetAction()
 	p = nil
-	p.GetAllowForkSyncing()
+	p.GetAction()
 }
 
-func TestProtection_GetBlockCreations(tt *testing.T) {
-	p := &Protection{}
-	p.GetBlockCreations()
+func TestPullRequestReviewCommentEvent_GetChanges(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetBlockCreations()
+	p.GetChanges()
 }
 
-func TestProtection_GetEnforceAdmins(tt *testing.T) {
-	p := &Protection{}
-	p.GetEnforceAdmins()
+func TestPullRequestReviewCommentEvent_GetComment(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetComment()
 	p = nil
-	p.GetEnforceAdmins()
+	p.GetComment()
 }
 
-func TestProtection_GetLockBranch(tt *testing.T) {
-	p := &Protection{}
-	p.GetLockBranch()
+func TestPullRequestReviewCommentEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetLockBranch()
+	p.GetInstallation()
 }
 
-func TestProtection_GetRequiredConversationResolution(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequiredConversationResolution()
+func TestPullRequestReviewCommentEvent_GetOrg(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetOrg()
 	p = nil
-	p.GetRequiredConversationResolution()
+	p.GetOrg()
 }
 
-func TestProtection_GetRequiredPullRequestReviews(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequiredPullRequestReviews()
+func TestPullRequestReviewCommentEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetRequiredPullRequestReviews()
+	p.GetPullRequest()
 }
 
-func TestProtection_GetRequiredStatusChecks(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequiredStatusChecks()
+func TestPullRequestReviewCommentEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetRequiredStatusChecks()
+	p.GetRepo()
 }
 
-func TestProtection_GetRequireLinearHistory(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequireLinearHistory()
+func TestPullRequestReviewCommentEvent_GetSender(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetSender()
 	p = nil
-	p.GetRequireLinearHistory()
+	p.GetSender()
 }
 
-func TestProtection_GetRestrictions(tt *testing.T) {
-	p := &Protection{}
-	p.GetRestrictions()
+func TestPullRequestReviewDismissalRequest_GetMessage(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewDismissalRequest{Message: &zeroValue}
+	p.GetMessage()
+	p = &PullRequestReviewDismissalRequest{}
+	p.GetMessage()
 	p = nil
-	p.GetRestrictions()
+	p.GetMessage()
 }
 
-func TestProtectionChanges_GetAdminEnforced(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetAdminEnforced()
+func TestPullRequestReviewEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestReviewEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
+}
+
+func TestPullRequestReviewEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetAdminEnforced()
+	p.GetInstallation()
 }
 
-func TestProtectionChanges_GetAllowDeletionsEnforcementLevel(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetAllowDeletionsEnforcementLevel()
+func TestPullRequestReviewEvent_GetOrganization(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetOrganization()
 	p = nil
-	p.GetAllowDeletionsEnforcementLevel()
+	p.GetOrganization()
 }
 
-func TestProtectionChanges_GetAuthorizedActorNames(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetAuthorizedActorNames()
+func TestPullRequestReviewEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetAuthorizedActorNames()
+	p.GetPullRequest()
 }
 
-func TestProtectionChanges_GetAuthorizedActorsOnly(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetAuthorizedActorsOnly()
+func TestPullRequestReviewEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetAuthorizedActorsOnly()
+	p.GetRepo()
 }
 
-func TestProtectionChanges_GetAuthorizedDismissalActorsOnly(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetAuthorizedDismissalActorsOnly()
+func TestPullRequestReviewEvent_GetReview(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetReview()
 	p = nil
-	p.GetAuthorizedDismissalActorsOnly()
+	p.GetReview()
 }
 
-func TestProtectionChanges_GetCreateProtected(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetCreateProtected()
+func TestPullRequestReviewEvent_GetSender(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetSender()
 	p = nil
-	p.GetCreateProtected()
+	p.GetSender()
 }
 
-func TestProtectionChanges_GetDismissStaleReviewsOnPush(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetDismissStaleReviewsOnPush()
+func TestPullRequestReviewRequest_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewRequest{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequestReviewRequest{}
+	p.GetBody()
 	p = nil
-	p.GetDismissStaleReviewsOnPush()
+	p.GetBody()
 }
 
-func TestProtectionChanges_GetLinearHistoryRequirementEnforcementLevel(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetLinearHistoryRequirementEnforcementLevel()
+func TestPullRequestReviewRequest_GetCommitID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewRequest{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestReviewRequest{}
+	p.GetCommitID()
 	p = nil
-	p.GetLinearHistoryRequirementEnforcementLevel()
+	p.GetCommitID()
 }
 
-func TestProtectionChanges_GetPullRequestReviewsEnforcementLevel(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetPullRequestReviewsEnforcementLevel()
+func TestPullRequestReviewRequest_GetEvent(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewRequest{Event: &zeroValue}
+	p.GetEvent()
+	p = &PullRequestReviewRequest{}
+	p.GetEvent()
 	p = nil
-	p.GetPullRequestReviewsEnforcementLevel()
+	p.GetEvent()
 }
 
-func TestProtectionChanges_GetRequireCodeOwnerReview(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetRequireCodeOwnerReview()
+func TestPullRequestReviewRequest_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewRequest{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestReviewRequest{}
+	p.GetNodeID()
 	p = nil
-	p.GetRequireCodeOwnerReview()
+	p.GetNodeID()
 }
 
-func TestProtectionChanges_GetRequiredConversationResolutionLevel(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetRequiredConversationResolutionLevel()
+func TestPullRequestReviewsEnforcement_GetBypassPullRequestAllowances(tt *testing.T) {
+	p := &PullRequestReviewsEnforcement{}
+	p.GetBypassPullRequestAllowances()
 	p = nil
-	p.GetRequiredConversationResolutionLevel()
+	p.GetBypassPullRequestAllowances()
 }
 
-func TestProtectionChanges_GetRequiredDeploymentsEnforcementLevel(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetRequiredDeploymentsEnforcementLevel()
+func TestPullRequestReviewsEnforcement_GetDismissalRestrictions(tt *testing.T) {
+	p := &PullRequestReviewsEnforcement{}
+	p.GetDismissalRestrictions()
 	p = nil
-	p.GetRequiredDeploymentsEnforcementLevel()
+	p.GetDismissalRestrictions()
 }
 
-func TestProtectionChanges_GetRequiredStatusChecks(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetRequiredStatusChecks()
+func TestPullRequestReviewsEnforcementLevelChanges_GetFrom(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewsEnforcementLevelChanges{From: &zeroValue}
+	p.GetFrom()
+	p = &PullRequestReviewsEnforcementLevelChanges{}
+	p.GetFrom()
 	p = nil
-	p.GetRequiredStatusChecks()
+	p.GetFrom()
 }
 
-func TestProtectionChanges_GetRequiredStatusChecksEnforcementLevel(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetRequiredStatusChecksEnforcementLevel()
+func TestPullRequestReviewsEnforcementRequest_GetBypassPullRequestAllowancesRequest(tt *testing.T) {
+	p := &PullRequestReviewsEnforcementRequest{}
+	p.GetBypassPullRequestAllowancesRequest()
 	p = nil
-	p.GetRequiredStatusChecksEnforcementLevel()
+	p.GetBypassPullRequestAllowancesRequest()
 }
 
-func TestProtectionChanges_GetSignatureRequirementEnforcementLevel(tt *testing.T) {
-	p := &ProtectionChanges{}
-	p.GetSignatureRequirementEnforcementLevel()
+func TestPullRequestReviewsEnforcementRequest_GetDismissalRestrictionsRequest(tt *testing.T) {
+	p := &PullRequestReviewsEnforcementRequest{}
+	p.GetDismissalRestrictionsRequest()
 	p = nil
-	p.GetSignatureRequirementEnforcementLevel()
+	p.GetDismissalRestrictionsRequest()
 }
 
-func TestProtectionRequest_GetAllowDeletions(tt *testing.T) {
+func TestPullRequestReviewsEnforcementRequest_GetRequireLastPushApproval(tt *testing.T) {
 	var zeroValue bool
-	p := &ProtectionRequest{AllowDeletions: &zeroValue}
-	p.GetAllowDeletions()
-	p = &ProtectionRequest{}
-	p.GetAllowDeletions()
+	p := &PullRequestReviewsEnforcementRequest{RequireLastPushApproval: &zeroValue}
+	p.GetRequireLastPushApproval()
+	p = &PullRequestReviewsEnforcementRequest{}
+	p.GetRequireLastPushApproval()
 	p = nil
-	p.GetAllowDeletions()
+	p.GetRequireLastPushApproval()
 }
 
-func TestProtectionRequest_GetAllowForcePushes(tt *testing.T) {
-	var zeroValue bool
-	p := &ProtectionRequest{AllowForcePushes: &zeroValue}
-	p.GetAllowForcePushes()
-	p = &ProtectionRequest{}
-	p.GetAllowForcePushes()
+func TestPullRequestReviewsEnforcementUpdate_GetBypassPullRequestAllowancesRequest(tt *testing.T) {
+	p := &PullRequestReviewsEnforcementUpdate{}
+	p.GetBypassPullRequestAllowancesRequest()
 	p = nil
-	p.GetAllowForcePushes()
+	p.GetBypassPullRequestAllowancesRequest()
 }
 
-func TestProtectionRequest_GetAllowForkSyncing(tt *testing.T) {
-	var zeroValue bool
-	p := &ProtectionRequest{AllowForkSyncing: &zeroValue}
-	p.GetAllowForkSyncing()
-	p = &ProtectionRequest{}
-	p.GetAllowForkSyncing()
+func TestPullRequestReviewsEnforcementUpdate_GetDismissalRestrictionsRequest(tt *testing.T) {
+	p := &PullRequestReviewsEnforcementUpdate{}
+	p.GetDismissalRestrictionsRequest()
 	p = nil
-	p.GetAllowForkSyncing()
+	p.GetDismissalRestrictionsRequest()
 }
 
-func TestProtectionRequest_GetBlockCreations(tt *testing.T) {
+func TestPullRequestReviewsEnforcementUpdate_GetDismissStaleReviews(tt *testing.T) {
 	var zeroValue bool
-	p := &ProtectionRequest{BlockCreations: &zeroValue}
-	p.GetBlockCreations()
-	p = &ProtectionRequest{}
-	p.GetBlockCreations()
+	p := &PullRequestReviewsEnforcementUpdate{DismissStaleReviews: &zeroValue}
+	p.GetDismissStaleReviews()
+	p = &PullRequestReviewsEnforcementUpdate{}
+	p.GetDismissStaleReviews()
 	p = nil
-	p.GetBlockCreations()
+	p.GetDismissStaleReviews()
 }
 
-func TestProtectionRequest_GetLockBranch(tt *testing.T) {
+func TestPullRequestReviewsEnforcementUpdate_GetRequireCodeOwnerReviews(tt *testing.T) {
 	var zeroValue bool
-	p := &ProtectionRequest{LockBranch: &zeroValue}
-	p.GetLockBranch()
-	p = &ProtectionRequest{}
-	p.GetLockBranch()
+	p := &PullRequestReviewsEnforcementUpdate{RequireCodeOwnerReviews: &zeroValue}
+	p.GetRequireCodeOwnerReviews()
+	p = &PullRequestReviewsEnforcementUpdate{}
+	p.GetRequireCodeOwnerReviews()
 	p = nil
-	p.GetLockBranch()
+	p.GetRequireCodeOwnerReviews()
 }
 
-func TestProtectionRequest_GetRequiredConversationResolution(tt *testing.T) {
+func TestPullRequestReviewsEnforcementUpdate_GetRequireLastPushApproval(tt *testing.T) {
 	var zeroValue bool
-	p := &ProtectionRequest{RequiredConversationResolution: &zeroValue}
-	p.GetRequiredConversationResolution()
-	p = &ProtectionRequest{}
-	p.GetRequiredConversationResolution()
+	p := &PullRequestReviewsEnforcementUpdate{RequireLastPushApproval: &zeroValue}
+	p.GetRequireLastPushApproval()
+	p = &PullRequestReviewsEnforcementUpdate{}
+	p.GetRequireLastPushApproval()
 	p = nil
-	p.GetRequiredConversationResolution()
+	p.GetRequireLastPushApproval()
 }
 
-func TestProtectionRequest_GetRequiredPullRequestReviews(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRequiredPullRequestReviews()
+func TestPullRequestReviewThread_GetID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewThread{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequestReviewThread{}
+	p.GetID()
 	p = nil
-	p.GetRequiredPullRequestReviews()
+	p.GetID()
 }
 
-func TestProtectionRequest_GetRequiredStatusChecks(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRequiredStatusChecks()
+func TestPullRequestReviewThread_GetIsOutdated(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestReviewThread{IsOutdated: &zeroValue}
+	p.GetIsOutdated()
+	p = &PullRequestReviewThread{}
+	p.GetIsOutdated()
 	p = nil
-	p.GetRequiredStatusChecks()
+	p.GetIsOutdated()
 }
 
-func TestProtectionRequest_GetRequireLinearHistory(tt *testing.T) {
+func TestPullRequestReviewThread_GetIsResolved(tt *testing.T) {
 	var zeroValue bool
-	p := &ProtectionRequest{RequireLinearHistory: &zeroValue}
-	p.GetRequireLinearHistory()
-	p = &ProtectionRequest{}
-	p.GetRequireLinearHistory()
+	p := &PullRequestReviewThread{IsResolved: &zeroValue}
+	p.GetIsResolved()
+	p = &PullRequestReviewThread{}
+	p.GetIsResolved()
 	p = nil
-	p.GetRequireLinearHistory()
+	p.GetIsResolved()
 }
 
-func TestProtectionRequest_GetRestrictions(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRestrictions()
+func TestPullRequestReviewThread_GetLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestReviewThread{Line: &zeroValue}
+	p.GetLine()
+	p = &PullRequestReviewThread{}
+	p.GetLine()
 	p = nil
-	p.GetRestrictions()
+	p.GetLine()
 }
 
-func TestProtectionRule_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProtectionRule{ID: &zeroValue}
-	p.GetID()
-	p = &ProtectionRule{}
-	p.GetID()
+func TestPullRequestReviewThread_GetPath(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewThread{Path: &zeroValue}
+	p.GetPath()
+	p = &PullRequestReviewThread{}
+	p.GetPath()
 	p = nil
-	p.GetID()
+	p.GetPath()
 }
 
-func TestProtectionRule_GetNodeID(tt *testing.T) {
+func TestPullRequestReviewThread_GetResolvedBy(tt *testing.T) {
+	p := &PullRequestReviewThread{}
+	p.GetResolvedBy()
+	p = nil
+	p.GetResolvedBy()
+}
+
+func TestPullRequestReviewThreadEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &ProtectionRule{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &ProtectionRule{}
-	p.GetNodeID()
+	p := &PullRequestReviewThreadEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestReviewThreadEvent{}
+	p.GetAction()
 	p = nil
-	p.GetNodeID()
+	p.GetAction()
 }
 
-func TestProtectionRule_GetType(tt *testing.T) {
-	var zeroValue string
-	p := &ProtectionRule{Type: &zeroValue}
-	p.GetType()
-	p = &ProtectionRule{}
-	p.GetType()
+func TestPullRequestReviewThreadEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestReviewThreadEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetType()
+	p.GetInstallation()
 }
 
-func TestProtectionRule_GetWaitTimer(tt *testing.T) {
-	var zeroValue int
-	p := &ProtectionRule{WaitTimer: &zeroValue}
-	p.GetWaitTimer()
-	p = &ProtectionRule{}
-	p.GetWaitTimer()
+func TestPullRequestReviewThreadEvent_GetOrg(tt *testing.T) {
+	p := &PullRequestReviewThreadEvent{}
+	p.GetOrg()
 	p = nil
-	p.GetWaitTimer()
+	p.GetOrg()
 }
 
-func TestPublicEvent_GetInstallation(tt *testing.T) {
-	p := &PublicEvent{}
-	p.GetInstallation()
+func TestPullRequestReviewThreadEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestReviewThreadEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetInstallation()
+	p.GetPullRequest()
 }
 
-func TestPublicEvent_GetRepo(tt *testing.T) {
-	p := &PublicEvent{}
+func TestPullRequestReviewThreadEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestReviewThreadEvent{}
 	p.GetRepo()
 	p = nil
 	p.GetRepo()
 }
 
-func TestPublicEvent_GetSender(tt *testing.T) {
-	p := &PublicEvent{}
+func TestPullRequestReviewThreadEvent_GetSender(tt *testing.T) {
+	p := &PullRequestReviewThreadEvent{}
 	p.GetSender()
 	p = nil
 	p.GetSender()
 }
 
-func TestPublicKey_GetKey(tt *testing.T) {
-	var zeroValue string
-	p := &PublicKey{Key: &zeroValue}
-	p.GetKey()
-	p = &PublicKey{}
-	p.GetKey()
+func TestPullRequestReviewThreadEvent_GetThread(tt *testing.T) {
+	p := &PullRequestReviewThreadEvent{}
+	p.GetThread()
 	p = nil
-	p.GetKey()
+	p.GetThread()
 }
 
-func TestPublicKey_GetKeyID(tt *testing.T) {
+func TestPullRequestTargetEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PublicKey{KeyID: &zeroValue}
-	p.GetKeyID()
-	p = &PublicKey{}
-	p.GetKeyID()
+	p := &PullRequestTargetEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestTargetEvent{}
+	p.GetAction()
 	p = nil
-	p.GetKeyID()
+	p.GetAction()
 }
 
-func TestPullRequest_GetActiveLockReason(tt *testing.T) {
+func TestPullRequestTargetEvent_GetAfter(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{ActiveLockReason: &zeroValue}
-	p.GetActiveLockReason()
-	p = &PullRequest{}
-	p.GetActiveLockReason()
-	p = nil
-	p.GetActiveLockReason()
-}
-
-func TestPullRequest_GetAdditions(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Additions: &zeroValue}
-	p.GetAdditions()
-	p = &PullRequest{}
-	p.GetAdditions()
+	p := &PullRequestTargetEvent{After: &zeroValue}
+	p.GetAfter()
+	p = &PullRequestTargetEvent{}
+	p.GetAfter()
 	p = nil
-	p.GetAdditions()
+	p.GetAfter()
 }
 
-func TestPullRequest_GetAssignee(tt *testing.T) {
-	p := &PullRequest{}
+func TestPullRequestTargetEvent_GetAssignee(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
 	p.GetAssignee()
 	p = nil
 	p.GetAssignee()
 }
 
-func TestPullRequest_GetAuthorAssociation(tt *testing.T) {
+func TestPullRequestTargetEvent_GetBefore(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequest{}
-	p.GetAuthorAssociation()
+	p := &PullRequestTargetEvent{Before: &zeroValue}
+	p.GetBefore()
+	p = &PullRequestTargetEvent{}
+	p.GetBefore()
 	p = nil
-	p.GetAuthorAssociation()
+	p.GetBefore()
 }
 
-func TestPullRequest_GetAutoMerge(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetAutoMerge()
+func TestPullRequestTargetEvent_GetChanges(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetAutoMerge()
+	p.GetChanges()
 }
 
-func TestPullRequest_GetBase(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetBase()
+func TestPullRequestTargetEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetBase()
+	p.GetInstallation()
 }
 
-func TestPullRequest_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequest{}
-	p.GetBody()
+func TestPullRequestTargetEvent_GetLabel(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetLabel()
 	p = nil
-	p.GetBody()
+	p.GetLabel()
 }
 
-func TestPullRequest_GetChangedFiles(tt *testing.T) {
+func TestPullRequestTargetEvent_GetNumber(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequest{ChangedFiles: &zeroValue}
-	p.GetChangedFiles()
-	p = &PullRequest{}
-	p.GetChangedFiles()
+	p := &PullRequestTargetEvent{Number: &zeroValue}
+	p.GetNumber()
+	p = &PullRequestTargetEvent{}
+	p.GetNumber()
 	p = nil
-	p.GetChangedFiles()
+	p.GetNumber()
 }
 
-func TestPullRequest_GetClosedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PullRequest{ClosedAt: &zeroValue}
-	p.GetClosedAt()
-	p = &PullRequest{}
-	p.GetClosedAt()
+func TestPullRequestTargetEvent_GetOrganization(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetOrganization()
 	p = nil
-	p.GetClosedAt()
+	p.GetOrganization()
 }
 
-func TestPullRequest_GetComments(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Comments: &zeroValue}
-	p.GetComments()
-	p = &PullRequest{}
-	p.GetComments()
+func TestPullRequestTargetEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetComments()
+	p.GetPullRequest()
 }
 
-func TestPullRequest_GetCommentsURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{CommentsURL: &zeroValue}
-	p.GetCommentsURL()
-	p = &PullRequest{}
-	p.GetCommentsURL()
+func TestPullRequestTargetEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetCommentsURL()
+	p.GetRepo()
 }
 
-func TestPullRequest_GetCommits(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Commits: &zeroValue}
-	p.GetCommits()
-	p = &PullRequest{}
-	p.GetCommits()
+func TestPullRequestTargetEvent_GetRequestedReviewer(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetRequestedReviewer()
 	p = nil
-	p.GetCommits()
+	p.GetRequestedReviewer()
 }
 
-func TestPullRequest_GetCommitsURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{CommitsURL: &zeroValue}
-	p.GetCommitsURL()
-	p = &PullRequest{}
-	p.GetCommitsURL()
+func TestPullRequestTargetEvent_GetRequestedTeam(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetRequestedTeam()
 	p = nil
-	p.GetCommitsURL()
+	p.GetRequestedTeam()
 }
 
-func TestPullRequest_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PullRequest{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PullRequest{}
-	p.GetCreatedAt()
+func TestPullRequestTargetEvent_GetSender(tt *testing.T) {
+	p := &PullRequestTargetEvent{}
+	p.GetSender()
 	p = nil
-	p.GetCreatedAt()
+	p.GetSender()
 }
 
-func TestPullRequest_GetDeletions(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Deletions: &zeroValue}
-	p.GetDeletions()
-	p = &PullRequest{}
-	p.GetDeletions()
+func TestPullRequestThread_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestThread{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequestThread{}
+	p.GetID()
 	p = nil
-	p.GetDeletions()
+	p.GetID()
 }
 
-func TestPullRequest_GetDiffURL(tt *testing.T) {
+func TestPullRequestThread_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{DiffURL: &zeroValue}
-	p.GetDiffURL()
-	p = &PullRequest{}
-	p.GetDiffURL()
-	p = nil
-	p.GetDiffURL()
-}
-
-func TestPullRequest_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PullRequest{}
-	p.GetDraft()
-	p = nil
-	p.GetDraft()
-}
-
-func TestPullRequest_GetHead(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetHead()
+	p := &PullRequestThread{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestThread{}
+	p.GetNodeID()
 	p = nil
-	p.GetHead()
+	p.GetNodeID()
 }
 
-func TestPullRequest_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequest{}
-	p.GetHTMLURL()
+func TestPullStats_GetMergablePulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{MergablePulls: &zeroValue}
+	p.GetMergablePulls()
+	p = &PullStats{}
+	p.GetMergablePulls()
 	p = nil
-	p.GetHTMLURL()
+	p.GetMergablePulls()
 }
 
-func TestPullRequest_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequest{ID: &zeroValue}
-	p.GetID()
-	p = &PullRequest{}
-	p.GetID()
+func TestPullStats_GetMergedPulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{MergedPulls: &zeroValue}
+	p.GetMergedPulls()
+	p = &PullStats{}
+	p.GetMergedPulls()
 	p = nil
-	p.GetID()
+	p.GetMergedPulls()
 }
 
-func TestPullRequest_GetIssueURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{IssueURL: &zeroValue}
-	p.GetIssueURL()
-	p = &PullRequest{}
-	p.GetIssueURL()
+func TestPullStats_GetTotalPulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{TotalPulls: &zeroValue}
+	p.GetTotalPulls()
+	p = &PullStats{}
+	p.GetTotalPulls()
 	p = nil
-	p.GetIssueURL()
+	p.GetTotalPulls()
 }
 
-func TestPullRequest_GetLinks(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetLinks()
+func TestPullStats_GetUnmergablePulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{UnmergablePulls: &zeroValue}
+	p.GetUnmergablePulls()
+	p = &PullStats{}
+	p.GetUnmergablePulls()
 	p = nil
-	p.GetLinks()
+	p.GetUnmergablePulls()
 }
 
-func TestPullRequest_GetLocked(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Locked: &zeroValue}
-	p.GetLocked()
-	p = &PullRequest{}
-	p.GetLocked()
+func TestPunchCard_GetCommits(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Commits: &zeroValue}
+	p.GetCommits()
+	p = &PunchCard{}
+	p.GetCommits()
 	p = nil
-	p.GetLocked()
+	p.GetCommits()
 }
 
-func TestPullRequest_GetMaintainerCanModify(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{MaintainerCanModify: &zeroValue}
-	p.GetMaintainerCanModify()
-	p = &PullRequest{}
-	p.GetMaintainerCanModify()
+func TestPunchCard_GetDay(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Day: &zeroValue}
+	p.GetDay()
+	p = &PunchCard{}
+	p.GetDay()
 	p = nil
-	p.GetMaintainerCanModify()
+	p.GetDay()
 }
 
-func TestPullRequest_GetMergeable(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Mergeable: &zeroValue}
-	p.GetMergeable()
-	p = &PullRequest{}
-	p.GetMergeable()
+func TestPunchCard_GetHour(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Hour: &zeroValue}
+	p.GetHour()
+	p = &PunchCard{}
+	p.GetHour()
 	p = nil
-	p.GetMergeable()
+	p.GetHour()
 }
 
-func TestPullRequest_GetMergeableState(tt *testing.T) {
+func TestPushEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{MergeableState: &zeroValue}
-	p.GetMergeableState()
-	p = &PullRequest{}
-	p.GetMergeableState()
+	p := &PushEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PushEvent{}
+	p.GetAction()
 	p = nil
-	p.GetMergeableState()
+	p.GetAction()
 }
 
-func TestPullRequest_GetMergeCommitSHA(tt *testing.T) {
+func TestPushEvent_GetAfter(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{MergeCommitSHA: &zeroValue}
-	p.GetMergeCommitSHA()
-	p = &PullRequest{}
-	p.GetMergeCommitSHA()
+	p := &PushEvent{After: &zeroValue}
+	p.GetAfter()
+	p = &PushEvent{}
+	p.GetAfter()
 	p = nil
-	p.GetMergeCommitSHA()
+	p.GetAfter()
 }
 
-func TestPullRequest_GetMerged(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Merged: &zeroValue}
-	p.GetMerged()
-	p = &PullRequest{}
-	p.GetMerged()
+func TestPushEvent_GetBaseRef(tt *testing.T) {
+	var zeroValue string
+	p := &PushEvent{BaseRef: &zeroValue}
+	p.GetBaseRef()
+	p = &PushEvent{}
+	p.GetBaseRef()
 	p = nil
-	p.GetMerged()
+	p.GetBaseRef()
 }
 
-func TestPullRequest_GetMergedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PullRequest{MergedAt: &zeroValue}
-	p.GetMergedAt()
-	p = &PullRequest{}
-	p.GetMergedAt()
+func TestPushEvent_GetBefore(tt *testing.T) {
+	var zeroValue string
+	p := &PushEvent{Before: &zeroValue}
+	p.GetBefore()
+	p = &PushEvent{}
+	p.GetBefore()
 	p = nil
-	p.GetMergedAt()
+	p.GetBefore()
 }
 
-func TestPullRequest_GetMergedBy(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetMergedBy()
+func TestPushEvent_GetCompare(tt *testing.T) {
+	var zeroValue string
+	p := &PushEvent{Compare: &zeroValue}
+	p.GetCompare()
+	p = &PushEvent{}
+	p.GetCompare()
 	p = nil
-	p.GetMergedBy()
+	p.GetCompare()
 }
 
-func TestPullRequest_GetMilestone(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetMilestone()
+func TestPushEvent_GetCreated(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEvent{Created: &zeroValue}
+	p.GetCreated()
+	p = &PushEvent{}
+	p.GetCreated()
 	p = nil
-	p.GetMilestone()
+	p.GetCreated()
 }
 
-func TestPullRequest_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequest{}
-	p.GetNodeID()
+func TestPushEvent_GetDeleted(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEvent{Deleted: &zeroValue}
+	p.GetDeleted()
+	p = &PushEvent{}
+	p.GetDeleted()
 	p = nil
-	p.GetNodeID()
+	p.GetDeleted()
 }
 
-func TestPullRequest_GetNumber(tt *testing.T) {
+func TestPushEvent_GetDistinctSize(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequest{Number: &zeroValue}
-	p.GetNumber()
-	p = &PullRequest{}
-	p.GetNumber()
+	p := &PushEvent{DistinctSize: &zeroValue}
+	p.GetDistinctSize()
+	p = &PushEvent{}
+	p.GetDistinctSize()
 	p = nil
-	p.GetNumber()
+	p.GetDistinctSize()
 }
 
-func TestPullRequest_GetPatchURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{PatchURL: &zeroValue}
-	p.GetPatchURL()
-	p = &PullRequest{}
-	p.GetPatchURL()
+func TestPushEvent_GetForced(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEvent{Forced: &zeroValue}
+	p.GetForced()
+	p = &PushEvent{}
+	p.GetForced()
 	p = nil
-	p.GetPatchURL()
+	p.GetForced()
 }
 
-func TestPullRequest_GetRebaseable(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Rebaseable: &zeroValue}
-	p.GetRebaseable()
-	p = &PullRequest{}
-	p.GetRebaseable()
+func TestPushEvent_GetHead(tt *testing.T) {
+	var zeroValue string
+	p := &PushEvent{Head: &zeroValue}
+	p.GetHead()
+	p = &PushEvent{}
+	p.GetHead()
 	p = nil
-	p.GetRebaseable()
+	p.GetHead()
 }
 
-func TestPullRequest_GetReviewComments(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{ReviewComments: &zeroValue}
-	p.GetReviewComments()
-	p = &PullRequest{}
-	p.GetReviewComments()
+func TestPushEvent_GetHeadCommit(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetHeadCommit()
 	p = nil
-	p.GetReviewComments()
+	p.GetHeadCommit()
 }
 
-func TestPullRequest_GetReviewCommentsURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{ReviewCommentsURL: &zeroValue}
-	p.GetReviewCommentsURL()
-	p = &PullRequest{}
-	p.GetReviewCommentsURL()
+func TestPushEvent_GetInstallation(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetReviewCommentsURL()
+	p.GetInstallation()
 }
 
-func TestPullRequest_GetReviewCommentURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{ReviewCommentURL: &zeroValue}
-	p.GetReviewCommentURL()
-	p = &PullRequest{}
-	p.GetReviewCommentURL()
+func TestPushEvent_GetOrganization(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetOrganization()
 	p = nil
-	p.GetReviewCommentURL()
+	p.GetOrganization()
 }
 
-func TestPullRequest_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{State: &zeroValue}
-	p.GetState()
-	p = &PullRequest{}
-	p.GetState()
+func TestPushEvent_GetPusher(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetPusher()
 	p = nil
-	p.GetState()
+	p.GetPusher()
 }
 
-func TestPullRequest_GetStatusesURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{StatusesURL: &zeroValue}
-	p.GetStatusesURL()
-	p = &PullRequest{}
-	p.GetStatusesURL()
+func TestPushEvent_GetPushID(tt *testing.T) {
+	var zeroValue int64
+	p := &PushEvent{PushID: &zeroValue}
+	p.GetPushID()
+	p = &PushEvent{}
+	p.GetPushID()
 	p = nil
-	p.GetStatusesURL()
+	p.GetPushID()
 }
 
-func TestPullRequest_GetTitle(tt *testing.T) {
+func TestPushEvent_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{Title: &zeroValue}
-	p.GetTitle()
-	p = &PullRequest{}
-	p.GetTitle()
+	p := &PushEvent{Ref: &zeroValue}
+	p.GetRef()
+	p = &PushEvent{}
+	p.GetRef()
 	p = nil
-	p.GetTitle()
+	p.GetRef()
 }
 
-func TestPullRequest_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PullRequest{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PullRequest{}
-	p.GetUpdatedAt()
+func TestPushEvent_GetRepo(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetRepo()
 }
 
-func TestPullRequest_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequest{}
-	p.GetURL()
+func TestPushEvent_GetSender(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetSender()
 	p = nil
-	p.GetURL()
+	p.GetSender()
 }
 
-func TestPullRequest_GetUser(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetUser()
+func TestPushEvent_GetSize(tt *testing.T) {
+	var zeroValue int
+	p := &PushEvent{Size: &zeroValue}
+	p.GetSize()
+	p = &PushEvent{}
+	p.GetSize()
 	p = nil
-	p.GetUser()
+	p.GetSize()
 }
 
-func TestPullRequestAutoMerge_GetCommitMessage(tt *testing.T) {
+func TestPushEventRepoOwner_GetEmail(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestAutoMerge{CommitMessage: &zeroValue}
-	p.GetCommitMessage()
-	p = &PullRequestAutoMerge{}
-	p.GetCommitMessage()
+	p := &PushEventRepoOwner{Email: &zeroValue}
+	p.GetEmail()
+	p = &PushEventRepoOwner{}
+	p.GetEmail()
 	p = nil
-	p.GetCommitMessage()
+	p.GetEmail()
 }
 
-func TestPullRequestAutoMerge_GetCommitTitle(tt *testing.T) {
+func TestPushEventRepoOwner_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestAutoMerge{CommitTitle: &zeroValue}
-	p.GetCommitTitle()
-	p = &PullRequestAutoMerge{}
-	p.GetCommitTitle()
+	p := &PushEventRepoOwner{Name: &zeroValue}
+	p.GetName()
+	p = &PushEventRepoOwner{}
+	p.GetName()
 	p = nil
-	p.GetCommitTitle()
+	p.GetName()
 }
 
-func TestPullRequestAutoMerge_GetEnabledBy(tt *testing.T) {
-	p := &PullRequestAutoMerge{}
-	p.GetEnabledBy()
+func TestPushEventRepository_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Archived: &zeroValue}
+	p.GetArchived()
+	p = &PushEventRepository{}
+	p.GetArchived()
 	p = nil
-	p.GetEnabledBy()
+	p.GetArchived()
 }
 
-func TestPullRequestAutoMerge_GetMergeMethod(tt *testing.T) {
+func TestPushEventRepository_GetArchiveURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestAutoMerge{MergeMethod: &zeroValue}
-	p.GetMergeMethod()
-	p = &PullRequestAutoMerge{}
-	p.GetMergeMethod()
+	p := &PushEventRepository{ArchiveURL: &zeroValue}
+	p.GetArchiveURL()
+	p = &PushEventRepository{}
+	p.GetArchiveURL()
 	p = nil
-	p.GetMergeMethod()
+	p.GetArchiveURL()
 }
 
-func TestPullRequestBranch_GetLabel(tt *testing.T) {
+func TestPushEventRepository_GetCloneURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranch{Label: &zeroValue}
-	p.GetLabel()
-	p = &PullRequestBranch{}
-	p.GetLabel()
+	p := &PushEventRepository{CloneURL: &zeroValue}
+	p.GetCloneURL()
+	p = &PushEventRepository{}
+	p.GetCloneURL()
 	p = nil
-	p.GetLabel()
+	p.GetCloneURL()
 }
 
-func TestPullRequestBranch_GetRef(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestBranch{Ref: &zeroValue}
-	p.GetRef()
-	p = &PullRequestBranch{}
-	p.GetRef()
+func TestPushEventRepository_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushEventRepository{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PushEventRepository{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetRef()
+	p.GetCreatedAt()
 }
 
-func TestPullRequestBranch_GetRepo(tt *testing.T) {
-	p := &PullRequestBranch{}
-	p.GetRepo()
+func TestPushEventRepository_GetDefaultBranch(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{DefaultBranch: &zeroValue}
+	p.GetDefaultBranch()
+	p = &PushEventRepository{}
+	p.GetDefaultBranch()
 	p = nil
-	p.GetRepo()
+	p.GetDefaultBranch()
 }
 
-func TestPullRequestBranch_GetSHA(tt *testing.T) {
+func TestPushEventRepository_GetDescription(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranch{SHA: &zeroValue}
-	p.GetSHA()
-	p = &PullRequestBranch{}
-	p.GetSHA()
+	p := &PushEventRepository{Description: &zeroValue}
+	p.GetDescription()
+	p = &PushEventRepository{}
+	p.GetDescription()
 	p = nil
-	p.GetSHA()
+	p.GetDescription()
 }
 
-func TestPullRequestBranch_GetUser(tt *testing.T) {
-	p := &PullRequestBranch{}
-	p.GetUser()
+func TestPushEventRepository_GetDisabled(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Disabled: &zeroValue}
+	p.GetDisabled()
+	p = &PushEventRepository{}
+	p.GetDisabled()
 	p = nil
-	p.GetUser()
+	p.GetDisabled()
 }
 
-func TestPullRequestBranchUpdateOptions_GetExpectedHeadSHA(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestBranchUpdateOptions{ExpectedHeadSHA: &zeroValue}
-	p.GetExpectedHeadSHA()
-	p = &PullRequestBranchUpdateOptions{}
-	p.GetExpectedHeadSHA()
+func TestPushEventRepository_GetFork(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Fork: &zeroValue}
+	p.GetFork()
+	p = &PushEventRepository{}
+	p.GetFork()
 	p = nil
-	p.GetExpectedHeadSHA()
+	p.GetFork()
 }
 
-func TestPullRequestBranchUpdateResponse_GetMessage(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestBranchUpdateResponse{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestBranchUpdateResponse{}
-	p.GetMessage()
+func TestPushEventRepository_GetForksCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{ForksCount: &zeroValue}
+	p.GetForksCount()
+	p = &PushEventRepository{}
+	p.GetForksCount()
 	p = nil
-	p.GetMessage()
+	p.GetForksCount()
 }
 
-func TestPullRequestBranchUpdateResponse_GetURL(tt *testing.T) {
+func TestPushEventRepository_GetFullName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranchUpdateResponse{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestBranchUpdateResponse{}
-	p.GetURL()
+	p := &PushEventRepository{FullName: &zeroValue}
+	p.GetFullName()
+	p = &PushEventRepository{}
+	p.GetFullName()
 	p = nil
-	p.GetURL()
+	p.GetFullName()
 }
 
-func TestPullRequestComment_GetAuthorAssociation(tt *testing.T) {
+func TestPushEventRepository_GetGitURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequestComment{}
-	p.GetAuthorAssociation()
+	p := &PushEventRepository{GitURL: &zeroValue}
+	p.GetGitURL()
+	p = &PushEventRepository{}
+	p.GetGitURL()
 	p = nil
-	p.GetAuthorAssociation()
+	p.GetGitURL()
 }
 
-func TestPullRequestComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestComment{}
-	p.GetBody()
+func TestPushEventRepository_GetHasDownloads(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasDownloads: &zeroValue}
+	p.GetHasDownloads()
+	p = &PushEventRepository{}
+	p.GetHasDownloads()
 	p = nil
-	p.GetBody()
+	p.GetHasDownloads()
 }
 
-func TestPullRequestComment_GetCommitID(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestComment{}
-	p.GetCommitID()
+func TestPushEventRepository_GetHasIssues(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasIssues: &zeroValue}
+	p.GetHasIssues()
+	p = &PushEventRepository{}
+	p.GetHasIssues()
 	p = nil
-	p.GetCommitID()
+	p.GetHasIssues()
+}
+
+func TestPushEventRepository_GetHasPages(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasPages: &zeroValue}
+	p.GetHasPages()
+	p = &PushEventRepository{}
+	p.GetHasPages()
+	p = nil
+	p.GetHasPages()
 }
 
-func TestPullRequestComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PullRequestComment{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PullRequestComment{}
-	p.GetCreatedAt()
+func TestPushEventRepository_GetHasWiki(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasWiki: &zeroValue}
+	p.GetHasWiki()
+	p = &PushEventRepository{}
+	p.GetHasWiki()
 	p = nil
-	p.GetCreatedAt()
+	p.GetHasWiki()
 }
 
-func TestPullRequestComment_GetDiffHunk(tt *testing.T) {
+func TestPushEventRepository_GetHomepage(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{DiffHunk: &zeroValue}
-	p.GetDiffHunk()
-	p = &PullRequestComment{}
-	p.GetDiffHunk()
+	p := &PushEventRepository{Homepage: &zeroValue}
+	p.GetHomepage()
+	p = &PushEventRepository{}
+	p.GetHomepage()
 	p = nil
-	p.GetDiffHunk()
+	p.GetHomepage()
 }
 
-func TestPullRequestComment_GetHTMLURL(tt *testing.T) {
+func TestPushEventRepository_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{HTMLURL: &zeroValue}
+	p := &PushEventRepository{HTMLURL: &zeroValue}
 	p.GetHTMLURL()
-	p = &PullRequestComment{}
+	p = &PushEventRepository{}
 	p.GetHTMLURL()
 	p = nil
 	p.GetHTMLURL()
 }
 
-func TestPullRequestComment_GetID(tt *testing.T) {
+func TestPushEventRepository_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PullRequestComment{ID: &zeroValue}
+	p := &PushEventRepository{ID: &zeroValue}
 	p.GetID()
-	p = &PullRequestComment{}
+	p = &PushEventRepository{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPullRequestComment_GetInReplyTo(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestComment{InReplyTo: &zeroValue}
-	p.GetInReplyTo()
-	p = &PullRequestComment{}
-	p.GetInReplyTo()
+func TestPushEventRepository_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{Language: &zeroValue}
+	p.GetLanguage()
+	p = &PushEventRepository{}
+	p.GetLanguage()
 	p = nil
-	p.GetInReplyTo()
+	p.GetLanguage()
 }
 
-func TestPullRequestComment_GetLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{Line: &zeroValue}
-	p.GetLine()
-	p = &PullRequestComment{}
-	p.GetLine()
+func TestPushEventRepository_GetMasterBranch(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{MasterBranch: &zeroValue}
+	p.GetMasterBranch()
+	p = &PushEventRepository{}
+	p.GetMasterBranch()
 	p = nil
-	p.GetLine()
+	p.GetMasterBranch()
 }
 
-func TestPullRequestComment_GetNodeID(tt *testing.T) {
+func TestPushEventRepository_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{NodeID: &zeroValue}
+	p := &PushEventRepository{Name: &zeroValue}
+	p.GetName()
+	p = &PushEventRepository{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestPushEventRepository_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{NodeID: &zeroValue}
 	p.GetNodeID()
-	p = &PullRequestComment{}
+	p = &PushEventRepository{}
 	p.GetNodeID()
 	p = nil
 	p.GetNodeID()
 }
 
-func TestPullRequestComment_GetOriginalCommitID(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{OriginalCommitID: &zeroValue}
-	p.GetOriginalCommitID()
-	p = &PullRequestComment{}
-	p.GetOriginalCommitID()
+func TestPushEventRepository_GetOpenIssuesCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{OpenIssuesCount: &zeroValue}
+	p.GetOpenIssuesCount()
+	p = &PushEventRepository{}
+	p.GetOpenIssuesCount()
 	p = nil
-	p.GetOriginalCommitID()
+	p.GetOpenIssuesCount()
 }
 
-func TestPullRequestComment_GetOriginalLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{OriginalLine: &zeroValue}
-	p.GetOriginalLine()
-	p = &PullRequestComment{}
-	p.GetOriginalLine()
+func TestPushEventRepository_GetOrganization(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{Organization: &zeroValue}
+	p.GetOrganization()
+	p = &PushEventRepository{}
+	p.GetOrganization()
 	p = nil
-	p.GetOriginalLine()
+	p.GetOrganization()
 }
 
-func TestPullRequestComment_GetOriginalPosition(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{OriginalPosition: &zeroValue}
-	p.GetOriginalPosition()
-	p = &PullRequestComment{}
-	p.GetOriginalPosition()
+func TestPushEventRepository_GetOwner(tt *testing.T) {
+	p := &PushEventRepository{}
+	p.GetOwner()
 	p = nil
-	p.GetOriginalPosition()
+	p.GetOwner()
 }
 
-func TestPullRequestComment_GetOriginalStartLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{OriginalStartLine: &zeroValue}
-	p.GetOriginalStartLine()
-	p = &PullRequestComment{}
-	p.GetOriginalStartLine()
+func TestPushEventRepository_GetPrivate(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Private: &zeroValue}
+	p.GetPrivate()
+	p = &PushEventRepository{}
+	p.GetPrivate()
 	p = nil
-	p.GetOriginalStartLine()
+	p.GetPrivate()
 }
 
-func TestPullRequestComment_GetPath(tt *testing.T) {
+func TestPushEventRepository_GetPullsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{Path: &zeroValue}
-	p.GetPath()
-	p = &PullRequestComment{}
-	p.GetPath()
+	p := &PushEventRepository{PullsURL: &zeroValue}
+	p.GetPullsURL()
+	p = &PushEventRepository{}
+	p.GetPullsURL()
 	p = nil
-	p.GetPath()
+	p.GetPullsURL()
 }
 
-func TestPullRequestComment_GetPosition(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{Position: &zeroValue}
-	p.GetPosition()
-	p = &PullRequestComment{}
-	p.GetPosition()
+func TestPushEventRepository_GetPushedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushEventRepository{PushedAt: &zeroValue}
+	p.GetPushedAt()
+	p = &PushEventRepository{}
+	p.GetPushedAt()
 	p = nil
-	p.GetPosition()
+	p.GetPushedAt()
 }
 
-func TestPullRequestComment_GetPullRequestReviewID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestComment{PullRequestReviewID: &zeroValue}
-	p.GetPullRequestReviewID()
-	p = &PullRequestComment{}
-	p.GetPullRequestReviewID()
+func TestPushEventRepository_GetSize(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{Size: &zeroValue}
+	p.GetSize()
+	p = &PushEventRepository{}
+	p.GetSize()
 	p = nil
-	p.GetPullRequestReviewID()
+	p.GetSize()
 }
 
-func TestPullRequestComment_GetPullRequestURL(tt *testing.T) {
+func TestPushEventRepository_GetSSHURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{PullRequestURL: &zeroValue}
-	p.GetPullRequestURL()
-	p = &PullRequestComment{}
-	p.GetPullRequestURL()
+	p := &PushEventRepository{SSHURL: &zeroValue}
+	p.GetSSHURL()
+	p = &PushEventRepository{}
+	p.GetSSHURL()
 	p = nil
-	p.GetPullRequestURL()
+	p.GetSSHURL()
 }
 
-func TestPullRequestComment_GetReactions(tt *testing.T) {
-	p := &PullRequestComment{}
-	p.GetReactions()
+func TestPushEventRepository_GetStargazersCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{StargazersCount: &zeroValue}
+	p.GetStargazersCount()
+	p = &PushEventRepository{}
+	p.GetStargazersCount()
 	p = nil
-	p.GetReactions()
+	p.GetStargazersCount()
 }
 
-func TestPullRequestComment_GetSide(tt *testing.T) {
+func TestPushEventRepository_GetStatusesURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{Side: &zeroValue}
-	p.GetSide()
-	p = &PullRequestComment{}
-	p.GetSide()
-	p = nil
-	p.GetSide()
-}
-
-func TestPullRequestComment_GetStartLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{StartLine: &zeroValue}
-	p.GetStartLine()
-	p = &PullRequestComment{}
-	p.GetStartLine()
+	p := &PushEventRepository{StatusesURL: &zeroValue}
+	p.GetStatusesURL()
+	p = &PushEventRepository{}
+	p.GetStatusesURL()
 	p = nil
-	p.GetStartLine()
+	p.GetStatusesURL()
 }
 
-func TestPullRequestComment_GetStartSide(tt *testing.T) {
+func TestPushEventRepository_GetSVNURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{StartSide: &zeroValue}
-	p.GetStartSide()
-	p = &PullRequestComment{}
-	p.GetStartSide()
+	p := &PushEventRepository{SVNURL: &zeroValue}
+	p.GetSVNURL()
+	p = &PushEventRepository{}
+	p.GetSVNURL()
 	p = nil
-	p.GetStartSide()
+	p.GetSVNURL()
 }
 
-func TestPullRequestComment_GetUpdatedAt(tt *testing.T) {
+func TestPushEventRepository_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	p := &PullRequestComment{UpdatedAt: &zeroValue}
+	p := &PushEventRepository{UpdatedAt: &zeroValue}
 	p.GetUpdatedAt()
-	p = &PullRequestComment{}
+	p = &PushEventRepository{}
 	p.GetUpdatedAt()
 	p = nil
 	p.GetUpdatedAt()
 }
 
-func TestPullRequestComment_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestComment{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
-}
-
-func TestPullRequestComment_GetUser(tt *testing.T) {
-	p := &PullRequestComment{}
-	p.GetUser()
-	p = nil
-	p.GetUser()
-}
-
-func TestPullRequestEvent_GetAction(tt *testing.T) {
+func TestPushEventRepository_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestEvent{}
-	p.GetAction()
+	p := &PushEventRepository{URL: &zeroValue}
+	p.GetURL()
+	p = &PushEventRepository{}
+	p.GetURL()
 	p = nil
-	p.GetAction()
+	p.GetURL()
 }
 
-func TestPullRequestEvent_GetAfter(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestEvent{After: &zeroValue}
-	p.GetAfter()
-	p = &PullRequestEvent{}
-	p.GetAfter()
+func TestPushEventRepository_GetWatchersCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{WatchersCount: &zeroValue}
+	p.GetWatchersCount()
+	p = &PushEventRepository{}
+	p.GetWatchersCount()
 	p = nil
-	p.GetAfter()
+	p.GetWatchersCount()
 }
 
-func TestPullRequestEvent_GetAssignee(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetAssignee()
-	p = nil
-	p.GetAssignee()
+func TestRateLimits_GetActionsRunnerRegistration(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetActionsRunnerRegistration()
+	r = nil
+	r.GetActionsRunnerRegistration()
 }
 
-func TestPullRequestEvent_GetBefore(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestEvent{Before: &zeroValue}
-	p.GetBefore()
-	p = &PullRequestEvent{}
-	p.GetBefore()
-	p = nil
-	p.GetBefore()
+func TestRateLimits_GetCodeScanningUpload(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetCodeScanningUpload()
+	r = nil
+	r.GetCodeScanningUpload()
 }
 
-func TestPullRequestEvent_GetChanges(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetChanges()
-	p = nil
-	p.GetChanges()
+func TestRateLimits_GetCore(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetCore()
+	r = nil
+	r.GetCore()
 }
 
-func TestPullRequestEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRateLimits_GetGraphQL(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetGraphQL()
+	r = nil
+	r.GetGraphQL()
 }
 
-func TestPullRequestEvent_GetLabel(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetLabel()
-	p = nil
-	p.GetLabel()
+func TestRateLimits_GetIntegrationManifest(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetIntegrationManifest()
+	r = nil
+	r.GetIntegrationManifest()
 }
 
-func TestPullRequestEvent_GetNumber(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestEvent{Number: &zeroValue}
-	p.GetNumber()
-	p = &PullRequestEvent{}
-	p.GetNumber()
-	p = nil
-	p.GetNumber()
+func TestRateLimits_GetSCIM(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSCIM()
+	r = nil
+	r.GetSCIM()
 }
 
-func TestPullRequestEvent_GetOrganization(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestRateLimits_GetSearch(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSearch()
+	r = nil
+	r.GetSearch()
 }
 
-func TestPullRequestEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRateLimits_GetSourceImport(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSourceImport()
+	r = nil
+	r.GetSourceImport()
 }
 
-func TestPullRequestEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestReaction_GetContent(tt *testing.T) {
+	var zeroValue string
+	r := &Reaction{Content: &zeroValue}
+	r.GetContent()
+	r = &Reaction{}
+	r.GetContent()
+	r = nil
+	r.GetContent()
 }
 
-func TestPullRequestEvent_GetRequestedReviewer(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRequestedReviewer()
-	p = nil
-	p.GetRequestedReviewer()
+func TestReaction_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Reaction{ID: &zeroValue}
+	r.GetID()
+	r = &Reaction{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPullRequestEvent_GetRequestedTeam(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRequestedTeam()
-	p = nil
-	p.GetRequestedTeam()
+func TestReaction_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &Reaction{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Reaction{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequestEvent_GetSender(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestReaction_GetUser(tt *testing.T) {
+	r := &Reaction{}
+	r.GetUser()
+	r = nil
+	r.GetUser()
 }
 
-func TestPullRequestLinks_GetDiffURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestLinks{DiffURL: &zeroValue}
-	p.GetDiffURL()
-	p = &PullRequestLinks{}
-	p.GetDiffURL()
-	p = nil
-	p.GetDiffURL()
+func TestReactions_GetConfused(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Confused: &zeroValue}
+	r.GetConfused()
+	r = &Reactions{}
+	r.GetConfused()
+	r = nil
+	r.GetConfused()
 }
 
-func TestPullRequestLinks_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestLinks{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequestLinks{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+func TestReactions_GetEyes(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Eyes: &zeroValue}
+	r.GetEyes()
+	r = &Reactions{}
+	r.GetEyes()
+	r = nil
+	r.GetEyes()
 }
 
-func TestPullRequestLinks_GetPatchURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestLinks{PatchURL: &zeroValue}
-	p.GetPatchURL()
-	p = &PullRequestLinks{}
-	p.GetPatchURL()
-	p = nil
-	p.GetPatchURL()
+func TestReactions_GetHeart(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Heart: &zeroValue}
+	r.GetHeart()
+	r = &Reactions{}
+	r.GetHeart()
+	r = nil
+	r.GetHeart()
 }
 
-func TestPullRequestLinks_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestLinks{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestLinks{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+func TestReactions_GetHooray(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Hooray: &zeroValue}
+	r.GetHooray()
+	r = &Reactions{}
+	r.GetHooray()
+	r = nil
+	r.GetHooray()
 }
 
-func TestPullRequestMergeResult_GetMerged(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequestMergeResult{Merged: &zeroValue}
-	p.GetMerged()
-	p = &PullRequestMergeResult{}
-	p.GetMerged()
-	p = nil
-	p.GetMerged()
+func TestReactions_GetLaugh(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Laugh: &zeroValue}
+	r.GetLaugh()
+	r = &Reactions{}
+	r.GetLaugh()
+	r = nil
+	r.GetLaugh()
 }
 
-func TestPullRequestMergeResult_GetMessage(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestMergeResult{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestMergeResult{}
-	p.GetMessage()
-	p = nil
-	p.GetMessage()
+func TestReactions_GetMinusOne(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{MinusOne: &zeroValue}
+	r.GetMinusOne()
+	r = &Reactions{}
+	r.GetMinusOne()
+	r = nil
+	r.GetMinusOne()
 }
 
-func TestPullRequestMergeResult_GetSHA(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestMergeResult{SHA: &zeroValue}
-	p.GetSHA()
-	p = &PullRequestMergeResult{}
-	p.GetSHA()
-	p = nil
-	p.GetSHA()
+func TestReactions_GetPlusOne(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{PlusOne: &zeroValue}
+	r.GetPlusOne()
+	r = &Reactions{}
+	r.GetPlusOne()
+	r = nil
+	r.GetPlusOne()
 }
 
-func TestPullRequestReview_GetAuthorAssociation(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequestReview{}
-	p.GetAuthorAssociation()
-	p = nil
-	p.GetAuthorAssociation()
+func TestReactions_GetRocket(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Rocket: &zeroValue}
+	r.GetRocket()
+	r = &Reactions{}
+	r.GetRocket()
+	r = nil
+	r.GetRocket()
 }
 
-func TestPullRequestReview_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestReview{}
-	p.GetBody()
-	p = nil
-	p.GetBody()
+func TestReactions_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{TotalCount: &zeroValue}
+	r.GetTotalCount()
+	r = &Reactions{}
+	r.GetTotalCount()
+	r = nil
+	r.GetTotalCount()
 }
 
-func TestPullRequestReview_GetCommitID(tt *testing.T) {
+func TestReactions_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestReview{}
-	p.GetCommitID()
-	p = nil
-	p.GetCommitID()
+	r := &Reactions{URL: &zeroValue}
+	r.GetURL()
+	r = &Reactions{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestReview_GetHTMLURL(tt *testing.T) {
+func TestReference_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequestReview{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &Reference{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Reference{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequestReview_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestReview{ID: &zeroValue}
-	p.GetID()
-	p = &PullRequestReview{}
-	p.GetID()
-	p = nil
-	p.GetID()
+func TestReference_GetObject(tt *testing.T) {
+	r := &Reference{}
+	r.GetObject()
+	r = nil
+	r.GetObject()
 }
 
-func TestPullRequestReview_GetNodeID(tt *testing.T) {
+func TestReference_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequestReview{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &Reference{Ref: &zeroValue}
+	r.GetRef()
+	r = &Reference{}
+	r.GetRef()
+	r = nil
+	r.GetRef()
 }
 
-func TestPullRequestReview_GetPullRequestURL(tt *testing.T) {
+func TestReference_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{PullRequestURL: &zeroValue}
-	p.GetPullRequestURL()
-	p = &PullRequestReview{}
-	p.GetPullRequestURL()
-	p = nil
-	p.GetPullRequestURL()
+	r := &Reference{URL: &zeroValue}
+	r.GetURL()
+	r = &Reference{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestReview_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{State: &zeroValue}
-	p.GetState()
-	p = &PullRequestReview{}
-	p.GetState()
-	p = nil
-	p.GetState()
+func TestRefStatusContext_GetCheckRun(tt *testing.T) {
+	r := &RefStatusContext{}
+	r.GetCheckRun()
+	r = nil
+	r.GetCheckRun()
 }
 
-func TestPullRequestReview_GetSubmittedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PullRequestReview{SubmittedAt: &zeroValue}
-	p.GetSubmittedAt()
-	p = &PullRequestReview{}
-	p.GetSubmittedAt()
-	p = nil
-	p.GetSubmittedAt()
+func TestRefStatusContext_GetStatus(tt *testing.T) {
+	r := &RefStatusContext{}
+	r.GetStatus()
+	r = nil
+	r.GetStatus()
 }
 
-func TestPullRequestReview_GetUser(tt *testing.T) {
-	p := &PullRequestReview{}
-	p.GetUser()
-	p = nil
-	p.GetUser()
+func TestRegistrationToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RegistrationToken{ExpiresAt: &zeroValue}
+	r.GetExpiresAt()
+	r = &RegistrationToken{}
+	r.GetExpiresAt()
+	r = nil
+	r.GetExpiresAt()
 }
 
-func TestPullRequestReviewCommentEvent_GetAction(tt *testing.T) {
+func TestRegistrationToken_GetToken(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewCommentEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestReviewCommentEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &RegistrationToken{Token: &zeroValue}
+	r.GetToken()
+	r = &RegistrationToken{}
+	r.GetToken()
+	r = nil
+	r.GetToken()
 }
 
-func TestPullRequestReviewCommentEvent_GetChanges(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetChanges()
-	p = nil
-	p.GetChanges()
+func TestRegistryPackageEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	r := &RegistryPackageEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RegistryPackageEvent{}
+	r.GetAction()
+	r = nil
+	r.GetAction()
 }
 
-func TestPullRequestReviewCommentEvent_GetComment(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetComment()
-	p = nil
-	p.GetComment()
+func TestRegistryPackageEvent_GetEnterprise(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetEnterprise()
+	r = nil
+	r.GetEnterprise()
 }
 
-func TestPullRequestReviewCommentEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRegistryPackageEvent_GetInstallation(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetInstallation()
+	r = nil
+	r.GetInstallation()
 }
 
-func TestPullRequestReviewCommentEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRegistryPackageEvent_GetOrg(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetOrg()
+	r = nil
+	r.GetOrg()
 }
 
-func TestPullRequestReviewCommentEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRegistryPackageEvent_GetRegistryPackage(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetRegistryPackage()
+	r = nil
+	r.GetRegistryPackage()
 }
 
-func TestPullRequestReviewCommentEvent_GetSender(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRegistryPackageEvent_GetRepo(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetRepo()
+	r = nil
+	r.GetRepo()
 }
 
-func TestPullRequestReviewDismissalRequest_GetMessage(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReviewDismissalRequest{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestReviewDismissalRequest{}
-	p.GetMessage()
-	p = nil
-	p.GetMessage()
+func TestRegistryPackageEvent_GetSender(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetSender()
+	r = nil
+	r.GetSender()
 }
 
-func TestPullRequestReviewEvent_GetAction(tt *testing.T) {
+func TestReleaseAsset_GetBrowserDownloadURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestReviewEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
-}
-
-func TestPullRequestReviewEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+	r := &ReleaseAsset{BrowserDownloadURL: &zeroValue}
+	r.GetBrowserDownloadURL()
+	r = &ReleaseAsset{}
+	r.GetBrowserDownloadURL()
+	r = nil
+	r.GetBrowserDownloadURL()
 }
 
-func TestPullRequestReviewEvent_GetOrganization(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestReleaseAsset_GetContentType(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseAsset{ContentType: &zeroValue}
+	r.GetContentType()
+	r = &ReleaseAsset{}
+	r.GetContentType()
+	r = nil
+	r.GetContentType()
 }
 
-func TestPullRequestReviewEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestReleaseAsset_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &ReleaseAsset{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &ReleaseAsset{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
 }
 
-func TestPullRequestReviewEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestReleaseAsset_GetDownloadCount(tt *testing.T) {
+	var zeroValue int
+	r := &ReleaseAsset{DownloadCount: &zeroValue}
+	r.GetDownloadCount()
+	r = &ReleaseAsset{}
+	r.GetDownloadCount()
+	r = nil
+	r.GetDownloadCount()
 }
 
-func TestPullRequestReviewEvent_GetReview(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetReview()
-	p = nil
-	p.GetReview()
+func TestReleaseAsset_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &ReleaseAsset{ID: &zeroValue}
+	r.GetID()
+	r = &ReleaseAsset{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPullRequestReviewEvent_GetSender(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestReleaseAsset_GetLabel(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseAsset{Label: &zeroValue}
+	r.GetLabel()
+	r = &ReleaseAsset{}
+	r.GetLabel()
+	r = nil
+	r.GetLabel()
 }
 
-func TestPullRequestReviewRequest_GetBody(tt *testing.T) {
+func TestReleaseAsset_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewRequest{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestReviewRequest{}
-	p.GetBody()
-	p = nil
-	p.GetBody()
+	r := &ReleaseAsset{Name: &zeroValue}
+	r.GetName()
+	r = &ReleaseAsset{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullRequestReviewRequest_GetCommitID(tt *testing.T) {
+func TestReleaseAsset_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewRequest{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestReviewRequest{}
-	p.GetCommitID()
-	p = nil
-	p.GetCommitID()
+	r := &ReleaseAsset{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &ReleaseAsset{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequestReviewRequest_GetEvent(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReviewRequest{Event: &zeroValue}
-	p.GetEvent()
-	p = &PullRequestReviewRequest{}
-	p.GetEvent()
-	p = nil
-	p.GetEvent()
+func TestReleaseAsset_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &ReleaseAsset{Size: &zeroValue}
+	r.GetSize()
+	r = &ReleaseAsset{}
+	r.GetSize()
+	r = nil
+	r.GetSize()
 }
 
-func TestPullRequestReviewRequest_GetNodeID(tt *testing.T) {
+func TestReleaseAsset_GetState(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewRequest{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequestReviewRequest{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &ReleaseAsset{State: &zeroValue}
+	r.GetState()
+	r = &ReleaseAsset{}
+	r.GetState()
+	r = nil
+	r.GetState()
 }
 
-func TestPullRequestReviewsEnforcement_GetBypassPullRequestAllowances(tt *testing.T) {
-	p := &PullRequestReviewsEnforcement{}
-	p.GetBypassPullRequestAllowances()
-	p = nil
-	p.GetBypassPullRequestAllowances()
+func TestReleaseAsset_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &ReleaseAsset{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &ReleaseAsset{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
 }
 
-func TestPullRequestReviewsEnforcement_GetDismissalRestrictions(tt *testing.T) {
-	p := &PullRequestReviewsEnforcement{}
-	p.GetDismissalRestrictions()
-	p = nil
-	p.GetDismissalRestrictions()
+func TestReleaseAsset_GetUploader(tt *testing.T) {
+	r := &ReleaseAsset{}
+	r.GetUploader()
+	r = nil
+	r.GetUploader()
 }
 
-func TestPullRequestReviewsEnforcementLevelChanges_GetFrom(tt *testing.T) {
+func TestReleaseAsset_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewsEnforcementLevelChanges{From: &zeroValue}
-	p.GetFrom()
-	p = &PullRequestReviewsEnforcementLevelChanges{}
-	p.GetFrom()
-	p = nil
-	p.GetFrom()
+	r := &ReleaseAsset{URL: &zeroValue}
+	r.GetURL()
+	r = &ReleaseAsset{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestReviewsEnforcementRequest_GetBypassPullRequestAllowancesRequest(tt *testing.T) {
-	p := &PullRequestReviewsEnforcementRequest{}
-	p.GetBypassPullRequestAllowancesRequest()
-	p = nil
-	p.GetBypassPullRequestAllowancesRequest()
+func TestReleaseEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &ReleaseEvent{}
+	r.GetAction()
+	r = nil
+	r.GetAction()
 }
 
-func TestPullRequestReviewsEnforcementRequest_GetDismissalRestrictionsRequest(tt *testing.T) {
-	p := &PullRequestReviewsEnforcementRequest{}
-	p.GetDismissalRestrictionsRequest()
-	p = nil
-	p.GetDismissalRestrictionsRequest()
+func TestReleaseEvent_GetInstallation(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetInstallation()
+	r = nil
+	r.GetInstallation()
 }
 
-func TestPullRequestReviewsEnforcementRequest_GetRequireLastPushApproval(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequestReviewsEnforcementRequest{RequireLastPushApproval: &zeroValue}
-	p.GetRequireLastPushApproval()
-	p = &PullRequestReviewsEnforcementRequest{}
-	p.GetRequireLastPushApproval()
-	p = nil
-	p.GetRequireLastPushApproval()
+func TestReleaseEvent_GetOrg(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetOrg()
+	r = nil
+	r.GetOrg()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetBypassPullRequestAllowancesRequest(tt *testing.T) {
-	p := &PullRequestReviewsEnforcementUpdate{}
-	p.GetBypassPullRequestAllowancesRequest()
-	p = nil
-	p.GetBypassPullRequestAllowancesRequest()
+func TestReleaseEvent_GetRelease(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetRelease()
+	r = nil
+	r.GetRelease()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetDismissalRestrictionsRequest(tt *testing.T) {
-	p := &PullRequestReviewsEnforcementUpdate{}
-	p.GetDismissalRestrictionsRequest()
-	p = nil
-	p.GetDismissalRestrictionsRequest()
+func TestReleaseEvent_GetRepo(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetRepo()
+	r = nil
+	r.GetRepo()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetDismissStaleReviews(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequestReviewsEnforcementUpdate{DismissStaleReviews: &zeroValue}
-	p.GetDismissStaleReviews()
-	p = &PullRequestReviewsEnforcementUpdate{}
-	p.GetDismissStaleReviews()
-	p = nil
-	p.GetDismissStaleReviews()
+func TestReleaseEvent_GetSender(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetSender()
+	r = nil
+	r.GetSender()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetRequireCodeOwnerReviews(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequestReviewsEnforcementUpdate{RequireCodeOwnerReviews: &zeroValue}
-	p.GetRequireCodeOwnerReviews()
-	p = &PullRequestReviewsEnforcementUpdate{}
-	p.GetRequireCodeOwnerReviews()
-	p = nil
-	p.GetRequireCodeOwnerReviews()
+func TestRemoveToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RemoveToken{ExpiresAt: &zeroValue}
+	r.GetExpiresAt()
+	r = &RemoveToken{}
+	r.GetExpiresAt()
+	r = nil
+	r.GetExpiresAt()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetRequireLastPushApproval(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequestReviewsEnforcementUpdate{RequireLastPushApproval: &zeroValue}
-	p.GetRequireLastPushApproval()
-	p = &PullRequestReviewsEnforcementUpdate{}
-	p.GetRequireLastPushApproval()
-	p = nil
-	p.GetRequireLastPushApproval()
+func TestRemoveToken_GetToken(tt *testing.T) {
+	var zeroValue string
+	r := &RemoveToken{Token: &zeroValue}
+	r.GetToken()
+	r = &RemoveToken{}
+	r.GetToken()
+	r = nil
+	r.GetToken()
+}
+
+func TestRename_GetFrom(tt *testing.T) {
+	var zeroValue string
+	r := &Rename{From: &zeroValue}
+	r.GetFrom()
+	r = &Rename{}
+	r.GetFrom()
+	r = nil
+	r.GetFrom()
 }
 
-func TestPullRequestReviewThreadEvent_GetAction(tt *testing.T) {
+func TestRename_GetTo(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewThreadEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestReviewThreadEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &Rename{To: &zeroValue}
+	r.GetTo()
+	r = &Rename{}
+	r.GetTo()
+	r = nil
+	r.GetTo()
 }
 
-func TestPullRequestReviewThreadEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestReviewThreadEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRenameOrgResponse_GetMessage(tt *testing.T) {
+	var zeroValue string
+	r := &RenameOrgResponse{Message: &zeroValue}
+	r.GetMessage()
+	r = &RenameOrgResponse{}
+	r.GetMessage()
+	r = nil
+	r.GetMessage()
 }
 
-func TestPullRequestReviewThreadEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestReviewThreadEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRenameOrgResponse_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RenameOrgResponse{URL: &zeroValue}
+	r.GetURL()
+	r = &RenameOrgResponse{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestReviewThreadEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestReviewThreadEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepoDependencies_GetCopyrightText(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{CopyrightText: &zeroValue}
+	r.GetCopyrightText()
+	r = &RepoDependencies{}
+	r.GetCopyrightText()
+	r = nil
+	r.GetCopyrightText()
 }
 
-func TestPullRequestReviewThreadEvent_GetSender(tt *testing.T) {
-	p := &PullRequestReviewThreadEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepoDependencies_GetDownloadLocation(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{DownloadLocation: &zeroValue}
+	r.GetDownloadLocation()
+	r = &RepoDependencies{}
+	r.GetDownloadLocation()
+	r = nil
+	r.GetDownloadLocation()
 }
 
-func TestPullRequestReviewThreadEvent_GetThread(tt *testing.T) {
-	p := &PullRequestReviewThreadEvent{}
-	p.GetThread()
-	p = nil
-	p.GetThread()
+func TestRepoDependencies_GetFilesAnalyzed(tt *testing.T) {
+	var zeroValue bool
+	r := &RepoDependencies{FilesAnalyzed: &zeroValue}
+	r.GetFilesAnalyzed()
+	r = &RepoDependencies{}
+	r.GetFilesAnalyzed()
+	r = nil
+	r.GetFilesAnalyzed()
 }
 
-func TestPullRequestTargetEvent_GetAction(tt *testing.T) {
+func TestRepoDependencies_GetLicenseConcluded(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestTargetEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestTargetEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &RepoDependencies{LicenseConcluded: &zeroValue}
+	r.GetLicenseConcluded()
+	r = &RepoDependencies{}
+	r.GetLicenseConcluded()
+	r = nil
+	r.GetLicenseConcluded()
 }
 
-func TestPullRequestTargetEvent_GetAfter(tt *testing.T) {
+func TestRepoDependencies_GetLicenseDeclared(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestTargetEvent{After: &zeroValue}
-	p.GetAfter()
-	p = &PullRequestTargetEvent{}
-	p.GetAfter()
-	p = nil
-	p.GetAfter()
+	r := &RepoDependencies{LicenseDeclared: &zeroValue}
+	r.GetLicenseDeclared()
+	r = &RepoDependencies{}
+	r.GetLicenseDeclared()
+	r = nil
+	r.GetLicenseDeclared()
 }
 
-func TestPullRequestTargetEvent_GetAssignee(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetAssignee()
-	p = nil
-	p.GetAssignee()
+func TestRepoDependencies_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{Name: &zeroValue}
+	r.GetName()
+	r = &RepoDependencies{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullRequestTargetEvent_GetBefore(tt *testing.T) {
+func TestRepoDependencies_GetSPDXID(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestTargetEvent{Before: &zeroValue}
-	p.GetBefore()
-	p = &PullRequestTargetEvent{}
-	p.GetBefore()
-	p = nil
-	p.GetBefore()
+	r := &RepoDependencies{SPDXID: &zeroValue}
+	r.GetSPDXID()
+	r = &RepoDependencies{}
+	r.GetSPDXID()
+	r = nil
+	r.GetSPDXID()
 }
 
-func TestPullRequestTargetEvent_GetChanges(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetChanges()
-	p = nil
-	p.GetChanges()
+func TestRepoDependencies_GetSupplier(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{Supplier: &zeroValue}
+	r.GetSupplier()
+	r = &RepoDependencies{}
+	r.GetSupplier()
+	r = nil
+	r.GetSupplier()
 }
 
-func TestPullRequestTargetEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRepoDependencies_GetVersionInfo(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{VersionInfo: &zeroValue}
+	r.GetVersionInfo()
+	r = &RepoDependencies{}
+	r.GetVersionInfo()
+	r = nil
+	r.GetVersionInfo()
 }
 
-func TestPullRequestTargetEvent_GetLabel(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetLabel()
-	p = nil
-	p.GetLabel()
+func TestRepoFineGrainedPermission_GetDescription(tt *testing.T) {
+	var zeroValue string
+	r := &RepoFineGrainedPermission{Description: &zeroValue}
+	r.GetDescription()
+	r = &RepoFineGrainedPermission{}
+	r.GetDescription()
+	r = nil
+	r.GetDescription()
 }
 
-func TestPullRequestTargetEvent_GetNumber(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestTargetEvent{Number: &zeroValue}
-	p.GetNumber()
-	p = &PullRequestTargetEvent{}
-	p.GetNumber()
-	p = nil
-	p.GetNumber()
+func TestRepoFineGrainedPermission_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepoFineGrainedPermission{Name: &zeroValue}
+	r.GetName()
+	r = &RepoFineGrainedPermission{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullRequestTargetEvent_GetOrganization(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestRepoMergeUpstreamRequest_GetBranch(tt *testing.T) {
+	var zeroValue string
+	r := &RepoMergeUpstreamRequest{Branch: &zeroValue}
+	r.GetBranch()
+	r = &RepoMergeUpstreamRequest{}
+	r.GetBranch()
+	r = nil
+	r.GetBranch()
 }
 
-func TestPullRequestTargetEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRepoMergeUpstreamResult_GetBaseBranch(tt *testing.T) {
+	var zeroValue string
+	r := &RepoMergeUpstreamResult{BaseBranch: &zeroValue}
+	r.GetBaseBranch()
+	r = &RepoMergeUpstreamResult{}
+	r.GetBaseBranch()
+	r = nil
+	r.GetBaseBranch()
 }
 
-func TestPullRequestTargetEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepoMergeUpstreamResult_GetMergeType(tt *testing.T) {
+	var zeroValue string
+	r := &RepoMergeUpstreamResult{MergeType: &zeroValue}
+	r.GetMergeType()
+	r = &RepoMergeUpstreamResult{}
+	r.GetMergeType()
+	r = nil
+	r.GetMergeType()
 }
 
-func TestPullRequestTargetEvent_GetRequestedReviewer(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetRequestedReviewer()
-	p = nil
-	p.GetRequestedReviewer()
+func TestRepoMergeUpstreamResult_GetMessage(tt *testing.T) {
+	var zeroValue string
+	r := &RepoMergeUpstreamResult{Message: &zeroValue}
+	r.GetMessage()
+	r = &RepoMergeUpstreamResult{}
+	r.GetMessage()
+	r = nil
+	r.GetMessage()
 }
 
-func TestPullRequestTargetEvent_GetRequestedTeam(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetRequestedTeam()
-	p = nil
-	p.GetRequestedTeam()
+func TestRepoName_GetFrom(tt *testing.T) {
+	var zeroValue string
+	r := &RepoName{From: &zeroValue}
+	r.GetFrom()
+	r = &RepoName{}
+	r.GetFrom()
+	r = nil
+	r.GetFrom()
 }
 
-func TestPullRequestTargetEvent_GetSender(tt *testing.T) {
-	p := &PullRequestTargetEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepoRequiredWorkflow_GetBadgeURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepoRequiredWorkflow{BadgeURL: &zeroValue}
+	r.GetBadgeURL()
+	r = &RepoRequiredWorkflow{}
+	r.GetBadgeURL()
+	r = nil
+	r.GetBadgeURL()
 }
 
-func TestPullRequestThread_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestThread{ID: &zeroValue}
-	p.GetID()
-	p = &PullRequestThread{}
-	p.GetID()
-	p = nil
-	p.GetID()
+func TestRepoRequiredWorkflow_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepoRequiredWorkflow{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepoRequiredWorkflow{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
 }
 
-func TestPullRequestThread_GetNodeID(tt *testing.T) {
+func TestRepoRequiredWorkflow_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestThread{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequestThread{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &RepoRequiredWorkflow{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepoRequiredWorkflow{}
+	r.GetHTMLURL()
+	r = nil
+	r.GetHTMLURL()
 }
 
-func TestPullStats_GetMergablePulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{MergablePulls: &zeroValue}
-	p.GetMergablePulls()
-	p = &PullStats{}
-	p.GetMergablePulls()
-	p = nil
-	p.GetMergablePulls()
+func TestRepoRequiredWorkflow_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepoRequiredWorkflow{ID: &zeroValue}
+	r.GetID()
+	r = &RepoRequiredWorkflow{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPullStats_GetMergedPulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{MergedPulls: &zeroValue}
-	p.GetMergedPulls()
-	p = &PullStats{}
-	p.GetMergedPulls()
-	p = nil
-	p.GetMergedPulls()
+func TestRepoRequiredWorkflow_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepoRequiredWorkflow{Name: &zeroValue}
+	r.GetName()
+	r = &RepoRequiredWorkflow{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullStats_GetTotalPulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{TotalPulls: &zeroValue}
-	p.GetTotalPulls()
-	p = &PullStats{}
-	p.GetTotalPulls()
-	p = nil
-	p.GetTotalPulls()
+func TestRepoRequiredWorkflow_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepoRequiredWorkflow{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepoRequiredWorkflow{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullStats_GetUnmergablePulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{UnmergablePulls: &zeroValue}
-	p.GetUnmergablePulls()
-	p = &PullStats{}
-	p.GetUnmergablePulls()
-	p = nil
-	p.GetUnmergablePulls()
+func TestRepoRequiredWorkflow_GetPath(tt *testing.T) {
+	var zeroValue string
+	r := &RepoRequiredWorkflow{Path: &zeroValue}
+	r.GetPath()
+	r = &RepoRequiredWorkflow{}
+	r.GetPath()
+	r = nil
+	r.GetPath()
 }
 
-func TestPunchCard_GetCommits(tt *testing.T) {
-	var zeroValue int
-	p := &PunchCard{Commits: &zeroValue}
-	p.GetCommits()
-	p = &PunchCard{}
-	p.GetCommits()
-	p = nil
-	p.GetCommits()
+func TestRepoRequiredWorkflow_GetSourceRepository(tt *testing.T) {
+	r := &RepoRequiredWorkflow{}
+	r.GetSourceRepository()
+	r = nil
+	r.GetSourceRepository()
 }
 
-func TestPunchCard_GetDay(tt *testing.T) {
-	var zeroValue int
-	p := &PunchCard{Day: &zeroValue}
-	p.GetDay()
-	p = &PunchCard{}
-	p.GetDay()
-	p = nil
-	p.GetDay()
+func TestRepoRequiredWorkflow_GetState(tt *testing.T) {
+	var zeroValue string
+	r := &RepoRequiredWorkflow{State: &zeroValue}
+	r.GetState()
+	r = &RepoRequiredWorkflow{}
+	r.GetState()
+	r = nil
+	r.GetState()
 }
 
-func TestPunchCard_GetHour(tt *testing.T) {
-	var zeroValue int
-	p := &PunchCard{Hour: &zeroValue}
-	p.GetHour()
-	p = &PunchCard{}
-	p.GetHour()
-	p = nil
-	p.GetHour()
+func TestRepoRequiredWorkflow_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepoRequiredWorkflow{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &RepoRequiredWorkflow{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
 }
 
-func TestPushEvent_GetAction(tt *testing.T) {
+func TestRepoRequiredWorkflow_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PushEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &RepoRequiredWorkflow{URL: &zeroValue}
+	r.GetURL()
+	r = &RepoRequiredWorkflow{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPushEvent_GetAfter(tt *testing.T) {
-	var zeroValue string
-	p := &PushEvent{After: &zeroValue}
-	p.GetAfter()
-	p = &PushEvent{}
-	p.GetAfter()
-	p = nil
-	p.GetAfter()
+func TestRepoRequiredWorkflows_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	r := &RepoRequiredWorkflows{TotalCount: &zeroValue}
+	r.GetTotalCount()
+	r = &RepoRequiredWorkflows{}
+	r.GetTotalCount()
+	r = nil
+	r.GetTotalCount()
 }
 
-func TestPushEvent_GetBaseRef(tt *testing.T) {
-	var zeroValue string
-	p := &PushEvent{BaseRef: &zeroValue}
-	p.GetBaseRef()
-	p = &PushEvent{}
-	p.GetBaseRef()
-	p = nil
-	p.GetBaseRef()
+func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
+	r.GetIncompleteResults()
+	r = &RepositoriesSearchResult{}
+	r.GetIncompleteResults()
+	r = nil
+	r.GetIncompleteResults()
 }
 
-func TestPushEvent_GetBefore(tt *testing.T) {
-	var zeroValue string
-	p := &PushEvent{Before: &zeroValue}
-	p.GetBefore()
-	p = &PushEvent{}
-	p.GetBefore()
-	p = nil
-	p.GetBefore()
+func TestRepositoriesSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoriesSearchResult{Total: &zeroValue}
+	r.GetTotal()
+	r = &RepositoriesSearchResult{}
+	r.GetTotal()
+	r = nil
+	r.GetTotal()
 }
 
-func TestPushEvent_GetCompare(tt *testing.T) {
-	var zeroValue string
-	p := &PushEvent{Compare: &zeroValue}
-	p.GetCompare()
-	p = &PushEvent{}
-	p.GetCompare()
-	p = nil
-	p.GetCompare()
+func TestRepository_GetAllowAutoMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowAutoMerge: &zeroValue}
+	r.GetAllowAutoMerge()
+	r = &Repository{}
+	r.GetAllowAutoMerge()
+	r = nil
+	r.GetAllowAutoMerge()
 }
 
-func TestPushEvent_GetCreated(tt *testing.T) {
+func TestRepository_GetAllowForking(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEvent{Created: &zeroValue}
-	p.GetCreated()
-	p = &PushEvent{}
-	p.GetCreated()
-	p = nil
-	p.GetCreated()
+	r := &Repository{AllowForking: &zeroValue}
+	r.GetAllowForking()
+	r = &Repository{}
+	r.GetAllowForking()
+	r = nil
+	r.GetAllowForking()
 }
 
-func TestPushEvent_GetDeleted(tt *testing.T) {
+func TestRepository_GetAllowMergeCommit(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEvent{Deleted: &zeroValue}
-	p.GetDeleted()
-	p = &PushEvent{}
-	p.GetDeleted()
-	p = nil
-	p.GetDeleted()
+	r := &Repository{AllowMergeCommit: &zeroValue}
+	r.GetAllowMergeCommit()
+	r = &Repository{}
+	r.GetAllowMergeCommit()
+	r = nil
+	r.GetAllowMergeCommit()
 }
 
-func TestPushEvent_GetDistinctSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEvent{DistinctSize: &zeroValue}
-	p.GetDistinctSize()
-	p = &PushEvent{}
-	p.GetDistinctSize()
-	p = nil
-	p.GetDistinctSize()
+func TestRepository_GetAllowRebaseMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowRebaseMerge: &zeroValue}
+	r.GetAllowRebaseMerge()
+	r = &Repository{}
+	r.GetAllowRebaseMerge()
+	r = nil
+	r.GetAllowRebaseMerge()
 }
 
-func TestPushEvent_GetForced(tt *testing.T) {
+func TestRepository_GetAllowSquashMerge(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEvent{Forced: &zeroValue}
-	p.GetForced()
-	p = &PushEvent{}
-	p.GetForced()
-	p = nil
-	p.GetForced()
+	r := &Repository{AllowSquashMerge: &zeroValue}
+	r.GetAllowSquashMerge()
+	r = &Repository{}
+	r.GetAllowSquashMerge()
+	r = nil
+	r.GetAllowSquashMerge()
 }
 
-func TestPushEvent_GetHead(tt *testing.T) {
-	var zeroValue string
-	p := &PushEvent{Head: &zeroValue}
-	p.GetHead()
-	p = &PushEvent{}
-	p.GetHead()
-	p = nil
-	p.GetHead()
+func TestRepository_GetAllowUpdateBranch(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowUpdateBranch: &zeroValue}
+	r.GetAllowUpdateBranch()
+	r = &Repository{}
+	r.GetAllowUpdateBranch()
+	r = nil
+	r.GetAllowUpdateBranch()
 }
 
-func TestPushEvent_GetHeadCommit(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetHeadCommit()
-	p = nil
-	p.GetHeadCommit()
+func TestRepository_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Archived: &zeroValue}
+	r.GetArchived()
+	r = &Repository{}
+	r.GetArchived()
+	r = nil
+	r.GetArchived()
 }
 
-func TestPushEvent_GetInstallation(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRepository_GetArchiveURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{ArchiveURL: &zeroValue}
+	r.GetArchiveURL()
+	r = &Repository{}
+	r.GetArchiveURL()
+	r = nil
+	r.GetArchiveURL()
 }
 
-func TestPushEvent_GetOrganization(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestRepository_GetAssigneesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{AssigneesURL: &zeroValue}
+	r.GetAssigneesURL()
+	r = &Repository{}
+	r.GetAssigneesURL()
+	r = nil
+	r.GetAssigneesURL()
 }
 
-func TestPushEvent_GetPusher(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetPusher()
-	p = nil
-	p.GetPusher()
+func TestRepository_GetAutoInit(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AutoInit: &zeroValue}
+	r.GetAutoInit()
+	r = &Repository{}
+	r.GetAutoInit()
+	r = nil
+	r.GetAutoInit()
 }
 
-func TestPushEvent_GetPushID(tt *testing.T) {
-	var zeroValue int64
-	p := &PushEvent{PushID: &zeroValue}
-	p.GetPushID()
-	p = &PushEvent{}
-	p.GetPushID()
-	p = nil
-	p.GetPushID()
+func TestRepository_GetBlobsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{BlobsURL: &zeroValue}
+	r.GetBlobsURL()
+	r = &Repository{}
+	r.GetBlobsURL()
+	r = nil
+	r.GetBlobsURL()
 }
 
-func TestPushEvent_GetRef(tt *testing.T) {
+func TestRepository_GetBranchesURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Ref: &zeroValue}
-	p.GetRef()
-	p = &PushEvent{}
-	p.GetRef()
-	p = nil
-	p.GetRef()
+	r := &Repository{BranchesURL: &zeroValue}
+	r.GetBranchesURL()
+	r = &Repository{}
+	r.GetBranchesURL()
+	r = nil
+	r.GetBranchesURL()
 }
 
-func TestPushEvent_GetRepo(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepository_GetCloneURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CloneURL: &zeroValue}
+	r.GetCloneURL()
+	r = &Repository{}
+	r.GetCloneURL()
+	r = nil
+	r.GetCloneURL()
 }
 
-func TestPushEvent_GetSender(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepository_GetCodeOfConduct(tt *testing.T) {
+	r := &Repository{}
+	r.GetCodeOfConduct()
+	r = nil
+	r.GetCodeOfConduct()
 }
 
-func TestPushEvent_GetSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEvent{Size: &zeroValue}
-	p.GetSize()
-	p = &PushEvent{}
-	p.GetSize()
-	p = nil
-	p.GetSize()
+func TestRepository_GetCollaboratorsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CollaboratorsURL: &zeroValue}
+	r.GetCollaboratorsURL()
+	r = &Repository{}
+	r.GetCollaboratorsURL()
+	r = nil
+	r.GetCollaboratorsURL()
 }
 
-func TestPushEventRepoOwner_GetEmail(tt *testing.T) {
+func TestRepository_GetCommentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepoOwner{Email: &zeroValue}
-	p.GetEmail()
-	p = &PushEventRepoOwner{}
-	p.GetEmail()
-	p = nil
-	p.GetEmail()
+	r := &Repository{CommentsURL: &zeroValue}
+	r.GetCommentsURL()
+	r = &Repository{}
+	r.GetCommentsURL()
+	r = nil
+	r.GetCommentsURL()
 }
 
-func TestPushEventRepoOwner_GetName(tt *testing.T) {
+func TestRepository_GetCommitsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepoOwner{Name: &zeroValue}
-	p.GetName()
-	p = &PushEventRepoOwner{}
-	p.GetName()
-	p = nil
-	p.GetName()
+	r := &Repository{CommitsURL: &zeroValue}
+	r.GetCommitsURL()
+	r = &Repository{}
+	r.GetCommitsURL()
+	r = nil
+	r.GetCommitsURL()
 }
 
-func TestPushEventRepository_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Archived: &zeroValue}
-	p.GetArchived()
-	p = &PushEventRepository{}
-	p.GetArchived()
-	p = nil
-	p.GetArchived()
+func TestRepository_GetCompareURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CompareURL: &zeroValue}
+	r.GetCompareURL()
+	r = &Repository{}
+	r.GetCompareURL()
+	r = nil
+	r.GetCompareURL()
 }
 
-func TestPushEventRepository_GetArchiveURL(tt *testing.T) {
+func TestRepository_GetContentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{ArchiveURL: &zeroValue}
-	p.GetArchiveURL()
-	p = &PushEventRepository{}
-	p.GetArchiveURL()
-	p = nil
-	p.GetArchiveURL()
+	r := &Repository{ContentsURL: &zeroValue}
+	r.GetContentsURL()
+	r = &Repository{}
+	r.GetContentsURL()
+	r = nil
+	r.GetContentsURL()
 }
 
-func TestPushEventRepository_GetCloneURL(tt *testing.T) {
+func TestRepository_GetContributorsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{CloneURL: &zeroValue}
-	p.GetCloneURL()
-	p = &PushEventRepository{}
-	p.GetCloneURL()
-	p = nil
-	p.GetCloneURL()
+	r := &Repository{ContributorsURL: &zeroValue}
+	r.GetContributorsURL()
+	r = &Repository{}
+	r.GetContributorsURL()
+	r = nil
+	r.GetContributorsURL()
 }
 
-func TestPushEventRepository_GetCreatedAt(tt *testing.T) {
+func TestRepository_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	p := &PushEventRepository{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PushEventRepository{}
-	p.GetCreatedAt()
-	p = nil
-	p.GetCreatedAt()
+	r := &Repository{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &Repository{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
 }
 
-func TestPushEventRepository_GetDefaultBranch(tt *testing.T) {
+func TestRepository_GetDefaultBranch(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{DefaultBranch: &zeroValue}
-	p.GetDefaultBranch()
-	p = &PushEventRepository{}
-	p.GetDefaultBranch()
-	p = nil
-	p.GetDefaultBranch()
+	r := &Repository{DefaultBranch: &zeroValue}
+	r.GetDefaultBranch()
+	r = &Repository{}
+	r.GetDefaultBranch()
+	r = nil
+	r.GetDefaultBranch()
 }
 
-func TestPushEventRepository_GetDescription(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{Description: &zeroValue}
-	p.GetDescription()
-	p = &PushEventRepository{}
-	p.GetDescription()
-	p = nil
-	p.GetDescription()
+func TestRepository_GetDeleteBranchOnMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{DeleteBranchOnMerge: &zeroValue}
+	r.GetDeleteBranchOnMerge()
+	r = &Repository{}
+	r.GetDeleteBranchOnMerge()
+	r = nil
+	r.GetDeleteBranchOnMerge()
 }
 
-func TestPushEventRepository_GetDisabled(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Disabled: &zeroValue}
-	p.GetDisabled()
-	p = &PushEventRepository{}
-	p.GetDisabled()
-	p = nil
-	p.GetDisabled()
+func TestRepository_GetDeploymentsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{DeploymentsURL: &zeroValue}
+	r.GetDeploymentsURL()
+	r = &Repository{}
+	r.GetDeploymentsURL()
+	r = nil
+	r.GetDeploymentsURL()
 }
 
-func TestPushEventRepository_GetFork(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Fork: &zeroValue}
-	p.GetFork()
-	p = &PushEventRepository{}
-	p.GetFork()
-	p = nil
-	p.GetFork()
+func TestRepository_GetDescription(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Description: &zeroValue}
+	r.GetDescription()
+	r = &Repository{}
+	r.GetDescription()
+	r = nil
+	r.GetDescription()
 }
 
-func TestPushEventRepository_GetForksCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{ForksCount: &zeroValue}
-	p.GetForksCount()
-	p = &PushEventRepository{}
-	p.GetForksCount()
-	p = nil
-	p.GetForksCount()
+func TestRepository_GetDisabled(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Disabled: &zeroValue}
+	r.GetDisabled()
+	r = &Repository{}
+	r.GetDisabled()
+	r = nil
+	r.GetDisabled()
 }
 
-func TestPushEventRepository_GetFullName(tt *testing.T) {
+func TestRepository_GetDownloadsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{FullName: &zeroValue}
-	p.GetFullName()
-	p = &PushEventRepository{}
-	p.GetFullName()
-	p = nil
-	p.GetFullName()
+	r := &Repository{DownloadsURL: &zeroValue}
+	r.GetDownloadsURL()
+	r = &Repository{}
+	r.GetDownloadsURL()
+	r = nil
+	r.GetDownloadsURL()
 }
 
-func TestPushEventRepository_GetGitURL(tt *testing.T) {
+func TestRepository_GetEventsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{GitURL: &zeroValue}
-	p.GetGitURL()
-	p = &PushEventRepository{}
-	p.GetGitURL()
-	p = nil
-	p.GetGitURL()
+	r := &Repository{EventsURL: &zeroValue}
+	r.GetEventsURL()
+	r = &Repository{}
+	r.GetEventsURL()
+	r = nil
+	r.GetEventsURL()
 }
 
-func TestPushEventRepository_GetHasDownloads(tt *testing.T) {
+func TestRepository_GetFork(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEventRepository{HasDownloads: &zeroValue}
-	p.GetHasDownloads()
-	p = &PushEventRepository{}
-	p.GetHasDownloads()
-	p = nil
-	p.GetHasDownloads()
+	r := &Repository{Fork: &zeroValue}
+	r.GetFork()
+	r = &Repository{}
+	r.GetFork()
+	r = nil
+	r.GetFork()
 }
 
-func TestPushEventRepository_GetHasIssues(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{HasIssues: &zeroValue}
-	p.GetHasIssues()
-	p = &PushEventRepository{}
-	p.GetHasIssues()
-	p = nil
-	p.GetHasIssues()
+func TestRepository_GetForksCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{ForksCount: &zeroValue}
+	r.GetForksCount()
+	r = &Repository{}
+	r.GetForksCount()
+	r = nil
+	r.GetForksCount()
 }
 
-func TestPushEventRepository_GetHasPages(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{HasPages: &zeroValue}
-	p.GetHasPages()
-	p = &PushEventRepository{}
-	p.GetHasPages()
-	p = nil
-	p.GetHasPages()
+func TestRepository_GetForksURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{ForksURL: &zeroValue}
+	r.GetForksURL()
+	r = &Repository{}
+	r.GetForksURL()
+	r = nil
+	r.GetForksURL()
 }
 
-func TestPushEventRepository_GetHasWiki(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{HasWiki: &zeroValue}
-	p.GetHasWiki()
-	p = &PushEventRepository{}
-	p.GetHasWiki()
-	p = nil
-	p.GetHasWiki()
+func TestRepository_GetFullName(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{FullName: &zeroValue}
+	r.GetFullName()
+	r = &Repository{}
+	r.GetFullName()
+	r = nil
+	r.GetFullName()
 }
 
-func TestPushEventRepository_GetHomepage(tt *testing.T) {
+func TestRepository_GetGitCommitsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Homepage: &zeroValue}
-	p.GetHomepage()
-	p = &PushEventRepository{}
-	p.GetHomepage()
-	p = nil
-	p.GetHomepage()
+	r := &Repository{GitCommitsURL: &zeroValue}
+	r.GetGitCommitsURL()
+	r = &Repository{}
+	r.GetGitCommitsURL()
+	r = nil
+	r.GetGitCommitsURL()
 }
 
-func TestPushEventRepository_GetHTMLURL(tt *testing.T) {
+func TestRepository_GetGitignoreTemplate(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PushEventRepository{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &Repository{GitignoreTemplate: &zeroValue}
+	r.GetGitignoreTemplate()
+	r = &Repository{}
+	r.GetGitignoreTemplate()
+	r = nil
+	r.GetGitignoreTemplate()
 }
 
-func TestPushEventRepository_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PushEventRepository{ID: &zeroValue}
-	p.GetID()
-	p = &PushEventRepository{}
-	p.GetID()
-	p = nil
-	p.GetID()
+func TestRepository_GetGitRefsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{GitRefsURL: &zeroValue}
+	r.GetGitRefsURL()
+	r = &Repository{}
+	r.GetGitRefsURL()
+	r = nil
+	r.GetGitRefsURL()
 }
 
-func TestPushEventRepository_GetLanguage(tt *testing.T) {
+func TestRepository_GetGitTagsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Language: &zeroValue}
-	p.GetLanguage()
-	p = &PushEventRepository{}
-	p.GetLanguage()
-	p = nil
-	p.GetLanguage()
+	r := &Repository{GitTagsURL: &zeroValue}
+	r.GetGitTagsURL()
+	r = &Repository{}
+	r.GetGitTagsURL()
+	r = nil
+	r.GetGitTagsURL()
 }
 
-func TestPushEventRepository_GetMasterBranch(tt *testing.T) {
+func TestRepository_GetGitURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{MasterBranch: &zeroValue}
-	p.GetMasterBranch()
-	p = &PushEventRepository{}
-	p.GetMasterBranch()
-	p = nil
-	p.GetMasterBranch()
+	r := &Repository{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &Repository{}
+	r.GetGitURL()
+	r = nil
+	r.GetGitURL()
 }
 
-func TestPushEventRepository_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{Name: &zeroValue}
-	p.GetName()
-	p = &PushEventRepository{}
-	p.GetName()
-	p = nil
-	p.GetName()
+func TestRepository_GetHasDiscussions(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasDiscussions: &zeroValue}
+	r.GetHasDiscussions()
+	r = &Repository{}
+	r.GetHasDiscussions()
+	r = nil
+	r.GetHasDiscussions()
 }
 
-func TestPushEventRepository_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PushEventRepository{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+func TestRepository_GetHasDownloads(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasDownloads: &zeroValue}
+	r.GetHasDownloads()
+	r = &Repository{}
+	r.GetHasDownloads()
+	r = nil
+	r.GetHasDownloads()
 }
 
-func TestPushEventRepository_GetOpenIssuesCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{OpenIssuesCount: &zeroValue}
-	p.GetOpenIssuesCount()
-	p = &PushEventRepository{}
-	p.GetOpenIssuesCount()
-	p = nil
-	p.GetOpenIssuesCount()
+func TestRepository_GetHasIssues(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasIssues: &zeroValue}
+	r.GetHasIssues()
+	r = &Repository{}
+	r.GetHasIssues()
+	r = nil
+	r.GetHasIssues()
 }
 
-func TestPushEventRepository_GetOrganization(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{Organization: &zeroValue}
-	p.GetOrganization()
-	p = &PushEventRepository{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestRepository_GetHasPages(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasPages: &zeroValue}
+	r.GetHasPages()
+	r = &Repository{}
+	r.GetHasPages()
+	r = nil
+	r.GetHasPages()
 }
 
-func TestPushEventRepository_GetOwner(tt *testing.T) {
-	p := &PushEventRepository{}
-	p.GetOwner()
-	p = nil
-	p.GetOwner()
+func TestRepository_GetHasProjects(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasProjects: &zeroValue}
+	r.GetHasProjects()
+	r = &Repository{}
+	r.GetHasProjects()
+	r = nil
+	r.GetHasProjects()
 }
 
-func TestPushEventRepository_GetPrivate(tt *testing.T) {
+func TestRepository_GetHasWiki(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEventRepository{Private: &zeroValue}
-	p.GetPrivate()
-	p = &PushEventRepository{}
-	p.GetPrivate()
-	p = nil
-	p.GetPrivate()
+	r := &Repository{HasWiki: &zeroValue}
+	r.GetHasWiki()
+	r = &Repository{}
+	r.GetHasWiki()
+	r = nil
+	r.GetHasWiki()
 }
 
-func TestPushEventRepository_GetPullsURL(tt *testing.T) {
+func TestRepository_GetHomepage(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{PullsURL: &zeroValue}
-	p.GetPullsURL()
-	p = &PushEventRepository{}
-	p.GetPullsURL()
-	p = nil
-	p.GetPullsURL()
-}
-
-func TestPushEventRepository_GetPushedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PushEventRepository{PushedAt: &zeroValue}
-	p.GetPushedAt()
-	p = &PushEventRepository{}
-	p.GetPushedAt()
-	p = nil
-	p.GetPushedAt()
+	r := &Repository{Homepage: &zeroValue}
+	r.GetHomepage()
+	r = &Repository{}
+	r.GetHomepage()
+	r = nil
+	r.GetHomepage()
 }
 
-func TestPushEventRepository_GetSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{Size: &zeroValue}
-	p.GetSize()
-	p = &PushEventRepository{}
-	p.GetSize()
-	p = nil
-	p.GetSize()
+func TestRepository_GetHooksURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{HooksURL: &zeroValue}
+	r.GetHooksURL()
+	r = &Repository{}
+	r.GetHooksURL()
+	r = nil
+	r.GetHooksURL()
 }
 
-func TestPushEventRepository_GetSSHURL(tt *testing.T) {
+func TestRepository_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{SSHURL: &zeroValue}
-	p.GetSSHURL()
-	p = &PushEventRepository{}
-	p.GetSSHURL()
-	p = nil
-	p.GetSSHURL()
+	r := &Repository{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &Repository{}
+	r.GetHTMLURL()
+	r = nil
+	r.GetHTMLURL()
 }
 
-func TestPushEventRepository_GetStargazersCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{StargazersCount: &zeroValue}
-	p.GetStargazersCount()
-	p = &PushEventRepository{}
-	p.GetStargazersCount()
-	p = nil
-	p.GetStargazersCount()
+func TestRepository_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Repository{ID: &zeroValue}
+	r.GetID()
+	r = &Repository{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPushEventRepository_GetStatusesURL(tt *testing.T) {
+func TestRepository_GetIssueCommentURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{StatusesURL: &zeroValue}
-	p.GetStatusesURL()
-	p = &PushEventRepository{}
-	p.GetStatusesURL()
-	p = nil
-	p.GetStatusesURL()
+	r := &Repository{IssueCommentURL: &zeroValue}
+	r.GetIssueCommentURL()
+	r = &Repository{}
+	r.GetIssueCommentURL()
+	r = nil
+	r.GetIssueCommentURL()
 }
 
-func TestPushEventRepository_GetSVNURL(tt *testing.T) {
+func TestRepository_GetIssueEventsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{SVNURL: &zeroValue}
-	p.GetSVNURL()
-	p = &PushEventRepository{}
-	p.GetSVNURL()
-	p = nil
-	p.GetSVNURL()
+	r := &Repository{IssueEventsURL: &zeroValue}
+	r.GetIssueEventsURL()
+	r = &Repository{}
+	r.GetIssueEventsURL()
+	r = nil
+	r.GetIssueEventsURL()
 }
 
-func TestPushEventRepository_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PushEventRepository{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PushEventRepository{}
-	p.GetUpdatedAt()
-	p = nil
-	p.GetUpdatedAt()
+func TestRepository_GetIssuesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{IssuesURL: &zeroValue}
+	r.GetIssuesURL()
+	r = &Repository{}
+	r.GetIssuesURL()
+	r = nil
+	r.GetIssuesURL()
 }
 
-func TestPushEventRepository_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{URL: &zeroValue}
-	p.GetURL()
-	p = &PushEventRepository{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+func TestRepository_GetIsTemplate(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{IsTemplate: &zeroValue}
+	r.GetIsTemplate()
+	r = &Repository{}
+	r.GetIsTemplate()
+	r = nil
+	r.GetIsTemplate()
 }
 
-func TestPushEventRepository_GetWatchersCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{WatchersCount: &zeroValue}
-	p.GetWatchersCount()
-	p = &PushEventRepository{}
-	p.GetWatchersCount()
-	p = nil
-	p.GetWatchersCount()
+func TestRepository_GetKeysURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{KeysURL: &zeroValue}
+	r.GetKeysURL()
+	r = &Repository{}
+	r.GetKeysURL()
+	r = nil
+	r.GetKeysURL()
 }
 
-func TestRateLimits_GetActionsRunnerRegistration(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetActionsRunnerRegistration()
+func TestRepository_GetLabelsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{LabelsURL: &zeroValue}
+	r.GetLabelsURL()
+	r = &Repository{}
+	r.GetLabelsURL()
 	r = nil
-	r.GetActionsRunnerRegistration()
+	r.GetLabelsURL()
 }
 
-func TestRateLimits_GetCodeScanningUpload(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetCodeScanningUpload()
+func TestRepository_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Language: &zeroValue}
+	r.GetLanguage()
+	r = &Repository{}
+	r.GetLanguage()
 	r = nil
-	r.GetCodeScanningUpload()
+	r.GetLanguage()
 }
 
-func TestRateLimits_GetCore(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetCore()
+func TestRepository_GetLanguagesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{LanguagesURL: &zeroValue}
+	r.GetLanguagesURL()
+	r = &Repository{}
+	r.GetLanguagesURL()
 	r = nil
-	r.GetCore()
+	r.GetLanguagesURL()
 }
 
-func TestRateLimits_GetGraphQL(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetGraphQL()
+func TestRepository_GetLicense(tt *testing.T) {
+	r := &Repository{}
+	r.GetLicense()
 	r = nil
-	r.GetGraphQL()
+	r.GetLicense()
 }
 
-func TestRateLimits_GetIntegrationManifest(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetIntegrationManifest()
+func TestRepository_GetLicenseTemplate(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{LicenseTemplate: &zeroValue}
+	r.GetLicenseTemplate()
+	r = &Repository{}
+	r.GetLicenseTemplate()
 	r = nil
-	r.GetIntegrationManifest()
+	r.GetLicenseTemplate()
 }
 
-func TestRateLimits_GetSCIM(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetSCIM()
+func TestRepository_GetMasterBranch(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MasterBranch: &zeroValue}
+	r.GetMasterBranch()
+	r = &Repository{}
+	r.GetMasterBranch()
 	r = nil
-	r.GetSCIM()
+	r.GetMasterBranch()
 }
 
-func TestRateLimits_GetSearch(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetSearch()
+func TestRepository_GetMergeCommitMessage(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MergeCommitMessage: &zeroValue}
+	r.GetMergeCommitMessage()
+	r = &Repository{}
+	r.GetMergeCommitMessage()
 	r = nil
-	r.GetSearch()
+	r.GetMergeCommitMessage()
 }
 
-func TestRateLimits_GetSourceImport(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetSourceImport()
+func TestRepository_GetMergeCommitTitle(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MergeCommitTitle: &zeroValue}
+	r.GetMergeCommitTitle()
+	r = &Repository{}
+	r.GetMergeCommitTitle()
 	r = nil
-	r.GetSourceImport()
+	r.GetMergeCommitTitle()
 }
 
-func TestReaction_GetContent(tt *testing.T) {
+func TestRepository_GetMergesURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reaction{Content: &zeroValue}
-	r.GetContent()
-	r = &Reaction{}
-	r.GetContent()
+	r := &Repository{MergesURL: &zeroValue}
+	r.GetMergesURL()
+	r = &Repository{}
+	r.GetMergesURL()
 	r = nil
-	r.GetContent()
+	r.GetMergesURL()
 }
 
-func TestReaction_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &Reaction{ID: &zeroValue}
-	r.GetID()
-	r = &Reaction{}
-	r.GetID()
+func TestRepository_GetMilestonesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MilestonesURL: &zeroValue}
+	r.GetMilestonesURL()
+	r = &Repository{}
+	r.GetMilestonesURL()
 	r = nil
-	r.GetID()
+	r.GetMilestonesURL()
 }
 
-func TestReaction_GetNodeID(tt *testing.T) {
+func TestRepository_GetMirrorURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reaction{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Reaction{}
-	r.GetNodeID()
+	r := &Repository{MirrorURL: &zeroValue}
+	r.GetMirrorURL()
+	r = &Repository{}
+	r.GetMirrorURL()
 	r = nil
-	r.GetNodeID()
+	r.GetMirrorURL()
 }
 
-func TestReaction_GetUser(tt *testing.T) {
-	r := &Reaction{}
-	r.GetUser()
+func TestRepository_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Name: &zeroValue}
+	r.GetName()
+	r = &Repository{}
+	r.GetName()
 	r = nil
-	r.GetUser()
+	r.GetName()
 }
 
-func TestReactions_GetConfused(tt *testing.T) {
+func TestRepository_GetNetworkCount(tt *testing.T) {
 	var zeroValue int
-	r := &Reactions{Confused: &zeroValue}
-	r.GetConfused()
-	r = &Reactions{}
-	r.GetConfused()
+	r := &Repository{NetworkCount: &zeroValue}
+	r.GetNetworkCount()
+	r = &Repository{}
+	r.GetNetworkCount()
 	r = nil
-	r.GetConfused()
+	r.GetNetworkCount()
 }
 
-func TestReactions_GetEyes(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Eyes: &zeroValue}
-	r.GetEyes()
-	r = &Reactions{}
-	r.GetEyes()
+func TestRepository_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Repository{}
+	r.GetNodeID()
 	r = nil
-	r.GetEyes()
+	r.GetNodeID()
 }
 
-func TestReactions_GetHeart(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Heart: &zeroValue}
-	r.GetHeart()
-	r = &Reactions{}
-	r.GetHeart()
+func TestRepository_GetNotificationsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{NotificationsURL: &zeroValue}
+	r.GetNotificationsURL()
+	r = &Repository{}
+	r.GetNotificationsURL()
 	r = nil
-	r.GetHeart()
+	r.GetNotificationsURL()
 }
 
-func TestReactions_GetHooray(tt *testing.T) {
+func TestRepository_GetOpenIssues(tt *testing.T) {
 	var zeroValue int
-	r := &Reactions{Hooray: &zeroValue}
-	r.GetHooray()
-	r = &Reactions{}
-	r.GetHooray()
+	r := &Repository{OpenIssues: &zeroValue}
+	r.GetOpenIssues()
+	r = &Repository{}
+	r.GetOpenIssues()
 	r = nil
-	r.GetHooray()
+	r.GetOpenIssues()
 }
 
-func TestReactions_GetLaugh(tt *testing.T) {
+func TestRepository_GetOpenIssuesCount(tt *testing.T) {
 	var zeroValue int
-	r := &Reactions{Laugh: &zeroValue}
-	r.GetLaugh()
-	r = &Reactions{}
-	r.GetLaugh()
+	r := &Repository{OpenIssuesCount: &zeroValue}
+	r.GetOpenIssuesCount()
+	r = &Repository{}
+	r.GetOpenIssuesCount()
 	r = nil
-	r.GetLaugh()
+	r.GetOpenIssuesCount()
 }
 
-func TestReactions_GetMinusOne(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{MinusOne: &zeroValue}
-	r.GetMinusOne()
-	r = &Reactions{}
-	r.GetMinusOne()
+func TestRepository_GetOrganization(tt *testing.T) {
+	r := &Repository{}
+	r.GetOrganization()
 	r = nil
-	r.GetMinusOne()
+	r.GetOrganization()
 }
 
-func TestReactions_GetPlusOne(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{PlusOne: &zeroValue}
-	r.GetPlusOne()
-	r = &Reactions{}
-	r.GetPlusOne()
+func TestRepository_GetOwner(tt *testing.T) {
+	r := &Repository{}
+	r.GetOwner()
 	r = nil
-	r.GetPlusOne()
+	r.GetOwner()
 }
 
-func TestReactions_GetRocket(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Rocket: &zeroValue}
-	r.GetRocket()
-	r = &Reactions{}
-	r.GetRocket()
+func TestRepository_GetParent(tt *testing.T) {
+	r := &Repository{}
+	r.GetParent()
 	r = nil
-	r.GetRocket()
+	r.GetParent()
 }
 
-func TestReactions_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{TotalCount: &zeroValue}
-	r.GetTotalCount()
-	r = &Reactions{}
-	r.GetTotalCount()
+func TestRepository_GetPermissions(tt *testing.T) {
+	zeroValue := map[string]bool{}
+	r := &Repository{Permissions: zeroValue}
+	r.GetPermissions()
+	r = &Repository{}
+	r.GetPermissions()
 	r = nil
-	r.GetTotalCount()
+	r.GetPermissions()
 }
 
-func TestReactions_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &Reactions{URL: &zeroValue}
-	r.GetURL()
-	r = &Reactions{}
-	r.GetURL()
+func TestRepository_GetPrivate(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Private: &zeroValue}
+	r.GetPrivate()
+	r = &Repository{}
+	r.GetPrivate()
 	r = nil
-	r.GetURL()
+	r.GetPrivate()
 }
 
-func TestReference_GetNodeID(tt *testing.T) {
+func TestRepository_GetPullsURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reference{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Reference{}
-	r.GetNodeID()
+	r := &Repository{PullsURL: &zeroValue}
+	r.GetPullsURL()
+	r = &Repository{}
+	r.GetPullsURL()
 	r = nil
-	r.GetNodeID()
+	r.GetPullsURL()
 }
 
-func TestReference_GetObject(tt *testing.T) {
-	r := &Reference{}
-	r.GetObject()
+func TestRepository_GetPushedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Repository{PushedAt: &zeroValue}
+	r.GetPushedAt()
+	r = &Repository{}
+	r.GetPushedAt()
 	r = nil
-	r.GetObject()
+	r.GetPushedAt()
 }
 
-func TestReference_GetRef(tt *testing.T) {
+func TestRepository_GetReleasesURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reference{Ref: &zeroValue}
-	r.GetRef()
-	r = &Reference{}
-	r.GetRef()
+	r := &Repository{ReleasesURL: &zeroValue}
+	r.GetReleasesURL()
+	r = &Repository{}
+	r.GetReleasesURL()
 	r = nil
-	r.GetRef()
+	r.GetReleasesURL()
 }
 
-func TestReference_GetURL(tt *testing.T) {
+func TestRepository_GetRoleName(tt *testing.T) {
 	var zeroValue string
-	r := &Reference{URL: &zeroValue}
-	r.GetURL()
-	r = &Reference{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
-}
-
-func TestRegistrationToken_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RegistrationToken{ExpiresAt: &zeroValue}
-	r.GetExpiresAt()
-	r = &RegistrationToken{}
-	r.GetExpiresAt()
+	r := &Repository{RoleName: &zeroValue}
+	r.GetRoleName()
+	r = &Repository{}
+	r.GetRoleName()
 	r = nil
-	r.GetExpiresAt()
+	r.GetRoleName()
 }
 
-func TestRegistrationToken_GetToken(tt *testing.T) {
-	var zeroValue string
-	r := &RegistrationToken{Token: &zeroValue}
-	r.GetToken()
-	r = &RegistrationToken{}
-	r.GetToken()
+func TestRepository_GetSecurityAndAnalysis(tt *testing.T) {
+	r := &Repository{}
+	r.GetSecurityAndAnalysis()
 	r = nil
-	r.GetToken()
+	r.GetSecurityAndAnalysis()
 }
 
-func TestReleaseAsset_GetBrowserDownloadURL(tt *testing.T) {
-	var zeroValue string
-	r := &ReleaseAsset{BrowserDownloadURL: &zeroValue}
-	r.GetBrowserDownloadURL()
-	r = &ReleaseAsset{}
-	r.GetBrowserDownloadURL()
+func TestRepository_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{Size: &zeroValue}
+	r.GetSize()
+	r = &Repository{}
+	r.GetSize()
 	r = nil
-	r.GetBrowserDownloadURL()
+	r.GetSize()
 }
 
-func TestReleaseAsset_GetContentType(tt *testing.T) {
-	var zeroValue string
-	r := &ReleaseAsset{ContentType: &zeroValue}
-	r.GetContentType()
-	r = &ReleaseAsset{}
-	r.GetContentType()
+func TestRepository_GetSource(tt *testing.T) {
+	r := &Repository{}
+	r.GetSource()
 	r = nil
-	r.GetContentType()
+	r.GetSource()
 }
 
-func TestReleaseAsset_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &ReleaseAsset{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &ReleaseAsset{}
-	r.GetCreatedAt()
+func TestRepository_GetSquashMergeCommitMessage(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SquashMergeCommitMessage: &zeroValue}
+	r.GetSquashMergeCommitMessage()
+	r = &Repository{}
+	r.GetSquashMergeCommitMessage()
 	r = nil
-	r.GetCreatedAt()
+	r.GetSquashMergeCommitMessage()
 }
 
-func TestReleaseAsset_GetDownloadCount(tt *testing.T) {
-	var zeroValue int
-	r := &ReleaseAsset{DownloadCount: &zeroValue}
-	r.GetDownloadCount()
-	r = &ReleaseAsset{}
-	r.GetDownloadCount()
+func TestRepository_GetSquashMergeCommitTitle(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SquashMergeCommitTitle: &zeroValue}
+	r.GetSquashMergeCommitTitle()
+	r = &Repository{}
+	r.GetSquashMergeCommitTitle()
 	r = nil
-	r.GetDownloadCount()
+	r.GetSquashMergeCommitTitle()
 }
 
-func TestReleaseAsset_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &ReleaseAsset{ID: &zeroValue}
-	r.GetID()
-	r = &ReleaseAsset{}
-	r.GetID()
+func TestRepository_GetSSHURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SSHURL: &zeroValue}
+	r.GetSSHURL()
+	r = &Repository{}
+	r.GetSSHURL()
 	r = nil
-	r.GetID()
+	r.GetSSHURL()
 }
 
-func TestReleaseAsset_GetLabel(tt *testing.T) {
-	var zeroValue string
-	r := &ReleaseAsset{Label: &zeroValue}
-	r.GetLabel()
-	r = &ReleaseAsset{}
-	r.GetLabel()
+func TestRepository_GetStargazersCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{StargazersCount: &zeroValue}
+	r.GetStargazersCount()
+	r = &Repository{}
+	r.GetStargazersCount()
 	r = nil
-	r.GetLabel()
+	r.GetStargazersCount()
 }
 
-func TestReleaseAsset_GetName(tt *testing.T) {
+func TestRepository_GetStargazersURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{Name: &zeroValue}
-	r.GetName()
-	r = &ReleaseAsset{}
-	r.GetName()
+	r := &Repository{StargazersURL: &zeroValue}
+	r.GetStargazersURL()
+	r = &Repository{}
+	r.GetStargazersURL()
 	r = nil
-	r.GetName()
+	r.GetStargazersURL()
 }
 
-func TestReleaseAsset_GetNodeID(tt *testing.T) {
+func TestRepository_GetStatusesURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &ReleaseAsset{}
-	r.GetNodeID()
+	r := &Repository{StatusesURL: &zeroValue}
+	r.GetStatusesURL()
+	r = &Repository{}
+	r.GetStatusesURL()
 	r = nil
-	r.GetNodeID()
+	r.GetStatusesURL()
 }
 
-func TestReleaseAsset_GetSize(tt *testing.T) {
+func TestRepository_GetSubscribersCount(tt *testing.T) {
 	var zeroValue int
-	r := &ReleaseAsset{Size: &zeroValue}
-	r.GetSize()
-	r = &ReleaseAsset{}
-	r.GetSize()
+	r := &Repository{SubscribersCount: &zeroValue}
+	r.GetSubscribersCount()
+	r = &Repository{}
+	r.GetSubscribersCount()
 	r = nil
-	r.GetSize()
+	r.GetSubscribersCount()
 }
 
-func TestReleaseAsset_GetState(tt *testing.T) {
+func TestRepository_GetSubscribersURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{State: &zeroValue}
-	r.GetState()
-	r = &ReleaseAsset{}
-	r.GetState()
-	r = nil
-	r.GetState()
-}
-
-func TestReleaseAsset_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &ReleaseAsset{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &ReleaseAsset{}
-	r.GetUpdatedAt()
+	r := &Repository{SubscribersURL: &zeroValue}
+	r.GetSubscribersURL()
+	r = &Repository{}
+	r.GetSubscribersURL()
 	r = nil
-	r.GetUpdatedAt()
+	r.GetSubscribersURL()
 }
 
-func TestReleaseAsset_GetUploader(tt *testing.T) {
-	r := &ReleaseAsset{}
-	r.GetUploader()
+func TestRepository_GetSubscriptionURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SubscriptionURL: &zeroValue}
+	r.GetSubscriptionURL()
+	r = &Repository{}
+	r.GetSubscriptionURL()
 	r = nil
-	r.GetUploader()
+	r.GetSubscriptionURL()
 }
 
-func TestReleaseAsset_GetURL(tt *testing.T) {
+func TestRepository_GetSVNURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{URL: &zeroValue}
-	r.GetURL()
-	r = &ReleaseAsset{}
-	r.GetURL()
+	r := &Repository{SVNURL: &zeroValue}
+	r.GetSVNURL()
+	r = &Repository{}
+	r.GetSVNURL()
 	r = nil
-	r.GetURL()
+	r.GetSVNURL()
 }
 
-func TestReleaseEvent_GetAction(tt *testing.T) {
+func TestRepository_GetTagsURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &ReleaseEvent{}
-	r.GetAction()
+	r := &Repository{TagsURL: &zeroValue}
+	r.GetTagsURL()
+	r = &Repository{}
+	r.GetTagsURL()
 	r = nil
-	r.GetAction()
+	r.GetTagsURL()
 }
 
-func TestReleaseEvent_GetInstallation(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetInstallation()
+func TestRepository_GetTeamID(tt *testing.T) {
+	var zeroValue int64
+	r := &Repository{TeamID: &zeroValue}
+	r.GetTeamID()
+	r = &Repository{}
+	r.GetTeamID()
 	r = nil
-	r.GetInstallation()
+	r.GetTeamID()
 }
 
-func TestReleaseEvent_GetRelease(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetRelease()
+func TestRepository_GetTeamsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{TeamsURL: &zeroValue}
+	r.GetTeamsURL()
+	r = &Repository{}
+	r.GetTeamsURL()
 	r = nil
-	r.GetRelease()
+	r.GetTeamsURL()
 }
 
-func TestReleaseEvent_GetRepo(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetRepo()
+func TestRepository_GetTemplateRepository(tt *testing.T) {
+	r := &Repository{}
+	r.GetTemplateRepository()
 	r = nil
-	r.GetRepo()
+	r.GetTemplateRepository()
 }
 
-func TestReleaseEvent_GetSender(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetSender()
+func TestRepository_GetTreesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{TreesURL: &zeroValue}
+	r.GetTreesURL()
+	r = &Repository{}
+	r.GetTreesURL()
 	r = nil
-	r.GetSender()
+	r.GetTreesURL()
 }
 
-func TestRemoveToken_GetExpiresAt(tt *testing.T) {
+func TestRepository_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	r := &RemoveToken{ExpiresAt: &zeroValue}
-	r.GetExpiresAt()
-	r = &RemoveToken{}
-	r.GetExpiresAt()
+	r := &Repository{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &Repository{}
+	r.GetUpdatedAt()
 	r = nil
-	r.GetExpiresAt()
+	r.GetUpdatedAt()
 }
 
-func TestRemoveToken_GetToken(tt *testing.T) {
+func TestRepository_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RemoveToken{Token: &zeroValue}
-	r.GetToken()
-	r = &RemoveToken{}
-	r.GetToken()
+	r := &Repository{URL: &zeroValue}
+	r.GetURL()
+	r = &Repository{}
+	r.GetURL()
 	r = nil
-	r.GetToken()
+	r.GetURL()
 }
 
-func TestRename_GetFrom(tt *testing.T) {
-	var zeroValue string
-	r := &Rename{From: &zeroValue}
-	r.GetFrom()
-	r = &Rename{}
-	r.GetFrom()
+func TestRepository_GetUseSquashPRTitleAsDefault(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{UseSquashPRTitleAsDefault: &zeroValue}
+	r.GetUseSquashPRTitleAsDefault()
+	r = &Repository{}
+	r.GetUseSquashPRTitleAsDefault()
 	r = nil
-	r.GetFrom()
+	r.GetUseSquashPRTitleAsDefault()
 }
 
-func TestRename_GetTo(tt *testing.T) {
+func TestRepository_GetVisibility(tt *testing.T) {
 	var zeroValue string
-	r := &Rename{To: &zeroValue}
-	r.GetTo()
-	r = &Rename{}
-	r.GetTo()
+	r := &Repository{Visibility: &zeroValue}
+	r.GetVisibility()
+	r = &Repository{}
+	r.GetVisibility()
 	r = nil
-	r.GetTo()
+	r.GetVisibility()
 }
 
-func TestRenameOrgResponse_GetMessage(tt *testing.T) {
-	var zeroValue string
-	r := &RenameOrgResponse{Message: &zeroValue}
-	r.GetMessage()
-	r = &RenameOrgResponse{}
-	r.GetMessage()
+func TestRepository_GetWatchers(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{Watchers: &zeroValue}
+	r.GetWatchers()
+	r = &Repository{}
+	r.GetWatchers()
 	r = nil
-	r.GetMessage()
+	r.GetWatchers()
 }
 
-func TestRenameOrgResponse_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &RenameOrgResponse{URL: &zeroValue}
-	r.GetURL()
-	r = &RenameOrgResponse{}
-	r.GetURL()
+func TestRepository_GetWatchersCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{WatchersCount: &zeroValue}
+	r.GetWatchersCount()
+	r = &Repository{}
+	r.GetWatchersCount()
 	r = nil
-	r.GetURL()
+	r.GetWatchersCount()
 }
 
-func TestRepoMergeUpstreamRequest_GetBranch(tt *testing.T) {
-	var zeroValue string
-	r := &RepoMergeUpstreamRequest{Branch: &zeroValue}
-	r.GetBranch()
-	r = &RepoMergeUpstreamRequest{}
-	r.GetBranch()
+func TestRepository_GetWebCommitSignoffRequired(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{WebCommitSignoffRequired: &zeroValue}
+	r.GetWebCommitSignoffRequired()
+	r = &Repository{}
+	r.GetWebCommitSignoffRequired()
 	r = nil
-	r.GetBranch()
+	r.GetWebCommitSignoffRequired()
 }
 
-func TestRepoMergeUpstreamResult_GetBaseBranch(tt *testing.T) {
+func TestRepositoryActionsAccessLevel_GetAccessLevel(tt *testing.T) {
 	var zeroValue string
-	r := &RepoMergeUpstreamResult{BaseBranch: &zeroValue}
-	r.GetBaseBranch()
-	r = &RepoMergeUpstreamResult{}
-	r.GetBaseBranch()
+	r := &RepositoryActionsAccessLevel{AccessLevel: &zeroValue}
+	r.GetAccessLevel()
+	r = &RepositoryActionsAccessLevel{}
+	r.GetAccessLevel()
 	r = nil
-	r.GetBaseBranch()
+	r.GetAccessLevel()
 }
 
-func TestRepoMergeUpstreamResult_GetMergeType(tt *testing.T) {
-	var zeroValue string
-	r := &RepoMergeUpstreamResult{MergeType: &zeroValue}
-	r.GetMergeType()
-	r = &RepoMergeUpstreamResult{}
-	r.GetMergeType()
+func TestRepositoryActiveCommitters_GetAdvancedSecurityCommitters(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoryActiveCommitters{AdvancedSecurityCommitters: &zeroValue}
+	r.GetAdvancedSecurityCommitters()
+	r = &RepositoryActiveCommitters{}
+	r.GetAdvancedSecurityCommitters()
 	r = nil
-	r.GetMergeType()
+	r.GetAdvancedSecurityCommitters()
 }
 
-func TestRepoMergeUpstreamResult_GetMessage(tt *testing.T) {
+func TestRepositoryActiveCommitters_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepoMergeUpstreamResult{Message: &zeroValue}
-	r.GetMessage()
-	r = &RepoMergeUpstreamResult{}
-	r.GetMessage()
+	r := &RepositoryActiveCommitters{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryActiveCommitters{}
+	r.GetName()
 	r = nil
-	r.GetMessage()
+	r.GetName()
 }
 
-func TestRepoName_GetFrom(tt *testing.T) {
+func TestRepositoryComment_GetBody(tt *testing.T) {
 	var zeroValue string
-	r := &RepoName{From: &zeroValue}
-	r.GetFrom()
-	r = &RepoName{}
-	r.GetFrom()
+	r := &RepositoryComment{Body: &zeroValue}
+	r.GetBody()
+	r = &RepositoryComment{}
+	r.GetBody()
 	r = nil
-	r.GetFrom()
+	r.GetBody()
 }
 
-func TestRepoRequiredWorkflow_GetBadgeURL(tt *testing.T) {
+func TestRepositoryComment_GetCommitID(tt *testing.T) {
 	var zeroValue string
-	r := &RepoRequiredWorkflow{BadgeURL: &zeroValue}
-	r.GetBadgeURL()
-	r = &RepoRequiredWorkflow{}
-	r.GetBadgeURL()
+	r := &RepositoryComment{CommitID: &zeroValue}
+	r.GetCommitID()
+	r = &RepositoryComment{}
+	r.GetCommitID()
 	r = nil
-	r.GetBadgeURL()
+	r.GetCommitID()
 }
 
-func TestRepoRequiredWorkflow_GetCreatedAt(tt *testing.T) {
+func TestRepositoryComment_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	r := &RepoRequiredWorkflow{CreatedAt: &zeroValue}
+	r := &RepositoryComment{CreatedAt: &zeroValue}
 	r.GetCreatedAt()
-	r = &RepoRequiredWorkflow{}
+	r = &RepositoryComment{}
 	r.GetCreatedAt()
 	r = nil
 	r.GetCreatedAt()
 }
 
-func TestRepoRequiredWorkflow_GetHTMLURL(tt *testing.T) {
+func TestRepositoryComment_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepoRequiredWorkflow{HTMLURL: &zeroValue}
+	r := &RepositoryComment{HTMLURL: &zeroValue}
 	r.GetHTMLURL()
-	r = &RepoRequiredWorkflow{}
+	r = &RepositoryComment{}
 	r.GetHTMLURL()
 	r = nil
 	r.GetHTMLURL()
 }
 
-func TestRepoRequiredWorkflow_GetID(tt *testing.T) {
+func TestRepositoryComment_GetID(tt *testing.T) {
 	var zeroValue int64
-	r := &RepoRequiredWorkflow{ID: &zeroValue}
+	r := &RepositoryComment{ID: &zeroValue}
 	r.GetID()
-	r = &RepoRequiredWorkflow{}
+	r = &RepositoryComment{}
 	r.GetID()
 	r = nil
 	r.GetID()
 }
 
-func TestRepoRequiredWorkflow_GetName(tt *testing.T) {
-	var zeroValue string
-	r := &RepoRequiredWorkflow{Name: &zeroValue}
-	r.GetName()
-	r = &RepoRequiredWorkflow{}
-	r.GetName()
-	r = nil
-	r.GetName()
-}
-
-func TestRepoRequiredWorkflow_GetNodeID(tt *testing.T) {
+func TestRepositoryComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	r := &RepoRequiredWorkflow{NodeID: &zeroValue}
+	r := &RepositoryComment{NodeID: &zeroValue}
 	r.GetNodeID()
-	r = &RepoRequiredWorkflow{}
+	r = &RepositoryComment{}
 	r.GetNodeID()
 	r = nil
 	r.GetNodeID()
 }
 
-func TestRepoRequiredWorkflow_GetPath(tt *testing.T) {
+func TestRepositoryComment_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &RepoRequiredWorkflow{Path: &zeroValue}
+	r := &RepositoryComment{Path: &zeroValue}
 	r.GetPath()
-	r = &RepoRequiredWorkflow{}
+	r = &RepositoryComment{}
 	r.GetPath()
 	r = nil
 	r.GetPath()
 }
 
-func TestRepoRequiredWorkflow_GetSourceRepository(tt *testing.T) {
-	r := &RepoRequiredWorkflow{}
-	r.GetSourceRepository()
+func TestRepositoryComment_GetPosition(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoryComment{Position: &zeroValue}
+	r.GetPosition()
+	r = &RepositoryComment{}
+	r.GetPosition()
 	r = nil
-	r.GetSourceRepository()
+	r.GetPosition()
 }
 
-func TestRepoRequiredWorkflow_GetState(tt *testing.T) {
-	var zeroValue string
-	r := &RepoRequiredWorkflow{State: &zeroValue}
-	r.GetState()
-	r = &RepoRequiredWorkflow{}
-	r.GetState()
+func TestRepositoryComment_GetReactions(tt *testing.T) {
+	r := &RepositoryComment{}
+	r.GetReactions()
 	r = nil
-	r.GetState()
+	r.GetReactions()
 }
 
-func TestRepoRequiredWorkflow_GetUpdatedAt(tt *testing.T) {
+func TestRepositoryComment_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	r := &RepoRequiredWorkflow{UpdatedAt: &zeroValue}
+	r := &RepositoryComment{UpdatedAt: &zeroValue}
 	r.GetUpdatedAt()
-	r = &RepoRequiredWorkflow{}
+	r = &RepositoryComment{}
 	r.GetUpdatedAt()
 	r = nil
 	r.GetUpdatedAt()
 }
 
-func TestRepoRequiredWorkflow_GetURL(tt *testing.T) {
+func TestRepositoryComment_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepoRequiredWorkflow{URL: &zeroValue}
+	r := &RepositoryComment{URL: &zeroValue}
 	r.GetURL()
-	r = &RepoRequiredWorkflow{}
+	r = &RepositoryComment{}
 	r.GetURL()
 	r = nil
 	r.GetURL()
 }
 
-func TestRepoRequiredWorkflows_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	r := &RepoRequiredWorkflows{TotalCount: &zeroValue}
-	r.GetTotalCount()
-	r = &RepoRequiredWorkflows{}
-	r.GetTotalCount()
+func TestRepositoryComment_GetUser(tt *testing.T) {
+	r := &RepositoryComment{}
+	r.GetUser()
 	r = nil
-	r.GetTotalCount()
+	r.GetUser()
 }
 
-func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
-	r.GetIncompleteResults()
-	r = &RepositoriesSearchResult{}
-	r.GetIncompleteResults()
+func TestRepositoryCommit_GetAuthor(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetAuthor()
 	r = nil
-	r.GetIncompleteResults()
+	r.GetAuthor()
 }
 
-func TestRepositoriesSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoriesSearchResult{Total: &zeroValue}
-	r.GetTotal()
-	r = &RepositoriesSearchResult{}
-	r.GetTotal()
+func TestRepositoryCommit_GetCommentsURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryCommit{CommentsURL: &zeroValue}
+	r.GetCommentsURL()
+	r = &RepositoryCommit{}
+	r.GetCommentsURL()
 	r = nil
-	r.GetTotal()
+	r.GetCommentsURL()
 }
 
-func TestRepository_GetAllowAutoMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowAutoMerge: &zeroValue}
-	r.GetAllowAutoMerge()
-	r = &Repository{}
-	r.GetAllowAutoMerge()
+func TestRepositoryCommit_GetCommit(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetCommit()
 	r = nil
-	r.GetAllowAutoMerge()
+	r.GetCommit()
 }
 
-func TestRepository_GetAllowForking(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowForking: &zeroValue}
-	r.GetAllowForking()
-	r = &Repository{}
-	r.GetAllowForking()
+func TestRepositoryCommit_GetCommitter(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetCommitter()
 	r = nil
-	r.GetAllowForking()
+	r.GetCommitter()
 }
 
-func TestRepository_GetAllowMergeCommit(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowMergeCommit: &zeroValue}
-	r.GetAllowMergeCommit()
-	r = &Repository{}
-	r.GetAllowMergeCommit()
+func TestRepositoryCommit_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryCommit{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryCommit{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetAllowMergeCommit()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetAllowRebaseMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowRebaseMerge: &zeroValue}
-	r.GetAllowRebaseMerge()
-	r = &Repository{}
-	r.GetAllowRebaseMerge()
+func TestRepositoryCommit_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryCommit{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryCommit{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
+}
+
+func TestRepositoryCommit_GetSHA(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryCommit{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryCommit{}
+	r.GetSHA()
 	r = nil
-	r.GetAllowRebaseMerge()
+	r.GetSHA()
 }
 
-func TestRepository_GetAllowSquashMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowSquashMerge: &zeroValue}
-	r.GetAllowSquashMerge()
-	r = &Repository{}
-	r.GetAllowSquashMerge()
+func TestRepositoryCommit_GetStats(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetStats()
 	r = nil
-	r.GetAllowSquashMerge()
+	r.GetStats()
 }
 
-func TestRepository_GetAllowUpdateBranch(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowUpdateBranch: &zeroValue}
-	r.GetAllowUpdateBranch()
-	r = &Repository{}
-	r.GetAllowUpdateBranch()
+func TestRepositoryCommit_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryCommit{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryCommit{}
+	r.GetURL()
 	r = nil
-	r.GetAllowUpdateBranch()
+	r.GetURL()
 }
 
-func TestRepository_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Archived: &zeroValue}
-	r.GetArchived()
-	r = &Repository{}
-	r.GetArchived()
+func TestRepositoryContent_GetDownloadURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RepositoryContent{}
+	r.GetDownloadURL()
 	r = nil
-	r.GetArchived()
+	r.GetDownloadURL()
 }
 
-func TestRepository_GetArchiveURL(tt *testing.T) {
+func TestRepositoryContent_GetEncoding(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ArchiveURL: &zeroValue}
-	r.GetArchiveURL()
-	r = &Repository{}
-	r.GetArchiveURL()
+	r := &RepositoryContent{Encoding: &zeroValue}
+	r.GetEncoding()
+	r = &RepositoryContent{}
+	r.GetEncoding()
 	r = nil
-	r.GetArchiveURL()
+	r.GetEncoding()
 }
 
-func TestRepository_GetAssigneesURL(tt *testing.T) {
+func TestRepositoryContent_GetGitURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{AssigneesURL: &zeroValue}
-	r.GetAssigneesURL()
-	r = &Repository{}
-	r.GetAssigneesURL()
+	r := &RepositoryContent{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &RepositoryContent{}
+	r.GetGitURL()
 	r = nil
-	r.GetAssigneesURL()
+	r.GetGitURL()
 }
 
-func TestRepository_GetAutoInit(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AutoInit: &zeroValue}
-	r.GetAutoInit()
-	r = &Repository{}
-	r.GetAutoInit()
+func TestRepositoryContent_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryContent{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetAutoInit()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetBlobsURL(tt *testing.T) {
+func TestRepositoryContent_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{BlobsURL: &zeroValue}
-	r.GetBlobsURL()
-	r = &Repository{}
-	r.GetBlobsURL()
+	r := &RepositoryContent{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryContent{}
+	r.GetName()
 	r = nil
-	r.GetBlobsURL()
+	r.GetName()
 }
 
-func TestRepository_GetBranchesURL(tt *testing.T) {
+func TestRepositoryContent_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{BranchesURL: &zeroValue}
-	r.GetBranchesURL()
-	r = &Repository{}
-	r.GetBranchesURL()
+	r := &RepositoryContent{Path: &zeroValue}
+	r.GetPath()
+	r = &RepositoryContent{}
+	r.GetPath()
 	r = nil
-	r.GetBranchesURL()
+	r.GetPath()
 }
 
-func TestRepository_GetCloneURL(tt *testing.T) {
+func TestRepositoryContent_GetSHA(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CloneURL: &zeroValue}
-	r.GetCloneURL()
-	r = &Repository{}
-	r.GetCloneURL()
+	r := &RepositoryContent{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryContent{}
+	r.GetSHA()
 	r = nil
-	r.GetCloneURL()
+	r.GetSHA()
 }
 
-func TestRepository_GetCodeOfConduct(tt *testing.T) {
-	r := &Repository{}
-	r.GetCodeOfConduct()
+func TestRepositoryContent_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoryContent{Size: &zeroValue}
+	r.GetSize()
+	r = &RepositoryContent{}
+	r.GetSize()
 	r = nil
-	r.GetCodeOfConduct()
+	r.GetSize()
 }
 
-func TestRepository_GetCollaboratorsURL(tt *testing.T) {
+func TestRepositoryContent_GetTarget(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CollaboratorsURL: &zeroValue}
-	r.GetCollaboratorsURL()
-	r = &Repository{}
-	r.GetCollaboratorsURL()
+	r := &RepositoryContent{Target: &zeroValue}
+	r.GetTarget()
+	r = &RepositoryContent{}
+	r.GetTarget()
 	r = nil
-	r.GetCollaboratorsURL()
+	r.GetTarget()
 }
 
-func TestRepository_GetCommentsURL(tt *testing.T) {
+func TestRepositoryContent_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CommentsURL: &zeroValue}
-	r.GetCommentsURL()
-	r = &Repository{}
-	r.GetCommentsURL()
+	r := &RepositoryContent{Type: &zeroValue}
+	r.GetType()
+	r = &RepositoryContent{}
+	r.GetType()
 	r = nil
-	r.GetCommentsURL()
+	r.GetType()
 }
 
-func TestRepository_GetCommitsURL(tt *testing.T) {
+func TestRepositoryContent_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CommitsURL: &zeroValue}
-	r.GetCommitsURL()
-	r = &Repository{}
-	r.GetCommitsURL()
+	r := &RepositoryContent{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryContent{}
+	r.GetURL()
 	r = nil
-	r.GetCommitsURL()
+	r.GetURL()
 }
 
-func TestRepository_GetCompareURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{CompareURL: &zeroValue}
-	r.GetCompareURL()
-	r = &Repository{}
-	r.GetCompareURL()
+func TestRepositoryContentFileOptions_GetAuthor(tt *testing.T) {
+	r := &RepositoryContentFileOptions{}
+	r.GetAuthor()
 	r = nil
-	r.GetCompareURL()
+	r.GetAuthor()
 }
 
-func TestRepository_GetContentsURL(tt *testing.T) {
+func TestRepositoryContentFileOptions_GetBranch(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ContentsURL: &zeroValue}
-	r.GetContentsURL()
-	r = &Repository{}
-	r.GetContentsURL()
+	r := &RepositoryContentFileOptions{Branch: &zeroValue}
+	r.GetBranch()
+	r = &RepositoryContentFileOptions{}
+	r.GetBranch()
 	r = nil
-	r.GetContentsURL()
+	r.GetBranch()
 }
 
-func TestRepository_GetContributorsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{ContributorsURL: &zeroValue}
-	r.GetContributorsURL()
-	r = &Repository{}
-	r.GetContributorsURL()
+func TestRepositoryContentFileOptions_GetCommitter(tt *testing.T) {
+	r := &RepositoryContentFileOptions{}
+	r.GetCommitter()
 	r = nil
-	r.GetContributorsURL()
+	r.GetCommitter()
 }
 
-func TestRepository_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &Repository{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &Repository{}
-	r.GetCreatedAt()
+func TestRepositoryContentFileOptions_GetMessage(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContentFileOptions{Message: &zeroValue}
+	r.GetMessage()
+	r = &RepositoryContentFileOptions{}
+	r.GetMessage()
 	r = nil
-	r.GetCreatedAt()
+	r.GetMessage()
 }
 
-func TestRepository_GetDefaultBranch(tt *testing.T) {
+func TestRepositoryContentFileOptions_GetSHA(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{DefaultBranch: &zeroValue}
-	r.GetDefaultBranch()
-	r = &Repository{}
-	r.GetDefaultBranch()
+	r := &RepositoryContentFileOptions{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryContentFileOptions{}
+	r.GetSHA()
 	r = nil
-	r.GetDefaultBranch()
+	r.GetSHA()
 }
 
-func TestRepository_GetDeleteBranchOnMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{DeleteBranchOnMerge: &zeroValue}
-	r.GetDeleteBranchOnMerge()
-	r = &Repository{}
-	r.GetDeleteBranchOnMerge()
+func TestRepositoryContentResponse_GetContent(tt *testing.T) {
+	r := &RepositoryContentResponse{}
+	r.GetContent()
 	r = nil
-	r.GetDeleteBranchOnMerge()
+	r.GetContent()
 }
 
-func TestRepository_GetDeploymentsURL(tt *testing.T) {
+func TestRepositoryDispatchEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{DeploymentsURL: &zeroValue}
-	r.GetDeploymentsURL()
-	r = &Repository{}
-	r.GetDeploymentsURL()
+	r := &RepositoryDispatchEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryDispatchEvent{}
+	r.GetAction()
+	r = nil
+	r.GetAction()
+}
+
+func TestRepositoryDispatchEvent_GetBranch(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryDispatchEvent{Branch: &zeroValue}
+	r.GetBranch()
+	r = &RepositoryDispatchEvent{}
+	r.GetBranch()
+	r = nil
+	r.GetBranch()
+}
+
+func TestRepositoryDispatchEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetDeploymentsURL()
+	r.GetInstallation()
 }
 
-func TestRepository_GetDescription(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{Description: &zeroValue}
-	r.GetDescription()
-	r = &Repository{}
-	r.GetDescription()
+func TestRepositoryDispatchEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetDescription()
+	r.GetOrg()
 }
 
-func TestRepository_GetDisabled(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Disabled: &zeroValue}
-	r.GetDisabled()
-	r = &Repository{}
-	r.GetDisabled()
+func TestRepositoryDispatchEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetDisabled()
+	r.GetRepo()
 }
 
-func TestRepository_GetDownloadsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{DownloadsURL: &zeroValue}
-	r.GetDownloadsURL()
-	r = &Repository{}
-	r.GetDownloadsURL()
+func TestRepositoryDispatchEvent_GetSender(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetSender()
 	r = nil
-	r.GetDownloadsURL()
+	r.GetSender()
 }
 
-func TestRepository_GetEventsURL(tt *testing.T) {
+func TestRepositoryEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{EventsURL: &zeroValue}
-	r.GetEventsURL()
-	r = &Repository{}
-	r.GetEventsURL()
+	r := &RepositoryEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryEvent{}
+	r.GetAction()
 	r = nil
-	r.GetEventsURL()
+	r.GetAction()
 }
 
-func TestRepository_GetFork(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Fork: &zeroValue}
-	r.GetFork()
-	r = &Repository{}
-	r.GetFork()
+func TestRepositoryEvent_GetChanges(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetChanges()
 	r = nil
-	r.GetFork()
+	r.GetChanges()
 }
 
-func TestRepository_GetForksCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{ForksCount: &zeroValue}
-	r.GetForksCount()
-	r = &Repository{}
-	r.GetForksCount()
+func TestRepositoryEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetForksCount()
+	r.GetInstallation()
 }
 
-func TestRepository_GetForksURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{ForksURL: &zeroValue}
-	r.GetForksURL()
-	r = &Repository{}
-	r.GetForksURL()
+func TestRepositoryEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetForksURL()
+	r.GetOrg()
 }
 
-func TestRepository_GetFullName(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{FullName: &zeroValue}
-	r.GetFullName()
-	r = &Repository{}
-	r.GetFullName()
+func TestRepositoryEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetFullName()
+	r.GetRepo()
 }
 
-func TestRepository_GetGitCommitsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitCommitsURL: &zeroValue}
-	r.GetGitCommitsURL()
-	r = &Repository{}
-	r.GetGitCommitsURL()
+func TestRepositoryEvent_GetSender(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetSender()
 	r = nil
-	r.GetGitCommitsURL()
+	r.GetSender()
 }
 
-func TestRepository_GetGitignoreTemplate(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitignoreTemplate: &zeroValue}
-	r.GetGitignoreTemplate()
-	r = &Repository{}
-	r.GetGitignoreTemplate()
+func TestRepositoryImportEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryImportEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetGitignoreTemplate()
+	r.GetInstallation()
 }
 
-func TestRepository_GetGitRefsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitRefsURL: &zeroValue}
-	r.GetGitRefsURL()
-	r = &Repository{}
-	r.GetGitRefsURL()
+func TestRepositoryImportEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryImportEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetGitRefsURL()
+	r.GetOrg()
 }
 
-func TestRepository_GetGitTagsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitTagsURL: &zeroValue}
-	r.GetGitTagsURL()
-	r = &Repository{}
-	r.GetGitTagsURL()
+func TestRepositoryImportEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryImportEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetGitTagsURL()
+	r.GetRepo()
 }
 
-func TestRepository_GetGitURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &Repository{}
-	r.GetGitURL()
+func TestRepositoryImportEvent_GetSender(tt *testing.T) {
+	r := &RepositoryImportEvent{}
+	r.GetSender()
 	r = nil
-	r.GetGitURL()
+	r.GetSender()
 }
 
-func TestRepository_GetHasDiscussions(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasDiscussions: &zeroValue}
-	r.GetHasDiscussions()
-	r = &Repository{}
-	r.GetHasDiscussions()
+func TestRepositoryImportEvent_GetStatus(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryImportEvent{Status: &zeroValue}
+	r.GetStatus()
+	r = &RepositoryImportEvent{}
+	r.GetStatus()
 	r = nil
-	r.GetHasDiscussions()
+	r.GetStatus()
 }
 
-func TestRepository_GetHasDownloads(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasDownloads: &zeroValue}
-	r.GetHasDownloads()
-	r = &Repository{}
-	r.GetHasDownloads()
+func TestRepositoryInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryInvitation{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryInvitation{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetHasDownloads()
+	r.GetCreatedAt()
 }
 
-func TestRepository_GetHasIssues(tt *testing.T) {
+func TestRepositoryInvitation_GetExpired(tt *testing.T) {
 	var zeroValue bool
-	r := &Repository{HasIssues: &zeroValue}
-	r.GetHasIssues()
-	r = &Repository{}
-	r.GetHasIssues()
+	r := &RepositoryInvitation{Expired: &zeroValue}
+	r.GetExpired()
+	r = &RepositoryInvitation{}
+	r.GetExpired()
 	r = nil
-	r.GetHasIssues()
+	r.GetExpired()
 }
 
-func TestRepository_GetHasPages(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasPages: &zeroValue}
-	r.GetHasPages()
-	r = &Repository{}
-	r.GetHasPages()
+func TestRepositoryInvitation_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryInvitation{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryInvitation{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetHasPages()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetHasProjects(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasProjects: &zeroValue}
-	r.GetHasProjects()
-	r = &Repository{}
-	r.GetHasProjects()
+func TestRepositoryInvitation_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryInvitation{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryInvitation{}
+	r.GetID()
 	r = nil
-	r.GetHasProjects()
+	r.GetID()
 }
 
-func TestRepository_GetHasWiki(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasWiki: &zeroValue}
-	r.GetHasWiki()
-	r = &Repository{}
-	r.GetHasWiki()
+func TestRepositoryInvitation_GetInvitee(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetInvitee()
 	r = nil
-	r.GetHasWiki()
+	r.GetInvitee()
 }
 
-func TestRepository_GetHomepage(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{Homepage: &zeroValue}
-	r.GetHomepage()
-	r = &Repository{}
-	r.GetHomepage()
+func TestRepositoryInvitation_GetInviter(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetInviter()
 	r = nil
-	r.GetHomepage()
+	r.GetInviter()
 }
 
-func TestRepository_GetHooksURL(tt *testing.T) {
+func TestRepositoryInvitation_GetPermissions(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{HooksURL: &zeroValue}
-	r.GetHooksURL()
-	r = &Repository{}
-	r.GetHooksURL()
+	r := &RepositoryInvitation{Permissions: &zeroValue}
+	r.GetPermissions()
+	r = &RepositoryInvitation{}
+	r.GetPermissions()
 	r = nil
-	r.GetHooksURL()
+	r.GetPermissions()
 }
 
-func TestRepository_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &Repository{}
-	r.GetHTMLURL()
+func TestRepositoryInvitation_GetRepo(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetRepo()
 	r = nil
-	r.GetHTMLURL()
+	r.GetRepo()
 }
 
-func TestRepository_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &Repository{ID: &zeroValue}
-	r.GetID()
-	r = &Repository{}
-	r.GetID()
+func TestRepositoryInvitation_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryInvitation{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryInvitation{}
+	r.GetURL()
 	r = nil
-	r.GetID()
+	r.GetURL()
 }
 
-func TestRepository_GetIssueCommentURL(tt *testing.T) {
+func TestRepositoryLicense_GetContent(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssueCommentURL: &zeroValue}
-	r.GetIssueCommentURL()
-	r = &Repository{}
-	r.GetIssueCommentURL()
+	r := &RepositoryLicense{Content: &zeroValue}
+	r.GetContent()
+	r = &RepositoryLicense{}
+	r.GetContent()
 	r = nil
-	r.GetIssueCommentURL()
+	r.GetContent()
 }
 
-func TestRepository_GetIssueEventsURL(tt *testing.T) {
+func TestRepositoryLicense_GetDownloadURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssueEventsURL: &zeroValue}
-	r.GetIssueEventsURL()
-	r = &Repository{}
-	r.GetIssueEventsURL()
+	r := &RepositoryLicense{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RepositoryLicense{}
+	r.GetDownloadURL()
 	r = nil
-	r.GetIssueEventsURL()
+	r.GetDownloadURL()
 }
 
-func TestRepository_GetIssuesURL(tt *testing.T) {
+func TestRepositoryLicense_GetEncoding(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssuesURL: &zeroValue}
-	r.GetIssuesURL()
-	r = &Repository{}
-	r.GetIssuesURL()
+	r := &RepositoryLicense{Encoding: &zeroValue}
+	r.GetEncoding()
+	r = &RepositoryLicense{}
+	r.GetEncoding()
 	r = nil
-	r.GetIssuesURL()
+	r.GetEncoding()
 }
 
-func TestRepository_GetIsTemplate(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{IsTemplate: &zeroValue}
-	r.GetIsTemplate()
-	r = &Repository{}
-	r.GetIsTemplate()
+func TestRepositoryLicense_GetGitURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryLicense{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &RepositoryLicense{}
+	r.GetGitURL()
 	r = nil
-	r.GetIsTemplate()
+	r.GetGitURL()
 }
 
-func TestRepository_GetKeysURL(tt *testing.T) {
+func TestRepositoryLicense_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{KeysURL: &zeroValue}
-	r.GetKeysURL()
-	r = &Repository{}
-	r.GetKeysURL()
+	r := &RepositoryLicense{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryLicense{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetKeysURL()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetLabelsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{LabelsURL: &zeroValue}
-	r.GetLabelsURL()
-	r = &Repository{}
-	r.GetLabelsURL()
+func TestRepositoryLicense_GetLicense(tt *testing.T) {
+	r := &RepositoryLicense{}
+	r.GetLicense()
 	r = nil
-	r.GetLabelsURL()
+	r.GetLicense()
 }
 
-func TestRepository_GetLanguage(tt *testing.T) {
+func TestRepositoryLicense_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Language: &zeroValue}
-	r.GetLanguage()
-	r = &Repository{}
-	r.GetLanguage()
+	r := &RepositoryLicense{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryLicense{}
+	r.GetName()
 	r = nil
-	r.GetLanguage()
+	r.GetName()
 }
 
-func TestRepository_GetLanguagesURL(tt *testing.T) {
+func TestRepositoryLicense_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{LanguagesURL: &zeroValue}
-	r.GetLanguagesURL()
-	r = &Repository{}
-	r.GetLanguagesURL()
+	r := &RepositoryLicense{Path: &zeroValue}
+	r.GetPath()
+	r = &RepositoryLicense{}
+	r.GetPath()
 	r = nil
-	r.GetLanguagesURL()
+	r.GetPath()
 }
 
-func TestRepository_GetLicense(tt *testing.T) {
-	r := &Repository{}
-	r.GetLicense()
+func TestRepositoryLicense_GetSHA(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryLicense{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryLicense{}
+	r.GetSHA()
 	r = nil
-	r.GetLicense()
+	r.GetSHA()
 }
 
-func TestRepository_GetLicenseTemplate(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{LicenseTemplate: &zeroValue}
-	r.GetLicenseTemplate()
-	r = &Repository{}
-	r.GetLicenseTemplate()
+func TestRepositoryLicense_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoryLicense{Size: &zeroValue}
+	r.GetSize()
+	r = &RepositoryLicense{}
+	r.GetSize()
 	r = nil
-	r.GetLicenseTemplate()
+	r.GetSize()
 }
 
-func TestRepository_GetMasterBranch(tt *testing.T) {
+func TestRepositoryLicense_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MasterBranch: &zeroValue}
-	r.GetMasterBranch()
-	r = &Repository{}
-	r.GetMasterBranch()
+	r := &RepositoryLicense{Type: &zeroValue}
+	r.GetType()
+	r = &RepositoryLicense{}
+	r.GetType()
 	r = nil
-	r.GetMasterBranch()
+	r.GetType()
 }
 
-func TestRepository_GetMergeCommitMessage(tt *testing.T) {
+func TestRepositoryLicense_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MergeCommitMessage: &zeroValue}
-	r.GetMergeCommitMessage()
-	r = &Repository{}
-	r.GetMergeCommitMessage()
+	r := &RepositoryLicense{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryLicense{}
+	r.GetURL()
 	r = nil
-	r.GetMergeCommitMessage()
+	r.GetURL()
 }
 
-func TestRepository_GetMergeCommitTitle(tt *testing.T) {
+func TestRepositoryMergeRequest_GetBase(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MergeCommitTitle: &zeroValue}
-	r.GetMergeCommitTitle()
-	r = &Repository{}
-	r.GetMergeCommitTitle()
+	r := &RepositoryMergeRequest{Base: &zeroValue}
+	r.GetBase()
+	r = &RepositoryMergeRequest{}
+	r.GetBase()
 	r = nil
-	r.GetMergeCommitTitle()
+	r.GetBase()
 }
 
-func TestRepository_GetMergesURL(tt *testing.T) {
+func TestRepositoryMergeRequest_GetCommitMessage(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MergesURL: &zeroValue}
-	r.GetMergesURL()
-	r = &Repository{}
-	r.GetMergesURL()
+	r := &RepositoryMergeRequest{CommitMessage: &zeroValue}
+	r.GetCommitMessage()
+	r = &RepositoryMergeRequest{}
+	r.GetCommitMessage()
 	r = nil
-	r.GetMergesURL()
+	r.GetCommitMessage()
 }
 
-func TestRepository_GetMilestonesURL(tt *testing.T) {
+func TestRepositoryMergeRequest_GetHead(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MilestonesURL: &zeroValue}
-	r.GetMilestonesURL()
-	r = &Repository{}
-	r.GetMilestonesURL()
+	r := &RepositoryMergeRequest{Head: &zeroValue}
+	r.GetHead()
+	r = &RepositoryMergeRequest{}
+	r.GetHead()
 	r = nil
-	r.GetMilestonesURL()
+	r.GetHead()
 }
 
-func TestRepository_GetMirrorURL(tt *testing.T) {
+func TestRepositoryMetadataChange_GetNewDescription(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MirrorURL: &zeroValue}
-	r.GetMirrorURL()
-	r = &Repository{}
-	r.GetMirrorURL()
+	r := &RepositoryMetadataChange{NewDescription: &zeroValue}
+	r.GetNewDescription()
+	r = &RepositoryMetadataChange{}
+	r.GetNewDescription()
 	r = nil
-	r.GetMirrorURL()
+	r.GetNewDescription()
 }
 
-func TestRepository_GetName(tt *testing.T) {
+func TestRepositoryMetadataChange_GetOldDescription(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Name: &zeroValue}
-	r.GetName()
-	r = &Repository{}
-	r.GetName()
+	r := &RepositoryMetadataChange{OldDescription: &zeroValue}
+	r.GetOldDescription()
+	r = &RepositoryMetadataChange{}
+	r.GetOldDescription()
 	r = nil
-	r.GetName()
+	r.GetOldDescription()
 }
 
-func TestRepository_GetNetworkCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{NetworkCount: &zeroValue}
-	r.GetNetworkCount()
-	r = &Repository{}
-	r.GetNetworkCount()
+func TestRepositoryMetadataChange_GetRepository(tt *testing.T) {
+	r := &RepositoryMetadataChange{}
+	r.GetRepository()
 	r = nil
-	r.GetNetworkCount()
+	r.GetRepository()
 }
 
-func TestRepository_GetNodeID(tt *testing.T) {
+func TestRepositoryPermissionLevel_GetPermission(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Repository{}
-	r.GetNodeID()
+	r := &RepositoryPermissionLevel{Permission: &zeroValue}
+	r.GetPermission()
+	r = &RepositoryPermissionLevel{}
+	r.GetPermission()
 	r = nil
-	r.GetNodeID()
+	r.GetPermission()
 }
 
-func TestRepository_GetNotificationsURL(tt *testing.T) {
+func TestRepositoryPermissionLevel_GetRoleName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{NotificationsURL: &zeroValue}
-	r.GetNotificationsURL()
-	r = &Repository{}
-	r.GetNotificationsURL()
+	r := &RepositoryPermissionLevel{RoleName: &zeroValue}
+	r.GetRoleName()
+	r = &RepositoryPermissionLevel{}
+	r.GetRoleName()
 	r = nil
-	r.GetNotificationsURL()
+	r.GetRoleName()
 }
 
-func TestRepository_GetOpenIssues(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{OpenIssues: &zeroValue}
-	r.GetOpenIssues()
-	r = &Repository{}
-	r.GetOpenIssues()
+func TestRepositoryPermissionLevel_GetUser(tt *testing.T) {
+	r := &RepositoryPermissionLevel{}
+	r.GetUser()
+	r = nil
+	r.GetUser()
+}
+
+func TestRepositoryRelease_GetAssetsURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{AssetsURL: &zeroValue}
+	r.GetAssetsURL()
+	r = &RepositoryRelease{}
+	r.GetAssetsURL()
 	r = nil
-	r.GetOpenIssues()
+	r.GetAssetsURL()
 }
 
-func TestRepository_GetOpenIssuesCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{OpenIssuesCount: &zeroValue}
-	r.GetOpenIssuesCount()
-	r = &Repository{}
-	r.GetOpenIssuesCount()
+func TestRepositoryRelease_GetAuthor(tt *testing.T) {
+	r := &RepositoryRelease{}
+	r.GetAuthor()
 	r = nil
-	r.GetOpenIssuesCount()
+	r.GetAuthor()
 }
 
-func TestRepository_GetOrganization(tt *testing.T) {
-	r := &Repository{}
-	r.GetOrganization()
+func TestRepositoryRelease_GetBody(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{Body: &zeroValue}
+	r.GetBody()
+	r = &RepositoryRelease{}
+	r.GetBody()
 	r = nil
-	r.GetOrganization()
+	r.GetBody()
 }
 
-func TestRepository_GetOwner(tt *testing.T) {
-	r := &Repository{}
-	r.GetOwner()
+func TestRepositoryRelease_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryRelease{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryRelease{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetOwner()
+	r.GetCreatedAt()
 }
 
-func TestRepository_GetParent(tt *testing.T) {
-	r := &Repository{}
-	r.GetParent()
+func TestRepositoryRelease_GetDiscussionCategoryName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{DiscussionCategoryName: &zeroValue}
+	r.GetDiscussionCategoryName()
+	r = &RepositoryRelease{}
+	r.GetDiscussionCategoryName()
 	r = nil
-	r.GetParent()
+	r.GetDiscussionCategoryName()
 }
 
-func TestRepository_GetPermissions(tt *testing.T) {
-	zeroValue := map[string]bool{}
-	r := &Repository{Permissions: zeroValue}
-	r.GetPermissions()
-	r = &Repository{}
-	r.GetPermissions()
+func TestRepositoryRelease_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoryRelease{Draft: &zeroValue}
+	r.GetDraft()
+	r = &RepositoryRelease{}
+	r.GetDraft()
 	r = nil
-	r.GetPermissions()
+	r.GetDraft()
 }
 
-func TestRepository_GetPrivate(tt *testing.T) {
+func TestRepositoryRelease_GetGenerateReleaseNotes(tt *testing.T) {
 	var zeroValue bool
-	r := &Repository{Private: &zeroValue}
-	r.GetPrivate()
-	r = &Repository{}
-	r.GetPrivate()
+	r := &RepositoryRelease{GenerateReleaseNotes: &zeroValue}
+	r.GetGenerateReleaseNotes()
+	r = &RepositoryRelease{}
+	r.GetGenerateReleaseNotes()
 	r = nil
-	r.GetPrivate()
+	r.GetGenerateReleaseNotes()
 }
 
-func TestRepository_GetPullsURL(tt *testing.T) {
+func TestRepositoryRelease_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{PullsURL: &zeroValue}
-	r.GetPullsURL()
-	r = &Repository{}
-	r.GetPullsURL()
+	r := &RepositoryRelease{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryRelease{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetPullsURL()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetPushedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &Repository{PushedAt: &zeroValue}
-	r.GetPushedAt()
-	r = &Repository{}
-	r.GetPushedAt()
+func TestRepositoryRelease_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryRelease{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryRelease{}
+	r.GetID()
 	r = nil
-	r.GetPushedAt()
+	r.GetID()
 }
 
-func TestRepository_GetReleasesURL(tt *testing.T) {
+func TestRepositoryRelease_GetMakeLatest(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ReleasesURL: &zeroValue}
-	r.GetReleasesURL()
-	r = &Repository{}
-	r.GetReleasesURL()
+	r := &RepositoryRelease{MakeLatest: &zeroValue}
+	r.GetMakeLatest()
+	r = &RepositoryRelease{}
+	r.GetMakeLatest()
 	r = nil
-	r.GetReleasesURL()
+	r.GetMakeLatest()
 }
 
-func TestRepository_GetRoleName(tt *testing.T) {
+func TestRepositoryRelease_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{RoleName: &zeroValue}
-	r.GetRoleName()
-	r = &Repository{}
-	r.GetRoleName()
+	r := &RepositoryRelease{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryRelease{}
+	r.GetName()
 	r = nil
-	r.GetRoleName()
+	r.GetName()
 }
 
-func TestRepository_GetSecurityAndAnalysis(tt *testing.T) {
-	r := &Repository{}
-	r.GetSecurityAndAnalysis()
+func TestRepositoryRelease_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryRelease{}
+	r.GetNodeID()
 	r = nil
-	r.GetSecurityAndAnalysis()
+	r.GetNodeID()
 }
 
-func TestRepository_GetSize(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{Size: &zeroValue}
-	r.GetSize()
-	r = &Repository{}
-	r.GetSize()
+func TestRepositoryRelease_GetPrerelease(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoryRelease{Prerelease: &zeroValue}
+	r.GetPrerelease()
+	r = &RepositoryRelease{}
+	r.GetPrerelease()
 	r = nil
-	r.GetSize()
+	r.GetPrerelease()
 }
 
-func TestRepository_GetSource(tt *testing.T) {
-	r := &Repository{}
-	r.GetSource()
+func TestRepositoryRelease_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryRelease{PublishedAt: &zeroValue}
+	r.GetPublishedAt()
+	r = &RepositoryRelease{}
+	r.GetPublishedAt()
 	r = nil
-	r.GetSource()
+	r.GetPublishedAt()
 }
 
-func TestRepository_GetSquashMergeCommitMessage(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{SquashMergeCommitMessage: &zeroValue}
-	r.GetSquashMergeCommitMessage()
-	r = &Repository{}
-	r.GetSquashMergeCommitMessage()
+func TestRepositoryRelease_GetReactions(tt *testing.T) {
+	r := &RepositoryRelease{}
+	r.GetReactions()
 	r = nil
-	r.GetSquashMergeCommitMessage()
+	r.GetReactions()
 }
 
-func TestRepository_GetSquashMergeCommitTitle(tt *testing.T) {
+func TestRepositoryRelease_GetTagName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SquashMergeCommitTitle: &zeroValue}
-	r.GetSquashMergeCommitTitle()
-	r = &Repository{}
-	r.GetSquashMergeCommitTitle()
+	r := &RepositoryRelease{TagName: &zeroValue}
+	r.GetTagName()
+	r = &RepositoryRelease{}
+	r.GetTagName()
 	r = nil
-	r.GetSquashMergeCommitTitle()
+	r.GetTagName()
 }
 
-func TestRepository_GetSSHURL(tt *testing.T) {
+func TestRepositoryRelease_GetTarballURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SSHURL: &zeroValue}
-	r.GetSSHURL()
-	r = &Repository{}
-	r.GetSSHURL()
-	r = nil
-	r.GetSSHURL()
-}
-
-func TestRepository_GetStargazersCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{StargazersCount: &zeroValue}
-	r.GetStargazersCount()
-	r = &Repository{}
-	r.GetStargazersCount()
+	r := &RepositoryRelease{TarballURL: &zeroValue}
+	r.GetTarballURL()
+	r = &RepositoryRelease{}
+	r.GetTarballURL()
 	r = nil
-	r.GetStargazersCount()
+	r.GetTarballURL()
 }
 
-func TestRepository_GetStargazersURL(tt *testing.T) {
+func TestRepositoryRelease_GetTargetCommitish(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{StargazersURL: &zeroValue}
-	r.GetStargazersURL()
-	r = &Repository{}
-	r.GetStargazersURL()
+	r := &RepositoryRelease{TargetCommitish: &zeroValue}
+	r.GetTargetCommitish()
+	r = &RepositoryRelease{}
+	r.GetTargetCommitish()
 	r = nil
-	r.GetStargazersURL()
+	r.GetTargetCommitish()
 }
 
-func TestRepository_GetStatusesURL(tt *testing.T) {
+func TestRepositoryRelease_GetUploadURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{StatusesURL: &zeroValue}
-	r.GetStatusesURL()
-	r = &Repository{}
-	r.GetStatusesURL()
+	r := &RepositoryRelease{UploadURL: &zeroValue}
+	r.GetUploadURL()
+	r = &RepositoryRelease{}
+	r.GetUploadURL()
 	r = nil
-	r.GetStatusesURL()
+	r.GetUploadURL()
 }
 
-func TestRepository_GetSubscribersCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{SubscribersCount: &zeroValue}
-	r.GetSubscribersCount()
-	r = &Repository{}
-	r.GetSubscribersCount()
+func TestRepositoryRelease_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryRelease{}
+	r.GetURL()
 	r = nil
-	r.GetSubscribersCount()
+	r.GetURL()
 }
 
-func TestRepository_GetSubscribersURL(tt *testing.T) {
+func TestRepositoryRelease_GetZipballURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SubscribersURL: &zeroValue}
-	r.GetSubscribersURL()
-	r = &Repository{}
-	r.GetSubscribersURL()
+	r := &RepositoryRelease{ZipballURL: &zeroValue}
+	r.GetZipballURL()
+	r = &RepositoryRelease{}
+	r.GetZipballURL()
 	r = nil
-	r.GetSubscribersURL()
+	r.GetZipballURL()
 }
 
-func TestRepository_GetSubscriptionURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{SubscriptionURL: &zeroValue}
-	r.GetSubscriptionURL()
-	r = &Repository{}
-	r.GetSubscriptionURL()
+func TestRepositoryRule_GetParameters(tt *testing.T) {
+	var zeroValue json.RawMessage
+	r := &RepositoryRule{Parameters: &zeroValue}
+	r.GetParameters()
+	r = &RepositoryRule{}
+	r.GetParameters()
 	r = nil
-	r.GetSubscriptionURL()
+	r.GetParameters()
 }
 
-func TestRepository_GetSVNURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{SVNURL: &zeroValue}
-	r.GetSVNURL()
-	r = &Repository{}
-	r.GetSVNURL()
+func TestRepositoryRuleset_GetConditions(tt *testing.T) {
+	r := &RepositoryRuleset{}
+	r.GetConditions()
 	r = nil
-	r.GetSVNURL()
+	r.GetConditions()
 }
 
-func TestRepository_GetTagsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{TagsURL: &zeroValue}
-	r.GetTagsURL()
-	r = &Repository{}
-	r.GetTagsURL()
+func TestRepositoryRuleset_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryRuleset{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryRuleset{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetTagsURL()
+	r.GetCreatedAt()
 }
 
-func TestRepository_GetTeamID(tt *testing.T) {
-	var zeroValue int64
-	r := &Repository{TeamID: &zeroValue}
-	r.GetTeamID()
-	r = &Repository{}
-	r.GetTeamID()
+func TestRepositoryRuleset_GetCurrentUserCanBypass(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRuleset{CurrentUserCanBypass: &zeroValue}
+	r.GetCurrentUserCanBypass()
+	r = &RepositoryRuleset{}
+	r.GetCurrentUserCanBypass()
 	r = nil
-	r.GetTeamID()
+	r.GetCurrentUserCanBypass()
 }
 
-func TestRepository_GetTeamsURL(tt *testing.T) {
+func TestRepositoryRuleset_GetEnforcement(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{TeamsURL: &zeroValue}
-	r.GetTeamsURL()
-	r = &Repository{}
-	r.GetTeamsURL()
+	r := &RepositoryRuleset{Enforcement: &zeroValue}
+	r.GetEnforcement()
+	r = &RepositoryRuleset{}
+	r.GetEnforcement()
 	r = nil
-	r.GetTeamsURL()
+	r.GetEnforcement()
 }
 
-func TestRepository_GetTemplateRepository(tt *testing.T) {
-	r := &Repository{}
-	r.GetTemplateRepository()
+func TestRepositoryRuleset_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryRuleset{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryRuleset{}
+	r.GetID()
 	r = nil
-	r.GetTemplateRepository()
+	r.GetID()
 }
 
-func TestRepository_GetTreesURL(tt *testing.T) {
+func TestRepositoryRuleset_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{TreesURL: &zeroValue}
-	r.GetTreesURL()
-	r = &Repository{}
-	r.GetTreesURL()
+	r := &RepositoryRuleset{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryRuleset{}
+	r.GetName()
 	r = nil
-	r.GetTreesURL()
+	r.GetName()
 }
 
-func TestRepository_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &Repository{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &Repository{}
-	r.GetUpdatedAt()
+func TestRepositoryRuleset_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRuleset{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryRuleset{}
+	r.GetNodeID()
 	r = nil
-	r.GetUpdatedAt()
+	r.GetNodeID()
 }
 
-func TestRepository_GetURL(tt *testing.T) {
+func TestRepositoryRuleset_GetSource(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{URL: &zeroValue}
-	r.GetURL()
-	r = &Repository{}
-	r.GetURL()
+	r := &RepositoryRuleset{Source: &zeroValue}
+	r.GetSource()
+	r = &RepositoryRuleset{}
+	r.GetSource()
 	r = nil
-	r.GetURL()
+	r.GetSource()
 }
 
-func TestRepository_GetUseSquashPRTitleAsDefault(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{UseSquashPRTitleAsDefault: &zeroValue}
-	r.GetUseSquashPRTitleAsDefault()
-	r = &Repository{}
-	r.GetUseSquashPRTitleAsDefault()
+func TestRepositoryRuleset_GetSourceType(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRuleset{SourceType: &zeroValue}
+	r.GetSourceType()
+	r = &RepositoryRuleset{}
+	r.GetSourceType()
 	r = nil
-	r.GetUseSquashPRTitleAsDefault()
+	r.GetSourceType()
 }
 
-func TestRepository_GetVisibility(tt *testing.T) {
+func TestRepositoryRuleset_GetTarget(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Visibility: &zeroValue}
-	r.GetVisibility()
-	r = &Repository{}
-	r.GetVisibility()
+	r := &RepositoryRuleset{Target: &zeroValue}
+	r.GetTarget()
+	r = &RepositoryRuleset{}
+	r.GetTarget()
 	r = nil
-	r.GetVisibility()
+	r.GetTarget()
 }
 
-func TestRepository_GetWatchers(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{Watchers: &zeroValue}
-	r.GetWatchers()
-	r = &Repository{}
-	r.GetWatchers()
+func TestRepositoryRuleset_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryRuleset{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &RepositoryRuleset{}
+	r.GetUpdatedAt()
 	r = nil
-	r.GetWatchers()
+	r.GetUpdatedAt()
 }
 
-func TestRepository_GetWatchersCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{WatchersCount: &zeroValue}
-	r.GetWatchersCount()
-	r = &Repository{}
-	r.GetWatchersCount()
+func TestRepositoryRulesetChanges_GetConditions(tt *testing.T) {
+	var zeroValue json.RawMessage
+	r := &RepositoryRulesetChanges{Conditions: &zeroValue}
+	r.GetConditions()
+	r = &RepositoryRulesetChanges{}
+	r.GetConditions()
 	r = nil
-	r.GetWatchersCount()
+	r.GetConditions()
 }
 
-func TestRepository_GetWebCommitSignoffRequired(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{WebCommitSignoffRequired: &zeroValue}
-	r.GetWebCommitSignoffRequired()
-	r = &Repository{}
-	r.GetWebCommitSignoffRequired()
+func TestRepositoryRulesetChanges_GetEnforcement(tt *testing.T) {
+	r := &RepositoryRulesetChanges{}
+	r.GetEnforcement()
 	r = nil
-	r.GetWebCommitSignoffRequired()
+	r.GetEnforcement()
 }
 
-func TestRepositoryActionsAccessLevel_GetAccessLevel(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryActionsAccessLevel{AccessLevel: &zeroValue}
-	r.GetAccessLevel()
-	r = &RepositoryActionsAccessLevel{}
-	r.GetAccessLevel()
+func TestRepositoryRulesetChanges_GetName(tt *testing.T) {
+	r := &RepositoryRulesetChanges{}
+	r.GetName()
 	r = nil
-	r.GetAccessLevel()
+	r.GetName()
 }
 
-func TestRepositoryActiveCommitters_GetAdvancedSecurityCommitters(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoryActiveCommitters{AdvancedSecurityCommitters: &zeroValue}
-	r.GetAdvancedSecurityCommitters()
-	r = &RepositoryActiveCommitters{}
-	r.GetAdvancedSecurityCommitters()
+func TestRepositoryRulesetChanges_GetRules(tt *testing.T) {
+	var zeroValue json.RawMessage
+	r := &RepositoryRulesetChanges{Rules: &zeroValue}
+	r.GetRules()
+	r = &RepositoryRulesetChanges{}
+	r.GetRules()
 	r = nil
-	r.GetAdvancedSecurityCommitters()
+	r.GetRules()
 }
 
-func TestRepositoryActiveCommitters_GetName(tt *testing.T) {
+func TestRepositoryRulesetChangeSource_GetFrom(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryActiveCommitters{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryActiveCommitters{}
-	r.GetName()
+	r := &RepositoryRulesetChangeSource{From: &zeroValue}
+	r.GetFrom()
+	r = &RepositoryRulesetChangeSource{}
+	r.GetFrom()
 	r = nil
-	r.GetName()
+	r.GetFrom()
 }
 
-func TestRepositoryComment_GetBody(tt *testing.T) {
+func TestRepositoryRulesetEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{Body: &zeroValue}
-	r.GetBody()
-	r = &RepositoryComment{}
-	r.GetBody()
+	r := &RepositoryRulesetEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryRulesetEvent{}
+	r.GetAction()
 	r = nil
-	r.GetBody()
+	r.GetAction()
 }
 
-func TestRepositoryComment_GetCommitID(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryComment{CommitID: &zeroValue}
-	r.GetCommitID()
-	r = &RepositoryComment{}
-	r.GetCommitID()
+func TestRepositoryRulesetEvent_GetChanges(tt *testing.T) {
+	r := &RepositoryRulesetEvent{}
+	r.GetChanges()
 	r = nil
-	r.GetCommitID()
+	r.GetChanges()
 }
 
-func TestRepositoryComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryComment{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryComment{}
-	r.GetCreatedAt()
+func TestRepositoryRulesetEvent_GetEnterprise(tt *testing.T) {
+	r := &RepositoryRulesetEvent{}
+	r.GetEnterprise()
 	r = nil
-	r.GetCreatedAt()
+	r.GetEnterprise()
 }
 
-func TestRepositoryComment_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryComment{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryComment{}
-	r.GetHTMLURL()
+func TestRepositoryRulesetEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryRulesetEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetHTMLURL()
+	r.GetInstallation()
 }
 
-func TestRepositoryComment_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepositoryComment{ID: &zeroValue}
-	r.GetID()
-	r = &RepositoryComment{}
-	r.GetID()
+func TestRepositoryRulesetEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryRulesetEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetID()
+	r.GetOrg()
 }
 
-func TestRepositoryComment_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryComment{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepositoryComment{}
-	r.GetNodeID()
+func TestRepositoryRulesetEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryRulesetEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetNodeID()
+	r.GetRepo()
 }
 
-func TestRepositoryComment_GetPath(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryComment{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryComment{}
-	r.GetPath()
+func TestRepositoryRulesetEvent_GetRepositoryRuleset(tt *testing.T) {
+	r := &RepositoryRulesetEvent{}
+	r.GetRepositoryRuleset()
 	r = nil
-	r.GetPath()
+	r.GetRepositoryRuleset()
 }
 
-func TestRepositoryComment_GetPosition(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoryComment{Position: &zeroValue}
-	r.GetPosition()
-	r = &RepositoryComment{}
-	r.GetPosition()
+func TestRepositoryRulesetEvent_GetSender(tt *testing.T) {
+	r := &RepositoryRulesetEvent{}
+	r.GetSender()
 	r = nil
-	r.GetPosition()
+	r.GetSender()
 }
 
-func TestRepositoryComment_GetReactions(tt *testing.T) {
-	r := &RepositoryComment{}
-	r.GetReactions()
+func TestRepositoryTag_GetCommit(tt *testing.T) {
+	r := &RepositoryTag{}
+	r.GetCommit()
 	r = nil
-	r.GetReactions()
+	r.GetCommit()
 }
 
-func TestRepositoryComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryComment{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &RepositoryComment{}
-	r.GetUpdatedAt()
+func TestRepositoryTag_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryTag{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryTag{}
+	r.GetName()
 	r = nil
-	r.GetUpdatedAt()
+	r.GetName()
 }
 
-func TestRepositoryComment_GetURL(tt *testing.T) {
+func TestRepositoryTag_GetTarballURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryComment{}
-	r.GetURL()
+	r := &RepositoryTag{TarballURL: &zeroValue}
+	r.GetTarballURL()
+	r = &RepositoryTag{}
+	r.GetTarballURL()
 	r = nil
-	r.GetURL()
+	r.GetTarballURL()
 }
 
-func TestRepositoryComment_GetUser(tt *testing.T) {
-	r := &RepositoryComment{}
-	r.GetUser()
+func TestRepositoryTag_GetZipballURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryTag{ZipballURL: &zeroValue}
+	r.GetZipballURL()
+	r = &RepositoryTag{}
+	r.GetZipballURL()
 	r = nil
-	r.GetUser()
+	r.GetZipballURL()
 }
 
-func TestRepositoryCommit_GetAuthor(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetAuthor()
+func TestRepositoryVulnerabilityAlert_GetAffectedPackageName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryVulnerabilityAlert{AffectedPackageName: &zeroValue}
+	r.GetAffectedPackageName()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetAffectedPackageName()
 	r = nil
-	r.GetAuthor()
+	r.GetAffectedPackageName()
 }
 
-func TestRepositoryCommit_GetCommentsURL(tt *testing.T) {
+func TestRepositoryVulnerabilityAlert_GetAffectedRange(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{CommentsURL: &zeroValue}
-	r.GetCommentsURL()
-	r = &RepositoryCommit{}
-	r.GetCommentsURL()
+	r := &RepositoryVulnerabilityAlert{AffectedRange: &zeroValue}
+	r.GetAffectedRange()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetAffectedRange()
 	r = nil
-	r.GetCommentsURL()
+	r.GetAffectedRange()
 }
 
-func TestRepositoryCommit_GetCommit(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetCommit()
+func TestRepositoryVulnerabilityAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryVulnerabilityAlert{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetCommit()
+	r.GetCreatedAt()
 }
 
-func TestRepositoryCommit_GetCommitter(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetCommitter()
+func TestRepositoryVulnerabilityAlert_GetDismissedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryVulnerabilityAlert{DismissedAt: &zeroValue}
+	r.GetDismissedAt()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetDismissedAt()
 	r = nil
-	r.GetCommitter()
+	r.GetDismissedAt()
 }
 
-func TestRepositoryCommit_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryCommit{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryCommit{}
-	r.GetHTMLURL()
+func TestRepositoryVulnerabilityAlert_GetDismisser(tt *testing.T) {
+	r := &RepositoryVulnerabilityAlert{}
+	r.GetDismisser()
 	r = nil
-	r.GetHTMLURL()
+	r.GetDismisser()
 }
 
-func TestRepositoryCommit_GetNodeID(tt *testing.T) {
+func TestRepositoryVulnerabilityAlert_GetDismissReason(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepositoryCommit{}
-	r.GetNodeID()
+	r := &RepositoryVulnerabilityAlert{DismissReason: &zeroValue}
+	r.GetDismissReason()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetDismissReason()
 	r = nil
-	r.GetNodeID()
+	r.GetDismissReason()
 }
 
-func TestRepositoryCommit_GetSHA(tt *testing.T) {
+func TestRepositoryVulnerabilityAlert_GetExternalIdentifier(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryCommit{}
-	r.GetSHA()
-	r = nil
-	r.GetSHA()
-}
-
-func TestRepositoryCommit_GetStats(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetStats()
+	r := &RepositoryVulnerabilityAlert{ExternalIdentifier: &zeroValue}
+	r.GetExternalIdentifier()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetExternalIdentifier()
 	r = nil
-	r.GetStats()
+	r.GetExternalIdentifier()
 }
 
-func TestRepositoryCommit_GetURL(tt *testing.T) {
+func TestRepositoryVulnerabilityAlert_GetExternalReference(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryCommit{}
-	r.GetURL()
+	r := &RepositoryVulnerabilityAlert{ExternalReference: &zeroValue}
+	r.GetExternalReference()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetExternalReference()
 	r = nil
-	r.GetURL()
+	r.GetExternalReference()
 }
 
-func TestRepositoryContent_GetDownloadURL(tt *testing.T) {
+func TestRepositoryVulnerabilityAlert_GetFixedIn(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RepositoryContent{}
-	r.GetDownloadURL()
+	r := &RepositoryVulnerabilityAlert{FixedIn: &zeroValue}
+	r.GetFixedIn()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetFixedIn()
 	r = nil
-	r.GetDownloadURL()
+	r.GetFixedIn()
 }
 
-func TestRepositoryContent_GetEncoding(tt *testing.T) {
+func TestRepositoryVulnerabilityAlert_GetGitHubSecurityAdvisoryID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Encoding: &zeroValue}
-	r.GetEncoding()
-	r = &RepositoryContent{}
-	r.GetEncoding()
+	r := &RepositoryVulnerabilityAlert{GitHubSecurityAdvisoryID: &zeroValue}
+	r.GetGitHubSecurityAdvisoryID()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetGitHubSecurityAdvisoryID()
 	r = nil
-	r.GetEncoding()
+	r.GetGitHubSecurityAdvisoryID()
 }
 
-func TestRepositoryContent_GetGitURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &RepositoryContent{}
-	r.GetGitURL()
+func TestRepositoryVulnerabilityAlert_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryVulnerabilityAlert{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetID()
 	r = nil
-	r.GetGitURL()
+	r.GetID()
 }
 
-func TestRepositoryContent_GetHTMLURL(tt *testing.T) {
+func TestRepositoryVulnerabilityAlert_GetSeverity(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryContent{}
-	r.GetHTMLURL()
+	r := &RepositoryVulnerabilityAlert{Severity: &zeroValue}
+	r.GetSeverity()
+	r = &RepositoryVulnerabilityAlert{}
+	r.GetSeverity()
 	r = nil
-	r.GetHTMLURL()
+	r.GetSeverity()
 }
 
-func TestRepositoryContent_GetName(tt *testing.T) {
+func TestRepositoryVulnerabilityAlertEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryContent{}
-	r.GetName()
+	r := &RepositoryVulnerabilityAlertEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryVulnerabilityAlertEvent{}
+	r.GetAction()
 	r = nil
-	r.GetName()
+	r.GetAction()
 }
 
-func TestRepositoryContent_GetPath(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryContent{}
-	r.GetPath()
+func TestRepositoryVulnerabilityAlertEvent_GetAlert(tt *testing.T) {
+	r := &RepositoryVulnerabilityAlertEvent{}
+	r.GetAlert()
 	r = nil
-	r.GetPath()
+	r.GetAlert()
 }
 
-func TestRepositoryContent_GetSHA(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryContent{}
-	r.GetSHA()
+func TestRepositoryVulnerabilityAlertEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryVulnerabilityAlertEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetSHA()
+	r.GetInstallation()
 }
 
-func TestRepositoryContent_GetSize(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoryContent{Size: &zeroValue}
-	r.GetSize()
-	r = &RepositoryContent{}
-	r.GetSize()
+func TestRepositoryVulnerabilityAlertEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryVulnerabilityAlertEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetSize()
+	r.GetOrg()
 }
 
-func TestRepositoryContent_GetTarget(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{Target: &zeroValue}
-	r.GetTarget()
-	r = &RepositoryContent{}
-	r.GetTarget()
+func TestRepositoryVulnerabilityAlertEvent_GetRepository(tt *testing.T) {
+	r := &RepositoryVulnerabilityAlertEvent{}
+	r.GetRepository()
 	r = nil
-	r.GetTarget()
+	r.GetRepository()
 }
 
-func TestRepositoryContent_GetType(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{Type: &zeroValue}
-	r.GetType()
-	r = &RepositoryContent{}
-	r.GetType()
+func TestRepositoryVulnerabilityAlertEvent_GetSender(tt *testing.T) {
+	r := &RepositoryVulnerabilityAlertEvent{}
+	r.GetSender()
 	r = nil
-	r.GetType()
+	r.GetSender()
 }
 
-func TestRepositoryContent_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryContent{}
-	r.GetURL()
+func TestRepoStats_GetForkRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{ForkRepos: &zeroValue}
+	r.GetForkRepos()
+	r = &RepoStats{}
+	r.GetForkRepos()
 	r = nil
-	r.GetURL()
+	r.GetForkRepos()
 }
 
-func TestRepositoryContentFileOptions_GetAuthor(tt *testing.T) {
-	r := &RepositoryContentFileOptions{}
-	r.GetAuthor()
+func TestRepoStats_GetOrgRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{OrgRepos: &zeroValue}
+	r.GetOrgRepos()
+	r = &RepoStats{}
+	r.GetOrgRepos()
 	r = nil
-	r.GetAuthor()
+	r.GetOrgRepos()
 }
 
-func TestRepositoryContentFileOptions_GetBranch(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContentFileOptions{Branch: &zeroValue}
-	r.GetBranch()
-	r = &RepositoryContentFileOptions{}
-	r.GetBranch()
+func TestRepoStats_GetRootRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{RootRepos: &zeroValue}
+	r.GetRootRepos()
+	r = &RepoStats{}
+	r.GetRootRepos()
 	r = nil
-	r.GetBranch()
+	r.GetRootRepos()
 }
 
-func TestRepositoryContentFileOptions_GetCommitter(tt *testing.T) {
-	r := &RepositoryContentFileOptions{}
-	r.GetCommitter()
+func TestRepoStats_GetTotalPushes(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{TotalPushes: &zeroValue}
+	r.GetTotalPushes()
+	r = &RepoStats{}
+	r.GetTotalPushes()
 	r = nil
-	r.GetCommitter()
+	r.GetTotalPushes()
 }
 
-func TestRepositoryContentFileOptions_GetMessage(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContentFileOptions{Message: &zeroValue}
-	r.GetMessage()
-	r = &RepositoryContentFileOptions{}
-	r.GetMessage()
+func TestRepoStats_GetTotalRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{TotalRepos: &zeroValue}
+	r.GetTotalRepos()
+	r = &RepoStats{}
+	r.GetTotalRepos()
 	r = nil
-	r.GetMessage()
+	r.GetTotalRepos()
 }
 
-func TestRepositoryContentFileOptions_GetSHA(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContentFileOptions{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryContentFileOptions{}
-	r.GetSHA()
+func TestRepoStats_GetTotalWikis(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{TotalWikis: &zeroValue}
+	r.GetTotalWikis()
+	r = &RepoStats{}
+	r.GetTotalWikis()
 	r = nil
-	r.GetSHA()
+	r.GetTotalWikis()
 }
 
-func TestRepositoryContentResponse_GetContent(tt *testing.T) {
-	r := &RepositoryContentResponse{}
-	r.GetContent()
+func TestRepoStatus_GetAvatarURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepoStatus{AvatarURL: &zeroValue}
+	r.GetAvatarURL()
+	r = &RepoStatus{}
+	r.GetAvatarURL()
 	r = nil
-	r.GetContent()
+	r.GetAvatarURL()
 }
 
-func TestRepositoryDispatchEvent_GetAction(tt *testing.T) {
+func TestRepoStatus_GetContext(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryDispatchEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryDispatchEvent{}
-	r.GetAction()
+	r := &RepoStatus{Context: &zeroValue}
+	r.GetContext()
+	r = &RepoStatus{}
+	r.GetContext()
 	r = nil
-	r.GetAction()
+	r.GetContext()
 }
 
-func TestRepositoryDispatchEvent_GetBranch(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryDispatchEvent{Branch: &zeroValue}
-	r.GetBranch()
-	r = &RepositoryDispatchEvent{}
-	r.GetBranch()
+func TestRepoStatus_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepoStatus{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepoStatus{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetBranch()
+	r.GetCreatedAt()
 }
 
-func TestRepositoryDispatchEvent_GetInstallation(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetInstallation()
+func TestRepoStatus_GetCreator(tt *testing.T) {
+	r := &RepoStatus{}
+	r.GetCreator()
 	r = nil
-	r.GetInstallation()
+	r.GetCreator()
 }
 
-func TestRepositoryDispatchEvent_GetOrg(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetOrg()
+func TestRepoStatus_GetDescription(tt *testing.T) {
+	var zeroValue string
+	r := &RepoStatus{Description: &zeroValue}
+	r.GetDescription()
+	r = &RepoStatus{}
+	r.GetDescription()
 	r = nil
-	r.GetOrg()
+	r.GetDescription()
 }
 
-func TestRepositoryDispatchEvent_GetRepo(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetRepo()
+func TestRepoStatus_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepoStatus{ID: &zeroValue}
+	r.GetID()
+	r = &RepoStatus{}
+	r.GetID()
 	r = nil
-	r.GetRepo()
+	r.GetID()
 }
 
-func TestRepositoryDispatchEvent_GetSender(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetSender()
+func TestRepoStatus_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepoStatus{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepoStatus{}
+	r.GetNodeID()
 	r = nil
-	r.GetSender()
+	r.GetNodeID()
 }
 
-func TestRepositoryEvent_GetAction(tt *testing.T) {
+func TestRepoStatus_GetState(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryEvent{}
-	r.GetAction()
+	r := &RepoStatus{State: &zeroValue}
+	r.GetState()
+	r = &RepoStatus{}
+	r.GetState()
 	r = nil
-	r.GetAction()
+	r.GetState()
 }
 
-func TestRepositoryEvent_GetChanges(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetChanges()
+func TestRepoStatus_GetTargetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepoStatus{TargetURL: &zeroValue}
+	r.GetTargetURL()
+	r = &RepoStatus{}
+	r.GetTargetURL()
 	r = nil
-	r.GetChanges()
+	r.GetTargetURL()
 }
 
-func TestRepositoryEvent_GetInstallation(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetInstallation()
+func TestRepoStatus_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepoStatus{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &RepoStatus{}
+	r.GetUpdatedAt()
 	r = nil
-	r.GetInstallation()
+	r.GetUpdatedAt()
 }
 
-func TestRepositoryEvent_GetOrg(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetOrg()
+func TestRepoStatus_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepoStatus{URL: &zeroValue}
+	r.GetURL()
+	r = &RepoStatus{}
+	r.GetURL()
 	r = nil
-	r.GetOrg()
+	r.GetURL()
 }
 
-func TestRepositoryEvent_GetRepo(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetRepo()
+func TestRequireCodeOwnerReviewChanges_GetFrom(tt *testing.T) {
+	var zeroValue bool
+	r := &RequireCodeOwnerReviewChanges{From: &zeroValue}
+	r.GetFrom()
+	r = &RequireCodeOwnerReviewChanges{}
+	r.GetFrom()
 	r = nil
-	r.GetRepo()
+	r.GetFrom()
 }
 
-func TestRepositoryEvent_GetSender(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetSender()
+func TestRequiredConversationResolutionLevelChanges_GetFrom(tt *testing.T) {
+	var zeroValue string
+	r := &RequiredConversationResolutionLevelChanges{From: &zeroValue}
+	r.GetFrom()
+	r = &RequiredConversationResolutionLevelChanges{}
+	r.GetFrom()
 	r = nil
-	r.GetSender()
+	r.GetFrom()
 }
 
-func TestRepositoryImportEvent_GetOrg(tt *testing.T) {
-	r := &RepositoryImportEvent{}
-	r.GetOrg()
+func TestRequiredDeploymentsEnforcementLevelChanges_GetFrom(tt *testing.T) {
+	var zeroValue string
+	r := &RequiredDeploymentsEnforcementLevelChanges{From: &zeroValue}
+	r.GetFrom()
+	r = &RequiredDeploymentsEnforcementLevelChanges{}
+	r.GetFrom()
 	r = nil
-	r.GetOrg()
+	r.GetFrom()
 }
 
-func TestRepositoryImportEvent_GetRepo(tt *testing.T) {
-	r := &RepositoryImportEvent{}
-	r.GetRepo()
+func TestRequiredReviewer_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RequiredReviewer{Type: &zeroValue}
+	r.GetType()
+	r = &RequiredReviewer{}
+	r.GetType()
 	r = nil
-	r.GetRepo()
+	r.GetType()
 }
 
-func TestRepositoryImportEvent_GetSender(tt *testing.T) {
-	r := &RepositoryImportEvent{}
-	r.GetSender()
+func TestRequiredStatusCheck_GetAppID(tt *testing.T) {
+	var zeroValue int64
+	r := &RequiredStatusCheck{AppID: &zeroValue}
+	r.GetAppID()
+	r = &RequiredStatusCheck{}
+	r.GetAppID()
 	r = nil
-	r.GetSender()
+	r.GetAppID()
 }
 
-func TestRepositoryImportEvent_GetStatus(tt *testing.T) {
+func TestRequiredStatusChecks_GetContextsURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryImportEvent{Status: &zeroValue}
-	r.GetStatus()
-	r = &RepositoryImportEvent{}
-	r.GetStatus()
+	r := &RequiredStatusChecks{ContextsURL: &zeroValue}
+	r.GetContextsURL()
+	r = &RequiredStatusChecks{}
+	r.GetContextsURL()
 	r = nil
-	r.GetStatus()
+	r.GetContextsURL()
 }
 
-func TestRepositoryInvitation_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryInvitation{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryInvitation{}
-	r.GetCreatedAt()
+func TestRequiredStatusChecks_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RequiredStatusChecks{URL: &zeroValue}
+	r.GetURL()
+	r = &RequiredStatusChecks{}
+	r.GetURL()
 	r = nil
-	r.GetCreatedAt()
+	r.GetURL()
 }
 
-func TestRepositoryInvitation_GetHTMLURL(tt *testing.T) {
+func TestRequiredStatusChecksEnforcementLevelChanges_GetFrom(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryInvitation{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryInvitation{}
-	r.GetHTMLURL()
+	r := &RequiredStatusChecksEnforcementLevelChanges{From: &zeroValue}
+	r.GetFrom()
+	r = &RequiredStatusChecksEnforcementLevelChanges{}
+	r.GetFrom()
 	r = nil
-	r.GetHTMLURL()
-}
-
-func TestRepositoryInvitation_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepositoryInvitation{ID: &zeroValue}
-	r.GetID()
-	r = &RepositoryInvitation{}
-	r.GetID()
+	r.GetFrom()
+}
+
+func TestRequiredStatusChecksRequest_GetStrict(tt *testing.T) {
+	var zeroValue bool
+	r := &RequiredStatusChecksRequest{Strict: &zeroValue}
+	r.GetStrict()
+	r = &RequiredStatusChecksRequest{}
+	r.GetStrict()
 	r = nil
-	r.GetID()
+	r.GetStrict()
 }
 
-func TestRepositoryInvitation_GetInvitee(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetInvitee()
+func TestRequiredWorkflowSelectedRepos_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	r := &RequiredWorkflowSelectedRepos{TotalCount: &zeroValue}
+	r.GetTotalCount()
+	r = &RequiredWorkflowSelectedRepos{}
+	r.GetTotalCount()
 	r = nil
-	r.GetInvitee()
+	r.GetTotalCount()
 }
 
-func TestRepositoryInvitation_GetInviter(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetInviter()
+func TestReviewersRequest_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &ReviewersRequest{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &ReviewersRequest{}
+	r.GetNodeID()
 	r = nil
-	r.GetInviter()
+	r.GetNodeID()
 }
 
-func TestRepositoryInvitation_GetPermissions(tt *testing.T) {
+func TestReviewPersonalAccessTokenRequestOptions_GetReason(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryInvitation{Permissions: &zeroValue}
-	r.GetPermissions()
-	r = &RepositoryInvitation{}
-	r.GetPermissions()
+	r := &ReviewPersonalAccessTokenRequestOptions{Reason: &zeroValue}
+	r.GetReason()
+	r = &ReviewPersonalAccessTokenRequestOptions{}
+	r.GetReason()
 	r = nil
-	r.GetPermissions()
+	r.GetReason()
 }
 
-func TestRepositoryInvitation_GetRepo(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetRepo()
+func TestRule_GetDescription(tt *testing.T) {
+	var zeroValue string
+	r := &Rule{Description: &zeroValue}
+	r.GetDescription()
+	r = &Rule{}
+	r.GetDescription()
 	r = nil
-	r.GetRepo()
+	r.GetDescription()
 }
 
-func TestRepositoryInvitation_GetURL(tt *testing.T) {
+func TestRule_GetFullDescription(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryInvitation{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryInvitation{}
-	r.GetURL()
+	r := &Rule{FullDescription: &zeroValue}
+	r.GetFullDescription()
+	r = &Rule{}
+	r.GetFullDescription()
 	r = nil
-	r.GetURL()
+	r.GetFullDescription()
 }
 
-func TestRepositoryLicense_GetContent(tt *testing.T) {
+func TestRule_GetHelp(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{Content: &zeroValue}
-	r.GetContent()
-	r = &RepositoryLicense{}
-	r.GetContent()
+	r := &Rule{Help: &zeroValue}
+	r.GetHelp()
+	r = &Rule{}
+	r.GetHelp()
 	r = nil
-	r.GetContent()
+	r.GetHelp()
 }
 
-func TestRepositoryLicense_GetDownloadURL(tt *testing.T) {
+func TestRule_GetID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RepositoryLicense{}
-	r.GetDownloadURL()
+	r := &Rule{ID: &zeroValue}
+	r.GetID()
+	r = &Rule{}
+	r.GetID()
 	r = nil
-	r.GetDownloadURL()
+	r.GetID()
 }
 
-func TestRepositoryLicense_GetEncoding(tt *testing.T) {
+func TestRule_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{Encoding: &zeroValue}
-	r.GetEncoding()
-	r = &RepositoryLicense{}
-	r.GetEncoding()
+	r := &Rule{Name: &zeroValue}
+	r.GetName()
+	r = &Rule{}
+	r.GetName()
 	r = nil
-	r.GetEncoding()
+	r.GetName()
 }
 
-func TestRepositoryLicense_GetGitURL(tt *testing.T) {
+func TestRule_GetSecuritySeverityLevel(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &RepositoryLicense{}
-	r.GetGitURL()
+	r := &Rule{SecuritySeverityLevel: &zeroValue}
+	r.GetSecuritySeverityLevel()
+	r = &Rule{}
+	r.GetSecuritySeverityLevel()
 	r = nil
-	r.GetGitURL()
+	r.GetSecuritySeverityLevel()
 }
 
-func TestRepositoryLicense_GetHTMLURL(tt *testing.T) {
+func TestRule_GetSeverity(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryLicense{}
-	r.GetHTMLURL()
+	r := &Rule{Severity: &zeroValue}
+	r.GetSeverity()
+	r = &Rule{}
+	r.GetSeverity()
 	r = nil
-	r.GetHTMLURL()
+	r.GetSeverity()
 }
 
-func TestRepositoryLicense_GetLicense(tt *testing.T) {
-	r := &RepositoryLicense{}
-	r.GetLicense()
+func TestRulesetBypassActor_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	r := &RulesetBypassActor{ActorID: &zeroValue}
+	r.GetActorID()
+	r = &RulesetBypassActor{}
+	r.GetActorID()
 	r = nil
-	r.GetLicense()
+	r.GetActorID()
 }
 
-func TestRepositoryLicense_GetName(tt *testing.T) {
+func TestRulesetBypassActor_GetActorType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryLicense{}
-	r.GetName()
+	r := &RulesetBypassActor{ActorType: &zeroValue}
+	r.GetActorType()
+	r = &RulesetBypassActor{}
+	r.GetActorType()
 	r = nil
-	r.GetName()
+	r.GetActorType()
 }
 
-func TestRepositoryLicense_GetPath(tt *testing.T) {
+func TestRulesetBypassActor_GetBypassMode(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryLicense{}
-	r.GetPath()
+	r := &RulesetBypassActor{BypassMode: &zeroValue}
+	r.GetBypassMode()
+	r = &RulesetBypassActor{}
+	r.GetBypassMode()
 	r = nil
-	r.GetPath()
+	r.GetBypassMode()
 }
 
-func TestRepositoryLicense_GetSHA(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryLicense{}
-	r.GetSHA()
+func TestRulesetConditions_GetRefName(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRefName()
 	r = nil
-	r.GetSHA()
+	r.GetRefName()
 }
 
-func TestRepositoryLicense_GetSize(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoryLicense{Size: &zeroValue}
-	r.GetSize()
-	r = &RepositoryLicense{}
-	r.GetSize()
+func TestRulesetConditions_GetRepositoryName(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRepositoryName()
 	r = nil
-	r.GetSize()
+	r.GetRepositoryName()
 }
 
-func TestRepositoryLicense_GetType(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{Type: &zeroValue}
-	r.GetType()
-	r = &RepositoryLicense{}
-	r.GetType()
+func TestRulesetRepositoryNamesConditionParameters_GetProtected(tt *testing.T) {
+	var zeroValue bool
+	r := &RulesetRepositoryNamesConditionParameters{Protected: &zeroValue}
+	r.GetProtected()
+	r = &RulesetRepositoryNamesConditionParameters{}
+	r.GetProtected()
 	r = nil
-	r.GetType()
+	r.GetProtected()
 }
 
-func TestRepositoryLicense_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryLicense{}
-	r.GetURL()
+func TestRunner_GetBusy(tt *testing.T) {
+	var zeroValue bool
+	r := &Runner{Busy: &zeroValue}
+	r.GetBusy()
+	r = &Runner{}
+	r.GetBusy()
 	r = nil
-	r.GetURL()
+	r.GetBusy()
 }
 
-func TestRepositoryMergeRequest_GetBase(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryMergeRequest{Base: &zeroValue}
-	r.GetBase()
-	r = &RepositoryMergeRequest{}
-	r.GetBase()
+func TestRunner_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Runner{ID: &zeroValue}
+	r.GetID()
+	r = &Runner{}
+	r.GetID()
 	r = nil
-	r.GetBase()
+	r.GetID()
 }
 
-func TestRepositoryMergeRequest_GetCommitMessage(tt *testing.T) {
+func TestRunner_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryMergeRequest{CommitMessage: &zeroValue}
-	r.GetCommitMessage()
-	r = &RepositoryMergeRequest{}
-	r.GetCommitMessage()
+	r := &Runner{Name: &zeroValue}
+	r.GetName()
+	r = &Runner{}
+	r.GetName()
 	r = nil
-	r.GetCommitMessage()
+	r.GetName()
 }
 
-func TestRepositoryMergeRequest_GetHead(tt *testing.T) {
+func TestRunner_GetOS(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryMergeRequest{Head: &zeroValue}
-	r.GetHead()
-	r = &RepositoryMergeRequest{}
-	r.GetHead()
+	r := &Runner{OS: &zeroValue}
+	r.GetOS()
+	r = &Runner{}
+	r.GetOS()
 	r = nil
-	r.GetHead()
+	r.GetOS()
 }
 
-func TestRepositoryPermissionLevel_GetPermission(tt *testing.T) {
+func TestRunner_GetStatus(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryPermissionLevel{Permission: &zeroValue}
-	r.GetPermission()
-	r = &RepositoryPermissionLevel{}
-	r.GetPermission()
+	r := &Runner{Status: &zeroValue}
+	r.GetStatus()
+	r = &Runner{}
+	r.GetStatus()
 	r = nil
-	r.GetPermission()
+	r.GetStatus()
 }
 
-func TestRepositoryPermissionLevel_GetUser(tt *testing.T) {
-	r := &RepositoryPermissionLevel{}
-	r.GetUser()
+func TestRunnerApplicationDownload_GetArchitecture(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{Architecture: &zeroValue}
+	r.GetArchitecture()
+	r = &RunnerApplicationDownload{}
+	r.GetArchitecture()
 	r = nil
-	r.GetUser()
+	r.GetArchitecture()
 }
 
-func TestRepositoryRelease_GetAssetsURL(tt *testing.T) {
+func TestRunnerApplicationDownload_GetDownloadURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{AssetsURL: &zeroValue}
-	r.GetAssetsURL()
-	r = &RepositoryRelease{}
-	r.GetAssetsURL()
+	r := &RunnerApplicationDownload{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RunnerApplicationDownload{}
+	r.GetDownloadURL()
 	r = nil
-	r.GetAssetsURL()
+	r.GetDownloadURL()
 }
 
-func TestRepositoryRelease_GetAuthor(tt *testing.T) {
-	r := &RepositoryRelease{}
-	r.GetAuthor()
+func TestRunnerApplicationDownload_GetFilename(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{Filename: &zeroValue}
+	r.GetFilename()
+	r = &RunnerApplicationDownload{}
+	r.GetFilename()
 	r = nil
-	r.GetAuthor()
+	r.GetFilename()
 }
 
-func TestRepositoryRelease_GetBody(tt *testing.T) {
+func TestRunnerApplicationDownload_GetOS(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{Body: &zeroValue}
-	r.GetBody()
-	r = &RepositoryRelease{}
-	r.GetBody()
+	r := &RunnerApplicationDownload{OS: &zeroValue}
+	r.GetOS()
+	r = &RunnerApplicationDownload{}
+	r.GetOS()
 	r = nil
-	r.GetBody()
+	r.GetOS()
 }
 
-func TestRepositoryRelease_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryRelease{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryRelease{}
-	r.GetCreatedAt()
+func TestRunnerApplicationDownload_GetSHA256Checksum(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{SHA256Checksum: &zeroValue}
+	r.GetSHA256Checksum()
+	r = &RunnerApplicationDownload{}
+	r.GetSHA256Checksum()
 	r = nil
-	r.GetCreatedAt()
+	r.GetSHA256Checksum()
 }
 
-func TestRepositoryRelease_GetDiscussionCategoryName(tt *testing.T) {
+func TestRunnerApplicationDownload_GetTempDownloadToken(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{DiscussionCategoryName: &zeroValue}
-	r.GetDiscussionCategoryName()
-	r = &RepositoryRelease{}
-	r.GetDiscussionCategoryName()
+	r := &RunnerApplicationDownload{TempDownloadToken: &zeroValue}
+	r.GetTempDownloadToken()
+	r = &RunnerApplicationDownload{}
+	r.GetTempDownloadToken()
 	r = nil
-	r.GetDiscussionCategoryName()
+	r.GetTempDownloadToken()
 }
 
-func TestRepositoryRelease_GetDraft(tt *testing.T) {
+func TestRunnerGroup_GetAllowsPublicRepositories(tt *testing.T) {
 	var zeroValue bool
-	r := &RepositoryRelease{Draft: &zeroValue}
-	r.GetDraft()
-	r = &RepositoryRelease{}
-	r.GetDraft()
+	r := &RunnerGroup{AllowsPublicRepositories: &zeroValue}
+	r.GetAllowsPublicRepositories()
+	r = &RunnerGroup{}
+	r.GetAllowsPublicRepositories()
 	r = nil
-	r.GetDraft()
+	r.GetAllowsPublicRepositories()
 }
 
-func TestRepositoryRelease_GetGenerateReleaseNotes(tt *testing.T) {
+func TestRunnerGroup_GetDefault(tt *testing.T) {
 	var zeroValue bool
-	r := &RepositoryRelease{GenerateReleaseNotes: &zeroValue}
-	r.GetGenerateReleaseNotes()
-	r = &RepositoryRelease{}
-	r.GetGenerateReleaseNotes()
-	r = nil
-	r.GetGenerateReleaseNotes()
-}
-
-func TestRepositoryRelease_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryRelease{}
-	r.GetHTMLURL()
+	r := &RunnerGroup{Default: &zeroValue}
+	r.GetDefault()
+	r = &RunnerGroup{}
+	r.GetDefault()
 	r = nil
-	r.GetHTMLURL()
+	r.GetDefault()
 }
 
-func TestRepositoryRelease_GetID(tt *testing.T) {
+func TestRunnerGroup_GetID(tt *testing.T) {
 	var zeroValue int64
-	r := &RepositoryRelease{ID: &zeroValue}
+	r := &RunnerGroup{ID: &zeroValue}
 	r.GetID()
-	r = &RepositoryRelease{}
+	r = &RunnerGroup{}
 	r.GetID()
 	r = nil
 	r.GetID()
 }
 
-func TestRepositoryRelease_GetMakeLatest(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{MakeLatest: &zeroValue}
-	r.GetMakeLatest()
-	r = &RepositoryRelease{}
-	r.GetMakeLatest()
+func TestRunnerGroup_GetInherited(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{Inherited: &zeroValue}
+	r.GetInherited()
+	r = &RunnerGroup{}
+	r.GetInherited()
 	r = nil
-	r.GetMakeLatest()
+	r.GetInherited()
 }
 
-func TestRepositoryRelease_GetName(tt *testing.T) {
+func TestRunnerGroup_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{Name: &zeroValue}
+	r := &RunnerGroup{Name: &zeroValue}
 	r.GetName()
-	r = &RepositoryRelease{}
+	r = &RunnerGroup{}
 	r.GetName()
 	r = nil
 	r.GetName()
 }
 
-func TestRepositoryRelease_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepositoryRelease{}
-	r.GetNodeID()
+func TestRunnerGroup_GetRestrictedToWorkflows(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{RestrictedToWorkflows: &zeroValue}
+	r.GetRestrictedToWorkflows()
+	r = &RunnerGroup{}
+	r.GetRestrictedToWorkflows()
 	r = nil
-	r.GetNodeID()
+	r.GetRestrictedToWorkflows()
 }
 
-func TestRepositoryRelease_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	r := &RepositoryRelease{Prerelease: &zeroValue}
-	r.GetPrerelease()
-	r = &RepositoryRelease{}
-	r.GetPrerelease()
+func TestRunnerGroup_GetRunnersURL(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerGroup{RunnersURL: &zeroValue}
+	r.GetRunnersURL()
+	r = &RunnerGroup{}
+	r.GetRunnersURL()
 	r = nil
-	r.GetPrerelease()
+	r.GetRunnersURL()
 }
 
-func TestRepositoryRelease_GetPublishedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryRelease{PublishedAt: &zeroValue}
-	r.GetPublishedAt()
-	r = &RepositoryRelease{}
-	r.GetPublishedAt()
+func TestRunnerGroup_GetSelectedRepositoriesURL(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerGroup{SelectedRepositoriesURL: &zeroValue}
+	r.GetSelectedRepositoriesURL()
+	r = &RunnerGroup{}
+	r.GetSelectedRepositoriesURL()
 	r = nil
-	r.GetPublishedAt()
+	r.GetSelectedRepositoriesURL()
 }
 
-func TestRepositoryRelease_GetTagName(tt *testing.T) {
+func TestRunnerGroup_GetVisibility(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{TagName: &zeroValue}
-	r.GetTagName()
-	r = &RepositoryRelease{}
-	r.GetTagName()
+	r := &RunnerGroup{Visibility: &zeroValue}
+	r.GetVisibility()
+	r = &RunnerGroup{}
+	r.GetVisibility()
 	r = nil
-	r.GetTagName()
+	r.GetVisibility()
 }
 
-func TestRepositoryRelease_GetTarballURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{TarballURL: &zeroValue}
-	r.GetTarballURL()
-	r = &RepositoryRelease{}
-	r.GetTarballURL()
+func TestRunnerGroup_GetWorkflowRestrictionsReadOnly(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{WorkflowRestrictionsReadOnly: &zeroValue}
+	r.GetWorkflowRestrictionsReadOnly()
+	r = &RunnerGroup{}
+	r.GetWorkflowRestrictionsReadOnly()
 	r = nil
-	r.GetTarballURL()
+	r.GetWorkflowRestrictionsReadOnly()
 }
 
-func TestRepositoryRelease_GetTargetCommitish(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{TargetCommitish: &zeroValue}
-	r.GetTargetCommitish()
-	r = &RepositoryRelease{}
-	r.GetTargetCommitish()
+func TestRunnerLabels_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RunnerLabels{ID: &zeroValue}
+	r.GetID()
+	r = &RunnerLabels{}
+	r.GetID()
 	r = nil
-	r.GetTargetCommitish()
+	r.GetID()
 }
 
-func TestRepositoryRelease_GetUploadURL(tt *testing.T) {
+func TestRunnerLabels_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{UploadURL: &zeroValue}
-	r.GetUploadURL()
-	r = &RepositoryRelease{}
-	r.GetUploadURL()
+	r := &RunnerLabels{Name: &zeroValue}
+	r.GetName()
+	r = &RunnerLabels{}
+	r.GetName()
 	r = nil
-	r.GetUploadURL()
+	r.GetName()
 }
 
-func TestRepositoryRelease_GetURL(tt *testing.T) {
+func TestRunnerLabels_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryRelease{}
-	r.GetURL()
+	r := &RunnerLabels{Type: &zeroValue}
+	r.GetType()
+	r = &RunnerLabels{}
+	r.GetType()
 	r = nil
-	r.GetURL()
+	r.GetType()
 }
 
-func TestRepositoryRelease_GetZipballURL(tt *testing.T) {
+func TestSarifAnalysis_GetCheckoutURI(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{ZipballURL: &zeroValue}
-	r.GetZipballURL()
-	r = &RepositoryRelease{}
-	r.GetZipballURL()
-	r = nil
-	r.GetZipballURL()
+	s := &SarifAnalysis{CheckoutURI: &zeroValue}
+	s.GetCheckoutURI()
+	s = &SarifAnalysis{}
+	s.GetCheckoutURI()
+	s = nil
+	s.GetCheckoutURI()
 }
 
-func TestRepositoryTag_GetCommit(tt *testing.T) {
-	r := &RepositoryTag{}
-	r.GetCommit()
-	r = nil
-	r.GetCommit()
+func TestSarifAnalysis_GetCommitSHA(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &SarifAnalysis{}
+	s.GetCommitSHA()
+	s = nil
+	s.GetCommitSHA()
 }
 
-func TestRepositoryTag_GetName(tt *testing.T) {
+func TestSarifAnalysis_GetRef(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryTag{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryTag{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SarifAnalysis{Ref: &zeroValue}
+	s.GetRef()
+	s = &SarifAnalysis{}
+	s.GetRef()
+	s = nil
+	s.GetRef()
+}
+
+func TestSarifAnalysis_GetSarif(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{Sarif: &zeroValue}
+	s.GetSarif()
+	s = &SarifAnalysis{}
+	s.GetSarif()
+	s = nil
+	s.GetSarif()
 }
 
-func TestRepositoryTag_GetTarballURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryTag{TarballURL: &zeroValue}
-	r.GetTarballURL()
-	r = &RepositoryTag{}
-	r.GetTarballURL()
-	r = nil
-	r.GetTarballURL()
+func TestSarifAnalysis_GetStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SarifAnalysis{StartedAt: &zeroValue}
+	s.GetStartedAt()
+	s = &SarifAnalysis{}
+	s.GetStartedAt()
+	s = nil
+	s.GetStartedAt()
 }
 
-func TestRepositoryTag_GetZipballURL(tt *testing.T) {
+func TestSarifAnalysis_GetToolName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryTag{ZipballURL: &zeroValue}
-	r.GetZipballURL()
-	r = &RepositoryTag{}
-	r.GetZipballURL()
-	r = nil
-	r.GetZipballURL()
+	s := &SarifAnalysis{ToolName: &zeroValue}
+	s.GetToolName()
+	s = &SarifAnalysis{}
+	s.GetToolName()
+	s = nil
+	s.GetToolName()
 }
 
-func TestRepositoryVulnerabilityAlert_GetAffectedPackageName(tt *testing.T) {
+func TestSarifID_GetID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlert{AffectedPackageName: &zeroValue}
-	r.GetAffectedPackageName()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetAffectedPackageName()
-	r = nil
-	r.GetAffectedPackageName()
+	s := &SarifID{ID: &zeroValue}
+	s.GetID()
+	s = &SarifID{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRepositoryVulnerabilityAlert_GetAffectedRange(tt *testing.T) {
+func TestSarifID_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlert{AffectedRange: &zeroValue}
-	r.GetAffectedRange()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetAffectedRange()
-	r = nil
-	r.GetAffectedRange()
+	s := &SarifID{URL: &zeroValue}
+	s.GetURL()
+	s = &SarifID{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRepositoryVulnerabilityAlert_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryVulnerabilityAlert{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+func TestSARIFUpload_GetAnalysesURL(tt *testing.T) {
+	var zeroValue string
+	s := &SARIFUpload{AnalysesURL: &zeroValue}
+	s.GetAnalysesURL()
+	s = &SARIFUpload{}
+	s.GetAnalysesURL()
+	s = nil
+	s.GetAnalysesURL()
 }
 
-func TestRepositoryVulnerabilityAlert_GetDismissedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryVulnerabilityAlert{DismissedAt: &zeroValue}
-	r.GetDismissedAt()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetDismissedAt()
-	r = nil
-	r.GetDismissedAt()
+func TestSARIFUpload_GetProcessingStatus(tt *testing.T) {
+	var zeroValue string
+	s := &SARIFUpload{ProcessingStatus: &zeroValue}
+	s.GetProcessingStatus()
+	s = &SARIFUpload{}
+	s.GetProcessingStatus()
+	s = nil
+	s.GetProcessingStatus()
 }
 
-func TestRepositoryVulnerabilityAlert_GetDismisser(tt *testing.T) {
-	r := &RepositoryVulnerabilityAlert{}
-	r.GetDismisser()
-	r = nil
-	r.GetDismisser()
+func TestSBOM_GetSBOM(tt *testing.T) {
+	s := &SBOM{}
+	s.GetSBOM()
+	s = nil
+	s.GetSBOM()
 }
 
-func TestRepositoryVulnerabilityAlert_GetDismissReason(tt *testing.T) {
+func TestSBOMExternalRef_GetReferenceCategory(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlert{DismissReason: &zeroValue}
-	r.GetDismissReason()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetDismissReason()
-	r = nil
-	r.GetDismissReason()
+	s := &SBOMExternalRef{ReferenceCategory: &zeroValue}
+	s.GetReferenceCategory()
+	s = &SBOMExternalRef{}
+	s.GetReferenceCategory()
+	s = nil
+	s.GetReferenceCategory()
 }
 
-func TestRepositoryVulnerabilityAlert_GetExternalIdentifier(tt *testing.T) {
+func TestSBOMExternalRef_GetReferenceLocator(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlert{ExternalIdentifier: &zeroValue}
-	r.GetExternalIdentifier()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetExternalIdentifier()
-	r = nil
-	r.GetExternalIdentifier()
+	s := &SBOMExternalRef{ReferenceLocator: &zeroValue}
+	s.GetReferenceLocator()
+	s = &SBOMExternalRef{}
+	s.GetReferenceLocator()
+	s = nil
+	s.GetReferenceLocator()
 }
 
-func TestRepositoryVulnerabilityAlert_GetExternalReference(tt *testing.T) {
+func TestSBOMExternalRef_GetReferenceType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlert{ExternalReference: &zeroValue}
-	r.GetExternalReference()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetExternalReference()
-	r = nil
-	r.GetExternalReference()
+	s := &SBOMExternalRef{ReferenceType: &zeroValue}
+	s.GetReferenceType()
+	s = &SBOMExternalRef{}
+	s.GetReferenceType()
+	s = nil
+	s.GetReferenceType()
 }
 
-func TestRepositoryVulnerabilityAlert_GetFixedIn(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryVulnerabilityAlert{FixedIn: &zeroValue}
-	r.GetFixedIn()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetFixedIn()
-	r = nil
-	r.GetFixedIn()
+func TestSBOMInfo_GetCreationInfo(tt *testing.T) {
+	s := &SBOMInfo{}
+	s.GetCreationInfo()
+	s = nil
+	s.GetCreationInfo()
 }
 
-func TestRepositoryVulnerabilityAlert_GetGitHubSecurityAdvisoryID(tt *testing.T) {
+func TestSBOMInfo_GetDataLicense(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlert{GitHubSecurityAdvisoryID: &zeroValue}
-	r.GetGitHubSecurityAdvisoryID()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetGitHubSecurityAdvisoryID()
-	r = nil
-	r.GetGitHubSecurityAdvisoryID()
+	s := &SBOMInfo{DataLicense: &zeroValue}
+	s.GetDataLicense()
+	s = &SBOMInfo{}
+	s.GetDataLicense()
+	s = nil
+	s.GetDataLicense()
 }
 
-func TestRepositoryVulnerabilityAlert_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepositoryVulnerabilityAlert{ID: &zeroValue}
-	r.GetID()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSBOMInfo_GetDocumentNamespace(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMInfo{DocumentNamespace: &zeroValue}
+	s.GetDocumentNamespace()
+	s = &SBOMInfo{}
+	s.GetDocumentNamespace()
+	s = nil
+	s.GetDocumentNamespace()
 }
 
-func TestRepositoryVulnerabilityAlert_GetSeverity(tt *testing.T) {
+func TestSBOMInfo_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlert{Severity: &zeroValue}
-	r.GetSeverity()
-	r = &RepositoryVulnerabilityAlert{}
-	r.GetSeverity()
-	r = nil
-	r.GetSeverity()
+	s := &SBOMInfo{Name: &zeroValue}
+	s.GetName()
+	s = &SBOMInfo{}
+	s.GetName()
+	s = nil
+	s.GetName()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetAction(tt *testing.T) {
+func TestSBOMInfo_GetSPDXID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlertEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryVulnerabilityAlertEvent{}
-	r.GetAction()
-	r = nil
-	r.GetAction()
+	s := &SBOMInfo{SPDXID: &zeroValue}
+	s.GetSPDXID()
+	s = &SBOMInfo{}
+	s.GetSPDXID()
+	s = nil
+	s.GetSPDXID()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetAlert(tt *testing.T) {
-	r := &RepositoryVulnerabilityAlertEvent{}
-	r.GetAlert()
-	r = nil
-	r.GetAlert()
+func TestSBOMInfo_GetSPDXVersion(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMInfo{SPDXVersion: &zeroValue}
+	s.GetSPDXVersion()
+	s = &SBOMInfo{}
+	s.GetSPDXVersion()
+	s = nil
+	s.GetSPDXVersion()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetInstallation(tt *testing.T) {
-	r := &RepositoryVulnerabilityAlertEvent{}
-	r.GetInstallation()
-	r = nil
-	r.GetInstallation()
+func TestSBOMRelationship_GetRelatedSPDXElement(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMRelationship{RelatedSPDXElement: &zeroValue}
+	s.GetRelatedSPDXElement()
+	s = &SBOMRelationship{}
+	s.GetRelatedSPDXElement()
+	s = nil
+	s.GetRelatedSPDXElement()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetRepository(tt *testing.T) {
-	r := &RepositoryVulnerabilityAlertEvent{}
-	r.GetRepository()
-	r = nil
-	r.GetRepository()
+func TestSBOMRelationship_GetRelationshipType(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMRelationship{RelationshipType: &zeroValue}
+	s.GetRelationshipType()
+	s = &SBOMRelationship{}
+	s.GetRelationshipType()
+	s = nil
+	s.GetRelationshipType()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetSender(tt *testing.T) {
-	r := &RepositoryVulnerabilityAlertEvent{}
-	r.GetSender()
-	r = nil
-	r.GetSender()
+func TestSBOMRelationship_GetSPDXElementID(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMRelationship{SPDXElementID: &zeroValue}
+	s.GetSPDXElementID()
+	s = &SBOMRelationship{}
+	s.GetSPDXElementID()
+	s = nil
+	s.GetSPDXElementID()
 }
 
-func TestRepoStats_GetForkRepos(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{ForkRepos: &zeroValue}
-	r.GetForkRepos()
-	r = &RepoStats{}
-	r.GetForkRepos()
-	r = nil
-	r.GetForkRepos()
+func TestScanningAnalysis_GetAnalysisKey(tt *testing.T) {
+	var zeroValue string
+	s := &ScanningAnalysis{AnalysisKey: &zeroValue}
+	s.GetAnalysisKey()
+	s = &ScanningAnalysis{}
+	s.GetAnalysisKey()
+	s = nil
+	s.GetAnalysisKey()
 }
 
-func TestRepoStats_GetOrgRepos(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{OrgRepos: &zeroValue}
-	r.GetOrgRepos()
-	r = &RepoStats{}
-	r.GetOrgRepos()
-	r = nil
-	r.GetOrgRepos()
+func TestScanningAnalysis_GetCategory(tt *testing.T) {
+	var zeroValue string
+	s := &ScanningAnalysis{Category: &zeroValue}
+	s.GetCategory()
+	s = &ScanningAnalysis{}
+	s.GetCategory()
+	s = nil
+	s.GetCategory()
 }
 
-func TestRepoStats_GetRootRepos(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{RootRepos: &zeroValue}
-	r.GetRootRepos()
-	r = &RepoStats{}
-	r.GetRootRepos()
-	r = nil
-	r.GetRootRepos()
+func TestScanningAnalysis_GetCommitSHA(tt *testing.T) {
+	var zeroValue string
+	s := &ScanningAnalysis{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &ScanningAnalysis{}
+	s.GetCommitSHA()
+	s = nil
+	s.GetCommitSHA()
 }
 
-func TestRepoStats_GetTotalPushes(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{TotalPushes: &zeroValue}
-	r.GetTotalPushes()
-	r = &RepoStats{}
-	r.GetTotalPushes()
-	r = nil
-	r.GetTotalPushes()
+func TestScanningAnalysis_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &ScanningAnalysis{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &ScanningAnalysis{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
 }
 
-func TestRepoStats_GetTotalRepos(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{TotalRepos: &zeroValue}
-	r.GetTotalRepos()
-	r = &RepoStats{}
-	r.GetTotalRepos()
-	r = nil
-	r.GetTotalRepos()
+func TestScanningAnalysis_GetDeletable(tt *testing.T) {
+	var zeroValue bool
+	s := &ScanningAnalysis{Deletable: &zeroValue}
+	s.GetDeletable()
+	s = &ScanningAnalysis{}
+	s.GetDeletable()
+	s = nil
+	s.GetDeletable()
 }
 
-func TestRepoStats_GetTotalWikis(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{TotalWikis: &zeroValue}
-	r.GetTotalWikis()
-	r = &RepoStats{}
-	r.GetTotalWikis()
-	r = nil
-	r.GetTotalWikis()
+func TestScanningAnalysis_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	s := &ScanningAnalysis{Environment: &zeroValue}
+	s.GetEnvironment()
+	s = &ScanningAnalysis{}
+	s.GetEnvironment()
+	s = nil
+	s.GetEnvironment()
 }
 
-func TestRepoStatus_GetAvatarURL(tt *testing.T) {
+func TestScanningAnalysis_GetError(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{AvatarURL: &zeroValue}
-	r.GetAvatarURL()
-	r = &RepoStatus{}
-	r.GetAvatarURL()
-	r = nil
-	r.GetAvatarURL()
+	s := &ScanningAnalysis{Error: &zeroValue}
+	s.GetError()
+	s = &ScanningAnalysis{}
+	s.GetError()
+	s = nil
+	s.GetError()
 }
 
-func TestRepoStatus_GetContext(tt *testing.T) {
+func TestScanningAnalysis_GetID(tt *testing.T) {
+	var zeroValue int64
+	s := &ScanningAnalysis{ID: &zeroValue}
+	s.GetID()
+	s = &ScanningAnalysis{}
+	s.GetID()
+	s = nil
+	s.GetID()
+}
+
+func TestScanningAnalysis_GetRef(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{Context: &zeroValue}
-	r.GetContext()
-	r = &RepoStatus{}
-	r.GetContext()
-	r = nil
-	r.GetContext()
+	s := &ScanningAnalysis{Ref: &zeroValue}
+	s.GetRef()
+	s = &ScanningAnalysis{}
+	s.GetRef()
+	s = nil
+	s.GetRef()
 }
 
-func TestRepoStatus_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepoStatus{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepoStatus{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+func TestScanningAnalysis_GetResultsCount(tt *testing.T) {
+	var zeroValue int
+	s := &ScanningAnalysis{ResultsCount: &zeroValue}
+	s.GetResultsCount()
+	s = &ScanningAnalysis{}
+	s.GetResultsCount()
+	s = nil
+	s.GetResultsCount()
 }
 
-func TestRepoStatus_GetCreator(tt *testing.T) {
-	r := &RepoStatus{}
-	r.GetCreator()
-	r = nil
-	r.GetCreator()
+func TestScanningAnalysis_GetRulesCount(tt *testing.T) {
+	var zeroValue int
+	s := &ScanningAnalysis{RulesCount: &zeroValue}
+	s.GetRulesCount()
+	s = &ScanningAnalysis{}
+	s.GetRulesCount()
+	s = nil
+	s.GetRulesCount()
 }
 
-func TestRepoStatus_GetDescription(tt *testing.T) {
+func TestScanningAnalysis_GetSarifID(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{Description: &zeroValue}
-	r.GetDescription()
-	r = &RepoStatus{}
-	r.GetDescription()
-	r = nil
-	r.GetDescription()
+	s := &ScanningAnalysis{SarifID: &zeroValue}
+	s.GetSarifID()
+	s = &ScanningAnalysis{}
+	s.GetSarifID()
+	s = nil
+	s.GetSarifID()
 }
 
-func TestRepoStatus_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepoStatus{ID: &zeroValue}
-	r.GetID()
-	r = &RepoStatus{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestScanningAnalysis_GetTool(tt *testing.T) {
+	s := &ScanningAnalysis{}
+	s.GetTool()
+	s = nil
+	s.GetTool()
 }
 
-func TestRepoStatus_GetNodeID(tt *testing.T) {
+func TestScanningAnalysis_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepoStatus{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+	s := &ScanningAnalysis{URL: &zeroValue}
+	s.GetURL()
+	s = &ScanningAnalysis{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRepoStatus_GetState(tt *testing.T) {
+func TestScanningAnalysis_GetWarning(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{State: &zeroValue}
-	r.GetState()
-	r = &RepoStatus{}
-	r.GetState()
-	r = nil
-	r.GetState()
+	s := &ScanningAnalysis{Warning: &zeroValue}
+	s.GetWarning()
+	s = &ScanningAnalysis{}
+	s.GetWarning()
+	s = nil
+	s.GetWarning()
 }
 
-func TestRepoStatus_GetTargetURL(tt *testing.T) {
+func TestSCIMDisplayReference_GetDisplay(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{TargetURL: &zeroValue}
-	r.GetTargetURL()
-	r = &RepoStatus{}
-	r.GetTargetURL()
-	r = nil
-	r.GetTargetURL()
-}
-
-func TestRepoStatus_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepoStatus{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &RepoStatus{}
-	r.GetUpdatedAt()
-	r = nil
-	r.GetUpdatedAt()
+	s := &SCIMDisplayReference{Display: &zeroValue}
+	s.GetDisplay()
+	s = &SCIMDisplayReference{}
+	s.GetDisplay()
+	s = nil
+	s.GetDisplay()
 }
 
-func TestRepoStatus_GetURL(tt *testing.T) {
+func TestSCIMDisplayReference_GetRef(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{URL: &zeroValue}
-	r.GetURL()
-	r = &RepoStatus{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SCIMDisplayReference{Ref: &zeroValue}
+	s.GetRef()
+	s = &SCIMDisplayReference{}
+	s.GetRef()
+	s = nil
+	s.GetRef()
 }
 
-func TestRequireCodeOwnerReviewChanges_GetFrom(tt *testing.T) {
-	var zeroValue bool
-	r := &RequireCodeOwnerReviewChanges{From: &zeroValue}
-	r.GetFrom()
-	r = &RequireCodeOwnerReviewChanges{}
-	r.GetFrom()
-	r = nil
-	r.GetFrom()
+func TestSCIMEnterpriseGroupAttributes_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMEnterpriseGroupAttributes{DisplayName: &zeroValue}
+	s.GetDisplayName()
+	s = &SCIMEnterpriseGroupAttributes{}
+	s.GetDisplayName()
+	s = nil
+	s.GetDisplayName()
 }
 
-func TestRequiredConversationResolutionLevelChanges_GetFrom(tt *testing.T) {
+func TestSCIMEnterpriseGroupAttributes_GetExternalID(tt *testing.T) {
 	var zeroValue string
-	r := &RequiredConversationResolutionLevelChanges{From: &zeroValue}
-	r.GetFrom()
-	r = &RequiredConversationResolutionLevelChanges{}
-	r.GetFrom()
-	r = nil
-	r.GetFrom()
+	s := &SCIMEnterpriseGroupAttributes{ExternalID: &zeroValue}
+	s.GetExternalID()
+	s = &SCIMEnterpriseGroupAttributes{}
+	s.GetExternalID()
+	s = nil
+	s.GetExternalID()
 }
 
-func TestRequiredDeploymentsEnforcementLevelChanges_GetFrom(tt *testing.T) {
+func TestSCIMEnterpriseGroupAttributes_GetID(tt *testing.T) {
 	var zeroValue string
-	r := &RequiredDeploymentsEnforcementLevelChanges{From: &zeroValue}
-	r.GetFrom()
-	r = &RequiredDeploymentsEnforcementLevelChanges{}
-	r.GetFrom()
-	r = nil
-	r.GetFrom()
+	s := &SCIMEnterpriseGroupAttributes{ID: &zeroValue}
+	s.GetID()
+	s = &SCIMEnterpriseGroupAttributes{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRequiredReviewer_GetType(tt *testing.T) {
-	var zeroValue string
-	r := &RequiredReviewer{Type: &zeroValue}
-	r.GetType()
-	r = &RequiredReviewer{}
-	r.GetType()
-	r = nil
-	r.GetType()
+func TestSCIMEnterpriseGroupAttributes_GetMeta(tt *testing.T) {
+	s := &SCIMEnterpriseGroupAttributes{}
+	s.GetMeta()
+	s = nil
+	s.GetMeta()
 }
 
-func TestRequiredStatusCheck_GetAppID(tt *testing.T) {
-	var zeroValue int64
-	r := &RequiredStatusCheck{AppID: &zeroValue}
-	r.GetAppID()
-	r = &RequiredStatusCheck{}
-	r.GetAppID()
-	r = nil
-	r.GetAppID()
+func TestSCIMEnterpriseGroups_GetItemsPerPage(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseGroups{ItemsPerPage: &zeroValue}
+	s.GetItemsPerPage()
+	s = &SCIMEnterpriseGroups{}
+	s.GetItemsPerPage()
+	s = nil
+	s.GetItemsPerPage()
 }
 
-func TestRequiredStatusChecksEnforcementLevelChanges_GetFrom(tt *testing.T) {
-	var zeroValue string
-	r := &RequiredStatusChecksEnforcementLevelChanges{From: &zeroValue}
-	r.GetFrom()
-	r = &RequiredStatusChecksEnforcementLevelChanges{}
-	r.GetFrom()
-	r = nil
-	r.GetFrom()
+func TestSCIMEnterpriseGroups_GetStartIndex(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseGroups{StartIndex: &zeroValue}
+	s.GetStartIndex()
+	s = &SCIMEnterpriseGroups{}
+	s.GetStartIndex()
+	s = nil
+	s.GetStartIndex()
 }
 
-func TestRequiredStatusChecksRequest_GetStrict(tt *testing.T) {
-	var zeroValue bool
-	r := &RequiredStatusChecksRequest{Strict: &zeroValue}
-	r.GetStrict()
-	r = &RequiredStatusChecksRequest{}
-	r.GetStrict()
-	r = nil
-	r.GetStrict()
+func TestSCIMEnterpriseGroups_GetTotalResults(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseGroups{TotalResults: &zeroValue}
+	s.GetTotalResults()
+	s = &SCIMEnterpriseGroups{}
+	s.GetTotalResults()
+	s = nil
+	s.GetTotalResults()
 }
 
-func TestRequiredWorkflowSelectedRepos_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	r := &RequiredWorkflowSelectedRepos{TotalCount: &zeroValue}
-	r.GetTotalCount()
-	r = &RequiredWorkflowSelectedRepos{}
-	r.GetTotalCount()
-	r = nil
-	r.GetTotalCount()
+func TestSCIMEnterpriseUserAttributes_GetActive(tt *testing.T) {
+	var zeroValue bool
+	s := &SCIMEnterpriseUserAttributes{Active: &zeroValue}
+	s.GetActive()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetActive()
+	s = nil
+	s.GetActive()
 }
 
-func TestReviewersRequest_GetNodeID(tt *testing.T) {
+func TestSCIMEnterpriseUserAttributes_GetDisplayName(tt *testing.T) {
 	var zeroValue string
-	r := &ReviewersRequest{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &ReviewersRequest{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+	s := &SCIMEnterpriseUserAttributes{DisplayName: &zeroValue}
+	s.GetDisplayName()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetDisplayName()
+	s = nil
+	s.GetDisplayName()
 }
 
-func TestRule_GetDescription(tt *testing.T) {
+func TestSCIMEnterpriseUserAttributes_GetExternalID(tt *testing.T) {
 	var zeroValue string
-	r := &Rule{Description: &zeroValue}
-	r.GetDescription()
-	r = &Rule{}
-	r.GetDescription()
-	r = nil
-	r.GetDescription()
+	s := &SCIMEnterpriseUserAttributes{ExternalID: &zeroValue}
+	s.GetExternalID()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetExternalID()
+	s = nil
+	s.GetExternalID()
 }
 
-func TestRule_GetFullDescription(tt *testing.T) {
+func TestSCIMEnterpriseUserAttributes_GetID(tt *testing.T) {
 	var zeroValue string
-	r := &Rule{FullDescription: &zeroValue}
-	r.GetFullDescription()
-	r = &Rule{}
-	r.GetFullDescription()
-	r = nil
-	r.GetFullDescription()
+	s := &SCIMEnterpriseUserAttributes{ID: &zeroValue}
+	s.GetID()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRule_GetHelp(tt *testing.T) {
-	var zeroValue string
-	r := &Rule{Help: &zeroValue}
-	r.GetHelp()
-	r = &Rule{}
-	r.GetHelp()
-	r = nil
-	r.GetHelp()
+func TestSCIMEnterpriseUserAttributes_GetMeta(tt *testing.T) {
+	s := &SCIMEnterpriseUserAttributes{}
+	s.GetMeta()
+	s = nil
+	s.GetMeta()
 }
 
-func TestRule_GetID(tt *testing.T) {
-	var zeroValue string
-	r := &Rule{ID: &zeroValue}
-	r.GetID()
-	r = &Rule{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSCIMEnterpriseUserAttributes_GetName(tt *testing.T) {
+	s := &SCIMEnterpriseUserAttributes{}
+	s.GetName()
+	s = nil
+	s.GetName()
 }
 
-func TestRule_GetName(tt *testing.T) {
-	var zeroValue string
-	r := &Rule{Name: &zeroValue}
-	r.GetName()
-	r = &Rule{}
-	r.GetName()
-	r = nil
-	r.GetName()
+func TestSCIMEnterpriseUsers_GetItemsPerPage(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseUsers{ItemsPerPage: &zeroValue}
+	s.GetItemsPerPage()
+	s = &SCIMEnterpriseUsers{}
+	s.GetItemsPerPage()
+	s = nil
+	s.GetItemsPerPage()
 }
 
-func TestRule_GetSecuritySeverityLevel(tt *testing.T) {
-	var zeroValue string
-	r := &Rule{SecuritySeverityLevel: &zeroValue}
-	r.GetSecuritySeverityLevel()
-	r = &Rule{}
-	r.GetSecuritySeverityLevel()
-	r = nil
-	r.GetSecuritySeverityLevel()
+func TestSCIMEnterpriseUsers_GetStartIndex(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseUsers{StartIndex: &zeroValue}
+	s.GetStartIndex()
+	s = &SCIMEnterpriseUsers{}
+	s.GetStartIndex()
+	s = nil
+	s.GetStartIndex()
 }
 
-func TestRule_GetSeverity(tt *testing.T) {
-	var zeroValue string
-	r := &Rule{Severity: &zeroValue}
-	r.GetSeverity()
-	r = &Rule{}
-	r.GetSeverity()
-	r = nil
-	r.GetSeverity()
+func TestSCIMEnterpriseUsers_GetTotalResults(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseUsers{TotalResults: &zeroValue}
+	s.GetTotalResults()
+	s = &SCIMEnterpriseUsers{}
+	s.GetTotalResults()
+	s = nil
+	s.GetTotalResults()
 }
 
-func TestRunner_GetBusy(tt *testing.T) {
-	var zeroValue bool
-	r := &Runner{Busy: &zeroValue}
-	r.GetBusy()
-	r = &Runner{}
-	r.GetBusy()
-	r = nil
-	r.GetBusy()
+func TestSCIMError_GetErr(tt *testing.T) {
+	s := &SCIMError{}
+	s.GetErr()
+	s = nil
+	s.GetErr()
 }
 
-func TestRunner_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &Runner{ID: &zeroValue}
-	r.GetID()
-	r = &Runner{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSCIMMeta_GetCreated(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SCIMMeta{Created: &zeroValue}
+	s.GetCreated()
+	s = &SCIMMeta{}
+	s.GetCreated()
+	s = nil
+	s.GetCreated()
 }
 
-func TestRunner_GetName(tt *testing.T) {
-	var zeroValue string
-	r := &Runner{Name: &zeroValue}
-	r.GetName()
-	r = &Runner{}
-	r.GetName()
-	r = nil
-	r.GetName()
+func TestSCIMMeta_GetLastModified(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SCIMMeta{LastModified: &zeroValue}
+	s.GetLastModified()
+	s = &SCIMMeta{}
+	s.GetLastModified()
+	s = nil
+	s.GetLastModified()
 }
 
-func TestRunner_GetOS(tt *testing.T) {
+func TestSCIMMeta_GetLocation(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{OS: &zeroValue}
-	r.GetOS()
-	r = &Runner{}
-	r.GetOS()
-	r = nil
-	r.GetOS()
+	s := &SCIMMeta{Location: &zeroValue}
+	s.GetLocation()
+	s = &SCIMMeta{}
+	s.GetLocation()
+	s = nil
+	s.GetLocation()
 }
 
-func TestRunner_GetStatus(tt *testing.T) {
+func TestSCIMMeta_GetResourceType(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{Status: &zeroValue}
-	r.GetStatus()
-	r = &Runner{}
-	r.GetStatus()
-	r = nil
-	r.GetStatus()
+	s := &SCIMMeta{ResourceType: &zeroValue}
+	s.GetResourceType()
+	s = &SCIMMeta{}
+	s.GetResourceType()
+	s = nil
+	s.GetResourceType()
 }
 
-func TestRunnerApplicationDownload_GetArchitecture(tt *testing.T) {
+func TestSCIMPatchOperation_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{Architecture: &zeroValue}
-	r.GetArchitecture()
-	r = &RunnerApplicationDownload{}
-	r.GetArchitecture()
-	r = nil
-	r.GetArchitecture()
+	s := &SCIMPatchOperation{Path: &zeroValue}
+	s.GetPath()
+	s = &SCIMPatchOperation{}
+	s.GetPath()
+	s = nil
+	s.GetPath()
 }
 
-func TestRunnerApplicationDownload_GetDownloadURL(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerApplicationDownload{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RunnerApplicationDownload{}
-	r.GetDownloadURL()
-	r = nil
-	r.GetDownloadURL()
+func TestSCIMProvisionedIdentities_GetItemsPerPage(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMProvisionedIdentities{ItemsPerPage: &zeroValue}
+	s.GetItemsPerPage()
+	s = &SCIMProvisionedIdentities{}
+	s.GetItemsPerPage()
+	s = nil
+	s.GetItemsPerPage()
 }
 
-func TestRunnerApplicationDownload_GetFilename(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerApplicationDownload{Filename: &zeroValue}
-	r.GetFilename()
-	r = &RunnerApplicationDownload{}
-	r.GetFilename()
-	r = nil
-	r.GetFilename()
+func TestSCIMProvisionedIdentities_GetStartIndex(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMProvisionedIdentities{StartIndex: &zeroValue}
+	s.GetStartIndex()
+	s = &SCIMProvisionedIdentities{}
+	s.GetStartIndex()
+	s = nil
+	s.GetStartIndex()
 }
 
-func TestRunnerApplicationDownload_GetOS(tt *testing.T) {
+func TestSCIMProvisionedIdentities_GetTotalResults(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMProvisionedIdentities{TotalResults: &zeroValue}
+	s.GetTotalResults()
+	s = &SCIMProvisionedIdentities{}
+	s.GetTotalResults()
+	s = nil
+	s.GetTotalResults()
+}
+
+func TestSCIMUserAttributes_GetActive(tt *testing.T) {
+	var zeroValue bool
+	s := &SCIMUserAttributes{Active: &zeroValue}
+	s.GetActive()
+	s = &SCIMUserAttributes{}
+	s.GetActive()
+	s = nil
+	s.GetActive()
+}
+
+func TestSCIMUserAttributes_GetDisplayName(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{OS: &zeroValue}
-	r.GetOS()
-	r = &RunnerApplicationDownload{}
-	r.GetOS()
-	r = nil
-	r.GetOS()
+	s := &SCIMUserAttributes{DisplayName: &zeroValue}
+	s.GetDisplayName()
+	s = &SCIMUserAttributes{}
+	s.GetDisplayName()
+	s = nil
+	s.GetDisplayName()
 }
 
-func TestRunnerApplicationDownload_GetSHA256Checksum(tt *testing.T) {
+func TestSCIMUserAttributes_GetExternalID(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{SHA256Checksum: &zeroValue}
-	r.GetSHA256Checksum()
-	r = &RunnerApplicationDownload{}
-	r.GetSHA256Checksum()
-	r = nil
-	r.GetSHA256Checksum()
+	s := &SCIMUserAttributes{ExternalID: &zeroValue}
+	s.GetExternalID()
+	s = &SCIMUserAttributes{}
+	s.GetExternalID()
+	s = nil
+	s.GetExternalID()
 }
 
-func TestRunnerApplicationDownload_GetTempDownloadToken(tt *testing.T) {
+func TestSCIMUserAttributes_GetID(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{TempDownloadToken: &zeroValue}
-	r.GetTempDownloadToken()
-	r = &RunnerApplicationDownload{}
-	r.GetTempDownloadToken()
-	r = nil
-	r.GetTempDownloadToken()
+	s := &SCIMUserAttributes{ID: &zeroValue}
+	s.GetID()
+	s = &SCIMUserAttributes{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRunnerGroup_GetAllowsPublicRepositories(tt *testing.T) {
-	var zeroValue bool
-	r := &RunnerGroup{AllowsPublicRepositories: &zeroValue}
-	r.GetAllowsPublicRepositories()
-	r = &RunnerGroup{}
-	r.GetAllowsPublicRepositories()
-	r = nil
-	r.GetAllowsPublicRepositories()
+func TestSCIMUserAttributes_GetMeta(tt *testing.T) {
+	s := &SCIMUserAttributes{}
+	s.GetMeta()
+	s = nil
+	s.GetMeta()
 }
 
-func TestRunnerGroup_GetDefault(tt *testing.T) {
+func TestSCIMUserEmail_GetPrimary(tt *testing.T) {
 	var zeroValue bool
-	r := &RunnerGroup{Default: &zeroValue}
-	r.GetDefault()
-	r = &RunnerGroup{}
-	r.GetDefault()
-	r = nil
-	r.GetDefault()
+	s := &SCIMUserEmail{Primary: &zeroValue}
+	s.GetPrimary()
+	s = &SCIMUserEmail{}
+	s.GetPrimary()
+	s = nil
+	s.GetPrimary()
 }
 
-func TestRunnerGroup_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RunnerGroup{ID: &zeroValue}
-	r.GetID()
-	r = &RunnerGroup{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSCIMUserEmail_GetType(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMUserEmail{Type: &zeroValue}
+	s.GetType()
+	s = &SCIMUserEmail{}
+	s.GetType()
+	s = nil
+	s.GetType()
 }
 
-func TestRunnerGroup_GetInherited(tt *testing.T) {
-	var zeroValue bool
-	r := &RunnerGroup{Inherited: &zeroValue}
-	r.GetInherited()
-	r = &RunnerGroup{}
-	r.GetInherited()
-	r = nil
-	r.GetInherited()
+func TestSCIMUserName_GetFormatted(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMUserName{Formatted: &zeroValue}
+	s.GetFormatted()
+	s = &SCIMUserName{}
+	s.GetFormatted()
+	s = nil
+	s.GetFormatted()
 }
 
-func TestRunnerGroup_GetName(tt *testing.T) {
+func TestSCIMUserRole_GetDisplay(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerGroup{Name: &zeroValue}
-	r.GetName()
-	r = &RunnerGroup{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SCIMUserRole{Display: &zeroValue}
+	s.GetDisplay()
+	s = &SCIMUserRole{}
+	s.GetDisplay()
+	s = nil
+	s.GetDisplay()
 }
 
-func TestRunnerGroup_GetRestrictedToWorkflows(tt *testing.T) {
+func TestSCIMUserRole_GetPrimary(tt *testing.T) {
 	var zeroValue bool
-	r := &RunnerGroup{RestrictedToWorkflows: &zeroValue}
-	r.GetRestrictedToWorkflows()
-	r = &RunnerGroup{}
-	r.GetRestrictedToWorkflows()
-	r = nil
-	r.GetRestrictedToWorkflows()
+	s := &SCIMUserRole{Primary: &zeroValue}
+	s.GetPrimary()
+	s = &SCIMUserRole{}
+	s.GetPrimary()
+	s = nil
+	s.GetPrimary()
 }
 
-func TestRunnerGroup_GetRunnersURL(tt *testing.T) {
+func TestSCIMUserRole_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerGroup{RunnersURL: &zeroValue}
-	r.GetRunnersURL()
-	r = &RunnerGroup{}
-	r.GetRunnersURL()
-	r = nil
-	r.GetRunnersURL()
+	s := &SCIMUserRole{Type: &zeroValue}
+	s.GetType()
+	s = &SCIMUserRole{}
+	s.GetType()
+	s = nil
+	s.GetType()
 }
 
-func TestRunnerGroup_GetSelectedRepositoriesURL(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerGroup{SelectedRepositoriesURL: &zeroValue}
-	r.GetSelectedRepositoriesURL()
-	r = &RunnerGroup{}
-	r.GetSelectedRepositoriesURL()
-	r = nil
-	r.GetSelectedRepositoriesURL()
+func TestScopedTokenOptions_GetPermissions(tt *testing.T) {
+	s := &ScopedTokenOptions{}
+	s.GetPermissions()
+	s = nil
+	s.GetPermissions()
 }
 
-func TestRunnerGroup_GetVisibility(tt *testing.T) {
+func TestScopedTokenOptions_GetTarget(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerGroup{Visibility: &zeroValue}
-	r.GetVisibility()
-	r = &RunnerGroup{}
-	r.GetVisibility()
-	r = nil
-	r.GetVisibility()
-}
-
-func TestRunnerGroup_GetWorkflowRestrictionsReadOnly(tt *testing.T) {
-	var zeroValue bool
-	r := &RunnerGroup{WorkflowRestrictionsReadOnly: &zeroValue}
-	r.GetWorkflowRestrictionsReadOnly()
-	r = &RunnerGroup{}
-	r.GetWorkflowRestrictionsReadOnly()
-	r = nil
-	r.GetWorkflowRestrictionsReadOnly()
+	s := &ScopedTokenOptions{Target: &zeroValue}
+	s.GetTarget()
+	s = &ScopedTokenOptions{}
+	s.GetTarget()
+	s = nil
+	s.GetTarget()
 }
 
-func TestRunnerLabels_GetID(tt *testing.T) {
+func TestScopedTokenOptions_GetTargetID(tt *testing.T) {
 	var zeroValue int64
-	r := &RunnerLabels{ID: &zeroValue}
-	r.GetID()
-	r = &RunnerLabels{}
-	r.GetID()
-	r = nil
-	r.GetID()
+	s := &ScopedTokenOptions{TargetID: &zeroValue}
+	s.GetTargetID()
+	s = &ScopedTokenOptions{}
+	s.GetTargetID()
+	s = nil
+	s.GetTargetID()
 }
 
-func TestRunnerLabels_GetName(tt *testing.T) {
+func TestSecretScanning_GetStatus(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerLabels{Name: &zeroValue}
-	r.GetName()
-	r = &RunnerLabels{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SecretScanning{Status: &zeroValue}
+	s.GetStatus()
+	s = &SecretScanning{}
+	s.GetStatus()
+	s = nil
+	s.GetStatus()
 }
 
-func TestRunnerLabels_GetType(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerLabels{Type: &zeroValue}
-	r.GetType()
-	r = &RunnerLabels{}
-	r.GetType()
-	r = nil
-	r.GetType()
+func TestSecretScanningAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecretScanningAlert{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
 }
 
-func TestSarifAnalysis_GetCheckoutURI(tt *testing.T) {
+func TestSecretScanningAlert_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	s := &SarifAnalysis{CheckoutURI: &zeroValue}
-	s.GetCheckoutURI()
-	s = &SarifAnalysis{}
-	s.GetCheckoutURI()
+	s := &SecretScanningAlert{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecretScanningAlert{}
+	s.GetHTMLURL()
 	s = nil
-	s.GetCheckoutURI()
+	s.GetHTMLURL()
 }
 
-func TestSarifAnalysis_GetCommitSHA(tt *testing.T) {
+func TestSecretScanningAlert_GetLocationsURL(tt *testing.T) {
 	var zeroValue string
-	s := &SarifAnalysis{CommitSHA: &zeroValue}
-	s.GetCommitSHA()
-	s = &SarifAnalysis{}
-	s.GetCommitSHA()
+	s := &SecretScanningAlert{LocationsURL: &zeroValue}
+	s.GetLocationsURL()
+	s = &SecretScanningAlert{}
+	s.GetLocationsURL()
 	s = nil
-	s.GetCommitSHA()
+	s.GetLocationsURL()
 }
 
-func TestSarifAnalysis_GetRef(tt *testing.T) {
-	var zeroValue string
-	s := &SarifAnalysis{Ref: &zeroValue}
-	s.GetRef()
-	s = &SarifAnalysis{}
-	s.GetRef()
+func TestSecretScanningAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningAlert{Number: &zeroValue}
+	s.GetNumber()
+	s = &SecretScanningAlert{}
+	s.GetNumber()
 	s = nil
-	s.GetRef()
+	s.GetNumber()
 }
 
-func TestSarifAnalysis_GetSarif(tt *testing.T) {
+func TestSecretScanningAlert_GetRepository(tt *testing.T) {
+	s := &SecretScanningAlert{}
+	s.GetRepository()
+	s = nil
+	s.GetRepository()
+}
+
+func TestSecretScanningAlert_GetResolution(tt *testing.T) {
 	var zeroValue string
-	s := &SarifAnalysis{Sarif: &zeroValue}
-	s.GetSarif()
-	s = &SarifAnalysis{}
-	s.GetSarif()
+	s := &SecretScanningAlert{Resolution: &zeroValue}
+	s.GetResolution()
+	s = &SecretScanningAlert{}
+	s.GetResolution()
 	s = nil
-	s.GetSarif()
+	s.GetResolution()
 }
 
-func TestSarifAnalysis_GetStartedAt(tt *testing.T) {
+func TestSecretScanningAlert_GetResolvedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	s := &SarifAnalysis{StartedAt: &zeroValue}
-	s.GetStartedAt()
-	s = &SarifAnalysis{}
-	s.GetStartedAt()
+	s := &SecretScanningAlert{ResolvedAt: &zeroValue}
+	s.GetResolvedAt()
+	s = &SecretScanningAlert{}
+	s.GetResolvedAt()
 	s = nil
-	s.GetStartedAt()
+	s.GetResolvedAt()
 }
 
-func TestSarifAnalysis_GetToolName(tt *testing.T) {
-	var zeroValue string
-	s := &SarifAnalysis{ToolName: &zeroValue}
-	s.GetToolName()
-	s = &SarifAnalysis{}
-	s.GetToolName()
+func TestSecretScanningAlert_GetResolvedBy(tt *testing.T) {
+	s := &SecretScanningAlert{}
+	s.GetResolvedBy()
 	s = nil
-	s.GetToolName()
+	s.GetResolvedBy()
 }
 
-func TestSarifID_GetID(tt *testing.T) {
+func TestSecretScanningAlert_GetSecret(tt *testing.T) {
 	var zeroValue string
-	s := &SarifID{ID: &zeroValue}
-	s.GetID()
-	s = &SarifID{}
-	s.GetID()
+	s := &SecretScanningAlert{Secret: &zeroValue}
+	s.GetSecret()
+	s = &SecretScanningAlert{}
+	s.GetSecret()
 	s = nil
-	s.GetID()
+	s.GetSecret()
 }
 
-func TestSarifID_GetURL(tt *testing.T) {
+func TestSecretScanningAlert_GetSecretType(tt *testing.T) {
 	var zeroValue string
-	s := &SarifID{URL: &zeroValue}
-	s.GetURL()
-	s = &SarifID{}
-	s.GetURL()
+	s := &SecretScanningAlert{SecretType: &zeroValue}
+	s.GetSecretType()
+	s = &SecretScanningAlert{}
+	s.GetSecretType()
 	s = nil
-	s.GetURL()
+	s.GetSecretType()
 }
 
-func TestScanningAnalysis_GetAnalysisKey(tt *testing.T) {
+func TestSecretScanningAlert_GetSecretTypeDisplayName(tt *testing.T) {
 	var zeroValue string
-	s := &ScanningAnalysis{AnalysisKey: &zeroValue}
-	s.GetAnalysisKey()
-	s = &ScanningAnalysis{}
-	s.GetAnalysisKey()
+	s := &SecretScanningAlert{SecretTypeDisplayName: &zeroValue}
+	s.GetSecretTypeDisplayName()
+	s = &SecretScanningAlert{}
+	s.GetSecretTypeDisplayName()
 	s = nil
-	s.GetAnalysisKey()
+	s.GetSecretTypeDisplayName()
 }
 
-func TestScanningAnalysis_GetCategory(tt *testing.T) {
+func TestSecretScanningAlert_GetState(tt *testing.T) {
 	var zeroValue string
-	s := &ScanningAnalysis{Category: &zeroValue}
-	s.GetCategory()
-	s = &ScanningAnalysis{}
-	s.GetCategory()
+	s := &SecretScanningAlert{State: &zeroValue}
+	s.GetState()
+	s = &SecretScanningAlert{}
+	s.GetState()
 	s = nil
-	s.GetCategory()
+	s.GetState()
 }
 
-func TestScanningAnalysis_GetCommitSHA(tt *testing.T) {
+func TestSecretScanningAlert_GetURL(tt *testing.T) {
 	var zeroValue string
-	s := &ScanningAnalysis{CommitSHA: &zeroValue}
-	s.GetCommitSHA()
-	s = &ScanningAnalysis{}
-	s.GetCommitSHA()
+	s := &SecretScanningAlert{URL: &zeroValue}
+	s.GetURL()
+	s = &SecretScanningAlert{}
+	s.GetURL()
 	s = nil
-	s.GetCommitSHA()
+	s.GetURL()
 }
 
-func TestScanningAnalysis_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	s := &ScanningAnalysis{CreatedAt: &zeroValue}
-	s.GetCreatedAt()
-	s = &ScanningAnalysis{}
-	s.GetCreatedAt()
+func TestSecretScanningAlertEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SecretScanningAlertEvent{}
+	s.GetAction()
 	s = nil
-	s.GetCreatedAt()
+	s.GetAction()
 }
 
-func TestScanningAnalysis_GetDeletable(tt *testing.T) {
-	var zeroValue bool
-	s := &ScanningAnalysis{Deletable: &zeroValue}
-	s.GetDeletable()
-	s = &ScanningAnalysis{}
-	s.GetDeletable()
+func TestSecretScanningAlertEvent_GetAlert(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetAlert()
 	s = nil
-	s.GetDeletable()
+	s.GetAlert()
 }
 
-func TestScanningAnalysis_GetEnvironment(tt *testing.T) {
-	var zeroValue string
-	s := &ScanningAnalysis{Environment: &zeroValue}
-	s.GetEnvironment()
-	s = &ScanningAnalysis{}
-	s.GetEnvironment()
+func TestSecretScanningAlertEvent_GetEnterprise(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetEnterprise()
 	s = nil
-	s.GetEnvironment()
+	s.GetEnterprise()
 }
 
-func TestScanningAnalysis_GetError(tt *testing.T) {
-	var zeroValue string
-	s := &ScanningAnalysis{Error: &zeroValue}
-	s.GetError()
-	s = &ScanningAnalysis{}
-	s.GetError()
+func TestSecretScanningAlertEvent_GetInstallation(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetInstallation()
 	s = nil
-	s.GetError()
+	s.GetInstallation()
 }
 
-func TestScanningAnalysis_GetID(tt *testing.T) {
-	var zeroValue int64
-	s := &ScanningAnalysis{ID: &zeroValue}
-	s.GetID()
-	s = &ScanningAnalysis{}
-	s.GetID()
+func TestSecretScanningAlertEvent_GetOrganization(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetOrganization()
 	s = nil
-	s.GetID()
+	s.GetOrganization()
 }
 
-func TestScanningAnalysis_GetRef(tt *testing.T) {
-	var zeroValue string
-	s := &ScanningAnalysis{Ref: &zeroValue}
-	s.GetRef()
-	s = &ScanningAnalysis{}
-	s.GetRef()
+func TestSecretScanningAlertEvent_GetRepo(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetRepo()
 	s = nil
-	s.GetRef()
+	s.GetRepo()
 }
 
-func TestScanningAnalysis_GetResultsCount(tt *testing.T) {
-	var zeroValue int
-	s := &ScanningAnalysis{ResultsCount: &zeroValue}
-	s.GetResultsCount()
-	s = &ScanningAnalysis{}
-	s.GetResultsCount()
+func TestSecretScanningAlertEvent_GetSender(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetSender()
 	s = nil
-	s.GetResultsCount()
+	s.GetSender()
 }
 
-func TestScanningAnalysis_GetRulesCount(tt *testing.T) {
-	var zeroValue int
-	s := &ScanningAnalysis{RulesCount: &zeroValue}
-	s.GetRulesCount()
-	s = &ScanningAnalysis{}
-	s.GetRulesCount()
+func TestSecretScanningAlertLocation_GetDetails(tt *testing.T) {
+	s := &SecretScanningAlertLocation{}
+	s.GetDetails()
 	s = nil
-	s.GetRulesCount()
+	s.GetDetails()
 }
 
-func TestScanningAnalysis_GetSarifID(tt *testing.T) {
+func TestSecretScanningAlertLocation_GetType(tt *testing.T) {
 	var zeroValue string
-	s := &ScanningAnalysis{SarifID: &zeroValue}
-	s.GetSarifID()
-	s = &ScanningAnalysis{}
-	s.GetSarifID()
+	s := &SecretScanningAlertLocation{Type: &zeroValue}
+	s.GetType()
+	s = &SecretScanningAlertLocation{}
+	s.GetType()
 	s = nil
-	s.GetSarifID()
+	s.GetType()
 }
 
-func TestScanningAnalysis_GetTool(tt *testing.T) {
-	s := &ScanningAnalysis{}
-	s.GetTool()
+func TestSecretScanningAlertLocationDetails_GetBlobSHA(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{BlobSHA: &zeroValue}
+	s.GetBlobSHA()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetBlobSHA()
 	s = nil
-	s.GetTool()
+	s.GetBlobSHA()
 }
 
-func TestScanningAnalysis_GetURL(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetBlobURL(tt *testing.T) {
 	var zeroValue string
-	s := &ScanningAnalysis{URL: &zeroValue}
-	s.GetURL()
-	s = &ScanningAnalysis{}
-	s.GetURL()
+	s := &SecretScanningAlertLocationDetails{BlobURL: &zeroValue}
+	s.GetBlobURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetBlobURL()
 	s = nil
-	s.GetURL()
+	s.GetBlobURL()
 }
 
-func TestScanningAnalysis_GetWarning(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetCommitSHA(tt *testing.T) {
 	var zeroValue string
-	s := &ScanningAnalysis{Warning: &zeroValue}
-	s.GetWarning()
-	s = &ScanningAnalysis{}
-	s.GetWarning()
+	s := &SecretScanningAlertLocationDetails{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetCommitSHA()
 	s = nil
-	s.GetWarning()
+	s.GetCommitSHA()
 }
 
-func TestSCIMMeta_GetCreated(tt *testing.T) {
-	var zeroValue Timestamp
-	s := &SCIMMeta{Created: &zeroValue}
-	s.GetCreated()
-	s = &SCIMMeta{}
-	s.GetCreated()
+func TestSecretScanningAlertLocationDetails_GetCommitURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{CommitURL: &zeroValue}
+	s.GetCommitURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetCommitURL()
 	s = nil
-	s.GetCreated()
+	s.GetCommitURL()
 }
 
-func TestSCIMMeta_GetLastModified(tt *testing.T) {
-	var zeroValue Timestamp
-	s := &SCIMMeta{LastModified: &zeroValue}
-	s.GetLastModified()
-	s = &SCIMMeta{}
-	s.GetLastModified()
+func TestSecretScanningAlertLocationDetails_GetDiscussionBodyURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{DiscussionBodyURL: &zeroValue}
+	s.GetDiscussionBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionBodyURL()
 	s = nil
-	s.GetLastModified()
+	s.GetDiscussionBodyURL()
 }
 
-func TestSCIMMeta_GetLocation(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetDiscussionCommentURL(tt *testing.T) {
 	var zeroValue string
-	s := &SCIMMeta{Location: &zeroValue}
-	s.GetLocation()
-	s = &SCIMMeta{}
-	s.GetLocation()
+	s := &SecretScanningAlertLocationDetails{DiscussionCommentURL: &zeroValue}
+	s.GetDiscussionCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionCommentURL()
 	s = nil
-	s.GetLocation()
+	s.GetDiscussionCommentURL()
 }
 
-func TestSCIMMeta_GetResourceType(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetDiscussionTitleURL(tt *testing.T) {
 	var zeroValue string
-	s := &SCIMMeta{ResourceType: &zeroValue}
-	s.GetResourceType()
-	s = &SCIMMeta{}
-	s.GetResourceType()
+	s := &SecretScanningAlertLocationDetails{DiscussionTitleURL: &zeroValue}
+	s.GetDiscussionTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionTitleURL()
 	s = nil
-	s.GetResourceType()
+	s.GetDiscussionTitleURL()
 }
 
-func TestSCIMProvisionedIdentities_GetItemsPerPage(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetEndColumn(tt *testing.T) {
 	var zeroValue int
-	s := &SCIMProvisionedIdentities{ItemsPerPage: &zeroValue}
-	s.GetItemsPerPage()
-	s = &SCIMProvisionedIdentities{}
-	s.GetItemsPerPage()
+	s := &SecretScanningAlertLocationDetails{EndColumn: &zeroValue}
+	s.GetEndColumn()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetEndColumn()
 	s = nil
-	s.GetItemsPerPage()
+	s.GetEndColumn()
 }
 
-func TestSCIMProvisionedIdentities_GetStartIndex(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetEndLine(tt *testing.T) {
 	var zeroValue int
-	s := &SCIMProvisionedIdentities{StartIndex: &zeroValue}
-	s.GetStartIndex()
-	s = &SCIMProvisionedIdentities{}
-	s.GetStartIndex()
+	s := &SecretScanningAlertLocationDetails{EndLine: &zeroValue}
+	s.GetEndLine()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetEndLine()
 	s = nil
-	s.GetStartIndex()
+	s.GetEndLine()
 }
 
-func TestSCIMProvisionedIdentities_GetTotalResults(tt *testing.T) {
-	var zeroValue int
-	s := &SCIMProvisionedIdentities{TotalResults: &zeroValue}
-	s.GetTotalResults()
-	s = &SCIMProvisionedIdentities{}
-	s.GetTotalResults()
+func TestSecretScanningAlertLocationDetails_GetIssueBodyURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{IssueBodyURL: &zeroValue}
+	s.GetIssueBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueBodyURL()
 	s = nil
-	s.GetTotalResults()
+	s.GetIssueBodyURL()
 }
 
-func TestSCIMUserAttributes_GetActive(tt *testing.T) {
-	var zeroValue bool
-	s := &SCIMUserAttributes{Active: &zeroValue}
-	s.GetActive()
-	s = &SCIMUserAttributes{}
-	s.GetActive()
+func TestSecretScanningAlertLocationDetails_GetIssueCommentURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{IssueCommentURL: &zeroValue}
+	s.GetIssueCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueCommentURL()
 	s = nil
-	s.GetActive()
+	s.GetIssueCommentURL()
 }
 
-func TestSCIMUserAttributes_GetDisplayName(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetIssueTitleURL(tt *testing.T) {
 	var zeroValue string
-	s := &SCIMUserAttributes{DisplayName: &zeroValue}
-	s.GetDisplayName()
-	s = &SCIMUserAttributes{}
-	s.GetDisplayName()
+	s := &SecretScanningAlertLocationDetails{IssueTitleURL: &zeroValue}
+	s.GetIssueTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueTitleURL()
 	s = nil
-	s.GetDisplayName()
+	s.GetIssueTitleURL()
 }
 
-func TestSCIMUserAttributes_GetExternalID(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPageURL(tt *testing.T) {
 	var zeroValue string
-	s := &SCIMUserAttributes{ExternalID: &zeroValue}
-	s.GetExternalID()
-	s = &SCIMUserAttributes{}
-	s.GetExternalID()
+	s := &SecretScanningAlertLocationDetails{PageURL: &zeroValue}
+	s.GetPageURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPageURL()
 	s = nil
-	s.GetExternalID()
+	s.GetPageURL()
 }
 
-func TestSCIMUserAttributes_GetID(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPath(tt *testing.T) {
 	var zeroValue string
-	s := &SCIMUserAttributes{ID: &zeroValue}
-	s.GetID()
-	s = &SCIMUserAttributes{}
-	s.GetID()
+	s := &SecretScanningAlertLocationDetails{Path: &zeroValue}
+	s.GetPath()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPath()
 	s = nil
-	s.GetID()
+	s.GetPath()
 }
 
-func TestSCIMUserAttributes_GetMeta(tt *testing.T) {
-	s := &SCIMUserAttributes{}
-	s.GetMeta()
+func TestSecretScanningAlertLocationDetails_GetPullRequestBodyURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PullRequestBodyURL: &zeroValue}
+	s.GetPullRequestBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestBodyURL()
 	s = nil
-	s.GetMeta()
+	s.GetPullRequestBodyURL()
 }
 
-func TestSCIMUserEmail_GetPrimary(tt *testing.T) {
-	var zeroValue bool
-	s := &SCIMUserEmail{Primary: &zeroValue}
-	s.GetPrimary()
-	s = &SCIMUserEmail{}
-	s.GetPrimary()
+func TestSecretScanningAlertLocationDetails_GetPullRequestCommentURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PullRequestCommentURL: &zeroValue}
+	s.GetPullRequestCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestCommentURL()
 	s = nil
-	s.GetPrimary()
+	s.GetPullRequestCommentURL()
 }
 
-func TestSCIMUserEmail_GetType(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPullRequestReviewCommentURL(tt *testing.T) {
 	var zeroValue string
-	s := &SCIMUserEmail{Type: &zeroValue}
-	s.GetType()
-	s = &SCIMUserEmail{}
-	s.GetType()
+	s := &SecretScanningAlertLocationDetails{PullRequestReviewCommentURL: &zeroValue}
+	s.GetPullRequestReviewCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestReviewCommentURL()
 	s = nil
-	s.GetType()
+	s.GetPullRequestReviewCommentURL()
 }
 
-func TestSCIMUserName_GetFormatted(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPullRequestReviewURL(tt *testing.T) {
 	var zeroValue string
-	s := &SCIMUserName{Formatted: &zeroValue}
-	s.GetFormatted()
-	s = &SCIMUserName{}
-	s.GetFormatted()
+	s := &SecretScanningAlertLocationDetails{PullRequestReviewURL: &zeroValue}
+	s.GetPullRequestReviewURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestReviewURL()
 	s = nil
-	s.GetFormatted()
+	s.GetPullRequestReviewURL()
 }
 
-func TestSecretScanning_GetStatus(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPullRequestTitleURL(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanning{Status: &zeroValue}
-	s.GetStatus()
-	s = &SecretScanning{}
-	s.GetStatus()
+	s := &SecretScanningAlertLocationDetails{PullRequestTitleURL: &zeroValue}
+	s.GetPullRequestTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestTitleURL()
 	s = nil
-	s.GetStatus()
+	s.GetPullRequestTitleURL()
 }
 
-func TestSecretScanningAlert_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	s := &SecretScanningAlert{CreatedAt: &zeroValue}
-	s.GetCreatedAt()
-	s = &SecretScanningAlert{}
-	s.GetCreatedAt()
+func TestSecretScanningAlertLocationDetails_GetStartColumn(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningAlertLocationDetails{StartColumn: &zeroValue}
+	s.GetStartColumn()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetStartColumn()
 	s = nil
-	s.GetCreatedAt()
+	s.GetStartColumn()
 }
 
-func TestSecretScanningAlert_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	s := &SecretScanningAlert{HTMLURL: &zeroValue}
-	s.GetHTMLURL()
-	s = &SecretScanningAlert{}
-	s.GetHTMLURL()
+func TestSecretScanningAlertLocationDetails_GetStartline(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningAlertLocationDetails{Startline: &zeroValue}
+	s.GetStartline()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetStartline()
 	s = nil
-	s.GetHTMLURL()
+	s.GetStartline()
 }
 
-func TestSecretScanningAlert_GetLocationsURL(tt *testing.T) {
+func TestSecretScanningAlertLocationEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlert{LocationsURL: &zeroValue}
-	s.GetLocationsURL()
-	s = &SecretScanningAlert{}
-	s.GetLocationsURL()
+	s := &SecretScanningAlertLocationEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SecretScanningAlertLocationEvent{}
+	s.GetAction()
 	s = nil
-	s.GetLocationsURL()
+	s.GetAction()
 }
 
-func TestSecretScanningAlert_GetNumber(tt *testing.T) {
-	var zeroValue int
-	s := &SecretScanningAlert{Number: &zeroValue}
-	s.GetNumber()
-	s = &SecretScanningAlert{}
-	s.GetNumber()
+func TestSecretScanningAlertLocationEvent_GetAlert(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetAlert()
 	s = nil
-	s.GetNumber()
+	s.GetAlert()
 }
 
-func TestSecretScanningAlert_GetResolution(tt *testing.T) {
-	var zeroValue string
-	s := &SecretScanningAlert{Resolution: &zeroValue}
-	s.GetResolution()
-	s = &SecretScanningAlert{}
-	s.GetResolution()
+func TestSecretScanningAlertLocationEvent_GetEnterprise(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetEnterprise()
 	s = nil
-	s.GetResolution()
+	s.GetEnterprise()
 }
 
-func TestSecretScanningAlert_GetResolvedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	s := &SecretScanningAlert{ResolvedAt: &zeroValue}
-	s.GetResolvedAt()
-	s = &SecretScanningAlert{}
-	s.GetResolvedAt()
+func TestSecretScanningAlertLocationEvent_GetInstallation(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetInstallation()
 	s = nil
-	s.GetResolvedAt()
+	s.GetInstallation()
 }
 
-func TestSecretScanningAlert_GetResolvedBy(tt *testing.T) {
-	s := &SecretScanningAlert{}
-	s.GetResolvedBy()
+func TestSecretScanningAlertLocationEvent_GetLocation(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetLocation()
 	s = nil
-	s.GetResolvedBy()
+	s.GetLocation()
 }
 
-func TestSecretScanningAlert_GetSecret(tt *testing.T) {
+func TestSecretScanningAlertLocationEvent_GetOrganization(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetOrganization()
+	s = nil
+	s.GetOrganization()
+}
+
+func TestSecretScanningAlertLocationEvent_GetRepo(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
+}
+
+func TestSecretScanningAlertLocationEvent_GetSender(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSecretScanningAlertUpdateOptions_GetResolution(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlert{Secret: &zeroValue}
-	s.GetSecret()
-	s = &SecretScanningAlert{}
-	s.GetSecret()
+	s := &SecretScanningAlertUpdateOptions{Resolution: &zeroValue}
+	s.GetResolution()
+	s = &SecretScanningAlertUpdateOptions{}
+	s.GetResolution()
 	s = nil
-	s.GetSecret()
+	s.GetResolution()
 }
 
-func TestSecretScanningAlert_GetSecretType(tt *testing.T) {
+func TestSecretScanningAlertUpdateOptions_GetSecretType(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlert{SecretType: &zeroValue}
+	s := &SecretScanningAlertUpdateOptions{SecretType: &zeroValue}
 	s.GetSecretType()
-	s = &SecretScanningAlert{}
+	s = &SecretScanningAlertUpdateOptions{}
 	s.GetSecretType()
 	s = nil
 	s.GetSecretType()
 }
 
-func TestSecretScanningAlert_GetState(tt *testing.T) {
+func TestSecretScanningAlertUpdateOptions_GetState(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlert{State: &zeroValue}
+	s := &SecretScanningAlertUpdateOptions{State: &zeroValue}
 	s.GetState()
-	s = &SecretScanningAlert{}
+	s = &SecretScanningAlertUpdateOptions{}
 	s.GetState()
 	s = nil
 	s.GetState()
 }
 
-func TestSecretScanningAlert_GetURL(tt *testing.T) {
+func TestSecretScanningBypassData_GetLocation(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlert{URL: &zeroValue}
-	s.GetURL()
-	s = &SecretScanningAlert{}
-	s.GetURL()
+	s := &SecretScanningBypassData{Location: &zeroValue}
+	s.GetLocation()
+	s = &SecretScanningBypassData{}
+	s.GetLocation()
 	s = nil
-	s.GetURL()
+	s.GetLocation()
 }
 
-func TestSecretScanningAlertEvent_GetAction(tt *testing.T) {
+func TestSecretScanningBypassData_GetPath(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlertEvent{Action: &zeroValue}
-	s.GetAction()
-	s = &SecretScanningAlertEvent{}
-	s.GetAction()
+	s := &SecretScanningBypassData{Path: &zeroValue}
+	s.GetPath()
+	s = &SecretScanningBypassData{}
+	s.GetPath()
 	s = nil
-	s.GetAction()
+	s.GetPath()
 }
 
-func TestSecretScanningAlertEvent_GetAlert(tt *testing.T) {
-	s := &SecretScanningAlertEvent{}
-	s.GetAlert()
+func TestSecretScanningBypassData_GetSecretType(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningBypassData{SecretType: &zeroValue}
+	s.GetSecretType()
+	s = &SecretScanningBypassData{}
+	s.GetSecretType()
 	s = nil
-	s.GetAlert()
+	s.GetSecretType()
 }
 
-func TestSecretScanningAlertEvent_GetEnterprise(tt *testing.T) {
-	s := &SecretScanningAlertEvent{}
-	s.GetEnterprise()
+func TestSecretScanningBypassRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningBypassRequest{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecretScanningBypassRequest{}
+	s.GetCreatedAt()
 	s = nil
-	s.GetEnterprise()
+	s.GetCreatedAt()
 }
 
-func TestSecretScanningAlertEvent_GetInstallation(tt *testing.T) {
-	s := &SecretScanningAlertEvent{}
-	s.GetInstallation()
+func TestSecretScanningBypassRequest_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningBypassRequest{ExpiresAt: &zeroValue}
+	s.GetExpiresAt()
+	s = &SecretScanningBypassRequest{}
+	s.GetExpiresAt()
 	s = nil
-	s.GetInstallation()
+	s.GetExpiresAt()
 }
 
-func TestSecretScanningAlertEvent_GetOrganization(tt *testing.T) {
-	s := &SecretScanningAlertEvent{}
-	s.GetOrganization()
+func TestSecretScanningBypassRequest_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningBypassRequest{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecretScanningBypassRequest{}
+	s.GetHTMLURL()
 	s = nil
-	s.GetOrganization()
+	s.GetHTMLURL()
 }
 
-func TestSecretScanningAlertEvent_GetRepo(tt *testing.T) {
-	s := &SecretScanningAlertEvent{}
-	s.GetRepo()
+func TestSecretScanningBypassRequest_GetID(tt *testing.T) {
+	var zeroValue int64
+	s := &SecretScanningBypassRequest{ID: &zeroValue}
+	s.GetID()
+	s = &SecretScanningBypassRequest{}
+	s.GetID()
 	s = nil
-	s.GetRepo()
+	s.GetID()
 }
 
-func TestSecretScanningAlertEvent_GetSender(tt *testing.T) {
-	s := &SecretScanningAlertEvent{}
-	s.GetSender()
+func TestSecretScanningBypassRequest_GetNumber(tt *testing.T) {
+	var zeroValue int64
+	s := &SecretScanningBypassRequest{Number: &zeroValue}
+	s.GetNumber()
+	s = &SecretScanningBypassRequest{}
+	s.GetNumber()
+	s = nil
+	s.GetNumber()
+}
+
+func TestSecretScanningBypassRequest_GetOrganization(tt *testing.T) {
+	s := &SecretScanningBypassRequest{}
+	s.GetOrganization()
 	s = nil
-	s.GetSender()
+	s.GetOrganization()
 }
 
-func TestSecretScanningAlertLocation_GetDetails(tt *testing.T) {
-	s := &SecretScanningAlertLocation{}
-	s.GetDetails()
+func TestSecretScanningBypassRequest_GetRepository(tt *testing.T) {
+	s := &SecretScanningBypassRequest{}
+	s.GetRepository()
 	s = nil
-	s.GetDetails()
+	s.GetRepository()
 }
 
-func TestSecretScanningAlertLocation_GetType(tt *testing.T) {
-	var zeroValue string
-	s := &SecretScanningAlertLocation{Type: &zeroValue}
-	s.GetType()
-	s = &SecretScanningAlertLocation{}
-	s.GetType()
+func TestSecretScanningBypassRequest_GetRequester(tt *testing.T) {
+	s := &SecretScanningBypassRequest{}
+	s.GetRequester()
 	s = nil
-	s.GetType()
+	s.GetRequester()
 }
 
-func TestSecretScanningAlertLocationDetails_GetBlobSHA(tt *testing.T) {
+func TestSecretScanningBypassRequest_GetRequesterComment(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlertLocationDetails{BlobSHA: &zeroValue}
-	s.GetBlobSHA()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetBlobSHA()
+	s := &SecretScanningBypassRequest{RequesterComment: &zeroValue}
+	s.GetRequesterComment()
+	s = &SecretScanningBypassRequest{}
+	s.GetRequesterComment()
 	s = nil
-	s.GetBlobSHA()
+	s.GetRequesterComment()
 }
 
-func TestSecretScanningAlertLocationDetails_GetBlobURL(tt *testing.T) {
+func TestSecretScanningBypassRequest_GetRequestType(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlertLocationDetails{BlobURL: &zeroValue}
-	s.GetBlobURL()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetBlobURL()
+	s := &SecretScanningBypassRequest{RequestType: &zeroValue}
+	s.GetRequestType()
+	s = &SecretScanningBypassRequest{}
+	s.GetRequestType()
 	s = nil
-	s.GetBlobURL()
+	s.GetRequestType()
 }
 
-func TestSecretScanningAlertLocationDetails_GetCommitSHA(tt *testing.T) {
+func TestSecretScanningBypassRequest_GetResourceIdentifier(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlertLocationDetails{CommitSHA: &zeroValue}
-	s.GetCommitSHA()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetCommitSHA()
+	s := &SecretScanningBypassRequest{ResourceIdentifier: &zeroValue}
+	s.GetResourceIdentifier()
+	s = &SecretScanningBypassRequest{}
+	s.GetResourceIdentifier()
 	s = nil
-	s.GetCommitSHA()
+	s.GetResourceIdentifier()
 }
 
-func TestSecretScanningAlertLocationDetails_GetCommitURL(tt *testing.T) {
+func TestSecretScanningBypassRequest_GetStatus(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlertLocationDetails{CommitURL: &zeroValue}
-	s.GetCommitURL()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetCommitURL()
+	s := &SecretScanningBypassRequest{Status: &zeroValue}
+	s.GetStatus()
+	s = &SecretScanningBypassRequest{}
+	s.GetStatus()
 	s = nil
-	s.GetCommitURL()
+	s.GetStatus()
 }
 
-func TestSecretScanningAlertLocationDetails_GetEndColumn(tt *testing.T) {
-	var zeroValue int
-	s := &SecretScanningAlertLocationDetails{EndColumn: &zeroValue}
-	s.GetEndColumn()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetEndColumn()
+func TestSecretScanningBypassRequest_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningBypassRequest{URL: &zeroValue}
+	s.GetURL()
+	s = &SecretScanningBypassRequest{}
+	s.GetURL()
 	s = nil
-	s.GetEndColumn()
+	s.GetURL()
 }
 
-func TestSecretScanningAlertLocationDetails_GetEndLine(tt *testing.T) {
-	var zeroValue int
-	s := &SecretScanningAlertLocationDetails{EndLine: &zeroValue}
-	s.GetEndLine()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetEndLine()
+func TestSecretScanningNonProviderPatterns_GetStatus(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningNonProviderPatterns{Status: &zeroValue}
+	s.GetStatus()
+	s = &SecretScanningNonProviderPatterns{}
+	s.GetStatus()
 	s = nil
-	s.GetEndLine()
+	s.GetStatus()
 }
 
-func TestSecretScanningAlertLocationDetails_GetPath(tt *testing.T) {
+func TestSecretScanningPushProtection_GetStatus(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlertLocationDetails{Path: &zeroValue}
-	s.GetPath()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetPath()
+	s := &SecretScanningPushProtection{Status: &zeroValue}
+	s.GetStatus()
+	s = &SecretScanningPushProtection{}
+	s.GetStatus()
 	s = nil
-	s.GetPath()
+	s.GetStatus()
 }
 
-func TestSecretScanningAlertLocationDetails_GetStartColumn(tt *testing.T) {
-	var zeroValue int
-	s := &SecretScanningAlertLocationDetails{StartColumn: &zeroValue}
-	s.GetStartColumn()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetStartColumn()
+func TestSecurityAdvisory_GetAuthor(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetAuthor()
 	s = nil
-	s.GetStartColumn()
+	s.GetAuthor()
 }
 
-func TestSecretScanningAlertLocationDetails_GetStartline(tt *testing.T) {
-	var zeroValue int
-	s := &SecretScanningAlertLocationDetails{Startline: &zeroValue}
-	s.GetStartline()
-	s = &SecretScanningAlertLocationDetails{}
-	s.GetStartline()
+func TestSecurityAdvisory_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{ClosedAt: &zeroValue}
+	s.GetClosedAt()
+	s = &SecurityAdvisory{}
+	s.GetClosedAt()
 	s = nil
-	s.GetStartline()
+	s.GetClosedAt()
 }
 
-func TestSecretScanningAlertUpdateOptions_GetResolution(tt *testing.T) {
-	var zeroValue string
-	s := &SecretScanningAlertUpdateOptions{Resolution: &zeroValue}
-	s.GetResolution()
-	s = &SecretScanningAlertUpdateOptions{}
-	s.GetResolution()
+func TestSecurityAdvisory_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecurityAdvisory{}
+	s.GetCreatedAt()
 	s = nil
-	s.GetResolution()
+	s.GetCreatedAt()
 }
 
-func TestSecretScanningAlertUpdateOptions_GetSecretType(tt *testing.T) {
+func TestSecurityAdvisory_GetCVEID(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlertUpdateOptions{SecretType: &zeroValue}
-	s.GetSecretType()
-	s = &SecretScanningAlertUpdateOptions{}
-	s.GetSecretType()
+	s := &SecurityAdvisory{CVEID: &zeroValue}
+	s.GetCVEID()
+	s = &SecurityAdvisory{}
+	s.GetCVEID()
 	s = nil
-	s.GetSecretType()
+	s.GetCVEID()
 }
 
-func TestSecretScanningAlertUpdateOptions_GetState(tt *testing.T) {
-	var zeroValue string
-	s := &SecretScanningAlertUpdateOptions{State: &zeroValue}
-	s.GetState()
-	s = &SecretScanningAlertUpdateOptions{}
-	s.GetState()
+func TestSecurityAdvisory_GetCVSs(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetCVSs()
 	s = nil
-	s.GetState()
+	s.GetCVSs()
 }
 
-func TestSecretScanningPushProtection_GetStatus(tt *testing.T) {
-	var zeroValue string
-	s := &SecretScanningPushProtection{Status: &zeroValue}
-	s.GetStatus()
-	s = &SecretScanningPushProtection{}
-	s.GetStatus()
+func TestSecurityAdvisory_GetCVSSSeverities(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetCVSSSeverities()
 	s = nil
-	s.GetStatus()
+	s.GetCVSSSeverities()
 }
 
 func TestSecurityAdvisory_GetDescription(tt *testing.T) {
@@ -21689,6 +28132,13 @@ func TestSecurityAdvisory_GetDescription(tt *testing.T) {
 	s.GetDescription()
 }
 
+func TestSecurityAdvisory_GetEPSS(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetEPSS()
+	s = nil
+	s.GetEPSS()
+}
+
 func TestSecurityAdvisory_GetGHSAID(tt *testing.T) {
 	var zeroValue string
 	s := &SecurityAdvisory{GHSAID: &zeroValue}
@@ -21699,6 +28149,23 @@ func TestSecurityAdvisory_GetGHSAID(tt *testing.T) {
 	s.GetGHSAID()
 }
 
+func TestSecurityAdvisory_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecurityAdvisory{}
+	s.GetHTMLURL()
+	s = nil
+	s.GetHTMLURL()
+}
+
+func TestSecurityAdvisory_GetPrivateFork(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetPrivateFork()
+	s = nil
+	s.GetPrivateFork()
+}
+
 func TestSecurityAdvisory_GetPublishedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	s := &SecurityAdvisory{PublishedAt: &zeroValue}
@@ -21709,6 +28176,13 @@ func TestSecurityAdvisory_GetPublishedAt(tt *testing.T) {
 	s.GetPublishedAt()
 }
 
+func TestSecurityAdvisory_GetPublisher(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetPublisher()
+	s = nil
+	s.GetPublisher()
+}
+
 func TestSecurityAdvisory_GetSeverity(tt *testing.T) {
 	var zeroValue string
 	s := &SecurityAdvisory{Severity: &zeroValue}
@@ -21719,6 +28193,23 @@ func TestSecurityAdvisory_GetSeverity(tt *testing.T) {
 	s.GetSeverity()
 }
 
+func TestSecurityAdvisory_GetState(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{State: &zeroValue}
+	s.GetState()
+	s = &SecurityAdvisory{}
+	s.GetState()
+	s = nil
+	s.GetState()
+}
+
+func TestSecurityAdvisory_GetSubmission(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetSubmission()
+	s = nil
+	s.GetSubmission()
+}
+
 func TestSecurityAdvisory_GetSummary(tt *testing.T) {
 	var zeroValue string
 	s := &SecurityAdvisory{Summary: &zeroValue}
@@ -21739,6 +28230,16 @@ func TestSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
 	s.GetUpdatedAt()
 }
 
+func TestSecurityAdvisory_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{URL: &zeroValue}
+	s.GetURL()
+	s = &SecurityAdvisory{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
 func TestSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
 	var zeroValue Timestamp
 	s := &SecurityAdvisory{WithdrawnAt: &zeroValue}
@@ -21766,6 +28267,86 @@ func TestSecurityAdvisoryEvent_GetSecurityAdvisory(tt *testing.T) {
 	s.GetSecurityAdvisory()
 }
 
+func TestSecurityAdvisoryRequest_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisoryRequest{CVEID: &zeroValue}
+	s.GetCVEID()
+	s = &SecurityAdvisoryRequest{}
+	s.GetCVEID()
+	s = nil
+	s.GetCVEID()
+}
+
+func TestSecurityAdvisoryRequest_GetCVSSVectorString(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisoryRequest{CVSSVectorString: &zeroValue}
+	s.GetCVSSVectorString()
+	s = &SecurityAdvisoryRequest{}
+	s.GetCVSSVectorString()
+	s = nil
+	s.GetCVSSVectorString()
+}
+
+func TestSecurityAdvisoryRequest_GetDescription(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisoryRequest{Description: &zeroValue}
+	s.GetDescription()
+	s = &SecurityAdvisoryRequest{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
+}
+
+func TestSecurityAdvisoryRequest_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisoryRequest{Severity: &zeroValue}
+	s.GetSeverity()
+	s = &SecurityAdvisoryRequest{}
+	s.GetSeverity()
+	s = nil
+	s.GetSeverity()
+}
+
+func TestSecurityAdvisoryRequest_GetStartPrivateFork(tt *testing.T) {
+	var zeroValue bool
+	s := &SecurityAdvisoryRequest{StartPrivateFork: &zeroValue}
+	s.GetStartPrivateFork()
+	s = &SecurityAdvisoryRequest{}
+	s.GetStartPrivateFork()
+	s = nil
+	s.GetStartPrivateFork()
+}
+
+func TestSecurityAdvisoryRequest_GetState(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisoryRequest{State: &zeroValue}
+	s.GetState()
+	s = &SecurityAdvisoryRequest{}
+	s.GetState()
+	s = nil
+	s.GetState()
+}
+
+func TestSecurityAdvisoryRequest_GetSummary(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisoryRequest{Summary: &zeroValue}
+	s.GetSummary()
+	s = &SecurityAdvisoryRequest{}
+	s.GetSummary()
+	s = nil
+	s.GetSummary()
+}
+
+func TestSecurityAdvisorySubmission_GetAccepted(tt *testing.T) {
+	var zeroValue bool
+	s := &SecurityAdvisorySubmission{Accepted: &zeroValue}
+	s.GetAccepted()
+	s = &SecurityAdvisorySubmission{}
+	s.GetAccepted()
+	s = nil
+	s.GetAccepted()
+}
+
 func TestSecurityAndAnalysis_GetAdvancedSecurity(tt *testing.T) {
 	s := &SecurityAndAnalysis{}
 	s.GetAdvancedSecurity()
@@ -21773,6 +28354,13 @@ func TestSecurityAndAnalysis_GetAdvancedSecurity(tt *testing.T) {
 	s.GetAdvancedSecurity()
 }
 
+func TestSecurityAndAnalysis_GetDependabotSecurityUpdates(tt *testing.T) {
+	s := &SecurityAndAnalysis{}
+	s.GetDependabotSecurityUpdates()
+	s = nil
+	s.GetDependabotSecurityUpdates()
+}
+
 func TestSecurityAndAnalysis_GetSecretScanning(tt *testing.T) {
 	s := &SecurityAndAnalysis{}
 	s.GetSecretScanning()
@@ -21780,6 +28368,13 @@ func TestSecurityAndAnalysis_GetSecretScanning(tt *testing.T) {
 	s.GetSecretScanning()
 }
 
+func TestSecurityAndAnalysis_GetSecretScanningNonProviderPatterns(tt *testing.T) {
+	s := &SecurityAndAnalysis{}
+	s.GetSecretScanningNonProviderPatterns()
+	s = nil
+	s.GetSecretScanningNonProviderPatterns()
+}
+
 func TestSecurityAndAnalysis_GetSecretScanningPushProtection(tt *testing.T) {
 	s := &SecurityAndAnalysis{}
 	s.GetSecretScanningPushProtection()
@@ -21877,6 +28472,36 @@ func TestSignatureVerification_GetVerified(tt *testing.T) {
 	s.GetVerified()
 }
 
+func TestSignatureVerification_GetVerifiedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SignatureVerification{VerifiedAt: &zeroValue}
+	s.GetVerifiedAt()
+	s = &SignatureVerification{}
+	s.GetVerifiedAt()
+	s = nil
+	s.GetVerifiedAt()
+}
+
+func TestSocialAccount_GetProvider(tt *testing.T) {
+	var zeroValue string
+	s := &SocialAccount{Provider: &zeroValue}
+	s.GetProvider()
+	s = &SocialAccount{}
+	s.GetProvider()
+	s = nil
+	s.GetProvider()
+}
+
+func TestSocialAccount_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SocialAccount{URL: &zeroValue}
+	s.GetURL()
+	s = &SocialAccount{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
 func TestSource_GetActor(tt *testing.T) {
 	s := &Source{}
 	s.GetActor()
@@ -21991,6 +28616,56 @@ func TestSourceImportAuthor_GetURL(tt *testing.T) {
 	s.GetURL()
 }
 
+func TestSplunkStreamConfig_GetDomain(tt *testing.T) {
+	var zeroValue string
+	s := &SplunkStreamConfig{Domain: &zeroValue}
+	s.GetDomain()
+	s = &SplunkStreamConfig{}
+	s.GetDomain()
+	s = nil
+	s.GetDomain()
+}
+
+func TestSplunkStreamConfig_GetEncryptedToken(tt *testing.T) {
+	var zeroValue string
+	s := &SplunkStreamConfig{EncryptedToken: &zeroValue}
+	s.GetEncryptedToken()
+	s = &SplunkStreamConfig{}
+	s.GetEncryptedToken()
+	s = nil
+	s.GetEncryptedToken()
+}
+
+func TestSplunkStreamConfig_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	s := &SplunkStreamConfig{KeyID: &zeroValue}
+	s.GetKeyID()
+	s = &SplunkStreamConfig{}
+	s.GetKeyID()
+	s = nil
+	s.GetKeyID()
+}
+
+func TestSplunkStreamConfig_GetPort(tt *testing.T) {
+	var zeroValue int
+	s := &SplunkStreamConfig{Port: &zeroValue}
+	s.GetPort()
+	s = &SplunkStreamConfig{}
+	s.GetPort()
+	s = nil
+	s.GetPort()
+}
+
+func TestSplunkStreamConfig_GetSSLVerify(tt *testing.T) {
+	var zeroValue bool
+	s := &SplunkStreamConfig{SSLVerify: &zeroValue}
+	s.GetSSLVerify()
+	s = &SplunkStreamConfig{}
+	s.GetSSLVerify()
+	s = nil
+	s.GetSSLVerify()
+}
+
 func TestSSHSigningKey_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	s := &SSHSigningKey{CreatedAt: &zeroValue}
@@ -22028,7 +28703,21 @@ func TestSSHSigningKey_GetTitle(tt *testing.T) {
 	s = &SSHSigningKey{}
 	s.GetTitle()
 	s = nil
-	s.GetTitle()
+	s.GetTitle()
+}
+
+func TestStaleDeployKey_GetKey(tt *testing.T) {
+	s := &StaleDeployKey{}
+	s.GetKey()
+	s = nil
+	s.GetKey()
+}
+
+func TestStaleDeployKey_GetRepository(tt *testing.T) {
+	s := &StaleDeployKey{}
+	s.GetRepository()
+	s = nil
+	s.GetRepository()
 }
 
 func TestStarEvent_GetAction(tt *testing.T) {
@@ -22177,6 +28866,13 @@ func TestStatusEvent_GetName(tt *testing.T) {
 	s.GetName()
 }
 
+func TestStatusEvent_GetOrg(tt *testing.T) {
+	s := &StatusEvent{}
+	s.GetOrg()
+	s = nil
+	s.GetOrg()
+}
+
 func TestStatusEvent_GetRepo(tt *testing.T) {
 	s := &StatusEvent{}
 	s.GetRepo()
@@ -22231,6 +28927,152 @@ func TestStatusEvent_GetUpdatedAt(tt *testing.T) {
 	s.GetUpdatedAt()
 }
 
+func TestSubIssueReprioritizeRequest_GetAfterID(tt *testing.T) {
+	var zeroValue int64
+	s := &SubIssueReprioritizeRequest{AfterID: &zeroValue}
+	s.GetAfterID()
+	s = &SubIssueReprioritizeRequest{}
+	s.GetAfterID()
+	s = nil
+	s.GetAfterID()
+}
+
+func TestSubIssueReprioritizeRequest_GetBeforeID(tt *testing.T) {
+	var zeroValue int64
+	s := &SubIssueReprioritizeRequest{BeforeID: &zeroValue}
+	s.GetBeforeID()
+	s = &SubIssueReprioritizeRequest{}
+	s.GetBeforeID()
+	s = nil
+	s.GetBeforeID()
+}
+
+func TestSubIssueRequest_GetReplaceParent(tt *testing.T) {
+	var zeroValue bool
+	s := &SubIssueRequest{ReplaceParent: &zeroValue}
+	s.GetReplaceParent()
+	s = &SubIssueRequest{}
+	s.GetReplaceParent()
+	s = nil
+	s.GetReplaceParent()
+}
+
+func TestSubIssuesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	s := &SubIssuesEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SubIssuesEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
+}
+
+func TestSubIssuesEvent_GetInstallation(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
+}
+
+func TestSubIssuesEvent_GetOrg(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetOrg()
+	s = nil
+	s.GetOrg()
+}
+
+func TestSubIssuesEvent_GetParentIssue(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetParentIssue()
+	s = nil
+	s.GetParentIssue()
+}
+
+func TestSubIssuesEvent_GetParentIssueID(tt *testing.T) {
+	var zeroValue int64
+	s := &SubIssuesEvent{ParentIssueID: &zeroValue}
+	s.GetParentIssueID()
+	s = &SubIssuesEvent{}
+	s.GetParentIssueID()
+	s = nil
+	s.GetParentIssueID()
+}
+
+func TestSubIssuesEvent_GetParentIssueRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetParentIssueRepo()
+	s = nil
+	s.GetParentIssueRepo()
+}
+
+func TestSubIssuesEvent_GetRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
+}
+
+func TestSubIssuesEvent_GetSender(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSubIssuesEvent_GetSubIssue(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSubIssue()
+	s = nil
+	s.GetSubIssue()
+}
+
+func TestSubIssuesEvent_GetSubIssueID(tt *testing.T) {
+	var zeroValue int64
+	s := &SubIssuesEvent{SubIssueID: &zeroValue}
+	s.GetSubIssueID()
+	s = &SubIssuesEvent{}
+	s.GetSubIssueID()
+	s = nil
+	s.GetSubIssueID()
+}
+
+func TestSubIssuesEvent_GetSubIssueRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSubIssueRepo()
+	s = nil
+	s.GetSubIssueRepo()
+}
+
+func TestSubIssuesSummary_GetCompleted(tt *testing.T) {
+	var zeroValue int
+	s := &SubIssuesSummary{Completed: &zeroValue}
+	s.GetCompleted()
+	s = &SubIssuesSummary{}
+	s.GetCompleted()
+	s = nil
+	s.GetCompleted()
+}
+
+func TestSubIssuesSummary_GetPercentCompleted(tt *testing.T) {
+	var zeroValue int
+	s := &SubIssuesSummary{PercentCompleted: &zeroValue}
+	s.GetPercentCompleted()
+	s = &SubIssuesSummary{}
+	s.GetPercentCompleted()
+	s = nil
+	s.GetPercentCompleted()
+}
+
+func TestSubIssuesSummary_GetTotal(tt *testing.T) {
+	var zeroValue int
+	s := &SubIssuesSummary{Total: &zeroValue}
+	s.GetTotal()
+	s = &SubIssuesSummary{}
+	s.GetTotal()
+	s = nil
+	s.GetTotal()
+}
+
 func TestSubscription_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	s := &Subscription{CreatedAt: &zeroValue}
@@ -23207,6 +30049,16 @@ func TestTimeline_GetAuthor(tt *testing.T) {
 	t.GetAuthor()
 }
 
+func TestTimeline_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	t := &Timeline{AuthorAssociation: &zeroValue}
+	t.GetAuthorAssociation()
+	t = &Timeline{}
+	t.GetAuthorAssociation()
+	t = nil
+	t.GetAuthorAssociation()
+}
+
 func TestTimeline_GetBody(tt *testing.T) {
 	var zeroValue string
 	t := &Timeline{Body: &zeroValue}
@@ -23254,6 +30106,13 @@ func TestTimeline_GetCreatedAt(tt *testing.T) {
 	t.GetCreatedAt()
 }
 
+func TestTimeline_GetDismissedReview(tt *testing.T) {
+	t := &Timeline{}
+	t.GetDismissedReview()
+	t = nil
+	t.GetDismissedReview()
+}
+
 func TestTimeline_GetEvent(tt *testing.T) {
 	var zeroValue string
 	t := &Timeline{Event: &zeroValue}
@@ -23264,6 +30123,16 @@ func TestTimeline_GetEvent(tt *testing.T) {
 	t.GetEvent()
 }
 
+func TestTimeline_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	t := &Timeline{HTMLURL: &zeroValue}
+	t.GetHTMLURL()
+	t = &Timeline{}
+	t.GetHTMLURL()
+	t = nil
+	t.GetHTMLURL()
+}
+
 func TestTimeline_GetID(tt *testing.T) {
 	var zeroValue int64
 	t := &Timeline{ID: &zeroValue}
@@ -23281,6 +30150,16 @@ func TestTimeline_GetLabel(tt *testing.T) {
 	t.GetLabel()
 }
 
+func TestTimeline_GetLockReason(tt *testing.T) {
+	var zeroValue string
+	t := &Timeline{LockReason: &zeroValue}
+	t.GetLockReason()
+	t = &Timeline{}
+	t.GetLockReason()
+	t = nil
+	t.GetLockReason()
+}
+
 func TestTimeline_GetMessage(tt *testing.T) {
 	var zeroValue string
 	t := &Timeline{Message: &zeroValue}
@@ -23298,6 +30177,23 @@ func TestTimeline_GetMilestone(tt *testing.T) {
 	t.GetMilestone()
 }
 
+func TestTimeline_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	t := &Timeline{NodeID: &zeroValue}
+	t.GetNodeID()
+	t = &Timeline{}
+	t.GetNodeID()
+	t = nil
+	t.GetNodeID()
+}
+
+func TestTimeline_GetPerformedViaGitHubApp(tt *testing.T) {
+	t := &Timeline{}
+	t.GetPerformedViaGitHubApp()
+	t = nil
+	t.GetPerformedViaGitHubApp()
+}
+
 func TestTimeline_GetProjectCard(tt *testing.T) {
 	t := &Timeline{}
 	t.GetProjectCard()
@@ -23360,6 +30256,16 @@ func TestTimeline_GetState(tt *testing.T) {
 	t.GetState()
 }
 
+func TestTimeline_GetStateReason(tt *testing.T) {
+	var zeroValue string
+	t := &Timeline{StateReason: &zeroValue}
+	t.GetStateReason()
+	t = &Timeline{}
+	t.GetStateReason()
+	t = nil
+	t.GetStateReason()
+}
+
 func TestTimeline_GetSubmittedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	t := &Timeline{SubmittedAt: &zeroValue}
@@ -23370,6 +30276,16 @@ func TestTimeline_GetSubmittedAt(tt *testing.T) {
 	t.GetSubmittedAt()
 }
 
+func TestTimeline_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	t := &Timeline{UpdatedAt: &zeroValue}
+	t.GetUpdatedAt()
+	t = &Timeline{}
+	t.GetUpdatedAt()
+	t = nil
+	t.GetUpdatedAt()
+}
+
 func TestTimeline_GetURL(tt *testing.T) {
 	var zeroValue string
 	t := &Timeline{URL: &zeroValue}
@@ -23584,6 +30500,20 @@ func TestTrafficData_GetUniques(tt *testing.T) {
 	t.GetUniques()
 }
 
+func TestTrafficDay_GetClones(tt *testing.T) {
+	t := &TrafficDay{}
+	t.GetClones()
+	t = nil
+	t.GetClones()
+}
+
+func TestTrafficDay_GetViews(tt *testing.T) {
+	t := &TrafficDay{}
+	t.GetViews()
+	t = nil
+	t.GetViews()
+}
+
 func TestTrafficPath_GetCount(tt *testing.T) {
 	var zeroValue int
 	t := &TrafficPath{Count: &zeroValue}
@@ -23654,6 +30584,20 @@ func TestTrafficReferrer_GetUniques(tt *testing.T) {
 	t.GetUniques()
 }
 
+func TestTrafficSummary_GetClones(tt *testing.T) {
+	t := &TrafficSummary{}
+	t.GetClones()
+	t = nil
+	t.GetClones()
+}
+
+func TestTrafficSummary_GetViews(tt *testing.T) {
+	t := &TrafficSummary{}
+	t.GetViews()
+	t = nil
+	t.GetViews()
+}
+
 func TestTrafficViews_GetCount(tt *testing.T) {
 	var zeroValue int
 	t := &TrafficViews{Count: &zeroValue}
@@ -23674,6 +30618,16 @@ func TestTrafficViews_GetUniques(tt *testing.T) {
 	t.GetUniques()
 }
 
+func TestTransferRequest_GetNewName(tt *testing.T) {
+	var zeroValue string
+	t := &TransferRequest{NewName: &zeroValue}
+	t.GetNewName()
+	t = &TransferRequest{}
+	t.GetNewName()
+	t = nil
+	t.GetNewName()
+}
+
 func TestTree_GetSHA(tt *testing.T) {
 	var zeroValue string
 	t := &Tree{SHA: &zeroValue}
@@ -23764,6 +30718,13 @@ func TestTreeEntry_GetURL(tt *testing.T) {
 	t.GetURL()
 }
 
+func TestUncheckedRepo_GetRepository(tt *testing.T) {
+	u := &UncheckedRepo{}
+	u.GetRepository()
+	u = nil
+	u.GetRepository()
+}
+
 func TestUpdateAttributeForSCIMUserOperations_GetPath(tt *testing.T) {
 	var zeroValue string
 	u := &UpdateAttributeForSCIMUserOperations{Path: &zeroValue}
@@ -23831,6 +30792,46 @@ func TestUpdateCheckRunOptions_GetStatus(tt *testing.T) {
 	u.GetStatus()
 }
 
+func TestUpdateDefaultSetupConfigurationOptions_GetQuerySuite(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateDefaultSetupConfigurationOptions{QuerySuite: &zeroValue}
+	u.GetQuerySuite()
+	u = &UpdateDefaultSetupConfigurationOptions{}
+	u.GetQuerySuite()
+	u = nil
+	u.GetQuerySuite()
+}
+
+func TestUpdateDefaultSetupConfigurationResponse_GetRunID(tt *testing.T) {
+	var zeroValue int64
+	u := &UpdateDefaultSetupConfigurationResponse{RunID: &zeroValue}
+	u.GetRunID()
+	u = &UpdateDefaultSetupConfigurationResponse{}
+	u.GetRunID()
+	u = nil
+	u.GetRunID()
+}
+
+func TestUpdateDefaultSetupConfigurationResponse_GetRunURL(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateDefaultSetupConfigurationResponse{RunURL: &zeroValue}
+	u.GetRunURL()
+	u = &UpdateDefaultSetupConfigurationResponse{}
+	u.GetRunURL()
+	u = nil
+	u.GetRunURL()
+}
+
+func TestUpdateProjectV2ItemOptions_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	u := &UpdateProjectV2ItemOptions{Archived: &zeroValue}
+	u.GetArchived()
+	u = &UpdateProjectV2ItemOptions{}
+	u.GetArchived()
+	u = nil
+	u.GetArchived()
+}
+
 func TestUpdateRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
 	var zeroValue bool
 	u := &UpdateRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
@@ -23871,6 +30872,151 @@ func TestUpdateRunnerGroupRequest_GetVisibility(tt *testing.T) {
 	u.GetVisibility()
 }
 
+func TestUsageItem_GetDate(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{Date: &zeroValue}
+	u.GetDate()
+	u = &UsageItem{}
+	u.GetDate()
+	u = nil
+	u.GetDate()
+}
+
+func TestUsageItem_GetDiscountAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetDiscountAmount()
+	u = nil
+	u.GetDiscountAmount()
+}
+
+func TestUsageItem_GetGrossAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetGrossAmount()
+	u = nil
+	u.GetGrossAmount()
+}
+
+func TestUsageItem_GetNetAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetNetAmount()
+	u = nil
+	u.GetNetAmount()
+}
+
+func TestUsageItem_GetOrganizationName(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{OrganizationName: &zeroValue}
+	u.GetOrganizationName()
+	u = &UsageItem{}
+	u.GetOrganizationName()
+	u = nil
+	u.GetOrganizationName()
+}
+
+func TestUsageItem_GetPricePerUnit(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetPricePerUnit()
+	u = nil
+	u.GetPricePerUnit()
+}
+
+func TestUsageItem_GetProduct(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{Product: &zeroValue}
+	u.GetProduct()
+	u = &UsageItem{}
+	u.GetProduct()
+	u = nil
+	u.GetProduct()
+}
+
+func TestUsageItem_GetQuantity(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetQuantity()
+	u = nil
+	u.GetQuantity()
+}
+
+func TestUsageItem_GetRepositoryName(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{RepositoryName: &zeroValue}
+	u.GetRepositoryName()
+	u = &UsageItem{}
+	u.GetRepositoryName()
+	u = nil
+	u.GetRepositoryName()
+}
+
+func TestUsageItem_GetSKU(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{SKU: &zeroValue}
+	u.GetSKU()
+	u = &UsageItem{}
+	u.GetSKU()
+	u = nil
+	u.GetSKU()
+}
+
+func TestUsageItem_GetUnitType(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{UnitType: &zeroValue}
+	u.GetUnitType()
+	u = &UsageItem{}
+	u.GetUnitType()
+	u = nil
+	u.GetUnitType()
+}
+
+func TestUsageReportOptions_GetCostCenterID(tt *testing.T) {
+	var zeroValue string
+	u := &UsageReportOptions{CostCenterID: &zeroValue}
+	u.GetCostCenterID()
+	u = &UsageReportOptions{}
+	u.GetCostCenterID()
+	u = nil
+	u.GetCostCenterID()
+}
+
+func TestUsageReportOptions_GetDay(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Day: &zeroValue}
+	u.GetDay()
+	u = &UsageReportOptions{}
+	u.GetDay()
+	u = nil
+	u.GetDay()
+}
+
+func TestUsageReportOptions_GetHour(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Hour: &zeroValue}
+	u.GetHour()
+	u = &UsageReportOptions{}
+	u.GetHour()
+	u = nil
+	u.GetHour()
+}
+
+func TestUsageReportOptions_GetMonth(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Month: &zeroValue}
+	u.GetMonth()
+	u = &UsageReportOptions{}
+	u.GetMonth()
+	u = nil
+	u.GetMonth()
+}
+
+func TestUsageReportOptions_GetYear(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Year: &zeroValue}
+	u.GetYear()
+	u = &UsageReportOptions{}
+	u.GetYear()
+	u = nil
+	u.GetYear()
+}
+
 func TestUser_GetAvatarURL(tt *testing.T) {
 	var zeroValue string
 	u := &User{AvatarURL: &zeroValue}
@@ -24673,6 +31819,16 @@ func TestUserLDAPMapping_GetURL(tt *testing.T) {
 	u.GetURL()
 }
 
+func TestUserMigration_GetArchiveURL(tt *testing.T) {
+	var zeroValue string
+	u := &UserMigration{ArchiveURL: &zeroValue}
+	u.GetArchiveURL()
+	u = &UserMigration{}
+	u.GetArchiveURL()
+	u = nil
+	u.GetArchiveURL()
+}
+
 func TestUserMigration_GetCreatedAt(tt *testing.T) {
 	var zeroValue string
 	u := &UserMigration{CreatedAt: &zeroValue}
@@ -24693,6 +31849,46 @@ func TestUserMigration_GetExcludeAttachments(tt *testing.T) {
 	u.GetExcludeAttachments()
 }
 
+func TestUserMigration_GetExcludeGitData(tt *testing.T) {
+	var zeroValue bool
+	u := &UserMigration{ExcludeGitData: &zeroValue}
+	u.GetExcludeGitData()
+	u = &UserMigration{}
+	u.GetExcludeGitData()
+	u = nil
+	u.GetExcludeGitData()
+}
+
+func TestUserMigration_GetExcludeMetadata(tt *testing.T) {
+	var zeroValue bool
+	u := &UserMigration{ExcludeMetadata: &zeroValue}
+	u.GetExcludeMetadata()
+	u = &UserMigration{}
+	u.GetExcludeMetadata()
+	u = nil
+	u.GetExcludeMetadata()
+}
+
+func TestUserMigration_GetExcludeOwnerProjects(tt *testing.T) {
+	var zeroValue bool
+	u := &UserMigration{ExcludeOwnerProjects: &zeroValue}
+	u.GetExcludeOwnerProjects()
+	u = &UserMigration{}
+	u.GetExcludeOwnerProjects()
+	u = nil
+	u.GetExcludeOwnerProjects()
+}
+
+func TestUserMigration_GetExcludeReleases(tt *testing.T) {
+	var zeroValue bool
+	u := &UserMigration{ExcludeReleases: &zeroValue}
+	u.GetExcludeReleases()
+	u = &UserMigration{}
+	u.GetExcludeReleases()
+	u = nil
+	u.GetExcludeReleases()
+}
+
 func TestUserMigration_GetGUID(tt *testing.T) {
 	var zeroValue string
 	u := &UserMigration{GUID: &zeroValue}
@@ -24850,6 +32046,13 @@ func TestWatchEvent_GetInstallation(tt *testing.T) {
 	w.GetInstallation()
 }
 
+func TestWatchEvent_GetOrg(tt *testing.T) {
+	w := &WatchEvent{}
+	w.GetOrg()
+	w = nil
+	w.GetOrg()
+}
+
 func TestWatchEvent_GetRepo(tt *testing.T) {
 	w := &WatchEvent{}
 	w.GetRepo()
@@ -95,8 +95,10 @@ func TestAuthorization_String(t *testing.T) {
 		CreatedAt:      &Timestamp{},
 		Fingerprint:    String(""),
 		User:           &User{},
+		ExpiresAt:      &Timestamp{},
+		Installation:   &Installation{},
 	}
-	want := `github.Authorization{ID:0, URL:"", Scopes:["(no scope)"], Token:"", TokenLastEight:"", HashedToken:"", App:github.AuthorizationApp{}, Note:"", NoteURL:"", UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Fingerprint:"", User:github.User{}}`
+	want := `github.Authorization{ID:0, URL:"", Scopes:["(no scope)"], Token:"", TokenLastEight:"", HashedToken:"", App:github.AuthorizationApp{}, Note:"", NoteURL:"", UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Fingerprint:"", User:github.User{}, ExpiresAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Installation:github.Installation{}}`
 	if got := v.String(); got != want {
 		t.Errorf("Authorization.String = %v, want %v", got, want)
 	}
@@ -211,8 +213,9 @@ func TestCodeResult_String(t *testing.T) {
 		SHA:        String(""),
 		HTMLURL:    String(""),
 		Repository: &Repository{},
+		Score:      Float64(0.0),
 	}
-	want := `github.CodeResult{Name:"", Path:"", SHA:"", HTMLURL:"", Repository:github.Repository{}}`
+	want := `github.CodeResult{Name:"", Path:"", SHA:"", HTMLURL:"", Repository:github.Repository{}, Score:0}`
 	if got := v.String(); got != want {
 		t.Errorf("CodeResult.String = %v, want %v", got, want)
 	}
@@ -342,6 +345,16 @@ func TestContributorStats_String(t *testing.T) {
 	}
 }
 
+func TestDependabotSecurityUpdates_String(t *testing.T) {
+	v := DependabotSecurityUpdates{
+		Status: String(""),
+	}
+	want := `github.DependabotSecurityUpdates{Status:""}`
+	if got := v.String(); got != want {
+		t.Errorf("DependabotSecurityUpdates.String = %v, want %v", got, want)
+	}
+}
+
 func TestDiscussionComment_String(t *testing.T) {
 	v := DiscussionComment{
 		Author:        &User{},
@@ -418,6 +431,7 @@ func TestEvent_String(t *testing.T) {
 func TestGPGKey_String(t *testing.T) {
 	v := GPGKey{
 		ID:                Int64(0),
+		Name:              String(""),
 		PrimaryKeyID:      Int64(0),
 		KeyID:             String(""),
 		RawKey:            String(""),
@@ -428,8 +442,9 @@ func TestGPGKey_String(t *testing.T) {
 		CanCertify:        Bool(false),
 		CreatedAt:         &Timestamp{},
 		ExpiresAt:         &Timestamp{},
+		Revoked:           Bool(false),
 	}
-	want := `github.GPGKey{ID:0, PrimaryKeyID:0, KeyID:"", RawKey:"", PublicKey:"", CanSign:false, CanEncryptComms:false, CanEncryptStorage:false, CanCertify:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, ExpiresAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	want := `github.GPGKey{ID:0, Name:"", PrimaryKeyID:0, KeyID:"", RawKey:"", PublicKey:"", CanSign:false, CanEncryptComms:false, CanEncryptStorage:false, CanCertify:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, ExpiresAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Revoked:false}`
 	if got := v.String(); got != want {
 		t.Errorf("GPGKey.String = %v, want %v", got, want)
 	}
@@ -448,8 +463,9 @@ func TestGist_String(t *testing.T) {
 		CreatedAt:   &Timestamp{},
 		UpdatedAt:   &Timestamp{},
 		NodeID:      String(""),
+		Truncated:   Bool(false),
 	}
-	want := `github.Gist{ID:"", Description:"", Public:false, Owner:github.User{}, Comments:0, HTMLURL:"", GitPullURL:"", GitPushURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, NodeID:""}`
+	want := `github.Gist{ID:"", Description:"", Public:false, Owner:github.User{}, Comments:0, HTMLURL:"", GitPullURL:"", GitPushURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, NodeID:"", Truncated:false}`
 	if got := v.String(); got != want {
 		t.Errorf("Gist.String = %v, want %v", got, want)
 	}
@@ -486,14 +502,15 @@ func TestGistCommit_String(t *testing.T) {
 
 func TestGistFile_String(t *testing.T) {
 	v := GistFile{
-		Size:     Int(0),
-		Filename: String(""),
-		Language: String(""),
-		Type:     String(""),
-		RawURL:   String(""),
-		Content:  String(""),
+		Size:      Int(0),
+		Filename:  String(""),
+		Language:  String(""),
+		Type:      String(""),
+		RawURL:    String(""),
+		Content:   String(""),
+		Truncated: Bool(false),
 	}
-	want := `github.GistFile{Size:0, Filename:"", Language:"", Type:"", RawURL:"", Content:""}`
+	want := `github.GistFile{Size:0, Filename:"", Language:"", Type:"", RawURL:"", Content:"", Truncated:false}`
 	if got := v.String(); got != want {
 		t.Errorf("GistFile.String = %v, want %v", got, want)
 	}
@@ -720,35 +737,39 @@ func TestInvitation_String(t *testing.T) {
 
 func TestIssue_String(t *testing.T) {
 	v := Issue{
-		ID:                Int64(0),
-		Number:            Int(0),
-		State:             String(""),
-		StateReason:       String(""),
-		Locked:            Bool(false),
-		Title:             String(""),
-		Body:              String(""),
-		AuthorAssociation: String(""),
-		User:              &User{},
-		Assignee:          &User{},
-		Comments:          Int(0),
-		ClosedAt:          &Timestamp{},
-		CreatedAt:         &Timestamp{},
-		UpdatedAt:         &Timestamp{},
-		ClosedBy:          &User{},
-		URL:               String(""),
-		HTMLURL:           String(""),
-		CommentsURL:       String(""),
-		EventsURL:         String(""),
-		LabelsURL:         String(""),
-		RepositoryURL:     String(""),
-		Milestone:         &Milestone{},
-		PullRequestLinks:  &PullRequestLinks{},
-		Repository:        &Repository{},
-		Reactions:         &Reactions{},
-		NodeID:            String(""),
-		ActiveLockReason:  String(""),
-	}
-	want := `github.Issue{ID:0, Number:0, State:"", StateReason:"", Locked:false, Title:"", Body:"", AuthorAssociation:"", User:github.User{}, Assignee:github.User{}, Comments:0, ClosedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, ClosedBy:github.User{}, URL:"", HTMLURL:"", CommentsURL:"", EventsURL:"", LabelsURL:"", RepositoryURL:"", Milestone:github.Milestone{}, PullRequestLinks:github.PullRequestLinks{}, Repository:github.Repository{}, Reactions:github.Reactions{}, NodeID:"", ActiveLockReason:""}`
+		ID:                       Int64(0),
+		Number:                   Int(0),
+		State:                    String(""),
+		StateReason:              String(""),
+		Locked:                   Bool(false),
+		Title:                    String(""),
+		Body:                     String(""),
+		AuthorAssociation:        String(""),
+		User:                     &User{},
+		Assignee:                 &User{},
+		Comments:                 Int(0),
+		ClosedAt:                 &Timestamp{},
+		CreatedAt:                &Timestamp{},
+		UpdatedAt:                &Timestamp{},
+		ClosedBy:                 &User{},
+		URL:                      String(""),
+		HTMLURL:                  String(""),
+		CommentsURL:              String(""),
+		EventsURL:                String(""),
+		LabelsURL:                String(""),
+		RepositoryURL:            String(""),
+		Milestone:                &Milestone{},
+		PullRequestLinks:         &PullRequestLinks{},
+		Repository:               &Repository{},
+		Reactions:                &Reactions{},
+		NodeID:                   String(""),
+		ActiveLockReason:         String(""),
+		SubIssuesSummary:         &SubIssuesSummary{},
+		Type:                     &IssueType{},
+		IssueDependenciesSummary: &IssueDependenciesSummary{},
+		Pinned:                   Bool(false),
+	}
+	want := `github.Issue{ID:0, Number:0, State:"", StateReason:"", Locked:false, Title:"", Body:"", AuthorAssociation:"", User:github.User{}, Assignee:github.User{}, Comments:0, ClosedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, ClosedBy:github.User{}, URL:"", HTMLURL:"", CommentsURL:"", EventsURL:"", LabelsURL:"", RepositoryURL:"", Milestone:github.Milestone{}, PullRequestLinks:github.PullRequestLinks{}, Repository:github.Repository{}, Reactions:github.Reactions{}, NodeID:"", ActiveLockReason:"", SubIssuesSummary:github.SubIssuesSummary{}, Type:github.IssueType{}, IssueDependenciesSummary:github.IssueDependenciesSummary{}, Pinned:false}`
 	if got := v.String(); got != want {
 		t.Errorf("Issue.String = %v, want %v", got, want)
 	}
@@ -786,6 +807,24 @@ func TestIssueStats_String(t *testing.T) {
 	}
 }
 
+func TestIssueTemplate_String(t *testing.T) {
+	v := IssueTemplate{
+		Path:        String(""),
+		Name:        String(""),
+		About:       String(""),
+		Description: String(""),
+		Title:       String(""),
+		Labels:      []string{""},
+		Assignees:   []string{""},
+		Projects:    []string{""},
+		Body:        String(""),
+	}
+	want := `github.IssueTemplate{Path:"", Name:"", About:"", Description:"", Title:"", Labels:[""], Assignees:[""], Projects:[""], Body:""}`
+	if got := v.String(); got != want {
+		t.Errorf("IssueTemplate.String = %v, want %v", got, want)
+	}
+}
+
 func TestKey_String(t *testing.T) {
 	v := Key{
 		ID:        Int64(0),
@@ -797,8 +836,9 @@ func TestKey_String(t *testing.T) {
 		CreatedAt: &Timestamp{},
 		AddedBy:   String(""),
 		LastUsed:  &Timestamp{},
+		Enabled:   Bool(false),
 	}
-	want := `github.Key{ID:0, Key:"", URL:"", Title:"", ReadOnly:false, Verified:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, AddedBy:"", LastUsed:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	want := `github.Key{ID:0, Key:"", URL:"", Title:"", ReadOnly:false, Verified:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, AddedBy:"", LastUsed:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Enabled:false}`
 	if got := v.String(); got != want {
 		t.Errorf("Key.String = %v, want %v", got, want)
 	}
@@ -809,12 +849,13 @@ func TestLabel_String(t *testing.T) {
 		ID:          Int64(0),
 		URL:         String(""),
 		Name:        String(""),
+		NewName:     String(""),
 		Color:       String(""),
 		Description: String(""),
 		Default:     Bool(false),
 		NodeID:      String(""),
 	}
-	want := `github.Label{ID:0, URL:"", Name:"", Color:"", Description:"", Default:false, NodeID:""}`
+	want := `github.Label{ID:0, URL:"", Name:"", NewName:"", Color:"", Description:"", Default:false, NodeID:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Label.String = %v, want %v", got, want)
 	}
@@ -884,16 +925,22 @@ func TestMembership_String(t *testing.T) {
 
 func TestMigration_String(t *testing.T) {
 	v := Migration{
-		ID:                 Int64(0),
-		GUID:               String(""),
-		State:              String(""),
-		LockRepositories:   Bool(false),
-		ExcludeAttachments: Bool(false),
-		URL:                String(""),
-		CreatedAt:          String(""),
-		UpdatedAt:          String(""),
-	}
-	want := `github.Migration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, URL:"", CreatedAt:"", UpdatedAt:""}`
+		ID:                   Int64(0),
+		GUID:                 String(""),
+		State:                String(""),
+		LockRepositories:     Bool(false),
+		ExcludeAttachments:   Bool(false),
+		ExcludeReleases:      Bool(false),
+		ExcludeGitData:       Bool(false),
+		ExcludeMetadata:      Bool(false),
+		ExcludeOwnerProjects: Bool(false),
+		OrgMetadataOnly:      Bool(false),
+		ArchiveURL:           String(""),
+		URL:                  String(""),
+		CreatedAt:            String(""),
+		UpdatedAt:            String(""),
+	}
+	want := `github.Migration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, ExcludeReleases:false, ExcludeGitData:false, ExcludeMetadata:false, ExcludeOwnerProjects:false, OrgMetadataOnly:false, ArchiveURL:"", URL:"", CreatedAt:"", UpdatedAt:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Migration.String = %v, want %v", got, want)
 	}
@@ -1745,8 +1792,9 @@ func TestRepositoryRelease_String(t *testing.T) {
 		TarballURL:             String(""),
 		Author:                 &User{},
 		NodeID:                 String(""),
+		Reactions:              &Reactions{},
 	}
-	want := `github.RepositoryRelease{TagName:"", TargetCommitish:"", Name:"", Body:"", Draft:false, Prerelease:false, MakeLatest:"", DiscussionCategoryName:"", GenerateReleaseNotes:false, ID:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, PublishedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", HTMLURL:"", AssetsURL:"", UploadURL:"", ZipballURL:"", TarballURL:"", Author:github.User{}, NodeID:""}`
+	want := `github.RepositoryRelease{TagName:"", TargetCommitish:"", Name:"", Body:"", Draft:false, Prerelease:false, MakeLatest:"", DiscussionCategoryName:"", GenerateReleaseNotes:false, ID:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, PublishedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", HTMLURL:"", AssetsURL:"", UploadURL:"", ZipballURL:"", TarballURL:"", Author:github.User{}, NodeID:"", Reactions:github.Reactions{}}`
 	if got := v.String(); got != want {
 		t.Errorf("RepositoryRelease.String = %v, want %v", got, want)
 	}
@@ -1775,13 +1823,25 @@ func TestSecretScanning_String(t *testing.T) {
 	}
 }
 
+func TestSecretScanningNonProviderPatterns_String(t *testing.T) {
+	v := SecretScanningNonProviderPatterns{
+		Status: String(""),
+	}
+	want := `github.SecretScanningNonProviderPatterns{Status:""}`
+	if got := v.String(); got != want {
+		t.Errorf("SecretScanningNonProviderPatterns.String = %v, want %v", got, want)
+	}
+}
+
 func TestSecurityAndAnalysis_String(t *testing.T) {
 	v := SecurityAndAnalysis{
-		AdvancedSecurity:             &AdvancedSecurity{},
-		SecretScanning:               &SecretScanning{},
-		SecretScanningPushProtection: &SecretScanningPushProtection{},
+		AdvancedSecurity:                  &AdvancedSecurity{},
+		SecretScanning:                    &SecretScanning{},
+		SecretScanningPushProtection:      &SecretScanningPushProtection{},
+		DependabotSecurityUpdates:         &DependabotSecurityUpdates{},
+		SecretScanningNonProviderPatterns: &SecretScanningNonProviderPatterns{},
 	}
-	want := `github.SecurityAndAnalysis{AdvancedSecurity:github.AdvancedSecurity{}, SecretScanning:github.SecretScanning{}, SecretScanningPushProtection:github.SecretScanningPushProtection{}}`
+	want := `github.SecurityAndAnalysis{AdvancedSecurity:github.AdvancedSecurity{}, SecretScanning:github.SecretScanning{}, SecretScanningPushProtection:github.SecretScanningPushProtection{}, DependabotSecurityUpdates:github.DependabotSecurityUpdates{}, SecretScanningNonProviderPatterns:github.SecretScanningNonProviderPatterns{}}`
 	if got := v.String(); got != want {
 		t.Errorf("SecurityAndAnalysis.String = %v, want %v", got, want)
 	}
@@ -2005,16 +2065,21 @@ func TestUserLDAPMapping_String(t *testing.T) {
 
 func TestUserMigration_String(t *testing.T) {
 	v := UserMigration{
-		ID:                 Int64(0),
-		GUID:               String(""),
-		State:              String(""),
-		LockRepositories:   Bool(false),
-		ExcludeAttachments: Bool(false),
-		URL:                String(""),
-		CreatedAt:          String(""),
-		UpdatedAt:          String(""),
-	}
-	want := `github.UserMigration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, URL:"", CreatedAt:"", UpdatedAt:""}`
+		ID:                   Int64(0),
+		GUID:                 String(""),
+		State:                String(""),
+		LockRepositories:     Bool(false),
+		ExcludeAttachments:   Bool(false),
+		ExcludeReleases:      Bool(false),
+		ExcludeGitData:       Bool(false),
+		ExcludeMetadata:      Bool(false),
+		ExcludeOwnerProjects: Bool(false),
+		ArchiveURL:           String(""),
+		URL:                  String(""),
+		CreatedAt:            String(""),
+		UpdatedAt:            String(""),
+	}
+	want := `github.UserMigration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, ExcludeReleases:false, ExcludeGitData:false, ExcludeMetadata:false, ExcludeOwnerProjects:false, ArchiveURL:"", URL:"", CreatedAt:"", UpdatedAt:""}`
 	if got := v.String(); got != want {
 		t.Errorf("UserMigration.String = %v, want %v", got, want)
 	}
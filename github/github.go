@@ -48,8 +48,10 @@ const (
 	mediaTypeV3SHA             = "application/vnd.github.v3.sha"
 	mediaTypeV3Diff            = "application/vnd.github.v3.diff"
 	mediaTypeV3Patch           = "application/vnd.github.v3.patch"
+	mediaTypeRaw               = "application/vnd.github.raw"
 	mediaTypeOrgPermissionRepo = "application/vnd.github.v3.repository+json"
 	mediaTypeIssueImportAPI    = "application/vnd.github.golden-comet-preview+json"
+	mediaTypeSCIM              = "application/scim+json"
 
 	// Media Type values to access preview APIs
 	// These media types will be added to the API request as headers
@@ -171,42 +173,55 @@ type Client struct {
 	// User agent used when communicating with the GitHub API.
 	UserAgent string
 
+	// UnknownFields, if set, enables strict decoding of API responses: it is
+	// called with the response fields that the value they were decoded into
+	// does not model, instead of those fields being silently dropped.
+	UnknownFields UnknownFieldsFunc
+
 	rateMu                  sync.Mutex
 	rateLimits              [categories]Rate // Rate limits for the client as determined by the most recent API calls.
 	secondaryRateLimitReset time.Time        // Secondary rate limit reset for the client as determined by the most recent API calls.
 
+	emojiMu   sync.Mutex
+	emojiETag string            // ETag of the most recent ListEmojis response.
+	emojis    map[string]string // Emojis returned by the most recent ListEmojis call.
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
-	Actions        *ActionsService
-	Activity       *ActivityService
-	Admin          *AdminService
-	Apps           *AppsService
-	Authorizations *AuthorizationsService
-	Billing        *BillingService
-	Checks         *ChecksService
-	CodeScanning   *CodeScanningService
-	Dependabot     *DependabotService
-	Enterprise     *EnterpriseService
-	Gists          *GistsService
-	Git            *GitService
-	Gitignores     *GitignoresService
-	Interactions   *InteractionsService
-	IssueImport    *IssueImportService
-	Issues         *IssuesService
-	Licenses       *LicensesService
-	Marketplace    *MarketplaceService
-	Migrations     *MigrationService
-	Organizations  *OrganizationsService
-	Projects       *ProjectsService
-	PullRequests   *PullRequestsService
-	Reactions      *ReactionsService
-	Repositories   *RepositoriesService
-	SCIM           *SCIMService
-	Search         *SearchService
-	SecretScanning *SecretScanningService
-	Teams          *TeamsService
-	Users          *UsersService
+	Actions            *ActionsService
+	Activity           *ActivityService
+	Admin              *AdminService
+	Apps               *AppsService
+	Authorizations     *AuthorizationsService
+	Billing            *BillingService
+	Checks             *ChecksService
+	CodeScanning       *CodeScanningService
+	Codespaces         *CodespacesService
+	Copilot            *CopilotService
+	Dependabot         *DependabotService
+	DependencyGraph    *DependencyGraphService
+	Enterprise         *EnterpriseService
+	Gists              *GistsService
+	Git                *GitService
+	Gitignores         *GitignoresService
+	Interactions       *InteractionsService
+	IssueImport        *IssueImportService
+	Issues             *IssuesService
+	Licenses           *LicensesService
+	Marketplace        *MarketplaceService
+	Migrations         *MigrationService
+	Organizations      *OrganizationsService
+	Projects           *ProjectsService
+	PullRequests       *PullRequestsService
+	Reactions          *ReactionsService
+	Repositories       *RepositoriesService
+	SCIM               *SCIMService
+	Search             *SearchService
+	SecretScanning     *SecretScanningService
+	SecurityAdvisories *SecurityAdvisoriesService
+	Teams              *TeamsService
+	Users              *UsersService
 }
 
 type service struct {
@@ -281,6 +296,27 @@ type RawOptions struct {
 	Type RawType
 }
 
+// getRaw streams the resource at urlStr to w in the raw format t, without
+// buffering it, so that large diffs and patches can be processed as they
+// are received.
+func (c *Client) getRaw(ctx context.Context, urlStr string, t RawType, w io.Writer) (*Response, error) {
+	req, err := c.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case Diff:
+		req.Header.Set("Accept", mediaTypeV3Diff)
+	case Patch:
+		req.Header.Set("Accept", mediaTypeV3Patch)
+	default:
+		return nil, fmt.Errorf("unsupported raw type %d", t)
+	}
+
+	return c.Do(ctx, req, w)
+}
+
 // addOptions adds the parameters in opts as URL query parameters to s. opts
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opts interface{}) (string, error) {
@@ -324,7 +360,10 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Billing = (*BillingService)(&c.common)
 	c.Checks = (*ChecksService)(&c.common)
 	c.CodeScanning = (*CodeScanningService)(&c.common)
+	c.Codespaces = (*CodespacesService)(&c.common)
+	c.Copilot = (*CopilotService)(&c.common)
 	c.Dependabot = (*DependabotService)(&c.common)
+	c.DependencyGraph = (*DependencyGraphService)(&c.common)
 	c.Enterprise = (*EnterpriseService)(&c.common)
 	c.Gists = (*GistsService)(&c.common)
 	c.Git = (*GitService)(&c.common)
@@ -343,6 +382,7 @@ func NewClient(httpClient *http.Client) *Client {
 	c.SCIM = (*SCIMService)(&c.common)
 	c.Search = (*SearchService)(&c.common)
 	c.SecretScanning = (*SecretScanningService)(&c.common)
+	c.SecurityAdvisories = (*SecurityAdvisoriesService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
 	return c
@@ -815,6 +855,10 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	case io.Writer:
 		_, err = io.Copy(v, resp.Body)
 	default:
+		if c.UnknownFields != nil {
+			err = c.decodeStrict(resp.Body, v)
+			break
+		}
 		decErr := json.NewDecoder(resp.Body).Decode(v)
 		if decErr == io.EOF {
 			decErr = nil // ignore EOF errors caused by empty response body
@@ -826,6 +870,22 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return resp, err
 }
 
+// decodeStrict decodes the JSON value in body into v and reports the fields
+// v does not model to c.UnknownFields.
+func (c *Client) decodeStrict(body io.Reader, v interface{}) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil // ignore empty response body
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	return reportUnknownFields(data, v, c.UnknownFields)
+}
+
 // checkRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
 // current client state in order to quickly check if *RateLimitError can be immediately returned
 // from Client.Do, and if so, returns it so that Client.Do can skip making a network API call unnecessarily.
@@ -1205,6 +1265,22 @@ func (r Rate) String() string {
 	return Stringify(r)
 }
 
+// waitForRateLimit blocks until the rate limit reported by resp resets if
+// fewer than minRemaining requests remain in the current window. It is used
+// by helpers that issue many requests in a row. If ctx is done first, its
+// error is returned.
+func waitForRateLimit(ctx context.Context, resp *Response, minRemaining int) error {
+	if resp == nil || resp.Rate.Limit == 0 || resp.Rate.Remaining >= minRemaining {
+		return nil
+	}
+
+	wait := time.Until(resp.Rate.Reset.Time)
+	if wait <= 0 {
+		return nil
+	}
+	return sleepContext(ctx, wait)
+}
+
 // RateLimits represents the rate limits for the current client.
 type RateLimits struct {
 	// The rate limit for non-search API requests. Unauthenticated
@@ -1069,6 +1069,58 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestDo_unknownFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	type foo struct {
+		A string
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"A":"a","B":"b"}`)
+	})
+
+	var got []string
+	client.UnknownFields = func(v interface{}, fields []string) {
+		got = fields
+	}
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	body := new(foo)
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, body); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if want := (&foo{"a"}); !cmp.Equal(body, want) {
+		t.Errorf("Response body = %v, want %v", body, want)
+	}
+	if want := []string{"B"}; !cmp.Equal(got, want) {
+		t.Errorf("UnknownFields called with %v, want %v", got, want)
+	}
+}
+
+func TestDo_unknownFieldsEmptyBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client.UnknownFields = func(v interface{}, fields []string) {
+		t.Errorf("UnknownFields called with %v", fields)
+	}
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, new(struct{})); err != nil {
+		t.Errorf("Do returned unexpected error: %v", err)
+	}
+}
+
 func TestDo_nilContext(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -2115,6 +2167,32 @@ func TestError_Error(t *testing.T) {
 	}
 }
 
+func TestWaitForRateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	if err := waitForRateLimit(ctx, nil, 10); err != nil {
+		t.Errorf("waitForRateLimit returned error for nil response: %v", err)
+	}
+
+	resp := &Response{Rate: Rate{Limit: 60, Remaining: 50, Reset: Timestamp{time.Now().Add(time.Hour)}}}
+	if err := waitForRateLimit(ctx, resp, 10); err != nil {
+		t.Errorf("waitForRateLimit returned error above threshold: %v", err)
+	}
+
+	resp.Rate.Remaining = 1
+	resp.Rate.Reset = Timestamp{time.Now().Add(10 * time.Millisecond)}
+	if err := waitForRateLimit(ctx, resp, 10); err != nil {
+		t.Errorf("waitForRateLimit returned error: %v", err)
+	}
+
+	resp.Rate.Reset = Timestamp{time.Now().Add(time.Hour)}
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := waitForRateLimit(cancelled, resp, 10); err != context.Canceled {
+		t.Errorf("waitForRateLimit returned %v, want %v", err, context.Canceled)
+	}
+}
+
 func TestRateLimits(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,93 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// A few features, such as merge queues, are only available through the
+// GitHub GraphQL API. The methods exposing them use the helpers in this file
+// to send GraphQL requests through the same Client, so that they share its
+// authentication, rate limit tracking, and error handling.
+
+// GraphQLError is a single error reported by the GitHub GraphQL API.
+type GraphQLError struct {
+	Type    string        `json:"type,omitempty"`
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+func (e *GraphQLError) Error() string {
+	if e.Type == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%v: %v", e.Type, e.Message)
+}
+
+// GraphQLErrorResponse reports the errors returned by the GitHub GraphQL
+// API. Unlike the REST API, the GraphQL API reports errors with a status
+// code of 200, so they are not reported as an *ErrorResponse.
+type GraphQLErrorResponse struct {
+	Response *http.Response // HTTP response that carried the errors
+	Errors   []*GraphQLError
+}
+
+func (r *GraphQLErrorResponse) Error() string {
+	messages := make([]string, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		messages = append(messages, e.Error())
+	}
+	return fmt.Sprintf("%v %v: GraphQL errors: %v",
+		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL), strings.Join(messages, "; "))
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []*GraphQLError `json:"errors,omitempty"`
+}
+
+// graphQL sends a GraphQL query or mutation and decodes the "data" member of
+// the response into v. GraphQL errors are returned as a *GraphQLErrorResponse.
+func (c *Client) graphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*Response, error) {
+	// The GraphQL endpoint of GitHub Enterprise Server is /api/graphql,
+	// next to the /api/v3/ REST API.
+	u := "graphql"
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		u = "../graphql"
+	}
+
+	req, err := c.NewRequest("POST", u, &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return nil, err
+	}
+
+	gr := new(graphQLResponse)
+	resp, err := c.Do(ctx, req, gr)
+	if err != nil {
+		return resp, err
+	}
+	if len(gr.Errors) > 0 {
+		return resp, &GraphQLErrorResponse{Response: resp.Response, Errors: gr.Errors}
+	}
+
+	if v != nil && len(gr.Data) > 0 {
+		if err := json.Unmarshal(gr.Data, v); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
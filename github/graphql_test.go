@@ -0,0 +1,83 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_graphQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"query":"query($login:String!){user(login:$login){name}}","variables":{"login":"u"}}`+"\n")
+		fmt.Fprint(w, `{"data":{"user":{"name":"n"}}}`)
+	})
+
+	var data struct {
+		User struct {
+			Name string `json:"name"`
+		} `json:"user"`
+	}
+	ctx := context.Background()
+	_, err := client.graphQL(ctx, "query($login:String!){user(login:$login){name}}", map[string]interface{}{"login": "u"}, &data)
+	if err != nil {
+		t.Fatalf("graphQL returned error: %v", err)
+	}
+	if got, want := data.User.Name, "n"; got != want {
+		t.Errorf("graphQL decoded name %q, want %q", got, want)
+	}
+}
+
+func TestClient_graphQL_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":null,"errors":[{"type":"NOT_FOUND","message":"Could not resolve","path":["repository"]}]}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.graphQL(ctx, "query{viewer{login}}", nil, nil)
+
+	var gerr *GraphQLErrorResponse
+	if !errors.As(err, &gerr) {
+		t.Fatalf("graphQL returned error %v, want *GraphQLErrorResponse", err)
+	}
+	want := []*GraphQLError{{Type: "NOT_FOUND", Message: "Could not resolve", Path: []interface{}{"repository"}}}
+	if !cmp.Equal(gerr.Errors, want) {
+		t.Errorf("graphQL returned errors %+v, want %+v", gerr.Errors, want)
+	}
+}
+
+func TestClient_graphQL_enterpriseURL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data":{}}`)
+	})
+
+	// Nest an /api/v3/ REST API under the test server and check that the
+	// GraphQL request goes to its sibling /api/graphql.
+	u, _ := url.Parse(client.BaseURL.String() + "api/v3/")
+	client.BaseURL = u
+
+	ctx := context.Background()
+	if _, err := client.graphQL(ctx, "query{viewer{login}}", nil, nil); err != nil {
+		t.Errorf("graphQL returned error: %v", err)
+	}
+}
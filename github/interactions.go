@@ -5,13 +5,31 @@
 
 package github
 
-// InteractionsService handles communication with the repository and organization related
-// methods of the GitHub API.
+// InteractionsService handles communication with the interaction restriction
+// related methods of the GitHub API for repositories, organizations and the
+// authenticated user.
 //
 // GitHub API docs: https://docs.github.com/en/rest/interactions/
 type InteractionsService service
 
-// InteractionRestriction represents the interaction restrictions for repository and organization.
+// Groups of GitHub users that interaction restrictions limit interactions to.
+const (
+	InteractionLimitExistingUsers     = "existing_users"
+	InteractionLimitContributorsOnly  = "contributors_only"
+	InteractionLimitCollaboratorsOnly = "collaborators_only"
+)
+
+// Durations of interaction restrictions.
+const (
+	InteractionExpiryOneDay    = "one_day"
+	InteractionExpiryThreeDays = "three_days"
+	InteractionExpiryOneWeek   = "one_week"
+	InteractionExpiryOneMonth  = "one_month"
+	InteractionExpirySixMonths = "six_months"
+)
+
+// InteractionRestriction represents the interaction restrictions for a
+// repository, an organization or the authenticated user.
 type InteractionRestriction struct {
 	// Specifies the group of GitHub users who can
 	// comment, open issues, or create pull requests for the given repository.
@@ -19,10 +37,14 @@ type InteractionRestriction struct {
 	Limit *string `json:"limit,omitempty"`
 
 	// Origin specifies the type of the resource to interact with.
-	// Possible values are: "repository" and "organization".
+	// Possible values are: "repository", "organization" and "user".
 	Origin *string `json:"origin,omitempty"`
 
 	// ExpiresAt specifies the time after which the interaction restrictions expire.
 	// The default expiry time is 24 hours from the time restriction is created.
 	ExpiresAt *Timestamp `json:"expires_at,omitempty"`
+
+	// Expiry specifies how long the restrictions last when setting them. It
+	// is one of the InteractionExpiry constants and defaults to one_day.
+	Expiry *string `json:"expiry,omitempty"`
 }
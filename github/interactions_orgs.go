@@ -38,12 +38,28 @@ func (s *InteractionsService) GetRestrictionsForOrg(ctx context.Context, organiz
 // limit specifies the group of GitHub users who can comment, open issues, or create pull requests
 // in public repositories for the given organization.
 // Possible values are: "existing_users", "contributors_only", "collaborators_only".
+// The restrictions expire after one day; use SetRestrictionsForOrg to choose the expiry.
 //
 // GitHub API docs: https://docs.github.com/en/rest/interactions/orgs#set-interaction-restrictions-for-an-organization
 func (s *InteractionsService) UpdateRestrictionsForOrg(ctx context.Context, organization, limit string) (*InteractionRestriction, *Response, error) {
+	return s.SetRestrictionsForOrg(ctx, organization, &InteractionRestriction{Limit: String(limit)})
+}
+
+// SetRestrictionsForOrg adds or updates the interaction restrictions for an
+// organization, using the Limit and Expiry of restriction, which must not
+// be nil.
+//
+// GitHub API docs: https://docs.github.com/en/rest/interactions/orgs#set-interaction-restrictions-for-an-organization
+func (s *InteractionsService) SetRestrictionsForOrg(ctx context.Context, organization string, restriction *InteractionRestriction) (*InteractionRestriction, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/interaction-limits", organization)
+	return s.setRestrictions(ctx, u, restriction)
+}
 
-	interaction := &InteractionRestriction{Limit: String(limit)}
+func (s *InteractionsService) setRestrictions(ctx context.Context, u string, restriction *InteractionRestriction) (*InteractionRestriction, *Response, error) {
+	if restriction == nil {
+		return nil, nil, fmt.Errorf("restriction must be provided")
+	}
+	interaction := &InteractionRestriction{Limit: restriction.Limit, Expiry: restriction.Expiry}
 
 	req, err := s.client.NewRequest("PUT", u, interaction)
 	if err != nil {
@@ -53,14 +69,14 @@ func (s *InteractionsService) UpdateRestrictionsForOrg(ctx context.Context, orga
 	// TODO: remove custom Accept header when this API fully launches.
 	req.Header.Set("Accept", mediaTypeInteractionRestrictionsPreview)
 
-	organizationInteractions := new(InteractionRestriction)
+	interactions := new(InteractionRestriction)
 
-	resp, err := s.client.Do(ctx, req, organizationInteractions)
+	resp, err := s.client.Do(ctx, req, interactions)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return organizationInteractions, resp, nil
+	return interactions, resp, nil
 }
 
 // RemoveRestrictionsFromOrg removes the interaction restrictions for an organization.
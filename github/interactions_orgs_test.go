@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -120,3 +121,59 @@ func TestInteractionsService_RemoveRestrictionsFromOrg(t *testing.T) {
 		return client.Interactions.RemoveRestrictionsFromOrg(ctx, "o")
 	})
 }
+
+func TestInteractionsService_SetRestrictionsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &InteractionRestriction{
+		Limit:  String(InteractionLimitContributorsOnly),
+		Expiry: String(InteractionExpiryOneWeek),
+	}
+
+	mux.HandleFunc("/orgs/o/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", mediaTypeInteractionRestrictionsPreview)
+		testBody(t, r, `{"limit":"contributors_only","expiry":"one_week"}`+"\n")
+		fmt.Fprint(w, `{"limit":"contributors_only","origin":"organization","expires_at":"2023-03-10T00:00:00Z"}`)
+	})
+
+	ctx := context.Background()
+	organizationInteractions, _, err := client.Interactions.SetRestrictionsForOrg(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Interactions.SetRestrictionsForOrg returned error: %v", err)
+	}
+
+	want := &InteractionRestriction{
+		Limit:     String("contributors_only"),
+		Origin:    String("organization"),
+		ExpiresAt: &Timestamp{time.Date(2023, time.March, 10, 0, 0, 0, 0, time.UTC)},
+	}
+	if !cmp.Equal(organizationInteractions, want) {
+		t.Errorf("Interactions.SetRestrictionsForOrg returned %+v, want %+v", organizationInteractions, want)
+	}
+
+	const methodName = "SetRestrictionsForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Interactions.SetRestrictionsForOrg(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Interactions.SetRestrictionsForOrg(ctx, "o", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestInteractionsService_SetRestrictionsForOrg_nil(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, _, err := client.Interactions.SetRestrictionsForOrg(ctx, "o", nil); err == nil {
+		t.Error("Interactions.SetRestrictionsForOrg returned nil error for a nil restriction")
+	}
+}
@@ -38,29 +38,21 @@ func (s *InteractionsService) GetRestrictionsForRepo(ctx context.Context, owner,
 // limit specifies the group of GitHub users who can comment, open issues, or create pull requests
 // for the given repository.
 // Possible values are: "existing_users", "contributors_only", "collaborators_only".
+// The restrictions expire after one day; use SetRestrictionsForRepo to choose the expiry.
 //
 // GitHub API docs: https://docs.github.com/en/rest/interactions/repos#set-interaction-restrictions-for-a-repository
 func (s *InteractionsService) UpdateRestrictionsForRepo(ctx context.Context, owner, repo, limit string) (*InteractionRestriction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/interaction-limits", owner, repo)
-
-	interaction := &InteractionRestriction{Limit: String(limit)}
-
-	req, err := s.client.NewRequest("PUT", u, interaction)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	// TODO: remove custom Accept header when this API fully launches.
-	req.Header.Set("Accept", mediaTypeInteractionRestrictionsPreview)
-
-	repositoryInteractions := new(InteractionRestriction)
-
-	resp, err := s.client.Do(ctx, req, repositoryInteractions)
-	if err != nil {
-		return nil, resp, err
-	}
+	return s.SetRestrictionsForRepo(ctx, owner, repo, &InteractionRestriction{Limit: String(limit)})
+}
 
-	return repositoryInteractions, resp, nil
+// SetRestrictionsForRepo adds or updates the interaction restrictions for a
+// repository, using the Limit and Expiry of restriction, which must not be
+// nil.
+//
+// GitHub API docs: https://docs.github.com/en/rest/interactions/repos#set-interaction-restrictions-for-a-repository
+func (s *InteractionsService) SetRestrictionsForRepo(ctx context.Context, owner, repo string, restriction *InteractionRestriction) (*InteractionRestriction, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/interaction-limits", owner, repo)
+	return s.setRestrictions(ctx, u, restriction)
 }
 
 // RemoveRestrictionsFromRepo removes the interaction restrictions for a repository.
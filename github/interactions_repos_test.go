@@ -120,3 +120,45 @@ func TestInteractionsService_RemoveRestrictionsFromRepo(t *testing.T) {
 		return client.Interactions.RemoveRestrictionsFromRepo(ctx, "o", "r")
 	})
 }
+
+func TestInteractionsService_SetRestrictionsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &InteractionRestriction{
+		Limit:  String(InteractionLimitCollaboratorsOnly),
+		Expiry: String(InteractionExpirySixMonths),
+	}
+
+	mux.HandleFunc("/repos/o/r/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", mediaTypeInteractionRestrictionsPreview)
+		testBody(t, r, `{"limit":"collaborators_only","expiry":"six_months"}`+"\n")
+		fmt.Fprint(w, `{"limit":"collaborators_only","origin":"repository"}`)
+	})
+
+	ctx := context.Background()
+	repoInteractions, _, err := client.Interactions.SetRestrictionsForRepo(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Interactions.SetRestrictionsForRepo returned error: %v", err)
+	}
+
+	want := &InteractionRestriction{Limit: String("collaborators_only"), Origin: String("repository")}
+	if !cmp.Equal(repoInteractions, want) {
+		t.Errorf("Interactions.SetRestrictionsForRepo returned %+v, want %+v", repoInteractions, want)
+	}
+
+	const methodName = "SetRestrictionsForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Interactions.SetRestrictionsForRepo(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Interactions.SetRestrictionsForRepo(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
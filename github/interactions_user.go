@@ -0,0 +1,59 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+)
+
+// GetRestrictionsForUser fetches the interaction restrictions for the public
+// repositories of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/interactions/user#get-interaction-restrictions-for-your-public-repositories
+func (s *InteractionsService) GetRestrictionsForUser(ctx context.Context) (*InteractionRestriction, *Response, error) {
+	req, err := s.client.NewRequest("GET", "user/interaction-limits", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeInteractionRestrictionsPreview)
+
+	userInteractions := new(InteractionRestriction)
+
+	resp, err := s.client.Do(ctx, req, userInteractions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return userInteractions, resp, nil
+}
+
+// SetRestrictionsForUser adds or updates the interaction restrictions for the
+// public repositories of the authenticated user, using the Limit and Expiry
+// of restriction, which must not be nil. They override any restrictions set
+// on those repositories.
+//
+// GitHub API docs: https://docs.github.com/en/rest/interactions/user#set-interaction-restrictions-for-your-public-repositories
+func (s *InteractionsService) SetRestrictionsForUser(ctx context.Context, restriction *InteractionRestriction) (*InteractionRestriction, *Response, error) {
+	return s.setRestrictions(ctx, "user/interaction-limits", restriction)
+}
+
+// RemoveRestrictionsFromUser removes the interaction restrictions for the
+// public repositories of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/interactions/user#remove-interaction-restrictions-from-your-public-repositories
+func (s *InteractionsService) RemoveRestrictionsFromUser(ctx context.Context) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", "user/interaction-limits", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeInteractionRestrictionsPreview)
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInteractionsService_GetRestrictionsForUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeInteractionRestrictionsPreview)
+		fmt.Fprint(w, `{"limit":"existing_users","origin":"user"}`)
+	})
+
+	ctx := context.Background()
+	userInteractions, _, err := client.Interactions.GetRestrictionsForUser(ctx)
+	if err != nil {
+		t.Errorf("Interactions.GetRestrictionsForUser returned error: %v", err)
+	}
+
+	want := &InteractionRestriction{Limit: String("existing_users"), Origin: String("user")}
+	if !cmp.Equal(userInteractions, want) {
+		t.Errorf("Interactions.GetRestrictionsForUser returned %+v, want %+v", userInteractions, want)
+	}
+
+	const methodName = "GetRestrictionsForUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Interactions.GetRestrictionsForUser(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestInteractionsService_SetRestrictionsForUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &InteractionRestriction{
+		Limit:  String(InteractionLimitExistingUsers),
+		Expiry: String(InteractionExpiryOneDay),
+	}
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", mediaTypeInteractionRestrictionsPreview)
+		testBody(t, r, `{"limit":"existing_users","expiry":"one_day"}`+"\n")
+		fmt.Fprint(w, `{"limit":"existing_users","origin":"user"}`)
+	})
+
+	ctx := context.Background()
+	userInteractions, _, err := client.Interactions.SetRestrictionsForUser(ctx, input)
+	if err != nil {
+		t.Errorf("Interactions.SetRestrictionsForUser returned error: %v", err)
+	}
+
+	want := &InteractionRestriction{Limit: String("existing_users"), Origin: String("user")}
+	if !cmp.Equal(userInteractions, want) {
+		t.Errorf("Interactions.SetRestrictionsForUser returned %+v, want %+v", userInteractions, want)
+	}
+
+	const methodName = "SetRestrictionsForUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Interactions.SetRestrictionsForUser(ctx, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestInteractionsService_RemoveRestrictionsFromUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypeInteractionRestrictionsPreview)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Interactions.RemoveRestrictionsFromUser(ctx)
+	if err != nil {
+		t.Errorf("Interactions.RemoveRestrictionsFromUser returned error: %v", err)
+	}
+
+	const methodName = "RemoveRestrictionsFromUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Interactions.RemoveRestrictionsFromUser(ctx)
+	})
+}
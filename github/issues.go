@@ -60,8 +60,21 @@ type Issue struct {
 	TextMatches []*TextMatch `json:"text_matches,omitempty"`
 
 	// ActiveLockReason is populated only when LockReason is provided while locking the issue.
-	// Possible values are: "off-topic", "too heated", "resolved", and "spam".
+	// Possible values are the LockReason constants.
 	ActiveLockReason *string `json:"active_lock_reason,omitempty"`
+
+	// SubIssuesSummary summarizes the progress of the sub-issues of the issue.
+	SubIssuesSummary *SubIssuesSummary `json:"sub_issues_summary,omitempty"`
+
+	// Type is the organization issue type of the issue, if any.
+	Type *IssueType `json:"type,omitempty"`
+
+	// IssueDependenciesSummary counts the issues blocking, and blocked by, the issue.
+	IssueDependenciesSummary *IssueDependenciesSummary `json:"issue_dependencies_summary,omitempty"`
+
+	// Pinned reports whether the issue is pinned to its repository. The REST
+	// API does not return it; it is set by IssuesService.ListPinned.
+	Pinned *bool `json:"pinned,omitempty"`
 }
 
 func (i Issue) String() string {
@@ -88,6 +101,8 @@ type IssueRequest struct {
 	StateReason *string   `json:"state_reason,omitempty"`
 	Milestone   *int      `json:"milestone,omitempty"`
 	Assignees   *[]string `json:"assignees,omitempty"`
+	// Type is the name of the organization issue type to set.
+	Type *string `json:"type,omitempty"`
 }
 
 // IssueListOptions specifies the optional parameters to the IssuesService.List
@@ -115,6 +130,10 @@ type IssueListOptions struct {
 	// Since filters issues by time.
 	Since time.Time `url:"since,omitempty"`
 
+	// Type filters issues by the name of their organization issue type.
+	// Only used by IssuesService.ListByOrg.
+	Type string `url:"type,omitempty"`
+
 	ListOptions
 }
 
@@ -213,6 +232,11 @@ type IssueListByRepoOptions struct {
 	// Since filters issues by time.
 	Since time.Time `url:"since,omitempty"`
 
+	// Type filters issues by the name of their organization issue type.
+	// Possible values are an issue type name, "none" for issues with no
+	// type, "*" for issues with any type.
+	Type string `url:"type,omitempty"`
+
 	ListOptions
 }
 
@@ -326,19 +350,45 @@ func (s *IssuesService) RemoveMilestone(ctx context.Context, owner, repo string,
 	return i, resp, nil
 }
 
+// Reasons for locking an issue or pull request conversation.
+const (
+	LockReasonOffTopic  = "off-topic"
+	LockReasonTooHeated = "too heated"
+	LockReasonResolved  = "resolved"
+	LockReasonSpam      = "spam"
+)
+
 // LockIssueOptions specifies the optional parameters to the
 // IssuesService.Lock method.
 type LockIssueOptions struct {
 	// LockReason specifies the reason to lock this issue.
 	// Providing a lock reason can help make it clearer to contributors why an issue
-	// was locked. Possible values are: "off-topic", "too heated", "resolved", and "spam".
+	// was locked. Possible values are the LockReason constants.
 	LockReason string `json:"lock_reason,omitempty"`
 }
 
-// Lock an issue's conversation.
+// validate reports an error if the lock reason is not one GitHub accepts.
+func (o *LockIssueOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	switch o.LockReason {
+	case "", LockReasonOffTopic, LockReasonTooHeated, LockReasonResolved, LockReasonSpam:
+		return nil
+	default:
+		return fmt.Errorf("invalid lock reason %q", o.LockReason)
+	}
+}
+
+// Lock an issue's conversation. Once locked, only users with write access
+// to the repository can comment on the issue.
 //
 // GitHub API docs: https://docs.github.com/en/rest/issues/issues#lock-an-issue
 func (s *IssuesService) Lock(ctx context.Context, owner string, repo string, number int, opts *LockIssueOptions) (*Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/issues/%d/lock", owner, repo, number)
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
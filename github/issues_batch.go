@@ -0,0 +1,213 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultIssuesBatchConcurrency is the number of issues updated in parallel
+// when IssuesBatch.Concurrency is unset.
+const defaultIssuesBatchConcurrency = 4
+
+// IssuesBatch describes operations applied to a set of issues by the
+// IssuesService.ApplyBatch method. For each issue, the operations are
+// applied in the order of the fields below.
+type IssuesBatch struct {
+	// Numbers lists the issues to update.
+	Numbers []int
+
+	AddLabels       []string
+	RemoveLabels    []string
+	AddAssignees    []string
+	RemoveAssignees []string
+	// Milestone, if set, is the number of the milestone to set.
+	Milestone *int
+	// Close closes the issues, with StateReason if set. StateReason can be
+	// "completed" or "not_planned".
+	Close       bool
+	StateReason string
+
+	// Concurrency is the maximum number of issues updated in parallel.
+	// Default is 4.
+	Concurrency int
+
+	// MinInterval is the minimum delay between two requests, across all
+	// issues. Default is one second.
+	MinInterval time.Duration
+
+	// MinRateRemaining pauses the batch until the rate limit resets whenever
+	// fewer than this many requests remain. Default is 100.
+	MinRateRemaining int
+
+	// DryRun reports the operations that would be made without making them.
+	DryRun bool
+}
+
+// IssueBatchResult reports the operations applied to a single issue by
+// IssuesService.ApplyBatch.
+type IssueBatchResult struct {
+	Number int
+	// Operations describes the operations applied, or that would be
+	// applied in a dry run, in order.
+	Operations []string
+	// Err is the error that stopped the operations on this issue, if any.
+	// The operations before it were applied.
+	Err error
+}
+
+// issueBatchOperation is a single operation of an IssuesBatch.
+type issueBatchOperation struct {
+	description string
+	do          func(ctx context.Context, number int) (*Response, error)
+}
+
+// operations returns the operations of the batch, applied by s on owner/repo.
+func (b *IssuesBatch) operations(s *IssuesService, owner, repo string) []*issueBatchOperation {
+	var ops []*issueBatchOperation
+
+	if len(b.AddLabels) > 0 {
+		ops = append(ops, &issueBatchOperation{
+			description: "add labels " + strings.Join(b.AddLabels, ", "),
+			do: func(ctx context.Context, number int) (*Response, error) {
+				_, resp, err := s.AddLabelsToIssue(ctx, owner, repo, number, b.AddLabels)
+				return resp, err
+			},
+		})
+	}
+	for _, label := range b.RemoveLabels {
+		label := label
+		ops = append(ops, &issueBatchOperation{
+			description: "remove label " + label,
+			do: func(ctx context.Context, number int) (*Response, error) {
+				resp, err := s.RemoveLabelForIssue(ctx, owner, repo, number, label)
+				// The label is already absent from the issue.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return resp, nil
+				}
+				return resp, err
+			},
+		})
+	}
+	if len(b.AddAssignees) > 0 {
+		ops = append(ops, &issueBatchOperation{
+			description: "add assignees " + strings.Join(b.AddAssignees, ", "),
+			do: func(ctx context.Context, number int) (*Response, error) {
+				_, resp, err := s.AddAssignees(ctx, owner, repo, number, b.AddAssignees)
+				return resp, err
+			},
+		})
+	}
+	if len(b.RemoveAssignees) > 0 {
+		ops = append(ops, &issueBatchOperation{
+			description: "remove assignees " + strings.Join(b.RemoveAssignees, ", "),
+			do: func(ctx context.Context, number int) (*Response, error) {
+				_, resp, err := s.RemoveAssignees(ctx, owner, repo, number, b.RemoveAssignees)
+				return resp, err
+			},
+		})
+	}
+	if b.Milestone != nil || b.Close {
+		req := &IssueRequest{Milestone: b.Milestone}
+		var descriptions []string
+		if b.Milestone != nil {
+			descriptions = append(descriptions, fmt.Sprintf("set milestone %v", *b.Milestone))
+		}
+		if b.Close {
+			req.State = String("closed")
+			if b.StateReason != "" {
+				req.StateReason = String(b.StateReason)
+			}
+			descriptions = append(descriptions, "close")
+		}
+		ops = append(ops, &issueBatchOperation{
+			description: strings.Join(descriptions, ", "),
+			do: func(ctx context.Context, number int) (*Response, error) {
+				_, resp, err := s.Edit(ctx, owner, repo, number, req)
+				return resp, err
+			},
+		})
+	}
+
+	return ops
+}
+
+// ApplyBatch applies the operations of batch to each of its issues, with
+// bounded concurrency.
+//
+// Requests are spaced by batch.MinInterval across all issues, pause when the
+// primary rate limit runs low, and are retried when rejected by the
+// secondary rate limit. A failure on one issue stops the remaining
+// operations on that issue only, and is reported in its IssueBatchResult.
+// The results are returned in the order of batch.Numbers. The returned error
+// is only set if batch has no operations or ctx is done.
+func (s *IssuesService) ApplyBatch(ctx context.Context, owner, repo string, batch *IssuesBatch) ([]*IssueBatchResult, error) {
+	if batch == nil {
+		return nil, errors.New("batch must be provided")
+	}
+	ops := batch.operations(s, owner, repo)
+	if len(ops) == 0 {
+		return nil, errors.New("batch has no operations")
+	}
+
+	concurrency := batch.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultIssuesBatchConcurrency
+	}
+	interval := batch.MinInterval
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+	minRemaining := batch.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	results := make([]*IssueBatchResult, len(batch.Numbers))
+	for i, number := range batch.Numbers {
+		results[i] = &IssueBatchResult{Number: number}
+	}
+	if batch.DryRun {
+		for _, result := range results {
+			for _, op := range ops {
+				result.Operations = append(result.Operations, op.description)
+			}
+		}
+		return results, nil
+	}
+
+	pacer := &requestPacer{interval: interval}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, result := range results {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *IssueBatchResult) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			for _, op := range ops {
+				if err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+					return op.do(ctx, result.Number)
+				}); err != nil {
+					result.Err = err
+					return
+				}
+				result.Operations = append(result.Operations, op.description)
+			}
+		}(result)
+	}
+	wg.Wait()
+
+	return results, ctx.Err()
+}
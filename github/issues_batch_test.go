@@ -0,0 +1,149 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_ApplyBatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var requests []string
+	record := func(r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+	}
+
+	for _, number := range []int{1, 2} {
+		mux.HandleFunc(fmt.Sprintf("/repos/o/r/issues/%v/labels", number), func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			testMethod(t, r, "POST")
+			testBody(t, r, `["triage"]`+"\n")
+			fmt.Fprint(w, `[{"name":"triage"}]`)
+		})
+		mux.HandleFunc(fmt.Sprintf("/repos/o/r/issues/%v/labels/new", number), func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			testMethod(t, r, "DELETE")
+			w.WriteHeader(http.StatusNotFound)
+		})
+	}
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"closed","state_reason":"not_planned","milestone":3}`+"\n")
+		fmt.Fprint(w, `{"number":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/2", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed"}`)
+	})
+
+	ctx := context.Background()
+	batch := &IssuesBatch{
+		Numbers:      []int{1, 2},
+		AddLabels:    []string{"triage"},
+		RemoveLabels: []string{"new"},
+		Milestone:    Int(3),
+		Close:        true,
+		StateReason:  "not_planned",
+		MinInterval:  time.Millisecond,
+	}
+	results, err := client.Issues.ApplyBatch(ctx, "o", "r", batch)
+	if err != nil {
+		t.Fatalf("Issues.ApplyBatch returned error: %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Issues.ApplyBatch returned %v results, want 2", len(results))
+	}
+	if results[0].Number != 1 || results[0].Err != nil {
+		t.Errorf("Issues.ApplyBatch returned %+v for issue 1, want success", results[0])
+	}
+	if want := []string{"add labels triage", "remove label new", "set milestone 3, close"}; !cmp.Equal(results[0].Operations, want) {
+		t.Errorf("Issues.ApplyBatch returned operations %v, want %v", results[0].Operations, want)
+	}
+	if results[1].Number != 2 || results[1].Err == nil {
+		t.Errorf("Issues.ApplyBatch returned %+v for issue 2, want error", results[1])
+	}
+	if want := []string{"add labels triage", "remove label new"}; !cmp.Equal(results[1].Operations, want) {
+		t.Errorf("Issues.ApplyBatch returned operations %v, want %v", results[1].Operations, want)
+	}
+	if len(requests) != 6 {
+		t.Errorf("Issues.ApplyBatch made requests %v, want 6", requests)
+	}
+}
+
+func TestIssuesService_ApplyBatch_dryRun(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	batch := &IssuesBatch{Numbers: []int{1}, AddAssignees: []string{"u"}, RemoveAssignees: []string{"v"}, DryRun: true}
+	results, err := client.Issues.ApplyBatch(ctx, "o", "r", batch)
+	if err != nil {
+		t.Fatalf("Issues.ApplyBatch returned error: %v", err)
+	}
+
+	want := []*IssueBatchResult{{Number: 1, Operations: []string{"add assignees u", "remove assignees v"}}}
+	if !cmp.Equal(results, want) {
+		t.Errorf("Issues.ApplyBatch returned %+v, want %+v", results, want)
+	}
+}
+
+func TestIssuesService_ApplyBatch_noOperations(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.Issues.ApplyBatch(ctx, "o", "r", &IssuesBatch{Numbers: []int{1}}); err == nil {
+		t.Error("Issues.ApplyBatch returned nil error, want error")
+	}
+	if _, err := client.Issues.ApplyBatch(ctx, "o", "r", nil); err == nil {
+		t.Error("Issues.ApplyBatch returned nil error, want error")
+	}
+}
+
+func TestIssuesService_ApplyBatch_secondaryRateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit.","documentation_url":"https://docs.github.com/en/rest/overview/resources-in-the-rest-api#secondary-rate-limits"}`)
+			return
+		}
+		fmt.Fprint(w, `{"number":1}`)
+	})
+
+	ctx := context.Background()
+	results, err := client.Issues.ApplyBatch(ctx, "o", "r", &IssuesBatch{Numbers: []int{1}, Close: true, MinInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Issues.ApplyBatch returned error: %v", err)
+	}
+	if results[0].Err != nil {
+		t.Errorf("Issues.ApplyBatch returned error %v for issue 1, want nil", results[0].Err)
+	}
+	if calls != 2 {
+		t.Errorf("Issues.ApplyBatch made %v requests, want 2", calls)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// IssueDependenciesSummary represents the dependency relationships of an issue.
+type IssueDependenciesSummary struct {
+	// BlockedBy is the number of open issues blocking the issue.
+	BlockedBy *int `json:"blocked_by,omitempty"`
+	// TotalBlockedBy is the number of issues, open or closed, blocking the issue.
+	TotalBlockedBy *int `json:"total_blocked_by,omitempty"`
+	// Blocking is the number of open issues blocked by the issue.
+	Blocking *int `json:"blocking,omitempty"`
+	// TotalBlocking is the number of issues, open or closed, blocked by the issue.
+	TotalBlocking *int `json:"total_blocking,omitempty"`
+}
+
+// IssueDependencyRequest represents a request to add a blocking issue.
+type IssueDependencyRequest struct {
+	// IssueID is the ID (not the number) of the blocking issue. (Required.)
+	IssueID int64 `json:"issue_id"`
+}
+
+// ListBlockedBy lists the issues that block an issue.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/issue-dependencies#list-dependencies-an-issue-is-blocked-by
+func (s *IssuesService) ListBlockedBy(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/dependencies/blocked_by", owner, repo, number)
+	return s.listDependencies(ctx, u, opts)
+}
+
+// ListBlocking lists the issues that an issue blocks.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/issue-dependencies#list-dependencies-an-issue-is-blocking
+func (s *IssuesService) ListBlocking(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/dependencies/blocking", owner, repo, number)
+	return s.listDependencies(ctx, u, opts)
+}
+
+func (s *IssuesService) listDependencies(ctx context.Context, u string, opts *ListOptions) ([]*Issue, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issues []*Issue
+	resp, err := s.client.Do(ctx, req, &issues)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issues, resp, nil
+}
+
+// AddBlockedBy marks an issue as blocked by another issue. It returns the
+// blocking issue.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/issue-dependencies#add-a-dependency-an-issue-is-blocked-by
+func (s *IssuesService) AddBlockedBy(ctx context.Context, owner, repo string, number int, blocking *IssueDependencyRequest) (*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/dependencies/blocked_by", owner, repo, number)
+	req, err := s.client.NewRequest("POST", u, blocking)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Issue)
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
+
+// RemoveBlockedBy removes the issue with ID blockingIssueID from the issues
+// blocking an issue. It returns the formerly blocking issue.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/issue-dependencies#remove-dependency-an-issue-is-blocked-by
+func (s *IssuesService) RemoveBlockedBy(ctx context.Context, owner, repo string, number int, blockingIssueID int64) (*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/dependencies/blocked_by/%v", owner, repo, number, blockingIssueID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Issue)
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_ListBlockedBy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/dependencies/blocked_by", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":10,"number":2,"state":"open"}]`)
+	})
+
+	opts := &ListOptions{Page: 2}
+	ctx := context.Background()
+	issues, _, err := client.Issues.ListBlockedBy(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("Issues.ListBlockedBy returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: Int64(10), Number: Int(2), State: String("open")}}
+	if !cmp.Equal(issues, want) {
+		t.Errorf("Issues.ListBlockedBy returned %+v, want %+v", issues, want)
+	}
+
+	const methodName = "ListBlockedBy"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.ListBlockedBy(ctx, "\n", "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.ListBlockedBy(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_ListBlocking(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/dependencies/blocking", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":11,"number":3}]`)
+	})
+
+	ctx := context.Background()
+	issues, _, err := client.Issues.ListBlocking(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Errorf("Issues.ListBlocking returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: Int64(11), Number: Int(3)}}
+	if !cmp.Equal(issues, want) {
+		t.Errorf("Issues.ListBlocking returned %+v, want %+v", issues, want)
+	}
+
+	const methodName = "ListBlocking"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.ListBlocking(ctx, "\n", "\n", -1, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.ListBlocking(ctx, "o", "r", 1, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_AddBlockedBy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &IssueDependencyRequest{IssueID: 10}
+
+	mux.HandleFunc("/repos/o/r/issues/1/dependencies/blocked_by", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"issue_id":10}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10,"number":2}`)
+	})
+
+	ctx := context.Background()
+	issue, _, err := client.Issues.AddBlockedBy(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Issues.AddBlockedBy returned error: %v", err)
+	}
+
+	want := &Issue{ID: Int64(10), Number: Int(2)}
+	if !cmp.Equal(issue, want) {
+		t.Errorf("Issues.AddBlockedBy returned %+v, want %+v", issue, want)
+	}
+
+	const methodName = "AddBlockedBy"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.AddBlockedBy(ctx, "\n", "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.AddBlockedBy(ctx, "o", "r", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_RemoveBlockedBy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/dependencies/blocked_by/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"id":10,"number":2}`)
+	})
+
+	ctx := context.Background()
+	issue, _, err := client.Issues.RemoveBlockedBy(ctx, "o", "r", 1, 10)
+	if err != nil {
+		t.Errorf("Issues.RemoveBlockedBy returned error: %v", err)
+	}
+
+	want := &Issue{ID: Int64(10), Number: Int(2)}
+	if !cmp.Equal(issue, want) {
+		t.Errorf("Issues.RemoveBlockedBy returned %+v, want %+v", issue, want)
+	}
+
+	const methodName = "RemoveBlockedBy"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.RemoveBlockedBy(ctx, "\n", "\n", -1, -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.RemoveBlockedBy(ctx, "o", "r", 1, 10)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssueDependenciesSummary_Marshal(t *testing.T) {
+	testJSONMarshal(t, &IssueDependenciesSummary{}, "{}")
+
+	u := &IssueDependenciesSummary{
+		BlockedBy:      Int(1),
+		TotalBlockedBy: Int(2),
+		Blocking:       Int(3),
+		TotalBlocking:  Int(4),
+	}
+
+	want := `{
+		"blocked_by": 1,
+		"total_blocked_by": 2,
+		"blocking": 3,
+		"total_blocking": 4
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -8,14 +8,29 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"unicode/utf8"
 )
 
+// maxLabelDescriptionLength is the maximum length, in characters, of the
+// description of a label.
+const maxLabelDescriptionLength = 100
+
+// labelColor matches the hexadecimal color of a label, without the leading "#".
+var labelColor = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
 // Label represents a GitHub label on an Issue
 type Label struct {
-	ID          *int64  `json:"id,omitempty"`
-	URL         *string `json:"url,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	Color       *string `json:"color,omitempty"`
+	ID   *int64  `json:"id,omitempty"`
+	URL  *string `json:"url,omitempty"`
+	Name *string `json:"name,omitempty"`
+	// NewName renames the label when passed to IssuesService.EditLabel.
+	NewName *string `json:"new_name,omitempty"`
+	// Color is the hexadecimal color code of the label, without the leading "#".
+	Color *string `json:"color,omitempty"`
+	// Description is limited to 100 characters. Emoji count as a single
+	// character, not as their UTF-8 length.
 	Description *string `json:"description,omitempty"`
 	Default     *bool   `json:"default,omitempty"`
 	NodeID      *string `json:"node_id,omitempty"`
@@ -25,6 +40,21 @@ func (l Label) String() string {
 	return Stringify(l)
 }
 
+// validate reports an error if the color or the description of l would be
+// rejected by GitHub.
+func (l *Label) validate() error {
+	if l == nil {
+		return nil
+	}
+	if l.Color != nil && !labelColor.MatchString(*l.Color) {
+		return fmt.Errorf("invalid label color %q: must be 6 hexadecimal digits without a leading #", *l.Color)
+	}
+	if l.Description != nil && utf8.RuneCountInString(*l.Description) > maxLabelDescriptionLength {
+		return fmt.Errorf("label description is longer than %v characters", maxLabelDescriptionLength)
+	}
+	return nil
+}
+
 // ListLabels lists all labels for a repository.
 //
 // GitHub API docs: https://docs.github.com/en/rest/issues/labels#list-labels-for-a-repository
@@ -53,7 +83,7 @@ func (s *IssuesService) ListLabels(ctx context.Context, owner string, repo strin
 //
 // GitHub API docs: https://docs.github.com/en/rest/issues/labels#get-a-label
 func (s *IssuesService) GetLabel(ctx context.Context, owner string, repo string, name string) (*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, name)
+	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, url.PathEscape(name))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -72,6 +102,10 @@ func (s *IssuesService) GetLabel(ctx context.Context, owner string, repo string,
 //
 // GitHub API docs: https://docs.github.com/en/rest/issues/labels#create-a-label
 func (s *IssuesService) CreateLabel(ctx context.Context, owner string, repo string, label *Label) (*Label, *Response, error) {
+	if err := label.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/labels", owner, repo)
 	req, err := s.client.NewRequest("POST", u, label)
 	if err != nil {
@@ -87,11 +121,16 @@ func (s *IssuesService) CreateLabel(ctx context.Context, owner string, repo stri
 	return l, resp, nil
 }
 
-// EditLabel edits a label.
+// EditLabel edits a label. To rename the label, set label.NewName; name
+// is the current name of the label.
 //
 // GitHub API docs: https://docs.github.com/en/rest/issues/labels#update-a-label
 func (s *IssuesService) EditLabel(ctx context.Context, owner string, repo string, name string, label *Label) (*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, name)
+	if err := label.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, url.PathEscape(name))
 	req, err := s.client.NewRequest("PATCH", u, label)
 	if err != nil {
 		return nil, nil, err
@@ -110,7 +149,7 @@ func (s *IssuesService) EditLabel(ctx context.Context, owner string, repo string
 //
 // GitHub API docs: https://docs.github.com/en/rest/issues/labels#delete-a-label
 func (s *IssuesService) DeleteLabel(ctx context.Context, owner string, repo string, name string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, name)
+	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, url.PathEscape(name))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -165,7 +204,7 @@ func (s *IssuesService) AddLabelsToIssue(ctx context.Context, owner string, repo
 //
 // GitHub API docs: https://docs.github.com/en/rest/issues/labels#remove-a-label-from-an-issue
 func (s *IssuesService) RemoveLabelForIssue(ctx context.Context, owner string, repo string, number int, label string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels/%v", owner, repo, number, label)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels/%v", owner, repo, number, url.PathEscape(label))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,168 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Actions of a LabelChange.
+const (
+	LabelChangeCreate = "create"
+	LabelChangeUpdate = "update"
+	LabelChangeDelete = "delete"
+)
+
+// SyncLabelsOptions specifies the optional parameters to the
+// IssuesService.SyncLabels method.
+type SyncLabelsOptions struct {
+	// Delete deletes the labels of the repository that are not in the
+	// desired set. By default they are kept.
+	Delete bool
+
+	// MinInterval is the minimum delay between two label changes. Default
+	// is one second.
+	MinInterval time.Duration
+
+	// MinRateRemaining pauses until the rate limit resets whenever fewer
+	// than this many requests remain. Default is 100.
+	MinRateRemaining int
+
+	// DryRun reports the changes that would be made without making them.
+	DryRun bool
+}
+
+// LabelChange describes a change made, or that would be made in a dry run,
+// to the labels of a repository by IssuesService.SyncLabels.
+type LabelChange struct {
+	// Action is one of LabelChangeCreate, LabelChangeUpdate, or LabelChangeDelete.
+	Action string
+	// Name is the current name of the label.
+	Name string
+	// Label is the desired label. It is nil for deletions.
+	Label *Label
+}
+
+// SyncLabels makes the labels of a repository match desired. Labels are
+// matched by name, ignoring case as GitHub does: missing labels are
+// created, and labels whose name case, color, or description differ are
+// updated. Labels of the repository that are not in desired are deleted
+// only if opts.Delete is set.
+//
+// All desired labels are validated before any change is made. The changes
+// are returned in the order they are applied; on error, the changes before
+// the failing one were applied. Changes are spaced by opts.MinInterval, and
+// retried when rejected by the secondary rate limit.
+func (s *IssuesService) SyncLabels(ctx context.Context, owner, repo string, desired []*Label, opts *SyncLabelsOptions) ([]*LabelChange, *Response, error) {
+	if opts == nil {
+		opts = &SyncLabelsOptions{}
+	}
+
+	wanted := make(map[string]bool)
+	for _, label := range desired {
+		if label.GetName() == "" {
+			return nil, nil, fmt.Errorf("desired label has no name")
+		}
+		key := strings.ToLower(label.GetName())
+		if wanted[key] {
+			return nil, nil, fmt.Errorf("duplicate desired label %q", label.GetName())
+		}
+		wanted[key] = true
+		if err := label.validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	existing := make(map[string]*Label)
+	var current []*Label
+	var resp *Response
+	listOpts := &ListOptions{PerPage: 100}
+	for {
+		page, r, err := s.ListLabels(ctx, owner, repo, listOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, label := range page {
+			existing[strings.ToLower(label.GetName())] = label
+		}
+		current = append(current, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+
+	var changes []*LabelChange
+	for _, label := range desired {
+		old, ok := existing[strings.ToLower(label.GetName())]
+		switch {
+		case !ok:
+			changes = append(changes, &LabelChange{Action: LabelChangeCreate, Name: label.GetName(), Label: label})
+		case labelDiffers(old, label):
+			changes = append(changes, &LabelChange{Action: LabelChangeUpdate, Name: old.GetName(), Label: label})
+		}
+	}
+	if opts.Delete {
+		for _, label := range current {
+			if !wanted[strings.ToLower(label.GetName())] {
+				changes = append(changes, &LabelChange{Action: LabelChangeDelete, Name: label.GetName()})
+			}
+		}
+	}
+	if opts.DryRun {
+		return changes, resp, nil
+	}
+
+	interval := opts.MinInterval
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+	minRemaining := opts.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	pacer := &requestPacer{interval: interval}
+	for i, change := range changes {
+		err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+			var err error
+			switch change.Action {
+			case LabelChangeCreate:
+				_, resp, err = s.CreateLabel(ctx, owner, repo, change.Label)
+			case LabelChangeUpdate:
+				edit := &Label{Color: change.Label.Color, Description: change.Label.Description}
+				if change.Name != change.Label.GetName() {
+					edit.NewName = change.Label.Name
+				}
+				_, resp, err = s.EditLabel(ctx, owner, repo, change.Name, edit)
+			case LabelChangeDelete:
+				resp, err = s.DeleteLabel(ctx, owner, repo, change.Name)
+			}
+			return resp, err
+		})
+		if err != nil {
+			return changes[:i], resp, err
+		}
+	}
+
+	return changes, resp, nil
+}
+
+// labelDiffers reports whether the existing label old must be updated to
+// match want. Unset fields of want are not compared.
+func labelDiffers(old, want *Label) bool {
+	if old.GetName() != want.GetName() {
+		return true
+	}
+	if want.Color != nil && !strings.EqualFold(old.GetColor(), want.GetColor()) {
+		return true
+	}
+	return want.Description != nil && old.GetDescription() != want.GetDescription()
+}
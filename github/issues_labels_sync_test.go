@@ -0,0 +1,106 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_SyncLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[
+				{"name":"Bug","color":"d73a4a","description":"Something is broken"},
+				{"name":"docs","color":"0075ca"},
+				{"name":"wontfix","color":"ffffff"}
+			]`)
+		case "POST":
+			testBody(t, r, `{"name":"triage","color":"ededed"}`+"\n")
+			fmt.Fprint(w, `{"name":"triage"}`)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/labels/Bug", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"new_name":"bug","color":"d73a4a"}`+"\n")
+		fmt.Fprint(w, `{"name":"bug"}`)
+	})
+	mux.HandleFunc("/repos/o/r/labels/wontfix", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	desired := []*Label{
+		{Name: String("bug"), Color: String("d73a4a")},
+		{Name: String("docs"), Color: String("0075CA")},
+		{Name: String("triage"), Color: String("ededed")},
+	}
+
+	ctx := context.Background()
+	changes, _, err := client.Issues.SyncLabels(ctx, "o", "r", desired, &SyncLabelsOptions{Delete: true, MinInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Issues.SyncLabels returned error: %v", err)
+	}
+
+	want := []*LabelChange{
+		{Action: LabelChangeUpdate, Name: "Bug", Label: desired[0]},
+		{Action: LabelChangeCreate, Name: "triage", Label: desired[2]},
+		{Action: LabelChangeDelete, Name: "wontfix"},
+	}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("Issues.SyncLabels returned %+v, want %+v", changes, want)
+	}
+
+	const methodName = "SyncLabels"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.SyncLabels(ctx, "\n", "\n", desired, nil)
+		return err
+	})
+}
+
+func TestIssuesService_SyncLabels_dryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"old"}]`)
+	})
+
+	ctx := context.Background()
+	changes, _, err := client.Issues.SyncLabels(ctx, "o", "r", []*Label{{Name: String("new")}}, &SyncLabelsOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Issues.SyncLabels returned error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Action != LabelChangeCreate {
+		t.Errorf("Issues.SyncLabels returned %+v, want a single create", changes)
+	}
+}
+
+func TestIssuesService_SyncLabels_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, desired := range [][]*Label{
+		{{Color: String("ffffff")}},
+		{{Name: String("a")}, {Name: String("A")}},
+		{{Name: String("a"), Color: String("#ffffff")}},
+	} {
+		if _, _, err := client.Issues.SyncLabels(ctx, "o", "r", desired, nil); err == nil {
+			t.Errorf("Issues.SyncLabels(%+v) returned nil error, want error", desired)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -158,6 +159,48 @@ func TestIssuesService_CreateLabel_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestIssuesService_CreateLabel_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, label := range []*Label{
+		{Name: String("n"), Color: String("#fff")},
+		{Name: String("n"), Color: String("zzzzzz")},
+		{Name: String("n"), Description: String(strings.Repeat("a", 101))},
+	} {
+		if _, _, err := client.Issues.CreateLabel(ctx, "o", "r", label); err == nil {
+			t.Errorf("Issues.CreateLabel(%v) returned nil error, want error", label)
+		}
+	}
+
+	// Emoji count as one character each.
+	label := &Label{Name: String("n"), Description: String(strings.Repeat("\U0001F41B", 100))}
+	if err := label.validate(); err != nil {
+		t.Errorf("Label.validate returned error %v, want nil", err)
+	}
+}
+
+func TestIssuesService_EditLabel_rename(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels/good first issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"new_name":"good-first-issue"}`+"\n")
+		fmt.Fprint(w, `{"name":"good-first-issue"}`)
+	})
+
+	ctx := context.Background()
+	label, _, err := client.Issues.EditLabel(ctx, "o", "r", "good first issue", &Label{NewName: String("good-first-issue")})
+	if err != nil {
+		t.Errorf("Issues.EditLabel returned error: %v", err)
+	}
+	if want := (&Label{Name: String("good-first-issue")}); !cmp.Equal(label, want) {
+		t.Errorf("Issues.EditLabel returned %+v, want %+v", label, want)
+	}
+}
+
 func TestIssuesService_EditLabel(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,97 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// LockClosedIssuesOptions specifies the parameters to the
+// IssuesService.LockClosedIssues method.
+type LockClosedIssuesOptions struct {
+	// ClosedFor is the minimum time since an issue was closed for it to be
+	// locked. It is required.
+	ClosedFor time.Duration
+
+	// LockReason is the reason given for locking, one of the LockReason
+	// constants. Default is LockReasonResolved.
+	LockReason string
+
+	// IncludePullRequests also locks closed pull requests.
+	IncludePullRequests bool
+
+	// MinInterval is the minimum delay between two lock requests. Default
+	// is one second.
+	MinInterval time.Duration
+
+	// MinRateRemaining pauses until the rate limit resets whenever fewer
+	// than this many requests remain. Default is 100.
+	MinRateRemaining int
+
+	// DryRun reports the issues that would be locked without locking them.
+	DryRun bool
+}
+
+// LockClosedIssues locks the conversation of the issues of a repository
+// that have been closed for at least opts.ClosedFor and are not locked yet,
+// a common moderation policy. Lock requests are spaced by opts.MinInterval,
+// and retried when rejected by the secondary rate limit.
+//
+// It returns the issues locked, or that would be locked in a dry run. On
+// error, the issues locked before it are returned.
+func (s *IssuesService) LockClosedIssues(ctx context.Context, owner, repo string, opts *LockClosedIssuesOptions) ([]*Issue, *Response, error) {
+	if opts == nil || opts.ClosedFor <= 0 {
+		return nil, nil, errors.New("ClosedFor must be positive")
+	}
+	lockOpts := &LockIssueOptions{LockReason: opts.LockReason}
+	if lockOpts.LockReason == "" {
+		lockOpts.LockReason = LockReasonResolved
+	}
+	if err := lockOpts.validate(); err != nil {
+		return nil, nil, err
+	}
+	interval := opts.MinInterval
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+	minRemaining := opts.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	closedBefore := time.Now().Add(-opts.ClosedFor)
+	listOpts := &IssueListByRepoOptions{State: "closed", ListOptions: ListOptions{PerPage: 100}}
+	pacer := &requestPacer{interval: interval}
+	var locked []*Issue
+	for {
+		issues, resp, err := s.ListByRepo(ctx, owner, repo, listOpts)
+		if err != nil {
+			return locked, resp, err
+		}
+		for _, issue := range issues {
+			if issue.GetLocked() || !issue.GetClosedAt().Before(closedBefore) {
+				continue
+			}
+			if issue.IsPullRequest() && !opts.IncludePullRequests {
+				continue
+			}
+			if !opts.DryRun {
+				if err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+					return s.Lock(ctx, owner, repo, issue.GetNumber(), lockOpts)
+				}); err != nil {
+					return locked, resp, err
+				}
+			}
+			locked = append(locked, issue)
+		}
+		if resp.NextPage == 0 {
+			return locked, resp, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIssuesService_LockClosedIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	old := time.Now().Add(-60 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	recent := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "closed", "per_page": "100"})
+		fmt.Fprintf(w, `[
+			{"number":1,"closed_at":%q},
+			{"number":2,"closed_at":%q},
+			{"number":3,"closed_at":%q,"locked":true},
+			{"number":4,"closed_at":%q,"pull_request":{"url":"u"}}
+		]`, old, recent, old, old)
+	})
+	var lockedNumbers []int
+	mux.HandleFunc("/repos/o/r/issues/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"lock_reason":"resolved"}`+"\n")
+		lockedNumbers = append(lockedNumbers, 1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	opts := &LockClosedIssuesOptions{ClosedFor: 30 * 24 * time.Hour, MinInterval: time.Millisecond}
+	issues, _, err := client.Issues.LockClosedIssues(ctx, "o", "r", opts)
+	if err != nil {
+		t.Fatalf("Issues.LockClosedIssues returned error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].GetNumber() != 1 {
+		t.Errorf("Issues.LockClosedIssues returned %+v, want issue 1", issues)
+	}
+	if len(lockedNumbers) != 1 {
+		t.Errorf("Issues.LockClosedIssues locked %v, want [1]", lockedNumbers)
+	}
+
+	opts.DryRun = true
+	opts.IncludePullRequests = true
+	issues, _, err = client.Issues.LockClosedIssues(ctx, "o", "r", opts)
+	if err != nil {
+		t.Fatalf("Issues.LockClosedIssues returned error: %v", err)
+	}
+	if len(issues) != 2 || len(lockedNumbers) != 1 {
+		t.Errorf("Issues.LockClosedIssues dry run returned %v issues and locked %v, want 2 issues and no new lock", len(issues), lockedNumbers)
+	}
+
+	const methodName = "LockClosedIssues"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.LockClosedIssues(ctx, "\n", "\n", opts)
+		return err
+	})
+}
+
+func TestIssuesService_LockClosedIssues_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, opts := range []*LockClosedIssuesOptions{
+		nil,
+		{},
+		{ClosedFor: time.Hour, LockReason: "boring"},
+	} {
+		if _, _, err := client.Issues.LockClosedIssues(ctx, "o", "r", opts); err == nil {
+			t.Errorf("Issues.LockClosedIssues(%+v) returned nil error, want error", opts)
+		}
+	}
+}
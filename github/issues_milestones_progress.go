@@ -0,0 +1,140 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// MilestoneProgressOptions specifies the optional parameters to the
+// IssuesService.GetMilestoneProgress method.
+type MilestoneProgressOptions struct {
+	// History computes MilestoneProgress.History. It requires listing the
+	// closed issues of the milestone and the events of each of them.
+	History bool
+}
+
+// MilestoneDay is the number of issues of a milestone closed on a day.
+type MilestoneDay struct {
+	// Date is the day, at midnight UTC.
+	Date time.Time
+	// Closed is the number of issues last closed on that day.
+	Closed int
+	// Remaining is the number of issues still open at the end of that day,
+	// assuming all the current issues of the milestone were in it from the start.
+	Remaining int
+}
+
+// MilestoneProgress summarizes the progress of a milestone.
+type MilestoneProgress struct {
+	Milestone       *Milestone
+	Open            int
+	Closed          int
+	PercentComplete float64
+	// History lists, in chronological order, the days on which issues of
+	// the milestone were closed. It is only set if requested with
+	// MilestoneProgressOptions.History.
+	History []*MilestoneDay
+}
+
+// GetMilestoneProgress returns the open and closed issue counts and the
+// completion of a milestone. If opts.History is set, it also returns a
+// burndown history bucketed by day, derived from the "closed" events of the
+// closed issues of the milestone; an issue closed several times counts on
+// the day it was last closed.
+func (s *IssuesService) GetMilestoneProgress(ctx context.Context, owner, repo string, number int, opts *MilestoneProgressOptions) (*MilestoneProgress, *Response, error) {
+	milestone, resp, err := s.GetMilestone(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	progress := &MilestoneProgress{
+		Milestone: milestone,
+		Open:      milestone.GetOpenIssues(),
+		Closed:    milestone.GetClosedIssues(),
+	}
+	if total := progress.Open + progress.Closed; total > 0 {
+		progress.PercentComplete = float64(progress.Closed) * 100 / float64(total)
+	}
+	if opts == nil || !opts.History {
+		return progress, resp, nil
+	}
+
+	closedOn := make(map[time.Time]int)
+	listOpts := &IssueListByRepoOptions{
+		Milestone:   strconv.Itoa(number),
+		State:       "closed",
+		ListOptions: ListOptions{PerPage: 100},
+	}
+	for {
+		issues, r, err := s.ListByRepo(ctx, owner, repo, listOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, issue := range issues {
+			closedAt, r, err := s.lastClosedAt(ctx, owner, repo, issue)
+			resp = r
+			if err != nil {
+				return nil, resp, err
+			}
+			if !closedAt.IsZero() {
+				y, m, d := closedAt.UTC().Date()
+				closedOn[time.Date(y, m, d, 0, 0, 0, 0, time.UTC)]++
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+
+	for date, closed := range closedOn {
+		progress.History = append(progress.History, &MilestoneDay{Date: date, Closed: closed})
+	}
+	sort.Slice(progress.History, func(i, j int) bool {
+		return progress.History[i].Date.Before(progress.History[j].Date)
+	})
+	remaining := progress.Open + progress.Closed
+	for _, day := range progress.History {
+		remaining -= day.Closed
+		day.Remaining = remaining
+	}
+
+	return progress, resp, nil
+}
+
+// lastClosedAt returns the time of the last "closed" event of issue, or its
+// ClosedAt if it has no such event.
+func (s *IssuesService) lastClosedAt(ctx context.Context, owner, repo string, issue *Issue) (time.Time, *Response, error) {
+	var closedAt time.Time
+	var resp *Response
+	opts := &ListOptions{PerPage: 100}
+	for {
+		events, r, err := s.ListIssueEvents(ctx, owner, repo, issue.GetNumber(), opts)
+		resp = r
+		if err != nil {
+			return time.Time{}, resp, err
+		}
+		for _, event := range events {
+			if event.GetEvent() == "closed" && event.GetCreatedAt().After(closedAt) {
+				closedAt = event.GetCreatedAt().Time
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if closedAt.IsZero() {
+		closedAt = issue.GetClosedAt().Time
+	}
+	return closedAt, resp, nil
+}
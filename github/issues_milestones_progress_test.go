@@ -0,0 +1,97 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_GetMilestoneProgress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/milestones/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"open_issues":1,"closed_issues":3}`)
+	})
+
+	ctx := context.Background()
+	progress, _, err := client.Issues.GetMilestoneProgress(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Issues.GetMilestoneProgress returned error: %v", err)
+	}
+
+	want := &MilestoneProgress{
+		Milestone:       &Milestone{Number: Int(1), OpenIssues: Int(1), ClosedIssues: Int(3)},
+		Open:            1,
+		Closed:          3,
+		PercentComplete: 75,
+	}
+	if !cmp.Equal(progress, want) {
+		t.Errorf("Issues.GetMilestoneProgress returned %+v, want %+v", progress, want)
+	}
+
+	const methodName = "GetMilestoneProgress"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.GetMilestoneProgress(ctx, "\n", "\n", -1, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.GetMilestoneProgress(ctx, "o", "r", 1, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_GetMilestoneProgress_history(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/milestones/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"number":1,"open_issues":1,"closed_issues":3}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"milestone": "1", "state": "closed", "per_page": "100"})
+		fmt.Fprint(w, `[{"number":10},{"number":11},{"number":12,"closed_at":"2023-01-03T08:00:00Z"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/10/events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"event":"closed","created_at":"2023-01-01T10:00:00Z"},
+			{"event":"reopened","created_at":"2023-01-01T11:00:00Z"},
+			{"event":"closed","created_at":"2023-01-03T23:00:00Z"}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/11/events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"event":"closed","created_at":"2023-01-02T10:00:00Z"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/12/events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	progress, _, err := client.Issues.GetMilestoneProgress(ctx, "o", "r", 1, &MilestoneProgressOptions{History: true})
+	if err != nil {
+		t.Fatalf("Issues.GetMilestoneProgress returned error: %v", err)
+	}
+
+	want := []*MilestoneDay{
+		{Date: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), Closed: 1, Remaining: 3},
+		{Date: time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), Closed: 2, Remaining: 1},
+	}
+	if !cmp.Equal(progress.History, want) {
+		t.Errorf("Issues.GetMilestoneProgress returned history %+v, want %+v", progress.History, want)
+	}
+}
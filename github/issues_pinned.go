@@ -0,0 +1,125 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// Pinned issues are only exposed by the GitHub GraphQL API. A repository
+// can have at most 3 pinned issues.
+
+// issueNode returns the node ID and the pinned state of an issue.
+func (s *IssuesService) issueNode(ctx context.Context, owner, repo string, number int) (string, bool, *Response, error) {
+	query := `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) { id isPinned }
+  }
+}`
+	variables := map[string]interface{}{"owner": owner, "repo": repo, "number": number}
+
+	var data struct {
+		Repository *struct {
+			Issue *struct {
+				ID       string `json:"id"`
+				IsPinned bool   `json:"isPinned"`
+			} `json:"issue"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return "", false, resp, err
+	}
+	if data.Repository == nil || data.Repository.Issue == nil {
+		return "", false, resp, fmt.Errorf("issue %v/%v#%v not found", owner, repo, number)
+	}
+
+	return data.Repository.Issue.ID, data.Repository.Issue.IsPinned, resp, nil
+}
+
+// ListPinned lists the issues pinned to a repository, in their pinned order.
+// The returned issues have Pinned set.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#pinnedissue
+func (s *IssuesService) ListPinned(ctx context.Context, owner, repo string) ([]*Issue, *Response, error) {
+	query := `query($owner: String!, $repo: String!) {
+  repository(owner: $owner, name: $repo) {
+    pinnedIssues(first: 3) { nodes { issue { number } } }
+  }
+}`
+	variables := map[string]interface{}{"owner": owner, "repo": repo}
+
+	var data struct {
+		Repository *struct {
+			PinnedIssues struct {
+				Nodes []struct {
+					Issue struct {
+						Number int `json:"number"`
+					} `json:"issue"`
+				} `json:"nodes"`
+			} `json:"pinnedIssues"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Repository == nil {
+		return nil, resp, fmt.Errorf("repository %v/%v not found", owner, repo)
+	}
+
+	var issues []*Issue
+	for _, node := range data.Repository.PinnedIssues.Nodes {
+		issue, r, err := s.Get(ctx, owner, repo, node.Issue.Number)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		issue.Pinned = Bool(true)
+		issues = append(issues, issue)
+	}
+
+	return issues, resp, nil
+}
+
+// IsPinned reports whether an issue is pinned to its repository.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#issue
+func (s *IssuesService) IsPinned(ctx context.Context, owner, repo string, number int) (bool, *Response, error) {
+	_, pinned, resp, err := s.issueNode(ctx, owner, repo, number)
+	return pinned, resp, err
+}
+
+// Pin pins an issue to its repository. GitHub reports an error if the
+// repository already has 3 pinned issues.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#pinissue
+func (s *IssuesService) Pin(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	return s.setPinned(ctx, owner, repo, number, "pinIssue")
+}
+
+// Unpin unpins an issue from its repository.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#unpinissue
+func (s *IssuesService) Unpin(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	return s.setPinned(ctx, owner, repo, number, "unpinIssue")
+}
+
+// setPinned applies mutation, either "pinIssue" or "unpinIssue", to an issue.
+func (s *IssuesService) setPinned(ctx context.Context, owner, repo string, number int, mutation string) (*Response, error) {
+	id, _, resp, err := s.issueNode(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	query := `mutation($id: ID!) {
+  ` + mutation + `(input: {issueId: $id}) {
+    issue { isPinned }
+  }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"id": id}, nil)
+}
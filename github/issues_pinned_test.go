@@ -0,0 +1,145 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleIssueGraphQL serves the issue lookup query made by the pinned issue
+// methods, and passes other requests to handle.
+func handleIssueGraphQL(t *testing.T, mux *http.ServeMux, pinned bool, handle func(req *graphQLRequest) string) {
+	t.Helper()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		req := new(graphQLRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+
+		if strings.Contains(req.Query, "isPinned }") && strings.HasPrefix(req.Query, "query") {
+			fmt.Fprintf(w, `{"data":{"repository":{"issue":{"id":"I_1","isPinned":%v}}}}`, pinned)
+			return
+		}
+		fmt.Fprint(w, handle(req))
+	})
+}
+
+func TestIssuesService_ListPinned(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleIssueGraphQL(t, mux, false, func(req *graphQLRequest) string {
+		if !strings.Contains(req.Query, "pinnedIssues") {
+			t.Errorf("Request query = %q, want pinnedIssues query", req.Query)
+		}
+		return `{"data":{"repository":{"pinnedIssues":{"nodes":[{"issue":{"number":2}},{"issue":{"number":1}}]}}}}`
+	})
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":2}`)
+	})
+
+	ctx := context.Background()
+	issues, _, err := client.Issues.ListPinned(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Issues.ListPinned returned error: %v", err)
+	}
+
+	want := []*Issue{{Number: Int(2), Pinned: Bool(true)}, {Number: Int(1), Pinned: Bool(true)}}
+	if !cmp.Equal(issues, want) {
+		t.Errorf("Issues.ListPinned returned %+v, want %+v", issues, want)
+	}
+
+	const methodName = "ListPinned"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.ListPinned(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_IsPinned(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleIssueGraphQL(t, mux, true, nil)
+
+	ctx := context.Background()
+	pinned, _, err := client.Issues.IsPinned(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Issues.IsPinned returned error: %v", err)
+	}
+	if !pinned {
+		t.Errorf("Issues.IsPinned returned false, want true")
+	}
+}
+
+func TestIssuesService_Pin(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var called bool
+	handleIssueGraphQL(t, mux, false, func(req *graphQLRequest) string {
+		called = true
+		if !strings.Contains(req.Query, "pinIssue") || strings.Contains(req.Query, "unpinIssue") {
+			t.Errorf("Request query = %q, want pinIssue mutation", req.Query)
+		}
+		if got := req.Variables["id"]; got != "I_1" {
+			t.Errorf("Request id = %v, want I_1", got)
+		}
+		return `{"data":{"pinIssue":{"issue":{"isPinned":true}}}}`
+	})
+
+	ctx := context.Background()
+	if _, err := client.Issues.Pin(ctx, "o", "r", 1); err != nil {
+		t.Errorf("Issues.Pin returned error: %v", err)
+	}
+	if !called {
+		t.Error("Issues.Pin did not send the mutation")
+	}
+
+	const methodName = "Pin"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Issues.Pin(ctx, "o", "r", 1)
+	})
+}
+
+func TestIssuesService_Unpin(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var called bool
+	handleIssueGraphQL(t, mux, true, func(req *graphQLRequest) string {
+		called = true
+		if !strings.Contains(req.Query, "unpinIssue") {
+			t.Errorf("Request query = %q, want unpinIssue mutation", req.Query)
+		}
+		return `{"data":{"unpinIssue":{"issue":{"isPinned":false}}}}`
+	})
+
+	ctx := context.Background()
+	if _, err := client.Issues.Unpin(ctx, "o", "r", 1); err != nil {
+		t.Errorf("Issues.Unpin returned error: %v", err)
+	}
+	if !called {
+		t.Error("Issues.Unpin did not send the mutation")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubIssuesSummary represents the progress of the sub-issues of an issue.
+type SubIssuesSummary struct {
+	Total            *int `json:"total,omitempty"`
+	Completed        *int `json:"completed,omitempty"`
+	PercentCompleted *int `json:"percent_completed,omitempty"`
+}
+
+// SubIssueRequest represents a request to add or remove a sub-issue.
+type SubIssueRequest struct {
+	// SubIssueID is the ID (not the number) of the sub-issue. (Required.)
+	SubIssueID int64 `json:"sub_issue_id"`
+	// ReplaceParent, when true, moves the sub-issue from its current parent
+	// issue. Only used when adding a sub-issue.
+	ReplaceParent *bool `json:"replace_parent,omitempty"`
+}
+
+// SubIssueReprioritizeRequest represents a request to change the position
+// of a sub-issue in the sub-issue list. Exactly one of AfterID and BeforeID
+// must be set.
+type SubIssueReprioritizeRequest struct {
+	// SubIssueID is the ID of the sub-issue to move. (Required.)
+	SubIssueID int64 `json:"sub_issue_id"`
+	// AfterID is the ID of the sub-issue to place the sub-issue after.
+	AfterID *int64 `json:"after_id,omitempty"`
+	// BeforeID is the ID of the sub-issue to place the sub-issue before.
+	BeforeID *int64 `json:"before_id,omitempty"`
+}
+
+// ListSubIssues lists the sub-issues of an issue, in priority order.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/sub-issues#list-sub-issues
+func (s *IssuesService) ListSubIssues(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/sub_issues", owner, repo, number)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subIssues []*Issue
+	resp, err := s.client.Do(ctx, req, &subIssues)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subIssues, resp, nil
+}
+
+// AddSubIssue adds a sub-issue to an issue. It returns the added sub-issue.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/sub-issues#add-sub-issue
+func (s *IssuesService) AddSubIssue(ctx context.Context, owner, repo string, number int, subIssue *SubIssueRequest) (*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/sub_issues", owner, repo, number)
+	req, err := s.client.NewRequest("POST", u, subIssue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Issue)
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
+
+// RemoveSubIssue removes a sub-issue from an issue. It returns the removed
+// sub-issue.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/sub-issues#remove-sub-issue
+func (s *IssuesService) RemoveSubIssue(ctx context.Context, owner, repo string, number int, subIssue *SubIssueRequest) (*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/sub_issue", owner, repo, number)
+	req, err := s.client.NewRequest("DELETE", u, subIssue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Issue)
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
+
+// ReprioritizeSubIssue changes the position of a sub-issue in the sub-issue
+// list of an issue. It returns the parent issue.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/sub-issues#reprioritize-sub-issue
+func (s *IssuesService) ReprioritizeSubIssue(ctx context.Context, owner, repo string, number int, subIssue *SubIssueReprioritizeRequest) (*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/sub_issues/priority", owner, repo, number)
+	req, err := s.client.NewRequest("PATCH", u, subIssue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Issue)
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
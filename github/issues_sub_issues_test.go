@@ -0,0 +1,189 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_ListSubIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/sub_issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":10,"number":2},{"id":11,"number":3}]`)
+	})
+
+	opts := &ListOptions{Page: 2}
+	ctx := context.Background()
+	subIssues, _, err := client.Issues.ListSubIssues(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("Issues.ListSubIssues returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: Int64(10), Number: Int(2)}, {ID: Int64(11), Number: Int(3)}}
+	if !cmp.Equal(subIssues, want) {
+		t.Errorf("Issues.ListSubIssues returned %+v, want %+v", subIssues, want)
+	}
+
+	const methodName = "ListSubIssues"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.ListSubIssues(ctx, "\n", "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.ListSubIssues(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_AddSubIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SubIssueRequest{SubIssueID: 10, ReplaceParent: Bool(true)}
+
+	mux.HandleFunc("/repos/o/r/issues/1/sub_issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"sub_issue_id":10,"replace_parent":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10,"number":2}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Issues.AddSubIssue(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Issues.AddSubIssue returned error: %v", err)
+	}
+
+	want := &Issue{ID: Int64(10), Number: Int(2)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Issues.AddSubIssue returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "AddSubIssue"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.AddSubIssue(ctx, "\n", "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.AddSubIssue(ctx, "o", "r", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_RemoveSubIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SubIssueRequest{SubIssueID: 10}
+
+	mux.HandleFunc("/repos/o/r/issues/1/sub_issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"sub_issue_id":10}`+"\n")
+		fmt.Fprint(w, `{"id":10,"number":2}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Issues.RemoveSubIssue(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Issues.RemoveSubIssue returned error: %v", err)
+	}
+
+	want := &Issue{ID: Int64(10), Number: Int(2)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Issues.RemoveSubIssue returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "RemoveSubIssue"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.RemoveSubIssue(ctx, "\n", "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.RemoveSubIssue(ctx, "o", "r", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_ReprioritizeSubIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SubIssueReprioritizeRequest{SubIssueID: 10, AfterID: Int64(11)}
+
+	mux.HandleFunc("/repos/o/r/issues/1/sub_issues/priority", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sub_issue_id":10,"after_id":11}`+"\n")
+		fmt.Fprint(w, `{"id":1,"number":1,"sub_issues_summary":{"total":2,"completed":1,"percent_completed":50}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Issues.ReprioritizeSubIssue(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Issues.ReprioritizeSubIssue returned error: %v", err)
+	}
+
+	want := &Issue{
+		ID:               Int64(1),
+		Number:           Int(1),
+		SubIssuesSummary: &SubIssuesSummary{Total: Int(2), Completed: Int(1), PercentCompleted: Int(50)},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Issues.ReprioritizeSubIssue returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ReprioritizeSubIssue"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.ReprioritizeSubIssue(ctx, "\n", "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.ReprioritizeSubIssue(ctx, "o", "r", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSubIssuesSummary_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SubIssuesSummary{}, "{}")
+
+	u := &SubIssuesSummary{
+		Total:            Int(4),
+		Completed:        Int(1),
+		PercentCompleted: Int(25),
+	}
+
+	want := `{
+		"total": 4,
+		"completed": 1,
+		"percent_completed": 25
+	}`
+
+	testJSONMarshal(t, u, want)
+}
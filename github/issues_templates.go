@@ -0,0 +1,368 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplatesDir is the directory GitHub reads issue templates from.
+const issueTemplatesDir = ".github/ISSUE_TEMPLATE"
+
+// IssueTemplate represents a Markdown issue template or a YAML issue form
+// stored in a repository's .github/ISSUE_TEMPLATE directory.
+//
+// GitHub API docs: https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-issue-forms
+type IssueTemplate struct {
+	// Path is the path of the template file within the repository.
+	Path *string `json:"-"`
+
+	Name *string `json:"name,omitempty"`
+	// About is the description of a Markdown issue template.
+	About *string `json:"about,omitempty"`
+	// Description is the description of an issue form.
+	Description *string  `json:"description,omitempty"`
+	Title       *string  `json:"title,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	Assignees   []string `json:"assignees,omitempty"`
+	Projects    []string `json:"projects,omitempty"`
+
+	// Body is the Markdown following the front matter of an issue template.
+	// It is nil for issue forms.
+	Body *string `json:"-"`
+	// Fields are the elements of an issue form. They are empty for Markdown
+	// issue templates.
+	Fields []*IssueFormField `json:"body,omitempty"`
+}
+
+func (t IssueTemplate) String() string {
+	return Stringify(t)
+}
+
+// IsForm reports whether t is a YAML issue form rather than a Markdown
+// issue template.
+func (t *IssueTemplate) IsForm() bool {
+	return t.Body == nil
+}
+
+// IssueFormField represents an element of an issue form. Possible values
+// for Type are "markdown", "textarea", "input", "dropdown" and "checkboxes".
+type IssueFormField struct {
+	Type        *string                    `json:"type,omitempty"`
+	ID          *string                    `json:"id,omitempty"`
+	Attributes  *IssueFormFieldAttributes  `json:"attributes,omitempty"`
+	Validations *IssueFormFieldValidations `json:"validations,omitempty"`
+}
+
+// IssueFormFieldAttributes represents the attributes of an issue form element.
+type IssueFormFieldAttributes struct {
+	Label       *string `json:"label,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Placeholder *string `json:"placeholder,omitempty"`
+	// Value is the text of a markdown element or the default value of a
+	// textarea or input element.
+	Value *string `json:"value,omitempty"`
+	// Render is the language a textarea is rendered as code in.
+	Render   *string `json:"render,omitempty"`
+	Multiple *bool   `json:"multiple,omitempty"`
+	// Options are the choices of a dropdown or checkboxes element. Dropdown
+	// options only have a label.
+	Options []*IssueFormFieldOption `json:"options,omitempty"`
+}
+
+// IssueFormFieldValidations represents the validations of an issue form element.
+type IssueFormFieldValidations struct {
+	Required *bool `json:"required,omitempty"`
+}
+
+// IssueFormFieldOption represents a dropdown or checkboxes option of an
+// issue form element.
+type IssueFormFieldOption struct {
+	Label    *string `json:"label,omitempty"`
+	Required *bool   `json:"required,omitempty"`
+}
+
+// isRequired reports whether the issue form element must be filled in.
+func (f *IssueFormField) isRequired() bool {
+	return f.GetValidations().GetRequired()
+}
+
+// label returns the label the issue form element is rendered under in the
+// body of an issue, falling back to its ID.
+func (f *IssueFormField) label() string {
+	if l := f.GetAttributes().GetLabel(); l != "" {
+		return l
+	}
+	return f.GetID()
+}
+
+// ParseIssueTemplate parses the content of an issue template file. Files
+// ending in .md are parsed as Markdown issue templates with YAML front
+// matter, files ending in .yml or .yaml as issue forms.
+func ParseIssueTemplate(filePath string, content []byte) (*IssueTemplate, error) {
+	var (
+		header string
+		body   *string
+	)
+	switch strings.ToLower(path.Ext(filePath)) {
+	case ".md":
+		s := strings.ReplaceAll(string(content), "\r\n", "\n")
+		if !strings.HasPrefix(s, "---\n") {
+			return nil, fmt.Errorf("issue template %v has no front matter", filePath)
+		}
+		end := strings.Index(s[3:], "\n---")
+		if end < 0 {
+			return nil, fmt.Errorf("issue template %v has unterminated front matter", filePath)
+		}
+		header = s[4 : end+4]
+		rest := s[end+7:]
+		if i := strings.IndexByte(rest, '\n'); i >= 0 {
+			rest = rest[i+1:]
+		} else {
+			rest = ""
+		}
+		body = String(rest)
+	case ".yml", ".yaml":
+		header = string(content)
+	default:
+		return nil, fmt.Errorf("unsupported issue template file %v", filePath)
+	}
+
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(header), &v); err != nil {
+		return nil, fmt.Errorf("parsing issue template %v: %w", filePath, err)
+	}
+	m, ok := jsonCompatibleYAML(v).(map[string]interface{})
+	if !ok && v != nil {
+		return nil, fmt.Errorf("issue template %v is not a YAML mapping", filePath)
+	}
+	normalizeIssueTemplate(m)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	t := &IssueTemplate{}
+	if err := json.Unmarshal(b, t); err != nil {
+		return nil, fmt.Errorf("parsing issue template %v: %w", filePath, err)
+	}
+	t.Path = String(filePath)
+	t.Body = body
+	return t, nil
+}
+
+// jsonCompatibleYAML converts a decoded YAML value so that it can be
+// marshaled to JSON: mappings with non-string keys get string keys, and
+// numbers and timestamps, which issue templates only use as text, become
+// strings.
+func jsonCompatibleYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = jsonCompatibleYAML(value)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonCompatibleYAML(value)
+		}
+		return m
+	case []interface{}:
+		for i, value := range v {
+			v[i] = jsonCompatibleYAML(value)
+		}
+		return v
+	case nil, bool, string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// normalizeIssueTemplate rewrites the shorthand forms GitHub accepts so
+// the decoded template can be unmarshaled into an IssueTemplate: labels,
+// assignees and projects may be given as a comma-separated string, and
+// dropdown options are plain strings.
+func normalizeIssueTemplate(m map[string]interface{}) {
+	for _, key := range []string{"labels", "assignees", "projects"} {
+		s, ok := m[key].(string)
+		if !ok {
+			continue
+		}
+		list := []interface{}{}
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		m[key] = list
+	}
+	fields, _ := m["body"].([]interface{})
+	for _, field := range fields {
+		f, _ := field.(map[string]interface{})
+		attrs, _ := f["attributes"].(map[string]interface{})
+		options, _ := attrs["options"].([]interface{})
+		for i, option := range options {
+			if _, ok := option.(map[string]interface{}); !ok {
+				options[i] = map[string]interface{}{"label": fmt.Sprint(option)}
+			}
+		}
+	}
+}
+
+// IssueTemplateErrors is returned by IssuesService.ListTemplates, along
+// with the templates that could be parsed, when some template files could
+// not be parsed. It maps the paths of these files to their parse errors.
+type IssueTemplateErrors map[string]error
+
+func (e IssueTemplateErrors) Error() string {
+	paths := make([]string, 0, len(e))
+	for p := range e {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	msgs := make([]string, len(paths))
+	for i, p := range paths {
+		msgs[i] = e[p].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ListTemplates lists and parses the issue templates and issue forms in the
+// .github/ISSUE_TEMPLATE directory of a repository. The template chooser
+// configuration file (config.yml) is skipped.
+//
+// Template files that cannot be parsed are skipped too: the other templates
+// are returned along with an IssueTemplateErrors error reporting them.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/contents#get-repository-content
+func (s *IssuesService) ListTemplates(ctx context.Context, owner, repo string, opts *RepositoryContentGetOptions) ([]*IssueTemplate, *Response, error) {
+	_, dir, resp, err := s.client.Repositories.GetContents(ctx, owner, repo, issueTemplatesDir, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var templates []*IssueTemplate
+	parseErrs := IssueTemplateErrors{}
+	for _, entry := range dir {
+		name := strings.ToLower(entry.GetName())
+		if entry.GetType() != "file" || name == "config.yml" || name == "config.yaml" {
+			continue
+		}
+		switch path.Ext(name) {
+		case ".md", ".yml", ".yaml":
+		default:
+			continue
+		}
+
+		file, _, fileResp, err := s.client.Repositories.GetContents(ctx, owner, repo, entry.GetPath(), opts)
+		resp = fileResp
+		if err != nil {
+			return nil, resp, err
+		}
+		if file == nil {
+			return nil, resp, errors.New("unexpected directory at " + entry.GetPath())
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			return nil, resp, err
+		}
+		t, err := ParseIssueTemplate(entry.GetPath(), []byte(content))
+		if err != nil {
+			parseErrs[entry.GetPath()] = err
+			continue
+		}
+		templates = append(templates, t)
+	}
+
+	if len(parseErrs) > 0 {
+		return templates, resp, parseErrs
+	}
+	return templates, resp, nil
+}
+
+// issueFormNoResponse is the placeholder GitHub renders for issue form
+// elements that were left empty.
+const issueFormNoResponse = "_No response_"
+
+// parseIssueFormResponse splits the body of an issue created from an issue
+// form into the responses rendered under each "### <label>" heading.
+func parseIssueFormResponse(body string) map[string]string {
+	responses := make(map[string]string)
+	var (
+		label   string
+		section []string
+		inField bool
+	)
+	flush := func() {
+		if inField {
+			responses[label] = strings.TrimSpace(strings.Join(section, "\n"))
+		}
+	}
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(line, "### ") {
+			flush()
+			label, section, inField = strings.TrimSpace(line[4:]), nil, true
+			continue
+		}
+		section = append(section, line)
+	}
+	flush()
+	return responses
+}
+
+// MissingRequiredFields checks the body of an issue created from the issue
+// form t and returns the labels of the required elements that were not
+// filled in. For checkboxes elements, the labels of the required options
+// that were not checked are returned. It returns nil for Markdown issue
+// templates, which have no required fields.
+func (t *IssueTemplate) MissingRequiredFields(issueBody string) []string {
+	responses := parseIssueFormResponse(issueBody)
+	var missing []string
+	for _, f := range t.Fields {
+		switch f.GetType() {
+		case "markdown":
+		case "checkboxes":
+			response := responses[f.label()]
+			for _, option := range f.GetAttributes().Options {
+				if option.GetRequired() && !isCheckboxChecked(response, option.GetLabel()) {
+					missing = append(missing, option.GetLabel())
+				}
+			}
+		default:
+			if !f.isRequired() {
+				continue
+			}
+			if r := responses[f.label()]; r == "" || r == issueFormNoResponse {
+				missing = append(missing, f.label())
+			}
+		}
+	}
+	return missing
+}
+
+// isCheckboxChecked reports whether the checkboxes response contains a
+// checked "- [X] <label>" task list item.
+func isCheckboxChecked(response, label string) bool {
+	for _, line := range strings.Split(response, "\n") {
+		line = strings.TrimSpace(line)
+		for _, checked := range []string{"- [X] ", "- [x] "} {
+			if strings.HasPrefix(line, checked) && strings.TrimSpace(line[len(checked):]) == label {
+				return true
+			}
+		}
+	}
+	return false
+}
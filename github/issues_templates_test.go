@@ -0,0 +1,251 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testIssueForm = `name: Bug Report
+description: File a bug report
+title: "[Bug]: "
+labels: ["bug", "triage"]
+assignees:
+  - octocat
+body:
+  - type: markdown
+    attributes:
+      value: |
+        Thanks for taking the time to fill out this bug report!
+  - type: input
+    id: contact
+    attributes:
+      label: Contact Details
+      placeholder: ex. email@example.com
+    validations:
+      required: false
+  - type: textarea
+    id: what-happened
+    attributes:
+      label: What happened?
+    validations:
+      required: true
+  - type: dropdown
+    id: version
+    attributes:
+      label: Version
+      options:
+        - 1.0.2 (Default)
+        - 1.0.3 (Edge)
+    validations:
+      required: true
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of Conduct
+      options:
+        - label: I agree to follow this project's Code of Conduct
+          required: true
+        - label: I searched for duplicates
+`
+
+const testIssueTemplate = `---
+name: Feature request
+about: Suggest an idea
+title: ''
+labels: enhancement, question
+assignees: ''
+---
+
+**Describe the solution you'd like**
+`
+
+func TestParseIssueTemplate_form(t *testing.T) {
+	got, err := ParseIssueTemplate(".github/ISSUE_TEMPLATE/bug.yml", []byte(testIssueForm))
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate returned error: %v", err)
+	}
+
+	want := &IssueTemplate{
+		Path:        String(".github/ISSUE_TEMPLATE/bug.yml"),
+		Name:        String("Bug Report"),
+		Description: String("File a bug report"),
+		Title:       String("[Bug]: "),
+		Labels:      []string{"bug", "triage"},
+		Assignees:   []string{"octocat"},
+		Fields: []*IssueFormField{
+			{
+				Type:       String("markdown"),
+				Attributes: &IssueFormFieldAttributes{Value: String("Thanks for taking the time to fill out this bug report!\n")},
+			},
+			{
+				Type:        String("input"),
+				ID:          String("contact"),
+				Attributes:  &IssueFormFieldAttributes{Label: String("Contact Details"), Placeholder: String("ex. email@example.com")},
+				Validations: &IssueFormFieldValidations{Required: Bool(false)},
+			},
+			{
+				Type:        String("textarea"),
+				ID:          String("what-happened"),
+				Attributes:  &IssueFormFieldAttributes{Label: String("What happened?")},
+				Validations: &IssueFormFieldValidations{Required: Bool(true)},
+			},
+			{
+				Type: String("dropdown"),
+				ID:   String("version"),
+				Attributes: &IssueFormFieldAttributes{
+					Label:   String("Version"),
+					Options: []*IssueFormFieldOption{{Label: String("1.0.2 (Default)")}, {Label: String("1.0.3 (Edge)")}},
+				},
+				Validations: &IssueFormFieldValidations{Required: Bool(true)},
+			},
+			{
+				Type: String("checkboxes"),
+				ID:   String("terms"),
+				Attributes: &IssueFormFieldAttributes{
+					Label: String("Code of Conduct"),
+					Options: []*IssueFormFieldOption{
+						{Label: String("I agree to follow this project's Code of Conduct"), Required: Bool(true)},
+						{Label: String("I searched for duplicates")},
+					},
+				},
+			},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ParseIssueTemplate returned %+v, want %+v", got, want)
+	}
+	if !got.IsForm() {
+		t.Error("IsForm returned false for an issue form")
+	}
+}
+
+func TestParseIssueTemplate_markdown(t *testing.T) {
+	got, err := ParseIssueTemplate(".github/ISSUE_TEMPLATE/feature.md", []byte(testIssueTemplate))
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate returned error: %v", err)
+	}
+
+	want := &IssueTemplate{
+		Path:      String(".github/ISSUE_TEMPLATE/feature.md"),
+		Name:      String("Feature request"),
+		About:     String("Suggest an idea"),
+		Title:     String(""),
+		Labels:    []string{"enhancement", "question"},
+		Assignees: []string{},
+		Body:      String("\n**Describe the solution you'd like**\n"),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ParseIssueTemplate returned %+v, want %+v", got, want)
+	}
+	if got.IsForm() {
+		t.Error("IsForm returned true for a Markdown template")
+	}
+}
+
+func TestParseIssueTemplate_documentMarkers(t *testing.T) {
+	got, err := ParseIssueTemplate("bug.yml", []byte("---\nname: Bug\nversion: 2\n...\n"))
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate returned error: %v", err)
+	}
+	if want := "Bug"; got.GetName() != want {
+		t.Errorf("ParseIssueTemplate returned name %q, want %q", got.GetName(), want)
+	}
+}
+
+func TestParseIssueTemplate_errors(t *testing.T) {
+	for path, content := range map[string]string{
+		"bug.txt":   "name: x",
+		"bug.md":    "no front matter",
+		"other.md":  "---\nname: x\n",
+		"list.yml":  "- a\n- b\n",
+		"bad.yaml":  "name: [x\n",
+		"typed.yml": "labels: {a: b}\n",
+	} {
+		if _, err := ParseIssueTemplate(path, []byte(content)); err == nil {
+			t.Errorf("ParseIssueTemplate(%q) returned nil error, want error", path)
+		}
+	}
+}
+
+func TestIssuesService_ListTemplates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/.github/ISSUE_TEMPLATE", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "main"})
+		fmt.Fprint(w, `[
+			{"type":"file","name":"bug.yml","path":".github/ISSUE_TEMPLATE/bug.yml"},
+			{"type":"file","name":"config.yml","path":".github/ISSUE_TEMPLATE/config.yml"},
+			{"type":"file","name":"README.txt","path":".github/ISSUE_TEMPLATE/README.txt"},
+			{"type":"dir","name":"more","path":".github/ISSUE_TEMPLATE/more"},
+			{"type":"file","name":"feature.md","path":".github/ISSUE_TEMPLATE/feature.md"},
+			{"type":"file","name":"broken.yml","path":".github/ISSUE_TEMPLATE/broken.yml"}
+		]`)
+	})
+	for name, content := range map[string]string{"bug.yml": testIssueForm, "feature.md": testIssueTemplate, "broken.yml": "name: [x\n"} {
+		encoded := base64.StdEncoding.EncodeToString([]byte(content))
+		mux.HandleFunc("/repos/o/r/contents/.github/ISSUE_TEMPLATE/"+name, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"type":"file","encoding":"base64","content":%q}`, encoded)
+		})
+	}
+
+	ctx := context.Background()
+	templates, _, err := client.Issues.ListTemplates(ctx, "o", "r", &RepositoryContentGetOptions{Ref: "main"})
+	parseErrs, ok := err.(IssueTemplateErrors)
+	if !ok || len(parseErrs) != 1 || parseErrs[".github/ISSUE_TEMPLATE/broken.yml"] == nil {
+		t.Fatalf("Issues.ListTemplates returned error %#v, want an IssueTemplateErrors for broken.yml", err)
+	}
+	if len(templates) != 2 {
+		t.Fatalf("Issues.ListTemplates returned %v templates, want 2", len(templates))
+	}
+	if got, want := templates[0].GetName(), "Bug Report"; got != want {
+		t.Errorf("Issues.ListTemplates returned first template %q, want %q", got, want)
+	}
+	if got, want := templates[1].GetName(), "Feature request"; got != want {
+		t.Errorf("Issues.ListTemplates returned second template %q, want %q", got, want)
+	}
+
+	const methodName = "ListTemplates"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.ListTemplates(ctx, "\n", "\n", nil)
+		return err
+	})
+}
+
+func TestIssueTemplate_MissingRequiredFields(t *testing.T) {
+	form, err := ParseIssueTemplate("bug.yml", []byte(testIssueForm))
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate returned error: %v", err)
+	}
+
+	complete := "### Contact Details\n\n_No response_\n\n### What happened?\n\nIt broke.\n\n" +
+		"### Version\n\n1.0.2 (Default)\n\n### Code of Conduct\n\n" +
+		"- [X] I agree to follow this project's Code of Conduct\n- [ ] I searched for duplicates\n"
+	if got := form.MissingRequiredFields(complete); got != nil {
+		t.Errorf("MissingRequiredFields returned %v, want nil", got)
+	}
+
+	incomplete := "### What happened?\n\n_No response_\n\n### Code of Conduct\n\n" +
+		"- [ ] I agree to follow this project's Code of Conduct\n"
+	want := []string{"What happened?", "Version", "I agree to follow this project's Code of Conduct"}
+	if got := form.MissingRequiredFields(incomplete); !cmp.Equal(got, want) {
+		t.Errorf("MissingRequiredFields returned %v, want %v", got, want)
+	}
+
+	markdown := &IssueTemplate{Body: String("")}
+	if got := markdown.MissingRequiredFields(""); got != nil {
+		t.Errorf("MissingRequiredFields returned %v for a Markdown template, want nil", got)
+	}
+}
@@ -38,7 +38,7 @@ func TestIssuesService_List_all(t *testing.T) {
 
 	opt := &IssueListOptions{
 		"all", "closed", []string{"a", "b"}, "updated", "asc",
-		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
+		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC), "",
 		ListOptions{Page: 1, PerPage: 2},
 	}
 	ctx := context.Background()
@@ -155,13 +155,14 @@ func TestIssuesService_ListByRepo(t *testing.T) {
 			"sort":      "updated",
 			"direction": "asc",
 			"since":     "2002-02-10T15:30:00Z",
+			"type":      "Bug",
 		})
 		fmt.Fprint(w, `[{"number":1}]`)
 	})
 
 	opt := &IssueListByRepoOptions{
 		"*", "closed", "a", "c", "m", []string{"a", "b"}, "updated", "asc",
-		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
+		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC), "Bug",
 		ListOptions{0, 0},
 	}
 	ctx := context.Background()
@@ -261,6 +262,7 @@ func TestIssuesService_Create(t *testing.T) {
 		Body:     String("b"),
 		Assignee: String("a"),
 		Labels:   &[]string{"l1", "l2"},
+		Type:     String("Bug"),
 	}
 
 	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
@@ -272,7 +274,7 @@ func TestIssuesService_Create(t *testing.T) {
 			t.Errorf("Request body = %+v, want %+v", v, input)
 		}
 
-		fmt.Fprint(w, `{"number":1}`)
+		fmt.Fprint(w, `{"number":1,"type":{"id":411,"name":"Bug"}}`)
 	})
 
 	ctx := context.Background()
@@ -281,7 +283,7 @@ func TestIssuesService_Create(t *testing.T) {
 		t.Errorf("Issues.Create returned error: %v", err)
 	}
 
-	want := &Issue{Number: Int(1)}
+	want := &Issue{Number: Int(1), Type: &IssueType{ID: Int64(411), Name: String("Bug")}}
 	if !cmp.Equal(issue, want) {
 		t.Errorf("Issues.Create returned %+v, want %+v", issue, want)
 	}
@@ -423,6 +425,16 @@ func TestIssuesService_Lock(t *testing.T) {
 	})
 }
 
+func TestIssuesService_Lock_invalidReason(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.Issues.Lock(ctx, "o", "r", 1, &LockIssueOptions{LockReason: "boring"}); err == nil {
+		t.Error("Issues.Lock returned nil error, want error for invalid lock reason")
+	}
+}
+
 func TestIssuesService_LockWithReason(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -11,6 +11,72 @@ import (
 	"strings"
 )
 
+// Event types of the Timeline.Event field.
+//
+// GitHub API docs: https://docs.github.com/en/webhooks-and-events/events/issue-event-types
+const (
+	TimelineEventAddedToMergeQueue            = "added_to_merge_queue"
+	TimelineEventAddedToProject               = "added_to_project"
+	TimelineEventAddedToProjectV2             = "added_to_project_v2"
+	TimelineEventAssigned                     = "assigned"
+	TimelineEventAutoMergeDisabled            = "auto_merge_disabled"
+	TimelineEventAutoMergeEnabled             = "auto_merge_enabled"
+	TimelineEventAutoRebaseEnabled            = "auto_rebase_enabled"
+	TimelineEventAutoSquashEnabled            = "auto_squash_enabled"
+	TimelineEventAutomaticBaseChangeFailed    = "automatic_base_change_failed"
+	TimelineEventAutomaticBaseChangeSucceeded = "automatic_base_change_succeeded"
+	TimelineEventBaseRefChanged               = "base_ref_changed"
+	TimelineEventBaseRefDeleted               = "base_ref_deleted"
+	TimelineEventBaseRefForcePushed           = "base_ref_force_pushed"
+	TimelineEventClosed                       = "closed"
+	TimelineEventCommentDeleted               = "comment_deleted"
+	TimelineEventCommented                    = "commented"
+	TimelineEventCommitCommented              = "commit-commented"
+	TimelineEventCommitted                    = "committed"
+	TimelineEventConnected                    = "connected"
+	TimelineEventConvertToDraft               = "convert_to_draft"
+	TimelineEventConvertedNoteToIssue         = "converted_note_to_issue"
+	TimelineEventConvertedToDiscussion        = "converted_to_discussion"
+	TimelineEventCrossReferenced              = "cross-referenced"
+	TimelineEventDemilestoned                 = "demilestoned"
+	TimelineEventDeployed                     = "deployed"
+	TimelineEventDeploymentEnvironmentChanged = "deployment_environment_changed"
+	TimelineEventDisconnected                 = "disconnected"
+	TimelineEventHeadRefDeleted               = "head_ref_deleted"
+	TimelineEventHeadRefForcePushed           = "head_ref_force_pushed"
+	TimelineEventHeadRefRestored              = "head_ref_restored"
+	TimelineEventLabeled                      = "labeled"
+	TimelineEventLineCommented                = "line-commented"
+	TimelineEventLocked                       = "locked"
+	TimelineEventMarkedAsDuplicate            = "marked_as_duplicate"
+	TimelineEventMentioned                    = "mentioned"
+	TimelineEventMerged                       = "merged"
+	TimelineEventMilestoned                   = "milestoned"
+	TimelineEventMovedColumnsInProject        = "moved_columns_in_project"
+	TimelineEventPinned                       = "pinned"
+	TimelineEventProjectV2ItemStatusChanged   = "project_v2_item_status_changed"
+	TimelineEventReadyForReview               = "ready_for_review"
+	TimelineEventReferenced                   = "referenced"
+	TimelineEventRemovedFromMergeQueue        = "removed_from_merge_queue"
+	TimelineEventRemovedFromProject           = "removed_from_project"
+	TimelineEventRemovedFromProjectV2         = "removed_from_project_v2"
+	TimelineEventRenamed                      = "renamed"
+	TimelineEventReopened                     = "reopened"
+	TimelineEventReviewDismissed              = "review_dismissed"
+	TimelineEventReviewRequestRemoved         = "review_request_removed"
+	TimelineEventReviewRequested              = "review_requested"
+	TimelineEventReviewed                     = "reviewed"
+	TimelineEventSubscribed                   = "subscribed"
+	TimelineEventTransferred                  = "transferred"
+	TimelineEventUnassigned                   = "unassigned"
+	TimelineEventUnlabeled                    = "unlabeled"
+	TimelineEventUnlocked                     = "unlocked"
+	TimelineEventUnmarkedAsDuplicate          = "unmarked_as_duplicate"
+	TimelineEventUnpinned                     = "unpinned"
+	TimelineEventUnsubscribed                 = "unsubscribed"
+	TimelineEventUserBlocked                  = "user_blocked"
+)
+
 // Timeline represents an event that occurred around an Issue or Pull Request.
 //
 // It is similar to an IssueEvent but may contain more information.
@@ -112,6 +178,7 @@ type Timeline struct {
 	//     unsubscribed
 	//       The actor unsubscribed to stop receiving notifications for an issue.
 	//
+	// See the TimelineEvent constants for the complete list.
 	Event *string `json:"event,omitempty"`
 
 	// The string SHA of a commit that referenced this Issue or Pull Request.
@@ -151,6 +218,23 @@ type Timeline struct {
 	// The review summary text.
 	Body        *string    `json:"body,omitempty"`
 	SubmittedAt *Timestamp `json:"submitted_at,omitempty"`
+
+	NodeID            *string    `json:"node_id,omitempty"`
+	HTMLURL           *string    `json:"html_url,omitempty"`
+	UpdatedAt         *Timestamp `json:"updated_at,omitempty"`
+	AuthorAssociation *string    `json:"author_association,omitempty"`
+	// The GitHub App that performed the event, if any.
+	PerformedViaGitHubApp *App `json:"performed_via_github_app,omitempty"`
+
+	// The reason the issue was locked, one of the LockReason constants.
+	// Only provided for 'locked' events.
+	LockReason *string `json:"lock_reason,omitempty"`
+	// The reason the issue was closed or reopened. Only provided for 'closed'
+	// and 'reopened' events.
+	StateReason *string `json:"state_reason,omitempty"`
+	// The review that was dismissed and why.
+	// Only provided for 'review_dismissed' events.
+	DismissedReview *DismissedReview `json:"dismissed_review,omitempty"`
 }
 
 // Source represents a reference's source.
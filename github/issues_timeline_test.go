@@ -57,6 +57,53 @@ func TestIssuesService_ListIssueTimeline(t *testing.T) {
 	})
 }
 
+func TestIssuesService_ListIssueTimeline_eventDetails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/timeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"event":"cross-referenced","source":{"type":"issue","issue":{"number":2,"repository":{"full_name":"o/r2"}}},"updated_at":`+referenceTimeStr+`},
+			{"id":2,"node_id":"n2","event":"review_dismissed","dismissed_review":{"state":"approved","review_id":3,"dismissal_message":"m","dismissal_commit_id":"c"}},
+			{"id":3,"event":"locked","lock_reason":"resolved"},
+			{"id":4,"event":"closed","state_reason":"not_planned"},
+			{"id":5,"event":"converted_to_discussion","performed_via_github_app":{"id":6,"slug":"s"}}
+		]`)
+	})
+
+	ctx := context.Background()
+	events, _, err := client.Issues.ListIssueTimeline(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Errorf("Issues.ListIssueTimeline returned error: %v", err)
+	}
+
+	want := []*Timeline{
+		{
+			Event:     String(TimelineEventCrossReferenced),
+			Source:    &Source{Type: String("issue"), Issue: &Issue{Number: Int(2), Repository: &Repository{FullName: String("o/r2")}}},
+			UpdatedAt: &Timestamp{referenceTime},
+		},
+		{
+			ID:     Int64(2),
+			NodeID: String("n2"),
+			Event:  String(TimelineEventReviewDismissed),
+			DismissedReview: &DismissedReview{
+				State:             String("approved"),
+				ReviewID:          Int64(3),
+				DismissalMessage:  String("m"),
+				DismissalCommitID: String("c"),
+			},
+		},
+		{ID: Int64(3), Event: String(TimelineEventLocked), LockReason: String("resolved")},
+		{ID: Int64(4), Event: String(TimelineEventClosed), StateReason: String("not_planned")},
+		{ID: Int64(5), Event: String(TimelineEventConvertedToDiscussion), PerformedViaGitHubApp: &App{ID: Int64(6), Slug: String("s")}},
+	}
+	if !cmp.Equal(events, want) {
+		t.Errorf("Issues.ListIssueTimeline = %+v, want %+v", events, want)
+	}
+}
+
 func TestSource_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Source{}, "{}")
 
@@ -9,6 +9,7 @@
 package github
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -22,6 +23,7 @@ import (
 	"mime"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -39,6 +41,14 @@ const (
 	EventTypeHeader = "X-Github-Event"
 	// DeliveryIDHeader is the GitHub header key used to pass the unique ID for the webhook event.
 	DeliveryIDHeader = "X-Github-Delivery"
+	// HookIDHeader is the GitHub header key used to pass the ID of the webhook.
+	HookIDHeader = "X-Github-Hook-Id"
+	// InstallationTargetIDHeader is the GitHub header key used to pass the ID of the
+	// resource the webhook was created on.
+	InstallationTargetIDHeader = "X-Github-Hook-Installation-Target-Id"
+	// InstallationTargetTypeHeader is the GitHub header key used to pass the type of the
+	// resource the webhook was created on.
+	InstallationTargetTypeHeader = "X-Github-Hook-Installation-Target-Type"
 )
 
 var (
@@ -51,9 +61,12 @@ var (
 		"commit_comment":                 "CommitCommentEvent",
 		"content_reference":              "ContentReferenceEvent",
 		"create":                         "CreateEvent",
+		"custom_property":                "CustomPropertyEvent",
+		"custom_property_values":         "CustomPropertyValuesEvent",
 		"delete":                         "DeleteEvent",
 		"deploy_key":                     "DeployKeyEvent",
 		"deployment":                     "DeploymentEvent",
+		"deployment_protection_rule":     "DeploymentProtectionRuleEvent",
 		"deployment_status":              "DeploymentStatusEvent",
 		"discussion":                     "DiscussionEvent",
 		"discussion_comment":             "DiscussionCommentEvent",
@@ -79,6 +92,8 @@ var (
 		"project":                        "ProjectEvent",
 		"project_card":                   "ProjectCardEvent",
 		"project_column":                 "ProjectColumnEvent",
+		"projects_v2":                    "ProjectV2Event",
+		"projects_v2_item":               "ProjectV2ItemEvent",
 		"public":                         "PublicEvent",
 		"pull_request":                   "PullRequestEvent",
 		"pull_request_review":            "PullRequestReviewEvent",
@@ -86,14 +101,18 @@ var (
 		"pull_request_review_thread":     "PullRequestReviewThreadEvent",
 		"pull_request_target":            "PullRequestTargetEvent",
 		"push":                           "PushEvent",
+		"registry_package":               "RegistryPackageEvent",
 		"repository":                     "RepositoryEvent",
 		"repository_dispatch":            "RepositoryDispatchEvent",
 		"repository_import":              "RepositoryImportEvent",
+		"repository_ruleset":             "RepositoryRulesetEvent",
 		"repository_vulnerability_alert": "RepositoryVulnerabilityAlertEvent",
 		"release":                        "ReleaseEvent",
 		"secret_scanning_alert":          "SecretScanningAlertEvent",
+		"secret_scanning_alert_location": "SecretScanningAlertLocationEvent",
 		"star":                           "StarEvent",
 		"status":                         "StatusEvent",
+		"sub_issues":                     "SubIssuesEvent",
 		"team":                           "TeamEvent",
 		"team_add":                       "TeamAddEvent",
 		"user":                           "UserEvent",
@@ -240,6 +259,115 @@ func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err e
 	return ValidatePayloadFromBody(contentType, r.Body, signature, secretToken)
 }
 
+// ErrPayloadTooLarge is returned by ValidatePayloadStream when a webhook
+// payload is larger than the allowed size.
+var ErrPayloadTooLarge = errors.New("webhook payload exceeds the maximum size")
+
+// ValidatePayloadStream validates an incoming GitHub Webhook event request
+// like ValidatePayload, without buffering its body. The returned ReadCloser
+// reads the payload from the request body and computes its signature as it
+// goes: once the whole payload has been read, Read returns io.EOF if the
+// signature matches, and an error otherwise. The payload must not be acted
+// upon until Read has returned io.EOF, so decoders that may stop before the
+// end of their input, such as json.Decoder, should be followed by a check
+// that the rest of the payload reads without error.
+//
+// Bodies larger than maxSize bytes are rejected with ErrPayloadTooLarge,
+// either immediately if the request declares its length, or from Read.
+// Payloads with the "application/x-www-form-urlencoded" Content-Type are
+// nested in a form parameter, so they are read in full, up to maxSize
+// bytes, and validated like ValidatePayload.
+//
+// Example usage:
+//
+//	func (s *GitHubEventMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	  payload, err := github.ValidatePayloadStream(r, s.webhookSecretKey, 25<<20)
+//	  if err != nil { ... }
+//	  defer payload.Close()
+//	  // Copy payload to a temporary file, and process it once io.Copy returned no error...
+//	}
+func ValidatePayloadStream(r *http.Request, secretToken []byte, maxSize int64) (payload io.ReadCloser, err error) {
+	signature := r.Header.Get(SHA256SignatureHeader)
+	if signature == "" {
+		signature = r.Header.Get(SHA1SignatureHeader)
+	}
+
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	if r.ContentLength > maxSize {
+		return nil, ErrPayloadTooLarge
+	}
+	body := io.LimitReader(r.Body, maxSize+1)
+
+	if contentType != "application/json" {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(data)) > maxSize {
+			return nil, ErrPayloadTooLarge
+		}
+		p, err := ValidatePayloadFromBody(contentType, bytes.NewReader(data), signature, secretToken)
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(bytes.NewReader(p)), nil
+	}
+
+	v := &validatingReader{r: body, closer: r.Body, maxSize: maxSize}
+	// Validate the signature if present or if one is expected (secretToken is non-empty).
+	if len(secretToken) > 0 || len(signature) > 0 {
+		wantMAC, hashFunc, err := messageMAC(signature)
+		if err != nil {
+			return nil, err
+		}
+		v.mac, v.wantMAC = hmac.New(hashFunc, secretToken), wantMAC
+	}
+	return v, nil
+}
+
+// validatingReader reads a webhook payload, computing its signature as it
+// is read, and fails at the end of the payload if the signature does not
+// match or if it is larger than maxSize bytes.
+type validatingReader struct {
+	r       io.Reader
+	closer  io.Closer
+	mac     hash.Hash
+	wantMAC []byte
+	maxSize int64
+	n       int64
+	err     error
+}
+
+func (v *validatingReader) Read(p []byte) (int, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+
+	n, err := v.r.Read(p)
+	v.n += int64(n)
+	if v.n > v.maxSize {
+		v.err = ErrPayloadTooLarge
+		return 0, v.err
+	}
+	if v.mac != nil {
+		v.mac.Write(p[:n])
+	}
+	if err == io.EOF && v.mac != nil && !hmac.Equal(v.wantMAC, v.mac.Sum(nil)) {
+		err = errors.New("payload signature check failed")
+	}
+	if err != nil {
+		v.err = err
+	}
+	return n, err
+}
+
+func (v *validatingReader) Close() error {
+	return v.closer.Close()
+}
+
 // ValidateSignature validates the signature for the given payload.
 // signature is the GitHub hash signature delivered in the X-Hub-Signature header.
 // payload is the JSON payload sent by GitHub Webhooks.
@@ -271,6 +399,57 @@ func DeliveryID(r *http.Request) string {
 	return r.Header.Get(DeliveryIDHeader)
 }
 
+// WebhookHeaders represents the GitHub headers of a webhook delivery.
+type WebhookHeaders struct {
+	// Event is the event type, such as "push".
+	Event string
+	// DeliveryID is the GUID identifying the delivery. Redeliveries keep
+	// the ID of the original delivery.
+	DeliveryID string
+	// HookID is the ID of the webhook.
+	HookID int64
+	// InstallationTargetID is the ID of the resource the webhook was
+	// created on.
+	InstallationTargetID int64
+	// InstallationTargetType is the type of the resource the webhook was
+	// created on, such as "repository", "organization" or "integration".
+	InstallationTargetType string
+}
+
+// ParseWebhookHeaders parses the GitHub headers of webhook request r.
+// Missing headers are left empty; an error is returned if a numeric header
+// cannot be parsed.
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#delivery-headers
+func ParseWebhookHeaders(r *http.Request) (*WebhookHeaders, error) {
+	h := &WebhookHeaders{
+		Event:                  WebHookType(r),
+		DeliveryID:             DeliveryID(r),
+		InstallationTargetType: r.Header.Get(InstallationTargetTypeHeader),
+	}
+
+	var err error
+	if h.HookID, err = parseWebhookIDHeader(r, HookIDHeader); err != nil {
+		return nil, err
+	}
+	if h.InstallationTargetID, err = parseWebhookIDHeader(r, InstallationTargetIDHeader); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func parseWebhookIDHeader(r *http.Request, key string) (int64, error) {
+	v := r.Header.Get(key)
+	if v == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing %v header %q: %v", key, v, err)
+	}
+	return id, nil
+}
+
 // ParseWebHook parses the event payload. For recognized event types, a
 // value of the corresponding struct type will be returned (as returned
 // by Event.ParsePayload()). An error will be returned for unrecognized event
@@ -303,3 +482,19 @@ func ParseWebHook(messageType string, payload []byte) (interface{}, error) {
 	}
 	return event.ParsePayload()
 }
+
+// ParseWebHookStrict parses the event payload like ParseWebHook, and
+// additionally calls unknownFields, if it is non-nil, with the payload
+// fields that the returned event struct does not model. This lets
+// applications detect fields GitHub added to webhook payloads that would
+// otherwise be silently dropped.
+func ParseWebHookStrict(messageType string, payload []byte, unknownFields UnknownFieldsFunc) (interface{}, error) {
+	event, err := ParseWebHook(messageType, payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := reportUnknownFields(payload, event, unknownFields); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
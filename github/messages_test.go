@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -247,6 +248,109 @@ func TestValidatePayload_ValidContentTypeParams(t *testing.T) {
 	}
 }
 
+func TestValidatePayloadStream(t *testing.T) {
+	const body = `{"yo":true}`
+	const signature = "sha256=b1f8020f5b4cd42042f807dd939015c4a418bc1ff7f604dd55b0a19b5d953d9b"
+	secretKey := []byte("0123456789abcdef")
+	tests := []struct {
+		name        string
+		secretKey   []byte
+		signature   string
+		maxSize     int64
+		wantPayload string
+		wantErr     error
+	}{
+		{name: "missing signature", secretKey: secretKey, maxSize: 100},
+		{name: "invalid signature", secretKey: secretKey, signature: "sha256=012345", maxSize: 100},
+		{name: "signature without secretKey", signature: signature, maxSize: 100},
+		{name: "too large", secretKey: secretKey, signature: signature, maxSize: 10, wantErr: ErrPayloadTooLarge},
+		{name: "no secretKey and no signature", maxSize: 100, wantPayload: body},
+		{name: "valid", secretKey: secretKey, signature: signature, maxSize: 11, wantPayload: body},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			// Hide the content length so that the body is capped while it is read.
+			req.ContentLength = -1
+			req.Header.Set("Content-Type", "application/json")
+			if test.signature != "" {
+				req.Header.Set(SHA256SignatureHeader, test.signature)
+			}
+
+			// The error is returned either by ValidatePayloadStream or when
+			// the payload has been read.
+			var got []byte
+			payload, err := ValidatePayloadStream(req, test.secretKey, test.maxSize)
+			if err == nil {
+				got, err = io.ReadAll(payload)
+				if err := payload.Close(); err != nil {
+					t.Errorf("Close: %v", err)
+				}
+			}
+			if test.wantPayload == "" {
+				if err == nil {
+					t.Fatal("ValidatePayloadStream = nil, want err")
+				}
+				if test.wantErr != nil && err != test.wantErr {
+					t.Errorf("ValidatePayloadStream err = %v, want %v", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidatePayloadStream: %v", err)
+			}
+			if string(got) != test.wantPayload {
+				t.Errorf("ValidatePayloadStream = %q, want %q", got, test.wantPayload)
+			}
+		})
+	}
+}
+
+func TestValidatePayloadStream_contentLength(t *testing.T) {
+	req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(`{"yo":true}`))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := ValidatePayloadStream(req, nil, 5); err != ErrPayloadTooLarge {
+		t.Errorf("ValidatePayloadStream err = %v, want %v", err, ErrPayloadTooLarge)
+	}
+}
+
+func TestValidatePayloadStream_Form(t *testing.T) {
+	payload := `{"yo":true}`
+	signature := "sha1=3374ef144403e8035423b23b02e2c9d7a4c50368"
+	secretKey := []byte("0123456789abcdef")
+
+	form := url.Values{}
+	form.Set("payload", payload)
+	req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(SHA1SignatureHeader, signature)
+
+	rc, err := ValidatePayloadStream(req, secretKey, 1<<10)
+	if err != nil {
+		t.Fatalf("ValidatePayloadStream: %v", err)
+	}
+	defer rc.Close()
+
+	got, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("reading payload: %v", err)
+	}
+	if string(got) != payload {
+		t.Errorf("ValidatePayloadStream = %q, want %q", got, payload)
+	}
+}
+
 func TestParseWebHook(t *testing.T) {
 	tests := []struct {
 		payload     interface{}
@@ -280,6 +384,14 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &CreateEvent{},
 			messageType: "create",
 		},
+		{
+			payload:     &CustomPropertyEvent{},
+			messageType: "custom_property",
+		},
+		{
+			payload:     &CustomPropertyValuesEvent{},
+			messageType: "custom_property_values",
+		},
 		{
 			payload:     &DeleteEvent{},
 			messageType: "delete",
@@ -292,6 +404,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &DeploymentEvent{},
 			messageType: "deployment",
 		},
+		{
+			payload:     &DeploymentProtectionRuleEvent{},
+			messageType: "deployment_protection_rule",
+		},
 		{
 			payload:     &DeploymentStatusEvent{},
 			messageType: "deployment_status",
@@ -392,6 +508,14 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &ProjectColumnEvent{},
 			messageType: "project_column",
 		},
+		{
+			payload:     &ProjectV2Event{},
+			messageType: "projects_v2",
+		},
+		{
+			payload:     &ProjectV2ItemEvent{},
+			messageType: "projects_v2_item",
+		},
 		{
 			payload:     &PublicEvent{},
 			messageType: "public",
@@ -420,6 +544,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &PushEvent{},
 			messageType: "push",
 		},
+		{
+			payload:     &RegistryPackageEvent{},
+			messageType: "registry_package",
+		},
 		{
 			payload:     &ReleaseEvent{},
 			messageType: "release",
@@ -428,6 +556,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &RepositoryEvent{},
 			messageType: "repository",
 		},
+		{
+			payload:     &RepositoryRulesetEvent{},
+			messageType: "repository_ruleset",
+		},
 		{
 			payload:     &RepositoryVulnerabilityAlertEvent{},
 			messageType: "repository_vulnerability_alert",
@@ -436,6 +568,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &SecretScanningAlertEvent{},
 			messageType: "secret_scanning_alert",
 		},
+		{
+			payload:     &SecretScanningAlertLocationEvent{},
+			messageType: "secret_scanning_alert_location",
+		},
 		{
 			payload:     &StarEvent{},
 			messageType: "star",
@@ -444,6 +580,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &StatusEvent{},
 			messageType: "status",
 		},
+		{
+			payload:     &SubIssuesEvent{},
+			messageType: "sub_issues",
+		},
 		{
 			payload:     &TeamEvent{},
 			messageType: "team",
@@ -497,6 +637,34 @@ func TestParseWebHook(t *testing.T) {
 	}
 }
 
+func TestParseWebHookStrict(t *testing.T) {
+	payload := []byte(`{"action":"created","new_field":1,"repository":{"id":1,"new_field":2},"sender":{"login":"l"}}`)
+
+	var gotValue interface{}
+	var gotFields []string
+	event, err := ParseWebHookStrict("star", payload, func(v interface{}, fields []string) {
+		gotValue, gotFields = v, fields
+	})
+	if err != nil {
+		t.Fatalf("ParseWebHookStrict returned unexpected error: %v", err)
+	}
+
+	want := &StarEvent{
+		Action: String("created"),
+		Repo:   &Repository{ID: Int64(1)},
+		Sender: &User{Login: String("l")},
+	}
+	if !cmp.Equal(event, want) {
+		t.Errorf("ParseWebHookStrict returned %+v, want %+v", event, want)
+	}
+	if gotValue != event {
+		t.Errorf("UnknownFields called with %v, want the parsed event", gotValue)
+	}
+	if wantFields := []string{"new_field", "repository.new_field"}; !cmp.Equal(gotFields, wantFields) {
+		t.Errorf("UnknownFields called with %v, want %v", gotFields, wantFields)
+	}
+}
+
 func TestParseWebHook_BadMessageType(t *testing.T) {
 	if _, err := ParseWebHook("bogus message type", []byte("{}")); err == nil {
 		t.Fatal("ParseWebHook returned nil; wanted error")
@@ -529,6 +697,56 @@ func TestDeliveryID(t *testing.T) {
 	}
 }
 
+func TestParseWebhookHeaders(t *testing.T) {
+	req, err := http.NewRequest("POST", "http://localhost", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("X-GitHub-Event", "ping")
+	req.Header.Set("X-GitHub-Delivery", "8970a780-244e-11e7-91ca-da3aabcb9793")
+	req.Header.Set("X-GitHub-Hook-ID", "292430182")
+	req.Header.Set("X-GitHub-Hook-Installation-Target-ID", "79929171")
+	req.Header.Set("X-GitHub-Hook-Installation-Target-Type", "repository")
+
+	got, err := ParseWebhookHeaders(req)
+	if err != nil {
+		t.Fatalf("ParseWebhookHeaders returned error: %v", err)
+	}
+
+	want := &WebhookHeaders{
+		Event:                  "ping",
+		DeliveryID:             "8970a780-244e-11e7-91ca-da3aabcb9793",
+		HookID:                 292430182,
+		InstallationTargetID:   79929171,
+		InstallationTargetType: "repository",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ParseWebhookHeaders = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseWebhookHeaders_missing(t *testing.T) {
+	req := &http.Request{Header: http.Header{EventTypeHeader: []string{"push"}}}
+
+	got, err := ParseWebhookHeaders(req)
+	if err != nil {
+		t.Fatalf("ParseWebhookHeaders returned error: %v", err)
+	}
+	if want := (&WebhookHeaders{Event: "push"}); !cmp.Equal(got, want) {
+		t.Errorf("ParseWebhookHeaders = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseWebhookHeaders_invalidID(t *testing.T) {
+	for _, key := range []string{HookIDHeader, InstallationTargetIDHeader} {
+		req := &http.Request{Header: http.Header{}}
+		req.Header.Set(key, "x")
+		if _, err := ParseWebhookHeaders(req); err == nil {
+			t.Errorf("ParseWebhookHeaders with invalid %v returned no error", key)
+		}
+	}
+}
+
 func TestWebHookType(t *testing.T) {
 	want := "yo"
 	req := &http.Request{
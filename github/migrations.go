@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 )
@@ -35,11 +36,28 @@ type Migration struct {
 	LockRepositories *bool `json:"lock_repositories,omitempty"`
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
-	ExcludeAttachments *bool         `json:"exclude_attachments,omitempty"`
-	URL                *string       `json:"url,omitempty"`
-	CreatedAt          *string       `json:"created_at,omitempty"`
-	UpdatedAt          *string       `json:"updated_at,omitempty"`
-	Repositories       []*Repository `json:"repositories,omitempty"`
+	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+	// ExcludeReleases indicates whether releases are excluded from the
+	// migration.
+	ExcludeReleases *bool `json:"exclude_releases,omitempty"`
+	// ExcludeGitData indicates whether repository git data is excluded from
+	// the migration.
+	ExcludeGitData *bool `json:"exclude_git_data,omitempty"`
+	// ExcludeMetadata indicates whether metadata is excluded from the
+	// migration, leaving only git source.
+	ExcludeMetadata *bool `json:"exclude_metadata,omitempty"`
+	// ExcludeOwnerProjects indicates whether projects owned by the
+	// organization or user are excluded from the migration.
+	ExcludeOwnerProjects *bool `json:"exclude_owner_projects,omitempty"`
+	// OrgMetadataOnly indicates whether only the organization metadata is
+	// migrated, leaving out repositories.
+	OrgMetadataOnly *bool `json:"org_metadata_only,omitempty"`
+	// ArchiveURL is the API URL of the migration archive.
+	ArchiveURL   *string       `json:"archive_url,omitempty"`
+	URL          *string       `json:"url,omitempty"`
+	CreatedAt    *string       `json:"created_at,omitempty"`
+	UpdatedAt    *string       `json:"updated_at,omitempty"`
+	Repositories []*Repository `json:"repositories,omitempty"`
 }
 
 func (m Migration) String() string {
@@ -55,6 +73,26 @@ type MigrationOptions struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments bool
+
+	// ExcludeReleases indicates whether releases should be excluded from
+	// the migration (to reduce migration archive file size).
+	ExcludeReleases bool
+
+	// ExcludeGitData indicates whether repository git data should be
+	// excluded from the migration.
+	ExcludeGitData bool
+
+	// ExcludeMetadata indicates whether metadata should be excluded from the
+	// migration, so that only git source is included.
+	ExcludeMetadata bool
+
+	// ExcludeOwnerProjects indicates whether projects owned by the
+	// organization or user should be excluded from the migration.
+	ExcludeOwnerProjects bool
+
+	// OrgMetadataOnly indicates whether only the organization metadata
+	// should be migrated. The repositories list must then be empty.
+	OrgMetadataOnly bool
 }
 
 // startMigration represents the body of a StartMigration request.
@@ -69,6 +107,12 @@ type startMigration struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+
+	ExcludeReleases      *bool `json:"exclude_releases,omitempty"`
+	ExcludeGitData       *bool `json:"exclude_git_data,omitempty"`
+	ExcludeMetadata      *bool `json:"exclude_metadata,omitempty"`
+	ExcludeOwnerProjects *bool `json:"exclude_owner_projects,omitempty"`
+	OrgMetadataOnly      *bool `json:"org_metadata_only,omitempty"`
 }
 
 // StartMigration starts the generation of a migration archive.
@@ -82,6 +126,11 @@ func (s *MigrationService) StartMigration(ctx context.Context, org string, repos
 	if opts != nil {
 		body.LockRepositories = Bool(opts.LockRepositories)
 		body.ExcludeAttachments = Bool(opts.ExcludeAttachments)
+		body.ExcludeReleases = Bool(opts.ExcludeReleases)
+		body.ExcludeGitData = Bool(opts.ExcludeGitData)
+		body.ExcludeMetadata = Bool(opts.ExcludeMetadata)
+		body.ExcludeOwnerProjects = Bool(opts.ExcludeOwnerProjects)
+		body.OrgMetadataOnly = Bool(opts.OrgMetadataOnly)
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
@@ -189,6 +238,74 @@ func (s *MigrationService) MigrationArchiveURL(ctx context.Context, org string,
 	return loc, nil
 }
 
+// MigrationArchiveProgress reports how much of a migration archive has been
+// read. total is -1 when the size of the archive is unknown.
+type MigrationArchiveProgress func(read, total int64)
+
+// DownloadMigrationArchive downloads a migration archive.
+// id is the migration ID.
+//
+// It returns an io.ReadCloser that streams the archive, so that large
+// archives are never held in memory. It is the caller's responsibility to
+// close the ReadCloser. The archive is downloaded from the location returned
+// by MigrationArchiveURL using followRedirectsClient, which must not carry
+// GitHub credentials; if it is nil, http.DefaultClient is used. If progress
+// is not nil, it is called after every read from the archive.
+//
+// GitHub API docs: https://docs.github.com/en/rest/migrations/orgs#download-an-organization-migration-archive
+func (s *MigrationService) DownloadMigrationArchive(ctx context.Context, org string, id int64, followRedirectsClient *http.Client, progress MigrationArchiveProgress) (io.ReadCloser, error) {
+	url, err := s.MigrationArchiveURL(ctx, org, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return downloadMigrationArchive(ctx, followRedirectsClient, url, progress)
+}
+
+func downloadMigrationArchive(ctx context.Context, followRedirectsClient *http.Client, url string, progress MigrationArchiveProgress) (io.ReadCloser, error) {
+	if followRedirectsClient == nil {
+		followRedirectsClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = withContext(ctx, req)
+	req.Header.Set("Accept", "*/*")
+
+	resp, err := followRedirectsClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	if progress == nil {
+		return resp.Body, nil
+	}
+	return &migrationArchiveReader{ReadCloser: resp.Body, total: resp.ContentLength, progress: progress}, nil
+}
+
+// migrationArchiveReader reports the progress of reading a migration archive.
+type migrationArchiveReader struct {
+	io.ReadCloser
+	read     int64
+	total    int64
+	progress MigrationArchiveProgress
+}
+
+func (r *migrationArchiveReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.read += int64(n)
+		r.progress(r.read, r.total)
+	}
+	return n, err
+}
+
 // DeleteMigration deletes a previous migration archive.
 // id is the migration ID.
 //
@@ -182,7 +182,10 @@ func (s *MigrationService) ImportProgress(ctx context.Context, owner, repo strin
 	return out, resp, nil
 }
 
-// UpdateImport initiates a repository import.
+// UpdateImport updates an ongoing repository import. Use it to provide
+// VCSUsername and VCSPassword when the import status is auth_failed or
+// detection_needs_auth, or to select one of the ProjectChoices when it is
+// detection_found_multiple. An Import with only VCSURL restarts the import.
 //
 // GitHub API docs: https://docs.github.com/en/rest/migrations/source-imports#update-an-import
 func (s *MigrationService) UpdateImport(ctx context.Context, owner, repo string, in *Import) (*Import, *Response, error) {
@@ -216,6 +219,20 @@ func (s *MigrationService) UpdateImport(ctx context.Context, owner, repo string,
 // GitHub API docs: https://docs.github.com/en/rest/migrations/source-imports#get-commit-authors
 func (s *MigrationService) CommitAuthors(ctx context.Context, owner, repo string) ([]*SourceImportAuthor, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/import/authors", owner, repo)
+	return s.commitAuthors(ctx, u)
+}
+
+// CommitAuthorsSince gets the authors mapped from the original repository
+// whose ID is greater than since, so that new authors can be picked up while
+// an import is in progress.
+//
+// GitHub API docs: https://docs.github.com/en/rest/migrations/source-imports#get-commit-authors
+func (s *MigrationService) CommitAuthorsSince(ctx context.Context, owner, repo string, since int64) ([]*SourceImportAuthor, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/import/authors?since=%v", owner, repo, since)
+	return s.commitAuthors(ctx, u)
+}
+
+func (s *MigrationService) commitAuthors(ctx context.Context, u string) ([]*SourceImportAuthor, *Response, error) {
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
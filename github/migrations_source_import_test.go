@@ -184,6 +184,41 @@ func TestMigrationService_CommitAuthors(t *testing.T) {
 	})
 }
 
+func TestMigrationService_CommitAuthorsSince(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/import/authors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"since": "1"})
+		fmt.Fprint(w, `[{"id":2,"name":"b"}]`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Migrations.CommitAuthorsSince(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("CommitAuthorsSince returned error: %v", err)
+	}
+	want := []*SourceImportAuthor{{ID: Int64(2), Name: String("b")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("CommitAuthorsSince = %+v, want %+v", got, want)
+	}
+
+	const methodName = "CommitAuthorsSince"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Migrations.CommitAuthorsSince(ctx, "\n", "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Migrations.CommitAuthorsSince(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestMigrationService_MapCommitAuthor(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"testing"
@@ -160,6 +161,76 @@ func TestMigrationService_MigrationArchiveURL(t *testing.T) {
 	})
 }
 
+func TestMigrationService_DownloadMigrationArchive(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeMigrationsPreview)
+
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "*/*")
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization header = %q, want none", got)
+		}
+
+		w.Header().Set("Content-Length", "16")
+		w.Write([]byte("0123456789abcdef"))
+	})
+
+	var read, total int64
+	progress := func(r, t int64) { read, total = r, t }
+
+	ctx := context.Background()
+	rc, err := client.Migrations.DownloadMigrationArchive(ctx, "o", 1, http.DefaultClient, progress)
+	if err != nil {
+		t.Fatalf("DownloadMigrationArchive returned error: %v", err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("reading archive returned error: %v", err)
+	}
+	if want := "0123456789abcdef"; string(data) != want {
+		t.Errorf("DownloadMigrationArchive = %q, want %q", data, want)
+	}
+	if read != 16 || total != 16 {
+		t.Errorf("DownloadMigrationArchive progress = %v/%v, want 16/16", read, total)
+	}
+}
+
+func TestMigrationService_DownloadMigrationArchive_downloadError(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	rc, err := client.Migrations.DownloadMigrationArchive(ctx, "o", 1, nil, nil)
+	if err == nil {
+		t.Error("DownloadMigrationArchive returned no error, want one")
+	}
+	if rc != nil {
+		t.Errorf("DownloadMigrationArchive = %#v, want nil", rc)
+	}
+
+	const methodName = "DownloadMigrationArchive"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Migrations.DownloadMigrationArchive(ctx, "\n", -1, nil, nil)
+		return err
+	})
+}
+
 func TestMigrationService_DeleteMigration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -286,6 +357,30 @@ func TestMigration_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestMigrationService_StartMigration_excludeOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/migrations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"repositories":["r"],"lock_repositories":true,"exclude_attachments":true,"exclude_releases":true,"exclude_git_data":false,"exclude_metadata":false,"exclude_owner_projects":true,"org_metadata_only":false}`+"\n")
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(migrationJSON)
+	})
+
+	opt := &MigrationOptions{
+		LockRepositories:     true,
+		ExcludeAttachments:   true,
+		ExcludeReleases:      true,
+		ExcludeOwnerProjects: true,
+	}
+	ctx := context.Background()
+	if _, _, err := client.Migrations.StartMigration(ctx, "o", []string{"r"}, opt); err != nil {
+		t.Errorf("StartMigration returned error: %v", err)
+	}
+}
+
 func TestStartMigration_Marshal(t *testing.T) {
 	testJSONMarshal(t, &startMigration{}, "{}")
 
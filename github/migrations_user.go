@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -28,11 +29,25 @@ type UserMigration struct {
 	LockRepositories *bool `json:"lock_repositories,omitempty"`
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
-	ExcludeAttachments *bool         `json:"exclude_attachments,omitempty"`
-	URL                *string       `json:"url,omitempty"`
-	CreatedAt          *string       `json:"created_at,omitempty"`
-	UpdatedAt          *string       `json:"updated_at,omitempty"`
-	Repositories       []*Repository `json:"repositories,omitempty"`
+	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+	// ExcludeReleases indicates whether releases are excluded from the
+	// migration.
+	ExcludeReleases *bool `json:"exclude_releases,omitempty"`
+	// ExcludeGitData indicates whether repository git data is excluded from
+	// the migration.
+	ExcludeGitData *bool `json:"exclude_git_data,omitempty"`
+	// ExcludeMetadata indicates whether metadata is excluded from the
+	// migration, leaving only git source.
+	ExcludeMetadata *bool `json:"exclude_metadata,omitempty"`
+	// ExcludeOwnerProjects indicates whether projects owned by the
+	// organization or user are excluded from the migration.
+	ExcludeOwnerProjects *bool `json:"exclude_owner_projects,omitempty"`
+	// ArchiveURL is the API URL of the migration archive.
+	ArchiveURL   *string       `json:"archive_url,omitempty"`
+	URL          *string       `json:"url,omitempty"`
+	CreatedAt    *string       `json:"created_at,omitempty"`
+	UpdatedAt    *string       `json:"updated_at,omitempty"`
+	Repositories []*Repository `json:"repositories,omitempty"`
 }
 
 func (m UserMigration) String() string {
@@ -48,6 +63,22 @@ type UserMigrationOptions struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments bool
+
+	// ExcludeReleases indicates whether releases should be excluded from
+	// the migration (to reduce migration archive file size).
+	ExcludeReleases bool
+
+	// ExcludeGitData indicates whether repository git data should be
+	// excluded from the migration.
+	ExcludeGitData bool
+
+	// ExcludeMetadata indicates whether metadata should be excluded from the
+	// migration, so that only git source is included.
+	ExcludeMetadata bool
+
+	// ExcludeOwnerProjects indicates whether projects owned by the
+	// organization or user should be excluded from the migration.
+	ExcludeOwnerProjects bool
 }
 
 // startUserMigration represents the body of a StartMigration request.
@@ -62,6 +93,11 @@ type startUserMigration struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+
+	ExcludeReleases      *bool `json:"exclude_releases,omitempty"`
+	ExcludeGitData       *bool `json:"exclude_git_data,omitempty"`
+	ExcludeMetadata      *bool `json:"exclude_metadata,omitempty"`
+	ExcludeOwnerProjects *bool `json:"exclude_owner_projects,omitempty"`
 }
 
 // StartUserMigration starts the generation of a migration archive.
@@ -75,6 +111,10 @@ func (s *MigrationService) StartUserMigration(ctx context.Context, repos []strin
 	if opts != nil {
 		body.LockRepositories = Bool(opts.LockRepositories)
 		body.ExcludeAttachments = Bool(opts.ExcludeAttachments)
+		body.ExcludeReleases = Bool(opts.ExcludeReleases)
+		body.ExcludeGitData = Bool(opts.ExcludeGitData)
+		body.ExcludeMetadata = Bool(opts.ExcludeMetadata)
+		body.ExcludeOwnerProjects = Bool(opts.ExcludeOwnerProjects)
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
@@ -162,6 +202,9 @@ func (s *MigrationService) UserMigrationArchiveURL(ctx context.Context, id int64
 
 	m := &UserMigration{}
 
+	s.client.clientMu.Lock()
+	defer s.client.clientMu.Unlock()
+
 	var loc string
 	originalRedirect := s.client.client.CheckRedirect
 	s.client.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
@@ -175,10 +218,29 @@ func (s *MigrationService) UserMigrationArchiveURL(ctx context.Context, id int64
 	if err == nil {
 		return "", errors.New("expected redirect, none provided")
 	}
+	if resp == nil || resp.Header.Get("Location") == "" {
+		return "", err
+	}
 	loc = resp.Header.Get("Location")
 	return loc, nil
 }
 
+// DownloadUserMigrationArchive downloads a user migration archive.
+// id is the migration ID.
+//
+// It streams the archive like DownloadMigrationArchive, using
+// followRedirectsClient and reporting progress the same way.
+//
+// GitHub API docs: https://docs.github.com/en/rest/migrations/users#download-a-user-migration-archive
+func (s *MigrationService) DownloadUserMigrationArchive(ctx context.Context, id int64, followRedirectsClient *http.Client, progress MigrationArchiveProgress) (io.ReadCloser, error) {
+	url, err := s.UserMigrationArchiveURL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return downloadMigrationArchive(ctx, followRedirectsClient, url, progress)
+}
+
 // DeleteUserMigration will delete a previous migration archive.
 // id is the migration ID.
 //
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"testing"
@@ -148,6 +149,58 @@ func TestMigrationService_UserMigrationArchiveURL(t *testing.T) {
 	}
 }
 
+func TestMigrationService_DownloadUserMigrationArchive(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeMigrationsPreview)
+
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.Write([]byte("archive"))
+	})
+
+	var read int64
+	progress := func(r, _ int64) { read = r }
+
+	ctx := context.Background()
+	rc, err := client.Migrations.DownloadUserMigrationArchive(ctx, 1, nil, progress)
+	if err != nil {
+		t.Fatalf("DownloadUserMigrationArchive returned error: %v", err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("reading archive returned error: %v", err)
+	}
+	if want := "archive"; string(data) != want {
+		t.Errorf("DownloadUserMigrationArchive = %q, want %q", data, want)
+	}
+	if read != 7 {
+		t.Errorf("DownloadUserMigrationArchive progress = %v, want 7", read)
+	}
+}
+
+func TestMigrationService_UserMigrationArchiveURL_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Migrations.UserMigrationArchiveURL(ctx, 1); err == nil {
+		t.Error("UserMigrationArchiveURL returned no error, want one")
+	}
+}
+
 func TestMigrationService_DeleteUserMigration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
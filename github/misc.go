@@ -9,7 +9,19 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Markdown rendering modes, for use in MarkdownOptions.Mode.
+const (
+	MarkdownModeMarkdown = "markdown"
+	MarkdownModeGFM      = "gfm"
 )
 
 // MarkdownOptions specifies optional parameters to the Markdown method.
@@ -65,7 +77,93 @@ func (c *Client) Markdown(ctx context.Context, text string, opts *MarkdownOption
 	return buf.String(), resp, nil
 }
 
-// ListEmojis returns the emojis available to use on GitHub.
+// MarkdownRaw renders a Markdown document in raw mode, sending it as plain
+// text rather than JSON. Raw mode always renders plain Markdown, as with the
+// "markdown" mode, and does not support a repository context.
+//
+// GitHub API docs: https://docs.github.com/en/rest/markdown/markdown#render-a-markdown-document-in-raw-mode
+func (c *Client) MarkdownRaw(ctx context.Context, text string) (string, *Response, error) {
+	req, err := c.NewFormRequest("markdown/raw", strings.NewReader(text))
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+
+	buf := new(bytes.Buffer)
+	resp, err := c.Do(ctx, req, buf)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return buf.String(), resp, nil
+}
+
+// defaultMarkdownBatchConcurrency is the number of documents MarkdownBatch
+// renders at once by default.
+const defaultMarkdownBatchConcurrency = 4
+
+// MarkdownBatchOptions specifies optional parameters to the MarkdownBatch
+// method.
+type MarkdownBatchOptions struct {
+	MarkdownOptions
+
+	// Concurrency is the maximum number of documents rendered at once.
+	// Defaults to 4.
+	Concurrency int
+}
+
+// MarkdownBatchResult is the result of rendering one document with
+// MarkdownBatch.
+type MarkdownBatchResult struct {
+	// HTML is the rendered document.
+	HTML string
+
+	// Err is the error rendering the document failed with, if any.
+	Err error
+}
+
+// MarkdownBatch renders many Markdown documents with bounded concurrency,
+// using the same options for each of them. A failure to render one document
+// does not stop the others, and is reported in its MarkdownBatchResult. The
+// results are returned in the order of texts. The returned error is only set
+// if ctx is done.
+func (c *Client) MarkdownBatch(ctx context.Context, texts []string, opts *MarkdownBatchOptions) ([]*MarkdownBatchResult, error) {
+	concurrency := defaultMarkdownBatchConcurrency
+	var markdownOpts *MarkdownOptions
+	if opts != nil {
+		if opts.Concurrency > 0 {
+			concurrency = opts.Concurrency
+		}
+		markdownOpts = &opts.MarkdownOptions
+	}
+
+	results := make([]*MarkdownBatchResult, len(texts))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, text := range texts {
+		results[i] = &MarkdownBatchResult{}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *MarkdownBatchResult, text string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result.HTML, _, result.Err = c.Markdown(ctx, text, markdownOpts)
+		}(results[i], text)
+	}
+	wg.Wait()
+
+	return results, ctx.Err()
+}
+
+// ListEmojis returns the emojis available to use on GitHub, by name and
+// image URL.
+//
+// The client caches the emojis and revalidates them with the ETag of the
+// previous response, so that repeated calls that GitHub answers with
+// 304 Not Modified return the cached emojis without counting against the
+// rate limit.
 //
 // GitHub API docs: https://docs.github.com/en/rest/emojis/
 func (c *Client) ListEmojis(ctx context.Context) (map[string]string, *Response, error) {
@@ -74,15 +172,102 @@ func (c *Client) ListEmojis(ctx context.Context) (map[string]string, *Response,
 		return nil, nil, err
 	}
 
+	c.emojiMu.Lock()
+	etag, cached := c.emojiETag, c.emojis
+	c.emojiMu.Unlock()
+	if etag != "" && cached != nil {
+		req.Header.Set("If-None-Match", etag)
+	}
+
 	var emoji map[string]string
 	resp, err := c.Do(ctx, req, &emoji)
+	if resp != nil && resp.StatusCode == http.StatusNotModified && cached != nil {
+		return copyEmojis(cached), resp, nil
+	}
 	if err != nil {
 		return nil, resp, err
 	}
 
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		c.emojiMu.Lock()
+		c.emojiETag, c.emojis = etag, copyEmojis(emoji)
+		c.emojiMu.Unlock()
+	}
+
 	return emoji, resp, nil
 }
 
+func copyEmojis(emojis map[string]string) map[string]string {
+	m := make(map[string]string, len(emojis))
+	for name, imageURL := range emojis {
+		m[name] = imageURL
+	}
+	return m
+}
+
+// EmojiUnicode returns the unicode characters of the emoji whose image is
+// at imageURL, as returned by ListEmojis. It reports false for emojis that are
+// specific to GitHub, such as :octocat:, which have no unicode equivalent.
+func EmojiUnicode(imageURL string) (string, bool) {
+	u, err := url.Parse(imageURL)
+	if err != nil || path.Base(path.Dir(u.Path)) != "unicode" {
+		return "", false
+	}
+
+	name := strings.TrimSuffix(path.Base(u.Path), path.Ext(u.Path))
+	var b strings.Builder
+	for _, code := range strings.Split(name, "-") {
+		r, err := strconv.ParseUint(code, 16, 32)
+		if err != nil || !utf8.ValidRune(rune(r)) {
+			return "", false
+		}
+		b.WriteRune(rune(r))
+	}
+	return b.String(), true
+}
+
+// ExpandEmojis replaces the :shortcode: occurrences in text that name one of
+// emojis, as returned by ListEmojis, with the result of expand called with
+// the emoji name and image URL. Other text is left unchanged. If expand is
+// nil, emojis are expanded to their unicode characters, or to their image
+// URL if they have none.
+func ExpandEmojis(text string, emojis map[string]string, expand func(name, imageURL string) string) string {
+	if expand == nil {
+		expand = func(name, imageURL string) string {
+			if s, ok := EmojiUnicode(imageURL); ok {
+				return s
+			}
+			return imageURL
+		}
+	}
+
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(text, ':')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(text[start+1:], ':')
+		if end < 0 {
+			break
+		}
+		end += start + 1
+
+		imageURL, ok := emojis[text[start+1:end]]
+		if !ok {
+			// The closing colon may open the next shortcode.
+			b.WriteString(text[:end])
+			text = text[end:]
+			continue
+		}
+		b.WriteString(text[:start])
+		b.WriteString(expand(text[start+1:end], imageURL))
+		text = text[end+1:]
+	}
+	b.WriteString(text)
+	return b.String()
+}
+
 // CodeOfConduct represents a code of conduct.
 type CodeOfConduct struct {
 	Name *string `json:"name,omitempty"`
@@ -171,7 +356,8 @@ type APIMeta struct {
 	// Dependabot will originate from.
 	Dependabot []string `json:"dependabot,omitempty"`
 
-	// A map of algorithms to SSH key fingerprints.
+	// A map of algorithms to SSH key fingerprints. The keys are one of the
+	// SSHKeyFingerprint constants.
 	SSHKeyFingerprints map[string]string `json:"ssh_key_fingerprints,omitempty"`
 
 	// An array of SSH keys.
@@ -184,8 +370,36 @@ type APIMeta struct {
 	// An array of IP addresses in CIDR format specifying the addresses
 	// which serve GitHub APIs.
 	API []string `json:"api,omitempty"`
+
+	// An array of IP addresses in CIDR format specifying the addresses
+	// which serve GitHub Packages.
+	Packages []string `json:"packages,omitempty"`
+
+	// An array of IP addresses in CIDR format specifying the addresses
+	// GitHub Actions macOS runners will originate from.
+	ActionsMacos []string `json:"actions_macos,omitempty"`
+
+	// The domains used by GitHub services.
+	Domains *APIMetaDomains `json:"domains,omitempty"`
 }
 
+// APIMetaDomains represents the domains used by GitHub services, which can
+// be added to an allowlist.
+type APIMetaDomains struct {
+	Website    []string `json:"website,omitempty"`
+	Codespaces []string `json:"codespaces,omitempty"`
+	Copilot    []string `json:"copilot,omitempty"`
+	Packages   []string `json:"packages,omitempty"`
+	Actions    []string `json:"actions,omitempty"`
+}
+
+// Keys of APIMeta.SSHKeyFingerprints.
+const (
+	SSHKeyFingerprintRSA     = "SHA256_RSA"
+	SSHKeyFingerprintECDSA   = "SHA256_ECDSA"
+	SSHKeyFingerprintED25519 = "SHA256_ED25519"
+)
+
 // APIMeta returns information about GitHub.com, the service. Or, if you access
 // this endpoint on your organization’s GitHub Enterprise installation, this
 // endpoint provides information about that installation.
@@ -208,6 +422,8 @@ func (c *Client) APIMeta(ctx context.Context) (*APIMeta, *Response, error) {
 
 // Octocat returns an ASCII art octocat with the specified message in a speech
 // bubble. If message is empty, a random zen phrase is used.
+//
+// GitHub API docs: https://docs.github.com/en/rest/meta#get-octocat
 func (c *Client) Octocat(ctx context.Context, message string) (string, *Response, error) {
 	u := "octocat"
 	if message != "" {
@@ -231,6 +447,8 @@ func (c *Client) Octocat(ctx context.Context, message string) (string, *Response
 // Zen returns a random line from The Zen of GitHub.
 //
 // see also: http://warpspire.com/posts/taste/
+//
+// GitHub API docs: https://docs.github.com/en/rest/meta#get-the-zen-of-github
 func (c *Client) Zen(ctx context.Context) (string, *Response, error) {
 	req, err := c.NewRequest("GET", "zen", nil)
 	if err != nil {
@@ -246,6 +464,25 @@ func (c *Client) Zen(ctx context.Context) (string, *Response, error) {
 	return buf.String(), resp, nil
 }
 
+// APIVersions returns the REST API versions supported by GitHub, as dates
+// such as "2022-11-28". They can be set in the X-GitHub-Api-Version header.
+//
+// GitHub API docs: https://docs.github.com/en/rest/meta#get-all-api-versions
+func (c *Client) APIVersions(ctx context.Context) ([]string, *Response, error) {
+	req, err := c.NewRequest("GET", "versions", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []string
+	resp, err := c.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
 // ServiceHook represents a hook that has configuration settings, a list of
 // available events, and default events.
 type ServiceHook struct {
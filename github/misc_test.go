@@ -10,7 +10,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -61,6 +63,95 @@ func TestMarkdown(t *testing.T) {
 	})
 }
 
+func TestMarkdownRaw(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/markdown/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "text/plain")
+		testBody(t, r, "# text #")
+		fmt.Fprint(w, `<h1>text</h1>`)
+	})
+
+	ctx := context.Background()
+	md, _, err := client.MarkdownRaw(ctx, "# text #")
+	if err != nil {
+		t.Errorf("MarkdownRaw returned error: %v", err)
+	}
+
+	if want := "<h1>text</h1>"; want != md {
+		t.Errorf("MarkdownRaw returned %+v, want %+v", md, want)
+	}
+
+	const methodName = "MarkdownRaw"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.MarkdownRaw(ctx, "# text #")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestMarkdownBatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	mux.HandleFunc("/markdown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		var v struct{ Text, Mode, Context string }
+		json.NewDecoder(r.Body).Decode(&v)
+		if v.Mode != "gfm" || v.Context != "o/r" {
+			t.Errorf("Request body = %+v, want gfm mode and o/r context", v)
+		}
+		if v.Text == "bad" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprintf(w, "<p>%v</p>", v.Text)
+	})
+
+	ctx := context.Background()
+	results, err := client.MarkdownBatch(ctx, []string{"a", "bad", "c", "d"}, &MarkdownBatchOptions{
+		MarkdownOptions: MarkdownOptions{Mode: MarkdownModeGFM, Context: "o/r"},
+		Concurrency:     2,
+	})
+	if err != nil {
+		t.Fatalf("MarkdownBatch returned error: %v", err)
+	}
+
+	if len(results) != 4 {
+		t.Fatalf("MarkdownBatch returned %v results, want 4", len(results))
+	}
+	for i, want := range []string{"<p>a</p>", "", "<p>c</p>", "<p>d</p>"} {
+		if results[i].HTML != want {
+			t.Errorf("MarkdownBatch result %v = %q, want %q", i, results[i].HTML, want)
+		}
+		if gotErr := results[i].Err != nil; gotErr != (i == 1) {
+			t.Errorf("MarkdownBatch result %v error = %v", i, results[i].Err)
+		}
+	}
+	if maxInFlight > 2 {
+		t.Errorf("MarkdownBatch rendered %v documents at once, want at most 2", maxInFlight)
+	}
+}
+
 func TestListEmojis(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -91,6 +182,96 @@ func TestListEmojis(t *testing.T) {
 	})
 }
 
+func TestListEmojis_cached(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/emojis", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls == 1 {
+			testHeader(t, r, "If-None-Match", "")
+		} else {
+			testHeader(t, r, "If-None-Match", `"e"`)
+		}
+		if calls == 2 {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"e"`)
+		fmt.Fprint(w, `{"+1": "+1.png"}`)
+	})
+
+	ctx := context.Background()
+	want := map[string]string{"+1": "+1.png"}
+	for i := 0; i < 3; i++ {
+		emoji, _, err := client.ListEmojis(ctx)
+		if err != nil {
+			t.Fatalf("ListEmojis call %v returned error: %v", i, err)
+		}
+		if !cmp.Equal(want, emoji) {
+			t.Errorf("ListEmojis call %v returned %+v, want %+v", i, emoji, want)
+		}
+		emoji["+1"] = "modified"
+	}
+	if calls != 3 {
+		t.Errorf("ListEmojis made %v requests, want 3", calls)
+	}
+}
+
+func TestEmojiUnicode(t *testing.T) {
+	tests := []struct {
+		url    string
+		want   string
+		wantOK bool
+	}{
+		{"https://github.githubassets.com/images/icons/emoji/unicode/1f44d.png?v8", "\U0001f44d", true},
+		{"https://github.githubassets.com/images/icons/emoji/unicode/1f1fa-1f1f8.png?v8", "\U0001f1fa\U0001f1f8", true},
+		{"https://github.githubassets.com/images/icons/emoji/octocat.png?v8", "", false},
+		{"https://github.githubassets.com/images/icons/emoji/unicode/zz.png?v8", "", false},
+		{"%", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := EmojiUnicode(tt.url)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("EmojiUnicode(%q) = %q, %v, want %q, %v", tt.url, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestExpandEmojis(t *testing.T) {
+	emojis := map[string]string{
+		"+1":      "https://github.githubassets.com/images/icons/emoji/unicode/1f44d.png?v8",
+		"octocat": "https://github.githubassets.com/images/icons/emoji/octocat.png?v8",
+	}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"", ""},
+		{"no emojis", "no emojis"},
+		{"great :+1:", "great \U0001f44d"},
+		{":octocat: at 12:30", "https://github.githubassets.com/images/icons/emoji/octocat.png?v8 at 12:30"},
+		{"a:b:+1: :unknown: ::", "a:b\U0001f44d :unknown: ::"},
+	}
+
+	for _, tt := range tests {
+		if got := ExpandEmojis(tt.text, emojis, nil); got != tt.want {
+			t.Errorf("ExpandEmojis(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	got := ExpandEmojis("hi :octocat:", emojis, func(name, imageURL string) string {
+		return fmt.Sprintf("![%v](%v)", name, imageURL)
+	})
+	if want := "hi ![octocat](https://github.githubassets.com/images/icons/emoji/octocat.png?v8)"; got != want {
+		t.Errorf("ExpandEmojis = %q, want %q", got, want)
+	}
+}
+
 func TestListCodesOfConduct(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -192,6 +373,9 @@ func TestAPIMeta_Marshal(t *testing.T) {
 		SSHKeys:                          []string{"k"},
 		API:                              []string{"a"},
 		Web:                              []string{"w"},
+		Packages:                         []string{"pk"},
+		ActionsMacos:                     []string{"am"},
+		Domains:                          &APIMetaDomains{Website: []string{"github.com"}, Copilot: []string{"*.githubcopilot.com"}},
 	}
 	want := `{
 		"hooks":["h"],
@@ -204,7 +388,10 @@ func TestAPIMeta_Marshal(t *testing.T) {
 		"ssh_key_fingerprints":{"a":"f"},
 		"ssh_keys":["k"],
 		"api":["a"],
-		"web":["w"]
+		"web":["w"],
+		"packages":["pk"],
+		"actions_macos":["am"],
+		"domains":{"website":["github.com"],"copilot":["*.githubcopilot.com"]}
 	}`
 
 	testJSONMarshal(t, a, want)
@@ -216,7 +403,7 @@ func TestAPIMeta(t *testing.T) {
 
 	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"web":["w"],"api":["a"],"hooks":["h"], "git":["g"], "pages":["p"], "importer":["i"], "actions":["a"], "dependabot":["d"], "verifiable_password_authentication": true}`)
+		fmt.Fprint(w, `{"web":["w"],"api":["a"],"hooks":["h"], "git":["g"], "pages":["p"], "importer":["i"], "actions":["a"], "dependabot":["d"], "verifiable_password_authentication": true, "ssh_key_fingerprints":{"SHA256_ED25519":"f"}, "ssh_keys":["k"], "packages":["pk"], "domains":{"codespaces":["*.github.dev"]}}`)
 	})
 
 	ctx := context.Background()
@@ -234,7 +421,11 @@ func TestAPIMeta(t *testing.T) {
 		Dependabot: []string{"d"},
 		API:        []string{"a"},
 		Web:        []string{"w"},
+		SSHKeys:    []string{"k"},
+		Packages:   []string{"pk"},
+		Domains:    &APIMetaDomains{Codespaces: []string{"*.github.dev"}},
 
+		SSHKeyFingerprints:               map[string]string{SSHKeyFingerprintED25519: "f"},
 		VerifiablePasswordAuthentication: Bool(true),
 	}
 	if !cmp.Equal(want, meta) {
@@ -251,6 +442,36 @@ func TestAPIMeta(t *testing.T) {
 	})
 }
 
+func TestAPIVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `["2022-11-28","2023-01-01"]`)
+	})
+
+	ctx := context.Background()
+	versions, _, err := client.APIVersions(ctx)
+	if err != nil {
+		t.Errorf("APIVersions returned error: %v", err)
+	}
+
+	want := []string{"2022-11-28", "2023-01-01"}
+	if !cmp.Equal(versions, want) {
+		t.Errorf("APIVersions returned %+v, want %+v", versions, want)
+	}
+
+	const methodName = "APIVersions"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.APIVersions(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestOctocat(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,82 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// AnnouncementBanner represents an announcement banner shown to the members
+// of an organization or enterprise.
+type AnnouncementBanner struct {
+	// Announcement is the text of the banner. GitHub Flavored Markdown is supported.
+	Announcement *string `json:"announcement,omitempty"`
+	// ExpiresAt is the time the banner stops being shown. A nil ExpiresAt
+	// means the banner never expires.
+	ExpiresAt *Timestamp `json:"expires_at,omitempty"`
+	// UserDismissible specifies whether users can dismiss the banner.
+	UserDismissible *bool `json:"user_dismissible,omitempty"`
+}
+
+// GetAnnouncementBanner gets the announcement banner currently set for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/announcement-banners/organizations#get-announcement-banner-for-organization
+func (s *OrganizationsService) GetAnnouncementBanner(ctx context.Context, org string) (*AnnouncementBanner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/announcement", org)
+	return getAnnouncementBanner(ctx, s.client, u)
+}
+
+// SetAnnouncementBanner sets the announcement banner of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/announcement-banners/organizations#set-announcement-banner-for-organization
+func (s *OrganizationsService) SetAnnouncementBanner(ctx context.Context, org string, banner *AnnouncementBanner) (*AnnouncementBanner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/announcement", org)
+	return setAnnouncementBanner(ctx, s.client, u, banner)
+}
+
+// RemoveAnnouncementBanner removes the announcement banner of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/announcement-banners/organizations#remove-announcement-banner-from-organization
+func (s *OrganizationsService) RemoveAnnouncementBanner(ctx context.Context, org string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/announcement", org)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func getAnnouncementBanner(ctx context.Context, client *Client, u string) (*AnnouncementBanner, *Response, error) {
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	banner := new(AnnouncementBanner)
+	resp, err := client.Do(ctx, req, banner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return banner, resp, nil
+}
+
+func setAnnouncementBanner(ctx context.Context, client *Client, u string, banner *AnnouncementBanner) (*AnnouncementBanner, *Response, error) {
+	req, err := client.NewRequest("PATCH", u, banner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(AnnouncementBanner)
+	resp, err := client.Do(ctx, req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
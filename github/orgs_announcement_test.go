@@ -0,0 +1,115 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_GetAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"announcement":"Maintenance tonight","expires_at":`+referenceTimeStr+`,"user_dismissible":true}`)
+	})
+
+	ctx := context.Background()
+	banner, _, err := client.Organizations.GetAnnouncementBanner(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.GetAnnouncementBanner returned error: %v", err)
+	}
+
+	want := &AnnouncementBanner{
+		Announcement:    String("Maintenance tonight"),
+		ExpiresAt:       &Timestamp{referenceTime},
+		UserDismissible: Bool(true),
+	}
+	if !cmp.Equal(banner, want) {
+		t.Errorf("Organizations.GetAnnouncementBanner returned %+v, want %+v", banner, want)
+	}
+
+	const methodName = "GetAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetAnnouncementBanner(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetAnnouncementBanner(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_SetAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"announcement":"Maintenance tonight","user_dismissible":false}`+"\n")
+		fmt.Fprint(w, `{"announcement":"Maintenance tonight","expires_at":null,"user_dismissible":false}`)
+	})
+
+	ctx := context.Background()
+	input := &AnnouncementBanner{Announcement: String("Maintenance tonight"), UserDismissible: Bool(false)}
+	banner, _, err := client.Organizations.SetAnnouncementBanner(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Organizations.SetAnnouncementBanner returned error: %v", err)
+	}
+
+	if !cmp.Equal(banner, input) {
+		t.Errorf("Organizations.SetAnnouncementBanner returned %+v, want %+v", banner, input)
+	}
+
+	const methodName = "SetAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.SetAnnouncementBanner(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.SetAnnouncementBanner(ctx, "o", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_RemoveAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.RemoveAnnouncementBanner(ctx, "o"); err != nil {
+		t.Errorf("Organizations.RemoveAnnouncementBanner returned error: %v", err)
+	}
+
+	const methodName = "RemoveAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RemoveAnnouncementBanner(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RemoveAnnouncementBanner(ctx, "o")
+	})
+}
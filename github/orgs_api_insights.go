@@ -0,0 +1,309 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// API Insights report the REST API usage of an organization, broken down by
+// route, subject (such as an app installation or a token) and user. Actor
+// types accepted by the *ByActor methods are "installation", "classic_pat",
+// "fine_grained_pat", "oauth_app" and "github_app_user_to_server".
+//
+// Timestamps are ISO 8601 strings, for example "2023-01-02T15:04:05Z".
+
+// APIInsightsSummaryStatsOptions specifies the parameters to the
+// OrganizationsService.GetSummaryStats methods.
+type APIInsightsSummaryStatsOptions struct {
+	// MinTimestamp is the earliest time to report on. (Required.)
+	MinTimestamp string `url:"min_timestamp"`
+	// MaxTimestamp is the latest time to report on. Defaults to the current time.
+	MaxTimestamp string `url:"max_timestamp,omitempty"`
+}
+
+// APIInsightsTimeStatsOptions specifies the parameters to the
+// OrganizationsService.GetTimeStats methods.
+type APIInsightsTimeStatsOptions struct {
+	// MinTimestamp is the earliest time to report on. (Required.)
+	MinTimestamp string `url:"min_timestamp"`
+	// MaxTimestamp is the latest time to report on. Defaults to the current time.
+	MaxTimestamp string `url:"max_timestamp,omitempty"`
+	// TimestampIncrement is the width of each reported time bucket, such as
+	// "5m", "10m", "1h", "4h", "3d" or "1w". (Required.)
+	TimestampIncrement string `url:"timestamp_increment"`
+}
+
+// APIInsightsRouteStatsOptions specifies the parameters to the
+// OrganizationsService.GetRouteStatsByActor method.
+type APIInsightsRouteStatsOptions struct {
+	// MinTimestamp is the earliest time to report on. (Required.)
+	MinTimestamp string `url:"min_timestamp"`
+	// MaxTimestamp is the latest time to report on. Defaults to the current time.
+	MaxTimestamp string `url:"max_timestamp,omitempty"`
+	// Direction in which to sort. Possible values are "asc" and "desc".
+	Direction string `url:"direction,omitempty"`
+	// Sort specifies the properties to sort by. Possible values are
+	// "last_rate_limited_timestamp", "last_request_timestamp",
+	// "rate_limited_request_count", "http_method", "api_route" and
+	// "total_request_count".
+	Sort []string `url:"sort,omitempty,comma"`
+	// APIRouteSubstring filters by routes containing this string.
+	APIRouteSubstring string `url:"api_route_substring,omitempty"`
+
+	ListOptions
+}
+
+// APIInsightsSubjectStatsOptions specifies the parameters to the
+// OrganizationsService.GetSubjectStats method.
+type APIInsightsSubjectStatsOptions struct {
+	// MinTimestamp is the earliest time to report on. (Required.)
+	MinTimestamp string `url:"min_timestamp"`
+	// MaxTimestamp is the latest time to report on. Defaults to the current time.
+	MaxTimestamp string `url:"max_timestamp,omitempty"`
+	// Direction in which to sort. Possible values are "asc" and "desc".
+	Direction string `url:"direction,omitempty"`
+	// Sort specifies the properties to sort by. Possible values are
+	// "last_rate_limited_timestamp", "last_request_timestamp",
+	// "rate_limited_request_count", "subject_name" and "total_request_count".
+	Sort []string `url:"sort,omitempty,comma"`
+	// SubjectNameSubstring filters by subject names containing this string.
+	SubjectNameSubstring string `url:"subject_name_substring,omitempty"`
+
+	ListOptions
+}
+
+// APIInsightsUserStatsOptions specifies the parameters to the
+// OrganizationsService.GetUserStats method.
+type APIInsightsUserStatsOptions struct {
+	// MinTimestamp is the earliest time to report on. (Required.)
+	MinTimestamp string `url:"min_timestamp"`
+	// MaxTimestamp is the latest time to report on. Defaults to the current time.
+	MaxTimestamp string `url:"max_timestamp,omitempty"`
+	// Direction in which to sort. Possible values are "asc" and "desc".
+	Direction string `url:"direction,omitempty"`
+	// Sort specifies the properties to sort by. Possible values are
+	// "last_rate_limited_timestamp", "last_request_timestamp",
+	// "rate_limited_request_count", "subject_name" and "total_request_count".
+	Sort []string `url:"sort,omitempty,comma"`
+	// ActorNameSubstring filters by actor names containing this string.
+	ActorNameSubstring string `url:"actor_name_substring,omitempty"`
+
+	ListOptions
+}
+
+// APIInsightsSummaryStats represents the total and rate limited request
+// counts of an organization or actor.
+type APIInsightsSummaryStats struct {
+	TotalRequestCount       *int64 `json:"total_request_count,omitempty"`
+	RateLimitedRequestCount *int64 `json:"rate_limited_request_count,omitempty"`
+}
+
+// APIInsightsTimeStats represents the request counts of a time bucket.
+type APIInsightsTimeStats struct {
+	Timestamp               *string `json:"timestamp,omitempty"`
+	TotalRequestCount       *int64  `json:"total_request_count,omitempty"`
+	RateLimitedRequestCount *int64  `json:"rate_limited_request_count,omitempty"`
+}
+
+// APIInsightsRouteStats represents the request counts of an API route.
+type APIInsightsRouteStats struct {
+	HTTPMethod               *string `json:"http_method,omitempty"`
+	APIRoute                 *string `json:"api_route,omitempty"`
+	TotalRequestCount        *int64  `json:"total_request_count,omitempty"`
+	RateLimitedRequestCount  *int64  `json:"rate_limited_request_count,omitempty"`
+	LastRateLimitedTimestamp *string `json:"last_rate_limited_timestamp,omitempty"`
+	LastRequestTimestamp     *string `json:"last_request_timestamp,omitempty"`
+}
+
+// APIInsightsSubjectStats represents the request counts of a subject, such
+// as an app installation or a personal access token.
+type APIInsightsSubjectStats struct {
+	SubjectType              *string `json:"subject_type,omitempty"`
+	SubjectName              *string `json:"subject_name,omitempty"`
+	SubjectID                *int64  `json:"subject_id,omitempty"`
+	TotalRequestCount        *int64  `json:"total_request_count,omitempty"`
+	RateLimitedRequestCount  *int64  `json:"rate_limited_request_count,omitempty"`
+	LastRateLimitedTimestamp *string `json:"last_rate_limited_timestamp,omitempty"`
+	LastRequestTimestamp     *string `json:"last_request_timestamp,omitempty"`
+}
+
+// APIInsightsUserStats represents the request counts of an actor acting on
+// behalf of a user.
+type APIInsightsUserStats struct {
+	ActorType                *string `json:"actor_type,omitempty"`
+	ActorName                *string `json:"actor_name,omitempty"`
+	ActorID                  *int64  `json:"actor_id,omitempty"`
+	IntegrationID            *int64  `json:"integration_id,omitempty"`
+	OAuthApplicationID       *int64  `json:"oauth_application_id,omitempty"`
+	TotalRequestCount        *int64  `json:"total_request_count,omitempty"`
+	RateLimitedRequestCount  *int64  `json:"rate_limited_request_count,omitempty"`
+	LastRateLimitedTimestamp *string `json:"last_rate_limited_timestamp,omitempty"`
+	LastRequestTimestamp     *string `json:"last_request_timestamp,omitempty"`
+}
+
+// GetRouteStatsByActor gets the API request counts of each route called by an actor.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-route-stats-by-actor
+func (s *OrganizationsService) GetRouteStatsByActor(ctx context.Context, org, actorType string, actorID int64, opts *APIInsightsRouteStatsOptions) ([]*APIInsightsRouteStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/route-stats/%v/%v", org, actorType, actorID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stats []*APIInsightsRouteStats
+	resp, err := s.client.Do(ctx, req, &stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
+
+// GetSubjectStats gets the API request counts of each subject, such as an
+// app installation or a personal access token, in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-subject-stats
+func (s *OrganizationsService) GetSubjectStats(ctx context.Context, org string, opts *APIInsightsSubjectStatsOptions) ([]*APIInsightsSubjectStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/subject-stats", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stats []*APIInsightsSubjectStats
+	resp, err := s.client.Do(ctx, req, &stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
+
+// GetSummaryStats gets the API request counts of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-summary-stats
+func (s *OrganizationsService) GetSummaryStats(ctx context.Context, org string, opts *APIInsightsSummaryStatsOptions) (*APIInsightsSummaryStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/summary-stats", org)
+	return s.getSummaryStats(ctx, u, opts)
+}
+
+// GetSummaryStatsByUser gets the API request counts of a user in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-summary-stats-by-user
+func (s *OrganizationsService) GetSummaryStatsByUser(ctx context.Context, org string, userID int64, opts *APIInsightsSummaryStatsOptions) (*APIInsightsSummaryStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/summary-stats/users/%v", org, userID)
+	return s.getSummaryStats(ctx, u, opts)
+}
+
+// GetSummaryStatsByActor gets the API request counts of an actor in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-summary-stats-by-actor
+func (s *OrganizationsService) GetSummaryStatsByActor(ctx context.Context, org, actorType string, actorID int64, opts *APIInsightsSummaryStatsOptions) (*APIInsightsSummaryStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/summary-stats/%v/%v", org, actorType, actorID)
+	return s.getSummaryStats(ctx, u, opts)
+}
+
+func (s *OrganizationsService) getSummaryStats(ctx context.Context, u string, opts *APIInsightsSummaryStatsOptions) (*APIInsightsSummaryStats, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := new(APIInsightsSummaryStats)
+	resp, err := s.client.Do(ctx, req, stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
+
+// GetTimeStats gets the API request counts of an organization over time.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-time-stats
+func (s *OrganizationsService) GetTimeStats(ctx context.Context, org string, opts *APIInsightsTimeStatsOptions) ([]*APIInsightsTimeStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/time-stats", org)
+	return s.getTimeStats(ctx, u, opts)
+}
+
+// GetTimeStatsByUser gets the API request counts of a user in an organization over time.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-time-stats-by-user
+func (s *OrganizationsService) GetTimeStatsByUser(ctx context.Context, org string, userID int64, opts *APIInsightsTimeStatsOptions) ([]*APIInsightsTimeStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/time-stats/users/%v", org, userID)
+	return s.getTimeStats(ctx, u, opts)
+}
+
+// GetTimeStatsByActor gets the API request counts of an actor in an organization over time.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-time-stats-by-actor
+func (s *OrganizationsService) GetTimeStatsByActor(ctx context.Context, org, actorType string, actorID int64, opts *APIInsightsTimeStatsOptions) ([]*APIInsightsTimeStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/time-stats/%v/%v", org, actorType, actorID)
+	return s.getTimeStats(ctx, u, opts)
+}
+
+func (s *OrganizationsService) getTimeStats(ctx context.Context, u string, opts *APIInsightsTimeStatsOptions) ([]*APIInsightsTimeStats, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stats []*APIInsightsTimeStats
+	resp, err := s.client.Do(ctx, req, &stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
+
+// GetUserStats gets the API request counts of each actor acting on behalf of
+// a user in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/api-insights#get-user-stats
+func (s *OrganizationsService) GetUserStats(ctx context.Context, org string, userID int64, opts *APIInsightsUserStatsOptions) ([]*APIInsightsUserStats, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/insights/api/user-stats/%v", org, userID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stats []*APIInsightsUserStats
+	resp, err := s.client.Do(ctx, req, &stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
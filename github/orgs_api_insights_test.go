@@ -0,0 +1,274 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_GetRouteStatsByActor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/insights/api/route-stats/installation/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"min_timestamp":       "2023-01-01T00:00:00Z",
+			"direction":           "desc",
+			"sort":                "total_request_count,api_route",
+			"api_route_substring": "/repos",
+			"per_page":            "10",
+		})
+		fmt.Fprint(w, `[{"http_method":"GET","api_route":"/repos/{owner}/{repo}","total_request_count":544665,"rate_limited_request_count":13,"last_rate_limited_timestamp":"2023-01-02T00:00:00Z","last_request_timestamp":"2023-01-03T00:00:00Z"}]`)
+	})
+
+	ctx := context.Background()
+	opts := &APIInsightsRouteStatsOptions{
+		MinTimestamp:      "2023-01-01T00:00:00Z",
+		Direction:         "desc",
+		Sort:              []string{"total_request_count", "api_route"},
+		APIRouteSubstring: "/repos",
+		ListOptions:       ListOptions{PerPage: 10},
+	}
+	stats, _, err := client.Organizations.GetRouteStatsByActor(ctx, "o", "installation", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.GetRouteStatsByActor returned error: %v", err)
+	}
+
+	want := []*APIInsightsRouteStats{{
+		HTTPMethod:               String("GET"),
+		APIRoute:                 String("/repos/{owner}/{repo}"),
+		TotalRequestCount:        Int64(544665),
+		RateLimitedRequestCount:  Int64(13),
+		LastRateLimitedTimestamp: String("2023-01-02T00:00:00Z"),
+		LastRequestTimestamp:     String("2023-01-03T00:00:00Z"),
+	}}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetRouteStatsByActor returned %+v, want %+v", stats, want)
+	}
+
+	const methodName = "GetRouteStatsByActor"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetRouteStatsByActor(ctx, "\n", "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetRouteStatsByActor(ctx, "o", "installation", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetSubjectStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/insights/api/subject-stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"min_timestamp": "2023-01-01T00:00:00Z", "subject_name_substring": "bot"})
+		fmt.Fprint(w, `[{"subject_type":"installation","subject_name":"bot","subject_id":7,"total_request_count":10,"rate_limited_request_count":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &APIInsightsSubjectStatsOptions{MinTimestamp: "2023-01-01T00:00:00Z", SubjectNameSubstring: "bot"}
+	stats, _, err := client.Organizations.GetSubjectStats(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.GetSubjectStats returned error: %v", err)
+	}
+
+	want := []*APIInsightsSubjectStats{{
+		SubjectType:             String("installation"),
+		SubjectName:             String("bot"),
+		SubjectID:               Int64(7),
+		TotalRequestCount:       Int64(10),
+		RateLimitedRequestCount: Int64(1),
+	}}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetSubjectStats returned %+v, want %+v", stats, want)
+	}
+
+	const methodName = "GetSubjectStats"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetSubjectStats(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetSubjectStats(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetSummaryStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"min_timestamp": "2023-01-01T00:00:00Z", "max_timestamp": "2023-02-01T00:00:00Z"})
+		fmt.Fprint(w, `{"total_request_count":100,"rate_limited_request_count":5}`)
+	}
+	mux.HandleFunc("/orgs/o/insights/api/summary-stats", handler)
+	mux.HandleFunc("/orgs/o/insights/api/summary-stats/users/1", handler)
+	mux.HandleFunc("/orgs/o/insights/api/summary-stats/oauth_app/2", handler)
+
+	ctx := context.Background()
+	opts := &APIInsightsSummaryStatsOptions{MinTimestamp: "2023-01-01T00:00:00Z", MaxTimestamp: "2023-02-01T00:00:00Z"}
+	want := &APIInsightsSummaryStats{TotalRequestCount: Int64(100), RateLimitedRequestCount: Int64(5)}
+
+	stats, _, err := client.Organizations.GetSummaryStats(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.GetSummaryStats returned error: %v", err)
+	}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetSummaryStats returned %+v, want %+v", stats, want)
+	}
+
+	stats, _, err = client.Organizations.GetSummaryStatsByUser(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.GetSummaryStatsByUser returned error: %v", err)
+	}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetSummaryStatsByUser returned %+v, want %+v", stats, want)
+	}
+
+	stats, _, err = client.Organizations.GetSummaryStatsByActor(ctx, "o", "oauth_app", 2, opts)
+	if err != nil {
+		t.Errorf("Organizations.GetSummaryStatsByActor returned error: %v", err)
+	}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetSummaryStatsByActor returned %+v, want %+v", stats, want)
+	}
+
+	const methodName = "GetSummaryStats"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetSummaryStats(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetSummaryStats(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetTimeStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"min_timestamp": "2023-01-01T00:00:00Z", "timestamp_increment": "1h"})
+		fmt.Fprint(w, `[{"timestamp":"2023-01-01T00:00:00Z","total_request_count":10,"rate_limited_request_count":0}]`)
+	}
+	mux.HandleFunc("/orgs/o/insights/api/time-stats", handler)
+	mux.HandleFunc("/orgs/o/insights/api/time-stats/users/1", handler)
+	mux.HandleFunc("/orgs/o/insights/api/time-stats/fine_grained_pat/2", handler)
+
+	ctx := context.Background()
+	opts := &APIInsightsTimeStatsOptions{MinTimestamp: "2023-01-01T00:00:00Z", TimestampIncrement: "1h"}
+	want := []*APIInsightsTimeStats{{
+		Timestamp:               String("2023-01-01T00:00:00Z"),
+		TotalRequestCount:       Int64(10),
+		RateLimitedRequestCount: Int64(0),
+	}}
+
+	stats, _, err := client.Organizations.GetTimeStats(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.GetTimeStats returned error: %v", err)
+	}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetTimeStats returned %+v, want %+v", stats, want)
+	}
+
+	stats, _, err = client.Organizations.GetTimeStatsByUser(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.GetTimeStatsByUser returned error: %v", err)
+	}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetTimeStatsByUser returned %+v, want %+v", stats, want)
+	}
+
+	stats, _, err = client.Organizations.GetTimeStatsByActor(ctx, "o", "fine_grained_pat", 2, opts)
+	if err != nil {
+		t.Errorf("Organizations.GetTimeStatsByActor returned error: %v", err)
+	}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetTimeStatsByActor returned %+v, want %+v", stats, want)
+	}
+
+	const methodName = "GetTimeStats"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetTimeStats(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetTimeStats(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetUserStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/insights/api/user-stats/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"min_timestamp": "2023-01-01T00:00:00Z", "actor_name_substring": "ci", "page": "2"})
+		fmt.Fprint(w, `[{"actor_type":"oauth_app","actor_name":"ci","actor_id":3,"oauth_application_id":3,"total_request_count":20,"rate_limited_request_count":2}]`)
+	})
+
+	ctx := context.Background()
+	opts := &APIInsightsUserStatsOptions{MinTimestamp: "2023-01-01T00:00:00Z", ActorNameSubstring: "ci", ListOptions: ListOptions{Page: 2}}
+	stats, _, err := client.Organizations.GetUserStats(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.GetUserStats returned error: %v", err)
+	}
+
+	want := []*APIInsightsUserStats{{
+		ActorType:               String("oauth_app"),
+		ActorName:               String("ci"),
+		ActorID:                 Int64(3),
+		OAuthApplicationID:      Int64(3),
+		TotalRequestCount:       Int64(20),
+		RateLimitedRequestCount: Int64(2),
+	}}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("Organizations.GetUserStats returned %+v, want %+v", stats, want)
+	}
+
+	const methodName = "GetUserStats"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetUserStats(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetUserStats(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,21 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListAttestations lists the artifact attestations of an organization for the
+// artifact with the given digest, formatted as "algorithm:hex", for example
+// "sha256:…". See Attestation.Verify to check them.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/orgs#list-attestations
+func (s *OrganizationsService) ListAttestations(ctx context.Context, org, subjectDigest string, opts *ListAttestationsOptions) (*AttestationsResponse, *Response, error) {
+	u := fmt.Sprintf("orgs/%v", org)
+	return s.client.listAttestations(ctx, u, subjectDigest, opts)
+}
@@ -0,0 +1,211 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// AuditLogQuery builds the search phrase of an audit log query. Empty
+// fields are left out of the phrase.
+//
+// GitHub API docs: https://docs.github.com/en/organizations/keeping-your-organization-secure/managing-security-settings-for-your-organization/reviewing-the-audit-log-for-your-organization#searching-the-audit-log
+type AuditLogQuery struct {
+	// Actor is the login of the user who performed the action.
+	Actor string
+	// Action is the name of an action, such as "repo.create", or a
+	// category of actions, such as "repo".
+	Action string
+	// Operation is the type of action. Possible values are "access",
+	// "auth", "create", "modify", "remove", "restore" and "transfer".
+	Operation string
+	// Repo is the repository affected by the action, in "owner/repo" form.
+	Repo string
+	// User is the login of the user affected by the action.
+	User string
+	// Country is the ISO 3166-1 alpha-2 code of the country the action
+	// was performed from, such as "US".
+	Country string
+	// CreatedAfter and CreatedBefore restrict the query to events that
+	// occurred in the given inclusive time range.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// auditLogTimeFormat is the layout used for dates in audit log qualifiers.
+const auditLogTimeFormat = "2006-01-02T15:04:05Z07:00"
+
+// Phrase returns the search phrase of the query, such as
+// "actor:octocat action:repo.create created:>=2023-01-01T00:00:00Z".
+func (q AuditLogQuery) Phrase() string {
+	var terms []string
+	add := func(qualifier, value string) {
+		if value == "" {
+			return
+		}
+		if strings.ContainsAny(value, " \t\"") {
+			value = `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+		}
+		terms = append(terms, qualifier+":"+value)
+	}
+	add("actor", q.Actor)
+	add("action", q.Action)
+	add("operation", q.Operation)
+	add("repo", q.Repo)
+	add("user", q.User)
+	add("country", q.Country)
+
+	after, before := q.CreatedAfter.Format(auditLogTimeFormat), q.CreatedBefore.Format(auditLogTimeFormat)
+	switch {
+	case !q.CreatedAfter.IsZero() && !q.CreatedBefore.IsZero():
+		add("created", after+".."+before)
+	case !q.CreatedAfter.IsZero():
+		add("created", ">="+after)
+	case !q.CreatedBefore.IsZero():
+		add("created", "<="+before)
+	}
+	return strings.Join(terms, " ")
+}
+
+// AuditLogIterator iterates over the entries of an organization or
+// enterprise audit log, following the after cursors GitHub returns in the
+// Link header. It is created by OrganizationsService.AuditLogIterator or
+// EnterpriseService.AuditLogIterator.
+//
+// To tail an audit log, set GetAuditLogOptions.Order to "asc". Once Next
+// returns false with a nil Err, the log has been read to the end; calling
+// Next again later re-reads the last page and returns only the entries
+// added since, so a long-running exporter can poll a single iterator.
+//
+//	it := client.Organizations.AuditLogIterator("o", opts)
+//	for it.Next(ctx) {
+//		export(it.Entry())
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type AuditLogIterator struct {
+	fetch func(ctx context.Context, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error)
+	opts  GetAuditLogOptions
+
+	fetched bool
+	drained bool   // whether the end of the log was reached
+	cursor  string // the after cursor of the current page
+	next    string // the after cursor of the next page, if any
+	page    []*AuditEntry
+	idx     int
+	seen    map[string]bool // document IDs returned from the current page
+
+	entry *AuditEntry
+	resp  *Response
+	err   error
+}
+
+func newAuditLogIterator(opts *GetAuditLogOptions, fetch func(context.Context, *GetAuditLogOptions) ([]*AuditEntry, *Response, error)) *AuditLogIterator {
+	it := &AuditLogIterator{fetch: fetch, seen: make(map[string]bool)}
+	if opts != nil {
+		it.opts = *opts
+	}
+	it.cursor = it.opts.After
+	return it
+}
+
+// AuditLogIterator returns an iterator over the audit log entries of an
+// organization matching opts. If opts.After is set, iteration starts at
+// that cursor, such as one previously returned by AuditLogIterator.Cursor.
+func (s *OrganizationsService) AuditLogIterator(org string, opts *GetAuditLogOptions) *AuditLogIterator {
+	return newAuditLogIterator(opts, func(ctx context.Context, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+		return s.GetAuditLog(ctx, org, opts)
+	})
+}
+
+// AuditLogIterator returns an iterator over the audit log entries of an
+// enterprise matching opts. If opts.After is set, iteration starts at that
+// cursor, such as one previously returned by AuditLogIterator.Cursor.
+func (s *EnterpriseService) AuditLogIterator(enterprise string, opts *GetAuditLogOptions) *AuditLogIterator {
+	return newAuditLogIterator(opts, func(ctx context.Context, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+		return s.GetAuditLog(ctx, enterprise, opts)
+	})
+}
+
+// Next advances the iterator to the next entry, fetching pages as needed.
+// It returns false when there are no more entries or an error occurred.
+func (it *AuditLogIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	refetched := false
+	for {
+		for it.idx < len(it.page) {
+			e := it.page[it.idx]
+			it.idx++
+			if id := e.GetDocumentID(); id != "" {
+				if it.seen[id] {
+					continue
+				}
+				it.seen[id] = true
+			}
+			it.entry = e
+			return true
+		}
+
+		switch {
+		case !it.fetched:
+		case it.next != "":
+			it.cursor, it.next = it.next, ""
+			it.seen = make(map[string]bool)
+		case it.drained && !refetched:
+			// Next is called again after reaching the end of the log; read
+			// the last page again to pick up the entries added since.
+			refetched = true
+		default:
+			it.drained = true
+			it.entry = nil
+			return false
+		}
+
+		opts := it.opts
+		opts.After = it.cursor
+		page, resp, err := it.fetch(ctx, &opts)
+		it.resp = resp
+		if err != nil {
+			it.err = err
+			it.entry = nil
+			return false
+		}
+		it.fetched, it.drained = true, false
+		it.page, it.idx = page, 0
+		if resp != nil {
+			it.next = resp.After
+		}
+	}
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *AuditLogIterator) Entry() *AuditEntry {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *AuditLogIterator) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last API call made.
+func (it *AuditLogIterator) Response() *Response {
+	return it.resp
+}
+
+// Cursor returns the after cursor of the page the iterator is reading. It
+// can be persisted and passed as GetAuditLogOptions.After to resume
+// iteration later; entries of that page already seen are returned again,
+// so consumers should deduplicate them by AuditEntry.DocumentID.
+func (it *AuditLogIterator) Cursor() string {
+	return it.cursor
+}
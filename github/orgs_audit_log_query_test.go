@@ -0,0 +1,125 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAuditLogQuery_Phrase(t *testing.T) {
+	after := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2023, time.February, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		query AuditLogQuery
+		want  string
+	}{
+		{AuditLogQuery{}, ""},
+		{
+			AuditLogQuery{Actor: "octocat", Action: "repo.create", Country: "US"},
+			"actor:octocat action:repo.create country:US",
+		},
+		{
+			AuditLogQuery{Operation: "remove", Repo: "o/r", User: "hubot", CreatedAfter: after},
+			"operation:remove repo:o/r user:hubot created:>=2023-01-01T00:00:00Z",
+		},
+		{AuditLogQuery{CreatedBefore: before}, "created:<=2023-02-01T12:30:00Z"},
+		{
+			AuditLogQuery{Actor: `a "b"`, CreatedAfter: after, CreatedBefore: before},
+			`actor:"a \"b\"" created:2023-01-01T00:00:00Z..2023-02-01T12:30:00Z`,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.query.Phrase(); got != tt.want {
+			t.Errorf("AuditLogQuery.Phrase() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestOrganizationsService_AuditLogIterator(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		switch after := r.FormValue("after"); {
+		case after == "":
+			testFormValues(t, r, values{"phrase": "action:repo", "order": "asc"})
+			w.Header().Set("Link", `<https://api.github.com/organizations/1/audit-log?order=asc&after=c1&before=>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id":"1"},{"_document_id":"2"}]`)
+		case after == "c1" && calls == 2:
+			fmt.Fprint(w, `[{"_document_id":"3"}]`)
+		case after == "c1":
+			fmt.Fprint(w, `[{"_document_id":"3"},{"_document_id":"4"}]`)
+		default:
+			t.Errorf("unexpected after cursor %q", after)
+		}
+	})
+
+	ctx := context.Background()
+	opts := &GetAuditLogOptions{Phrase: String(AuditLogQuery{Action: "repo"}.Phrase()), Order: String("asc")}
+	it := client.Organizations.AuditLogIterator("o", opts)
+
+	read := func() []string {
+		var ids []string
+		for it.Next(ctx) {
+			ids = append(ids, it.Entry().GetDocumentID())
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("AuditLogIterator returned error: %v", err)
+		}
+		return ids
+	}
+
+	if got, want := read(), []string{"1", "2", "3"}; !cmp.Equal(got, want) {
+		t.Errorf("AuditLogIterator returned %v, want %v", got, want)
+	}
+	if got, want := it.Cursor(), "c1"; got != want {
+		t.Errorf("AuditLogIterator.Cursor() = %q, want %q", got, want)
+	}
+
+	// Polling again only returns the entries added since.
+	if got, want := read(), []string{"4"}; !cmp.Equal(got, want) {
+		t.Errorf("AuditLogIterator returned %v after polling, want %v", got, want)
+	}
+	if calls != 3 {
+		t.Errorf("AuditLogIterator made %v requests, want 3", calls)
+	}
+	if it.Response() == nil {
+		t.Error("AuditLogIterator.Response() = nil, want the last response")
+	}
+}
+
+func TestEnterpriseService_AuditLogIterator_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"after": "c0"})
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	it := client.Enterprise.AuditLogIterator("e", &GetAuditLogOptions{ListCursorOptions: ListCursorOptions{After: "c0"}})
+	if it.Next(ctx) {
+		t.Fatal("AuditLogIterator.Next returned true, want false")
+	}
+	if it.Err() == nil {
+		t.Error("AuditLogIterator.Err() = nil, want error")
+	}
+	if it.Next(ctx) {
+		t.Error("AuditLogIterator.Next returned true after an error, want false")
+	}
+}
@@ -25,6 +25,17 @@ type CustomRepoRoles struct {
 	Description *string  `json:"description,omitempty"`
 	BaseRole    *string  `json:"base_role,omitempty"`
 	Permissions []string `json:"permissions,omitempty"`
+
+	Org       *Organization `json:"organization,omitempty"`
+	CreatedAt *Timestamp    `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp    `json:"updated_at,omitempty"`
+}
+
+// RepoFineGrainedPermission represents a fine-grained permission that can be
+// added to a custom repository role, such as "add_label".
+type RepoFineGrainedPermission struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 // ListCustomRepoRoles lists the custom repository roles available in this organization.
@@ -48,11 +59,57 @@ func (s *OrganizationsService) ListCustomRepoRoles(ctx context.Context, org stri
 	return customRepoRoles, resp, nil
 }
 
+// GetCustomRepoRole gets a custom repository role in this organization.
+// In order to see custom repository roles in an organization, the authenticated user must be an organization owner.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/orgs/custom-roles#get-a-custom-repository-role
+func (s *OrganizationsService) GetCustomRepoRole(ctx context.Context, org string, roleID int64) (*CustomRepoRoles, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/custom-repository-roles/%v", org, roleID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(CustomRepoRoles)
+	resp, err := s.client.Do(ctx, req, role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return role, resp, nil
+}
+
+// ListRepositoryFineGrainedPermissions lists the fine-grained permissions
+// that can be used in custom repository roles for this organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/orgs/custom-roles#list-repository-fine-grained-permissions-for-an-organization
+func (s *OrganizationsService) ListRepositoryFineGrainedPermissions(ctx context.Context, org string) ([]*RepoFineGrainedPermission, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/repository-fine-grained-permissions", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var perms []*RepoFineGrainedPermission
+	resp, err := s.client.Do(ctx, req, &perms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return perms, resp, nil
+}
+
 // CreateOrUpdateCustomRoleOptions represents options required to create or update a custom repository role.
 type CreateOrUpdateCustomRoleOptions struct {
-	Name        *string  `json:"name,omitempty"`
-	Description *string  `json:"description,omitempty"`
-	BaseRole    *string  `json:"base_role,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// BaseRole is the system role the custom role inherits from. Possible
+	// values are "read", "triage", "write" and "maintain".
+	BaseRole *string `json:"base_role,omitempty"`
+	// Permissions are the names of the fine-grained permissions added to the
+	// base role, as returned by ListRepositoryFineGrainedPermissions.
 	Permissions []string `json:"permissions,omitempty"`
 }
 
@@ -49,6 +49,86 @@ func TestOrganizationsService_ListCustomRepoRoles(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_GetCustomRepoRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/custom-repository-roles/8030", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":8030,"name":"Labeler","base_role":"read","permissions":["add_label"],"organization":{"login":"o"},"created_at":`+referenceTimeStr+`}`)
+	})
+
+	ctx := context.Background()
+	role, _, err := client.Organizations.GetCustomRepoRole(ctx, "o", 8030)
+	if err != nil {
+		t.Errorf("Organizations.GetCustomRepoRole returned error: %v", err)
+	}
+
+	want := &CustomRepoRoles{
+		ID:          Int64(8030),
+		Name:        String("Labeler"),
+		BaseRole:    String("read"),
+		Permissions: []string{"add_label"},
+		Org:         &Organization{Login: String("o")},
+		CreatedAt:   &Timestamp{referenceTime},
+	}
+	if !cmp.Equal(role, want) {
+		t.Errorf("Organizations.GetCustomRepoRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "GetCustomRepoRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetCustomRepoRole(ctx, "\no", 8030)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetCustomRepoRole(ctx, "o", 8030)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ListRepositoryFineGrainedPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repository-fine-grained-permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"add_assignee","description":"Assign or remove a user"},{"name":"add_label","description":"Add or remove a label"}]`)
+	})
+
+	ctx := context.Background()
+	perms, _, err := client.Organizations.ListRepositoryFineGrainedPermissions(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.ListRepositoryFineGrainedPermissions returned error: %v", err)
+	}
+
+	want := []*RepoFineGrainedPermission{
+		{Name: String("add_assignee"), Description: String("Assign or remove a user")},
+		{Name: String("add_label"), Description: String("Add or remove a label")},
+	}
+	if !cmp.Equal(perms, want) {
+		t.Errorf("Organizations.ListRepositoryFineGrainedPermissions returned %+v, want %+v", perms, want)
+	}
+
+	const methodName = "ListRepositoryFineGrainedPermissions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListRepositoryFineGrainedPermissions(ctx, "\no")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListRepositoryFineGrainedPermissions(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestOrganizationsService_CreateCustomRepoRole(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
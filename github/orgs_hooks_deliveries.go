@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 )
 
 // ListHookDeliveries lists webhook deliveries for a webhook configured in an organization.
@@ -71,3 +73,88 @@ func (s *OrganizationsService) RedeliverHookDelivery(ctx context.Context, owner
 
 	return h, resp, nil
 }
+
+// RedeliverFailedHookDeliveries redelivers the deliveries of an organization
+// webhook that failed since the given time, such as the start of an outage
+// of the receiving service. A delivery failed if it did not get a 2xx
+// response; deliveries that were later redelivered successfully are skipped,
+// and each failed delivery is redelivered at most once, using its most
+// recent attempt.
+//
+// Redeliveries are spaced by one second, and pause whenever fewer than 100
+// requests remain in the rate limit. It returns the deliveries that were
+// redelivered.
+func (s *OrganizationsService) RedeliverFailedHookDeliveries(ctx context.Context, org string, hookID int64, since time.Time) ([]*HookDelivery, *Response, error) {
+	list := func(opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
+		return s.ListHookDeliveries(ctx, org, hookID, opts)
+	}
+	redeliver := func(deliveryID int64) (*HookDelivery, *Response, error) {
+		return s.RedeliverHookDelivery(ctx, org, hookID, deliveryID)
+	}
+	return redeliverFailedHookDeliveries(ctx, since, list, redeliver)
+}
+
+// redeliverFailedHookDeliveries pages through deliveries with list until it
+// reaches one older than since and redelivers the latest attempt of each
+// delivery that has not succeeded, pacing the redeliveries like the other
+// bulk helpers.
+func redeliverFailedHookDeliveries(ctx context.Context, since time.Time, list func(*ListCursorOptions) ([]*HookDelivery, *Response, error), redeliver func(int64) (*HookDelivery, *Response, error)) ([]*HookDelivery, *Response, error) {
+	// Deliveries are listed newest first, so the latest attempt for each
+	// GUID is seen before any older one.
+	succeeded := make(map[string]bool)
+	failed := make(map[string]bool)
+	var toRedeliver []*HookDelivery
+
+	opts := &ListCursorOptions{PerPage: 100}
+	var resp *Response
+	for {
+		deliveries, r, err := list(opts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+
+		done := false
+		for _, d := range deliveries {
+			if d.DeliveredAt != nil && d.DeliveredAt.Before(since) {
+				done = true
+				break
+			}
+			guid := d.GetGUID()
+			if code := d.GetStatusCode(); code >= 200 && code < 300 {
+				succeeded[guid] = true
+				continue
+			}
+			if succeeded[guid] || failed[guid] {
+				continue
+			}
+			failed[guid] = true
+			toRedeliver = append(toRedeliver, d)
+		}
+		if done || resp.Cursor == "" {
+			break
+		}
+		opts.Cursor = resp.Cursor
+	}
+
+	pacer := &requestPacer{interval: defaultBatchInterval}
+	var redelivered []*HookDelivery
+	for _, d := range toRedeliver {
+		err := pacedDo(ctx, pacer, defaultMinRateRemaining, func() (*Response, error) {
+			_, r, err := redeliver(d.GetID())
+			resp = r
+			// GitHub queues redeliveries and responds with 202 Accepted.
+			var aerr *AcceptedError
+			if errors.As(err, &aerr) {
+				err = nil
+			}
+			return r, err
+		})
+		if err != nil {
+			return redelivered, resp, err
+		}
+		redelivered = append(redelivered, d)
+	}
+
+	return redelivered, resp, nil
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -148,3 +149,63 @@ func TestOrganizationsService_RedeliverHookDelivery_invalidOwner(t *testing.T) {
 	_, _, err := client.Organizations.RedeliverHookDelivery(ctx, "%", 1, 1)
 	testURLParseError(t, err)
 }
+
+func TestOrganizationsService_RedeliverFailedHookDeliveries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	since := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mux.HandleFunc("/orgs/o/hooks/1/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("cursor") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/hooks/1/deliveries?per_page=100&cursor=v1_2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"id":10,"guid":"a","status_code":200,"delivered_at":"2023-01-03T00:00:00Z","redelivery":true},
+				{"id":9,"guid":"b","status_code":502,"delivered_at":"2023-01-02T12:00:00Z","redelivery":true}
+			]`)
+		case "v1_2":
+			fmt.Fprint(w, `[
+				{"id":8,"guid":"b","status_code":502,"delivered_at":"2023-01-02T00:00:00Z"},
+				{"id":7,"guid":"a","status_code":500,"delivered_at":"2023-01-01T12:00:00Z"},
+				{"id":6,"guid":"c","status_code":0,"delivered_at":"2023-01-01T06:00:00Z"},
+				{"id":5,"guid":"d","status_code":500,"delivered_at":"2022-12-31T00:00:00Z"}
+			]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("cursor"))
+		}
+	})
+	var redelivered []string
+	for _, id := range []string{"9", "6"} {
+		id := id
+		mux.HandleFunc("/orgs/o/hooks/1/deliveries/"+id+"/attempts", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			redelivered = append(redelivered, id)
+			w.WriteHeader(http.StatusAccepted)
+		})
+	}
+
+	ctx := context.Background()
+	deliveries, _, err := client.Organizations.RedeliverFailedHookDeliveries(ctx, "o", 1, since)
+	if err != nil {
+		t.Fatalf("Organizations.RedeliverFailedHookDeliveries returned error: %v", err)
+	}
+
+	var ids []int64
+	for _, d := range deliveries {
+		ids = append(ids, d.GetID())
+	}
+	if want := []int64{9, 6}; !cmp.Equal(ids, want) {
+		t.Errorf("Organizations.RedeliverFailedHookDeliveries returned %v, want %v", ids, want)
+	}
+	if want := []string{"9", "6"}; !cmp.Equal(redelivered, want) {
+		t.Errorf("Organizations.RedeliverFailedHookDeliveries redelivered %v, want %v", redelivered, want)
+	}
+
+	const methodName = "RedeliverFailedHookDeliveries"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.RedeliverFailedHookDeliveries(ctx, "\n", 1, since)
+		return err
+	})
+}
@@ -204,6 +204,90 @@ func TestOrganizationsService_EditHook_invalidOrg(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestOrganizationsService_GetHookConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"content_type":"json","insecure_ssl":"0","secret":"********","url":"https://example.com/webhook"}`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Organizations.GetHookConfiguration(ctx, "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.GetHookConfiguration returned error: %v", err)
+	}
+
+	want := &HookConfig{
+		ContentType: String("json"),
+		InsecureSSL: String("0"),
+		Secret:      String("********"),
+		URL:         String("https://example.com/webhook"),
+	}
+	if !cmp.Equal(config, want) {
+		t.Errorf("Organizations.GetHookConfiguration returned %+v, want %+v", config, want)
+	}
+
+	const methodName = "GetHookConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetHookConfiguration(ctx, "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetHookConfiguration(ctx, "o", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_EditHookConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &HookConfig{ContentType: String("form"), URL: String("https://example.com/new")}
+
+	mux.HandleFunc("/orgs/o/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		v := new(HookConfig)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !cmp.Equal(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"content_type":"form","insecure_ssl":"0","url":"https://example.com/new"}`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Organizations.EditHookConfiguration(ctx, "o", 1, input)
+	if err != nil {
+		t.Errorf("Organizations.EditHookConfiguration returned error: %v", err)
+	}
+
+	want := &HookConfig{ContentType: String("form"), InsecureSSL: String("0"), URL: String("https://example.com/new")}
+	if !cmp.Equal(config, want) {
+		t.Errorf("Organizations.EditHookConfiguration returned %+v, want %+v", config, want)
+	}
+
+	const methodName = "EditHookConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.EditHookConfiguration(ctx, "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.EditHookConfiguration(ctx, "o", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestOrganizationsService_PingHook(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
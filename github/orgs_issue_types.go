@@ -0,0 +1,109 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// IssueType represents an organization issue type, such as Bug, Feature, or Task.
+type IssueType struct {
+	ID          *int64     `json:"id,omitempty"`
+	NodeID      *string    `json:"node_id,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Color       *string    `json:"color,omitempty"`
+	IsEnabled   *bool      `json:"is_enabled,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
+}
+
+// CreateOrUpdateIssueTypesOptions represents the parameters for creating or
+// updating an organization issue type.
+type CreateOrUpdateIssueTypesOptions struct {
+	Name      string `json:"name"`       // Required.
+	IsEnabled bool   `json:"is_enabled"` // Required.
+	// Description of the issue type.
+	Description *string `json:"description,omitempty"`
+	// Color of the issue type. Possible values are: "gray", "blue", "green",
+	// "yellow", "orange", "red", "pink", "purple".
+	Color *string `json:"color,omitempty"`
+}
+
+// ListIssueTypes lists all issue types for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/issue-types#list-issue-types-for-an-organization
+func (s *OrganizationsService) ListIssueTypes(ctx context.Context, org string) ([]*IssueType, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/issue-types", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issueTypes []*IssueType
+	resp, err := s.client.Do(ctx, req, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issueTypes, resp, nil
+}
+
+// CreateIssueType creates a new issue type for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/issue-types#create-issue-type-for-an-organization
+func (s *OrganizationsService) CreateIssueType(ctx context.Context, org string, opt *CreateOrUpdateIssueTypesOptions) (*IssueType, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/issue-types", org)
+
+	req, err := s.client.NewRequest("POST", u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueType := new(IssueType)
+	resp, err := s.client.Do(ctx, req, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issueType, resp, nil
+}
+
+// UpdateIssueType updates an issue type for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/issue-types#update-issue-type-for-an-organization
+func (s *OrganizationsService) UpdateIssueType(ctx context.Context, org string, issueTypeID int64, opt *CreateOrUpdateIssueTypesOptions) (*IssueType, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/issue-types/%v", org, issueTypeID)
+
+	req, err := s.client.NewRequest("PUT", u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueType := new(IssueType)
+	resp, err := s.client.Do(ctx, req, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issueType, resp, nil
+}
+
+// DeleteIssueType deletes an issue type for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/issue-types#delete-issue-type-for-an-organization
+func (s *OrganizationsService) DeleteIssueType(ctx context.Context, org string, issueTypeID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/issue-types/%v", org, issueTypeID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_ListIssueTypes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/issue-types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":410,"node_id":"IT_kwDNAd3NAZo","name":"Task","description":"A specific piece of work","color":"yellow","is_enabled":true,"created_at":`+referenceTimeStr+`,"updated_at":`+referenceTimeStr+`},
+			{"id":411,"node_id":"IT_kwDNAd3NAZs","name":"Bug","color":"red","is_enabled":false}
+		]`)
+	})
+
+	ctx := context.Background()
+	issueTypes, _, err := client.Organizations.ListIssueTypes(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.ListIssueTypes returned error: %v", err)
+	}
+
+	want := []*IssueType{
+		{
+			ID:          Int64(410),
+			NodeID:      String("IT_kwDNAd3NAZo"),
+			Name:        String("Task"),
+			Description: String("A specific piece of work"),
+			Color:       String("yellow"),
+			IsEnabled:   Bool(true),
+			CreatedAt:   &Timestamp{referenceTime},
+			UpdatedAt:   &Timestamp{referenceTime},
+		},
+		{
+			ID:        Int64(411),
+			NodeID:    String("IT_kwDNAd3NAZs"),
+			Name:      String("Bug"),
+			Color:     String("red"),
+			IsEnabled: Bool(false),
+		},
+	}
+	if !cmp.Equal(issueTypes, want) {
+		t.Errorf("Organizations.ListIssueTypes returned %+v, want %+v", issueTypes, want)
+	}
+
+	const methodName = "ListIssueTypes"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListIssueTypes(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListIssueTypes(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateIssueType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateOrUpdateIssueTypesOptions{
+		Name:        "Epic",
+		IsEnabled:   true,
+		Description: String("An issue type for a multi-week tracking of work"),
+		Color:       String("green"),
+	}
+
+	mux.HandleFunc("/orgs/o/issue-types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Epic","is_enabled":true,"description":"An issue type for a multi-week tracking of work","color":"green"}`+"\n")
+		fmt.Fprint(w, `{"id":412,"name":"Epic","description":"An issue type for a multi-week tracking of work","color":"green","is_enabled":true}`)
+	})
+
+	ctx := context.Background()
+	issueType, _, err := client.Organizations.CreateIssueType(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Organizations.CreateIssueType returned error: %v", err)
+	}
+
+	want := &IssueType{
+		ID:          Int64(412),
+		Name:        String("Epic"),
+		Description: String("An issue type for a multi-week tracking of work"),
+		Color:       String("green"),
+		IsEnabled:   Bool(true),
+	}
+	if !cmp.Equal(issueType, want) {
+		t.Errorf("Organizations.CreateIssueType returned %+v, want %+v", issueType, want)
+	}
+
+	const methodName = "CreateIssueType"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateIssueType(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateIssueType(ctx, "o", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateIssueType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateOrUpdateIssueTypesOptions{
+		Name:      "Epic",
+		IsEnabled: false,
+	}
+
+	mux.HandleFunc("/orgs/o/issue-types/412", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"Epic","is_enabled":false}`+"\n")
+		fmt.Fprint(w, `{"id":412,"name":"Epic","is_enabled":false}`)
+	})
+
+	ctx := context.Background()
+	issueType, _, err := client.Organizations.UpdateIssueType(ctx, "o", 412, input)
+	if err != nil {
+		t.Errorf("Organizations.UpdateIssueType returned error: %v", err)
+	}
+
+	want := &IssueType{ID: Int64(412), Name: String("Epic"), IsEnabled: Bool(false)}
+	if !cmp.Equal(issueType, want) {
+		t.Errorf("Organizations.UpdateIssueType returned %+v, want %+v", issueType, want)
+	}
+
+	const methodName = "UpdateIssueType"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.UpdateIssueType(ctx, "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.UpdateIssueType(ctx, "o", 412, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_DeleteIssueType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/issue-types/412", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.DeleteIssueType(ctx, "o", 412)
+	if err != nil {
+		t.Errorf("Organizations.DeleteIssueType returned error: %v", err)
+	}
+
+	const methodName = "DeleteIssueType"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.DeleteIssueType(ctx, "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.DeleteIssueType(ctx, "o", 412)
+	})
+}
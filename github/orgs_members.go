@@ -341,6 +341,20 @@ func (s *OrganizationsService) CreateOrgInvitation(ctx context.Context, org stri
 	return invitation, resp, nil
 }
 
+// CancelInvite cancels an organization invitation. In order to cancel invitations in an organization,
+// the authenticated user must be an organization owner.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/members#cancel-an-organization-invitation
+func (s *OrganizationsService) CancelInvite(ctx context.Context, org string, invitationID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/invitations/%v", org, invitationID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // ListOrgInvitationTeams lists all teams associated with an invitation. In order to see invitations in an organization,
 // the authenticated user must be an organization owner.
 //
@@ -366,7 +380,7 @@ func (s *OrganizationsService) ListOrgInvitationTeams(ctx context.Context, org,
 	return orgInvitationTeams, resp, nil
 }
 
-// ListFailedOrgInvitations returns a list of failed inviatations.
+// ListFailedOrgInvitations returns a list of failed invitations.
 //
 // GitHub API docs: https://docs.github.com/en/rest/orgs/members#list-failed-organization-invitations
 func (s *OrganizationsService) ListFailedOrgInvitations(ctx context.Context, org string, opts *ListOptions) ([]*Invitation, *Response, error) {
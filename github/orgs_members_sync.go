@@ -0,0 +1,185 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Actions of a MemberChange.
+const (
+	MemberChangeInvite     = "invite"
+	MemberChangeUpdateRole = "update_role"
+	MemberChangeRemove     = "remove"
+)
+
+// SyncMembersOptions specifies the optional parameters to the
+// OrganizationsService.SyncMembers method.
+type SyncMembersOptions struct {
+	// Remove removes the members of the organization that are not in the
+	// desired set. By default they are kept.
+	Remove bool
+
+	// MinInterval is the minimum delay between two requests changing the
+	// organization. Default is one second.
+	MinInterval time.Duration
+
+	// MinRateRemaining pauses until the rate limit resets whenever fewer
+	// than this many requests remain. Default is 100.
+	MinRateRemaining int
+
+	// DryRun reports the changes that would be made without making them.
+	DryRun bool
+}
+
+// MemberChange describes a change made, or that would be made in a dry run,
+// to the members of an organization by OrganizationsService.SyncMembers.
+type MemberChange struct {
+	// Action is one of MemberChangeInvite, MemberChangeUpdateRole, or MemberChangeRemove.
+	Action string
+	Login  string
+	// Role is the desired role, "admin" or "member". It is empty for removals.
+	Role string
+	// PreviousRole is the role of the user before the change. It is empty
+	// for invitations.
+	PreviousRole string
+}
+
+// SyncMembers makes the members of an organization match desired, which
+// maps logins to roles, "admin" or "member"; an empty role means "member".
+// Logins are compared ignoring case. Users that are neither members nor
+// invited are invited with their desired role, and members whose role
+// differs are updated. Members not in desired are removed only if
+// opts.Remove is set. Pending invitations are left unchanged.
+//
+// All desired roles are validated before any change is made. The changes
+// are returned in the order they are applied: invitations and role updates
+// sorted by login, then removals sorted by login. On error, the changes
+// before the failing one were applied.
+func (s *OrganizationsService) SyncMembers(ctx context.Context, org string, desired map[string]string, opts *SyncMembersOptions) ([]*MemberChange, *Response, error) {
+	if opts == nil {
+		opts = &SyncMembersOptions{}
+	}
+	interval := opts.MinInterval
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+	minRemaining := opts.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	wanted := make(map[string]string)
+	var logins []string
+	for login, role := range desired {
+		if login == "" {
+			return nil, nil, fmt.Errorf("desired login is empty")
+		}
+		if role == "" {
+			role = "member"
+		}
+		if role != "admin" && role != "member" {
+			return nil, nil, fmt.Errorf("invalid role %q for %q, want \"admin\" or \"member\"", role, login)
+		}
+		key := strings.ToLower(login)
+		if _, ok := wanted[key]; ok {
+			return nil, nil, fmt.Errorf("duplicate desired login %q", login)
+		}
+		wanted[key] = role
+		logins = append(logins, login)
+	}
+	sort.Strings(logins)
+
+	var resp *Response
+	current := make(map[string]string)
+	var members []string
+	for _, role := range []string{"admin", "member"} {
+		listOpts := &ListMembersOptions{Role: role, ListOptions: ListOptions{PerPage: 100}}
+		for {
+			page, r, err := s.ListMembers(ctx, org, listOpts)
+			resp = r
+			if err != nil {
+				return nil, resp, err
+			}
+			for _, user := range page {
+				current[strings.ToLower(user.GetLogin())] = role
+				members = append(members, user.GetLogin())
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			listOpts.Page = resp.NextPage
+		}
+	}
+	sort.Strings(members)
+
+	invited := make(map[string]bool)
+	inviteOpts := &ListOptions{PerPage: 100}
+	for {
+		page, r, err := s.ListPendingOrgInvitations(ctx, org, inviteOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, invitation := range page {
+			if invitation.GetLogin() != "" {
+				invited[strings.ToLower(invitation.GetLogin())] = true
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		inviteOpts.Page = resp.NextPage
+	}
+
+	var changes []*MemberChange
+	for _, login := range logins {
+		key := strings.ToLower(login)
+		role, ok := current[key]
+		switch {
+		case !ok && !invited[key]:
+			changes = append(changes, &MemberChange{Action: MemberChangeInvite, Login: login, Role: wanted[key]})
+		case ok && role != wanted[key]:
+			changes = append(changes, &MemberChange{Action: MemberChangeUpdateRole, Login: login, Role: wanted[key], PreviousRole: role})
+		}
+	}
+	if opts.Remove {
+		for _, login := range members {
+			key := strings.ToLower(login)
+			if _, ok := wanted[key]; !ok {
+				changes = append(changes, &MemberChange{Action: MemberChangeRemove, Login: login, PreviousRole: current[key]})
+			}
+		}
+	}
+	if opts.DryRun {
+		return changes, resp, nil
+	}
+
+	pacer := &requestPacer{interval: interval}
+	for i, change := range changes {
+		change := change
+		err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+			var err error
+			switch change.Action {
+			case MemberChangeRemove:
+				resp, err = s.RemoveMember(ctx, org, change.Login)
+			default:
+				// Setting the role of a user who is not a member invites them.
+				_, resp, err = s.EditOrgMembership(ctx, change.Login, org, &Membership{Role: String(change.Role)})
+			}
+			return resp, err
+		})
+		if err != nil {
+			return changes[:i], resp, err
+		}
+	}
+
+	return changes, resp, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func setupSyncMembers(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/orgs/o/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("role") {
+		case "admin":
+			fmt.Fprint(w, `[{"login":"Owner"}]`)
+		case "member":
+			fmt.Fprint(w, `[{"login":"promoted"},{"login":"leaver"}]`)
+		default:
+			t.Errorf("unexpected role %q", r.FormValue("role"))
+		}
+	})
+	mux.HandleFunc("/orgs/o/invitations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"login":"pending"}]`)
+	})
+}
+
+func TestOrganizationsService_SyncMembers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	setupSyncMembers(t, mux)
+	mux.HandleFunc("/orgs/o/memberships/newcomer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"role":"member"}`+"\n")
+		fmt.Fprint(w, `{"state":"pending","role":"member"}`)
+	})
+	mux.HandleFunc("/orgs/o/memberships/promoted", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"role":"admin"}`+"\n")
+		fmt.Fprint(w, `{"state":"active","role":"admin"}`)
+	})
+	mux.HandleFunc("/orgs/o/members/leaver", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	desired := map[string]string{
+		"owner":    "admin",
+		"promoted": "admin",
+		"newcomer": "",
+		"pending":  "member",
+	}
+
+	ctx := context.Background()
+	opts := &SyncMembersOptions{Remove: true, MinInterval: time.Millisecond}
+	changes, _, err := client.Organizations.SyncMembers(ctx, "o", desired, opts)
+	if err != nil {
+		t.Fatalf("Organizations.SyncMembers returned error: %v", err)
+	}
+
+	want := []*MemberChange{
+		{Action: MemberChangeInvite, Login: "newcomer", Role: "member"},
+		{Action: MemberChangeUpdateRole, Login: "promoted", Role: "admin", PreviousRole: "member"},
+		{Action: MemberChangeRemove, Login: "leaver", PreviousRole: "member"},
+	}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("Organizations.SyncMembers returned %+v, want %+v", changes, want)
+	}
+
+	const methodName = "SyncMembers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.SyncMembers(ctx, "\n", desired, nil)
+		return err
+	})
+}
+
+func TestOrganizationsService_SyncMembers_dryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	setupSyncMembers(t, mux)
+
+	ctx := context.Background()
+	desired := map[string]string{"owner": "admin", "promoted": "member", "leaver": "member"}
+	changes, _, err := client.Organizations.SyncMembers(ctx, "o", desired, &SyncMembersOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Organizations.SyncMembers returned error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("Organizations.SyncMembers returned %+v, want no changes", changes)
+	}
+}
+
+func TestOrganizationsService_SyncMembers_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, desired := range []map[string]string{
+		{"": "member"},
+		{"a": "owner"},
+		{"a": "member", "A": "admin"},
+	} {
+		if _, _, err := client.Organizations.SyncMembers(ctx, "o", desired, nil); err == nil {
+			t.Errorf("Organizations.SyncMembers(%v) returned nil error, want error", desired)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_ListRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count": 1, "roles": [{"id": 1, "name": "Auditor", "permissions": ["read_audit_logs"], "source": "Organization"}]}`)
+	})
+
+	ctx := context.Background()
+	roles, _, err := client.Organizations.ListRoles(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.ListRoles returned error: %v", err)
+	}
+
+	want := &OrganizationCustomRoles{
+		TotalCount: Int(1),
+		CustomOrgRoles: []*CustomOrgRoles{
+			{ID: Int64(1), Name: String("Auditor"), Permissions: []string{"read_audit_logs"}, Source: String("Organization")},
+		},
+	}
+	if !cmp.Equal(roles, want) {
+		t.Errorf("Organizations.ListRoles returned %+v, want %+v", roles, want)
+	}
+
+	const methodName = "ListRoles"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListRoles(ctx, "\no")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListRoles(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "name": "Auditor", "permissions": ["read_audit_logs"], "organization": {"login": "o"}, "created_at": `+referenceTimeStr+`}`)
+	})
+
+	ctx := context.Background()
+	role, _, err := client.Organizations.GetOrgRole(ctx, "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.GetOrgRole returned error: %v", err)
+	}
+
+	want := &CustomOrgRoles{
+		ID:          Int64(1),
+		Name:        String("Auditor"),
+		Permissions: []string{"read_audit_logs"},
+		Org:         &Organization{Login: String("o")},
+		CreatedAt:   &Timestamp{referenceTime},
+	}
+	if !cmp.Equal(role, want) {
+		t.Errorf("Organizations.GetOrgRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "GetOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetOrgRole(ctx, "\no", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetOrgRole(ctx, "o", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateCustomOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Auditor","description":"Reads audit logs","permissions":["read_audit_logs"]}`+"\n")
+		fmt.Fprint(w, `{"id": 8030, "name": "Auditor", "description": "Reads audit logs", "permissions": ["read_audit_logs"]}`)
+	})
+
+	ctx := context.Background()
+	opts := &CreateOrUpdateOrgRoleOptions{
+		Name:        String("Auditor"),
+		Description: String("Reads audit logs"),
+		Permissions: []string{"read_audit_logs"},
+	}
+	role, _, err := client.Organizations.CreateCustomOrgRole(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.CreateCustomOrgRole returned error: %v", err)
+	}
+
+	want := &CustomOrgRoles{ID: Int64(8030), Name: String("Auditor"), Description: String("Reads audit logs"), Permissions: []string{"read_audit_logs"}}
+	if !cmp.Equal(role, want) {
+		t.Errorf("Organizations.CreateCustomOrgRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "CreateCustomOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateCustomOrgRole(ctx, "\no", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateCustomOrgRole(ctx, "o", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateCustomOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/8030", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"description":"Updated"}`+"\n")
+		fmt.Fprint(w, `{"id": 8030, "name": "Auditor", "description": "Updated"}`)
+	})
+
+	ctx := context.Background()
+	opts := &CreateOrUpdateOrgRoleOptions{Description: String("Updated")}
+	role, _, err := client.Organizations.UpdateCustomOrgRole(ctx, "o", 8030, opts)
+	if err != nil {
+		t.Errorf("Organizations.UpdateCustomOrgRole returned error: %v", err)
+	}
+
+	want := &CustomOrgRoles{ID: Int64(8030), Name: String("Auditor"), Description: String("Updated")}
+	if !cmp.Equal(role, want) {
+		t.Errorf("Organizations.UpdateCustomOrgRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "UpdateCustomOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.UpdateCustomOrgRole(ctx, "\no", 8030, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.UpdateCustomOrgRole(ctx, "o", 8030, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_DeleteCustomOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/8030", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.DeleteCustomOrgRole(ctx, "o", 8030); err != nil {
+		t.Errorf("Organizations.DeleteCustomOrgRole returned error: %v", err)
+	}
+
+	const methodName = "DeleteCustomOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.DeleteCustomOrgRole(ctx, "\no", 8030)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.DeleteCustomOrgRole(ctx, "o", 8030)
+	})
+}
+
+func TestOrganizationsService_OrgRoleAssignments(t *testing.T) {
+	tests := []struct {
+		methodName string
+		method     string
+		path       string
+		call       func(ctx context.Context, client *Client, org string) (*Response, error)
+	}{
+		{"AssignOrgRoleToTeam", "PUT", "/orgs/o/organization-roles/teams/t/8030", func(ctx context.Context, client *Client, org string) (*Response, error) {
+			return client.Organizations.AssignOrgRoleToTeam(ctx, org, "t", 8030)
+		}},
+		{"RemoveOrgRoleFromTeam", "DELETE", "/orgs/o/organization-roles/teams/t2/8030", func(ctx context.Context, client *Client, org string) (*Response, error) {
+			return client.Organizations.RemoveOrgRoleFromTeam(ctx, org, "t2", 8030)
+		}},
+		{"RemoveAllOrgRolesFromTeam", "DELETE", "/orgs/o/organization-roles/teams/t3", func(ctx context.Context, client *Client, org string) (*Response, error) {
+			return client.Organizations.RemoveAllOrgRolesFromTeam(ctx, org, "t3")
+		}},
+		{"AssignOrgRoleToUser", "PUT", "/orgs/o/organization-roles/users/u/8030", func(ctx context.Context, client *Client, org string) (*Response, error) {
+			return client.Organizations.AssignOrgRoleToUser(ctx, org, "u", 8030)
+		}},
+		{"RemoveOrgRoleFromUser", "DELETE", "/orgs/o/organization-roles/users/u2/8030", func(ctx context.Context, client *Client, org string) (*Response, error) {
+			return client.Organizations.RemoveOrgRoleFromUser(ctx, org, "u2", 8030)
+		}},
+		{"RemoveAllOrgRolesFromUser", "DELETE", "/orgs/o/organization-roles/users/u3", func(ctx context.Context, client *Client, org string) (*Response, error) {
+			return client.Organizations.RemoveAllOrgRolesFromUser(ctx, org, "u3")
+		}},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+		client, mux, _, teardown := setup()
+		mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, tt.method)
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		if _, err := tt.call(ctx, client, "o"); err != nil {
+			t.Errorf("Organizations.%v returned error: %v", tt.methodName, err)
+		}
+
+		testBadOptions(t, tt.methodName, func() (err error) {
+			_, err = tt.call(ctx, client, "\no")
+			return err
+		})
+
+		testNewRequestAndDoFailure(t, tt.methodName, client, func() (*Response, error) {
+			return tt.call(ctx, client, "o")
+		})
+		teardown()
+	}
+}
+
+func TestOrganizationsService_ListTeamsAssignedToOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1729/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2}
+	teams, _, err := client.Organizations.ListTeamsAssignedToOrgRole(ctx, "o", 1729, opts)
+	if err != nil {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned error: %v", err)
+	}
+
+	want := []*Team{{ID: Int64(1)}}
+	if !cmp.Equal(teams, want) {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned %+v, want %+v", teams, want)
+	}
+
+	const methodName = "ListTeamsAssignedToOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListTeamsAssignedToOrgRole(ctx, "\no", 1729, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListTeamsAssignedToOrgRole(ctx, "o", 1729, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ListUsersAssignedToOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1729/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2}
+	users, _, err := client.Organizations.ListUsersAssignedToOrgRole(ctx, "o", 1729, opts)
+	if err != nil {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned error: %v", err)
+	}
+
+	want := []*User{{ID: Int64(1)}}
+	if !cmp.Equal(users, want) {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned %+v, want %+v", users, want)
+	}
+
+	const methodName = "ListUsersAssignedToOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListUsersAssignedToOrgRole(ctx, "\no", 1729, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListUsersAssignedToOrgRole(ctx, "o", 1729, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
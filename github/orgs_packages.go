@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // List the packages for an organization.
@@ -38,7 +39,7 @@ func (s *OrganizationsService) ListPackages(ctx context.Context, org string, opt
 //
 // GitHub API docs: https://docs.github.com/en/rest/packages#get-a-package-for-an-organization
 func (s *OrganizationsService) GetPackage(ctx context.Context, org, packageType, packageName string) (*Package, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/packages/%v/%v", org, packageType, packageName)
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v", org, packageType, url.PathEscape(packageName))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -57,7 +58,7 @@ func (s *OrganizationsService) GetPackage(ctx context.Context, org, packageType,
 //
 // GitHub API docs: https://docs.github.com/en/rest/packages#delete-a-package-for-an-organization
 func (s *OrganizationsService) DeletePackage(ctx context.Context, org, packageType, packageName string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/packages/%v/%v", org, packageType, packageName)
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v", org, packageType, url.PathEscape(packageName))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -70,7 +71,7 @@ func (s *OrganizationsService) DeletePackage(ctx context.Context, org, packageTy
 //
 // GitHub API docs: https://docs.github.com/en/rest/packages#restore-a-package-for-an-organization
 func (s *OrganizationsService) RestorePackage(ctx context.Context, org, packageType, packageName string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/packages/%v/%v/restore", org, packageType, packageName)
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/restore", org, packageType, url.PathEscape(packageName))
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, err
@@ -83,7 +84,7 @@ func (s *OrganizationsService) RestorePackage(ctx context.Context, org, packageT
 //
 // GitHub API docs: https://docs.github.com/en/rest/packages#list-package-versions-for-a-package-owned-by-an-organization
 func (s *OrganizationsService) PackageGetAllVersions(ctx context.Context, org, packageType, packageName string, opts *PackageListOptions) ([]*PackageVersion, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions", org, packageType, packageName)
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions", org, packageType, url.PathEscape(packageName))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -107,7 +108,7 @@ func (s *OrganizationsService) PackageGetAllVersions(ctx context.Context, org, p
 //
 // GitHub API docs: https://docs.github.com/en/rest/packages#get-a-package-version-for-an-organization
 func (s *OrganizationsService) PackageGetVersion(ctx context.Context, org, packageType, packageName string, packageVersionID int64) (*PackageVersion, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v", org, packageType, packageName, packageVersionID)
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v", org, packageType, url.PathEscape(packageName), packageVersionID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -126,7 +127,7 @@ func (s *OrganizationsService) PackageGetVersion(ctx context.Context, org, packa
 //
 // GitHub API docs: https://docs.github.com/en/rest/packages#delete-package-version-for-an-organization
 func (s *OrganizationsService) PackageDeleteVersion(ctx context.Context, org, packageType, packageName string, packageVersionID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v", org, packageType, packageName, packageVersionID)
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v", org, packageType, url.PathEscape(packageName), packageVersionID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -139,7 +140,7 @@ func (s *OrganizationsService) PackageDeleteVersion(ctx context.Context, org, pa
 //
 // GitHub API docs: https://docs.github.com/en/rest/packages#restore-package-version-for-an-organization
 func (s *OrganizationsService) PackageRestoreVersion(ctx context.Context, org, packageType, packageName string, packageVersionID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v/restore", org, packageType, packageName, packageVersionID)
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v/restore", org, packageType, url.PathEscape(packageName), packageVersionID)
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, err
@@ -147,3 +148,31 @@ func (s *OrganizationsService) PackageRestoreVersion(ctx context.Context, org, p
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// PackageGetVersionByTag gets the version of a container package of an
+// organization with the given tag. It returns a nil version if no version
+// has the tag.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#list-package-versions-for-a-package-owned-by-an-organization
+func (s *OrganizationsService) PackageGetVersionByTag(ctx context.Context, org, packageName, tag string) (*PackageVersion, *Response, error) {
+	list := func(ctx context.Context, listOpts *PackageListOptions) ([]*PackageVersion, *Response, error) {
+		return s.PackageGetAllVersions(ctx, org, "container", packageName, listOpts)
+	}
+	return findPackageVersionByTag(ctx, list, tag)
+}
+
+// PackageDeleteUntaggedVersions deletes the untagged versions of a container
+// package of an organization which were last updated before opts.Before,
+// waiting opts.Interval between deletions. It returns the deleted versions,
+// including when it fails part way.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#delete-package-version-for-an-organization
+func (s *OrganizationsService) PackageDeleteUntaggedVersions(ctx context.Context, org, packageName string, opts *DeleteUntaggedVersionsOptions) ([]*PackageVersion, *Response, error) {
+	list := func(ctx context.Context, listOpts *PackageListOptions) ([]*PackageVersion, *Response, error) {
+		return s.PackageGetAllVersions(ctx, org, "container", packageName, listOpts)
+	}
+	del := func(ctx context.Context, id int64) (*Response, error) {
+		return s.PackageDeleteVersion(ctx, org, "container", packageName, id)
+	}
+	return deleteUntaggedPackageVersions(ctx, list, del, opts)
+}
@@ -404,3 +404,21 @@ func TestOrganizationsService_PackageRestoreVersion(t *testing.T) {
 		return client.Organizations.PackageRestoreVersion(ctx, "", "", "", 45763)
 	})
 }
+
+func TestOrganizationsService_PackageRestoreVersion_nameWithSlash(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/hello/hello_docker/versions/45763/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got, want := r.URL.EscapedPath(), "/orgs/o/packages/container/hello%2Fhello_docker/versions/45763/restore"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.PackageRestoreVersion(ctx, "o", "container", "hello/hello_docker", 45763)
+	if err != nil {
+		t.Errorf("Organizations.PackageRestoreVersion returned error: %v", err)
+	}
+}
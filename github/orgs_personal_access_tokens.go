@@ -0,0 +1,272 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PersonalAccessTokenPermissions represents the permissions granted to a
+// fine-grained personal access token, grouped by scope and keyed by
+// permission name. Values are "read" or "write".
+type PersonalAccessTokenPermissions struct {
+	Org   map[string]string `json:"organization,omitempty"`
+	Repo  map[string]string `json:"repository,omitempty"`
+	Other map[string]string `json:"other,omitempty"`
+}
+
+// PersonalAccessToken represents a fine-grained personal access token
+// granted access to an organization.
+type PersonalAccessToken struct {
+	// ID is the unique identifier of the token's grant to the organization.
+	ID    *int64 `json:"id,omitempty"`
+	Owner *User  `json:"owner,omitempty"`
+	// RepositorySelection is the type of repository selection requested.
+	// Possible values are "none", "all" and "subset".
+	RepositorySelection *string                         `json:"repository_selection,omitempty"`
+	RepositoriesURL     *string                         `json:"repositories_url,omitempty"`
+	Permissions         *PersonalAccessTokenPermissions `json:"permissions,omitempty"`
+	AccessGrantedAt     *Timestamp                      `json:"access_granted_at,omitempty"`
+	TokenID             *int64                          `json:"token_id,omitempty"`
+	TokenName           *string                         `json:"token_name,omitempty"`
+	TokenExpired        *bool                           `json:"token_expired,omitempty"`
+	TokenExpiresAt      *Timestamp                      `json:"token_expires_at,omitempty"`
+	TokenLastUsedAt     *Timestamp                      `json:"token_last_used_at,omitempty"`
+}
+
+// PersonalAccessTokenRequest represents a pending request for a
+// fine-grained personal access token to access an organization.
+type PersonalAccessTokenRequest struct {
+	// ID is the unique identifier of the request.
+	ID     *int64  `json:"id,omitempty"`
+	Reason *string `json:"reason,omitempty"`
+	Owner  *User   `json:"owner,omitempty"`
+	// RepositorySelection is the type of repository selection requested.
+	// Possible values are "none", "all" and "subset".
+	RepositorySelection *string                         `json:"repository_selection,omitempty"`
+	RepositoriesURL     *string                         `json:"repositories_url,omitempty"`
+	Permissions         *PersonalAccessTokenPermissions `json:"permissions,omitempty"`
+	CreatedAt           *Timestamp                      `json:"created_at,omitempty"`
+	TokenID             *int64                          `json:"token_id,omitempty"`
+	TokenName           *string                         `json:"token_name,omitempty"`
+	TokenExpired        *bool                           `json:"token_expired,omitempty"`
+	TokenExpiresAt      *Timestamp                      `json:"token_expires_at,omitempty"`
+	TokenLastUsedAt     *Timestamp                      `json:"token_last_used_at,omitempty"`
+}
+
+// ListFineGrainedPATOptions specifies the optional parameters to the
+// OrganizationsService.ListFineGrainedPersonalAccessTokens and
+// OrganizationsService.ListFineGrainedPersonalAccessTokenRequests methods.
+type ListFineGrainedPATOptions struct {
+	// Sort specifies how to sort the results. The only possible value is "created_at".
+	Sort string `url:"sort,omitempty"`
+	// Direction in which to sort. Possible values are "asc" and "desc".
+	Direction string `url:"direction,omitempty"`
+	// Owner filters by the logins of the token owners.
+	Owner []string `url:"owner[],omitempty"`
+	// Repository filters by the name of a repository the token can access.
+	Repository string `url:"repository,omitempty"`
+	// Permission filters by the name of a permission granted to the token.
+	Permission string `url:"permission,omitempty"`
+	// LastUsedBefore filters by tokens last used before this time.
+	LastUsedBefore time.Time `url:"last_used_before,omitempty"`
+	// LastUsedAfter filters by tokens last used after this time.
+	LastUsedAfter time.Time `url:"last_used_after,omitempty"`
+
+	ListOptions
+}
+
+// ListFineGrainedPersonalAccessTokens lists the fine-grained personal access
+// tokens with access to resources owned by an organization. Only GitHub Apps
+// can call this API, using the organization_personal_access_tokens: read permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/personal-access-tokens#list-fine-grained-personal-access-tokens-with-access-to-organization-resources
+func (s *OrganizationsService) ListFineGrainedPersonalAccessTokens(ctx context.Context, org string, opts *ListFineGrainedPATOptions) ([]*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tokens []*PersonalAccessToken
+	resp, err := s.client.Do(ctx, req, &tokens)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tokens, resp, nil
+}
+
+// revokePATRequest represents the body of a request revoking fine-grained
+// personal access tokens.
+type revokePATRequest struct {
+	Action string  `json:"action"`
+	PATIDs []int64 `json:"pat_ids,omitempty"`
+}
+
+// RevokeFineGrainedPersonalAccessToken revokes the access of a fine-grained
+// personal access token to an organization. Only GitHub Apps can call this API,
+// using the organization_personal_access_tokens: write permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/personal-access-tokens#update-the-access-a-fine-grained-personal-access-token-has-to-organization-resources
+func (s *OrganizationsService) RevokeFineGrainedPersonalAccessToken(ctx context.Context, org string, patID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens/%v", org, patID)
+
+	req, err := s.client.NewRequest("POST", u, &revokePATRequest{Action: "revoke"})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RevokeFineGrainedPersonalAccessTokens revokes the access of multiple
+// fine-grained personal access tokens to an organization. The tokens are
+// revoked asynchronously; GitHub responds with 202 Accepted, which is
+// returned as an *AcceptedError. Only GitHub Apps can call this API, using
+// the organization_personal_access_tokens: write permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/personal-access-tokens#update-the-access-to-organization-resources-via-fine-grained-personal-access-tokens
+func (s *OrganizationsService) RevokeFineGrainedPersonalAccessTokens(ctx context.Context, org string, patIDs []int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens", org)
+
+	req, err := s.client.NewRequest("POST", u, &revokePATRequest{Action: "revoke", PATIDs: patIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListFineGrainedPersonalAccessTokenRepositories lists the repositories a
+// fine-grained personal access token has access to in an organization.
+// Only GitHub Apps can call this API, using the
+// organization_personal_access_tokens: read permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/personal-access-tokens#list-repositories-a-fine-grained-personal-access-token-has-access-to
+func (s *OrganizationsService) ListFineGrainedPersonalAccessTokenRepositories(ctx context.Context, org string, patID int64, opts *ListOptions) ([]*Repository, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens/%v/repositories", org, patID)
+	return s.listPATRepositories(ctx, u, opts)
+}
+
+// ListFineGrainedPersonalAccessTokenRequests lists the requests from
+// organization members to access organization resources with a fine-grained
+// personal access token. Only GitHub Apps can call this API, using the
+// organization_personal_access_token_requests: read permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/personal-access-tokens#list-requests-to-access-organization-resources-with-fine-grained-personal-access-tokens
+func (s *OrganizationsService) ListFineGrainedPersonalAccessTokenRequests(ctx context.Context, org string, opts *ListFineGrainedPATOptions) ([]*PersonalAccessTokenRequest, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*PersonalAccessTokenRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
+// ReviewPersonalAccessTokenRequestOptions specifies the parameters to the
+// OrganizationsService.ReviewPersonalAccessTokenRequest and
+// OrganizationsService.ReviewPersonalAccessTokenRequests methods.
+type ReviewPersonalAccessTokenRequestOptions struct {
+	// Action to take on the request. Possible values are "approve" and "deny". (Required.)
+	Action string `json:"action"`
+	// Reason for approving or denying the request. Max 1024 characters.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// reviewPATRequestsRequest represents the body of a request reviewing
+// multiple fine-grained personal access token requests.
+type reviewPATRequestsRequest struct {
+	PATRequestIDs []int64 `json:"pat_request_ids,omitempty"`
+	*ReviewPersonalAccessTokenRequestOptions
+}
+
+// ReviewPersonalAccessTokenRequest approves or denies a pending request to
+// access organization resources via a fine-grained personal access token.
+// Only GitHub Apps can call this API, using the
+// organization_personal_access_token_requests: write permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/personal-access-tokens#review-a-request-to-access-organization-resources-with-a-fine-grained-personal-access-token
+func (s *OrganizationsService) ReviewPersonalAccessTokenRequest(ctx context.Context, org string, requestID int64, opts *ReviewPersonalAccessTokenRequestOptions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests/%v", org, requestID)
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ReviewPersonalAccessTokenRequests approves or denies multiple pending
+// requests to access organization resources via fine-grained personal access
+// tokens. The requests are reviewed asynchronously; GitHub responds with
+// 202 Accepted, which is returned as an *AcceptedError. Only GitHub Apps can
+// call this API, using the organization_personal_access_token_requests: write
+// permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/personal-access-tokens#review-requests-to-access-organization-resources-with-fine-grained-personal-access-tokens
+func (s *OrganizationsService) ReviewPersonalAccessTokenRequests(ctx context.Context, org string, requestIDs []int64, opts *ReviewPersonalAccessTokenRequestOptions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests", org)
+
+	body := &reviewPATRequestsRequest{PATRequestIDs: requestIDs, ReviewPersonalAccessTokenRequestOptions: opts}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListFineGrainedPersonalAccessTokenRequestRepositories lists the
+// repositories a fine-grained personal access token request is requesting
+// access to. Only GitHub Apps can call this API, using the
+// organization_personal_access_token_requests: read permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/personal-access-tokens#list-repositories-requested-to-be-accessed-by-a-fine-grained-personal-access-token
+func (s *OrganizationsService) ListFineGrainedPersonalAccessTokenRequestRepositories(ctx context.Context, org string, requestID int64, opts *ListOptions) ([]*Repository, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests/%v/repositories", org, requestID)
+	return s.listPATRepositories(ctx, u, opts)
+}
+
+func (s *OrganizationsService) listPATRepositories(ctx context.Context, u string, opts *ListOptions) ([]*Repository, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var repos []*Repository
+	resp, err := s.client.Do(ctx, req, &repos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repos, resp, nil
+}
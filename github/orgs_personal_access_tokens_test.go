@@ -0,0 +1,310 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_ListFineGrainedPersonalAccessTokens(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := url.Values{
+			"sort":             {"created_at"},
+			"direction":        {"desc"},
+			"owner[]":          {"a", "b"},
+			"last_used_before": {"2023-01-02T00:00:00Z"},
+			"page":             {"2"},
+		}
+		if got := r.URL.Query(); !cmp.Equal(got, want) {
+			t.Errorf("Request parameters: %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `[{
+			"id": 25381,
+			"owner": {"login": "octocat"},
+			"repository_selection": "subset",
+			"permissions": {"organization": {"members": "read"}, "repository": {"metadata": "read"}},
+			"access_granted_at": `+referenceTimeStr+`,
+			"token_expired": false
+		}]`)
+	})
+
+	opts := &ListFineGrainedPATOptions{
+		Sort:           "created_at",
+		Direction:      "desc",
+		Owner:          []string{"a", "b"},
+		LastUsedBefore: time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+		ListOptions:    ListOptions{Page: 2},
+	}
+	ctx := context.Background()
+	tokens, _, err := client.Organizations.ListFineGrainedPersonalAccessTokens(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokens returned error: %v", err)
+	}
+
+	want := []*PersonalAccessToken{{
+		ID:                  Int64(25381),
+		Owner:               &User{Login: String("octocat")},
+		RepositorySelection: String("subset"),
+		Permissions: &PersonalAccessTokenPermissions{
+			Org:  map[string]string{"members": "read"},
+			Repo: map[string]string{"metadata": "read"},
+		},
+		AccessGrantedAt: &Timestamp{referenceTime},
+		TokenExpired:    Bool(false),
+	}}
+	if !cmp.Equal(tokens, want) {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokens returned %+v, want %+v", tokens, want)
+	}
+
+	const methodName = "ListFineGrainedPersonalAccessTokens"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFineGrainedPersonalAccessTokens(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFineGrainedPersonalAccessTokens(ctx, "o", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_RevokeFineGrainedPersonalAccessToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"action":"revoke"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.RevokeFineGrainedPersonalAccessToken(ctx, "o", 1); err != nil {
+		t.Errorf("Organizations.RevokeFineGrainedPersonalAccessToken returned error: %v", err)
+	}
+
+	const methodName = "RevokeFineGrainedPersonalAccessToken"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RevokeFineGrainedPersonalAccessToken(ctx, "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RevokeFineGrainedPersonalAccessToken(ctx, "o", 1)
+	})
+}
+
+func TestOrganizationsService_RevokeFineGrainedPersonalAccessTokens(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"action":"revoke","pat_ids":[1,2]}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.RevokeFineGrainedPersonalAccessTokens(ctx, "o", []int64{1, 2})
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Organizations.RevokeFineGrainedPersonalAccessTokens returned error %v, want *AcceptedError", err)
+	}
+
+	const methodName = "RevokeFineGrainedPersonalAccessTokens"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RevokeFineGrainedPersonalAccessTokens(ctx, "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RevokeFineGrainedPersonalAccessTokens(ctx, "o", []int64{1})
+	})
+}
+
+func TestOrganizationsService_ListFineGrainedPersonalAccessTokenRepositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens/1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2}
+	repos, _, err := client.Organizations.ListFineGrainedPersonalAccessTokenRepositories(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokenRepositories returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1)}}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokenRepositories returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListFineGrainedPersonalAccessTokenRepositories"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFineGrainedPersonalAccessTokenRepositories(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFineGrainedPersonalAccessTokenRepositories(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ListFineGrainedPersonalAccessTokenRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"permission": "contents", "repository": "r"})
+		fmt.Fprint(w, `[{"id":25381,"reason":"deploys","owner":{"login":"octocat"},"created_at":`+referenceTimeStr+`,"token_id":4,"token_name":"deploy"}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListFineGrainedPATOptions{Permission: "contents", Repository: "r"}
+	requests, _, err := client.Organizations.ListFineGrainedPersonalAccessTokenRequests(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokenRequests returned error: %v", err)
+	}
+
+	want := []*PersonalAccessTokenRequest{{
+		ID:        Int64(25381),
+		Reason:    String("deploys"),
+		Owner:     &User{Login: String("octocat")},
+		CreatedAt: &Timestamp{referenceTime},
+		TokenID:   Int64(4),
+		TokenName: String("deploy"),
+	}}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokenRequests returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListFineGrainedPersonalAccessTokenRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFineGrainedPersonalAccessTokenRequests(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFineGrainedPersonalAccessTokenRequests(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ReviewPersonalAccessTokenRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"action":"deny","reason":"too broad"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	opts := &ReviewPersonalAccessTokenRequestOptions{Action: "deny", Reason: String("too broad")}
+	if _, err := client.Organizations.ReviewPersonalAccessTokenRequest(ctx, "o", 1, opts); err != nil {
+		t.Errorf("Organizations.ReviewPersonalAccessTokenRequest returned error: %v", err)
+	}
+
+	const methodName = "ReviewPersonalAccessTokenRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.ReviewPersonalAccessTokenRequest(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.ReviewPersonalAccessTokenRequest(ctx, "o", 1, opts)
+	})
+}
+
+func TestOrganizationsService_ReviewPersonalAccessTokenRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"pat_request_ids":[1,2],"action":"approve"}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	opts := &ReviewPersonalAccessTokenRequestOptions{Action: "approve"}
+	_, err := client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "o", []int64{1, 2}, opts)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Organizations.ReviewPersonalAccessTokenRequests returned error %v, want *AcceptedError", err)
+	}
+
+	const methodName = "ReviewPersonalAccessTokenRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "\n", nil, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "o", []int64{1}, opts)
+	})
+}
+
+func TestOrganizationsService_ListFineGrainedPersonalAccessTokenRequestRepositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests/1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	repos, _, err := client.Organizations.ListFineGrainedPersonalAccessTokenRequestRepositories(ctx, "o", 1, nil)
+	if err != nil {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokenRequestRepositories returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1)}}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokenRequestRepositories returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListFineGrainedPersonalAccessTokenRequestRepositories"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFineGrainedPersonalAccessTokenRequestRepositories(ctx, "\n", 1, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFineGrainedPersonalAccessTokenRequestRepositories(ctx, "o", 1, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
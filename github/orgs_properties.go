@@ -0,0 +1,27 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// CustomProperty represents the definition of an organization custom property.
+type CustomProperty struct {
+	PropertyName *string `json:"property_name,omitempty"`
+	// ValueType is one of "string", "single_select", "multi_select" or "true_false".
+	ValueType     string   `json:"value_type"`
+	Required      *bool    `json:"required,omitempty"`
+	DefaultValue  *string  `json:"default_value,omitempty"`
+	Description   *string  `json:"description,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+	// ValuesEditableBy is one of "org_actors" or "org_and_repo_actors".
+	ValuesEditableBy *string `json:"values_editable_by,omitempty"`
+}
+
+// CustomPropertyValue represents the value of a custom property for a
+// repository. Value is a string, a []interface{} of strings for
+// multi_select properties, or nil when the property is unset.
+type CustomPropertyValue struct {
+	PropertyName string      `json:"property_name"`
+	Value        interface{} `json:"value"`
+}
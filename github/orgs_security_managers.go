@@ -31,6 +31,8 @@ func (s *OrganizationsService) ListSecurityManagerTeams(ctx context.Context, org
 }
 
 // AddSecurityManagerTeam adds a team to the list of security managers for an organization.
+// team is the slug of the team. Adding a team that already is a security manager
+// team succeeds without changes, so provisioning code can call it unconditionally.
 //
 // GitHub API docs: https://docs.github.com/en/rest/orgs/security-managers#add-a-security-manager-team
 func (s *OrganizationsService) AddSecurityManagerTeam(ctx context.Context, org, team string) (*Response, error) {
@@ -44,6 +46,7 @@ func (s *OrganizationsService) AddSecurityManagerTeam(ctx context.Context, org,
 }
 
 // RemoveSecurityManagerTeam removes a team from the list of security managers for an organization.
+// team is the slug of the team.
 //
 // GitHub API docs: https://docs.github.com/en/rest/orgs/security-managers#remove-a-security-manager-team
 func (s *OrganizationsService) RemoveSecurityManagerTeam(ctx context.Context, org, team string) (*Response, error) {
@@ -0,0 +1,208 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// defaultStaleDeployKeyAge is the age after which an unused deploy key is
+// reported as stale when OrgSecurityPostureOptions.StaleDeployKeyAge is unset.
+const defaultStaleDeployKeyAge = 90 * 24 * time.Hour
+
+// defaultMinRateRemaining is the number of remaining requests below which
+// multi-request helpers pause until the rate limit resets.
+const defaultMinRateRemaining = 100
+
+// OrgSecurityPostureOptions specifies the optional parameters to the
+// OrganizationsService.GetSecurityPosture method.
+type OrgSecurityPostureOptions struct {
+	// StaleDeployKeyAge is the duration after which a deploy key that has not
+	// been used is reported as stale. Default is 90 days.
+	StaleDeployKeyAge time.Duration
+
+	// MinRateRemaining pauses the scan until the rate limit resets whenever
+	// fewer than this many requests remain. Default is 100.
+	MinRateRemaining int
+
+	// IncludeArchived includes archived repositories in the scan.
+	IncludeArchived bool
+}
+
+// StaleDeployKey represents a deploy key that has not been used recently.
+type StaleDeployKey struct {
+	Repository *Repository `json:"repository,omitempty"`
+	Key        *Key        `json:"key,omitempty"`
+}
+
+// OrgSecurityPosture summarizes the security posture of an organization.
+type OrgSecurityPosture struct {
+	// MembersWithout2FA lists the members that have not enabled two-factor authentication.
+	MembersWithout2FA []*User `json:"members_without_2fa,omitempty"`
+	// UnprotectedRepos lists the repositories whose default branch is not
+	// covered by a branch protection rule or ruleset.
+	UnprotectedRepos []*Repository `json:"unprotected_repos,omitempty"`
+	// SecretScanningDisabled lists the repositories with secret scanning
+	// disabled. Repositories whose security and analysis settings are not
+	// visible to the caller are not reported.
+	SecretScanningDisabled []*Repository `json:"secret_scanning_disabled,omitempty"`
+	// StaleDeployKeys lists the deploy keys not used within the configured age.
+	StaleDeployKeys []*StaleDeployKey `json:"stale_deploy_keys,omitempty"`
+	// UncheckedRepos lists the repositories that could not be fully
+	// scanned, such as empty repositories, which have no default branch to
+	// check, or repositories whose deploy keys the caller cannot list.
+	UncheckedRepos []*UncheckedRepo `json:"unchecked_repos,omitempty"`
+}
+
+// UncheckedRepo represents a repository that GetSecurityPosture could not
+// fully scan, with the error that stopped its scan.
+type UncheckedRepo struct {
+	Repository *Repository `json:"repository,omitempty"`
+	Err        error       `json:"-"`
+}
+
+// GetSecurityPosture scans an organization and reports its members without
+// two-factor authentication, repositories with an unprotected default branch
+// or with secret scanning disabled, and stale deploy keys.
+//
+// The scan issues several requests per repository; it pauses whenever the
+// remaining rate limit drops below opts.MinRateRemaining. A repository for
+// which GitHub responds with a client error, such as 404 Not Found or 403
+// Forbidden, is reported in UncheckedRepos and the scan goes on with the
+// next one.
+func (s *OrganizationsService) GetSecurityPosture(ctx context.Context, org string, opts *OrgSecurityPostureOptions) (*OrgSecurityPosture, *Response, error) {
+	if opts == nil {
+		opts = &OrgSecurityPostureOptions{}
+	}
+	staleAge := opts.StaleDeployKeyAge
+	if staleAge <= 0 {
+		staleAge = defaultStaleDeployKeyAge
+	}
+	minRemaining := opts.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	posture := &OrgSecurityPosture{}
+	var lastResp *Response
+	pace := func(resp *Response) error {
+		lastResp = resp
+		return waitForRateLimit(ctx, resp, minRemaining)
+	}
+
+	memberOpts := &ListMembersOptions{Filter: "2fa_disabled", ListOptions: ListOptions{PerPage: 100}}
+	for {
+		members, resp, err := s.ListMembers(ctx, org, memberOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		if err := pace(resp); err != nil {
+			return nil, resp, err
+		}
+		posture.MembersWithout2FA = append(posture.MembersWithout2FA, members...)
+		if resp.NextPage == 0 {
+			break
+		}
+		memberOpts.Page = resp.NextPage
+	}
+
+	var repos []*Repository
+	repoOpts := &RepositoryListByOrgOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		page, resp, err := s.client.Repositories.ListByOrg(ctx, org, repoOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		if err := pace(resp); err != nil {
+			return nil, resp, err
+		}
+		repos = append(repos, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		repoOpts.Page = resp.NextPage
+	}
+
+	staleBefore := time.Now().Add(-staleAge)
+	scanRepo := func(repo *Repository, owner, name string) (*Response, error) {
+		if branch := repo.GetDefaultBranch(); branch != "" {
+			b, resp, err := s.client.Repositories.GetBranch(ctx, owner, name, branch, false)
+			if err != nil {
+				return resp, err
+			}
+			if err := pace(resp); err != nil {
+				return resp, err
+			}
+			if !b.GetProtected() {
+				posture.UnprotectedRepos = append(posture.UnprotectedRepos, repo)
+			}
+		}
+
+		keyOpts := &ListOptions{PerPage: 100}
+		for {
+			keys, resp, err := s.client.Repositories.ListKeys(ctx, owner, name, keyOpts)
+			if err != nil {
+				return resp, err
+			}
+			if err := pace(resp); err != nil {
+				return resp, err
+			}
+			for _, key := range keys {
+				lastUsed := key.GetLastUsed()
+				if lastUsed.IsZero() {
+					lastUsed = key.GetCreatedAt()
+				}
+				if lastUsed.Before(staleBefore) {
+					posture.StaleDeployKeys = append(posture.StaleDeployKeys, &StaleDeployKey{Repository: repo, Key: key})
+				}
+			}
+			if resp.NextPage == 0 {
+				return resp, nil
+			}
+			keyOpts.Page = resp.NextPage
+		}
+	}
+
+	for _, repo := range repos {
+		if repo.GetArchived() && !opts.IncludeArchived {
+			continue
+		}
+		owner, name := repo.GetOwner().GetLogin(), repo.GetName()
+		if owner == "" {
+			owner = org
+		}
+
+		if status := repo.GetSecurityAndAnalysis().GetSecretScanning().GetStatus(); status != "" && status != "enabled" {
+			posture.SecretScanningDisabled = append(posture.SecretScanningDisabled, repo)
+		}
+
+		resp, err := scanRepo(repo, owner, name)
+		if err != nil {
+			if !isRepoScanError(resp, err) {
+				return nil, resp, err
+			}
+			lastResp = resp
+			posture.UncheckedRepos = append(posture.UncheckedRepos, &UncheckedRepo{Repository: repo, Err: err})
+		}
+	}
+
+	return posture, lastResp, nil
+}
+
+// isRepoScanError reports whether err, returned with resp while scanning a
+// repository, only concerns that repository: GitHub responded with a client
+// error other than a rate limit, such as 404 Not Found for the default
+// branch of an empty repository or 403 Forbidden without admin rights.
+func isRepoScanError(resp *Response, err error) bool {
+	var rateLimitErr *RateLimitError
+	var abuseErr *AbuseRateLimitError
+	if errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr) {
+		return false
+	}
+	return resp != nil && resp.StatusCode >= 400 && resp.StatusCode < 500
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_GetSecurityPosture(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	recent := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+
+	mux.HandleFunc("/orgs/o/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filter": "2fa_disabled", "per_page": "100"})
+		fmt.Fprint(w, `[{"login":"u1"}]`)
+	})
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"name":"r1","owner":{"login":"o"},"default_branch":"main","security_and_analysis":{"secret_scanning":{"status":"disabled"}}},
+			{"id":2,"name":"r2","owner":{"login":"o"},"default_branch":"main","security_and_analysis":{"secret_scanning":{"status":"enabled"}}},
+			{"id":3,"name":"r3","owner":{"login":"o"},"archived":true},
+			{"id":4,"name":"r4","owner":{"login":"o"},"default_branch":"main"},
+			{"id":5,"name":"r5","owner":{"login":"o"},"default_branch":"main"}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/r1/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"main","protected":false}`)
+	})
+	mux.HandleFunc("/repos/o/r2/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"main","protected":true}`)
+	})
+	mux.HandleFunc("/repos/o/r4/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Branch not found"}`)
+	})
+	mux.HandleFunc("/repos/o/r5/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"main","protected":true}`)
+	})
+	mux.HandleFunc("/repos/o/r5/keys", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Must have admin rights to Repository."}`)
+	})
+	mux.HandleFunc("/repos/o/r1/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `[{"id":1,"created_at":"2020-01-01T00:00:00Z"},{"id":2,"created_at":"2020-01-01T00:00:00Z","last_used":%q}]`, recent)
+	})
+	mux.HandleFunc("/repos/o/r2/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":3,"created_at":"2020-01-01T00:00:00Z","last_used":"2021-01-01T00:00:00Z"}]`)
+	})
+
+	ctx := context.Background()
+	posture, _, err := client.Organizations.GetSecurityPosture(ctx, "o", nil)
+	if err != nil {
+		t.Fatalf("Organizations.GetSecurityPosture returned error: %v", err)
+	}
+
+	var ids []int64
+	for _, k := range posture.StaleDeployKeys {
+		ids = append(ids, k.GetKey().GetID())
+	}
+	if want := []int64{1, 3}; !cmp.Equal(ids, want) {
+		t.Errorf("Organizations.GetSecurityPosture returned stale key IDs %v, want %v", ids, want)
+	}
+	if got := posture.StaleDeployKeys[0].GetRepository().GetName(); got != "r1" {
+		t.Errorf("Organizations.GetSecurityPosture returned stale key repository %v, want r1", got)
+	}
+
+	if want := []*User{{Login: String("u1")}}; !cmp.Equal(posture.MembersWithout2FA, want) {
+		t.Errorf("Organizations.GetSecurityPosture returned members %+v, want %+v", posture.MembersWithout2FA, want)
+	}
+	if len(posture.UnprotectedRepos) != 1 || posture.UnprotectedRepos[0].GetName() != "r1" {
+		t.Errorf("Organizations.GetSecurityPosture returned unprotected repos %+v, want [r1]", posture.UnprotectedRepos)
+	}
+	if len(posture.SecretScanningDisabled) != 1 || posture.SecretScanningDisabled[0].GetName() != "r1" {
+		t.Errorf("Organizations.GetSecurityPosture returned secret scanning disabled repos %+v, want [r1]", posture.SecretScanningDisabled)
+	}
+
+	var unchecked []string
+	for _, u := range posture.UncheckedRepos {
+		if u.Err == nil {
+			t.Errorf("Organizations.GetSecurityPosture returned unchecked repo %v without error", u.GetRepository().GetName())
+		}
+		unchecked = append(unchecked, u.GetRepository().GetName())
+	}
+	if want := []string{"r4", "r5"}; !cmp.Equal(unchecked, want) {
+		t.Errorf("Organizations.GetSecurityPosture returned unchecked repos %v, want %v", unchecked, want)
+	}
+
+	const methodName = "GetSecurityPosture"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetSecurityPosture(ctx, "\n", nil)
+		return err
+	})
+}
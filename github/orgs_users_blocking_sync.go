@@ -0,0 +1,140 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SyncBlockedUsersOptions specifies the optional parameters to the
+// OrganizationsService.SyncBlockedUsers method.
+type SyncBlockedUsersOptions struct {
+	// Unblock unblocks the users blocked from the organization that are not
+	// in the desired set. By default they stay blocked.
+	Unblock bool
+
+	// MinInterval is the minimum delay between two block or unblock
+	// requests. Default is one second.
+	MinInterval time.Duration
+
+	// MinRateRemaining pauses until the rate limit resets whenever fewer
+	// than this many requests remain. Default is 100.
+	MinRateRemaining int
+
+	// DryRun reports the changes that would be made without making them.
+	DryRun bool
+}
+
+// BlockedUsersChange describes the changes made, or that would be made in a
+// dry run, to the blocklist of an organization by
+// OrganizationsService.SyncBlockedUsers.
+type BlockedUsersChange struct {
+	// Blocked lists the logins of the users that were blocked.
+	Blocked []string
+	// Unblocked lists the logins of the users that were unblocked.
+	Unblocked []string
+}
+
+// SyncBlockedUsers makes the users blocked from an organization match the
+// desired logins, so that a blocklist can be kept identical across several
+// organizations. Logins are compared ignoring case. Users in desired that
+// are not blocked yet are blocked; blocked users not in desired are
+// unblocked only if opts.Unblock is set. Requests are spaced by
+// opts.MinInterval, and retried when rejected by the secondary rate limit.
+//
+// On error, the returned change lists the users blocked and unblocked
+// before the failing call.
+func (s *OrganizationsService) SyncBlockedUsers(ctx context.Context, org string, desired []string, opts *SyncBlockedUsersOptions) (*BlockedUsersChange, *Response, error) {
+	if opts == nil {
+		opts = &SyncBlockedUsersOptions{}
+	}
+
+	wanted := make(map[string]bool)
+	for _, login := range desired {
+		if login == "" {
+			return nil, nil, fmt.Errorf("desired login is empty")
+		}
+		wanted[strings.ToLower(login)] = true
+	}
+
+	blocked := make(map[string]bool)
+	var current []string
+	var resp *Response
+	listOpts := &ListOptions{PerPage: 100}
+	for {
+		page, r, err := s.ListBlockedUsers(ctx, org, listOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, user := range page {
+			blocked[strings.ToLower(user.GetLogin())] = true
+			current = append(current, user.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+
+	var toBlock, toUnblock []string
+	for _, login := range desired {
+		key := strings.ToLower(login)
+		if !blocked[key] {
+			blocked[key] = true
+			toBlock = append(toBlock, login)
+		}
+	}
+	if opts.Unblock {
+		for _, login := range current {
+			if !wanted[strings.ToLower(login)] {
+				toUnblock = append(toUnblock, login)
+			}
+		}
+	}
+	if opts.DryRun {
+		return &BlockedUsersChange{Blocked: toBlock, Unblocked: toUnblock}, resp, nil
+	}
+
+	interval := opts.MinInterval
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+	minRemaining := opts.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	pacer := &requestPacer{interval: interval}
+	change := &BlockedUsersChange{}
+	for _, login := range toBlock {
+		err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+			var err error
+			resp, err = s.BlockUser(ctx, org, login)
+			return resp, err
+		})
+		if err != nil {
+			return change, resp, err
+		}
+		change.Blocked = append(change.Blocked, login)
+	}
+	for _, login := range toUnblock {
+		err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+			var err error
+			resp, err = s.UnblockUser(ctx, org, login)
+			return resp, err
+		})
+		if err != nil {
+			return change, resp, err
+		}
+		change.Unblocked = append(change.Unblocked, login)
+	}
+
+	return change, resp, nil
+}
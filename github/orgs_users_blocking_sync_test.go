@@ -0,0 +1,83 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_SyncBlockedUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/blocks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `[{"login":"Spammer"},{"login":"forgiven"}]`)
+	})
+	mux.HandleFunc("/orgs/o/blocks/troll", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/orgs/o/blocks/forgiven", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	change, _, err := client.Organizations.SyncBlockedUsers(ctx, "o", []string{"spammer", "troll"}, &SyncBlockedUsersOptions{Unblock: true, MinInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Organizations.SyncBlockedUsers returned error: %v", err)
+	}
+
+	want := &BlockedUsersChange{Blocked: []string{"troll"}, Unblocked: []string{"forgiven"}}
+	if !cmp.Equal(change, want) {
+		t.Errorf("Organizations.SyncBlockedUsers returned %+v, want %+v", change, want)
+	}
+
+	const methodName = "SyncBlockedUsers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.SyncBlockedUsers(ctx, "\n", []string{"troll"}, nil)
+		return err
+	})
+}
+
+func TestOrganizationsService_SyncBlockedUsers_dryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/blocks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"login":"forgiven"}]`)
+	})
+
+	ctx := context.Background()
+	change, _, err := client.Organizations.SyncBlockedUsers(ctx, "o", []string{"troll"}, &SyncBlockedUsersOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Organizations.SyncBlockedUsers returned error: %v", err)
+	}
+
+	want := &BlockedUsersChange{Blocked: []string{"troll"}}
+	if !cmp.Equal(change, want) {
+		t.Errorf("Organizations.SyncBlockedUsers returned %+v, want %+v", change, want)
+	}
+}
+
+func TestOrganizationsService_SyncBlockedUsers_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, _, err := client.Organizations.SyncBlockedUsers(ctx, "o", []string{""}, nil); err == nil {
+		t.Error("Organizations.SyncBlockedUsers returned nil error, want error")
+	}
+}
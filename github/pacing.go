@@ -0,0 +1,94 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// maxSecondaryRateLimitRetries is the number of times pacedDo retries a
+// request rejected by the secondary rate limit.
+const maxSecondaryRateLimitRetries = 3
+
+// defaultSecondaryRateLimitWait is how long to wait before retrying a
+// request rejected by the secondary rate limit without a Retry-After.
+var defaultSecondaryRateLimitWait = 1 * time.Minute
+
+// defaultBatchInterval is the minimum delay between two requests of the
+// methods that make many requests in a row, when no interval is given.
+// GitHub recommends waiting at least one second between requests that
+// modify content, to avoid the secondary rate limit.
+var defaultBatchInterval = 1 * time.Second
+
+// requestPacer spaces requests made from several goroutines by a minimum
+// interval.
+type requestPacer struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the next request may be made.
+func (p *requestPacer) wait(ctx context.Context) error {
+	p.mu.Lock()
+	at := p.next
+	if now := time.Now(); at.Before(now) {
+		at = now
+	}
+	p.next = at.Add(p.interval)
+	p.mu.Unlock()
+
+	return sleepContext(ctx, time.Until(at))
+}
+
+// pacedDo calls do once pacer allows it, retrying when the request is
+// rejected by the secondary rate limit, then waits for the primary rate
+// limit to reset if fewer than minRemaining requests remain.
+func pacedDo(ctx context.Context, pacer *requestPacer, minRemaining int, do func() (*Response, error)) error {
+	for retries := 0; ; retries++ {
+		if err := pacer.wait(ctx); err != nil {
+			return err
+		}
+
+		resp, err := do()
+		var abuseErr *AbuseRateLimitError
+		if errors.As(err, &abuseErr) && retries < maxSecondaryRateLimitRetries {
+			wait := defaultSecondaryRateLimitWait
+			if abuseErr.RetryAfter != nil {
+				wait = *abuseErr.RetryAfter
+			}
+			if err := sleepContext(ctx, wait); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return waitForRateLimit(ctx, resp, minRemaining)
+	}
+}
+
+// sleepContext blocks for d, or until ctx is done, in which case its error
+// is returned.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -118,6 +118,7 @@ type PackageListOptions struct {
 	PackageType *string `url:"package_type,omitempty"`
 
 	// State of package either "active" or "deleted".
+	// Deleted packages and versions can be restored within 30 days.
 	State *string `url:"state,omitempty"`
 
 	ListOptions
@@ -0,0 +1,133 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Tags returns the tags of a container package version, or nil if the
+// version is untagged or not a container.
+func (pv *PackageVersion) Tags() []string {
+	if pv == nil || pv.Metadata == nil || pv.Metadata.Container == nil {
+		return nil
+	}
+	return pv.Metadata.Container.Tags
+}
+
+// HasTag reports whether a container package version has the given tag.
+func (pv *PackageVersion) HasTag(tag string) bool {
+	for _, t := range pv.Tags() {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteUntaggedVersionsOptions specifies the parameters to the
+// PackageDeleteUntaggedVersions methods.
+type DeleteUntaggedVersionsOptions struct {
+	// Before only deletes versions last updated before this time. (Required.)
+	Before time.Time
+
+	// Interval is the minimum delay between two deletions, to stay below
+	// the secondary rate limits when deleting many versions. Default is one
+	// second.
+	Interval time.Duration
+
+	// MinRateRemaining pauses the deletions until the rate limit resets
+	// whenever fewer than this many requests remain. Default is 100.
+	MinRateRemaining int
+}
+
+// packageVersionsLister lists a page of the versions of a package.
+type packageVersionsLister func(ctx context.Context, opts *PackageListOptions) ([]*PackageVersion, *Response, error)
+
+// listAllPackageVersions lists the active versions of a package, following
+// pagination, and returns those for which keep returns true. It stops at
+// the first version for which stop returns true.
+func listAllPackageVersions(ctx context.Context, list packageVersionsLister, keep func(*PackageVersion) bool, stop bool) ([]*PackageVersion, *Response, error) {
+	opts := &PackageListOptions{ListOptions: ListOptions{PerPage: 100}}
+	var kept []*PackageVersion
+	for {
+		versions, resp, err := list(ctx, opts)
+		if err != nil {
+			return kept, resp, err
+		}
+		for _, v := range versions {
+			if keep(v) {
+				kept = append(kept, v)
+				if stop {
+					return kept, resp, nil
+				}
+			}
+		}
+		if resp.NextPage == 0 {
+			return kept, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// findPackageVersionByTag returns the version with the given tag, or nil.
+func findPackageVersionByTag(ctx context.Context, list packageVersionsLister, tag string) (*PackageVersion, *Response, error) {
+	versions, resp, err := listAllPackageVersions(ctx, list, func(v *PackageVersion) bool {
+		return v.HasTag(tag)
+	}, true)
+	if err != nil || len(versions) == 0 {
+		return nil, resp, err
+	}
+	return versions[0], resp, nil
+}
+
+// deleteUntaggedPackageVersions deletes the untagged versions last updated
+// before opts.Before, and returns the deleted versions.
+func deleteUntaggedPackageVersions(ctx context.Context, list packageVersionsLister, del func(ctx context.Context, id int64) (*Response, error), opts *DeleteUntaggedVersionsOptions) ([]*PackageVersion, *Response, error) {
+	if opts == nil || opts.Before.IsZero() {
+		return nil, nil, errors.New("DeleteUntaggedVersionsOptions.Before must be set")
+	}
+
+	// List all candidates before deleting any, as deleting versions
+	// shifts the later pages.
+	candidates, resp, err := listAllPackageVersions(ctx, list, func(v *PackageVersion) bool {
+		updated := v.GetUpdatedAt()
+		if updated.IsZero() {
+			updated = v.GetCreatedAt()
+		}
+		return len(v.Tags()) == 0 && !updated.IsZero() && updated.Before(opts.Before)
+	}, false)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+	minRemaining := opts.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	pacer := &requestPacer{interval: interval}
+	var deleted []*PackageVersion
+	for _, v := range candidates {
+		err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+			var err error
+			resp, err = del(ctx, v.GetID())
+			return resp, err
+		})
+		if err != nil {
+			return deleted, resp, err
+		}
+		deleted = append(deleted, v)
+	}
+
+	return deleted, resp, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPackageVersion_Tags(t *testing.T) {
+	var nilVersion *PackageVersion
+	if tags := nilVersion.Tags(); tags != nil {
+		t.Errorf("Tags of nil version = %v, want nil", tags)
+	}
+	if tags := (&PackageVersion{}).Tags(); tags != nil {
+		t.Errorf("Tags of version without metadata = %v, want nil", tags)
+	}
+
+	v := &PackageVersion{Metadata: &PackageMetadata{Container: &PackageContainerMetadata{Tags: []string{"latest", "v1"}}}}
+	if got, want := v.Tags(), []string{"latest", "v1"}; !cmp.Equal(got, want) {
+		t.Errorf("Tags = %v, want %v", got, want)
+	}
+	if !v.HasTag("v1") {
+		t.Error("HasTag(v1) = false, want true")
+	}
+	if v.HasTag("v2") {
+		t.Error("HasTag(v2) = true, want false")
+	}
+}
+
+// handlePackageVersionPages serves versions of the container package "p"
+// at path, two per page.
+func handlePackageVersionPages(t *testing.T, mux *http.ServeMux, path string, versions []string) {
+	t.Helper()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscan(p, &page)
+		}
+		if got := r.URL.Query().Get("per_page"); got != "100" {
+			t.Errorf("per_page = %q, want 100", got)
+		}
+		end := page * 2
+		if end < len(versions) {
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com%v?page=%v>; rel="next"`, path, page+1))
+		} else {
+			end = len(versions)
+		}
+		fmt.Fprintf(w, "[%v]", strings.Join(versions[(page-1)*2:end], ","))
+	})
+}
+
+var testContainerVersions = []string{
+	`{"id":1,"updated_at":"2023-01-05T00:00:00Z","metadata":{"package_type":"container","container":{"tags":["latest"]}}}`,
+	`{"id":2,"updated_at":"2023-01-04T00:00:00Z","metadata":{"package_type":"container","container":{"tags":[]}}}`,
+	`{"id":3,"updated_at":"2023-01-03T00:00:00Z","metadata":{"package_type":"container","container":{"tags":["v1"]}}}`,
+	`{"id":4,"updated_at":"2023-01-02T00:00:00Z","metadata":{"package_type":"container","container":{"tags":[]}}}`,
+	`{"id":5,"created_at":"2023-01-01T00:00:00Z","metadata":{"package_type":"container","container":{"tags":[]}}}`,
+}
+
+func TestOrganizationsService_PackageGetVersionByTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePackageVersionPages(t, mux, "/orgs/o/packages/container/p/versions", testContainerVersions)
+
+	ctx := context.Background()
+	version, _, err := client.Organizations.PackageGetVersionByTag(ctx, "o", "p", "v1")
+	if err != nil {
+		t.Errorf("Organizations.PackageGetVersionByTag returned error: %v", err)
+	}
+	if got := version.GetID(); got != 3 {
+		t.Errorf("Organizations.PackageGetVersionByTag returned version %v, want 3", got)
+	}
+
+	version, _, err = client.Organizations.PackageGetVersionByTag(ctx, "o", "p", "v2")
+	if err != nil {
+		t.Errorf("Organizations.PackageGetVersionByTag returned error: %v", err)
+	}
+	if version != nil {
+		t.Errorf("Organizations.PackageGetVersionByTag returned %+v, want nil", version)
+	}
+
+	const methodName = "PackageGetVersionByTag"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.PackageGetVersionByTag(ctx, "\n", "p", "v1")
+		return err
+	})
+}
+
+func TestUsersService_PackageGetVersionByTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePackageVersionPages(t, mux, "/user/packages/container/p/versions", testContainerVersions)
+
+	ctx := context.Background()
+	version, _, err := client.Users.PackageGetVersionByTag(ctx, "", "p", "latest")
+	if err != nil {
+		t.Errorf("Users.PackageGetVersionByTag returned error: %v", err)
+	}
+	if got := version.GetID(); got != 1 {
+		t.Errorf("Users.PackageGetVersionByTag returned version %v, want 1", got)
+	}
+}
+
+func TestOrganizationsService_PackageDeleteUntaggedVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePackageVersionPages(t, mux, "/orgs/o/packages/container/p/versions", testContainerVersions)
+	var deletedIDs []string
+	mux.HandleFunc("/orgs/o/packages/container/p/versions/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deletedIDs = append(deletedIDs, strings.TrimPrefix(r.URL.Path, "/orgs/o/packages/container/p/versions/"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	opts := &DeleteUntaggedVersionsOptions{
+		Before:   time.Date(2023, time.January, 4, 0, 0, 0, 0, time.UTC),
+		Interval: time.Millisecond,
+	}
+	deleted, _, err := client.Organizations.PackageDeleteUntaggedVersions(ctx, "o", "p", opts)
+	if err != nil {
+		t.Errorf("Organizations.PackageDeleteUntaggedVersions returned error: %v", err)
+	}
+
+	var ids []int64
+	for _, v := range deleted {
+		ids = append(ids, v.GetID())
+	}
+	if want := []int64{4, 5}; !cmp.Equal(ids, want) {
+		t.Errorf("Organizations.PackageDeleteUntaggedVersions deleted %v, want %v", ids, want)
+	}
+	if want := []string{"4", "5"}; !cmp.Equal(deletedIDs, want) {
+		t.Errorf("Organizations.PackageDeleteUntaggedVersions sent deletions for %v, want %v", deletedIDs, want)
+	}
+
+	if _, _, err := client.Organizations.PackageDeleteUntaggedVersions(ctx, "o", "p", nil); err == nil {
+		t.Error("Organizations.PackageDeleteUntaggedVersions without Before returned nil error")
+	}
+}
+
+func TestOrganizationsService_PackageDeleteUntaggedVersions_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePackageVersionPages(t, mux, "/orgs/o/packages/container/p/versions", testContainerVersions)
+	mux.HandleFunc("/orgs/o/packages/container/p/versions/5", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/orgs/o/packages/container/p/versions/4", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	opts := &DeleteUntaggedVersionsOptions{Before: time.Date(2023, time.January, 4, 0, 0, 0, 0, time.UTC)}
+	deleted, _, err := client.Organizations.PackageDeleteUntaggedVersions(ctx, "o", "p", opts)
+	if err == nil {
+		t.Error("Organizations.PackageDeleteUntaggedVersions returned nil error")
+	}
+	if len(deleted) != 1 || deleted[0].GetID() != 4 {
+		t.Errorf("Organizations.PackageDeleteUntaggedVersions returned %+v, want version 4", deleted)
+	}
+}
+
+func TestUsersService_PackageDeleteUntaggedVersions_canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePackageVersionPages(t, mux, "/users/u/packages/container/p/versions", testContainerVersions)
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/users/u/packages/container/p/versions/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		// Cancel while waiting for the next deletion.
+		time.AfterFunc(10*time.Millisecond, cancel)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &DeleteUntaggedVersionsOptions{
+		Before:   time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC),
+		Interval: time.Hour,
+	}
+	deleted, _, err := client.Users.PackageDeleteUntaggedVersions(ctx, "u", "p", opts)
+	if err != context.Canceled {
+		t.Errorf("Users.PackageDeleteUntaggedVersions returned error %v, want %v", err, context.Canceled)
+	}
+	if len(deleted) != 1 || deleted[0].GetID() != 2 {
+		t.Errorf("Users.PackageDeleteUntaggedVersions returned %+v, want version 2", deleted)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Types of the lines of a PatchHunk.
+const (
+	PatchLineContext = "context"
+	PatchLineAdded   = "added"
+	PatchLineDeleted = "deleted"
+)
+
+// PatchLine is a single line of a PatchHunk.
+type PatchLine struct {
+	// Type is one of PatchLineContext, PatchLineAdded, or PatchLineDeleted.
+	Type string
+	// Content is the line without its leading "+", "-", or " " marker.
+	Content string
+	// OldLine and NewLine are the line numbers in the old and new versions
+	// of the file. OldLine is 0 for added lines, NewLine is 0 for deleted lines.
+	OldLine int
+	NewLine int
+	// Position is the position of the line in the patch, as expected by the
+	// Position field of a PullRequestComment: the first line below the first
+	// hunk header is 1, and each following line, hunk headers included,
+	// counts as one more.
+	Position int
+	// NoNewlineAtEOF reports that the line is followed by the
+	// "\ No newline at end of file" marker.
+	NoNewlineAtEOF bool
+}
+
+// Side returns the side of the diff the line belongs to, as expected by the
+// Side field of a PullRequestComment: "LEFT" for deleted lines, "RIGHT" otherwise.
+func (l *PatchLine) Side() string {
+	if l.Type == PatchLineDeleted {
+		return "LEFT"
+	}
+	return "RIGHT"
+}
+
+// Line returns the line number of the line on its Side, as expected by the
+// Line field of a PullRequestComment.
+func (l *PatchLine) Line() int {
+	if l.Type == PatchLineDeleted {
+		return l.OldLine
+	}
+	return l.NewLine
+}
+
+// PatchHunk is a hunk of a unified diff, as found in CommitFile.Patch.
+type PatchHunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	// Section is the text following the hunk range, usually the enclosing
+	// function. It may be empty.
+	Section string
+	// Position is the position of the hunk header in the patch.
+	Position int
+	Lines    []*PatchLine
+}
+
+var patchHunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+// ParsePatch parses the unified diff of a single file, such as
+// CommitFile.Patch, into its hunks. An empty patch, as returned for binary
+// or very large files, has no hunks.
+func ParsePatch(patch string) ([]*PatchHunk, error) {
+	if patch == "" {
+		return nil, nil
+	}
+
+	var hunks []*PatchHunk
+	var hunk *PatchHunk
+	var oldLine, newLine int
+	lines := strings.Split(strings.TrimSuffix(patch, "\n"), "\n")
+	for i, text := range lines {
+		if strings.HasPrefix(text, "@@") {
+			m := patchHunkHeader.FindStringSubmatch(text)
+			if m == nil {
+				return nil, fmt.Errorf("invalid hunk header %q", text)
+			}
+			hunk = &PatchHunk{
+				OldStart: atoiOr(m[1], 0),
+				OldLines: atoiOr(m[2], 1),
+				NewStart: atoiOr(m[3], 0),
+				NewLines: atoiOr(m[4], 1),
+				Section:  m[5],
+				Position: i,
+			}
+			hunks = append(hunks, hunk)
+			oldLine, newLine = hunk.OldStart, hunk.NewStart
+			continue
+		}
+		if hunk == nil {
+			return nil, fmt.Errorf("patch does not start with a hunk header: %q", text)
+		}
+
+		line := &PatchLine{Position: i}
+		switch {
+		case strings.HasPrefix(text, `\`):
+			if n := len(hunk.Lines); n > 0 {
+				hunk.Lines[n-1].NoNewlineAtEOF = true
+			}
+			continue
+		case strings.HasPrefix(text, "+"):
+			line.Type = PatchLineAdded
+			line.NewLine = newLine
+			newLine++
+		case strings.HasPrefix(text, "-"):
+			line.Type = PatchLineDeleted
+			line.OldLine = oldLine
+			oldLine++
+		default:
+			// Context lines start with a space, which some tools strip
+			// from empty lines.
+			line.Type = PatchLineContext
+			line.OldLine = oldLine
+			line.NewLine = newLine
+			oldLine++
+			newLine++
+		}
+		if text != "" {
+			line.Content = text[1:]
+		}
+		hunk.Lines = append(hunk.Lines, line)
+	}
+
+	return hunks, nil
+}
+
+// atoiOr returns the integer in s, or def if s is empty.
+func atoiOr(s string, def int) int {
+	if s == "" {
+		return def
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// ParsePatch parses the Patch of the file into its hunks. See ParsePatch.
+func (c *CommitFile) ParsePatch() ([]*PatchHunk, error) {
+	return ParsePatch(c.GetPatch())
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePatch(t *testing.T) {
+	patch := "@@ -1,3 +1,3 @@ package main\n" +
+		" a\n" +
+		"-b\n" +
+		"+c\n" +
+		" d\n" +
+		"@@ -10 +10,2 @@\n" +
+		"-x\n" +
+		"\\ No newline at end of file\n" +
+		"+y\n" +
+		"+z"
+
+	hunks, err := ParsePatch(patch)
+	if err != nil {
+		t.Fatalf("ParsePatch returned error: %v", err)
+	}
+
+	want := []*PatchHunk{
+		{
+			OldStart: 1, OldLines: 3, NewStart: 1, NewLines: 3, Section: "package main", Position: 0,
+			Lines: []*PatchLine{
+				{Type: PatchLineContext, Content: "a", OldLine: 1, NewLine: 1, Position: 1},
+				{Type: PatchLineDeleted, Content: "b", OldLine: 2, Position: 2},
+				{Type: PatchLineAdded, Content: "c", NewLine: 2, Position: 3},
+				{Type: PatchLineContext, Content: "d", OldLine: 3, NewLine: 3, Position: 4},
+			},
+		},
+		{
+			OldStart: 10, OldLines: 1, NewStart: 10, NewLines: 2, Position: 5,
+			Lines: []*PatchLine{
+				{Type: PatchLineDeleted, Content: "x", OldLine: 10, Position: 6, NoNewlineAtEOF: true},
+				{Type: PatchLineAdded, Content: "y", NewLine: 10, Position: 8},
+				{Type: PatchLineAdded, Content: "z", NewLine: 11, Position: 9},
+			},
+		},
+	}
+	if !cmp.Equal(hunks, want) {
+		t.Errorf("ParsePatch returned %+v, want %+v", hunks, want)
+	}
+
+	deleted := hunks[0].Lines[1]
+	if side, line := deleted.Side(), deleted.Line(); side != "LEFT" || line != 2 {
+		t.Errorf("deleted line Side, Line = %v, %v, want LEFT, 2", side, line)
+	}
+	added := hunks[1].Lines[2]
+	if side, line := added.Side(), added.Line(); side != "RIGHT" || line != 11 {
+		t.Errorf("added line Side, Line = %v, %v, want RIGHT, 11", side, line)
+	}
+}
+
+func TestParsePatch_empty(t *testing.T) {
+	hunks, err := (&CommitFile{}).ParsePatch()
+	if err != nil {
+		t.Errorf("ParsePatch returned error: %v", err)
+	}
+	if hunks != nil {
+		t.Errorf("ParsePatch returned %+v, want nil", hunks)
+	}
+}
+
+func TestParsePatch_invalid(t *testing.T) {
+	for _, patch := range []string{"+a", "@@ -a +b @@\n+c"} {
+		if _, err := ParsePatch(patch); err == nil {
+			t.Errorf("ParsePatch(%q) returned nil error, want error", patch)
+		}
+	}
+}
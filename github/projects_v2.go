@@ -0,0 +1,506 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ProjectV2Item represents an item (issue, pull request, or draft issue)
+// in a GitHub Projects (v2) board.
+type ProjectV2Item struct {
+	ID          *int64  `json:"id,omitempty"`
+	NodeID      *string `json:"node_id,omitempty"`
+	ProjectURL  *string `json:"project_url,omitempty"`
+	ItemURL     *string `json:"item_url,omitempty"`
+	ContentType *string `json:"content_type,omitempty"`
+	// ProjectNodeID and ContentNodeID are only populated by webhook events.
+	ProjectNodeID *string `json:"project_node_id,omitempty"`
+	ContentNodeID *string `json:"content_node_id,omitempty"`
+	// Content holds the issue or pull request backing the item. For pull
+	// requests only the fields shared with issues are populated.
+	Content    *Issue                 `json:"content,omitempty"`
+	Creator    *User                  `json:"creator,omitempty"`
+	Fields     []*ProjectV2FieldValue `json:"fields,omitempty"`
+	CreatedAt  *Timestamp             `json:"created_at,omitempty"`
+	UpdatedAt  *Timestamp             `json:"updated_at,omitempty"`
+	ArchivedAt *Timestamp             `json:"archived_at,omitempty"`
+}
+
+// ProjectV2FieldValue represents the value of a field on a GitHub Projects (v2) item.
+type ProjectV2FieldValue struct {
+	ID       *int64      `json:"id,omitempty"`
+	Name     *string     `json:"name,omitempty"`
+	DataType *string     `json:"data_type,omitempty"`
+	Value    interface{} `json:"value,omitempty"`
+}
+
+// ListProjectV2ItemsOptions specifies the optional parameters to the
+// ProjectsService.ListOrgProjectV2Items and ProjectsService.ListUserProjectV2Items methods.
+type ListProjectV2ItemsOptions struct {
+	// Query filters the items using the project filter syntax.
+	Query string `url:"q,omitempty"`
+
+	// Fields limits the field values returned for each item to the given field IDs.
+	Fields []int64 `url:"fields,omitempty,comma"`
+
+	ListCursorOptions
+}
+
+// AddProjectV2ItemOptions specifies the parameters to the
+// ProjectsService.AddOrgProjectV2Item and ProjectsService.AddUserProjectV2Item methods.
+type AddProjectV2ItemOptions struct {
+	// Type is the type of the content to add. Possible values are: "Issue", "PullRequest".
+	Type string `json:"type"`
+	// ID is the ID of the issue or pull request to add.
+	ID int64 `json:"id"`
+}
+
+// ProjectV2FieldUpdate represents a new value for a single field of a project item.
+type ProjectV2FieldUpdate struct {
+	ID    int64       `json:"id"`
+	Value interface{} `json:"value"`
+}
+
+// UpdateProjectV2ItemOptions specifies the parameters to the
+// ProjectsService.UpdateOrgProjectV2Item and ProjectsService.UpdateUserProjectV2Item methods.
+type UpdateProjectV2ItemOptions struct {
+	// Archived archives or unarchives the item.
+	Archived *bool                   `json:"archived,omitempty"`
+	Fields   []*ProjectV2FieldUpdate `json:"fields,omitempty"`
+}
+
+// ProjectV2 represents a GitHub Projects (v2) project.
+type ProjectV2 struct {
+	ID               *int64     `json:"id,omitempty"`
+	NodeID           *string    `json:"node_id,omitempty"`
+	Owner            *User      `json:"owner,omitempty"`
+	Creator          *User      `json:"creator,omitempty"`
+	Title            *string    `json:"title,omitempty"`
+	Description      *string    `json:"description,omitempty"`
+	ShortDescription *string    `json:"short_description,omitempty"`
+	Public           *bool      `json:"public,omitempty"`
+	Number           *int       `json:"number,omitempty"`
+	State            *string    `json:"state,omitempty"`
+	CreatedAt        *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt        *Timestamp `json:"updated_at,omitempty"`
+	ClosedAt         *Timestamp `json:"closed_at,omitempty"`
+	DeletedAt        *Timestamp `json:"deleted_at,omitempty"`
+	DeletedBy        *User      `json:"deleted_by,omitempty"`
+}
+
+// ProjectV2Field represents a field of a GitHub Projects (v2) project.
+type ProjectV2Field struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	// DataType is the type of the field, for example text, number, date,
+	// single_select or iteration.
+	DataType   *string `json:"data_type,omitempty"`
+	ProjectURL *string `json:"project_url,omitempty"`
+	// Options are the options of a single select field.
+	Options   []*ProjectV2FieldOption `json:"options,omitempty"`
+	CreatedAt *Timestamp              `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp              `json:"updated_at,omitempty"`
+}
+
+// ProjectV2FieldOption represents an option of a single select field of a
+// GitHub Projects (v2) project.
+type ProjectV2FieldOption struct {
+	ID          *string `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ListProjectsV2Options specifies the optional parameters to the
+// ProjectsService.ListOrgProjectsV2 and ProjectsService.ListUserProjectsV2 methods.
+type ListProjectsV2Options struct {
+	// Query filters the projects by their title and description.
+	Query string `url:"q,omitempty"`
+
+	ListCursorOptions
+}
+
+// ListOrgProjectsV2 lists the projects of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/projects#list-projects-for-organization
+func (s *ProjectsService) ListOrgProjectsV2(ctx context.Context, org string, opts *ListProjectsV2Options) ([]*ProjectV2, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2", org)
+	return s.listProjectsV2(ctx, u, opts)
+}
+
+// ListUserProjectsV2 lists the projects of a user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/projects#list-projects-for-user
+func (s *ProjectsService) ListUserProjectsV2(ctx context.Context, user string, opts *ListProjectsV2Options) ([]*ProjectV2, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2", user)
+	return s.listProjectsV2(ctx, u, opts)
+}
+
+func (s *ProjectsService) listProjectsV2(ctx context.Context, u string, opts *ListProjectsV2Options) ([]*ProjectV2, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projects []*ProjectV2
+	resp, err := s.client.Do(ctx, req, &projects)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return projects, resp, nil
+}
+
+// GetOrgProjectV2 gets a project of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/projects#get-project-for-organization
+func (s *ProjectsService) GetOrgProjectV2(ctx context.Context, org string, projectNumber int) (*ProjectV2, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v", org, projectNumber)
+	return s.getProjectV2(ctx, u)
+}
+
+// GetUserProjectV2 gets a project of a user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/projects#get-project-for-user
+func (s *ProjectsService) GetUserProjectV2(ctx context.Context, user string, projectNumber int) (*ProjectV2, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v", user, projectNumber)
+	return s.getProjectV2(ctx, u)
+}
+
+func (s *ProjectsService) getProjectV2(ctx context.Context, u string) (*ProjectV2, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(ProjectV2)
+	resp, err := s.client.Do(ctx, req, project)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return project, resp, nil
+}
+
+// ListOrgProjectV2Fields lists the fields of a project of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/fields#list-project-fields-for-organization
+func (s *ProjectsService) ListOrgProjectV2Fields(ctx context.Context, org string, projectNumber int, opts *ListCursorOptions) ([]*ProjectV2Field, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/fields", org, projectNumber)
+	return s.listProjectV2Fields(ctx, u, opts)
+}
+
+// ListUserProjectV2Fields lists the fields of a project of a user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/fields#list-project-fields-for-user
+func (s *ProjectsService) ListUserProjectV2Fields(ctx context.Context, user string, projectNumber int, opts *ListCursorOptions) ([]*ProjectV2Field, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/fields", user, projectNumber)
+	return s.listProjectV2Fields(ctx, u, opts)
+}
+
+func (s *ProjectsService) listProjectV2Fields(ctx context.Context, u string, opts *ListCursorOptions) ([]*ProjectV2Field, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields []*ProjectV2Field
+	resp, err := s.client.Do(ctx, req, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return fields, resp, nil
+}
+
+// ListOrgProjectV2Items lists the items of an organization-owned project.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#list-items-for-an-organization-owned-project
+func (s *ProjectsService) ListOrgProjectV2Items(ctx context.Context, org string, projectNumber int, opts *ListProjectV2ItemsOptions) ([]*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items", org, projectNumber)
+	return s.listProjectV2Items(ctx, u, opts)
+}
+
+// ListUserProjectV2Items lists the items of a user-owned project.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#list-items-for-a-user-owned-project
+func (s *ProjectsService) ListUserProjectV2Items(ctx context.Context, user string, projectNumber int, opts *ListProjectV2ItemsOptions) ([]*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items", user, projectNumber)
+	return s.listProjectV2Items(ctx, u, opts)
+}
+
+func (s *ProjectsService) listProjectV2Items(ctx context.Context, u string, opts *ListProjectV2ItemsOptions) ([]*ProjectV2Item, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []*ProjectV2Item
+	resp, err := s.client.Do(ctx, req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return items, resp, nil
+}
+
+// GetOrgProjectV2Item gets an item of an organization-owned project, with
+// its field values.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#get-an-item-for-an-organization-owned-project
+func (s *ProjectsService) GetOrgProjectV2Item(ctx context.Context, org string, projectNumber int, itemID int64) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items/%v", org, projectNumber, itemID)
+	return s.projectV2Item(ctx, "GET", u, nil)
+}
+
+// GetUserProjectV2Item gets an item of a user-owned project, with its
+// field values.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#get-an-item-for-a-user-owned-project
+func (s *ProjectsService) GetUserProjectV2Item(ctx context.Context, user string, projectNumber int, itemID int64) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items/%v", user, projectNumber, itemID)
+	return s.projectV2Item(ctx, "GET", u, nil)
+}
+
+// AddOrgProjectV2Item adds an issue or pull request to an organization-owned project.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#add-item-to-organization-owned-project
+func (s *ProjectsService) AddOrgProjectV2Item(ctx context.Context, org string, projectNumber int, opts *AddProjectV2ItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items", org, projectNumber)
+	return s.projectV2Item(ctx, "POST", u, opts)
+}
+
+// AddUserProjectV2Item adds an issue or pull request to a user-owned project.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#add-item-to-user-owned-project
+func (s *ProjectsService) AddUserProjectV2Item(ctx context.Context, user string, projectNumber int, opts *AddProjectV2ItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items", user, projectNumber)
+	return s.projectV2Item(ctx, "POST", u, opts)
+}
+
+// UpdateOrgProjectV2Item updates the field values or the archived state of
+// an item in an organization-owned project.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#update-project-item-for-organization
+func (s *ProjectsService) UpdateOrgProjectV2Item(ctx context.Context, org string, projectNumber int, itemID int64, opts *UpdateProjectV2ItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items/%v", org, projectNumber, itemID)
+	return s.projectV2Item(ctx, "PATCH", u, opts)
+}
+
+// UpdateUserProjectV2Item updates the field values or the archived state of
+// an item in a user-owned project.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#update-project-item-for-user
+func (s *ProjectsService) UpdateUserProjectV2Item(ctx context.Context, user string, projectNumber int, itemID int64, opts *UpdateProjectV2ItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items/%v", user, projectNumber, itemID)
+	return s.projectV2Item(ctx, "PATCH", u, opts)
+}
+
+func (s *ProjectsService) projectV2Item(ctx context.Context, method, u string, body interface{}) (*ProjectV2Item, *Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item := new(ProjectV2Item)
+	resp, err := s.client.Do(ctx, req, item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item, resp, nil
+}
+
+// DeleteOrgProjectV2Item deletes an item from an organization-owned project.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#delete-project-item-for-organization
+func (s *ProjectsService) DeleteOrgProjectV2Item(ctx context.Context, org string, projectNumber int, itemID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items/%v", org, projectNumber, itemID)
+	return s.deleteProjectV2Item(ctx, u)
+}
+
+// DeleteUserProjectV2Item deletes an item from a user-owned project.
+//
+// GitHub API docs: https://docs.github.com/en/rest/projects/items#delete-project-item-for-user
+func (s *ProjectsService) DeleteUserProjectV2Item(ctx context.Context, user string, projectNumber int, itemID int64) (*Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items/%v", user, projectNumber, itemID)
+	return s.deleteProjectV2Item(ctx, u)
+}
+
+func (s *ProjectsService) deleteProjectV2Item(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ProjectV2SyncOptions specifies the parameters to the
+// ProjectsService.SyncOrgProjectV2Items method.
+type ProjectV2SyncOptions struct {
+	// Query is an issue search query (as accepted by SearchService.Issues)
+	// selecting the issues and pull requests that belong on the board.
+	Query string
+
+	// LabelFieldValues maps a label name to the field values to set on
+	// items whose issue or pull request carries that label.
+	LabelFieldValues map[string][]*ProjectV2FieldUpdate
+
+	// MilestoneFieldID, when non-zero, is the ID of a text field that is set
+	// to the title of the item's milestone.
+	MilestoneFieldID int64
+
+	// ArchiveClosed archives items whose issue or pull request is closed.
+	ArchiveClosed bool
+}
+
+// ProjectV2SyncResult summarizes the changes made by ProjectsService.SyncOrgProjectV2Items.
+type ProjectV2SyncResult struct {
+	Added    []*ProjectV2Item
+	Updated  []*ProjectV2Item
+	Archived []*ProjectV2Item
+}
+
+// SyncOrgProjectV2Items keeps an organization-owned project in sync with an
+// issue search query. Issues and pull requests matching opts.Query that are
+// not yet on the board are added, field values derived from labels and
+// milestones are applied to every matching item, and, if opts.ArchiveClosed
+// is set, items whose content is closed are archived.
+func (s *ProjectsService) SyncOrgProjectV2Items(ctx context.Context, org string, projectNumber int, opts *ProjectV2SyncOptions) (*ProjectV2SyncResult, *Response, error) {
+	if opts == nil || opts.Query == "" {
+		return nil, nil, fmt.Errorf("a search query must be provided")
+	}
+
+	var lastResp *Response
+	var items []*ProjectV2Item
+	byContentID := make(map[int64]*ProjectV2Item)
+	listOpts := &ListProjectV2ItemsOptions{ListCursorOptions: ListCursorOptions{PerPage: 100}}
+	for {
+		page, resp, err := s.ListOrgProjectV2Items(ctx, org, projectNumber, listOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		lastResp = resp
+		for _, item := range page {
+			items = append(items, item)
+			if id := item.GetContent().GetID(); id != 0 {
+				byContentID[id] = item
+			}
+		}
+		if resp.After == "" {
+			break
+		}
+		listOpts.After = resp.After
+	}
+
+	result := &ProjectV2SyncResult{}
+	searchOpts := &SearchOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		found, searchResp, err := s.client.Search.Issues(ctx, opts.Query, searchOpts)
+		if err != nil {
+			return result, searchResp, err
+		}
+		lastResp = searchResp
+
+		for _, issue := range found.Issues {
+			item, ok := byContentID[issue.GetID()]
+			if !ok {
+				add := &AddProjectV2ItemOptions{Type: "Issue", ID: issue.GetID()}
+				if issue.IsPullRequest() {
+					add.Type = "PullRequest"
+				}
+				added, resp, err := s.AddOrgProjectV2Item(ctx, org, projectNumber, add)
+				if err != nil {
+					return result, resp, err
+				}
+				lastResp = resp
+				if added.Content == nil {
+					added.Content = issue
+				}
+				item = added
+				items = append(items, item)
+				byContentID[issue.GetID()] = item
+				result.Added = append(result.Added, item)
+			}
+
+			fields := opts.fieldUpdates(issue)
+			if len(fields) == 0 {
+				continue
+			}
+			updated, resp, err := s.UpdateOrgProjectV2Item(ctx, org, projectNumber, item.GetID(), &UpdateProjectV2ItemOptions{Fields: fields})
+			if err != nil {
+				return result, resp, err
+			}
+			lastResp = resp
+			result.Updated = append(result.Updated, updated)
+		}
+
+		if searchResp.NextPage == 0 {
+			break
+		}
+		searchOpts.Page = searchResp.NextPage
+	}
+
+	if !opts.ArchiveClosed {
+		return result, lastResp, nil
+	}
+
+	for _, item := range items {
+		if item.ArchivedAt != nil || item.GetContent().GetState() != "closed" {
+			continue
+		}
+		archived, resp, err := s.UpdateOrgProjectV2Item(ctx, org, projectNumber, item.GetID(), &UpdateProjectV2ItemOptions{Archived: Bool(true)})
+		if err != nil {
+			return result, resp, err
+		}
+		lastResp = resp
+		result.Archived = append(result.Archived, archived)
+	}
+
+	return result, lastResp, nil
+}
+
+// fieldUpdates returns the field values that opts derives from the labels
+// and milestone of issue.
+func (opts *ProjectV2SyncOptions) fieldUpdates(issue *Issue) []*ProjectV2FieldUpdate {
+	var labels []string
+	for _, l := range issue.Labels {
+		if _, ok := opts.LabelFieldValues[l.GetName()]; ok {
+			labels = append(labels, l.GetName())
+		}
+	}
+	sort.Strings(labels)
+
+	var fields []*ProjectV2FieldUpdate
+	for _, name := range labels {
+		fields = append(fields, opts.LabelFieldValues[name]...)
+	}
+	if opts.MilestoneFieldID != 0 && issue.Milestone != nil {
+		fields = append(fields, &ProjectV2FieldUpdate{ID: opts.MilestoneFieldID, Value: issue.Milestone.GetTitle()})
+	}
+
+	return fields
+}
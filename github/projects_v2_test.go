@@ -0,0 +1,697 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProjectsService_ListOrgProjectV2Items(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "is:open", "fields": "1,2", "per_page": "2"})
+		fmt.Fprint(w, `[{"id":1,"content_type":"Issue","content":{"id":10,"state":"open"}}]`)
+	})
+
+	opts := &ListProjectV2ItemsOptions{Query: "is:open", Fields: []int64{1, 2}, ListCursorOptions: ListCursorOptions{PerPage: 2}}
+	ctx := context.Background()
+	items, _, err := client.Projects.ListOrgProjectV2Items(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Projects.ListOrgProjectV2Items returned error: %v", err)
+	}
+
+	want := []*ProjectV2Item{{ID: Int64(1), ContentType: String("Issue"), Content: &Issue{ID: Int64(10), State: String("open")}}}
+	if !cmp.Equal(items, want) {
+		t.Errorf("Projects.ListOrgProjectV2Items returned %+v, want %+v", items, want)
+	}
+
+	const methodName = "ListOrgProjectV2Items"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListOrgProjectV2Items(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListOrgProjectV2Items(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_AddOrgProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &AddProjectV2ItemOptions{Type: "PullRequest", ID: 10}
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AddProjectV2ItemOptions)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !cmp.Equal(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"id":1,"content_type":"PullRequest"}`)
+	})
+
+	ctx := context.Background()
+	item, _, err := client.Projects.AddOrgProjectV2Item(ctx, "o", 1, input)
+	if err != nil {
+		t.Errorf("Projects.AddOrgProjectV2Item returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(1), ContentType: String("PullRequest")}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.AddOrgProjectV2Item returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "AddOrgProjectV2Item"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.AddOrgProjectV2Item(ctx, "\n", 1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.AddOrgProjectV2Item(ctx, "o", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_UpdateOrgProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateProjectV2ItemOptions{Fields: []*ProjectV2FieldUpdate{{ID: 2, Value: "v"}}}
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"fields":[{"id":2,"value":"v"}]}`+"\n")
+		fmt.Fprint(w, `{"id":3}`)
+	})
+
+	ctx := context.Background()
+	item, _, err := client.Projects.UpdateOrgProjectV2Item(ctx, "o", 1, 3, input)
+	if err != nil {
+		t.Errorf("Projects.UpdateOrgProjectV2Item returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(3)}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.UpdateOrgProjectV2Item returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "UpdateOrgProjectV2Item"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.UpdateOrgProjectV2Item(ctx, "\n", 1, 3, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.UpdateOrgProjectV2Item(ctx, "o", 1, 3, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_DeleteOrgProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Projects.DeleteOrgProjectV2Item(ctx, "o", 1, 3)
+	if err != nil {
+		t.Errorf("Projects.DeleteOrgProjectV2Item returned error: %v", err)
+	}
+
+	const methodName = "DeleteOrgProjectV2Item"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Projects.DeleteOrgProjectV2Item(ctx, "\n", 1, 3)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Projects.DeleteOrgProjectV2Item(ctx, "o", 1, 3)
+	})
+}
+
+func TestProjectsService_SyncOrgProjectV2Items(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			if r.FormValue("after") == "" {
+				w.Header().Set("Link", `<https://api.github.com/orgs/o/projectsV2/1/items?after=c1>; rel="next"`)
+				fmt.Fprint(w, `[{"id":1,"content":{"id":10,"state":"open"}}]`)
+				return
+			}
+			fmt.Fprint(w, `[{"id":2,"content":{"id":20,"state":"closed"}},{"id":3,"content":{"id":30,"state":"closed"},"archived_at":`+referenceTimeStr+`}]`)
+		case "POST":
+			testBody(t, r, `{"type":"PullRequest","id":40}`+"\n")
+			fmt.Fprint(w, `{"id":4}`)
+		default:
+			t.Errorf("unexpected %v request", r.Method)
+		}
+	})
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "repo:o/r", "per_page": "100"})
+		fmt.Fprint(w, `{"items":[
+			{"id":10,"state":"open","labels":[{"name":"bug"},{"name":"other"}],"milestone":{"title":"v1"}},
+			{"id":40,"state":"closed","pull_request":{"url":"u"}}
+		]}`)
+	})
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"fields":[{"id":5,"value":"Bug"},{"id":6,"value":"v1"}]}`+"\n")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"archived":true}`+"\n")
+		fmt.Fprint(w, `{"id":2}`)
+	})
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"archived":true}`+"\n")
+		fmt.Fprint(w, `{"id":4}`)
+	})
+
+	opts := &ProjectV2SyncOptions{
+		Query:            "repo:o/r",
+		LabelFieldValues: map[string][]*ProjectV2FieldUpdate{"bug": {{ID: 5, Value: "Bug"}}},
+		MilestoneFieldID: 6,
+		ArchiveClosed:    true,
+	}
+	ctx := context.Background()
+	result, _, err := client.Projects.SyncOrgProjectV2Items(ctx, "o", 1, opts)
+	if err != nil {
+		t.Fatalf("Projects.SyncOrgProjectV2Items returned error: %v", err)
+	}
+
+	want := &ProjectV2SyncResult{
+		Added:    []*ProjectV2Item{{ID: Int64(4), Content: &Issue{ID: Int64(40), State: String("closed"), PullRequestLinks: &PullRequestLinks{URL: String("u")}}}},
+		Updated:  []*ProjectV2Item{{ID: Int64(1)}},
+		Archived: []*ProjectV2Item{{ID: Int64(2)}, {ID: Int64(4)}},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Projects.SyncOrgProjectV2Items returned %+v, want %+v", result, want)
+	}
+
+	if _, _, err := client.Projects.SyncOrgProjectV2Items(ctx, "o", 1, nil); err == nil {
+		t.Error("Projects.SyncOrgProjectV2Items returned no error for nil options")
+	}
+}
+
+func TestProjectV2Item_Marshal(t *testing.T) {
+	testJSONMarshal(t, &ProjectV2Item{}, "{}")
+
+	u := &ProjectV2Item{
+		ID:          Int64(1),
+		NodeID:      String("nid"),
+		ContentType: String("Issue"),
+		Content:     &Issue{ID: Int64(2)},
+		Fields: []*ProjectV2FieldValue{
+			{ID: Int64(3), Name: String("Status"), DataType: String("single_select"), Value: "Done"},
+		},
+		ArchivedAt: &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"id": 1,
+		"node_id": "nid",
+		"content_type": "Issue",
+		"content": {
+			"id": 2
+		},
+		"fields": [
+			{
+				"id": 3,
+				"name": "Status",
+				"data_type": "single_select",
+				"value": "Done"
+			}
+		],
+		"archived_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestProjectsService_ListOrgProjectsV2(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "roadmap", "after": "c1"})
+		fmt.Fprint(w, `[{"id":1,"number":2,"title":"Roadmap"}]`)
+	})
+
+	opts := &ListProjectsV2Options{Query: "roadmap", ListCursorOptions: ListCursorOptions{After: "c1"}}
+	ctx := context.Background()
+	projects, _, err := client.Projects.ListOrgProjectsV2(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Projects.ListOrgProjectsV2 returned error: %v", err)
+	}
+
+	want := []*ProjectV2{{ID: Int64(1), Number: Int(2), Title: String("Roadmap")}}
+	if !cmp.Equal(projects, want) {
+		t.Errorf("Projects.ListOrgProjectsV2 returned %+v, want %+v", projects, want)
+	}
+
+	const methodName = "ListOrgProjectsV2"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListOrgProjectsV2(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListOrgProjectsV2(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_ListUserProjectsV2(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/projectsV2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `[{"id":1,"owner":{"login":"u"}}]`)
+	})
+
+	opts := &ListProjectsV2Options{ListCursorOptions: ListCursorOptions{PerPage: 1}}
+	ctx := context.Background()
+	projects, _, err := client.Projects.ListUserProjectsV2(ctx, "u", opts)
+	if err != nil {
+		t.Errorf("Projects.ListUserProjectsV2 returned error: %v", err)
+	}
+
+	want := []*ProjectV2{{ID: Int64(1), Owner: &User{Login: String("u")}}}
+	if !cmp.Equal(projects, want) {
+		t.Errorf("Projects.ListUserProjectsV2 returned %+v, want %+v", projects, want)
+	}
+
+	const methodName = "ListUserProjectsV2"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListUserProjectsV2(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListUserProjectsV2(ctx, "u", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_GetOrgProjectV2(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"number":2,"public":true}`)
+	})
+
+	ctx := context.Background()
+	project, _, err := client.Projects.GetOrgProjectV2(ctx, "o", 2)
+	if err != nil {
+		t.Errorf("Projects.GetOrgProjectV2 returned error: %v", err)
+	}
+
+	want := &ProjectV2{ID: Int64(1), Number: Int(2), Public: Bool(true)}
+	if !cmp.Equal(project, want) {
+		t.Errorf("Projects.GetOrgProjectV2 returned %+v, want %+v", project, want)
+	}
+
+	const methodName = "GetOrgProjectV2"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.GetOrgProjectV2(ctx, "\n", 2)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.GetOrgProjectV2(ctx, "o", 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_GetUserProjectV2(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/projectsV2/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"number":2}`)
+	})
+
+	ctx := context.Background()
+	project, _, err := client.Projects.GetUserProjectV2(ctx, "u", 2)
+	if err != nil {
+		t.Errorf("Projects.GetUserProjectV2 returned error: %v", err)
+	}
+
+	want := &ProjectV2{ID: Int64(1), Number: Int(2)}
+	if !cmp.Equal(project, want) {
+		t.Errorf("Projects.GetUserProjectV2 returned %+v, want %+v", project, want)
+	}
+
+	const methodName = "GetUserProjectV2"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.GetUserProjectV2(ctx, "\n", 2)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.GetUserProjectV2(ctx, "u", 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_ListOrgProjectV2Fields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/2/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2"})
+		fmt.Fprint(w, `[{"id":5,"name":"Status","data_type":"single_select","options":[{"id":"a","name":"Todo"},{"id":"b","name":"Done"}]}]`)
+	})
+
+	opts := &ListCursorOptions{PerPage: 2}
+	ctx := context.Background()
+	fields, _, err := client.Projects.ListOrgProjectV2Fields(ctx, "o", 2, opts)
+	if err != nil {
+		t.Errorf("Projects.ListOrgProjectV2Fields returned error: %v", err)
+	}
+
+	want := []*ProjectV2Field{{
+		ID:       Int64(5),
+		Name:     String("Status"),
+		DataType: String("single_select"),
+		Options: []*ProjectV2FieldOption{
+			{ID: String("a"), Name: String("Todo")},
+			{ID: String("b"), Name: String("Done")},
+		},
+	}}
+	if !cmp.Equal(fields, want) {
+		t.Errorf("Projects.ListOrgProjectV2Fields returned %+v, want %+v", fields, want)
+	}
+
+	const methodName = "ListOrgProjectV2Fields"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListOrgProjectV2Fields(ctx, "\n", 2, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListOrgProjectV2Fields(ctx, "o", 2, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_ListUserProjectV2Fields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/projectsV2/2/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":5,"name":"Estimate","data_type":"number"}]`)
+	})
+
+	ctx := context.Background()
+	fields, _, err := client.Projects.ListUserProjectV2Fields(ctx, "u", 2, nil)
+	if err != nil {
+		t.Errorf("Projects.ListUserProjectV2Fields returned error: %v", err)
+	}
+
+	want := []*ProjectV2Field{{ID: Int64(5), Name: String("Estimate"), DataType: String("number")}}
+	if !cmp.Equal(fields, want) {
+		t.Errorf("Projects.ListUserProjectV2Fields returned %+v, want %+v", fields, want)
+	}
+
+	const methodName = "ListUserProjectV2Fields"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListUserProjectV2Fields(ctx, "\n", 2, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListUserProjectV2Fields(ctx, "u", 2, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_ListUserProjectV2Items(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "is:open"})
+		fmt.Fprint(w, `[{"id":1,"content_type":"Issue"}]`)
+	})
+
+	opts := &ListProjectV2ItemsOptions{Query: "is:open"}
+	ctx := context.Background()
+	items, _, err := client.Projects.ListUserProjectV2Items(ctx, "u", 1, opts)
+	if err != nil {
+		t.Errorf("Projects.ListUserProjectV2Items returned error: %v", err)
+	}
+
+	want := []*ProjectV2Item{{ID: Int64(1), ContentType: String("Issue")}}
+	if !cmp.Equal(items, want) {
+		t.Errorf("Projects.ListUserProjectV2Items returned %+v, want %+v", items, want)
+	}
+
+	const methodName = "ListUserProjectV2Items"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListUserProjectV2Items(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListUserProjectV2Items(ctx, "u", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_GetOrgProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":3,"project_node_id":"p","content_node_id":"c"}`)
+	})
+
+	ctx := context.Background()
+	item, _, err := client.Projects.GetOrgProjectV2Item(ctx, "o", 1, 3)
+	if err != nil {
+		t.Errorf("Projects.GetOrgProjectV2Item returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(3), ProjectNodeID: String("p"), ContentNodeID: String("c")}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.GetOrgProjectV2Item returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "GetOrgProjectV2Item"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.GetOrgProjectV2Item(ctx, "\n", 1, 3)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.GetOrgProjectV2Item(ctx, "o", 1, 3)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_GetUserProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/projectsV2/1/items/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":3}`)
+	})
+
+	ctx := context.Background()
+	item, _, err := client.Projects.GetUserProjectV2Item(ctx, "u", 1, 3)
+	if err != nil {
+		t.Errorf("Projects.GetUserProjectV2Item returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(3)}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.GetUserProjectV2Item returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "GetUserProjectV2Item"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.GetUserProjectV2Item(ctx, "\n", 1, 3)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.GetUserProjectV2Item(ctx, "u", 1, 3)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_AddUserProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &AddProjectV2ItemOptions{Type: "Issue", ID: 10}
+
+	mux.HandleFunc("/users/u/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"type":"Issue","id":10}`+"\n")
+		fmt.Fprint(w, `{"id":1,"content_type":"Issue"}`)
+	})
+
+	ctx := context.Background()
+	item, _, err := client.Projects.AddUserProjectV2Item(ctx, "u", 1, input)
+	if err != nil {
+		t.Errorf("Projects.AddUserProjectV2Item returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(1), ContentType: String("Issue")}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.AddUserProjectV2Item returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "AddUserProjectV2Item"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.AddUserProjectV2Item(ctx, "\n", 1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.AddUserProjectV2Item(ctx, "u", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_UpdateUserProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateProjectV2ItemOptions{Archived: Bool(true)}
+
+	mux.HandleFunc("/users/u/projectsV2/1/items/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"archived":true}`+"\n")
+		fmt.Fprint(w, `{"id":3,"archived_at":`+referenceTimeStr+`}`)
+	})
+
+	ctx := context.Background()
+	item, _, err := client.Projects.UpdateUserProjectV2Item(ctx, "u", 1, 3, input)
+	if err != nil {
+		t.Errorf("Projects.UpdateUserProjectV2Item returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(3), ArchivedAt: &Timestamp{referenceTime}}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.UpdateUserProjectV2Item returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "UpdateUserProjectV2Item"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.UpdateUserProjectV2Item(ctx, "\n", 1, 3, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.UpdateUserProjectV2Item(ctx, "u", 1, 3, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_DeleteUserProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/projectsV2/1/items/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Projects.DeleteUserProjectV2Item(ctx, "u", 1, 3)
+	if err != nil {
+		t.Errorf("Projects.DeleteUserProjectV2Item returned error: %v", err)
+	}
+
+	const methodName = "DeleteUserProjectV2Item"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Projects.DeleteUserProjectV2Item(ctx, "\n", 1, 3)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Projects.DeleteUserProjectV2Item(ctx, "u", 1, 3)
+	})
+}
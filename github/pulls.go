@@ -8,7 +8,11 @@ package github
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 )
 
 // PullRequestsService handles communication with the pull request related
@@ -80,7 +84,7 @@ type PullRequest struct {
 	Base  *PullRequestBranch `json:"base,omitempty"`
 
 	// ActiveLockReason is populated only when LockReason is provided while locking the pull request.
-	// Possible values are: "off-topic", "too heated", "resolved", and "spam".
+	// Possible values are the LockReason constants.
 	ActiveLockReason *string `json:"active_lock_reason,omitempty"`
 }
 
@@ -166,8 +170,10 @@ func (s *PullRequestsService) List(ctx context.Context, owner string, repo strin
 
 // ListPullRequestsWithCommit returns pull requests associated with a commit SHA.
 //
-// The results may include open and closed pull requests.
-// By default, the PullRequestListOptions State filters for "open".
+// If the commit is in the default branch, the results are the merged pull
+// requests that introduced it; otherwise they are the open pull requests
+// containing it. Only the pagination options of opts are honored by GitHub;
+// use ListPullRequestsWithCommitAll to filter by State or Base.
 //
 // GitHub API docs: https://docs.github.com/en/rest/commits/commits#list-pull-requests-associated-with-a-commit
 func (s *PullRequestsService) ListPullRequestsWithCommit(ctx context.Context, owner, repo, sha string, opts *PullRequestListOptions) ([]*PullRequest, *Response, error) {
@@ -193,6 +199,41 @@ func (s *PullRequestsService) ListPullRequestsWithCommit(ctx context.Context, ow
 	return pulls, resp, nil
 }
 
+// ListPullRequestsWithCommitAll returns all pull requests associated with a
+// commit SHA, fetching every page. Unlike ListPullRequestsWithCommit, the
+// State ("open", "closed", or "all", the default) and Base options of opts
+// are applied to the results; its other fields are ignored.
+//
+// GitHub API docs: https://docs.github.com/en/rest/commits/commits#list-pull-requests-associated-with-a-commit
+func (s *PullRequestsService) ListPullRequestsWithCommitAll(ctx context.Context, owner, repo, sha string, opts *PullRequestListOptions) ([]*PullRequest, *Response, error) {
+	var state, base string
+	if opts != nil {
+		state, base = opts.State, opts.Base
+	}
+
+	pageOpts := &PullRequestListOptions{ListOptions: ListOptions{PerPage: 100}}
+	var pulls []*PullRequest
+	for {
+		page, resp, err := s.ListPullRequestsWithCommit(ctx, owner, repo, sha, pageOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, pull := range page {
+			if state != "" && state != "all" && pull.GetState() != state {
+				continue
+			}
+			if base != "" && pull.GetBase().GetRef() != base {
+				continue
+			}
+			pulls = append(pulls, pull)
+		}
+		if resp.NextPage == 0 {
+			return pulls, resp, nil
+		}
+		pageOpts.Page = resp.NextPage
+	}
+}
+
 // Get a single pull request.
 //
 // GitHub API docs: https://docs.github.com/en/rest/pulls/pulls#get-a-pull-request
@@ -240,6 +281,24 @@ func (s *PullRequestsService) GetRaw(ctx context.Context, owner string, repo str
 	return buf.String(), resp, nil
 }
 
+// GetDiff writes a single pull request in diff format to w, as it is
+// received. Unlike GetRaw, it does not hold the whole diff in memory.
+//
+// GitHub API docs: https://docs.github.com/en/rest/pulls/pulls#get-a-pull-request
+func (s *PullRequestsService) GetDiff(ctx context.Context, owner, repo string, number int, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
+	return s.client.getRaw(ctx, u, Diff, w)
+}
+
+// GetPatch writes a single pull request in patch format to w, as it is
+// received. Unlike GetRaw, it does not hold the whole patch in memory.
+//
+// GitHub API docs: https://docs.github.com/en/rest/pulls/pulls#get-a-pull-request
+func (s *PullRequestsService) GetPatch(ctx context.Context, owner, repo string, number int, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
+	return s.client.getRaw(ctx, u, Patch, w)
+}
+
 // NewPullRequest represents a new pull request to be created.
 type NewPullRequest struct {
 	Title               *string `json:"title,omitempty"`
@@ -410,6 +469,58 @@ func (s *PullRequestsService) ListFiles(ctx context.Context, owner string, repo
 	return commitFiles, resp, nil
 }
 
+// maxPullRequestFiles is the maximum number of files listed by
+// PullRequestsService.ListFiles, across all pages.
+const maxPullRequestFiles = 3000
+
+// ErrPullRequestFilesTruncated is returned by PullRequestsService.ListFilesAll
+// when the pull request changes more files than the API lists.
+var ErrPullRequestFilesTruncated = errors.New("pull request changes more files than the API lists")
+
+// ListFilesAll lists the files in a pull request, calling fn for each file as
+// each page is fetched. If fn returns an error, iteration stops and that
+// error is returned.
+//
+// The API lists at most 3000 files per pull request. When the pull request
+// changes more files, fn is still called for the 3000 listed ones, then
+// ErrPullRequestFilesTruncated is returned.
+//
+// GitHub API docs: https://docs.github.com/en/rest/pulls/pulls#list-pull-requests-files
+func (s *PullRequestsService) ListFilesAll(ctx context.Context, owner, repo string, number int, fn func(*CommitFile) error) (*Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	var count int
+	for {
+		files, resp, err := s.ListFiles(ctx, owner, repo, number, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, file := range files {
+			if err := fn(file); err != nil {
+				return resp, err
+			}
+		}
+		count += len(files)
+
+		if resp.NextPage == 0 {
+			if count < maxPullRequestFiles {
+				return resp, nil
+			}
+
+			// The listing stops at the cap whether or not more files
+			// changed, so compare with the pull request's own count.
+			pull, resp, err := s.Get(ctx, owner, repo, number)
+			if err != nil {
+				return resp, err
+			}
+			if pull.GetChangedFiles() > count {
+				return resp, ErrPullRequestFilesTruncated
+			}
+			return resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // IsMerged checks if a pull request has been merged.
 //
 // GitHub API docs: https://docs.github.com/en/rest/pulls/pulls#check-if-a-pull-request-has-been-merged
@@ -451,9 +562,58 @@ type pullRequestMergeRequest struct {
 	SHA           string  `json:"sha,omitempty"`
 }
 
+// Reasons a pull request could not be merged, reported by MergeFailureReason.
+const (
+	MergeFailureNotMergeable        = "not_mergeable"
+	MergeFailureStatusChecksPending = "status_checks_pending"
+	MergeFailureReviewRequired      = "review_required"
+	MergeFailureMergeQueueRequired  = "merge_queue_required"
+	MergeFailureHeadChanged         = "head_changed"
+	MergeFailureUnknown             = "unknown"
+)
+
+// MergeFailureReason returns one of the MergeFailure constants telling why
+// GitHub refused to merge a pull request, given the error returned by
+// PullRequestsService.Merge. The reason is derived from the status code and
+// message of the *ErrorResponse, and is MergeFailureUnknown for messages it
+// does not recognize. An empty string is returned if err does not report a
+// refused merge.
+func MergeFailureReason(err error) string {
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return ""
+	}
+	if isMergeQueueRequired(errorResponse) {
+		return MergeFailureMergeQueueRequired
+	}
+	switch errorResponse.Response.StatusCode {
+	case http.StatusMethodNotAllowed, http.StatusConflict, http.StatusUnprocessableEntity:
+	default:
+		return ""
+	}
+
+	message := strings.ToLower(errorResponse.Message)
+	switch {
+	case errorResponse.Response.StatusCode == http.StatusConflict || strings.Contains(message, "head branch was modified"):
+		return MergeFailureHeadChanged
+	case strings.Contains(message, "status check"):
+		return MergeFailureStatusChecksPending
+	case strings.Contains(message, "review"):
+		return MergeFailureReviewRequired
+	case strings.Contains(message, "not mergeable"):
+		return MergeFailureNotMergeable
+	}
+	return MergeFailureUnknown
+}
+
 // Merge a pull request.
 // commitMessage is an extra detail to append to automatic commit message.
 //
+// If GitHub refuses to merge the pull request, Merge returns the
+// *ErrorResponse; MergeFailureReason tells why. If the base branch requires
+// pull requests to be merged through a merge queue, the reason is
+// MergeFailureMergeQueueRequired; use EnqueuePullRequest instead.
+//
 // GitHub API docs: https://docs.github.com/en/rest/pulls/pulls#merge-a-pull-request
 func (s *PullRequestsService) Merge(ctx context.Context, owner string, repo string, number int, commitMessage string, options *PullRequestOptions) (*PullRequestMergeResult, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/%d/merge", owner, repo, number)
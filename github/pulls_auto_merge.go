@@ -0,0 +1,164 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AutoMergeOptions specifies the optional parameters to the
+// PullRequestsService.EnableAutoMerge method.
+type AutoMergeOptions struct {
+	// MergeMethod is the merge method to use once the requirements are met.
+	// Possible values are: "merge", "squash", "rebase". Default is the
+	// repository's default merge method.
+	MergeMethod string
+	// CommitTitle and CommitMessage are the title and the message of the
+	// merge commit. They are ignored by the "rebase" merge method.
+	CommitTitle   string
+	CommitMessage string
+	// ExpectedHeadSHA, if set, is the SHA the head of the pull request must
+	// match for auto-merge to be enabled.
+	ExpectedHeadSHA string
+	// AuthorEmail is the email address to associate with the merge commit.
+	AuthorEmail string
+}
+
+// graphQLAutoMergeRequest is the GraphQL representation of a PullRequestAutoMerge.
+type graphQLAutoMergeRequest struct {
+	EnabledBy *struct {
+		Login string `json:"login"`
+	} `json:"enabledBy"`
+	MergeMethod    string `json:"mergeMethod"`
+	CommitHeadline string `json:"commitHeadline"`
+	CommitBody     string `json:"commitBody"`
+}
+
+func (r *graphQLAutoMergeRequest) toAutoMerge() *PullRequestAutoMerge {
+	if r == nil {
+		return nil
+	}
+
+	autoMerge := &PullRequestAutoMerge{
+		MergeMethod:   String(strings.ToLower(r.MergeMethod)),
+		CommitTitle:   String(r.CommitHeadline),
+		CommitMessage: String(r.CommitBody),
+	}
+	if r.EnabledBy != nil {
+		autoMerge.EnabledBy = &User{Login: String(r.EnabledBy.Login)}
+	}
+	return autoMerge
+}
+
+// pullRequestNodeID returns the node ID of a pull request. Unlike
+// pullRequestNode, it does not depend on merge queue support in the
+// GraphQL schema.
+func (s *PullRequestsService) pullRequestNodeID(ctx context.Context, owner, repo string, number int) (string, *Response, error) {
+	query := `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) { id }
+  }
+}`
+	variables := map[string]interface{}{"owner": owner, "repo": repo, "number": number}
+
+	var data struct {
+		Repository *struct {
+			PullRequest *struct {
+				ID string `json:"id"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return "", resp, err
+	}
+	if data.Repository == nil || data.Repository.PullRequest == nil {
+		return "", resp, errors.New("pull request not found")
+	}
+
+	return data.Repository.PullRequest.ID, resp, nil
+}
+
+// EnableAutoMerge enables auto-merge on a pull request, so that it is merged
+// as soon as all its requirements are met. It returns the resulting
+// auto-merge settings, in the form used by PullRequest.AutoMerge.
+//
+// Auto-merge must be allowed in the repository settings, and the pull
+// request must have unmet requirements, otherwise GitHub reports an error.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#enablepullrequestautomerge
+func (s *PullRequestsService) EnableAutoMerge(ctx context.Context, owner, repo string, number int, opts *AutoMergeOptions) (*PullRequestAutoMerge, *Response, error) {
+	input := map[string]interface{}{}
+	if opts != nil {
+		if opts.MergeMethod != "" {
+			switch method := strings.ToUpper(opts.MergeMethod); method {
+			case "MERGE", "SQUASH", "REBASE":
+				input["mergeMethod"] = method
+			default:
+				return nil, nil, fmt.Errorf("invalid merge method %q", opts.MergeMethod)
+			}
+		}
+		if opts.CommitTitle != "" {
+			input["commitHeadline"] = opts.CommitTitle
+		}
+		if opts.CommitMessage != "" {
+			input["commitBody"] = opts.CommitMessage
+		}
+		if opts.ExpectedHeadSHA != "" {
+			input["expectedHeadOid"] = opts.ExpectedHeadSHA
+		}
+		if opts.AuthorEmail != "" {
+			input["authorEmail"] = opts.AuthorEmail
+		}
+	}
+
+	id, resp, err := s.pullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+	input["pullRequestId"] = id
+
+	mutation := `mutation($input: EnablePullRequestAutoMergeInput!) {
+  enablePullRequestAutoMerge(input: $input) {
+    pullRequest {
+      autoMergeRequest { enabledBy { login } mergeMethod commitHeadline commitBody }
+    }
+  }
+}`
+	var data struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				AutoMergeRequest *graphQLAutoMergeRequest `json:"autoMergeRequest"`
+			} `json:"pullRequest"`
+		} `json:"enablePullRequestAutoMerge"`
+	}
+	resp, err = s.client.graphQL(ctx, mutation, map[string]interface{}{"input": input}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.EnablePullRequestAutoMerge.PullRequest.AutoMergeRequest.toAutoMerge(), resp, nil
+}
+
+// DisableAutoMerge disables auto-merge on a pull request.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#disablepullrequestautomerge
+func (s *PullRequestsService) DisableAutoMerge(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	id, resp, err := s.pullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	mutation := `mutation($input: DisablePullRequestAutoMergeInput!) {
+  disablePullRequestAutoMerge(input: $input) {
+    pullRequest { id }
+  }
+}`
+	return s.client.graphQL(ctx, mutation, map[string]interface{}{"input": map[string]interface{}{"pullRequestId": id}}, nil)
+}
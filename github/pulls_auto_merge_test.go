@@ -0,0 +1,133 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPullRequestsService_EnableAutoMerge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePullRequestGraphQL(t, mux, `null`, func(req *graphQLRequest) string {
+		if !strings.Contains(req.Query, "enablePullRequestAutoMerge") {
+			t.Errorf("Request query = %q, want enablePullRequestAutoMerge mutation", req.Query)
+		}
+		want := map[string]interface{}{"input": map[string]interface{}{
+			"pullRequestId":   "PR_1",
+			"mergeMethod":     "SQUASH",
+			"commitHeadline":  "t",
+			"commitBody":      "m",
+			"expectedHeadOid": "s",
+		}}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", req.Variables, want)
+		}
+		return `{"data":{"enablePullRequestAutoMerge":{"pullRequest":{"autoMergeRequest":{"enabledBy":{"login":"u"},"mergeMethod":"SQUASH","commitHeadline":"t","commitBody":"m"}}}}}`
+	})
+
+	ctx := context.Background()
+	opts := &AutoMergeOptions{MergeMethod: "squash", CommitTitle: "t", CommitMessage: "m", ExpectedHeadSHA: "s"}
+	autoMerge, _, err := client.PullRequests.EnableAutoMerge(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("PullRequests.EnableAutoMerge returned error: %v", err)
+	}
+
+	want := &PullRequestAutoMerge{
+		EnabledBy:     &User{Login: String("u")},
+		MergeMethod:   String("squash"),
+		CommitTitle:   String("t"),
+		CommitMessage: String("m"),
+	}
+	if !cmp.Equal(autoMerge, want) {
+		t.Errorf("PullRequests.EnableAutoMerge returned %+v, want %+v", autoMerge, want)
+	}
+
+	const methodName = "EnableAutoMerge"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.EnableAutoMerge(ctx, "o", "r", 1, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_pullRequestNodeID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := new(graphQLRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+		if strings.Contains(req.Query, "mergeQueueEntry") {
+			t.Errorf("Request query = %q, want no merge queue fields", req.Query)
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"id":"PR_1"}}}}`)
+	})
+
+	ctx := context.Background()
+	id, _, err := client.PullRequests.pullRequestNodeID(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.pullRequestNodeID returned error: %v", err)
+	}
+	if id != "PR_1" {
+		t.Errorf("PullRequests.pullRequestNodeID returned %q, want %q", id, "PR_1")
+	}
+}
+
+func TestPullRequestsService_EnableAutoMerge_invalidMergeMethod(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.EnableAutoMerge(ctx, "o", "r", 1, &AutoMergeOptions{MergeMethod: "fast-forward"})
+	if err == nil {
+		t.Error("PullRequests.EnableAutoMerge returned nil error, want error for invalid merge method")
+	}
+}
+
+func TestPullRequestsService_DisableAutoMerge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var called bool
+	handlePullRequestGraphQL(t, mux, `null`, func(req *graphQLRequest) string {
+		called = true
+		if !strings.Contains(req.Query, "disablePullRequestAutoMerge") {
+			t.Errorf("Request query = %q, want disablePullRequestAutoMerge mutation", req.Query)
+		}
+		want := map[string]interface{}{"input": map[string]interface{}{"pullRequestId": "PR_1"}}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", req.Variables, want)
+		}
+		return `{"data":{"disablePullRequestAutoMerge":{"pullRequest":{"id":"PR_1"}}}}`
+	})
+
+	ctx := context.Background()
+	_, err := client.PullRequests.DisableAutoMerge(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.DisableAutoMerge returned error: %v", err)
+	}
+	if !called {
+		t.Error("PullRequests.DisableAutoMerge did not send the mutation")
+	}
+
+	const methodName = "DisableAutoMerge"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.PullRequests.DisableAutoMerge(ctx, "o", "r", 1)
+	})
+}
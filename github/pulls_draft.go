@@ -0,0 +1,42 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "context"
+
+// MarkReadyForReview marks a draft pull request as ready for review.
+//
+// The draft state cannot be changed through PullRequestsService.Edit.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#markpullrequestreadyforreview
+func (s *PullRequestsService) MarkReadyForReview(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	return s.setDraft(ctx, owner, repo, number, "markPullRequestReadyForReview")
+}
+
+// ConvertToDraft converts a pull request to a draft.
+//
+// The draft state cannot be changed through PullRequestsService.Edit.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#convertpullrequesttodraft
+func (s *PullRequestsService) ConvertToDraft(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	return s.setDraft(ctx, owner, repo, number, "convertPullRequestToDraft")
+}
+
+// setDraft applies mutation, either "markPullRequestReadyForReview" or
+// "convertPullRequestToDraft", to a pull request.
+func (s *PullRequestsService) setDraft(ctx context.Context, owner, repo string, number int, mutation string) (*Response, error) {
+	id, resp, err := s.pullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	query := `mutation($id: ID!) {
+  ` + mutation + `(input: {pullRequestId: $id}) {
+    pullRequest { isDraft }
+  }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"id": id}, nil)
+}
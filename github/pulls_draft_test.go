@@ -0,0 +1,79 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestPullRequestsService_MarkReadyForReview(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var called bool
+	handlePullRequestGraphQL(t, mux, `null`, func(req *graphQLRequest) string {
+		called = true
+		if !strings.Contains(req.Query, "markPullRequestReadyForReview") {
+			t.Errorf("Request query = %q, want markPullRequestReadyForReview mutation", req.Query)
+		}
+		if got := req.Variables["id"]; got != "PR_1" {
+			t.Errorf("Request id = %v, want PR_1", got)
+		}
+		return `{"data":{"markPullRequestReadyForReview":{"pullRequest":{"isDraft":false}}}}`
+	})
+
+	ctx := context.Background()
+	if _, err := client.PullRequests.MarkReadyForReview(ctx, "o", "r", 1); err != nil {
+		t.Errorf("PullRequests.MarkReadyForReview returned error: %v", err)
+	}
+	if !called {
+		t.Error("PullRequests.MarkReadyForReview did not send the mutation")
+	}
+
+	const methodName = "MarkReadyForReview"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.PullRequests.MarkReadyForReview(ctx, "o", "r", 1)
+	})
+}
+
+func TestPullRequestsService_ConvertToDraft(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var called bool
+	handlePullRequestGraphQL(t, mux, `null`, func(req *graphQLRequest) string {
+		called = true
+		if !strings.Contains(req.Query, "convertPullRequestToDraft") {
+			t.Errorf("Request query = %q, want convertPullRequestToDraft mutation", req.Query)
+		}
+		return `{"data":{"convertPullRequestToDraft":{"pullRequest":{"isDraft":true}}}}`
+	})
+
+	ctx := context.Background()
+	if _, err := client.PullRequests.ConvertToDraft(ctx, "o", "r", 1); err != nil {
+		t.Errorf("PullRequests.ConvertToDraft returned error: %v", err)
+	}
+	if !called {
+		t.Error("PullRequests.ConvertToDraft did not send the mutation")
+	}
+}
+
+func TestPullRequestsService_ConvertToDraft_graphQLError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePullRequestGraphQL(t, mux, `null`, func(req *graphQLRequest) string {
+		return `{"errors":[{"type":"UNPROCESSABLE","message":"Pull request is already a draft"}]}`
+	})
+
+	ctx := context.Background()
+	_, err := client.PullRequests.ConvertToDraft(ctx, "o", "r", 1)
+	if _, ok := err.(*GraphQLErrorResponse); !ok {
+		t.Errorf("PullRequests.ConvertToDraft returned error %v, want *GraphQLErrorResponse", err)
+	}
+}
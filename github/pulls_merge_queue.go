@@ -0,0 +1,160 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// isMergeQueueRequired reports whether errorResponse reports that a pull
+// request can only be merged through a merge queue.
+func isMergeQueueRequired(errorResponse *ErrorResponse) bool {
+	return errorResponse.Response.StatusCode == http.StatusMethodNotAllowed &&
+		strings.Contains(strings.ToLower(errorResponse.Message), "merge queue")
+}
+
+// MergeQueueEntry represents a pull request in a merge queue.
+//
+// Merge queues are only exposed by the GitHub GraphQL API, hence the
+// GraphQL field names.
+type MergeQueueEntry struct {
+	// ID is the node ID of the merge queue entry.
+	ID *string `json:"id,omitempty"`
+	// State is the state of the entry in the queue. Possible values are:
+	// "AWAITING_CHECKS", "LOCKED", "MERGEABLE", "QUEUED", "UNMERGEABLE".
+	State *string `json:"state,omitempty"`
+	// Position is the position of the entry in the queue, starting at 0.
+	Position   *int       `json:"position,omitempty"`
+	EnqueuedAt *Timestamp `json:"enqueuedAt,omitempty"`
+	// EstimatedTimeToMerge is the estimated number of seconds until the
+	// pull request is merged.
+	EstimatedTimeToMerge *int `json:"estimatedTimeToMerge,omitempty"`
+}
+
+// EnqueuePullRequestOptions specifies the optional parameters to the
+// PullRequestsService.EnqueuePullRequest method.
+type EnqueuePullRequestOptions struct {
+	// ExpectedHeadSHA, if set, is the SHA the head of the pull request must
+	// match for it to be added to the queue.
+	ExpectedHeadSHA string
+	// Jump adds the pull request to the front of the queue.
+	Jump bool
+}
+
+const mergeQueueEntryFields = `id state position enqueuedAt estimatedTimeToMerge`
+
+// pullRequestNode returns the node ID and the merge queue entry, if any, of a
+// pull request.
+func (s *PullRequestsService) pullRequestNode(ctx context.Context, owner, repo string, number int) (string, *MergeQueueEntry, *Response, error) {
+	query := `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      id
+      mergeQueueEntry { ` + mergeQueueEntryFields + ` }
+    }
+  }
+}`
+	variables := map[string]interface{}{"owner": owner, "repo": repo, "number": number}
+
+	var data struct {
+		Repository *struct {
+			PullRequest *struct {
+				ID              string           `json:"id"`
+				MergeQueueEntry *MergeQueueEntry `json:"mergeQueueEntry"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return "", nil, resp, err
+	}
+	if data.Repository == nil || data.Repository.PullRequest == nil {
+		return "", nil, resp, errors.New("pull request not found")
+	}
+
+	return data.Repository.PullRequest.ID, data.Repository.PullRequest.MergeQueueEntry, resp, nil
+}
+
+// GetMergeQueueEntry returns the merge queue entry of a pull request, or
+// nil if the pull request is not in a merge queue.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#mergequeueentry
+func (s *PullRequestsService) GetMergeQueueEntry(ctx context.Context, owner, repo string, number int) (*MergeQueueEntry, *Response, error) {
+	_, entry, resp, err := s.pullRequestNode(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entry, resp, nil
+}
+
+// EnqueuePullRequest adds a pull request to the merge queue of its base branch.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#enqueuepullrequest
+func (s *PullRequestsService) EnqueuePullRequest(ctx context.Context, owner, repo string, number int, opts *EnqueuePullRequestOptions) (*MergeQueueEntry, *Response, error) {
+	id, _, resp, err := s.pullRequestNode(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	input := map[string]interface{}{"pullRequestId": id}
+	if opts != nil {
+		if opts.ExpectedHeadSHA != "" {
+			input["expectedHeadOid"] = opts.ExpectedHeadSHA
+		}
+		if opts.Jump {
+			input["jump"] = true
+		}
+	}
+
+	mutation := `mutation($input: EnqueuePullRequestInput!) {
+  enqueuePullRequest(input: $input) {
+    mergeQueueEntry { ` + mergeQueueEntryFields + ` }
+  }
+}`
+	var data struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry *MergeQueueEntry `json:"mergeQueueEntry"`
+		} `json:"enqueuePullRequest"`
+	}
+	resp, err = s.client.graphQL(ctx, mutation, map[string]interface{}{"input": input}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.EnqueuePullRequest.MergeQueueEntry, resp, nil
+}
+
+// DequeuePullRequest removes a pull request from the merge queue of its
+// base branch. It returns the removed merge queue entry.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#dequeuepullrequest
+func (s *PullRequestsService) DequeuePullRequest(ctx context.Context, owner, repo string, number int) (*MergeQueueEntry, *Response, error) {
+	id, _, resp, err := s.pullRequestNode(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	mutation := `mutation($input: DequeuePullRequestInput!) {
+  dequeuePullRequest(input: $input) {
+    mergeQueueEntry { ` + mergeQueueEntryFields + ` }
+  }
+}`
+	var data struct {
+		DequeuePullRequest struct {
+			MergeQueueEntry *MergeQueueEntry `json:"mergeQueueEntry"`
+		} `json:"dequeuePullRequest"`
+	}
+	resp, err = s.client.graphQL(ctx, mutation, map[string]interface{}{"input": map[string]interface{}{"id": id}}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.DequeuePullRequest.MergeQueueEntry, resp, nil
+}
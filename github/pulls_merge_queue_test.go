@@ -0,0 +1,164 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handlePullRequestGraphQL serves the pull request lookup query made by the
+// GraphQL-backed pull request methods, and passes mutations to mutate.
+func handlePullRequestGraphQL(t *testing.T, mux *http.ServeMux, entry string, mutate func(req *graphQLRequest) string) {
+	t.Helper()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		req := new(graphQLRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+
+		if strings.HasPrefix(req.Query, "query") {
+			want := map[string]interface{}{"owner": "o", "repo": "r", "number": float64(1)}
+			if !cmp.Equal(req.Variables, want) {
+				t.Errorf("Request variables = %+v, want %+v", req.Variables, want)
+			}
+			fmt.Fprintf(w, `{"data":{"repository":{"pullRequest":{"id":"PR_1","mergeQueueEntry":%v}}}}`, entry)
+			return
+		}
+		fmt.Fprint(w, mutate(req))
+	})
+}
+
+func TestPullRequestsService_GetMergeQueueEntry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePullRequestGraphQL(t, mux, `{"id":"MQE_1","state":"AWAITING_CHECKS","position":2,"enqueuedAt":`+referenceTimeStr+`,"estimatedTimeToMerge":600}`, nil)
+
+	ctx := context.Background()
+	entry, _, err := client.PullRequests.GetMergeQueueEntry(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned error: %v", err)
+	}
+
+	want := &MergeQueueEntry{
+		ID:                   String("MQE_1"),
+		State:                String("AWAITING_CHECKS"),
+		Position:             Int(2),
+		EnqueuedAt:           &Timestamp{referenceTime},
+		EstimatedTimeToMerge: Int(600),
+	}
+	if !cmp.Equal(entry, want) {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned %+v, want %+v", entry, want)
+	}
+
+	const methodName = "GetMergeQueueEntry"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.GetMergeQueueEntry(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_GetMergeQueueEntry_notQueued(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePullRequestGraphQL(t, mux, `null`, nil)
+
+	ctx := context.Background()
+	entry, _, err := client.PullRequests.GetMergeQueueEntry(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned error: %v", err)
+	}
+	if entry != nil {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned %+v, want nil", entry)
+	}
+}
+
+func TestPullRequestsService_EnqueuePullRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePullRequestGraphQL(t, mux, `null`, func(req *graphQLRequest) string {
+		if !strings.Contains(req.Query, "enqueuePullRequest") {
+			t.Errorf("Request query = %q, want enqueuePullRequest mutation", req.Query)
+		}
+		want := map[string]interface{}{"input": map[string]interface{}{"pullRequestId": "PR_1", "expectedHeadOid": "s", "jump": true}}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", req.Variables, want)
+		}
+		return `{"data":{"enqueuePullRequest":{"mergeQueueEntry":{"id":"MQE_1","state":"QUEUED","position":0}}}}`
+	})
+
+	ctx := context.Background()
+	entry, _, err := client.PullRequests.EnqueuePullRequest(ctx, "o", "r", 1, &EnqueuePullRequestOptions{ExpectedHeadSHA: "s", Jump: true})
+	if err != nil {
+		t.Errorf("PullRequests.EnqueuePullRequest returned error: %v", err)
+	}
+
+	want := &MergeQueueEntry{ID: String("MQE_1"), State: String("QUEUED"), Position: Int(0)}
+	if !cmp.Equal(entry, want) {
+		t.Errorf("PullRequests.EnqueuePullRequest returned %+v, want %+v", entry, want)
+	}
+}
+
+func TestPullRequestsService_DequeuePullRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handlePullRequestGraphQL(t, mux, `{"id":"MQE_1"}`, func(req *graphQLRequest) string {
+		if !strings.Contains(req.Query, "dequeuePullRequest") {
+			t.Errorf("Request query = %q, want dequeuePullRequest mutation", req.Query)
+		}
+		want := map[string]interface{}{"input": map[string]interface{}{"id": "PR_1"}}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", req.Variables, want)
+		}
+		return `{"data":{"dequeuePullRequest":{"mergeQueueEntry":{"id":"MQE_1","state":"QUEUED"}}}}`
+	})
+
+	ctx := context.Background()
+	entry, _, err := client.PullRequests.DequeuePullRequest(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.DequeuePullRequest returned error: %v", err)
+	}
+
+	want := &MergeQueueEntry{ID: String("MQE_1"), State: String("QUEUED")}
+	if !cmp.Equal(entry, want) {
+		t.Errorf("PullRequests.DequeuePullRequest returned %+v, want %+v", entry, want)
+	}
+}
+
+func TestPullRequestsService_Merge_mergeQueueRequired(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprint(w, `{"message":"Changes must be made through the merge queue"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("PullRequests.Merge returned error %v, want *ErrorResponse", err)
+	}
+	if got := MergeFailureReason(err); got != MergeFailureMergeQueueRequired {
+		t.Errorf("MergeFailureReason = %v, want %v", got, MergeFailureMergeQueueRequired)
+	}
+}
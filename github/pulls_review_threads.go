@@ -0,0 +1,177 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// PullRequestReviewThread represents a thread of review comments on a pull
+// request.
+//
+// Review threads are only exposed by the GitHub GraphQL API. They are linked
+// to the REST API through CommentIDs, the IDs of the PullRequestComments that
+// make up the thread.
+type PullRequestReviewThread struct {
+	// ID is the node ID of the thread.
+	ID         *string `json:"id,omitempty"`
+	IsResolved *bool   `json:"is_resolved,omitempty"`
+	IsOutdated *bool   `json:"is_outdated,omitempty"`
+	ResolvedBy *User   `json:"resolved_by,omitempty"`
+	Path       *string `json:"path,omitempty"`
+	Line       *int    `json:"line,omitempty"`
+	// CommentIDs lists the IDs of the comments of the thread, first comment
+	// first. At most the first 100 comments of a thread are listed.
+	CommentIDs []int64 `json:"comment_ids,omitempty"`
+}
+
+const reviewThreadFields = `id isResolved isOutdated path line resolvedBy { login } comments(first: 100) { nodes { databaseId } }`
+
+// graphQLReviewThread is the GraphQL representation of a PullRequestReviewThread.
+type graphQLReviewThread struct {
+	ID         string `json:"id"`
+	IsResolved bool   `json:"isResolved"`
+	IsOutdated bool   `json:"isOutdated"`
+	Path       string `json:"path"`
+	Line       *int   `json:"line"`
+	ResolvedBy *struct {
+		Login string `json:"login"`
+	} `json:"resolvedBy"`
+	Comments struct {
+		Nodes []struct {
+			DatabaseID int64 `json:"databaseId"`
+		} `json:"nodes"`
+	} `json:"comments"`
+}
+
+func (t *graphQLReviewThread) toReviewThread() *PullRequestReviewThread {
+	thread := &PullRequestReviewThread{
+		ID:         String(t.ID),
+		IsResolved: Bool(t.IsResolved),
+		IsOutdated: Bool(t.IsOutdated),
+		Path:       String(t.Path),
+		Line:       t.Line,
+	}
+	if t.ResolvedBy != nil {
+		thread.ResolvedBy = &User{Login: String(t.ResolvedBy.Login)}
+	}
+	for _, c := range t.Comments.Nodes {
+		thread.CommentIDs = append(thread.CommentIDs, c.DatabaseID)
+	}
+	return thread
+}
+
+// ListReviewThreads lists all review threads on a pull request, with their
+// resolved state.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#pullrequestreviewthread
+func (s *PullRequestsService) ListReviewThreads(ctx context.Context, owner, repo string, number int) ([]*PullRequestReviewThread, *Response, error) {
+	query := `query($owner: String!, $repo: String!, $number: Int!, $cursor: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewThreads(first: 100, after: $cursor) {
+        pageInfo { hasNextPage endCursor }
+        nodes { ` + reviewThreadFields + ` }
+      }
+    }
+  }
+}`
+	variables := map[string]interface{}{"owner": owner, "repo": repo, "number": number}
+
+	var threads []*PullRequestReviewThread
+	var resp *Response
+	for {
+		var data struct {
+			Repository *struct {
+				PullRequest *struct {
+					ReviewThreads struct {
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+						Nodes []*graphQLReviewThread `json:"nodes"`
+					} `json:"reviewThreads"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
+		}
+		var err error
+		resp, err = s.client.graphQL(ctx, query, variables, &data)
+		if err != nil {
+			return nil, resp, err
+		}
+		if data.Repository == nil || data.Repository.PullRequest == nil {
+			return nil, resp, fmt.Errorf("pull request %v/%v#%v not found", owner, repo, number)
+		}
+
+		page := data.Repository.PullRequest.ReviewThreads
+		for _, t := range page.Nodes {
+			threads = append(threads, t.toReviewThread())
+		}
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = page.PageInfo.EndCursor
+	}
+
+	return threads, resp, nil
+}
+
+// ResolveReviewThread marks as resolved the review thread that contains the
+// pull request review comment commentID.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#resolvereviewthread
+func (s *PullRequestsService) ResolveReviewThread(ctx context.Context, owner, repo string, number int, commentID int64) (*PullRequestReviewThread, *Response, error) {
+	return s.setReviewThreadResolved(ctx, owner, repo, number, commentID, "resolveReviewThread")
+}
+
+// UnresolveReviewThread marks as unresolved the review thread that contains
+// the pull request review comment commentID.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#unresolvereviewthread
+func (s *PullRequestsService) UnresolveReviewThread(ctx context.Context, owner, repo string, number int, commentID int64) (*PullRequestReviewThread, *Response, error) {
+	return s.setReviewThreadResolved(ctx, owner, repo, number, commentID, "unresolveReviewThread")
+}
+
+// setReviewThreadResolved looks up the review thread containing commentID and
+// applies mutation, either "resolveReviewThread" or "unresolveReviewThread", to it.
+func (s *PullRequestsService) setReviewThreadResolved(ctx context.Context, owner, repo string, number int, commentID int64, mutation string) (*PullRequestReviewThread, *Response, error) {
+	threads, resp, err := s.ListReviewThreads(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var threadID string
+	for _, t := range threads {
+		for _, id := range t.CommentIDs {
+			if id == commentID {
+				threadID = t.GetID()
+			}
+		}
+	}
+	if threadID == "" {
+		return nil, resp, fmt.Errorf("no review thread found for comment %v", commentID)
+	}
+
+	query := fmt.Sprintf(`mutation($threadId: ID!) {
+  %v(input: {threadId: $threadId}) {
+    thread { %v }
+  }
+}`, mutation, reviewThreadFields)
+	var data map[string]struct {
+		Thread *graphQLReviewThread `json:"thread"`
+	}
+	resp, err = s.client.graphQL(ctx, query, map[string]interface{}{"threadId": threadID}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	thread := data[mutation].Thread
+	if thread == nil {
+		return nil, resp, nil
+	}
+	return thread.toReviewThread(), resp, nil
+}
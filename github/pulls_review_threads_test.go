@@ -0,0 +1,145 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleReviewThreadsGraphQL serves two pages of review threads, and passes
+// mutations to mutate.
+func handleReviewThreadsGraphQL(t *testing.T, mux *http.ServeMux, mutate func(req *graphQLRequest) string) {
+	t.Helper()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		req := new(graphQLRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+
+		if !strings.HasPrefix(req.Query, "query") {
+			fmt.Fprint(w, mutate(req))
+			return
+		}
+		if req.Variables["cursor"] == nil {
+			fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"reviewThreads":{
+				"pageInfo":{"hasNextPage":true,"endCursor":"c1"},
+				"nodes":[{"id":"T_1","isResolved":false,"isOutdated":true,"path":"a.go","line":3,"comments":{"nodes":[{"databaseId":10},{"databaseId":11}]}}]
+			}}}}}`)
+			return
+		}
+		if got := req.Variables["cursor"]; got != "c1" {
+			t.Errorf("Request cursor = %v, want c1", got)
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"reviewThreads":{
+			"pageInfo":{"hasNextPage":false},
+			"nodes":[{"id":"T_2","isResolved":true,"path":"b.go","resolvedBy":{"login":"u"},"comments":{"nodes":[{"databaseId":20}]}}]
+		}}}}}`)
+	})
+}
+
+func TestPullRequestsService_ListReviewThreads(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleReviewThreadsGraphQL(t, mux, nil)
+
+	ctx := context.Background()
+	threads, _, err := client.PullRequests.ListReviewThreads(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.ListReviewThreads returned error: %v", err)
+	}
+
+	want := []*PullRequestReviewThread{
+		{ID: String("T_1"), IsResolved: Bool(false), IsOutdated: Bool(true), Path: String("a.go"), Line: Int(3), CommentIDs: []int64{10, 11}},
+		{ID: String("T_2"), IsResolved: Bool(true), IsOutdated: Bool(false), Path: String("b.go"), ResolvedBy: &User{Login: String("u")}, CommentIDs: []int64{20}},
+	}
+	if !cmp.Equal(threads, want) {
+		t.Errorf("PullRequests.ListReviewThreads returned %+v, want %+v", threads, want)
+	}
+
+	const methodName = "ListReviewThreads"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.ListReviewThreads(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_ResolveReviewThread(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleReviewThreadsGraphQL(t, mux, func(req *graphQLRequest) string {
+		if !strings.Contains(req.Query, "resolveReviewThread") || strings.Contains(req.Query, "unresolveReviewThread") {
+			t.Errorf("Request query = %q, want resolveReviewThread mutation", req.Query)
+		}
+		if got := req.Variables["threadId"]; got != "T_1" {
+			t.Errorf("Request threadId = %v, want T_1", got)
+		}
+		return `{"data":{"resolveReviewThread":{"thread":{"id":"T_1","isResolved":true,"path":"a.go","resolvedBy":{"login":"u"},"comments":{"nodes":[{"databaseId":10},{"databaseId":11}]}}}}}`
+	})
+
+	ctx := context.Background()
+	thread, _, err := client.PullRequests.ResolveReviewThread(ctx, "o", "r", 1, 11)
+	if err != nil {
+		t.Errorf("PullRequests.ResolveReviewThread returned error: %v", err)
+	}
+
+	want := &PullRequestReviewThread{ID: String("T_1"), IsResolved: Bool(true), IsOutdated: Bool(false), Path: String("a.go"), ResolvedBy: &User{Login: String("u")}, CommentIDs: []int64{10, 11}}
+	if !cmp.Equal(thread, want) {
+		t.Errorf("PullRequests.ResolveReviewThread returned %+v, want %+v", thread, want)
+	}
+}
+
+func TestPullRequestsService_UnresolveReviewThread(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleReviewThreadsGraphQL(t, mux, func(req *graphQLRequest) string {
+		if !strings.Contains(req.Query, "unresolveReviewThread") {
+			t.Errorf("Request query = %q, want unresolveReviewThread mutation", req.Query)
+		}
+		if got := req.Variables["threadId"]; got != "T_2" {
+			t.Errorf("Request threadId = %v, want T_2", got)
+		}
+		return `{"data":{"unresolveReviewThread":{"thread":{"id":"T_2","isResolved":false,"path":"b.go","comments":{"nodes":[{"databaseId":20}]}}}}}`
+	})
+
+	ctx := context.Background()
+	thread, _, err := client.PullRequests.UnresolveReviewThread(ctx, "o", "r", 1, 20)
+	if err != nil {
+		t.Errorf("PullRequests.UnresolveReviewThread returned error: %v", err)
+	}
+	if thread.GetIsResolved() {
+		t.Errorf("PullRequests.UnresolveReviewThread returned resolved thread %+v", thread)
+	}
+}
+
+func TestPullRequestsService_ResolveReviewThread_unknownComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleReviewThreadsGraphQL(t, mux, func(req *graphQLRequest) string {
+		t.Errorf("Unexpected mutation %q", req.Query)
+		return `{}`
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.PullRequests.ResolveReviewThread(ctx, "o", "r", 1, 99); err == nil {
+		t.Error("PullRequests.ResolveReviewThread returned nil error, want error for unknown comment")
+	}
+}
@@ -7,16 +7,48 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // ReviewersRequest specifies users and teams for a pull request review request.
 type ReviewersRequest struct {
-	NodeID        *string  `json:"node_id,omitempty"`
-	Reviewers     []string `json:"reviewers,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	// Reviewers lists the logins of the users.
+	Reviewers []string `json:"reviewers,omitempty"`
+	// TeamReviewers lists the slugs of the teams, without the organization
+	// prefix. Teams must have at least read access to the repository.
 	TeamReviewers []string `json:"team_reviewers,omitempty"`
 }
 
+// validate reports an error if r requests no reviewers, or if a team is not
+// given by its slug.
+func (r ReviewersRequest) validate() error {
+	if len(r.Reviewers) == 0 && len(r.TeamReviewers) == 0 {
+		return errors.New("at least one reviewer or team reviewer is required")
+	}
+	for _, team := range r.TeamReviewers {
+		if team == "" || strings.Contains(team, "/") {
+			return fmt.Errorf("invalid team reviewer %q: must be a team slug", team)
+		}
+	}
+	return nil
+}
+
+// IsReviewersNotCollaborator reports whether err is the error returned by
+// RequestReviewers when some of the requested users or teams are not
+// collaborators of the repository. GitHub does not say which ones; use
+// NonCollaboratorReviewers to find the users.
+func IsReviewersNotCollaborator(err error) bool {
+	errorResponse, ok := err.(*ErrorResponse)
+	if !ok || errorResponse.Response == nil || errorResponse.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	return strings.Contains(errorResponse.Message, "not a collaborator")
+}
+
 // Reviewers represents reviewers of a pull request.
 type Reviewers struct {
 	Users []*User `json:"users,omitempty"`
@@ -27,6 +59,10 @@ type Reviewers struct {
 //
 // GitHub API docs: https://docs.github.com/en/rest/pulls/review-requests#request-reviewers-for-a-pull-request
 func (s *PullRequestsService) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*PullRequest, *Response, error) {
+	if err := reviewers.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)
 	req, err := s.client.NewRequest("POST", u, &reviewers)
 	if err != nil {
@@ -42,6 +78,69 @@ func (s *PullRequestsService) RequestReviewers(ctx context.Context, owner, repo
 	return r, resp, nil
 }
 
+// NonCollaboratorReviewers returns the logins that are not collaborators of
+// the specified repository, checking each of them with one request. It is
+// meant to explain an error for which IsReviewersNotCollaborator is true.
+//
+// GitHub API docs: https://docs.github.com/en/rest/collaborators/collaborators#check-if-a-user-is-a-repository-collaborator
+func (s *PullRequestsService) NonCollaboratorReviewers(ctx context.Context, owner, repo string, logins []string) ([]string, *Response, error) {
+	var nonCollaborators []string
+	var resp *Response
+	for _, login := range logins {
+		isCollaborator, r, err := s.client.Repositories.IsCollaborator(ctx, owner, repo, login)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		if !isCollaborator {
+			nonCollaborators = append(nonCollaborators, login)
+		}
+	}
+	return nonCollaborators, resp, nil
+}
+
+// RerequestReviews requests a new review from every user who already
+// reviewed the specified pull request, like the re-request button of the
+// GitHub UI. The author of the pull request is skipped. If nobody has
+// reviewed the pull request yet, no request is made and the returned
+// PullRequest is the current one.
+//
+// GitHub API docs: https://docs.github.com/en/rest/pulls/review-requests#request-reviewers-for-a-pull-request
+func (s *PullRequestsService) RerequestReviews(ctx context.Context, owner, repo string, number int) (*PullRequest, *Response, error) {
+	pull, resp, err := s.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	author := pull.GetUser().GetLogin()
+	seen := make(map[string]bool)
+	var reviewers []string
+	opts := &ListOptions{PerPage: 100}
+	for {
+		reviews, resp, err := s.ListReviews(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, review := range reviews {
+			login := review.GetUser().GetLogin()
+			if login == "" || login == author || seen[login] || review.GetState() == "PENDING" {
+				continue
+			}
+			seen[login] = true
+			reviewers = append(reviewers, login)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if len(reviewers) == 0 {
+		return pull, resp, nil
+	}
+	return s.RequestReviewers(ctx, owner, repo, number, ReviewersRequest{Reviewers: reviewers})
+}
+
 // ListReviewers lists reviewers whose reviews have been requested on the specified pull request.
 //
 // GitHub API docs: https://docs.github.com/en/rest/pulls/review-requests#list-requested-reviewers-for-a-pull-request
@@ -66,12 +165,35 @@ func (s *PullRequestsService) ListReviewers(ctx context.Context, owner, repo str
 	return reviewers, resp, nil
 }
 
-// RemoveReviewers removes the review request for the provided reviewers for the specified pull request.
+// removeReviewersRequest is the body of a RemoveReviewers request. Unlike in
+// ReviewersRequest, reviewers is always sent, as GitHub requires it even when
+// only teams are removed.
+type removeReviewersRequest struct {
+	NodeID        *string  `json:"node_id,omitempty"`
+	Reviewers     []string `json:"reviewers"`
+	TeamReviewers []string `json:"team_reviewers,omitempty"`
+}
+
+// RemoveReviewers removes the review request for the provided reviewers for
+// the specified pull request. Both users and teams can be removed at once.
 //
 // GitHub API docs: https://docs.github.com/en/rest/pulls/review-requests#remove-requested-reviewers-from-a-pull-request
 func (s *PullRequestsService) RemoveReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*Response, error) {
+	if err := reviewers.validate(); err != nil {
+		return nil, err
+	}
+
+	body := &removeReviewersRequest{
+		NodeID:        reviewers.NodeID,
+		Reviewers:     reviewers.Reviewers,
+		TeamReviewers: reviewers.TeamReviewers,
+	}
+	if body.Reviewers == nil {
+		body.Reviewers = []string{}
+	}
+
 	u := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)
-	req, err := s.client.NewRequest("DELETE", u, &reviewers)
+	req, err := s.client.NewRequest("DELETE", u, body)
 	if err != nil {
 		return nil, err
 	}
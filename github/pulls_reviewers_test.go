@@ -170,6 +170,122 @@ func TestRemoveReviewers(t *testing.T) {
 	})
 }
 
+func TestRemoveReviewers_teamsOnly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"reviewers":[],"team_reviewers":["justice-league"]}`+"\n")
+	})
+
+	ctx := context.Background()
+	_, err := client.PullRequests.RemoveReviewers(ctx, "o", "r", 1, ReviewersRequest{TeamReviewers: []string{"justice-league"}})
+	if err != nil {
+		t.Errorf("PullRequests.RemoveReviewers returned error: %v", err)
+	}
+}
+
+func TestRequestReviewers_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, reviewers := range []ReviewersRequest{
+		{},
+		{TeamReviewers: []string{"o/justice-league"}},
+	} {
+		if _, _, err := client.PullRequests.RequestReviewers(ctx, "o", "r", 1, reviewers); err == nil {
+			t.Errorf("PullRequests.RequestReviewers(%+v) returned nil error, want error", reviewers)
+		}
+		if _, err := client.PullRequests.RemoveReviewers(ctx, "o", "r", 1, reviewers); err == nil {
+			t.Errorf("PullRequests.RemoveReviewers(%+v) returned nil error, want error", reviewers)
+		}
+	}
+}
+
+func TestRequestReviewers_notCollaborator(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Reviews may only be requested from collaborators. One or more of the users or teams you specified is not a collaborator of the o/r repository."}`)
+	})
+	mux.HandleFunc("/repos/o/r/collaborators/octocat", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/r/collaborators/googlebot", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	logins := []string{"octocat", "googlebot"}
+	_, _, err := client.PullRequests.RequestReviewers(ctx, "o", "r", 1, ReviewersRequest{Reviewers: logins})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Fatalf("PullRequests.RequestReviewers returned error %v, want *ErrorResponse", err)
+	}
+	if !IsReviewersNotCollaborator(err) {
+		t.Errorf("IsReviewersNotCollaborator(%v) = false, want true", err)
+	}
+
+	got, _, err := client.PullRequests.NonCollaboratorReviewers(ctx, "o", "r", logins)
+	if err != nil {
+		t.Errorf("PullRequests.NonCollaboratorReviewers returned error: %v", err)
+	}
+	if want := []string{"googlebot"}; !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.NonCollaboratorReviewers returned %v, want %v", got, want)
+	}
+
+	const methodName = "NonCollaboratorReviewers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.NonCollaboratorReviewers(ctx, "\n", "r", logins)
+		return err
+	})
+}
+
+func TestRerequestReviews(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"user":{"login":"author"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/pulls/1/reviews", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"user":{"login":"octocat"},"state":"CHANGES_REQUESTED"},
+			{"id":2,"user":{"login":"author"},"state":"COMMENTED"},
+			{"id":3,"user":{"login":"octocat"},"state":"COMMENTED"},
+			{"id":4,"user":{"login":"googlebot"},"state":"APPROVED"},
+			{"id":5,"user":{"login":"hubot"},"state":"PENDING"}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"reviewers":["octocat","googlebot"]}`+"\n")
+		fmt.Fprint(w, `{"number":1}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.PullRequests.RerequestReviews(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.RerequestReviews returned error: %v", err)
+	}
+	want := &PullRequest{Number: Int(1)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.RerequestReviews returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "RerequestReviews"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.RerequestReviews(ctx, "\n", "\n", 1)
+		return err
+	})
+}
+
 func TestListReviewers(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -6,11 +6,14 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -106,6 +109,47 @@ func TestPullRequestsService_ListPullRequestsWithCommit(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_ListPullRequestsWithCommitAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/sha/pulls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/commits/sha/pulls?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"number":1,"state":"closed","base":{"ref":"main"}},{"number":2,"state":"open","base":{"ref":"main"}}]`)
+		case "2":
+			fmt.Fprint(w, `[{"number":3,"state":"closed","base":{"ref":"release"}}]`)
+		}
+	})
+
+	ctx := context.Background()
+	pulls, _, err := client.PullRequests.ListPullRequestsWithCommitAll(ctx, "o", "r", "sha", nil)
+	if err != nil {
+		t.Errorf("PullRequests.ListPullRequestsWithCommitAll returned error: %v", err)
+	}
+	if len(pulls) != 3 {
+		t.Errorf("PullRequests.ListPullRequestsWithCommitAll returned %v pull requests, want 3", len(pulls))
+	}
+
+	pulls, _, err = client.PullRequests.ListPullRequestsWithCommitAll(ctx, "o", "r", "sha", &PullRequestListOptions{State: "closed", Base: "main"})
+	if err != nil {
+		t.Errorf("PullRequests.ListPullRequestsWithCommitAll returned error: %v", err)
+	}
+	want := []*PullRequest{{Number: Int(1), State: String("closed"), Base: &PullRequestBranch{Ref: String("main")}}}
+	if !cmp.Equal(pulls, want) {
+		t.Errorf("PullRequests.ListPullRequestsWithCommitAll returned %+v, want %+v", pulls, want)
+	}
+
+	const methodName = "ListPullRequestsWithCommitAll"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.ListPullRequestsWithCommitAll(ctx, "\n", "\n", "\n", nil)
+		return err
+	})
+}
+
 func TestPullRequestsService_List_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -224,6 +268,62 @@ func TestPullRequestsService_GetRaw_invalid(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_GetDiff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const rawStr = "@@diff content"
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Diff)
+		fmt.Fprint(w, rawStr)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	_, err := client.PullRequests.GetDiff(ctx, "o", "r", 1, &buf)
+	if err != nil {
+		t.Fatalf("PullRequests.GetDiff returned error: %v", err)
+	}
+	if got := buf.String(); got != rawStr {
+		t.Errorf("PullRequests.GetDiff wrote %s want %s", got, rawStr)
+	}
+
+	const methodName = "GetDiff"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.PullRequests.GetDiff(ctx, "\n", "\n", -1, &buf)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.PullRequests.GetDiff(ctx, "o", "r", 1, io.Discard)
+	})
+}
+
+func TestPullRequestsService_GetPatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const rawStr = "@@patch content"
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Patch)
+		fmt.Fprint(w, rawStr)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	_, err := client.PullRequests.GetPatch(ctx, "o", "r", 1, &buf)
+	if err != nil {
+		t.Fatalf("PullRequests.GetPatch returned error: %v", err)
+	}
+	if got := buf.String(); got != rawStr {
+		t.Errorf("PullRequests.GetPatch wrote %s want %s", got, rawStr)
+	}
+}
+
 func TestPullRequestsService_Get_links(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -677,6 +777,110 @@ func TestPullRequestsService_ListFiles(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_ListFilesAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/1/files?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"filename":"a"},{"filename":"b"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"filename":"c"}]`)
+		default:
+			t.Errorf("Unexpected page %v", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	var names []string
+	_, err := client.PullRequests.ListFilesAll(ctx, "o", "r", 1, func(f *CommitFile) error {
+		names = append(names, f.GetFilename())
+		return nil
+	})
+	if err != nil {
+		t.Errorf("PullRequests.ListFilesAll returned error: %v", err)
+	}
+	if want := []string{"a", "b", "c"}; !cmp.Equal(names, want) {
+		t.Errorf("PullRequests.ListFilesAll listed %v, want %v", names, want)
+	}
+
+	const methodName = "ListFilesAll"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.PullRequests.ListFilesAll(ctx, "\n", "\n", 1, func(*CommitFile) error { return nil })
+		return err
+	})
+}
+
+func TestPullRequestsService_ListFilesAll_truncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	page := make([]*CommitFile, 100)
+	for i := range page {
+		page[i] = &CommitFile{Filename: String(fmt.Sprintf("f%v", i))}
+	}
+	body, err := json.Marshal(page)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	mux.HandleFunc("/repos/o/r/pulls/1/files", func(w http.ResponseWriter, r *http.Request) {
+		p := 1
+		if v := r.FormValue("page"); v != "" {
+			p, _ = strconv.Atoi(v)
+		}
+		if p < maxPullRequestFiles/100 {
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/o/r/pulls/1/files?per_page=100&page=%v>; rel="next"`, p+1))
+		}
+		w.Write(body)
+	})
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"changed_files":3500}`)
+	})
+
+	ctx := context.Background()
+	var count int
+	_, err = client.PullRequests.ListFilesAll(ctx, "o", "r", 1, func(*CommitFile) error {
+		count++
+		return nil
+	})
+	if err != ErrPullRequestFilesTruncated {
+		t.Errorf("PullRequests.ListFilesAll returned error %v, want %v", err, ErrPullRequestFilesTruncated)
+	}
+	if count != 3000 {
+		t.Errorf("PullRequests.ListFilesAll listed %v files, want 3000", count)
+	}
+}
+
+func TestPullRequestsService_ListFilesAll_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/files", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/1/files?per_page=100&page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"filename":"a"},{"filename":"b"}]`)
+	})
+
+	ctx := context.Background()
+	stop := errors.New("stop")
+	var count int
+	_, err := client.PullRequests.ListFilesAll(ctx, "o", "r", 1, func(*CommitFile) error {
+		count++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("PullRequests.ListFilesAll returned error %v, want %v", err, stop)
+	}
+	if count != 1 {
+		t.Errorf("PullRequests.ListFilesAll called fn %v times, want 1", count)
+	}
+}
+
 func TestPullRequestsService_IsMerged(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -842,6 +1046,61 @@ func TestPullRequestsService_Merge_Blank_Message(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_Merge_failureReasons(t *testing.T) {
+	tests := []struct {
+		status  int
+		message string
+		want    string
+	}{
+		{http.StatusMethodNotAllowed, "Pull Request is not mergeable", MergeFailureNotMergeable},
+		{http.StatusMethodNotAllowed, `Required status check "ci" is expected.`, MergeFailureStatusChecksPending},
+		{http.StatusMethodNotAllowed, "At least 1 approving review is required by reviewers with write access.", MergeFailureReviewRequired},
+		{http.StatusMethodNotAllowed, "Changes must be made through the merge queue", MergeFailureMergeQueueRequired},
+		{http.StatusConflict, "Head branch was modified. Review and try the merge again.", MergeFailureHeadChanged},
+		{http.StatusUnprocessableEntity, "Something else", MergeFailureUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PUT")
+				w.WriteHeader(tt.status)
+				fmt.Fprintf(w, `{"message":%q}`, tt.message)
+			})
+
+			ctx := context.Background()
+			_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", nil)
+			if _, ok := err.(*ErrorResponse); !ok {
+				t.Errorf("PullRequests.Merge returned error %v, want *ErrorResponse", err)
+			}
+			if got := MergeFailureReason(err); got != tt.want {
+				t.Errorf("MergeFailureReason = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestsService_Merge_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("PullRequests.Merge returned error %v, want *ErrorResponse", err)
+	}
+	if got := MergeFailureReason(err); got != "" {
+		t.Errorf("MergeFailureReason = %q, want empty", got)
+	}
+}
+
 func TestPullRequestMergeRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &pullRequestMergeRequest{}, "{}")
 
@@ -518,3 +518,100 @@ func (s *ReactionsService) CreateReleaseReaction(ctx context.Context, owner, rep
 
 	return m, resp, nil
 }
+
+// ListReleaseReactions lists the reactions for a release.
+//
+// GitHub API docs: https://docs.github.com/en/rest/reactions#list-reactions-for-a-release
+func (s *ReactionsService) ListReleaseReactions(ctx context.Context, owner, repo string, releaseID int64, opts *ListOptions) ([]*Reaction, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions", owner, repo, releaseID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", mediaTypeReactionsPreview)
+
+	var m []*Reaction
+	resp, err := s.client.Do(ctx, req, &m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, nil
+}
+
+// DeleteReleaseReaction deletes the reaction for a release.
+//
+// GitHub API docs: https://docs.github.com/en/rest/reactions#delete-a-release-reaction
+func (s *ReactionsService) DeleteReleaseReaction(ctx context.Context, owner, repo string, releaseID, reactionID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions/%v", owner, repo, releaseID, reactionID)
+
+	return s.deleteReaction(ctx, u)
+}
+
+// GetIssueReactionSummary returns the reaction counts, per content type, of
+// an issue. The counts come from the issue itself, so they are fetched in a
+// single request instead of paging through ListIssueReactions.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/issues#get-an-issue
+func (s *ReactionsService) GetIssueReactionSummary(ctx context.Context, owner, repo string, number int) (*Reactions, *Response, error) {
+	issue, resp, err := s.client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reactionSummary(issue.Reactions), resp, nil
+}
+
+// GetIssueCommentReactionSummary returns the reaction counts, per content
+// type, of an issue comment, in a single request.
+//
+// GitHub API docs: https://docs.github.com/en/rest/issues/comments#get-an-issue-comment
+func (s *ReactionsService) GetIssueCommentReactionSummary(ctx context.Context, owner, repo string, commentID int64) (*Reactions, *Response, error) {
+	comment, resp, err := s.client.Issues.GetComment(ctx, owner, repo, commentID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reactionSummary(comment.Reactions), resp, nil
+}
+
+// GetPullRequestCommentReactionSummary returns the reaction counts, per
+// content type, of a pull request review comment, in a single request.
+//
+// GitHub API docs: https://docs.github.com/en/rest/pulls/comments#get-a-review-comment-for-a-pull-request
+func (s *ReactionsService) GetPullRequestCommentReactionSummary(ctx context.Context, owner, repo string, commentID int64) (*Reactions, *Response, error) {
+	comment, resp, err := s.client.PullRequests.GetComment(ctx, owner, repo, commentID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reactionSummary(comment.Reactions), resp, nil
+}
+
+// GetReleaseReactionSummary returns the reaction counts, per content type,
+// of a release, in a single request.
+//
+// GitHub API docs: https://docs.github.com/en/rest/releases/releases#get-a-release
+func (s *ReactionsService) GetReleaseReactionSummary(ctx context.Context, owner, repo string, releaseID int64) (*Reactions, *Response, error) {
+	release, resp, err := s.client.Repositories.GetRelease(ctx, owner, repo, releaseID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reactionSummary(release.Reactions), resp, nil
+}
+
+// reactionSummary returns r, or an empty summary with a zero total if the
+// resource has no reactions rollup.
+func reactionSummary(r *Reactions) *Reactions {
+	if r == nil {
+		return &Reactions{TotalCount: Int(0)}
+	}
+	return r
+}
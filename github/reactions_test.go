@@ -906,3 +906,113 @@ func TestReactionService_CreateReleaseReaction(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestReactionsService_ListReleaseReactions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/reactions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeReactionsPreview)
+		testFormValues(t, r, values{"page": "2"})
+		w.Write([]byte(`[{"id":1,"content":"heart"}]`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Reactions.ListReleaseReactions(ctx, "o", "r", 1, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("ListReleaseReactions returned error: %v", err)
+	}
+	if want := []*Reaction{{ID: Int64(1), Content: String("heart")}}; !cmp.Equal(got, want) {
+		t.Errorf("ListReleaseReactions = %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListReleaseReactions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Reactions.ListReleaseReactions(ctx, "\n", "\n", -1, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Reactions.ListReleaseReactions(ctx, "o", "r", 1, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestReactionsService_DeleteReleaseReaction(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/reactions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypeReactionsPreview)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Reactions.DeleteReleaseReaction(ctx, "o", "r", 1, 2); err != nil {
+		t.Errorf("DeleteReleaseReaction returned error: %v", err)
+	}
+
+	const methodName = "DeleteReleaseReaction"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Reactions.DeleteReleaseReaction(ctx, "\n", "\n", -1, -2)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Reactions.DeleteReleaseReaction(ctx, "o", "r", 1, 2)
+	})
+}
+
+func TestReactionsService_GetReactionSummary(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const rollup = `"reactions":{"total_count":3,"+1":2,"heart":1}`
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,`+rollup+`}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/comments/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,`+rollup+`}`)
+	})
+	mux.HandleFunc("/repos/o/r/pulls/comments/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":3,`+rollup+`}`)
+	})
+	mux.HandleFunc("/repos/o/r/releases/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":4}`)
+	})
+
+	ctx := context.Background()
+	want := &Reactions{TotalCount: Int(3), PlusOne: Int(2), Heart: Int(1)}
+
+	got, _, err := client.Reactions.GetIssueReactionSummary(ctx, "o", "r", 1)
+	if err != nil || !cmp.Equal(got, want) {
+		t.Errorf("GetIssueReactionSummary = %+v, %v, want %+v", got, err, want)
+	}
+	got, _, err = client.Reactions.GetIssueCommentReactionSummary(ctx, "o", "r", 2)
+	if err != nil || !cmp.Equal(got, want) {
+		t.Errorf("GetIssueCommentReactionSummary = %+v, %v, want %+v", got, err, want)
+	}
+	got, _, err = client.Reactions.GetPullRequestCommentReactionSummary(ctx, "o", "r", 3)
+	if err != nil || !cmp.Equal(got, want) {
+		t.Errorf("GetPullRequestCommentReactionSummary = %+v, %v, want %+v", got, err, want)
+	}
+	got, _, err = client.Reactions.GetReleaseReactionSummary(ctx, "o", "r", 4)
+	if want := (&Reactions{TotalCount: Int(0)}); err != nil || !cmp.Equal(got, want) {
+		t.Errorf("GetReleaseReactionSummary = %+v, %v, want %+v", got, err, want)
+	}
+
+	const methodName = "GetIssueReactionSummary"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Reactions.GetIssueReactionSummary(ctx, "\n", "\n", -1)
+		return err
+	})
+}
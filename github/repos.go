@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -206,10 +207,16 @@ type RepositoryListOptions struct {
 
 // SecurityAndAnalysis specifies the optional advanced security features
 // that are enabled on a given repository.
+//
+// It can be passed to RepositoriesService.Edit to enable or disable the
+// features; each status is either "enabled" or "disabled", and features
+// left nil are not changed.
 type SecurityAndAnalysis struct {
-	AdvancedSecurity             *AdvancedSecurity             `json:"advanced_security,omitempty"`
-	SecretScanning               *SecretScanning               `json:"secret_scanning,omitempty"`
-	SecretScanningPushProtection *SecretScanningPushProtection `json:"secret_scanning_push_protection,omitempty"`
+	AdvancedSecurity                  *AdvancedSecurity                  `json:"advanced_security,omitempty"`
+	SecretScanning                    *SecretScanning                    `json:"secret_scanning,omitempty"`
+	SecretScanningPushProtection      *SecretScanningPushProtection      `json:"secret_scanning_push_protection,omitempty"`
+	DependabotSecurityUpdates         *DependabotSecurityUpdates         `json:"dependabot_security_updates,omitempty"`
+	SecretScanningNonProviderPatterns *SecretScanningNonProviderPatterns `json:"secret_scanning_non_provider_patterns,omitempty"`
 }
 
 func (s SecurityAndAnalysis) String() string {
@@ -245,6 +252,29 @@ type SecretScanningPushProtection struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// DependabotSecurityUpdates specifies the state of Dependabot security updates on a repository.
+//
+// GitHub API docs: https://docs.github.com/en/code-security/dependabot/dependabot-security-updates/about-dependabot-security-updates
+type DependabotSecurityUpdates struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (d DependabotSecurityUpdates) String() string {
+	return Stringify(d)
+}
+
+// SecretScanningNonProviderPatterns specifies the state of secret scanning
+// for non-provider patterns (such as private keys and generic passwords) on a repository.
+//
+// GitHub API docs: https://docs.github.com/en/code-security/secret-scanning/secret-scanning-patterns#non-provider-patterns
+type SecretScanningNonProviderPatterns struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (s SecretScanningNonProviderPatterns) String() string {
+	return Stringify(s)
+}
+
 // List the repositories for a user. Passing the empty string will list
 // repositories for the authenticated user.
 //
@@ -469,6 +499,7 @@ type TemplateRepoRequest struct {
 	Owner       *string `json:"owner,omitempty"`
 	Description *string `json:"description,omitempty"`
 
+	// IncludeAllBranches copies every branch of the template instead of only the default branch.
 	IncludeAllBranches *bool `json:"include_all_branches,omitempty"`
 	Private            *bool `json:"private,omitempty"`
 }
@@ -721,6 +752,56 @@ func (s *RepositoriesService) DisableAutomatedSecurityFixes(ctx context.Context,
 	return s.client.Do(ctx, req, nil)
 }
 
+// checkPrivateReporting represents whether private vulnerability reporting is enabled.
+type checkPrivateReporting struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// IsPrivateReportingEnabled checks if private vulnerability reporting is enabled
+// for the repository and returns a boolean indicating the status.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#check-if-private-vulnerability-reporting-is-enabled-for-a-repository
+func (s *RepositoriesService) IsPrivateReportingEnabled(ctx context.Context, owner, repo string) (bool, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	privateReporting := new(checkPrivateReporting)
+	resp, err := s.client.Do(ctx, req, privateReporting)
+	return privateReporting.Enabled, resp, err
+}
+
+// EnablePrivateReporting enables private vulnerability reporting for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#enable-private-vulnerability-reporting-for-a-repository
+func (s *RepositoriesService) EnablePrivateReporting(ctx context.Context, owner, repo string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DisablePrivateReporting disables private vulnerability reporting for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#disable-private-vulnerability-reporting-for-a-repository
+func (s *RepositoriesService) DisablePrivateReporting(ctx context.Context, owner, repo string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // ListContributors lists contributors for a repository.
 //
 // GitHub API docs: https://docs.github.com/en/rest/repos/repos#list-repository-contributors
@@ -1023,10 +1104,14 @@ type RequiredStatusChecks struct {
 	Contexts []string `json:"contexts,omitempty"`
 	// The list of status checks to require in order to merge into this
 	// branch.
-	Checks []*RequiredStatusCheck `json:"checks"`
+	Checks      []*RequiredStatusCheck `json:"checks"`
+	ContextsURL *string                `json:"contexts_url,omitempty"`
+	URL         *string                `json:"url,omitempty"`
 }
 
 // RequiredStatusChecksRequest represents a request to edit a protected branch's status checks.
+// Fields left unset are not changed, so a single setting can be updated
+// without reading and rewriting the whole branch protection.
 type RequiredStatusChecksRequest struct {
 	Strict *bool `json:"strict,omitempty"`
 	// Note: if both Contexts and Checks are populated,
@@ -1258,14 +1343,15 @@ type renameBranchRequest struct {
 	NewName string `json:"new_name"`
 }
 
-// RenameBranch renames a branch in a repository.
+// RenameBranch renames a branch in a repository. The branch name is escaped,
+// so it may contain slashes.
 //
 // To rename a non-default branch: Users must have push access. GitHub Apps must have the `contents:write` repository permission.
 // To rename the default branch: Users must have admin or owner permissions. GitHub Apps must have the `administration:write` repository permission.
 //
 // GitHub API docs: https://docs.github.com/en/rest/branches/branches#rename-a-branch
 func (s *RepositoriesService) RenameBranch(ctx context.Context, owner, repo, branch, newName string) (*Branch, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/rename", owner, repo, branch)
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/rename", owner, repo, url.PathEscape(branch))
 	r := &renameBranchRequest{NewName: newName}
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
@@ -1446,6 +1532,7 @@ func (s *RepositoriesService) OptionalSignaturesOnProtectedBranch(ctx context.Co
 }
 
 // UpdateRequiredStatusChecks updates the required status checks for a given protected branch.
+// Only the fields set in sreq are changed.
 //
 // GitHub API docs: https://docs.github.com/en/rest/branches/branch-protection#update-status-check-protection
 func (s *RepositoriesService) UpdateRequiredStatusChecks(ctx context.Context, owner, repo, branch string, sreq *RequiredStatusChecksRequest) (*RequiredStatusChecks, *Response, error) {
@@ -1661,10 +1748,47 @@ func (s *RepositoriesService) ListAllTopics(ctx context.Context, owner, repo str
 	return topics.Names, resp, nil
 }
 
+// Limits enforced by GitHub on repository topics.
+const (
+	maxTopics      = 20
+	maxTopicLength = 50
+)
+
+// ValidateTopics reports whether topics can be set on a repository: there
+// may be at most 20 topics, and each must be at most 50 characters long,
+// consist of lowercase letters, numbers, and hyphens, and start with a
+// letter or a number.
+func ValidateTopics(topics []string) error {
+	if len(topics) > maxTopics {
+		return fmt.Errorf("too many topics: %v, the maximum is %v", len(topics), maxTopics)
+	}
+
+	for _, topic := range topics {
+		if topic == "" || len(topic) > maxTopicLength {
+			return fmt.Errorf("topic %q must be between 1 and %v characters long", topic, maxTopicLength)
+		}
+		for i, r := range topic {
+			switch {
+			case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			case r == '-' && i > 0:
+			default:
+				return fmt.Errorf("topic %q must contain only lowercase letters, numbers, and hyphens, and start with a letter or number", topic)
+			}
+		}
+	}
+
+	return nil
+}
+
 // ReplaceAllTopics replaces all repository topics.
+// The topics are checked with ValidateTopics before calling the API.
 //
 // GitHub API docs: https://docs.github.com/en/rest/repos/repos#replace-all-repository-topics
 func (s *RepositoriesService) ReplaceAllTopics(ctx context.Context, owner, repo string, topics []string) ([]string, *Response, error) {
+	if err := ValidateTopics(topics); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/topics", owner, repo)
 	t := &repositoryTopics{
 		Names: topics,
@@ -1964,8 +2088,10 @@ func (s *RepositoriesService) RemoveUserRestrictions(ctx context.Context, owner,
 
 // TransferRequest represents a request to transfer a repository.
 type TransferRequest struct {
-	NewOwner string  `json:"new_owner"`
-	TeamID   []int64 `json:"team_ids,omitempty"`
+	NewOwner string `json:"new_owner"`
+	// NewName renames the repository as part of the transfer.
+	NewName *string `json:"new_name,omitempty"`
+	TeamID  []int64 `json:"team_ids,omitempty"`
 }
 
 // Transfer transfers a repository from one account or organization to another.
@@ -1973,6 +2099,8 @@ type TransferRequest struct {
 // This method might return an *AcceptedError and a status code of
 // 202. This is because this is the status that GitHub returns to signify that
 // it has now scheduled the transfer of the repository in a background task.
+// In this event, the Repository value will be returned, which includes the
+// details about the pending transfer.
 // A follow up request, after a delay of a second or so, should result
 // in a successful request.
 //
@@ -1988,12 +2116,26 @@ func (s *RepositoriesService) Transfer(ctx context.Context, owner, repo string,
 	r := new(Repository)
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
+		// Persist AcceptedError's metadata to the Repository object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, r); err != nil {
+				return r, resp, err
+			}
+
+			return r, resp, err
+		}
 		return nil, resp, err
 	}
 
 	return r, resp, nil
 }
 
+// Limits enforced by GitHub on repository_dispatch events.
+const (
+	maxDispatchEventTypeLength   = 100
+	maxDispatchPayloadProperties = 10
+)
+
 // DispatchRequestOptions represents a request to trigger a repository_dispatch event.
 type DispatchRequestOptions struct {
 	// EventType is a custom webhook event name. (Required.)
@@ -2001,12 +2143,79 @@ type DispatchRequestOptions struct {
 	// ClientPayload is a custom JSON payload with extra information about the webhook event.
 	// Defaults to an empty JSON object.
 	ClientPayload *json.RawMessage `json:"client_payload,omitempty"`
+
+	// AllowedEventTypes, if not empty, lists the only event types that may
+	// be dispatched. It is checked by the client and not sent to GitHub.
+	AllowedEventTypes []string `json:"-"`
+}
+
+// NewDispatchRequestOptions returns the options to dispatch an event of
+// eventType whose client payload is payload marshaled to JSON. payload must
+// marshal to a JSON object, such as a struct or a map.
+func NewDispatchRequestOptions(eventType string, payload interface{}) (DispatchRequestOptions, error) {
+	opts := DispatchRequestOptions{EventType: eventType}
+	if payload == nil {
+		return opts, opts.validate()
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return opts, fmt.Errorf("marshaling client payload: %w", err)
+	}
+	raw := json.RawMessage(b)
+	opts.ClientPayload = &raw
+
+	return opts, opts.validate()
+}
+
+// validate checks the options against the limits enforced by GitHub, so
+// that callers get a descriptive error instead of a 422 response.
+func (o *DispatchRequestOptions) validate() error {
+	if o.EventType == "" {
+		return errors.New("dispatch event type must be provided")
+	}
+	if len(o.EventType) > maxDispatchEventTypeLength {
+		return fmt.Errorf("dispatch event type %q is longer than %v characters", o.EventType, maxDispatchEventTypeLength)
+	}
+	if len(o.AllowedEventTypes) > 0 {
+		allowed := false
+		for _, t := range o.AllowedEventTypes {
+			if t == o.EventType {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("dispatch event type %q is not one of %q", o.EventType, o.AllowedEventTypes)
+		}
+	}
+
+	if o.ClientPayload != nil {
+		var properties map[string]json.RawMessage
+		if err := json.Unmarshal(*o.ClientPayload, &properties); err != nil {
+			return fmt.Errorf("dispatch client payload must be a JSON object: %w", err)
+		}
+		if len(properties) > maxDispatchPayloadProperties {
+			return fmt.Errorf("dispatch client payload has %v top-level properties, the maximum is %v", len(properties), maxDispatchPayloadProperties)
+		}
+	}
+
+	return nil
 }
 
 // Dispatch triggers a repository_dispatch event in a GitHub Actions workflow.
 //
+// The options are checked before calling the API: the event type must be
+// at most 100 characters long and listed in opts.AllowedEventTypes if set,
+// and the client payload must be a JSON object with at most 10 top-level
+// properties.
+//
 // GitHub API docs: https://docs.github.com/en/rest/repos/repos#create-a-repository-dispatch-event
 func (s *RepositoriesService) Dispatch(ctx context.Context, owner, repo string, opts DispatchRequestOptions) (*Repository, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/dispatches", owner, repo)
 
 	req, err := s.client.NewRequest("POST", u, &opts)
@@ -8,13 +8,25 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
+// autolinkURLPlaceholder is the placeholder in an autolink URL template that
+// is replaced by the reference found after the key prefix.
+const autolinkURLPlaceholder = "<num>"
+
 // AutolinkOptions specifies parameters for RepositoriesService.AddAutolink method.
 type AutolinkOptions struct {
-	KeyPrefix      *string `json:"key_prefix,omitempty"`
-	URLTemplate    *string `json:"url_template,omitempty"`
-	IsAlphanumeric *bool   `json:"is_alphanumeric,omitempty"`
+	// KeyPrefix is the prefix appended by alphanumeric characters that
+	// generates a link, for example "TICKET-".
+	KeyPrefix *string `json:"key_prefix,omitempty"`
+	// URLTemplate is the URL the references link to. It must contain the
+	// "<num>" placeholder, for example "https://example.com/TICKET?query=<num>".
+	URLTemplate *string `json:"url_template,omitempty"`
+	// IsAlphanumeric specifies whether the reference may contain letters
+	// (A-Z, case insensitive), hyphens, and underscores in addition to
+	// digits. Default is true.
+	IsAlphanumeric *bool `json:"is_alphanumeric,omitempty"`
 }
 
 // Autolink represents autolinks to external resources like JIRA issues and Zendesk tickets.
@@ -52,9 +64,15 @@ func (s *RepositoriesService) ListAutolinks(ctx context.Context, owner, repo str
 
 // AddAutolink creates an autolink reference for a repository.
 // Users with admin access to the repository can create an autolink.
+// It returns an error without calling the API if opts.URLTemplate does not
+// contain the "<num>" placeholder.
 //
 // GitHub API docs: https://docs.github.com/en/rest/repos/autolinks#create-an-autolink-reference-for-a-repository
 func (s *RepositoriesService) AddAutolink(ctx context.Context, owner, repo string, opts *AutolinkOptions) (*Autolink, *Response, error) {
+	if opts != nil && opts.URLTemplate != nil && !strings.Contains(*opts.URLTemplate, autolinkURLPlaceholder) {
+		return nil, nil, fmt.Errorf("autolink URL template %q must contain %v", *opts.URLTemplate, autolinkURLPlaceholder)
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/autolinks", owner, repo)
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
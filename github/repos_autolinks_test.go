@@ -113,6 +113,25 @@ func TestRepositoriesService_AddAutolink(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_AddAutolink_invalidURLTemplate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/autolinks", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.AddAutolink made a request for an invalid URL template")
+	})
+
+	opt := &AutolinkOptions{
+		KeyPrefix:   String("TICKET-"),
+		URLTemplate: String("https://example.com/TICKET?query=<ref>"),
+	}
+	ctx := context.Background()
+	_, _, err := client.Repositories.AddAutolink(ctx, "o", "r", opt)
+	if err == nil {
+		t.Error("Repositories.AddAutolink returned nil error, want error")
+	}
+}
+
 func TestRepositoriesService_GetAutolink(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
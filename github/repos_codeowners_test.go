@@ -38,7 +38,7 @@ func TestRepositoriesService_GetCodeownersErrors(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	codeownersErrors, _, err := client.Repositories.GetCodeownersErrors(ctx, "o", "r")
+	codeownersErrors, _, err := client.Repositories.GetCodeownersErrors(ctx, "o", "r", nil)
 	if err != nil {
 		t.Errorf("Repositories.GetCodeownersErrors returned error: %v", err)
 	}
@@ -62,12 +62,12 @@ func TestRepositoriesService_GetCodeownersErrors(t *testing.T) {
 
 	const methodName = "GetCodeownersErrors"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Repositories.GetCodeownersErrors(ctx, "\n", "\n")
+		_, _, err = client.Repositories.GetCodeownersErrors(ctx, "\n", "\n", nil)
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		got, resp, err := client.Repositories.GetCodeownersErrors(ctx, "o", "r")
+		got, resp, err := client.Repositories.GetCodeownersErrors(ctx, "o", "r", nil)
 		if got != nil {
 			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
 		}
@@ -75,6 +75,38 @@ func TestRepositoriesService_GetCodeownersErrors(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetCodeownersErrors_specifiedRef(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codeowners/errors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "mybranch"})
+		fmt.Fprint(w, `{"errors":[{"line":1,"column":1,"kind":"Unknown owner","path":".github/CODEOWNERS"}]}`)
+	})
+
+	opts := &GetCodeownersErrorsOptions{Ref: "mybranch"}
+	ctx := context.Background()
+	codeownersErrors, _, err := client.Repositories.GetCodeownersErrors(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.GetCodeownersErrors returned error: %v", err)
+	}
+
+	want := &CodeownersErrors{
+		Errors: []*CodeownersError{
+			{
+				Line:   1,
+				Column: 1,
+				Kind:   "Unknown owner",
+				Path:   ".github/CODEOWNERS",
+			},
+		},
+	}
+	if !cmp.Equal(codeownersErrors, want) {
+		t.Errorf("Repositories.GetCodeownersErrors returned %+v, want %+v", codeownersErrors, want)
+	}
+}
+
 func TestCodeownersErrors_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CodeownersErrors{}, "{}")
 
@@ -94,6 +94,12 @@ type RepositoryPermissionLevel struct {
 	// Possible values: "admin", "write", "read", "none"
 	Permission *string `json:"permission,omitempty"`
 
+	// RoleName is the name of the role the user has on the repository,
+	// which may be "maintain", "triage", or a custom repository role, where
+	// Permission only reports the legacy level the role is based on.
+	RoleName *string `json:"role_name,omitempty"`
+
+	// User.Permissions and User.RoleName describe the user's access to the repository.
 	User *User `json:"user,omitempty"`
 }
 
@@ -126,8 +132,10 @@ type RepositoryAddCollaboratorOptions struct {
 	//     admin - team members can pull, push and administer this repository
 	//     maintain - team members can manage the repository without access to sensitive or destructive actions.
 	//     triage - team members can proactively manage issues and pull requests without write access.
+	// The name of a custom repository role defined by the organization may also be used.
 	//
 	// Default value is "push". This option is only valid for organization-owned repositories.
+	// Calling AddCollaborator for an existing collaborator updates their permission.
 	Permission string `json:"permission,omitempty"`
 }
 
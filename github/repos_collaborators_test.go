@@ -226,7 +226,7 @@ func TestRepositoryService_GetPermissionLevel(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/collaborators/u/permission", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprintf(w, `{"permission":"admin","user":{"login":"u"}}`)
+		fmt.Fprintf(w, `{"permission":"write","role_name":"maintain","user":{"login":"u","role_name":"maintain","permissions":{"admin":false,"maintain":true,"push":true,"triage":true,"pull":true}}}`)
 	})
 
 	ctx := context.Background()
@@ -236,9 +236,12 @@ func TestRepositoryService_GetPermissionLevel(t *testing.T) {
 	}
 
 	want := &RepositoryPermissionLevel{
-		Permission: String("admin"),
+		Permission: String("write"),
+		RoleName:   String("maintain"),
 		User: &User{
-			Login: String("u"),
+			Login:       String("u"),
+			RoleName:    String("maintain"),
+			Permissions: map[string]bool{"admin": false, "maintain": true, "push": true, "triage": true, "pull": true},
 		},
 	}
 
@@ -318,6 +321,30 @@ func TestRepositoriesService_AddCollaborator(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_AddCollaborator_customRoleForExistingCollaborator(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/collaborators/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"permission":"security-reviewer"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	opt := &RepositoryAddCollaboratorOptions{Permission: "security-reviewer"}
+	collaboratorInvitation, resp, err := client.Repositories.AddCollaborator(ctx, "o", "r", "u", opt)
+	if err != nil {
+		t.Errorf("Repositories.AddCollaborator returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Repositories.AddCollaborator returned status %v, want %v", got, want)
+	}
+	if want := (&CollaboratorInvitation{}); !cmp.Equal(collaboratorInvitation, want) {
+		t.Errorf("AddCollaborator returned %+v, want %+v", collaboratorInvitation, want)
+	}
+}
+
 func TestRepositoriesService_AddCollaborator_invalidUser(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -381,6 +408,7 @@ func TestRepositoryPermissionLevel_Marshal(t *testing.T) {
 
 	r := &RepositoryPermissionLevel{
 		Permission: String("permission"),
+		RoleName:   String("role_name"),
 		User: &User{
 			Login:           String("l"),
 			ID:              Int64(1),
@@ -405,6 +433,7 @@ func TestRepositoryPermissionLevel_Marshal(t *testing.T) {
 
 	want := `{
 		"permission": "permission",
+		"role_name": "role_name",
 		"user": {
 			"login": "l",
 			"id": 1,
@@ -8,7 +8,10 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 )
@@ -199,6 +202,24 @@ func (s *RepositoriesService) GetCommitRaw(ctx context.Context, owner string, re
 	return buf.String(), resp, nil
 }
 
+// GetCommitDiff writes the specified commit in diff format to w, as it is
+// received. Unlike GetCommitRaw, it does not hold the whole diff in memory.
+//
+// GitHub API docs: https://docs.github.com/en/rest/commits/commits#get-a-commit
+func (s *RepositoriesService) GetCommitDiff(ctx context.Context, owner, repo, sha string, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/commits/%v", owner, repo, sha)
+	return s.client.getRaw(ctx, u, Diff, w)
+}
+
+// GetCommitPatch writes the specified commit in patch format to w, as it is
+// received. Unlike GetCommitRaw, it does not hold the whole patch in memory.
+//
+// GitHub API docs: https://docs.github.com/en/rest/commits/commits#get-a-commit
+func (s *RepositoriesService) GetCommitPatch(ctx context.Context, owner, repo, sha string, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/commits/%v", owner, repo, sha)
+	return s.client.getRaw(ctx, u, Patch, w)
+}
+
 // GetCommitSHA1 gets the SHA-1 of a commit reference. If a last-known SHA1 is
 // supplied and no new commits have occurred, a 304 Unmodified response is returned.
 //
@@ -252,6 +273,161 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 	return comp, resp, nil
 }
 
+// CompareCommitsAll compares a range of commits with each other, like
+// CompareCommits, but pages through the comparison so that Commits holds
+// every commit instead of being truncated at 250.
+//
+// GitHub API docs: https://docs.github.com/en/rest/commits/commits#compare-two-commits
+func (s *RepositoriesService) CompareCommitsAll(ctx context.Context, owner, repo, base, head string) (*CommitsComparison, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	var comp *CommitsComparison
+	for {
+		page, resp, err := s.CompareCommits(ctx, owner, repo, base, head, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		// Files are only listed on the first page, for the whole comparison.
+		if comp == nil {
+			comp = page
+		} else {
+			comp.Commits = append(comp.Commits, page.Commits...)
+		}
+
+		if resp.NextPage == 0 {
+			return comp, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ListPullRequestsForCommitRange returns the pull requests associated with
+// the commits between base and head, as reported by
+// PullRequestsService.ListPullRequestsWithCommitAll for each commit. Each pull
+// request is listed once, in the order of the first commit associating it.
+func (s *RepositoriesService) ListPullRequestsForCommitRange(ctx context.Context, owner, repo, base, head string) ([]*PullRequest, *Response, error) {
+	comp, resp, err := s.CompareCommitsAll(ctx, owner, repo, base, head)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	seen := make(map[int]bool)
+	var pulls []*PullRequest
+	for _, commit := range comp.Commits {
+		var page []*PullRequest
+		page, resp, err = s.client.PullRequests.ListPullRequestsWithCommitAll(ctx, owner, repo, commit.GetSHA(), nil)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, pull := range page {
+			if !seen[pull.GetNumber()] {
+				seen[pull.GetNumber()] = true
+				pulls = append(pulls, pull)
+			}
+		}
+	}
+
+	return pulls, resp, nil
+}
+
+// maxCompareFiles is the number of files the compare endpoint lists at most.
+const maxCompareFiles = 300
+
+// ErrCompareFilesTruncated is returned by RepositoriesService.CompareCommitsFiles
+// when the comparison lists as many files as the API lists at most, so that
+// more files may have changed.
+var ErrCompareFilesTruncated = errors.New("comparison may change more files than the API lists")
+
+// CompareCommitsFiles compares a range of commits with each other and calls
+// fn for each changed file, decoding the files one at a time from the
+// response instead of buffering the whole comparison. If fn returns an
+// error, iteration stops and that error is returned.
+//
+// The API lists at most 300 files per comparison, and does not tell whether
+// more files changed. When 300 files are listed, fn is still called for
+// each of them, then ErrCompareFilesTruncated is returned.
+//
+// GitHub API docs: https://docs.github.com/en/rest/commits/commits#compare-two-commits
+func (s *RepositoriesService) CompareCommitsFiles(ctx context.Context, owner, repo, base, head string, fn func(*CommitFile) error) (*Response, error) {
+	escapedBase := url.QueryEscape(base)
+	escapedHead := url.QueryEscape(head)
+
+	// Paging keeps the commits list short; the first page still lists
+	// the files of the whole comparison.
+	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v?per_page=1", owner, repo, escapedBase, escapedHead)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.BareDo(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	dec := json.NewDecoder(resp.Body)
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return resp, err
+	}
+	count := 0
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return resp, err
+		}
+		if key != "files" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return resp, err
+			}
+			continue
+		}
+
+		tok, err := dec.Token()
+		if err != nil {
+			return resp, err
+		}
+		if tok == nil {
+			continue
+		}
+		if tok != json.Delim('[') {
+			return resp, fmt.Errorf("unexpected JSON token %v for files", tok)
+		}
+		for dec.More() {
+			file := new(CommitFile)
+			if err := dec.Decode(file); err != nil {
+				return resp, err
+			}
+			if err := fn(file); err != nil {
+				return resp, err
+			}
+			count++
+		}
+		if err := expectJSONDelim(dec, ']'); err != nil {
+			return resp, err
+		}
+	}
+
+	if count >= maxCompareFiles {
+		return resp, ErrCompareFilesTruncated
+	}
+	return resp, nil
+}
+
+// expectJSONDelim reads the next token from dec and returns an error unless
+// it is the delimiter want.
+func expectJSONDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != want {
+		return fmt.Errorf("unexpected JSON token %v, want %v", tok, want)
+	}
+	return nil
+}
+
 // CompareCommitsRaw compares a range of commits with each other in raw (diff or patch) format.
 //
 // Both "base" and "head" must be branch names in "repo".
@@ -6,8 +6,12 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -234,6 +238,62 @@ func TestRepositoriesService_GetCommitRaw_invalid(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_GetCommitDiff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const rawStr = "@@diff content"
+
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Diff)
+		fmt.Fprint(w, rawStr)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	_, err := client.Repositories.GetCommitDiff(ctx, "o", "r", "s", &buf)
+	if err != nil {
+		t.Fatalf("Repositories.GetCommitDiff returned error: %v", err)
+	}
+	if got := buf.String(); got != rawStr {
+		t.Errorf("Repositories.GetCommitDiff wrote %s want %s", got, rawStr)
+	}
+
+	const methodName = "GetCommitDiff"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.GetCommitDiff(ctx, "\n", "\n", "\n", &buf)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.GetCommitDiff(ctx, "o", "r", "s", io.Discard)
+	})
+}
+
+func TestRepositoriesService_GetCommitPatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const rawStr = "@@patch content"
+
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Patch)
+		fmt.Fprint(w, rawStr)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	_, err := client.Repositories.GetCommitPatch(ctx, "o", "r", "s", &buf)
+	if err != nil {
+		t.Fatalf("Repositories.GetCommitPatch returned error: %v", err)
+	}
+	if got := buf.String(); got != rawStr {
+		t.Errorf("Repositories.GetCommitPatch wrote %s want %s", got, rawStr)
+	}
+}
+
 func TestRepositoriesService_GetCommitSHA1(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -370,6 +430,180 @@ func TestRepositoriesService_TrailingPercent_GetCommitSHA1(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_CompareCommitsAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/compare/b...h?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_commits":2,"commits":[{"sha":"c1"}],"files":[{"filename":"f"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_commits":2,"commits":[{"sha":"c2"}]}`)
+		default:
+			t.Errorf("unexpected page %v", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.CompareCommitsAll(ctx, "o", "r", "b", "h")
+	if err != nil {
+		t.Errorf("Repositories.CompareCommitsAll returned error: %v", err)
+	}
+
+	want := &CommitsComparison{
+		TotalCommits: Int(2),
+		Commits:      []*RepositoryCommit{{SHA: String("c1")}, {SHA: String("c2")}},
+		Files:        []*CommitFile{{Filename: String("f")}},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.CompareCommitsAll returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "CompareCommitsAll"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CompareCommitsAll(ctx, "\n", "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CompareCommitsAll(ctx, "o", "r", "b", "h")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_ListPullRequestsForCommitRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"commits":[{"sha":"s1"},{"sha":"s2"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s1/pulls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s2/pulls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"number":2},{"number":1}]`)
+	})
+
+	ctx := context.Background()
+	pulls, _, err := client.Repositories.ListPullRequestsForCommitRange(ctx, "o", "r", "b", "h")
+	if err != nil {
+		t.Errorf("Repositories.ListPullRequestsForCommitRange returned error: %v", err)
+	}
+
+	want := []*PullRequest{{Number: Int(1)}, {Number: Int(2)}}
+	if !cmp.Equal(pulls, want) {
+		t.Errorf("Repositories.ListPullRequestsForCommitRange returned %+v, want %+v", pulls, want)
+	}
+
+	const methodName = "ListPullRequestsForCommitRange"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListPullRequestsForCommitRange(ctx, "\n", "\n", "\n", "\n")
+		return err
+	})
+}
+
+func TestRepositoriesService_CompareCommitsFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `{"status":"ahead","commits":[{"sha":"c1"}],"files":[{"filename":"f1","additions":1},{"filename":"f2"}],"url":"u"}`)
+	})
+
+	ctx := context.Background()
+	var got []*CommitFile
+	_, err := client.Repositories.CompareCommitsFiles(ctx, "o", "r", "b", "h", func(f *CommitFile) error {
+		got = append(got, f)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Repositories.CompareCommitsFiles returned error: %v", err)
+	}
+
+	want := []*CommitFile{{Filename: String("f1"), Additions: Int(1)}, {Filename: String("f2")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.CompareCommitsFiles returned %+v, want %+v", got, want)
+	}
+
+	errStop := errors.New("stop")
+	calls := 0
+	_, err = client.Repositories.CompareCommitsFiles(ctx, "o", "r", "b", "h", func(f *CommitFile) error {
+		calls++
+		return errStop
+	})
+	if err != errStop || calls != 1 {
+		t.Errorf("Repositories.CompareCommitsFiles returned %v after %v calls, want %v after 1 call", err, calls, errStop)
+	}
+
+	const methodName = "CompareCommitsFiles"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.CompareCommitsFiles(ctx, "\n", "\n", "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.CompareCommitsFiles(ctx, "o", "r", "b", "h", func(*CommitFile) error { return nil })
+	})
+}
+
+func TestRepositoriesService_CompareCommitsFiles_truncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		files := make([]*CommitFile, 300)
+		for i := range files {
+			files[i] = &CommitFile{Filename: String(fmt.Sprintf("f%v", i))}
+		}
+		json.NewEncoder(w).Encode(&CommitsComparison{Files: files})
+	})
+
+	ctx := context.Background()
+	count := 0
+	_, err := client.Repositories.CompareCommitsFiles(ctx, "o", "r", "b", "h", func(*CommitFile) error {
+		count++
+		return nil
+	})
+	if err != ErrCompareFilesTruncated {
+		t.Errorf("Repositories.CompareCommitsFiles returned error %v, want %v", err, ErrCompareFilesTruncated)
+	}
+	if count != 300 {
+		t.Errorf("Repositories.CompareCommitsFiles listed %v files, want 300", count)
+	}
+}
+
+func TestRepositoriesService_CompareCommitsFiles_invalidJSON(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	bodies := []string{`[]`, `{"files":{}}`, `{"files":[1]}`, `{"files":null}`}
+	var body string
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	})
+
+	ctx := context.Background()
+	for _, body = range bodies {
+		_, err := client.Repositories.CompareCommitsFiles(ctx, "o", "r", "b", "h", func(*CommitFile) error { return nil })
+		if gotErr, wantErr := err != nil, body != `{"files":null}`; gotErr != wantErr {
+			t.Errorf("Repositories.CompareCommitsFiles(%v) returned error %v, want error: %v", body, err, wantErr)
+		}
+	}
+}
+
 func TestRepositoriesService_CompareCommits(t *testing.T) {
 	testCases := []struct {
 		base string
@@ -9,6 +9,7 @@
 package github
 
 import (
+	"bufio"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -185,6 +186,121 @@ func (s *RepositoriesService) DownloadContentsWithMeta(ctx context.Context, owne
 	return nil, nil, resp, fmt.Errorf("no file named %s found in %s", filename, dir)
 }
 
+// lfsPointerPrefix is the first line of every Git LFS pointer file.
+const lfsPointerPrefix = "version https://git-lfs.github.com/spec/v1\n"
+
+// DownloadContentsStream returns an io.ReadCloser that streams the raw
+// contents of the specified file, without buffering or base64-decoding it.
+// It is the caller's responsibility to close the ReadCloser.
+//
+// Files up to 100 MB are streamed from the contents API using the raw media
+// type. Larger files are streamed from the Git Data API blob instead. If the
+// file is a Git LFS pointer, the LFS object it points to is streamed from
+// the file's download URL.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/contents#get-repository-content
+func (s *RepositoriesService) DownloadContentsStream(ctx context.Context, owner, repo, filepath string, opts *RepositoryContentGetOptions) (io.ReadCloser, *Response, error) {
+	escapedPath := (&url.URL{Path: strings.TrimSuffix(filepath, "/")}).String()
+	u := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, escapedPath)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeRaw)
+
+	resp, err := s.client.BareDo(ctx, req)
+	if err != nil {
+		if isTooLargeError(err) {
+			return s.downloadLargeContents(ctx, owner, repo, filepath, opts)
+		}
+		return nil, resp, err
+	}
+
+	body := bufio.NewReader(resp.Body)
+	if prefix, _ := body.Peek(len(lfsPointerPrefix)); string(prefix) != lfsPointerPrefix {
+		return struct {
+			io.Reader
+			io.Closer
+		}{body, resp.Body}, resp, nil
+	}
+	resp.Body.Close()
+
+	content, _, resp, err := s.GetContents(ctx, owner, repo, filepath, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if content.GetDownloadURL() == "" {
+		return nil, resp, fmt.Errorf("no download link found for %s", filepath)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, "GET", content.GetDownloadURL(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// BareDo reports a non-2xx status of the download as an *ErrorResponse.
+	resp, err = s.client.BareDo(ctx, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resp.Body, resp, nil
+}
+
+// downloadLargeContents streams a file that is too large for the contents
+// API from the Git Data API, using the blob SHA listed in its directory.
+func (s *RepositoriesService) downloadLargeContents(ctx context.Context, owner, repo, filepath string, opts *RepositoryContentGetOptions) (io.ReadCloser, *Response, error) {
+	dir := path.Dir(filepath)
+	filename := path.Base(filepath)
+	_, dirContents, resp, err := s.GetContents(ctx, owner, repo, dir, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, contents := range dirContents {
+		if contents.GetName() != filename {
+			continue
+		}
+
+		u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", owner, repo, contents.GetSHA())
+		req, err := s.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		req.Header.Set("Accept", mediaTypeRaw)
+
+		resp, err := s.client.BareDo(ctx, req)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		return resp.Body, resp, nil
+	}
+
+	return nil, resp, fmt.Errorf("no file named %s found in %s", filename, dir)
+}
+
+// isTooLargeError reports whether err is GitHub's response to a request for
+// a file that is too large for the contents API.
+func isTooLargeError(err error) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil || errResp.Response.StatusCode != http.StatusForbidden {
+		return false
+	}
+	for _, e := range errResp.Errors {
+		if e.Code == "too_large" {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetContents can return either the metadata and content of a single file
 // (when path references a file) or the metadata of all the files and/or
 // subdirectories of a directory (when path references a directory). To make it
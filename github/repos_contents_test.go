@@ -399,6 +399,183 @@ func TestRepositoriesService_DownloadContentsWithMeta_NoFile(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_DownloadContentsStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeRaw)
+		testFormValues(t, r, values{"ref": "main"})
+		fmt.Fprint(w, "foo")
+	})
+
+	ctx := context.Background()
+	opts := &RepositoryContentGetOptions{Ref: "main"}
+	r, _, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", opts)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadContentsStream returned error: %v", err)
+	}
+	defer r.Close()
+
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		t.Errorf("Error reading response body: %v", err)
+	}
+	if got, want := string(bytes), "foo"; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned %v, want %v", got, want)
+	}
+
+	const methodName = "DownloadContentsStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.DownloadContentsStream(ctx, "\n", "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_DownloadContentsStream_LFSPointer(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.Header.Get("Accept") == mediaTypeRaw {
+			fmt.Fprint(w, "version https://git-lfs.github.com/spec/v1\noid sha256:abc\nsize 3\n")
+			return
+		}
+		fmt.Fprint(w, `{"type":"file","name":"f","download_url":"`+serverURL+baseURLPath+`/media/f"}`)
+	})
+	mux.HandleFunc("/media/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "bar")
+	})
+
+	ctx := context.Background()
+	r, _, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", nil)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadContentsStream returned error: %v", err)
+	}
+	defer r.Close()
+
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		t.Errorf("Error reading response body: %v", err)
+	}
+	if got, want := string(bytes), "bar"; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_DownloadContentsStream_LFSPointerDownloadFails(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") == mediaTypeRaw {
+			fmt.Fprint(w, "version https://git-lfs.github.com/spec/v1\noid sha256:abc\nsize 3\n")
+			return
+		}
+		fmt.Fprint(w, `{"type":"file","name":"f","download_url":"`+serverURL+baseURLPath+`/media/f"}`)
+	})
+	mux.HandleFunc("/media/f", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Repositories.DownloadContentsStream returned error %v, want *ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Repositories.DownloadContentsStream returned response %+v, want status 404", resp)
+	}
+}
+
+func TestRepositoriesService_DownloadContentsStream_LFSPointerNoDownloadURL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") == mediaTypeRaw {
+			fmt.Fprint(w, "version https://git-lfs.github.com/spec/v1\noid sha256:abc\nsize 3\n")
+			return
+		}
+		fmt.Fprint(w, `{"type":"file","name":"f"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", nil)
+	if err == nil {
+		t.Errorf("Repositories.DownloadContentsStream did not return expected error")
+	}
+}
+
+func TestRepositoriesService_DownloadContentsStream_TooLarge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"This API returns blobs up to 100 MB in size.","errors":[{"resource":"Blob","field":"data","code":"too_large"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/contents/d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"type":"file","name":"f","sha":"s"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeRaw)
+		fmt.Fprint(w, "large")
+	})
+
+	ctx := context.Background()
+	r, _, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", nil)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadContentsStream returned error: %v", err)
+	}
+	defer r.Close()
+
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		t.Errorf("Error reading response body: %v", err)
+	}
+	if got, want := string(bytes), "large"; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned %v, want %v", got, want)
+	}
+
+	if _, _, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/g", nil); err == nil {
+		t.Errorf("Repositories.DownloadContentsStream did not return expected error for missing file")
+	}
+}
+
+func TestRepositoriesService_DownloadContentsStream_TooLargeNoFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errors":[{"code":"too_large"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/contents/d", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"type":"file","name":"g","sha":"s"}]`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", nil)
+	if err == nil || err.Error() != "no file named f found in d" {
+		t.Errorf("Repositories.DownloadContentsStream returned error %v, want no file found", err)
+	}
+}
+
 func TestRepositoriesService_GetContents_File(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -15,6 +15,9 @@ type DeploymentBranchPolicy struct {
 	Name   *string `json:"name,omitempty"`
 	ID     *int64  `json:"id,omitempty"`
 	NodeID *string `json:"node_id,omitempty"`
+	// Type is whether the policy matches branches or tags.
+	// Possible values are: "branch", "tag".
+	Type *string `json:"type,omitempty"`
 }
 
 // DeploymentBranchPolicyResponse represents the slightly different format of response that comes back when you list deployment branch policies.
@@ -25,7 +28,12 @@ type DeploymentBranchPolicyResponse struct {
 
 // DeploymentBranchPolicyRequest represents a deployment branch policy request.
 type DeploymentBranchPolicyRequest struct {
+	// Name is the name pattern that branches or tags must match in order
+	// to deploy to the environment.
 	Name *string `json:"name,omitempty"`
+	// Type is whether the policy matches branches or tags. Only used when
+	// creating a policy. Possible values are: "branch" (the default), "tag".
+	Type *string `json:"type,omitempty"`
 }
 
 // ListDeploymentBranchPolicies lists the deployment branch policies for an environment.
@@ -83,16 +83,17 @@ func TestRepositoriesService_CreateDeploymentBranchPolicy(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/environments/e/deployment-branch-policies", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		fmt.Fprint(w, `{"id":1}`)
+		testBody(t, r, `{"name":"n","type":"tag"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"type":"tag"}`)
 	})
 
 	ctx := context.Background()
-	got, _, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, "o", "r", "e", &DeploymentBranchPolicyRequest{Name: String("n")})
+	got, _, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, "o", "r", "e", &DeploymentBranchPolicyRequest{Name: String("n"), Type: String("tag")})
 	if err != nil {
 		t.Errorf("Repositories.CreateDeploymentBranchPolicy returned error: %v", err)
 	}
 
-	want := &DeploymentBranchPolicy{ID: Int64(1)}
+	want := &DeploymentBranchPolicy{ID: Int64(1), Type: String("tag")}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Repositories.CreateDeploymentBranchPolicy = %+v, want %+v", got, want)
 	}
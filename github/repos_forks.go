@@ -9,6 +9,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
+)
+
+// Intervals between the polls made by RepositoriesService.WaitForFork. The
+// interval doubles after each poll, up to forkPollMaxInterval.
+var (
+	forkPollInitialInterval = 1 * time.Second
+	forkPollMaxInterval     = 30 * time.Second
 )
 
 // RepositoryListForksOptions specifies the optional parameters to the
@@ -52,9 +61,11 @@ func (s *RepositoriesService) ListForks(ctx context.Context, owner, repo string,
 // RepositoriesService.CreateFork method.
 type RepositoryCreateForkOptions struct {
 	// The organization to fork the repository into.
-	Organization      string `json:"organization,omitempty"`
-	Name              string `json:"name,omitempty"`
-	DefaultBranchOnly bool   `json:"default_branch_only,omitempty"`
+	Organization string `json:"organization,omitempty"`
+	// The name of the new repository. Defaults to the name of the forked repository.
+	Name string `json:"name,omitempty"`
+	// When true, only the default branch is included in the fork.
+	DefaultBranchOnly bool `json:"default_branch_only,omitempty"`
 }
 
 // CreateFork creates a fork of the specified repository.
@@ -64,7 +75,8 @@ type RepositoryCreateForkOptions struct {
 // it is now computing creating the fork in a background task. In this event,
 // the Repository value will be returned, which includes the details about the pending fork.
 // A follow up request, after a delay of a second or so, should result
-// in a successful request.
+// in a successful request. WaitForFork can be used to wait until the fork
+// is ready.
 //
 // GitHub API docs: https://docs.github.com/en/rest/repos/forks#create-a-fork
 func (s *RepositoriesService) CreateFork(ctx context.Context, owner, repo string, opts *RepositoryCreateForkOptions) (*Repository, *Response, error) {
@@ -91,3 +103,50 @@ func (s *RepositoriesService) CreateFork(ctx context.Context, owner, repo string
 
 	return fork, resp, nil
 }
+
+// WaitForFork polls the fork owner/repo until it can be queried and its git
+// data has been copied, which may take a while after CreateFork returned an
+// *AcceptedError. The delay between polls starts at one second and doubles
+// up to 30 seconds; use a context with a deadline to bound the total wait.
+func (s *RepositoriesService) WaitForFork(ctx context.Context, owner, repo string) (*Repository, *Response, error) {
+	interval := forkPollInitialInterval
+	for {
+		fork, resp, err := s.forkReady(ctx, owner, repo)
+		if fork != nil || err != nil {
+			return fork, resp, err
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, resp, err
+		}
+
+		interval *= 2
+		if interval > forkPollMaxInterval {
+			interval = forkPollMaxInterval
+		}
+	}
+}
+
+// forkReady reports the fork owner/repo if it is ready. It returns a nil
+// Repository and a nil error if the fork is still being created.
+func (s *RepositoriesService) forkReady(ctx context.Context, owner, repo string) (*Repository, *Response, error) {
+	fork, resp, err := s.Get(ctx, owner, repo)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, resp, nil
+		}
+		return nil, resp, err
+	}
+
+	// The repository can be queried before its git data has been copied;
+	// until then listing its commits returns 409 Conflict.
+	_, resp, err = s.ListCommits(ctx, owner, repo, &CommitsListOptions{ListOptions: ListOptions{PerPage: 1}})
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusNotFound) {
+			return nil, resp, nil
+		}
+		return nil, resp, err
+	}
+
+	return fork, resp, nil
+}
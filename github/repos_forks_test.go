@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -137,3 +138,91 @@ func TestRepositoriesService_CreateFork_invalidOwner(t *testing.T) {
 	_, _, err := client.Repositories.CreateFork(ctx, "%", "r", nil)
 	testURLParseError(t, err)
 }
+
+func TestRepositoriesService_WaitForFork(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(initial, max time.Duration) {
+		forkPollInitialInterval, forkPollMaxInterval = initial, max
+	}(forkPollInitialInterval, forkPollMaxInterval)
+	forkPollInitialInterval, forkPollMaxInterval = time.Millisecond, 2*time.Millisecond
+
+	var repoCalls, commitCalls int
+	mux.HandleFunc("/repos/u/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		repoCalls++
+		if repoCalls == 1 {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"fork":true}`)
+	})
+	mux.HandleFunc("/repos/u/r/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		commitCalls++
+		if commitCalls == 1 {
+			http.Error(w, `{"message":"Git Repository is empty."}`, http.StatusConflict)
+			return
+		}
+		fmt.Fprint(w, `[{"sha":"s"}]`)
+	})
+
+	ctx := context.Background()
+	repo, _, err := client.Repositories.WaitForFork(ctx, "u", "r")
+	if err != nil {
+		t.Fatalf("Repositories.WaitForFork returned error: %v", err)
+	}
+
+	want := &Repository{ID: Int64(1), Fork: Bool(true)}
+	if !cmp.Equal(repo, want) {
+		t.Errorf("Repositories.WaitForFork returned %+v, want %+v", repo, want)
+	}
+	if repoCalls != 3 || commitCalls != 2 {
+		t.Errorf("Repositories.WaitForFork made %v repository and %v commit requests, want 3 and 2", repoCalls, commitCalls)
+	}
+
+	const methodName = "WaitForFork"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.WaitForFork(ctx, "\n", "\n")
+		return err
+	})
+}
+
+func TestRepositoriesService_WaitForFork_contextDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(initial time.Duration) { forkPollInitialInterval = initial }(forkPollInitialInterval)
+	forkPollInitialInterval = time.Millisecond
+
+	mux.HandleFunc("/repos/u/r", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Repositories.WaitForFork(ctx, "u", "r")
+	if err != context.DeadlineExceeded {
+		t.Errorf("Repositories.WaitForFork returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRepositoriesService_WaitForFork_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/u/r", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Forbidden"}`, http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.WaitForFork(ctx, "u", "r")
+	if err == nil {
+		t.Fatal("Repositories.WaitForFork returned nil error, want error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Repositories.WaitForFork returned response %v, want status %v", resp, http.StatusForbidden)
+	}
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -125,12 +126,38 @@ func (s *RepositoriesService) RedeliverHookDelivery(ctx context.Context, owner,
 
 // ParseRequestPayload parses the request payload. For recognized event types,
 // a value of the corresponding struct type will be returned.
+//
+// The payload is only populated on deliveries returned by GetHookDelivery.
 func (d *HookDelivery) ParseRequestPayload() (interface{}, error) {
-	eType, ok := eventTypeMapping[*d.Event]
+	eType, ok := eventTypeMapping[d.GetEvent()]
 	if !ok {
-		return nil, fmt.Errorf("unsupported event type %q", *d.Event)
+		return nil, fmt.Errorf("unsupported event type %q", d.GetEvent())
+	}
+	if d.Request == nil || d.Request.RawPayload == nil {
+		return nil, errors.New("hook delivery has no request payload")
 	}
 
 	e := &Event{Type: &eType, RawPayload: d.Request.RawPayload}
 	return e.ParsePayload()
 }
+
+// ResponseBody returns the body that the webhook endpoint served in response
+// to the delivery, or an empty string if it returned none.
+//
+// The body is only populated on deliveries returned by GetHookDelivery.
+func (d *HookDelivery) ResponseBody() string {
+	if d.Response == nil || d.Response.RawPayload == nil {
+		return ""
+	}
+
+	// GitHub encodes the body as a JSON string, but may pass JSON bodies through as-is.
+	var body *string
+	if err := json.Unmarshal(*d.Response.RawPayload, &body); err != nil {
+		return string(*d.Response.RawPayload)
+	}
+	if body == nil {
+		return ""
+	}
+
+	return *body
+}
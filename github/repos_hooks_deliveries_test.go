@@ -261,6 +261,43 @@ func TestHookDelivery_ParsePayload_invalidPayload(t *testing.T) {
 	}
 }
 
+func TestHookDelivery_ParsePayload_missingRequest(t *testing.T) {
+	d := &HookDelivery{Event: String("push")}
+
+	_, err := d.ParseRequestPayload()
+	if err == nil || err.Error() != "hook delivery has no request payload" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestHookDelivery_ResponseBody(t *testing.T) {
+	tests := []struct {
+		payload *json.RawMessage
+		want    string
+	}{
+		{payload: nil, want: ""},
+		{payload: rawJSON(`null`), want: ""},
+		{payload: rawJSON(`"ok"`), want: "ok"},
+		{payload: rawJSON(`{"status":"ok"}`), want: `{"status":"ok"}`},
+	}
+
+	for _, tt := range tests {
+		d := &HookDelivery{Response: &HookResponse{RawPayload: tt.payload}}
+		if got := d.ResponseBody(); got != tt.want {
+			t.Errorf("ResponseBody = %q, want %q", got, tt.want)
+		}
+	}
+
+	if got := (&HookDelivery{}).ResponseBody(); got != "" {
+		t.Errorf("ResponseBody = %q, want empty string", got)
+	}
+}
+
+func rawJSON(s string) *json.RawMessage {
+	m := json.RawMessage(s)
+	return &m
+}
+
 func TestHookRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &HookRequest{}, "{}")
 
@@ -18,11 +18,17 @@ type RepositoryInvitation struct {
 	Inviter *User       `json:"inviter,omitempty"`
 
 	// Permissions represents the permissions that the associated user will have
-	// on the repository. Possible values are: "read", "write", "admin".
+	// on the repository. Possible values are: "read", "triage", "write",
+	// "maintain", "admin".
 	Permissions *string    `json:"permissions,omitempty"`
 	CreatedAt   *Timestamp `json:"created_at,omitempty"`
 	URL         *string    `json:"url,omitempty"`
 	HTMLURL     *string    `json:"html_url,omitempty"`
+
+	// Expired reports whether the invitation has expired. Repository
+	// invitations expire after 7 days; expired invitations can no longer be
+	// accepted and should be deleted and sent again.
+	Expired *bool `json:"expired,omitempty"`
 }
 
 // ListInvitations lists all currently-open repository invitations.
@@ -21,7 +21,7 @@ func TestRepositoriesService_ListInvitations(t *testing.T) {
 	mux.HandleFunc("/repos/o/r/invitations", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{"page": "2"})
-		fmt.Fprintf(w, `[{"id":1}, {"id":2}]`)
+		fmt.Fprintf(w, `[{"id":1}, {"id":2,"expired":true}]`)
 	})
 
 	opt := &ListOptions{Page: 2}
@@ -31,7 +31,7 @@ func TestRepositoriesService_ListInvitations(t *testing.T) {
 		t.Errorf("Repositories.ListInvitations returned error: %v", err)
 	}
 
-	want := []*RepositoryInvitation{{ID: Int64(1)}, {ID: Int64(2)}}
+	want := []*RepositoryInvitation{{ID: Int64(1)}, {ID: Int64(2), Expired: Bool(true)}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.ListInvitations = %+v, want %+v", got, want)
 	}
@@ -136,6 +136,7 @@ func TestRepositoryInvitation_Marshal(t *testing.T) {
 		CreatedAt:   &Timestamp{referenceTime},
 		URL:         String("u"),
 		HTMLURL:     String("h"),
+		Expired:     Bool(true),
 	}
 
 	want := `{
@@ -158,7 +159,8 @@ func TestRepositoryInvitation_Marshal(t *testing.T) {
 		"permissions":"p",
 		"created_at":` + referenceTimeStr + `,
 		"url":"u",
-		"html_url":"h"
+		"html_url":"h",
+		"expired":true
 	}`
 
 	testJSONMarshal(t, r, want)
@@ -58,6 +58,9 @@ func (s *RepositoriesService) GetKey(ctx context.Context, owner string, repo str
 
 // CreateKey adds a deploy key for a repository.
 //
+// Deploy keys cannot be modified once created. To change whether a key is
+// read-only, delete it and add it again with the new ReadOnly value.
+//
 // GitHub API docs: https://docs.github.com/en/rest/deploy-keys#create-a-deploy-key
 func (s *RepositoriesService) CreateKey(ctx context.Context, owner string, repo string, key *Key) (*Key, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/keys", owner, repo)
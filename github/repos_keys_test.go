@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -67,7 +68,7 @@ func TestRepositoriesService_GetKey(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/keys/1", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"id":1}`)
+		fmt.Fprint(w, `{"id":1,"read_only":true,"last_used":"2023-01-02T03:04:05Z","enabled":false}`)
 	})
 
 	ctx := context.Background()
@@ -76,7 +77,7 @@ func TestRepositoriesService_GetKey(t *testing.T) {
 		t.Errorf("Repositories.GetKey returned error: %v", err)
 	}
 
-	want := &Key{ID: Int64(1)}
+	want := &Key{ID: Int64(1), ReadOnly: Bool(true), LastUsed: &Timestamp{time.Date(2023, time.January, 2, 3, 4, 5, 0, time.UTC)}, Enabled: Bool(false)}
 	if !cmp.Equal(key, want) {
 		t.Errorf("Repositories.GetKey returned %+v, want %+v", key, want)
 	}
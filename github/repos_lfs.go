@@ -12,6 +12,10 @@ import (
 
 // EnableLFS turns the LFS (Large File Storage) feature ON for the selected repo.
 //
+// GitHub enables LFS asynchronously and usually responds with a status code
+// of 202, in which case EnableLFS returns an *AcceptedError; callers should
+// treat it as success.
+//
 // GitHub API docs: https://docs.github.com/en/rest/repos/lfs#enable-git-lfs-for-a-repository
 func (s *RepositoriesService) EnableLFS(ctx context.Context, owner, repo string) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/lfs", owner, repo)
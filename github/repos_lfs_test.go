@@ -37,6 +37,26 @@ func TestRepositoriesService_EnableLFS(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_EnableLFS_accepted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/lfs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Repositories.EnableLFS(ctx, "o", "r")
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Repositories.EnableLFS returned error: %v (want AcceptedError)", err)
+	}
+	if got, want := resp.StatusCode, http.StatusAccepted; got != want {
+		t.Errorf("Repositories.EnableLFS returned status %v, want %v", got, want)
+	}
+}
+
 func TestRepositoriesService_DisableLFS(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -27,7 +27,9 @@ type RepoMergeUpstreamRequest struct {
 // RepoMergeUpstreamResult represents the result of syncing a branch of
 // a forked repository with the upstream repository.
 type RepoMergeUpstreamResult struct {
-	Message    *string `json:"message,omitempty"`
+	Message *string `json:"message,omitempty"`
+	// MergeType reports how the branch was updated. Possible values are:
+	// "fast-forward", "merge", "none".
 	MergeType  *string `json:"merge_type,omitempty"`
 	BaseBranch *string `json:"base_branch,omitempty"`
 }
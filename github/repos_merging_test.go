@@ -127,6 +127,20 @@ func TestRepositoriesService_MergeUpstream(t *testing.T) {
 	})
 }
 
+func TestRepoMergeUpstreamRequest_Marshal(t *testing.T) {
+	testJSONMarshal(t, &RepoMergeUpstreamRequest{}, "{}")
+
+	u := &RepoMergeUpstreamRequest{
+		Branch: String("main"),
+	}
+
+	want := `{
+		"branch": "main"
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
 func TestRepoMergeUpstreamResult_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepoMergeUpstreamResult{}, "{}")
 
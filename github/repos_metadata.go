@@ -0,0 +1,219 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// defaultMetadataPolicyConcurrency is the number of repositories updated in
+// parallel when RepositoryMetadataPolicy.Concurrency is unset.
+const defaultMetadataPolicyConcurrency = 4
+
+// RepositoryMetadataPolicy describes the topics and description that the
+// repositories of an organization should have. It is used by the
+// RepositoriesService.ApplyMetadataPolicy method.
+type RepositoryMetadataPolicy struct {
+	// Filter selects the repositories the policy applies to. If nil, the
+	// policy applies to all non-archived repositories.
+	Filter func(repo *Repository) bool
+
+	// Topics returns the topics repo should have, given its current
+	// topics in repo.Topics. If nil, topics are not changed.
+	Topics func(repo *Repository) []string
+
+	// Description returns the description repo should have, given its
+	// current description. If nil, descriptions are not changed. An empty
+	// description leaves the current one unchanged.
+	Description func(repo *Repository) string
+
+	// Concurrency is the maximum number of repositories updated in
+	// parallel. Default is 4.
+	Concurrency int
+
+	// MinInterval is the minimum delay between two update requests, across
+	// all the repositories updated in parallel. Default is one second.
+	MinInterval time.Duration
+
+	// MinRateRemaining pauses the updates until the rate limit resets
+	// whenever fewer than this many requests remain. Default is 100.
+	MinRateRemaining int
+
+	// DryRun reports the changes that would be made without making them.
+	DryRun bool
+}
+
+// RepositoryMetadataChange describes the change made, or that would be made
+// in a dry run, to a single repository by RepositoriesService.ApplyMetadataPolicy.
+type RepositoryMetadataChange struct {
+	Repository *Repository
+
+	// OldTopics and NewTopics are set if the topics changed.
+	OldTopics []string
+	NewTopics []string
+
+	// OldDescription and NewDescription are set if the description changed.
+	OldDescription *string
+	NewDescription *string
+
+	// Err is the error returned when applying the change, if any.
+	Err error
+}
+
+// ApplyMetadataPolicy applies policy to the repositories of org. Archived
+// repositories are read-only and are always skipped.
+//
+// Only repositories whose topics or description differ from the policy are
+// updated, and a RepositoryMetadataChange is returned for each of them, in
+// the order the repositories were listed. Errors updating a single
+// repository are reported in its RepositoryMetadataChange.Err and do not stop
+// the other updates; the returned error is only set if listing the
+// repositories failed. Update requests are spaced by policy.MinInterval, and
+// retried when rejected by the secondary rate limit.
+func (s *RepositoriesService) ApplyMetadataPolicy(ctx context.Context, org string, policy *RepositoryMetadataPolicy) ([]*RepositoryMetadataChange, *Response, error) {
+	if policy == nil {
+		policy = &RepositoryMetadataPolicy{}
+	}
+	concurrency := policy.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultMetadataPolicyConcurrency
+	}
+	interval := policy.MinInterval
+	if interval <= 0 {
+		interval = defaultBatchInterval
+	}
+	minRemaining := policy.MinRateRemaining
+	if minRemaining <= 0 {
+		minRemaining = defaultMinRateRemaining
+	}
+
+	var repos []*Repository
+	var resp *Response
+	opts := &RepositoryListByOrgOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		page, r, err := s.ListByOrg(ctx, org, opts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		repos = append(repos, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	var changes []*RepositoryMetadataChange
+	for _, repo := range repos {
+		if repo.GetArchived() || (policy.Filter != nil && !policy.Filter(repo)) {
+			continue
+		}
+		if change := policy.change(repo); change != nil {
+			changes = append(changes, change)
+		}
+	}
+	if policy.DryRun {
+		return changes, resp, nil
+	}
+
+	pacer := &requestPacer{interval: interval}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, change := range changes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(change *RepositoryMetadataChange) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			change.Err = s.applyMetadataChange(ctx, pacer, minRemaining, change)
+		}(change)
+	}
+	wg.Wait()
+
+	return changes, resp, nil
+}
+
+// change returns the change needed for repo to comply with the policy, or
+// nil if it already does.
+func (p *RepositoryMetadataPolicy) change(repo *Repository) *RepositoryMetadataChange {
+	change := &RepositoryMetadataChange{Repository: repo}
+	changed := false
+
+	if p.Topics != nil {
+		topics := p.Topics(repo)
+		if topics == nil {
+			topics = []string{}
+		}
+		if !sameTopics(repo.Topics, topics) {
+			change.OldTopics = repo.Topics
+			change.NewTopics = topics
+			changed = true
+		}
+	}
+
+	if p.Description != nil {
+		if description := p.Description(repo); description != "" && description != repo.GetDescription() {
+			change.OldDescription = repo.Description
+			change.NewDescription = String(description)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	return change
+}
+
+// applyMetadataChange updates the topics and description of a repository,
+// pacing the requests with pacer.
+func (s *RepositoriesService) applyMetadataChange(ctx context.Context, pacer *requestPacer, minRemaining int, change *RepositoryMetadataChange) error {
+	owner, name := change.Repository.GetOwner().GetLogin(), change.Repository.GetName()
+
+	if change.NewTopics != nil {
+		if err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+			_, resp, err := s.ReplaceAllTopics(ctx, owner, name, change.NewTopics)
+			return resp, err
+		}); err != nil {
+			return err
+		}
+	}
+
+	if change.NewDescription != nil {
+		if err := pacedDo(ctx, pacer, minRemaining, func() (*Response, error) {
+			_, resp, err := s.Edit(ctx, owner, name, &Repository{Description: change.NewDescription})
+			return resp, err
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sameTopics reports whether a and b contain the same topics, in any order.
+func sameTopics(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_ApplyMetadataPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `[
+			{"name":"r1","owner":{"login":"o"},"description":"d","topics":["go"]},
+			{"name":"r2","owner":{"login":"o"},"description":"old","topics":["team-a","go"]},
+			{"name":"r3","owner":{"login":"o"},"archived":true},
+			{"name":"skip","owner":{"login":"o"}}
+		]`)
+	})
+
+	var mu sync.Mutex
+	var calls []string
+	record := func(r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, r.Method+" "+r.URL.Path)
+	}
+	mux.HandleFunc("/repos/o/r1/topics", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"names":["go","team-a"]}`+"\n")
+		fmt.Fprint(w, `{"names":["go","team-a"]}`)
+	})
+	mux.HandleFunc("/repos/o/r2", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"description":"d"}`+"\n")
+		fmt.Fprint(w, `{"name":"r2","description":"d"}`)
+	})
+
+	policy := &RepositoryMetadataPolicy{
+		Filter: func(repo *Repository) bool { return !strings.HasPrefix(repo.GetName(), "skip") },
+		Topics: func(repo *Repository) []string {
+			for _, topic := range repo.Topics {
+				if topic == "team-a" {
+					return repo.Topics
+				}
+			}
+			return append(repo.Topics, "team-a")
+		},
+		Description: func(repo *Repository) string { return "d" },
+		MinInterval: time.Millisecond,
+	}
+
+	ctx := context.Background()
+	policy.DryRun = true
+	changes, _, err := client.Repositories.ApplyMetadataPolicy(ctx, "o", policy)
+	if err != nil {
+		t.Fatalf("Repositories.ApplyMetadataPolicy returned error: %v", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("Repositories.ApplyMetadataPolicy made requests %v in a dry run", calls)
+	}
+
+	want := []*RepositoryMetadataChange{
+		{
+			Repository: &Repository{Name: String("r1"), Owner: &User{Login: String("o")}, Description: String("d"), Topics: []string{"go"}},
+			OldTopics:  []string{"go"},
+			NewTopics:  []string{"go", "team-a"},
+		},
+		{
+			Repository:     &Repository{Name: String("r2"), Owner: &User{Login: String("o")}, Description: String("old"), Topics: []string{"team-a", "go"}},
+			OldDescription: String("old"),
+			NewDescription: String("d"),
+		},
+	}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("Repositories.ApplyMetadataPolicy returned %+v, want %+v", changes, want)
+	}
+
+	policy.DryRun = false
+	changes, _, err = client.Repositories.ApplyMetadataPolicy(ctx, "o", policy)
+	if err != nil {
+		t.Fatalf("Repositories.ApplyMetadataPolicy returned error: %v", err)
+	}
+	for _, change := range changes {
+		if change.Err != nil {
+			t.Errorf("Repositories.ApplyMetadataPolicy returned change error for %v: %v", change.Repository.GetName(), change.Err)
+		}
+	}
+	if len(calls) != 2 {
+		t.Errorf("Repositories.ApplyMetadataPolicy made requests %v, want 2", calls)
+	}
+
+	const methodName = "ApplyMetadataPolicy"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ApplyMetadataPolicy(ctx, "\n", policy)
+		return err
+	})
+}
+
+func TestRepositoriesService_ApplyMetadataPolicy_invalidTopics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"r","owner":{"login":"o"}}]`)
+	})
+
+	policy := &RepositoryMetadataPolicy{
+		Topics: func(repo *Repository) []string { return []string{"Invalid Topic"} },
+	}
+
+	ctx := context.Background()
+	changes, _, err := client.Repositories.ApplyMetadataPolicy(ctx, "o", policy)
+	if err != nil {
+		t.Fatalf("Repositories.ApplyMetadataPolicy returned error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Err == nil {
+		t.Errorf("Repositories.ApplyMetadataPolicy returned %+v, want one change with an error", changes)
+	}
+}
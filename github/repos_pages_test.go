@@ -449,6 +449,54 @@ func TestRepositoriesService_RequestPageBuild(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetPagesHealthCheck(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pages/health", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"domain":{"host":"example.com","uri":"http://example.com/","nameservers":"default","dns_resolves":true},"alt_domain":{"host":"www.example.com","uri":"http://www.example.com/","nameservers":"default","dns_resolves":true}}`)
+	})
+
+	ctx := context.Background()
+	healthCheckResponse, _, err := client.Repositories.GetPagesHealthCheck(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetPagesHealthCheck returned error: %v", err)
+	}
+
+	want := &PagesHealthCheckResponse{
+		Domain: &PagesDomain{
+			Host:        String("example.com"),
+			URI:         String("http://example.com/"),
+			Nameservers: String("default"),
+			DNSResolves: Bool(true),
+		},
+		AltDomain: &PagesDomain{
+			Host:        String("www.example.com"),
+			URI:         String("http://www.example.com/"),
+			Nameservers: String("default"),
+			DNSResolves: Bool(true),
+		},
+	}
+	if !cmp.Equal(healthCheckResponse, want) {
+		t.Errorf("Repositories.GetPagesHealthCheck returned %+v, want %+v", healthCheckResponse, want)
+	}
+
+	const methodName = "GetPagesHealthCheck"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetPagesHealthCheck(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetPagesHealthCheck(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestPagesSource_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PagesSource{}, "{}")
 
@@ -578,3 +626,43 @@ func TestCreatePagesRequest_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestPagesHealthCheckResponse_Marshal(t *testing.T) {
+	testJSONMarshal(t, &PagesHealthCheckResponse{}, "{}")
+
+	u := &PagesHealthCheckResponse{
+		Domain: &PagesDomain{
+			Host:            String("example.com"),
+			DNSResolves:     Bool(true),
+			IsApexDomain:    Bool(true),
+			IsValid:         Bool(true),
+			RespondsToHTTPS: Bool(true),
+			EnforcesHTTPS:   Bool(false),
+		},
+		AltDomain: &PagesDomain{
+			Host:       String("www.example.com"),
+			IsValid:    Bool(false),
+			Reason:     String("reason"),
+			HTTPSError: String("error"),
+		},
+	}
+
+	want := `{
+		"domain": {
+			"host": "example.com",
+			"dns_resolves": true,
+			"is_apex_domain": true,
+			"is_valid": true,
+			"responds_to_https": true,
+			"enforces_https": false
+		},
+		"alt_domain": {
+			"host": "www.example.com",
+			"is_valid": false,
+			"reason": "reason",
+			"https_error": "error"
+		}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
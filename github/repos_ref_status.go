@@ -0,0 +1,223 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"sort"
+)
+
+// Normalized states reported by RepositoriesService.GetRefStatus.
+const (
+	RefStatusSuccess = "success"
+	RefStatusPending = "pending"
+	RefStatusFailure = "failure"
+)
+
+// RefStatusOptions specifies the optional parameters to the
+// RepositoriesService.GetRefStatus method.
+type RefStatusOptions struct {
+	// RequiredContexts lists the contexts (commit status contexts or check
+	// run names) that must succeed.
+	RequiredContexts []string
+
+	// ProtectedBranch, if set, adds the required status checks of that
+	// branch's protection to RequiredContexts. A branch whose required
+	// status checks cannot be found, because it is not protected or its
+	// protection requires none, adds nothing.
+	ProtectedBranch string
+}
+
+// RefStatusContext is a single commit status or check run, normalized.
+type RefStatusContext struct {
+	// Name is the commit status context or the check run name.
+	Name string
+	// State is one of RefStatusSuccess, RefStatusPending, or RefStatusFailure.
+	State       string
+	Required    bool
+	TargetURL   string
+	Description string
+
+	// Exactly one of Status and CheckRun is set.
+	Status   *RepoStatus
+	CheckRun *CheckRun
+}
+
+// RefStatus is the merged view of the commit statuses and checks of a ref.
+type RefStatus struct {
+	SHA string
+	// State is the rollup of all contexts: RefStatusFailure if any failed,
+	// otherwise RefStatusPending if any is pending, otherwise RefStatusSuccess.
+	State string
+	// RequiredState is the rollup of the required contexts only. Required
+	// contexts that have not been reported yet count as pending.
+	RequiredState string
+	// MissingRequired lists the required contexts that have not been reported.
+	MissingRequired []string
+	Contexts        []*RefStatusContext
+	// CheckSuites is informational: suites without check runs (for example
+	// from apps that never report) are not part of the rollups.
+	CheckSuites []*CheckSuite
+}
+
+// GetRefStatus fetches the combined commit status, the check runs, and the
+// check suites of ref, and normalizes them into a single RefStatus with
+// overall and required-only rollups.
+func (s *RepositoriesService) GetRefStatus(ctx context.Context, owner, repo, ref string, opts *RefStatusOptions) (*RefStatus, *Response, error) {
+	if opts == nil {
+		opts = &RefStatusOptions{}
+	}
+
+	required := make(map[string]bool)
+	for _, c := range opts.RequiredContexts {
+		required[c] = true
+	}
+
+	var resp *Response
+	if opts.ProtectedBranch != "" {
+		checks, r, err := s.GetRequiredStatusChecks(ctx, owner, repo, opts.ProtectedBranch)
+		resp = r
+		if err != nil && (r == nil || r.StatusCode != http.StatusNotFound) {
+			return nil, resp, err
+		}
+		if checks != nil {
+			for _, c := range checks.Contexts {
+				required[c] = true
+			}
+			for _, c := range checks.Checks {
+				required[c.Context] = true
+			}
+		}
+	}
+
+	result := &RefStatus{}
+	statusOpts := &ListOptions{PerPage: 100}
+	for {
+		combined, r, err := s.GetCombinedStatus(ctx, owner, repo, ref, statusOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		result.SHA = combined.GetSHA()
+		for _, st := range combined.Statuses {
+			result.Contexts = append(result.Contexts, &RefStatusContext{
+				Name:        st.GetContext(),
+				State:       normalizeStatusState(st.GetState()),
+				Required:    required[st.GetContext()],
+				TargetURL:   st.GetTargetURL(),
+				Description: st.GetDescription(),
+				Status:      st,
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		statusOpts.Page = resp.NextPage
+	}
+
+	runOpts := &ListCheckRunsOptions{Filter: String("latest"), ListOptions: ListOptions{PerPage: 100}}
+	for {
+		runs, r, err := s.client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, runOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, run := range runs.CheckRuns {
+			result.Contexts = append(result.Contexts, &RefStatusContext{
+				Name:        run.GetName(),
+				State:       normalizeCheckRunState(run),
+				Required:    required[run.GetName()],
+				TargetURL:   run.GetDetailsURL(),
+				Description: run.GetOutput().GetTitle(),
+				CheckRun:    run,
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		runOpts.Page = resp.NextPage
+	}
+
+	suiteOpts := &ListCheckSuiteOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		suites, r, err := s.client.Checks.ListCheckSuitesForRef(ctx, owner, repo, ref, suiteOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		result.CheckSuites = append(result.CheckSuites, suites.CheckSuites...)
+		if resp.NextPage == 0 {
+			break
+		}
+		suiteOpts.Page = resp.NextPage
+	}
+
+	var states, requiredStates []string
+	reported := make(map[string]bool)
+	for _, c := range result.Contexts {
+		states = append(states, c.State)
+		if c.Required {
+			requiredStates = append(requiredStates, c.State)
+			reported[c.Name] = true
+		}
+	}
+	for c := range required {
+		if !reported[c] {
+			result.MissingRequired = append(result.MissingRequired, c)
+			requiredStates = append(requiredStates, RefStatusPending)
+		}
+	}
+	sort.Strings(result.MissingRequired)
+
+	result.State = rollupRefStatus(states)
+	result.RequiredState = rollupRefStatus(requiredStates)
+
+	return result, resp, nil
+}
+
+// normalizeStatusState maps a commit status state to a RefStatus state.
+func normalizeStatusState(state string) string {
+	switch state {
+	case "success":
+		return RefStatusSuccess
+	case "error", "failure":
+		return RefStatusFailure
+	default:
+		return RefStatusPending
+	}
+}
+
+// normalizeCheckRunState maps the status and conclusion of a check run to a
+// RefStatus state. Neutral and skipped runs count as successful, as they do
+// for branch protection.
+func normalizeCheckRunState(run *CheckRun) string {
+	if run.GetStatus() != "completed" {
+		return RefStatusPending
+	}
+
+	switch run.GetConclusion() {
+	case "success", "neutral", "skipped":
+		return RefStatusSuccess
+	default:
+		return RefStatusFailure
+	}
+}
+
+// rollupRefStatus combines states into a single state.
+func rollupRefStatus(states []string) string {
+	rollup := RefStatusSuccess
+	for _, state := range states {
+		switch state {
+		case RefStatusFailure:
+			return RefStatusFailure
+		case RefStatusPending:
+			rollup = RefStatusPending
+		}
+	}
+
+	return rollup
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_GetRefStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/main/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"strict":true,"contexts":["ci/build"],"checks":[{"context":"lint"},{"context":"deploy"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/ref/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"s","statuses":[
+			{"context":"ci/build","state":"success","target_url":"u1"},
+			{"context":"coverage","state":"pending"}
+		]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/ref/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filter": "latest", "per_page": "100"})
+		fmt.Fprint(w, `{"total_count":2,"check_runs":[
+			{"id":1,"name":"lint","status":"completed","conclusion":"neutral","details_url":"u2","output":{"title":"t"}},
+			{"id":2,"name":"fuzz","status":"completed","conclusion":"timed_out"}
+		]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/ref/check-suites", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"check_suites":[{"id":3,"status":"queued"}]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetRefStatus(ctx, "o", "r", "ref", &RefStatusOptions{ProtectedBranch: "main"})
+	if err != nil {
+		t.Fatalf("Repositories.GetRefStatus returned error: %v", err)
+	}
+
+	want := &RefStatus{
+		SHA:             "s",
+		State:           RefStatusFailure,
+		RequiredState:   RefStatusPending,
+		MissingRequired: []string{"deploy"},
+		Contexts: []*RefStatusContext{
+			{Name: "ci/build", State: RefStatusSuccess, Required: true, TargetURL: "u1", Status: &RepoStatus{Context: String("ci/build"), State: String("success"), TargetURL: String("u1")}},
+			{Name: "coverage", State: RefStatusPending, Status: &RepoStatus{Context: String("coverage"), State: String("pending")}},
+			{Name: "lint", State: RefStatusSuccess, Required: true, TargetURL: "u2", Description: "t", CheckRun: &CheckRun{ID: Int64(1), Name: String("lint"), Status: String("completed"), Conclusion: String("neutral"), DetailsURL: String("u2"), Output: &CheckRunOutput{Title: String("t")}}},
+			{Name: "fuzz", State: RefStatusFailure, CheckRun: &CheckRun{ID: Int64(2), Name: String("fuzz"), Status: String("completed"), Conclusion: String("timed_out")}},
+		},
+		CheckSuites: []*CheckSuite{{ID: Int64(3), Status: String("queued")}},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetRefStatus returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetRefStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetRefStatus(ctx, "\n", "\n", "\n", nil)
+		return err
+	})
+}
+
+func TestRepositoriesService_GetRefStatus_unprotectedBranch(t *testing.T) {
+	for _, message := range []string{githubBranchNotProtected, "Required status checks not enabled"} {
+		t.Run(message, func(t *testing.T) {
+			testGetRefStatusUnprotectedBranch(t, message)
+		})
+	}
+}
+
+func testGetRefStatusUnprotectedBranch(t *testing.T, message string) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/main/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"message": %q}`, message)
+	})
+	mux.HandleFunc("/repos/o/r/commits/ref/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"s","statuses":[{"context":"ci","state":"success"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/ref/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"check_runs":[{"name":"build","status":"in_progress"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/ref/check-suites", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetRefStatus(ctx, "o", "r", "ref", &RefStatusOptions{ProtectedBranch: "main", RequiredContexts: []string{"ci"}})
+	if err != nil {
+		t.Fatalf("Repositories.GetRefStatus returned error: %v", err)
+	}
+
+	if got.State != RefStatusPending {
+		t.Errorf("Repositories.GetRefStatus returned State %v, want %v", got.State, RefStatusPending)
+	}
+	if got.RequiredState != RefStatusSuccess {
+		t.Errorf("Repositories.GetRefStatus returned RequiredState %v, want %v", got.RequiredState, RefStatusSuccess)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+)
+
+// RepositoryRuleset represents a ruleset of a repository or an organization.
+type RepositoryRuleset struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	// Target is one of "branch", "tag" or "push".
+	Target *string `json:"target,omitempty"`
+	// SourceType is one of "Repository" or "Organization".
+	SourceType *string `json:"source_type,omitempty"`
+	// Source is the name of the repository or organization owning the ruleset.
+	Source *string `json:"source,omitempty"`
+	// Enforcement is one of "disabled", "active" or "evaluate".
+	Enforcement  *string               `json:"enforcement,omitempty"`
+	BypassActors []*RulesetBypassActor `json:"bypass_actors,omitempty"`
+	// CurrentUserCanBypass is one of "always", "pull_requests_only" or "never".
+	CurrentUserCanBypass *string            `json:"current_user_can_bypass,omitempty"`
+	Conditions           *RulesetConditions `json:"conditions,omitempty"`
+	Rules                []*RepositoryRule  `json:"rules,omitempty"`
+	CreatedAt            *Timestamp         `json:"created_at,omitempty"`
+	UpdatedAt            *Timestamp         `json:"updated_at,omitempty"`
+}
+
+// RulesetBypassActor represents an actor that can bypass a ruleset.
+type RulesetBypassActor struct {
+	ActorID *int64 `json:"actor_id,omitempty"`
+	// ActorType is one of "RepositoryRole", "Team", "Integration",
+	// "OrganizationAdmin" or "DeployKey".
+	ActorType *string `json:"actor_type,omitempty"`
+	// BypassMode is one of "always" or "pull_request".
+	BypassMode *string `json:"bypass_mode,omitempty"`
+}
+
+// RulesetConditions represents the conditions under which a ruleset applies.
+type RulesetConditions struct {
+	RefName        *RulesetRefConditionParameters             `json:"ref_name,omitempty"`
+	RepositoryName *RulesetRepositoryNamesConditionParameters `json:"repository_name,omitempty"`
+}
+
+// RulesetRefConditionParameters represents the branches or tags a ruleset
+// applies to, as fnmatch patterns or "~DEFAULT_BRANCH" and "~ALL".
+type RulesetRefConditionParameters struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// RulesetRepositoryNamesConditionParameters represents the repositories an
+// organization ruleset applies to, as fnmatch patterns or "~ALL".
+type RulesetRepositoryNamesConditionParameters struct {
+	Include   []string `json:"include"`
+	Exclude   []string `json:"exclude"`
+	Protected *bool    `json:"protected,omitempty"`
+}
+
+// RepositoryRule represents a rule of a ruleset. The shape of Parameters
+// depends on Type, such as "pull_request" or "required_status_checks".
+type RepositoryRule struct {
+	Type       string           `json:"type"`
+	Parameters *json.RawMessage `json:"parameters,omitempty"`
+}
@@ -360,7 +360,7 @@ func TestRepositoriesService_Get(t *testing.T) {
 	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testHeader(t, r, "Accept", strings.Join(wantAcceptHeaders, ", "))
-		fmt.Fprint(w, `{"id":1,"name":"n","description":"d","owner":{"login":"l"},"license":{"key":"mit"},"security_and_analysis":{"advanced_security":{"status":"enabled"},"secret_scanning":{"status":"enabled"},"secret_scanning_push_protection":{"status":"enabled"}}}`)
+		fmt.Fprint(w, `{"id":1,"name":"n","description":"d","owner":{"login":"l"},"license":{"key":"mit"},"security_and_analysis":{"advanced_security":{"status":"enabled"},"secret_scanning":{"status":"enabled"},"secret_scanning_push_protection":{"status":"enabled"},"dependabot_security_updates":{"status":"enabled"},"secret_scanning_non_provider_patterns":{"status":"disabled"}}}`)
 	})
 
 	ctx := context.Background()
@@ -369,7 +369,7 @@ func TestRepositoriesService_Get(t *testing.T) {
 		t.Errorf("Repositories.Get returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1), Name: String("n"), Description: String("d"), Owner: &User{Login: String("l")}, License: &License{Key: String("mit")}, SecurityAndAnalysis: &SecurityAndAnalysis{AdvancedSecurity: &AdvancedSecurity{Status: String("enabled")}, SecretScanning: &SecretScanning{String("enabled")}, SecretScanningPushProtection: &SecretScanningPushProtection{String("enabled")}}}
+	want := &Repository{ID: Int64(1), Name: String("n"), Description: String("d"), Owner: &User{Login: String("l")}, License: &License{Key: String("mit")}, SecurityAndAnalysis: &SecurityAndAnalysis{AdvancedSecurity: &AdvancedSecurity{Status: String("enabled")}, SecretScanning: &SecretScanning{String("enabled")}, SecretScanningPushProtection: &SecretScanningPushProtection{String("enabled")}, DependabotSecurityUpdates: &DependabotSecurityUpdates{String("enabled")}, SecretScanningNonProviderPatterns: &SecretScanningNonProviderPatterns{String("disabled")}}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.Get returned %+v, want %+v", got, want)
 	}
@@ -468,6 +468,39 @@ func TestRepositoriesService_GetByID(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateFromTemplate_includeAllBranches(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	templateRepoReq := &TemplateRepoRequest{
+		Name:               String("n"),
+		Owner:              String("o"),
+		IncludeAllBranches: Bool(true),
+	}
+
+	mux.HandleFunc("/repos/to/tr/generate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"n","owner":"o","include_all_branches":true}`+"\n")
+		fmt.Fprint(w, `{"id":1,"name":"n","owner":{"login":"o"},"template_repository":{"id":2,"name":"tr","is_template":true}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.CreateFromTemplate(ctx, "to", "tr", templateRepoReq)
+	if err != nil {
+		t.Errorf("Repositories.CreateFromTemplate returned error: %v", err)
+	}
+
+	want := &Repository{
+		ID:                 Int64(1),
+		Name:               String("n"),
+		Owner:              &User{Login: String("o")},
+		TemplateRepository: &Repository{ID: Int64(2), Name: String("tr"), IsTemplate: Bool(true)},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.CreateFromTemplate returned %+v, want %+v", got, want)
+	}
+}
+
 func TestRepositoriesService_Edit(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -514,6 +547,66 @@ func TestRepositoriesService_Edit(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_Edit_securityAndAnalysis(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Repository{
+		SecurityAndAnalysis: &SecurityAndAnalysis{
+			AdvancedSecurity:                  &AdvancedSecurity{Status: String("enabled")},
+			SecretScanning:                    &SecretScanning{Status: String("enabled")},
+			SecretScanningPushProtection:      &SecretScanningPushProtection{Status: String("enabled")},
+			DependabotSecurityUpdates:         &DependabotSecurityUpdates{Status: String("disabled")},
+			SecretScanningNonProviderPatterns: &SecretScanningNonProviderPatterns{Status: String("enabled")},
+		},
+	}
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"security_and_analysis":{"advanced_security":{"status":"enabled"},"secret_scanning":{"status":"enabled"},"secret_scanning_push_protection":{"status":"enabled"},"dependabot_security_updates":{"status":"disabled"},"secret_scanning_non_provider_patterns":{"status":"enabled"}}}`+"\n")
+		fmt.Fprint(w, `{"id":1,"security_and_analysis":{"advanced_security":{"status":"enabled"},"dependabot_security_updates":{"status":"disabled"}}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.Edit(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.Edit returned error: %v", err)
+	}
+
+	want := &Repository{
+		ID: Int64(1),
+		SecurityAndAnalysis: &SecurityAndAnalysis{
+			AdvancedSecurity:          &AdvancedSecurity{Status: String("enabled")},
+			DependabotSecurityUpdates: &DependabotSecurityUpdates{Status: String("disabled")},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.Edit returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_Edit_isTemplate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"is_template":true}`+"\n")
+		fmt.Fprint(w, `{"id":1,"is_template":true}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.Edit(ctx, "o", "r", &Repository{IsTemplate: Bool(true)})
+	if err != nil {
+		t.Errorf("Repositories.Edit returned error: %v", err)
+	}
+
+	want := &Repository{ID: Int64(1), IsTemplate: Bool(true)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.Edit returned %+v, want %+v", got, want)
+	}
+}
+
 func TestRepositoriesService_Delete(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -681,6 +774,89 @@ func TestRepositoriesService_DisableAutomatedSecurityFixes(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_IsPrivateReportingEnabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled": true}`)
+	})
+
+	ctx := context.Background()
+	enabled, _, err := client.Repositories.IsPrivateReportingEnabled(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.IsPrivateReportingEnabled returned error: %v", err)
+	}
+	if want := true; enabled != want {
+		t.Errorf("Repositories.IsPrivateReportingEnabled returned %+v, want %+v", enabled, want)
+	}
+
+	const methodName = "IsPrivateReportingEnabled"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.IsPrivateReportingEnabled(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.IsPrivateReportingEnabled(ctx, "o", "r")
+		if got {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want false", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_EnablePrivateReporting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.EnablePrivateReporting(ctx, "o", "r"); err != nil {
+		t.Errorf("Repositories.EnablePrivateReporting returned error: %v", err)
+	}
+
+	const methodName = "EnablePrivateReporting"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.EnablePrivateReporting(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.EnablePrivateReporting(ctx, "o", "r")
+	})
+}
+
+func TestRepositoriesService_DisablePrivateReporting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.DisablePrivateReporting(ctx, "o", "r"); err != nil {
+		t.Errorf("Repositories.DisablePrivateReporting returned error: %v", err)
+	}
+
+	const methodName = "DisablePrivateReporting"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DisablePrivateReporting(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.DisablePrivateReporting(ctx, "o", "r")
+	})
+}
+
 func TestRepositoriesService_ListContributors(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1043,6 +1219,30 @@ func TestRepositoriesService_RenameBranch(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_RenameBranch_slashInName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/feat/b/rename", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got, want := r.URL.EscapedPath(), "/repos/o/r/branches/feat%2Fb/rename"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"name":"feat/nn"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.RenameBranch(ctx, "o", "r", "feat/b", "feat/nn")
+	if err != nil {
+		t.Errorf("Repositories.RenameBranch returned error: %v", err)
+	}
+
+	want := &Branch{Name: String("feat/nn")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.RenameBranch returned %+v, want %+v", got, want)
+	}
+}
+
 func TestRepositoriesService_GetBranchProtection(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -2026,6 +2226,40 @@ func TestRepositoriesService_UpdateRequiredStatusChecks_Contexts(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_UpdateRequiredStatusChecks_strictOnly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"strict":false}`+"\n")
+		fmt.Fprint(w, `{
+			"url": "https://api.github.com/repos/o/r/branches/b/protection/required_status_checks",
+			"strict": false,
+			"contexts": ["ci"],
+			"contexts_url": "https://api.github.com/repos/o/r/branches/b/protection/required_status_checks/contexts",
+			"checks": [{"context": "ci", "app_id": 123}]
+		}`)
+	})
+
+	ctx := context.Background()
+	statusChecks, _, err := client.Repositories.UpdateRequiredStatusChecks(ctx, "o", "r", "b", &RequiredStatusChecksRequest{Strict: Bool(false)})
+	if err != nil {
+		t.Errorf("Repositories.UpdateRequiredStatusChecks returned error: %v", err)
+	}
+
+	want := &RequiredStatusChecks{
+		Strict:      false,
+		Contexts:    []string{"ci"},
+		Checks:      []*RequiredStatusCheck{{Context: "ci", AppID: Int64(123)}},
+		ContextsURL: String("https://api.github.com/repos/o/r/branches/b/protection/required_status_checks/contexts"),
+		URL:         String("https://api.github.com/repos/o/r/branches/b/protection/required_status_checks"),
+	}
+	if !cmp.Equal(statusChecks, want) {
+		t.Errorf("Repositories.UpdateRequiredStatusChecks returned %+v, want %+v", statusChecks, want)
+	}
+}
+
 func TestRepositoriesService_UpdateRequiredStatusChecks_Checks(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -2717,6 +2951,30 @@ func TestRepositoriesService_ListAllTopics_emptyTopics(t *testing.T) {
 	}
 }
 
+func TestValidateTopics(t *testing.T) {
+	tests := []struct {
+		topics  []string
+		wantErr bool
+	}{
+		{topics: nil},
+		{topics: []string{"go", "go-github", "v2"}},
+		{topics: []string{strings.Repeat("a", 50)}},
+		{topics: []string{strings.Repeat("a", 51)}, wantErr: true},
+		{topics: []string{""}, wantErr: true},
+		{topics: []string{"Go"}, wantErr: true},
+		{topics: []string{"-go"}, wantErr: true},
+		{topics: []string{"go github"}, wantErr: true},
+		{topics: strings.Split(strings.Repeat("t,", 21)[:41], ","), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateTopics(tt.topics)
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("ValidateTopics(%q) returned error %v, want error: %v", tt.topics, err, tt.wantErr)
+		}
+	}
+}
+
 func TestRepositoriesService_ReplaceAllTopics(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -2740,7 +2998,7 @@ func TestRepositoriesService_ReplaceAllTopics(t *testing.T) {
 
 	const methodName = "ReplaceAllTopics"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Repositories.ReplaceAllTopics(ctx, "\n", "\n", []string{"\n", "\n", "\n"})
+		_, _, err = client.Repositories.ReplaceAllTopics(ctx, "\n", "\n", []string{"go"})
 		return err
 	})
 
@@ -3220,7 +3478,7 @@ func TestRepositoriesService_Transfer(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := TransferRequest{NewOwner: "a", TeamID: []int64{123}}
+	input := TransferRequest{NewOwner: "a", NewName: String("b"), TeamID: []int64{123}}
 
 	mux.HandleFunc("/repos/o/r/transfer", func(w http.ResponseWriter, r *http.Request) {
 		var v TransferRequest
@@ -3260,6 +3518,45 @@ func TestRepositoriesService_Transfer(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_Transfer_accepted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/transfer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"new_owner":"a","new_name":"b"}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"name":"b","owner":{"login":"a"}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.Transfer(ctx, "o", "r", TransferRequest{NewOwner: "a", NewName: String("b")})
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Repositories.Transfer returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &Repository{Name: String("b"), Owner: &User{Login: String("a")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.Transfer returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_Transfer_invalidAcceptedBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/transfer", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"name":1}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.Transfer(ctx, "o", "r", TransferRequest{NewOwner: "a"})
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		t.Errorf("Repositories.Transfer returned error: %v (want json.UnmarshalTypeError)", err)
+	}
+}
+
 func TestRepositoriesService_Dispatch(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -3338,6 +3635,63 @@ func TestRepositoriesService_Dispatch(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_Dispatch_invalidOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.Dispatch made a request with invalid options")
+	})
+
+	tooMany := make(map[string]int)
+	for i := 0; i <= maxDispatchPayloadProperties; i++ {
+		tooMany[fmt.Sprintf("p%v", i)] = i
+	}
+	tooManyPayload, _ := json.Marshal(tooMany)
+	tooManyRaw := json.RawMessage(tooManyPayload)
+	arrayRaw := json.RawMessage(`[1,2]`)
+
+	tests := map[string]DispatchRequestOptions{
+		"missing event type":  {},
+		"long event type":     {EventType: strings.Repeat("e", 101)},
+		"event type disallow": {EventType: "deploy", AllowedEventTypes: []string{"build", "test"}},
+		"too many properties": {EventType: "go", ClientPayload: &tooManyRaw},
+		"non-object payload":  {EventType: "go", ClientPayload: &arrayRaw},
+	}
+
+	ctx := context.Background()
+	for name, opts := range tests {
+		if _, _, err := client.Repositories.Dispatch(ctx, "o", "r", opts); err == nil {
+			t.Errorf("%v: Repositories.Dispatch returned nil error, want error", name)
+		}
+	}
+}
+
+func TestNewDispatchRequestOptions(t *testing.T) {
+	type payload struct {
+		Ref     string `json:"ref"`
+		Version int    `json:"version"`
+	}
+
+	opts, err := NewDispatchRequestOptions("release", payload{Ref: "main", Version: 2})
+	if err != nil {
+		t.Fatalf("NewDispatchRequestOptions returned error: %v", err)
+	}
+
+	raw := json.RawMessage(`{"ref":"main","version":2}`)
+	want := DispatchRequestOptions{EventType: "release", ClientPayload: &raw}
+	if !cmp.Equal(opts, want) {
+		t.Errorf("NewDispatchRequestOptions returned %+v, want %+v", opts, want)
+	}
+
+	if _, err := NewDispatchRequestOptions("release", []string{"a"}); err == nil {
+		t.Error("NewDispatchRequestOptions returned nil error for a non-object payload, want error")
+	}
+	if _, err := NewDispatchRequestOptions("release", func() {}); err == nil {
+		t.Error("NewDispatchRequestOptions returned nil error for an unmarshalable payload, want error")
+	}
+}
+
 func TestAdvancedSecurity_Marshal(t *testing.T) {
 	testJSONMarshal(t, &AdvancedSecurity{}, "{}")
 
@@ -3352,6 +3706,28 @@ func TestAdvancedSecurity_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestSecurityAndAnalysis_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SecurityAndAnalysis{}, "{}")
+
+	u := &SecurityAndAnalysis{
+		AdvancedSecurity:                  &AdvancedSecurity{Status: String("enabled")},
+		SecretScanning:                    &SecretScanning{Status: String("enabled")},
+		SecretScanningPushProtection:      &SecretScanningPushProtection{Status: String("disabled")},
+		DependabotSecurityUpdates:         &DependabotSecurityUpdates{Status: String("enabled")},
+		SecretScanningNonProviderPatterns: &SecretScanningNonProviderPatterns{Status: String("disabled")},
+	}
+
+	want := `{
+		"advanced_security": {"status": "enabled"},
+		"secret_scanning": {"status": "enabled"},
+		"secret_scanning_push_protection": {"status": "disabled"},
+		"dependabot_security_updates": {"status": "enabled"},
+		"secret_scanning_non_provider_patterns": {"status": "disabled"}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
 func TestAuthorizedActorsOnly_Marshal(t *testing.T) {
 	testJSONMarshal(t, &AuthorizedActorsOnly{}, "{}")
 
@@ -3390,11 +3766,13 @@ func TestTransferRequest_Marshal(t *testing.T) {
 
 	u := &TransferRequest{
 		NewOwner: "testOwner",
+		NewName:  String("testName"),
 		TeamID:   []int64{1, 2},
 	}
 
 	want := `{
 		"new_owner": "testOwner",
+		"new_name": "testName",
 		"team_ids": [1,2]
 	}`
 
@@ -8,6 +8,9 @@ package github
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
 )
 
 // TrafficReferrer represent information about traffic from a referrer .
@@ -139,3 +142,179 @@ func (s *RepositoriesService) ListTrafficClones(ctx context.Context, owner, repo
 
 	return trafficClones, resp, nil
 }
+
+// TrafficDay represents the views and clones of a repository on a single day.
+type TrafficDay struct {
+	// Date is the start of the day, in UTC.
+	Date   time.Time    `json:"date"`
+	Views  *TrafficData `json:"views,omitempty"`
+	Clones *TrafficData `json:"clones,omitempty"`
+}
+
+// TrafficStore persists daily traffic samples collected by
+// RepositoriesService.CollectTraffic. Implementations must be safe for
+// concurrent use if CollectTraffic is called concurrently.
+type TrafficStore interface {
+	// LoadTrafficDays returns the stored days of owner/repo whose Date is
+	// within [since, until).
+	LoadTrafficDays(ctx context.Context, owner, repo string, since, until time.Time) ([]*TrafficDay, error)
+	// SaveTrafficDays stores days for owner/repo, replacing any stored day
+	// with the same Date.
+	SaveTrafficDays(ctx context.Context, owner, repo string, days []*TrafficDay) error
+}
+
+// MemoryTrafficStore is a TrafficStore that keeps samples in memory.
+// The zero value is ready to use.
+type MemoryTrafficStore struct {
+	mu   sync.Mutex
+	days map[string]map[time.Time]*TrafficDay
+}
+
+// LoadTrafficDays implements TrafficStore. Days are returned in chronological order.
+func (m *MemoryTrafficStore) LoadTrafficDays(ctx context.Context, owner, repo string, since, until time.Time) ([]*TrafficDay, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var days []*TrafficDay
+	for date, day := range m.days[owner+"/"+repo] {
+		if !date.Before(since) && date.Before(until) {
+			days = append(days, day)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Date.Before(days[j].Date) })
+
+	return days, nil
+}
+
+// SaveTrafficDays implements TrafficStore.
+func (m *MemoryTrafficStore) SaveTrafficDays(ctx context.Context, owner, repo string, days []*TrafficDay) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.days == nil {
+		m.days = make(map[string]map[time.Time]*TrafficDay)
+	}
+	key := owner + "/" + repo
+	if m.days[key] == nil {
+		m.days[key] = make(map[time.Time]*TrafficDay)
+	}
+	for _, day := range days {
+		m.days[key][day.Date] = day
+	}
+
+	return nil
+}
+
+// CollectTraffic samples the daily views and clones of a repository and
+// merges them into store. GitHub only retains the last 14 days of traffic,
+// so calling CollectTraffic on a schedule at least that frequent builds a
+// complete history. Samples of the same day are de-duplicated, keeping the
+// highest counts seen since the current day is only partially counted until
+// it ends.
+//
+// It returns the days that were saved.
+func (s *RepositoriesService) CollectTraffic(ctx context.Context, owner, repo string, store TrafficStore) ([]*TrafficDay, *Response, error) {
+	perDay := &TrafficBreakdownOptions{Per: "day"}
+	views, resp, err := s.ListTrafficViews(ctx, owner, repo, perDay)
+	if err != nil {
+		return nil, resp, err
+	}
+	clones, resp, err := s.ListTrafficClones(ctx, owner, repo, perDay)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	sampled := make(map[time.Time]*TrafficDay)
+	sample := func(data *TrafficData) *TrafficDay {
+		date := trafficDate(data.GetTimestamp())
+		if sampled[date] == nil {
+			sampled[date] = &TrafficDay{Date: date}
+		}
+		return sampled[date]
+	}
+	for _, v := range views.Views {
+		sample(v).Views = v
+	}
+	for _, c := range clones.Clones {
+		sample(c).Clones = c
+	}
+	if len(sampled) == 0 {
+		return nil, resp, nil
+	}
+
+	var days []*TrafficDay
+	for _, day := range sampled {
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Date.Before(days[j].Date) })
+
+	stored, err := store.LoadTrafficDays(ctx, owner, repo, days[0].Date, days[len(days)-1].Date.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, old := range stored {
+		if day := sampled[old.Date]; day != nil {
+			day.Views = maxTrafficData(day.Views, old.Views)
+			day.Clones = maxTrafficData(day.Clones, old.Clones)
+		}
+	}
+
+	if err := store.SaveTrafficDays(ctx, owner, repo, days); err != nil {
+		return nil, resp, err
+	}
+
+	return days, resp, nil
+}
+
+// TrafficSummary aggregates the traffic of a repository over a window of days.
+type TrafficSummary struct {
+	Since time.Time `json:"since"`
+	Until time.Time `json:"until"`
+	// Views and Clones are the totals over the window. Their Uniques are the
+	// sum of the daily unique counts, as GitHub does not expose unique
+	// visitors across arbitrary windows.
+	Views  *TrafficData  `json:"views,omitempty"`
+	Clones *TrafficData  `json:"clones,omitempty"`
+	Days   []*TrafficDay `json:"days,omitempty"`
+}
+
+// AggregateTraffic summarizes the traffic of owner/repo recorded in store
+// for the days within [since, until).
+func AggregateTraffic(ctx context.Context, store TrafficStore, owner, repo string, since, until time.Time) (*TrafficSummary, error) {
+	days, err := store.LoadTrafficDays(ctx, owner, repo, trafficDate(Timestamp{since}), until)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &TrafficSummary{
+		Since:  since,
+		Until:  until,
+		Views:  &TrafficData{Count: Int(0), Uniques: Int(0)},
+		Clones: &TrafficData{Count: Int(0), Uniques: Int(0)},
+		Days:   days,
+	}
+	add := func(total, data *TrafficData) {
+		*total.Count += data.GetCount()
+		*total.Uniques += data.GetUniques()
+	}
+	for _, day := range days {
+		add(summary.Views, day.Views)
+		add(summary.Clones, day.Clones)
+	}
+
+	return summary, nil
+}
+
+// trafficDate truncates ts to the start of its day in UTC.
+func trafficDate(ts Timestamp) time.Time {
+	t := ts.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// maxTrafficData returns whichever of a and b has the higher count.
+func maxTrafficData(a, b *TrafficData) *TrafficData {
+	if a == nil || b.GetCount() > a.GetCount() {
+		return b
+	}
+	return a
+}
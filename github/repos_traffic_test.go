@@ -201,6 +201,86 @@ func TestRepositoriesService_ListTrafficClones(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CollectTraffic(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	views := `{"views":[{"timestamp":"2016-05-30T00:00:00Z","count":5,"uniques":2},{"timestamp":"2016-05-31T00:00:00Z","count":%v,"uniques":1}]}`
+	viewsOfLastDay := 1
+	mux.HandleFunc("/repos/o/r/traffic/views", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per": "day"})
+		fmt.Fprintf(w, views, viewsOfLastDay)
+	})
+	mux.HandleFunc("/repos/o/r/traffic/clones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per": "day"})
+		fmt.Fprint(w, `{"clones":[{"timestamp":"2016-05-31T00:00:00Z","count":3,"uniques":3}]}`)
+	})
+
+	ctx := context.Background()
+	store := &MemoryTrafficStore{}
+	if _, _, err := client.Repositories.CollectTraffic(ctx, "o", "r", store); err != nil {
+		t.Fatalf("Repositories.CollectTraffic returned error: %v", err)
+	}
+
+	viewsOfLastDay = 4
+	days, _, err := client.Repositories.CollectTraffic(ctx, "o", "r", store)
+	if err != nil {
+		t.Fatalf("Repositories.CollectTraffic returned error: %v", err)
+	}
+
+	day1 := time.Date(2016, time.May, 30, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2016, time.May, 31, 0, 0, 0, 0, time.UTC)
+	want := []*TrafficDay{
+		{
+			Date:  day1,
+			Views: &TrafficData{Timestamp: &Timestamp{day1}, Count: Int(5), Uniques: Int(2)},
+		},
+		{
+			Date:   day2,
+			Views:  &TrafficData{Timestamp: &Timestamp{day2}, Count: Int(4), Uniques: Int(1)},
+			Clones: &TrafficData{Timestamp: &Timestamp{day2}, Count: Int(3), Uniques: Int(3)},
+		},
+	}
+	if !cmp.Equal(days, want) {
+		t.Errorf("Repositories.CollectTraffic returned %+v, want %+v", days, want)
+	}
+
+	viewsOfLastDay = 2
+	if _, _, err := client.Repositories.CollectTraffic(ctx, "o", "r", store); err != nil {
+		t.Fatalf("Repositories.CollectTraffic returned error: %v", err)
+	}
+
+	summary, err := AggregateTraffic(ctx, store, "o", "r", day1, day2.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("AggregateTraffic returned error: %v", err)
+	}
+	if got, want := summary.Views, (&TrafficData{Count: Int(9), Uniques: Int(3)}); !cmp.Equal(got, want) {
+		t.Errorf("AggregateTraffic returned views %+v, want %+v", got, want)
+	}
+	if got, want := summary.Clones, (&TrafficData{Count: Int(3), Uniques: Int(3)}); !cmp.Equal(got, want) {
+		t.Errorf("AggregateTraffic returned clones %+v, want %+v", got, want)
+	}
+	if got := len(summary.Days); got != 2 {
+		t.Errorf("AggregateTraffic returned %v days, want 2", got)
+	}
+
+	summary, err = AggregateTraffic(ctx, store, "o", "r", day2, day2.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("AggregateTraffic returned error: %v", err)
+	}
+	if got, want := summary.Views, (&TrafficData{Count: Int(4), Uniques: Int(1)}); !cmp.Equal(got, want) {
+		t.Errorf("AggregateTraffic returned views %+v, want %+v", got, want)
+	}
+
+	const methodName = "CollectTraffic"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CollectTraffic(ctx, "\n", "\n", store)
+		return err
+	})
+}
+
 func TestTrafficReferrer_Marshal(t *testing.T) {
 	testJSONMarshal(t, &TrafficReferrer{}, "{}")
 
@@ -0,0 +1,94 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// WalkTreeFunc is the type of the function called by RepositoriesService.WalkTree
+// for each entry of the tree. The entry's Path is relative to the root of the
+// repository.
+//
+// If the function returns fs.SkipDir when invoked on an entry of type "tree",
+// WalkTree skips the contents of that directory. Any other non-nil error stops
+// the walk and is returned by WalkTree.
+type WalkTreeFunc func(entry *TreeEntry) error
+
+// WalkTree walks the file tree of a repository at ref (a branch, tag, or
+// commit SHA), calling fn for each entry in depth-first order, directories
+// before their contents.
+//
+// The tree is fetched with a single recursive request. If GitHub truncates
+// that response because the tree is too large, WalkTree falls back to
+// fetching each directory separately, so that no entry is missed.
+func (s *RepositoriesService) WalkTree(ctx context.Context, owner, repo, ref string, fn WalkTreeFunc) (*Response, error) {
+	tree, resp, err := s.client.Git.GetTree(ctx, owner, repo, ref, true)
+	if err != nil {
+		return resp, err
+	}
+
+	if !tree.GetTruncated() {
+		var skip string
+		for _, entry := range tree.Entries {
+			if skip != "" && strings.HasPrefix(entry.GetPath(), skip) {
+				continue
+			}
+			skip = ""
+
+			err := fn(entry)
+			if errors.Is(err, fs.SkipDir) && entry.GetType() == "tree" {
+				skip = entry.GetPath() + "/"
+				continue
+			}
+			if err != nil {
+				return resp, err
+			}
+		}
+		return resp, nil
+	}
+
+	return s.walkTreeByDirectory(ctx, owner, repo, ref, "", fn)
+}
+
+// walkTreeByDirectory walks the tree identified by sha, one non-recursive
+// request per directory. prefix is the path of that tree in the repository.
+func (s *RepositoriesService) walkTreeByDirectory(ctx context.Context, owner, repo, sha, prefix string, fn WalkTreeFunc) (*Response, error) {
+	tree, resp, err := s.client.Git.GetTree(ctx, owner, repo, sha, false)
+	if err != nil {
+		return resp, err
+	}
+	if tree.GetTruncated() {
+		return resp, fmt.Errorf("tree %q has too many entries to list", prefix)
+	}
+
+	for _, entry := range tree.Entries {
+		if prefix != "" {
+			entry.Path = String(prefix + "/" + entry.GetPath())
+		}
+
+		err := fn(entry)
+		if errors.Is(err, fs.SkipDir) && entry.GetType() == "tree" {
+			continue
+		}
+		if err != nil {
+			return resp, err
+		}
+
+		if entry.GetType() == "tree" {
+			resp, err = s.walkTreeByDirectory(ctx, owner, repo, entry.GetSHA(), entry.GetPath(), fn)
+			if err != nil {
+				return resp, err
+			}
+		}
+	}
+
+	return resp, nil
+}
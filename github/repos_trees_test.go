@@ -0,0 +1,126 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_WalkTree(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"recursive": "1"})
+		fmt.Fprint(w, `{"sha":"s","truncated":false,"tree":[
+			{"path":"a","type":"tree","sha":"t1"},
+			{"path":"a/b","type":"blob","sha":"b1"},
+			{"path":"c","type":"tree","sha":"t2"},
+			{"path":"c/d","type":"blob","sha":"b2"},
+			{"path":"e","type":"blob","sha":"b3"}
+		]}`)
+	})
+
+	ctx := context.Background()
+	var paths []string
+	_, err := client.Repositories.WalkTree(ctx, "o", "r", "main", func(entry *TreeEntry) error {
+		paths = append(paths, entry.GetPath())
+		if entry.GetPath() == "c" {
+			return fs.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Repositories.WalkTree returned error: %v", err)
+	}
+
+	if want := []string{"a", "a/b", "c", "e"}; !cmp.Equal(paths, want) {
+		t.Errorf("Repositories.WalkTree visited %v, want %v", paths, want)
+	}
+
+	errStop := errors.New("stop")
+	_, err = client.Repositories.WalkTree(ctx, "o", "r", "main", func(entry *TreeEntry) error {
+		return errStop
+	})
+	if err != errStop {
+		t.Errorf("Repositories.WalkTree returned error %v, want %v", err, errStop)
+	}
+
+	const methodName = "WalkTree"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.WalkTree(ctx, "\n", "\n", "\n", nil)
+		return err
+	})
+}
+
+func TestRepositoriesService_WalkTree_truncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("recursive") != "" {
+			fmt.Fprint(w, `{"sha":"s","truncated":true,"tree":[{"path":"a","type":"tree","sha":"t1"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"sha":"s","tree":[
+			{"path":"a","type":"tree","sha":"t1"},
+			{"path":"c","type":"tree","sha":"t2"},
+			{"path":"e","type":"blob","sha":"b3"}
+		]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/t1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"t1","tree":[{"path":"b","type":"tree","sha":"t3"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/t3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"t3","tree":[{"path":"f","type":"blob","sha":"b1"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/t2", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.WalkTree listed a skipped directory")
+	})
+
+	ctx := context.Background()
+	var paths []string
+	_, err := client.Repositories.WalkTree(ctx, "o", "r", "main", func(entry *TreeEntry) error {
+		paths = append(paths, entry.GetPath())
+		if entry.GetPath() == "c" {
+			return fs.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Repositories.WalkTree returned error: %v", err)
+	}
+
+	if want := []string{"a", "a/b", "a/b/f", "c", "e"}; !cmp.Equal(paths, want) {
+		t.Errorf("Repositories.WalkTree visited %v, want %v", paths, want)
+	}
+}
+
+func TestRepositoriesService_WalkTree_truncatedDirectory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/main", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"s","truncated":true}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.WalkTree(ctx, "o", "r", "main", func(entry *TreeEntry) error { return nil })
+	if err == nil {
+		t.Error("Repositories.WalkTree returned no error for a truncated directory")
+	}
+}
@@ -6,9 +6,12 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strconv"
 )
 
 // SCIMService provides access to SCIM related functions in the
@@ -17,6 +20,77 @@ import (
 // GitHub API docs: https://docs.github.com/en/rest/scim
 type SCIMService service
 
+// scimErrorSchema identifies the body of a SCIM error response.
+const scimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+// SCIMError is returned by SCIM endpoints that reject a request with a SCIM
+// error response instead of the usual GitHub error body.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#scim-error-responses
+type SCIMError struct {
+	// Status is the HTTP status code reported in the error body.
+	Status int
+	// SCIMType is the SCIM error type, such as "invalidValue" or
+	// "uniqueness". It is empty for errors without a SCIM type.
+	SCIMType string
+	// Detail is the human-readable description of the error.
+	Detail string
+	// Err is the error returned by GitHub.
+	Err *ErrorResponse
+}
+
+func (e *SCIMError) Error() string {
+	r := e.Err.Response
+	msg := fmt.Sprintf("%v %v: %d %v", r.Request.Method, sanitizeURL(r.Request.URL), r.StatusCode, e.Detail)
+	if e.SCIMType != "" {
+		msg += fmt.Sprintf(" (%v)", e.SCIMType)
+	}
+	return msg
+}
+
+// Unwrap returns the error returned by GitHub.
+func (e *SCIMError) Unwrap() error {
+	return e.Err
+}
+
+// newSCIMError returns err as a *SCIMError if its body is a SCIM error
+// response, and err unchanged otherwise.
+func newSCIMError(err error) error {
+	errorResponse, ok := err.(*ErrorResponse)
+	if !ok || errorResponse.Response == nil || errorResponse.Response.Body == nil {
+		return err
+	}
+
+	data, readErr := io.ReadAll(errorResponse.Response.Body)
+	if readErr != nil {
+		return err
+	}
+	errorResponse.Response.Body = io.NopCloser(bytes.NewReader(data))
+
+	var body struct {
+		Schemas  []string    `json:"schemas"`
+		Status   json.Number `json:"status"`
+		SCIMType string      `json:"scimType"`
+		Detail   string      `json:"detail"`
+	}
+	if json.Unmarshal(data, &body) != nil {
+		return err
+	}
+	isSCIM := body.Detail != "" || body.SCIMType != ""
+	for _, schema := range body.Schemas {
+		isSCIM = isSCIM || schema == scimErrorSchema
+	}
+	if !isSCIM {
+		return err
+	}
+
+	status, convErr := strconv.Atoi(body.Status.String())
+	if convErr != nil {
+		status = errorResponse.Response.StatusCode
+	}
+	return &SCIMError{Status: status, SCIMType: body.SCIMType, Detail: body.Detail, Err: errorResponse}
+}
+
 // SCIMUserAttributes represents supported SCIM User attributes.
 //
 // GitHub API docs: https://docs.github.com/en/rest/scim#supported-scim-user-attributes
@@ -0,0 +1,280 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SCIMPatchOpSchema is the schema of a SCIM PATCH request body.
+const SCIMPatchOpSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+
+// SCIMEnterpriseUserAttributes represents a user provisioned in an
+// enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#provision-a-scim-enterprise-user
+type SCIMEnterpriseUserAttributes struct {
+	Schemas     []string                `json:"schemas,omitempty"`
+	ExternalID  *string                 `json:"externalId,omitempty"`
+	Active      *bool                   `json:"active,omitempty"`
+	UserName    string                  `json:"userName"` // (Required.)
+	Name        *SCIMUserName           `json:"name,omitempty"`
+	DisplayName *string                 `json:"displayName,omitempty"`
+	Emails      []*SCIMUserEmail        `json:"emails,omitempty"`
+	Roles       []*SCIMUserRole         `json:"roles,omitempty"`
+	Groups      []*SCIMDisplayReference `json:"groups,omitempty"` // (Read-only.)
+	ID          *string                 `json:"id,omitempty"`
+	Meta        *SCIMMeta               `json:"meta,omitempty"`
+}
+
+// SCIMUserRole represents a role assigned to a SCIM enterprise user.
+type SCIMUserRole struct {
+	Value   string  `json:"value"` // (Required.)
+	Display *string `json:"display,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Primary *bool   `json:"primary,omitempty"`
+}
+
+// SCIMDisplayReference references a SCIM user or group, such as a member of a
+// group or a group of a user.
+type SCIMDisplayReference struct {
+	Value   string  `json:"value"` // The ID of the referenced resource. (Required.)
+	Ref     *string `json:"$ref,omitempty"`
+	Display *string `json:"display,omitempty"`
+}
+
+// SCIMEnterpriseGroupAttributes represents a group provisioned in an
+// enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#provision-a-scim-enterprise-group
+type SCIMEnterpriseGroupAttributes struct {
+	Schemas     []string                `json:"schemas,omitempty"`
+	ExternalID  *string                 `json:"externalId,omitempty"`
+	DisplayName *string                 `json:"displayName,omitempty"`
+	Members     []*SCIMDisplayReference `json:"members,omitempty"`
+	ID          *string                 `json:"id,omitempty"`
+	Meta        *SCIMMeta               `json:"meta,omitempty"`
+}
+
+// SCIMEnterpriseUsers represents the result of calling ListEnterpriseUsers.
+type SCIMEnterpriseUsers struct {
+	Schemas      []string                        `json:"schemas,omitempty"`
+	TotalResults *int                            `json:"totalResults,omitempty"`
+	ItemsPerPage *int                            `json:"itemsPerPage,omitempty"`
+	StartIndex   *int                            `json:"startIndex,omitempty"`
+	Resources    []*SCIMEnterpriseUserAttributes `json:"Resources,omitempty"`
+}
+
+// SCIMEnterpriseGroups represents the result of calling ListEnterpriseGroups.
+type SCIMEnterpriseGroups struct {
+	Schemas      []string                         `json:"schemas,omitempty"`
+	TotalResults *int                             `json:"totalResults,omitempty"`
+	ItemsPerPage *int                             `json:"itemsPerPage,omitempty"`
+	StartIndex   *int                             `json:"startIndex,omitempty"`
+	Resources    []*SCIMEnterpriseGroupAttributes `json:"Resources,omitempty"`
+}
+
+// ListSCIMEnterpriseOptions specifies the optional parameters to the
+// SCIMService.ListEnterpriseUsers and SCIMService.ListEnterpriseGroups methods.
+type ListSCIMEnterpriseOptions struct {
+	// Filter results using the equals query parameter operator (eq), for
+	// example userName eq "octocat" or externalId eq "9138790-10932-109120392-12321".
+	Filter *string `url:"filter,omitempty"`
+	// ExcludedAttributes excludes attributes from the results, for example
+	// "members". Only applies to groups.
+	ExcludedAttributes *string `url:"excludedAttributes,omitempty"`
+	StartIndex         *int    `url:"startIndex,omitempty"` // Used for pagination: the index of the first result to return.
+	Count              *int    `url:"count,omitempty"`      // Used for pagination: the number of results to return.
+}
+
+// SCIMPatchOptions represents a SCIM PATCH request. Schemas defaults to
+// SCIMPatchOpSchema.
+type SCIMPatchOptions struct {
+	Schemas    []string              `json:"schemas"`
+	Operations []*SCIMPatchOperation `json:"Operations"`
+}
+
+// SCIMPatchOperation represents a single operation of a SCIM PATCH request.
+type SCIMPatchOperation struct {
+	Op    string      `json:"op"` // One of add, remove or replace. (Required.)
+	Path  *string     `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ListEnterpriseUsers lists the users provisioned in an enterprise with SCIM.
+//
+// Errors reported by the SCIM API are returned as a *SCIMError.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#list-scim-provisioned-identities-for-an-enterprise
+func (s *SCIMService) ListEnterpriseUsers(ctx context.Context, enterprise string, opts *ListSCIMEnterpriseOptions) (*SCIMEnterpriseUsers, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users", enterprise)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := new(SCIMEnterpriseUsers)
+	resp, err := s.doSCIM(ctx, "GET", u, nil, users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// CreateEnterpriseUser provisions a user in an enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#provision-a-scim-enterprise-user
+func (s *SCIMService) CreateEnterpriseUser(ctx context.Context, enterprise string, user *SCIMEnterpriseUserAttributes) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users", enterprise)
+	return s.enterpriseUser(ctx, "POST", u, user)
+}
+
+// GetEnterpriseUser gets a user provisioned in an enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#get-scim-provisioning-information-for-an-enterprise-user
+func (s *SCIMService) GetEnterpriseUser(ctx context.Context, enterprise, scimUserID string) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users/%v", enterprise, scimUserID)
+	return s.enterpriseUser(ctx, "GET", u, nil)
+}
+
+// SetEnterpriseUser replaces all attributes of a user provisioned in an
+// enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#set-scim-information-for-a-provisioned-enterprise-user
+func (s *SCIMService) SetEnterpriseUser(ctx context.Context, enterprise, scimUserID string, user *SCIMEnterpriseUserAttributes) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users/%v", enterprise, scimUserID)
+	return s.enterpriseUser(ctx, "PUT", u, user)
+}
+
+// UpdateEnterpriseUser updates attributes of a user provisioned in an
+// enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#update-an-attribute-for-a-scim-enterprise-user
+func (s *SCIMService) UpdateEnterpriseUser(ctx context.Context, enterprise, scimUserID string, opts *SCIMPatchOptions) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users/%v", enterprise, scimUserID)
+	return s.enterpriseUser(ctx, "PATCH", u, opts.withSchema())
+}
+
+// DeleteEnterpriseUser deletes a user provisioned in an enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#delete-a-scim-user-from-an-enterprise
+func (s *SCIMService) DeleteEnterpriseUser(ctx context.Context, enterprise, scimUserID string) (*Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users/%v", enterprise, scimUserID)
+	return s.doSCIM(ctx, "DELETE", u, nil, nil)
+}
+
+func (s *SCIMService) enterpriseUser(ctx context.Context, method, u string, body interface{}) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	user := new(SCIMEnterpriseUserAttributes)
+	resp, err := s.doSCIM(ctx, method, u, body, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+// ListEnterpriseGroups lists the groups provisioned in an enterprise with SCIM.
+//
+// Errors reported by the SCIM API are returned as a *SCIMError.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#list-provisioned-scim-groups-for-an-enterprise
+func (s *SCIMService) ListEnterpriseGroups(ctx context.Context, enterprise string, opts *ListSCIMEnterpriseOptions) (*SCIMEnterpriseGroups, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups", enterprise)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := new(SCIMEnterpriseGroups)
+	resp, err := s.doSCIM(ctx, "GET", u, nil, groups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groups, resp, nil
+}
+
+// CreateEnterpriseGroup provisions a group in an enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#provision-a-scim-enterprise-group
+func (s *SCIMService) CreateEnterpriseGroup(ctx context.Context, enterprise string, group *SCIMEnterpriseGroupAttributes) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups", enterprise)
+	return s.enterpriseGroup(ctx, "POST", u, group)
+}
+
+// GetEnterpriseGroup gets a group provisioned in an enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#get-scim-provisioning-information-for-an-enterprise-group
+func (s *SCIMService) GetEnterpriseGroup(ctx context.Context, enterprise, scimGroupID string) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups/%v", enterprise, scimGroupID)
+	return s.enterpriseGroup(ctx, "GET", u, nil)
+}
+
+// SetEnterpriseGroup replaces all attributes of a group provisioned in an
+// enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#set-scim-information-for-a-provisioned-enterprise-group
+func (s *SCIMService) SetEnterpriseGroup(ctx context.Context, enterprise, scimGroupID string, group *SCIMEnterpriseGroupAttributes) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups/%v", enterprise, scimGroupID)
+	return s.enterpriseGroup(ctx, "PUT", u, group)
+}
+
+// UpdateEnterpriseGroup updates attributes of a group provisioned in an
+// enterprise with SCIM, such as adding or removing members.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#update-an-attribute-for-a-scim-enterprise-group
+func (s *SCIMService) UpdateEnterpriseGroup(ctx context.Context, enterprise, scimGroupID string, opts *SCIMPatchOptions) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups/%v", enterprise, scimGroupID)
+	return s.enterpriseGroup(ctx, "PATCH", u, opts.withSchema())
+}
+
+// DeleteEnterpriseGroup deletes a group provisioned in an enterprise with SCIM.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/scim#delete-a-scim-group-from-an-enterprise
+func (s *SCIMService) DeleteEnterpriseGroup(ctx context.Context, enterprise, scimGroupID string) (*Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups/%v", enterprise, scimGroupID)
+	return s.doSCIM(ctx, "DELETE", u, nil, nil)
+}
+
+func (s *SCIMService) enterpriseGroup(ctx context.Context, method, u string, body interface{}) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	group := new(SCIMEnterpriseGroupAttributes)
+	resp, err := s.doSCIM(ctx, method, u, body, group)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
+
+// withSchema returns opts with Schemas defaulting to SCIMPatchOpSchema,
+// without modifying opts.
+func (opts *SCIMPatchOptions) withSchema() *SCIMPatchOptions {
+	if opts == nil || len(opts.Schemas) > 0 {
+		return opts
+	}
+	o := *opts
+	o.Schemas = []string{SCIMPatchOpSchema}
+	return &o
+}
+
+// doSCIM sends a request to a SCIM endpoint, returning errors reported in
+// SCIM format as a *SCIMError.
+func (s *SCIMService) doSCIM(ctx context.Context, method, u string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return resp, newSCIMError(err)
+	}
+
+	return resp, nil
+}
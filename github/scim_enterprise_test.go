@@ -0,0 +1,536 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSCIMService_ListEnterpriseUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		testFormValues(t, r, values{"filter": `userName eq "octocat"`, "startIndex": "1", "count": "2"})
+		fmt.Fprint(w, `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+			"totalResults": 1,
+			"itemsPerPage": 1,
+			"startIndex": 1,
+			"Resources": [{
+				"id": "u1",
+				"userName": "octocat",
+				"active": true,
+				"roles": [{"value": "User", "primary": false}],
+				"groups": [{"value": "g1", "display": "Engineering"}]
+			}]
+		}`)
+	})
+
+	opts := &ListSCIMEnterpriseOptions{Filter: String(`userName eq "octocat"`), StartIndex: Int(1), Count: Int(2)}
+	ctx := context.Background()
+	users, _, err := client.SCIM.ListEnterpriseUsers(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("SCIM.ListEnterpriseUsers returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUsers{
+		Schemas:      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+		TotalResults: Int(1),
+		ItemsPerPage: Int(1),
+		StartIndex:   Int(1),
+		Resources: []*SCIMEnterpriseUserAttributes{{
+			ID:       String("u1"),
+			UserName: "octocat",
+			Active:   Bool(true),
+			Roles:    []*SCIMUserRole{{Value: "User", Primary: Bool(false)}},
+			Groups:   []*SCIMDisplayReference{{Value: "g1", Display: String("Engineering")}},
+		}},
+	}
+	if !cmp.Equal(users, want) {
+		t.Errorf("SCIM.ListEnterpriseUsers returned %+v, want %+v", users, want)
+	}
+
+	const methodName = "ListEnterpriseUsers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.ListEnterpriseUsers(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.ListEnterpriseUsers(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_CreateEnterpriseUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SCIMEnterpriseUserAttributes{
+		Schemas:  []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+		UserName: "octocat",
+		Name:     &SCIMUserName{GivenName: "Mona", FamilyName: "Octocat"},
+		Emails:   []*SCIMUserEmail{{Value: "octocat@github.com", Primary: Bool(true)}},
+	}
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"octocat","name":{"givenName":"Mona","familyName":"Octocat"},"emails":[{"value":"octocat@github.com","primary":true}]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"u1","userName":"octocat"}`)
+	})
+
+	ctx := context.Background()
+	user, _, err := client.SCIM.CreateEnterpriseUser(ctx, "e", input)
+	if err != nil {
+		t.Errorf("SCIM.CreateEnterpriseUser returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUserAttributes{ID: String("u1"), UserName: "octocat"}
+	if !cmp.Equal(user, want) {
+		t.Errorf("SCIM.CreateEnterpriseUser returned %+v, want %+v", user, want)
+	}
+
+	const methodName = "CreateEnterpriseUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.CreateEnterpriseUser(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.CreateEnterpriseUser(ctx, "e", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_CreateEnterpriseUser_scimError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"409","scimType":"uniqueness","detail":"User already exists"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.SCIM.CreateEnterpriseUser(ctx, "e", &SCIMEnterpriseUserAttributes{UserName: "octocat"})
+
+	var scimErr *SCIMError
+	if !errors.As(err, &scimErr) {
+		t.Fatalf("SCIM.CreateEnterpriseUser returned error %v, want *SCIMError", err)
+	}
+	if scimErr.Status != 409 || scimErr.SCIMType != "uniqueness" || scimErr.Detail != "User already exists" {
+		t.Errorf("SCIM.CreateEnterpriseUser returned %+v, want status 409, type uniqueness and detail", scimErr)
+	}
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response.StatusCode != http.StatusConflict {
+		t.Errorf("SCIMError does not unwrap to the *ErrorResponse")
+	}
+}
+
+func TestSCIMService_GetEnterpriseUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users/u1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"u1","userName":"octocat","externalId":"x1"}`)
+	})
+
+	ctx := context.Background()
+	user, _, err := client.SCIM.GetEnterpriseUser(ctx, "e", "u1")
+	if err != nil {
+		t.Errorf("SCIM.GetEnterpriseUser returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUserAttributes{ID: String("u1"), UserName: "octocat", ExternalID: String("x1")}
+	if !cmp.Equal(user, want) {
+		t.Errorf("SCIM.GetEnterpriseUser returned %+v, want %+v", user, want)
+	}
+
+	const methodName = "GetEnterpriseUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.GetEnterpriseUser(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.GetEnterpriseUser(ctx, "e", "u1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_SetEnterpriseUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SCIMEnterpriseUserAttributes{UserName: "octocat", Active: Bool(false)}
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users/u1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"active":false,"userName":"octocat"}`+"\n")
+		fmt.Fprint(w, `{"id":"u1","userName":"octocat","active":false}`)
+	})
+
+	ctx := context.Background()
+	user, _, err := client.SCIM.SetEnterpriseUser(ctx, "e", "u1", input)
+	if err != nil {
+		t.Errorf("SCIM.SetEnterpriseUser returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUserAttributes{ID: String("u1"), UserName: "octocat", Active: Bool(false)}
+	if !cmp.Equal(user, want) {
+		t.Errorf("SCIM.SetEnterpriseUser returned %+v, want %+v", user, want)
+	}
+
+	const methodName = "SetEnterpriseUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.SetEnterpriseUser(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.SetEnterpriseUser(ctx, "e", "u1", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_UpdateEnterpriseUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SCIMPatchOptions{Operations: []*SCIMPatchOperation{{Op: "replace", Path: String("active"), Value: false}}}
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users/u1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"active","value":false}]}`+"\n")
+		fmt.Fprint(w, `{"id":"u1","active":false}`)
+	})
+
+	ctx := context.Background()
+	user, _, err := client.SCIM.UpdateEnterpriseUser(ctx, "e", "u1", input)
+	if err != nil {
+		t.Errorf("SCIM.UpdateEnterpriseUser returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUserAttributes{ID: String("u1"), Active: Bool(false)}
+	if !cmp.Equal(user, want) {
+		t.Errorf("SCIM.UpdateEnterpriseUser returned %+v, want %+v", user, want)
+	}
+	if input.Schemas != nil {
+		t.Errorf("SCIM.UpdateEnterpriseUser modified the options: %+v", input)
+	}
+
+	const methodName = "UpdateEnterpriseUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.UpdateEnterpriseUser(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.UpdateEnterpriseUser(ctx, "e", "u1", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_DeleteEnterpriseUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users/u1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.SCIM.DeleteEnterpriseUser(ctx, "e", "u1")
+	if err != nil {
+		t.Errorf("SCIM.DeleteEnterpriseUser returned error: %v", err)
+	}
+
+	const methodName = "DeleteEnterpriseUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.SCIM.DeleteEnterpriseUser(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.SCIM.DeleteEnterpriseUser(ctx, "e", "u1")
+	})
+}
+
+func TestSCIMService_ListEnterpriseGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"excludedAttributes": "members", "count": "1"})
+		fmt.Fprint(w, `{"totalResults":1,"Resources":[{"id":"g1","displayName":"Engineering","externalId":"x"}]}`)
+	})
+
+	opts := &ListSCIMEnterpriseOptions{ExcludedAttributes: String("members"), Count: Int(1)}
+	ctx := context.Background()
+	groups, _, err := client.SCIM.ListEnterpriseGroups(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("SCIM.ListEnterpriseGroups returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroups{
+		TotalResults: Int(1),
+		Resources:    []*SCIMEnterpriseGroupAttributes{{ID: String("g1"), DisplayName: String("Engineering"), ExternalID: String("x")}},
+	}
+	if !cmp.Equal(groups, want) {
+		t.Errorf("SCIM.ListEnterpriseGroups returned %+v, want %+v", groups, want)
+	}
+
+	const methodName = "ListEnterpriseGroups"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.ListEnterpriseGroups(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.ListEnterpriseGroups(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_CreateEnterpriseGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SCIMEnterpriseGroupAttributes{
+		DisplayName: String("Engineering"),
+		ExternalID:  String("x"),
+		Members:     []*SCIMDisplayReference{{Value: "u1"}},
+	}
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"externalId":"x","displayName":"Engineering","members":[{"value":"u1"}]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"g1","displayName":"Engineering","members":[{"value":"u1","$ref":"https://api.github.com/scim/v2/enterprises/e/Users/u1","display":"octocat"}]}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.SCIM.CreateEnterpriseGroup(ctx, "e", input)
+	if err != nil {
+		t.Errorf("SCIM.CreateEnterpriseGroup returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroupAttributes{
+		ID:          String("g1"),
+		DisplayName: String("Engineering"),
+		Members: []*SCIMDisplayReference{{
+			Value:   "u1",
+			Ref:     String("https://api.github.com/scim/v2/enterprises/e/Users/u1"),
+			Display: String("octocat"),
+		}},
+	}
+	if !cmp.Equal(group, want) {
+		t.Errorf("SCIM.CreateEnterpriseGroup returned %+v, want %+v", group, want)
+	}
+
+	const methodName = "CreateEnterpriseGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.CreateEnterpriseGroup(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.CreateEnterpriseGroup(ctx, "e", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_GetEnterpriseGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/g1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"g1"}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.SCIM.GetEnterpriseGroup(ctx, "e", "g1")
+	if err != nil {
+		t.Errorf("SCIM.GetEnterpriseGroup returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroupAttributes{ID: String("g1")}
+	if !cmp.Equal(group, want) {
+		t.Errorf("SCIM.GetEnterpriseGroup returned %+v, want %+v", group, want)
+	}
+
+	const methodName = "GetEnterpriseGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.GetEnterpriseGroup(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.GetEnterpriseGroup(ctx, "e", "g1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_SetEnterpriseGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SCIMEnterpriseGroupAttributes{DisplayName: String("Platform")}
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/g1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"displayName":"Platform"}`+"\n")
+		fmt.Fprint(w, `{"id":"g1","displayName":"Platform"}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.SCIM.SetEnterpriseGroup(ctx, "e", "g1", input)
+	if err != nil {
+		t.Errorf("SCIM.SetEnterpriseGroup returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroupAttributes{ID: String("g1"), DisplayName: String("Platform")}
+	if !cmp.Equal(group, want) {
+		t.Errorf("SCIM.SetEnterpriseGroup returned %+v, want %+v", group, want)
+	}
+
+	const methodName = "SetEnterpriseGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.SetEnterpriseGroup(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.SetEnterpriseGroup(ctx, "e", "g1", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_UpdateEnterpriseGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SCIMPatchOptions{
+		Schemas: []string{SCIMPatchOpSchema},
+		Operations: []*SCIMPatchOperation{
+			{Op: "add", Path: String("members"), Value: []*SCIMDisplayReference{{Value: "u2"}}},
+		},
+	}
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/g1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","path":"members","value":[{"value":"u2"}]}]}`+"\n")
+		fmt.Fprint(w, `{"id":"g1","members":[{"value":"u2"}]}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.SCIM.UpdateEnterpriseGroup(ctx, "e", "g1", input)
+	if err != nil {
+		t.Errorf("SCIM.UpdateEnterpriseGroup returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroupAttributes{ID: String("g1"), Members: []*SCIMDisplayReference{{Value: "u2"}}}
+	if !cmp.Equal(group, want) {
+		t.Errorf("SCIM.UpdateEnterpriseGroup returned %+v, want %+v", group, want)
+	}
+
+	const methodName = "UpdateEnterpriseGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SCIM.UpdateEnterpriseGroup(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SCIM.UpdateEnterpriseGroup(ctx, "e", "g1", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSCIMService_DeleteEnterpriseGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/g1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.SCIM.DeleteEnterpriseGroup(ctx, "e", "g1")
+	if err != nil {
+		t.Errorf("SCIM.DeleteEnterpriseGroup returned error: %v", err)
+	}
+
+	const methodName = "DeleteEnterpriseGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.SCIM.DeleteEnterpriseGroup(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.SCIM.DeleteEnterpriseGroup(ctx, "e", "g1")
+	})
+}
+
+func TestNewSCIMError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/g1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.SCIM.DeleteEnterpriseGroup(ctx, "e", "g1")
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("SCIM.DeleteEnterpriseGroup returned error %#v, want *ErrorResponse for a non-SCIM body", err)
+	}
+}
@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -51,7 +53,8 @@ type SearchOptions struct {
 	// desc. Default is desc.
 	Order string `url:"order,omitempty"`
 
-	// Whether to retrieve text match metadata with a query
+	// Whether to retrieve text match metadata with a query. The matches are
+	// returned in the TextMatches field of each result.
 	TextMatch bool `url:"-"`
 
 	ListOptions
@@ -91,16 +94,17 @@ type TopicsSearchResult struct {
 }
 
 type TopicResult struct {
-	Name             *string    `json:"name,omitempty"`
-	DisplayName      *string    `json:"display_name,omitempty"`
-	ShortDescription *string    `json:"short_description,omitempty"`
-	Description      *string    `json:"description,omitempty"`
-	CreatedBy        *string    `json:"created_by,omitempty"`
-	CreatedAt        *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt        *string    `json:"updated_at,omitempty"`
-	Featured         *bool      `json:"featured,omitempty"`
-	Curated          *bool      `json:"curated,omitempty"`
-	Score            *float64   `json:"score,omitempty"`
+	Name             *string      `json:"name,omitempty"`
+	DisplayName      *string      `json:"display_name,omitempty"`
+	ShortDescription *string      `json:"short_description,omitempty"`
+	Description      *string      `json:"description,omitempty"`
+	CreatedBy        *string      `json:"created_by,omitempty"`
+	CreatedAt        *Timestamp   `json:"created_at,omitempty"`
+	UpdatedAt        *string      `json:"updated_at,omitempty"`
+	Featured         *bool        `json:"featured,omitempty"`
+	Curated          *bool        `json:"curated,omitempty"`
+	Score            *float64     `json:"score,omitempty"`
+	TextMatches      []*TextMatch `json:"text_matches,omitempty"`
 }
 
 // Topics finds topics via various criteria. Results are sorted by best match.
@@ -136,8 +140,9 @@ type CommitResult struct {
 	URL         *string   `json:"url,omitempty"`
 	CommentsURL *string   `json:"comments_url,omitempty"`
 
-	Repository *Repository `json:"repository,omitempty"`
-	Score      *float64    `json:"score,omitempty"`
+	Repository  *Repository  `json:"repository,omitempty"`
+	Score       *float64     `json:"score,omitempty"`
+	TextMatches []*TextMatch `json:"text_matches,omitempty"`
 }
 
 // Commits searches commits via various criteria.
@@ -226,6 +231,7 @@ type CodeResult struct {
 	SHA         *string      `json:"sha,omitempty"`
 	HTMLURL     *string      `json:"html_url,omitempty"`
 	Repository  *Repository  `json:"repository,omitempty"`
+	Score       *float64     `json:"score,omitempty"`
 	TextMatches []*TextMatch `json:"text_matches,omitempty"`
 }
 
@@ -255,13 +261,14 @@ type LabelsSearchResult struct {
 
 // LabelResult represents a single search result.
 type LabelResult struct {
-	ID          *int64   `json:"id,omitempty"`
-	URL         *string  `json:"url,omitempty"`
-	Name        *string  `json:"name,omitempty"`
-	Color       *string  `json:"color,omitempty"`
-	Default     *bool    `json:"default,omitempty"`
-	Description *string  `json:"description,omitempty"`
-	Score       *float64 `json:"score,omitempty"`
+	ID          *int64       `json:"id,omitempty"`
+	URL         *string      `json:"url,omitempty"`
+	Name        *string      `json:"name,omitempty"`
+	Color       *string      `json:"color,omitempty"`
+	Default     *bool        `json:"default,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Score       *float64     `json:"score,omitempty"`
+	TextMatches []*TextMatch `json:"text_matches,omitempty"`
 }
 
 func (l LabelResult) String() string {
@@ -331,3 +338,93 @@ func (s *SearchService) search(ctx context.Context, searchType string, parameter
 
 	return s.client.Do(ctx, req, result)
 }
+
+// Reasons of a SearchQueryError.
+const (
+	SearchQueryTooLong    = "too_long"
+	SearchQueryTooComplex = "too_complex"
+	SearchQueryInvalid    = "invalid"
+)
+
+const (
+	// maxSearchQueryLength is the maximum length of a search query,
+	// qualifiers excluded.
+	maxSearchQueryLength = 256
+
+	// maxSearchQueryOperators is the maximum number of AND, OR and NOT
+	// operators in a search query.
+	maxSearchQueryOperators = 5
+)
+
+// SearchQueryError describes a search query rejected by GitHub, such as
+// when it is too long or uses invalid qualifiers. The detailed messages are
+// in the Errors field of the embedded ErrorResponse.
+//
+// The SearchService methods return the *ErrorResponse itself; use
+// SearchQueryErrorFrom to get a SearchQueryError from it.
+type SearchQueryError struct {
+	*ErrorResponse
+
+	// Query is the rejected search query.
+	Query string
+
+	// Reason is one of SearchQueryTooLong, when the query exceeds the
+	// maximum length, SearchQueryTooComplex, when it has too many AND, OR
+	// or NOT operators, or SearchQueryInvalid otherwise. It is derived from
+	// the query rather than from the error messages, whose wording may
+	// change.
+	Reason string
+}
+
+// SearchQueryErrorFrom returns the SearchQueryError describing err, if err
+// is the error returned by a SearchService method when GitHub rejects the
+// search query with 422 Unprocessable Entity. It returns nil for any other
+// error, including a 422 that is not about the query.
+func SearchQueryErrorFrom(err error) *SearchQueryError {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		return nil
+	}
+	aboutQuery := false
+	for _, e := range errResp.Errors {
+		if e.Field == "q" || e.Resource == "Search" {
+			aboutQuery = true
+			break
+		}
+	}
+	if !aboutQuery {
+		return nil
+	}
+
+	var query string
+	if req := errResp.Response.Request; req != nil && req.URL != nil {
+		query = req.URL.Query().Get("q")
+	}
+	return newSearchQueryError(errResp, query)
+}
+
+func newSearchQueryError(errResp *ErrorResponse, query string) *SearchQueryError {
+	e := &SearchQueryError{ErrorResponse: errResp, Query: query, Reason: SearchQueryInvalid}
+	var terms []string
+	operators := 0
+	for _, field := range strings.Fields(query) {
+		switch {
+		case field == "AND" || field == "OR" || field == "NOT":
+			operators++
+		case !strings.Contains(field, ":"):
+			terms = append(terms, field)
+		}
+	}
+	switch {
+	case len(strings.Join(terms, " ")) > maxSearchQueryLength:
+		e.Reason = SearchQueryTooLong
+	case operators > maxSearchQueryOperators:
+		e.Reason = SearchQueryTooComplex
+	}
+	return e
+}
+
+// Unwrap returns the ErrorResponse of the rejected search.
+func (e *SearchQueryError) Unwrap() error {
+	return e.ErrorResponse
+}
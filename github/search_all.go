@@ -0,0 +1,358 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// maxSearchResults is the number of results the search API returns for
+	// a query at most, however many match it.
+	maxSearchResults = 1000
+
+	// defaultSearchInterval spaces search requests so that they stay within
+	// the search rate limit of 30 requests per minute.
+	defaultSearchInterval = 2 * time.Second
+)
+
+// SearchAllOptions specifies the optional parameters to the SearchService
+// iterator methods, such as SearchService.IssuesAll.
+type SearchAllOptions struct {
+	SearchOptions
+
+	// SliceBy is the date qualifier by which to split the query into date
+	// ranges whenever it matches more than the 1000 results the search API
+	// returns for a query, such as "created" or "updated" for issues and
+	// repositories, "committer-date" for commits, or "created" for users.
+	// The ranges are split in half until each matches at most 1000
+	// results. If empty, or for code search which has no date qualifier,
+	// iteration stops after 1000 results.
+	SliceBy string
+
+	// SliceSince and SliceUntil bound the dates iterated over when SliceBy
+	// is set. SliceSince defaults to the beginning of 2008, before any
+	// GitHub data, and SliceUntil defaults to the time of the first call
+	// to Next.
+	SliceSince time.Time
+	SliceUntil time.Time
+
+	// MinInterval is the minimum delay between two search requests.
+	// Default is two seconds, the interval of the search rate limit of 30
+	// requests per minute.
+	MinInterval time.Duration
+
+	// MinRateRemaining pauses until the search rate limit resets whenever
+	// fewer than this many search requests remain. Default is 1.
+	MinRateRemaining int
+}
+
+// searchRange is a range of dates, from included to to excluded.
+type searchRange struct {
+	from, to time.Time
+}
+
+// SearchIterator iterates over the results of a search, fetching them page
+// by page. It is created by the SearchService iterator methods, such as
+// SearchService.IssuesAll.
+//
+// Requests are spaced to stay within the search rate limit, pause when it
+// runs out, and are retried when rejected by the secondary rate limit.
+//
+//	it := client.Search.IssuesAll("org:google is:pr", &github.SearchAllOptions{SliceBy: "created"})
+//	for it.Next(ctx) {
+//		process(it.Issue())
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type SearchIterator struct {
+	fetch        func(ctx context.Context, query string, opts *SearchOptions) (int, []interface{}, *Response, error)
+	query        string
+	opts         SearchOptions
+	sliceBy      string
+	since, until time.Time
+	minRemaining int
+	pacer        requestPacer
+
+	started bool
+	pending []*searchRange // nil ranges stand for the unsliced query
+	inQuery bool
+	first   bool
+	current *searchRange
+	count   int
+	page    []interface{}
+	idx     int
+
+	item      interface{}
+	resp      *Response
+	err       error
+	truncated bool
+}
+
+func newSearchIterator(query string, opts *SearchAllOptions, fetch func(context.Context, string, *SearchOptions) (int, []interface{}, *Response, error)) *SearchIterator {
+	it := &SearchIterator{fetch: fetch, query: query}
+	interval := defaultSearchInterval
+	if opts != nil {
+		it.opts = opts.SearchOptions
+		it.sliceBy = opts.SliceBy
+		it.since, it.until = opts.SliceSince, opts.SliceUntil
+		if opts.MinInterval > 0 {
+			interval = opts.MinInterval
+		}
+		it.minRemaining = opts.MinRateRemaining
+	}
+	if it.opts.PerPage == 0 {
+		it.opts.PerPage = 100
+	}
+	if it.minRemaining <= 0 {
+		it.minRemaining = 1
+	}
+	it.pacer.interval = interval
+	return it
+}
+
+// RepositoriesAll returns an iterator over the repositories matching
+// query, which are returned by SearchIterator.Repository.
+func (s *SearchService) RepositoriesAll(query string, opts *SearchAllOptions) *SearchIterator {
+	return newSearchIterator(query, opts, func(ctx context.Context, query string, opts *SearchOptions) (int, []interface{}, *Response, error) {
+		result, resp, err := s.Repositories(ctx, query, opts)
+		if err != nil {
+			return 0, nil, resp, err
+		}
+		items := make([]interface{}, len(result.Repositories))
+		for i, repo := range result.Repositories {
+			items[i] = repo
+		}
+		return result.GetTotal(), items, resp, nil
+	})
+}
+
+// CommitsAll returns an iterator over the commits matching query, which are
+// returned by SearchIterator.Commit.
+func (s *SearchService) CommitsAll(query string, opts *SearchAllOptions) *SearchIterator {
+	return newSearchIterator(query, opts, func(ctx context.Context, query string, opts *SearchOptions) (int, []interface{}, *Response, error) {
+		result, resp, err := s.Commits(ctx, query, opts)
+		if err != nil {
+			return 0, nil, resp, err
+		}
+		items := make([]interface{}, len(result.Commits))
+		for i, commit := range result.Commits {
+			items[i] = commit
+		}
+		return result.GetTotal(), items, resp, nil
+	})
+}
+
+// IssuesAll returns an iterator over the issues and pull requests matching
+// query, which are returned by SearchIterator.Issue.
+func (s *SearchService) IssuesAll(query string, opts *SearchAllOptions) *SearchIterator {
+	return newSearchIterator(query, opts, func(ctx context.Context, query string, opts *SearchOptions) (int, []interface{}, *Response, error) {
+		result, resp, err := s.Issues(ctx, query, opts)
+		if err != nil {
+			return 0, nil, resp, err
+		}
+		items := make([]interface{}, len(result.Issues))
+		for i, issue := range result.Issues {
+			items[i] = issue
+		}
+		return result.GetTotal(), items, resp, nil
+	})
+}
+
+// UsersAll returns an iterator over the users matching query, which are
+// returned by SearchIterator.User.
+func (s *SearchService) UsersAll(query string, opts *SearchAllOptions) *SearchIterator {
+	return newSearchIterator(query, opts, func(ctx context.Context, query string, opts *SearchOptions) (int, []interface{}, *Response, error) {
+		result, resp, err := s.Users(ctx, query, opts)
+		if err != nil {
+			return 0, nil, resp, err
+		}
+		items := make([]interface{}, len(result.Users))
+		for i, user := range result.Users {
+			items[i] = user
+		}
+		return result.GetTotal(), items, resp, nil
+	})
+}
+
+// CodeAll returns an iterator over the code matching query, which is
+// returned by SearchIterator.Code. Code search cannot be sliced by date, so
+// opts.SliceBy must be empty.
+func (s *SearchService) CodeAll(query string, opts *SearchAllOptions) *SearchIterator {
+	it := newSearchIterator(query, opts, func(ctx context.Context, query string, opts *SearchOptions) (int, []interface{}, *Response, error) {
+		result, resp, err := s.Code(ctx, query, opts)
+		if err != nil {
+			return 0, nil, resp, err
+		}
+		items := make([]interface{}, len(result.CodeResults))
+		for i, code := range result.CodeResults {
+			items[i] = code
+		}
+		return result.GetTotal(), items, resp, nil
+	})
+	if it.sliceBy != "" {
+		it.err = fmt.Errorf("code search cannot be sliced by %q", it.sliceBy)
+	}
+	return it
+}
+
+// Next advances the iterator to the next result, fetching pages as needed.
+// It returns false when there are no more results or an error occurred.
+func (it *SearchIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	if !it.started {
+		it.start()
+	}
+
+	for it.idx >= len(it.page) {
+		if !it.advance() {
+			it.item = nil
+			return false
+		}
+
+		var total int
+		var page []interface{}
+		err := pacedDo(ctx, &it.pacer, it.minRemaining, func() (*Response, error) {
+			var resp *Response
+			var err error
+			total, page, resp, err = it.fetch(ctx, it.currentQuery(), &it.opts)
+			it.resp = resp
+			return resp, err
+		})
+		if err != nil {
+			it.err = err
+			it.item = nil
+			return false
+		}
+
+		if it.first && total > maxSearchResults {
+			if r := it.current; r != nil && r.to.Sub(r.from) > time.Second {
+				mid := r.from.Add(r.to.Sub(r.from) / 2).Truncate(time.Second)
+				it.pending = append([]*searchRange{{r.from, mid}, {mid, r.to}}, it.pending...)
+				it.inQuery = false
+				it.page, it.idx = nil, 0
+				continue
+			}
+			it.truncated = true
+		}
+		it.first = false
+		it.count += len(page)
+		it.page, it.idx = page, 0
+	}
+
+	it.item = it.page[it.idx]
+	it.idx++
+	return true
+}
+
+// start sets up the queries to run.
+func (it *SearchIterator) start() {
+	it.started = true
+	if it.sliceBy == "" {
+		it.pending = []*searchRange{nil}
+		return
+	}
+
+	since, until := it.since, it.until
+	if since.IsZero() {
+		since = time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if until.IsZero() {
+		until = time.Now()
+	}
+	it.pending = []*searchRange{{since.UTC().Truncate(time.Second), until.UTC().Truncate(time.Second).Add(time.Second)}}
+}
+
+// advance moves to the next page of the current query, or to the next
+// query. It returns false when there are no more pages.
+func (it *SearchIterator) advance() bool {
+	if it.inQuery {
+		if it.resp != nil && it.resp.NextPage != 0 && it.count < maxSearchResults {
+			it.opts.Page = it.resp.NextPage
+			return true
+		}
+		if it.resp != nil && it.resp.NextPage != 0 {
+			it.truncated = true
+		}
+		it.inQuery = false
+		// Only the first query starts at the page set in the options.
+		it.opts.Page = 0
+	}
+
+	if len(it.pending) == 0 {
+		return false
+	}
+	it.current, it.pending = it.pending[0], it.pending[1:]
+	it.inQuery, it.first, it.count = true, true, 0
+	return true
+}
+
+// currentQuery returns the query for the current date range.
+func (it *SearchIterator) currentQuery() string {
+	r := it.current
+	if r == nil {
+		return it.query
+	}
+	last := r.to.Add(-time.Second)
+	return fmt.Sprintf("%v %v:%v..%v", it.query, it.sliceBy, r.from.Format(time.RFC3339), last.Format(time.RFC3339))
+}
+
+// Repository returns the repository the iterator is positioned at, or nil
+// if the iterator was not created by SearchService.RepositoriesAll.
+func (it *SearchIterator) Repository() *Repository {
+	repo, _ := it.item.(*Repository)
+	return repo
+}
+
+// Commit returns the commit the iterator is positioned at, or nil if the
+// iterator was not created by SearchService.CommitsAll.
+func (it *SearchIterator) Commit() *CommitResult {
+	commit, _ := it.item.(*CommitResult)
+	return commit
+}
+
+// Issue returns the issue or pull request the iterator is positioned at, or
+// nil if the iterator was not created by SearchService.IssuesAll.
+func (it *SearchIterator) Issue() *Issue {
+	issue, _ := it.item.(*Issue)
+	return issue
+}
+
+// User returns the user the iterator is positioned at, or nil if the
+// iterator was not created by SearchService.UsersAll.
+func (it *SearchIterator) User() *User {
+	user, _ := it.item.(*User)
+	return user
+}
+
+// Code returns the code result the iterator is positioned at, or nil if the
+// iterator was not created by SearchService.CodeAll.
+func (it *SearchIterator) Code() *CodeResult {
+	code, _ := it.item.(*CodeResult)
+	return code
+}
+
+// Truncated reports whether results were left out because a query, or a
+// date range of a single second, matched more than the 1000 results the
+// search API returns.
+func (it *SearchIterator) Truncated() bool {
+	return it.truncated
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last API call made.
+func (it *SearchIterator) Response() *Response {
+	return it.resp
+}
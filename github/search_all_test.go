@@ -0,0 +1,173 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSearchService_IssuesAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"q": "is:pr", "sort": "created", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/search/issues?q=is%3Apr&page=2&per_page=100>; rel="next"`)
+			fmt.Fprint(w, `{"total_count": 3, "items": [{"number":1},{"number":2}]}`)
+		case "2":
+			testFormValues(t, r, values{"q": "is:pr", "sort": "created", "page": "2", "per_page": "100"})
+			fmt.Fprint(w, `{"total_count": 3, "items": [{"number":3}]}`)
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Search.IssuesAll("is:pr", &SearchAllOptions{
+		SearchOptions: SearchOptions{Sort: "created"},
+		MinInterval:   time.Nanosecond,
+	})
+	var numbers []int
+	for it.Next(ctx) {
+		numbers = append(numbers, it.Issue().GetNumber())
+		if it.Repository() != nil {
+			t.Errorf("SearchIterator.Repository = %+v for an issue search, want nil", it.Repository())
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("SearchIterator returned error: %v", err)
+	}
+
+	if want := []int{1, 2, 3}; !cmp.Equal(numbers, want) {
+		t.Errorf("SearchIterator returned %v, want %v", numbers, want)
+	}
+	if it.Truncated() {
+		t.Error("SearchIterator.Truncated = true, want false")
+	}
+	if it.Issue() != nil {
+		t.Errorf("SearchIterator.Issue = %+v after the end of the results, want nil", it.Issue())
+	}
+}
+
+func TestSearchService_RepositoriesAll_sliced(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var queries []string
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		q := r.FormValue("q")
+		queries = append(queries, q)
+
+		dates := strings.Split(strings.TrimPrefix(q, "go created:"), "..")
+		from, _ := time.Parse(time.RFC3339, dates[0])
+		to, _ := time.Parse(time.RFC3339, dates[1])
+		if to.Sub(from) >= 2*time.Second {
+			fmt.Fprint(w, `{"total_count": 2000, "items": [{"id":0}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"total_count": 1, "items": [{"name":%q}]}`, q)
+	})
+
+	since := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	it := client.Search.RepositoriesAll("go", &SearchAllOptions{
+		SliceBy:     "created",
+		SliceSince:  since,
+		SliceUntil:  since.Add(3 * time.Second),
+		MinInterval: time.Nanosecond,
+	})
+	var names []string
+	for it.Next(ctx) {
+		names = append(names, it.Repository().GetName())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("SearchIterator returned error: %v", err)
+	}
+
+	want := []string{
+		"go created:2020-01-01T00:00:00Z..2020-01-01T00:00:01Z",
+		"go created:2020-01-01T00:00:02Z..2020-01-01T00:00:03Z",
+	}
+	if !cmp.Equal(names, want) {
+		t.Errorf("SearchIterator returned %v, want %v", names, want)
+	}
+	wantQueries := append([]string{"go created:2020-01-01T00:00:00Z..2020-01-01T00:00:03Z"}, want...)
+	if !cmp.Equal(queries, wantQueries) {
+		t.Errorf("SearchIterator made queries %v, want %v", queries, wantQueries)
+	}
+	if it.Truncated() {
+		t.Error("SearchIterator.Truncated = true, want false")
+	}
+}
+
+func TestSearchService_UsersAll_truncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count": 2000, "items": [{"login":"a"}]}`)
+	})
+
+	since := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	it := client.Search.UsersAll("u", &SearchAllOptions{
+		SliceBy:     "created",
+		SliceSince:  since,
+		SliceUntil:  since,
+		MinInterval: time.Nanosecond,
+	})
+	var logins []string
+	for it.Next(ctx) {
+		logins = append(logins, it.User().GetLogin())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("SearchIterator returned error: %v", err)
+	}
+
+	if want := []string{"a"}; !cmp.Equal(logins, want) {
+		t.Errorf("SearchIterator returned %v, want %v", logins, want)
+	}
+	if !it.Truncated() {
+		t.Error("SearchIterator.Truncated = false, want true")
+	}
+}
+
+func TestSearchService_CodeAll_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	it := client.Search.CodeAll("c", &SearchAllOptions{SliceBy: "created"})
+	if it.Next(ctx) || it.Err() == nil {
+		t.Error("SearchIterator did not fail for code search sliced by date")
+	}
+
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+
+	it = client.Search.CommitsAll("c", &SearchAllOptions{MinInterval: time.Nanosecond})
+	if it.Next(ctx) {
+		t.Fatal("SearchIterator.Next returned true, want false")
+	}
+	if it.Err() == nil {
+		t.Error("SearchIterator.Err returned nil, want error")
+	}
+	if it.Response() == nil || it.Response().StatusCode != http.StatusInternalServerError {
+		t.Errorf("SearchIterator.Response = %+v, want a 500 response", it.Response())
+	}
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -436,6 +437,96 @@ func TestSearchService_Code(t *testing.T) {
 	}
 }
 
+func TestSearchService_Commits_textMatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeCommitSearchPreview+", application/vnd.github.v3.text-match+json")
+		fmt.Fprint(w, `{"total_count": 1, "items": [{"sha":"s","text_matches":[{"property":"message","fragment":"fix blah","matches":[{"text":"blah","indices":[4,8]}]}]}]}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Search.Commits(ctx, "blah", &SearchOptions{TextMatch: true})
+	if err != nil {
+		t.Fatalf("Search.Commits returned error: %v", err)
+	}
+
+	want := []*TextMatch{{
+		Property: String("message"),
+		Fragment: String("fix blah"),
+		Matches:  []*Match{{Text: String("blah"), Indices: []int{4, 8}}},
+	}}
+	if got := result.Commits[0].TextMatches; !cmp.Equal(got, want) {
+		t.Errorf("Search.Commits returned text matches %+v, want %+v", got, want)
+	}
+}
+
+func TestSearchService_queryError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{strings.Repeat("a ", 200) + "is:issue", SearchQueryTooLong},
+		{"a OR b OR c OR d OR e AND f NOT g", SearchQueryTooComplex},
+		{"user:a " + strings.Repeat("user:b ", 100), SearchQueryInvalid},
+		{"q", SearchQueryInvalid},
+	}
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Search","field":"q","code":"invalid"}]}`)
+	})
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		_, _, err := client.Search.Issues(ctx, tt.query, nil)
+
+		errResp, ok := err.(*ErrorResponse)
+		if !ok || errResp.Message != "Validation Failed" {
+			t.Fatalf("Search.Issues returned %#v, want *ErrorResponse", err)
+		}
+
+		queryErr := SearchQueryErrorFrom(err)
+		if queryErr == nil {
+			t.Fatalf("SearchQueryErrorFrom(%v) = nil, want *SearchQueryError", err)
+		}
+		if queryErr.Query != tt.query || queryErr.Reason != tt.want {
+			t.Errorf("SearchQueryError for %q has query %q and reason %q, want %q", tt.query, queryErr.Query, queryErr.Reason, tt.want)
+		}
+		if !errors.Is(queryErr, errResp) {
+			t.Errorf("SearchQueryError does not wrap the ErrorResponse: %v", queryErr)
+		}
+	}
+}
+
+func TestSearchQueryErrorFrom_notAboutQuery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Issue","field":"per_page","code":"invalid"}]}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Search.Issues(ctx, "q", nil)
+	if err == nil {
+		t.Fatal("Search.Issues returned nil error")
+	}
+	if got := SearchQueryErrorFrom(err); got != nil {
+		t.Errorf("SearchQueryErrorFrom(%v) = %+v, want nil", err, got)
+	}
+	if got := SearchQueryErrorFrom(errors.New("boom")); got != nil {
+		t.Errorf("SearchQueryErrorFrom(boom) = %+v, want nil", got)
+	}
+}
+
 func TestSearchService_Code_coverage(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -26,16 +26,44 @@ type SecretScanningAlert struct {
 	ResolvedAt   *Timestamp `json:"resolved_at,omitempty"`
 	ResolvedBy   *User      `json:"resolved_by,omitempty"`
 	SecretType   *string    `json:"secret_type,omitempty"`
-	Secret       *string    `json:"secret,omitempty"`
+	// SecretTypeDisplayName is the name of the provider pattern, or of the
+	// custom pattern that detected the secret.
+	SecretTypeDisplayName *string `json:"secret_type_display_name,omitempty"`
+	Secret                *string `json:"secret,omitempty"`
+	// Repository is only populated when listing the alerts of an organization or enterprise.
+	Repository *Repository `json:"repository,omitempty"`
 }
 
 // SecretScanningAlertLocation represents the location for a secret scanning alert.
 type SecretScanningAlertLocation struct {
+	// Type is one of the SecretScanningLocation constants. It tells which
+	// fields of Details are set.
 	Type    *string                             `json:"type,omitempty"`
 	Details *SecretScanningAlertLocationDetails `json:"details,omitempty"`
 }
 
+// Types of a SecretScanningAlertLocation.
+const (
+	SecretScanningLocationCommit                   = "commit"
+	SecretScanningLocationWikiCommit               = "wiki_commit"
+	SecretScanningLocationIssueTitle               = "issue_title"
+	SecretScanningLocationIssueBody                = "issue_body"
+	SecretScanningLocationIssueComment             = "issue_comment"
+	SecretScanningLocationDiscussionTitle          = "discussion_title"
+	SecretScanningLocationDiscussionBody           = "discussion_body"
+	SecretScanningLocationDiscussionComment        = "discussion_comment"
+	SecretScanningLocationPullRequestTitle         = "pull_request_title"
+	SecretScanningLocationPullRequestBody          = "pull_request_body"
+	SecretScanningLocationPullRequestComment       = "pull_request_comment"
+	SecretScanningLocationPullRequestReview        = "pull_request_review"
+	SecretScanningLocationPullRequestReviewComment = "pull_request_review_comment"
+)
+
 // SecretScanningAlertLocationDetails represents the location details for a secret scanning alert.
+//
+// Commit and wiki commit locations set the file position fields. Other
+// locations set the API URL of the issue, discussion, pull request, comment
+// or review containing the secret.
 type SecretScanningAlertLocationDetails struct {
 	Path        *string `json:"path,omitempty"`
 	Startline   *int    `json:"start_line,omitempty"`
@@ -46,6 +74,20 @@ type SecretScanningAlertLocationDetails struct {
 	BlobURL     *string `json:"blob_url,omitempty"`
 	CommitSHA   *string `json:"commit_sha,omitempty"`
 	CommitURL   *string `json:"commit_url,omitempty"`
+	// PageURL is the URL of the wiki page, for wiki commit locations.
+	PageURL *string `json:"page_url,omitempty"`
+
+	IssueTitleURL               *string `json:"issue_title_url,omitempty"`
+	IssueBodyURL                *string `json:"issue_body_url,omitempty"`
+	IssueCommentURL             *string `json:"issue_comment_url,omitempty"`
+	DiscussionTitleURL          *string `json:"discussion_title_url,omitempty"`
+	DiscussionBodyURL           *string `json:"discussion_body_url,omitempty"`
+	DiscussionCommentURL        *string `json:"discussion_comment_url,omitempty"`
+	PullRequestTitleURL         *string `json:"pull_request_title_url,omitempty"`
+	PullRequestBodyURL          *string `json:"pull_request_body_url,omitempty"`
+	PullRequestCommentURL       *string `json:"pull_request_comment_url,omitempty"`
+	PullRequestReviewURL        *string `json:"pull_request_review_url,omitempty"`
+	PullRequestReviewCommentURL *string `json:"pull_request_review_comment_url,omitempty"`
 }
 
 // SecretScanningAlertListOptions specifies optional parameters to the SecretScanningService.ListAlertsForEnterprise method.
@@ -60,6 +102,16 @@ type SecretScanningAlertListOptions struct {
 	// Valid resolutions are false_positive, wont_fix, revoked, pattern_edited, pattern_deleted or used_in_tests.
 	Resolution string `url:"resolution,omitempty"`
 
+	// The property by which to sort the results. Can be either created or updated. Default: created
+	Sort string `url:"sort,omitempty"`
+
+	// The direction to sort the results by. Can be either asc or desc. Default: desc
+	Direction string `url:"direction,omitempty"`
+
+	// A comma-separated list of validities. Only secret scanning alerts with one of these validities are listed.
+	// Valid validities are active, inactive and unknown.
+	Validity string `url:"validity,omitempty"`
+
 	ListCursorOptions
 
 	// List options can vary on the Enterprise type.
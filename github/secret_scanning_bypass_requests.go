@@ -0,0 +1,185 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// BypassActor represents the user who requested or reviewed a push protection bypass.
+type BypassActor struct {
+	ActorID   *int64  `json:"actor_id,omitempty"`
+	ActorName *string `json:"actor_name,omitempty"`
+}
+
+// BypassRequestRepository represents the repository of a push protection bypass request.
+type BypassRequestRepository struct {
+	ID       *int64  `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	FullName *string `json:"full_name,omitempty"`
+}
+
+// BypassRequestOrganization represents the organization of a push protection bypass request.
+type BypassRequestOrganization struct {
+	ID   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// SecretScanningBypassData represents a secret that was blocked by push protection.
+type SecretScanningBypassData struct {
+	SecretType *string `json:"secret_type,omitempty"`
+	Location   *string `json:"location,omitempty"`
+	Path       *string `json:"path,omitempty"`
+}
+
+// BypassResponse represents a review of a push protection bypass request.
+type BypassResponse struct {
+	ID        *int64       `json:"id,omitempty"`
+	Reviewer  *BypassActor `json:"reviewer,omitempty"`
+	Status    *string      `json:"status,omitempty"`
+	CreatedAt *Timestamp   `json:"created_at,omitempty"`
+}
+
+// SecretScanningBypassRequest represents a request to bypass secret scanning
+// push protection.
+type SecretScanningBypassRequest struct {
+	ID                 *int64                      `json:"id,omitempty"`
+	Number             *int64                      `json:"number,omitempty"`
+	Repository         *BypassRequestRepository    `json:"repository,omitempty"`
+	Organization       *BypassRequestOrganization  `json:"organization,omitempty"`
+	Requester          *BypassActor                `json:"requester,omitempty"`
+	RequestType        *string                     `json:"request_type,omitempty"`
+	Data               []*SecretScanningBypassData `json:"data,omitempty"`
+	ResourceIdentifier *string                     `json:"resource_identifier,omitempty"`
+	// Status is one of pending, denied, approved, cancelled, completed, expired or open.
+	Status           *string           `json:"status,omitempty"`
+	RequesterComment *string           `json:"requester_comment,omitempty"`
+	ExpiresAt        *Timestamp        `json:"expires_at,omitempty"`
+	CreatedAt        *Timestamp        `json:"created_at,omitempty"`
+	Responses        []*BypassResponse `json:"responses,omitempty"`
+	URL              *string           `json:"url,omitempty"`
+	HTMLURL          *string           `json:"html_url,omitempty"`
+}
+
+// ListBypassRequestsOptions specifies optional parameters to the
+// SecretScanningService.ListBypassRequestsForOrg and
+// SecretScanningService.ListBypassRequestsForRepo methods.
+type ListBypassRequestsOptions struct {
+	// RepositoryName filters the requests by repository. It is only used
+	// when listing the requests of an organization.
+	RepositoryName string `url:"repository_name,omitempty"`
+
+	// Reviewer filters the requests by the handle of the user who reviewed them.
+	Reviewer string `url:"reviewer,omitempty"`
+
+	// Requester filters the requests by the handle of the user who made them.
+	Requester string `url:"requester,omitempty"`
+
+	// TimePeriod is one of hour, day, week or month. Default: day.
+	TimePeriod string `url:"time_period,omitempty"`
+
+	// RequestStatus is one of completed, cancelled, expired, denied, open or all.
+	// Default: all.
+	RequestStatus string `url:"request_status,omitempty"`
+
+	ListOptions
+}
+
+// ReviewBypassRequestOptions specifies the review of a push protection bypass request.
+type ReviewBypassRequestOptions struct {
+	// Status is either approve or deny. (Required.)
+	Status string `json:"status"`
+	// Message is the reason for the review. (Required.)
+	Message string `json:"message"`
+}
+
+// BypassReview represents the result of reviewing a push protection bypass request.
+type BypassReview struct {
+	BypassReviewID *int64     `json:"bypass_review_id,omitempty"`
+	Status         *string    `json:"status,omitempty"`
+	ReviewedAt     *Timestamp `json:"reviewed_at,omitempty"`
+}
+
+// ListBypassRequestsForOrg lists the requests to bypass secret scanning push
+// protection in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning/delegated-bypass#list-bypass-requests-for-secret-scanning-for-an-org
+func (s *SecretScanningService) ListBypassRequestsForOrg(ctx context.Context, org string, opts *ListBypassRequestsOptions) ([]*SecretScanningBypassRequest, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/bypass-requests/secret-scanning", org)
+	return s.listBypassRequests(ctx, u, opts)
+}
+
+// ListBypassRequestsForRepo lists the requests to bypass secret scanning push
+// protection in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning/delegated-bypass#list-bypass-requests-for-secret-scanning-for-a-repository
+func (s *SecretScanningService) ListBypassRequestsForRepo(ctx context.Context, owner, repo string, opts *ListBypassRequestsOptions) ([]*SecretScanningBypassRequest, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/secret-scanning", owner, repo)
+	return s.listBypassRequests(ctx, u, opts)
+}
+
+func (s *SecretScanningService) listBypassRequests(ctx context.Context, u string, opts *ListBypassRequestsOptions) ([]*SecretScanningBypassRequest, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*SecretScanningBypassRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
+// GetBypassRequest gets a single request to bypass secret scanning push
+// protection in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning/delegated-bypass#get-a-bypass-request-for-secret-scanning
+func (s *SecretScanningService) GetBypassRequest(ctx context.Context, owner, repo string, number int64) (*SecretScanningBypassRequest, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/secret-scanning/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var request *SecretScanningBypassRequest
+	resp, err := s.client.Do(ctx, req, &request)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return request, resp, nil
+}
+
+// ReviewBypassRequest approves or denies a request to bypass secret scanning
+// push protection in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning/delegated-bypass#review-a-bypass-request-for-secret-scanning
+func (s *SecretScanningService) ReviewBypassRequest(ctx context.Context, owner, repo string, number int64, review *ReviewBypassRequestOptions) (*BypassReview, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/secret-scanning/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("PATCH", u, review)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result *BypassReview
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
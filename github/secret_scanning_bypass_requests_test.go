@@ -0,0 +1,208 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const bypassRequestJSON = `{
+	"id": 2,
+	"number": 42,
+	"repository": {"id": 1, "name": "r", "full_name": "o/r"},
+	"organization": {"id": 3, "name": "o"},
+	"requester": {"actor_id": 12, "actor_name": "monalisa"},
+	"request_type": "secret_scanning",
+	"data": [{"secret_type": "adafruit_io_key", "location": "12345-1", "path": "/example/path"}],
+	"resource_identifier": "827efc6d56897b048c772eb4087f854f46256132",
+	"status": "denied",
+	"requester_comment": "Test token used in the readme",
+	"expires_at": "2024-07-08T08:43:03Z",
+	"created_at": "2024-07-01T08:43:03Z",
+	"responses": [{"id": 5, "reviewer": {"actor_id": 4, "actor_name": "octocat"}, "status": "denied", "created_at": "2024-07-02T08:43:04Z"}],
+	"url": "https://api.github.com/repos/o/r/bypass-requests/secret-scanning/42",
+	"html_url": "https://github.com/o/r/exemptions/42"
+}`
+
+func wantBypassRequest() *SecretScanningBypassRequest {
+	return &SecretScanningBypassRequest{
+		ID:                 Int64(2),
+		Number:             Int64(42),
+		Repository:         &BypassRequestRepository{ID: Int64(1), Name: String("r"), FullName: String("o/r")},
+		Organization:       &BypassRequestOrganization{ID: Int64(3), Name: String("o")},
+		Requester:          &BypassActor{ActorID: Int64(12), ActorName: String("monalisa")},
+		RequestType:        String("secret_scanning"),
+		Data:               []*SecretScanningBypassData{{SecretType: String("adafruit_io_key"), Location: String("12345-1"), Path: String("/example/path")}},
+		ResourceIdentifier: String("827efc6d56897b048c772eb4087f854f46256132"),
+		Status:             String("denied"),
+		RequesterComment:   String("Test token used in the readme"),
+		ExpiresAt:          &Timestamp{time.Date(2024, time.July, 8, 8, 43, 3, 0, time.UTC)},
+		CreatedAt:          &Timestamp{time.Date(2024, time.July, 1, 8, 43, 3, 0, time.UTC)},
+		Responses: []*BypassResponse{{
+			ID:        Int64(5),
+			Reviewer:  &BypassActor{ActorID: Int64(4), ActorName: String("octocat")},
+			Status:    String("denied"),
+			CreatedAt: &Timestamp{time.Date(2024, time.July, 2, 8, 43, 4, 0, time.UTC)},
+		}},
+		URL:     String("https://api.github.com/repos/o/r/bypass-requests/secret-scanning/42"),
+		HTMLURL: String("https://github.com/o/r/exemptions/42"),
+	}
+}
+
+func TestSecretScanningService_ListBypassRequestsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/bypass-requests/secret-scanning", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"repository_name": "r", "request_status": "open", "page": "2"})
+		fmt.Fprint(w, "["+bypassRequestJSON+"]")
+	})
+
+	ctx := context.Background()
+	opts := &ListBypassRequestsOptions{RepositoryName: "r", RequestStatus: "open", ListOptions: ListOptions{Page: 2}}
+	requests, _, err := client.SecretScanning.ListBypassRequestsForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListBypassRequestsForOrg returned error: %v", err)
+	}
+
+	want := []*SecretScanningBypassRequest{wantBypassRequest()}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("SecretScanning.ListBypassRequestsForOrg returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListBypassRequestsForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListBypassRequestsForOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListBypassRequestsForOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ListBypassRequestsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"reviewer": "octocat", "time_period": "week"})
+		fmt.Fprint(w, "["+bypassRequestJSON+"]")
+	})
+
+	ctx := context.Background()
+	opts := &ListBypassRequestsOptions{Reviewer: "octocat", TimePeriod: "week"}
+	requests, _, err := client.SecretScanning.ListBypassRequestsForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListBypassRequestsForRepo returned error: %v", err)
+	}
+
+	want := []*SecretScanningBypassRequest{wantBypassRequest()}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("SecretScanning.ListBypassRequestsForRepo returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListBypassRequestsForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListBypassRequestsForRepo(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListBypassRequestsForRepo(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_GetBypassRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, bypassRequestJSON)
+	})
+
+	ctx := context.Background()
+	request, _, err := client.SecretScanning.GetBypassRequest(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("SecretScanning.GetBypassRequest returned error: %v", err)
+	}
+
+	if want := wantBypassRequest(); !cmp.Equal(request, want) {
+		t.Errorf("SecretScanning.GetBypassRequest returned %+v, want %+v", request, want)
+	}
+
+	const methodName = "GetBypassRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.GetBypassRequest(ctx, "\n", "\n", 42)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.GetBypassRequest(ctx, "o", "r", 42)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ReviewBypassRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"status":"approve","message":"Used in tests"}`+"\n")
+		fmt.Fprint(w, `{"bypass_review_id": 7, "status": "APPROVED", "reviewed_at": "2024-07-02T08:43:04Z"}`)
+	})
+
+	ctx := context.Background()
+	review := &ReviewBypassRequestOptions{Status: "approve", Message: "Used in tests"}
+	result, _, err := client.SecretScanning.ReviewBypassRequest(ctx, "o", "r", 42, review)
+	if err != nil {
+		t.Errorf("SecretScanning.ReviewBypassRequest returned error: %v", err)
+	}
+
+	want := &BypassReview{
+		BypassReviewID: Int64(7),
+		Status:         String("APPROVED"),
+		ReviewedAt:     &Timestamp{time.Date(2024, time.July, 2, 8, 43, 4, 0, time.UTC)},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("SecretScanning.ReviewBypassRequest returned %+v, want %+v", result, want)
+	}
+
+	const methodName = "ReviewBypassRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ReviewBypassRequest(ctx, "\n", "\n", 42, review)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ReviewBypassRequest(ctx, "o", "r", 42, review)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
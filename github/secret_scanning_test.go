@@ -22,10 +22,11 @@ func TestSecretScanningService_ListAlertsForEnterprise(t *testing.T) {
 
 	mux.HandleFunc("/enterprises/e/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testFormValues(t, r, values{"state": "open", "secret_type": "mailchimp_api_key"})
+		testFormValues(t, r, values{"state": "open", "secret_type": "mailchimp_api_key", "validity": "active", "sort": "updated", "after": "Y3Vyc29y"})
 
 		fmt.Fprint(w, `[{
 			"number": 1,
+			"repository": {"id": 1, "full_name": "o/r"},
 			"created_at": "1996-06-20T00:00:00Z",
 			"url": "https://api.github.com/repos/o/r/secret-scanning/alerts/1",
 			"html_url": "https://github.com/o/r/security/secret-scanning/1",
@@ -40,7 +41,7 @@ func TestSecretScanningService_ListAlertsForEnterprise(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	opts := &SecretScanningAlertListOptions{State: "open", SecretType: "mailchimp_api_key"}
+	opts := &SecretScanningAlertListOptions{State: "open", SecretType: "mailchimp_api_key", Validity: "active", Sort: "updated", ListCursorOptions: ListCursorOptions{After: "Y3Vyc29y"}}
 
 	alerts, _, err := client.SecretScanning.ListAlertsForEnterprise(ctx, "e", opts)
 	if err != nil {
@@ -61,6 +62,7 @@ func TestSecretScanningService_ListAlertsForEnterprise(t *testing.T) {
 			ResolvedBy:   nil,
 			SecretType:   String("mailchimp_api_key"),
 			Secret:       String("XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX-us2"),
+			Repository:   &Repository{ID: Int64(1), FullName: String("o/r")},
 		},
 	}
 
@@ -476,6 +478,80 @@ func TestSecretScanningService_ListLocationsForAlert(t *testing.T) {
 	})
 }
 
+func TestSecretScanningService_ListLocationsForAlert_nonCommitLocations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/1/locations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{
+				"type": "wiki_commit",
+				"details": {
+					"path": "/Home.md",
+					"start_line": 2,
+					"end_line": 2,
+					"start_column": 5,
+					"end_column": 44,
+					"blob_sha": "b",
+					"page_url": "https://github.com/o/r/wiki/Home/abc",
+					"commit_sha": "c",
+					"commit_url": "https://github.com/o/r/wiki/_compare/c"
+				}
+			},
+			{"type": "issue_title", "details": {"issue_title_url": "https://api.github.com/repos/o/r/issues/1"}},
+			{"type": "issue_body", "details": {"issue_body_url": "https://api.github.com/repos/o/r/issues/1"}},
+			{"type": "issue_comment", "details": {"issue_comment_url": "https://api.github.com/repos/o/r/issues/comments/2"}},
+			{"type": "discussion_title", "details": {"discussion_title_url": "https://github.com/o/r/discussions/3"}},
+			{"type": "discussion_body", "details": {"discussion_body_url": "https://github.com/o/r/discussions/3#discussion-4"}},
+			{"type": "discussion_comment", "details": {"discussion_comment_url": "https://github.com/o/r/discussions/3#discussioncomment-5"}},
+			{"type": "pull_request_title", "details": {"pull_request_title_url": "https://api.github.com/repos/o/r/pulls/6"}},
+			{"type": "pull_request_body", "details": {"pull_request_body_url": "https://api.github.com/repos/o/r/pulls/6"}},
+			{"type": "pull_request_comment", "details": {"pull_request_comment_url": "https://api.github.com/repos/o/r/issues/comments/7"}},
+			{"type": "pull_request_review", "details": {"pull_request_review_url": "https://api.github.com/repos/o/r/pulls/6/reviews/8"}},
+			{"type": "pull_request_review_comment", "details": {"pull_request_review_comment_url": "https://api.github.com/repos/o/r/pulls/comments/9"}}
+		]`)
+	})
+
+	ctx := context.Background()
+	locations, _, err := client.SecretScanning.ListLocationsForAlert(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Errorf("SecretScanning.ListLocationsForAlert returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlertLocation{
+		{
+			Type: String(SecretScanningLocationWikiCommit),
+			Details: &SecretScanningAlertLocationDetails{
+				Path:        String("/Home.md"),
+				Startline:   Int(2),
+				EndLine:     Int(2),
+				StartColumn: Int(5),
+				EndColumn:   Int(44),
+				BlobSHA:     String("b"),
+				PageURL:     String("https://github.com/o/r/wiki/Home/abc"),
+				CommitSHA:   String("c"),
+				CommitURL:   String("https://github.com/o/r/wiki/_compare/c"),
+			},
+		},
+		{Type: String(SecretScanningLocationIssueTitle), Details: &SecretScanningAlertLocationDetails{IssueTitleURL: String("https://api.github.com/repos/o/r/issues/1")}},
+		{Type: String(SecretScanningLocationIssueBody), Details: &SecretScanningAlertLocationDetails{IssueBodyURL: String("https://api.github.com/repos/o/r/issues/1")}},
+		{Type: String(SecretScanningLocationIssueComment), Details: &SecretScanningAlertLocationDetails{IssueCommentURL: String("https://api.github.com/repos/o/r/issues/comments/2")}},
+		{Type: String(SecretScanningLocationDiscussionTitle), Details: &SecretScanningAlertLocationDetails{DiscussionTitleURL: String("https://github.com/o/r/discussions/3")}},
+		{Type: String(SecretScanningLocationDiscussionBody), Details: &SecretScanningAlertLocationDetails{DiscussionBodyURL: String("https://github.com/o/r/discussions/3#discussion-4")}},
+		{Type: String(SecretScanningLocationDiscussionComment), Details: &SecretScanningAlertLocationDetails{DiscussionCommentURL: String("https://github.com/o/r/discussions/3#discussioncomment-5")}},
+		{Type: String(SecretScanningLocationPullRequestTitle), Details: &SecretScanningAlertLocationDetails{PullRequestTitleURL: String("https://api.github.com/repos/o/r/pulls/6")}},
+		{Type: String(SecretScanningLocationPullRequestBody), Details: &SecretScanningAlertLocationDetails{PullRequestBodyURL: String("https://api.github.com/repos/o/r/pulls/6")}},
+		{Type: String(SecretScanningLocationPullRequestComment), Details: &SecretScanningAlertLocationDetails{PullRequestCommentURL: String("https://api.github.com/repos/o/r/issues/comments/7")}},
+		{Type: String(SecretScanningLocationPullRequestReview), Details: &SecretScanningAlertLocationDetails{PullRequestReviewURL: String("https://api.github.com/repos/o/r/pulls/6/reviews/8")}},
+		{Type: String(SecretScanningLocationPullRequestReviewComment), Details: &SecretScanningAlertLocationDetails{PullRequestReviewCommentURL: String("https://api.github.com/repos/o/r/pulls/comments/9")}},
+	}
+
+	if !cmp.Equal(locations, want) {
+		t.Errorf("SecretScanning.ListLocationsForAlert returned %+v, want %+v", locations, want)
+	}
+}
+
 func TestSecretScanningAlert_Marshal(t *testing.T) {
 	testJSONMarshal(t, &SecretScanningAlert{}, `{}`)
 
@@ -494,8 +570,9 @@ func TestSecretScanningAlert_Marshal(t *testing.T) {
 			NodeID:    String("A123"),
 			AvatarURL: String("https://api.github.com/teams/2/discussions/3/comments"),
 		},
-		SecretType: String("test"),
-		Secret:     String("test"),
+		SecretType:            String("test"),
+		SecretTypeDisplayName: String("Test pattern"),
+		Secret:                String("test"),
 	}
 
 	want := `{
@@ -514,6 +591,7 @@ func TestSecretScanningAlert_Marshal(t *testing.T) {
 			"avatar_url": "https://api.github.com/teams/2/discussions/3/comments"
 		},
 		"secret_type": "test",
+		"secret_type_display_name": "Test pattern",
 		"secret": "test"
 	}`
 
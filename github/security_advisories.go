@@ -0,0 +1,331 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SecurityAdvisoriesService handles communication with the security advisory
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories
+type SecurityAdvisoriesService service
+
+// ListRepositorySecurityAdvisoriesOptions specifies optional parameters to the
+// SecurityAdvisoriesService.ListRepositorySecurityAdvisories method.
+type ListRepositorySecurityAdvisoriesOptions struct {
+	ListCursorOptions
+
+	// Direction in which to sort advisories. Possible values are: asc, desc.
+	// Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Sort specifies how to sort advisories. Possible values are: created, updated,
+	// and published. Default value is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// State filters advisories based on their state. Possible values are: triage, draft, published, closed.
+	State string `url:"state,omitempty"`
+}
+
+// ListGlobalAdvisoriesOptions specifies optional parameters to the
+// SecurityAdvisoriesService.ListGlobalAdvisories method.
+type ListGlobalAdvisoriesOptions struct {
+	ListCursorOptions
+
+	// GHSAID filters advisories by GitHub Security Advisory identifier.
+	GHSAID string `url:"ghsa_id,omitempty"`
+
+	// Type filters advisories by type. Possible values are: reviewed, malware, unreviewed.
+	// Default is "reviewed".
+	Type string `url:"type,omitempty"`
+
+	// CVEID filters advisories by CVE identifier.
+	CVEID string `url:"cve_id,omitempty"`
+
+	// Ecosystem filters advisories by the ecosystem of the affected packages,
+	// for example npm, pip or go.
+	Ecosystem string `url:"ecosystem,omitempty"`
+
+	// Severity filters advisories by severity. Possible values are: unknown, low,
+	// medium, high, critical.
+	Severity string `url:"severity,omitempty"`
+
+	// CWEs filters advisories by Common Weakness Enumeration IDs, for example "79".
+	CWEs []string `url:"cwes,omitempty,comma"`
+
+	// IsWithdrawn filters advisories on whether they were withdrawn.
+	IsWithdrawn *bool `url:"is_withdrawn,omitempty"`
+
+	// Affects filters advisories by the affected packages, given as
+	// package or package@version.
+	Affects []string `url:"affects,omitempty,comma"`
+
+	// Published, Updated and Modified filter advisories by date or date range,
+	// using the GitHub search syntax, for example ">=2023-01-01".
+	Published string `url:"published,omitempty"`
+	Updated   string `url:"updated,omitempty"`
+	Modified  string `url:"modified,omitempty"`
+
+	// EPSSPercentage and EPSSPercentile filter advisories by their Exploit
+	// Prediction Scoring System scores, for example ">=0.9".
+	EPSSPercentage string `url:"epss_percentage,omitempty"`
+	EPSSPercentile string `url:"epss_percentile,omitempty"`
+
+	// Direction in which to sort advisories. Possible values are: asc, desc.
+	// Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Sort specifies how to sort advisories. Possible values are: updated,
+	// published, epss_percentage, epss_percentile. Default is "published".
+	Sort string `url:"sort,omitempty"`
+}
+
+// GlobalSecurityAdvisory represents an advisory of the GitHub Advisory Database.
+type GlobalSecurityAdvisory struct {
+	SecurityAdvisory
+	ID                    *int64                         `json:"id,omitempty"`
+	RepositoryAdvisoryURL *string                        `json:"repository_advisory_url,omitempty"`
+	Type                  *string                        `json:"type,omitempty"`
+	SourceCodeLocation    *string                        `json:"source_code_location,omitempty"`
+	References            []string                       `json:"references,omitempty"`
+	Vulnerabilities       []*GlobalSecurityVulnerability `json:"vulnerabilities,omitempty"`
+	GithubReviewedAt      *Timestamp                     `json:"github_reviewed_at,omitempty"`
+	NVDPublishedAt        *Timestamp                     `json:"nvd_published_at,omitempty"`
+	Credits               []*AdvisoryCreditDetailed      `json:"credits,omitempty"`
+}
+
+// GlobalSecurityVulnerability represents a vulnerability of a global security advisory.
+type GlobalSecurityVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	FirstPatchedVersion    *string               `json:"first_patched_version,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
+}
+
+// SecurityAdvisoryRequest represents the fields of a repository security
+// advisory that can be set when creating or updating it.
+type SecurityAdvisoryRequest struct {
+	// Summary is required when creating an advisory.
+	Summary *string `json:"summary,omitempty"`
+	// Description is required when creating an advisory.
+	Description *string `json:"description,omitempty"`
+	CVEID       *string `json:"cve_id,omitempty"`
+	// Vulnerabilities is required when creating an advisory. Only the
+	// Package, VulnerableVersionRange, PatchedVersions and
+	// VulnerableFunctions fields of each vulnerability are used.
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	CWEIDs          []string                 `json:"cwe_ids,omitempty"`
+	Credits         []*AdvisoryCredit        `json:"credits,omitempty"`
+	// Severity is one of critical, high, medium or low. It must not be set
+	// together with CVSSVectorString.
+	Severity         *string `json:"severity,omitempty"`
+	CVSSVectorString *string `json:"cvss_vector_string,omitempty"`
+
+	// StartPrivateFork starts a temporary private fork along with the advisory.
+	// It is only used when creating an advisory.
+	StartPrivateFork *bool `json:"start_private_fork,omitempty"`
+
+	// The following fields are only used when updating an advisory.
+
+	// State is one of published, closed or draft.
+	State *string `json:"state,omitempty"`
+	// CollaboratingUsers lists the logins of the users given access to the advisory.
+	CollaboratingUsers []string `json:"collaborating_users,omitempty"`
+	// CollaboratingTeams lists the slugs of the teams given access to the advisory.
+	CollaboratingTeams []string `json:"collaborating_teams,omitempty"`
+}
+
+// ListRepositorySecurityAdvisories lists the security advisories in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#list-repository-security-advisories
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context.Context, owner, repo string, opts *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*SecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// GetRepositorySecurityAdvisory gets a repository security advisory by its GHSA ID.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#get-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) GetRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	advisory := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisory, resp, nil
+}
+
+// CreateRepositorySecurityAdvisory creates a draft security advisory in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#create-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) CreateRepositorySecurityAdvisory(ctx context.Context, owner, repo string, advisory *SecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// UpdateRepositorySecurityAdvisory updates a repository security advisory.
+// Only the fields set in advisory are changed.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#update-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) UpdateRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string, advisory *SecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("PATCH", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// RequestCVE requests a CVE ID for a repository security advisory. GitHub
+// reviews the request and assigns the CVE ID asynchronously.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#request-a-cve-for-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) RequestCVE(ctx context.Context, owner, repo, ghsaID string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/cve", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		if _, ok := err.(*AcceptedError); ok {
+			return resp, nil
+		}
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// CreateTemporaryPrivateFork creates a temporary private fork to collaborate
+// on fixing the vulnerability of a repository security advisory.
+//
+// This method might return an *AcceptedError and a status code of 202.
+// This is because this is the status that GitHub returns to signify that
+// it is now creating the fork in a background task. In this event, the
+// Repository value will be returned, which includes the details about the
+// fork. See RepositoriesService.WaitForFork to wait until it is ready.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#create-a-temporary-private-fork
+func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Context, owner, repo, ghsaID string) (*Repository, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/forks", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fork := new(Repository)
+	resp, err := s.client.Do(ctx, req, fork)
+	if err != nil {
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, fork); err != nil {
+				return fork, resp, err
+			}
+
+			return fork, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return fork, resp, nil
+}
+
+// ListGlobalAdvisories lists the advisories of the GitHub Advisory
+// Database. Use ListCursorOptions.After to page through the results.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/global-advisories#list-global-security-advisories
+func (s *SecurityAdvisoriesService) ListGlobalAdvisories(ctx context.Context, opts *ListGlobalAdvisoriesOptions) ([]*GlobalSecurityAdvisory, *Response, error) {
+	u, err := addOptions("advisories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*GlobalSecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// GetGlobalAdvisory gets an advisory of the GitHub Advisory
+// Database by its GHSA ID.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/global-advisories#get-a-global-security-advisory
+func (s *SecurityAdvisoriesService) GetGlobalAdvisory(ctx context.Context, ghsaID string) (*GlobalSecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("advisories/%v", ghsaID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	advisory := new(GlobalSecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisory, resp, nil
+}
@@ -0,0 +1,445 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "draft", "sort": "updated", "per_page": "2"})
+		fmt.Fprint(w, `[{
+			"ghsa_id": "GHSA-abcd-1234-efgh",
+			"cve_id": "CVE-2050-00000",
+			"state": "draft",
+			"cvss": {"score": 7.6, "vector_string": "CVSS:3.1/AV:N"},
+			"cvss_severities": {
+				"cvss_v3": {"score": 7.6, "vector_string": "CVSS:3.1/AV:N"},
+				"cvss_v4": {"score": 9.3, "vector_string": "CVSS:4.0/AV:N"}
+			},
+			"cwe_ids": ["CWE-123"],
+			"cwes": [{"cwe_id": "CWE-123", "name": "Write-what-where Condition"}],
+			"author": {"login": "a"},
+			"submission": {"accepted": true},
+			"vulnerabilities": [{
+				"package": {"ecosystem": "npm", "name": "a-package"},
+				"vulnerable_version_range": "< 1.0.0",
+				"patched_versions": "1.0.0",
+				"vulnerable_functions": ["a_function"]
+			}],
+			"credits": [{"login": "c", "type": "finder"}],
+			"credits_detailed": [{"user": {"login": "c"}, "type": "finder", "state": "pending"}],
+			"collaborating_teams": [{"slug": "t"}],
+			"private_fork": {"id": 1}
+		}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListRepositorySecurityAdvisoriesOptions{State: "draft", Sort: "updated", ListCursorOptions: ListCursorOptions{PerPage: 2}}
+	advisories, _, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*SecurityAdvisory{{
+		GHSAID: String("GHSA-abcd-1234-efgh"),
+		CVEID:  String("CVE-2050-00000"),
+		State:  String("draft"),
+		CVSs:   &AdvisoryCVSs{Score: Float64(7.6), VectorString: String("CVSS:3.1/AV:N")},
+		CVSSSeverities: &AdvisoryCVSSSeverities{
+			CVSSV3: &AdvisoryCVSs{Score: Float64(7.6), VectorString: String("CVSS:3.1/AV:N")},
+			CVSSV4: &AdvisoryCVSs{Score: Float64(9.3), VectorString: String("CVSS:4.0/AV:N")},
+		},
+		CWEIDs:     []string{"CWE-123"},
+		CWEs:       []*AdvisoryCWEs{{CWEID: String("CWE-123"), Name: String("Write-what-where Condition")}},
+		Author:     &User{Login: String("a")},
+		Submission: &SecurityAdvisorySubmission{Accepted: Bool(true)},
+		Vulnerabilities: []*AdvisoryVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("a-package")},
+			VulnerableVersionRange: String("< 1.0.0"),
+			PatchedVersions:        String("1.0.0"),
+			VulnerableFunctions:    []string{"a_function"},
+		}},
+		Credits:            []*AdvisoryCredit{{Login: String("c"), Type: String("finder")}},
+		CreditsDetailed:    []*AdvisoryCreditDetailed{{User: &User{Login: String("c")}, Type: String("finder"), State: String(AdvisoryCreditStatePending)}},
+		CollaboratingTeams: []*Team{{Slug: String("t")}},
+		PrivateFork:        &Repository{ID: Int64(1)},
+	}}
+	if !cmp.Equal(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+
+	const methodName = "ListRepositorySecurityAdvisories"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_GetRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-abcd-1234-efgh", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ghsa_id": "GHSA-abcd-1234-efgh", "state": "published"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-abcd-1234-efgh"), State: String("published")}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "GetRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SecurityAdvisoryRequest{
+		Summary:     String("s"),
+		Description: String("d"),
+		Vulnerabilities: []*AdvisoryVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("a-package")},
+			VulnerableVersionRange: String("< 1.0.0"),
+			PatchedVersions:        String("1.0.0"),
+		}},
+		Credits:          []*AdvisoryCredit{{Login: String("c"), Type: String("reporter")}},
+		Severity:         String("high"),
+		StartPrivateFork: Bool(true),
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"summary":"s","description":"d","vulnerabilities":[{"package":{"ecosystem":"npm","name":"a-package"},"vulnerable_version_range":"< 1.0.0","patched_versions":"1.0.0"}],"credits":[{"login":"c","type":"reporter"}],"severity":"high","start_private_fork":true}`+"\n")
+		fmt.Fprint(w, `{"ghsa_id": "GHSA-abcd-1234-efgh", "state": "draft"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-abcd-1234-efgh"), State: String("draft")}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "CreateRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_UpdateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SecurityAdvisoryRequest{
+		State:              String("published"),
+		CollaboratingUsers: []string{"u"},
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-abcd-1234-efgh", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"published","collaborating_users":["u"]}`+"\n")
+		fmt.Fprint(w, `{"ghsa_id": "GHSA-abcd-1234-efgh", "state": "published"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-abcd-1234-efgh", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-abcd-1234-efgh"), State: String("published")}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "UpdateRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "\n", "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-abcd-1234-efgh", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_RequestCVE(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-abcd-1234-efgh/cve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.SecurityAdvisories.RequestCVE(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.RequestCVE returned error: %v", err)
+	}
+
+	const methodName = "RequestCVE"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.SecurityAdvisories.RequestCVE(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.SecurityAdvisories.RequestCVE(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateTemporaryPrivateFork(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-abcd-1234-efgh/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 1, "full_name": "o/r-ghsa-abcd-1234-efgh", "private": true}`)
+	})
+
+	ctx := context.Background()
+	fork, _, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned error: %v", err)
+	}
+
+	want := &Repository{ID: Int64(1), FullName: String("o/r-ghsa-abcd-1234-efgh"), Private: Bool(true)}
+	if !cmp.Equal(fork, want) {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned %+v, want %+v", fork, want)
+	}
+
+	const methodName = "CreateTemporaryPrivateFork"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateTemporaryPrivateFork_deferred(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-abcd-1234-efgh/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		// This response indicates the fork will happen asynchronously.
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	ctx := context.Background()
+	fork, _, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &Repository{ID: Int64(1)}
+	if !cmp.Equal(fork, want) {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned %+v, want %+v", fork, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_ListGlobalAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ecosystem":       "npm",
+			"cwes":            "79,284",
+			"is_withdrawn":    "false",
+			"affects":         "lodash@4.17.20",
+			"epss_percentage": ">=0.5",
+			"after":           "Y3Vyc29y",
+		})
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"ghsa_id": "GHSA-xoxo-1234-xoxo",
+			"cve_id": "CVE-xoxo-1234",
+			"url": "https://api.github.com/advisories/GHSA-xoxo-1234-xoxo",
+			"type": "reviewed",
+			"severity": "high",
+			"source_code_location": "https://github.com/project/a-package",
+			"identifiers": [{"type": "GHSA", "value": "GHSA-xoxo-1234-xoxo"}],
+			"references": ["https://nvd.nist.gov/vuln/detail/CVE-xoxo-1234"],
+			"published_at": "1996-06-20T00:00:00Z",
+			"github_reviewed_at": "1996-06-20T00:00:00Z",
+			"vulnerabilities": [{
+				"package": {"ecosystem": "npm", "name": "a-package"},
+				"first_patched_version": "1.0.3",
+				"vulnerable_version_range": "<=1.0.2",
+				"vulnerable_functions": ["a_function"]
+			}],
+			"cvss": {"vector_string": "CVSS:3.1/AV:N", "score": 7.6},
+			"cvss_severities": {"cvss_v4": {"vector_string": "CVSS:4.0/AV:N", "score": 9.3}},
+			"cwes": [{"cwe_id": "CWE-400", "name": "Uncontrolled Resource Consumption"}],
+			"epss": {"percentage": 0.5, "percentile": 0.9},
+			"credits": [{"user": {"login": "user"}, "type": "analyst"}]
+		}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListGlobalAdvisoriesOptions{
+		Ecosystem:         "npm",
+		CWEs:              []string{"79", "284"},
+		IsWithdrawn:       Bool(false),
+		Affects:           []string{"lodash@4.17.20"},
+		EPSSPercentage:    ">=0.5",
+		ListCursorOptions: ListCursorOptions{After: "Y3Vyc29y"},
+	}
+	advisories, _, err := client.SecurityAdvisories.ListGlobalAdvisories(ctx, opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListGlobalAdvisories returned error: %v", err)
+	}
+
+	date := Timestamp{time.Date(1996, time.June, 20, 0, 0, 0, 0, time.UTC)}
+	want := []*GlobalSecurityAdvisory{{
+		ID: Int64(1),
+		SecurityAdvisory: SecurityAdvisory{
+			GHSAID:      String("GHSA-xoxo-1234-xoxo"),
+			CVEID:       String("CVE-xoxo-1234"),
+			URL:         String("https://api.github.com/advisories/GHSA-xoxo-1234-xoxo"),
+			Severity:    String("high"),
+			Identifiers: []*AdvisoryIdentifier{{Type: String("GHSA"), Value: String("GHSA-xoxo-1234-xoxo")}},
+			PublishedAt: &date,
+			CVSs:        &AdvisoryCVSs{VectorString: String("CVSS:3.1/AV:N"), Score: Float64(7.6)},
+			CVSSSeverities: &AdvisoryCVSSSeverities{
+				CVSSV4: &AdvisoryCVSs{VectorString: String("CVSS:4.0/AV:N"), Score: Float64(9.3)},
+			},
+			CWEs: []*AdvisoryCWEs{{CWEID: String("CWE-400"), Name: String("Uncontrolled Resource Consumption")}},
+			EPSS: &AdvisoryEPSS{Percentage: 0.5, Percentile: 0.9},
+		},
+		Type:               String("reviewed"),
+		SourceCodeLocation: String("https://github.com/project/a-package"),
+		References:         []string{"https://nvd.nist.gov/vuln/detail/CVE-xoxo-1234"},
+		GithubReviewedAt:   &date,
+		Vulnerabilities: []*GlobalSecurityVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("a-package")},
+			FirstPatchedVersion:    String("1.0.3"),
+			VulnerableVersionRange: String("<=1.0.2"),
+			VulnerableFunctions:    []string{"a_function"},
+		}},
+		Credits: []*AdvisoryCreditDetailed{{User: &User{Login: String("user")}, Type: String("analyst")}},
+	}}
+	if !cmp.Equal(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListGlobalAdvisories returned %+v, want %+v", advisories, want)
+	}
+
+	const methodName = "ListGlobalAdvisories"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.ListGlobalAdvisories(ctx, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_GetGlobalAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories/GHSA-xoxo-1234-xoxo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "ghsa_id": "GHSA-xoxo-1234-xoxo", "type": "malware"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetGlobalAdvisory(ctx, "GHSA-xoxo-1234-xoxo")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetGlobalAdvisory returned error: %v", err)
+	}
+
+	want := &GlobalSecurityAdvisory{
+		ID:               Int64(1),
+		SecurityAdvisory: SecurityAdvisory{GHSAID: String("GHSA-xoxo-1234-xoxo")},
+		Type:             String("malware"),
+	}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetGlobalAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "GetGlobalAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.GetGlobalAdvisory(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.GetGlobalAdvisory(ctx, "GHSA-xoxo-1234-xoxo")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
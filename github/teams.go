@@ -460,6 +460,7 @@ type TeamAddTeamRepoOptions struct {
 	//     admin - team members can pull, push and administer this repository
 	//     maintain - team members can manage the repository without access to sensitive or destructive actions.
 	//     triage - team members can proactively manage issues and pull requests without write access.
+	// The name of a custom repository role defined by the organization may also be used.
 	//
 	// If not specified, the team's permission attribute will be used.
 	Permission string `json:"permission,omitempty"`
@@ -750,10 +751,19 @@ type IDPGroup struct {
 	GroupDescription *string `json:"group_description,omitempty"`
 }
 
+// ListIDPGroupsOptions specifies the optional parameters to the
+// TeamsService.ListIDPGroupsInOrganization method.
+type ListIDPGroupsOptions struct {
+	// Query filters the results to the groups whose names begin with it.
+	Query string `url:"q,omitempty"`
+
+	ListCursorOptions
+}
+
 // ListIDPGroupsInOrganization lists IDP groups available in an organization.
 //
 // GitHub API docs: https://docs.github.com/en/rest/teams/team-sync#list-idp-groups-for-an-organization
-func (s *TeamsService) ListIDPGroupsInOrganization(ctx context.Context, org string, opts *ListCursorOptions) (*IDPGroupList, *Response, error) {
+func (s *TeamsService) ListIDPGroupsInOrganization(ctx context.Context, org string, opts *ListIDPGroupsOptions) (*IDPGroupList, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/team-sync/groups", org)
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -977,6 +987,15 @@ func (s *TeamsService) UpdateConnectedExternalGroup(ctx context.Context, org, sl
 	return externalGroup, resp, nil
 }
 
+// ConnectExternalGroup connects the external group with the given ID to a team,
+// replacing any external group the team was previously connected to.
+// It is a shorthand for UpdateConnectedExternalGroup.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/teams/external-groups#update-the-connection-between-an-external-group-and-a-team
+func (s *TeamsService) ConnectExternalGroup(ctx context.Context, org, slug string, groupID int64) (*ExternalGroup, *Response, error) {
+	return s.UpdateConnectedExternalGroup(ctx, org, slug, &ExternalGroup{GroupID: &groupID})
+}
+
 // RemoveConnectedExternalGroup removes the connection between an external group and a team.
 //
 // GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/teams/external-groups#remove-the-connection-between-an-external-group-and-a-team
@@ -1302,11 +1302,15 @@ func TestTeamsService_ListIDPGroupsInOrganization(t *testing.T) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{
 			"page": "url-encoded-next-page-token",
+			"q":    "n",
 		})
 		fmt.Fprint(w, `{"groups": [{"group_id": "1",  "group_name": "n", "group_description": "d"}]}`)
 	})
 
-	opt := &ListCursorOptions{Page: "url-encoded-next-page-token"}
+	opt := &ListIDPGroupsOptions{
+		Query:             "n",
+		ListCursorOptions: ListCursorOptions{Page: "url-encoded-next-page-token"},
+	}
 	ctx := context.Background()
 	groups, _, err := client.Teams.ListIDPGroupsInOrganization(ctx, "o", opt)
 	if err != nil {
@@ -2127,6 +2131,42 @@ func TestTeamsService_UpdateConnectedExternalGroup_notFound(t *testing.T) {
 	}
 }
 
+func TestTeamsService_ConnectExternalGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/teams/t/external-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"group_id":123}`+"\n")
+		fmt.Fprint(w, `{"group_id":123,"group_name":"Octocat admins"}`)
+	})
+
+	ctx := context.Background()
+	externalGroup, _, err := client.Teams.ConnectExternalGroup(ctx, "o", "t", 123)
+	if err != nil {
+		t.Errorf("Teams.ConnectExternalGroup returned error: %v", err)
+	}
+
+	want := &ExternalGroup{GroupID: Int64(123), GroupName: String("Octocat admins")}
+	if !cmp.Equal(externalGroup, want) {
+		t.Errorf("Teams.ConnectExternalGroup returned %+v, want %+v", externalGroup, want)
+	}
+
+	const methodName = "ConnectExternalGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Teams.ConnectExternalGroup(ctx, "\n", "\n", 123)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Teams.ConnectExternalGroup(ctx, "o", "t", 123)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestTeamsService_RemoveConnectedExternalGroup(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
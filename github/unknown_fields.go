@@ -0,0 +1,158 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFieldsFunc is called with the fields of a JSON document that have
+// no corresponding field in the value v it was decoded into, and were
+// therefore dropped. Fields are given as dotted paths from the root of the
+// document, such as "head.repo.new_field", with "[]" standing for the
+// elements of an array.
+//
+// It lets applications detect fields GitHub added to the API that the
+// version of this package they use does not model yet.
+type UnknownFieldsFunc func(v interface{}, fields []string)
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// reportUnknownFields calls fn with the fields of data that are unknown to
+// v, if there are any. Fields within values that have their own JSON
+// decoding, or that are decoded into interface values, are not checked.
+func reportUnknownFields(data []byte, v interface{}, fn UnknownFieldsFunc) error {
+	if fn == nil {
+		return nil
+	}
+
+	var doc interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return err
+	}
+
+	unknown := map[string]bool{}
+	collectUnknownFields(doc, reflect.TypeOf(v), "", unknown)
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(unknown))
+	for field := range unknown {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	fn(v, fields)
+	return nil
+}
+
+func collectUnknownFields(doc interface{}, t reflect.Type, path string, unknown map[string]bool) {
+	if t == nil {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Interface || reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	switch doc := doc.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for name, value := range doc {
+				ft, ok := lookupJSONField(fields, name)
+				if !ok {
+					unknown[joinFieldPath(path, name)] = true
+					continue
+				}
+				collectUnknownFields(value, ft, joinFieldPath(path, name), unknown)
+			}
+		case reflect.Map:
+			for name, value := range doc {
+				collectUnknownFields(value, t.Elem(), joinFieldPath(path, name), unknown)
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, value := range doc {
+				collectUnknownFields(value, t.Elem(), path+"[]", unknown)
+			}
+		}
+	}
+}
+
+// jsonFields returns the types of the fields of struct type t by their JSON
+// name, including the fields promoted from embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue // unexported
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+
+	// Fields of the outer struct take precedence over promoted fields.
+	for _, et := range embedded {
+		for name, ft := range jsonFields(et) {
+			if _, ok := fields[name]; !ok {
+				fields[name] = ft
+			}
+		}
+	}
+	return fields
+}
+
+// lookupJSONField finds the field for the JSON key name the way
+// encoding/json does, preferring an exact match over a case-insensitive one.
+func lookupJSONField(fields map[string]reflect.Type, name string) (reflect.Type, bool) {
+	if ft, ok := fields[name]; ok {
+		return ft, true
+	}
+	for field, ft := range fields {
+		if strings.EqualFold(field, name) {
+			return ft, true
+		}
+	}
+	return nil, false
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReportUnknownFields(t *testing.T) {
+	type inner struct {
+		Name string `json:"name"`
+	}
+	type Embedded struct {
+		Extra string `json:"extra"`
+	}
+	type outer struct {
+		Embedded
+		ID      int64            `json:"id"`
+		Inner   *inner           `json:"inner"`
+		Items   []*inner         `json:"items"`
+		ByKey   map[string]inner `json:"by_key"`
+		Any     interface{}      `json:"any"`
+		Raw     json.RawMessage  `json:"raw"`
+		Time    *Timestamp       `json:"time"`
+		Ignored string           `json:"-"`
+		Untag   string
+		Params  map[string]interface{} `json:"params"`
+	}
+
+	tests := []struct {
+		data string
+		want []string
+	}{
+		{data: `{"id":1,"inner":{"name":"n"}}`},
+		{data: `{"ID":1,"untag":"u","extra":"e"}`},
+		{data: `{"any":{"x":1},"raw":{"x":1},"time":"2006-01-02T15:04:05Z","params":{"x":1}}`},
+		{data: `{"id":1,"inner":null,"items":null}`},
+		{
+			data: `{"new":1,"Ignored":"i","inner":{"name":"n","new":2}}`,
+			want: []string{"Ignored", "inner.new", "new"},
+		},
+		{
+			data: `{"items":[{"new":1},{"other":2},{"new":3}],"by_key":{"k":{"new":4}}}`,
+			want: []string{"by_key.k.new", "items[].new", "items[].other"},
+		},
+	}
+
+	for _, tt := range tests {
+		var v outer
+		if err := json.Unmarshal([]byte(tt.data), &v); err != nil {
+			t.Fatalf("Unmarshal(%v) returned error: %v", tt.data, err)
+		}
+
+		var got []string
+		err := reportUnknownFields([]byte(tt.data), &v, func(gotV interface{}, fields []string) {
+			if gotV != &v {
+				t.Errorf("UnknownFieldsFunc called with %v, want %v", gotV, &v)
+			}
+			got = fields
+		})
+		if err != nil {
+			t.Errorf("reportUnknownFields(%v) returned error: %v", tt.data, err)
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("reportUnknownFields(%v) reported %v, want %v", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestReportUnknownFields_nilFunc(t *testing.T) {
+	if err := reportUnknownFields([]byte(`invalid`), new(struct{}), nil); err != nil {
+		t.Errorf("reportUnknownFields returned error: %v", err)
+	}
+}
+
+func TestReportUnknownFields_invalidJSON(t *testing.T) {
+	err := reportUnknownFields([]byte(`{`), new(struct{}), func(interface{}, []string) {
+		t.Error("UnknownFieldsFunc called for invalid JSON")
+	})
+	if err == nil {
+		t.Error("reportUnknownFields returned nil error for invalid JSON")
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListAttestations lists the artifact attestations of a user for the
+// artifact with the given digest, formatted as "algorithm:hex", for example
+// "sha256:…". See Attestation.Verify to check them.
+//
+// GitHub API docs: https://docs.github.com/en/rest/users/attestations#list-attestations
+func (s *UsersService) ListAttestations(ctx context.Context, user, subjectDigest string, opts *ListAttestationsOptions) (*AttestationsResponse, *Response, error) {
+	u := fmt.Sprintf("users/%v", user)
+	return s.client.listAttestations(ctx, u, subjectDigest, opts)
+}
@@ -0,0 +1,186 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"strings"
+)
+
+// UserIterator iterates over a list of users, fetching it page by page. It
+// is created by UsersService.ListFollowersAll or UsersService.ListFollowingAll.
+//
+// Pages are fetched only as needed, so lists of hundreds of thousands of
+// users can be processed without holding them in memory. Requests rejected
+// by the secondary rate limit are retried.
+//
+//	it := client.Users.ListFollowersAll("octocat", nil)
+//	for it.Next(ctx) {
+//		process(it.User())
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type UserIterator struct {
+	// MinRateRemaining pauses until the rate limit resets whenever fewer
+	// than this many requests remain after fetching a page. Default is 100.
+	MinRateRemaining int
+
+	fetch func(ctx context.Context, opts *ListOptions) ([]*User, *Response, error)
+	opts  ListOptions
+	pacer requestPacer
+
+	fetched bool
+	page    []*User
+	idx     int
+
+	user *User
+	resp *Response
+	err  error
+}
+
+func newUserIterator(opts *ListOptions, fetch func(context.Context, *ListOptions) ([]*User, *Response, error)) *UserIterator {
+	it := &UserIterator{fetch: fetch}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.PerPage == 0 {
+		it.opts.PerPage = 100
+	}
+	return it
+}
+
+// ListFollowersAll returns an iterator over the followers of a user. Passing
+// the empty string iterates over the followers of the authenticated user.
+// If opts.Page is set, iteration starts at that page.
+func (s *UsersService) ListFollowersAll(user string, opts *ListOptions) *UserIterator {
+	return newUserIterator(opts, func(ctx context.Context, opts *ListOptions) ([]*User, *Response, error) {
+		return s.ListFollowers(ctx, user, opts)
+	})
+}
+
+// ListFollowingAll returns an iterator over the people a user is following.
+// Passing the empty string iterates over the people the authenticated user
+// is following. If opts.Page is set, iteration starts at that page.
+func (s *UsersService) ListFollowingAll(user string, opts *ListOptions) *UserIterator {
+	return newUserIterator(opts, func(ctx context.Context, opts *ListOptions) ([]*User, *Response, error) {
+		return s.ListFollowing(ctx, user, opts)
+	})
+}
+
+// Next advances the iterator to the next user, fetching pages as needed.
+// It returns false when there are no more users or an error occurred.
+func (it *UserIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.idx >= len(it.page) {
+		if it.fetched {
+			if it.resp == nil || it.resp.NextPage == 0 {
+				it.user = nil
+				return false
+			}
+			it.opts.Page = it.resp.NextPage
+		}
+
+		minRemaining := it.MinRateRemaining
+		if minRemaining <= 0 {
+			minRemaining = defaultMinRateRemaining
+		}
+		var page []*User
+		err := pacedDo(ctx, &it.pacer, minRemaining, func() (*Response, error) {
+			var resp *Response
+			var err error
+			page, resp, err = it.fetch(ctx, &it.opts)
+			it.resp = resp
+			return resp, err
+		})
+		if err != nil {
+			it.err = err
+			it.user = nil
+			return false
+		}
+		it.fetched = true
+		it.page, it.idx = page, 0
+	}
+
+	it.user = it.page[it.idx]
+	it.idx++
+	return true
+}
+
+// User returns the user the iterator is positioned at.
+func (it *UserIterator) User() *User {
+	return it.user
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *UserIterator) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last API call made.
+func (it *UserIterator) Response() *Response {
+	return it.resp
+}
+
+// FollowRelations partitions the followers and followed users of a user,
+// by login.
+type FollowRelations struct {
+	// Mutual lists the users that the user follows and that follow the user back.
+	Mutual []string
+	// FollowersOnly lists the followers of the user that the user does not follow.
+	FollowersOnly []string
+	// FollowingOnly lists the users the user follows that do not follow the user back.
+	FollowingOnly []string
+}
+
+// GetFollowRelations computes which of the users followed by user follow
+// back, and which followers are not followed. Passing the empty string
+// computes them for the authenticated user.
+//
+// Only logins are kept in memory, but all of them are: those of the
+// followed users, and those of the followers as they are sorted into Mutual
+// and FollowersOnly. Both lists are fetched once, pausing whenever fewer
+// than 100 requests remain in the rate limit.
+func (s *UsersService) GetFollowRelations(ctx context.Context, user string) (*FollowRelations, *Response, error) {
+	following := s.ListFollowingAll(user, nil)
+	var order []string
+	followed := make(map[string]bool)
+	for following.Next(ctx) {
+		login := following.User().GetLogin()
+		order = append(order, login)
+		followed[strings.ToLower(login)] = false
+	}
+	if err := following.Err(); err != nil {
+		return nil, following.Response(), err
+	}
+
+	relations := &FollowRelations{}
+	followers := s.ListFollowersAll(user, nil)
+	for followers.Next(ctx) {
+		login := followers.User().GetLogin()
+		key := strings.ToLower(login)
+		if _, ok := followed[key]; ok {
+			followed[key] = true
+			relations.Mutual = append(relations.Mutual, login)
+		} else {
+			relations.FollowersOnly = append(relations.FollowersOnly, login)
+		}
+	}
+	if err := followers.Err(); err != nil {
+		return nil, followers.Response(), err
+	}
+
+	for _, login := range order {
+		if !followed[strings.ToLower(login)] {
+			relations.FollowingOnly = append(relations.FollowingOnly, login)
+		}
+	}
+
+	return relations, followers.Response(), nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUsersService_ListFollowersAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/followers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/users/u/followers?page=2&per_page=100>; rel="next"`)
+			fmt.Fprint(w, `[{"login":"a"},{"login":"b"}]`)
+		case "2":
+			testFormValues(t, r, values{"page": "2", "per_page": "100"})
+			fmt.Fprint(w, `[{"login":"c"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Users.ListFollowersAll("u", nil)
+	var logins []string
+	for it.Next(ctx) {
+		logins = append(logins, it.User().GetLogin())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("UserIterator returned error: %v", err)
+	}
+
+	if want := []string{"a", "b", "c"}; !cmp.Equal(logins, want) {
+		t.Errorf("UserIterator returned %v, want %v", logins, want)
+	}
+	if it.Next(ctx) {
+		t.Error("UserIterator.Next returned true after the end of the list")
+	}
+	if it.User() != nil {
+		t.Errorf("UserIterator.User = %+v after the end of the list, want nil", it.User())
+	}
+}
+
+func TestUsersService_ListFollowingAll_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/following", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	it := client.Users.ListFollowingAll("", nil)
+	if it.Next(ctx) {
+		t.Fatal("UserIterator.Next returned true, want false")
+	}
+	if it.Err() == nil {
+		t.Error("UserIterator.Err returned nil, want error")
+	}
+	if it.Response() == nil || it.Response().StatusCode != http.StatusInternalServerError {
+		t.Errorf("UserIterator.Response = %+v, want a 500 response", it.Response())
+	}
+
+	const methodName = "ListFollowingAll"
+	testBadOptions(t, methodName, func() (err error) {
+		it := client.Users.ListFollowingAll("\n", nil)
+		it.Next(ctx)
+		return it.Err()
+	})
+}
+
+func TestUsersService_GetFollowRelations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/following", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"login":"Mutual"},{"login":"idol"}]`)
+	})
+	mux.HandleFunc("/users/u/followers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"login":"fan"},{"login":"mutual"}]`)
+	})
+
+	ctx := context.Background()
+	relations, _, err := client.Users.GetFollowRelations(ctx, "u")
+	if err != nil {
+		t.Fatalf("Users.GetFollowRelations returned error: %v", err)
+	}
+
+	want := &FollowRelations{
+		Mutual:        []string{"mutual"},
+		FollowersOnly: []string{"fan"},
+		FollowingOnly: []string{"idol"},
+	}
+	if !cmp.Equal(relations, want) {
+		t.Errorf("Users.GetFollowRelations returned %+v, want %+v", relations, want)
+	}
+
+	const methodName = "GetFollowRelations"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.GetFollowRelations(ctx, "\n")
+		return err
+	})
+}
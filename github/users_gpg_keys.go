@@ -15,6 +15,7 @@ import (
 // https://developer.github.com/changes/2016-04-04-git-signing-api-preview/
 type GPGKey struct {
 	ID                *int64      `json:"id,omitempty"`
+	Name              *string     `json:"name,omitempty"`
 	PrimaryKeyID      *int64      `json:"primary_key_id,omitempty"`
 	KeyID             *string     `json:"key_id,omitempty"`
 	RawKey            *string     `json:"raw_key,omitempty"`
@@ -27,6 +28,7 @@ type GPGKey struct {
 	CanCertify        *bool       `json:"can_certify,omitempty"`
 	CreatedAt         *Timestamp  `json:"created_at,omitempty"`
 	ExpiresAt         *Timestamp  `json:"expires_at,omitempty"`
+	Revoked           *bool       `json:"revoked,omitempty"`
 }
 
 // String stringifies a GPGKey.
@@ -0,0 +1,99 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+// HasKeyID reports whether the key or one of its subkeys has the given key
+// ID. keyID is compared ignoring case, and may also be a full fingerprint,
+// in which case its last 16 hex digits are compared.
+func (k *GPGKey) HasKeyID(keyID string) bool {
+	if len(keyID) > 16 {
+		keyID = keyID[len(keyID)-16:]
+	}
+	if keyID == "" {
+		return false
+	}
+	if strings.EqualFold(k.GetKeyID(), keyID) {
+		return true
+	}
+	for _, sub := range k.Subkeys {
+		if strings.EqualFold(sub.GetKeyID(), keyID) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindGPGKey returns the GPG key of a user that has the given key ID, or
+// one of whose subkeys has it, such as the ID returned by
+// SignatureVerification.GPGKeyID for a commit signature. Passing an empty
+// username string searches the keys of the authenticated user. A nil key
+// is returned if the user has no such key.
+func (s *UsersService) FindGPGKey(ctx context.Context, user, keyID string) (*GPGKey, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	for {
+		keys, resp, err := s.ListGPGKeys(ctx, user, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, key := range keys {
+			if key.HasKeyID(keyID) {
+				return key, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// errNoGPGIssuer is returned when a signature does not name the key that made it.
+var errNoGPGIssuer = errors.New("signature has no issuer")
+
+// GPGKeyID returns the ID of the key that made the signature, as 16
+// uppercase hex digits like GPGKey.KeyID. It returns an error if the
+// signature is not an ASCII armored OpenPGP signature naming its issuer.
+func (v *SignatureVerification) GPGKeyID() (string, error) {
+	sig, err := readGPGSignature(v.GetSignature())
+	if err != nil {
+		return "", err
+	}
+	if sig.IssuerKeyId == nil {
+		return "", errNoGPGIssuer
+	}
+	return fmt.Sprintf("%016X", *sig.IssuerKeyId), nil
+}
+
+// readGPGSignature decodes an ASCII armored OpenPGP signature.
+func readGPGSignature(armored string) (*packet.Signature, error) {
+	block, err := armor.Decode(strings.NewReader(armored))
+	if err != nil {
+		return nil, fmt.Errorf("decoding signature: %w", err)
+	}
+	if block.Type != openpgp.SignatureType {
+		return nil, fmt.Errorf("unexpected armor type %q", block.Type)
+	}
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading signature: %w", err)
+	}
+	sig, ok := p.(*packet.Signature)
+	if !ok {
+		return nil, fmt.Errorf("unexpected packet of type %T", p)
+	}
+	return sig, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// testGPGSignature was made with the subkey 8C25882BAFCFB831 of the key 148CA3D96582B60F.
+const testGPGSignature = `-----BEGIN PGP SIGNATURE-----
+
+iHUEABYIAB0WIQTTspg3nLdoI1vyjB6MJYgrr8+4MQUCatNCQwAKCRCMJYgrr8+4
+McXlAQDA7JS/I2+hj8eiZ6QkaMwrF5/kEcJra0PsPyCb1K3WhgD/fjB14iYK16iz
+pKmf3rdiit9enP9c4xlZ+Ja/TSdrkgg=
+=ifsN
+-----END PGP SIGNATURE-----
+`
+
+func TestSignatureVerification_GPGKeyID(t *testing.T) {
+	v := &SignatureVerification{Signature: String(testGPGSignature)}
+	got, err := v.GPGKeyID()
+	if err != nil {
+		t.Fatalf("GPGKeyID returned error: %v", err)
+	}
+	if want := "8C25882BAFCFB831"; got != want {
+		t.Errorf("GPGKeyID = %q, want %q", got, want)
+	}
+}
+
+func TestSignatureVerification_GPGKeyID_fingerprint(t *testing.T) {
+	fingerprint, _ := hex.DecodeString("D3B298379CB768235BF28C1E8C25882BAFCFB831")
+	subpacket := append([]byte{5, 2, 0x6a, 0xd3, 0x42, 0x43, 22, 33, 4}, fingerprint...)
+	body := append([]byte{4, 0, 22, 8, 0, byte(len(subpacket))}, subpacket...)
+	body = append(body, 0, 0, 0, 0, 0, 1, 1, 0, 1, 1)
+	packet := append([]byte{0xc2, byte(len(body))}, body...)
+	armored := "-----BEGIN PGP SIGNATURE-----\n\n" + base64.StdEncoding.EncodeToString(packet) + "\n-----END PGP SIGNATURE-----\n"
+
+	v := &SignatureVerification{Signature: String(armored)}
+	got, err := v.GPGKeyID()
+	if err != nil {
+		t.Fatalf("GPGKeyID returned error: %v", err)
+	}
+	if want := "8C25882BAFCFB831"; got != want {
+		t.Errorf("GPGKeyID = %q, want %q", got, want)
+	}
+}
+
+func TestSignatureVerification_GPGKeyID_invalid(t *testing.T) {
+	for _, sig := range []string{
+		"",
+		"-----BEGIN SSH SIGNATURE-----\nU1NIU0lH\n-----END SSH SIGNATURE-----\n",
+		"-----BEGIN PGP SIGNATURE-----\n\n!!!\n-----END PGP SIGNATURE-----\n",
+		"-----BEGIN PGP SIGNATURE-----\n\niHUEABYIAB0WIQTT\n",
+		// A literal data packet.
+		"-----BEGIN PGP SIGNATURE-----\n\nywA=\n-----END PGP SIGNATURE-----\n",
+		// A signature packet without issuer.
+		"-----BEGIN PGP SIGNATURE-----\n\nwggEABYIAAAAAA==\n-----END PGP SIGNATURE-----\n",
+	} {
+		v := &SignatureVerification{Signature: String(sig)}
+		if id, err := v.GPGKeyID(); err == nil {
+			t.Errorf("GPGKeyID(%q) = %q, want error", sig, id)
+		}
+	}
+}
+
+func TestGPGKey_HasKeyID(t *testing.T) {
+	key := &GPGKey{
+		KeyID:   String("148CA3D96582B60F"),
+		Subkeys: []*GPGKey{{KeyID: String("8C25882BAFCFB831")}},
+	}
+
+	tests := []struct {
+		keyID string
+		want  bool
+	}{
+		{"148CA3D96582B60F", true},
+		{"8c25882bafcfb831", true},
+		{"D3B298379CB768235BF28C1E8C25882BAFCFB831", true},
+		{"0000000000000000", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := key.HasKeyID(tt.keyID); got != tt.want {
+			t.Errorf("HasKeyID(%q) = %v, want %v", tt.keyID, got, tt.want)
+		}
+	}
+}
+
+func TestUsersService_FindGPGKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `[
+			{"id":1,"key_id":"0123456789ABCDEF"},
+			{"id":2,"key_id":"148CA3D96582B60F","subkeys":[{"id":3,"key_id":"8C25882BAFCFB831"}]}
+		]`)
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Users.FindGPGKey(ctx, "u", "8C25882BAFCFB831")
+	if err != nil {
+		t.Errorf("Users.FindGPGKey returned error: %v", err)
+	}
+	if want := int64(2); key.GetID() != want {
+		t.Errorf("Users.FindGPGKey returned key %v, want %v", key.GetID(), want)
+	}
+
+	key, _, err = client.Users.FindGPGKey(ctx, "u", "FFFFFFFFFFFFFFFF")
+	if err != nil {
+		t.Errorf("Users.FindGPGKey returned error: %v", err)
+	}
+	if key != nil {
+		t.Errorf("Users.FindGPGKey returned %+v, want nil", key)
+	}
+
+	const methodName = "FindGPGKey"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.FindGPGKey(ctx, "\n", "8C25882BAFCFB831")
+		return err
+	})
+}
@@ -213,6 +213,7 @@ func TestGPGKey_Marshal(t *testing.T) {
 
 	g := &GPGKey{
 		ID:           Int64(1),
+		Name:         String("laptop"),
 		PrimaryKeyID: Int64(1),
 		KeyID:        String("someKeyID"),
 		RawKey:       String("someRawKeyID"),
@@ -232,10 +233,12 @@ func TestGPGKey_Marshal(t *testing.T) {
 		CanCertify:        Bool(true),
 		CreatedAt:         ti,
 		ExpiresAt:         ti,
+		Revoked:           Bool(false),
 	}
 
 	want := `{
 			"id":1,
+			"name":"laptop",
 			"primary_key_id":1,
 			"key_id":"someKeyID",
 			"raw_key":"someRawKeyID",
@@ -254,7 +257,8 @@ func TestGPGKey_Marshal(t *testing.T) {
 			"can_encrypt_storage":true,
 			"can_certify":true,
 			"created_at":"0001-01-01T00:00:00Z",
-			"expires_at":"0001-01-01T00:00:00Z"
+			"expires_at":"0001-01-01T00:00:00Z",
+			"revoked":false
 		}`
 
 	testJSONMarshal(t, g, want)
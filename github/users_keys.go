@@ -11,6 +11,8 @@ import (
 )
 
 // Key represents a public SSH key used to authenticate a user or deploy script.
+//
+// ReadOnly, AddedBy, LastUsed, and Enabled are only set for deploy keys.
 type Key struct {
 	ID        *int64     `json:"id,omitempty"`
 	Key       *string    `json:"key,omitempty"`
@@ -21,6 +23,9 @@ type Key struct {
 	CreatedAt *Timestamp `json:"created_at,omitempty"`
 	AddedBy   *string    `json:"added_by,omitempty"`
 	LastUsed  *Timestamp `json:"last_used,omitempty"`
+	// Enabled is false when deploy keys are disabled by an organization
+	// or enterprise policy. Disabled keys cannot be used.
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 func (k Key) String() string {
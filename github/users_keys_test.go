@@ -192,6 +192,9 @@ func TestKey_Marshal(t *testing.T) {
 		ReadOnly:  Bool(true),
 		Verified:  Bool(true),
 		CreatedAt: &Timestamp{referenceTime},
+		AddedBy:   String("u"),
+		LastUsed:  &Timestamp{referenceTime},
+		Enabled:   Bool(true),
 	}
 
 	want := `{
@@ -201,7 +204,10 @@ func TestKey_Marshal(t *testing.T) {
 		"title": "title",
 		"read_only": true,
 		"verified": true,
-		"created_at": ` + referenceTimeStr + `
+		"created_at": ` + referenceTimeStr + `,
+		"added_by": "u",
+		"last_used": ` + referenceTimeStr + `,
+		"enabled": true
 	}`
 
 	testJSONMarshal(t, u, want)
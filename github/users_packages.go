@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // List the packages for a user. Passing the empty string for "user" will
@@ -49,9 +50,9 @@ func (s *UsersService) ListPackages(ctx context.Context, user string, opts *Pack
 func (s *UsersService) GetPackage(ctx context.Context, user, packageType, packageName string) (*Package, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v", user, packageType, packageName)
+		u = fmt.Sprintf("users/%v/packages/%v/%v", user, packageType, url.PathEscape(packageName))
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v", packageType, packageName)
+		u = fmt.Sprintf("user/packages/%v/%v", packageType, url.PathEscape(packageName))
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -76,9 +77,9 @@ func (s *UsersService) GetPackage(ctx context.Context, user, packageType, packag
 func (s *UsersService) DeletePackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v", user, packageType, packageName)
+		u = fmt.Sprintf("users/%v/packages/%v/%v", user, packageType, url.PathEscape(packageName))
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v", packageType, packageName)
+		u = fmt.Sprintf("user/packages/%v/%v", packageType, url.PathEscape(packageName))
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -97,9 +98,9 @@ func (s *UsersService) DeletePackage(ctx context.Context, user, packageType, pac
 func (s *UsersService) RestorePackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/restore", user, packageType, packageName)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/restore", user, packageType, url.PathEscape(packageName))
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/restore", packageType, packageName)
+		u = fmt.Sprintf("user/packages/%v/%v/restore", packageType, url.PathEscape(packageName))
 	}
 
 	req, err := s.client.NewRequest("POST", u, nil)
@@ -118,9 +119,9 @@ func (s *UsersService) RestorePackage(ctx context.Context, user, packageType, pa
 func (s *UsersService) PackageGetAllVersions(ctx context.Context, user, packageType, packageName string, opts *PackageListOptions) ([]*PackageVersion, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/versions", user, packageType, packageName)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/versions", user, packageType, url.PathEscape(packageName))
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/versions", packageType, packageName)
+		u = fmt.Sprintf("user/packages/%v/%v/versions", packageType, url.PathEscape(packageName))
 	}
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -149,9 +150,9 @@ func (s *UsersService) PackageGetAllVersions(ctx context.Context, user, packageT
 func (s *UsersService) PackageGetVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*PackageVersion, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v", user, packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v", user, packageType, url.PathEscape(packageName), packageVersionID)
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/versions/%v", packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("user/packages/%v/%v/versions/%v", packageType, url.PathEscape(packageName), packageVersionID)
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -176,9 +177,9 @@ func (s *UsersService) PackageGetVersion(ctx context.Context, user, packageType,
 func (s *UsersService) PackageDeleteVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v", user, packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v", user, packageType, url.PathEscape(packageName), packageVersionID)
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/versions/%v", packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("user/packages/%v/%v/versions/%v", packageType, url.PathEscape(packageName), packageVersionID)
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -197,9 +198,9 @@ func (s *UsersService) PackageDeleteVersion(ctx context.Context, user, packageTy
 func (s *UsersService) PackageRestoreVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v/restore", user, packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v/restore", user, packageType, url.PathEscape(packageName), packageVersionID)
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/versions/%v/restore", packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("user/packages/%v/%v/versions/%v/restore", packageType, url.PathEscape(packageName), packageVersionID)
 	}
 
 	req, err := s.client.NewRequest("POST", u, nil)
@@ -209,3 +210,35 @@ func (s *UsersService) PackageRestoreVersion(ctx context.Context, user, packageT
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// PackageGetVersionByTag gets the version of a container package of a user
+// with the given tag. Passing the empty string for "user" will get the
+// version for the authenticated user. It returns a nil version if no
+// version has the tag.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#get-all-package-versions-for-a-package-owned-by-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/rest/packages#get-all-package-versions-for-a-package-owned-by-a-user
+func (s *UsersService) PackageGetVersionByTag(ctx context.Context, user, packageName, tag string) (*PackageVersion, *Response, error) {
+	list := func(ctx context.Context, listOpts *PackageListOptions) ([]*PackageVersion, *Response, error) {
+		return s.PackageGetAllVersions(ctx, user, "container", packageName, listOpts)
+	}
+	return findPackageVersionByTag(ctx, list, tag)
+}
+
+// PackageDeleteUntaggedVersions deletes the untagged versions of a container
+// package of a user which were last updated before opts.Before, waiting
+// opts.Interval between deletions. Passing the empty string for "user" will
+// delete the versions for the authenticated user. It returns the deleted
+// versions, including when it fails part way.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#delete-a-package-version-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/rest/packages#delete-package-version-for-a-user
+func (s *UsersService) PackageDeleteUntaggedVersions(ctx context.Context, user, packageName string, opts *DeleteUntaggedVersionsOptions) ([]*PackageVersion, *Response, error) {
+	list := func(ctx context.Context, listOpts *PackageListOptions) ([]*PackageVersion, *Response, error) {
+		return s.PackageGetAllVersions(ctx, user, "container", packageName, listOpts)
+	}
+	del := func(ctx context.Context, id int64) (*Response, error) {
+		return s.PackageDeleteVersion(ctx, user, "container", packageName, id)
+	}
+	return deleteUntaggedPackageVersions(ctx, list, del, opts)
+}
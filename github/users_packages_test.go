@@ -705,3 +705,21 @@ func TestUsersService_specifiedUser_PackageRestoreVersion(t *testing.T) {
 		return client.Users.PackageRestoreVersion(ctx, "", "", "", 45763)
 	})
 }
+
+func TestUsersService_Authenticated_PackageDeleteVersion_nameWithSlash(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages/container/hello/hello_docker/versions/45763", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got, want := r.URL.EscapedPath(), "/user/packages/container/hello%2Fhello_docker/versions/45763"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+	})
+
+	ctx := context.Background()
+	_, err := client.Users.PackageDeleteVersion(ctx, "", "container", "hello/hello_docker", 45763)
+	if err != nil {
+		t.Errorf("Users.PackageDeleteVersion returned error: %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"golang.org/x/crypto/ssh"
+)
+
+// VerifySignature verifies the signature of a commit or tag offline, against
+// the GPG keys or SSH signing keys registered by user. v is the
+// verification of the commit or tag, as returned in Commit.Verification or
+// Tag.Verification, whose Signature and Payload are checked; its Verified
+// and Reason fields, as computed by GitHub, are ignored.
+//
+// It reports whether the signature was made by one of the keys of user,
+// checking the expiry and revocation of GPG keys as of the time the
+// signature was made. A signature that was not made by such a key, or that
+// does not match the payload, gives false and a nil error. An error is
+// returned if the signature is missing, malformed, or of a type other than
+// GPG or SSH, such as S/MIME.
+func (s *UsersService) VerifySignature(ctx context.Context, user string, v *SignatureVerification) (bool, *Response, error) {
+	signature, payload := v.GetSignature(), v.GetPayload()
+	switch {
+	case signature == "":
+		return false, nil, errors.New("signature verification has no signature")
+	case strings.HasPrefix(signature, "-----BEGIN PGP SIGNATURE-----"):
+		return s.verifyGPGSignature(ctx, user, signature, payload)
+	case strings.HasPrefix(signature, "-----BEGIN SSH SIGNATURE-----"):
+		return s.verifySSHSignature(ctx, user, signature, payload)
+	default:
+		return false, nil, errors.New("unsupported signature type")
+	}
+}
+
+func (s *UsersService) verifyGPGSignature(ctx context.Context, user, signature, payload string) (bool, *Response, error) {
+	sig, err := readGPGSignature(signature)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var keyring openpgp.EntityList
+	var resp *Response
+	opts := &ListOptions{PerPage: 100}
+	for {
+		keys, r, err := s.ListGPGKeys(ctx, user, opts)
+		resp = r
+		if err != nil {
+			return false, resp, err
+		}
+		for _, key := range keys {
+			if key.GetRevoked() || key.GetRawKey() == "" {
+				continue
+			}
+			entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key.GetRawKey()))
+			if err != nil {
+				continue // GitHub accepts keys this package cannot read.
+			}
+			keyring = append(keyring, entities...)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// Check the keys as of the time the signature was made, so that
+	// signatures made before a key expired still verify.
+	config := &packet.Config{Time: func() time.Time { return sig.CreationTime }}
+	_, err = openpgp.CheckArmoredDetachedSignature(keyring, strings.NewReader(payload), strings.NewReader(signature), config)
+	var sigErr pgperrors.SignatureError
+	switch {
+	case err == nil:
+		return true, resp, nil
+	case errors.Is(err, pgperrors.ErrUnknownIssuer), errors.As(err, &sigErr),
+		errors.Is(err, pgperrors.ErrKeyRevoked), errors.Is(err, pgperrors.ErrKeyExpired), errors.Is(err, pgperrors.ErrSignatureExpired):
+		return false, resp, nil
+	default:
+		return false, resp, err
+	}
+}
+
+// sshSignature is the SSHSIG signature format used by git, as specified in
+// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig.
+type sshSignature struct {
+	MagicPreamble [6]byte
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the data an SSHSIG signature is computed over.
+type sshSignedData struct {
+	MagicPreamble [6]byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+func (s *UsersService) verifySSHSignature(ctx context.Context, user, signature, payload string) (bool, *Response, error) {
+	block, _ := pem.Decode([]byte(signature))
+	if block == nil || block.Type != "SSH SIGNATURE" {
+		return false, nil, errors.New("malformed SSH signature")
+	}
+	sig := new(sshSignature)
+	if err := ssh.Unmarshal(block.Bytes, sig); err != nil {
+		return false, nil, fmt.Errorf("malformed SSH signature: %v", err)
+	}
+	if string(sig.MagicPreamble[:]) != "SSHSIG" || sig.Version != 1 {
+		return false, nil, errors.New("unsupported SSH signature version")
+	}
+	publicKey, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return false, nil, fmt.Errorf("malformed SSH signature public key: %v", err)
+	}
+	sshSig := new(ssh.Signature)
+	if err := ssh.Unmarshal(sig.Signature, sshSig); err != nil {
+		return false, nil, fmt.Errorf("malformed SSH signature: %v", err)
+	}
+
+	var h hash.Hash
+	switch sig.HashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return false, nil, fmt.Errorf("unsupported SSH signature hash algorithm %q", sig.HashAlgorithm)
+	}
+	h.Write([]byte(payload))
+	signed := ssh.Marshal(&sshSignedData{
+		MagicPreamble: sig.MagicPreamble,
+		Namespace:     sig.Namespace,
+		HashAlgorithm: sig.HashAlgorithm,
+		Hash:          h.Sum(nil),
+	})
+	if sig.Namespace != "git" || publicKey.Verify(signed, sshSig) != nil {
+		return false, nil, nil
+	}
+
+	var resp *Response
+	opts := &ListOptions{PerPage: 100}
+	for {
+		keys, r, err := s.ListSSHSigningKeys(ctx, user, opts)
+		resp = r
+		if err != nil {
+			return false, resp, err
+		}
+		for _, key := range keys {
+			registered, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.GetKey()))
+			if err == nil && bytes.Equal(registered.Marshal(), publicKey.Marshal()) {
+				return true, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return false, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"golang.org/x/crypto/ssh"
+)
+
+const testSignedPayload = "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor u <u@example.com> 1 +0000\n\nm\n"
+
+func TestUsersService_VerifySignature_gpg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	entity, err := openpgp.NewEntity("u", "", "u@example.com", nil)
+	if err != nil {
+		t.Fatalf("NewEntity returned error: %v", err)
+	}
+	var publicKey bytes.Buffer
+	w, _ := armor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+	entity.Serialize(w)
+	w.Close()
+
+	var signature bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&signature, entity, strings.NewReader(testSignedPayload), nil); err != nil {
+		t.Fatalf("ArmoredDetachSign returned error: %v", err)
+	}
+
+	mux.HandleFunc("/users/u/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		json.NewEncoder(w).Encode([]*GPGKey{{RawKey: String("malformed")}, {RawKey: String(publicKey.String())}})
+	})
+	mux.HandleFunc("/users/other/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`[]`))
+	})
+
+	ctx := context.Background()
+	v := &SignatureVerification{Signature: String(signature.String()), Payload: String(testSignedPayload)}
+	tests := []struct {
+		user    string
+		payload string
+		want    bool
+	}{
+		{"u", testSignedPayload, true},
+		{"u", testSignedPayload + "tampered", false},
+		{"other", testSignedPayload, false},
+	}
+	for _, tt := range tests {
+		v.Payload = String(tt.payload)
+		got, _, err := client.Users.VerifySignature(ctx, tt.user, v)
+		if err != nil {
+			t.Errorf("Users.VerifySignature(%v) returned error: %v", tt.user, err)
+		}
+		if got != tt.want {
+			t.Errorf("Users.VerifySignature(%v, %q) = %v, want %v", tt.user, tt.payload, got, tt.want)
+		}
+	}
+
+	const methodName = "VerifySignature"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.VerifySignature(ctx, "\n", v)
+		return err
+	})
+}
+
+func TestUsersService_VerifySignature_ssh(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey returned error: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatalf("NewSignerFromKey returned error: %v", err)
+	}
+	signature := testSSHSignature(t, signer, "git", testSignedPayload)
+
+	mux.HandleFunc("/users/u/ssh_signing_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		json.NewEncoder(w).Encode([]*SSHSigningKey{{Key: String("malformed")}, {Key: String(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))}})
+	})
+	mux.HandleFunc("/users/other/ssh_signing_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`[]`))
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		user      string
+		signature string
+		payload   string
+		want      bool
+	}{
+		{"u", signature, testSignedPayload, true},
+		{"u", signature, testSignedPayload + "tampered", false},
+		{"u", testSSHSignature(t, signer, "file", testSignedPayload), testSignedPayload, false},
+		{"other", signature, testSignedPayload, false},
+	}
+	for _, tt := range tests {
+		v := &SignatureVerification{Signature: String(tt.signature), Payload: String(tt.payload)}
+		got, _, err := client.Users.VerifySignature(ctx, tt.user, v)
+		if err != nil {
+			t.Errorf("Users.VerifySignature(%v) returned error: %v", tt.user, err)
+		}
+		if got != tt.want {
+			t.Errorf("Users.VerifySignature(%v, %q) = %v, want %v", tt.user, tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestUsersService_VerifySignature_gpgExpiredKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// The key expired an hour after it was created, long after it signed.
+	created := time.Now().Add(-48 * time.Hour)
+	config := &packet.Config{Time: func() time.Time { return created }, KeyLifetimeSecs: 3600}
+	entity, err := openpgp.NewEntity("u", "", "u@example.com", config)
+	if err != nil {
+		t.Fatalf("NewEntity returned error: %v", err)
+	}
+	var publicKey bytes.Buffer
+	w, _ := armor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+	entity.Serialize(w)
+	w.Close()
+
+	var signature bytes.Buffer
+	config.Time = func() time.Time { return created.Add(time.Minute) }
+	if err := openpgp.ArmoredDetachSign(&signature, entity, strings.NewReader(testSignedPayload), config); err != nil {
+		t.Fatalf("ArmoredDetachSign returned error: %v", err)
+	}
+
+	mux.HandleFunc("/users/u/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		json.NewEncoder(w).Encode([]*GPGKey{{RawKey: String(publicKey.String())}})
+	})
+
+	ctx := context.Background()
+	v := &SignatureVerification{Signature: String(signature.String()), Payload: String(testSignedPayload)}
+	got, _, err := client.Users.VerifySignature(ctx, "u", v)
+	if err != nil {
+		t.Errorf("Users.VerifySignature returned error: %v", err)
+	}
+	if !got {
+		t.Error("Users.VerifySignature = false, want true")
+	}
+}
+
+func TestUsersService_VerifySignature_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, signature := range []string{
+		"",
+		"-----BEGIN SIGNED MESSAGE-----\n-----END SIGNED MESSAGE-----\n",
+		"-----BEGIN PGP SIGNATURE-----\n\nywA=\n-----END PGP SIGNATURE-----\n",
+		"-----BEGIN SSH SIGNATURE-----\nbWFsZm9ybWVk\n-----END SSH SIGNATURE-----\n",
+	} {
+		v := &SignatureVerification{Signature: String(signature), Payload: String(testSignedPayload)}
+		if _, _, err := client.Users.VerifySignature(ctx, "u", v); err == nil {
+			t.Errorf("Users.VerifySignature(%q) returned nil error", signature)
+		}
+	}
+}
+
+// testSSHSignature returns the armored SSHSIG signature of payload by signer.
+func testSSHSignature(t *testing.T, signer ssh.Signer, namespace, payload string) string {
+	t.Helper()
+
+	hash := sha512.Sum512([]byte(payload))
+	preamble := [6]byte{'S', 'S', 'H', 'S', 'I', 'G'}
+	sig, err := signer.Sign(rand.Reader, ssh.Marshal(&sshSignedData{
+		MagicPreamble: preamble,
+		Namespace:     namespace,
+		HashAlgorithm: "sha512",
+		Hash:          hash[:],
+	}))
+	if err != nil {
+		t.Fatalf("Sign returned error: %v", err)
+	}
+
+	blob := ssh.Marshal(&sshSignature{
+		MagicPreamble: preamble,
+		Version:       1,
+		PublicKey:     signer.PublicKey().Marshal(),
+		Namespace:     namespace,
+		HashAlgorithm: "sha512",
+		Signature:     ssh.Marshal(sig),
+	})
+	return string(pem.EncodeToMemory(&pem.Block{Type: "SSH SIGNATURE", Bytes: blob}))
+}
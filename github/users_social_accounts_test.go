@@ -0,0 +1,156 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUsersService_ListSocialAccounts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/social_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"provider":"mastodon","url":"https://mastodon.social/@octocat"}]`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	ctx := context.Background()
+	accounts, _, err := client.Users.ListSocialAccounts(ctx, opt)
+	if err != nil {
+		t.Errorf("Users.ListSocialAccounts returned error: %v", err)
+	}
+
+	want := []*SocialAccount{{Provider: String("mastodon"), URL: String("https://mastodon.social/@octocat")}}
+	if !cmp.Equal(accounts, want) {
+		t.Errorf("Users.ListSocialAccounts returned %+v, want %+v", accounts, want)
+	}
+
+	const methodName = "ListSocialAccounts"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.ListSocialAccounts(ctx, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_ListUserSocialAccounts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/social_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"provider":"linkedin","url":"https://www.linkedin.com/company/github/"}]`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	ctx := context.Background()
+	accounts, _, err := client.Users.ListUserSocialAccounts(ctx, "u", opt)
+	if err != nil {
+		t.Errorf("Users.ListUserSocialAccounts returned error: %v", err)
+	}
+
+	want := []*SocialAccount{{Provider: String("linkedin"), URL: String("https://www.linkedin.com/company/github/")}}
+	if !cmp.Equal(accounts, want) {
+		t.Errorf("Users.ListUserSocialAccounts returned %+v, want %+v", accounts, want)
+	}
+
+	const methodName = "ListUserSocialAccounts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.ListUserSocialAccounts(ctx, "\n", opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.ListUserSocialAccounts(ctx, "u", opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_AddSocialAccounts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/social_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"account_urls":["https://mastodon.social/@octocat"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `[{"provider":"mastodon","url":"https://mastodon.social/@octocat"}]`)
+	})
+
+	input := []string{"https://mastodon.social/@octocat"}
+	ctx := context.Background()
+	accounts, _, err := client.Users.AddSocialAccounts(ctx, input)
+	if err != nil {
+		t.Errorf("Users.AddSocialAccounts returned error: %v", err)
+	}
+
+	want := []*SocialAccount{{Provider: String("mastodon"), URL: String("https://mastodon.social/@octocat")}}
+	if !cmp.Equal(accounts, want) {
+		t.Errorf("Users.AddSocialAccounts returned %+v, want %+v", accounts, want)
+	}
+
+	const methodName = "AddSocialAccounts"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.AddSocialAccounts(ctx, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_DeleteSocialAccounts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/social_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"account_urls":["https://mastodon.social/@octocat"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	input := []string{"https://mastodon.social/@octocat"}
+	ctx := context.Background()
+	_, err := client.Users.DeleteSocialAccounts(ctx, input)
+	if err != nil {
+		t.Errorf("Users.DeleteSocialAccounts returned error: %v", err)
+	}
+
+	const methodName = "DeleteSocialAccounts"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Users.DeleteSocialAccounts(ctx, input)
+	})
+}
+
+func TestSocialAccount_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SocialAccount{}, "{}")
+
+	u := &SocialAccount{
+		Provider: String("mastodon"),
+		URL:      String("https://mastodon.social/@octocat"),
+	}
+
+	want := `{
+		"provider": "mastodon",
+		"url": "https://mastodon.social/@octocat"
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // SSHSigningKey represents a public SSH key used to sign git commits.
@@ -93,7 +94,7 @@ func (s *UsersService) CreateSSHSigningKey(ctx context.Context, key *Key) (*SSHS
 	return k, resp, nil
 }
 
-// DeleteKey deletes a SSH signing key for the authenticated user.
+// DeleteSSHSigningKey deletes a SSH signing key for the authenticated user.
 //
 // GitHub API docs: https://docs.github.com/en/rest/users/ssh-signing-keys#delete-an-ssh-signing-key-for-the-authenticated-user
 func (s *UsersService) DeleteSSHSigningKey(ctx context.Context, id int64) (*Response, error) {
@@ -106,3 +107,41 @@ func (s *UsersService) DeleteSSHSigningKey(ctx context.Context, id int64) (*Resp
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// HasSSHSigningKey reports whether publicKey is registered as an SSH signing
+// key of a user. Passing an empty username string checks the keys of the
+// authenticated user. publicKey is in the authorized_keys format, such as
+// "ssh-ed25519 AAAA... comment"; only its type and data are compared.
+func (s *UsersService) HasSSHSigningKey(ctx context.Context, user, publicKey string) (bool, *Response, error) {
+	want := sshKeyFields(publicKey)
+	if want == "" {
+		return false, nil, fmt.Errorf("invalid SSH public key %q", publicKey)
+	}
+
+	opts := &ListOptions{PerPage: 100}
+	for {
+		keys, resp, err := s.ListSSHSigningKeys(ctx, user, opts)
+		if err != nil {
+			return false, resp, err
+		}
+		for _, key := range keys {
+			if sshKeyFields(key.GetKey()) == want {
+				return true, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return false, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// sshKeyFields returns the type and data of an authorized_keys formatted
+// SSH public key, without its comment, or "" if key is malformed.
+func sshKeyFields(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return ""
+	}
+	return fields[0] + " " + fields[1]
+}
@@ -181,6 +181,46 @@ func TestUsersService_DeleteSSHSigningKey(t *testing.T) {
 	})
 }
 
+func TestUsersService_HasSSHSigningKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/ssh_signing_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `[{"id":1,"key":"ssh-ed25519 AAAAC3Nza"}]`)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"ssh-ed25519 AAAAC3Nza octocat@example.com", true},
+		{"ssh-ed25519 AAAAC3Nzb", false},
+		{"ssh-rsa AAAAC3Nza", false},
+	}
+	for _, tt := range tests {
+		got, _, err := client.Users.HasSSHSigningKey(ctx, "u", tt.key)
+		if err != nil {
+			t.Errorf("Users.HasSSHSigningKey(%q) returned error: %v", tt.key, err)
+		}
+		if got != tt.want {
+			t.Errorf("Users.HasSSHSigningKey(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+
+	if _, _, err := client.Users.HasSSHSigningKey(ctx, "u", "AAAAC3Nza"); err == nil {
+		t.Error("Users.HasSSHSigningKey returned nil error for a malformed key, want error")
+	}
+
+	const methodName = "HasSSHSigningKey"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.HasSSHSigningKey(ctx, "\n", "ssh-ed25519 AAAAC3Nza")
+		return err
+	})
+}
+
 func TestSSHSigningKey_Marshal(t *testing.T) {
 	testJSONMarshal(t, &SSHSigningKey{}, "{}")
 
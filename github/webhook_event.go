@@ -0,0 +1,463 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// WebhookEvent is implemented by all the event payloads returned by
+// ParseWebHook and Event.ParsePayload. It exposes the fields shared by most
+// events, so that middleware such as authenticating as the installation or
+// routing by organization can handle events without a type switch. Each
+// method returns nil when an event does not have the field or GitHub did not
+// populate it.
+//
+// The repository is exposed as GetRepository rather than GetRepo because
+// PushEvent.GetRepo returns a *PushEventRepository.
+type WebhookEvent interface {
+	GetInstallation() *Installation
+	GetRepository() *Repository
+	GetOrg() *Organization
+	GetSender() *User
+}
+
+// GetRepository returns the Repo field.
+func (e *BranchProtectionRuleEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *CheckRunEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *CheckSuiteEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *CodeScanningAlertEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *CommitCommentEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *ContentReferenceEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *CreateEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns nil, as CustomPropertyEvent has no repository.
+func (e *CustomPropertyEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetRepository returns the Repo field.
+func (e *CustomPropertyValuesEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *DeleteEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *DeployKeyEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetOrg returns the Organization field.
+func (e *DeployKeyEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field.
+func (e *DeploymentEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *DeploymentProtectionRuleEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *DeploymentStatusEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *DiscussionCommentEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *DiscussionEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *ForkEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns nil, as GitHubAppAuthorizationEvent has no repository.
+func (e *GitHubAppAuthorizationEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetOrg returns nil, as GitHubAppAuthorizationEvent has no organization.
+func (e *GitHubAppAuthorizationEvent) GetOrg() *Organization {
+	return nil
+}
+
+// GetRepository returns the Repo field.
+func (e *GollumEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns nil, as InstallationEvent has no repository.
+func (e *InstallationEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetOrg returns nil, as InstallationEvent has no organization.
+func (e *InstallationEvent) GetOrg() *Organization {
+	return nil
+}
+
+// GetRepository returns nil, as InstallationRepositoriesEvent has no repository.
+func (e *InstallationRepositoriesEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetOrg returns nil, as InstallationRepositoriesEvent has no organization.
+func (e *InstallationRepositoriesEvent) GetOrg() *Organization {
+	return nil
+}
+
+// GetRepository returns the Repo field.
+func (e *IssueCommentEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetOrg returns the Organization field.
+func (e *IssueCommentEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field.
+func (e *IssuesEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *LabelEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns nil, as MarketplacePurchaseEvent has no repository.
+func (e *MarketplacePurchaseEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetOrg returns nil, as MarketplacePurchaseEvent has no organization.
+func (e *MarketplacePurchaseEvent) GetOrg() *Organization {
+	return nil
+}
+
+// GetRepository returns the Repo field.
+func (e *MemberEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns nil, as MembershipEvent has no repository.
+func (e *MembershipEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetRepository returns the Repo field.
+func (e *MergeGroupEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *MetaEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *MilestoneEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns nil, as OrgBlockEvent has no repository.
+func (e *OrgBlockEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetOrg returns the Organization field.
+func (e *OrgBlockEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns nil, as OrganizationEvent has no repository.
+func (e *OrganizationEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetOrg returns the Organization field.
+func (e *OrganizationEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field.
+func (e *PackageEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *PageBuildEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *PingEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *ProjectCardEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *ProjectColumnEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *ProjectEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns nil, as ProjectV2Event has no repository.
+func (e *ProjectV2Event) GetRepository() *Repository {
+	return nil
+}
+
+// GetRepository returns nil, as ProjectV2ItemEvent has no repository.
+func (e *ProjectV2ItemEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetRepository returns the Repo field.
+func (e *PublicEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *PullRequestEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetOrg returns the Organization field.
+func (e *PullRequestEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field.
+func (e *PullRequestReviewCommentEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *PullRequestReviewEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetOrg returns the Organization field.
+func (e *PullRequestReviewEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field.
+func (e *PullRequestReviewThreadEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *PullRequestTargetEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetOrg returns the Organization field.
+func (e *PullRequestTargetEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field converted to a *Repository, or nil
+// if it is nil.
+func (e *PushEvent) GetRepository() *Repository {
+	r := e.GetRepo()
+	if r == nil {
+		return nil
+	}
+	return &Repository{
+		ID:              r.ID,
+		NodeID:          r.NodeID,
+		Name:            r.Name,
+		FullName:        r.FullName,
+		Owner:           r.Owner,
+		Private:         r.Private,
+		Description:     r.Description,
+		Fork:            r.Fork,
+		CreatedAt:       r.CreatedAt,
+		PushedAt:        r.PushedAt,
+		UpdatedAt:       r.UpdatedAt,
+		Homepage:        r.Homepage,
+		PullsURL:        r.PullsURL,
+		Size:            r.Size,
+		StargazersCount: r.StargazersCount,
+		WatchersCount:   r.WatchersCount,
+		Language:        r.Language,
+		HasIssues:       r.HasIssues,
+		HasDownloads:    r.HasDownloads,
+		HasWiki:         r.HasWiki,
+		HasPages:        r.HasPages,
+		ForksCount:      r.ForksCount,
+		Archived:        r.Archived,
+		Disabled:        r.Disabled,
+		OpenIssuesCount: r.OpenIssuesCount,
+		DefaultBranch:   r.DefaultBranch,
+		MasterBranch:    r.MasterBranch,
+		URL:             r.URL,
+		ArchiveURL:      r.ArchiveURL,
+		HTMLURL:         r.HTMLURL,
+		StatusesURL:     r.StatusesURL,
+		GitURL:          r.GitURL,
+		SSHURL:          r.SSHURL,
+		CloneURL:        r.CloneURL,
+		SVNURL:          r.SVNURL,
+	}
+}
+
+// GetOrg returns the Organization field.
+func (e *PushEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field.
+func (e *RegistryPackageEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *ReleaseEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *RepositoryDispatchEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *RepositoryEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *RepositoryImportEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *RepositoryRulesetEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *SecretScanningAlertEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetOrg returns the Organization field.
+func (e *SecretScanningAlertEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field.
+func (e *SecretScanningAlertLocationEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetOrg returns the Organization field.
+func (e *SecretScanningAlertLocationEvent) GetOrg() *Organization {
+	return e.GetOrganization()
+}
+
+// GetRepository returns the Repo field.
+func (e *StarEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *StatusEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *SubIssuesEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *TeamAddEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *TeamEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns nil, as UserEvent has no repository.
+func (e *UserEvent) GetRepository() *Repository {
+	return nil
+}
+
+// GetOrg returns nil, as UserEvent has no organization.
+func (e *UserEvent) GetOrg() *Organization {
+	return nil
+}
+
+// GetRepository returns the Repo field.
+func (e *WatchEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *WorkflowDispatchEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *WorkflowJobEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
+
+// GetRepository returns the Repo field.
+func (e *WorkflowRunEvent) GetRepository() *Repository {
+	return e.GetRepo()
+}
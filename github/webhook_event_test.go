@@ -0,0 +1,69 @@
+// Copyright 2023 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWebhookEvent_allEvents(t *testing.T) {
+	for eventType := range eventTypeMapping {
+		event, err := ParseWebHook(eventType, []byte(`{}`))
+		if err != nil {
+			t.Fatalf("ParseWebHook(%q) returned error: %v", eventType, err)
+		}
+		if _, ok := event.(WebhookEvent); !ok {
+			t.Errorf("%T does not implement WebhookEvent", event)
+		}
+	}
+}
+
+func TestWebhookEvent_fields(t *testing.T) {
+	payload := []byte(`{
+		"repository": {"id": 1, "full_name": "o/r", "owner": {"login": "o"}},
+		"organization": {"login": "o"},
+		"installation": {"id": 2},
+		"sender": {"login": "s"}
+	}`)
+
+	for _, eventType := range []string{"push", "pull_request", "issues", "repository_vulnerability_alert"} {
+		event, err := ParseWebHook(eventType, payload)
+		if err != nil {
+			t.Fatalf("ParseWebHook(%q) returned error: %v", eventType, err)
+		}
+		e := event.(WebhookEvent)
+
+		if got, want := e.GetRepository(), (&Repository{ID: Int64(1), FullName: String("o/r"), Owner: &User{Login: String("o")}}); !cmp.Equal(got, want) {
+			t.Errorf("%v GetRepository = %+v, want %+v", eventType, got, want)
+		}
+		if got := e.GetOrg().GetLogin(); got != "o" {
+			t.Errorf("%v GetOrg login = %q, want %q", eventType, got, "o")
+		}
+		if got := e.GetInstallation().GetID(); got != 2 {
+			t.Errorf("%v GetInstallation ID = %v, want 2", eventType, got)
+		}
+		if got := e.GetSender().GetLogin(); got != "s" {
+			t.Errorf("%v GetSender login = %q, want %q", eventType, got, "s")
+		}
+	}
+}
+
+func TestWebhookEvent_missingFields(t *testing.T) {
+	var e WebhookEvent = &InstallationEvent{Installation: &Installation{ID: Int64(1)}}
+	if got := e.GetRepository(); got != nil {
+		t.Errorf("GetRepository = %+v, want nil", got)
+	}
+	if got := e.GetOrg(); got != nil {
+		t.Errorf("GetOrg = %+v, want nil", got)
+	}
+
+	e = &PushEvent{}
+	if got := e.GetRepository(); got != nil {
+		t.Errorf("PushEvent GetRepository = %+v, want nil", got)
+	}
+}